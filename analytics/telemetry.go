@@ -0,0 +1,320 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/version"
+	"github.com/fxamacker/cbor/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	telemetryEnabledKey  = "telemetry_enabled"
+	telemetryLastSentKey = "telemetry_last_sent"
+	telemetryInterval    = 24 * time.Hour
+	telemetryCheckPeriod = time.Hour
+	telemetryEndpoint    = "https://singularity-metrics.dataprogram.io/api/telemetry"
+)
+
+// TelemetryReport contains aggregate usage statistics of a singularity deployment.
+// It does not contain any identifying information such as instance ID, identity, names, paths, CIDs or wallet addresses.
+type TelemetryReport struct {
+	Version          string           `cbor:"1,keyasint"  json:"version"`
+	OS               string           `cbor:"2,keyasint"  json:"os"`
+	Arch             string           `cbor:"3,keyasint"  json:"arch"`
+	GoVersion        string           `cbor:"4,keyasint"  json:"goVersion"`
+	Database         string           `cbor:"5,keyasint"  json:"database"`
+	PreparationSizes map[string]int64 `cbor:"6,keyasint"  json:"preparationSizes"` // Number of preparations per size bucket
+	StorageTypes     map[string]int64 `cbor:"7,keyasint"  json:"storageTypes"`     // Number of storages per storage type
+	JobStates        map[string]int64 `cbor:"8,keyasint"  json:"jobStates"`        // Number of jobs per job type and state
+	ErrorClasses     map[string]int64 `cbor:"9,keyasint"  json:"errorClasses"`     // Number of failed jobs per job type and error class
+	DealStates       map[string]int64 `cbor:"10,keyasint" json:"dealStates"`       // Number of deals per deal state
+}
+
+var sizeBuckets = []struct {
+	name  string
+	limit int64
+}{
+	{"<1GiB", 1 << 30},
+	{"1GiB-10GiB", 10 << 30},
+	{"10GiB-100GiB", 100 << 30},
+	{"100GiB-1TiB", 1 << 40},
+	{"1TiB-10TiB", 10 << 40},
+	{"10TiB-100TiB", 100 << 40},
+	{"100TiB-1PiB", 1 << 50},
+}
+
+func sizeBucket(size int64) string {
+	for _, bucket := range sizeBuckets {
+		if size < bucket.limit {
+			return bucket.name
+		}
+	}
+	return ">=1PiB"
+}
+
+var errorClasses = []struct {
+	class    string
+	keywords []string
+}{
+	{"canceled", []string{"context canceled"}},
+	{"timeout", []string{"deadline exceeded", "timeout", "timed out"}},
+	{"file_changed", []string{"file has changed"}},
+	{"not_found", []string{"not found", "no such file", "does not exist"}},
+	{"permission", []string{"permission denied", "access denied", "forbidden", "unauthorized"}},
+	{"network", []string{"connection", "network", "eof", "tls", "dial"}},
+	{"disk_full", []string{"no space left"}},
+	{"database", []string{"database", "sql", "deadlock"}},
+}
+
+// classifyError maps an error message to a coarse error class so that no identifying
+// information, such as paths or bucket names, is reported.
+func classifyError(message string) string {
+	message = strings.ToLower(message)
+	for _, c := range errorClasses {
+		for _, keyword := range c.keywords {
+			if strings.Contains(message, keyword) {
+				return c.class
+			}
+		}
+	}
+	return "other"
+}
+
+// BuildTelemetryReport collects aggregate usage statistics from the database.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: The gorm.DB instance for making database queries.
+//
+// Returns:
+//   - A pointer to the TelemetryReport.
+//   - An error if there are issues querying the database.
+func BuildTelemetryReport(ctx context.Context, db *gorm.DB) (*TelemetryReport, error) {
+	db = db.WithContext(ctx)
+	report := TelemetryReport{
+		Version:          version.Version,
+		OS:               runtime.GOOS,
+		Arch:             runtime.GOARCH,
+		GoVersion:        runtime.Version(),
+		Database:         db.Dialector.Name(),
+		PreparationSizes: map[string]int64{},
+		StorageTypes:     map[string]int64{},
+		JobStates:        map[string]int64{},
+		ErrorClasses:     map[string]int64{},
+		DealStates:       map[string]int64{},
+	}
+
+	var preparationIDs []model.PreparationID
+	err := db.Model(&model.Preparation{}).Pluck("id", &preparationIDs).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var preparationSizes []struct {
+		PreparationID model.PreparationID
+		Size          int64
+	}
+	err = db.Model(&model.File{}).
+		Select("source_attachments.preparation_id AS preparation_id, SUM(files.size) AS size").
+		Joins("JOIN source_attachments ON source_attachments.id = files.attachment_id").
+		Group("source_attachments.preparation_id").
+		Scan(&preparationSizes).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	sizes := make(map[model.PreparationID]int64)
+	for _, s := range preparationSizes {
+		sizes[s.PreparationID] = s.Size
+	}
+	for _, id := range preparationIDs {
+		report.PreparationSizes[sizeBucket(sizes[id])]++
+	}
+
+	var storageTypes []struct {
+		Type  string
+		Count int64
+	}
+	err = db.Model(&model.Storage{}).Select("type, COUNT(*) AS count").Group("type").Scan(&storageTypes).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, s := range storageTypes {
+		report.StorageTypes[s.Type] = s.Count
+	}
+
+	var jobStates []struct {
+		Type  model.JobType
+		State model.JobState
+		Count int64
+	}
+	err = db.Model(&model.Job{}).Select("type, state, COUNT(*) AS count").Group("type, state").Scan(&jobStates).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, s := range jobStates {
+		report.JobStates[string(s.Type)+"/"+string(s.State)] = s.Count
+	}
+
+	var failedJobs []model.Job
+	err = db.Select("type", "error_message").Where("state = ?", model.Error).Find(&failedJobs).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, job := range failedJobs {
+		report.ErrorClasses[string(job.Type)+"/"+classifyError(job.ErrorMessage)]++
+	}
+
+	var dealStates []struct {
+		State model.DealState
+		Count int64
+	}
+	err = db.Model(&model.Deal{}).Select("state, COUNT(*) AS count").Group("state").Scan(&dealStates).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, s := range dealStates {
+		report.DealStates[string(s.State)] = s.Count
+	}
+
+	return &report, nil
+}
+
+// IsTelemetryEnabled returns whether the user has opted in to telemetry.
+// The environment variable 'SINGULARITY_TELEMETRY' takes precedence over the setting stored in the database.
+func IsTelemetryEnabled(ctx context.Context, db *gorm.DB) (bool, error) {
+	switch os.Getenv("SINGULARITY_TELEMETRY") {
+	case "1":
+		return true, nil
+	case "0":
+		return false, nil
+	}
+
+	value, err := getGlobal(ctx, db, telemetryEnabledKey)
+	if err != nil {
+		return false, err
+	}
+	return value == "true", nil
+}
+
+// SetTelemetryEnabled stores whether the user has opted in to telemetry in the database.
+func SetTelemetryEnabled(ctx context.Context, db *gorm.DB, enabled bool) error {
+	value := "false"
+	if enabled {
+		value = "true"
+	}
+	return setGlobal(ctx, db, telemetryEnabledKey, value)
+}
+
+// getGlobal returns the value of the global setting with the given key, or an empty string if it does not exist.
+// The key column is quoted by the clause since it is a reserved word in MySQL.
+func getGlobal(ctx context.Context, db *gorm.DB, key string) (string, error) {
+	var global model.Global
+	where := clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Name: "key"}, Value: key},
+	}}
+	err := db.WithContext(ctx).Clauses(where).Find(&global).Error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return global.Value, nil
+}
+
+func setGlobal(ctx context.Context, db *gorm.DB, key string, value string) error {
+	return errors.WithStack(db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value"}),
+	}).Create(&model.Global{Key: key, Value: value}).Error)
+}
+
+// SendTelemetryReport sends the report to the remote metrics service, using the same encoding as the analytics events.
+func SendTelemetryReport(ctx context.Context, report TelemetryReport) error {
+	body := bytes.NewBuffer(nil)
+	err := cbor.NewEncoder(body).Encode(report)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	compressed := zstdEncoder.EncodeAll(body.Bytes(), make([]byte, 0, body.Len()))
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, telemetryEndpoint,
+		bytes.NewBufferString(base64.StdEncoding.EncodeToString(compressed)))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	request.Header.Set("Content-Type", "text/plain")
+	resp, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		responseBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return errors.Errorf("failed to send telemetry report: %s", responseBody)
+	}
+	return nil
+}
+
+// sendTelemetryIfDue sends a telemetry report if the user has opted in and no report has been sent
+// by any singularity process sharing the same database in the last telemetryInterval.
+func sendTelemetryIfDue(ctx context.Context, db *gorm.DB) error {
+	enabled, err := IsTelemetryEnabled(ctx, db)
+	if err != nil || !enabled {
+		return err
+	}
+
+	lastSent, err := getGlobal(ctx, db, telemetryLastSentKey)
+	if err != nil {
+		return err
+	}
+	if lastSent != "" {
+		last, err := time.Parse(time.RFC3339, lastSent)
+		if err == nil && time.Since(last) < telemetryInterval {
+			return nil
+		}
+	}
+
+	err = setGlobal(ctx, db, telemetryLastSentKey, time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+
+	report, err := BuildTelemetryReport(ctx, db)
+	if err != nil {
+		return err
+	}
+	return SendTelemetryReport(ctx, *report)
+}
+
+// StartTelemetry periodically sends the telemetry report if the user has opted in.
+// It is designed to be run as a background task and will continue to run until the passed context is cancelled.
+func StartTelemetry(ctx context.Context, db *gorm.DB) {
+	timer := time.NewTimer(telemetryCheckPeriod)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			timer.Reset(telemetryCheckPeriod)
+		}
+		err := sendTelemetryIfDue(ctx, db)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			logger.Warnw("failed to send telemetry report", "error", err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestClassifyError(t *testing.T) {
+	require.Equal(t, "not_found", classifyError("failed to open /secret/path/file.txt: no such file or directory"))
+	require.Equal(t, "timeout", classifyError("context deadline exceeded"))
+	require.Equal(t, "network", classifyError("read tcp 10.0.0.1:443: connection reset by peer"))
+	require.Equal(t, "other", classifyError("something unexpected"))
+}
+
+func TestSizeBucket(t *testing.T) {
+	require.Equal(t, "<1GiB", sizeBucket(0))
+	require.Equal(t, "1GiB-10GiB", sizeBucket(1<<30))
+	require.Equal(t, "100TiB-1PiB", sizeBucket(1<<49))
+	require.Equal(t, ">=1PiB", sizeBucket(1<<50))
+}
+
+func TestBuildTelemetryReport(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			Name: "prep",
+			SourceStorages: []model.Storage{{
+				Name: "source",
+				Type: "local",
+				Path: "/secret/path",
+			}},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Preparation{Name: "empty"}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.File{
+			Path:         "large.bin",
+			Size:         5 << 30,
+			AttachmentID: 1,
+			Directory: &model.Directory{
+				AttachmentID: 1,
+			},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Job{
+			Type:         model.Pack,
+			State:        model.Error,
+			ErrorMessage: "failed to open /secret/path/large.bin: permission denied",
+			AttachmentID: 1,
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Deal{
+			State:    model.DealActive,
+			Provider: "f01000",
+			Wallet:   &model.Wallet{},
+		}).Error
+		require.NoError(t, err)
+
+		report, err := BuildTelemetryReport(ctx, db)
+		require.NoError(t, err)
+		require.Equal(t, map[string]int64{"<1GiB": 1, "1GiB-10GiB": 1}, report.PreparationSizes)
+		require.Equal(t, map[string]int64{"local": 1}, report.StorageTypes)
+		require.Equal(t, map[string]int64{"pack/error": 1}, report.JobStates)
+		require.Equal(t, map[string]int64{"pack/permission": 1}, report.ErrorClasses)
+		require.Equal(t, map[string]int64{"active": 1}, report.DealStates)
+		require.Equal(t, db.Dialector.Name(), report.Database)
+	})
+}
+
+func TestTelemetryEnabled(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		enabled, err := IsTelemetryEnabled(ctx, db)
+		require.NoError(t, err)
+		require.False(t, enabled)
+
+		err = SetTelemetryEnabled(ctx, db, true)
+		require.NoError(t, err)
+		enabled, err = IsTelemetryEnabled(ctx, db)
+		require.NoError(t, err)
+		require.True(t, enabled)
+
+		t.Setenv("SINGULARITY_TELEMETRY", "0")
+		enabled, err = IsTelemetryEnabled(ctx, db)
+		require.NoError(t, err)
+		require.False(t, enabled)
+	})
+}
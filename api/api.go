@@ -2,7 +2,6 @@ package api
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"net"
 	"net/http"
@@ -16,18 +15,24 @@ import (
 	"github.com/data-preservation-programs/singularity/handler/dataprep"
 	"github.com/data-preservation-programs/singularity/handler/deal"
 	"github.com/data-preservation-programs/singularity/handler/deal/schedule"
+	"github.com/data-preservation-programs/singularity/handler/export"
 	"github.com/data-preservation-programs/singularity/handler/file"
 	"github.com/data-preservation-programs/singularity/handler/handlererror"
 	"github.com/data-preservation-programs/singularity/handler/job"
+	"github.com/data-preservation-programs/singularity/handler/report"
 	"github.com/data-preservation-programs/singularity/handler/storage"
 	"github.com/data-preservation-programs/singularity/handler/wallet"
+	"github.com/data-preservation-programs/singularity/handler/worker"
+	"github.com/data-preservation-programs/singularity/i18n"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/operator"
 	"github.com/data-preservation-programs/singularity/replication"
 	"github.com/data-preservation-programs/singularity/retriever"
 	"github.com/data-preservation-programs/singularity/retriever/endpointfinder"
 	"github.com/data-preservation-programs/singularity/service"
 	"github.com/data-preservation-programs/singularity/service/contentprovider"
 	"github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/version"
 	"github.com/filecoin-project/lassie/pkg/lassie"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/ybbus/jsonrpc/v3"
@@ -59,6 +64,11 @@ type Server struct {
 	fileHandler     file.Handler
 	jobHandler      job.Handler
 	scheduleHandler schedule.Handler
+	reportHandler   report.Handler
+	exportHandler   export.Handler
+	workerHandler   worker.Handler
+	backupRoot      string
+	enableDump      bool
 }
 
 func (s Server) Name() string {
@@ -86,6 +96,8 @@ func Run(c *cli.Context) error {
 
 	lotusAPI := c.String("lotus-api")
 	lotusToken := c.String("lotus-token")
+	backupRoot := c.String("backup-root")
+	enableDump := c.Bool("enable-database-dump")
 
 	listener, err := net.Listen("tcp", bind)
 	if err != nil {
@@ -96,6 +108,8 @@ func Run(c *cli.Context) error {
 		Listener:   listener,
 		LotusAPI:   lotusAPI,
 		LotusToken: lotusToken,
+		BackupRoot: backupRoot,
+		EnableDump: enableDump,
 	})
 	if err != nil {
 		return errors.WithStack(err)
@@ -110,6 +124,8 @@ type APIParams struct {
 	LotusAPI   string
 	LotusToken string
 	ConnString string
+	BackupRoot string // Directory the backups requested through the API are confined to. Backups are disabled if empty.
+	EnableDump bool   // Whether the database dumps, which run the dump tools on the server, can be requested through the API.
 }
 
 func InitServer(ctx context.Context, params APIParams) (Server, error) {
@@ -157,6 +173,11 @@ func InitServer(ctx context.Context, params APIParams) (Server, error) {
 		fileHandler:     &file.DefaultHandler{},
 		jobHandler:      &job.DefaultHandler{},
 		scheduleHandler: &schedule.DefaultHandler{},
+		reportHandler:   &report.DefaultHandler{},
+		exportHandler:   &export.DefaultHandler{},
+		workerHandler:   &worker.DefaultHandler{},
+		backupRoot:      params.BackupRoot,
+		enableDump:      params.EnableDump,
 	}, nil
 }
 
@@ -172,7 +193,8 @@ func InitServer(ctx context.Context, params APIParams) (Server, error) {
 //   - Any other supported path parameters (string, int, uint) or a request body.
 //
 // The handler function should return either a single error or a result and an error.
-// The output will be interpreted and converted into appropriate HTTP responses.
+// The output will be interpreted and converted into appropriate HTTP responses, with the secrets of the
+// storages in the result masked.
 //
 // Parameters:
 //   - handlerFunc: A function to be converted, it should have a supported signature.
@@ -223,20 +245,20 @@ func (s Server) toEchoHandler(handlerFunc any) echo.HandlerFunc {
 			if paramType.Kind() == reflect.String || isIntKind(paramType.Kind()) || isUIntKind(paramType.Kind()) {
 				if j >= len(c.ParamValues()) {
 					logger.Error("Invalid handler function signature.")
-					return c.JSON(http.StatusInternalServerError, HTTPError{Err: "invalid handler function signature"})
+					return c.JSON(http.StatusInternalServerError, HTTPError{Err: i18n.Sprintf(c.Request().Context(), "invalid handler function signature")})
 				}
 				paramValue := c.ParamValues()[j]
 				switch {
 				case paramType.Kind() == reflect.String:
 					decoded, err := url.QueryUnescape(paramValue)
 					if err != nil {
-						return c.JSON(http.StatusInternalServerError, HTTPError{Err: "failed to decode path parameter"})
+						return c.JSON(http.StatusInternalServerError, HTTPError{Err: i18n.Sprintf(c.Request().Context(), "failed to decode path parameter")})
 					}
 					inputParams = append(inputParams, reflect.ValueOf(decoded))
 				case isIntKind(paramType.Kind()):
 					decoded, err := strconv.ParseInt(paramValue, 10, paramType.Bits())
 					if err != nil {
-						return c.JSON(http.StatusBadRequest, HTTPError{Err: "failed to parse path parameter as number"})
+						return c.JSON(http.StatusBadRequest, HTTPError{Err: i18n.Sprintf(c.Request().Context(), "failed to parse path parameter as number")})
 					}
 					val := reflect.New(paramType).Elem()
 					val.SetInt(decoded)
@@ -244,7 +266,7 @@ func (s Server) toEchoHandler(handlerFunc any) echo.HandlerFunc {
 				case isUIntKind(paramType.Kind()):
 					decoded, err := strconv.ParseUint(paramValue, 10, paramType.Bits())
 					if err != nil {
-						return c.JSON(http.StatusBadRequest, HTTPError{Err: "failed to parse path parameter as number"})
+						return c.JSON(http.StatusBadRequest, HTTPError{Err: i18n.Sprintf(c.Request().Context(), "failed to parse path parameter as number")})
 					}
 					val := reflect.New(paramType).Elem()
 					val.SetUint(decoded)
@@ -259,7 +281,7 @@ func (s Server) toEchoHandler(handlerFunc any) echo.HandlerFunc {
 				bodyParam.Set(reflect.MakeMap(bodyParam.Type()))
 			}
 			if err := c.Bind(bodyParam.Addr().Interface()); err != nil {
-				return c.JSON(http.StatusBadRequest, HTTPError{Err: fmt.Sprintf("failed to bind request body: %s", err)})
+				return c.JSON(http.StatusBadRequest, HTTPError{Err: i18n.Sprintf(c.Request().Context(), "failed to bind request body: %s", err)})
 			}
 			inputParams = append(inputParams, bodyParam)
 			break
@@ -273,7 +295,7 @@ func (s Server) toEchoHandler(handlerFunc any) echo.HandlerFunc {
 			if results[0].Interface() != nil {
 				err, ok := results[1].Interface().(error)
 				if !ok {
-					return c.JSON(http.StatusInternalServerError, HTTPError{Err: "invalid handler function signature"})
+					return c.JSON(http.StatusInternalServerError, HTTPError{Err: i18n.Sprintf(c.Request().Context(), "invalid handler function signature")})
 				}
 				return httpResponseFromError(c, err)
 			}
@@ -284,21 +306,46 @@ func (s Server) toEchoHandler(handlerFunc any) echo.HandlerFunc {
 		if results[1].Interface() != nil {
 			err, ok := results[1].Interface().(error)
 			if !ok {
-				return c.JSON(http.StatusInternalServerError, HTTPError{Err: "invalid handler function signature"})
+				return c.JSON(http.StatusInternalServerError, HTTPError{Err: i18n.Sprintf(c.Request().Context(), "invalid handler function signature")})
 			}
 			return httpResponseFromError(c, err)
 		}
 
-		// Handle the returned data
-		data := results[0].Interface()
+		// Handle the returned data. Secrets in storage configs are never returned by the API.
+		data := model.MaskStorageSecrets(results[0].Interface())
 		return c.JSON(http.StatusOK, data)
 	}
 }
 
+// backupRootMiddleware confines the backups requested through the API to the backup root of the server.
+func (s Server) backupRootMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.SetRequest(c.Request().WithContext(admin.WithBackupRoot(c.Request().Context(), s.backupRoot)))
+		return next(c)
+	}
+}
+
+// dumpMiddleware denies the database dumps requested through the API unless the server enables them.
+func (s Server) dumpMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.SetRequest(c.Request().WithContext(dataprep.WithDumpEnabled(c.Request().Context(), s.enableDump)))
+		return next(c)
+	}
+}
+
 func (s Server) setupRoutes(e *echo.Echo) {
 	// Admin
 	e.POST("/api/identity", s.toEchoHandler(s.adminHandler.SetIdentityHandler))
+	e.POST("/api/admin/migrate-config", s.toEchoHandler(s.adminHandler.MigrateConfigHandler))
+	e.POST("/api/admin/backup", s.toEchoHandler(s.adminHandler.BackupHandler), s.backupRootMiddleware)
+	e.POST("/api/admin/merge-preparations", s.toEchoHandler(s.adminHandler.MergePreparationsHandler))
+	e.POST("/api/admin/split-source", s.toEchoHandler(s.adminHandler.SplitSourceHandler))
+	e.POST("/api/admin/storage-forecast", s.toEchoHandler(s.adminHandler.StorageForecastHandler))
+	e.POST("/api/admin/full-text-search", s.toEchoHandler(s.adminHandler.FullTextSearchHandler))
 	// Storage
+	e.GET("/api/storage/types", s.toEchoHandler(s.storageHandler.ListStorageTypesHandler))
+	e.GET("/api/storage/types/:type", s.toEchoHandler(s.storageHandler.GetStorageTypeHandler))
+	e.POST("/api/storage/slowest", s.toEchoHandler(s.storageHandler.ListSlowestSourcesHandler))
 	e.POST("/api/storage/:type", s.toEchoHandler(s.storageHandler.CreateStorageHandler))
 	e.POST("/api/storage/:type/:provider", s.toEchoHandler(func(
 		ctx context.Context,
@@ -315,14 +362,20 @@ func (s Server) setupRoutes(e *echo.Echo) {
 	e.DELETE("/api/storage/:name", s.toEchoHandler(s.storageHandler.RemoveHandler))
 	e.PATCH("/api/storage/:name", s.toEchoHandler(s.storageHandler.UpdateStorageHandler))
 	e.PATCH("/api/storage/:name/rename", s.toEchoHandler(s.storageHandler.RenameStorageHandler))
+	e.POST("/api/storage/:name/reauth", s.toEchoHandler(s.storageHandler.ReauthStorageHandler))
 
 	// Preparation
 	e.POST("/api/preparation", s.toEchoHandler(s.dataprepHandler.CreatePreparationHandler))
 	e.DELETE("/api/preparation/:id", s.toEchoHandler(s.dataprepHandler.RemovePreparationHandler))
 	e.GET("/api/preparation", s.toEchoHandler(s.dataprepHandler.ListHandler))
 	e.GET("/api/preparation/:id", s.toEchoHandler(s.jobHandler.GetStatusHandler))
+	e.GET("/api/preparation/:id/timing", s.toEchoHandler(s.jobHandler.GetPackTimingsHandler))
 	e.GET("/api/preparation/:id/schedules", s.toEchoHandler(s.dataprepHandler.ListSchedulesHandler))
 	e.PATCH("/api/preparation/:name/rename", s.toEchoHandler(s.dataprepHandler.RenamePreparationHandler))
+	e.POST("/api/preparation/:name/pause", s.toEchoHandler(s.dataprepHandler.PausePreparationHandler))
+	e.POST("/api/preparation/:name/resume", s.toEchoHandler(s.dataprepHandler.ResumePreparationHandler))
+	e.PUT("/api/preparation/:id/recipients", s.toEchoHandler(s.dataprepHandler.RotateRecipientsHandler))
+	e.POST("/api/preparation/:id/verify-sources", s.toEchoHandler(s.dataprepHandler.VerifySourcesHandler))
 
 	// Job management
 	e.POST("/api/preparation/:id/source/:name/start-daggen", s.toEchoHandler(s.jobHandler.StartDagGenHandler))
@@ -333,10 +386,14 @@ func (s Server) setupRoutes(e *echo.Echo) {
 	e.POST("/api/preparation/:id/source/:name/pause-pack/:job_id", s.toEchoHandler(s.jobHandler.PausePackHandler))
 	e.POST("/api/preparation/:id/source/:name/finalize", s.toEchoHandler(s.jobHandler.PrepareToPackSourceHandler))
 	e.POST("/api/job/:id/pack", s.toEchoHandler(s.jobHandler.PackHandler))
+	e.POST("/api/job/pack-one", s.toEchoHandler(s.jobHandler.PackOneHandler))
 
 	// storage attachment
 	e.POST("/api/preparation/:id/output/:name", s.toEchoHandler(s.dataprepHandler.AddOutputStorageHandler))
 	e.POST("/api/preparation/:id/source/:name", s.toEchoHandler(s.dataprepHandler.AddSourceStorageHandler))
+	e.PATCH("/api/preparation/:id/source/:name", s.toEchoHandler(s.dataprepHandler.UpdateSourceHandler))
+	e.POST("/api/preparation/:id/source/:name/dump", s.toEchoHandler(s.dataprepHandler.DumpDatabaseHandler), s.dumpMiddleware)
+	e.POST("/api/preparation/:id/source/:name/diff", s.toEchoHandler(s.dataprepHandler.DiffSourceHandler))
 	e.DELETE("/api/preparation/:id/output/:name", s.toEchoHandler(s.dataprepHandler.RemoveOutputStorageHandler))
 
 	// Explore
@@ -345,10 +402,21 @@ func (s Server) setupRoutes(e *echo.Echo) {
 	// Piece
 	e.GET("/api/preparation/:id/piece", s.toEchoHandler(s.dataprepHandler.ListPiecesHandler))
 	e.POST("/api/preparation/:id/piece", s.toEchoHandler(s.dataprepHandler.AddPieceHandler))
+	e.POST("/api/preparation/:id/piece/index", s.toEchoHandler(s.dataprepHandler.ExportPieceIndexHandler))
+	e.GET("/api/preparation/:id/piece-keys", s.toEchoHandler(s.dataprepHandler.ExportPieceKeysHandler))
+	e.GET("/api/preparation/:id/item-keys", s.toEchoHandler(s.dataprepHandler.ExportItemKeysHandler))
+	e.POST("/api/preparation/:id/retrieval-token", s.toEchoHandler(s.dataprepHandler.CreateRetrievalTokenHandler))
+	e.GET("/api/preparation/:id/retrieval-token", s.toEchoHandler(s.dataprepHandler.ListRetrievalTokensHandler))
+	e.POST("/api/preparation/:id/retrieval-token/:token_id/revoke", s.toEchoHandler(s.dataprepHandler.RevokeRetrievalTokenHandler))
+	e.POST("/api/preparation/:id/piece/aggregate", s.toEchoHandler(s.dataprepHandler.AggregatePiecesHandler))
+	e.GET("/api/preparation/:id/stats", s.toEchoHandler(s.dataprepHandler.GetStatsHandler))
+	e.POST("/api/preparation/:id/stats/share", s.toEchoHandler(s.dataprepHandler.ShareStatsHandler))
+	e.DELETE("/api/preparation/:id/stats/share", s.toEchoHandler(s.dataprepHandler.UnshareStatsHandler))
 
 	// Wallet
 	e.POST("/api/wallet", s.toEchoHandler(s.walletHandler.ImportHandler))
 	e.GET("/api/wallet", s.toEchoHandler(s.walletHandler.ListHandler))
+	e.GET("/api/wallet/balance", s.toEchoHandler(s.walletHandler.BalanceHandler))
 	e.DELETE("/api/wallet/:address", s.toEchoHandler(s.walletHandler.RemoveHandler))
 
 	// Wallet Association
@@ -358,25 +426,48 @@ func (s Server) setupRoutes(e *echo.Echo) {
 
 	// Piece metadata
 	e.GET("/api/piece/:id/metadata", s.getMetadataHandler)
+	e.GET("/api/piece/:id/proof", s.toEchoHandler(s.dataprepHandler.GetPieceProofHandler))
 
 	// Deal Schedule
 	e.POST("/api/send_deal", s.toEchoHandler(s.dealHandler.SendManualHandler))
 	e.POST("/api/schedule", s.toEchoHandler(s.scheduleHandler.CreateHandler))
 	e.GET("/api/schedule", s.toEchoHandler(s.scheduleHandler.ListHandler))
+	e.POST("/api/schedule/calendar", s.toEchoHandler(s.scheduleHandler.CalendarHandler))
+	e.POST("/api/schedule/simulate", s.toEchoHandler(s.scheduleHandler.SimulateHandler))
 	e.POST("/api/schedule/:id/pause", s.toEchoHandler(s.scheduleHandler.PauseHandler))
 	e.POST("/api/schedule/:id/resume", s.toEchoHandler(s.scheduleHandler.ResumeHandler))
 	e.PATCH("/api/schedule/:id", s.toEchoHandler(s.scheduleHandler.UpdateHandler))
+	e.POST("/api/schedule/:id/approve", s.toEchoHandler(s.scheduleHandler.ApproveHandler))
 	e.DELETE("/api/schedule/:id", s.toEchoHandler(s.scheduleHandler.RemoveHandler))
+	e.PUT("/api/preparation/:id/policy", s.toEchoHandler(s.scheduleHandler.SetPolicyHandler))
+	e.DELETE("/api/preparation/:id/policy", s.toEchoHandler(s.scheduleHandler.RemovePolicyHandler))
+	e.GET("/api/policy", s.toEchoHandler(s.scheduleHandler.ListPoliciesHandler))
 
 	// Deal
 	e.POST("/api/deal", s.toEchoHandler(s.dealHandler.ListHandler))
+	e.POST("/api/deal/receipt", s.toEchoHandler(s.dealHandler.ListReceiptsHandler))
+	e.POST("/api/deal/piece_providers", s.toEchoHandler(s.dealHandler.ListPieceProvidersHandler))
 
 	// File
 	e.GET("/api/file/:id/deals", s.toEchoHandler(s.fileHandler.GetFileDealsHandler))
 	e.GET("/api/file/:id", s.toEchoHandler(s.fileHandler.GetFileHandler))
 	e.POST("/api/file/:id/prepare_to_pack", s.toEchoHandler(s.fileHandler.PrepareToPackFileHandler))
 	e.GET("/api/file/:id/retrieve", s.retrieveFile)
+	e.GET("/api/file/:id/preview", s.previewFile)
+	e.POST("/api/file/search", s.toEchoHandler(s.fileHandler.SearchFilesHandler))
 	e.POST("/api/preparation/:id/source/:name/file", s.toEchoHandler(s.fileHandler.PushFileHandler))
+	e.POST("/api/preparation/:id/source/:name/files", s.toEchoHandler(s.fileHandler.AppendFilesHandler))
+
+	// Report
+	e.POST("/api/report/capacity", s.toEchoHandler(s.reportHandler.CapacityHandler))
+	e.POST("/api/report/audit", s.toEchoHandler(s.reportHandler.AuditHandler))
+	e.POST("/api/report/lineage", s.toEchoHandler(s.reportHandler.LineageHandler))
+
+	// Export
+	e.POST("/api/export/manifest", s.toEchoHandler(s.exportHandler.ManifestHandler))
+
+	// Worker
+	e.GET("/api/worker", s.toEchoHandler(s.workerHandler.ListHandler))
 }
 
 var logger = logging.Logger("api")
@@ -438,10 +529,14 @@ func (s Server) Start(ctx context.Context, exitErr chan<- error) error {
 		},
 	}))
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
+		AllowOrigins:  []string{"*"},
+		AllowMethods:  []string{http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete},
+		AllowHeaders:  []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, version.Header, operator.Header},
+		ExposeHeaders: []string{version.Header},
 	}))
+	e.Use(version.Middleware)
+	e.Use(operator.Middleware)
+	e.Use(i18n.Middleware)
 
 	//nolint:contextcheck
 	s.setupRoutes(e)
@@ -490,6 +585,8 @@ func (s Server) Start(ctx context.Context, exitErr chan<- error) error {
 		analytics.Default.Flush()
 	}()
 
+	go analytics.StartTelemetry(ctx, s.db)
+
 	return nil
 }
 
@@ -524,6 +621,14 @@ func httpResponseFromError(c echo.Context, e error) error {
 		httpStatusCode = http.StatusConflict
 	}
 
+	if errors.Is(e, handlererror.ErrUnauthorized) {
+		httpStatusCode = http.StatusUnauthorized
+	}
+
+	if errors.Is(e, handlererror.ErrForbidden) {
+		httpStatusCode = http.StatusForbidden
+	}
+
 	logger.Errorf("%+v", e)
-	return c.JSON(httpStatusCode, HTTPError{Err: e.Error()})
+	return c.JSON(httpStatusCode, HTTPError{Err: i18n.Error(i18n.FromContext(c.Request().Context()), e)})
 }
@@ -27,10 +27,13 @@ import (
 	"github.com/data-preservation-programs/singularity/handler/dataprep"
 	"github.com/data-preservation-programs/singularity/handler/deal"
 	"github.com/data-preservation-programs/singularity/handler/deal/schedule"
+	"github.com/data-preservation-programs/singularity/handler/export"
 	"github.com/data-preservation-programs/singularity/handler/file"
 	"github.com/data-preservation-programs/singularity/handler/job"
+	"github.com/data-preservation-programs/singularity/handler/report"
 	"github.com/data-preservation-programs/singularity/handler/storage"
 	"github.com/data-preservation-programs/singularity/handler/wallet"
+	"github.com/data-preservation-programs/singularity/handler/worker"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/replication"
 	"github.com/data-preservation-programs/singularity/service"
@@ -63,6 +66,10 @@ func setupMockAdmin() admin.Handler {
 		Return(nil)
 	m.On("SetIdentityHandler", mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
+	m.On("StorageForecastHandler", mock.Anything, mock.Anything, mock.Anything).
+		Return([]admin.StorageForecast{{}}, nil)
+	m.On("FullTextSearchHandler", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
 	return m
 }
 
@@ -84,10 +91,38 @@ func setupMockDataPrep() dataprep.Handler {
 		Return([]dataprep.PieceList{{}}, nil)
 	m.On("AddPieceHandler", mock.Anything, mock.Anything, "id", mock.Anything).
 		Return(&model.Car{}, nil)
+	m.On("ExportPieceKeysHandler", mock.Anything, mock.Anything, "id").
+		Return(&dataprep.PieceKeyEscrow{}, nil)
+	m.On("ExportItemKeysHandler", mock.Anything, mock.Anything, "id").
+		Return(&dataprep.ItemKeyEscrow{}, nil)
+	m.On("AggregatePiecesHandler", mock.Anything, mock.Anything, "id", mock.Anything).
+		Return([]model.Car{{}}, nil)
+	m.On("GetPieceProofHandler", mock.Anything, mock.Anything, "id").
+		Return(&dataprep.PieceProof{}, nil)
+	m.On("GetStatsHandler", mock.Anything, mock.Anything, "id").
+		Return(&dataprep.Stats{}, nil)
+	m.On("ShareStatsHandler", mock.Anything, mock.Anything, "id").
+		Return(&model.Preparation{}, nil)
+	m.On("UnshareStatsHandler", mock.Anything, mock.Anything, "id").
+		Return(&model.Preparation{}, nil)
 	m.On("AddSourceStorageHandler", mock.Anything, mock.Anything, "id", "name").
 		Return(&model.Preparation{}, nil)
+	m.On("UpdateSourceHandler", mock.Anything, mock.Anything, "id", "name", mock.Anything).
+		Return(&model.SourceAttachment{}, nil)
+	m.On("DumpDatabaseHandler", mock.Anything, mock.Anything, "id", "name", mock.Anything).
+		Return(&file.AppendResult{}, nil)
+	m.On("DiffSourceHandler", mock.Anything, mock.Anything, "id", "name", mock.Anything).
+		Return(&dataprep.SourceDiff{}, nil)
 	m.On("RenamePreparationHandler", mock.Anything, mock.Anything, "old", mock.Anything).
 		Return(&model.Preparation{}, nil)
+	m.On("PausePreparationHandler", mock.Anything, mock.Anything, "old").
+		Return(&model.Preparation{}, nil)
+	m.On("ResumePreparationHandler", mock.Anything, mock.Anything, "old").
+		Return(&model.Preparation{}, nil)
+	m.On("RotateRecipientsHandler", mock.Anything, mock.Anything, "id", mock.Anything).
+		Return(&model.Preparation{}, nil)
+	m.On("VerifySourcesHandler", mock.Anything, mock.Anything, "id").
+		Return([]model.SourceAttachment{}, nil)
 	m.On("RemovePreparationHandler", mock.Anything, mock.Anything, "old", mock.Anything).
 		Return(nil)
 	return m
@@ -99,6 +134,10 @@ func setupMockDeal() deal.Handler {
 		Return([]model.Deal{{}}, nil)
 	m.On("SendManualHandler", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(&model.Deal{}, nil)
+	m.On("ListReceiptsHandler", mock.Anything, mock.Anything, mock.Anything).
+		Return([]model.PieceReceipt{{}}, nil)
+	m.On("ListPieceProvidersHandler", mock.Anything, mock.Anything, mock.Anything).
+		Return([]deal.PieceProviders{{}}, nil)
 	return m
 }
 
@@ -112,10 +151,43 @@ func setupMockSchedule() schedule.Handler {
 		Return(&model.Schedule{}, nil)
 	m.On("ResumeHandler", mock.Anything, mock.Anything, uint32(1)).
 		Return(&model.Schedule{}, nil)
+	m.On("ApproveHandler", mock.Anything, mock.Anything, uint32(1)).
+		Return(&model.Schedule{}, nil)
 	m.On("UpdateHandler", mock.Anything, mock.Anything, uint32(1), mock.Anything).
 		Return(&model.Schedule{}, nil)
 	m.On("RemoveHandler", mock.Anything, mock.Anything, uint32(1)).
 		Return(nil)
+	m.On("CalendarHandler", mock.Anything, mock.Anything, mock.Anything).
+		Return([]schedule.CalendarEntry{{}}, nil)
+	m.On("SetPolicyHandler", mock.Anything, mock.Anything, mock.Anything, "id", mock.Anything).
+		Return(&model.ReplicationPolicy{}, nil)
+	m.On("ListPoliciesHandler", mock.Anything, mock.Anything).
+		Return([]model.ReplicationPolicy{{}}, nil)
+	m.On("RemovePolicyHandler", mock.Anything, mock.Anything, "id").
+		Return(nil)
+	return m
+}
+
+func setupMockReport() report.Handler {
+	m := new(report.MockReport)
+	m.On("CapacityHandler", mock.Anything, mock.Anything, mock.Anything).
+		Return([]report.CapacityReport{{}}, nil)
+	m.On("AuditHandler", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(&report.AuditReport{}, nil)
+	return m
+}
+
+func setupMockExport() export.Handler {
+	m := new(export.MockExport)
+	m.On("ManifestHandler", mock.Anything, mock.Anything, mock.Anything).
+		Return([]export.ManifestEntry{{}}, nil)
+	return m
+}
+
+func setupMockWorker() worker.Handler {
+	m := new(worker.MockWorker)
+	m.On("ListHandler", mock.Anything, mock.Anything).
+		Return([]worker.Status{{}}, nil)
 	return m
 }
 
@@ -135,8 +207,14 @@ func setupMockFile() file.Handler {
 		Return(int64(1), nil)
 	m.On("PushFileHandler", mock.Anything, mock.Anything, "id", "name", mock.Anything).
 		Return(&model.File{}, nil)
+	m.On("AppendFilesHandler", mock.Anything, mock.Anything, "id", "name", mock.Anything).
+		Return(&file.AppendResult{}, nil)
 	m.On("RetrieveFileHandler", mock.Anything, mock.Anything, mock.Anything, uint64(1)).
 		Return(io.ReadSeekCloser(nopCloser{strings.NewReader("hello world")}), "hello.txt", time.Date(1999, 12, 31, 11, 59, 59, 0, time.UTC), nil)
+	m.On("PreviewFileHandler", mock.Anything, mock.Anything, uint64(1), file.PreviewRequest{Length: 5}).
+		Return(&file.Preview{Name: "hello.txt", Size: 11, ContentType: "text/plain; charset=utf-8", Data: []byte("hello")}, nil)
+	m.On("SearchFilesHandler", mock.Anything, mock.Anything, mock.Anything).
+		Return([]file.SearchResult{{}}, nil)
 	return m
 }
 
@@ -160,6 +238,8 @@ func setupMockJob() job.Handler {
 		Return(&model.Job{}, nil)
 	m.On("GetStatusHandler", mock.Anything, mock.Anything, "id").
 		Return([]job.SourceStatus{{}}, nil)
+	m.On("GetPackTimingsHandler", mock.Anything, mock.Anything, "id").
+		Return(&job.PackTimingReport{}, nil)
 	return m
 }
 
@@ -177,6 +257,14 @@ func setupMockStorage() storage.Handler {
 		Return(&model.Storage{}, nil)
 	m.On("RenameStorageHandler", mock.Anything, mock.Anything, "old", mock.Anything).
 		Return(&model.Storage{}, nil)
+	m.On("ReauthStorageHandler", mock.Anything, mock.Anything, "name", mock.Anything).
+		Return(&model.Storage{}, nil)
+	m.On("ListStorageTypesHandler", mock.Anything, mock.Anything).
+		Return([]storage.StorageType{{}}, nil)
+	m.On("GetStorageTypeHandler", mock.Anything, mock.Anything, "local").
+		Return(&storage.StorageType{}, nil)
+	m.On("ListSlowestSourcesHandler", mock.Anything, mock.Anything, mock.Anything).
+		Return([]storage.SlowSource{}, nil)
 	return m
 }
 
@@ -186,6 +274,8 @@ func setupMockWallet() wallet.Handler {
 		Return(&model.Preparation{}, nil)
 	m.On("DetachHandler", mock.Anything, mock.Anything, "id", "wallet").
 		Return(&model.Preparation{}, nil)
+	m.On("BalanceHandler", mock.Anything, mock.Anything, mock.Anything).
+		Return([]wallet.Balance{{}}, nil)
 	m.On("ImportHandler", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(&model.Wallet{}, nil)
 	m.On("ListHandler", mock.Anything, mock.Anything).
@@ -206,6 +296,9 @@ func TestAllAPIs(t *testing.T) {
 	mockFile := setupMockFile()
 	mockJob := setupMockJob()
 	mockSchedule := setupMockSchedule()
+	mockReport := setupMockReport()
+	mockExport := setupMockExport()
+	mockWorker := setupMockWorker()
 	mockDealMaker := new(MockDealMaker)
 
 	listener, err := net.Listen("tcp", apiBind)
@@ -230,6 +323,9 @@ func TestAllAPIs(t *testing.T) {
 			fileHandler:     mockFile,
 			jobHandler:      mockJob,
 			scheduleHandler: mockSchedule,
+			reportHandler:   mockReport,
+			exportHandler:   mockExport,
+			workerHandler:   mockWorker,
 		}
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
@@ -341,9 +437,13 @@ func TestAllAPIs(t *testing.T) {
 				require.True(t, resp.IsSuccess())
 				require.NotNil(t, resp.Payload)
 			})
-			t.Run("CreateS3AWSStorage", func(t *testing.T) {
-				resp, err := client.Storage.CreateS3AWSStorage(&storage2.CreateS3AWSStorageParams{
-					Request: &models.StorageCreateS3AWSStorageRequest{},
+			t.Run("CreateStorageWithProvider", func(t *testing.T) {
+				resp, err := client.Storage.CreateStorageWithProvider(&storage2.CreateStorageWithProviderParams{
+					Type:     "s3",
+					Provider: "aws",
+					Request: &models.StorageCreateRequest{
+						Name: ptr.Of("name"),
+					},
 					Context: ctx,
 				})
 				require.NoError(t, err)
@@ -723,6 +823,14 @@ func TestAllAPIs(t *testing.T) {
 				require.Nil(t, partial)
 				require.Equal(t, "hello world", buf.String())
 			})
+			t.Run("PreviewFile", func(t *testing.T) {
+				resp, body, errs := gorequest.New().
+					Get(fmt.Sprintf("http://%s/api/file/1/preview?length=5", apiBind)).End()
+				require.Empty(t, errs)
+				require.Equal(t, http2.StatusOK, resp.StatusCode)
+				require.Equal(t, "text/plain; charset=utf-8", resp.Header.Get("Content-Type"))
+				require.Equal(t, "hello", body)
+			})
 		})
 	})
 }
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/data-preservation-programs/singularity/handler/file"
+	"github.com/labstack/echo/v4"
+)
+
+// @ID PreviewFile
+// @Summary Get the first bytes of a prepared file, or a preview such as a thumbnail of an image
+// @Tags File
+// @Produce octet-stream
+// @Param id path int true "File ID"
+// @Param length query int false "Number of bytes from the beginning of the file, 64KiB by default and 16MiB at most"
+// @Param transform query string false "Transform to apply to the whole file instead, i.e. thumbnail"
+// @Success 200 {file} file
+// @Failure 500 {object} api.HTTPError
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /file/{id}/preview [get]
+func (s *Server) previewFile(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, HTTPError{Err: "failed to parse path parameter as number"})
+	}
+	request := file.PreviewRequest{Transform: c.QueryParam("transform")}
+	if length := c.QueryParam("length"); length != "" {
+		request.Length, err = strconv.ParseInt(length, 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, HTTPError{Err: "failed to parse length as number"})
+		}
+	}
+	preview, err := s.fileHandler.PreviewFileHandler(ctx, s.db.WithContext(ctx), id, request)
+	if err != nil {
+		return httpResponseFromError(c, err)
+	}
+	return c.Blob(http.StatusOK, preview.ContentType, preview.Data)
+}
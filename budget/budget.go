@@ -0,0 +1,386 @@
+// Package budget enforces hard limits on the datacap and FIL spent on deals, per preparation and globally, and
+// alerts when most of a budget is consumed.
+package budget
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/dustin/go-humanize"
+	"github.com/ipfs/go-log/v2"
+	"golang.org/x/exp/slices"
+	"gorm.io/gorm"
+)
+
+var logger = log.Logger("budget")
+
+// AlertRatio is the ratio of a budget that is consumed when an alert is sent.
+const AlertRatio = 0.8
+
+const (
+	ResourceDatacap = "datacap"
+	ResourceFIL     = "fil"
+)
+
+var ErrExceeded = errors.New("budget exceeded")
+
+// SpentDealStates are the states of the deals that have spent, or may still spend, datacap or FIL.
+var SpentDealStates = []model.DealState{
+	model.DealProposed,
+	model.DealPublished,
+	model.DealActive,
+	model.DealExpired,
+	model.DealSlashed,
+}
+
+// attoFIL is the number of attoFIL in a FIL.
+var attoFIL = new(big.Float).SetInt64(1e18)
+
+// Usage is the datacap and FIL spent by the deals that count towards a budget.
+type Usage struct {
+	Datacap int64   `json:"datacap"` // Total size of the verified deals in bytes
+	FIL     float64 `json:"fil"`     // Total price of the deals in FIL
+}
+
+// Scope returns a human readable description of the deals that count towards a budget.
+func Scope(budget model.Budget) string {
+	if budget.PreparationID == nil {
+		return "global"
+	}
+	return fmt.Sprintf("preparation %d", *budget.PreparationID)
+}
+
+// GetUsage returns the datacap and FIL spent by the deals of the schedules of a preparation, or by all deals if the
+// preparation ID is nil.
+//
+// Parameters:
+//   - db: The database connection.
+//   - preparationID: The ID of the preparation, or nil for all deals.
+//
+// Returns:
+//   - The Usage of the deals.
+//   - An error, if any occurred while querying the deals or if a deal has an invalid price.
+func GetUsage(db *gorm.DB, preparationID *model.PreparationID) (Usage, error) {
+	deals := func() *gorm.DB {
+		query := db.Model(&model.Deal{}).Where("state IN ?", SpentDealStates)
+		if preparationID != nil {
+			query = query.Where("schedule_id IN (?)",
+				db.Model(&model.Schedule{}).Select("id").Where("preparation_id = ?", *preparationID))
+		}
+		return query
+	}
+
+	var usage Usage
+	var datacap struct {
+		Size *int64
+	}
+	err := deals().Where("verified = ?", true).Select("SUM(piece_size) AS size").Scan(&datacap).Error
+	if err != nil {
+		return usage, errors.Wrap(err, "failed to sum datacap of deals")
+	}
+	if datacap.Size != nil {
+		usage.Datacap = *datacap.Size
+	}
+
+	// Prices are stored as attoFIL strings, which cannot be summed by the database.
+	var prices []string
+	err = deals().Where("price NOT IN ?", []string{"", "0"}).Pluck("price", &prices).Error
+	if err != nil {
+		return usage, errors.Wrap(err, "failed to get prices of deals")
+	}
+	total := new(big.Int)
+	for _, price := range prices {
+		value, ok := new(big.Int).SetString(price, 10)
+		if !ok {
+			return usage, errors.Newf("invalid deal price %s", price)
+		}
+		total.Add(total, value)
+	}
+	usage.FIL = ToFIL(total)
+	return usage, nil
+}
+
+// ToFIL converts an amount in attoFIL to FIL.
+func ToFIL(amount *big.Int) float64 {
+	fil, _ := new(big.Float).Quo(new(big.Float).SetInt(amount), attoFIL).Float64()
+	return fil
+}
+
+// Alert is sent when a deal consumes at least AlertRatio of a budget.
+type Alert struct {
+	Type          string               `json:"type"` // Always budget, to tell the alert apart from the other alerts posted to the same webhook
+	BudgetID      model.BudgetID       `json:"budgetId"`
+	PreparationID *model.PreparationID `json:"preparationId"`
+	Resource      string               `json:"resource"` // Either datacap, in bytes, or fil
+	Used          float64              `json:"used"`
+	Limit         float64              `json:"limit"`
+	Time          time.Time            `json:"time"`
+}
+
+// Guard checks the deals made by the deal pusher against the budgets.
+type Guard struct {
+	db       *gorm.DB
+	notifier *Notifier
+}
+
+// NewGuard creates a new Guard.
+//
+// Parameters:
+//   - db: The database connection.
+//   - webhookURL: The URL that alerts are posted to as JSON, in addition to being logged. Alerts are only logged if it
+//     is empty.
+func NewGuard(db *gorm.DB, webhookURL string) *Guard {
+	return &Guard{
+		db:       db,
+		notifier: NewNotifier(webhookURL),
+	}
+}
+
+// Reservation is the datacap and FIL reserved from the budgets for a deal that is being made.
+type Reservation struct {
+	BudgetIDs []model.BudgetID
+	Datacap   int64
+	FIL       float64
+}
+
+// Sync sets the spent datacap and FIL of a budget from the deals that count towards it, i.e. when the budget is
+// created. Afterwards, the spent amounts are kept up to date by the reservations of the deal pusher.
+//
+// Parameters:
+//   - db: The database connection.
+//   - budget: The budget to update.
+//
+// Returns:
+//   - An error, if any occurred while summing the deals.
+func Sync(db *gorm.DB, budget *model.Budget) error {
+	usage, err := GetUsage(db, budget.PreparationID)
+	if err != nil {
+		return err
+	}
+	budget.DatacapSpent = usage.Datacap
+	budget.FILSpent = usage.FIL
+	return nil
+}
+
+// Reserve reserves the datacap and FIL of a deal from the global budget and the budget of the preparation of the
+// deal. Each budget is updated with a single conditional UPDATE that only succeeds if the spent amount stays within
+// the limit, and all budgets are updated in one transaction, so concurrent deal pushers cannot overshoot a budget.
+// The first time a budget is consumed to at least AlertRatio, an alert is sent. A budget that is overridden is still
+// alerted on but not enforced.
+//
+// Parameters:
+//   - ctx: The context for the database queries and alerts.
+//   - preparationID: The ID of the preparation of the schedule making the deal.
+//   - verified: Whether the deal is verified, i.e. uses datacap.
+//   - pieceSize: The piece size of the deal in bytes.
+//   - price: The price of the deal in attoFIL.
+//
+// Returns:
+//   - The Reservation, to be released with Release if the deal is not made.
+//   - An error wrapping ErrExceeded if the deal would exceed a budget, or any other error that occurred.
+func (g *Guard) Reserve(
+	ctx context.Context,
+	preparationID model.PreparationID,
+	verified bool,
+	pieceSize int64,
+	price *big.Int,
+) (*Reservation, error) {
+	reservation := &Reservation{FIL: ToFIL(price)}
+	if verified {
+		reservation.Datacap = pieceSize
+	}
+	if reservation.Datacap == 0 && reservation.FIL == 0 {
+		return reservation, nil
+	}
+
+	db := g.db.WithContext(ctx)
+	var reserved []model.Budget
+	err := database.DoRetry(ctx, func() error {
+		reserved = nil
+		return db.Transaction(func(db *gorm.DB) error {
+			var budgets []model.Budget
+			err := db.Where("preparation_id IS NULL OR preparation_id = ?", preparationID).Find(&budgets).Error
+			if err != nil {
+				return errors.Wrap(err, "failed to get budgets")
+			}
+			for _, budget := range budgets {
+				query := db.Model(&model.Budget{}).Where("id = ?", budget.ID)
+				var limits []string
+				var args []any
+				if reservation.Datacap > 0 {
+					limits = append(limits, "(datacap <= 0 OR datacap_spent + ? <= datacap)")
+					args = append(args, reservation.Datacap)
+				}
+				if reservation.FIL > 0 {
+					limits = append(limits, "(fil <= 0 OR fil_spent + ? <= fil)")
+					args = append(args, reservation.FIL)
+				}
+				query = query.Where("override_until > ? OR ("+strings.Join(limits, " AND ")+")",
+					append([]any{time.Now()}, args...)...)
+				result := query.Updates(map[string]any{
+					"datacap_spent": gorm.Expr("datacap_spent + ?", reservation.Datacap),
+					"fil_spent":     gorm.Expr("fil_spent + ?", reservation.FIL),
+				})
+				if result.Error != nil {
+					return errors.Wrap(result.Error, "failed to reserve budget")
+				}
+				if result.RowsAffected == 0 {
+					return exceeded(budget, reservation)
+				}
+				err = db.First(&budget, budget.ID).Error
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				reserved = append(reserved, budget)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, budget := range reserved {
+		reservation.BudgetIDs = append(reservation.BudgetIDs, budget.ID)
+		if budget.Datacap > 0 && reservation.Datacap > 0 && !budget.DatacapAlerted &&
+			float64(budget.DatacapSpent) >= AlertRatio*float64(budget.Datacap) {
+			g.alert(ctx, budget, ResourceDatacap, float64(budget.DatacapSpent), float64(budget.Datacap))
+		}
+		if budget.FIL > 0 && reservation.FIL > 0 && !budget.FILAlerted && budget.FILSpent >= AlertRatio*budget.FIL {
+			g.alert(ctx, budget, ResourceFIL, budget.FILSpent, budget.FIL)
+		}
+	}
+	return reservation, nil
+}
+
+// exceeded returns the error for a reservation that does not fit in the budget.
+func exceeded(budget model.Budget, reservation *Reservation) error {
+	if budget.Datacap > 0 && budget.DatacapSpent+reservation.Datacap > budget.Datacap {
+		return errors.Wrapf(ErrExceeded, "%s datacap budget of %s would be exceeded, %s used",
+			Scope(budget), humanize.IBytes(uint64(budget.Datacap)), humanize.IBytes(uint64(budget.DatacapSpent)))
+	}
+	return errors.Wrapf(ErrExceeded, "%s FIL budget of %g FIL would be exceeded, %g FIL used",
+		Scope(budget), budget.FIL, budget.FILSpent)
+}
+
+// Release returns the datacap and FIL of a reservation to the budgets, i.e. because the deal was rejected or could
+// not be sent.
+//
+// Parameters:
+//   - ctx: The context for the database queries.
+//   - reservation: The Reservation returned by Reserve.
+//
+// Returns:
+//   - An error, if any occurred while updating the budgets.
+func (g *Guard) Release(ctx context.Context, reservation *Reservation) error {
+	if reservation == nil {
+		return nil
+	}
+	return release(ctx, g.db.WithContext(ctx), reservation.BudgetIDs, reservation.Datacap, reservation.FIL)
+}
+
+// ReleaseDeals returns the datacap and FIL of deals that no longer spend them, i.e. deal proposals that expired
+// before they were published, to the global budget and the budget of the preparation of each deal.
+//
+// Parameters:
+//   - ctx: The context for the database queries.
+//   - db: The database connection.
+//   - deals: The deals to release. Deals that are still in SpentDealStates are skipped.
+//
+// Returns:
+//   - An error, if any occurred while updating the budgets or if a deal has an invalid price.
+func ReleaseDeals(ctx context.Context, db *gorm.DB, deals []model.Deal) error {
+	db = db.WithContext(ctx)
+	type released struct {
+		datacap int64
+		fil     *big.Int
+	}
+	bySchedule := make(map[model.ScheduleID]*released)
+	for _, deal := range deals {
+		if deal.ScheduleID == nil || slices.Contains(SpentDealStates, deal.State) {
+			continue
+		}
+		r, ok := bySchedule[*deal.ScheduleID]
+		if !ok {
+			r = &released{fil: new(big.Int)}
+			bySchedule[*deal.ScheduleID] = r
+		}
+		if deal.Verified {
+			r.datacap += deal.PieceSize
+		}
+		if deal.Price != "" {
+			price, ok := new(big.Int).SetString(deal.Price, 10)
+			if !ok {
+				return errors.Newf("invalid deal price %s", deal.Price)
+			}
+			r.fil.Add(r.fil, price)
+		}
+	}
+
+	for scheduleID, r := range bySchedule {
+		var budgetIDs []model.BudgetID
+		err := db.Model(&model.Budget{}).
+			Where("preparation_id IS NULL OR preparation_id IN (?)",
+				db.Model(&model.Schedule{}).Select("preparation_id").Where("id = ?", scheduleID)).
+			Pluck("id", &budgetIDs).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to get budgets")
+		}
+		err = release(ctx, db, budgetIDs, r.datacap, ToFIL(r.fil))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// release subtracts the datacap and FIL from the spent amounts of the budgets.
+func release(ctx context.Context, db *gorm.DB, budgetIDs []model.BudgetID, datacap int64, fil float64) error {
+	if len(budgetIDs) == 0 || (datacap == 0 && fil == 0) {
+		return nil
+	}
+	return database.DoRetry(ctx, func() error {
+		return errors.Wrap(db.Model(&model.Budget{}).Where("id IN ?", budgetIDs).Updates(map[string]any{
+			"datacap_spent": gorm.Expr("datacap_spent - ?", datacap),
+			"fil_spent":     gorm.Expr("fil_spent - ?", fil),
+		}).Error, "failed to release budget")
+	})
+}
+
+// alert marks the budget as alerted for the resource and sends the alert. The alert is only sent by the caller that
+// marks the budget, so it is sent once until the limit is changed.
+func (g *Guard) alert(ctx context.Context, budget model.Budget, resource string, used float64, limit float64) {
+	column := "datacap_alerted"
+	if resource == ResourceFIL {
+		column = "fil_alerted"
+	}
+	result := g.db.WithContext(ctx).Model(&model.Budget{}).
+		Where("id = ? AND "+column+" = ?", budget.ID, false).
+		Update(column, true)
+	if result.Error != nil {
+		logger.Errorw("failed to mark budget as alerted", "budget", budget.ID, "error", result.Error)
+		return
+	}
+	if result.RowsAffected == 0 {
+		return
+	}
+
+	alert := Alert{
+		Type:          AlertTypeBudget,
+		BudgetID:      budget.ID,
+		PreparationID: budget.PreparationID,
+		Resource:      resource,
+		Used:          used,
+		Limit:         limit,
+		Time:          time.Now(),
+	}
+	logger.Warnw("budget is almost consumed", "scope", Scope(budget), "resource", resource,
+		"used", used, "limit", limit, "ratio", used/limit)
+	g.notifier.Notify(ctx, alert)
+}
@@ -0,0 +1,215 @@
+package budget
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func fil(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(1e18))
+}
+
+func createDeals(t *testing.T, db *gorm.DB) {
+	for _, name := range []string{"prep1", "prep2"} {
+		prep := model.Preparation{Name: name}
+		require.NoError(t, db.Create(&prep).Error)
+		schedule := model.Schedule{PreparationID: prep.ID}
+		require.NoError(t, db.Create(&schedule).Error)
+		for _, state := range []model.DealState{model.DealActive, model.DealProposed, model.DealRejected} {
+			require.NoError(t, db.Create(&model.Deal{
+				State:      state,
+				PieceSize:  1 << 35,
+				Verified:   true,
+				ScheduleID: &schedule.ID,
+				Wallet:     &model.Wallet{ID: name + string(state)},
+			}).Error)
+		}
+		require.NoError(t, db.Create(&model.Deal{
+			State:      model.DealPublished,
+			PieceSize:  1 << 35,
+			Price:      fil(1).String(),
+			ScheduleID: &schedule.ID,
+			ClientID:   name + string(model.DealActive),
+		}).Error)
+	}
+	// A deal that is not made by a schedule only counts towards the global budget
+	require.NoError(t, db.Create(&model.Deal{
+		State:     model.DealActive,
+		PieceSize: 1 << 35,
+		Verified:  true,
+		Price:     fil(2).String(),
+		ClientID:  "prep1" + string(model.DealActive),
+	}).Error)
+}
+
+func TestGetUsage(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		usage, err := GetUsage(db, nil)
+		require.NoError(t, err)
+		require.Zero(t, usage)
+
+		createDeals(t, db)
+		usage, err = GetUsage(db, nil)
+		require.NoError(t, err)
+		require.EqualValues(t, 5<<35, usage.Datacap)
+		require.EqualValues(t, 4, usage.FIL)
+
+		usage, err = GetUsage(db, ptr.Of(model.PreparationID(1)))
+		require.NoError(t, err)
+		require.EqualValues(t, 2<<35, usage.Datacap)
+		require.EqualValues(t, 1, usage.FIL)
+	})
+}
+
+func TestGuard_Reserve(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		var mu sync.Mutex
+		var alerts []Alert
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var alert Alert
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+			mu.Lock()
+			alerts = append(alerts, alert)
+			mu.Unlock()
+		}))
+		defer server.Close()
+		guard := NewGuard(db, server.URL)
+		createDeals(t, db)
+
+		// No budgets
+		reservation, err := guard.Reserve(ctx, 1, true, 1<<40, fil(100))
+		require.NoError(t, err)
+		require.Empty(t, reservation.BudgetIDs)
+
+		prepBudget := model.Budget{PreparationID: ptr.Of(model.PreparationID(1)), Datacap: 4 << 35}
+		require.NoError(t, Sync(db, &prepBudget))
+		require.EqualValues(t, 2<<35, prepBudget.DatacapSpent)
+		require.NoError(t, db.Create(&prepBudget).Error)
+		globalBudget := model.Budget{FIL: 10}
+		require.NoError(t, Sync(db, &globalBudget))
+		require.EqualValues(t, 4, globalBudget.FILSpent)
+		require.NoError(t, db.Create(&globalBudget).Error)
+
+		// Below the alert ratio
+		reservation, err = guard.Reserve(ctx, 1, true, 1<<35, big.NewInt(0))
+		require.NoError(t, err)
+		require.ElementsMatch(t, []model.BudgetID{prepBudget.ID, globalBudget.ID}, reservation.BudgetIDs)
+		require.Empty(t, alerts)
+		// Unverified deals do not use datacap
+		_, err = guard.Reserve(ctx, 1, false, 1<<40, big.NewInt(0))
+		require.NoError(t, err)
+		// Other preparations only count towards the global budget
+		_, err = guard.Reserve(ctx, 2, true, 1<<40, big.NewInt(0))
+		require.NoError(t, err)
+
+		// Released reservations are no longer spent
+		require.NoError(t, guard.Release(ctx, reservation))
+		require.NoError(t, db.First(&prepBudget, prepBudget.ID).Error)
+		require.EqualValues(t, 2<<35, prepBudget.DatacapSpent)
+
+		// Reaching the alert ratio alerts once
+		_, err = guard.Reserve(ctx, 1, true, 2<<35, big.NewInt(0))
+		require.NoError(t, err)
+		require.Len(t, alerts, 1)
+		require.Equal(t, AlertTypeBudget, alerts[0].Type)
+		require.Equal(t, ResourceDatacap, alerts[0].Resource)
+		require.Equal(t, prepBudget.ID, alerts[0].BudgetID)
+		require.EqualValues(t, 4<<35, alerts[0].Used)
+
+		_, err = guard.Reserve(ctx, 1, true, 1<<35, big.NewInt(0))
+		require.ErrorIs(t, err, ErrExceeded)
+
+		// FIL is counted across all preparations by the global budget
+		_, err = guard.Reserve(ctx, 2, false, 1<<35, fil(3))
+		require.NoError(t, err)
+		require.Len(t, alerts, 1)
+		_, err = guard.Reserve(ctx, 2, false, 1<<35, fil(1))
+		require.NoError(t, err)
+		require.Len(t, alerts, 2)
+		require.Equal(t, ResourceFIL, alerts[1].Resource)
+		_, err = guard.Reserve(ctx, 2, false, 1<<35, fil(3))
+		require.ErrorIs(t, err, ErrExceeded)
+		require.NoError(t, db.First(&globalBudget, globalBudget.ID).Error)
+		require.EqualValues(t, 8, globalBudget.FILSpent)
+
+		// An overridden budget can be exceeded
+		require.NoError(t, db.Model(&prepBudget).Update("override_until", time.Now().Add(time.Hour)).Error)
+		_, err = guard.Reserve(ctx, 1, true, 3<<35, big.NewInt(0))
+		require.NoError(t, err)
+		require.NoError(t, db.Model(&prepBudget).Update("override_until", time.Now().Add(-time.Hour)).Error)
+		_, err = guard.Reserve(ctx, 1, true, 3<<35, big.NewInt(0))
+		require.ErrorIs(t, err, ErrExceeded)
+	})
+}
+
+func TestGuard_ReserveConcurrently(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		guard := NewGuard(db, "")
+		prep := model.Preparation{Name: "prep"}
+		require.NoError(t, db.Create(&prep).Error)
+		prepBudget := model.Budget{PreparationID: &prep.ID, Datacap: 2 << 35}
+		require.NoError(t, db.Create(&prepBudget).Error)
+
+		var wg sync.WaitGroup
+		errs := make([]error, 5)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = guard.Reserve(ctx, prep.ID, true, 1<<35, big.NewInt(0))
+			}(i)
+		}
+		wg.Wait()
+
+		var reserved int
+		for _, err := range errs {
+			if err == nil {
+				reserved++
+			}
+		}
+		require.LessOrEqual(t, reserved, 2)
+		require.NoError(t, db.First(&prepBudget, prepBudget.ID).Error)
+		require.EqualValues(t, reserved<<35, prepBudget.DatacapSpent)
+	})
+}
+
+func TestReleaseDeals(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		createDeals(t, db)
+		prepBudget := model.Budget{PreparationID: ptr.Of(model.PreparationID(1)), Datacap: 4 << 35, FIL: 10}
+		require.NoError(t, Sync(db, &prepBudget))
+		require.NoError(t, db.Create(&prepBudget).Error)
+		globalBudget := model.Budget{FIL: 10}
+		require.NoError(t, Sync(db, &globalBudget))
+		require.NoError(t, db.Create(&globalBudget).Error)
+
+		var deals []model.Deal
+		require.NoError(t, db.Where("state IN ?", []model.DealState{model.DealProposed, model.DealPublished}).
+			Order("id").Find(&deals).Error)
+		require.Len(t, deals, 4)
+		// Only the deals that no longer spend the budgets are released
+		for i := range deals[:2] {
+			deals[i].State = model.DealProposalExpired
+		}
+		require.NoError(t, ReleaseDeals(ctx, db, deals))
+
+		require.NoError(t, db.First(&prepBudget, prepBudget.ID).Error)
+		require.EqualValues(t, 1<<35, prepBudget.DatacapSpent)
+		require.EqualValues(t, 0, prepBudget.FILSpent)
+		require.NoError(t, db.First(&globalBudget, globalBudget.ID).Error)
+		require.EqualValues(t, 4<<35, globalBudget.DatacapSpent)
+		require.EqualValues(t, 3, globalBudget.FILSpent)
+	})
+}
@@ -0,0 +1,68 @@
+package budget
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	AlertTypeBudget  = "budget"
+	AlertTypeStorage = "storage"
+)
+
+// Notifier posts alerts as JSON to a webhook. It is shared by the budget alerts and the storage forecast warnings,
+// so that operators receive both on the same endpoint. The alerts are logged by their callers whether or not a
+// webhook is set.
+type Notifier struct {
+	webhookURL string
+	client     *http.Client
+}
+
+// NewNotifier creates a new Notifier.
+//
+// Parameters:
+//   - webhookURL: The URL that alerts are posted to as JSON. Nothing is posted if it is empty.
+func NewNotifier(webhookURL string) *Notifier {
+	return &Notifier{
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Notify posts an alert to the webhook, if any. A failure is logged rather than returned, as alerts are best
+// effort and must not fail the operation that raised them.
+func (n *Notifier) Notify(ctx context.Context, alert any) {
+	if n.webhookURL == "" {
+		return
+	}
+	err := n.post(ctx, alert)
+	if err != nil {
+		logger.Errorw("failed to post alert", "url", n.webhookURL, "error", err)
+	}
+}
+
+func (n *Notifier) post(ctx context.Context, alert any) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return errors.Newf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -30,11 +30,175 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	Backup(params *BackupParams, opts ...ClientOption) (*BackupOK, error)
+
+	FullTextSearch(params *FullTextSearchParams, opts ...ClientOption) (*FullTextSearchNoContent, error)
+
+	MergePreparations(params *MergePreparationsParams, opts ...ClientOption) (*MergePreparationsOK, error)
+
+	MigrateConfig(params *MigrateConfigParams, opts ...ClientOption) (*MigrateConfigOK, error)
+
 	SetIdentity(params *SetIdentityParams, opts ...ClientOption) (*SetIdentityNoContent, error)
 
+	SplitSource(params *SplitSourceParams, opts ...ClientOption) (*SplitSourceOK, error)
+
+	StorageForecast(params *StorageForecastParams, opts ...ClientOption) (*StorageForecastOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+Backup creates a consistent snapshot of the database
+*/
+func (a *Client) Backup(params *BackupParams, opts ...ClientOption) (*BackupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "Backup",
+		Method:             "POST",
+		PathPattern:        "/admin/backup",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &BackupReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for Backup: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+FullTextSearch creates or remove the full text index used to search files by path
+*/
+func (a *Client) FullTextSearch(params *FullTextSearchParams, opts ...ClientOption) (*FullTextSearchNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewFullTextSearchParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "FullTextSearch",
+		Method:             "POST",
+		PathPattern:        "/admin/full-text-search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &FullTextSearchReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*FullTextSearchNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for FullTextSearch: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+MergePreparations merges a preparation into another preparation
+*/
+func (a *Client) MergePreparations(params *MergePreparationsParams, opts ...ClientOption) (*MergePreparationsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMergePreparationsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "MergePreparations",
+		Method:             "POST",
+		PathPattern:        "/admin/merge-preparations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &MergePreparationsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*MergePreparationsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for MergePreparations: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+MigrateConfig rewrites settings stored with legacy conventions to the current conventions
+*/
+func (a *Client) MigrateConfig(params *MigrateConfigParams, opts ...ClientOption) (*MigrateConfigOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMigrateConfigParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "MigrateConfig",
+		Method:             "POST",
+		PathPattern:        "/admin/migrate-config",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &MigrateConfigReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*MigrateConfigOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for MigrateConfig: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SetIdentity sets the user identity for tracking purpose
 */
@@ -73,6 +237,82 @@ func (a *Client) SetIdentity(params *SetIdentityParams, opts ...ClientOption) (*
 	panic(msg)
 }
 
+/*
+SplitSource splits a source out of a preparation into a new preparation
+*/
+func (a *Client) SplitSource(params *SplitSourceParams, opts ...ClientOption) (*SplitSourceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSplitSourceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SplitSource",
+		Method:             "POST",
+		PathPattern:        "/admin/split-source",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SplitSourceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SplitSourceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SplitSource: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+StorageForecast forecasts when each output storage will be full based on the output rates of the preparations
+*/
+func (a *Client) StorageForecast(params *StorageForecastParams, opts ...ClientOption) (*StorageForecastOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStorageForecastParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "StorageForecast",
+		Method:             "POST",
+		PathPattern:        "/admin/storage-forecast",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &StorageForecastReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StorageForecastOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for StorageForecast: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
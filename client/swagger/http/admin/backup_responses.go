@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// BackupReader is a Reader for the Backup structure.
+type BackupReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BackupReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewBackupOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewBackupBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBackupInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /admin/backup] Backup", response, response.Code())
+	}
+}
+
+// NewBackupOK creates a BackupOK with default headers values
+func NewBackupOK() *BackupOK {
+	return &BackupOK{}
+}
+
+/*
+BackupOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type BackupOK struct {
+	Payload *models.AdminBackupManifest
+}
+
+// IsSuccess returns true when this backup o k response has a 2xx status code
+func (o *BackupOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this backup o k response has a 3xx status code
+func (o *BackupOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backup o k response has a 4xx status code
+func (o *BackupOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backup o k response has a 5xx status code
+func (o *BackupOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backup o k response a status code equal to that given
+func (o *BackupOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the backup o k response
+func (o *BackupOK) Code() int {
+	return 200
+}
+
+func (o *BackupOK) Error() string {
+	return fmt.Sprintf("[POST /admin/backup][%d] backupOK  %+v", 200, o.Payload)
+}
+
+func (o *BackupOK) String() string {
+	return fmt.Sprintf("[POST /admin/backup][%d] backupOK  %+v", 200, o.Payload)
+}
+
+func (o *BackupOK) GetPayload() *models.AdminBackupManifest {
+	return o.Payload
+}
+
+func (o *BackupOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AdminBackupManifest)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupBadRequest creates a BackupBadRequest with default headers values
+func NewBackupBadRequest() *BackupBadRequest {
+	return &BackupBadRequest{}
+}
+
+/*
+BackupBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type BackupBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this backup bad request response has a 2xx status code
+func (o *BackupBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backup bad request response has a 3xx status code
+func (o *BackupBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backup bad request response has a 4xx status code
+func (o *BackupBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backup bad request response has a 5xx status code
+func (o *BackupBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backup bad request response a status code equal to that given
+func (o *BackupBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the backup bad request response
+func (o *BackupBadRequest) Code() int {
+	return 400
+}
+
+func (o *BackupBadRequest) Error() string {
+	return fmt.Sprintf("[POST /admin/backup][%d] backupBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *BackupBadRequest) String() string {
+	return fmt.Sprintf("[POST /admin/backup][%d] backupBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *BackupBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *BackupBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupInternalServerError creates a BackupInternalServerError with default headers values
+func NewBackupInternalServerError() *BackupInternalServerError {
+	return &BackupInternalServerError{}
+}
+
+/*
+BackupInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type BackupInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this backup internal server error response has a 2xx status code
+func (o *BackupInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backup internal server error response has a 3xx status code
+func (o *BackupInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backup internal server error response has a 4xx status code
+func (o *BackupInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backup internal server error response has a 5xx status code
+func (o *BackupInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this backup internal server error response a status code equal to that given
+func (o *BackupInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the backup internal server error response
+func (o *BackupInternalServerError) Code() int {
+	return 500
+}
+
+func (o *BackupInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /admin/backup][%d] backupInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupInternalServerError) String() string {
+	return fmt.Sprintf("[POST /admin/backup][%d] backupInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *BackupInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
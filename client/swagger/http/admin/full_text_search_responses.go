@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// FullTextSearchReader is a Reader for the FullTextSearch structure.
+type FullTextSearchReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *FullTextSearchReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewFullTextSearchNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewFullTextSearchBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewFullTextSearchInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /admin/full-text-search] FullTextSearch", response, response.Code())
+	}
+}
+
+// NewFullTextSearchNoContent creates a FullTextSearchNoContent with default headers values
+func NewFullTextSearchNoContent() *FullTextSearchNoContent {
+	return &FullTextSearchNoContent{}
+}
+
+/*
+FullTextSearchNoContent describes a response with status code 204, with default header values.
+
+No Content
+*/
+type FullTextSearchNoContent struct {
+}
+
+// IsSuccess returns true when this full text search no content response has a 2xx status code
+func (o *FullTextSearchNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this full text search no content response has a 3xx status code
+func (o *FullTextSearchNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this full text search no content response has a 4xx status code
+func (o *FullTextSearchNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this full text search no content response has a 5xx status code
+func (o *FullTextSearchNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this full text search no content response a status code equal to that given
+func (o *FullTextSearchNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the full text search no content response
+func (o *FullTextSearchNoContent) Code() int {
+	return 204
+}
+
+func (o *FullTextSearchNoContent) Error() string {
+	return fmt.Sprintf("[POST /admin/full-text-search][%d] fullTextSearchNoContent ", 204)
+}
+
+func (o *FullTextSearchNoContent) String() string {
+	return fmt.Sprintf("[POST /admin/full-text-search][%d] fullTextSearchNoContent ", 204)
+}
+
+func (o *FullTextSearchNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewFullTextSearchBadRequest creates a FullTextSearchBadRequest with default headers values
+func NewFullTextSearchBadRequest() *FullTextSearchBadRequest {
+	return &FullTextSearchBadRequest{}
+}
+
+/*
+FullTextSearchBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type FullTextSearchBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this full text search bad request response has a 2xx status code
+func (o *FullTextSearchBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this full text search bad request response has a 3xx status code
+func (o *FullTextSearchBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this full text search bad request response has a 4xx status code
+func (o *FullTextSearchBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this full text search bad request response has a 5xx status code
+func (o *FullTextSearchBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this full text search bad request response a status code equal to that given
+func (o *FullTextSearchBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the full text search bad request response
+func (o *FullTextSearchBadRequest) Code() int {
+	return 400
+}
+
+func (o *FullTextSearchBadRequest) Error() string {
+	return fmt.Sprintf("[POST /admin/full-text-search][%d] fullTextSearchBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *FullTextSearchBadRequest) String() string {
+	return fmt.Sprintf("[POST /admin/full-text-search][%d] fullTextSearchBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *FullTextSearchBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *FullTextSearchBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewFullTextSearchInternalServerError creates a FullTextSearchInternalServerError with default headers values
+func NewFullTextSearchInternalServerError() *FullTextSearchInternalServerError {
+	return &FullTextSearchInternalServerError{}
+}
+
+/*
+FullTextSearchInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type FullTextSearchInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this full text search internal server error response has a 2xx status code
+func (o *FullTextSearchInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this full text search internal server error response has a 3xx status code
+func (o *FullTextSearchInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this full text search internal server error response has a 4xx status code
+func (o *FullTextSearchInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this full text search internal server error response has a 5xx status code
+func (o *FullTextSearchInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this full text search internal server error response a status code equal to that given
+func (o *FullTextSearchInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the full text search internal server error response
+func (o *FullTextSearchInternalServerError) Code() int {
+	return 500
+}
+
+func (o *FullTextSearchInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /admin/full-text-search][%d] fullTextSearchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *FullTextSearchInternalServerError) String() string {
+	return fmt.Sprintf("[POST /admin/full-text-search][%d] fullTextSearchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *FullTextSearchInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *FullTextSearchInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
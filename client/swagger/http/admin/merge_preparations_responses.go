@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// MergePreparationsReader is a Reader for the MergePreparations structure.
+type MergePreparationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *MergePreparationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewMergePreparationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewMergePreparationsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewMergePreparationsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /admin/merge-preparations] MergePreparations", response, response.Code())
+	}
+}
+
+// NewMergePreparationsOK creates a MergePreparationsOK with default headers values
+func NewMergePreparationsOK() *MergePreparationsOK {
+	return &MergePreparationsOK{}
+}
+
+/*
+MergePreparationsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type MergePreparationsOK struct {
+	Payload *models.ModelPreparation
+}
+
+// IsSuccess returns true when this merge preparations o k response has a 2xx status code
+func (o *MergePreparationsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this merge preparations o k response has a 3xx status code
+func (o *MergePreparationsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this merge preparations o k response has a 4xx status code
+func (o *MergePreparationsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this merge preparations o k response has a 5xx status code
+func (o *MergePreparationsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this merge preparations o k response a status code equal to that given
+func (o *MergePreparationsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the merge preparations o k response
+func (o *MergePreparationsOK) Code() int {
+	return 200
+}
+
+func (o *MergePreparationsOK) Error() string {
+	return fmt.Sprintf("[POST /admin/merge-preparations][%d] mergePreparationsOK  %+v", 200, o.Payload)
+}
+
+func (o *MergePreparationsOK) String() string {
+	return fmt.Sprintf("[POST /admin/merge-preparations][%d] mergePreparationsOK  %+v", 200, o.Payload)
+}
+
+func (o *MergePreparationsOK) GetPayload() *models.ModelPreparation {
+	return o.Payload
+}
+
+func (o *MergePreparationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ModelPreparation)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMergePreparationsBadRequest creates a MergePreparationsBadRequest with default headers values
+func NewMergePreparationsBadRequest() *MergePreparationsBadRequest {
+	return &MergePreparationsBadRequest{}
+}
+
+/*
+MergePreparationsBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type MergePreparationsBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this merge preparations bad request response has a 2xx status code
+func (o *MergePreparationsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this merge preparations bad request response has a 3xx status code
+func (o *MergePreparationsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this merge preparations bad request response has a 4xx status code
+func (o *MergePreparationsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this merge preparations bad request response has a 5xx status code
+func (o *MergePreparationsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this merge preparations bad request response a status code equal to that given
+func (o *MergePreparationsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the merge preparations bad request response
+func (o *MergePreparationsBadRequest) Code() int {
+	return 400
+}
+
+func (o *MergePreparationsBadRequest) Error() string {
+	return fmt.Sprintf("[POST /admin/merge-preparations][%d] mergePreparationsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *MergePreparationsBadRequest) String() string {
+	return fmt.Sprintf("[POST /admin/merge-preparations][%d] mergePreparationsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *MergePreparationsBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *MergePreparationsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMergePreparationsInternalServerError creates a MergePreparationsInternalServerError with default headers values
+func NewMergePreparationsInternalServerError() *MergePreparationsInternalServerError {
+	return &MergePreparationsInternalServerError{}
+}
+
+/*
+MergePreparationsInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type MergePreparationsInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this merge preparations internal server error response has a 2xx status code
+func (o *MergePreparationsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this merge preparations internal server error response has a 3xx status code
+func (o *MergePreparationsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this merge preparations internal server error response has a 4xx status code
+func (o *MergePreparationsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this merge preparations internal server error response has a 5xx status code
+func (o *MergePreparationsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this merge preparations internal server error response a status code equal to that given
+func (o *MergePreparationsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the merge preparations internal server error response
+func (o *MergePreparationsInternalServerError) Code() int {
+	return 500
+}
+
+func (o *MergePreparationsInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /admin/merge-preparations][%d] mergePreparationsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *MergePreparationsInternalServerError) String() string {
+	return fmt.Sprintf("[POST /admin/merge-preparations][%d] mergePreparationsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *MergePreparationsInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *MergePreparationsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// MigrateConfigReader is a Reader for the MigrateConfig structure.
+type MigrateConfigReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *MigrateConfigReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewMigrateConfigOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewMigrateConfigBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewMigrateConfigInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /admin/migrate-config] MigrateConfig", response, response.Code())
+	}
+}
+
+// NewMigrateConfigOK creates a MigrateConfigOK with default headers values
+func NewMigrateConfigOK() *MigrateConfigOK {
+	return &MigrateConfigOK{}
+}
+
+/*
+MigrateConfigOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type MigrateConfigOK struct {
+	Payload []*models.AdminConfigChange
+}
+
+// IsSuccess returns true when this migrate config o k response has a 2xx status code
+func (o *MigrateConfigOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this migrate config o k response has a 3xx status code
+func (o *MigrateConfigOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this migrate config o k response has a 4xx status code
+func (o *MigrateConfigOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this migrate config o k response has a 5xx status code
+func (o *MigrateConfigOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this migrate config o k response a status code equal to that given
+func (o *MigrateConfigOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the migrate config o k response
+func (o *MigrateConfigOK) Code() int {
+	return 200
+}
+
+func (o *MigrateConfigOK) Error() string {
+	return fmt.Sprintf("[POST /admin/migrate-config][%d] migrateConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *MigrateConfigOK) String() string {
+	return fmt.Sprintf("[POST /admin/migrate-config][%d] migrateConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *MigrateConfigOK) GetPayload() []*models.AdminConfigChange {
+	return o.Payload
+}
+
+func (o *MigrateConfigOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMigrateConfigBadRequest creates a MigrateConfigBadRequest with default headers values
+func NewMigrateConfigBadRequest() *MigrateConfigBadRequest {
+	return &MigrateConfigBadRequest{}
+}
+
+/*
+MigrateConfigBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type MigrateConfigBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this migrate config bad request response has a 2xx status code
+func (o *MigrateConfigBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this migrate config bad request response has a 3xx status code
+func (o *MigrateConfigBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this migrate config bad request response has a 4xx status code
+func (o *MigrateConfigBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this migrate config bad request response has a 5xx status code
+func (o *MigrateConfigBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this migrate config bad request response a status code equal to that given
+func (o *MigrateConfigBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the migrate config bad request response
+func (o *MigrateConfigBadRequest) Code() int {
+	return 400
+}
+
+func (o *MigrateConfigBadRequest) Error() string {
+	return fmt.Sprintf("[POST /admin/migrate-config][%d] migrateConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *MigrateConfigBadRequest) String() string {
+	return fmt.Sprintf("[POST /admin/migrate-config][%d] migrateConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *MigrateConfigBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *MigrateConfigBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMigrateConfigInternalServerError creates a MigrateConfigInternalServerError with default headers values
+func NewMigrateConfigInternalServerError() *MigrateConfigInternalServerError {
+	return &MigrateConfigInternalServerError{}
+}
+
+/*
+MigrateConfigInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type MigrateConfigInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this migrate config internal server error response has a 2xx status code
+func (o *MigrateConfigInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this migrate config internal server error response has a 3xx status code
+func (o *MigrateConfigInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this migrate config internal server error response has a 4xx status code
+func (o *MigrateConfigInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this migrate config internal server error response has a 5xx status code
+func (o *MigrateConfigInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this migrate config internal server error response a status code equal to that given
+func (o *MigrateConfigInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the migrate config internal server error response
+func (o *MigrateConfigInternalServerError) Code() int {
+	return 500
+}
+
+func (o *MigrateConfigInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /admin/migrate-config][%d] migrateConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *MigrateConfigInternalServerError) String() string {
+	return fmt.Sprintf("[POST /admin/migrate-config][%d] migrateConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *MigrateConfigInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *MigrateConfigInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
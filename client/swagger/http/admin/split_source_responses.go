@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// SplitSourceReader is a Reader for the SplitSource structure.
+type SplitSourceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SplitSourceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSplitSourceOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSplitSourceBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSplitSourceInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /admin/split-source] SplitSource", response, response.Code())
+	}
+}
+
+// NewSplitSourceOK creates a SplitSourceOK with default headers values
+func NewSplitSourceOK() *SplitSourceOK {
+	return &SplitSourceOK{}
+}
+
+/*
+SplitSourceOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type SplitSourceOK struct {
+	Payload *models.ModelPreparation
+}
+
+// IsSuccess returns true when this split source o k response has a 2xx status code
+func (o *SplitSourceOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this split source o k response has a 3xx status code
+func (o *SplitSourceOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this split source o k response has a 4xx status code
+func (o *SplitSourceOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this split source o k response has a 5xx status code
+func (o *SplitSourceOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this split source o k response a status code equal to that given
+func (o *SplitSourceOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the split source o k response
+func (o *SplitSourceOK) Code() int {
+	return 200
+}
+
+func (o *SplitSourceOK) Error() string {
+	return fmt.Sprintf("[POST /admin/split-source][%d] splitSourceOK  %+v", 200, o.Payload)
+}
+
+func (o *SplitSourceOK) String() string {
+	return fmt.Sprintf("[POST /admin/split-source][%d] splitSourceOK  %+v", 200, o.Payload)
+}
+
+func (o *SplitSourceOK) GetPayload() *models.ModelPreparation {
+	return o.Payload
+}
+
+func (o *SplitSourceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ModelPreparation)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSplitSourceBadRequest creates a SplitSourceBadRequest with default headers values
+func NewSplitSourceBadRequest() *SplitSourceBadRequest {
+	return &SplitSourceBadRequest{}
+}
+
+/*
+SplitSourceBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type SplitSourceBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this split source bad request response has a 2xx status code
+func (o *SplitSourceBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this split source bad request response has a 3xx status code
+func (o *SplitSourceBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this split source bad request response has a 4xx status code
+func (o *SplitSourceBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this split source bad request response has a 5xx status code
+func (o *SplitSourceBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this split source bad request response a status code equal to that given
+func (o *SplitSourceBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the split source bad request response
+func (o *SplitSourceBadRequest) Code() int {
+	return 400
+}
+
+func (o *SplitSourceBadRequest) Error() string {
+	return fmt.Sprintf("[POST /admin/split-source][%d] splitSourceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SplitSourceBadRequest) String() string {
+	return fmt.Sprintf("[POST /admin/split-source][%d] splitSourceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SplitSourceBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *SplitSourceBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSplitSourceInternalServerError creates a SplitSourceInternalServerError with default headers values
+func NewSplitSourceInternalServerError() *SplitSourceInternalServerError {
+	return &SplitSourceInternalServerError{}
+}
+
+/*
+SplitSourceInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type SplitSourceInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this split source internal server error response has a 2xx status code
+func (o *SplitSourceInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this split source internal server error response has a 3xx status code
+func (o *SplitSourceInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this split source internal server error response has a 4xx status code
+func (o *SplitSourceInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this split source internal server error response has a 5xx status code
+func (o *SplitSourceInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this split source internal server error response a status code equal to that given
+func (o *SplitSourceInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the split source internal server error response
+func (o *SplitSourceInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SplitSourceInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /admin/split-source][%d] splitSourceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SplitSourceInternalServerError) String() string {
+	return fmt.Sprintf("[POST /admin/split-source][%d] splitSourceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SplitSourceInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *SplitSourceInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// StorageForecastReader is a Reader for the StorageForecast structure.
+type StorageForecastReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StorageForecastReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewStorageForecastOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewStorageForecastBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewStorageForecastInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /admin/storage-forecast] StorageForecast", response, response.Code())
+	}
+}
+
+// NewStorageForecastOK creates a StorageForecastOK with default headers values
+func NewStorageForecastOK() *StorageForecastOK {
+	return &StorageForecastOK{}
+}
+
+/*
+StorageForecastOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type StorageForecastOK struct {
+	Payload []*models.AdminStorageForecast
+}
+
+// IsSuccess returns true when this storage forecast o k response has a 2xx status code
+func (o *StorageForecastOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this storage forecast o k response has a 3xx status code
+func (o *StorageForecastOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this storage forecast o k response has a 4xx status code
+func (o *StorageForecastOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this storage forecast o k response has a 5xx status code
+func (o *StorageForecastOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this storage forecast o k response a status code equal to that given
+func (o *StorageForecastOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the storage forecast o k response
+func (o *StorageForecastOK) Code() int {
+	return 200
+}
+
+func (o *StorageForecastOK) Error() string {
+	return fmt.Sprintf("[POST /admin/storage-forecast][%d] storageForecastOK  %+v", 200, o.Payload)
+}
+
+func (o *StorageForecastOK) String() string {
+	return fmt.Sprintf("[POST /admin/storage-forecast][%d] storageForecastOK  %+v", 200, o.Payload)
+}
+
+func (o *StorageForecastOK) GetPayload() []*models.AdminStorageForecast {
+	return o.Payload
+}
+
+func (o *StorageForecastOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStorageForecastBadRequest creates a StorageForecastBadRequest with default headers values
+func NewStorageForecastBadRequest() *StorageForecastBadRequest {
+	return &StorageForecastBadRequest{}
+}
+
+/*
+StorageForecastBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type StorageForecastBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this storage forecast bad request response has a 2xx status code
+func (o *StorageForecastBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this storage forecast bad request response has a 3xx status code
+func (o *StorageForecastBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this storage forecast bad request response has a 4xx status code
+func (o *StorageForecastBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this storage forecast bad request response has a 5xx status code
+func (o *StorageForecastBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this storage forecast bad request response a status code equal to that given
+func (o *StorageForecastBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the storage forecast bad request response
+func (o *StorageForecastBadRequest) Code() int {
+	return 400
+}
+
+func (o *StorageForecastBadRequest) Error() string {
+	return fmt.Sprintf("[POST /admin/storage-forecast][%d] storageForecastBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *StorageForecastBadRequest) String() string {
+	return fmt.Sprintf("[POST /admin/storage-forecast][%d] storageForecastBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *StorageForecastBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *StorageForecastBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStorageForecastInternalServerError creates a StorageForecastInternalServerError with default headers values
+func NewStorageForecastInternalServerError() *StorageForecastInternalServerError {
+	return &StorageForecastInternalServerError{}
+}
+
+/*
+StorageForecastInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type StorageForecastInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this storage forecast internal server error response has a 2xx status code
+func (o *StorageForecastInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this storage forecast internal server error response has a 3xx status code
+func (o *StorageForecastInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this storage forecast internal server error response has a 4xx status code
+func (o *StorageForecastInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this storage forecast internal server error response has a 5xx status code
+func (o *StorageForecastInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this storage forecast internal server error response a status code equal to that given
+func (o *StorageForecastInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the storage forecast internal server error response
+func (o *StorageForecastInternalServerError) Code() int {
+	return 500
+}
+
+func (o *StorageForecastInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /admin/storage-forecast][%d] storageForecastInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *StorageForecastInternalServerError) String() string {
+	return fmt.Sprintf("[POST /admin/storage-forecast][%d] storageForecastInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *StorageForecastInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *StorageForecastInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -32,6 +32,10 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	ListDeals(params *ListDealsParams, opts ...ClientOption) (*ListDealsOK, error)
 
+	ListPieceProviders(params *ListPieceProvidersParams, opts ...ClientOption) (*ListPieceProvidersOK, error)
+
+	ListReceipts(params *ListReceiptsParams, opts ...ClientOption) (*ListReceiptsOK, error)
+
 	SendManual(params *SendManualParams, opts ...ClientOption) (*SendManualOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -77,6 +81,82 @@ func (a *Client) ListDeals(params *ListDealsParams, opts ...ClientOption) (*List
 	panic(msg)
 }
 
+/*
+ListPieceProviders lists the providers that already have a proposed published or active deal for each piece
+*/
+func (a *Client) ListPieceProviders(params *ListPieceProvidersParams, opts ...ClientOption) (*ListPieceProvidersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListPieceProvidersParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListPieceProviders",
+		Method:             "POST",
+		PathPattern:        "/deal/piece_providers",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListPieceProvidersReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListPieceProvidersOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ListPieceProviders: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListReceipts lists the signed receipts of pieces that have reached their replication target
+*/
+func (a *Client) ListReceipts(params *ListReceiptsParams, opts ...ClientOption) (*ListReceiptsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListReceiptsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListReceipts",
+		Method:             "POST",
+		PathPattern:        "/deal/receipt",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListReceiptsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListReceiptsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ListReceipts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SendManual sends a manual deal proposal
 
@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package deal
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// ListPieceProvidersReader is a Reader for the ListPieceProviders structure.
+type ListPieceProvidersReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListPieceProvidersReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListPieceProvidersOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewListPieceProvidersBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListPieceProvidersInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /deal/piece_providers] ListPieceProviders", response, response.Code())
+	}
+}
+
+// NewListPieceProvidersOK creates a ListPieceProvidersOK with default headers values
+func NewListPieceProvidersOK() *ListPieceProvidersOK {
+	return &ListPieceProvidersOK{}
+}
+
+/*
+ListPieceProvidersOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListPieceProvidersOK struct {
+	Payload []*models.DealPieceProviders
+}
+
+// IsSuccess returns true when this list piece providers o k response has a 2xx status code
+func (o *ListPieceProvidersOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list piece providers o k response has a 3xx status code
+func (o *ListPieceProvidersOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list piece providers o k response has a 4xx status code
+func (o *ListPieceProvidersOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list piece providers o k response has a 5xx status code
+func (o *ListPieceProvidersOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list piece providers o k response a status code equal to that given
+func (o *ListPieceProvidersOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list piece providers o k response
+func (o *ListPieceProvidersOK) Code() int {
+	return 200
+}
+
+func (o *ListPieceProvidersOK) Error() string {
+	return fmt.Sprintf("[POST /deal/piece_providers][%d] listPieceProvidersOK  %+v", 200, o.Payload)
+}
+
+func (o *ListPieceProvidersOK) String() string {
+	return fmt.Sprintf("[POST /deal/piece_providers][%d] listPieceProvidersOK  %+v", 200, o.Payload)
+}
+
+func (o *ListPieceProvidersOK) GetPayload() []*models.DealPieceProviders {
+	return o.Payload
+}
+
+func (o *ListPieceProvidersOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListPieceProvidersBadRequest creates a ListPieceProvidersBadRequest with default headers values
+func NewListPieceProvidersBadRequest() *ListPieceProvidersBadRequest {
+	return &ListPieceProvidersBadRequest{}
+}
+
+/*
+ListPieceProvidersBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ListPieceProvidersBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this list piece providers bad request response has a 2xx status code
+func (o *ListPieceProvidersBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list piece providers bad request response has a 3xx status code
+func (o *ListPieceProvidersBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list piece providers bad request response has a 4xx status code
+func (o *ListPieceProvidersBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list piece providers bad request response has a 5xx status code
+func (o *ListPieceProvidersBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list piece providers bad request response a status code equal to that given
+func (o *ListPieceProvidersBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the list piece providers bad request response
+func (o *ListPieceProvidersBadRequest) Code() int {
+	return 400
+}
+
+func (o *ListPieceProvidersBadRequest) Error() string {
+	return fmt.Sprintf("[POST /deal/piece_providers][%d] listPieceProvidersBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListPieceProvidersBadRequest) String() string {
+	return fmt.Sprintf("[POST /deal/piece_providers][%d] listPieceProvidersBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListPieceProvidersBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ListPieceProvidersBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListPieceProvidersInternalServerError creates a ListPieceProvidersInternalServerError with default headers values
+func NewListPieceProvidersInternalServerError() *ListPieceProvidersInternalServerError {
+	return &ListPieceProvidersInternalServerError{}
+}
+
+/*
+ListPieceProvidersInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ListPieceProvidersInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this list piece providers internal server error response has a 2xx status code
+func (o *ListPieceProvidersInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list piece providers internal server error response has a 3xx status code
+func (o *ListPieceProvidersInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list piece providers internal server error response has a 4xx status code
+func (o *ListPieceProvidersInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list piece providers internal server error response has a 5xx status code
+func (o *ListPieceProvidersInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this list piece providers internal server error response a status code equal to that given
+func (o *ListPieceProvidersInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the list piece providers internal server error response
+func (o *ListPieceProvidersInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ListPieceProvidersInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /deal/piece_providers][%d] listPieceProvidersInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListPieceProvidersInternalServerError) String() string {
+	return fmt.Sprintf("[POST /deal/piece_providers][%d] listPieceProvidersInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListPieceProvidersInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ListPieceProvidersInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
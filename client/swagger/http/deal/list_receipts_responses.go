@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package deal
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// ListReceiptsReader is a Reader for the ListReceipts structure.
+type ListReceiptsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListReceiptsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListReceiptsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewListReceiptsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListReceiptsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /deal/receipt] ListReceipts", response, response.Code())
+	}
+}
+
+// NewListReceiptsOK creates a ListReceiptsOK with default headers values
+func NewListReceiptsOK() *ListReceiptsOK {
+	return &ListReceiptsOK{}
+}
+
+/*
+ListReceiptsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListReceiptsOK struct {
+	Payload []*models.ModelPieceReceipt
+}
+
+// IsSuccess returns true when this list receipts o k response has a 2xx status code
+func (o *ListReceiptsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list receipts o k response has a 3xx status code
+func (o *ListReceiptsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list receipts o k response has a 4xx status code
+func (o *ListReceiptsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list receipts o k response has a 5xx status code
+func (o *ListReceiptsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list receipts o k response a status code equal to that given
+func (o *ListReceiptsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list receipts o k response
+func (o *ListReceiptsOK) Code() int {
+	return 200
+}
+
+func (o *ListReceiptsOK) Error() string {
+	return fmt.Sprintf("[POST /deal/receipt][%d] listReceiptsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListReceiptsOK) String() string {
+	return fmt.Sprintf("[POST /deal/receipt][%d] listReceiptsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListReceiptsOK) GetPayload() []*models.ModelPieceReceipt {
+	return o.Payload
+}
+
+func (o *ListReceiptsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListReceiptsBadRequest creates a ListReceiptsBadRequest with default headers values
+func NewListReceiptsBadRequest() *ListReceiptsBadRequest {
+	return &ListReceiptsBadRequest{}
+}
+
+/*
+ListReceiptsBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ListReceiptsBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this list receipts bad request response has a 2xx status code
+func (o *ListReceiptsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list receipts bad request response has a 3xx status code
+func (o *ListReceiptsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list receipts bad request response has a 4xx status code
+func (o *ListReceiptsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list receipts bad request response has a 5xx status code
+func (o *ListReceiptsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list receipts bad request response a status code equal to that given
+func (o *ListReceiptsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the list receipts bad request response
+func (o *ListReceiptsBadRequest) Code() int {
+	return 400
+}
+
+func (o *ListReceiptsBadRequest) Error() string {
+	return fmt.Sprintf("[POST /deal/receipt][%d] listReceiptsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListReceiptsBadRequest) String() string {
+	return fmt.Sprintf("[POST /deal/receipt][%d] listReceiptsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListReceiptsBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ListReceiptsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListReceiptsInternalServerError creates a ListReceiptsInternalServerError with default headers values
+func NewListReceiptsInternalServerError() *ListReceiptsInternalServerError {
+	return &ListReceiptsInternalServerError{}
+}
+
+/*
+ListReceiptsInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ListReceiptsInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this list receipts internal server error response has a 2xx status code
+func (o *ListReceiptsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list receipts internal server error response has a 3xx status code
+func (o *ListReceiptsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list receipts internal server error response has a 4xx status code
+func (o *ListReceiptsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list receipts internal server error response has a 5xx status code
+func (o *ListReceiptsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this list receipts internal server error response a status code equal to that given
+func (o *ListReceiptsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the list receipts internal server error response
+func (o *ListReceiptsInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ListReceiptsInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /deal/receipt][%d] listReceiptsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListReceiptsInternalServerError) String() string {
+	return fmt.Sprintf("[POST /deal/receipt][%d] listReceiptsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListReceiptsInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ListReceiptsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
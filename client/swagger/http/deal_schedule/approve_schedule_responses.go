@@ -0,0 +1,473 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package deal_schedule
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// ApproveScheduleReader is a Reader for the ApproveSchedule structure.
+type ApproveScheduleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ApproveScheduleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewApproveScheduleOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewApproveScheduleBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewApproveScheduleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewApproveScheduleForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewApproveScheduleNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewApproveScheduleInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /schedule/{id}/approve] ApproveSchedule", response, response.Code())
+	}
+}
+
+// NewApproveScheduleOK creates a ApproveScheduleOK with default headers values
+func NewApproveScheduleOK() *ApproveScheduleOK {
+	return &ApproveScheduleOK{}
+}
+
+/*
+ApproveScheduleOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ApproveScheduleOK struct {
+	Payload *models.ModelSchedule
+}
+
+// IsSuccess returns true when this approve schedule o k response has a 2xx status code
+func (o *ApproveScheduleOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this approve schedule o k response has a 3xx status code
+func (o *ApproveScheduleOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this approve schedule o k response has a 4xx status code
+func (o *ApproveScheduleOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this approve schedule o k response has a 5xx status code
+func (o *ApproveScheduleOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this approve schedule o k response a status code equal to that given
+func (o *ApproveScheduleOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the approve schedule o k response
+func (o *ApproveScheduleOK) Code() int {
+	return 200
+}
+
+func (o *ApproveScheduleOK) Error() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleOK  %+v", 200, o.Payload)
+}
+
+func (o *ApproveScheduleOK) String() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleOK  %+v", 200, o.Payload)
+}
+
+func (o *ApproveScheduleOK) GetPayload() *models.ModelSchedule {
+	return o.Payload
+}
+
+func (o *ApproveScheduleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ModelSchedule)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApproveScheduleBadRequest creates a ApproveScheduleBadRequest with default headers values
+func NewApproveScheduleBadRequest() *ApproveScheduleBadRequest {
+	return &ApproveScheduleBadRequest{}
+}
+
+/*
+ApproveScheduleBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ApproveScheduleBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this approve schedule bad request response has a 2xx status code
+func (o *ApproveScheduleBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this approve schedule bad request response has a 3xx status code
+func (o *ApproveScheduleBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this approve schedule bad request response has a 4xx status code
+func (o *ApproveScheduleBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this approve schedule bad request response has a 5xx status code
+func (o *ApproveScheduleBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this approve schedule bad request response a status code equal to that given
+func (o *ApproveScheduleBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the approve schedule bad request response
+func (o *ApproveScheduleBadRequest) Code() int {
+	return 400
+}
+
+func (o *ApproveScheduleBadRequest) Error() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ApproveScheduleBadRequest) String() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ApproveScheduleBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ApproveScheduleBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApproveScheduleUnauthorized creates a ApproveScheduleUnauthorized with default headers values
+func NewApproveScheduleUnauthorized() *ApproveScheduleUnauthorized {
+	return &ApproveScheduleUnauthorized{}
+}
+
+/*
+ApproveScheduleUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type ApproveScheduleUnauthorized struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this approve schedule unauthorized response has a 2xx status code
+func (o *ApproveScheduleUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this approve schedule unauthorized response has a 3xx status code
+func (o *ApproveScheduleUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this approve schedule unauthorized response has a 4xx status code
+func (o *ApproveScheduleUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this approve schedule unauthorized response has a 5xx status code
+func (o *ApproveScheduleUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this approve schedule unauthorized response a status code equal to that given
+func (o *ApproveScheduleUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the approve schedule unauthorized response
+func (o *ApproveScheduleUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ApproveScheduleUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ApproveScheduleUnauthorized) String() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ApproveScheduleUnauthorized) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ApproveScheduleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApproveScheduleForbidden creates a ApproveScheduleForbidden with default headers values
+func NewApproveScheduleForbidden() *ApproveScheduleForbidden {
+	return &ApproveScheduleForbidden{}
+}
+
+/*
+ApproveScheduleForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ApproveScheduleForbidden struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this approve schedule forbidden response has a 2xx status code
+func (o *ApproveScheduleForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this approve schedule forbidden response has a 3xx status code
+func (o *ApproveScheduleForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this approve schedule forbidden response has a 4xx status code
+func (o *ApproveScheduleForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this approve schedule forbidden response has a 5xx status code
+func (o *ApproveScheduleForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this approve schedule forbidden response a status code equal to that given
+func (o *ApproveScheduleForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the approve schedule forbidden response
+func (o *ApproveScheduleForbidden) Code() int {
+	return 403
+}
+
+func (o *ApproveScheduleForbidden) Error() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApproveScheduleForbidden) String() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApproveScheduleForbidden) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ApproveScheduleForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApproveScheduleNotFound creates a ApproveScheduleNotFound with default headers values
+func NewApproveScheduleNotFound() *ApproveScheduleNotFound {
+	return &ApproveScheduleNotFound{}
+}
+
+/*
+ApproveScheduleNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ApproveScheduleNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this approve schedule not found response has a 2xx status code
+func (o *ApproveScheduleNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this approve schedule not found response has a 3xx status code
+func (o *ApproveScheduleNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this approve schedule not found response has a 4xx status code
+func (o *ApproveScheduleNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this approve schedule not found response has a 5xx status code
+func (o *ApproveScheduleNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this approve schedule not found response a status code equal to that given
+func (o *ApproveScheduleNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the approve schedule not found response
+func (o *ApproveScheduleNotFound) Code() int {
+	return 404
+}
+
+func (o *ApproveScheduleNotFound) Error() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ApproveScheduleNotFound) String() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ApproveScheduleNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ApproveScheduleNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApproveScheduleInternalServerError creates a ApproveScheduleInternalServerError with default headers values
+func NewApproveScheduleInternalServerError() *ApproveScheduleInternalServerError {
+	return &ApproveScheduleInternalServerError{}
+}
+
+/*
+ApproveScheduleInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ApproveScheduleInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this approve schedule internal server error response has a 2xx status code
+func (o *ApproveScheduleInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this approve schedule internal server error response has a 3xx status code
+func (o *ApproveScheduleInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this approve schedule internal server error response has a 4xx status code
+func (o *ApproveScheduleInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this approve schedule internal server error response has a 5xx status code
+func (o *ApproveScheduleInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this approve schedule internal server error response a status code equal to that given
+func (o *ApproveScheduleInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the approve schedule internal server error response
+func (o *ApproveScheduleInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ApproveScheduleInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApproveScheduleInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schedule/{id}/approve][%d] approveScheduleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApproveScheduleInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ApproveScheduleInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -30,23 +30,75 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ApproveSchedule(params *ApproveScheduleParams, opts ...ClientOption) (*ApproveScheduleOK, error)
+
 	CreateSchedule(params *CreateScheduleParams, opts ...ClientOption) (*CreateScheduleOK, error)
 
 	ListPreparationSchedules(params *ListPreparationSchedulesParams, opts ...ClientOption) (*ListPreparationSchedulesOK, error)
 
+	ListReplicationPolicies(params *ListReplicationPoliciesParams, opts ...ClientOption) (*ListReplicationPoliciesOK, error)
+
 	ListSchedules(params *ListSchedulesParams, opts ...ClientOption) (*ListSchedulesOK, error)
 
 	PauseSchedule(params *PauseScheduleParams, opts ...ClientOption) (*PauseScheduleOK, error)
 
+	RemoveReplicationPolicy(params *RemoveReplicationPolicyParams, opts ...ClientOption) (*RemoveReplicationPolicyNoContent, error)
+
 	RemoveSchedule(params *RemoveScheduleParams, opts ...ClientOption) (*RemoveScheduleNoContent, error)
 
 	ResumeSchedule(params *ResumeScheduleParams, opts ...ClientOption) (*ResumeScheduleOK, error)
 
+	ScheduleCalendar(params *ScheduleCalendarParams, opts ...ClientOption) (*ScheduleCalendarOK, error)
+
+	ScheduleSimulate(params *ScheduleSimulateParams, opts ...ClientOption) (*ScheduleSimulateOK, error)
+
+	SetReplicationPolicy(params *SetReplicationPolicyParams, opts ...ClientOption) (*SetReplicationPolicyOK, error)
+
 	UpdateSchedule(params *UpdateScheduleParams, opts ...ClientOption) (*UpdateScheduleOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+ApproveSchedule approves a schedule that is pending approval
+
+The schedule must be approved by an operator other than the one that requested it, identified by the X-Singularity-Api-Key header.
+*/
+func (a *Client) ApproveSchedule(params *ApproveScheduleParams, opts ...ClientOption) (*ApproveScheduleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewApproveScheduleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ApproveSchedule",
+		Method:             "POST",
+		PathPattern:        "/schedule/{id}/approve",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ApproveScheduleReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ApproveScheduleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ApproveSchedule: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 CreateSchedule creates a new schedule
 
@@ -125,6 +177,44 @@ func (a *Client) ListPreparationSchedules(params *ListPreparationSchedulesParams
 	panic(msg)
 }
 
+/*
+ListReplicationPolicies lists the replication policies of all preparations
+*/
+func (a *Client) ListReplicationPolicies(params *ListReplicationPoliciesParams, opts ...ClientOption) (*ListReplicationPoliciesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListReplicationPoliciesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListReplicationPolicies",
+		Method:             "GET",
+		PathPattern:        "/policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListReplicationPoliciesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListReplicationPoliciesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ListReplicationPolicies: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ListSchedules lists all deal making schedules
 */
@@ -201,6 +291,44 @@ func (a *Client) PauseSchedule(params *PauseScheduleParams, opts ...ClientOption
 	panic(msg)
 }
 
+/*
+RemoveReplicationPolicy removes the replication policy of a preparation
+*/
+func (a *Client) RemoveReplicationPolicy(params *RemoveReplicationPolicyParams, opts ...ClientOption) (*RemoveReplicationPolicyNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRemoveReplicationPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RemoveReplicationPolicy",
+		Method:             "DELETE",
+		PathPattern:        "/preparation/{id}/policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RemoveReplicationPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RemoveReplicationPolicyNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for RemoveReplicationPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 RemoveSchedule deletes a specific schedule
 */
@@ -277,6 +405,120 @@ func (a *Client) ResumeSchedule(params *ResumeScheduleParams, opts ...ClientOpti
 	panic(msg)
 }
 
+/*
+ScheduleCalendar projects the deals the active schedules are expected to propose per day or week and per provider
+*/
+func (a *Client) ScheduleCalendar(params *ScheduleCalendarParams, opts ...ClientOption) (*ScheduleCalendarOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewScheduleCalendarParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ScheduleCalendar",
+		Method:             "POST",
+		PathPattern:        "/schedule/calendar",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ScheduleCalendarReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ScheduleCalendarOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ScheduleCalendar: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ScheduleSimulate simulates which pieces the schedules would propose to which providers and when without sending proposals
+*/
+func (a *Client) ScheduleSimulate(params *ScheduleSimulateParams, opts ...ClientOption) (*ScheduleSimulateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewScheduleSimulateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ScheduleSimulate",
+		Method:             "POST",
+		PathPattern:        "/schedule/simulate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ScheduleSimulateReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ScheduleSimulateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ScheduleSimulate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SetReplicationPolicy sets the replication policy of a preparation
+*/
+func (a *Client) SetReplicationPolicy(params *SetReplicationPolicyParams, opts ...ClientOption) (*SetReplicationPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetReplicationPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SetReplicationPolicy",
+		Method:             "PUT",
+		PathPattern:        "/preparation/{id}/policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SetReplicationPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SetReplicationPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SetReplicationPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 UpdateSchedule updates a schedule
 
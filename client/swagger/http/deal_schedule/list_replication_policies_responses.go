@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package deal_schedule
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// ListReplicationPoliciesReader is a Reader for the ListReplicationPolicies structure.
+type ListReplicationPoliciesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListReplicationPoliciesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListReplicationPoliciesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewListReplicationPoliciesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListReplicationPoliciesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /policy] ListReplicationPolicies", response, response.Code())
+	}
+}
+
+// NewListReplicationPoliciesOK creates a ListReplicationPoliciesOK with default headers values
+func NewListReplicationPoliciesOK() *ListReplicationPoliciesOK {
+	return &ListReplicationPoliciesOK{}
+}
+
+/*
+ListReplicationPoliciesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListReplicationPoliciesOK struct {
+	Payload []*models.ModelReplicationPolicy
+}
+
+// IsSuccess returns true when this list replication policies o k response has a 2xx status code
+func (o *ListReplicationPoliciesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list replication policies o k response has a 3xx status code
+func (o *ListReplicationPoliciesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list replication policies o k response has a 4xx status code
+func (o *ListReplicationPoliciesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list replication policies o k response has a 5xx status code
+func (o *ListReplicationPoliciesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list replication policies o k response a status code equal to that given
+func (o *ListReplicationPoliciesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list replication policies o k response
+func (o *ListReplicationPoliciesOK) Code() int {
+	return 200
+}
+
+func (o *ListReplicationPoliciesOK) Error() string {
+	return fmt.Sprintf("[GET /policy][%d] listReplicationPoliciesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListReplicationPoliciesOK) String() string {
+	return fmt.Sprintf("[GET /policy][%d] listReplicationPoliciesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListReplicationPoliciesOK) GetPayload() []*models.ModelReplicationPolicy {
+	return o.Payload
+}
+
+func (o *ListReplicationPoliciesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListReplicationPoliciesBadRequest creates a ListReplicationPoliciesBadRequest with default headers values
+func NewListReplicationPoliciesBadRequest() *ListReplicationPoliciesBadRequest {
+	return &ListReplicationPoliciesBadRequest{}
+}
+
+/*
+ListReplicationPoliciesBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ListReplicationPoliciesBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this list replication policies bad request response has a 2xx status code
+func (o *ListReplicationPoliciesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list replication policies bad request response has a 3xx status code
+func (o *ListReplicationPoliciesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list replication policies bad request response has a 4xx status code
+func (o *ListReplicationPoliciesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list replication policies bad request response has a 5xx status code
+func (o *ListReplicationPoliciesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list replication policies bad request response a status code equal to that given
+func (o *ListReplicationPoliciesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the list replication policies bad request response
+func (o *ListReplicationPoliciesBadRequest) Code() int {
+	return 400
+}
+
+func (o *ListReplicationPoliciesBadRequest) Error() string {
+	return fmt.Sprintf("[GET /policy][%d] listReplicationPoliciesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListReplicationPoliciesBadRequest) String() string {
+	return fmt.Sprintf("[GET /policy][%d] listReplicationPoliciesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListReplicationPoliciesBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ListReplicationPoliciesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListReplicationPoliciesInternalServerError creates a ListReplicationPoliciesInternalServerError with default headers values
+func NewListReplicationPoliciesInternalServerError() *ListReplicationPoliciesInternalServerError {
+	return &ListReplicationPoliciesInternalServerError{}
+}
+
+/*
+ListReplicationPoliciesInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ListReplicationPoliciesInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this list replication policies internal server error response has a 2xx status code
+func (o *ListReplicationPoliciesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list replication policies internal server error response has a 3xx status code
+func (o *ListReplicationPoliciesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list replication policies internal server error response has a 4xx status code
+func (o *ListReplicationPoliciesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list replication policies internal server error response has a 5xx status code
+func (o *ListReplicationPoliciesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this list replication policies internal server error response a status code equal to that given
+func (o *ListReplicationPoliciesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the list replication policies internal server error response
+func (o *ListReplicationPoliciesInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ListReplicationPoliciesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /policy][%d] listReplicationPoliciesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListReplicationPoliciesInternalServerError) String() string {
+	return fmt.Sprintf("[GET /policy][%d] listReplicationPoliciesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListReplicationPoliciesInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ListReplicationPoliciesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,313 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package deal_schedule
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// RemoveReplicationPolicyReader is a Reader for the RemoveReplicationPolicy structure.
+type RemoveReplicationPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RemoveReplicationPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewRemoveReplicationPolicyNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRemoveReplicationPolicyBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRemoveReplicationPolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRemoveReplicationPolicyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /preparation/{id}/policy] RemoveReplicationPolicy", response, response.Code())
+	}
+}
+
+// NewRemoveReplicationPolicyNoContent creates a RemoveReplicationPolicyNoContent with default headers values
+func NewRemoveReplicationPolicyNoContent() *RemoveReplicationPolicyNoContent {
+	return &RemoveReplicationPolicyNoContent{}
+}
+
+/*
+RemoveReplicationPolicyNoContent describes a response with status code 204, with default header values.
+
+No Content
+*/
+type RemoveReplicationPolicyNoContent struct {
+}
+
+// IsSuccess returns true when this remove replication policy no content response has a 2xx status code
+func (o *RemoveReplicationPolicyNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this remove replication policy no content response has a 3xx status code
+func (o *RemoveReplicationPolicyNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this remove replication policy no content response has a 4xx status code
+func (o *RemoveReplicationPolicyNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this remove replication policy no content response has a 5xx status code
+func (o *RemoveReplicationPolicyNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this remove replication policy no content response a status code equal to that given
+func (o *RemoveReplicationPolicyNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the remove replication policy no content response
+func (o *RemoveReplicationPolicyNoContent) Code() int {
+	return 204
+}
+
+func (o *RemoveReplicationPolicyNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /preparation/{id}/policy][%d] removeReplicationPolicyNoContent ", 204)
+}
+
+func (o *RemoveReplicationPolicyNoContent) String() string {
+	return fmt.Sprintf("[DELETE /preparation/{id}/policy][%d] removeReplicationPolicyNoContent ", 204)
+}
+
+func (o *RemoveReplicationPolicyNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRemoveReplicationPolicyBadRequest creates a RemoveReplicationPolicyBadRequest with default headers values
+func NewRemoveReplicationPolicyBadRequest() *RemoveReplicationPolicyBadRequest {
+	return &RemoveReplicationPolicyBadRequest{}
+}
+
+/*
+RemoveReplicationPolicyBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type RemoveReplicationPolicyBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this remove replication policy bad request response has a 2xx status code
+func (o *RemoveReplicationPolicyBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this remove replication policy bad request response has a 3xx status code
+func (o *RemoveReplicationPolicyBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this remove replication policy bad request response has a 4xx status code
+func (o *RemoveReplicationPolicyBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this remove replication policy bad request response has a 5xx status code
+func (o *RemoveReplicationPolicyBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this remove replication policy bad request response a status code equal to that given
+func (o *RemoveReplicationPolicyBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the remove replication policy bad request response
+func (o *RemoveReplicationPolicyBadRequest) Code() int {
+	return 400
+}
+
+func (o *RemoveReplicationPolicyBadRequest) Error() string {
+	return fmt.Sprintf("[DELETE /preparation/{id}/policy][%d] removeReplicationPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RemoveReplicationPolicyBadRequest) String() string {
+	return fmt.Sprintf("[DELETE /preparation/{id}/policy][%d] removeReplicationPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RemoveReplicationPolicyBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *RemoveReplicationPolicyBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRemoveReplicationPolicyNotFound creates a RemoveReplicationPolicyNotFound with default headers values
+func NewRemoveReplicationPolicyNotFound() *RemoveReplicationPolicyNotFound {
+	return &RemoveReplicationPolicyNotFound{}
+}
+
+/*
+RemoveReplicationPolicyNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type RemoveReplicationPolicyNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this remove replication policy not found response has a 2xx status code
+func (o *RemoveReplicationPolicyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this remove replication policy not found response has a 3xx status code
+func (o *RemoveReplicationPolicyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this remove replication policy not found response has a 4xx status code
+func (o *RemoveReplicationPolicyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this remove replication policy not found response has a 5xx status code
+func (o *RemoveReplicationPolicyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this remove replication policy not found response a status code equal to that given
+func (o *RemoveReplicationPolicyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the remove replication policy not found response
+func (o *RemoveReplicationPolicyNotFound) Code() int {
+	return 404
+}
+
+func (o *RemoveReplicationPolicyNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /preparation/{id}/policy][%d] removeReplicationPolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RemoveReplicationPolicyNotFound) String() string {
+	return fmt.Sprintf("[DELETE /preparation/{id}/policy][%d] removeReplicationPolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RemoveReplicationPolicyNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *RemoveReplicationPolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRemoveReplicationPolicyInternalServerError creates a RemoveReplicationPolicyInternalServerError with default headers values
+func NewRemoveReplicationPolicyInternalServerError() *RemoveReplicationPolicyInternalServerError {
+	return &RemoveReplicationPolicyInternalServerError{}
+}
+
+/*
+RemoveReplicationPolicyInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type RemoveReplicationPolicyInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this remove replication policy internal server error response has a 2xx status code
+func (o *RemoveReplicationPolicyInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this remove replication policy internal server error response has a 3xx status code
+func (o *RemoveReplicationPolicyInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this remove replication policy internal server error response has a 4xx status code
+func (o *RemoveReplicationPolicyInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this remove replication policy internal server error response has a 5xx status code
+func (o *RemoveReplicationPolicyInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this remove replication policy internal server error response a status code equal to that given
+func (o *RemoveReplicationPolicyInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the remove replication policy internal server error response
+func (o *RemoveReplicationPolicyInternalServerError) Code() int {
+	return 500
+}
+
+func (o *RemoveReplicationPolicyInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /preparation/{id}/policy][%d] removeReplicationPolicyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RemoveReplicationPolicyInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /preparation/{id}/policy][%d] removeReplicationPolicyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RemoveReplicationPolicyInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *RemoveReplicationPolicyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
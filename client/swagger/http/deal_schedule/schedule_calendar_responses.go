@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package deal_schedule
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// ScheduleCalendarReader is a Reader for the ScheduleCalendar structure.
+type ScheduleCalendarReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ScheduleCalendarReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewScheduleCalendarOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewScheduleCalendarBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewScheduleCalendarInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /schedule/calendar] ScheduleCalendar", response, response.Code())
+	}
+}
+
+// NewScheduleCalendarOK creates a ScheduleCalendarOK with default headers values
+func NewScheduleCalendarOK() *ScheduleCalendarOK {
+	return &ScheduleCalendarOK{}
+}
+
+/*
+ScheduleCalendarOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ScheduleCalendarOK struct {
+	Payload []*models.ScheduleCalendarEntry
+}
+
+// IsSuccess returns true when this schedule calendar o k response has a 2xx status code
+func (o *ScheduleCalendarOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schedule calendar o k response has a 3xx status code
+func (o *ScheduleCalendarOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schedule calendar o k response has a 4xx status code
+func (o *ScheduleCalendarOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schedule calendar o k response has a 5xx status code
+func (o *ScheduleCalendarOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schedule calendar o k response a status code equal to that given
+func (o *ScheduleCalendarOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schedule calendar o k response
+func (o *ScheduleCalendarOK) Code() int {
+	return 200
+}
+
+func (o *ScheduleCalendarOK) Error() string {
+	return fmt.Sprintf("[POST /schedule/calendar][%d] scheduleCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *ScheduleCalendarOK) String() string {
+	return fmt.Sprintf("[POST /schedule/calendar][%d] scheduleCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *ScheduleCalendarOK) GetPayload() []*models.ScheduleCalendarEntry {
+	return o.Payload
+}
+
+func (o *ScheduleCalendarOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewScheduleCalendarBadRequest creates a ScheduleCalendarBadRequest with default headers values
+func NewScheduleCalendarBadRequest() *ScheduleCalendarBadRequest {
+	return &ScheduleCalendarBadRequest{}
+}
+
+/*
+ScheduleCalendarBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ScheduleCalendarBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this schedule calendar bad request response has a 2xx status code
+func (o *ScheduleCalendarBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schedule calendar bad request response has a 3xx status code
+func (o *ScheduleCalendarBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schedule calendar bad request response has a 4xx status code
+func (o *ScheduleCalendarBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schedule calendar bad request response has a 5xx status code
+func (o *ScheduleCalendarBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schedule calendar bad request response a status code equal to that given
+func (o *ScheduleCalendarBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the schedule calendar bad request response
+func (o *ScheduleCalendarBadRequest) Code() int {
+	return 400
+}
+
+func (o *ScheduleCalendarBadRequest) Error() string {
+	return fmt.Sprintf("[POST /schedule/calendar][%d] scheduleCalendarBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ScheduleCalendarBadRequest) String() string {
+	return fmt.Sprintf("[POST /schedule/calendar][%d] scheduleCalendarBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ScheduleCalendarBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ScheduleCalendarBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewScheduleCalendarInternalServerError creates a ScheduleCalendarInternalServerError with default headers values
+func NewScheduleCalendarInternalServerError() *ScheduleCalendarInternalServerError {
+	return &ScheduleCalendarInternalServerError{}
+}
+
+/*
+ScheduleCalendarInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ScheduleCalendarInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this schedule calendar internal server error response has a 2xx status code
+func (o *ScheduleCalendarInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schedule calendar internal server error response has a 3xx status code
+func (o *ScheduleCalendarInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schedule calendar internal server error response has a 4xx status code
+func (o *ScheduleCalendarInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schedule calendar internal server error response has a 5xx status code
+func (o *ScheduleCalendarInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schedule calendar internal server error response a status code equal to that given
+func (o *ScheduleCalendarInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schedule calendar internal server error response
+func (o *ScheduleCalendarInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ScheduleCalendarInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schedule/calendar][%d] scheduleCalendarInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ScheduleCalendarInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schedule/calendar][%d] scheduleCalendarInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ScheduleCalendarInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ScheduleCalendarInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
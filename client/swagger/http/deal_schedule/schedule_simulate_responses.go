@@ -0,0 +1,323 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package deal_schedule
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// ScheduleSimulateReader is a Reader for the ScheduleSimulate structure.
+type ScheduleSimulateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ScheduleSimulateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewScheduleSimulateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewScheduleSimulateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewScheduleSimulateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewScheduleSimulateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /schedule/simulate] ScheduleSimulate", response, response.Code())
+	}
+}
+
+// NewScheduleSimulateOK creates a ScheduleSimulateOK with default headers values
+func NewScheduleSimulateOK() *ScheduleSimulateOK {
+	return &ScheduleSimulateOK{}
+}
+
+/*
+ScheduleSimulateOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ScheduleSimulateOK struct {
+	Payload []*models.ScheduleSimulatedProposal
+}
+
+// IsSuccess returns true when this schedule simulate o k response has a 2xx status code
+func (o *ScheduleSimulateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schedule simulate o k response has a 3xx status code
+func (o *ScheduleSimulateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schedule simulate o k response has a 4xx status code
+func (o *ScheduleSimulateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schedule simulate o k response has a 5xx status code
+func (o *ScheduleSimulateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schedule simulate o k response a status code equal to that given
+func (o *ScheduleSimulateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schedule simulate o k response
+func (o *ScheduleSimulateOK) Code() int {
+	return 200
+}
+
+func (o *ScheduleSimulateOK) Error() string {
+	return fmt.Sprintf("[POST /schedule/simulate][%d] scheduleSimulateOK  %+v", 200, o.Payload)
+}
+
+func (o *ScheduleSimulateOK) String() string {
+	return fmt.Sprintf("[POST /schedule/simulate][%d] scheduleSimulateOK  %+v", 200, o.Payload)
+}
+
+func (o *ScheduleSimulateOK) GetPayload() []*models.ScheduleSimulatedProposal {
+	return o.Payload
+}
+
+func (o *ScheduleSimulateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewScheduleSimulateBadRequest creates a ScheduleSimulateBadRequest with default headers values
+func NewScheduleSimulateBadRequest() *ScheduleSimulateBadRequest {
+	return &ScheduleSimulateBadRequest{}
+}
+
+/*
+ScheduleSimulateBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ScheduleSimulateBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this schedule simulate bad request response has a 2xx status code
+func (o *ScheduleSimulateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schedule simulate bad request response has a 3xx status code
+func (o *ScheduleSimulateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schedule simulate bad request response has a 4xx status code
+func (o *ScheduleSimulateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schedule simulate bad request response has a 5xx status code
+func (o *ScheduleSimulateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schedule simulate bad request response a status code equal to that given
+func (o *ScheduleSimulateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the schedule simulate bad request response
+func (o *ScheduleSimulateBadRequest) Code() int {
+	return 400
+}
+
+func (o *ScheduleSimulateBadRequest) Error() string {
+	return fmt.Sprintf("[POST /schedule/simulate][%d] scheduleSimulateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ScheduleSimulateBadRequest) String() string {
+	return fmt.Sprintf("[POST /schedule/simulate][%d] scheduleSimulateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ScheduleSimulateBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ScheduleSimulateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewScheduleSimulateNotFound creates a ScheduleSimulateNotFound with default headers values
+func NewScheduleSimulateNotFound() *ScheduleSimulateNotFound {
+	return &ScheduleSimulateNotFound{}
+}
+
+/*
+ScheduleSimulateNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ScheduleSimulateNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this schedule simulate not found response has a 2xx status code
+func (o *ScheduleSimulateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schedule simulate not found response has a 3xx status code
+func (o *ScheduleSimulateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schedule simulate not found response has a 4xx status code
+func (o *ScheduleSimulateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schedule simulate not found response has a 5xx status code
+func (o *ScheduleSimulateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schedule simulate not found response a status code equal to that given
+func (o *ScheduleSimulateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schedule simulate not found response
+func (o *ScheduleSimulateNotFound) Code() int {
+	return 404
+}
+
+func (o *ScheduleSimulateNotFound) Error() string {
+	return fmt.Sprintf("[POST /schedule/simulate][%d] scheduleSimulateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ScheduleSimulateNotFound) String() string {
+	return fmt.Sprintf("[POST /schedule/simulate][%d] scheduleSimulateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ScheduleSimulateNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ScheduleSimulateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewScheduleSimulateInternalServerError creates a ScheduleSimulateInternalServerError with default headers values
+func NewScheduleSimulateInternalServerError() *ScheduleSimulateInternalServerError {
+	return &ScheduleSimulateInternalServerError{}
+}
+
+/*
+ScheduleSimulateInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ScheduleSimulateInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this schedule simulate internal server error response has a 2xx status code
+func (o *ScheduleSimulateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schedule simulate internal server error response has a 3xx status code
+func (o *ScheduleSimulateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schedule simulate internal server error response has a 4xx status code
+func (o *ScheduleSimulateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schedule simulate internal server error response has a 5xx status code
+func (o *ScheduleSimulateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schedule simulate internal server error response a status code equal to that given
+func (o *ScheduleSimulateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schedule simulate internal server error response
+func (o *ScheduleSimulateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ScheduleSimulateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schedule/simulate][%d] scheduleSimulateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ScheduleSimulateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schedule/simulate][%d] scheduleSimulateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ScheduleSimulateInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ScheduleSimulateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
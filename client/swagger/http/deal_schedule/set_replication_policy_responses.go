@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package deal_schedule
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// SetReplicationPolicyReader is a Reader for the SetReplicationPolicy structure.
+type SetReplicationPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SetReplicationPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSetReplicationPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSetReplicationPolicyBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSetReplicationPolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSetReplicationPolicyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PUT /preparation/{id}/policy] SetReplicationPolicy", response, response.Code())
+	}
+}
+
+// NewSetReplicationPolicyOK creates a SetReplicationPolicyOK with default headers values
+func NewSetReplicationPolicyOK() *SetReplicationPolicyOK {
+	return &SetReplicationPolicyOK{}
+}
+
+/*
+SetReplicationPolicyOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type SetReplicationPolicyOK struct {
+	Payload *models.ModelReplicationPolicy
+}
+
+// IsSuccess returns true when this set replication policy o k response has a 2xx status code
+func (o *SetReplicationPolicyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this set replication policy o k response has a 3xx status code
+func (o *SetReplicationPolicyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this set replication policy o k response has a 4xx status code
+func (o *SetReplicationPolicyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this set replication policy o k response has a 5xx status code
+func (o *SetReplicationPolicyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this set replication policy o k response a status code equal to that given
+func (o *SetReplicationPolicyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the set replication policy o k response
+func (o *SetReplicationPolicyOK) Code() int {
+	return 200
+}
+
+func (o *SetReplicationPolicyOK) Error() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/policy][%d] setReplicationPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *SetReplicationPolicyOK) String() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/policy][%d] setReplicationPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *SetReplicationPolicyOK) GetPayload() *models.ModelReplicationPolicy {
+	return o.Payload
+}
+
+func (o *SetReplicationPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ModelReplicationPolicy)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetReplicationPolicyBadRequest creates a SetReplicationPolicyBadRequest with default headers values
+func NewSetReplicationPolicyBadRequest() *SetReplicationPolicyBadRequest {
+	return &SetReplicationPolicyBadRequest{}
+}
+
+/*
+SetReplicationPolicyBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type SetReplicationPolicyBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this set replication policy bad request response has a 2xx status code
+func (o *SetReplicationPolicyBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this set replication policy bad request response has a 3xx status code
+func (o *SetReplicationPolicyBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this set replication policy bad request response has a 4xx status code
+func (o *SetReplicationPolicyBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this set replication policy bad request response has a 5xx status code
+func (o *SetReplicationPolicyBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this set replication policy bad request response a status code equal to that given
+func (o *SetReplicationPolicyBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the set replication policy bad request response
+func (o *SetReplicationPolicyBadRequest) Code() int {
+	return 400
+}
+
+func (o *SetReplicationPolicyBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/policy][%d] setReplicationPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SetReplicationPolicyBadRequest) String() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/policy][%d] setReplicationPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SetReplicationPolicyBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *SetReplicationPolicyBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetReplicationPolicyNotFound creates a SetReplicationPolicyNotFound with default headers values
+func NewSetReplicationPolicyNotFound() *SetReplicationPolicyNotFound {
+	return &SetReplicationPolicyNotFound{}
+}
+
+/*
+SetReplicationPolicyNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type SetReplicationPolicyNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this set replication policy not found response has a 2xx status code
+func (o *SetReplicationPolicyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this set replication policy not found response has a 3xx status code
+func (o *SetReplicationPolicyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this set replication policy not found response has a 4xx status code
+func (o *SetReplicationPolicyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this set replication policy not found response has a 5xx status code
+func (o *SetReplicationPolicyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this set replication policy not found response a status code equal to that given
+func (o *SetReplicationPolicyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the set replication policy not found response
+func (o *SetReplicationPolicyNotFound) Code() int {
+	return 404
+}
+
+func (o *SetReplicationPolicyNotFound) Error() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/policy][%d] setReplicationPolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SetReplicationPolicyNotFound) String() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/policy][%d] setReplicationPolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SetReplicationPolicyNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *SetReplicationPolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetReplicationPolicyInternalServerError creates a SetReplicationPolicyInternalServerError with default headers values
+func NewSetReplicationPolicyInternalServerError() *SetReplicationPolicyInternalServerError {
+	return &SetReplicationPolicyInternalServerError{}
+}
+
+/*
+SetReplicationPolicyInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type SetReplicationPolicyInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this set replication policy internal server error response has a 2xx status code
+func (o *SetReplicationPolicyInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this set replication policy internal server error response has a 3xx status code
+func (o *SetReplicationPolicyInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this set replication policy internal server error response has a 4xx status code
+func (o *SetReplicationPolicyInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this set replication policy internal server error response has a 5xx status code
+func (o *SetReplicationPolicyInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this set replication policy internal server error response a status code equal to that given
+func (o *SetReplicationPolicyInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the set replication policy internal server error response
+func (o *SetReplicationPolicyInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SetReplicationPolicyInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/policy][%d] setReplicationPolicyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SetReplicationPolicyInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/policy][%d] setReplicationPolicyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SetReplicationPolicyInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *SetReplicationPolicyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
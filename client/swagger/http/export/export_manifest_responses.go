@@ -0,0 +1,323 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package export
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// ExportManifestReader is a Reader for the ExportManifest structure.
+type ExportManifestReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ExportManifestReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewExportManifestOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewExportManifestBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewExportManifestNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewExportManifestInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /export/manifest] ExportManifest", response, response.Code())
+	}
+}
+
+// NewExportManifestOK creates a ExportManifestOK with default headers values
+func NewExportManifestOK() *ExportManifestOK {
+	return &ExportManifestOK{}
+}
+
+/*
+ExportManifestOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ExportManifestOK struct {
+	Payload []*models.ExportManifestEntry
+}
+
+// IsSuccess returns true when this export manifest o k response has a 2xx status code
+func (o *ExportManifestOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this export manifest o k response has a 3xx status code
+func (o *ExportManifestOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export manifest o k response has a 4xx status code
+func (o *ExportManifestOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this export manifest o k response has a 5xx status code
+func (o *ExportManifestOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export manifest o k response a status code equal to that given
+func (o *ExportManifestOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the export manifest o k response
+func (o *ExportManifestOK) Code() int {
+	return 200
+}
+
+func (o *ExportManifestOK) Error() string {
+	return fmt.Sprintf("[POST /export/manifest][%d] exportManifestOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportManifestOK) String() string {
+	return fmt.Sprintf("[POST /export/manifest][%d] exportManifestOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportManifestOK) GetPayload() []*models.ExportManifestEntry {
+	return o.Payload
+}
+
+func (o *ExportManifestOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportManifestBadRequest creates a ExportManifestBadRequest with default headers values
+func NewExportManifestBadRequest() *ExportManifestBadRequest {
+	return &ExportManifestBadRequest{}
+}
+
+/*
+ExportManifestBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ExportManifestBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this export manifest bad request response has a 2xx status code
+func (o *ExportManifestBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export manifest bad request response has a 3xx status code
+func (o *ExportManifestBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export manifest bad request response has a 4xx status code
+func (o *ExportManifestBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this export manifest bad request response has a 5xx status code
+func (o *ExportManifestBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export manifest bad request response a status code equal to that given
+func (o *ExportManifestBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the export manifest bad request response
+func (o *ExportManifestBadRequest) Code() int {
+	return 400
+}
+
+func (o *ExportManifestBadRequest) Error() string {
+	return fmt.Sprintf("[POST /export/manifest][%d] exportManifestBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ExportManifestBadRequest) String() string {
+	return fmt.Sprintf("[POST /export/manifest][%d] exportManifestBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ExportManifestBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ExportManifestBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportManifestNotFound creates a ExportManifestNotFound with default headers values
+func NewExportManifestNotFound() *ExportManifestNotFound {
+	return &ExportManifestNotFound{}
+}
+
+/*
+ExportManifestNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ExportManifestNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this export manifest not found response has a 2xx status code
+func (o *ExportManifestNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export manifest not found response has a 3xx status code
+func (o *ExportManifestNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export manifest not found response has a 4xx status code
+func (o *ExportManifestNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this export manifest not found response has a 5xx status code
+func (o *ExportManifestNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export manifest not found response a status code equal to that given
+func (o *ExportManifestNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the export manifest not found response
+func (o *ExportManifestNotFound) Code() int {
+	return 404
+}
+
+func (o *ExportManifestNotFound) Error() string {
+	return fmt.Sprintf("[POST /export/manifest][%d] exportManifestNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ExportManifestNotFound) String() string {
+	return fmt.Sprintf("[POST /export/manifest][%d] exportManifestNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ExportManifestNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ExportManifestNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportManifestInternalServerError creates a ExportManifestInternalServerError with default headers values
+func NewExportManifestInternalServerError() *ExportManifestInternalServerError {
+	return &ExportManifestInternalServerError{}
+}
+
+/*
+ExportManifestInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ExportManifestInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this export manifest internal server error response has a 2xx status code
+func (o *ExportManifestInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export manifest internal server error response has a 3xx status code
+func (o *ExportManifestInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export manifest internal server error response has a 4xx status code
+func (o *ExportManifestInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this export manifest internal server error response has a 5xx status code
+func (o *ExportManifestInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this export manifest internal server error response a status code equal to that given
+func (o *ExportManifestInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the export manifest internal server error response
+func (o *ExportManifestInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ExportManifestInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /export/manifest][%d] exportManifestInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ExportManifestInternalServerError) String() string {
+	return fmt.Sprintf("[POST /export/manifest][%d] exportManifestInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ExportManifestInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ExportManifestInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package file
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// AppendFilesReader is a Reader for the AppendFiles structure.
+type AppendFilesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AppendFilesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAppendFilesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewAppendFilesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAppendFilesNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAppendFilesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /preparation/{id}/source/{name}/files] AppendFiles", response, response.Code())
+	}
+}
+
+// NewAppendFilesOK creates a AppendFilesOK with default headers values
+func NewAppendFilesOK() *AppendFilesOK {
+	return &AppendFilesOK{}
+}
+
+/*
+AppendFilesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type AppendFilesOK struct {
+	Payload *models.FileAppendResult
+}
+
+// IsSuccess returns true when this append files o k response has a 2xx status code
+func (o *AppendFilesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this append files o k response has a 3xx status code
+func (o *AppendFilesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this append files o k response has a 4xx status code
+func (o *AppendFilesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this append files o k response has a 5xx status code
+func (o *AppendFilesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this append files o k response a status code equal to that given
+func (o *AppendFilesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the append files o k response
+func (o *AppendFilesOK) Code() int {
+	return 200
+}
+
+func (o *AppendFilesOK) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/files][%d] appendFilesOK  %+v", 200, o.Payload)
+}
+
+func (o *AppendFilesOK) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/files][%d] appendFilesOK  %+v", 200, o.Payload)
+}
+
+func (o *AppendFilesOK) GetPayload() *models.FileAppendResult {
+	return o.Payload
+}
+
+func (o *AppendFilesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.FileAppendResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAppendFilesBadRequest creates a AppendFilesBadRequest with default headers values
+func NewAppendFilesBadRequest() *AppendFilesBadRequest {
+	return &AppendFilesBadRequest{}
+}
+
+/*
+AppendFilesBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type AppendFilesBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this append files bad request response has a 2xx status code
+func (o *AppendFilesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this append files bad request response has a 3xx status code
+func (o *AppendFilesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this append files bad request response has a 4xx status code
+func (o *AppendFilesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this append files bad request response has a 5xx status code
+func (o *AppendFilesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this append files bad request response a status code equal to that given
+func (o *AppendFilesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the append files bad request response
+func (o *AppendFilesBadRequest) Code() int {
+	return 400
+}
+
+func (o *AppendFilesBadRequest) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/files][%d] appendFilesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *AppendFilesBadRequest) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/files][%d] appendFilesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *AppendFilesBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *AppendFilesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAppendFilesNotFound creates a AppendFilesNotFound with default headers values
+func NewAppendFilesNotFound() *AppendFilesNotFound {
+	return &AppendFilesNotFound{}
+}
+
+/*
+AppendFilesNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type AppendFilesNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this append files not found response has a 2xx status code
+func (o *AppendFilesNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this append files not found response has a 3xx status code
+func (o *AppendFilesNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this append files not found response has a 4xx status code
+func (o *AppendFilesNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this append files not found response has a 5xx status code
+func (o *AppendFilesNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this append files not found response a status code equal to that given
+func (o *AppendFilesNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the append files not found response
+func (o *AppendFilesNotFound) Code() int {
+	return 404
+}
+
+func (o *AppendFilesNotFound) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/files][%d] appendFilesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *AppendFilesNotFound) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/files][%d] appendFilesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *AppendFilesNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *AppendFilesNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAppendFilesInternalServerError creates a AppendFilesInternalServerError with default headers values
+func NewAppendFilesInternalServerError() *AppendFilesInternalServerError {
+	return &AppendFilesInternalServerError{}
+}
+
+/*
+AppendFilesInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type AppendFilesInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this append files internal server error response has a 2xx status code
+func (o *AppendFilesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this append files internal server error response has a 3xx status code
+func (o *AppendFilesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this append files internal server error response has a 4xx status code
+func (o *AppendFilesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this append files internal server error response has a 5xx status code
+func (o *AppendFilesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this append files internal server error response a status code equal to that given
+func (o *AppendFilesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the append files internal server error response
+func (o *AppendFilesInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AppendFilesInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/files][%d] appendFilesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AppendFilesInternalServerError) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/files][%d] appendFilesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AppendFilesInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *AppendFilesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
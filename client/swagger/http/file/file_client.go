@@ -31,19 +31,65 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	AppendFiles(params *AppendFilesParams, opts ...ClientOption) (*AppendFilesOK, error)
+
 	GetFile(params *GetFileParams, opts ...ClientOption) (*GetFileOK, error)
 
 	GetFileDeals(params *GetFileDealsParams, opts ...ClientOption) (*GetFileDealsOK, error)
 
 	PrepareToPackFile(params *PrepareToPackFileParams, opts ...ClientOption) (*PrepareToPackFileOK, error)
 
+	PreviewFile(params *PreviewFileParams, writer io.Writer, opts ...ClientOption) (*PreviewFileOK, error)
+
 	PushFile(params *PushFileParams, opts ...ClientOption) (*PushFileOK, error)
 
 	RetrieveFile(params *RetrieveFileParams, writer io.Writer, opts ...ClientOption) (*RetrieveFileOK, *RetrieveFilePartialContent, error)
 
+	SearchFiles(params *SearchFilesParams, opts ...ClientOption) (*SearchFilesOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+AppendFiles appends files to a source and queue them for packing without rescanning the source
+
+Tells Singularity that a list of new files landed in the source, so they are packed without a full rescan
+*/
+func (a *Client) AppendFiles(params *AppendFilesParams, opts ...ClientOption) (*AppendFilesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAppendFilesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "AppendFiles",
+		Method:             "POST",
+		PathPattern:        "/preparation/{id}/source/{name}/files",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AppendFilesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AppendFilesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for AppendFiles: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetFile gets details about a file
 */
@@ -158,6 +204,44 @@ func (a *Client) PrepareToPackFile(params *PrepareToPackFileParams, opts ...Clie
 	panic(msg)
 }
 
+/*
+PreviewFile gets the first bytes of a prepared file or a preview such as a thumbnail of an image
+*/
+func (a *Client) PreviewFile(params *PreviewFileParams, writer io.Writer, opts ...ClientOption) (*PreviewFileOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPreviewFileParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PreviewFile",
+		Method:             "GET",
+		PathPattern:        "/file/{id}/preview",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PreviewFileReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PreviewFileOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PreviewFile: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 PushFile pushes a file to be queued
 
@@ -237,6 +321,44 @@ func (a *Client) RetrieveFile(params *RetrieveFileParams, writer io.Writer, opts
 	panic(msg)
 }
 
+/*
+SearchFiles searches files across all preparations by path c ID size range or deal label
+*/
+func (a *Client) SearchFiles(params *SearchFilesParams, opts ...ClientOption) (*SearchFilesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSearchFilesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SearchFiles",
+		Method:             "POST",
+		PathPattern:        "/file/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SearchFilesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SearchFilesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SearchFiles: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -0,0 +1,327 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package file
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// PreviewFileReader is a Reader for the PreviewFile structure.
+type PreviewFileReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PreviewFileReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPreviewFileOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPreviewFileBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPreviewFileNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPreviewFileInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /file/{id}/preview] PreviewFile", response, response.Code())
+	}
+}
+
+// NewPreviewFileOK creates a PreviewFileOK with default headers values
+func NewPreviewFileOK(writer io.Writer) *PreviewFileOK {
+	return &PreviewFileOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+PreviewFileOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type PreviewFileOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this preview file o k response has a 2xx status code
+func (o *PreviewFileOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this preview file o k response has a 3xx status code
+func (o *PreviewFileOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview file o k response has a 4xx status code
+func (o *PreviewFileOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this preview file o k response has a 5xx status code
+func (o *PreviewFileOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview file o k response a status code equal to that given
+func (o *PreviewFileOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the preview file o k response
+func (o *PreviewFileOK) Code() int {
+	return 200
+}
+
+func (o *PreviewFileOK) Error() string {
+	return fmt.Sprintf("[GET /file/{id}/preview][%d] previewFileOK  %+v", 200, o.Payload)
+}
+
+func (o *PreviewFileOK) String() string {
+	return fmt.Sprintf("[GET /file/{id}/preview][%d] previewFileOK  %+v", 200, o.Payload)
+}
+
+func (o *PreviewFileOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *PreviewFileOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPreviewFileBadRequest creates a PreviewFileBadRequest with default headers values
+func NewPreviewFileBadRequest() *PreviewFileBadRequest {
+	return &PreviewFileBadRequest{}
+}
+
+/*
+PreviewFileBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type PreviewFileBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this preview file bad request response has a 2xx status code
+func (o *PreviewFileBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this preview file bad request response has a 3xx status code
+func (o *PreviewFileBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview file bad request response has a 4xx status code
+func (o *PreviewFileBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this preview file bad request response has a 5xx status code
+func (o *PreviewFileBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview file bad request response a status code equal to that given
+func (o *PreviewFileBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the preview file bad request response
+func (o *PreviewFileBadRequest) Code() int {
+	return 400
+}
+
+func (o *PreviewFileBadRequest) Error() string {
+	return fmt.Sprintf("[GET /file/{id}/preview][%d] previewFileBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PreviewFileBadRequest) String() string {
+	return fmt.Sprintf("[GET /file/{id}/preview][%d] previewFileBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PreviewFileBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *PreviewFileBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPreviewFileNotFound creates a PreviewFileNotFound with default headers values
+func NewPreviewFileNotFound() *PreviewFileNotFound {
+	return &PreviewFileNotFound{}
+}
+
+/*
+PreviewFileNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PreviewFileNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this preview file not found response has a 2xx status code
+func (o *PreviewFileNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this preview file not found response has a 3xx status code
+func (o *PreviewFileNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview file not found response has a 4xx status code
+func (o *PreviewFileNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this preview file not found response has a 5xx status code
+func (o *PreviewFileNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview file not found response a status code equal to that given
+func (o *PreviewFileNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the preview file not found response
+func (o *PreviewFileNotFound) Code() int {
+	return 404
+}
+
+func (o *PreviewFileNotFound) Error() string {
+	return fmt.Sprintf("[GET /file/{id}/preview][%d] previewFileNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PreviewFileNotFound) String() string {
+	return fmt.Sprintf("[GET /file/{id}/preview][%d] previewFileNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PreviewFileNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *PreviewFileNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPreviewFileInternalServerError creates a PreviewFileInternalServerError with default headers values
+func NewPreviewFileInternalServerError() *PreviewFileInternalServerError {
+	return &PreviewFileInternalServerError{}
+}
+
+/*
+PreviewFileInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type PreviewFileInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this preview file internal server error response has a 2xx status code
+func (o *PreviewFileInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this preview file internal server error response has a 3xx status code
+func (o *PreviewFileInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview file internal server error response has a 4xx status code
+func (o *PreviewFileInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this preview file internal server error response has a 5xx status code
+func (o *PreviewFileInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this preview file internal server error response a status code equal to that given
+func (o *PreviewFileInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the preview file internal server error response
+func (o *PreviewFileInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PreviewFileInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /file/{id}/preview][%d] previewFileInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *PreviewFileInternalServerError) String() string {
+	return fmt.Sprintf("[GET /file/{id}/preview][%d] previewFileInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *PreviewFileInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *PreviewFileInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package file
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// SearchFilesReader is a Reader for the SearchFiles structure.
+type SearchFilesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SearchFilesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSearchFilesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSearchFilesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSearchFilesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /file/search] SearchFiles", response, response.Code())
+	}
+}
+
+// NewSearchFilesOK creates a SearchFilesOK with default headers values
+func NewSearchFilesOK() *SearchFilesOK {
+	return &SearchFilesOK{}
+}
+
+/*
+SearchFilesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type SearchFilesOK struct {
+	Payload []*models.FileSearchResult
+}
+
+// IsSuccess returns true when this search files o k response has a 2xx status code
+func (o *SearchFilesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this search files o k response has a 3xx status code
+func (o *SearchFilesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search files o k response has a 4xx status code
+func (o *SearchFilesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this search files o k response has a 5xx status code
+func (o *SearchFilesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this search files o k response a status code equal to that given
+func (o *SearchFilesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the search files o k response
+func (o *SearchFilesOK) Code() int {
+	return 200
+}
+
+func (o *SearchFilesOK) Error() string {
+	return fmt.Sprintf("[POST /file/search][%d] searchFilesOK  %+v", 200, o.Payload)
+}
+
+func (o *SearchFilesOK) String() string {
+	return fmt.Sprintf("[POST /file/search][%d] searchFilesOK  %+v", 200, o.Payload)
+}
+
+func (o *SearchFilesOK) GetPayload() []*models.FileSearchResult {
+	return o.Payload
+}
+
+func (o *SearchFilesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSearchFilesBadRequest creates a SearchFilesBadRequest with default headers values
+func NewSearchFilesBadRequest() *SearchFilesBadRequest {
+	return &SearchFilesBadRequest{}
+}
+
+/*
+SearchFilesBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type SearchFilesBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this search files bad request response has a 2xx status code
+func (o *SearchFilesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this search files bad request response has a 3xx status code
+func (o *SearchFilesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search files bad request response has a 4xx status code
+func (o *SearchFilesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this search files bad request response has a 5xx status code
+func (o *SearchFilesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this search files bad request response a status code equal to that given
+func (o *SearchFilesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the search files bad request response
+func (o *SearchFilesBadRequest) Code() int {
+	return 400
+}
+
+func (o *SearchFilesBadRequest) Error() string {
+	return fmt.Sprintf("[POST /file/search][%d] searchFilesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SearchFilesBadRequest) String() string {
+	return fmt.Sprintf("[POST /file/search][%d] searchFilesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SearchFilesBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *SearchFilesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSearchFilesInternalServerError creates a SearchFilesInternalServerError with default headers values
+func NewSearchFilesInternalServerError() *SearchFilesInternalServerError {
+	return &SearchFilesInternalServerError{}
+}
+
+/*
+SearchFilesInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type SearchFilesInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this search files internal server error response has a 2xx status code
+func (o *SearchFilesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this search files internal server error response has a 3xx status code
+func (o *SearchFilesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search files internal server error response has a 4xx status code
+func (o *SearchFilesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this search files internal server error response has a 5xx status code
+func (o *SearchFilesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this search files internal server error response a status code equal to that given
+func (o *SearchFilesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the search files internal server error response
+func (o *SearchFilesInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SearchFilesInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /file/search][%d] searchFilesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SearchFilesInternalServerError) String() string {
+	return fmt.Sprintf("[POST /file/search][%d] searchFilesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SearchFilesInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *SearchFilesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// GetPackTimingsReader is a Reader for the GetPackTimings structure.
+type GetPackTimingsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetPackTimingsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetPackTimingsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetPackTimingsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetPackTimingsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetPackTimingsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /preparation/{id}/timing] GetPackTimings", response, response.Code())
+	}
+}
+
+// NewGetPackTimingsOK creates a GetPackTimingsOK with default headers values
+func NewGetPackTimingsOK() *GetPackTimingsOK {
+	return &GetPackTimingsOK{}
+}
+
+/*
+GetPackTimingsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetPackTimingsOK struct {
+	Payload *models.JobPackTimingReport
+}
+
+// IsSuccess returns true when this get pack timings o k response has a 2xx status code
+func (o *GetPackTimingsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get pack timings o k response has a 3xx status code
+func (o *GetPackTimingsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get pack timings o k response has a 4xx status code
+func (o *GetPackTimingsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get pack timings o k response has a 5xx status code
+func (o *GetPackTimingsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get pack timings o k response a status code equal to that given
+func (o *GetPackTimingsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get pack timings o k response
+func (o *GetPackTimingsOK) Code() int {
+	return 200
+}
+
+func (o *GetPackTimingsOK) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/timing][%d] getPackTimingsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetPackTimingsOK) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/timing][%d] getPackTimingsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetPackTimingsOK) GetPayload() *models.JobPackTimingReport {
+	return o.Payload
+}
+
+func (o *GetPackTimingsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.JobPackTimingReport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetPackTimingsBadRequest creates a GetPackTimingsBadRequest with default headers values
+func NewGetPackTimingsBadRequest() *GetPackTimingsBadRequest {
+	return &GetPackTimingsBadRequest{}
+}
+
+/*
+GetPackTimingsBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type GetPackTimingsBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get pack timings bad request response has a 2xx status code
+func (o *GetPackTimingsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get pack timings bad request response has a 3xx status code
+func (o *GetPackTimingsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get pack timings bad request response has a 4xx status code
+func (o *GetPackTimingsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get pack timings bad request response has a 5xx status code
+func (o *GetPackTimingsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get pack timings bad request response a status code equal to that given
+func (o *GetPackTimingsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get pack timings bad request response
+func (o *GetPackTimingsBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetPackTimingsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/timing][%d] getPackTimingsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetPackTimingsBadRequest) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/timing][%d] getPackTimingsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetPackTimingsBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetPackTimingsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetPackTimingsNotFound creates a GetPackTimingsNotFound with default headers values
+func NewGetPackTimingsNotFound() *GetPackTimingsNotFound {
+	return &GetPackTimingsNotFound{}
+}
+
+/*
+GetPackTimingsNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type GetPackTimingsNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get pack timings not found response has a 2xx status code
+func (o *GetPackTimingsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get pack timings not found response has a 3xx status code
+func (o *GetPackTimingsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get pack timings not found response has a 4xx status code
+func (o *GetPackTimingsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get pack timings not found response has a 5xx status code
+func (o *GetPackTimingsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get pack timings not found response a status code equal to that given
+func (o *GetPackTimingsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get pack timings not found response
+func (o *GetPackTimingsNotFound) Code() int {
+	return 404
+}
+
+func (o *GetPackTimingsNotFound) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/timing][%d] getPackTimingsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetPackTimingsNotFound) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/timing][%d] getPackTimingsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetPackTimingsNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetPackTimingsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetPackTimingsInternalServerError creates a GetPackTimingsInternalServerError with default headers values
+func NewGetPackTimingsInternalServerError() *GetPackTimingsInternalServerError {
+	return &GetPackTimingsInternalServerError{}
+}
+
+/*
+GetPackTimingsInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type GetPackTimingsInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get pack timings internal server error response has a 2xx status code
+func (o *GetPackTimingsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get pack timings internal server error response has a 3xx status code
+func (o *GetPackTimingsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get pack timings internal server error response has a 4xx status code
+func (o *GetPackTimingsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get pack timings internal server error response has a 5xx status code
+func (o *GetPackTimingsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get pack timings internal server error response a status code equal to that given
+func (o *GetPackTimingsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the get pack timings internal server error response
+func (o *GetPackTimingsInternalServerError) Code() int {
+	return 500
+}
+
+func (o *GetPackTimingsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/timing][%d] getPackTimingsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetPackTimingsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/timing][%d] getPackTimingsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetPackTimingsInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetPackTimingsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
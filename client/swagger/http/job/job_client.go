@@ -30,8 +30,12 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	GetPackTimings(params *GetPackTimingsParams, opts ...ClientOption) (*GetPackTimingsOK, error)
+
 	Pack(params *PackParams, opts ...ClientOption) (*PackOK, error)
 
+	PackOne(params *PackOneParams, opts ...ClientOption) (*PackOneOK, error)
+
 	PauseDagGen(params *PauseDagGenParams, opts ...ClientOption) (*PauseDagGenOK, error)
 
 	PausePack(params *PausePackParams, opts ...ClientOption) (*PausePackOK, error)
@@ -49,6 +53,44 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+GetPackTimings gets the timing breakdown of the pieces generated for a preparation
+*/
+func (a *Client) GetPackTimings(params *GetPackTimingsParams, opts ...ClientOption) (*GetPackTimingsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetPackTimingsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetPackTimings",
+		Method:             "GET",
+		PathPattern:        "/preparation/{id}/timing",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetPackTimingsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetPackTimingsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetPackTimings: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 Pack packs a pack job into car files
 */
@@ -87,6 +129,44 @@ func (a *Client) Pack(params *PackParams, opts ...ClientOption) (*PackOK, error)
 	panic(msg)
 }
 
+/*
+PackOne claims pack and release exactly one pack job that is ready to be packed
+*/
+func (a *Client) PackOne(params *PackOneParams, opts ...ClientOption) (*PackOneOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPackOneParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PackOne",
+		Method:             "POST",
+		PathPattern:        "/job/pack-one",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PackOneReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PackOneOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PackOne: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 PauseDagGen pauses an ongoing d a g generation job
 */
@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// PackOneReader is a Reader for the PackOne structure.
+type PackOneReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PackOneReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPackOneOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPackOneBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPackOneInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /job/pack-one] PackOne", response, response.Code())
+	}
+}
+
+// NewPackOneOK creates a PackOneOK with default headers values
+func NewPackOneOK() *PackOneOK {
+	return &PackOneOK{}
+}
+
+/*
+PackOneOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type PackOneOK struct {
+	Payload *models.ModelCar
+}
+
+// IsSuccess returns true when this pack one o k response has a 2xx status code
+func (o *PackOneOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this pack one o k response has a 3xx status code
+func (o *PackOneOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this pack one o k response has a 4xx status code
+func (o *PackOneOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this pack one o k response has a 5xx status code
+func (o *PackOneOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this pack one o k response a status code equal to that given
+func (o *PackOneOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the pack one o k response
+func (o *PackOneOK) Code() int {
+	return 200
+}
+
+func (o *PackOneOK) Error() string {
+	return fmt.Sprintf("[POST /job/pack-one][%d] packOneOK  %+v", 200, o.Payload)
+}
+
+func (o *PackOneOK) String() string {
+	return fmt.Sprintf("[POST /job/pack-one][%d] packOneOK  %+v", 200, o.Payload)
+}
+
+func (o *PackOneOK) GetPayload() *models.ModelCar {
+	return o.Payload
+}
+
+func (o *PackOneOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ModelCar)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPackOneBadRequest creates a PackOneBadRequest with default headers values
+func NewPackOneBadRequest() *PackOneBadRequest {
+	return &PackOneBadRequest{}
+}
+
+/*
+PackOneBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type PackOneBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this pack one bad request response has a 2xx status code
+func (o *PackOneBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this pack one bad request response has a 3xx status code
+func (o *PackOneBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this pack one bad request response has a 4xx status code
+func (o *PackOneBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this pack one bad request response has a 5xx status code
+func (o *PackOneBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this pack one bad request response a status code equal to that given
+func (o *PackOneBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the pack one bad request response
+func (o *PackOneBadRequest) Code() int {
+	return 400
+}
+
+func (o *PackOneBadRequest) Error() string {
+	return fmt.Sprintf("[POST /job/pack-one][%d] packOneBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PackOneBadRequest) String() string {
+	return fmt.Sprintf("[POST /job/pack-one][%d] packOneBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PackOneBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *PackOneBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPackOneInternalServerError creates a PackOneInternalServerError with default headers values
+func NewPackOneInternalServerError() *PackOneInternalServerError {
+	return &PackOneInternalServerError{}
+}
+
+/*
+PackOneInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type PackOneInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this pack one internal server error response has a 2xx status code
+func (o *PackOneInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this pack one internal server error response has a 3xx status code
+func (o *PackOneInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this pack one internal server error response has a 4xx status code
+func (o *PackOneInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this pack one internal server error response has a 5xx status code
+func (o *PackOneInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this pack one internal server error response a status code equal to that given
+func (o *PackOneInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the pack one internal server error response
+func (o *PackOneInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PackOneInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /job/pack-one][%d] packOneInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *PackOneInternalServerError) String() string {
+	return fmt.Sprintf("[POST /job/pack-one][%d] packOneInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *PackOneInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *PackOneInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package piece
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// CreateRetrievalTokenReader is a Reader for the CreateRetrievalToken structure.
+type CreateRetrievalTokenReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateRetrievalTokenReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCreateRetrievalTokenOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCreateRetrievalTokenBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewCreateRetrievalTokenNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewCreateRetrievalTokenInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /preparation/{id}/retrieval-token] CreateRetrievalToken", response, response.Code())
+	}
+}
+
+// NewCreateRetrievalTokenOK creates a CreateRetrievalTokenOK with default headers values
+func NewCreateRetrievalTokenOK() *CreateRetrievalTokenOK {
+	return &CreateRetrievalTokenOK{}
+}
+
+/*
+CreateRetrievalTokenOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type CreateRetrievalTokenOK struct {
+	Payload *models.DataprepIssuedRetrievalToken
+}
+
+// IsSuccess returns true when this create retrieval token o k response has a 2xx status code
+func (o *CreateRetrievalTokenOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create retrieval token o k response has a 3xx status code
+func (o *CreateRetrievalTokenOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create retrieval token o k response has a 4xx status code
+func (o *CreateRetrievalTokenOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create retrieval token o k response has a 5xx status code
+func (o *CreateRetrievalTokenOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create retrieval token o k response a status code equal to that given
+func (o *CreateRetrievalTokenOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the create retrieval token o k response
+func (o *CreateRetrievalTokenOK) Code() int {
+	return 200
+}
+
+func (o *CreateRetrievalTokenOK) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token][%d] createRetrievalTokenOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateRetrievalTokenOK) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token][%d] createRetrievalTokenOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateRetrievalTokenOK) GetPayload() *models.DataprepIssuedRetrievalToken {
+	return o.Payload
+}
+
+func (o *CreateRetrievalTokenOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DataprepIssuedRetrievalToken)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateRetrievalTokenBadRequest creates a CreateRetrievalTokenBadRequest with default headers values
+func NewCreateRetrievalTokenBadRequest() *CreateRetrievalTokenBadRequest {
+	return &CreateRetrievalTokenBadRequest{}
+}
+
+/*
+CreateRetrievalTokenBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type CreateRetrievalTokenBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this create retrieval token bad request response has a 2xx status code
+func (o *CreateRetrievalTokenBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create retrieval token bad request response has a 3xx status code
+func (o *CreateRetrievalTokenBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create retrieval token bad request response has a 4xx status code
+func (o *CreateRetrievalTokenBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create retrieval token bad request response has a 5xx status code
+func (o *CreateRetrievalTokenBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create retrieval token bad request response a status code equal to that given
+func (o *CreateRetrievalTokenBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the create retrieval token bad request response
+func (o *CreateRetrievalTokenBadRequest) Code() int {
+	return 400
+}
+
+func (o *CreateRetrievalTokenBadRequest) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token][%d] createRetrievalTokenBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateRetrievalTokenBadRequest) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token][%d] createRetrievalTokenBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateRetrievalTokenBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *CreateRetrievalTokenBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateRetrievalTokenNotFound creates a CreateRetrievalTokenNotFound with default headers values
+func NewCreateRetrievalTokenNotFound() *CreateRetrievalTokenNotFound {
+	return &CreateRetrievalTokenNotFound{}
+}
+
+/*
+CreateRetrievalTokenNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type CreateRetrievalTokenNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this create retrieval token not found response has a 2xx status code
+func (o *CreateRetrievalTokenNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create retrieval token not found response has a 3xx status code
+func (o *CreateRetrievalTokenNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create retrieval token not found response has a 4xx status code
+func (o *CreateRetrievalTokenNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create retrieval token not found response has a 5xx status code
+func (o *CreateRetrievalTokenNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create retrieval token not found response a status code equal to that given
+func (o *CreateRetrievalTokenNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the create retrieval token not found response
+func (o *CreateRetrievalTokenNotFound) Code() int {
+	return 404
+}
+
+func (o *CreateRetrievalTokenNotFound) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token][%d] createRetrievalTokenNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CreateRetrievalTokenNotFound) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token][%d] createRetrievalTokenNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CreateRetrievalTokenNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *CreateRetrievalTokenNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateRetrievalTokenInternalServerError creates a CreateRetrievalTokenInternalServerError with default headers values
+func NewCreateRetrievalTokenInternalServerError() *CreateRetrievalTokenInternalServerError {
+	return &CreateRetrievalTokenInternalServerError{}
+}
+
+/*
+CreateRetrievalTokenInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type CreateRetrievalTokenInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this create retrieval token internal server error response has a 2xx status code
+func (o *CreateRetrievalTokenInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create retrieval token internal server error response has a 3xx status code
+func (o *CreateRetrievalTokenInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create retrieval token internal server error response has a 4xx status code
+func (o *CreateRetrievalTokenInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create retrieval token internal server error response has a 5xx status code
+func (o *CreateRetrievalTokenInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this create retrieval token internal server error response a status code equal to that given
+func (o *CreateRetrievalTokenInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the create retrieval token internal server error response
+func (o *CreateRetrievalTokenInternalServerError) Code() int {
+	return 500
+}
+
+func (o *CreateRetrievalTokenInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token][%d] createRetrievalTokenInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CreateRetrievalTokenInternalServerError) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token][%d] createRetrievalTokenInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CreateRetrievalTokenInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *CreateRetrievalTokenInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package piece
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// ExportPieceKeysReader is a Reader for the ExportPieceKeys structure.
+type ExportPieceKeysReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ExportPieceKeysReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewExportPieceKeysOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewExportPieceKeysBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewExportPieceKeysNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewExportPieceKeysInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /preparation/{id}/piece-keys] ExportPieceKeys", response, response.Code())
+	}
+}
+
+// NewExportPieceKeysOK creates a ExportPieceKeysOK with default headers values
+func NewExportPieceKeysOK() *ExportPieceKeysOK {
+	return &ExportPieceKeysOK{}
+}
+
+/*
+ExportPieceKeysOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ExportPieceKeysOK struct {
+	Payload *models.DataprepPieceKeyEscrow
+}
+
+// IsSuccess returns true when this export piece keys o k response has a 2xx status code
+func (o *ExportPieceKeysOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this export piece keys o k response has a 3xx status code
+func (o *ExportPieceKeysOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export piece keys o k response has a 4xx status code
+func (o *ExportPieceKeysOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this export piece keys o k response has a 5xx status code
+func (o *ExportPieceKeysOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export piece keys o k response a status code equal to that given
+func (o *ExportPieceKeysOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the export piece keys o k response
+func (o *ExportPieceKeysOK) Code() int {
+	return 200
+}
+
+func (o *ExportPieceKeysOK) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/piece-keys][%d] exportPieceKeysOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportPieceKeysOK) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/piece-keys][%d] exportPieceKeysOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportPieceKeysOK) GetPayload() *models.DataprepPieceKeyEscrow {
+	return o.Payload
+}
+
+func (o *ExportPieceKeysOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DataprepPieceKeyEscrow)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportPieceKeysBadRequest creates a ExportPieceKeysBadRequest with default headers values
+func NewExportPieceKeysBadRequest() *ExportPieceKeysBadRequest {
+	return &ExportPieceKeysBadRequest{}
+}
+
+/*
+ExportPieceKeysBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ExportPieceKeysBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this export piece keys bad request response has a 2xx status code
+func (o *ExportPieceKeysBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export piece keys bad request response has a 3xx status code
+func (o *ExportPieceKeysBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export piece keys bad request response has a 4xx status code
+func (o *ExportPieceKeysBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this export piece keys bad request response has a 5xx status code
+func (o *ExportPieceKeysBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export piece keys bad request response a status code equal to that given
+func (o *ExportPieceKeysBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the export piece keys bad request response
+func (o *ExportPieceKeysBadRequest) Code() int {
+	return 400
+}
+
+func (o *ExportPieceKeysBadRequest) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/piece-keys][%d] exportPieceKeysBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ExportPieceKeysBadRequest) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/piece-keys][%d] exportPieceKeysBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ExportPieceKeysBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ExportPieceKeysBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportPieceKeysNotFound creates a ExportPieceKeysNotFound with default headers values
+func NewExportPieceKeysNotFound() *ExportPieceKeysNotFound {
+	return &ExportPieceKeysNotFound{}
+}
+
+/*
+ExportPieceKeysNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ExportPieceKeysNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this export piece keys not found response has a 2xx status code
+func (o *ExportPieceKeysNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export piece keys not found response has a 3xx status code
+func (o *ExportPieceKeysNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export piece keys not found response has a 4xx status code
+func (o *ExportPieceKeysNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this export piece keys not found response has a 5xx status code
+func (o *ExportPieceKeysNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export piece keys not found response a status code equal to that given
+func (o *ExportPieceKeysNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the export piece keys not found response
+func (o *ExportPieceKeysNotFound) Code() int {
+	return 404
+}
+
+func (o *ExportPieceKeysNotFound) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/piece-keys][%d] exportPieceKeysNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ExportPieceKeysNotFound) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/piece-keys][%d] exportPieceKeysNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ExportPieceKeysNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ExportPieceKeysNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportPieceKeysInternalServerError creates a ExportPieceKeysInternalServerError with default headers values
+func NewExportPieceKeysInternalServerError() *ExportPieceKeysInternalServerError {
+	return &ExportPieceKeysInternalServerError{}
+}
+
+/*
+ExportPieceKeysInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ExportPieceKeysInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this export piece keys internal server error response has a 2xx status code
+func (o *ExportPieceKeysInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export piece keys internal server error response has a 3xx status code
+func (o *ExportPieceKeysInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export piece keys internal server error response has a 4xx status code
+func (o *ExportPieceKeysInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this export piece keys internal server error response has a 5xx status code
+func (o *ExportPieceKeysInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this export piece keys internal server error response a status code equal to that given
+func (o *ExportPieceKeysInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the export piece keys internal server error response
+func (o *ExportPieceKeysInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ExportPieceKeysInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/piece-keys][%d] exportPieceKeysInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ExportPieceKeysInternalServerError) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/piece-keys][%d] exportPieceKeysInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ExportPieceKeysInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ExportPieceKeysInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
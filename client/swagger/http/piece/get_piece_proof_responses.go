@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package piece
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// GetPieceProofReader is a Reader for the GetPieceProof structure.
+type GetPieceProofReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetPieceProofReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetPieceProofOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetPieceProofBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetPieceProofNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetPieceProofInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /piece/{id}/proof] GetPieceProof", response, response.Code())
+	}
+}
+
+// NewGetPieceProofOK creates a GetPieceProofOK with default headers values
+func NewGetPieceProofOK() *GetPieceProofOK {
+	return &GetPieceProofOK{}
+}
+
+/*
+GetPieceProofOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetPieceProofOK struct {
+	Payload *models.DataprepPieceProof
+}
+
+// IsSuccess returns true when this get piece proof o k response has a 2xx status code
+func (o *GetPieceProofOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get piece proof o k response has a 3xx status code
+func (o *GetPieceProofOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get piece proof o k response has a 4xx status code
+func (o *GetPieceProofOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get piece proof o k response has a 5xx status code
+func (o *GetPieceProofOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get piece proof o k response a status code equal to that given
+func (o *GetPieceProofOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get piece proof o k response
+func (o *GetPieceProofOK) Code() int {
+	return 200
+}
+
+func (o *GetPieceProofOK) Error() string {
+	return fmt.Sprintf("[GET /piece/{id}/proof][%d] getPieceProofOK  %+v", 200, o.Payload)
+}
+
+func (o *GetPieceProofOK) String() string {
+	return fmt.Sprintf("[GET /piece/{id}/proof][%d] getPieceProofOK  %+v", 200, o.Payload)
+}
+
+func (o *GetPieceProofOK) GetPayload() *models.DataprepPieceProof {
+	return o.Payload
+}
+
+func (o *GetPieceProofOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DataprepPieceProof)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetPieceProofBadRequest creates a GetPieceProofBadRequest with default headers values
+func NewGetPieceProofBadRequest() *GetPieceProofBadRequest {
+	return &GetPieceProofBadRequest{}
+}
+
+/*
+GetPieceProofBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type GetPieceProofBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get piece proof bad request response has a 2xx status code
+func (o *GetPieceProofBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get piece proof bad request response has a 3xx status code
+func (o *GetPieceProofBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get piece proof bad request response has a 4xx status code
+func (o *GetPieceProofBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get piece proof bad request response has a 5xx status code
+func (o *GetPieceProofBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get piece proof bad request response a status code equal to that given
+func (o *GetPieceProofBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get piece proof bad request response
+func (o *GetPieceProofBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetPieceProofBadRequest) Error() string {
+	return fmt.Sprintf("[GET /piece/{id}/proof][%d] getPieceProofBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetPieceProofBadRequest) String() string {
+	return fmt.Sprintf("[GET /piece/{id}/proof][%d] getPieceProofBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetPieceProofBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetPieceProofBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetPieceProofNotFound creates a GetPieceProofNotFound with default headers values
+func NewGetPieceProofNotFound() *GetPieceProofNotFound {
+	return &GetPieceProofNotFound{}
+}
+
+/*
+GetPieceProofNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type GetPieceProofNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get piece proof not found response has a 2xx status code
+func (o *GetPieceProofNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get piece proof not found response has a 3xx status code
+func (o *GetPieceProofNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get piece proof not found response has a 4xx status code
+func (o *GetPieceProofNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get piece proof not found response has a 5xx status code
+func (o *GetPieceProofNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get piece proof not found response a status code equal to that given
+func (o *GetPieceProofNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get piece proof not found response
+func (o *GetPieceProofNotFound) Code() int {
+	return 404
+}
+
+func (o *GetPieceProofNotFound) Error() string {
+	return fmt.Sprintf("[GET /piece/{id}/proof][%d] getPieceProofNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetPieceProofNotFound) String() string {
+	return fmt.Sprintf("[GET /piece/{id}/proof][%d] getPieceProofNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetPieceProofNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetPieceProofNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetPieceProofInternalServerError creates a GetPieceProofInternalServerError with default headers values
+func NewGetPieceProofInternalServerError() *GetPieceProofInternalServerError {
+	return &GetPieceProofInternalServerError{}
+}
+
+/*
+GetPieceProofInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type GetPieceProofInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get piece proof internal server error response has a 2xx status code
+func (o *GetPieceProofInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get piece proof internal server error response has a 3xx status code
+func (o *GetPieceProofInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get piece proof internal server error response has a 4xx status code
+func (o *GetPieceProofInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get piece proof internal server error response has a 5xx status code
+func (o *GetPieceProofInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get piece proof internal server error response a status code equal to that given
+func (o *GetPieceProofInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the get piece proof internal server error response
+func (o *GetPieceProofInternalServerError) Code() int {
+	return 500
+}
+
+func (o *GetPieceProofInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /piece/{id}/proof][%d] getPieceProofInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetPieceProofInternalServerError) String() string {
+	return fmt.Sprintf("[GET /piece/{id}/proof][%d] getPieceProofInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetPieceProofInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetPieceProofInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
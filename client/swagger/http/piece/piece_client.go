@@ -32,10 +32,24 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	AddPiece(params *AddPieceParams, opts ...ClientOption) (*AddPieceOK, error)
 
+	AggregatePieces(params *AggregatePiecesParams, opts ...ClientOption) (*AggregatePiecesOK, error)
+
+	CreateRetrievalToken(params *CreateRetrievalTokenParams, opts ...ClientOption) (*CreateRetrievalTokenOK, error)
+
+	ExportPieceIndex(params *ExportPieceIndexParams, opts ...ClientOption) (*ExportPieceIndexOK, error)
+
+	ExportPieceKeys(params *ExportPieceKeysParams, opts ...ClientOption) (*ExportPieceKeysOK, error)
+
 	GetPieceIDMetadata(params *GetPieceIDMetadataParams, opts ...ClientOption) (*GetPieceIDMetadataOK, error)
 
+	GetPieceProof(params *GetPieceProofParams, opts ...ClientOption) (*GetPieceProofOK, error)
+
 	ListPieces(params *ListPiecesParams, opts ...ClientOption) (*ListPiecesOK, error)
 
+	ListRetrievalTokens(params *ListRetrievalTokensParams, opts ...ClientOption) (*ListRetrievalTokensOK, error)
+
+	RevokeRetrievalToken(params *RevokeRetrievalTokenParams, opts ...ClientOption) (*RevokeRetrievalTokenOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -77,6 +91,158 @@ func (a *Client) AddPiece(params *AddPieceParams, opts ...ClientOption) (*AddPie
 	panic(msg)
 }
 
+/*
+AggregatePieces aggregates the small pieces of a preparation into large aggregate pieces with a data segment index
+*/
+func (a *Client) AggregatePieces(params *AggregatePiecesParams, opts ...ClientOption) (*AggregatePiecesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAggregatePiecesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "AggregatePieces",
+		Method:             "POST",
+		PathPattern:        "/preparation/{id}/piece/aggregate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AggregatePiecesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AggregatePiecesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for AggregatePieces: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+CreateRetrievalToken issues a retrieval token for the pieces of a preparation
+*/
+func (a *Client) CreateRetrievalToken(params *CreateRetrievalTokenParams, opts ...ClientOption) (*CreateRetrievalTokenOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateRetrievalTokenParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "CreateRetrievalToken",
+		Method:             "POST",
+		PathPattern:        "/preparation/{id}/retrieval-token",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CreateRetrievalTokenReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateRetrievalTokenOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for CreateRetrievalToken: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ExportPieceIndex exports the c a rv2 index of the pieces of a preparation
+*/
+func (a *Client) ExportPieceIndex(params *ExportPieceIndexParams, opts ...ClientOption) (*ExportPieceIndexOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportPieceIndexParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ExportPieceIndex",
+		Method:             "POST",
+		PathPattern:        "/preparation/{id}/piece/index",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ExportPieceIndexReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExportPieceIndexOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ExportPieceIndex: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ExportPieceKeys exports the wrapped piece keys of a preparation for key escrow
+*/
+func (a *Client) ExportPieceKeys(params *ExportPieceKeysParams, opts ...ClientOption) (*ExportPieceKeysOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportPieceKeysParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ExportPieceKeys",
+		Method:             "GET",
+		PathPattern:        "/preparation/{id}/piece-keys",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ExportPieceKeysReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExportPieceKeysOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ExportPieceKeys: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetPieceIDMetadata gets metadata for a piece
 
@@ -117,6 +283,44 @@ func (a *Client) GetPieceIDMetadata(params *GetPieceIDMetadataParams, opts ...Cl
 	panic(msg)
 }
 
+/*
+GetPieceProof gets the proof of the inclusion of an aggregated piece in its aggregate piece
+*/
+func (a *Client) GetPieceProof(params *GetPieceProofParams, opts ...ClientOption) (*GetPieceProofOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetPieceProofParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetPieceProof",
+		Method:             "GET",
+		PathPattern:        "/piece/{id}/proof",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetPieceProofReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetPieceProofOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetPieceProof: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ListPieces lists all prepared pieces for a preparation
 */
@@ -155,6 +359,82 @@ func (a *Client) ListPieces(params *ListPiecesParams, opts ...ClientOption) (*Li
 	panic(msg)
 }
 
+/*
+ListRetrievalTokens lists the retrieval tokens of a preparation
+*/
+func (a *Client) ListRetrievalTokens(params *ListRetrievalTokensParams, opts ...ClientOption) (*ListRetrievalTokensOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListRetrievalTokensParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListRetrievalTokens",
+		Method:             "GET",
+		PathPattern:        "/preparation/{id}/retrieval-token",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListRetrievalTokensReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListRetrievalTokensOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ListRetrievalTokens: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+RevokeRetrievalToken revokes a retrieval token of a preparation
+*/
+func (a *Client) RevokeRetrievalToken(params *RevokeRetrievalTokenParams, opts ...ClientOption) (*RevokeRetrievalTokenOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRevokeRetrievalTokenParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RevokeRetrievalToken",
+		Method:             "POST",
+		PathPattern:        "/preparation/{id}/retrieval-token/{token_id}/revoke",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RevokeRetrievalTokenReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RevokeRetrievalTokenOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for RevokeRetrievalToken: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package piece
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// RevokeRetrievalTokenReader is a Reader for the RevokeRetrievalToken structure.
+type RevokeRetrievalTokenReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RevokeRetrievalTokenReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRevokeRetrievalTokenOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRevokeRetrievalTokenBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRevokeRetrievalTokenNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRevokeRetrievalTokenInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /preparation/{id}/retrieval-token/{token_id}/revoke] RevokeRetrievalToken", response, response.Code())
+	}
+}
+
+// NewRevokeRetrievalTokenOK creates a RevokeRetrievalTokenOK with default headers values
+func NewRevokeRetrievalTokenOK() *RevokeRetrievalTokenOK {
+	return &RevokeRetrievalTokenOK{}
+}
+
+/*
+RevokeRetrievalTokenOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type RevokeRetrievalTokenOK struct {
+	Payload *models.ModelRetrievalToken
+}
+
+// IsSuccess returns true when this revoke retrieval token o k response has a 2xx status code
+func (o *RevokeRetrievalTokenOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this revoke retrieval token o k response has a 3xx status code
+func (o *RevokeRetrievalTokenOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this revoke retrieval token o k response has a 4xx status code
+func (o *RevokeRetrievalTokenOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this revoke retrieval token o k response has a 5xx status code
+func (o *RevokeRetrievalTokenOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this revoke retrieval token o k response a status code equal to that given
+func (o *RevokeRetrievalTokenOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the revoke retrieval token o k response
+func (o *RevokeRetrievalTokenOK) Code() int {
+	return 200
+}
+
+func (o *RevokeRetrievalTokenOK) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token/{token_id}/revoke][%d] revokeRetrievalTokenOK  %+v", 200, o.Payload)
+}
+
+func (o *RevokeRetrievalTokenOK) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token/{token_id}/revoke][%d] revokeRetrievalTokenOK  %+v", 200, o.Payload)
+}
+
+func (o *RevokeRetrievalTokenOK) GetPayload() *models.ModelRetrievalToken {
+	return o.Payload
+}
+
+func (o *RevokeRetrievalTokenOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ModelRetrievalToken)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRevokeRetrievalTokenBadRequest creates a RevokeRetrievalTokenBadRequest with default headers values
+func NewRevokeRetrievalTokenBadRequest() *RevokeRetrievalTokenBadRequest {
+	return &RevokeRetrievalTokenBadRequest{}
+}
+
+/*
+RevokeRetrievalTokenBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type RevokeRetrievalTokenBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this revoke retrieval token bad request response has a 2xx status code
+func (o *RevokeRetrievalTokenBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this revoke retrieval token bad request response has a 3xx status code
+func (o *RevokeRetrievalTokenBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this revoke retrieval token bad request response has a 4xx status code
+func (o *RevokeRetrievalTokenBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this revoke retrieval token bad request response has a 5xx status code
+func (o *RevokeRetrievalTokenBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this revoke retrieval token bad request response a status code equal to that given
+func (o *RevokeRetrievalTokenBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the revoke retrieval token bad request response
+func (o *RevokeRetrievalTokenBadRequest) Code() int {
+	return 400
+}
+
+func (o *RevokeRetrievalTokenBadRequest) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token/{token_id}/revoke][%d] revokeRetrievalTokenBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RevokeRetrievalTokenBadRequest) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token/{token_id}/revoke][%d] revokeRetrievalTokenBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RevokeRetrievalTokenBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *RevokeRetrievalTokenBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRevokeRetrievalTokenNotFound creates a RevokeRetrievalTokenNotFound with default headers values
+func NewRevokeRetrievalTokenNotFound() *RevokeRetrievalTokenNotFound {
+	return &RevokeRetrievalTokenNotFound{}
+}
+
+/*
+RevokeRetrievalTokenNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type RevokeRetrievalTokenNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this revoke retrieval token not found response has a 2xx status code
+func (o *RevokeRetrievalTokenNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this revoke retrieval token not found response has a 3xx status code
+func (o *RevokeRetrievalTokenNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this revoke retrieval token not found response has a 4xx status code
+func (o *RevokeRetrievalTokenNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this revoke retrieval token not found response has a 5xx status code
+func (o *RevokeRetrievalTokenNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this revoke retrieval token not found response a status code equal to that given
+func (o *RevokeRetrievalTokenNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the revoke retrieval token not found response
+func (o *RevokeRetrievalTokenNotFound) Code() int {
+	return 404
+}
+
+func (o *RevokeRetrievalTokenNotFound) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token/{token_id}/revoke][%d] revokeRetrievalTokenNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RevokeRetrievalTokenNotFound) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token/{token_id}/revoke][%d] revokeRetrievalTokenNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RevokeRetrievalTokenNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *RevokeRetrievalTokenNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRevokeRetrievalTokenInternalServerError creates a RevokeRetrievalTokenInternalServerError with default headers values
+func NewRevokeRetrievalTokenInternalServerError() *RevokeRetrievalTokenInternalServerError {
+	return &RevokeRetrievalTokenInternalServerError{}
+}
+
+/*
+RevokeRetrievalTokenInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type RevokeRetrievalTokenInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this revoke retrieval token internal server error response has a 2xx status code
+func (o *RevokeRetrievalTokenInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this revoke retrieval token internal server error response has a 3xx status code
+func (o *RevokeRetrievalTokenInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this revoke retrieval token internal server error response has a 4xx status code
+func (o *RevokeRetrievalTokenInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this revoke retrieval token internal server error response has a 5xx status code
+func (o *RevokeRetrievalTokenInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this revoke retrieval token internal server error response a status code equal to that given
+func (o *RevokeRetrievalTokenInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the revoke retrieval token internal server error response
+func (o *RevokeRetrievalTokenInternalServerError) Code() int {
+	return 500
+}
+
+func (o *RevokeRetrievalTokenInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token/{token_id}/revoke][%d] revokeRetrievalTokenInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RevokeRetrievalTokenInternalServerError) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/retrieval-token/{token_id}/revoke][%d] revokeRetrievalTokenInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RevokeRetrievalTokenInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *RevokeRetrievalTokenInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preparation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// DiffSourceReader is a Reader for the DiffSource structure.
+type DiffSourceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DiffSourceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDiffSourceOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewDiffSourceBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDiffSourceNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewDiffSourceInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /preparation/{id}/source/{name}/diff] DiffSource", response, response.Code())
+	}
+}
+
+// NewDiffSourceOK creates a DiffSourceOK with default headers values
+func NewDiffSourceOK() *DiffSourceOK {
+	return &DiffSourceOK{}
+}
+
+/*
+DiffSourceOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type DiffSourceOK struct {
+	Payload *models.DataprepSourceDiff
+}
+
+// IsSuccess returns true when this diff source o k response has a 2xx status code
+func (o *DiffSourceOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this diff source o k response has a 3xx status code
+func (o *DiffSourceOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this diff source o k response has a 4xx status code
+func (o *DiffSourceOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this diff source o k response has a 5xx status code
+func (o *DiffSourceOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this diff source o k response a status code equal to that given
+func (o *DiffSourceOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the diff source o k response
+func (o *DiffSourceOK) Code() int {
+	return 200
+}
+
+func (o *DiffSourceOK) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/diff][%d] diffSourceOK  %+v", 200, o.Payload)
+}
+
+func (o *DiffSourceOK) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/diff][%d] diffSourceOK  %+v", 200, o.Payload)
+}
+
+func (o *DiffSourceOK) GetPayload() *models.DataprepSourceDiff {
+	return o.Payload
+}
+
+func (o *DiffSourceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DataprepSourceDiff)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDiffSourceBadRequest creates a DiffSourceBadRequest with default headers values
+func NewDiffSourceBadRequest() *DiffSourceBadRequest {
+	return &DiffSourceBadRequest{}
+}
+
+/*
+DiffSourceBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type DiffSourceBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this diff source bad request response has a 2xx status code
+func (o *DiffSourceBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this diff source bad request response has a 3xx status code
+func (o *DiffSourceBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this diff source bad request response has a 4xx status code
+func (o *DiffSourceBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this diff source bad request response has a 5xx status code
+func (o *DiffSourceBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this diff source bad request response a status code equal to that given
+func (o *DiffSourceBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the diff source bad request response
+func (o *DiffSourceBadRequest) Code() int {
+	return 400
+}
+
+func (o *DiffSourceBadRequest) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/diff][%d] diffSourceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DiffSourceBadRequest) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/diff][%d] diffSourceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DiffSourceBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *DiffSourceBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDiffSourceNotFound creates a DiffSourceNotFound with default headers values
+func NewDiffSourceNotFound() *DiffSourceNotFound {
+	return &DiffSourceNotFound{}
+}
+
+/*
+DiffSourceNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type DiffSourceNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this diff source not found response has a 2xx status code
+func (o *DiffSourceNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this diff source not found response has a 3xx status code
+func (o *DiffSourceNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this diff source not found response has a 4xx status code
+func (o *DiffSourceNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this diff source not found response has a 5xx status code
+func (o *DiffSourceNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this diff source not found response a status code equal to that given
+func (o *DiffSourceNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the diff source not found response
+func (o *DiffSourceNotFound) Code() int {
+	return 404
+}
+
+func (o *DiffSourceNotFound) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/diff][%d] diffSourceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DiffSourceNotFound) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/diff][%d] diffSourceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DiffSourceNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *DiffSourceNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDiffSourceInternalServerError creates a DiffSourceInternalServerError with default headers values
+func NewDiffSourceInternalServerError() *DiffSourceInternalServerError {
+	return &DiffSourceInternalServerError{}
+}
+
+/*
+DiffSourceInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type DiffSourceInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this diff source internal server error response has a 2xx status code
+func (o *DiffSourceInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this diff source internal server error response has a 3xx status code
+func (o *DiffSourceInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this diff source internal server error response has a 4xx status code
+func (o *DiffSourceInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this diff source internal server error response has a 5xx status code
+func (o *DiffSourceInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this diff source internal server error response a status code equal to that given
+func (o *DiffSourceInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the diff source internal server error response
+func (o *DiffSourceInternalServerError) Code() int {
+	return 500
+}
+
+func (o *DiffSourceInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/diff][%d] diffSourceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DiffSourceInternalServerError) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/diff][%d] diffSourceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DiffSourceInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *DiffSourceInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preparation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// DumpDatabaseReader is a Reader for the DumpDatabase structure.
+type DumpDatabaseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DumpDatabaseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDumpDatabaseOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewDumpDatabaseBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDumpDatabaseNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewDumpDatabaseInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /preparation/{id}/source/{name}/dump] DumpDatabase", response, response.Code())
+	}
+}
+
+// NewDumpDatabaseOK creates a DumpDatabaseOK with default headers values
+func NewDumpDatabaseOK() *DumpDatabaseOK {
+	return &DumpDatabaseOK{}
+}
+
+/*
+DumpDatabaseOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type DumpDatabaseOK struct {
+	Payload *models.FileAppendResult
+}
+
+// IsSuccess returns true when this dump database o k response has a 2xx status code
+func (o *DumpDatabaseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this dump database o k response has a 3xx status code
+func (o *DumpDatabaseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this dump database o k response has a 4xx status code
+func (o *DumpDatabaseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this dump database o k response has a 5xx status code
+func (o *DumpDatabaseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this dump database o k response a status code equal to that given
+func (o *DumpDatabaseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the dump database o k response
+func (o *DumpDatabaseOK) Code() int {
+	return 200
+}
+
+func (o *DumpDatabaseOK) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/dump][%d] dumpDatabaseOK  %+v", 200, o.Payload)
+}
+
+func (o *DumpDatabaseOK) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/dump][%d] dumpDatabaseOK  %+v", 200, o.Payload)
+}
+
+func (o *DumpDatabaseOK) GetPayload() *models.FileAppendResult {
+	return o.Payload
+}
+
+func (o *DumpDatabaseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.FileAppendResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDumpDatabaseBadRequest creates a DumpDatabaseBadRequest with default headers values
+func NewDumpDatabaseBadRequest() *DumpDatabaseBadRequest {
+	return &DumpDatabaseBadRequest{}
+}
+
+/*
+DumpDatabaseBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type DumpDatabaseBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this dump database bad request response has a 2xx status code
+func (o *DumpDatabaseBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this dump database bad request response has a 3xx status code
+func (o *DumpDatabaseBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this dump database bad request response has a 4xx status code
+func (o *DumpDatabaseBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this dump database bad request response has a 5xx status code
+func (o *DumpDatabaseBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this dump database bad request response a status code equal to that given
+func (o *DumpDatabaseBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the dump database bad request response
+func (o *DumpDatabaseBadRequest) Code() int {
+	return 400
+}
+
+func (o *DumpDatabaseBadRequest) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/dump][%d] dumpDatabaseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DumpDatabaseBadRequest) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/dump][%d] dumpDatabaseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DumpDatabaseBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *DumpDatabaseBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDumpDatabaseNotFound creates a DumpDatabaseNotFound with default headers values
+func NewDumpDatabaseNotFound() *DumpDatabaseNotFound {
+	return &DumpDatabaseNotFound{}
+}
+
+/*
+DumpDatabaseNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type DumpDatabaseNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this dump database not found response has a 2xx status code
+func (o *DumpDatabaseNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this dump database not found response has a 3xx status code
+func (o *DumpDatabaseNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this dump database not found response has a 4xx status code
+func (o *DumpDatabaseNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this dump database not found response has a 5xx status code
+func (o *DumpDatabaseNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this dump database not found response a status code equal to that given
+func (o *DumpDatabaseNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the dump database not found response
+func (o *DumpDatabaseNotFound) Code() int {
+	return 404
+}
+
+func (o *DumpDatabaseNotFound) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/dump][%d] dumpDatabaseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DumpDatabaseNotFound) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/dump][%d] dumpDatabaseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DumpDatabaseNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *DumpDatabaseNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDumpDatabaseInternalServerError creates a DumpDatabaseInternalServerError with default headers values
+func NewDumpDatabaseInternalServerError() *DumpDatabaseInternalServerError {
+	return &DumpDatabaseInternalServerError{}
+}
+
+/*
+DumpDatabaseInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type DumpDatabaseInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this dump database internal server error response has a 2xx status code
+func (o *DumpDatabaseInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this dump database internal server error response has a 3xx status code
+func (o *DumpDatabaseInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this dump database internal server error response has a 4xx status code
+func (o *DumpDatabaseInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this dump database internal server error response has a 5xx status code
+func (o *DumpDatabaseInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this dump database internal server error response a status code equal to that given
+func (o *DumpDatabaseInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the dump database internal server error response
+func (o *DumpDatabaseInternalServerError) Code() int {
+	return 500
+}
+
+func (o *DumpDatabaseInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/dump][%d] dumpDatabaseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DumpDatabaseInternalServerError) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/source/{name}/dump][%d] dumpDatabaseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DumpDatabaseInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *DumpDatabaseInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preparation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// ExportItemKeysReader is a Reader for the ExportItemKeys structure.
+type ExportItemKeysReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ExportItemKeysReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewExportItemKeysOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewExportItemKeysBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewExportItemKeysNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewExportItemKeysInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /preparation/{id}/item-keys] ExportItemKeys", response, response.Code())
+	}
+}
+
+// NewExportItemKeysOK creates a ExportItemKeysOK with default headers values
+func NewExportItemKeysOK() *ExportItemKeysOK {
+	return &ExportItemKeysOK{}
+}
+
+/*
+ExportItemKeysOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ExportItemKeysOK struct {
+	Payload *models.DataprepItemKeyEscrow
+}
+
+// IsSuccess returns true when this export item keys o k response has a 2xx status code
+func (o *ExportItemKeysOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this export item keys o k response has a 3xx status code
+func (o *ExportItemKeysOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export item keys o k response has a 4xx status code
+func (o *ExportItemKeysOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this export item keys o k response has a 5xx status code
+func (o *ExportItemKeysOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export item keys o k response a status code equal to that given
+func (o *ExportItemKeysOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the export item keys o k response
+func (o *ExportItemKeysOK) Code() int {
+	return 200
+}
+
+func (o *ExportItemKeysOK) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/item-keys][%d] exportItemKeysOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportItemKeysOK) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/item-keys][%d] exportItemKeysOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportItemKeysOK) GetPayload() *models.DataprepItemKeyEscrow {
+	return o.Payload
+}
+
+func (o *ExportItemKeysOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DataprepItemKeyEscrow)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportItemKeysBadRequest creates a ExportItemKeysBadRequest with default headers values
+func NewExportItemKeysBadRequest() *ExportItemKeysBadRequest {
+	return &ExportItemKeysBadRequest{}
+}
+
+/*
+ExportItemKeysBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ExportItemKeysBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this export item keys bad request response has a 2xx status code
+func (o *ExportItemKeysBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export item keys bad request response has a 3xx status code
+func (o *ExportItemKeysBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export item keys bad request response has a 4xx status code
+func (o *ExportItemKeysBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this export item keys bad request response has a 5xx status code
+func (o *ExportItemKeysBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export item keys bad request response a status code equal to that given
+func (o *ExportItemKeysBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the export item keys bad request response
+func (o *ExportItemKeysBadRequest) Code() int {
+	return 400
+}
+
+func (o *ExportItemKeysBadRequest) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/item-keys][%d] exportItemKeysBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ExportItemKeysBadRequest) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/item-keys][%d] exportItemKeysBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ExportItemKeysBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ExportItemKeysBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportItemKeysNotFound creates a ExportItemKeysNotFound with default headers values
+func NewExportItemKeysNotFound() *ExportItemKeysNotFound {
+	return &ExportItemKeysNotFound{}
+}
+
+/*
+ExportItemKeysNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ExportItemKeysNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this export item keys not found response has a 2xx status code
+func (o *ExportItemKeysNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export item keys not found response has a 3xx status code
+func (o *ExportItemKeysNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export item keys not found response has a 4xx status code
+func (o *ExportItemKeysNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this export item keys not found response has a 5xx status code
+func (o *ExportItemKeysNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export item keys not found response a status code equal to that given
+func (o *ExportItemKeysNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the export item keys not found response
+func (o *ExportItemKeysNotFound) Code() int {
+	return 404
+}
+
+func (o *ExportItemKeysNotFound) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/item-keys][%d] exportItemKeysNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ExportItemKeysNotFound) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/item-keys][%d] exportItemKeysNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ExportItemKeysNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ExportItemKeysNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportItemKeysInternalServerError creates a ExportItemKeysInternalServerError with default headers values
+func NewExportItemKeysInternalServerError() *ExportItemKeysInternalServerError {
+	return &ExportItemKeysInternalServerError{}
+}
+
+/*
+ExportItemKeysInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ExportItemKeysInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this export item keys internal server error response has a 2xx status code
+func (o *ExportItemKeysInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export item keys internal server error response has a 3xx status code
+func (o *ExportItemKeysInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export item keys internal server error response has a 4xx status code
+func (o *ExportItemKeysInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this export item keys internal server error response has a 5xx status code
+func (o *ExportItemKeysInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this export item keys internal server error response a status code equal to that given
+func (o *ExportItemKeysInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the export item keys internal server error response
+func (o *ExportItemKeysInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ExportItemKeysInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /preparation/{id}/item-keys][%d] exportItemKeysInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ExportItemKeysInternalServerError) String() string {
+	return fmt.Sprintf("[GET /preparation/{id}/item-keys][%d] exportItemKeysInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ExportItemKeysInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ExportItemKeysInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -36,18 +36,40 @@ type ClientService interface {
 
 	CreatePreparation(params *CreatePreparationParams, opts ...ClientOption) (*CreatePreparationOK, error)
 
+	DiffSource(params *DiffSourceParams, opts ...ClientOption) (*DiffSourceOK, error)
+
+	DumpDatabase(params *DumpDatabaseParams, opts ...ClientOption) (*DumpDatabaseOK, error)
+
 	ExplorePreparation(params *ExplorePreparationParams, opts ...ClientOption) (*ExplorePreparationOK, error)
 
+	ExportItemKeys(params *ExportItemKeysParams, opts ...ClientOption) (*ExportItemKeysOK, error)
+
+	GetPreparationStats(params *GetPreparationStatsParams, opts ...ClientOption) (*GetPreparationStatsOK, error)
+
 	GetPreparationStatus(params *GetPreparationStatusParams, opts ...ClientOption) (*GetPreparationStatusOK, error)
 
 	ListPreparations(params *ListPreparationsParams, opts ...ClientOption) (*ListPreparationsOK, error)
 
+	PausePreparation(params *PausePreparationParams, opts ...ClientOption) (*PausePreparationOK, error)
+
 	RemoveOutputStorage(params *RemoveOutputStorageParams, opts ...ClientOption) (*RemoveOutputStorageOK, error)
 
 	RemovePreparation(params *RemovePreparationParams, opts ...ClientOption) (*RemovePreparationNoContent, error)
 
 	RenamePreparation(params *RenamePreparationParams, opts ...ClientOption) (*RenamePreparationOK, error)
 
+	ResumePreparation(params *ResumePreparationParams, opts ...ClientOption) (*ResumePreparationOK, error)
+
+	RotateRecipients(params *RotateRecipientsParams, opts ...ClientOption) (*RotateRecipientsOK, error)
+
+	ShareStats(params *ShareStatsParams, opts ...ClientOption) (*ShareStatsOK, error)
+
+	UnshareStats(params *UnshareStatsParams, opts ...ClientOption) (*UnshareStatsOK, error)
+
+	UpdateSource(params *UpdateSourceParams, opts ...ClientOption) (*UpdateSourceOK, error)
+
+	VerifySources(params *VerifySourcesParams, opts ...ClientOption) (*VerifySourcesOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -165,6 +187,82 @@ func (a *Client) CreatePreparation(params *CreatePreparationParams, opts ...Clie
 	panic(msg)
 }
 
+/*
+DiffSource compares the current state of a source storage against what has been prepared from it
+*/
+func (a *Client) DiffSource(params *DiffSourceParams, opts ...ClientOption) (*DiffSourceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDiffSourceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "DiffSource",
+		Method:             "POST",
+		PathPattern:        "/preparation/{id}/source/{name}/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DiffSourceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DiffSourceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DiffSource: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DumpDatabase snapshots a postgre SQL or my SQL database into a source and queue it for packing
+*/
+func (a *Client) DumpDatabase(params *DumpDatabaseParams, opts ...ClientOption) (*DumpDatabaseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDumpDatabaseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "DumpDatabase",
+		Method:             "POST",
+		PathPattern:        "/preparation/{id}/source/{name}/dump",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DumpDatabaseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DumpDatabaseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DumpDatabase: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ExplorePreparation explores a directory in a prepared source storage
 */
@@ -203,6 +301,82 @@ func (a *Client) ExplorePreparation(params *ExplorePreparationParams, opts ...Cl
 	panic(msg)
 }
 
+/*
+ExportItemKeys exports the wrapped data keys of the files of a preparation encrypted with a k m s key
+*/
+func (a *Client) ExportItemKeys(params *ExportItemKeysParams, opts ...ClientOption) (*ExportItemKeysOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportItemKeysParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ExportItemKeys",
+		Method:             "GET",
+		PathPattern:        "/preparation/{id}/item-keys",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ExportItemKeysReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExportItemKeysOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ExportItemKeys: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetPreparationStats gets the stats of a preparation
+*/
+func (a *Client) GetPreparationStats(params *GetPreparationStatsParams, opts ...ClientOption) (*GetPreparationStatsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetPreparationStatsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetPreparationStats",
+		Method:             "GET",
+		PathPattern:        "/preparation/{id}/stats",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetPreparationStatsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetPreparationStatsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetPreparationStats: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetPreparationStatus gets the status of a preparation
 */
@@ -279,6 +453,44 @@ func (a *Client) ListPreparations(params *ListPreparationsParams, opts ...Client
 	panic(msg)
 }
 
+/*
+PausePreparation pauses the scanning and packing of a preparation
+*/
+func (a *Client) PausePreparation(params *PausePreparationParams, opts ...ClientOption) (*PausePreparationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPausePreparationParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PausePreparation",
+		Method:             "POST",
+		PathPattern:        "/preparation/{name}/pause",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PausePreparationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PausePreparationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PausePreparation: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 RemoveOutputStorage detaches an output storage from a preparation
 */
@@ -393,6 +605,234 @@ func (a *Client) RenamePreparation(params *RenamePreparationParams, opts ...Clie
 	panic(msg)
 }
 
+/*
+ResumePreparation resumes the scanning and packing of a paused preparation
+*/
+func (a *Client) ResumePreparation(params *ResumePreparationParams, opts ...ClientOption) (*ResumePreparationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewResumePreparationParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ResumePreparation",
+		Method:             "POST",
+		PathPattern:        "/preparation/{name}/resume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ResumePreparationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ResumePreparationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ResumePreparation: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+RotateRecipients rotates the age recipients that the files of a preparation are encrypted for
+*/
+func (a *Client) RotateRecipients(params *RotateRecipientsParams, opts ...ClientOption) (*RotateRecipientsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRotateRecipientsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RotateRecipients",
+		Method:             "PUT",
+		PathPattern:        "/preparation/{id}/recipients",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RotateRecipientsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RotateRecipientsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for RotateRecipients: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ShareStats shares the stats of a preparation publicly
+*/
+func (a *Client) ShareStats(params *ShareStatsParams, opts ...ClientOption) (*ShareStatsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewShareStatsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ShareStats",
+		Method:             "POST",
+		PathPattern:        "/preparation/{id}/stats/share",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ShareStatsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ShareStatsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ShareStats: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+UnshareStats stops sharing the stats of a preparation publicly
+*/
+func (a *Client) UnshareStats(params *UnshareStatsParams, opts ...ClientOption) (*UnshareStatsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUnshareStatsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "UnshareStats",
+		Method:             "DELETE",
+		PathPattern:        "/preparation/{id}/stats/share",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UnshareStatsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UnshareStatsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for UnshareStats: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+UpdateSource updates the settings of a source storage attached to a preparation
+*/
+func (a *Client) UpdateSource(params *UpdateSourceParams, opts ...ClientOption) (*UpdateSourceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateSourceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "UpdateSource",
+		Method:             "PATCH",
+		PathPattern:        "/preparation/{id}/source/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateSourceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateSourceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for UpdateSource: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+VerifySources verifies and record the object lock retention mode of the source storages of a preparation
+*/
+func (a *Client) VerifySources(params *VerifySourcesParams, opts ...ClientOption) (*VerifySourcesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewVerifySourcesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "VerifySources",
+		Method:             "POST",
+		PathPattern:        "/preparation/{id}/verify-sources",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &VerifySourcesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*VerifySourcesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for VerifySources: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preparation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// RotateRecipientsReader is a Reader for the RotateRecipients structure.
+type RotateRecipientsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RotateRecipientsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRotateRecipientsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRotateRecipientsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRotateRecipientsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRotateRecipientsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PUT /preparation/{id}/recipients] RotateRecipients", response, response.Code())
+	}
+}
+
+// NewRotateRecipientsOK creates a RotateRecipientsOK with default headers values
+func NewRotateRecipientsOK() *RotateRecipientsOK {
+	return &RotateRecipientsOK{}
+}
+
+/*
+RotateRecipientsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type RotateRecipientsOK struct {
+	Payload *models.ModelPreparation
+}
+
+// IsSuccess returns true when this rotate recipients o k response has a 2xx status code
+func (o *RotateRecipientsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this rotate recipients o k response has a 3xx status code
+func (o *RotateRecipientsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rotate recipients o k response has a 4xx status code
+func (o *RotateRecipientsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this rotate recipients o k response has a 5xx status code
+func (o *RotateRecipientsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rotate recipients o k response a status code equal to that given
+func (o *RotateRecipientsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the rotate recipients o k response
+func (o *RotateRecipientsOK) Code() int {
+	return 200
+}
+
+func (o *RotateRecipientsOK) Error() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/recipients][%d] rotateRecipientsOK  %+v", 200, o.Payload)
+}
+
+func (o *RotateRecipientsOK) String() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/recipients][%d] rotateRecipientsOK  %+v", 200, o.Payload)
+}
+
+func (o *RotateRecipientsOK) GetPayload() *models.ModelPreparation {
+	return o.Payload
+}
+
+func (o *RotateRecipientsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ModelPreparation)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRotateRecipientsBadRequest creates a RotateRecipientsBadRequest with default headers values
+func NewRotateRecipientsBadRequest() *RotateRecipientsBadRequest {
+	return &RotateRecipientsBadRequest{}
+}
+
+/*
+RotateRecipientsBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type RotateRecipientsBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this rotate recipients bad request response has a 2xx status code
+func (o *RotateRecipientsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this rotate recipients bad request response has a 3xx status code
+func (o *RotateRecipientsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rotate recipients bad request response has a 4xx status code
+func (o *RotateRecipientsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this rotate recipients bad request response has a 5xx status code
+func (o *RotateRecipientsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rotate recipients bad request response a status code equal to that given
+func (o *RotateRecipientsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the rotate recipients bad request response
+func (o *RotateRecipientsBadRequest) Code() int {
+	return 400
+}
+
+func (o *RotateRecipientsBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/recipients][%d] rotateRecipientsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RotateRecipientsBadRequest) String() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/recipients][%d] rotateRecipientsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RotateRecipientsBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *RotateRecipientsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRotateRecipientsNotFound creates a RotateRecipientsNotFound with default headers values
+func NewRotateRecipientsNotFound() *RotateRecipientsNotFound {
+	return &RotateRecipientsNotFound{}
+}
+
+/*
+RotateRecipientsNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type RotateRecipientsNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this rotate recipients not found response has a 2xx status code
+func (o *RotateRecipientsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this rotate recipients not found response has a 3xx status code
+func (o *RotateRecipientsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rotate recipients not found response has a 4xx status code
+func (o *RotateRecipientsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this rotate recipients not found response has a 5xx status code
+func (o *RotateRecipientsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rotate recipients not found response a status code equal to that given
+func (o *RotateRecipientsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the rotate recipients not found response
+func (o *RotateRecipientsNotFound) Code() int {
+	return 404
+}
+
+func (o *RotateRecipientsNotFound) Error() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/recipients][%d] rotateRecipientsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RotateRecipientsNotFound) String() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/recipients][%d] rotateRecipientsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RotateRecipientsNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *RotateRecipientsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRotateRecipientsInternalServerError creates a RotateRecipientsInternalServerError with default headers values
+func NewRotateRecipientsInternalServerError() *RotateRecipientsInternalServerError {
+	return &RotateRecipientsInternalServerError{}
+}
+
+/*
+RotateRecipientsInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type RotateRecipientsInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this rotate recipients internal server error response has a 2xx status code
+func (o *RotateRecipientsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this rotate recipients internal server error response has a 3xx status code
+func (o *RotateRecipientsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rotate recipients internal server error response has a 4xx status code
+func (o *RotateRecipientsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this rotate recipients internal server error response has a 5xx status code
+func (o *RotateRecipientsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this rotate recipients internal server error response a status code equal to that given
+func (o *RotateRecipientsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the rotate recipients internal server error response
+func (o *RotateRecipientsInternalServerError) Code() int {
+	return 500
+}
+
+func (o *RotateRecipientsInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/recipients][%d] rotateRecipientsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RotateRecipientsInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /preparation/{id}/recipients][%d] rotateRecipientsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RotateRecipientsInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *RotateRecipientsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
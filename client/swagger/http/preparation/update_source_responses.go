@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preparation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// UpdateSourceReader is a Reader for the UpdateSource structure.
+type UpdateSourceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateSourceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateSourceOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewUpdateSourceBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUpdateSourceNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewUpdateSourceInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PATCH /preparation/{id}/source/{name}] UpdateSource", response, response.Code())
+	}
+}
+
+// NewUpdateSourceOK creates a UpdateSourceOK with default headers values
+func NewUpdateSourceOK() *UpdateSourceOK {
+	return &UpdateSourceOK{}
+}
+
+/*
+UpdateSourceOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type UpdateSourceOK struct {
+	Payload *models.ModelSourceAttachment
+}
+
+// IsSuccess returns true when this update source o k response has a 2xx status code
+func (o *UpdateSourceOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update source o k response has a 3xx status code
+func (o *UpdateSourceOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update source o k response has a 4xx status code
+func (o *UpdateSourceOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update source o k response has a 5xx status code
+func (o *UpdateSourceOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update source o k response a status code equal to that given
+func (o *UpdateSourceOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the update source o k response
+func (o *UpdateSourceOK) Code() int {
+	return 200
+}
+
+func (o *UpdateSourceOK) Error() string {
+	return fmt.Sprintf("[PATCH /preparation/{id}/source/{name}][%d] updateSourceOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateSourceOK) String() string {
+	return fmt.Sprintf("[PATCH /preparation/{id}/source/{name}][%d] updateSourceOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateSourceOK) GetPayload() *models.ModelSourceAttachment {
+	return o.Payload
+}
+
+func (o *UpdateSourceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ModelSourceAttachment)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateSourceBadRequest creates a UpdateSourceBadRequest with default headers values
+func NewUpdateSourceBadRequest() *UpdateSourceBadRequest {
+	return &UpdateSourceBadRequest{}
+}
+
+/*
+UpdateSourceBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type UpdateSourceBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this update source bad request response has a 2xx status code
+func (o *UpdateSourceBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update source bad request response has a 3xx status code
+func (o *UpdateSourceBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update source bad request response has a 4xx status code
+func (o *UpdateSourceBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update source bad request response has a 5xx status code
+func (o *UpdateSourceBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update source bad request response a status code equal to that given
+func (o *UpdateSourceBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the update source bad request response
+func (o *UpdateSourceBadRequest) Code() int {
+	return 400
+}
+
+func (o *UpdateSourceBadRequest) Error() string {
+	return fmt.Sprintf("[PATCH /preparation/{id}/source/{name}][%d] updateSourceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateSourceBadRequest) String() string {
+	return fmt.Sprintf("[PATCH /preparation/{id}/source/{name}][%d] updateSourceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateSourceBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *UpdateSourceBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateSourceNotFound creates a UpdateSourceNotFound with default headers values
+func NewUpdateSourceNotFound() *UpdateSourceNotFound {
+	return &UpdateSourceNotFound{}
+}
+
+/*
+UpdateSourceNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type UpdateSourceNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this update source not found response has a 2xx status code
+func (o *UpdateSourceNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update source not found response has a 3xx status code
+func (o *UpdateSourceNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update source not found response has a 4xx status code
+func (o *UpdateSourceNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update source not found response has a 5xx status code
+func (o *UpdateSourceNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update source not found response a status code equal to that given
+func (o *UpdateSourceNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the update source not found response
+func (o *UpdateSourceNotFound) Code() int {
+	return 404
+}
+
+func (o *UpdateSourceNotFound) Error() string {
+	return fmt.Sprintf("[PATCH /preparation/{id}/source/{name}][%d] updateSourceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateSourceNotFound) String() string {
+	return fmt.Sprintf("[PATCH /preparation/{id}/source/{name}][%d] updateSourceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateSourceNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *UpdateSourceNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateSourceInternalServerError creates a UpdateSourceInternalServerError with default headers values
+func NewUpdateSourceInternalServerError() *UpdateSourceInternalServerError {
+	return &UpdateSourceInternalServerError{}
+}
+
+/*
+UpdateSourceInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type UpdateSourceInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this update source internal server error response has a 2xx status code
+func (o *UpdateSourceInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update source internal server error response has a 3xx status code
+func (o *UpdateSourceInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update source internal server error response has a 4xx status code
+func (o *UpdateSourceInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update source internal server error response has a 5xx status code
+func (o *UpdateSourceInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this update source internal server error response a status code equal to that given
+func (o *UpdateSourceInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the update source internal server error response
+func (o *UpdateSourceInternalServerError) Code() int {
+	return 500
+}
+
+func (o *UpdateSourceInternalServerError) Error() string {
+	return fmt.Sprintf("[PATCH /preparation/{id}/source/{name}][%d] updateSourceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UpdateSourceInternalServerError) String() string {
+	return fmt.Sprintf("[PATCH /preparation/{id}/source/{name}][%d] updateSourceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UpdateSourceInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *UpdateSourceInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
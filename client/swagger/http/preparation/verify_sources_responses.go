@@ -0,0 +1,323 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preparation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// VerifySourcesReader is a Reader for the VerifySources structure.
+type VerifySourcesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *VerifySourcesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewVerifySourcesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewVerifySourcesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewVerifySourcesNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewVerifySourcesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /preparation/{id}/verify-sources] VerifySources", response, response.Code())
+	}
+}
+
+// NewVerifySourcesOK creates a VerifySourcesOK with default headers values
+func NewVerifySourcesOK() *VerifySourcesOK {
+	return &VerifySourcesOK{}
+}
+
+/*
+VerifySourcesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type VerifySourcesOK struct {
+	Payload []*models.ModelSourceAttachment
+}
+
+// IsSuccess returns true when this verify sources o k response has a 2xx status code
+func (o *VerifySourcesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this verify sources o k response has a 3xx status code
+func (o *VerifySourcesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this verify sources o k response has a 4xx status code
+func (o *VerifySourcesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this verify sources o k response has a 5xx status code
+func (o *VerifySourcesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this verify sources o k response a status code equal to that given
+func (o *VerifySourcesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the verify sources o k response
+func (o *VerifySourcesOK) Code() int {
+	return 200
+}
+
+func (o *VerifySourcesOK) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/verify-sources][%d] verifySourcesOK  %+v", 200, o.Payload)
+}
+
+func (o *VerifySourcesOK) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/verify-sources][%d] verifySourcesOK  %+v", 200, o.Payload)
+}
+
+func (o *VerifySourcesOK) GetPayload() []*models.ModelSourceAttachment {
+	return o.Payload
+}
+
+func (o *VerifySourcesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewVerifySourcesBadRequest creates a VerifySourcesBadRequest with default headers values
+func NewVerifySourcesBadRequest() *VerifySourcesBadRequest {
+	return &VerifySourcesBadRequest{}
+}
+
+/*
+VerifySourcesBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type VerifySourcesBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this verify sources bad request response has a 2xx status code
+func (o *VerifySourcesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this verify sources bad request response has a 3xx status code
+func (o *VerifySourcesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this verify sources bad request response has a 4xx status code
+func (o *VerifySourcesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this verify sources bad request response has a 5xx status code
+func (o *VerifySourcesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this verify sources bad request response a status code equal to that given
+func (o *VerifySourcesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the verify sources bad request response
+func (o *VerifySourcesBadRequest) Code() int {
+	return 400
+}
+
+func (o *VerifySourcesBadRequest) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/verify-sources][%d] verifySourcesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *VerifySourcesBadRequest) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/verify-sources][%d] verifySourcesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *VerifySourcesBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *VerifySourcesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewVerifySourcesNotFound creates a VerifySourcesNotFound with default headers values
+func NewVerifySourcesNotFound() *VerifySourcesNotFound {
+	return &VerifySourcesNotFound{}
+}
+
+/*
+VerifySourcesNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type VerifySourcesNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this verify sources not found response has a 2xx status code
+func (o *VerifySourcesNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this verify sources not found response has a 3xx status code
+func (o *VerifySourcesNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this verify sources not found response has a 4xx status code
+func (o *VerifySourcesNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this verify sources not found response has a 5xx status code
+func (o *VerifySourcesNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this verify sources not found response a status code equal to that given
+func (o *VerifySourcesNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the verify sources not found response
+func (o *VerifySourcesNotFound) Code() int {
+	return 404
+}
+
+func (o *VerifySourcesNotFound) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/verify-sources][%d] verifySourcesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *VerifySourcesNotFound) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/verify-sources][%d] verifySourcesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *VerifySourcesNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *VerifySourcesNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewVerifySourcesInternalServerError creates a VerifySourcesInternalServerError with default headers values
+func NewVerifySourcesInternalServerError() *VerifySourcesInternalServerError {
+	return &VerifySourcesInternalServerError{}
+}
+
+/*
+VerifySourcesInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type VerifySourcesInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this verify sources internal server error response has a 2xx status code
+func (o *VerifySourcesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this verify sources internal server error response has a 3xx status code
+func (o *VerifySourcesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this verify sources internal server error response has a 4xx status code
+func (o *VerifySourcesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this verify sources internal server error response has a 5xx status code
+func (o *VerifySourcesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this verify sources internal server error response a status code equal to that given
+func (o *VerifySourcesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the verify sources internal server error response
+func (o *VerifySourcesInternalServerError) Code() int {
+	return 500
+}
+
+func (o *VerifySourcesInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /preparation/{id}/verify-sources][%d] verifySourcesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *VerifySourcesInternalServerError) String() string {
+	return fmt.Sprintf("[POST /preparation/{id}/verify-sources][%d] verifySourcesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *VerifySourcesInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *VerifySourcesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
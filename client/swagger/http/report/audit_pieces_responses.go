@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// AuditPiecesReader is a Reader for the AuditPieces structure.
+type AuditPiecesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuditPiecesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAuditPiecesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewAuditPiecesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuditPiecesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /report/audit] AuditPieces", response, response.Code())
+	}
+}
+
+// NewAuditPiecesOK creates a AuditPiecesOK with default headers values
+func NewAuditPiecesOK() *AuditPiecesOK {
+	return &AuditPiecesOK{}
+}
+
+/*
+AuditPiecesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type AuditPiecesOK struct {
+	Payload *models.ReportAuditReport
+}
+
+// IsSuccess returns true when this audit pieces o k response has a 2xx status code
+func (o *AuditPiecesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this audit pieces o k response has a 3xx status code
+func (o *AuditPiecesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this audit pieces o k response has a 4xx status code
+func (o *AuditPiecesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this audit pieces o k response has a 5xx status code
+func (o *AuditPiecesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this audit pieces o k response a status code equal to that given
+func (o *AuditPiecesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the audit pieces o k response
+func (o *AuditPiecesOK) Code() int {
+	return 200
+}
+
+func (o *AuditPiecesOK) Error() string {
+	return fmt.Sprintf("[POST /report/audit][%d] auditPiecesOK  %+v", 200, o.Payload)
+}
+
+func (o *AuditPiecesOK) String() string {
+	return fmt.Sprintf("[POST /report/audit][%d] auditPiecesOK  %+v", 200, o.Payload)
+}
+
+func (o *AuditPiecesOK) GetPayload() *models.ReportAuditReport {
+	return o.Payload
+}
+
+func (o *AuditPiecesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReportAuditReport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuditPiecesBadRequest creates a AuditPiecesBadRequest with default headers values
+func NewAuditPiecesBadRequest() *AuditPiecesBadRequest {
+	return &AuditPiecesBadRequest{}
+}
+
+/*
+AuditPiecesBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type AuditPiecesBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this audit pieces bad request response has a 2xx status code
+func (o *AuditPiecesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this audit pieces bad request response has a 3xx status code
+func (o *AuditPiecesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this audit pieces bad request response has a 4xx status code
+func (o *AuditPiecesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this audit pieces bad request response has a 5xx status code
+func (o *AuditPiecesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this audit pieces bad request response a status code equal to that given
+func (o *AuditPiecesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the audit pieces bad request response
+func (o *AuditPiecesBadRequest) Code() int {
+	return 400
+}
+
+func (o *AuditPiecesBadRequest) Error() string {
+	return fmt.Sprintf("[POST /report/audit][%d] auditPiecesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *AuditPiecesBadRequest) String() string {
+	return fmt.Sprintf("[POST /report/audit][%d] auditPiecesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *AuditPiecesBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *AuditPiecesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuditPiecesInternalServerError creates a AuditPiecesInternalServerError with default headers values
+func NewAuditPiecesInternalServerError() *AuditPiecesInternalServerError {
+	return &AuditPiecesInternalServerError{}
+}
+
+/*
+AuditPiecesInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type AuditPiecesInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this audit pieces internal server error response has a 2xx status code
+func (o *AuditPiecesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this audit pieces internal server error response has a 3xx status code
+func (o *AuditPiecesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this audit pieces internal server error response has a 4xx status code
+func (o *AuditPiecesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this audit pieces internal server error response has a 5xx status code
+func (o *AuditPiecesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this audit pieces internal server error response a status code equal to that given
+func (o *AuditPiecesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the audit pieces internal server error response
+func (o *AuditPiecesInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuditPiecesInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /report/audit][%d] auditPiecesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuditPiecesInternalServerError) String() string {
+	return fmt.Sprintf("[POST /report/audit][%d] auditPiecesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuditPiecesInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *AuditPiecesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
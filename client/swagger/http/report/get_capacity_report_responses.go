@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// GetCapacityReportReader is a Reader for the GetCapacityReport structure.
+type GetCapacityReportReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetCapacityReportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetCapacityReportOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetCapacityReportBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetCapacityReportInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /report/capacity] GetCapacityReport", response, response.Code())
+	}
+}
+
+// NewGetCapacityReportOK creates a GetCapacityReportOK with default headers values
+func NewGetCapacityReportOK() *GetCapacityReportOK {
+	return &GetCapacityReportOK{}
+}
+
+/*
+GetCapacityReportOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetCapacityReportOK struct {
+	Payload []*models.ReportCapacityReport
+}
+
+// IsSuccess returns true when this get capacity report o k response has a 2xx status code
+func (o *GetCapacityReportOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get capacity report o k response has a 3xx status code
+func (o *GetCapacityReportOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get capacity report o k response has a 4xx status code
+func (o *GetCapacityReportOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get capacity report o k response has a 5xx status code
+func (o *GetCapacityReportOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get capacity report o k response a status code equal to that given
+func (o *GetCapacityReportOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get capacity report o k response
+func (o *GetCapacityReportOK) Code() int {
+	return 200
+}
+
+func (o *GetCapacityReportOK) Error() string {
+	return fmt.Sprintf("[POST /report/capacity][%d] getCapacityReportOK  %+v", 200, o.Payload)
+}
+
+func (o *GetCapacityReportOK) String() string {
+	return fmt.Sprintf("[POST /report/capacity][%d] getCapacityReportOK  %+v", 200, o.Payload)
+}
+
+func (o *GetCapacityReportOK) GetPayload() []*models.ReportCapacityReport {
+	return o.Payload
+}
+
+func (o *GetCapacityReportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetCapacityReportBadRequest creates a GetCapacityReportBadRequest with default headers values
+func NewGetCapacityReportBadRequest() *GetCapacityReportBadRequest {
+	return &GetCapacityReportBadRequest{}
+}
+
+/*
+GetCapacityReportBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type GetCapacityReportBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get capacity report bad request response has a 2xx status code
+func (o *GetCapacityReportBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get capacity report bad request response has a 3xx status code
+func (o *GetCapacityReportBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get capacity report bad request response has a 4xx status code
+func (o *GetCapacityReportBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get capacity report bad request response has a 5xx status code
+func (o *GetCapacityReportBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get capacity report bad request response a status code equal to that given
+func (o *GetCapacityReportBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get capacity report bad request response
+func (o *GetCapacityReportBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetCapacityReportBadRequest) Error() string {
+	return fmt.Sprintf("[POST /report/capacity][%d] getCapacityReportBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetCapacityReportBadRequest) String() string {
+	return fmt.Sprintf("[POST /report/capacity][%d] getCapacityReportBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetCapacityReportBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetCapacityReportBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetCapacityReportInternalServerError creates a GetCapacityReportInternalServerError with default headers values
+func NewGetCapacityReportInternalServerError() *GetCapacityReportInternalServerError {
+	return &GetCapacityReportInternalServerError{}
+}
+
+/*
+GetCapacityReportInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type GetCapacityReportInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get capacity report internal server error response has a 2xx status code
+func (o *GetCapacityReportInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get capacity report internal server error response has a 3xx status code
+func (o *GetCapacityReportInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get capacity report internal server error response has a 4xx status code
+func (o *GetCapacityReportInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get capacity report internal server error response has a 5xx status code
+func (o *GetCapacityReportInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get capacity report internal server error response a status code equal to that given
+func (o *GetCapacityReportInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the get capacity report internal server error response
+func (o *GetCapacityReportInternalServerError) Code() int {
+	return 500
+}
+
+func (o *GetCapacityReportInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /report/capacity][%d] getCapacityReportInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetCapacityReportInternalServerError) String() string {
+	return fmt.Sprintf("[POST /report/capacity][%d] getCapacityReportInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetCapacityReportInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetCapacityReportInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,323 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// GetLineageReportReader is a Reader for the GetLineageReport structure.
+type GetLineageReportReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetLineageReportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetLineageReportOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetLineageReportBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetLineageReportNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetLineageReportInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /report/lineage] GetLineageReport", response, response.Code())
+	}
+}
+
+// NewGetLineageReportOK creates a GetLineageReportOK with default headers values
+func NewGetLineageReportOK() *GetLineageReportOK {
+	return &GetLineageReportOK{}
+}
+
+/*
+GetLineageReportOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetLineageReportOK struct {
+	Payload []*models.ReportLineageEntry
+}
+
+// IsSuccess returns true when this get lineage report o k response has a 2xx status code
+func (o *GetLineageReportOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get lineage report o k response has a 3xx status code
+func (o *GetLineageReportOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get lineage report o k response has a 4xx status code
+func (o *GetLineageReportOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get lineage report o k response has a 5xx status code
+func (o *GetLineageReportOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get lineage report o k response a status code equal to that given
+func (o *GetLineageReportOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get lineage report o k response
+func (o *GetLineageReportOK) Code() int {
+	return 200
+}
+
+func (o *GetLineageReportOK) Error() string {
+	return fmt.Sprintf("[POST /report/lineage][%d] getLineageReportOK  %+v", 200, o.Payload)
+}
+
+func (o *GetLineageReportOK) String() string {
+	return fmt.Sprintf("[POST /report/lineage][%d] getLineageReportOK  %+v", 200, o.Payload)
+}
+
+func (o *GetLineageReportOK) GetPayload() []*models.ReportLineageEntry {
+	return o.Payload
+}
+
+func (o *GetLineageReportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetLineageReportBadRequest creates a GetLineageReportBadRequest with default headers values
+func NewGetLineageReportBadRequest() *GetLineageReportBadRequest {
+	return &GetLineageReportBadRequest{}
+}
+
+/*
+GetLineageReportBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type GetLineageReportBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get lineage report bad request response has a 2xx status code
+func (o *GetLineageReportBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get lineage report bad request response has a 3xx status code
+func (o *GetLineageReportBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get lineage report bad request response has a 4xx status code
+func (o *GetLineageReportBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get lineage report bad request response has a 5xx status code
+func (o *GetLineageReportBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get lineage report bad request response a status code equal to that given
+func (o *GetLineageReportBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get lineage report bad request response
+func (o *GetLineageReportBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetLineageReportBadRequest) Error() string {
+	return fmt.Sprintf("[POST /report/lineage][%d] getLineageReportBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetLineageReportBadRequest) String() string {
+	return fmt.Sprintf("[POST /report/lineage][%d] getLineageReportBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetLineageReportBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetLineageReportBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetLineageReportNotFound creates a GetLineageReportNotFound with default headers values
+func NewGetLineageReportNotFound() *GetLineageReportNotFound {
+	return &GetLineageReportNotFound{}
+}
+
+/*
+GetLineageReportNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type GetLineageReportNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get lineage report not found response has a 2xx status code
+func (o *GetLineageReportNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get lineage report not found response has a 3xx status code
+func (o *GetLineageReportNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get lineage report not found response has a 4xx status code
+func (o *GetLineageReportNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get lineage report not found response has a 5xx status code
+func (o *GetLineageReportNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get lineage report not found response a status code equal to that given
+func (o *GetLineageReportNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get lineage report not found response
+func (o *GetLineageReportNotFound) Code() int {
+	return 404
+}
+
+func (o *GetLineageReportNotFound) Error() string {
+	return fmt.Sprintf("[POST /report/lineage][%d] getLineageReportNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetLineageReportNotFound) String() string {
+	return fmt.Sprintf("[POST /report/lineage][%d] getLineageReportNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetLineageReportNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetLineageReportNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetLineageReportInternalServerError creates a GetLineageReportInternalServerError with default headers values
+func NewGetLineageReportInternalServerError() *GetLineageReportInternalServerError {
+	return &GetLineageReportInternalServerError{}
+}
+
+/*
+GetLineageReportInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type GetLineageReportInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get lineage report internal server error response has a 2xx status code
+func (o *GetLineageReportInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get lineage report internal server error response has a 3xx status code
+func (o *GetLineageReportInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get lineage report internal server error response has a 4xx status code
+func (o *GetLineageReportInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get lineage report internal server error response has a 5xx status code
+func (o *GetLineageReportInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get lineage report internal server error response a status code equal to that given
+func (o *GetLineageReportInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the get lineage report internal server error response
+func (o *GetLineageReportInternalServerError) Code() int {
+	return 500
+}
+
+func (o *GetLineageReportInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /report/lineage][%d] getLineageReportInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetLineageReportInternalServerError) String() string {
+	return fmt.Sprintf("[POST /report/lineage][%d] getLineageReportInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetLineageReportInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetLineageReportInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
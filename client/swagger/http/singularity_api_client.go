@@ -13,13 +13,16 @@ import (
 	"github.com/data-preservation-programs/singularity/client/swagger/http/admin"
 	"github.com/data-preservation-programs/singularity/client/swagger/http/deal"
 	"github.com/data-preservation-programs/singularity/client/swagger/http/deal_schedule"
+	"github.com/data-preservation-programs/singularity/client/swagger/http/export"
 	"github.com/data-preservation-programs/singularity/client/swagger/http/file"
 	"github.com/data-preservation-programs/singularity/client/swagger/http/job"
 	"github.com/data-preservation-programs/singularity/client/swagger/http/piece"
 	"github.com/data-preservation-programs/singularity/client/swagger/http/preparation"
+	"github.com/data-preservation-programs/singularity/client/swagger/http/report"
 	"github.com/data-preservation-programs/singularity/client/swagger/http/storage"
 	"github.com/data-preservation-programs/singularity/client/swagger/http/wallet"
 	"github.com/data-preservation-programs/singularity/client/swagger/http/wallet_association"
+	"github.com/data-preservation-programs/singularity/client/swagger/http/worker"
 )
 
 // Default singularity API HTTP client.
@@ -67,13 +70,16 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Singularit
 	cli.Admin = admin.New(transport, formats)
 	cli.Deal = deal.New(transport, formats)
 	cli.DealSchedule = deal_schedule.New(transport, formats)
+	cli.Export = export.New(transport, formats)
 	cli.File = file.New(transport, formats)
 	cli.Job = job.New(transport, formats)
 	cli.Piece = piece.New(transport, formats)
 	cli.Preparation = preparation.New(transport, formats)
+	cli.Report = report.New(transport, formats)
 	cli.Storage = storage.New(transport, formats)
 	cli.Wallet = wallet.New(transport, formats)
 	cli.WalletAssociation = wallet_association.New(transport, formats)
+	cli.Worker = worker.New(transport, formats)
 	return cli
 }
 
@@ -124,6 +130,8 @@ type SingularityAPI struct {
 
 	DealSchedule deal_schedule.ClientService
 
+	Export export.ClientService
+
 	File file.ClientService
 
 	Job job.ClientService
@@ -132,12 +140,16 @@ type SingularityAPI struct {
 
 	Preparation preparation.ClientService
 
+	Report report.ClientService
+
 	Storage storage.ClientService
 
 	Wallet wallet.ClientService
 
 	WalletAssociation wallet_association.ClientService
 
+	Worker worker.ClientService
+
 	Transport runtime.ClientTransport
 }
 
@@ -147,11 +159,14 @@ func (c *SingularityAPI) SetTransport(transport runtime.ClientTransport) {
 	c.Admin.SetTransport(transport)
 	c.Deal.SetTransport(transport)
 	c.DealSchedule.SetTransport(transport)
+	c.Export.SetTransport(transport)
 	c.File.SetTransport(transport)
 	c.Job.SetTransport(transport)
 	c.Piece.SetTransport(transport)
 	c.Preparation.SetTransport(transport)
+	c.Report.SetTransport(transport)
 	c.Storage.SetTransport(transport)
 	c.Wallet.SetTransport(transport)
 	c.WalletAssociation.SetTransport(transport)
+	c.Worker.SetTransport(transport)
 }
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateAcdStorageReader is a Reader for the CreateAcdStorage structure.
-type CreateAcdStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateAcdStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateAcdStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateAcdStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateAcdStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/acd] CreateAcdStorage", response, response.Code())
-	}
-}
-
-// NewCreateAcdStorageOK creates a CreateAcdStorageOK with default headers values
-func NewCreateAcdStorageOK() *CreateAcdStorageOK {
-	return &CreateAcdStorageOK{}
-}
-
-/*
-CreateAcdStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateAcdStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create acd storage o k response has a 2xx status code
-func (o *CreateAcdStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create acd storage o k response has a 3xx status code
-func (o *CreateAcdStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create acd storage o k response has a 4xx status code
-func (o *CreateAcdStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create acd storage o k response has a 5xx status code
-func (o *CreateAcdStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create acd storage o k response a status code equal to that given
-func (o *CreateAcdStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create acd storage o k response
-func (o *CreateAcdStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateAcdStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/acd][%d] createAcdStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateAcdStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/acd][%d] createAcdStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateAcdStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateAcdStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateAcdStorageBadRequest creates a CreateAcdStorageBadRequest with default headers values
-func NewCreateAcdStorageBadRequest() *CreateAcdStorageBadRequest {
-	return &CreateAcdStorageBadRequest{}
-}
-
-/*
-CreateAcdStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateAcdStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create acd storage bad request response has a 2xx status code
-func (o *CreateAcdStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create acd storage bad request response has a 3xx status code
-func (o *CreateAcdStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create acd storage bad request response has a 4xx status code
-func (o *CreateAcdStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create acd storage bad request response has a 5xx status code
-func (o *CreateAcdStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create acd storage bad request response a status code equal to that given
-func (o *CreateAcdStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create acd storage bad request response
-func (o *CreateAcdStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateAcdStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/acd][%d] createAcdStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateAcdStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/acd][%d] createAcdStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateAcdStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateAcdStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateAcdStorageInternalServerError creates a CreateAcdStorageInternalServerError with default headers values
-func NewCreateAcdStorageInternalServerError() *CreateAcdStorageInternalServerError {
-	return &CreateAcdStorageInternalServerError{}
-}
-
-/*
-CreateAcdStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateAcdStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create acd storage internal server error response has a 2xx status code
-func (o *CreateAcdStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create acd storage internal server error response has a 3xx status code
-func (o *CreateAcdStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create acd storage internal server error response has a 4xx status code
-func (o *CreateAcdStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create acd storage internal server error response has a 5xx status code
-func (o *CreateAcdStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create acd storage internal server error response a status code equal to that given
-func (o *CreateAcdStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create acd storage internal server error response
-func (o *CreateAcdStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateAcdStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/acd][%d] createAcdStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateAcdStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/acd][%d] createAcdStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateAcdStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateAcdStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateAzureblobStorageParams creates a new CreateAzureblobStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateAzureblobStorageParams() *CreateAzureblobStorageParams {
-	return &CreateAzureblobStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateAzureblobStorageParamsWithTimeout creates a new CreateAzureblobStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateAzureblobStorageParamsWithTimeout(timeout time.Duration) *CreateAzureblobStorageParams {
-	return &CreateAzureblobStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateAzureblobStorageParamsWithContext creates a new CreateAzureblobStorageParams object
-// with the ability to set a context for a request.
-func NewCreateAzureblobStorageParamsWithContext(ctx context.Context) *CreateAzureblobStorageParams {
-	return &CreateAzureblobStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateAzureblobStorageParamsWithHTTPClient creates a new CreateAzureblobStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateAzureblobStorageParamsWithHTTPClient(client *http.Client) *CreateAzureblobStorageParams {
-	return &CreateAzureblobStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateAzureblobStorageParams contains all the parameters to send to the API endpoint
-
-	for the create azureblob storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateAzureblobStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateAzureblobStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create azureblob storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateAzureblobStorageParams) WithDefaults() *CreateAzureblobStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create azureblob storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateAzureblobStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create azureblob storage params
-func (o *CreateAzureblobStorageParams) WithTimeout(timeout time.Duration) *CreateAzureblobStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create azureblob storage params
-func (o *CreateAzureblobStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create azureblob storage params
-func (o *CreateAzureblobStorageParams) WithContext(ctx context.Context) *CreateAzureblobStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create azureblob storage params
-func (o *CreateAzureblobStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create azureblob storage params
-func (o *CreateAzureblobStorageParams) WithHTTPClient(client *http.Client) *CreateAzureblobStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create azureblob storage params
-func (o *CreateAzureblobStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create azureblob storage params
-func (o *CreateAzureblobStorageParams) WithRequest(request *models.StorageCreateAzureblobStorageRequest) *CreateAzureblobStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create azureblob storage params
-func (o *CreateAzureblobStorageParams) SetRequest(request *models.StorageCreateAzureblobStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateAzureblobStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateB2StorageParams creates a new CreateB2StorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateB2StorageParams() *CreateB2StorageParams {
-	return &CreateB2StorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateB2StorageParamsWithTimeout creates a new CreateB2StorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateB2StorageParamsWithTimeout(timeout time.Duration) *CreateB2StorageParams {
-	return &CreateB2StorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateB2StorageParamsWithContext creates a new CreateB2StorageParams object
-// with the ability to set a context for a request.
-func NewCreateB2StorageParamsWithContext(ctx context.Context) *CreateB2StorageParams {
-	return &CreateB2StorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateB2StorageParamsWithHTTPClient creates a new CreateB2StorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateB2StorageParamsWithHTTPClient(client *http.Client) *CreateB2StorageParams {
-	return &CreateB2StorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateB2StorageParams contains all the parameters to send to the API endpoint
-
-	for the create b2 storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateB2StorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateB2StorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create b2 storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateB2StorageParams) WithDefaults() *CreateB2StorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create b2 storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateB2StorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create b2 storage params
-func (o *CreateB2StorageParams) WithTimeout(timeout time.Duration) *CreateB2StorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create b2 storage params
-func (o *CreateB2StorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create b2 storage params
-func (o *CreateB2StorageParams) WithContext(ctx context.Context) *CreateB2StorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create b2 storage params
-func (o *CreateB2StorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create b2 storage params
-func (o *CreateB2StorageParams) WithHTTPClient(client *http.Client) *CreateB2StorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create b2 storage params
-func (o *CreateB2StorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create b2 storage params
-func (o *CreateB2StorageParams) WithRequest(request *models.StorageCreateB2StorageRequest) *CreateB2StorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create b2 storage params
-func (o *CreateB2StorageParams) SetRequest(request *models.StorageCreateB2StorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateB2StorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
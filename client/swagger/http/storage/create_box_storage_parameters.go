@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateBoxStorageParams creates a new CreateBoxStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateBoxStorageParams() *CreateBoxStorageParams {
-	return &CreateBoxStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateBoxStorageParamsWithTimeout creates a new CreateBoxStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateBoxStorageParamsWithTimeout(timeout time.Duration) *CreateBoxStorageParams {
-	return &CreateBoxStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateBoxStorageParamsWithContext creates a new CreateBoxStorageParams object
-// with the ability to set a context for a request.
-func NewCreateBoxStorageParamsWithContext(ctx context.Context) *CreateBoxStorageParams {
-	return &CreateBoxStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateBoxStorageParamsWithHTTPClient creates a new CreateBoxStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateBoxStorageParamsWithHTTPClient(client *http.Client) *CreateBoxStorageParams {
-	return &CreateBoxStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateBoxStorageParams contains all the parameters to send to the API endpoint
-
-	for the create box storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateBoxStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateBoxStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create box storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateBoxStorageParams) WithDefaults() *CreateBoxStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create box storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateBoxStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create box storage params
-func (o *CreateBoxStorageParams) WithTimeout(timeout time.Duration) *CreateBoxStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create box storage params
-func (o *CreateBoxStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create box storage params
-func (o *CreateBoxStorageParams) WithContext(ctx context.Context) *CreateBoxStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create box storage params
-func (o *CreateBoxStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create box storage params
-func (o *CreateBoxStorageParams) WithHTTPClient(client *http.Client) *CreateBoxStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create box storage params
-func (o *CreateBoxStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create box storage params
-func (o *CreateBoxStorageParams) WithRequest(request *models.StorageCreateBoxStorageRequest) *CreateBoxStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create box storage params
-func (o *CreateBoxStorageParams) SetRequest(request *models.StorageCreateBoxStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateBoxStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
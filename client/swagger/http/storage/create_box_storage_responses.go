@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateBoxStorageReader is a Reader for the CreateBoxStorage structure.
-type CreateBoxStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateBoxStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateBoxStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateBoxStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateBoxStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/box] CreateBoxStorage", response, response.Code())
-	}
-}
-
-// NewCreateBoxStorageOK creates a CreateBoxStorageOK with default headers values
-func NewCreateBoxStorageOK() *CreateBoxStorageOK {
-	return &CreateBoxStorageOK{}
-}
-
-/*
-CreateBoxStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateBoxStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create box storage o k response has a 2xx status code
-func (o *CreateBoxStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create box storage o k response has a 3xx status code
-func (o *CreateBoxStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create box storage o k response has a 4xx status code
-func (o *CreateBoxStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create box storage o k response has a 5xx status code
-func (o *CreateBoxStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create box storage o k response a status code equal to that given
-func (o *CreateBoxStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create box storage o k response
-func (o *CreateBoxStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateBoxStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/box][%d] createBoxStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateBoxStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/box][%d] createBoxStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateBoxStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateBoxStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateBoxStorageBadRequest creates a CreateBoxStorageBadRequest with default headers values
-func NewCreateBoxStorageBadRequest() *CreateBoxStorageBadRequest {
-	return &CreateBoxStorageBadRequest{}
-}
-
-/*
-CreateBoxStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateBoxStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create box storage bad request response has a 2xx status code
-func (o *CreateBoxStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create box storage bad request response has a 3xx status code
-func (o *CreateBoxStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create box storage bad request response has a 4xx status code
-func (o *CreateBoxStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create box storage bad request response has a 5xx status code
-func (o *CreateBoxStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create box storage bad request response a status code equal to that given
-func (o *CreateBoxStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create box storage bad request response
-func (o *CreateBoxStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateBoxStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/box][%d] createBoxStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateBoxStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/box][%d] createBoxStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateBoxStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateBoxStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateBoxStorageInternalServerError creates a CreateBoxStorageInternalServerError with default headers values
-func NewCreateBoxStorageInternalServerError() *CreateBoxStorageInternalServerError {
-	return &CreateBoxStorageInternalServerError{}
-}
-
-/*
-CreateBoxStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateBoxStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create box storage internal server error response has a 2xx status code
-func (o *CreateBoxStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create box storage internal server error response has a 3xx status code
-func (o *CreateBoxStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create box storage internal server error response has a 4xx status code
-func (o *CreateBoxStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create box storage internal server error response has a 5xx status code
-func (o *CreateBoxStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create box storage internal server error response a status code equal to that given
-func (o *CreateBoxStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create box storage internal server error response
-func (o *CreateBoxStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateBoxStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/box][%d] createBoxStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateBoxStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/box][%d] createBoxStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateBoxStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateBoxStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
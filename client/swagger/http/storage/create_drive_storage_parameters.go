@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateDriveStorageParams creates a new CreateDriveStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateDriveStorageParams() *CreateDriveStorageParams {
-	return &CreateDriveStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateDriveStorageParamsWithTimeout creates a new CreateDriveStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateDriveStorageParamsWithTimeout(timeout time.Duration) *CreateDriveStorageParams {
-	return &CreateDriveStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateDriveStorageParamsWithContext creates a new CreateDriveStorageParams object
-// with the ability to set a context for a request.
-func NewCreateDriveStorageParamsWithContext(ctx context.Context) *CreateDriveStorageParams {
-	return &CreateDriveStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateDriveStorageParamsWithHTTPClient creates a new CreateDriveStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateDriveStorageParamsWithHTTPClient(client *http.Client) *CreateDriveStorageParams {
-	return &CreateDriveStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateDriveStorageParams contains all the parameters to send to the API endpoint
-
-	for the create drive storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateDriveStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateDriveStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create drive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateDriveStorageParams) WithDefaults() *CreateDriveStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create drive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateDriveStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create drive storage params
-func (o *CreateDriveStorageParams) WithTimeout(timeout time.Duration) *CreateDriveStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create drive storage params
-func (o *CreateDriveStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create drive storage params
-func (o *CreateDriveStorageParams) WithContext(ctx context.Context) *CreateDriveStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create drive storage params
-func (o *CreateDriveStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create drive storage params
-func (o *CreateDriveStorageParams) WithHTTPClient(client *http.Client) *CreateDriveStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create drive storage params
-func (o *CreateDriveStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create drive storage params
-func (o *CreateDriveStorageParams) WithRequest(request *models.StorageCreateDriveStorageRequest) *CreateDriveStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create drive storage params
-func (o *CreateDriveStorageParams) SetRequest(request *models.StorageCreateDriveStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateDriveStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateDropboxStorageParams creates a new CreateDropboxStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateDropboxStorageParams() *CreateDropboxStorageParams {
-	return &CreateDropboxStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateDropboxStorageParamsWithTimeout creates a new CreateDropboxStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateDropboxStorageParamsWithTimeout(timeout time.Duration) *CreateDropboxStorageParams {
-	return &CreateDropboxStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateDropboxStorageParamsWithContext creates a new CreateDropboxStorageParams object
-// with the ability to set a context for a request.
-func NewCreateDropboxStorageParamsWithContext(ctx context.Context) *CreateDropboxStorageParams {
-	return &CreateDropboxStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateDropboxStorageParamsWithHTTPClient creates a new CreateDropboxStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateDropboxStorageParamsWithHTTPClient(client *http.Client) *CreateDropboxStorageParams {
-	return &CreateDropboxStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateDropboxStorageParams contains all the parameters to send to the API endpoint
-
-	for the create dropbox storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateDropboxStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateDropboxStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create dropbox storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateDropboxStorageParams) WithDefaults() *CreateDropboxStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create dropbox storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateDropboxStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create dropbox storage params
-func (o *CreateDropboxStorageParams) WithTimeout(timeout time.Duration) *CreateDropboxStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create dropbox storage params
-func (o *CreateDropboxStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create dropbox storage params
-func (o *CreateDropboxStorageParams) WithContext(ctx context.Context) *CreateDropboxStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create dropbox storage params
-func (o *CreateDropboxStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create dropbox storage params
-func (o *CreateDropboxStorageParams) WithHTTPClient(client *http.Client) *CreateDropboxStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create dropbox storage params
-func (o *CreateDropboxStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create dropbox storage params
-func (o *CreateDropboxStorageParams) WithRequest(request *models.StorageCreateDropboxStorageRequest) *CreateDropboxStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create dropbox storage params
-func (o *CreateDropboxStorageParams) SetRequest(request *models.StorageCreateDropboxStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateDropboxStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
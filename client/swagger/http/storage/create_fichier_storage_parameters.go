@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateFichierStorageParams creates a new CreateFichierStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateFichierStorageParams() *CreateFichierStorageParams {
-	return &CreateFichierStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateFichierStorageParamsWithTimeout creates a new CreateFichierStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateFichierStorageParamsWithTimeout(timeout time.Duration) *CreateFichierStorageParams {
-	return &CreateFichierStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateFichierStorageParamsWithContext creates a new CreateFichierStorageParams object
-// with the ability to set a context for a request.
-func NewCreateFichierStorageParamsWithContext(ctx context.Context) *CreateFichierStorageParams {
-	return &CreateFichierStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateFichierStorageParamsWithHTTPClient creates a new CreateFichierStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateFichierStorageParamsWithHTTPClient(client *http.Client) *CreateFichierStorageParams {
-	return &CreateFichierStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateFichierStorageParams contains all the parameters to send to the API endpoint
-
-	for the create fichier storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateFichierStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateFichierStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create fichier storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateFichierStorageParams) WithDefaults() *CreateFichierStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create fichier storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateFichierStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create fichier storage params
-func (o *CreateFichierStorageParams) WithTimeout(timeout time.Duration) *CreateFichierStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create fichier storage params
-func (o *CreateFichierStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create fichier storage params
-func (o *CreateFichierStorageParams) WithContext(ctx context.Context) *CreateFichierStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create fichier storage params
-func (o *CreateFichierStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create fichier storage params
-func (o *CreateFichierStorageParams) WithHTTPClient(client *http.Client) *CreateFichierStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create fichier storage params
-func (o *CreateFichierStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create fichier storage params
-func (o *CreateFichierStorageParams) WithRequest(request *models.StorageCreateFichierStorageRequest) *CreateFichierStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create fichier storage params
-func (o *CreateFichierStorageParams) SetRequest(request *models.StorageCreateFichierStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateFichierStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
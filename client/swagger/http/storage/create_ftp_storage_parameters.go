@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateFtpStorageParams creates a new CreateFtpStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateFtpStorageParams() *CreateFtpStorageParams {
-	return &CreateFtpStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateFtpStorageParamsWithTimeout creates a new CreateFtpStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateFtpStorageParamsWithTimeout(timeout time.Duration) *CreateFtpStorageParams {
-	return &CreateFtpStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateFtpStorageParamsWithContext creates a new CreateFtpStorageParams object
-// with the ability to set a context for a request.
-func NewCreateFtpStorageParamsWithContext(ctx context.Context) *CreateFtpStorageParams {
-	return &CreateFtpStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateFtpStorageParamsWithHTTPClient creates a new CreateFtpStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateFtpStorageParamsWithHTTPClient(client *http.Client) *CreateFtpStorageParams {
-	return &CreateFtpStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateFtpStorageParams contains all the parameters to send to the API endpoint
-
-	for the create ftp storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateFtpStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateFtpStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create ftp storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateFtpStorageParams) WithDefaults() *CreateFtpStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create ftp storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateFtpStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create ftp storage params
-func (o *CreateFtpStorageParams) WithTimeout(timeout time.Duration) *CreateFtpStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create ftp storage params
-func (o *CreateFtpStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create ftp storage params
-func (o *CreateFtpStorageParams) WithContext(ctx context.Context) *CreateFtpStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create ftp storage params
-func (o *CreateFtpStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create ftp storage params
-func (o *CreateFtpStorageParams) WithHTTPClient(client *http.Client) *CreateFtpStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create ftp storage params
-func (o *CreateFtpStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create ftp storage params
-func (o *CreateFtpStorageParams) WithRequest(request *models.StorageCreateFtpStorageRequest) *CreateFtpStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create ftp storage params
-func (o *CreateFtpStorageParams) SetRequest(request *models.StorageCreateFtpStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateFtpStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
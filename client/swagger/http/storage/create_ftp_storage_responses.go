@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateFtpStorageReader is a Reader for the CreateFtpStorage structure.
-type CreateFtpStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateFtpStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateFtpStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateFtpStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateFtpStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/ftp] CreateFtpStorage", response, response.Code())
-	}
-}
-
-// NewCreateFtpStorageOK creates a CreateFtpStorageOK with default headers values
-func NewCreateFtpStorageOK() *CreateFtpStorageOK {
-	return &CreateFtpStorageOK{}
-}
-
-/*
-CreateFtpStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateFtpStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create ftp storage o k response has a 2xx status code
-func (o *CreateFtpStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create ftp storage o k response has a 3xx status code
-func (o *CreateFtpStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create ftp storage o k response has a 4xx status code
-func (o *CreateFtpStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create ftp storage o k response has a 5xx status code
-func (o *CreateFtpStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create ftp storage o k response a status code equal to that given
-func (o *CreateFtpStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create ftp storage o k response
-func (o *CreateFtpStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateFtpStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/ftp][%d] createFtpStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateFtpStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/ftp][%d] createFtpStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateFtpStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateFtpStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateFtpStorageBadRequest creates a CreateFtpStorageBadRequest with default headers values
-func NewCreateFtpStorageBadRequest() *CreateFtpStorageBadRequest {
-	return &CreateFtpStorageBadRequest{}
-}
-
-/*
-CreateFtpStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateFtpStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create ftp storage bad request response has a 2xx status code
-func (o *CreateFtpStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create ftp storage bad request response has a 3xx status code
-func (o *CreateFtpStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create ftp storage bad request response has a 4xx status code
-func (o *CreateFtpStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create ftp storage bad request response has a 5xx status code
-func (o *CreateFtpStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create ftp storage bad request response a status code equal to that given
-func (o *CreateFtpStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create ftp storage bad request response
-func (o *CreateFtpStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateFtpStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/ftp][%d] createFtpStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateFtpStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/ftp][%d] createFtpStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateFtpStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateFtpStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateFtpStorageInternalServerError creates a CreateFtpStorageInternalServerError with default headers values
-func NewCreateFtpStorageInternalServerError() *CreateFtpStorageInternalServerError {
-	return &CreateFtpStorageInternalServerError{}
-}
-
-/*
-CreateFtpStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateFtpStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create ftp storage internal server error response has a 2xx status code
-func (o *CreateFtpStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create ftp storage internal server error response has a 3xx status code
-func (o *CreateFtpStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create ftp storage internal server error response has a 4xx status code
-func (o *CreateFtpStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create ftp storage internal server error response has a 5xx status code
-func (o *CreateFtpStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create ftp storage internal server error response a status code equal to that given
-func (o *CreateFtpStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create ftp storage internal server error response
-func (o *CreateFtpStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateFtpStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/ftp][%d] createFtpStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateFtpStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/ftp][%d] createFtpStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateFtpStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateFtpStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
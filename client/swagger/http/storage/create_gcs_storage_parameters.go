@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateGcsStorageParams creates a new CreateGcsStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateGcsStorageParams() *CreateGcsStorageParams {
-	return &CreateGcsStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateGcsStorageParamsWithTimeout creates a new CreateGcsStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateGcsStorageParamsWithTimeout(timeout time.Duration) *CreateGcsStorageParams {
-	return &CreateGcsStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateGcsStorageParamsWithContext creates a new CreateGcsStorageParams object
-// with the ability to set a context for a request.
-func NewCreateGcsStorageParamsWithContext(ctx context.Context) *CreateGcsStorageParams {
-	return &CreateGcsStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateGcsStorageParamsWithHTTPClient creates a new CreateGcsStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateGcsStorageParamsWithHTTPClient(client *http.Client) *CreateGcsStorageParams {
-	return &CreateGcsStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateGcsStorageParams contains all the parameters to send to the API endpoint
-
-	for the create gcs storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateGcsStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateGcsStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create gcs storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateGcsStorageParams) WithDefaults() *CreateGcsStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create gcs storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateGcsStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create gcs storage params
-func (o *CreateGcsStorageParams) WithTimeout(timeout time.Duration) *CreateGcsStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create gcs storage params
-func (o *CreateGcsStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create gcs storage params
-func (o *CreateGcsStorageParams) WithContext(ctx context.Context) *CreateGcsStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create gcs storage params
-func (o *CreateGcsStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create gcs storage params
-func (o *CreateGcsStorageParams) WithHTTPClient(client *http.Client) *CreateGcsStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create gcs storage params
-func (o *CreateGcsStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create gcs storage params
-func (o *CreateGcsStorageParams) WithRequest(request *models.StorageCreateGcsStorageRequest) *CreateGcsStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create gcs storage params
-func (o *CreateGcsStorageParams) SetRequest(request *models.StorageCreateGcsStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateGcsStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
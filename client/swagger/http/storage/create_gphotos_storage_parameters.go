@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateGphotosStorageParams creates a new CreateGphotosStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateGphotosStorageParams() *CreateGphotosStorageParams {
-	return &CreateGphotosStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateGphotosStorageParamsWithTimeout creates a new CreateGphotosStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateGphotosStorageParamsWithTimeout(timeout time.Duration) *CreateGphotosStorageParams {
-	return &CreateGphotosStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateGphotosStorageParamsWithContext creates a new CreateGphotosStorageParams object
-// with the ability to set a context for a request.
-func NewCreateGphotosStorageParamsWithContext(ctx context.Context) *CreateGphotosStorageParams {
-	return &CreateGphotosStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateGphotosStorageParamsWithHTTPClient creates a new CreateGphotosStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateGphotosStorageParamsWithHTTPClient(client *http.Client) *CreateGphotosStorageParams {
-	return &CreateGphotosStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateGphotosStorageParams contains all the parameters to send to the API endpoint
-
-	for the create gphotos storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateGphotosStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateGphotosStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create gphotos storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateGphotosStorageParams) WithDefaults() *CreateGphotosStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create gphotos storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateGphotosStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create gphotos storage params
-func (o *CreateGphotosStorageParams) WithTimeout(timeout time.Duration) *CreateGphotosStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create gphotos storage params
-func (o *CreateGphotosStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create gphotos storage params
-func (o *CreateGphotosStorageParams) WithContext(ctx context.Context) *CreateGphotosStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create gphotos storage params
-func (o *CreateGphotosStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create gphotos storage params
-func (o *CreateGphotosStorageParams) WithHTTPClient(client *http.Client) *CreateGphotosStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create gphotos storage params
-func (o *CreateGphotosStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create gphotos storage params
-func (o *CreateGphotosStorageParams) WithRequest(request *models.StorageCreateGphotosStorageRequest) *CreateGphotosStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create gphotos storage params
-func (o *CreateGphotosStorageParams) SetRequest(request *models.StorageCreateGphotosStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateGphotosStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateGphotosStorageReader is a Reader for the CreateGphotosStorage structure.
-type CreateGphotosStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateGphotosStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateGphotosStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateGphotosStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateGphotosStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/gphotos] CreateGphotosStorage", response, response.Code())
-	}
-}
-
-// NewCreateGphotosStorageOK creates a CreateGphotosStorageOK with default headers values
-func NewCreateGphotosStorageOK() *CreateGphotosStorageOK {
-	return &CreateGphotosStorageOK{}
-}
-
-/*
-CreateGphotosStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateGphotosStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create gphotos storage o k response has a 2xx status code
-func (o *CreateGphotosStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create gphotos storage o k response has a 3xx status code
-func (o *CreateGphotosStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create gphotos storage o k response has a 4xx status code
-func (o *CreateGphotosStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create gphotos storage o k response has a 5xx status code
-func (o *CreateGphotosStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create gphotos storage o k response a status code equal to that given
-func (o *CreateGphotosStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create gphotos storage o k response
-func (o *CreateGphotosStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateGphotosStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/gphotos][%d] createGphotosStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateGphotosStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/gphotos][%d] createGphotosStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateGphotosStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateGphotosStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateGphotosStorageBadRequest creates a CreateGphotosStorageBadRequest with default headers values
-func NewCreateGphotosStorageBadRequest() *CreateGphotosStorageBadRequest {
-	return &CreateGphotosStorageBadRequest{}
-}
-
-/*
-CreateGphotosStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateGphotosStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create gphotos storage bad request response has a 2xx status code
-func (o *CreateGphotosStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create gphotos storage bad request response has a 3xx status code
-func (o *CreateGphotosStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create gphotos storage bad request response has a 4xx status code
-func (o *CreateGphotosStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create gphotos storage bad request response has a 5xx status code
-func (o *CreateGphotosStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create gphotos storage bad request response a status code equal to that given
-func (o *CreateGphotosStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create gphotos storage bad request response
-func (o *CreateGphotosStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateGphotosStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/gphotos][%d] createGphotosStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateGphotosStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/gphotos][%d] createGphotosStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateGphotosStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateGphotosStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateGphotosStorageInternalServerError creates a CreateGphotosStorageInternalServerError with default headers values
-func NewCreateGphotosStorageInternalServerError() *CreateGphotosStorageInternalServerError {
-	return &CreateGphotosStorageInternalServerError{}
-}
-
-/*
-CreateGphotosStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateGphotosStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create gphotos storage internal server error response has a 2xx status code
-func (o *CreateGphotosStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create gphotos storage internal server error response has a 3xx status code
-func (o *CreateGphotosStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create gphotos storage internal server error response has a 4xx status code
-func (o *CreateGphotosStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create gphotos storage internal server error response has a 5xx status code
-func (o *CreateGphotosStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create gphotos storage internal server error response a status code equal to that given
-func (o *CreateGphotosStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create gphotos storage internal server error response
-func (o *CreateGphotosStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateGphotosStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/gphotos][%d] createGphotosStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateGphotosStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/gphotos][%d] createGphotosStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateGphotosStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateGphotosStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
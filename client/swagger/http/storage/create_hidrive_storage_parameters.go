@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateHidriveStorageParams creates a new CreateHidriveStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateHidriveStorageParams() *CreateHidriveStorageParams {
-	return &CreateHidriveStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateHidriveStorageParamsWithTimeout creates a new CreateHidriveStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateHidriveStorageParamsWithTimeout(timeout time.Duration) *CreateHidriveStorageParams {
-	return &CreateHidriveStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateHidriveStorageParamsWithContext creates a new CreateHidriveStorageParams object
-// with the ability to set a context for a request.
-func NewCreateHidriveStorageParamsWithContext(ctx context.Context) *CreateHidriveStorageParams {
-	return &CreateHidriveStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateHidriveStorageParamsWithHTTPClient creates a new CreateHidriveStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateHidriveStorageParamsWithHTTPClient(client *http.Client) *CreateHidriveStorageParams {
-	return &CreateHidriveStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateHidriveStorageParams contains all the parameters to send to the API endpoint
-
-	for the create hidrive storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateHidriveStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateHidriveStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create hidrive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateHidriveStorageParams) WithDefaults() *CreateHidriveStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create hidrive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateHidriveStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create hidrive storage params
-func (o *CreateHidriveStorageParams) WithTimeout(timeout time.Duration) *CreateHidriveStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create hidrive storage params
-func (o *CreateHidriveStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create hidrive storage params
-func (o *CreateHidriveStorageParams) WithContext(ctx context.Context) *CreateHidriveStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create hidrive storage params
-func (o *CreateHidriveStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create hidrive storage params
-func (o *CreateHidriveStorageParams) WithHTTPClient(client *http.Client) *CreateHidriveStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create hidrive storage params
-func (o *CreateHidriveStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create hidrive storage params
-func (o *CreateHidriveStorageParams) WithRequest(request *models.StorageCreateHidriveStorageRequest) *CreateHidriveStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create hidrive storage params
-func (o *CreateHidriveStorageParams) SetRequest(request *models.StorageCreateHidriveStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateHidriveStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
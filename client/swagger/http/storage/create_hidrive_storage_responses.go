@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateHidriveStorageReader is a Reader for the CreateHidriveStorage structure.
-type CreateHidriveStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateHidriveStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateHidriveStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateHidriveStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateHidriveStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/hidrive] CreateHidriveStorage", response, response.Code())
-	}
-}
-
-// NewCreateHidriveStorageOK creates a CreateHidriveStorageOK with default headers values
-func NewCreateHidriveStorageOK() *CreateHidriveStorageOK {
-	return &CreateHidriveStorageOK{}
-}
-
-/*
-CreateHidriveStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateHidriveStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create hidrive storage o k response has a 2xx status code
-func (o *CreateHidriveStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create hidrive storage o k response has a 3xx status code
-func (o *CreateHidriveStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create hidrive storage o k response has a 4xx status code
-func (o *CreateHidriveStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create hidrive storage o k response has a 5xx status code
-func (o *CreateHidriveStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create hidrive storage o k response a status code equal to that given
-func (o *CreateHidriveStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create hidrive storage o k response
-func (o *CreateHidriveStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateHidriveStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/hidrive][%d] createHidriveStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateHidriveStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/hidrive][%d] createHidriveStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateHidriveStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateHidriveStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateHidriveStorageBadRequest creates a CreateHidriveStorageBadRequest with default headers values
-func NewCreateHidriveStorageBadRequest() *CreateHidriveStorageBadRequest {
-	return &CreateHidriveStorageBadRequest{}
-}
-
-/*
-CreateHidriveStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateHidriveStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create hidrive storage bad request response has a 2xx status code
-func (o *CreateHidriveStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create hidrive storage bad request response has a 3xx status code
-func (o *CreateHidriveStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create hidrive storage bad request response has a 4xx status code
-func (o *CreateHidriveStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create hidrive storage bad request response has a 5xx status code
-func (o *CreateHidriveStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create hidrive storage bad request response a status code equal to that given
-func (o *CreateHidriveStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create hidrive storage bad request response
-func (o *CreateHidriveStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateHidriveStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/hidrive][%d] createHidriveStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateHidriveStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/hidrive][%d] createHidriveStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateHidriveStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateHidriveStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateHidriveStorageInternalServerError creates a CreateHidriveStorageInternalServerError with default headers values
-func NewCreateHidriveStorageInternalServerError() *CreateHidriveStorageInternalServerError {
-	return &CreateHidriveStorageInternalServerError{}
-}
-
-/*
-CreateHidriveStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateHidriveStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create hidrive storage internal server error response has a 2xx status code
-func (o *CreateHidriveStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create hidrive storage internal server error response has a 3xx status code
-func (o *CreateHidriveStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create hidrive storage internal server error response has a 4xx status code
-func (o *CreateHidriveStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create hidrive storage internal server error response has a 5xx status code
-func (o *CreateHidriveStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create hidrive storage internal server error response a status code equal to that given
-func (o *CreateHidriveStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create hidrive storage internal server error response
-func (o *CreateHidriveStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateHidriveStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/hidrive][%d] createHidriveStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateHidriveStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/hidrive][%d] createHidriveStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateHidriveStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateHidriveStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
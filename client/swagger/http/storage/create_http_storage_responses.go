@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateHTTPStorageReader is a Reader for the CreateHTTPStorage structure.
-type CreateHTTPStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateHTTPStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateHTTPStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateHTTPStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateHTTPStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/http] CreateHttpStorage", response, response.Code())
-	}
-}
-
-// NewCreateHTTPStorageOK creates a CreateHTTPStorageOK with default headers values
-func NewCreateHTTPStorageOK() *CreateHTTPStorageOK {
-	return &CreateHTTPStorageOK{}
-}
-
-/*
-CreateHTTPStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateHTTPStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create Http storage o k response has a 2xx status code
-func (o *CreateHTTPStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create Http storage o k response has a 3xx status code
-func (o *CreateHTTPStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create Http storage o k response has a 4xx status code
-func (o *CreateHTTPStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create Http storage o k response has a 5xx status code
-func (o *CreateHTTPStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create Http storage o k response a status code equal to that given
-func (o *CreateHTTPStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create Http storage o k response
-func (o *CreateHTTPStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateHTTPStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/http][%d] createHttpStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateHTTPStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/http][%d] createHttpStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateHTTPStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateHTTPStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateHTTPStorageBadRequest creates a CreateHTTPStorageBadRequest with default headers values
-func NewCreateHTTPStorageBadRequest() *CreateHTTPStorageBadRequest {
-	return &CreateHTTPStorageBadRequest{}
-}
-
-/*
-CreateHTTPStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateHTTPStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create Http storage bad request response has a 2xx status code
-func (o *CreateHTTPStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create Http storage bad request response has a 3xx status code
-func (o *CreateHTTPStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create Http storage bad request response has a 4xx status code
-func (o *CreateHTTPStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create Http storage bad request response has a 5xx status code
-func (o *CreateHTTPStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create Http storage bad request response a status code equal to that given
-func (o *CreateHTTPStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create Http storage bad request response
-func (o *CreateHTTPStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateHTTPStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/http][%d] createHttpStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateHTTPStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/http][%d] createHttpStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateHTTPStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateHTTPStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateHTTPStorageInternalServerError creates a CreateHTTPStorageInternalServerError with default headers values
-func NewCreateHTTPStorageInternalServerError() *CreateHTTPStorageInternalServerError {
-	return &CreateHTTPStorageInternalServerError{}
-}
-
-/*
-CreateHTTPStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateHTTPStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create Http storage internal server error response has a 2xx status code
-func (o *CreateHTTPStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create Http storage internal server error response has a 3xx status code
-func (o *CreateHTTPStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create Http storage internal server error response has a 4xx status code
-func (o *CreateHTTPStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create Http storage internal server error response has a 5xx status code
-func (o *CreateHTTPStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create Http storage internal server error response a status code equal to that given
-func (o *CreateHTTPStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create Http storage internal server error response
-func (o *CreateHTTPStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateHTTPStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/http][%d] createHttpStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateHTTPStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/http][%d] createHttpStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateHTTPStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateHTTPStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
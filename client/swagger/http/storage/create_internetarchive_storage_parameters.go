@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateInternetarchiveStorageParams creates a new CreateInternetarchiveStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateInternetarchiveStorageParams() *CreateInternetarchiveStorageParams {
-	return &CreateInternetarchiveStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateInternetarchiveStorageParamsWithTimeout creates a new CreateInternetarchiveStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateInternetarchiveStorageParamsWithTimeout(timeout time.Duration) *CreateInternetarchiveStorageParams {
-	return &CreateInternetarchiveStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateInternetarchiveStorageParamsWithContext creates a new CreateInternetarchiveStorageParams object
-// with the ability to set a context for a request.
-func NewCreateInternetarchiveStorageParamsWithContext(ctx context.Context) *CreateInternetarchiveStorageParams {
-	return &CreateInternetarchiveStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateInternetarchiveStorageParamsWithHTTPClient creates a new CreateInternetarchiveStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateInternetarchiveStorageParamsWithHTTPClient(client *http.Client) *CreateInternetarchiveStorageParams {
-	return &CreateInternetarchiveStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateInternetarchiveStorageParams contains all the parameters to send to the API endpoint
-
-	for the create internetarchive storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateInternetarchiveStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateInternetarchiveStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create internetarchive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateInternetarchiveStorageParams) WithDefaults() *CreateInternetarchiveStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create internetarchive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateInternetarchiveStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create internetarchive storage params
-func (o *CreateInternetarchiveStorageParams) WithTimeout(timeout time.Duration) *CreateInternetarchiveStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create internetarchive storage params
-func (o *CreateInternetarchiveStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create internetarchive storage params
-func (o *CreateInternetarchiveStorageParams) WithContext(ctx context.Context) *CreateInternetarchiveStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create internetarchive storage params
-func (o *CreateInternetarchiveStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create internetarchive storage params
-func (o *CreateInternetarchiveStorageParams) WithHTTPClient(client *http.Client) *CreateInternetarchiveStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create internetarchive storage params
-func (o *CreateInternetarchiveStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create internetarchive storage params
-func (o *CreateInternetarchiveStorageParams) WithRequest(request *models.StorageCreateInternetarchiveStorageRequest) *CreateInternetarchiveStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create internetarchive storage params
-func (o *CreateInternetarchiveStorageParams) SetRequest(request *models.StorageCreateInternetarchiveStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateInternetarchiveStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateInternetarchiveStorageReader is a Reader for the CreateInternetarchiveStorage structure.
-type CreateInternetarchiveStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateInternetarchiveStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateInternetarchiveStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateInternetarchiveStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateInternetarchiveStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/internetarchive] CreateInternetarchiveStorage", response, response.Code())
-	}
-}
-
-// NewCreateInternetarchiveStorageOK creates a CreateInternetarchiveStorageOK with default headers values
-func NewCreateInternetarchiveStorageOK() *CreateInternetarchiveStorageOK {
-	return &CreateInternetarchiveStorageOK{}
-}
-
-/*
-CreateInternetarchiveStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateInternetarchiveStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create internetarchive storage o k response has a 2xx status code
-func (o *CreateInternetarchiveStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create internetarchive storage o k response has a 3xx status code
-func (o *CreateInternetarchiveStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create internetarchive storage o k response has a 4xx status code
-func (o *CreateInternetarchiveStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create internetarchive storage o k response has a 5xx status code
-func (o *CreateInternetarchiveStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create internetarchive storage o k response a status code equal to that given
-func (o *CreateInternetarchiveStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create internetarchive storage o k response
-func (o *CreateInternetarchiveStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateInternetarchiveStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/internetarchive][%d] createInternetarchiveStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateInternetarchiveStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/internetarchive][%d] createInternetarchiveStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateInternetarchiveStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateInternetarchiveStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateInternetarchiveStorageBadRequest creates a CreateInternetarchiveStorageBadRequest with default headers values
-func NewCreateInternetarchiveStorageBadRequest() *CreateInternetarchiveStorageBadRequest {
-	return &CreateInternetarchiveStorageBadRequest{}
-}
-
-/*
-CreateInternetarchiveStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateInternetarchiveStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create internetarchive storage bad request response has a 2xx status code
-func (o *CreateInternetarchiveStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create internetarchive storage bad request response has a 3xx status code
-func (o *CreateInternetarchiveStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create internetarchive storage bad request response has a 4xx status code
-func (o *CreateInternetarchiveStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create internetarchive storage bad request response has a 5xx status code
-func (o *CreateInternetarchiveStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create internetarchive storage bad request response a status code equal to that given
-func (o *CreateInternetarchiveStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create internetarchive storage bad request response
-func (o *CreateInternetarchiveStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateInternetarchiveStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/internetarchive][%d] createInternetarchiveStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateInternetarchiveStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/internetarchive][%d] createInternetarchiveStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateInternetarchiveStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateInternetarchiveStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateInternetarchiveStorageInternalServerError creates a CreateInternetarchiveStorageInternalServerError with default headers values
-func NewCreateInternetarchiveStorageInternalServerError() *CreateInternetarchiveStorageInternalServerError {
-	return &CreateInternetarchiveStorageInternalServerError{}
-}
-
-/*
-CreateInternetarchiveStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateInternetarchiveStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create internetarchive storage internal server error response has a 2xx status code
-func (o *CreateInternetarchiveStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create internetarchive storage internal server error response has a 3xx status code
-func (o *CreateInternetarchiveStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create internetarchive storage internal server error response has a 4xx status code
-func (o *CreateInternetarchiveStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create internetarchive storage internal server error response has a 5xx status code
-func (o *CreateInternetarchiveStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create internetarchive storage internal server error response a status code equal to that given
-func (o *CreateInternetarchiveStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create internetarchive storage internal server error response
-func (o *CreateInternetarchiveStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateInternetarchiveStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/internetarchive][%d] createInternetarchiveStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateInternetarchiveStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/internetarchive][%d] createInternetarchiveStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateInternetarchiveStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateInternetarchiveStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateJottacloudStorageReader is a Reader for the CreateJottacloudStorage structure.
-type CreateJottacloudStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateJottacloudStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateJottacloudStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateJottacloudStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateJottacloudStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/jottacloud] CreateJottacloudStorage", response, response.Code())
-	}
-}
-
-// NewCreateJottacloudStorageOK creates a CreateJottacloudStorageOK with default headers values
-func NewCreateJottacloudStorageOK() *CreateJottacloudStorageOK {
-	return &CreateJottacloudStorageOK{}
-}
-
-/*
-CreateJottacloudStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateJottacloudStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create jottacloud storage o k response has a 2xx status code
-func (o *CreateJottacloudStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create jottacloud storage o k response has a 3xx status code
-func (o *CreateJottacloudStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create jottacloud storage o k response has a 4xx status code
-func (o *CreateJottacloudStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create jottacloud storage o k response has a 5xx status code
-func (o *CreateJottacloudStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create jottacloud storage o k response a status code equal to that given
-func (o *CreateJottacloudStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create jottacloud storage o k response
-func (o *CreateJottacloudStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateJottacloudStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/jottacloud][%d] createJottacloudStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateJottacloudStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/jottacloud][%d] createJottacloudStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateJottacloudStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateJottacloudStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateJottacloudStorageBadRequest creates a CreateJottacloudStorageBadRequest with default headers values
-func NewCreateJottacloudStorageBadRequest() *CreateJottacloudStorageBadRequest {
-	return &CreateJottacloudStorageBadRequest{}
-}
-
-/*
-CreateJottacloudStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateJottacloudStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create jottacloud storage bad request response has a 2xx status code
-func (o *CreateJottacloudStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create jottacloud storage bad request response has a 3xx status code
-func (o *CreateJottacloudStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create jottacloud storage bad request response has a 4xx status code
-func (o *CreateJottacloudStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create jottacloud storage bad request response has a 5xx status code
-func (o *CreateJottacloudStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create jottacloud storage bad request response a status code equal to that given
-func (o *CreateJottacloudStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create jottacloud storage bad request response
-func (o *CreateJottacloudStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateJottacloudStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/jottacloud][%d] createJottacloudStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateJottacloudStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/jottacloud][%d] createJottacloudStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateJottacloudStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateJottacloudStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateJottacloudStorageInternalServerError creates a CreateJottacloudStorageInternalServerError with default headers values
-func NewCreateJottacloudStorageInternalServerError() *CreateJottacloudStorageInternalServerError {
-	return &CreateJottacloudStorageInternalServerError{}
-}
-
-/*
-CreateJottacloudStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateJottacloudStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create jottacloud storage internal server error response has a 2xx status code
-func (o *CreateJottacloudStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create jottacloud storage internal server error response has a 3xx status code
-func (o *CreateJottacloudStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create jottacloud storage internal server error response has a 4xx status code
-func (o *CreateJottacloudStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create jottacloud storage internal server error response has a 5xx status code
-func (o *CreateJottacloudStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create jottacloud storage internal server error response a status code equal to that given
-func (o *CreateJottacloudStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create jottacloud storage internal server error response
-func (o *CreateJottacloudStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateJottacloudStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/jottacloud][%d] createJottacloudStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateJottacloudStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/jottacloud][%d] createJottacloudStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateJottacloudStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateJottacloudStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateKoofrDigistorageStorageParams creates a new CreateKoofrDigistorageStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateKoofrDigistorageStorageParams() *CreateKoofrDigistorageStorageParams {
-	return &CreateKoofrDigistorageStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateKoofrDigistorageStorageParamsWithTimeout creates a new CreateKoofrDigistorageStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateKoofrDigistorageStorageParamsWithTimeout(timeout time.Duration) *CreateKoofrDigistorageStorageParams {
-	return &CreateKoofrDigistorageStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateKoofrDigistorageStorageParamsWithContext creates a new CreateKoofrDigistorageStorageParams object
-// with the ability to set a context for a request.
-func NewCreateKoofrDigistorageStorageParamsWithContext(ctx context.Context) *CreateKoofrDigistorageStorageParams {
-	return &CreateKoofrDigistorageStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateKoofrDigistorageStorageParamsWithHTTPClient creates a new CreateKoofrDigistorageStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateKoofrDigistorageStorageParamsWithHTTPClient(client *http.Client) *CreateKoofrDigistorageStorageParams {
-	return &CreateKoofrDigistorageStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateKoofrDigistorageStorageParams contains all the parameters to send to the API endpoint
-
-	for the create koofr digistorage storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateKoofrDigistorageStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateKoofrDigistorageStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create koofr digistorage storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateKoofrDigistorageStorageParams) WithDefaults() *CreateKoofrDigistorageStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create koofr digistorage storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateKoofrDigistorageStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create koofr digistorage storage params
-func (o *CreateKoofrDigistorageStorageParams) WithTimeout(timeout time.Duration) *CreateKoofrDigistorageStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create koofr digistorage storage params
-func (o *CreateKoofrDigistorageStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create koofr digistorage storage params
-func (o *CreateKoofrDigistorageStorageParams) WithContext(ctx context.Context) *CreateKoofrDigistorageStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create koofr digistorage storage params
-func (o *CreateKoofrDigistorageStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create koofr digistorage storage params
-func (o *CreateKoofrDigistorageStorageParams) WithHTTPClient(client *http.Client) *CreateKoofrDigistorageStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create koofr digistorage storage params
-func (o *CreateKoofrDigistorageStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create koofr digistorage storage params
-func (o *CreateKoofrDigistorageStorageParams) WithRequest(request *models.StorageCreateKoofrDigistorageStorageRequest) *CreateKoofrDigistorageStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create koofr digistorage storage params
-func (o *CreateKoofrDigistorageStorageParams) SetRequest(request *models.StorageCreateKoofrDigistorageStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateKoofrDigistorageStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateKoofrDigistorageStorageReader is a Reader for the CreateKoofrDigistorageStorage structure.
-type CreateKoofrDigistorageStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateKoofrDigistorageStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateKoofrDigistorageStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateKoofrDigistorageStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateKoofrDigistorageStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/koofr/digistorage] CreateKoofrDigistorageStorage", response, response.Code())
-	}
-}
-
-// NewCreateKoofrDigistorageStorageOK creates a CreateKoofrDigistorageStorageOK with default headers values
-func NewCreateKoofrDigistorageStorageOK() *CreateKoofrDigistorageStorageOK {
-	return &CreateKoofrDigistorageStorageOK{}
-}
-
-/*
-CreateKoofrDigistorageStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateKoofrDigistorageStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create koofr digistorage storage o k response has a 2xx status code
-func (o *CreateKoofrDigistorageStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create koofr digistorage storage o k response has a 3xx status code
-func (o *CreateKoofrDigistorageStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create koofr digistorage storage o k response has a 4xx status code
-func (o *CreateKoofrDigistorageStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create koofr digistorage storage o k response has a 5xx status code
-func (o *CreateKoofrDigistorageStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create koofr digistorage storage o k response a status code equal to that given
-func (o *CreateKoofrDigistorageStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create koofr digistorage storage o k response
-func (o *CreateKoofrDigistorageStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateKoofrDigistorageStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/koofr/digistorage][%d] createKoofrDigistorageStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateKoofrDigistorageStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/koofr/digistorage][%d] createKoofrDigistorageStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateKoofrDigistorageStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateKoofrDigistorageStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateKoofrDigistorageStorageBadRequest creates a CreateKoofrDigistorageStorageBadRequest with default headers values
-func NewCreateKoofrDigistorageStorageBadRequest() *CreateKoofrDigistorageStorageBadRequest {
-	return &CreateKoofrDigistorageStorageBadRequest{}
-}
-
-/*
-CreateKoofrDigistorageStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateKoofrDigistorageStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create koofr digistorage storage bad request response has a 2xx status code
-func (o *CreateKoofrDigistorageStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create koofr digistorage storage bad request response has a 3xx status code
-func (o *CreateKoofrDigistorageStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create koofr digistorage storage bad request response has a 4xx status code
-func (o *CreateKoofrDigistorageStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create koofr digistorage storage bad request response has a 5xx status code
-func (o *CreateKoofrDigistorageStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create koofr digistorage storage bad request response a status code equal to that given
-func (o *CreateKoofrDigistorageStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create koofr digistorage storage bad request response
-func (o *CreateKoofrDigistorageStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateKoofrDigistorageStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/koofr/digistorage][%d] createKoofrDigistorageStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateKoofrDigistorageStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/koofr/digistorage][%d] createKoofrDigistorageStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateKoofrDigistorageStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateKoofrDigistorageStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateKoofrDigistorageStorageInternalServerError creates a CreateKoofrDigistorageStorageInternalServerError with default headers values
-func NewCreateKoofrDigistorageStorageInternalServerError() *CreateKoofrDigistorageStorageInternalServerError {
-	return &CreateKoofrDigistorageStorageInternalServerError{}
-}
-
-/*
-CreateKoofrDigistorageStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateKoofrDigistorageStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create koofr digistorage storage internal server error response has a 2xx status code
-func (o *CreateKoofrDigistorageStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create koofr digistorage storage internal server error response has a 3xx status code
-func (o *CreateKoofrDigistorageStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create koofr digistorage storage internal server error response has a 4xx status code
-func (o *CreateKoofrDigistorageStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create koofr digistorage storage internal server error response has a 5xx status code
-func (o *CreateKoofrDigistorageStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create koofr digistorage storage internal server error response a status code equal to that given
-func (o *CreateKoofrDigistorageStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create koofr digistorage storage internal server error response
-func (o *CreateKoofrDigistorageStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateKoofrDigistorageStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/koofr/digistorage][%d] createKoofrDigistorageStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateKoofrDigistorageStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/koofr/digistorage][%d] createKoofrDigistorageStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateKoofrDigistorageStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateKoofrDigistorageStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
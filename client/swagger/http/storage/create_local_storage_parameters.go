@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateLocalStorageParams creates a new CreateLocalStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateLocalStorageParams() *CreateLocalStorageParams {
-	return &CreateLocalStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateLocalStorageParamsWithTimeout creates a new CreateLocalStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateLocalStorageParamsWithTimeout(timeout time.Duration) *CreateLocalStorageParams {
-	return &CreateLocalStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateLocalStorageParamsWithContext creates a new CreateLocalStorageParams object
-// with the ability to set a context for a request.
-func NewCreateLocalStorageParamsWithContext(ctx context.Context) *CreateLocalStorageParams {
-	return &CreateLocalStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateLocalStorageParamsWithHTTPClient creates a new CreateLocalStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateLocalStorageParamsWithHTTPClient(client *http.Client) *CreateLocalStorageParams {
-	return &CreateLocalStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateLocalStorageParams contains all the parameters to send to the API endpoint
-
-	for the create local storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateLocalStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateLocalStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create local storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateLocalStorageParams) WithDefaults() *CreateLocalStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create local storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateLocalStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create local storage params
-func (o *CreateLocalStorageParams) WithTimeout(timeout time.Duration) *CreateLocalStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create local storage params
-func (o *CreateLocalStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create local storage params
-func (o *CreateLocalStorageParams) WithContext(ctx context.Context) *CreateLocalStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create local storage params
-func (o *CreateLocalStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create local storage params
-func (o *CreateLocalStorageParams) WithHTTPClient(client *http.Client) *CreateLocalStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create local storage params
-func (o *CreateLocalStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create local storage params
-func (o *CreateLocalStorageParams) WithRequest(request *models.StorageCreateLocalStorageRequest) *CreateLocalStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create local storage params
-func (o *CreateLocalStorageParams) SetRequest(request *models.StorageCreateLocalStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateLocalStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateLocalStorageReader is a Reader for the CreateLocalStorage structure.
-type CreateLocalStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateLocalStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateLocalStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateLocalStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateLocalStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/local] CreateLocalStorage", response, response.Code())
-	}
-}
-
-// NewCreateLocalStorageOK creates a CreateLocalStorageOK with default headers values
-func NewCreateLocalStorageOK() *CreateLocalStorageOK {
-	return &CreateLocalStorageOK{}
-}
-
-/*
-CreateLocalStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateLocalStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create local storage o k response has a 2xx status code
-func (o *CreateLocalStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create local storage o k response has a 3xx status code
-func (o *CreateLocalStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create local storage o k response has a 4xx status code
-func (o *CreateLocalStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create local storage o k response has a 5xx status code
-func (o *CreateLocalStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create local storage o k response a status code equal to that given
-func (o *CreateLocalStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create local storage o k response
-func (o *CreateLocalStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateLocalStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/local][%d] createLocalStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateLocalStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/local][%d] createLocalStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateLocalStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateLocalStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateLocalStorageBadRequest creates a CreateLocalStorageBadRequest with default headers values
-func NewCreateLocalStorageBadRequest() *CreateLocalStorageBadRequest {
-	return &CreateLocalStorageBadRequest{}
-}
-
-/*
-CreateLocalStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateLocalStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create local storage bad request response has a 2xx status code
-func (o *CreateLocalStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create local storage bad request response has a 3xx status code
-func (o *CreateLocalStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create local storage bad request response has a 4xx status code
-func (o *CreateLocalStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create local storage bad request response has a 5xx status code
-func (o *CreateLocalStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create local storage bad request response a status code equal to that given
-func (o *CreateLocalStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create local storage bad request response
-func (o *CreateLocalStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateLocalStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/local][%d] createLocalStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateLocalStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/local][%d] createLocalStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateLocalStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateLocalStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateLocalStorageInternalServerError creates a CreateLocalStorageInternalServerError with default headers values
-func NewCreateLocalStorageInternalServerError() *CreateLocalStorageInternalServerError {
-	return &CreateLocalStorageInternalServerError{}
-}
-
-/*
-CreateLocalStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateLocalStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create local storage internal server error response has a 2xx status code
-func (o *CreateLocalStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create local storage internal server error response has a 3xx status code
-func (o *CreateLocalStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create local storage internal server error response has a 4xx status code
-func (o *CreateLocalStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create local storage internal server error response has a 5xx status code
-func (o *CreateLocalStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create local storage internal server error response a status code equal to that given
-func (o *CreateLocalStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create local storage internal server error response
-func (o *CreateLocalStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateLocalStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/local][%d] createLocalStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateLocalStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/local][%d] createLocalStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateLocalStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateLocalStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateMailruStorageParams creates a new CreateMailruStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateMailruStorageParams() *CreateMailruStorageParams {
-	return &CreateMailruStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateMailruStorageParamsWithTimeout creates a new CreateMailruStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateMailruStorageParamsWithTimeout(timeout time.Duration) *CreateMailruStorageParams {
-	return &CreateMailruStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateMailruStorageParamsWithContext creates a new CreateMailruStorageParams object
-// with the ability to set a context for a request.
-func NewCreateMailruStorageParamsWithContext(ctx context.Context) *CreateMailruStorageParams {
-	return &CreateMailruStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateMailruStorageParamsWithHTTPClient creates a new CreateMailruStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateMailruStorageParamsWithHTTPClient(client *http.Client) *CreateMailruStorageParams {
-	return &CreateMailruStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateMailruStorageParams contains all the parameters to send to the API endpoint
-
-	for the create mailru storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateMailruStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateMailruStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create mailru storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateMailruStorageParams) WithDefaults() *CreateMailruStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create mailru storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateMailruStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create mailru storage params
-func (o *CreateMailruStorageParams) WithTimeout(timeout time.Duration) *CreateMailruStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create mailru storage params
-func (o *CreateMailruStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create mailru storage params
-func (o *CreateMailruStorageParams) WithContext(ctx context.Context) *CreateMailruStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create mailru storage params
-func (o *CreateMailruStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create mailru storage params
-func (o *CreateMailruStorageParams) WithHTTPClient(client *http.Client) *CreateMailruStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create mailru storage params
-func (o *CreateMailruStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create mailru storage params
-func (o *CreateMailruStorageParams) WithRequest(request *models.StorageCreateMailruStorageRequest) *CreateMailruStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create mailru storage params
-func (o *CreateMailruStorageParams) SetRequest(request *models.StorageCreateMailruStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateMailruStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateMailruStorageReader is a Reader for the CreateMailruStorage structure.
-type CreateMailruStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateMailruStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateMailruStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateMailruStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateMailruStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/mailru] CreateMailruStorage", response, response.Code())
-	}
-}
-
-// NewCreateMailruStorageOK creates a CreateMailruStorageOK with default headers values
-func NewCreateMailruStorageOK() *CreateMailruStorageOK {
-	return &CreateMailruStorageOK{}
-}
-
-/*
-CreateMailruStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateMailruStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create mailru storage o k response has a 2xx status code
-func (o *CreateMailruStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create mailru storage o k response has a 3xx status code
-func (o *CreateMailruStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create mailru storage o k response has a 4xx status code
-func (o *CreateMailruStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create mailru storage o k response has a 5xx status code
-func (o *CreateMailruStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create mailru storage o k response a status code equal to that given
-func (o *CreateMailruStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create mailru storage o k response
-func (o *CreateMailruStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateMailruStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/mailru][%d] createMailruStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateMailruStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/mailru][%d] createMailruStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateMailruStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateMailruStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateMailruStorageBadRequest creates a CreateMailruStorageBadRequest with default headers values
-func NewCreateMailruStorageBadRequest() *CreateMailruStorageBadRequest {
-	return &CreateMailruStorageBadRequest{}
-}
-
-/*
-CreateMailruStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateMailruStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create mailru storage bad request response has a 2xx status code
-func (o *CreateMailruStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create mailru storage bad request response has a 3xx status code
-func (o *CreateMailruStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create mailru storage bad request response has a 4xx status code
-func (o *CreateMailruStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create mailru storage bad request response has a 5xx status code
-func (o *CreateMailruStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create mailru storage bad request response a status code equal to that given
-func (o *CreateMailruStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create mailru storage bad request response
-func (o *CreateMailruStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateMailruStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/mailru][%d] createMailruStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateMailruStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/mailru][%d] createMailruStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateMailruStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateMailruStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateMailruStorageInternalServerError creates a CreateMailruStorageInternalServerError with default headers values
-func NewCreateMailruStorageInternalServerError() *CreateMailruStorageInternalServerError {
-	return &CreateMailruStorageInternalServerError{}
-}
-
-/*
-CreateMailruStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateMailruStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create mailru storage internal server error response has a 2xx status code
-func (o *CreateMailruStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create mailru storage internal server error response has a 3xx status code
-func (o *CreateMailruStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create mailru storage internal server error response has a 4xx status code
-func (o *CreateMailruStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create mailru storage internal server error response has a 5xx status code
-func (o *CreateMailruStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create mailru storage internal server error response a status code equal to that given
-func (o *CreateMailruStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create mailru storage internal server error response
-func (o *CreateMailruStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateMailruStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/mailru][%d] createMailruStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateMailruStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/mailru][%d] createMailruStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateMailruStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateMailruStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateMegaStorageReader is a Reader for the CreateMegaStorage structure.
-type CreateMegaStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateMegaStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateMegaStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateMegaStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateMegaStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/mega] CreateMegaStorage", response, response.Code())
-	}
-}
-
-// NewCreateMegaStorageOK creates a CreateMegaStorageOK with default headers values
-func NewCreateMegaStorageOK() *CreateMegaStorageOK {
-	return &CreateMegaStorageOK{}
-}
-
-/*
-CreateMegaStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateMegaStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create mega storage o k response has a 2xx status code
-func (o *CreateMegaStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create mega storage o k response has a 3xx status code
-func (o *CreateMegaStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create mega storage o k response has a 4xx status code
-func (o *CreateMegaStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create mega storage o k response has a 5xx status code
-func (o *CreateMegaStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create mega storage o k response a status code equal to that given
-func (o *CreateMegaStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create mega storage o k response
-func (o *CreateMegaStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateMegaStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/mega][%d] createMegaStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateMegaStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/mega][%d] createMegaStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateMegaStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateMegaStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateMegaStorageBadRequest creates a CreateMegaStorageBadRequest with default headers values
-func NewCreateMegaStorageBadRequest() *CreateMegaStorageBadRequest {
-	return &CreateMegaStorageBadRequest{}
-}
-
-/*
-CreateMegaStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateMegaStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create mega storage bad request response has a 2xx status code
-func (o *CreateMegaStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create mega storage bad request response has a 3xx status code
-func (o *CreateMegaStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create mega storage bad request response has a 4xx status code
-func (o *CreateMegaStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create mega storage bad request response has a 5xx status code
-func (o *CreateMegaStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create mega storage bad request response a status code equal to that given
-func (o *CreateMegaStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create mega storage bad request response
-func (o *CreateMegaStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateMegaStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/mega][%d] createMegaStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateMegaStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/mega][%d] createMegaStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateMegaStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateMegaStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateMegaStorageInternalServerError creates a CreateMegaStorageInternalServerError with default headers values
-func NewCreateMegaStorageInternalServerError() *CreateMegaStorageInternalServerError {
-	return &CreateMegaStorageInternalServerError{}
-}
-
-/*
-CreateMegaStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateMegaStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create mega storage internal server error response has a 2xx status code
-func (o *CreateMegaStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create mega storage internal server error response has a 3xx status code
-func (o *CreateMegaStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create mega storage internal server error response has a 4xx status code
-func (o *CreateMegaStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create mega storage internal server error response has a 5xx status code
-func (o *CreateMegaStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create mega storage internal server error response a status code equal to that given
-func (o *CreateMegaStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create mega storage internal server error response
-func (o *CreateMegaStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateMegaStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/mega][%d] createMegaStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateMegaStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/mega][%d] createMegaStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateMegaStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateMegaStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
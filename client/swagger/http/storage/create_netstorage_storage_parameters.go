@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateNetstorageStorageParams creates a new CreateNetstorageStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateNetstorageStorageParams() *CreateNetstorageStorageParams {
-	return &CreateNetstorageStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateNetstorageStorageParamsWithTimeout creates a new CreateNetstorageStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateNetstorageStorageParamsWithTimeout(timeout time.Duration) *CreateNetstorageStorageParams {
-	return &CreateNetstorageStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateNetstorageStorageParamsWithContext creates a new CreateNetstorageStorageParams object
-// with the ability to set a context for a request.
-func NewCreateNetstorageStorageParamsWithContext(ctx context.Context) *CreateNetstorageStorageParams {
-	return &CreateNetstorageStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateNetstorageStorageParamsWithHTTPClient creates a new CreateNetstorageStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateNetstorageStorageParamsWithHTTPClient(client *http.Client) *CreateNetstorageStorageParams {
-	return &CreateNetstorageStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateNetstorageStorageParams contains all the parameters to send to the API endpoint
-
-	for the create netstorage storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateNetstorageStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateNetstorageStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create netstorage storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateNetstorageStorageParams) WithDefaults() *CreateNetstorageStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create netstorage storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateNetstorageStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create netstorage storage params
-func (o *CreateNetstorageStorageParams) WithTimeout(timeout time.Duration) *CreateNetstorageStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create netstorage storage params
-func (o *CreateNetstorageStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create netstorage storage params
-func (o *CreateNetstorageStorageParams) WithContext(ctx context.Context) *CreateNetstorageStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create netstorage storage params
-func (o *CreateNetstorageStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create netstorage storage params
-func (o *CreateNetstorageStorageParams) WithHTTPClient(client *http.Client) *CreateNetstorageStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create netstorage storage params
-func (o *CreateNetstorageStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create netstorage storage params
-func (o *CreateNetstorageStorageParams) WithRequest(request *models.StorageCreateNetstorageStorageRequest) *CreateNetstorageStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create netstorage storage params
-func (o *CreateNetstorageStorageParams) SetRequest(request *models.StorageCreateNetstorageStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateNetstorageStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateNetstorageStorageReader is a Reader for the CreateNetstorageStorage structure.
-type CreateNetstorageStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateNetstorageStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateNetstorageStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateNetstorageStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateNetstorageStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/netstorage] CreateNetstorageStorage", response, response.Code())
-	}
-}
-
-// NewCreateNetstorageStorageOK creates a CreateNetstorageStorageOK with default headers values
-func NewCreateNetstorageStorageOK() *CreateNetstorageStorageOK {
-	return &CreateNetstorageStorageOK{}
-}
-
-/*
-CreateNetstorageStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateNetstorageStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create netstorage storage o k response has a 2xx status code
-func (o *CreateNetstorageStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create netstorage storage o k response has a 3xx status code
-func (o *CreateNetstorageStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create netstorage storage o k response has a 4xx status code
-func (o *CreateNetstorageStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create netstorage storage o k response has a 5xx status code
-func (o *CreateNetstorageStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create netstorage storage o k response a status code equal to that given
-func (o *CreateNetstorageStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create netstorage storage o k response
-func (o *CreateNetstorageStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateNetstorageStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/netstorage][%d] createNetstorageStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateNetstorageStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/netstorage][%d] createNetstorageStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateNetstorageStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateNetstorageStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateNetstorageStorageBadRequest creates a CreateNetstorageStorageBadRequest with default headers values
-func NewCreateNetstorageStorageBadRequest() *CreateNetstorageStorageBadRequest {
-	return &CreateNetstorageStorageBadRequest{}
-}
-
-/*
-CreateNetstorageStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateNetstorageStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create netstorage storage bad request response has a 2xx status code
-func (o *CreateNetstorageStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create netstorage storage bad request response has a 3xx status code
-func (o *CreateNetstorageStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create netstorage storage bad request response has a 4xx status code
-func (o *CreateNetstorageStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create netstorage storage bad request response has a 5xx status code
-func (o *CreateNetstorageStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create netstorage storage bad request response a status code equal to that given
-func (o *CreateNetstorageStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create netstorage storage bad request response
-func (o *CreateNetstorageStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateNetstorageStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/netstorage][%d] createNetstorageStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateNetstorageStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/netstorage][%d] createNetstorageStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateNetstorageStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateNetstorageStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateNetstorageStorageInternalServerError creates a CreateNetstorageStorageInternalServerError with default headers values
-func NewCreateNetstorageStorageInternalServerError() *CreateNetstorageStorageInternalServerError {
-	return &CreateNetstorageStorageInternalServerError{}
-}
-
-/*
-CreateNetstorageStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateNetstorageStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create netstorage storage internal server error response has a 2xx status code
-func (o *CreateNetstorageStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create netstorage storage internal server error response has a 3xx status code
-func (o *CreateNetstorageStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create netstorage storage internal server error response has a 4xx status code
-func (o *CreateNetstorageStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create netstorage storage internal server error response has a 5xx status code
-func (o *CreateNetstorageStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create netstorage storage internal server error response a status code equal to that given
-func (o *CreateNetstorageStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create netstorage storage internal server error response
-func (o *CreateNetstorageStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateNetstorageStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/netstorage][%d] createNetstorageStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateNetstorageStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/netstorage][%d] createNetstorageStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateNetstorageStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateNetstorageStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
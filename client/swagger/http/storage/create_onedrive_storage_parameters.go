@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateOnedriveStorageParams creates a new CreateOnedriveStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateOnedriveStorageParams() *CreateOnedriveStorageParams {
-	return &CreateOnedriveStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateOnedriveStorageParamsWithTimeout creates a new CreateOnedriveStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateOnedriveStorageParamsWithTimeout(timeout time.Duration) *CreateOnedriveStorageParams {
-	return &CreateOnedriveStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateOnedriveStorageParamsWithContext creates a new CreateOnedriveStorageParams object
-// with the ability to set a context for a request.
-func NewCreateOnedriveStorageParamsWithContext(ctx context.Context) *CreateOnedriveStorageParams {
-	return &CreateOnedriveStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateOnedriveStorageParamsWithHTTPClient creates a new CreateOnedriveStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateOnedriveStorageParamsWithHTTPClient(client *http.Client) *CreateOnedriveStorageParams {
-	return &CreateOnedriveStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateOnedriveStorageParams contains all the parameters to send to the API endpoint
-
-	for the create onedrive storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateOnedriveStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateOnedriveStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create onedrive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOnedriveStorageParams) WithDefaults() *CreateOnedriveStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create onedrive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOnedriveStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create onedrive storage params
-func (o *CreateOnedriveStorageParams) WithTimeout(timeout time.Duration) *CreateOnedriveStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create onedrive storage params
-func (o *CreateOnedriveStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create onedrive storage params
-func (o *CreateOnedriveStorageParams) WithContext(ctx context.Context) *CreateOnedriveStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create onedrive storage params
-func (o *CreateOnedriveStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create onedrive storage params
-func (o *CreateOnedriveStorageParams) WithHTTPClient(client *http.Client) *CreateOnedriveStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create onedrive storage params
-func (o *CreateOnedriveStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create onedrive storage params
-func (o *CreateOnedriveStorageParams) WithRequest(request *models.StorageCreateOnedriveStorageRequest) *CreateOnedriveStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create onedrive storage params
-func (o *CreateOnedriveStorageParams) SetRequest(request *models.StorageCreateOnedriveStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateOnedriveStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
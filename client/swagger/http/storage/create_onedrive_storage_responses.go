@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateOnedriveStorageReader is a Reader for the CreateOnedriveStorage structure.
-type CreateOnedriveStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateOnedriveStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateOnedriveStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateOnedriveStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateOnedriveStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/onedrive] CreateOnedriveStorage", response, response.Code())
-	}
-}
-
-// NewCreateOnedriveStorageOK creates a CreateOnedriveStorageOK with default headers values
-func NewCreateOnedriveStorageOK() *CreateOnedriveStorageOK {
-	return &CreateOnedriveStorageOK{}
-}
-
-/*
-CreateOnedriveStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateOnedriveStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create onedrive storage o k response has a 2xx status code
-func (o *CreateOnedriveStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create onedrive storage o k response has a 3xx status code
-func (o *CreateOnedriveStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create onedrive storage o k response has a 4xx status code
-func (o *CreateOnedriveStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create onedrive storage o k response has a 5xx status code
-func (o *CreateOnedriveStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create onedrive storage o k response a status code equal to that given
-func (o *CreateOnedriveStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create onedrive storage o k response
-func (o *CreateOnedriveStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateOnedriveStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/onedrive][%d] createOnedriveStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateOnedriveStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/onedrive][%d] createOnedriveStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateOnedriveStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateOnedriveStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateOnedriveStorageBadRequest creates a CreateOnedriveStorageBadRequest with default headers values
-func NewCreateOnedriveStorageBadRequest() *CreateOnedriveStorageBadRequest {
-	return &CreateOnedriveStorageBadRequest{}
-}
-
-/*
-CreateOnedriveStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateOnedriveStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create onedrive storage bad request response has a 2xx status code
-func (o *CreateOnedriveStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create onedrive storage bad request response has a 3xx status code
-func (o *CreateOnedriveStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create onedrive storage bad request response has a 4xx status code
-func (o *CreateOnedriveStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create onedrive storage bad request response has a 5xx status code
-func (o *CreateOnedriveStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create onedrive storage bad request response a status code equal to that given
-func (o *CreateOnedriveStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create onedrive storage bad request response
-func (o *CreateOnedriveStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateOnedriveStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/onedrive][%d] createOnedriveStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateOnedriveStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/onedrive][%d] createOnedriveStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateOnedriveStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateOnedriveStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateOnedriveStorageInternalServerError creates a CreateOnedriveStorageInternalServerError with default headers values
-func NewCreateOnedriveStorageInternalServerError() *CreateOnedriveStorageInternalServerError {
-	return &CreateOnedriveStorageInternalServerError{}
-}
-
-/*
-CreateOnedriveStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateOnedriveStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create onedrive storage internal server error response has a 2xx status code
-func (o *CreateOnedriveStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create onedrive storage internal server error response has a 3xx status code
-func (o *CreateOnedriveStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create onedrive storage internal server error response has a 4xx status code
-func (o *CreateOnedriveStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create onedrive storage internal server error response has a 5xx status code
-func (o *CreateOnedriveStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create onedrive storage internal server error response a status code equal to that given
-func (o *CreateOnedriveStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create onedrive storage internal server error response
-func (o *CreateOnedriveStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateOnedriveStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/onedrive][%d] createOnedriveStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateOnedriveStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/onedrive][%d] createOnedriveStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateOnedriveStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateOnedriveStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
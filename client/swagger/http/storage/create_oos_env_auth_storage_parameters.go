@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateOosEnvAuthStorageParams creates a new CreateOosEnvAuthStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateOosEnvAuthStorageParams() *CreateOosEnvAuthStorageParams {
-	return &CreateOosEnvAuthStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateOosEnvAuthStorageParamsWithTimeout creates a new CreateOosEnvAuthStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateOosEnvAuthStorageParamsWithTimeout(timeout time.Duration) *CreateOosEnvAuthStorageParams {
-	return &CreateOosEnvAuthStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateOosEnvAuthStorageParamsWithContext creates a new CreateOosEnvAuthStorageParams object
-// with the ability to set a context for a request.
-func NewCreateOosEnvAuthStorageParamsWithContext(ctx context.Context) *CreateOosEnvAuthStorageParams {
-	return &CreateOosEnvAuthStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateOosEnvAuthStorageParamsWithHTTPClient creates a new CreateOosEnvAuthStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateOosEnvAuthStorageParamsWithHTTPClient(client *http.Client) *CreateOosEnvAuthStorageParams {
-	return &CreateOosEnvAuthStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateOosEnvAuthStorageParams contains all the parameters to send to the API endpoint
-
-	for the create oos env auth storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateOosEnvAuthStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateOosEnvAuthStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create oos env auth storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOosEnvAuthStorageParams) WithDefaults() *CreateOosEnvAuthStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create oos env auth storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOosEnvAuthStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create oos env auth storage params
-func (o *CreateOosEnvAuthStorageParams) WithTimeout(timeout time.Duration) *CreateOosEnvAuthStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create oos env auth storage params
-func (o *CreateOosEnvAuthStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create oos env auth storage params
-func (o *CreateOosEnvAuthStorageParams) WithContext(ctx context.Context) *CreateOosEnvAuthStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create oos env auth storage params
-func (o *CreateOosEnvAuthStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create oos env auth storage params
-func (o *CreateOosEnvAuthStorageParams) WithHTTPClient(client *http.Client) *CreateOosEnvAuthStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create oos env auth storage params
-func (o *CreateOosEnvAuthStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create oos env auth storage params
-func (o *CreateOosEnvAuthStorageParams) WithRequest(request *models.StorageCreateOosEnvAuthStorageRequest) *CreateOosEnvAuthStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create oos env auth storage params
-func (o *CreateOosEnvAuthStorageParams) SetRequest(request *models.StorageCreateOosEnvAuthStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateOosEnvAuthStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
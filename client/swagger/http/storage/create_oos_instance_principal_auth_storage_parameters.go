@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateOosInstancePrincipalAuthStorageParams creates a new CreateOosInstancePrincipalAuthStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateOosInstancePrincipalAuthStorageParams() *CreateOosInstancePrincipalAuthStorageParams {
-	return &CreateOosInstancePrincipalAuthStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateOosInstancePrincipalAuthStorageParamsWithTimeout creates a new CreateOosInstancePrincipalAuthStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateOosInstancePrincipalAuthStorageParamsWithTimeout(timeout time.Duration) *CreateOosInstancePrincipalAuthStorageParams {
-	return &CreateOosInstancePrincipalAuthStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateOosInstancePrincipalAuthStorageParamsWithContext creates a new CreateOosInstancePrincipalAuthStorageParams object
-// with the ability to set a context for a request.
-func NewCreateOosInstancePrincipalAuthStorageParamsWithContext(ctx context.Context) *CreateOosInstancePrincipalAuthStorageParams {
-	return &CreateOosInstancePrincipalAuthStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateOosInstancePrincipalAuthStorageParamsWithHTTPClient creates a new CreateOosInstancePrincipalAuthStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateOosInstancePrincipalAuthStorageParamsWithHTTPClient(client *http.Client) *CreateOosInstancePrincipalAuthStorageParams {
-	return &CreateOosInstancePrincipalAuthStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateOosInstancePrincipalAuthStorageParams contains all the parameters to send to the API endpoint
-
-	for the create oos instance principal auth storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateOosInstancePrincipalAuthStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateOosInstancePrincipalAuthStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create oos instance principal auth storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOosInstancePrincipalAuthStorageParams) WithDefaults() *CreateOosInstancePrincipalAuthStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create oos instance principal auth storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOosInstancePrincipalAuthStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create oos instance principal auth storage params
-func (o *CreateOosInstancePrincipalAuthStorageParams) WithTimeout(timeout time.Duration) *CreateOosInstancePrincipalAuthStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create oos instance principal auth storage params
-func (o *CreateOosInstancePrincipalAuthStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create oos instance principal auth storage params
-func (o *CreateOosInstancePrincipalAuthStorageParams) WithContext(ctx context.Context) *CreateOosInstancePrincipalAuthStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create oos instance principal auth storage params
-func (o *CreateOosInstancePrincipalAuthStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create oos instance principal auth storage params
-func (o *CreateOosInstancePrincipalAuthStorageParams) WithHTTPClient(client *http.Client) *CreateOosInstancePrincipalAuthStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create oos instance principal auth storage params
-func (o *CreateOosInstancePrincipalAuthStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create oos instance principal auth storage params
-func (o *CreateOosInstancePrincipalAuthStorageParams) WithRequest(request *models.StorageCreateOosInstancePrincipalAuthStorageRequest) *CreateOosInstancePrincipalAuthStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create oos instance principal auth storage params
-func (o *CreateOosInstancePrincipalAuthStorageParams) SetRequest(request *models.StorageCreateOosInstancePrincipalAuthStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateOosInstancePrincipalAuthStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateOosNoAuthStorageParams creates a new CreateOosNoAuthStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateOosNoAuthStorageParams() *CreateOosNoAuthStorageParams {
-	return &CreateOosNoAuthStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateOosNoAuthStorageParamsWithTimeout creates a new CreateOosNoAuthStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateOosNoAuthStorageParamsWithTimeout(timeout time.Duration) *CreateOosNoAuthStorageParams {
-	return &CreateOosNoAuthStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateOosNoAuthStorageParamsWithContext creates a new CreateOosNoAuthStorageParams object
-// with the ability to set a context for a request.
-func NewCreateOosNoAuthStorageParamsWithContext(ctx context.Context) *CreateOosNoAuthStorageParams {
-	return &CreateOosNoAuthStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateOosNoAuthStorageParamsWithHTTPClient creates a new CreateOosNoAuthStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateOosNoAuthStorageParamsWithHTTPClient(client *http.Client) *CreateOosNoAuthStorageParams {
-	return &CreateOosNoAuthStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateOosNoAuthStorageParams contains all the parameters to send to the API endpoint
-
-	for the create oos no auth storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateOosNoAuthStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateOosNoAuthStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create oos no auth storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOosNoAuthStorageParams) WithDefaults() *CreateOosNoAuthStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create oos no auth storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOosNoAuthStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create oos no auth storage params
-func (o *CreateOosNoAuthStorageParams) WithTimeout(timeout time.Duration) *CreateOosNoAuthStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create oos no auth storage params
-func (o *CreateOosNoAuthStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create oos no auth storage params
-func (o *CreateOosNoAuthStorageParams) WithContext(ctx context.Context) *CreateOosNoAuthStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create oos no auth storage params
-func (o *CreateOosNoAuthStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create oos no auth storage params
-func (o *CreateOosNoAuthStorageParams) WithHTTPClient(client *http.Client) *CreateOosNoAuthStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create oos no auth storage params
-func (o *CreateOosNoAuthStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create oos no auth storage params
-func (o *CreateOosNoAuthStorageParams) WithRequest(request *models.StorageCreateOosNoAuthStorageRequest) *CreateOosNoAuthStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create oos no auth storage params
-func (o *CreateOosNoAuthStorageParams) SetRequest(request *models.StorageCreateOosNoAuthStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateOosNoAuthStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateOosNoAuthStorageReader is a Reader for the CreateOosNoAuthStorage structure.
-type CreateOosNoAuthStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateOosNoAuthStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateOosNoAuthStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateOosNoAuthStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateOosNoAuthStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/oos/no_auth] CreateOosNo_authStorage", response, response.Code())
-	}
-}
-
-// NewCreateOosNoAuthStorageOK creates a CreateOosNoAuthStorageOK with default headers values
-func NewCreateOosNoAuthStorageOK() *CreateOosNoAuthStorageOK {
-	return &CreateOosNoAuthStorageOK{}
-}
-
-/*
-CreateOosNoAuthStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateOosNoAuthStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create oos no auth storage o k response has a 2xx status code
-func (o *CreateOosNoAuthStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create oos no auth storage o k response has a 3xx status code
-func (o *CreateOosNoAuthStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create oos no auth storage o k response has a 4xx status code
-func (o *CreateOosNoAuthStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create oos no auth storage o k response has a 5xx status code
-func (o *CreateOosNoAuthStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create oos no auth storage o k response a status code equal to that given
-func (o *CreateOosNoAuthStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create oos no auth storage o k response
-func (o *CreateOosNoAuthStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateOosNoAuthStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/oos/no_auth][%d] createOosNoAuthStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateOosNoAuthStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/oos/no_auth][%d] createOosNoAuthStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateOosNoAuthStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateOosNoAuthStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateOosNoAuthStorageBadRequest creates a CreateOosNoAuthStorageBadRequest with default headers values
-func NewCreateOosNoAuthStorageBadRequest() *CreateOosNoAuthStorageBadRequest {
-	return &CreateOosNoAuthStorageBadRequest{}
-}
-
-/*
-CreateOosNoAuthStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateOosNoAuthStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create oos no auth storage bad request response has a 2xx status code
-func (o *CreateOosNoAuthStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create oos no auth storage bad request response has a 3xx status code
-func (o *CreateOosNoAuthStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create oos no auth storage bad request response has a 4xx status code
-func (o *CreateOosNoAuthStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create oos no auth storage bad request response has a 5xx status code
-func (o *CreateOosNoAuthStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create oos no auth storage bad request response a status code equal to that given
-func (o *CreateOosNoAuthStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create oos no auth storage bad request response
-func (o *CreateOosNoAuthStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateOosNoAuthStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/oos/no_auth][%d] createOosNoAuthStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateOosNoAuthStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/oos/no_auth][%d] createOosNoAuthStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateOosNoAuthStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateOosNoAuthStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateOosNoAuthStorageInternalServerError creates a CreateOosNoAuthStorageInternalServerError with default headers values
-func NewCreateOosNoAuthStorageInternalServerError() *CreateOosNoAuthStorageInternalServerError {
-	return &CreateOosNoAuthStorageInternalServerError{}
-}
-
-/*
-CreateOosNoAuthStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateOosNoAuthStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create oos no auth storage internal server error response has a 2xx status code
-func (o *CreateOosNoAuthStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create oos no auth storage internal server error response has a 3xx status code
-func (o *CreateOosNoAuthStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create oos no auth storage internal server error response has a 4xx status code
-func (o *CreateOosNoAuthStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create oos no auth storage internal server error response has a 5xx status code
-func (o *CreateOosNoAuthStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create oos no auth storage internal server error response a status code equal to that given
-func (o *CreateOosNoAuthStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create oos no auth storage internal server error response
-func (o *CreateOosNoAuthStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateOosNoAuthStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/oos/no_auth][%d] createOosNoAuthStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateOosNoAuthStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/oos/no_auth][%d] createOosNoAuthStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateOosNoAuthStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateOosNoAuthStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
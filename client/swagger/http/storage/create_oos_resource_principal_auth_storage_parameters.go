@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateOosResourcePrincipalAuthStorageParams creates a new CreateOosResourcePrincipalAuthStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateOosResourcePrincipalAuthStorageParams() *CreateOosResourcePrincipalAuthStorageParams {
-	return &CreateOosResourcePrincipalAuthStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateOosResourcePrincipalAuthStorageParamsWithTimeout creates a new CreateOosResourcePrincipalAuthStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateOosResourcePrincipalAuthStorageParamsWithTimeout(timeout time.Duration) *CreateOosResourcePrincipalAuthStorageParams {
-	return &CreateOosResourcePrincipalAuthStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateOosResourcePrincipalAuthStorageParamsWithContext creates a new CreateOosResourcePrincipalAuthStorageParams object
-// with the ability to set a context for a request.
-func NewCreateOosResourcePrincipalAuthStorageParamsWithContext(ctx context.Context) *CreateOosResourcePrincipalAuthStorageParams {
-	return &CreateOosResourcePrincipalAuthStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateOosResourcePrincipalAuthStorageParamsWithHTTPClient creates a new CreateOosResourcePrincipalAuthStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateOosResourcePrincipalAuthStorageParamsWithHTTPClient(client *http.Client) *CreateOosResourcePrincipalAuthStorageParams {
-	return &CreateOosResourcePrincipalAuthStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateOosResourcePrincipalAuthStorageParams contains all the parameters to send to the API endpoint
-
-	for the create oos resource principal auth storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateOosResourcePrincipalAuthStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateOosResourcePrincipalAuthStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create oos resource principal auth storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOosResourcePrincipalAuthStorageParams) WithDefaults() *CreateOosResourcePrincipalAuthStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create oos resource principal auth storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOosResourcePrincipalAuthStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create oos resource principal auth storage params
-func (o *CreateOosResourcePrincipalAuthStorageParams) WithTimeout(timeout time.Duration) *CreateOosResourcePrincipalAuthStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create oos resource principal auth storage params
-func (o *CreateOosResourcePrincipalAuthStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create oos resource principal auth storage params
-func (o *CreateOosResourcePrincipalAuthStorageParams) WithContext(ctx context.Context) *CreateOosResourcePrincipalAuthStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create oos resource principal auth storage params
-func (o *CreateOosResourcePrincipalAuthStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create oos resource principal auth storage params
-func (o *CreateOosResourcePrincipalAuthStorageParams) WithHTTPClient(client *http.Client) *CreateOosResourcePrincipalAuthStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create oos resource principal auth storage params
-func (o *CreateOosResourcePrincipalAuthStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create oos resource principal auth storage params
-func (o *CreateOosResourcePrincipalAuthStorageParams) WithRequest(request *models.StorageCreateOosResourcePrincipalAuthStorageRequest) *CreateOosResourcePrincipalAuthStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create oos resource principal auth storage params
-func (o *CreateOosResourcePrincipalAuthStorageParams) SetRequest(request *models.StorageCreateOosResourcePrincipalAuthStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateOosResourcePrincipalAuthStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateOosResourcePrincipalAuthStorageReader is a Reader for the CreateOosResourcePrincipalAuthStorage structure.
-type CreateOosResourcePrincipalAuthStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateOosResourcePrincipalAuthStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateOosResourcePrincipalAuthStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateOosResourcePrincipalAuthStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateOosResourcePrincipalAuthStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/oos/resource_principal_auth] CreateOosResource_principal_authStorage", response, response.Code())
-	}
-}
-
-// NewCreateOosResourcePrincipalAuthStorageOK creates a CreateOosResourcePrincipalAuthStorageOK with default headers values
-func NewCreateOosResourcePrincipalAuthStorageOK() *CreateOosResourcePrincipalAuthStorageOK {
-	return &CreateOosResourcePrincipalAuthStorageOK{}
-}
-
-/*
-CreateOosResourcePrincipalAuthStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateOosResourcePrincipalAuthStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create oos resource principal auth storage o k response has a 2xx status code
-func (o *CreateOosResourcePrincipalAuthStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create oos resource principal auth storage o k response has a 3xx status code
-func (o *CreateOosResourcePrincipalAuthStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create oos resource principal auth storage o k response has a 4xx status code
-func (o *CreateOosResourcePrincipalAuthStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create oos resource principal auth storage o k response has a 5xx status code
-func (o *CreateOosResourcePrincipalAuthStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create oos resource principal auth storage o k response a status code equal to that given
-func (o *CreateOosResourcePrincipalAuthStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create oos resource principal auth storage o k response
-func (o *CreateOosResourcePrincipalAuthStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/oos/resource_principal_auth][%d] createOosResourcePrincipalAuthStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/oos/resource_principal_auth][%d] createOosResourcePrincipalAuthStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateOosResourcePrincipalAuthStorageBadRequest creates a CreateOosResourcePrincipalAuthStorageBadRequest with default headers values
-func NewCreateOosResourcePrincipalAuthStorageBadRequest() *CreateOosResourcePrincipalAuthStorageBadRequest {
-	return &CreateOosResourcePrincipalAuthStorageBadRequest{}
-}
-
-/*
-CreateOosResourcePrincipalAuthStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateOosResourcePrincipalAuthStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create oos resource principal auth storage bad request response has a 2xx status code
-func (o *CreateOosResourcePrincipalAuthStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create oos resource principal auth storage bad request response has a 3xx status code
-func (o *CreateOosResourcePrincipalAuthStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create oos resource principal auth storage bad request response has a 4xx status code
-func (o *CreateOosResourcePrincipalAuthStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create oos resource principal auth storage bad request response has a 5xx status code
-func (o *CreateOosResourcePrincipalAuthStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create oos resource principal auth storage bad request response a status code equal to that given
-func (o *CreateOosResourcePrincipalAuthStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create oos resource principal auth storage bad request response
-func (o *CreateOosResourcePrincipalAuthStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/oos/resource_principal_auth][%d] createOosResourcePrincipalAuthStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/oos/resource_principal_auth][%d] createOosResourcePrincipalAuthStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateOosResourcePrincipalAuthStorageInternalServerError creates a CreateOosResourcePrincipalAuthStorageInternalServerError with default headers values
-func NewCreateOosResourcePrincipalAuthStorageInternalServerError() *CreateOosResourcePrincipalAuthStorageInternalServerError {
-	return &CreateOosResourcePrincipalAuthStorageInternalServerError{}
-}
-
-/*
-CreateOosResourcePrincipalAuthStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateOosResourcePrincipalAuthStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create oos resource principal auth storage internal server error response has a 2xx status code
-func (o *CreateOosResourcePrincipalAuthStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create oos resource principal auth storage internal server error response has a 3xx status code
-func (o *CreateOosResourcePrincipalAuthStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create oos resource principal auth storage internal server error response has a 4xx status code
-func (o *CreateOosResourcePrincipalAuthStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create oos resource principal auth storage internal server error response has a 5xx status code
-func (o *CreateOosResourcePrincipalAuthStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create oos resource principal auth storage internal server error response a status code equal to that given
-func (o *CreateOosResourcePrincipalAuthStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create oos resource principal auth storage internal server error response
-func (o *CreateOosResourcePrincipalAuthStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/oos/resource_principal_auth][%d] createOosResourcePrincipalAuthStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/oos/resource_principal_auth][%d] createOosResourcePrincipalAuthStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateOosResourcePrincipalAuthStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateOosUserPrincipalAuthStorageParams creates a new CreateOosUserPrincipalAuthStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateOosUserPrincipalAuthStorageParams() *CreateOosUserPrincipalAuthStorageParams {
-	return &CreateOosUserPrincipalAuthStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateOosUserPrincipalAuthStorageParamsWithTimeout creates a new CreateOosUserPrincipalAuthStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateOosUserPrincipalAuthStorageParamsWithTimeout(timeout time.Duration) *CreateOosUserPrincipalAuthStorageParams {
-	return &CreateOosUserPrincipalAuthStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateOosUserPrincipalAuthStorageParamsWithContext creates a new CreateOosUserPrincipalAuthStorageParams object
-// with the ability to set a context for a request.
-func NewCreateOosUserPrincipalAuthStorageParamsWithContext(ctx context.Context) *CreateOosUserPrincipalAuthStorageParams {
-	return &CreateOosUserPrincipalAuthStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateOosUserPrincipalAuthStorageParamsWithHTTPClient creates a new CreateOosUserPrincipalAuthStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateOosUserPrincipalAuthStorageParamsWithHTTPClient(client *http.Client) *CreateOosUserPrincipalAuthStorageParams {
-	return &CreateOosUserPrincipalAuthStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateOosUserPrincipalAuthStorageParams contains all the parameters to send to the API endpoint
-
-	for the create oos user principal auth storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateOosUserPrincipalAuthStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateOosUserPrincipalAuthStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create oos user principal auth storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOosUserPrincipalAuthStorageParams) WithDefaults() *CreateOosUserPrincipalAuthStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create oos user principal auth storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOosUserPrincipalAuthStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create oos user principal auth storage params
-func (o *CreateOosUserPrincipalAuthStorageParams) WithTimeout(timeout time.Duration) *CreateOosUserPrincipalAuthStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create oos user principal auth storage params
-func (o *CreateOosUserPrincipalAuthStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create oos user principal auth storage params
-func (o *CreateOosUserPrincipalAuthStorageParams) WithContext(ctx context.Context) *CreateOosUserPrincipalAuthStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create oos user principal auth storage params
-func (o *CreateOosUserPrincipalAuthStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create oos user principal auth storage params
-func (o *CreateOosUserPrincipalAuthStorageParams) WithHTTPClient(client *http.Client) *CreateOosUserPrincipalAuthStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create oos user principal auth storage params
-func (o *CreateOosUserPrincipalAuthStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create oos user principal auth storage params
-func (o *CreateOosUserPrincipalAuthStorageParams) WithRequest(request *models.StorageCreateOosUserPrincipalAuthStorageRequest) *CreateOosUserPrincipalAuthStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create oos user principal auth storage params
-func (o *CreateOosUserPrincipalAuthStorageParams) SetRequest(request *models.StorageCreateOosUserPrincipalAuthStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateOosUserPrincipalAuthStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
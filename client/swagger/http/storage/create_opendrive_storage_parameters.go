@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateOpendriveStorageParams creates a new CreateOpendriveStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateOpendriveStorageParams() *CreateOpendriveStorageParams {
-	return &CreateOpendriveStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateOpendriveStorageParamsWithTimeout creates a new CreateOpendriveStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateOpendriveStorageParamsWithTimeout(timeout time.Duration) *CreateOpendriveStorageParams {
-	return &CreateOpendriveStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateOpendriveStorageParamsWithContext creates a new CreateOpendriveStorageParams object
-// with the ability to set a context for a request.
-func NewCreateOpendriveStorageParamsWithContext(ctx context.Context) *CreateOpendriveStorageParams {
-	return &CreateOpendriveStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateOpendriveStorageParamsWithHTTPClient creates a new CreateOpendriveStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateOpendriveStorageParamsWithHTTPClient(client *http.Client) *CreateOpendriveStorageParams {
-	return &CreateOpendriveStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateOpendriveStorageParams contains all the parameters to send to the API endpoint
-
-	for the create opendrive storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateOpendriveStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateOpendriveStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create opendrive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOpendriveStorageParams) WithDefaults() *CreateOpendriveStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create opendrive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateOpendriveStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create opendrive storage params
-func (o *CreateOpendriveStorageParams) WithTimeout(timeout time.Duration) *CreateOpendriveStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create opendrive storage params
-func (o *CreateOpendriveStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create opendrive storage params
-func (o *CreateOpendriveStorageParams) WithContext(ctx context.Context) *CreateOpendriveStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create opendrive storage params
-func (o *CreateOpendriveStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create opendrive storage params
-func (o *CreateOpendriveStorageParams) WithHTTPClient(client *http.Client) *CreateOpendriveStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create opendrive storage params
-func (o *CreateOpendriveStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create opendrive storage params
-func (o *CreateOpendriveStorageParams) WithRequest(request *models.StorageCreateOpendriveStorageRequest) *CreateOpendriveStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create opendrive storage params
-func (o *CreateOpendriveStorageParams) SetRequest(request *models.StorageCreateOpendriveStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateOpendriveStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
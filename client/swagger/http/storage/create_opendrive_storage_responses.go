@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateOpendriveStorageReader is a Reader for the CreateOpendriveStorage structure.
-type CreateOpendriveStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateOpendriveStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateOpendriveStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateOpendriveStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateOpendriveStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/opendrive] CreateOpendriveStorage", response, response.Code())
-	}
-}
-
-// NewCreateOpendriveStorageOK creates a CreateOpendriveStorageOK with default headers values
-func NewCreateOpendriveStorageOK() *CreateOpendriveStorageOK {
-	return &CreateOpendriveStorageOK{}
-}
-
-/*
-CreateOpendriveStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateOpendriveStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create opendrive storage o k response has a 2xx status code
-func (o *CreateOpendriveStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create opendrive storage o k response has a 3xx status code
-func (o *CreateOpendriveStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create opendrive storage o k response has a 4xx status code
-func (o *CreateOpendriveStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create opendrive storage o k response has a 5xx status code
-func (o *CreateOpendriveStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create opendrive storage o k response a status code equal to that given
-func (o *CreateOpendriveStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create opendrive storage o k response
-func (o *CreateOpendriveStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateOpendriveStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/opendrive][%d] createOpendriveStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateOpendriveStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/opendrive][%d] createOpendriveStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateOpendriveStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateOpendriveStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateOpendriveStorageBadRequest creates a CreateOpendriveStorageBadRequest with default headers values
-func NewCreateOpendriveStorageBadRequest() *CreateOpendriveStorageBadRequest {
-	return &CreateOpendriveStorageBadRequest{}
-}
-
-/*
-CreateOpendriveStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateOpendriveStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create opendrive storage bad request response has a 2xx status code
-func (o *CreateOpendriveStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create opendrive storage bad request response has a 3xx status code
-func (o *CreateOpendriveStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create opendrive storage bad request response has a 4xx status code
-func (o *CreateOpendriveStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create opendrive storage bad request response has a 5xx status code
-func (o *CreateOpendriveStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create opendrive storage bad request response a status code equal to that given
-func (o *CreateOpendriveStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create opendrive storage bad request response
-func (o *CreateOpendriveStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateOpendriveStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/opendrive][%d] createOpendriveStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateOpendriveStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/opendrive][%d] createOpendriveStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateOpendriveStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateOpendriveStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateOpendriveStorageInternalServerError creates a CreateOpendriveStorageInternalServerError with default headers values
-func NewCreateOpendriveStorageInternalServerError() *CreateOpendriveStorageInternalServerError {
-	return &CreateOpendriveStorageInternalServerError{}
-}
-
-/*
-CreateOpendriveStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateOpendriveStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create opendrive storage internal server error response has a 2xx status code
-func (o *CreateOpendriveStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create opendrive storage internal server error response has a 3xx status code
-func (o *CreateOpendriveStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create opendrive storage internal server error response has a 4xx status code
-func (o *CreateOpendriveStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create opendrive storage internal server error response has a 5xx status code
-func (o *CreateOpendriveStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create opendrive storage internal server error response a status code equal to that given
-func (o *CreateOpendriveStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create opendrive storage internal server error response
-func (o *CreateOpendriveStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateOpendriveStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/opendrive][%d] createOpendriveStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateOpendriveStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/opendrive][%d] createOpendriveStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateOpendriveStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateOpendriveStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreatePcloudStorageReader is a Reader for the CreatePcloudStorage structure.
-type CreatePcloudStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreatePcloudStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreatePcloudStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreatePcloudStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreatePcloudStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/pcloud] CreatePcloudStorage", response, response.Code())
-	}
-}
-
-// NewCreatePcloudStorageOK creates a CreatePcloudStorageOK with default headers values
-func NewCreatePcloudStorageOK() *CreatePcloudStorageOK {
-	return &CreatePcloudStorageOK{}
-}
-
-/*
-CreatePcloudStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreatePcloudStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create pcloud storage o k response has a 2xx status code
-func (o *CreatePcloudStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create pcloud storage o k response has a 3xx status code
-func (o *CreatePcloudStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create pcloud storage o k response has a 4xx status code
-func (o *CreatePcloudStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create pcloud storage o k response has a 5xx status code
-func (o *CreatePcloudStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create pcloud storage o k response a status code equal to that given
-func (o *CreatePcloudStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create pcloud storage o k response
-func (o *CreatePcloudStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreatePcloudStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/pcloud][%d] createPcloudStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreatePcloudStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/pcloud][%d] createPcloudStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreatePcloudStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreatePcloudStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreatePcloudStorageBadRequest creates a CreatePcloudStorageBadRequest with default headers values
-func NewCreatePcloudStorageBadRequest() *CreatePcloudStorageBadRequest {
-	return &CreatePcloudStorageBadRequest{}
-}
-
-/*
-CreatePcloudStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreatePcloudStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create pcloud storage bad request response has a 2xx status code
-func (o *CreatePcloudStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create pcloud storage bad request response has a 3xx status code
-func (o *CreatePcloudStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create pcloud storage bad request response has a 4xx status code
-func (o *CreatePcloudStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create pcloud storage bad request response has a 5xx status code
-func (o *CreatePcloudStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create pcloud storage bad request response a status code equal to that given
-func (o *CreatePcloudStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create pcloud storage bad request response
-func (o *CreatePcloudStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreatePcloudStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/pcloud][%d] createPcloudStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreatePcloudStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/pcloud][%d] createPcloudStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreatePcloudStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreatePcloudStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreatePcloudStorageInternalServerError creates a CreatePcloudStorageInternalServerError with default headers values
-func NewCreatePcloudStorageInternalServerError() *CreatePcloudStorageInternalServerError {
-	return &CreatePcloudStorageInternalServerError{}
-}
-
-/*
-CreatePcloudStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreatePcloudStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create pcloud storage internal server error response has a 2xx status code
-func (o *CreatePcloudStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create pcloud storage internal server error response has a 3xx status code
-func (o *CreatePcloudStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create pcloud storage internal server error response has a 4xx status code
-func (o *CreatePcloudStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create pcloud storage internal server error response has a 5xx status code
-func (o *CreatePcloudStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create pcloud storage internal server error response a status code equal to that given
-func (o *CreatePcloudStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create pcloud storage internal server error response
-func (o *CreatePcloudStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreatePcloudStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/pcloud][%d] createPcloudStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreatePcloudStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/pcloud][%d] createPcloudStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreatePcloudStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreatePcloudStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
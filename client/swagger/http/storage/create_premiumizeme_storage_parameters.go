@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreatePremiumizemeStorageParams creates a new CreatePremiumizemeStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreatePremiumizemeStorageParams() *CreatePremiumizemeStorageParams {
-	return &CreatePremiumizemeStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreatePremiumizemeStorageParamsWithTimeout creates a new CreatePremiumizemeStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreatePremiumizemeStorageParamsWithTimeout(timeout time.Duration) *CreatePremiumizemeStorageParams {
-	return &CreatePremiumizemeStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreatePremiumizemeStorageParamsWithContext creates a new CreatePremiumizemeStorageParams object
-// with the ability to set a context for a request.
-func NewCreatePremiumizemeStorageParamsWithContext(ctx context.Context) *CreatePremiumizemeStorageParams {
-	return &CreatePremiumizemeStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreatePremiumizemeStorageParamsWithHTTPClient creates a new CreatePremiumizemeStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreatePremiumizemeStorageParamsWithHTTPClient(client *http.Client) *CreatePremiumizemeStorageParams {
-	return &CreatePremiumizemeStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreatePremiumizemeStorageParams contains all the parameters to send to the API endpoint
-
-	for the create premiumizeme storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreatePremiumizemeStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreatePremiumizemeStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create premiumizeme storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreatePremiumizemeStorageParams) WithDefaults() *CreatePremiumizemeStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create premiumizeme storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreatePremiumizemeStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create premiumizeme storage params
-func (o *CreatePremiumizemeStorageParams) WithTimeout(timeout time.Duration) *CreatePremiumizemeStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create premiumizeme storage params
-func (o *CreatePremiumizemeStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create premiumizeme storage params
-func (o *CreatePremiumizemeStorageParams) WithContext(ctx context.Context) *CreatePremiumizemeStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create premiumizeme storage params
-func (o *CreatePremiumizemeStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create premiumizeme storage params
-func (o *CreatePremiumizemeStorageParams) WithHTTPClient(client *http.Client) *CreatePremiumizemeStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create premiumizeme storage params
-func (o *CreatePremiumizemeStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create premiumizeme storage params
-func (o *CreatePremiumizemeStorageParams) WithRequest(request *models.StorageCreatePremiumizemeStorageRequest) *CreatePremiumizemeStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create premiumizeme storage params
-func (o *CreatePremiumizemeStorageParams) SetRequest(request *models.StorageCreatePremiumizemeStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreatePremiumizemeStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
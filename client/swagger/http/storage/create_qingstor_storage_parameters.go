@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateQingstorStorageParams creates a new CreateQingstorStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateQingstorStorageParams() *CreateQingstorStorageParams {
-	return &CreateQingstorStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateQingstorStorageParamsWithTimeout creates a new CreateQingstorStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateQingstorStorageParamsWithTimeout(timeout time.Duration) *CreateQingstorStorageParams {
-	return &CreateQingstorStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateQingstorStorageParamsWithContext creates a new CreateQingstorStorageParams object
-// with the ability to set a context for a request.
-func NewCreateQingstorStorageParamsWithContext(ctx context.Context) *CreateQingstorStorageParams {
-	return &CreateQingstorStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateQingstorStorageParamsWithHTTPClient creates a new CreateQingstorStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateQingstorStorageParamsWithHTTPClient(client *http.Client) *CreateQingstorStorageParams {
-	return &CreateQingstorStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateQingstorStorageParams contains all the parameters to send to the API endpoint
-
-	for the create qingstor storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateQingstorStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateQingstorStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create qingstor storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateQingstorStorageParams) WithDefaults() *CreateQingstorStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create qingstor storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateQingstorStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create qingstor storage params
-func (o *CreateQingstorStorageParams) WithTimeout(timeout time.Duration) *CreateQingstorStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create qingstor storage params
-func (o *CreateQingstorStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create qingstor storage params
-func (o *CreateQingstorStorageParams) WithContext(ctx context.Context) *CreateQingstorStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create qingstor storage params
-func (o *CreateQingstorStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create qingstor storage params
-func (o *CreateQingstorStorageParams) WithHTTPClient(client *http.Client) *CreateQingstorStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create qingstor storage params
-func (o *CreateQingstorStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create qingstor storage params
-func (o *CreateQingstorStorageParams) WithRequest(request *models.StorageCreateQingstorStorageRequest) *CreateQingstorStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create qingstor storage params
-func (o *CreateQingstorStorageParams) SetRequest(request *models.StorageCreateQingstorStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateQingstorStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
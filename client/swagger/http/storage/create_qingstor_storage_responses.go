@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateQingstorStorageReader is a Reader for the CreateQingstorStorage structure.
-type CreateQingstorStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateQingstorStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateQingstorStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateQingstorStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateQingstorStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/qingstor] CreateQingstorStorage", response, response.Code())
-	}
-}
-
-// NewCreateQingstorStorageOK creates a CreateQingstorStorageOK with default headers values
-func NewCreateQingstorStorageOK() *CreateQingstorStorageOK {
-	return &CreateQingstorStorageOK{}
-}
-
-/*
-CreateQingstorStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateQingstorStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create qingstor storage o k response has a 2xx status code
-func (o *CreateQingstorStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create qingstor storage o k response has a 3xx status code
-func (o *CreateQingstorStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create qingstor storage o k response has a 4xx status code
-func (o *CreateQingstorStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create qingstor storage o k response has a 5xx status code
-func (o *CreateQingstorStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create qingstor storage o k response a status code equal to that given
-func (o *CreateQingstorStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create qingstor storage o k response
-func (o *CreateQingstorStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateQingstorStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/qingstor][%d] createQingstorStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateQingstorStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/qingstor][%d] createQingstorStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateQingstorStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateQingstorStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateQingstorStorageBadRequest creates a CreateQingstorStorageBadRequest with default headers values
-func NewCreateQingstorStorageBadRequest() *CreateQingstorStorageBadRequest {
-	return &CreateQingstorStorageBadRequest{}
-}
-
-/*
-CreateQingstorStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateQingstorStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create qingstor storage bad request response has a 2xx status code
-func (o *CreateQingstorStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create qingstor storage bad request response has a 3xx status code
-func (o *CreateQingstorStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create qingstor storage bad request response has a 4xx status code
-func (o *CreateQingstorStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create qingstor storage bad request response has a 5xx status code
-func (o *CreateQingstorStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create qingstor storage bad request response a status code equal to that given
-func (o *CreateQingstorStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create qingstor storage bad request response
-func (o *CreateQingstorStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateQingstorStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/qingstor][%d] createQingstorStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateQingstorStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/qingstor][%d] createQingstorStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateQingstorStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateQingstorStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateQingstorStorageInternalServerError creates a CreateQingstorStorageInternalServerError with default headers values
-func NewCreateQingstorStorageInternalServerError() *CreateQingstorStorageInternalServerError {
-	return &CreateQingstorStorageInternalServerError{}
-}
-
-/*
-CreateQingstorStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateQingstorStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create qingstor storage internal server error response has a 2xx status code
-func (o *CreateQingstorStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create qingstor storage internal server error response has a 3xx status code
-func (o *CreateQingstorStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create qingstor storage internal server error response has a 4xx status code
-func (o *CreateQingstorStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create qingstor storage internal server error response has a 5xx status code
-func (o *CreateQingstorStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create qingstor storage internal server error response a status code equal to that given
-func (o *CreateQingstorStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create qingstor storage internal server error response
-func (o *CreateQingstorStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateQingstorStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/qingstor][%d] createQingstorStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateQingstorStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/qingstor][%d] createQingstorStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateQingstorStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateQingstorStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
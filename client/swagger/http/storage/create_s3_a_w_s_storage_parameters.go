@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3AWSStorageParams creates a new CreateS3AWSStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3AWSStorageParams() *CreateS3AWSStorageParams {
-	return &CreateS3AWSStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3AWSStorageParamsWithTimeout creates a new CreateS3AWSStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3AWSStorageParamsWithTimeout(timeout time.Duration) *CreateS3AWSStorageParams {
-	return &CreateS3AWSStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3AWSStorageParamsWithContext creates a new CreateS3AWSStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3AWSStorageParamsWithContext(ctx context.Context) *CreateS3AWSStorageParams {
-	return &CreateS3AWSStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3AWSStorageParamsWithHTTPClient creates a new CreateS3AWSStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3AWSStorageParamsWithHTTPClient(client *http.Client) *CreateS3AWSStorageParams {
-	return &CreateS3AWSStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3AWSStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 a w s storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3AWSStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3AWSStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 a w s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3AWSStorageParams) WithDefaults() *CreateS3AWSStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 a w s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3AWSStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 a w s storage params
-func (o *CreateS3AWSStorageParams) WithTimeout(timeout time.Duration) *CreateS3AWSStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 a w s storage params
-func (o *CreateS3AWSStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 a w s storage params
-func (o *CreateS3AWSStorageParams) WithContext(ctx context.Context) *CreateS3AWSStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 a w s storage params
-func (o *CreateS3AWSStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 a w s storage params
-func (o *CreateS3AWSStorageParams) WithHTTPClient(client *http.Client) *CreateS3AWSStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 a w s storage params
-func (o *CreateS3AWSStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 a w s storage params
-func (o *CreateS3AWSStorageParams) WithRequest(request *models.StorageCreateS3AWSStorageRequest) *CreateS3AWSStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 a w s storage params
-func (o *CreateS3AWSStorageParams) SetRequest(request *models.StorageCreateS3AWSStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3AWSStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3AlibabaStorageParams creates a new CreateS3AlibabaStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3AlibabaStorageParams() *CreateS3AlibabaStorageParams {
-	return &CreateS3AlibabaStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3AlibabaStorageParamsWithTimeout creates a new CreateS3AlibabaStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3AlibabaStorageParamsWithTimeout(timeout time.Duration) *CreateS3AlibabaStorageParams {
-	return &CreateS3AlibabaStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3AlibabaStorageParamsWithContext creates a new CreateS3AlibabaStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3AlibabaStorageParamsWithContext(ctx context.Context) *CreateS3AlibabaStorageParams {
-	return &CreateS3AlibabaStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3AlibabaStorageParamsWithHTTPClient creates a new CreateS3AlibabaStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3AlibabaStorageParamsWithHTTPClient(client *http.Client) *CreateS3AlibabaStorageParams {
-	return &CreateS3AlibabaStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3AlibabaStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 alibaba storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3AlibabaStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3AlibabaStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 alibaba storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3AlibabaStorageParams) WithDefaults() *CreateS3AlibabaStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 alibaba storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3AlibabaStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 alibaba storage params
-func (o *CreateS3AlibabaStorageParams) WithTimeout(timeout time.Duration) *CreateS3AlibabaStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 alibaba storage params
-func (o *CreateS3AlibabaStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 alibaba storage params
-func (o *CreateS3AlibabaStorageParams) WithContext(ctx context.Context) *CreateS3AlibabaStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 alibaba storage params
-func (o *CreateS3AlibabaStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 alibaba storage params
-func (o *CreateS3AlibabaStorageParams) WithHTTPClient(client *http.Client) *CreateS3AlibabaStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 alibaba storage params
-func (o *CreateS3AlibabaStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 alibaba storage params
-func (o *CreateS3AlibabaStorageParams) WithRequest(request *models.StorageCreateS3AlibabaStorageRequest) *CreateS3AlibabaStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 alibaba storage params
-func (o *CreateS3AlibabaStorageParams) SetRequest(request *models.StorageCreateS3AlibabaStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3AlibabaStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3CephStorageParams creates a new CreateS3CephStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3CephStorageParams() *CreateS3CephStorageParams {
-	return &CreateS3CephStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3CephStorageParamsWithTimeout creates a new CreateS3CephStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3CephStorageParamsWithTimeout(timeout time.Duration) *CreateS3CephStorageParams {
-	return &CreateS3CephStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3CephStorageParamsWithContext creates a new CreateS3CephStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3CephStorageParamsWithContext(ctx context.Context) *CreateS3CephStorageParams {
-	return &CreateS3CephStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3CephStorageParamsWithHTTPClient creates a new CreateS3CephStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3CephStorageParamsWithHTTPClient(client *http.Client) *CreateS3CephStorageParams {
-	return &CreateS3CephStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3CephStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 ceph storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3CephStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3CephStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 ceph storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3CephStorageParams) WithDefaults() *CreateS3CephStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 ceph storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3CephStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 ceph storage params
-func (o *CreateS3CephStorageParams) WithTimeout(timeout time.Duration) *CreateS3CephStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 ceph storage params
-func (o *CreateS3CephStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 ceph storage params
-func (o *CreateS3CephStorageParams) WithContext(ctx context.Context) *CreateS3CephStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 ceph storage params
-func (o *CreateS3CephStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 ceph storage params
-func (o *CreateS3CephStorageParams) WithHTTPClient(client *http.Client) *CreateS3CephStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 ceph storage params
-func (o *CreateS3CephStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 ceph storage params
-func (o *CreateS3CephStorageParams) WithRequest(request *models.StorageCreateS3CephStorageRequest) *CreateS3CephStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 ceph storage params
-func (o *CreateS3CephStorageParams) SetRequest(request *models.StorageCreateS3CephStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3CephStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateS3CephStorageReader is a Reader for the CreateS3CephStorage structure.
-type CreateS3CephStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateS3CephStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateS3CephStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateS3CephStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateS3CephStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/s3/ceph] CreateS3CephStorage", response, response.Code())
-	}
-}
-
-// NewCreateS3CephStorageOK creates a CreateS3CephStorageOK with default headers values
-func NewCreateS3CephStorageOK() *CreateS3CephStorageOK {
-	return &CreateS3CephStorageOK{}
-}
-
-/*
-CreateS3CephStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateS3CephStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create s3 ceph storage o k response has a 2xx status code
-func (o *CreateS3CephStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create s3 ceph storage o k response has a 3xx status code
-func (o *CreateS3CephStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 ceph storage o k response has a 4xx status code
-func (o *CreateS3CephStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 ceph storage o k response has a 5xx status code
-func (o *CreateS3CephStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 ceph storage o k response a status code equal to that given
-func (o *CreateS3CephStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create s3 ceph storage o k response
-func (o *CreateS3CephStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateS3CephStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/ceph][%d] createS3CephStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3CephStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/s3/ceph][%d] createS3CephStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3CephStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateS3CephStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3CephStorageBadRequest creates a CreateS3CephStorageBadRequest with default headers values
-func NewCreateS3CephStorageBadRequest() *CreateS3CephStorageBadRequest {
-	return &CreateS3CephStorageBadRequest{}
-}
-
-/*
-CreateS3CephStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateS3CephStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 ceph storage bad request response has a 2xx status code
-func (o *CreateS3CephStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 ceph storage bad request response has a 3xx status code
-func (o *CreateS3CephStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 ceph storage bad request response has a 4xx status code
-func (o *CreateS3CephStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create s3 ceph storage bad request response has a 5xx status code
-func (o *CreateS3CephStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 ceph storage bad request response a status code equal to that given
-func (o *CreateS3CephStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create s3 ceph storage bad request response
-func (o *CreateS3CephStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateS3CephStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/ceph][%d] createS3CephStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3CephStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/s3/ceph][%d] createS3CephStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3CephStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3CephStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3CephStorageInternalServerError creates a CreateS3CephStorageInternalServerError with default headers values
-func NewCreateS3CephStorageInternalServerError() *CreateS3CephStorageInternalServerError {
-	return &CreateS3CephStorageInternalServerError{}
-}
-
-/*
-CreateS3CephStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateS3CephStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 ceph storage internal server error response has a 2xx status code
-func (o *CreateS3CephStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 ceph storage internal server error response has a 3xx status code
-func (o *CreateS3CephStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 ceph storage internal server error response has a 4xx status code
-func (o *CreateS3CephStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 ceph storage internal server error response has a 5xx status code
-func (o *CreateS3CephStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create s3 ceph storage internal server error response a status code equal to that given
-func (o *CreateS3CephStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create s3 ceph storage internal server error response
-func (o *CreateS3CephStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateS3CephStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/ceph][%d] createS3CephStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3CephStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/s3/ceph][%d] createS3CephStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3CephStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3CephStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3ChinaMobileStorageParams creates a new CreateS3ChinaMobileStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3ChinaMobileStorageParams() *CreateS3ChinaMobileStorageParams {
-	return &CreateS3ChinaMobileStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3ChinaMobileStorageParamsWithTimeout creates a new CreateS3ChinaMobileStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3ChinaMobileStorageParamsWithTimeout(timeout time.Duration) *CreateS3ChinaMobileStorageParams {
-	return &CreateS3ChinaMobileStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3ChinaMobileStorageParamsWithContext creates a new CreateS3ChinaMobileStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3ChinaMobileStorageParamsWithContext(ctx context.Context) *CreateS3ChinaMobileStorageParams {
-	return &CreateS3ChinaMobileStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3ChinaMobileStorageParamsWithHTTPClient creates a new CreateS3ChinaMobileStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3ChinaMobileStorageParamsWithHTTPClient(client *http.Client) *CreateS3ChinaMobileStorageParams {
-	return &CreateS3ChinaMobileStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3ChinaMobileStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 china mobile storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3ChinaMobileStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3ChinaMobileStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 china mobile storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3ChinaMobileStorageParams) WithDefaults() *CreateS3ChinaMobileStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 china mobile storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3ChinaMobileStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 china mobile storage params
-func (o *CreateS3ChinaMobileStorageParams) WithTimeout(timeout time.Duration) *CreateS3ChinaMobileStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 china mobile storage params
-func (o *CreateS3ChinaMobileStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 china mobile storage params
-func (o *CreateS3ChinaMobileStorageParams) WithContext(ctx context.Context) *CreateS3ChinaMobileStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 china mobile storage params
-func (o *CreateS3ChinaMobileStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 china mobile storage params
-func (o *CreateS3ChinaMobileStorageParams) WithHTTPClient(client *http.Client) *CreateS3ChinaMobileStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 china mobile storage params
-func (o *CreateS3ChinaMobileStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 china mobile storage params
-func (o *CreateS3ChinaMobileStorageParams) WithRequest(request *models.StorageCreateS3ChinaMobileStorageRequest) *CreateS3ChinaMobileStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 china mobile storage params
-func (o *CreateS3ChinaMobileStorageParams) SetRequest(request *models.StorageCreateS3ChinaMobileStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3ChinaMobileStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
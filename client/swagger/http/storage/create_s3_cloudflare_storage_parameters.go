@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3CloudflareStorageParams creates a new CreateS3CloudflareStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3CloudflareStorageParams() *CreateS3CloudflareStorageParams {
-	return &CreateS3CloudflareStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3CloudflareStorageParamsWithTimeout creates a new CreateS3CloudflareStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3CloudflareStorageParamsWithTimeout(timeout time.Duration) *CreateS3CloudflareStorageParams {
-	return &CreateS3CloudflareStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3CloudflareStorageParamsWithContext creates a new CreateS3CloudflareStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3CloudflareStorageParamsWithContext(ctx context.Context) *CreateS3CloudflareStorageParams {
-	return &CreateS3CloudflareStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3CloudflareStorageParamsWithHTTPClient creates a new CreateS3CloudflareStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3CloudflareStorageParamsWithHTTPClient(client *http.Client) *CreateS3CloudflareStorageParams {
-	return &CreateS3CloudflareStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3CloudflareStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 cloudflare storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3CloudflareStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3CloudflareStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 cloudflare storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3CloudflareStorageParams) WithDefaults() *CreateS3CloudflareStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 cloudflare storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3CloudflareStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 cloudflare storage params
-func (o *CreateS3CloudflareStorageParams) WithTimeout(timeout time.Duration) *CreateS3CloudflareStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 cloudflare storage params
-func (o *CreateS3CloudflareStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 cloudflare storage params
-func (o *CreateS3CloudflareStorageParams) WithContext(ctx context.Context) *CreateS3CloudflareStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 cloudflare storage params
-func (o *CreateS3CloudflareStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 cloudflare storage params
-func (o *CreateS3CloudflareStorageParams) WithHTTPClient(client *http.Client) *CreateS3CloudflareStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 cloudflare storage params
-func (o *CreateS3CloudflareStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 cloudflare storage params
-func (o *CreateS3CloudflareStorageParams) WithRequest(request *models.StorageCreateS3CloudflareStorageRequest) *CreateS3CloudflareStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 cloudflare storage params
-func (o *CreateS3CloudflareStorageParams) SetRequest(request *models.StorageCreateS3CloudflareStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3CloudflareStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3DigitalOceanStorageParams creates a new CreateS3DigitalOceanStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3DigitalOceanStorageParams() *CreateS3DigitalOceanStorageParams {
-	return &CreateS3DigitalOceanStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3DigitalOceanStorageParamsWithTimeout creates a new CreateS3DigitalOceanStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3DigitalOceanStorageParamsWithTimeout(timeout time.Duration) *CreateS3DigitalOceanStorageParams {
-	return &CreateS3DigitalOceanStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3DigitalOceanStorageParamsWithContext creates a new CreateS3DigitalOceanStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3DigitalOceanStorageParamsWithContext(ctx context.Context) *CreateS3DigitalOceanStorageParams {
-	return &CreateS3DigitalOceanStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3DigitalOceanStorageParamsWithHTTPClient creates a new CreateS3DigitalOceanStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3DigitalOceanStorageParamsWithHTTPClient(client *http.Client) *CreateS3DigitalOceanStorageParams {
-	return &CreateS3DigitalOceanStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3DigitalOceanStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 digital ocean storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3DigitalOceanStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3DigitalOceanStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 digital ocean storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3DigitalOceanStorageParams) WithDefaults() *CreateS3DigitalOceanStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 digital ocean storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3DigitalOceanStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 digital ocean storage params
-func (o *CreateS3DigitalOceanStorageParams) WithTimeout(timeout time.Duration) *CreateS3DigitalOceanStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 digital ocean storage params
-func (o *CreateS3DigitalOceanStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 digital ocean storage params
-func (o *CreateS3DigitalOceanStorageParams) WithContext(ctx context.Context) *CreateS3DigitalOceanStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 digital ocean storage params
-func (o *CreateS3DigitalOceanStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 digital ocean storage params
-func (o *CreateS3DigitalOceanStorageParams) WithHTTPClient(client *http.Client) *CreateS3DigitalOceanStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 digital ocean storage params
-func (o *CreateS3DigitalOceanStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 digital ocean storage params
-func (o *CreateS3DigitalOceanStorageParams) WithRequest(request *models.StorageCreateS3DigitalOceanStorageRequest) *CreateS3DigitalOceanStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 digital ocean storage params
-func (o *CreateS3DigitalOceanStorageParams) SetRequest(request *models.StorageCreateS3DigitalOceanStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3DigitalOceanStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
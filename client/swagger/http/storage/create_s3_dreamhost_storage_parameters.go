@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3DreamhostStorageParams creates a new CreateS3DreamhostStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3DreamhostStorageParams() *CreateS3DreamhostStorageParams {
-	return &CreateS3DreamhostStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3DreamhostStorageParamsWithTimeout creates a new CreateS3DreamhostStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3DreamhostStorageParamsWithTimeout(timeout time.Duration) *CreateS3DreamhostStorageParams {
-	return &CreateS3DreamhostStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3DreamhostStorageParamsWithContext creates a new CreateS3DreamhostStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3DreamhostStorageParamsWithContext(ctx context.Context) *CreateS3DreamhostStorageParams {
-	return &CreateS3DreamhostStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3DreamhostStorageParamsWithHTTPClient creates a new CreateS3DreamhostStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3DreamhostStorageParamsWithHTTPClient(client *http.Client) *CreateS3DreamhostStorageParams {
-	return &CreateS3DreamhostStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3DreamhostStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 dreamhost storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3DreamhostStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3DreamhostStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 dreamhost storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3DreamhostStorageParams) WithDefaults() *CreateS3DreamhostStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 dreamhost storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3DreamhostStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 dreamhost storage params
-func (o *CreateS3DreamhostStorageParams) WithTimeout(timeout time.Duration) *CreateS3DreamhostStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 dreamhost storage params
-func (o *CreateS3DreamhostStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 dreamhost storage params
-func (o *CreateS3DreamhostStorageParams) WithContext(ctx context.Context) *CreateS3DreamhostStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 dreamhost storage params
-func (o *CreateS3DreamhostStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 dreamhost storage params
-func (o *CreateS3DreamhostStorageParams) WithHTTPClient(client *http.Client) *CreateS3DreamhostStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 dreamhost storage params
-func (o *CreateS3DreamhostStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 dreamhost storage params
-func (o *CreateS3DreamhostStorageParams) WithRequest(request *models.StorageCreateS3DreamhostStorageRequest) *CreateS3DreamhostStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 dreamhost storage params
-func (o *CreateS3DreamhostStorageParams) SetRequest(request *models.StorageCreateS3DreamhostStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3DreamhostStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
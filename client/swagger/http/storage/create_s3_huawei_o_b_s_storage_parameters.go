@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3HuaweiOBSStorageParams creates a new CreateS3HuaweiOBSStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3HuaweiOBSStorageParams() *CreateS3HuaweiOBSStorageParams {
-	return &CreateS3HuaweiOBSStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3HuaweiOBSStorageParamsWithTimeout creates a new CreateS3HuaweiOBSStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3HuaweiOBSStorageParamsWithTimeout(timeout time.Duration) *CreateS3HuaweiOBSStorageParams {
-	return &CreateS3HuaweiOBSStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3HuaweiOBSStorageParamsWithContext creates a new CreateS3HuaweiOBSStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3HuaweiOBSStorageParamsWithContext(ctx context.Context) *CreateS3HuaweiOBSStorageParams {
-	return &CreateS3HuaweiOBSStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3HuaweiOBSStorageParamsWithHTTPClient creates a new CreateS3HuaweiOBSStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3HuaweiOBSStorageParamsWithHTTPClient(client *http.Client) *CreateS3HuaweiOBSStorageParams {
-	return &CreateS3HuaweiOBSStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3HuaweiOBSStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 huawei o b s storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3HuaweiOBSStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3HuaweiOBSStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 huawei o b s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3HuaweiOBSStorageParams) WithDefaults() *CreateS3HuaweiOBSStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 huawei o b s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3HuaweiOBSStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 huawei o b s storage params
-func (o *CreateS3HuaweiOBSStorageParams) WithTimeout(timeout time.Duration) *CreateS3HuaweiOBSStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 huawei o b s storage params
-func (o *CreateS3HuaweiOBSStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 huawei o b s storage params
-func (o *CreateS3HuaweiOBSStorageParams) WithContext(ctx context.Context) *CreateS3HuaweiOBSStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 huawei o b s storage params
-func (o *CreateS3HuaweiOBSStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 huawei o b s storage params
-func (o *CreateS3HuaweiOBSStorageParams) WithHTTPClient(client *http.Client) *CreateS3HuaweiOBSStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 huawei o b s storage params
-func (o *CreateS3HuaweiOBSStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 huawei o b s storage params
-func (o *CreateS3HuaweiOBSStorageParams) WithRequest(request *models.StorageCreateS3HuaweiOBSStorageRequest) *CreateS3HuaweiOBSStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 huawei o b s storage params
-func (o *CreateS3HuaweiOBSStorageParams) SetRequest(request *models.StorageCreateS3HuaweiOBSStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3HuaweiOBSStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3IBMCOSStorageParams creates a new CreateS3IBMCOSStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3IBMCOSStorageParams() *CreateS3IBMCOSStorageParams {
-	return &CreateS3IBMCOSStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3IBMCOSStorageParamsWithTimeout creates a new CreateS3IBMCOSStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3IBMCOSStorageParamsWithTimeout(timeout time.Duration) *CreateS3IBMCOSStorageParams {
-	return &CreateS3IBMCOSStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3IBMCOSStorageParamsWithContext creates a new CreateS3IBMCOSStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3IBMCOSStorageParamsWithContext(ctx context.Context) *CreateS3IBMCOSStorageParams {
-	return &CreateS3IBMCOSStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3IBMCOSStorageParamsWithHTTPClient creates a new CreateS3IBMCOSStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3IBMCOSStorageParamsWithHTTPClient(client *http.Client) *CreateS3IBMCOSStorageParams {
-	return &CreateS3IBMCOSStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3IBMCOSStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 i b m c o s storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3IBMCOSStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3IBMCOSStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 i b m c o s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3IBMCOSStorageParams) WithDefaults() *CreateS3IBMCOSStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 i b m c o s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3IBMCOSStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 i b m c o s storage params
-func (o *CreateS3IBMCOSStorageParams) WithTimeout(timeout time.Duration) *CreateS3IBMCOSStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 i b m c o s storage params
-func (o *CreateS3IBMCOSStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 i b m c o s storage params
-func (o *CreateS3IBMCOSStorageParams) WithContext(ctx context.Context) *CreateS3IBMCOSStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 i b m c o s storage params
-func (o *CreateS3IBMCOSStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 i b m c o s storage params
-func (o *CreateS3IBMCOSStorageParams) WithHTTPClient(client *http.Client) *CreateS3IBMCOSStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 i b m c o s storage params
-func (o *CreateS3IBMCOSStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 i b m c o s storage params
-func (o *CreateS3IBMCOSStorageParams) WithRequest(request *models.StorageCreateS3IBMCOSStorageRequest) *CreateS3IBMCOSStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 i b m c o s storage params
-func (o *CreateS3IBMCOSStorageParams) SetRequest(request *models.StorageCreateS3IBMCOSStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3IBMCOSStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3IDriveStorageParams creates a new CreateS3IDriveStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3IDriveStorageParams() *CreateS3IDriveStorageParams {
-	return &CreateS3IDriveStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3IDriveStorageParamsWithTimeout creates a new CreateS3IDriveStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3IDriveStorageParamsWithTimeout(timeout time.Duration) *CreateS3IDriveStorageParams {
-	return &CreateS3IDriveStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3IDriveStorageParamsWithContext creates a new CreateS3IDriveStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3IDriveStorageParamsWithContext(ctx context.Context) *CreateS3IDriveStorageParams {
-	return &CreateS3IDriveStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3IDriveStorageParamsWithHTTPClient creates a new CreateS3IDriveStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3IDriveStorageParamsWithHTTPClient(client *http.Client) *CreateS3IDriveStorageParams {
-	return &CreateS3IDriveStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3IDriveStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 i drive storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3IDriveStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3IDriveStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 i drive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3IDriveStorageParams) WithDefaults() *CreateS3IDriveStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 i drive storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3IDriveStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 i drive storage params
-func (o *CreateS3IDriveStorageParams) WithTimeout(timeout time.Duration) *CreateS3IDriveStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 i drive storage params
-func (o *CreateS3IDriveStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 i drive storage params
-func (o *CreateS3IDriveStorageParams) WithContext(ctx context.Context) *CreateS3IDriveStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 i drive storage params
-func (o *CreateS3IDriveStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 i drive storage params
-func (o *CreateS3IDriveStorageParams) WithHTTPClient(client *http.Client) *CreateS3IDriveStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 i drive storage params
-func (o *CreateS3IDriveStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 i drive storage params
-func (o *CreateS3IDriveStorageParams) WithRequest(request *models.StorageCreateS3IDriveStorageRequest) *CreateS3IDriveStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 i drive storage params
-func (o *CreateS3IDriveStorageParams) SetRequest(request *models.StorageCreateS3IDriveStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3IDriveStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
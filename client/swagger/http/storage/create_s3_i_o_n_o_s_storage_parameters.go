@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3IONOSStorageParams creates a new CreateS3IONOSStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3IONOSStorageParams() *CreateS3IONOSStorageParams {
-	return &CreateS3IONOSStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3IONOSStorageParamsWithTimeout creates a new CreateS3IONOSStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3IONOSStorageParamsWithTimeout(timeout time.Duration) *CreateS3IONOSStorageParams {
-	return &CreateS3IONOSStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3IONOSStorageParamsWithContext creates a new CreateS3IONOSStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3IONOSStorageParamsWithContext(ctx context.Context) *CreateS3IONOSStorageParams {
-	return &CreateS3IONOSStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3IONOSStorageParamsWithHTTPClient creates a new CreateS3IONOSStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3IONOSStorageParamsWithHTTPClient(client *http.Client) *CreateS3IONOSStorageParams {
-	return &CreateS3IONOSStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3IONOSStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 i o n o s storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3IONOSStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3IONOSStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 i o n o s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3IONOSStorageParams) WithDefaults() *CreateS3IONOSStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 i o n o s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3IONOSStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 i o n o s storage params
-func (o *CreateS3IONOSStorageParams) WithTimeout(timeout time.Duration) *CreateS3IONOSStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 i o n o s storage params
-func (o *CreateS3IONOSStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 i o n o s storage params
-func (o *CreateS3IONOSStorageParams) WithContext(ctx context.Context) *CreateS3IONOSStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 i o n o s storage params
-func (o *CreateS3IONOSStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 i o n o s storage params
-func (o *CreateS3IONOSStorageParams) WithHTTPClient(client *http.Client) *CreateS3IONOSStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 i o n o s storage params
-func (o *CreateS3IONOSStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 i o n o s storage params
-func (o *CreateS3IONOSStorageParams) WithRequest(request *models.StorageCreateS3IONOSStorageRequest) *CreateS3IONOSStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 i o n o s storage params
-func (o *CreateS3IONOSStorageParams) SetRequest(request *models.StorageCreateS3IONOSStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3IONOSStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
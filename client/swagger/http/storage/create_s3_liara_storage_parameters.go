@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3LiaraStorageParams creates a new CreateS3LiaraStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3LiaraStorageParams() *CreateS3LiaraStorageParams {
-	return &CreateS3LiaraStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3LiaraStorageParamsWithTimeout creates a new CreateS3LiaraStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3LiaraStorageParamsWithTimeout(timeout time.Duration) *CreateS3LiaraStorageParams {
-	return &CreateS3LiaraStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3LiaraStorageParamsWithContext creates a new CreateS3LiaraStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3LiaraStorageParamsWithContext(ctx context.Context) *CreateS3LiaraStorageParams {
-	return &CreateS3LiaraStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3LiaraStorageParamsWithHTTPClient creates a new CreateS3LiaraStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3LiaraStorageParamsWithHTTPClient(client *http.Client) *CreateS3LiaraStorageParams {
-	return &CreateS3LiaraStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3LiaraStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 liara storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3LiaraStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3LiaraStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 liara storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3LiaraStorageParams) WithDefaults() *CreateS3LiaraStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 liara storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3LiaraStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 liara storage params
-func (o *CreateS3LiaraStorageParams) WithTimeout(timeout time.Duration) *CreateS3LiaraStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 liara storage params
-func (o *CreateS3LiaraStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 liara storage params
-func (o *CreateS3LiaraStorageParams) WithContext(ctx context.Context) *CreateS3LiaraStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 liara storage params
-func (o *CreateS3LiaraStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 liara storage params
-func (o *CreateS3LiaraStorageParams) WithHTTPClient(client *http.Client) *CreateS3LiaraStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 liara storage params
-func (o *CreateS3LiaraStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 liara storage params
-func (o *CreateS3LiaraStorageParams) WithRequest(request *models.StorageCreateS3LiaraStorageRequest) *CreateS3LiaraStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 liara storage params
-func (o *CreateS3LiaraStorageParams) SetRequest(request *models.StorageCreateS3LiaraStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3LiaraStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3LyveCloudStorageParams creates a new CreateS3LyveCloudStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3LyveCloudStorageParams() *CreateS3LyveCloudStorageParams {
-	return &CreateS3LyveCloudStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3LyveCloudStorageParamsWithTimeout creates a new CreateS3LyveCloudStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3LyveCloudStorageParamsWithTimeout(timeout time.Duration) *CreateS3LyveCloudStorageParams {
-	return &CreateS3LyveCloudStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3LyveCloudStorageParamsWithContext creates a new CreateS3LyveCloudStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3LyveCloudStorageParamsWithContext(ctx context.Context) *CreateS3LyveCloudStorageParams {
-	return &CreateS3LyveCloudStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3LyveCloudStorageParamsWithHTTPClient creates a new CreateS3LyveCloudStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3LyveCloudStorageParamsWithHTTPClient(client *http.Client) *CreateS3LyveCloudStorageParams {
-	return &CreateS3LyveCloudStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3LyveCloudStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 lyve cloud storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3LyveCloudStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3LyveCloudStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 lyve cloud storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3LyveCloudStorageParams) WithDefaults() *CreateS3LyveCloudStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 lyve cloud storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3LyveCloudStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 lyve cloud storage params
-func (o *CreateS3LyveCloudStorageParams) WithTimeout(timeout time.Duration) *CreateS3LyveCloudStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 lyve cloud storage params
-func (o *CreateS3LyveCloudStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 lyve cloud storage params
-func (o *CreateS3LyveCloudStorageParams) WithContext(ctx context.Context) *CreateS3LyveCloudStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 lyve cloud storage params
-func (o *CreateS3LyveCloudStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 lyve cloud storage params
-func (o *CreateS3LyveCloudStorageParams) WithHTTPClient(client *http.Client) *CreateS3LyveCloudStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 lyve cloud storage params
-func (o *CreateS3LyveCloudStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 lyve cloud storage params
-func (o *CreateS3LyveCloudStorageParams) WithRequest(request *models.StorageCreateS3LyveCloudStorageRequest) *CreateS3LyveCloudStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 lyve cloud storage params
-func (o *CreateS3LyveCloudStorageParams) SetRequest(request *models.StorageCreateS3LyveCloudStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3LyveCloudStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
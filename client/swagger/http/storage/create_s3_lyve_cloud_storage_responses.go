@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateS3LyveCloudStorageReader is a Reader for the CreateS3LyveCloudStorage structure.
-type CreateS3LyveCloudStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateS3LyveCloudStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateS3LyveCloudStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateS3LyveCloudStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateS3LyveCloudStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/s3/lyvecloud] CreateS3LyveCloudStorage", response, response.Code())
-	}
-}
-
-// NewCreateS3LyveCloudStorageOK creates a CreateS3LyveCloudStorageOK with default headers values
-func NewCreateS3LyveCloudStorageOK() *CreateS3LyveCloudStorageOK {
-	return &CreateS3LyveCloudStorageOK{}
-}
-
-/*
-CreateS3LyveCloudStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateS3LyveCloudStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create s3 lyve cloud storage o k response has a 2xx status code
-func (o *CreateS3LyveCloudStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create s3 lyve cloud storage o k response has a 3xx status code
-func (o *CreateS3LyveCloudStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 lyve cloud storage o k response has a 4xx status code
-func (o *CreateS3LyveCloudStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 lyve cloud storage o k response has a 5xx status code
-func (o *CreateS3LyveCloudStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 lyve cloud storage o k response a status code equal to that given
-func (o *CreateS3LyveCloudStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create s3 lyve cloud storage o k response
-func (o *CreateS3LyveCloudStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateS3LyveCloudStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/lyvecloud][%d] createS3LyveCloudStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3LyveCloudStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/s3/lyvecloud][%d] createS3LyveCloudStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3LyveCloudStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateS3LyveCloudStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3LyveCloudStorageBadRequest creates a CreateS3LyveCloudStorageBadRequest with default headers values
-func NewCreateS3LyveCloudStorageBadRequest() *CreateS3LyveCloudStorageBadRequest {
-	return &CreateS3LyveCloudStorageBadRequest{}
-}
-
-/*
-CreateS3LyveCloudStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateS3LyveCloudStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 lyve cloud storage bad request response has a 2xx status code
-func (o *CreateS3LyveCloudStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 lyve cloud storage bad request response has a 3xx status code
-func (o *CreateS3LyveCloudStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 lyve cloud storage bad request response has a 4xx status code
-func (o *CreateS3LyveCloudStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create s3 lyve cloud storage bad request response has a 5xx status code
-func (o *CreateS3LyveCloudStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 lyve cloud storage bad request response a status code equal to that given
-func (o *CreateS3LyveCloudStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create s3 lyve cloud storage bad request response
-func (o *CreateS3LyveCloudStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateS3LyveCloudStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/lyvecloud][%d] createS3LyveCloudStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3LyveCloudStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/s3/lyvecloud][%d] createS3LyveCloudStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3LyveCloudStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3LyveCloudStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3LyveCloudStorageInternalServerError creates a CreateS3LyveCloudStorageInternalServerError with default headers values
-func NewCreateS3LyveCloudStorageInternalServerError() *CreateS3LyveCloudStorageInternalServerError {
-	return &CreateS3LyveCloudStorageInternalServerError{}
-}
-
-/*
-CreateS3LyveCloudStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateS3LyveCloudStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 lyve cloud storage internal server error response has a 2xx status code
-func (o *CreateS3LyveCloudStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 lyve cloud storage internal server error response has a 3xx status code
-func (o *CreateS3LyveCloudStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 lyve cloud storage internal server error response has a 4xx status code
-func (o *CreateS3LyveCloudStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 lyve cloud storage internal server error response has a 5xx status code
-func (o *CreateS3LyveCloudStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create s3 lyve cloud storage internal server error response a status code equal to that given
-func (o *CreateS3LyveCloudStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create s3 lyve cloud storage internal server error response
-func (o *CreateS3LyveCloudStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateS3LyveCloudStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/lyvecloud][%d] createS3LyveCloudStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3LyveCloudStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/s3/lyvecloud][%d] createS3LyveCloudStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3LyveCloudStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3LyveCloudStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3MinioStorageParams creates a new CreateS3MinioStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3MinioStorageParams() *CreateS3MinioStorageParams {
-	return &CreateS3MinioStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3MinioStorageParamsWithTimeout creates a new CreateS3MinioStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3MinioStorageParamsWithTimeout(timeout time.Duration) *CreateS3MinioStorageParams {
-	return &CreateS3MinioStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3MinioStorageParamsWithContext creates a new CreateS3MinioStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3MinioStorageParamsWithContext(ctx context.Context) *CreateS3MinioStorageParams {
-	return &CreateS3MinioStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3MinioStorageParamsWithHTTPClient creates a new CreateS3MinioStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3MinioStorageParamsWithHTTPClient(client *http.Client) *CreateS3MinioStorageParams {
-	return &CreateS3MinioStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3MinioStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 minio storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3MinioStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3MinioStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 minio storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3MinioStorageParams) WithDefaults() *CreateS3MinioStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 minio storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3MinioStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 minio storage params
-func (o *CreateS3MinioStorageParams) WithTimeout(timeout time.Duration) *CreateS3MinioStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 minio storage params
-func (o *CreateS3MinioStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 minio storage params
-func (o *CreateS3MinioStorageParams) WithContext(ctx context.Context) *CreateS3MinioStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 minio storage params
-func (o *CreateS3MinioStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 minio storage params
-func (o *CreateS3MinioStorageParams) WithHTTPClient(client *http.Client) *CreateS3MinioStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 minio storage params
-func (o *CreateS3MinioStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 minio storage params
-func (o *CreateS3MinioStorageParams) WithRequest(request *models.StorageCreateS3MinioStorageRequest) *CreateS3MinioStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 minio storage params
-func (o *CreateS3MinioStorageParams) SetRequest(request *models.StorageCreateS3MinioStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3MinioStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
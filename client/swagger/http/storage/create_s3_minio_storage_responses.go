@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateS3MinioStorageReader is a Reader for the CreateS3MinioStorage structure.
-type CreateS3MinioStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateS3MinioStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateS3MinioStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateS3MinioStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateS3MinioStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/s3/minio] CreateS3MinioStorage", response, response.Code())
-	}
-}
-
-// NewCreateS3MinioStorageOK creates a CreateS3MinioStorageOK with default headers values
-func NewCreateS3MinioStorageOK() *CreateS3MinioStorageOK {
-	return &CreateS3MinioStorageOK{}
-}
-
-/*
-CreateS3MinioStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateS3MinioStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create s3 minio storage o k response has a 2xx status code
-func (o *CreateS3MinioStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create s3 minio storage o k response has a 3xx status code
-func (o *CreateS3MinioStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 minio storage o k response has a 4xx status code
-func (o *CreateS3MinioStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 minio storage o k response has a 5xx status code
-func (o *CreateS3MinioStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 minio storage o k response a status code equal to that given
-func (o *CreateS3MinioStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create s3 minio storage o k response
-func (o *CreateS3MinioStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateS3MinioStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/minio][%d] createS3MinioStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3MinioStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/s3/minio][%d] createS3MinioStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3MinioStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateS3MinioStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3MinioStorageBadRequest creates a CreateS3MinioStorageBadRequest with default headers values
-func NewCreateS3MinioStorageBadRequest() *CreateS3MinioStorageBadRequest {
-	return &CreateS3MinioStorageBadRequest{}
-}
-
-/*
-CreateS3MinioStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateS3MinioStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 minio storage bad request response has a 2xx status code
-func (o *CreateS3MinioStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 minio storage bad request response has a 3xx status code
-func (o *CreateS3MinioStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 minio storage bad request response has a 4xx status code
-func (o *CreateS3MinioStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create s3 minio storage bad request response has a 5xx status code
-func (o *CreateS3MinioStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 minio storage bad request response a status code equal to that given
-func (o *CreateS3MinioStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create s3 minio storage bad request response
-func (o *CreateS3MinioStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateS3MinioStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/minio][%d] createS3MinioStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3MinioStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/s3/minio][%d] createS3MinioStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3MinioStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3MinioStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3MinioStorageInternalServerError creates a CreateS3MinioStorageInternalServerError with default headers values
-func NewCreateS3MinioStorageInternalServerError() *CreateS3MinioStorageInternalServerError {
-	return &CreateS3MinioStorageInternalServerError{}
-}
-
-/*
-CreateS3MinioStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateS3MinioStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 minio storage internal server error response has a 2xx status code
-func (o *CreateS3MinioStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 minio storage internal server error response has a 3xx status code
-func (o *CreateS3MinioStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 minio storage internal server error response has a 4xx status code
-func (o *CreateS3MinioStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 minio storage internal server error response has a 5xx status code
-func (o *CreateS3MinioStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create s3 minio storage internal server error response a status code equal to that given
-func (o *CreateS3MinioStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create s3 minio storage internal server error response
-func (o *CreateS3MinioStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateS3MinioStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/minio][%d] createS3MinioStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3MinioStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/s3/minio][%d] createS3MinioStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3MinioStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3MinioStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateS3NeteaseStorageReader is a Reader for the CreateS3NeteaseStorage structure.
-type CreateS3NeteaseStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateS3NeteaseStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateS3NeteaseStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateS3NeteaseStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateS3NeteaseStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/s3/netease] CreateS3NeteaseStorage", response, response.Code())
-	}
-}
-
-// NewCreateS3NeteaseStorageOK creates a CreateS3NeteaseStorageOK with default headers values
-func NewCreateS3NeteaseStorageOK() *CreateS3NeteaseStorageOK {
-	return &CreateS3NeteaseStorageOK{}
-}
-
-/*
-CreateS3NeteaseStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateS3NeteaseStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create s3 netease storage o k response has a 2xx status code
-func (o *CreateS3NeteaseStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create s3 netease storage o k response has a 3xx status code
-func (o *CreateS3NeteaseStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 netease storage o k response has a 4xx status code
-func (o *CreateS3NeteaseStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 netease storage o k response has a 5xx status code
-func (o *CreateS3NeteaseStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 netease storage o k response a status code equal to that given
-func (o *CreateS3NeteaseStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create s3 netease storage o k response
-func (o *CreateS3NeteaseStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateS3NeteaseStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/netease][%d] createS3NeteaseStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3NeteaseStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/s3/netease][%d] createS3NeteaseStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3NeteaseStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateS3NeteaseStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3NeteaseStorageBadRequest creates a CreateS3NeteaseStorageBadRequest with default headers values
-func NewCreateS3NeteaseStorageBadRequest() *CreateS3NeteaseStorageBadRequest {
-	return &CreateS3NeteaseStorageBadRequest{}
-}
-
-/*
-CreateS3NeteaseStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateS3NeteaseStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 netease storage bad request response has a 2xx status code
-func (o *CreateS3NeteaseStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 netease storage bad request response has a 3xx status code
-func (o *CreateS3NeteaseStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 netease storage bad request response has a 4xx status code
-func (o *CreateS3NeteaseStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create s3 netease storage bad request response has a 5xx status code
-func (o *CreateS3NeteaseStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 netease storage bad request response a status code equal to that given
-func (o *CreateS3NeteaseStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create s3 netease storage bad request response
-func (o *CreateS3NeteaseStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateS3NeteaseStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/netease][%d] createS3NeteaseStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3NeteaseStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/s3/netease][%d] createS3NeteaseStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3NeteaseStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3NeteaseStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3NeteaseStorageInternalServerError creates a CreateS3NeteaseStorageInternalServerError with default headers values
-func NewCreateS3NeteaseStorageInternalServerError() *CreateS3NeteaseStorageInternalServerError {
-	return &CreateS3NeteaseStorageInternalServerError{}
-}
-
-/*
-CreateS3NeteaseStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateS3NeteaseStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 netease storage internal server error response has a 2xx status code
-func (o *CreateS3NeteaseStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 netease storage internal server error response has a 3xx status code
-func (o *CreateS3NeteaseStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 netease storage internal server error response has a 4xx status code
-func (o *CreateS3NeteaseStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 netease storage internal server error response has a 5xx status code
-func (o *CreateS3NeteaseStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create s3 netease storage internal server error response a status code equal to that given
-func (o *CreateS3NeteaseStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create s3 netease storage internal server error response
-func (o *CreateS3NeteaseStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateS3NeteaseStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/netease][%d] createS3NeteaseStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3NeteaseStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/s3/netease][%d] createS3NeteaseStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3NeteaseStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3NeteaseStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
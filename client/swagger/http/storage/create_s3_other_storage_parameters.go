@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3OtherStorageParams creates a new CreateS3OtherStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3OtherStorageParams() *CreateS3OtherStorageParams {
-	return &CreateS3OtherStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3OtherStorageParamsWithTimeout creates a new CreateS3OtherStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3OtherStorageParamsWithTimeout(timeout time.Duration) *CreateS3OtherStorageParams {
-	return &CreateS3OtherStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3OtherStorageParamsWithContext creates a new CreateS3OtherStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3OtherStorageParamsWithContext(ctx context.Context) *CreateS3OtherStorageParams {
-	return &CreateS3OtherStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3OtherStorageParamsWithHTTPClient creates a new CreateS3OtherStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3OtherStorageParamsWithHTTPClient(client *http.Client) *CreateS3OtherStorageParams {
-	return &CreateS3OtherStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3OtherStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 other storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3OtherStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3OtherStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 other storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3OtherStorageParams) WithDefaults() *CreateS3OtherStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 other storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3OtherStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 other storage params
-func (o *CreateS3OtherStorageParams) WithTimeout(timeout time.Duration) *CreateS3OtherStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 other storage params
-func (o *CreateS3OtherStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 other storage params
-func (o *CreateS3OtherStorageParams) WithContext(ctx context.Context) *CreateS3OtherStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 other storage params
-func (o *CreateS3OtherStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 other storage params
-func (o *CreateS3OtherStorageParams) WithHTTPClient(client *http.Client) *CreateS3OtherStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 other storage params
-func (o *CreateS3OtherStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 other storage params
-func (o *CreateS3OtherStorageParams) WithRequest(request *models.StorageCreateS3OtherStorageRequest) *CreateS3OtherStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 other storage params
-func (o *CreateS3OtherStorageParams) SetRequest(request *models.StorageCreateS3OtherStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3OtherStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateS3OtherStorageReader is a Reader for the CreateS3OtherStorage structure.
-type CreateS3OtherStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateS3OtherStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateS3OtherStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateS3OtherStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateS3OtherStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/s3/other] CreateS3OtherStorage", response, response.Code())
-	}
-}
-
-// NewCreateS3OtherStorageOK creates a CreateS3OtherStorageOK with default headers values
-func NewCreateS3OtherStorageOK() *CreateS3OtherStorageOK {
-	return &CreateS3OtherStorageOK{}
-}
-
-/*
-CreateS3OtherStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateS3OtherStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create s3 other storage o k response has a 2xx status code
-func (o *CreateS3OtherStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create s3 other storage o k response has a 3xx status code
-func (o *CreateS3OtherStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 other storage o k response has a 4xx status code
-func (o *CreateS3OtherStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 other storage o k response has a 5xx status code
-func (o *CreateS3OtherStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 other storage o k response a status code equal to that given
-func (o *CreateS3OtherStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create s3 other storage o k response
-func (o *CreateS3OtherStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateS3OtherStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/other][%d] createS3OtherStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3OtherStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/s3/other][%d] createS3OtherStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3OtherStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateS3OtherStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3OtherStorageBadRequest creates a CreateS3OtherStorageBadRequest with default headers values
-func NewCreateS3OtherStorageBadRequest() *CreateS3OtherStorageBadRequest {
-	return &CreateS3OtherStorageBadRequest{}
-}
-
-/*
-CreateS3OtherStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateS3OtherStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 other storage bad request response has a 2xx status code
-func (o *CreateS3OtherStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 other storage bad request response has a 3xx status code
-func (o *CreateS3OtherStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 other storage bad request response has a 4xx status code
-func (o *CreateS3OtherStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create s3 other storage bad request response has a 5xx status code
-func (o *CreateS3OtherStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 other storage bad request response a status code equal to that given
-func (o *CreateS3OtherStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create s3 other storage bad request response
-func (o *CreateS3OtherStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateS3OtherStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/other][%d] createS3OtherStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3OtherStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/s3/other][%d] createS3OtherStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3OtherStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3OtherStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3OtherStorageInternalServerError creates a CreateS3OtherStorageInternalServerError with default headers values
-func NewCreateS3OtherStorageInternalServerError() *CreateS3OtherStorageInternalServerError {
-	return &CreateS3OtherStorageInternalServerError{}
-}
-
-/*
-CreateS3OtherStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateS3OtherStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 other storage internal server error response has a 2xx status code
-func (o *CreateS3OtherStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 other storage internal server error response has a 3xx status code
-func (o *CreateS3OtherStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 other storage internal server error response has a 4xx status code
-func (o *CreateS3OtherStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 other storage internal server error response has a 5xx status code
-func (o *CreateS3OtherStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create s3 other storage internal server error response a status code equal to that given
-func (o *CreateS3OtherStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create s3 other storage internal server error response
-func (o *CreateS3OtherStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateS3OtherStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/other][%d] createS3OtherStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3OtherStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/s3/other][%d] createS3OtherStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3OtherStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3OtherStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3QiniuStorageParams creates a new CreateS3QiniuStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3QiniuStorageParams() *CreateS3QiniuStorageParams {
-	return &CreateS3QiniuStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3QiniuStorageParamsWithTimeout creates a new CreateS3QiniuStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3QiniuStorageParamsWithTimeout(timeout time.Duration) *CreateS3QiniuStorageParams {
-	return &CreateS3QiniuStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3QiniuStorageParamsWithContext creates a new CreateS3QiniuStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3QiniuStorageParamsWithContext(ctx context.Context) *CreateS3QiniuStorageParams {
-	return &CreateS3QiniuStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3QiniuStorageParamsWithHTTPClient creates a new CreateS3QiniuStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3QiniuStorageParamsWithHTTPClient(client *http.Client) *CreateS3QiniuStorageParams {
-	return &CreateS3QiniuStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3QiniuStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 qiniu storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3QiniuStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3QiniuStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 qiniu storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3QiniuStorageParams) WithDefaults() *CreateS3QiniuStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 qiniu storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3QiniuStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 qiniu storage params
-func (o *CreateS3QiniuStorageParams) WithTimeout(timeout time.Duration) *CreateS3QiniuStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 qiniu storage params
-func (o *CreateS3QiniuStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 qiniu storage params
-func (o *CreateS3QiniuStorageParams) WithContext(ctx context.Context) *CreateS3QiniuStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 qiniu storage params
-func (o *CreateS3QiniuStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 qiniu storage params
-func (o *CreateS3QiniuStorageParams) WithHTTPClient(client *http.Client) *CreateS3QiniuStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 qiniu storage params
-func (o *CreateS3QiniuStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 qiniu storage params
-func (o *CreateS3QiniuStorageParams) WithRequest(request *models.StorageCreateS3QiniuStorageRequest) *CreateS3QiniuStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 qiniu storage params
-func (o *CreateS3QiniuStorageParams) SetRequest(request *models.StorageCreateS3QiniuStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3QiniuStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
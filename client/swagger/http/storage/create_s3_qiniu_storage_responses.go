@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateS3QiniuStorageReader is a Reader for the CreateS3QiniuStorage structure.
-type CreateS3QiniuStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateS3QiniuStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateS3QiniuStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateS3QiniuStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateS3QiniuStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/s3/qiniu] CreateS3QiniuStorage", response, response.Code())
-	}
-}
-
-// NewCreateS3QiniuStorageOK creates a CreateS3QiniuStorageOK with default headers values
-func NewCreateS3QiniuStorageOK() *CreateS3QiniuStorageOK {
-	return &CreateS3QiniuStorageOK{}
-}
-
-/*
-CreateS3QiniuStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateS3QiniuStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create s3 qiniu storage o k response has a 2xx status code
-func (o *CreateS3QiniuStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create s3 qiniu storage o k response has a 3xx status code
-func (o *CreateS3QiniuStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 qiniu storage o k response has a 4xx status code
-func (o *CreateS3QiniuStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 qiniu storage o k response has a 5xx status code
-func (o *CreateS3QiniuStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 qiniu storage o k response a status code equal to that given
-func (o *CreateS3QiniuStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create s3 qiniu storage o k response
-func (o *CreateS3QiniuStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateS3QiniuStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/qiniu][%d] createS3QiniuStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3QiniuStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/s3/qiniu][%d] createS3QiniuStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3QiniuStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateS3QiniuStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3QiniuStorageBadRequest creates a CreateS3QiniuStorageBadRequest with default headers values
-func NewCreateS3QiniuStorageBadRequest() *CreateS3QiniuStorageBadRequest {
-	return &CreateS3QiniuStorageBadRequest{}
-}
-
-/*
-CreateS3QiniuStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateS3QiniuStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 qiniu storage bad request response has a 2xx status code
-func (o *CreateS3QiniuStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 qiniu storage bad request response has a 3xx status code
-func (o *CreateS3QiniuStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 qiniu storage bad request response has a 4xx status code
-func (o *CreateS3QiniuStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create s3 qiniu storage bad request response has a 5xx status code
-func (o *CreateS3QiniuStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 qiniu storage bad request response a status code equal to that given
-func (o *CreateS3QiniuStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create s3 qiniu storage bad request response
-func (o *CreateS3QiniuStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateS3QiniuStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/qiniu][%d] createS3QiniuStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3QiniuStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/s3/qiniu][%d] createS3QiniuStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3QiniuStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3QiniuStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3QiniuStorageInternalServerError creates a CreateS3QiniuStorageInternalServerError with default headers values
-func NewCreateS3QiniuStorageInternalServerError() *CreateS3QiniuStorageInternalServerError {
-	return &CreateS3QiniuStorageInternalServerError{}
-}
-
-/*
-CreateS3QiniuStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateS3QiniuStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 qiniu storage internal server error response has a 2xx status code
-func (o *CreateS3QiniuStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 qiniu storage internal server error response has a 3xx status code
-func (o *CreateS3QiniuStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 qiniu storage internal server error response has a 4xx status code
-func (o *CreateS3QiniuStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 qiniu storage internal server error response has a 5xx status code
-func (o *CreateS3QiniuStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create s3 qiniu storage internal server error response a status code equal to that given
-func (o *CreateS3QiniuStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create s3 qiniu storage internal server error response
-func (o *CreateS3QiniuStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateS3QiniuStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/qiniu][%d] createS3QiniuStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3QiniuStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/s3/qiniu][%d] createS3QiniuStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3QiniuStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3QiniuStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
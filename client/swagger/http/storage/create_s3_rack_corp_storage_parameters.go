@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3RackCorpStorageParams creates a new CreateS3RackCorpStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3RackCorpStorageParams() *CreateS3RackCorpStorageParams {
-	return &CreateS3RackCorpStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3RackCorpStorageParamsWithTimeout creates a new CreateS3RackCorpStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3RackCorpStorageParamsWithTimeout(timeout time.Duration) *CreateS3RackCorpStorageParams {
-	return &CreateS3RackCorpStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3RackCorpStorageParamsWithContext creates a new CreateS3RackCorpStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3RackCorpStorageParamsWithContext(ctx context.Context) *CreateS3RackCorpStorageParams {
-	return &CreateS3RackCorpStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3RackCorpStorageParamsWithHTTPClient creates a new CreateS3RackCorpStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3RackCorpStorageParamsWithHTTPClient(client *http.Client) *CreateS3RackCorpStorageParams {
-	return &CreateS3RackCorpStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3RackCorpStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 rack corp storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3RackCorpStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3RackCorpStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 rack corp storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3RackCorpStorageParams) WithDefaults() *CreateS3RackCorpStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 rack corp storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3RackCorpStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 rack corp storage params
-func (o *CreateS3RackCorpStorageParams) WithTimeout(timeout time.Duration) *CreateS3RackCorpStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 rack corp storage params
-func (o *CreateS3RackCorpStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 rack corp storage params
-func (o *CreateS3RackCorpStorageParams) WithContext(ctx context.Context) *CreateS3RackCorpStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 rack corp storage params
-func (o *CreateS3RackCorpStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 rack corp storage params
-func (o *CreateS3RackCorpStorageParams) WithHTTPClient(client *http.Client) *CreateS3RackCorpStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 rack corp storage params
-func (o *CreateS3RackCorpStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 rack corp storage params
-func (o *CreateS3RackCorpStorageParams) WithRequest(request *models.StorageCreateS3RackCorpStorageRequest) *CreateS3RackCorpStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 rack corp storage params
-func (o *CreateS3RackCorpStorageParams) SetRequest(request *models.StorageCreateS3RackCorpStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3RackCorpStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
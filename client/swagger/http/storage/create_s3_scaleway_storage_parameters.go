@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3ScalewayStorageParams creates a new CreateS3ScalewayStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3ScalewayStorageParams() *CreateS3ScalewayStorageParams {
-	return &CreateS3ScalewayStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3ScalewayStorageParamsWithTimeout creates a new CreateS3ScalewayStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3ScalewayStorageParamsWithTimeout(timeout time.Duration) *CreateS3ScalewayStorageParams {
-	return &CreateS3ScalewayStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3ScalewayStorageParamsWithContext creates a new CreateS3ScalewayStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3ScalewayStorageParamsWithContext(ctx context.Context) *CreateS3ScalewayStorageParams {
-	return &CreateS3ScalewayStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3ScalewayStorageParamsWithHTTPClient creates a new CreateS3ScalewayStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3ScalewayStorageParamsWithHTTPClient(client *http.Client) *CreateS3ScalewayStorageParams {
-	return &CreateS3ScalewayStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3ScalewayStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 scaleway storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3ScalewayStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3ScalewayStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 scaleway storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3ScalewayStorageParams) WithDefaults() *CreateS3ScalewayStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 scaleway storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3ScalewayStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 scaleway storage params
-func (o *CreateS3ScalewayStorageParams) WithTimeout(timeout time.Duration) *CreateS3ScalewayStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 scaleway storage params
-func (o *CreateS3ScalewayStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 scaleway storage params
-func (o *CreateS3ScalewayStorageParams) WithContext(ctx context.Context) *CreateS3ScalewayStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 scaleway storage params
-func (o *CreateS3ScalewayStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 scaleway storage params
-func (o *CreateS3ScalewayStorageParams) WithHTTPClient(client *http.Client) *CreateS3ScalewayStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 scaleway storage params
-func (o *CreateS3ScalewayStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 scaleway storage params
-func (o *CreateS3ScalewayStorageParams) WithRequest(request *models.StorageCreateS3ScalewayStorageRequest) *CreateS3ScalewayStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 scaleway storage params
-func (o *CreateS3ScalewayStorageParams) SetRequest(request *models.StorageCreateS3ScalewayStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3ScalewayStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateS3ScalewayStorageReader is a Reader for the CreateS3ScalewayStorage structure.
-type CreateS3ScalewayStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateS3ScalewayStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateS3ScalewayStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateS3ScalewayStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateS3ScalewayStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/s3/scaleway] CreateS3ScalewayStorage", response, response.Code())
-	}
-}
-
-// NewCreateS3ScalewayStorageOK creates a CreateS3ScalewayStorageOK with default headers values
-func NewCreateS3ScalewayStorageOK() *CreateS3ScalewayStorageOK {
-	return &CreateS3ScalewayStorageOK{}
-}
-
-/*
-CreateS3ScalewayStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateS3ScalewayStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create s3 scaleway storage o k response has a 2xx status code
-func (o *CreateS3ScalewayStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create s3 scaleway storage o k response has a 3xx status code
-func (o *CreateS3ScalewayStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 scaleway storage o k response has a 4xx status code
-func (o *CreateS3ScalewayStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 scaleway storage o k response has a 5xx status code
-func (o *CreateS3ScalewayStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 scaleway storage o k response a status code equal to that given
-func (o *CreateS3ScalewayStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create s3 scaleway storage o k response
-func (o *CreateS3ScalewayStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateS3ScalewayStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/scaleway][%d] createS3ScalewayStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3ScalewayStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/s3/scaleway][%d] createS3ScalewayStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3ScalewayStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateS3ScalewayStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3ScalewayStorageBadRequest creates a CreateS3ScalewayStorageBadRequest with default headers values
-func NewCreateS3ScalewayStorageBadRequest() *CreateS3ScalewayStorageBadRequest {
-	return &CreateS3ScalewayStorageBadRequest{}
-}
-
-/*
-CreateS3ScalewayStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateS3ScalewayStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 scaleway storage bad request response has a 2xx status code
-func (o *CreateS3ScalewayStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 scaleway storage bad request response has a 3xx status code
-func (o *CreateS3ScalewayStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 scaleway storage bad request response has a 4xx status code
-func (o *CreateS3ScalewayStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create s3 scaleway storage bad request response has a 5xx status code
-func (o *CreateS3ScalewayStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 scaleway storage bad request response a status code equal to that given
-func (o *CreateS3ScalewayStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create s3 scaleway storage bad request response
-func (o *CreateS3ScalewayStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateS3ScalewayStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/scaleway][%d] createS3ScalewayStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3ScalewayStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/s3/scaleway][%d] createS3ScalewayStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3ScalewayStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3ScalewayStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3ScalewayStorageInternalServerError creates a CreateS3ScalewayStorageInternalServerError with default headers values
-func NewCreateS3ScalewayStorageInternalServerError() *CreateS3ScalewayStorageInternalServerError {
-	return &CreateS3ScalewayStorageInternalServerError{}
-}
-
-/*
-CreateS3ScalewayStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateS3ScalewayStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 scaleway storage internal server error response has a 2xx status code
-func (o *CreateS3ScalewayStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 scaleway storage internal server error response has a 3xx status code
-func (o *CreateS3ScalewayStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 scaleway storage internal server error response has a 4xx status code
-func (o *CreateS3ScalewayStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 scaleway storage internal server error response has a 5xx status code
-func (o *CreateS3ScalewayStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create s3 scaleway storage internal server error response a status code equal to that given
-func (o *CreateS3ScalewayStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create s3 scaleway storage internal server error response
-func (o *CreateS3ScalewayStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateS3ScalewayStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/scaleway][%d] createS3ScalewayStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3ScalewayStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/s3/scaleway][%d] createS3ScalewayStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3ScalewayStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3ScalewayStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
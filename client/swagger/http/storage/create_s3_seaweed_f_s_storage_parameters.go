@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3SeaweedFSStorageParams creates a new CreateS3SeaweedFSStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3SeaweedFSStorageParams() *CreateS3SeaweedFSStorageParams {
-	return &CreateS3SeaweedFSStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3SeaweedFSStorageParamsWithTimeout creates a new CreateS3SeaweedFSStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3SeaweedFSStorageParamsWithTimeout(timeout time.Duration) *CreateS3SeaweedFSStorageParams {
-	return &CreateS3SeaweedFSStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3SeaweedFSStorageParamsWithContext creates a new CreateS3SeaweedFSStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3SeaweedFSStorageParamsWithContext(ctx context.Context) *CreateS3SeaweedFSStorageParams {
-	return &CreateS3SeaweedFSStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3SeaweedFSStorageParamsWithHTTPClient creates a new CreateS3SeaweedFSStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3SeaweedFSStorageParamsWithHTTPClient(client *http.Client) *CreateS3SeaweedFSStorageParams {
-	return &CreateS3SeaweedFSStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3SeaweedFSStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 seaweed f s storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3SeaweedFSStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3SeaweedFSStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 seaweed f s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3SeaweedFSStorageParams) WithDefaults() *CreateS3SeaweedFSStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 seaweed f s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3SeaweedFSStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 seaweed f s storage params
-func (o *CreateS3SeaweedFSStorageParams) WithTimeout(timeout time.Duration) *CreateS3SeaweedFSStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 seaweed f s storage params
-func (o *CreateS3SeaweedFSStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 seaweed f s storage params
-func (o *CreateS3SeaweedFSStorageParams) WithContext(ctx context.Context) *CreateS3SeaweedFSStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 seaweed f s storage params
-func (o *CreateS3SeaweedFSStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 seaweed f s storage params
-func (o *CreateS3SeaweedFSStorageParams) WithHTTPClient(client *http.Client) *CreateS3SeaweedFSStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 seaweed f s storage params
-func (o *CreateS3SeaweedFSStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 seaweed f s storage params
-func (o *CreateS3SeaweedFSStorageParams) WithRequest(request *models.StorageCreateS3SeaweedFSStorageRequest) *CreateS3SeaweedFSStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 seaweed f s storage params
-func (o *CreateS3SeaweedFSStorageParams) SetRequest(request *models.StorageCreateS3SeaweedFSStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3SeaweedFSStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateS3SeaweedFSStorageReader is a Reader for the CreateS3SeaweedFSStorage structure.
-type CreateS3SeaweedFSStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateS3SeaweedFSStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateS3SeaweedFSStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateS3SeaweedFSStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateS3SeaweedFSStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/s3/seaweedfs] CreateS3SeaweedFSStorage", response, response.Code())
-	}
-}
-
-// NewCreateS3SeaweedFSStorageOK creates a CreateS3SeaweedFSStorageOK with default headers values
-func NewCreateS3SeaweedFSStorageOK() *CreateS3SeaweedFSStorageOK {
-	return &CreateS3SeaweedFSStorageOK{}
-}
-
-/*
-CreateS3SeaweedFSStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateS3SeaweedFSStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create s3 seaweed f s storage o k response has a 2xx status code
-func (o *CreateS3SeaweedFSStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create s3 seaweed f s storage o k response has a 3xx status code
-func (o *CreateS3SeaweedFSStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 seaweed f s storage o k response has a 4xx status code
-func (o *CreateS3SeaweedFSStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 seaweed f s storage o k response has a 5xx status code
-func (o *CreateS3SeaweedFSStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 seaweed f s storage o k response a status code equal to that given
-func (o *CreateS3SeaweedFSStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create s3 seaweed f s storage o k response
-func (o *CreateS3SeaweedFSStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateS3SeaweedFSStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/seaweedfs][%d] createS3SeaweedFSStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3SeaweedFSStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/s3/seaweedfs][%d] createS3SeaweedFSStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3SeaweedFSStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateS3SeaweedFSStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3SeaweedFSStorageBadRequest creates a CreateS3SeaweedFSStorageBadRequest with default headers values
-func NewCreateS3SeaweedFSStorageBadRequest() *CreateS3SeaweedFSStorageBadRequest {
-	return &CreateS3SeaweedFSStorageBadRequest{}
-}
-
-/*
-CreateS3SeaweedFSStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateS3SeaweedFSStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 seaweed f s storage bad request response has a 2xx status code
-func (o *CreateS3SeaweedFSStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 seaweed f s storage bad request response has a 3xx status code
-func (o *CreateS3SeaweedFSStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 seaweed f s storage bad request response has a 4xx status code
-func (o *CreateS3SeaweedFSStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create s3 seaweed f s storage bad request response has a 5xx status code
-func (o *CreateS3SeaweedFSStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 seaweed f s storage bad request response a status code equal to that given
-func (o *CreateS3SeaweedFSStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create s3 seaweed f s storage bad request response
-func (o *CreateS3SeaweedFSStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateS3SeaweedFSStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/seaweedfs][%d] createS3SeaweedFSStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3SeaweedFSStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/s3/seaweedfs][%d] createS3SeaweedFSStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3SeaweedFSStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3SeaweedFSStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3SeaweedFSStorageInternalServerError creates a CreateS3SeaweedFSStorageInternalServerError with default headers values
-func NewCreateS3SeaweedFSStorageInternalServerError() *CreateS3SeaweedFSStorageInternalServerError {
-	return &CreateS3SeaweedFSStorageInternalServerError{}
-}
-
-/*
-CreateS3SeaweedFSStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateS3SeaweedFSStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 seaweed f s storage internal server error response has a 2xx status code
-func (o *CreateS3SeaweedFSStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 seaweed f s storage internal server error response has a 3xx status code
-func (o *CreateS3SeaweedFSStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 seaweed f s storage internal server error response has a 4xx status code
-func (o *CreateS3SeaweedFSStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 seaweed f s storage internal server error response has a 5xx status code
-func (o *CreateS3SeaweedFSStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create s3 seaweed f s storage internal server error response a status code equal to that given
-func (o *CreateS3SeaweedFSStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create s3 seaweed f s storage internal server error response
-func (o *CreateS3SeaweedFSStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateS3SeaweedFSStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/seaweedfs][%d] createS3SeaweedFSStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3SeaweedFSStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/s3/seaweedfs][%d] createS3SeaweedFSStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3SeaweedFSStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3SeaweedFSStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
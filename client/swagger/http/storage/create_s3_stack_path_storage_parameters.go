@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3StackPathStorageParams creates a new CreateS3StackPathStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3StackPathStorageParams() *CreateS3StackPathStorageParams {
-	return &CreateS3StackPathStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3StackPathStorageParamsWithTimeout creates a new CreateS3StackPathStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3StackPathStorageParamsWithTimeout(timeout time.Duration) *CreateS3StackPathStorageParams {
-	return &CreateS3StackPathStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3StackPathStorageParamsWithContext creates a new CreateS3StackPathStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3StackPathStorageParamsWithContext(ctx context.Context) *CreateS3StackPathStorageParams {
-	return &CreateS3StackPathStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3StackPathStorageParamsWithHTTPClient creates a new CreateS3StackPathStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3StackPathStorageParamsWithHTTPClient(client *http.Client) *CreateS3StackPathStorageParams {
-	return &CreateS3StackPathStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3StackPathStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 stack path storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3StackPathStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3StackPathStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 stack path storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3StackPathStorageParams) WithDefaults() *CreateS3StackPathStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 stack path storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3StackPathStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 stack path storage params
-func (o *CreateS3StackPathStorageParams) WithTimeout(timeout time.Duration) *CreateS3StackPathStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 stack path storage params
-func (o *CreateS3StackPathStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 stack path storage params
-func (o *CreateS3StackPathStorageParams) WithContext(ctx context.Context) *CreateS3StackPathStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 stack path storage params
-func (o *CreateS3StackPathStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 stack path storage params
-func (o *CreateS3StackPathStorageParams) WithHTTPClient(client *http.Client) *CreateS3StackPathStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 stack path storage params
-func (o *CreateS3StackPathStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 stack path storage params
-func (o *CreateS3StackPathStorageParams) WithRequest(request *models.StorageCreateS3StackPathStorageRequest) *CreateS3StackPathStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 stack path storage params
-func (o *CreateS3StackPathStorageParams) SetRequest(request *models.StorageCreateS3StackPathStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3StackPathStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateS3StackPathStorageReader is a Reader for the CreateS3StackPathStorage structure.
-type CreateS3StackPathStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateS3StackPathStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateS3StackPathStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateS3StackPathStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateS3StackPathStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/s3/stackpath] CreateS3StackPathStorage", response, response.Code())
-	}
-}
-
-// NewCreateS3StackPathStorageOK creates a CreateS3StackPathStorageOK with default headers values
-func NewCreateS3StackPathStorageOK() *CreateS3StackPathStorageOK {
-	return &CreateS3StackPathStorageOK{}
-}
-
-/*
-CreateS3StackPathStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateS3StackPathStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create s3 stack path storage o k response has a 2xx status code
-func (o *CreateS3StackPathStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create s3 stack path storage o k response has a 3xx status code
-func (o *CreateS3StackPathStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 stack path storage o k response has a 4xx status code
-func (o *CreateS3StackPathStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 stack path storage o k response has a 5xx status code
-func (o *CreateS3StackPathStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 stack path storage o k response a status code equal to that given
-func (o *CreateS3StackPathStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create s3 stack path storage o k response
-func (o *CreateS3StackPathStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateS3StackPathStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/stackpath][%d] createS3StackPathStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3StackPathStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/s3/stackpath][%d] createS3StackPathStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3StackPathStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateS3StackPathStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3StackPathStorageBadRequest creates a CreateS3StackPathStorageBadRequest with default headers values
-func NewCreateS3StackPathStorageBadRequest() *CreateS3StackPathStorageBadRequest {
-	return &CreateS3StackPathStorageBadRequest{}
-}
-
-/*
-CreateS3StackPathStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateS3StackPathStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 stack path storage bad request response has a 2xx status code
-func (o *CreateS3StackPathStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 stack path storage bad request response has a 3xx status code
-func (o *CreateS3StackPathStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 stack path storage bad request response has a 4xx status code
-func (o *CreateS3StackPathStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create s3 stack path storage bad request response has a 5xx status code
-func (o *CreateS3StackPathStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 stack path storage bad request response a status code equal to that given
-func (o *CreateS3StackPathStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create s3 stack path storage bad request response
-func (o *CreateS3StackPathStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateS3StackPathStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/stackpath][%d] createS3StackPathStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3StackPathStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/s3/stackpath][%d] createS3StackPathStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3StackPathStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3StackPathStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3StackPathStorageInternalServerError creates a CreateS3StackPathStorageInternalServerError with default headers values
-func NewCreateS3StackPathStorageInternalServerError() *CreateS3StackPathStorageInternalServerError {
-	return &CreateS3StackPathStorageInternalServerError{}
-}
-
-/*
-CreateS3StackPathStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateS3StackPathStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 stack path storage internal server error response has a 2xx status code
-func (o *CreateS3StackPathStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 stack path storage internal server error response has a 3xx status code
-func (o *CreateS3StackPathStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 stack path storage internal server error response has a 4xx status code
-func (o *CreateS3StackPathStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 stack path storage internal server error response has a 5xx status code
-func (o *CreateS3StackPathStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create s3 stack path storage internal server error response a status code equal to that given
-func (o *CreateS3StackPathStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create s3 stack path storage internal server error response
-func (o *CreateS3StackPathStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateS3StackPathStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/stackpath][%d] createS3StackPathStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3StackPathStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/s3/stackpath][%d] createS3StackPathStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3StackPathStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3StackPathStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
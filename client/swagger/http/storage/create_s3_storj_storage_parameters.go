@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3StorjStorageParams creates a new CreateS3StorjStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3StorjStorageParams() *CreateS3StorjStorageParams {
-	return &CreateS3StorjStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3StorjStorageParamsWithTimeout creates a new CreateS3StorjStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3StorjStorageParamsWithTimeout(timeout time.Duration) *CreateS3StorjStorageParams {
-	return &CreateS3StorjStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3StorjStorageParamsWithContext creates a new CreateS3StorjStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3StorjStorageParamsWithContext(ctx context.Context) *CreateS3StorjStorageParams {
-	return &CreateS3StorjStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3StorjStorageParamsWithHTTPClient creates a new CreateS3StorjStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3StorjStorageParamsWithHTTPClient(client *http.Client) *CreateS3StorjStorageParams {
-	return &CreateS3StorjStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3StorjStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 storj storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3StorjStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3StorjStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 storj storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3StorjStorageParams) WithDefaults() *CreateS3StorjStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 storj storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3StorjStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 storj storage params
-func (o *CreateS3StorjStorageParams) WithTimeout(timeout time.Duration) *CreateS3StorjStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 storj storage params
-func (o *CreateS3StorjStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 storj storage params
-func (o *CreateS3StorjStorageParams) WithContext(ctx context.Context) *CreateS3StorjStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 storj storage params
-func (o *CreateS3StorjStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 storj storage params
-func (o *CreateS3StorjStorageParams) WithHTTPClient(client *http.Client) *CreateS3StorjStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 storj storage params
-func (o *CreateS3StorjStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 storj storage params
-func (o *CreateS3StorjStorageParams) WithRequest(request *models.StorageCreateS3StorjStorageRequest) *CreateS3StorjStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 storj storage params
-func (o *CreateS3StorjStorageParams) SetRequest(request *models.StorageCreateS3StorjStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3StorjStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
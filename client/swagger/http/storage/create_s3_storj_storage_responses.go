@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateS3StorjStorageReader is a Reader for the CreateS3StorjStorage structure.
-type CreateS3StorjStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateS3StorjStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateS3StorjStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateS3StorjStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateS3StorjStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/s3/storj] CreateS3StorjStorage", response, response.Code())
-	}
-}
-
-// NewCreateS3StorjStorageOK creates a CreateS3StorjStorageOK with default headers values
-func NewCreateS3StorjStorageOK() *CreateS3StorjStorageOK {
-	return &CreateS3StorjStorageOK{}
-}
-
-/*
-CreateS3StorjStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateS3StorjStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create s3 storj storage o k response has a 2xx status code
-func (o *CreateS3StorjStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create s3 storj storage o k response has a 3xx status code
-func (o *CreateS3StorjStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 storj storage o k response has a 4xx status code
-func (o *CreateS3StorjStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 storj storage o k response has a 5xx status code
-func (o *CreateS3StorjStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 storj storage o k response a status code equal to that given
-func (o *CreateS3StorjStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create s3 storj storage o k response
-func (o *CreateS3StorjStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateS3StorjStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/storj][%d] createS3StorjStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3StorjStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/s3/storj][%d] createS3StorjStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3StorjStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateS3StorjStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3StorjStorageBadRequest creates a CreateS3StorjStorageBadRequest with default headers values
-func NewCreateS3StorjStorageBadRequest() *CreateS3StorjStorageBadRequest {
-	return &CreateS3StorjStorageBadRequest{}
-}
-
-/*
-CreateS3StorjStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateS3StorjStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 storj storage bad request response has a 2xx status code
-func (o *CreateS3StorjStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 storj storage bad request response has a 3xx status code
-func (o *CreateS3StorjStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 storj storage bad request response has a 4xx status code
-func (o *CreateS3StorjStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create s3 storj storage bad request response has a 5xx status code
-func (o *CreateS3StorjStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 storj storage bad request response a status code equal to that given
-func (o *CreateS3StorjStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create s3 storj storage bad request response
-func (o *CreateS3StorjStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateS3StorjStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/storj][%d] createS3StorjStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3StorjStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/s3/storj][%d] createS3StorjStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3StorjStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3StorjStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3StorjStorageInternalServerError creates a CreateS3StorjStorageInternalServerError with default headers values
-func NewCreateS3StorjStorageInternalServerError() *CreateS3StorjStorageInternalServerError {
-	return &CreateS3StorjStorageInternalServerError{}
-}
-
-/*
-CreateS3StorjStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateS3StorjStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 storj storage internal server error response has a 2xx status code
-func (o *CreateS3StorjStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 storj storage internal server error response has a 3xx status code
-func (o *CreateS3StorjStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 storj storage internal server error response has a 4xx status code
-func (o *CreateS3StorjStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 storj storage internal server error response has a 5xx status code
-func (o *CreateS3StorjStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create s3 storj storage internal server error response a status code equal to that given
-func (o *CreateS3StorjStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create s3 storj storage internal server error response
-func (o *CreateS3StorjStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateS3StorjStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/storj][%d] createS3StorjStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3StorjStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/s3/storj][%d] createS3StorjStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3StorjStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3StorjStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
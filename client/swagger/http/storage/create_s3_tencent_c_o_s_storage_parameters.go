@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateS3TencentCOSStorageParams creates a new CreateS3TencentCOSStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateS3TencentCOSStorageParams() *CreateS3TencentCOSStorageParams {
-	return &CreateS3TencentCOSStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateS3TencentCOSStorageParamsWithTimeout creates a new CreateS3TencentCOSStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateS3TencentCOSStorageParamsWithTimeout(timeout time.Duration) *CreateS3TencentCOSStorageParams {
-	return &CreateS3TencentCOSStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateS3TencentCOSStorageParamsWithContext creates a new CreateS3TencentCOSStorageParams object
-// with the ability to set a context for a request.
-func NewCreateS3TencentCOSStorageParamsWithContext(ctx context.Context) *CreateS3TencentCOSStorageParams {
-	return &CreateS3TencentCOSStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateS3TencentCOSStorageParamsWithHTTPClient creates a new CreateS3TencentCOSStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateS3TencentCOSStorageParamsWithHTTPClient(client *http.Client) *CreateS3TencentCOSStorageParams {
-	return &CreateS3TencentCOSStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateS3TencentCOSStorageParams contains all the parameters to send to the API endpoint
-
-	for the create s3 tencent c o s storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateS3TencentCOSStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateS3TencentCOSStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create s3 tencent c o s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3TencentCOSStorageParams) WithDefaults() *CreateS3TencentCOSStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create s3 tencent c o s storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateS3TencentCOSStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create s3 tencent c o s storage params
-func (o *CreateS3TencentCOSStorageParams) WithTimeout(timeout time.Duration) *CreateS3TencentCOSStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create s3 tencent c o s storage params
-func (o *CreateS3TencentCOSStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create s3 tencent c o s storage params
-func (o *CreateS3TencentCOSStorageParams) WithContext(ctx context.Context) *CreateS3TencentCOSStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create s3 tencent c o s storage params
-func (o *CreateS3TencentCOSStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create s3 tencent c o s storage params
-func (o *CreateS3TencentCOSStorageParams) WithHTTPClient(client *http.Client) *CreateS3TencentCOSStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create s3 tencent c o s storage params
-func (o *CreateS3TencentCOSStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create s3 tencent c o s storage params
-func (o *CreateS3TencentCOSStorageParams) WithRequest(request *models.StorageCreateS3TencentCOSStorageRequest) *CreateS3TencentCOSStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create s3 tencent c o s storage params
-func (o *CreateS3TencentCOSStorageParams) SetRequest(request *models.StorageCreateS3TencentCOSStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateS3TencentCOSStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateS3WasabiStorageReader is a Reader for the CreateS3WasabiStorage structure.
-type CreateS3WasabiStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateS3WasabiStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateS3WasabiStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateS3WasabiStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateS3WasabiStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/s3/wasabi] CreateS3WasabiStorage", response, response.Code())
-	}
-}
-
-// NewCreateS3WasabiStorageOK creates a CreateS3WasabiStorageOK with default headers values
-func NewCreateS3WasabiStorageOK() *CreateS3WasabiStorageOK {
-	return &CreateS3WasabiStorageOK{}
-}
-
-/*
-CreateS3WasabiStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateS3WasabiStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create s3 wasabi storage o k response has a 2xx status code
-func (o *CreateS3WasabiStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create s3 wasabi storage o k response has a 3xx status code
-func (o *CreateS3WasabiStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 wasabi storage o k response has a 4xx status code
-func (o *CreateS3WasabiStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 wasabi storage o k response has a 5xx status code
-func (o *CreateS3WasabiStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 wasabi storage o k response a status code equal to that given
-func (o *CreateS3WasabiStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create s3 wasabi storage o k response
-func (o *CreateS3WasabiStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateS3WasabiStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/wasabi][%d] createS3WasabiStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3WasabiStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/s3/wasabi][%d] createS3WasabiStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateS3WasabiStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateS3WasabiStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3WasabiStorageBadRequest creates a CreateS3WasabiStorageBadRequest with default headers values
-func NewCreateS3WasabiStorageBadRequest() *CreateS3WasabiStorageBadRequest {
-	return &CreateS3WasabiStorageBadRequest{}
-}
-
-/*
-CreateS3WasabiStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateS3WasabiStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 wasabi storage bad request response has a 2xx status code
-func (o *CreateS3WasabiStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 wasabi storage bad request response has a 3xx status code
-func (o *CreateS3WasabiStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 wasabi storage bad request response has a 4xx status code
-func (o *CreateS3WasabiStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create s3 wasabi storage bad request response has a 5xx status code
-func (o *CreateS3WasabiStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create s3 wasabi storage bad request response a status code equal to that given
-func (o *CreateS3WasabiStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create s3 wasabi storage bad request response
-func (o *CreateS3WasabiStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateS3WasabiStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/wasabi][%d] createS3WasabiStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3WasabiStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/s3/wasabi][%d] createS3WasabiStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateS3WasabiStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3WasabiStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateS3WasabiStorageInternalServerError creates a CreateS3WasabiStorageInternalServerError with default headers values
-func NewCreateS3WasabiStorageInternalServerError() *CreateS3WasabiStorageInternalServerError {
-	return &CreateS3WasabiStorageInternalServerError{}
-}
-
-/*
-CreateS3WasabiStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateS3WasabiStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create s3 wasabi storage internal server error response has a 2xx status code
-func (o *CreateS3WasabiStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create s3 wasabi storage internal server error response has a 3xx status code
-func (o *CreateS3WasabiStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create s3 wasabi storage internal server error response has a 4xx status code
-func (o *CreateS3WasabiStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create s3 wasabi storage internal server error response has a 5xx status code
-func (o *CreateS3WasabiStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create s3 wasabi storage internal server error response a status code equal to that given
-func (o *CreateS3WasabiStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create s3 wasabi storage internal server error response
-func (o *CreateS3WasabiStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateS3WasabiStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/s3/wasabi][%d] createS3WasabiStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3WasabiStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/s3/wasabi][%d] createS3WasabiStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateS3WasabiStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateS3WasabiStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateSeafileStorageParams creates a new CreateSeafileStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateSeafileStorageParams() *CreateSeafileStorageParams {
-	return &CreateSeafileStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateSeafileStorageParamsWithTimeout creates a new CreateSeafileStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateSeafileStorageParamsWithTimeout(timeout time.Duration) *CreateSeafileStorageParams {
-	return &CreateSeafileStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateSeafileStorageParamsWithContext creates a new CreateSeafileStorageParams object
-// with the ability to set a context for a request.
-func NewCreateSeafileStorageParamsWithContext(ctx context.Context) *CreateSeafileStorageParams {
-	return &CreateSeafileStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateSeafileStorageParamsWithHTTPClient creates a new CreateSeafileStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateSeafileStorageParamsWithHTTPClient(client *http.Client) *CreateSeafileStorageParams {
-	return &CreateSeafileStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateSeafileStorageParams contains all the parameters to send to the API endpoint
-
-	for the create seafile storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateSeafileStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateSeafileStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create seafile storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSeafileStorageParams) WithDefaults() *CreateSeafileStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create seafile storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSeafileStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create seafile storage params
-func (o *CreateSeafileStorageParams) WithTimeout(timeout time.Duration) *CreateSeafileStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create seafile storage params
-func (o *CreateSeafileStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create seafile storage params
-func (o *CreateSeafileStorageParams) WithContext(ctx context.Context) *CreateSeafileStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create seafile storage params
-func (o *CreateSeafileStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create seafile storage params
-func (o *CreateSeafileStorageParams) WithHTTPClient(client *http.Client) *CreateSeafileStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create seafile storage params
-func (o *CreateSeafileStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create seafile storage params
-func (o *CreateSeafileStorageParams) WithRequest(request *models.StorageCreateSeafileStorageRequest) *CreateSeafileStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create seafile storage params
-func (o *CreateSeafileStorageParams) SetRequest(request *models.StorageCreateSeafileStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateSeafileStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateSeafileStorageReader is a Reader for the CreateSeafileStorage structure.
-type CreateSeafileStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateSeafileStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateSeafileStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateSeafileStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateSeafileStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/seafile] CreateSeafileStorage", response, response.Code())
-	}
-}
-
-// NewCreateSeafileStorageOK creates a CreateSeafileStorageOK with default headers values
-func NewCreateSeafileStorageOK() *CreateSeafileStorageOK {
-	return &CreateSeafileStorageOK{}
-}
-
-/*
-CreateSeafileStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateSeafileStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create seafile storage o k response has a 2xx status code
-func (o *CreateSeafileStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create seafile storage o k response has a 3xx status code
-func (o *CreateSeafileStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create seafile storage o k response has a 4xx status code
-func (o *CreateSeafileStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create seafile storage o k response has a 5xx status code
-func (o *CreateSeafileStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create seafile storage o k response a status code equal to that given
-func (o *CreateSeafileStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create seafile storage o k response
-func (o *CreateSeafileStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateSeafileStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/seafile][%d] createSeafileStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSeafileStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/seafile][%d] createSeafileStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSeafileStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateSeafileStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSeafileStorageBadRequest creates a CreateSeafileStorageBadRequest with default headers values
-func NewCreateSeafileStorageBadRequest() *CreateSeafileStorageBadRequest {
-	return &CreateSeafileStorageBadRequest{}
-}
-
-/*
-CreateSeafileStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateSeafileStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create seafile storage bad request response has a 2xx status code
-func (o *CreateSeafileStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create seafile storage bad request response has a 3xx status code
-func (o *CreateSeafileStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create seafile storage bad request response has a 4xx status code
-func (o *CreateSeafileStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create seafile storage bad request response has a 5xx status code
-func (o *CreateSeafileStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create seafile storage bad request response a status code equal to that given
-func (o *CreateSeafileStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create seafile storage bad request response
-func (o *CreateSeafileStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateSeafileStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/seafile][%d] createSeafileStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSeafileStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/seafile][%d] createSeafileStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSeafileStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSeafileStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSeafileStorageInternalServerError creates a CreateSeafileStorageInternalServerError with default headers values
-func NewCreateSeafileStorageInternalServerError() *CreateSeafileStorageInternalServerError {
-	return &CreateSeafileStorageInternalServerError{}
-}
-
-/*
-CreateSeafileStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateSeafileStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create seafile storage internal server error response has a 2xx status code
-func (o *CreateSeafileStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create seafile storage internal server error response has a 3xx status code
-func (o *CreateSeafileStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create seafile storage internal server error response has a 4xx status code
-func (o *CreateSeafileStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create seafile storage internal server error response has a 5xx status code
-func (o *CreateSeafileStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create seafile storage internal server error response a status code equal to that given
-func (o *CreateSeafileStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create seafile storage internal server error response
-func (o *CreateSeafileStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateSeafileStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/seafile][%d] createSeafileStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSeafileStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/seafile][%d] createSeafileStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSeafileStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSeafileStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
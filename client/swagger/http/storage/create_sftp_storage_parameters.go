@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateSftpStorageParams creates a new CreateSftpStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateSftpStorageParams() *CreateSftpStorageParams {
-	return &CreateSftpStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateSftpStorageParamsWithTimeout creates a new CreateSftpStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateSftpStorageParamsWithTimeout(timeout time.Duration) *CreateSftpStorageParams {
-	return &CreateSftpStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateSftpStorageParamsWithContext creates a new CreateSftpStorageParams object
-// with the ability to set a context for a request.
-func NewCreateSftpStorageParamsWithContext(ctx context.Context) *CreateSftpStorageParams {
-	return &CreateSftpStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateSftpStorageParamsWithHTTPClient creates a new CreateSftpStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateSftpStorageParamsWithHTTPClient(client *http.Client) *CreateSftpStorageParams {
-	return &CreateSftpStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateSftpStorageParams contains all the parameters to send to the API endpoint
-
-	for the create sftp storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateSftpStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateSftpStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create sftp storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSftpStorageParams) WithDefaults() *CreateSftpStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create sftp storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSftpStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create sftp storage params
-func (o *CreateSftpStorageParams) WithTimeout(timeout time.Duration) *CreateSftpStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create sftp storage params
-func (o *CreateSftpStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create sftp storage params
-func (o *CreateSftpStorageParams) WithContext(ctx context.Context) *CreateSftpStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create sftp storage params
-func (o *CreateSftpStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create sftp storage params
-func (o *CreateSftpStorageParams) WithHTTPClient(client *http.Client) *CreateSftpStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create sftp storage params
-func (o *CreateSftpStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create sftp storage params
-func (o *CreateSftpStorageParams) WithRequest(request *models.StorageCreateSftpStorageRequest) *CreateSftpStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create sftp storage params
-func (o *CreateSftpStorageParams) SetRequest(request *models.StorageCreateSftpStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateSftpStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
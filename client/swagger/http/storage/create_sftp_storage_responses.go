@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateSftpStorageReader is a Reader for the CreateSftpStorage structure.
-type CreateSftpStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateSftpStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateSftpStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateSftpStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateSftpStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/sftp] CreateSftpStorage", response, response.Code())
-	}
-}
-
-// NewCreateSftpStorageOK creates a CreateSftpStorageOK with default headers values
-func NewCreateSftpStorageOK() *CreateSftpStorageOK {
-	return &CreateSftpStorageOK{}
-}
-
-/*
-CreateSftpStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateSftpStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create sftp storage o k response has a 2xx status code
-func (o *CreateSftpStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create sftp storage o k response has a 3xx status code
-func (o *CreateSftpStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create sftp storage o k response has a 4xx status code
-func (o *CreateSftpStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create sftp storage o k response has a 5xx status code
-func (o *CreateSftpStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create sftp storage o k response a status code equal to that given
-func (o *CreateSftpStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create sftp storage o k response
-func (o *CreateSftpStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateSftpStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/sftp][%d] createSftpStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSftpStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/sftp][%d] createSftpStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSftpStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateSftpStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSftpStorageBadRequest creates a CreateSftpStorageBadRequest with default headers values
-func NewCreateSftpStorageBadRequest() *CreateSftpStorageBadRequest {
-	return &CreateSftpStorageBadRequest{}
-}
-
-/*
-CreateSftpStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateSftpStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create sftp storage bad request response has a 2xx status code
-func (o *CreateSftpStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create sftp storage bad request response has a 3xx status code
-func (o *CreateSftpStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create sftp storage bad request response has a 4xx status code
-func (o *CreateSftpStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create sftp storage bad request response has a 5xx status code
-func (o *CreateSftpStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create sftp storage bad request response a status code equal to that given
-func (o *CreateSftpStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create sftp storage bad request response
-func (o *CreateSftpStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateSftpStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/sftp][%d] createSftpStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSftpStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/sftp][%d] createSftpStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSftpStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSftpStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSftpStorageInternalServerError creates a CreateSftpStorageInternalServerError with default headers values
-func NewCreateSftpStorageInternalServerError() *CreateSftpStorageInternalServerError {
-	return &CreateSftpStorageInternalServerError{}
-}
-
-/*
-CreateSftpStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateSftpStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create sftp storage internal server error response has a 2xx status code
-func (o *CreateSftpStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create sftp storage internal server error response has a 3xx status code
-func (o *CreateSftpStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create sftp storage internal server error response has a 4xx status code
-func (o *CreateSftpStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create sftp storage internal server error response has a 5xx status code
-func (o *CreateSftpStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create sftp storage internal server error response a status code equal to that given
-func (o *CreateSftpStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create sftp storage internal server error response
-func (o *CreateSftpStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateSftpStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/sftp][%d] createSftpStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSftpStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/sftp][%d] createSftpStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSftpStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSftpStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
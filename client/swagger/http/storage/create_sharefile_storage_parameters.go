@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateSharefileStorageParams creates a new CreateSharefileStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateSharefileStorageParams() *CreateSharefileStorageParams {
-	return &CreateSharefileStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateSharefileStorageParamsWithTimeout creates a new CreateSharefileStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateSharefileStorageParamsWithTimeout(timeout time.Duration) *CreateSharefileStorageParams {
-	return &CreateSharefileStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateSharefileStorageParamsWithContext creates a new CreateSharefileStorageParams object
-// with the ability to set a context for a request.
-func NewCreateSharefileStorageParamsWithContext(ctx context.Context) *CreateSharefileStorageParams {
-	return &CreateSharefileStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateSharefileStorageParamsWithHTTPClient creates a new CreateSharefileStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateSharefileStorageParamsWithHTTPClient(client *http.Client) *CreateSharefileStorageParams {
-	return &CreateSharefileStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateSharefileStorageParams contains all the parameters to send to the API endpoint
-
-	for the create sharefile storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateSharefileStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateSharefileStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create sharefile storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSharefileStorageParams) WithDefaults() *CreateSharefileStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create sharefile storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSharefileStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create sharefile storage params
-func (o *CreateSharefileStorageParams) WithTimeout(timeout time.Duration) *CreateSharefileStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create sharefile storage params
-func (o *CreateSharefileStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create sharefile storage params
-func (o *CreateSharefileStorageParams) WithContext(ctx context.Context) *CreateSharefileStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create sharefile storage params
-func (o *CreateSharefileStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create sharefile storage params
-func (o *CreateSharefileStorageParams) WithHTTPClient(client *http.Client) *CreateSharefileStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create sharefile storage params
-func (o *CreateSharefileStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create sharefile storage params
-func (o *CreateSharefileStorageParams) WithRequest(request *models.StorageCreateSharefileStorageRequest) *CreateSharefileStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create sharefile storage params
-func (o *CreateSharefileStorageParams) SetRequest(request *models.StorageCreateSharefileStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateSharefileStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
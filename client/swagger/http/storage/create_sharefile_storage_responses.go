@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateSharefileStorageReader is a Reader for the CreateSharefileStorage structure.
-type CreateSharefileStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateSharefileStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateSharefileStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateSharefileStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateSharefileStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/sharefile] CreateSharefileStorage", response, response.Code())
-	}
-}
-
-// NewCreateSharefileStorageOK creates a CreateSharefileStorageOK with default headers values
-func NewCreateSharefileStorageOK() *CreateSharefileStorageOK {
-	return &CreateSharefileStorageOK{}
-}
-
-/*
-CreateSharefileStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateSharefileStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create sharefile storage o k response has a 2xx status code
-func (o *CreateSharefileStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create sharefile storage o k response has a 3xx status code
-func (o *CreateSharefileStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create sharefile storage o k response has a 4xx status code
-func (o *CreateSharefileStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create sharefile storage o k response has a 5xx status code
-func (o *CreateSharefileStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create sharefile storage o k response a status code equal to that given
-func (o *CreateSharefileStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create sharefile storage o k response
-func (o *CreateSharefileStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateSharefileStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/sharefile][%d] createSharefileStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSharefileStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/sharefile][%d] createSharefileStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSharefileStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateSharefileStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSharefileStorageBadRequest creates a CreateSharefileStorageBadRequest with default headers values
-func NewCreateSharefileStorageBadRequest() *CreateSharefileStorageBadRequest {
-	return &CreateSharefileStorageBadRequest{}
-}
-
-/*
-CreateSharefileStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateSharefileStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create sharefile storage bad request response has a 2xx status code
-func (o *CreateSharefileStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create sharefile storage bad request response has a 3xx status code
-func (o *CreateSharefileStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create sharefile storage bad request response has a 4xx status code
-func (o *CreateSharefileStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create sharefile storage bad request response has a 5xx status code
-func (o *CreateSharefileStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create sharefile storage bad request response a status code equal to that given
-func (o *CreateSharefileStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create sharefile storage bad request response
-func (o *CreateSharefileStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateSharefileStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/sharefile][%d] createSharefileStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSharefileStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/sharefile][%d] createSharefileStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSharefileStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSharefileStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSharefileStorageInternalServerError creates a CreateSharefileStorageInternalServerError with default headers values
-func NewCreateSharefileStorageInternalServerError() *CreateSharefileStorageInternalServerError {
-	return &CreateSharefileStorageInternalServerError{}
-}
-
-/*
-CreateSharefileStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateSharefileStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create sharefile storage internal server error response has a 2xx status code
-func (o *CreateSharefileStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create sharefile storage internal server error response has a 3xx status code
-func (o *CreateSharefileStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create sharefile storage internal server error response has a 4xx status code
-func (o *CreateSharefileStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create sharefile storage internal server error response has a 5xx status code
-func (o *CreateSharefileStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create sharefile storage internal server error response a status code equal to that given
-func (o *CreateSharefileStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create sharefile storage internal server error response
-func (o *CreateSharefileStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateSharefileStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/sharefile][%d] createSharefileStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSharefileStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/sharefile][%d] createSharefileStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSharefileStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSharefileStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateSiaStorageParams creates a new CreateSiaStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateSiaStorageParams() *CreateSiaStorageParams {
-	return &CreateSiaStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateSiaStorageParamsWithTimeout creates a new CreateSiaStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateSiaStorageParamsWithTimeout(timeout time.Duration) *CreateSiaStorageParams {
-	return &CreateSiaStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateSiaStorageParamsWithContext creates a new CreateSiaStorageParams object
-// with the ability to set a context for a request.
-func NewCreateSiaStorageParamsWithContext(ctx context.Context) *CreateSiaStorageParams {
-	return &CreateSiaStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateSiaStorageParamsWithHTTPClient creates a new CreateSiaStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateSiaStorageParamsWithHTTPClient(client *http.Client) *CreateSiaStorageParams {
-	return &CreateSiaStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateSiaStorageParams contains all the parameters to send to the API endpoint
-
-	for the create sia storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateSiaStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateSiaStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create sia storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSiaStorageParams) WithDefaults() *CreateSiaStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create sia storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSiaStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create sia storage params
-func (o *CreateSiaStorageParams) WithTimeout(timeout time.Duration) *CreateSiaStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create sia storage params
-func (o *CreateSiaStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create sia storage params
-func (o *CreateSiaStorageParams) WithContext(ctx context.Context) *CreateSiaStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create sia storage params
-func (o *CreateSiaStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create sia storage params
-func (o *CreateSiaStorageParams) WithHTTPClient(client *http.Client) *CreateSiaStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create sia storage params
-func (o *CreateSiaStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create sia storage params
-func (o *CreateSiaStorageParams) WithRequest(request *models.StorageCreateSiaStorageRequest) *CreateSiaStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create sia storage params
-func (o *CreateSiaStorageParams) SetRequest(request *models.StorageCreateSiaStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateSiaStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateSiaStorageReader is a Reader for the CreateSiaStorage structure.
-type CreateSiaStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateSiaStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateSiaStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateSiaStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateSiaStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/sia] CreateSiaStorage", response, response.Code())
-	}
-}
-
-// NewCreateSiaStorageOK creates a CreateSiaStorageOK with default headers values
-func NewCreateSiaStorageOK() *CreateSiaStorageOK {
-	return &CreateSiaStorageOK{}
-}
-
-/*
-CreateSiaStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateSiaStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create sia storage o k response has a 2xx status code
-func (o *CreateSiaStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create sia storage o k response has a 3xx status code
-func (o *CreateSiaStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create sia storage o k response has a 4xx status code
-func (o *CreateSiaStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create sia storage o k response has a 5xx status code
-func (o *CreateSiaStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create sia storage o k response a status code equal to that given
-func (o *CreateSiaStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create sia storage o k response
-func (o *CreateSiaStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateSiaStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/sia][%d] createSiaStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSiaStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/sia][%d] createSiaStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSiaStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateSiaStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSiaStorageBadRequest creates a CreateSiaStorageBadRequest with default headers values
-func NewCreateSiaStorageBadRequest() *CreateSiaStorageBadRequest {
-	return &CreateSiaStorageBadRequest{}
-}
-
-/*
-CreateSiaStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateSiaStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create sia storage bad request response has a 2xx status code
-func (o *CreateSiaStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create sia storage bad request response has a 3xx status code
-func (o *CreateSiaStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create sia storage bad request response has a 4xx status code
-func (o *CreateSiaStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create sia storage bad request response has a 5xx status code
-func (o *CreateSiaStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create sia storage bad request response a status code equal to that given
-func (o *CreateSiaStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create sia storage bad request response
-func (o *CreateSiaStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateSiaStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/sia][%d] createSiaStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSiaStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/sia][%d] createSiaStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSiaStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSiaStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSiaStorageInternalServerError creates a CreateSiaStorageInternalServerError with default headers values
-func NewCreateSiaStorageInternalServerError() *CreateSiaStorageInternalServerError {
-	return &CreateSiaStorageInternalServerError{}
-}
-
-/*
-CreateSiaStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateSiaStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create sia storage internal server error response has a 2xx status code
-func (o *CreateSiaStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create sia storage internal server error response has a 3xx status code
-func (o *CreateSiaStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create sia storage internal server error response has a 4xx status code
-func (o *CreateSiaStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create sia storage internal server error response has a 5xx status code
-func (o *CreateSiaStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create sia storage internal server error response a status code equal to that given
-func (o *CreateSiaStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create sia storage internal server error response
-func (o *CreateSiaStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateSiaStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/sia][%d] createSiaStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSiaStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/sia][%d] createSiaStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSiaStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSiaStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateSmbStorageParams creates a new CreateSmbStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateSmbStorageParams() *CreateSmbStorageParams {
-	return &CreateSmbStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateSmbStorageParamsWithTimeout creates a new CreateSmbStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateSmbStorageParamsWithTimeout(timeout time.Duration) *CreateSmbStorageParams {
-	return &CreateSmbStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateSmbStorageParamsWithContext creates a new CreateSmbStorageParams object
-// with the ability to set a context for a request.
-func NewCreateSmbStorageParamsWithContext(ctx context.Context) *CreateSmbStorageParams {
-	return &CreateSmbStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateSmbStorageParamsWithHTTPClient creates a new CreateSmbStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateSmbStorageParamsWithHTTPClient(client *http.Client) *CreateSmbStorageParams {
-	return &CreateSmbStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateSmbStorageParams contains all the parameters to send to the API endpoint
-
-	for the create smb storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateSmbStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateSmbStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create smb storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSmbStorageParams) WithDefaults() *CreateSmbStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create smb storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSmbStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create smb storage params
-func (o *CreateSmbStorageParams) WithTimeout(timeout time.Duration) *CreateSmbStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create smb storage params
-func (o *CreateSmbStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create smb storage params
-func (o *CreateSmbStorageParams) WithContext(ctx context.Context) *CreateSmbStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create smb storage params
-func (o *CreateSmbStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create smb storage params
-func (o *CreateSmbStorageParams) WithHTTPClient(client *http.Client) *CreateSmbStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create smb storage params
-func (o *CreateSmbStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create smb storage params
-func (o *CreateSmbStorageParams) WithRequest(request *models.StorageCreateSmbStorageRequest) *CreateSmbStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create smb storage params
-func (o *CreateSmbStorageParams) SetRequest(request *models.StorageCreateSmbStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateSmbStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateSmbStorageReader is a Reader for the CreateSmbStorage structure.
-type CreateSmbStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateSmbStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateSmbStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateSmbStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateSmbStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/smb] CreateSmbStorage", response, response.Code())
-	}
-}
-
-// NewCreateSmbStorageOK creates a CreateSmbStorageOK with default headers values
-func NewCreateSmbStorageOK() *CreateSmbStorageOK {
-	return &CreateSmbStorageOK{}
-}
-
-/*
-CreateSmbStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateSmbStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create smb storage o k response has a 2xx status code
-func (o *CreateSmbStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create smb storage o k response has a 3xx status code
-func (o *CreateSmbStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create smb storage o k response has a 4xx status code
-func (o *CreateSmbStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create smb storage o k response has a 5xx status code
-func (o *CreateSmbStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create smb storage o k response a status code equal to that given
-func (o *CreateSmbStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create smb storage o k response
-func (o *CreateSmbStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateSmbStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/smb][%d] createSmbStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSmbStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/smb][%d] createSmbStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSmbStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateSmbStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSmbStorageBadRequest creates a CreateSmbStorageBadRequest with default headers values
-func NewCreateSmbStorageBadRequest() *CreateSmbStorageBadRequest {
-	return &CreateSmbStorageBadRequest{}
-}
-
-/*
-CreateSmbStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateSmbStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create smb storage bad request response has a 2xx status code
-func (o *CreateSmbStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create smb storage bad request response has a 3xx status code
-func (o *CreateSmbStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create smb storage bad request response has a 4xx status code
-func (o *CreateSmbStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create smb storage bad request response has a 5xx status code
-func (o *CreateSmbStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create smb storage bad request response a status code equal to that given
-func (o *CreateSmbStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create smb storage bad request response
-func (o *CreateSmbStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateSmbStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/smb][%d] createSmbStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSmbStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/smb][%d] createSmbStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSmbStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSmbStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSmbStorageInternalServerError creates a CreateSmbStorageInternalServerError with default headers values
-func NewCreateSmbStorageInternalServerError() *CreateSmbStorageInternalServerError {
-	return &CreateSmbStorageInternalServerError{}
-}
-
-/*
-CreateSmbStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateSmbStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create smb storage internal server error response has a 2xx status code
-func (o *CreateSmbStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create smb storage internal server error response has a 3xx status code
-func (o *CreateSmbStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create smb storage internal server error response has a 4xx status code
-func (o *CreateSmbStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create smb storage internal server error response has a 5xx status code
-func (o *CreateSmbStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create smb storage internal server error response a status code equal to that given
-func (o *CreateSmbStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create smb storage internal server error response
-func (o *CreateSmbStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateSmbStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/smb][%d] createSmbStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSmbStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/smb][%d] createSmbStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSmbStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSmbStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
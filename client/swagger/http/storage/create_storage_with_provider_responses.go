@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package storage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// CreateStorageWithProviderReader is a Reader for the CreateStorageWithProvider structure.
+type CreateStorageWithProviderReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateStorageWithProviderReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCreateStorageWithProviderOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCreateStorageWithProviderBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewCreateStorageWithProviderInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /storage/{type}/{provider}] CreateStorageWithProvider", response, response.Code())
+	}
+}
+
+// NewCreateStorageWithProviderOK creates a CreateStorageWithProviderOK with default headers values
+func NewCreateStorageWithProviderOK() *CreateStorageWithProviderOK {
+	return &CreateStorageWithProviderOK{}
+}
+
+/*
+CreateStorageWithProviderOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type CreateStorageWithProviderOK struct {
+	Payload *models.ModelStorage
+}
+
+// IsSuccess returns true when this create storage with provider o k response has a 2xx status code
+func (o *CreateStorageWithProviderOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create storage with provider o k response has a 3xx status code
+func (o *CreateStorageWithProviderOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create storage with provider o k response has a 4xx status code
+func (o *CreateStorageWithProviderOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create storage with provider o k response has a 5xx status code
+func (o *CreateStorageWithProviderOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create storage with provider o k response a status code equal to that given
+func (o *CreateStorageWithProviderOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the create storage with provider o k response
+func (o *CreateStorageWithProviderOK) Code() int {
+	return 200
+}
+
+func (o *CreateStorageWithProviderOK) Error() string {
+	return fmt.Sprintf("[POST /storage/{type}/{provider}][%d] createStorageWithProviderOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateStorageWithProviderOK) String() string {
+	return fmt.Sprintf("[POST /storage/{type}/{provider}][%d] createStorageWithProviderOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateStorageWithProviderOK) GetPayload() *models.ModelStorage {
+	return o.Payload
+}
+
+func (o *CreateStorageWithProviderOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ModelStorage)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateStorageWithProviderBadRequest creates a CreateStorageWithProviderBadRequest with default headers values
+func NewCreateStorageWithProviderBadRequest() *CreateStorageWithProviderBadRequest {
+	return &CreateStorageWithProviderBadRequest{}
+}
+
+/*
+CreateStorageWithProviderBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type CreateStorageWithProviderBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this create storage with provider bad request response has a 2xx status code
+func (o *CreateStorageWithProviderBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create storage with provider bad request response has a 3xx status code
+func (o *CreateStorageWithProviderBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create storage with provider bad request response has a 4xx status code
+func (o *CreateStorageWithProviderBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create storage with provider bad request response has a 5xx status code
+func (o *CreateStorageWithProviderBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create storage with provider bad request response a status code equal to that given
+func (o *CreateStorageWithProviderBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the create storage with provider bad request response
+func (o *CreateStorageWithProviderBadRequest) Code() int {
+	return 400
+}
+
+func (o *CreateStorageWithProviderBadRequest) Error() string {
+	return fmt.Sprintf("[POST /storage/{type}/{provider}][%d] createStorageWithProviderBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateStorageWithProviderBadRequest) String() string {
+	return fmt.Sprintf("[POST /storage/{type}/{provider}][%d] createStorageWithProviderBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateStorageWithProviderBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *CreateStorageWithProviderBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateStorageWithProviderInternalServerError creates a CreateStorageWithProviderInternalServerError with default headers values
+func NewCreateStorageWithProviderInternalServerError() *CreateStorageWithProviderInternalServerError {
+	return &CreateStorageWithProviderInternalServerError{}
+}
+
+/*
+CreateStorageWithProviderInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type CreateStorageWithProviderInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this create storage with provider internal server error response has a 2xx status code
+func (o *CreateStorageWithProviderInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create storage with provider internal server error response has a 3xx status code
+func (o *CreateStorageWithProviderInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create storage with provider internal server error response has a 4xx status code
+func (o *CreateStorageWithProviderInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create storage with provider internal server error response has a 5xx status code
+func (o *CreateStorageWithProviderInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this create storage with provider internal server error response a status code equal to that given
+func (o *CreateStorageWithProviderInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the create storage with provider internal server error response
+func (o *CreateStorageWithProviderInternalServerError) Code() int {
+	return 500
+}
+
+func (o *CreateStorageWithProviderInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /storage/{type}/{provider}][%d] createStorageWithProviderInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CreateStorageWithProviderInternalServerError) String() string {
+	return fmt.Sprintf("[POST /storage/{type}/{provider}][%d] createStorageWithProviderInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CreateStorageWithProviderInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *CreateStorageWithProviderInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateStorjExistingStorageParams creates a new CreateStorjExistingStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateStorjExistingStorageParams() *CreateStorjExistingStorageParams {
-	return &CreateStorjExistingStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateStorjExistingStorageParamsWithTimeout creates a new CreateStorjExistingStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateStorjExistingStorageParamsWithTimeout(timeout time.Duration) *CreateStorjExistingStorageParams {
-	return &CreateStorjExistingStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateStorjExistingStorageParamsWithContext creates a new CreateStorjExistingStorageParams object
-// with the ability to set a context for a request.
-func NewCreateStorjExistingStorageParamsWithContext(ctx context.Context) *CreateStorjExistingStorageParams {
-	return &CreateStorjExistingStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateStorjExistingStorageParamsWithHTTPClient creates a new CreateStorjExistingStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateStorjExistingStorageParamsWithHTTPClient(client *http.Client) *CreateStorjExistingStorageParams {
-	return &CreateStorjExistingStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateStorjExistingStorageParams contains all the parameters to send to the API endpoint
-
-	for the create storj existing storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateStorjExistingStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateStorjExistingStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create storj existing storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateStorjExistingStorageParams) WithDefaults() *CreateStorjExistingStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create storj existing storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateStorjExistingStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create storj existing storage params
-func (o *CreateStorjExistingStorageParams) WithTimeout(timeout time.Duration) *CreateStorjExistingStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create storj existing storage params
-func (o *CreateStorjExistingStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create storj existing storage params
-func (o *CreateStorjExistingStorageParams) WithContext(ctx context.Context) *CreateStorjExistingStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create storj existing storage params
-func (o *CreateStorjExistingStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create storj existing storage params
-func (o *CreateStorjExistingStorageParams) WithHTTPClient(client *http.Client) *CreateStorjExistingStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create storj existing storage params
-func (o *CreateStorjExistingStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create storj existing storage params
-func (o *CreateStorjExistingStorageParams) WithRequest(request *models.StorageCreateStorjExistingStorageRequest) *CreateStorjExistingStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create storj existing storage params
-func (o *CreateStorjExistingStorageParams) SetRequest(request *models.StorageCreateStorjExistingStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateStorjExistingStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
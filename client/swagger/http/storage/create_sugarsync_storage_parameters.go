@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateSugarsyncStorageParams creates a new CreateSugarsyncStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateSugarsyncStorageParams() *CreateSugarsyncStorageParams {
-	return &CreateSugarsyncStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateSugarsyncStorageParamsWithTimeout creates a new CreateSugarsyncStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateSugarsyncStorageParamsWithTimeout(timeout time.Duration) *CreateSugarsyncStorageParams {
-	return &CreateSugarsyncStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateSugarsyncStorageParamsWithContext creates a new CreateSugarsyncStorageParams object
-// with the ability to set a context for a request.
-func NewCreateSugarsyncStorageParamsWithContext(ctx context.Context) *CreateSugarsyncStorageParams {
-	return &CreateSugarsyncStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateSugarsyncStorageParamsWithHTTPClient creates a new CreateSugarsyncStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateSugarsyncStorageParamsWithHTTPClient(client *http.Client) *CreateSugarsyncStorageParams {
-	return &CreateSugarsyncStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateSugarsyncStorageParams contains all the parameters to send to the API endpoint
-
-	for the create sugarsync storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateSugarsyncStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateSugarsyncStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create sugarsync storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSugarsyncStorageParams) WithDefaults() *CreateSugarsyncStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create sugarsync storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSugarsyncStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create sugarsync storage params
-func (o *CreateSugarsyncStorageParams) WithTimeout(timeout time.Duration) *CreateSugarsyncStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create sugarsync storage params
-func (o *CreateSugarsyncStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create sugarsync storage params
-func (o *CreateSugarsyncStorageParams) WithContext(ctx context.Context) *CreateSugarsyncStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create sugarsync storage params
-func (o *CreateSugarsyncStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create sugarsync storage params
-func (o *CreateSugarsyncStorageParams) WithHTTPClient(client *http.Client) *CreateSugarsyncStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create sugarsync storage params
-func (o *CreateSugarsyncStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create sugarsync storage params
-func (o *CreateSugarsyncStorageParams) WithRequest(request *models.StorageCreateSugarsyncStorageRequest) *CreateSugarsyncStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create sugarsync storage params
-func (o *CreateSugarsyncStorageParams) SetRequest(request *models.StorageCreateSugarsyncStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateSugarsyncStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateSwiftStorageParams creates a new CreateSwiftStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateSwiftStorageParams() *CreateSwiftStorageParams {
-	return &CreateSwiftStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateSwiftStorageParamsWithTimeout creates a new CreateSwiftStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateSwiftStorageParamsWithTimeout(timeout time.Duration) *CreateSwiftStorageParams {
-	return &CreateSwiftStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateSwiftStorageParamsWithContext creates a new CreateSwiftStorageParams object
-// with the ability to set a context for a request.
-func NewCreateSwiftStorageParamsWithContext(ctx context.Context) *CreateSwiftStorageParams {
-	return &CreateSwiftStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateSwiftStorageParamsWithHTTPClient creates a new CreateSwiftStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateSwiftStorageParamsWithHTTPClient(client *http.Client) *CreateSwiftStorageParams {
-	return &CreateSwiftStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateSwiftStorageParams contains all the parameters to send to the API endpoint
-
-	for the create swift storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateSwiftStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateSwiftStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create swift storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSwiftStorageParams) WithDefaults() *CreateSwiftStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create swift storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateSwiftStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create swift storage params
-func (o *CreateSwiftStorageParams) WithTimeout(timeout time.Duration) *CreateSwiftStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create swift storage params
-func (o *CreateSwiftStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create swift storage params
-func (o *CreateSwiftStorageParams) WithContext(ctx context.Context) *CreateSwiftStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create swift storage params
-func (o *CreateSwiftStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create swift storage params
-func (o *CreateSwiftStorageParams) WithHTTPClient(client *http.Client) *CreateSwiftStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create swift storage params
-func (o *CreateSwiftStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create swift storage params
-func (o *CreateSwiftStorageParams) WithRequest(request *models.StorageCreateSwiftStorageRequest) *CreateSwiftStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create swift storage params
-func (o *CreateSwiftStorageParams) SetRequest(request *models.StorageCreateSwiftStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateSwiftStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
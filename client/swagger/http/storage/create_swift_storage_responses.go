@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateSwiftStorageReader is a Reader for the CreateSwiftStorage structure.
-type CreateSwiftStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateSwiftStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateSwiftStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateSwiftStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateSwiftStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/swift] CreateSwiftStorage", response, response.Code())
-	}
-}
-
-// NewCreateSwiftStorageOK creates a CreateSwiftStorageOK with default headers values
-func NewCreateSwiftStorageOK() *CreateSwiftStorageOK {
-	return &CreateSwiftStorageOK{}
-}
-
-/*
-CreateSwiftStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateSwiftStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create swift storage o k response has a 2xx status code
-func (o *CreateSwiftStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create swift storage o k response has a 3xx status code
-func (o *CreateSwiftStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create swift storage o k response has a 4xx status code
-func (o *CreateSwiftStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create swift storage o k response has a 5xx status code
-func (o *CreateSwiftStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create swift storage o k response a status code equal to that given
-func (o *CreateSwiftStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create swift storage o k response
-func (o *CreateSwiftStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateSwiftStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/swift][%d] createSwiftStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSwiftStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/swift][%d] createSwiftStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateSwiftStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateSwiftStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSwiftStorageBadRequest creates a CreateSwiftStorageBadRequest with default headers values
-func NewCreateSwiftStorageBadRequest() *CreateSwiftStorageBadRequest {
-	return &CreateSwiftStorageBadRequest{}
-}
-
-/*
-CreateSwiftStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateSwiftStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create swift storage bad request response has a 2xx status code
-func (o *CreateSwiftStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create swift storage bad request response has a 3xx status code
-func (o *CreateSwiftStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create swift storage bad request response has a 4xx status code
-func (o *CreateSwiftStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create swift storage bad request response has a 5xx status code
-func (o *CreateSwiftStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create swift storage bad request response a status code equal to that given
-func (o *CreateSwiftStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create swift storage bad request response
-func (o *CreateSwiftStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateSwiftStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/swift][%d] createSwiftStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSwiftStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/swift][%d] createSwiftStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateSwiftStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSwiftStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateSwiftStorageInternalServerError creates a CreateSwiftStorageInternalServerError with default headers values
-func NewCreateSwiftStorageInternalServerError() *CreateSwiftStorageInternalServerError {
-	return &CreateSwiftStorageInternalServerError{}
-}
-
-/*
-CreateSwiftStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateSwiftStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create swift storage internal server error response has a 2xx status code
-func (o *CreateSwiftStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create swift storage internal server error response has a 3xx status code
-func (o *CreateSwiftStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create swift storage internal server error response has a 4xx status code
-func (o *CreateSwiftStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create swift storage internal server error response has a 5xx status code
-func (o *CreateSwiftStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create swift storage internal server error response a status code equal to that given
-func (o *CreateSwiftStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create swift storage internal server error response
-func (o *CreateSwiftStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateSwiftStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/swift][%d] createSwiftStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSwiftStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/swift][%d] createSwiftStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateSwiftStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateSwiftStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
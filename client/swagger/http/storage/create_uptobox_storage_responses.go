@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateUptoboxStorageReader is a Reader for the CreateUptoboxStorage structure.
-type CreateUptoboxStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateUptoboxStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateUptoboxStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateUptoboxStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateUptoboxStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/uptobox] CreateUptoboxStorage", response, response.Code())
-	}
-}
-
-// NewCreateUptoboxStorageOK creates a CreateUptoboxStorageOK with default headers values
-func NewCreateUptoboxStorageOK() *CreateUptoboxStorageOK {
-	return &CreateUptoboxStorageOK{}
-}
-
-/*
-CreateUptoboxStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateUptoboxStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create uptobox storage o k response has a 2xx status code
-func (o *CreateUptoboxStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create uptobox storage o k response has a 3xx status code
-func (o *CreateUptoboxStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create uptobox storage o k response has a 4xx status code
-func (o *CreateUptoboxStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create uptobox storage o k response has a 5xx status code
-func (o *CreateUptoboxStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create uptobox storage o k response a status code equal to that given
-func (o *CreateUptoboxStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create uptobox storage o k response
-func (o *CreateUptoboxStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateUptoboxStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/uptobox][%d] createUptoboxStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateUptoboxStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/uptobox][%d] createUptoboxStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateUptoboxStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateUptoboxStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateUptoboxStorageBadRequest creates a CreateUptoboxStorageBadRequest with default headers values
-func NewCreateUptoboxStorageBadRequest() *CreateUptoboxStorageBadRequest {
-	return &CreateUptoboxStorageBadRequest{}
-}
-
-/*
-CreateUptoboxStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateUptoboxStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create uptobox storage bad request response has a 2xx status code
-func (o *CreateUptoboxStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create uptobox storage bad request response has a 3xx status code
-func (o *CreateUptoboxStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create uptobox storage bad request response has a 4xx status code
-func (o *CreateUptoboxStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create uptobox storage bad request response has a 5xx status code
-func (o *CreateUptoboxStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create uptobox storage bad request response a status code equal to that given
-func (o *CreateUptoboxStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create uptobox storage bad request response
-func (o *CreateUptoboxStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateUptoboxStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/uptobox][%d] createUptoboxStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateUptoboxStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/uptobox][%d] createUptoboxStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateUptoboxStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateUptoboxStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateUptoboxStorageInternalServerError creates a CreateUptoboxStorageInternalServerError with default headers values
-func NewCreateUptoboxStorageInternalServerError() *CreateUptoboxStorageInternalServerError {
-	return &CreateUptoboxStorageInternalServerError{}
-}
-
-/*
-CreateUptoboxStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateUptoboxStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create uptobox storage internal server error response has a 2xx status code
-func (o *CreateUptoboxStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create uptobox storage internal server error response has a 3xx status code
-func (o *CreateUptoboxStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create uptobox storage internal server error response has a 4xx status code
-func (o *CreateUptoboxStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create uptobox storage internal server error response has a 5xx status code
-func (o *CreateUptoboxStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create uptobox storage internal server error response a status code equal to that given
-func (o *CreateUptoboxStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create uptobox storage internal server error response
-func (o *CreateUptoboxStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateUptoboxStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/uptobox][%d] createUptoboxStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateUptoboxStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/uptobox][%d] createUptoboxStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateUptoboxStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateUptoboxStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,153 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// NewCreateZohoStorageParams creates a new CreateZohoStorageParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewCreateZohoStorageParams() *CreateZohoStorageParams {
-	return &CreateZohoStorageParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewCreateZohoStorageParamsWithTimeout creates a new CreateZohoStorageParams object
-// with the ability to set a timeout on a request.
-func NewCreateZohoStorageParamsWithTimeout(timeout time.Duration) *CreateZohoStorageParams {
-	return &CreateZohoStorageParams{
-		timeout: timeout,
-	}
-}
-
-// NewCreateZohoStorageParamsWithContext creates a new CreateZohoStorageParams object
-// with the ability to set a context for a request.
-func NewCreateZohoStorageParamsWithContext(ctx context.Context) *CreateZohoStorageParams {
-	return &CreateZohoStorageParams{
-		Context: ctx,
-	}
-}
-
-// NewCreateZohoStorageParamsWithHTTPClient creates a new CreateZohoStorageParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewCreateZohoStorageParamsWithHTTPClient(client *http.Client) *CreateZohoStorageParams {
-	return &CreateZohoStorageParams{
-		HTTPClient: client,
-	}
-}
-
-/*
-CreateZohoStorageParams contains all the parameters to send to the API endpoint
-
-	for the create zoho storage operation.
-
-	Typically these are written to a http.Request.
-*/
-type CreateZohoStorageParams struct {
-
-	/* Request.
-
-	   Request body
-	*/
-	Request *models.StorageCreateZohoStorageRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the create zoho storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateZohoStorageParams) WithDefaults() *CreateZohoStorageParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the create zoho storage params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *CreateZohoStorageParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the create zoho storage params
-func (o *CreateZohoStorageParams) WithTimeout(timeout time.Duration) *CreateZohoStorageParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the create zoho storage params
-func (o *CreateZohoStorageParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the create zoho storage params
-func (o *CreateZohoStorageParams) WithContext(ctx context.Context) *CreateZohoStorageParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the create zoho storage params
-func (o *CreateZohoStorageParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the create zoho storage params
-func (o *CreateZohoStorageParams) WithHTTPClient(client *http.Client) *CreateZohoStorageParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the create zoho storage params
-func (o *CreateZohoStorageParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithRequest adds the request to the create zoho storage params
-func (o *CreateZohoStorageParams) WithRequest(request *models.StorageCreateZohoStorageRequest) *CreateZohoStorageParams {
-	o.SetRequest(request)
-	return o
-}
-
-// SetRequest adds the request to the create zoho storage params
-func (o *CreateZohoStorageParams) SetRequest(request *models.StorageCreateZohoStorageRequest) {
-	o.Request = request
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *CreateZohoStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Request != nil {
-		if err := r.SetBodyParam(o.Request); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
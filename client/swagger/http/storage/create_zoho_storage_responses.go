@@ -1,251 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package storage
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-	"github.com/data-preservation-programs/singularity/client/swagger/models"
-)
-
-// CreateZohoStorageReader is a Reader for the CreateZohoStorage structure.
-type CreateZohoStorageReader struct {
-	formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *CreateZohoStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewCreateZohoStorageOK()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	case 400:
-		result := NewCreateZohoStorageBadRequest()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	case 500:
-		result := NewCreateZohoStorageInternalServerError()
-		if err := result.readResponse(response, consumer, o.formats); err != nil {
-			return nil, err
-		}
-		return nil, result
-	default:
-		return nil, runtime.NewAPIError("[POST /storage/zoho] CreateZohoStorage", response, response.Code())
-	}
-}
-
-// NewCreateZohoStorageOK creates a CreateZohoStorageOK with default headers values
-func NewCreateZohoStorageOK() *CreateZohoStorageOK {
-	return &CreateZohoStorageOK{}
-}
-
-/*
-CreateZohoStorageOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type CreateZohoStorageOK struct {
-	Payload *models.ModelStorage
-}
-
-// IsSuccess returns true when this create zoho storage o k response has a 2xx status code
-func (o *CreateZohoStorageOK) IsSuccess() bool {
-	return true
-}
-
-// IsRedirect returns true when this create zoho storage o k response has a 3xx status code
-func (o *CreateZohoStorageOK) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create zoho storage o k response has a 4xx status code
-func (o *CreateZohoStorageOK) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create zoho storage o k response has a 5xx status code
-func (o *CreateZohoStorageOK) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create zoho storage o k response a status code equal to that given
-func (o *CreateZohoStorageOK) IsCode(code int) bool {
-	return code == 200
-}
-
-// Code gets the status code for the create zoho storage o k response
-func (o *CreateZohoStorageOK) Code() int {
-	return 200
-}
-
-func (o *CreateZohoStorageOK) Error() string {
-	return fmt.Sprintf("[POST /storage/zoho][%d] createZohoStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateZohoStorageOK) String() string {
-	return fmt.Sprintf("[POST /storage/zoho][%d] createZohoStorageOK  %+v", 200, o.Payload)
-}
-
-func (o *CreateZohoStorageOK) GetPayload() *models.ModelStorage {
-	return o.Payload
-}
-
-func (o *CreateZohoStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.ModelStorage)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateZohoStorageBadRequest creates a CreateZohoStorageBadRequest with default headers values
-func NewCreateZohoStorageBadRequest() *CreateZohoStorageBadRequest {
-	return &CreateZohoStorageBadRequest{}
-}
-
-/*
-CreateZohoStorageBadRequest describes a response with status code 400, with default header values.
-
-Bad Request
-*/
-type CreateZohoStorageBadRequest struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create zoho storage bad request response has a 2xx status code
-func (o *CreateZohoStorageBadRequest) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create zoho storage bad request response has a 3xx status code
-func (o *CreateZohoStorageBadRequest) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create zoho storage bad request response has a 4xx status code
-func (o *CreateZohoStorageBadRequest) IsClientError() bool {
-	return true
-}
-
-// IsServerError returns true when this create zoho storage bad request response has a 5xx status code
-func (o *CreateZohoStorageBadRequest) IsServerError() bool {
-	return false
-}
-
-// IsCode returns true when this create zoho storage bad request response a status code equal to that given
-func (o *CreateZohoStorageBadRequest) IsCode(code int) bool {
-	return code == 400
-}
-
-// Code gets the status code for the create zoho storage bad request response
-func (o *CreateZohoStorageBadRequest) Code() int {
-	return 400
-}
-
-func (o *CreateZohoStorageBadRequest) Error() string {
-	return fmt.Sprintf("[POST /storage/zoho][%d] createZohoStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateZohoStorageBadRequest) String() string {
-	return fmt.Sprintf("[POST /storage/zoho][%d] createZohoStorageBadRequest  %+v", 400, o.Payload)
-}
-
-func (o *CreateZohoStorageBadRequest) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateZohoStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
-
-// NewCreateZohoStorageInternalServerError creates a CreateZohoStorageInternalServerError with default headers values
-func NewCreateZohoStorageInternalServerError() *CreateZohoStorageInternalServerError {
-	return &CreateZohoStorageInternalServerError{}
-}
-
-/*
-CreateZohoStorageInternalServerError describes a response with status code 500, with default header values.
-
-Internal Server Error
-*/
-type CreateZohoStorageInternalServerError struct {
-	Payload *models.APIHTTPError
-}
-
-// IsSuccess returns true when this create zoho storage internal server error response has a 2xx status code
-func (o *CreateZohoStorageInternalServerError) IsSuccess() bool {
-	return false
-}
-
-// IsRedirect returns true when this create zoho storage internal server error response has a 3xx status code
-func (o *CreateZohoStorageInternalServerError) IsRedirect() bool {
-	return false
-}
-
-// IsClientError returns true when this create zoho storage internal server error response has a 4xx status code
-func (o *CreateZohoStorageInternalServerError) IsClientError() bool {
-	return false
-}
-
-// IsServerError returns true when this create zoho storage internal server error response has a 5xx status code
-func (o *CreateZohoStorageInternalServerError) IsServerError() bool {
-	return true
-}
-
-// IsCode returns true when this create zoho storage internal server error response a status code equal to that given
-func (o *CreateZohoStorageInternalServerError) IsCode(code int) bool {
-	return code == 500
-}
-
-// Code gets the status code for the create zoho storage internal server error response
-func (o *CreateZohoStorageInternalServerError) Code() int {
-	return 500
-}
-
-func (o *CreateZohoStorageInternalServerError) Error() string {
-	return fmt.Sprintf("[POST /storage/zoho][%d] createZohoStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateZohoStorageInternalServerError) String() string {
-	return fmt.Sprintf("[POST /storage/zoho][%d] createZohoStorageInternalServerError  %+v", 500, o.Payload)
-}
-
-func (o *CreateZohoStorageInternalServerError) GetPayload() *models.APIHTTPError {
-	return o.Payload
-}
-
-func (o *CreateZohoStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(models.APIHTTPError)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package storage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// GetStorageTypeReader is a Reader for the GetStorageType structure.
+type GetStorageTypeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetStorageTypeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetStorageTypeOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetStorageTypeNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetStorageTypeInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /storage/types/{type}] GetStorageType", response, response.Code())
+	}
+}
+
+// NewGetStorageTypeOK creates a GetStorageTypeOK with default headers values
+func NewGetStorageTypeOK() *GetStorageTypeOK {
+	return &GetStorageTypeOK{}
+}
+
+/*
+GetStorageTypeOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetStorageTypeOK struct {
+	Payload *models.StorageStorageType
+}
+
+// IsSuccess returns true when this get storage type o k response has a 2xx status code
+func (o *GetStorageTypeOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get storage type o k response has a 3xx status code
+func (o *GetStorageTypeOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get storage type o k response has a 4xx status code
+func (o *GetStorageTypeOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get storage type o k response has a 5xx status code
+func (o *GetStorageTypeOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get storage type o k response a status code equal to that given
+func (o *GetStorageTypeOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get storage type o k response
+func (o *GetStorageTypeOK) Code() int {
+	return 200
+}
+
+func (o *GetStorageTypeOK) Error() string {
+	return fmt.Sprintf("[GET /storage/types/{type}][%d] getStorageTypeOK  %+v", 200, o.Payload)
+}
+
+func (o *GetStorageTypeOK) String() string {
+	return fmt.Sprintf("[GET /storage/types/{type}][%d] getStorageTypeOK  %+v", 200, o.Payload)
+}
+
+func (o *GetStorageTypeOK) GetPayload() *models.StorageStorageType {
+	return o.Payload
+}
+
+func (o *GetStorageTypeOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.StorageStorageType)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetStorageTypeNotFound creates a GetStorageTypeNotFound with default headers values
+func NewGetStorageTypeNotFound() *GetStorageTypeNotFound {
+	return &GetStorageTypeNotFound{}
+}
+
+/*
+GetStorageTypeNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type GetStorageTypeNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get storage type not found response has a 2xx status code
+func (o *GetStorageTypeNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get storage type not found response has a 3xx status code
+func (o *GetStorageTypeNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get storage type not found response has a 4xx status code
+func (o *GetStorageTypeNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get storage type not found response has a 5xx status code
+func (o *GetStorageTypeNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get storage type not found response a status code equal to that given
+func (o *GetStorageTypeNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get storage type not found response
+func (o *GetStorageTypeNotFound) Code() int {
+	return 404
+}
+
+func (o *GetStorageTypeNotFound) Error() string {
+	return fmt.Sprintf("[GET /storage/types/{type}][%d] getStorageTypeNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetStorageTypeNotFound) String() string {
+	return fmt.Sprintf("[GET /storage/types/{type}][%d] getStorageTypeNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetStorageTypeNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetStorageTypeNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetStorageTypeInternalServerError creates a GetStorageTypeInternalServerError with default headers values
+func NewGetStorageTypeInternalServerError() *GetStorageTypeInternalServerError {
+	return &GetStorageTypeInternalServerError{}
+}
+
+/*
+GetStorageTypeInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type GetStorageTypeInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get storage type internal server error response has a 2xx status code
+func (o *GetStorageTypeInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get storage type internal server error response has a 3xx status code
+func (o *GetStorageTypeInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get storage type internal server error response has a 4xx status code
+func (o *GetStorageTypeInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get storage type internal server error response has a 5xx status code
+func (o *GetStorageTypeInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get storage type internal server error response a status code equal to that given
+func (o *GetStorageTypeInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the get storage type internal server error response
+func (o *GetStorageTypeInternalServerError) Code() int {
+	return 500
+}
+
+func (o *GetStorageTypeInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /storage/types/{type}][%d] getStorageTypeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetStorageTypeInternalServerError) String() string {
+	return fmt.Sprintf("[GET /storage/types/{type}][%d] getStorageTypeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetStorageTypeInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetStorageTypeInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package storage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// ListSlowestSourcesReader is a Reader for the ListSlowestSources structure.
+type ListSlowestSourcesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListSlowestSourcesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListSlowestSourcesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewListSlowestSourcesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListSlowestSourcesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /storage/slowest] ListSlowestSources", response, response.Code())
+	}
+}
+
+// NewListSlowestSourcesOK creates a ListSlowestSourcesOK with default headers values
+func NewListSlowestSourcesOK() *ListSlowestSourcesOK {
+	return &ListSlowestSourcesOK{}
+}
+
+/*
+ListSlowestSourcesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListSlowestSourcesOK struct {
+	Payload []*models.StorageSlowSource
+}
+
+// IsSuccess returns true when this list slowest sources o k response has a 2xx status code
+func (o *ListSlowestSourcesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list slowest sources o k response has a 3xx status code
+func (o *ListSlowestSourcesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list slowest sources o k response has a 4xx status code
+func (o *ListSlowestSourcesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list slowest sources o k response has a 5xx status code
+func (o *ListSlowestSourcesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list slowest sources o k response a status code equal to that given
+func (o *ListSlowestSourcesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list slowest sources o k response
+func (o *ListSlowestSourcesOK) Code() int {
+	return 200
+}
+
+func (o *ListSlowestSourcesOK) Error() string {
+	return fmt.Sprintf("[POST /storage/slowest][%d] listSlowestSourcesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListSlowestSourcesOK) String() string {
+	return fmt.Sprintf("[POST /storage/slowest][%d] listSlowestSourcesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListSlowestSourcesOK) GetPayload() []*models.StorageSlowSource {
+	return o.Payload
+}
+
+func (o *ListSlowestSourcesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListSlowestSourcesBadRequest creates a ListSlowestSourcesBadRequest with default headers values
+func NewListSlowestSourcesBadRequest() *ListSlowestSourcesBadRequest {
+	return &ListSlowestSourcesBadRequest{}
+}
+
+/*
+ListSlowestSourcesBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ListSlowestSourcesBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this list slowest sources bad request response has a 2xx status code
+func (o *ListSlowestSourcesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list slowest sources bad request response has a 3xx status code
+func (o *ListSlowestSourcesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list slowest sources bad request response has a 4xx status code
+func (o *ListSlowestSourcesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list slowest sources bad request response has a 5xx status code
+func (o *ListSlowestSourcesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list slowest sources bad request response a status code equal to that given
+func (o *ListSlowestSourcesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the list slowest sources bad request response
+func (o *ListSlowestSourcesBadRequest) Code() int {
+	return 400
+}
+
+func (o *ListSlowestSourcesBadRequest) Error() string {
+	return fmt.Sprintf("[POST /storage/slowest][%d] listSlowestSourcesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListSlowestSourcesBadRequest) String() string {
+	return fmt.Sprintf("[POST /storage/slowest][%d] listSlowestSourcesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListSlowestSourcesBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ListSlowestSourcesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListSlowestSourcesInternalServerError creates a ListSlowestSourcesInternalServerError with default headers values
+func NewListSlowestSourcesInternalServerError() *ListSlowestSourcesInternalServerError {
+	return &ListSlowestSourcesInternalServerError{}
+}
+
+/*
+ListSlowestSourcesInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ListSlowestSourcesInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this list slowest sources internal server error response has a 2xx status code
+func (o *ListSlowestSourcesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list slowest sources internal server error response has a 3xx status code
+func (o *ListSlowestSourcesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list slowest sources internal server error response has a 4xx status code
+func (o *ListSlowestSourcesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list slowest sources internal server error response has a 5xx status code
+func (o *ListSlowestSourcesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this list slowest sources internal server error response a status code equal to that given
+func (o *ListSlowestSourcesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the list slowest sources internal server error response
+func (o *ListSlowestSourcesInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ListSlowestSourcesInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /storage/slowest][%d] listSlowestSourcesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListSlowestSourcesInternalServerError) String() string {
+	return fmt.Sprintf("[POST /storage/slowest][%d] listSlowestSourcesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListSlowestSourcesInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ListSlowestSourcesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package storage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// ReauthStorageReader is a Reader for the ReauthStorage structure.
+type ReauthStorageReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReauthStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReauthStorageOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReauthStorageBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReauthStorageNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReauthStorageInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /storage/{name}/reauth] ReauthStorage", response, response.Code())
+	}
+}
+
+// NewReauthStorageOK creates a ReauthStorageOK with default headers values
+func NewReauthStorageOK() *ReauthStorageOK {
+	return &ReauthStorageOK{}
+}
+
+/*
+ReauthStorageOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReauthStorageOK struct {
+	Payload *models.ModelStorage
+}
+
+// IsSuccess returns true when this reauth storage o k response has a 2xx status code
+func (o *ReauthStorageOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reauth storage o k response has a 3xx status code
+func (o *ReauthStorageOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reauth storage o k response has a 4xx status code
+func (o *ReauthStorageOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reauth storage o k response has a 5xx status code
+func (o *ReauthStorageOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reauth storage o k response a status code equal to that given
+func (o *ReauthStorageOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reauth storage o k response
+func (o *ReauthStorageOK) Code() int {
+	return 200
+}
+
+func (o *ReauthStorageOK) Error() string {
+	return fmt.Sprintf("[POST /storage/{name}/reauth][%d] reauthStorageOK  %+v", 200, o.Payload)
+}
+
+func (o *ReauthStorageOK) String() string {
+	return fmt.Sprintf("[POST /storage/{name}/reauth][%d] reauthStorageOK  %+v", 200, o.Payload)
+}
+
+func (o *ReauthStorageOK) GetPayload() *models.ModelStorage {
+	return o.Payload
+}
+
+func (o *ReauthStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ModelStorage)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReauthStorageBadRequest creates a ReauthStorageBadRequest with default headers values
+func NewReauthStorageBadRequest() *ReauthStorageBadRequest {
+	return &ReauthStorageBadRequest{}
+}
+
+/*
+ReauthStorageBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReauthStorageBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this reauth storage bad request response has a 2xx status code
+func (o *ReauthStorageBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reauth storage bad request response has a 3xx status code
+func (o *ReauthStorageBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reauth storage bad request response has a 4xx status code
+func (o *ReauthStorageBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reauth storage bad request response has a 5xx status code
+func (o *ReauthStorageBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reauth storage bad request response a status code equal to that given
+func (o *ReauthStorageBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reauth storage bad request response
+func (o *ReauthStorageBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReauthStorageBadRequest) Error() string {
+	return fmt.Sprintf("[POST /storage/{name}/reauth][%d] reauthStorageBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ReauthStorageBadRequest) String() string {
+	return fmt.Sprintf("[POST /storage/{name}/reauth][%d] reauthStorageBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ReauthStorageBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ReauthStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReauthStorageNotFound creates a ReauthStorageNotFound with default headers values
+func NewReauthStorageNotFound() *ReauthStorageNotFound {
+	return &ReauthStorageNotFound{}
+}
+
+/*
+ReauthStorageNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ReauthStorageNotFound struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this reauth storage not found response has a 2xx status code
+func (o *ReauthStorageNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reauth storage not found response has a 3xx status code
+func (o *ReauthStorageNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reauth storage not found response has a 4xx status code
+func (o *ReauthStorageNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reauth storage not found response has a 5xx status code
+func (o *ReauthStorageNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reauth storage not found response a status code equal to that given
+func (o *ReauthStorageNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the reauth storage not found response
+func (o *ReauthStorageNotFound) Code() int {
+	return 404
+}
+
+func (o *ReauthStorageNotFound) Error() string {
+	return fmt.Sprintf("[POST /storage/{name}/reauth][%d] reauthStorageNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReauthStorageNotFound) String() string {
+	return fmt.Sprintf("[POST /storage/{name}/reauth][%d] reauthStorageNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReauthStorageNotFound) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ReauthStorageNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReauthStorageInternalServerError creates a ReauthStorageInternalServerError with default headers values
+func NewReauthStorageInternalServerError() *ReauthStorageInternalServerError {
+	return &ReauthStorageInternalServerError{}
+}
+
+/*
+ReauthStorageInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReauthStorageInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this reauth storage internal server error response has a 2xx status code
+func (o *ReauthStorageInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reauth storage internal server error response has a 3xx status code
+func (o *ReauthStorageInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reauth storage internal server error response has a 4xx status code
+func (o *ReauthStorageInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reauth storage internal server error response has a 5xx status code
+func (o *ReauthStorageInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reauth storage internal server error response a status code equal to that given
+func (o *ReauthStorageInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reauth storage internal server error response
+func (o *ReauthStorageInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReauthStorageInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /storage/{name}/reauth][%d] reauthStorageInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReauthStorageInternalServerError) String() string {
+	return fmt.Sprintf("[POST /storage/{name}/reauth][%d] reauthStorageInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReauthStorageInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *ReauthStorageInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package wallet
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/data-preservation-programs/singularity/client/swagger/models"
+)
+
+// GetWalletBalancesReader is a Reader for the GetWalletBalances structure.
+type GetWalletBalancesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetWalletBalancesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetWalletBalancesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetWalletBalancesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetWalletBalancesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /wallet/balance] GetWalletBalances", response, response.Code())
+	}
+}
+
+// NewGetWalletBalancesOK creates a GetWalletBalancesOK with default headers values
+func NewGetWalletBalancesOK() *GetWalletBalancesOK {
+	return &GetWalletBalancesOK{}
+}
+
+/*
+GetWalletBalancesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetWalletBalancesOK struct {
+	Payload []*models.WalletBalance
+}
+
+// IsSuccess returns true when this get wallet balances o k response has a 2xx status code
+func (o *GetWalletBalancesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get wallet balances o k response has a 3xx status code
+func (o *GetWalletBalancesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get wallet balances o k response has a 4xx status code
+func (o *GetWalletBalancesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get wallet balances o k response has a 5xx status code
+func (o *GetWalletBalancesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get wallet balances o k response a status code equal to that given
+func (o *GetWalletBalancesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get wallet balances o k response
+func (o *GetWalletBalancesOK) Code() int {
+	return 200
+}
+
+func (o *GetWalletBalancesOK) Error() string {
+	return fmt.Sprintf("[GET /wallet/balance][%d] getWalletBalancesOK  %+v", 200, o.Payload)
+}
+
+func (o *GetWalletBalancesOK) String() string {
+	return fmt.Sprintf("[GET /wallet/balance][%d] getWalletBalancesOK  %+v", 200, o.Payload)
+}
+
+func (o *GetWalletBalancesOK) GetPayload() []*models.WalletBalance {
+	return o.Payload
+}
+
+func (o *GetWalletBalancesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetWalletBalancesBadRequest creates a GetWalletBalancesBadRequest with default headers values
+func NewGetWalletBalancesBadRequest() *GetWalletBalancesBadRequest {
+	return &GetWalletBalancesBadRequest{}
+}
+
+/*
+GetWalletBalancesBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type GetWalletBalancesBadRequest struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get wallet balances bad request response has a 2xx status code
+func (o *GetWalletBalancesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get wallet balances bad request response has a 3xx status code
+func (o *GetWalletBalancesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get wallet balances bad request response has a 4xx status code
+func (o *GetWalletBalancesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get wallet balances bad request response has a 5xx status code
+func (o *GetWalletBalancesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get wallet balances bad request response a status code equal to that given
+func (o *GetWalletBalancesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get wallet balances bad request response
+func (o *GetWalletBalancesBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetWalletBalancesBadRequest) Error() string {
+	return fmt.Sprintf("[GET /wallet/balance][%d] getWalletBalancesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetWalletBalancesBadRequest) String() string {
+	return fmt.Sprintf("[GET /wallet/balance][%d] getWalletBalancesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetWalletBalancesBadRequest) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetWalletBalancesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetWalletBalancesInternalServerError creates a GetWalletBalancesInternalServerError with default headers values
+func NewGetWalletBalancesInternalServerError() *GetWalletBalancesInternalServerError {
+	return &GetWalletBalancesInternalServerError{}
+}
+
+/*
+GetWalletBalancesInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type GetWalletBalancesInternalServerError struct {
+	Payload *models.APIHTTPError
+}
+
+// IsSuccess returns true when this get wallet balances internal server error response has a 2xx status code
+func (o *GetWalletBalancesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get wallet balances internal server error response has a 3xx status code
+func (o *GetWalletBalancesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get wallet balances internal server error response has a 4xx status code
+func (o *GetWalletBalancesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get wallet balances internal server error response has a 5xx status code
+func (o *GetWalletBalancesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get wallet balances internal server error response a status code equal to that given
+func (o *GetWalletBalancesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the get wallet balances internal server error response
+func (o *GetWalletBalancesInternalServerError) Code() int {
+	return 500
+}
+
+func (o *GetWalletBalancesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /wallet/balance][%d] getWalletBalancesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetWalletBalancesInternalServerError) String() string {
+	return fmt.Sprintf("[GET /wallet/balance][%d] getWalletBalancesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetWalletBalancesInternalServerError) GetPayload() *models.APIHTTPError {
+	return o.Payload
+}
+
+func (o *GetWalletBalancesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
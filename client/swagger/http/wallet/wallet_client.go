@@ -30,6 +30,8 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	GetWalletBalances(params *GetWalletBalancesParams, opts ...ClientOption) (*GetWalletBalancesOK, error)
+
 	ImportWallet(params *ImportWalletParams, opts ...ClientOption) (*ImportWalletOK, error)
 
 	ListWallets(params *ListWalletsParams, opts ...ClientOption) (*ListWalletsOK, error)
@@ -39,6 +41,44 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+GetWalletBalances gets the balance and the datacap of all imported wallets
+*/
+func (a *Client) GetWalletBalances(params *GetWalletBalancesParams, opts ...ClientOption) (*GetWalletBalancesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetWalletBalancesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetWalletBalances",
+		Method:             "GET",
+		PathPattern:        "/wallet/balance",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetWalletBalancesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetWalletBalancesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetWalletBalances: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ImportWallet imports a private key
 */
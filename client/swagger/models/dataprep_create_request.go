@@ -19,9 +19,57 @@ import (
 // swagger:model dataprep.CreateRequest
 type DataprepCreateRequest struct {
 
+	// Name of the storage system to store the raw blocks (dag nodes) instead of the database. Can shrink the database for datasets with many small files.
+	BlobStorage string `json:"blobStorage,omitempty"`
+
+	// How the CAR files are compressed in the output storages. Empty or zstd. Compressed CAR files are decompressed by the content provider when they are served, and the piece CID is still the one of the uncompressed CAR file. Requires at least one output storage.
+	CarCompression string `json:"carCompression,omitempty"`
+
+	// Template of the paths of the CAR files in the output storages, without the .car extension. Placeholders are {piece_cid}, {preparation}, {sequence} and {date}, and {piece_cid} is required. Slashes put the CAR files in subdirectories, i.e. {preparation}/{date}/{piece_cid}. Empty means {piece_cid}.
+	CarNameTemplate string `json:"carNameTemplate,omitempty"`
+
+	// Number of levels of subdirectories, named after two characters of the piece CID each, that the CAR files are sharded into, so no directory holds too many CAR files. Up to 3. 0 disables sharding.
+	CarShardDepth int64 `json:"carShardDepth,omitempty"`
+
+	// Whether the sources are collections of existing CAR files, i.e. Filecoin snapshots. The blocks of the .car files are indexed and packed as is, with their offsets in the CAR files, rather than the files being chunked again. Other files are skipped. Requires noDag.
+	CarSource *bool `json:"carSource,omitempty"`
+
+	// Whether to write .sha256 and .commp.json sidecar files with the payload CID, the piece CID, the piece size and the checksum next to each CAR file in the output storages, so transfer tools can verify them without querying the API.
+	ChecksumSidecar *bool `json:"checksumSidecar,omitempty"`
+
+	// Strategy splitting the content of files into leaf blocks, as in ipfs add. One of size-{size}, rabin, rabin-{avg}, rabin-{min}-{avg}-{max} or buzhash. Sizes can have units, i.e. rabin-256KiB-512KiB-1MiB.
+	Chunker *string `json:"chunker,omitempty"`
+
+	// Version of the CIDs of the blocks. One of v1 or v0. v0 requires the sha2-256 hash function and the dag-pb leaf codec.
+	CidVersion *string `json:"cidVersion,omitempty"`
+
+	// What to do when the same path is packed more than once, i.e. a rescan finds a new version of a file. One of overwrite, newest, keep_both or error.
+	ConflictPolicy *string `json:"conflictPolicy,omitempty"`
+
 	// Whether to delete the source files after export
 	DeleteAfterExport *bool `json:"deleteAfterExport,omitempty"`
 
+	// URI of the master key of a key management service, i.e. awskms://<key ARN>, gcpkms://projects/<project>/locations/<location>/keyRings/<key ring>/cryptoKeys/<key> or vault://<transit mount>/<key>. Each file is encrypted with AES-256-GCM as it is packed, with its own data key generated and wrapped by the KMS. Cannot be combined with encryptionRecipients. Requires inline preparation to be disabled.
+	EncryptionKmsKey string `json:"encryptionKmsKey,omitempty"`
+
+	// age X25519 public keys, i.e. age1..., that each file is encrypted for as it is packed, with its own ephemeral file key, so it can be decrypted with age -d and the identity of any of them. Files are not encrypted if empty. Requires inline preparation to be disabled.
+	EncryptionRecipients []string `json:"encryptionRecipients"`
+
+	// Multihash function of the CIDs of the blocks. One of sha2-256 or blake2b-256.
+	HashFunction *string `json:"hashFunction,omitempty"`
+
+	// Whether the sources are verified to be in buckets with object lock, i.e. S3 buckets with a default retention in governance or compliance mode, before they are scanned. One of warn, which logs mutable sources, or enforce, which refuses to scan them. Empty means they are not verified.
+	Immutability string `json:"immutability,omitempty"`
+
+	// Codec of the leaf blocks holding the content of files. One of raw or dag-pb. dag-pb requires inline preparation to be disabled.
+	LeafCodec *string `json:"leafCodec,omitempty"`
+
+	// How long a pack job can be filled with appended files before it is packed even if it is not full, i.e. 6h. Empty means it waits until full.
+	MaxBatchAge string `json:"maxBatchAge,omitempty"`
+
+	// Maximum number of nested directories of a file. Deeper files are skipped during scanning. 0 means unlimited.
+	MaxDirectoryDepth int64 `json:"maxDirectoryDepth,omitempty"`
+
 	// Maximum size of the CAR files to be created
 	MaxSize *string `json:"maxSize,omitempty"`
 
@@ -38,9 +86,18 @@ type DataprepCreateRequest struct {
 	// Name of Output storage systems to be used for the output
 	OutputStorages []string `json:"outputStorages"`
 
+	// Organize files into date-partitioned virtual directories based on their event time or last modified time, i.e. 2024/06/15/ for day. One of year, month, day or hour. Empty keeps the directory structure of the source.
+	PartitionBy string `json:"partitionBy,omitempty"`
+
+	// Base64 encoded public key of the data owner. If set, each CAR file is encrypted with its own piece key, which is wrapped for this public key. Requires inline preparation to be disabled.
+	PieceKeyRecipient string `json:"pieceKeyRecipient,omitempty"`
+
 	// Target piece size of the CAR files used for piece commitment calculation
 	PieceSize string `json:"pieceSize,omitempty"`
 
+	// Size in bytes of the largest file that is embedded in its CID, and so in its directory, rather than written as a block of its own. Up to 128. 0 disables it. Requires the raw leaf codec.
+	SmallFileLimit int64 `json:"smallFileLimit,omitempty"`
+
 	// Name of Source storage systems to be used for the source
 	SourceStorages []string `json:"sourceStorages"`
 }
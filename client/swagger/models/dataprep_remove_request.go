@@ -17,6 +17,9 @@ import (
 // swagger:model dataprep.RemoveRequest
 type DataprepRemoveRequest struct {
 
+	// Delete the CAR blocks, CARs, files, directories, jobs and schedules of the preparation in batches before the preparation itself
+	Purge bool `json:"purge,omitempty"`
+
 	// remove cars
 	RemoveCars bool `json:"removeCars,omitempty"`
 }
@@ -26,6 +26,9 @@ type DealProposal struct {
 	// File size in bytes for boost to fetch the CAR file
 	FileSize int64 `json:"fileSize,omitempty"`
 
+	// Send the proposal even if the provider already has a proposed, published or active deal for the piece
+	Force bool `json:"force,omitempty"`
+
 	// http headers to be passed with the request (i.e. key=value)
 	HTTPHeaders []string `json:"httpHeaders"`
 
@@ -17,6 +17,9 @@ import (
 // swagger:model file.Info
 type FileInfo struct {
 
+	// Time of the event that created the file. Decides the partition of the file if the preparation is partitioned, instead of its last modified time.
+	EventTime string `json:"eventTime,omitempty"`
+
 	// Path to the new file, relative to the source
 	Path string `json:"path,omitempty"`
 }
@@ -17,12 +17,24 @@ import (
 // swagger:model model.Car
 type ModelCar struct {
 
+	// AggregateID is the aggregate piece the piece has been aggregated into. Aggregated pieces are dealt through their aggregate.
+	AggregateID int64 `json:"aggregateId,omitempty"`
+
+	// AggregateOffset is the padded offset of the piece in its aggregate piece, if it has been aggregated.
+	AggregateOffset int64 `json:"aggregateOffset,omitempty"`
+
 	// attachment Id
 	AttachmentID int64 `json:"attachmentId,omitempty"`
 
+	// Compression is how the CAR file at StoragePath is compressed. FileSize is always the size of the uncompressed CAR file.
+	Compression string `json:"compression,omitempty"`
+
 	// created at
 	CreatedAt string `json:"createdAt,omitempty"`
 
+	// EncryptionKeyVersion is the version of the encryption recipients of the preparation that the items of the CAR file are encrypted for. 0 if the items are not encrypted.
+	EncryptionKeyVersion int64 `json:"encryptionKeyVersion,omitempty"`
+
 	// file size
 	FileSize int64 `json:"fileSize,omitempty"`
 
@@ -52,6 +64,9 @@ type ModelCar struct {
 
 	// StoragePath is the path to the CAR file inside the storage. If the StorageID is nil but StoragePath is not empty, it means the CAR file is stored at the local absolute path.
 	StoragePath string `json:"storagePath,omitempty"`
+
+	// WrappedKey is the piece key the CAR file is encrypted with, wrapped for the piece key recipient of the preparation. Empty if the CAR file is not encrypted.
+	WrappedKey []int64 `json:"wrappedKey"`
 }
 
 // Validate validates this model car
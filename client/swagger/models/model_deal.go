@@ -33,12 +33,18 @@ type ModelDeal struct {
 	// error message
 	ErrorMessage string `json:"errorMessage,omitempty"`
 
+	// FailedProofs is the number of consecutive failed retrieval proofs of the deal
+	FailedProofs int64 `json:"failedProofs,omitempty"`
+
 	// id
 	ID int64 `json:"id,omitempty"`
 
 	// label
 	Label string `json:"label,omitempty"`
 
+	// LastSampledAt is the last time a block of the deal was retrieved from the provider to prove it is retrievable
+	LastSampledAt string `json:"lastSampledAt,omitempty"`
+
 	// LastVerifiedAt is the last time the deal was verified as active by the tracker
 	LastVerifiedAt string `json:"lastVerifiedAt,omitempty"`
 
@@ -20,6 +20,9 @@ type ModelFileRange struct {
 	// CID is the CID of the range.
 	Cid string `json:"cid,omitempty"`
 
+	// EncryptedLength is the length of the age file, or of the AES-256-GCM encryption, the range is encrypted into, which is what the CID addresses. 0 if the range is not encrypted.
+	EncryptedLength int64 `json:"encryptedLength,omitempty"`
+
 	// file Id
 	FileID int64 `json:"fileId,omitempty"`
 
@@ -34,6 +37,9 @@ type ModelFileRange struct {
 
 	// Offset is the offset of the range inside the file.
 	Offset int64 `json:"offset,omitempty"`
+
+	// WrappedKey is the data key the range is encrypted with, wrapped by the KMS key of the preparation. Empty if the range is not encrypted with a KMS key.
+	WrappedKey []int64 `json:"wrappedKey"`
 }
 
 // Validate validates this model file range
@@ -21,6 +21,9 @@ type ModelJob struct {
 	// attachment Id
 	AttachmentID int64 `json:"attachmentId,omitempty"`
 
+	// created at
+	CreatedAt string `json:"createdAt,omitempty"`
+
 	// error message
 	ErrorMessage string `json:"errorMessage,omitempty"`
 
@@ -30,6 +33,9 @@ type ModelJob struct {
 	// id
 	ID int64 `json:"id,omitempty"`
 
+	// Last time the worker holding the job reported progress
+	ProgressAt string `json:"progressAt,omitempty"`
+
 	// state
 	State ModelJobState `json:"state,omitempty"`
 
@@ -19,15 +19,66 @@ import (
 // swagger:model model.Preparation
 type ModelPreparation struct {
 
+	// BlobStorageID is the storage that holds the raw blocks (dag nodes) instead of the database.
+	BlobStorageID int64 `json:"blobStorageId,omitempty"`
+
+	// CarCompression is how the CAR files are compressed in the output storage. Empty means they are not compressed.
+	CarCompression string `json:"carCompression,omitempty"`
+
+	// CarNameTemplate is the template of the paths of the CAR files in the output storage, i.e. {preparation}/{date}/{piece_cid}. Empty means {piece_cid}.
+	CarNameTemplate string `json:"carNameTemplate,omitempty"`
+
+	// CarShardDepth is the number of levels of subdirectories named after the piece CID that the CAR files are sharded into. 0 disables sharding.
+	CarShardDepth int64 `json:"carShardDepth,omitempty"`
+
+	// CarSource is a flag that indicates whether the sources are collections of existing CAR files, whose blocks are packed as is rather than chunked again.
+	CarSource bool `json:"carSource,omitempty"`
+
+	// ChecksumSidecar is a flag that indicates whether .sha256 and .commp.json sidecar files are written next to each CAR file in the output storage.
+	ChecksumSidecar bool `json:"checksumSidecar,omitempty"`
+
+	// Chunker is the strategy splitting the content of files into leaf blocks, i.e. size-262144 or rabin-262144-524288-1048576. Empty means size-1048576.
+	Chunker string `json:"chunker,omitempty"`
+
+	// CidVersion is the version of the CIDs of the blocks. Empty means v1.
+	CidVersion string `json:"cidVersion,omitempty"`
+
+	// ConflictPolicy decides which version of a file is kept when the same path is packed more than once. Empty means overwrite.
+	ConflictPolicy string `json:"conflictPolicy,omitempty"`
+
 	// created at
 	CreatedAt string `json:"createdAt,omitempty"`
 
 	// DeleteAfterExport is a flag that indicates whether the source files should be deleted after export.
 	DeleteAfterExport bool `json:"deleteAfterExport,omitempty"`
 
+	// EncryptionKeyVersion is incremented each time the encryption recipients are rotated, so the pieces packed for older recipients can be found. 0 if items are not encrypted.
+	EncryptionKeyVersion int64 `json:"encryptionKeyVersion,omitempty"`
+
+	// EncryptionKMSKey is the URI of the master key of a key management service, i.e. awskms://<key ARN>, that generates and wraps a data key for each item, which is encrypted with AES-256-GCM as it is packed. Items are not encrypted with a KMS key if empty.
+	EncryptionKmsKey string `json:"encryptionKmsKey,omitempty"`
+
+	// EncryptionRecipients are the age X25519 public keys that the items are encrypted for as they are packed, each with its own ephemeral file key. Items are not encrypted if empty.
+	EncryptionRecipients []string `json:"encryptionRecipients"`
+
+	// HashFunction is the multihash function of the CIDs of the blocks. Empty means sha2-256.
+	HashFunction string `json:"hashFunction,omitempty"`
+
 	// id
 	ID int64 `json:"id,omitempty"`
 
+	// Immutability decides whether the sources are verified to be in buckets with object lock before they are scanned, and whether mutable sources are refused. Empty means they are not verified.
+	Immutability string `json:"immutability,omitempty"`
+
+	// LeafCodec is the codec of the leaf blocks of files. Empty means raw.
+	LeafCodec string `json:"leafCodec,omitempty"`
+
+	// MaxBatchAge is how long a pack job can be filled with appended files before it is packed even if it is not full. 0 means it waits until full.
+	MaxBatchAge int64 `json:"maxBatchAge,omitempty"`
+
+	// MaxDirectoryDepth is the maximum number of nested directories of a file. Deeper files are skipped during scanning. 0 means unlimited.
+	MaxDirectoryDepth int64 `json:"maxDirectoryDepth,omitempty"`
+
 	// max size
 	MaxSize int64 `json:"maxSize,omitempty"`
 
@@ -43,12 +94,27 @@ type ModelPreparation struct {
 	// output storages
 	OutputStorages []*ModelStorage `json:"outputStorages"`
 
+	// PartitionBy organizes files into date-partitioned virtual directories based on their event time or modification time. Empty means the directory structure of the source is kept.
+	PartitionBy string `json:"partitionBy,omitempty"`
+
+	// Paused is a flag that indicates whether the workers should stop picking up the scan, pack and daggen jobs of the preparation.
+	Paused bool `json:"paused,omitempty"`
+
+	// PieceKeyRecipient is the base64 encoded public key of the data owner. If set, each CAR file is encrypted with its own piece key, which is wrapped for this public key.
+	PieceKeyRecipient string `json:"pieceKeyRecipient,omitempty"`
+
 	// piece size
 	PieceSize int64 `json:"pieceSize,omitempty"`
 
+	// SmallFileLimit is the size of the largest file that is embedded in its CID, and so in its directory, rather than written as a block. 0 disables it.
+	SmallFileLimit int64 `json:"smallFileLimit,omitempty"`
+
 	// source storages
 	SourceStorages []*ModelStorage `json:"sourceStorages"`
 
+	// StatsShareID is the ID the stats of the preparation are publicly shared at by the content provider, or nil if they are not shared.
+	StatsShareID string `json:"statsShareId,omitempty"`
+
 	// updated at
 	UpdatedAt string `json:"updatedAt,omitempty"`
 }
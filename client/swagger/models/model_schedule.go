@@ -24,9 +24,18 @@ type ModelSchedule struct {
 	// announce to ipni
 	AnnounceToIpni bool `json:"announceToIpni,omitempty"`
 
+	// ApprovedBy is the operator that approved the schedule, if it needed approval
+	ApprovedBy string `json:"approvedBy,omitempty"`
+
 	// created at
 	CreatedAt string `json:"createdAt,omitempty"`
 
+	// daily deal number
+	DailyDealNumber int64 `json:"dailyDealNumber,omitempty"`
+
+	// daily deal size
+	DailyDealSize int64 `json:"dailyDealSize,omitempty"`
+
 	// duration
 	Duration int64 `json:"duration,omitempty"`
 
@@ -36,6 +45,12 @@ type ModelSchedule struct {
 	// force
 	Force bool `json:"force,omitempty"`
 
+	// hourly deal number
+	HourlyDealNumber int64 `json:"hourlyDealNumber,omitempty"`
+
+	// hourly deal size
+	HourlyDealSize int64 `json:"hourlyDealSize,omitempty"`
+
 	// http headers
 	HTTPHeaders ModelConfigMap `json:"httpHeaders,omitempty"`
 
@@ -51,9 +66,15 @@ type ModelSchedule struct {
 	// max pending deal size
 	MaxPendingDealSize int64 `json:"maxPendingDealSize,omitempty"`
 
+	// max rejected retries
+	MaxRejectedRetries int64 `json:"maxRejectedRetries,omitempty"`
+
 	// notes
 	Notes string `json:"notes,omitempty"`
 
+	// PolicyID is the replication policy the schedule proposes the replicas of, if it was created by one
+	PolicyID int64 `json:"policyId,omitempty"`
+
 	// Associations
 	PreparationID int64 `json:"preparationId,omitempty"`
 
@@ -69,6 +90,12 @@ type ModelSchedule struct {
 	// provider
 	Provider string `json:"provider,omitempty"`
 
+	// rejected retry delay
+	RejectedRetryDelay int64 `json:"rejectedRetryDelay,omitempty"`
+
+	// RequestedBy is the operator that last created or modified the schedule, if known
+	RequestedBy string `json:"requestedBy,omitempty"`
+
 	// schedule cron
 	ScheduleCron string `json:"scheduleCron,omitempty"`
 
@@ -41,6 +41,9 @@ const (
 
 	// ModelScheduleStateCompleted captures enum value "completed"
 	ModelScheduleStateCompleted ModelScheduleState = "completed"
+
+	// ModelScheduleStatePendingApproval captures enum value "pending_approval"
+	ModelScheduleStatePendingApproval ModelScheduleState = "pending_approval"
 )
 
 // for schema
@@ -48,7 +51,7 @@ var modelScheduleStateEnum []interface{}
 
 func init() {
 	var res []ModelScheduleState
-	if err := json.Unmarshal([]byte(`["active","paused","error","completed"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["active","paused","error","completed","pending_approval"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -19,6 +19,9 @@ import (
 // swagger:model model.Storage
 type ModelStorage struct {
 
+	// AuthError is the error the credentials of the storage were rejected with.
+	AuthError string `json:"authError,omitempty"`
+
 	// ClientConfig is the HTTP configuration for the storage, if applicable.
 	ClientConfig struct {
 		ModelClientConfig
@@ -38,6 +41,9 @@ type ModelStorage struct {
 	// name
 	Name string `json:"name,omitempty"`
 
+	// NeedsReauth is set when the credentials of the storage are rejected. The jobs that use the storage are held until it is re-authenticated.
+	NeedsReauth bool `json:"needsReauth,omitempty"`
+
 	// Path is the path to the storage root.
 	Path string `json:"path,omitempty"`
 
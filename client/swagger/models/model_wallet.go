@@ -20,10 +20,19 @@ type ModelWallet struct {
 	// Address is the Filecoin full address of the wallet
 	Address string `json:"address,omitempty"`
 
+	// Balance is the balance of the wallet in FIL
+	Balance float64 `json:"balance,omitempty"`
+
+	// BalanceUpdatedAt is when the balance and the datacap were last tracked by the deal tracker or looked up, nil if they never were
+	BalanceUpdatedAt string `json:"balanceUpdatedAt,omitempty"`
+
+	// Datacap is the remaining datacap of the wallet in bytes
+	Datacap int64 `json:"datacap,omitempty"`
+
 	// ID is the short ID of the wallet
 	ID string `json:"id,omitempty"`
 
-	// PrivateKey is the private key of the wallet
+	// PrivateKey is the private key of the wallet, or the PKCS#11 URI of the key if it is kept in a hardware security module
 	PrivateKey string `json:"privateKey,omitempty"`
 }
 
@@ -20,12 +20,24 @@ type ScheduleCreateRequest struct {
 	// Allowed piece CIDs in this schedule
 	AllowedPieceCids []string `json:"allowedPieceCids"`
 
+	// Max number of deals proposed within any day
+	DailyDealNumber int64 `json:"dailyDealNumber,omitempty"`
+
+	// Max size of deals proposed within any day in human readable format, i.e. 100 TiB
+	DailyDealSize string `json:"dailyDealSize,omitempty"`
+
 	// Duration in epoch or in duration format, i.e. 1500000, 2400h
 	Duration *string `json:"duration,omitempty"`
 
 	// Force to send out deals regardless of replication restriction
 	Force bool `json:"force,omitempty"`
 
+	// Max number of deals proposed within any hour
+	HourlyDealNumber int64 `json:"hourlyDealNumber,omitempty"`
+
+	// Max size of deals proposed within any hour in human readable format, i.e. 10 TiB
+	HourlyDealSize string `json:"hourlyDealSize,omitempty"`
+
 	// http headers to be passed with the request (i.e. key=value)
 	HTTPHeaders []string `json:"httpHeaders"`
 
@@ -41,6 +53,9 @@ type ScheduleCreateRequest struct {
 	// Max pending deal size in human readable format, i.e. 100 TiB
 	MaxPendingDealSize string `json:"maxPendingDealSize,omitempty"`
 
+	// Number of times a piece rejected by the provider is proposed again
+	MaxRejectedRetries *int64 `json:"maxRejectedRetries,omitempty"`
+
 	// Notes
 	Notes string `json:"notes,omitempty"`
 
@@ -59,6 +74,9 @@ type ScheduleCreateRequest struct {
 	// Provider
 	Provider string `json:"provider,omitempty"`
 
+	// Delay before a piece rejected by the provider is proposed again, in duration format, i.e. 1h
+	RejectedRetryDelay *string `json:"rejectedRetryDelay,omitempty"`
+
 	// Schedule cron pattern
 	ScheduleCron string `json:"scheduleCron,omitempty"`
 
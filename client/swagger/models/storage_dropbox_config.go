@@ -1,89 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageDropboxConfig storage dropbox config
-//
-// swagger:model storage.dropboxConfig
-type StorageDropboxConfig struct {
-
-	// Auth server URL.
-	AuthURL string `json:"authUrl,omitempty"`
-
-	// Max time to wait for a batch to finish committing
-	BatchCommitTimeout *string `json:"batchCommitTimeout,omitempty"`
-
-	// Upload file batching sync|async|off.
-	BatchMode *string `json:"batchMode,omitempty"`
-
-	// Max number of files in upload batch.
-	BatchSize int64 `json:"batchSize,omitempty"`
-
-	// Max time to allow an idle upload batch before uploading.
-	BatchTimeout *string `json:"batchTimeout,omitempty"`
-
-	// Upload chunk size (< 150Mi).
-	ChunkSize *string `json:"chunkSize,omitempty"`
-
-	// OAuth Client Id.
-	ClientID string `json:"clientId,omitempty"`
-
-	// OAuth Client Secret.
-	ClientSecret string `json:"clientSecret,omitempty"`
-
-	// The encoding for the backend.
-	Encoding *string `json:"encoding,omitempty"`
-
-	// Impersonate this user when using a business account.
-	Impersonate string `json:"impersonate,omitempty"`
-
-	// Instructs rclone to work on individual shared files.
-	SharedFiles *bool `json:"sharedFiles,omitempty"`
-
-	// Instructs rclone to work on shared folders.
-	SharedFolders *bool `json:"sharedFolders,omitempty"`
-
-	// OAuth Access Token as a JSON blob.
-	Token string `json:"token,omitempty"`
-
-	// Token server url.
-	TokenURL string `json:"tokenUrl,omitempty"`
-}
-
-// Validate validates this storage dropbox config
-func (m *StorageDropboxConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage dropbox config based on context it is used
-func (m *StorageDropboxConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageDropboxConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageDropboxConfig) UnmarshalBinary(b []byte) error {
-	var res StorageDropboxConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
@@ -1,107 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageGcsConfig storage gcs config
-//
-// swagger:model storage.gcsConfig
-type StorageGcsConfig struct {
-
-	// Access public buckets and objects without credentials.
-	Anonymous *bool `json:"anonymous,omitempty"`
-
-	// Auth server URL.
-	AuthURL string `json:"authUrl,omitempty"`
-
-	// Access Control List for new buckets.
-	// Example: authenticatedRead
-	BucketACL string `json:"bucketAcl,omitempty"`
-
-	// Access checks should use bucket-level IAM policies.
-	BucketPolicyOnly *bool `json:"bucketPolicyOnly,omitempty"`
-
-	// OAuth Client Id.
-	ClientID string `json:"clientId,omitempty"`
-
-	// OAuth Client Secret.
-	ClientSecret string `json:"clientSecret,omitempty"`
-
-	// If set this will decompress gzip encoded objects.
-	Decompress *bool `json:"decompress,omitempty"`
-
-	// The encoding for the backend.
-	Encoding *string `json:"encoding,omitempty"`
-
-	// Endpoint for the service.
-	Endpoint string `json:"endpoint,omitempty"`
-
-	// Get GCP IAM credentials from runtime (environment variables or instance meta data if no env vars).
-	// Example: false
-	EnvAuth *bool `json:"envAuth,omitempty"`
-
-	// Location for the newly created buckets.
-	Location string `json:"location,omitempty"`
-
-	// If set, don't attempt to check the bucket exists or create it.
-	NoCheckBucket *bool `json:"noCheckBucket,omitempty"`
-
-	// Access Control List for new objects.
-	// Example: authenticatedRead
-	ObjectACL string `json:"objectAcl,omitempty"`
-
-	// Project number.
-	ProjectNumber string `json:"projectNumber,omitempty"`
-
-	// Service Account Credentials JSON blob.
-	ServiceAccountCredentials string `json:"serviceAccountCredentials,omitempty"`
-
-	// Service Account Credentials JSON file path.
-	ServiceAccountFile string `json:"serviceAccountFile,omitempty"`
-
-	// The storage class to use when storing objects in Google Cloud Storage.
-	StorageClass string `json:"storageClass,omitempty"`
-
-	// OAuth Access Token as a JSON blob.
-	Token string `json:"token,omitempty"`
-
-	// Token server url.
-	TokenURL string `json:"tokenUrl,omitempty"`
-}
-
-// Validate validates this storage gcs config
-func (m *StorageGcsConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage gcs config based on context it is used
-func (m *StorageGcsConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageGcsConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageGcsConfig) UnmarshalBinary(b []byte) error {
-	var res StorageGcsConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
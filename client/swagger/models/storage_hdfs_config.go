@@ -1,64 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageHdfsConfig storage hdfs config
-//
-// swagger:model storage.hdfsConfig
-type StorageHdfsConfig struct {
-
-	// Kerberos data transfer protection: authentication|integrity|privacy.
-	// Example: privacy
-	DataTransferProtection string `json:"dataTransferProtection,omitempty"`
-
-	// The encoding for the backend.
-	Encoding *string `json:"encoding,omitempty"`
-
-	// Hadoop name node and port.
-	Namenode string `json:"namenode,omitempty"`
-
-	// Kerberos service principal name for the namenode.
-	ServicePrincipalName string `json:"servicePrincipalName,omitempty"`
-
-	// Hadoop user name.
-	// Example: root
-	Username string `json:"username,omitempty"`
-}
-
-// Validate validates this storage hdfs config
-func (m *StorageHdfsConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage hdfs config based on context it is used
-func (m *StorageHdfsConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageHdfsConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageHdfsConfig) UnmarshalBinary(b []byte) error {
-	var res StorageHdfsConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
@@ -1,62 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageKoofrDigistorageConfig storage koofr digistorage config
-//
-// swagger:model storage.koofrDigistorageConfig
-type StorageKoofrDigistorageConfig struct {
-
-	// The encoding for the backend.
-	Encoding *string `json:"encoding,omitempty"`
-
-	// Mount ID of the mount to use.
-	Mountid string `json:"mountid,omitempty"`
-
-	// Your password for rclone (generate one at https://storage.rcs-rds.ro/app/admin/preferences/password).
-	Password string `json:"password,omitempty"`
-
-	// Does the backend support setting modification time.
-	Setmtime *bool `json:"setmtime,omitempty"`
-
-	// Your user name.
-	User string `json:"user,omitempty"`
-}
-
-// Validate validates this storage koofr digistorage config
-func (m *StorageKoofrDigistorageConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage koofr digistorage config based on context it is used
-func (m *StorageKoofrDigistorageConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageKoofrDigistorageConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageKoofrDigistorageConfig) UnmarshalBinary(b []byte) error {
-	var res StorageKoofrDigistorageConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
@@ -1,65 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageKoofrOtherConfig storage koofr other config
-//
-// swagger:model storage.koofrOtherConfig
-type StorageKoofrOtherConfig struct {
-
-	// The encoding for the backend.
-	Encoding *string `json:"encoding,omitempty"`
-
-	// The Koofr API endpoint to use.
-	Endpoint string `json:"endpoint,omitempty"`
-
-	// Mount ID of the mount to use.
-	Mountid string `json:"mountid,omitempty"`
-
-	// Your password for rclone (generate one at your service's settings page).
-	Password string `json:"password,omitempty"`
-
-	// Does the backend support setting modification time.
-	Setmtime *bool `json:"setmtime,omitempty"`
-
-	// Your user name.
-	User string `json:"user,omitempty"`
-}
-
-// Validate validates this storage koofr other config
-func (m *StorageKoofrOtherConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage koofr other config based on context it is used
-func (m *StorageKoofrOtherConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageKoofrOtherConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageKoofrOtherConfig) UnmarshalBinary(b []byte) error {
-	var res StorageKoofrOtherConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
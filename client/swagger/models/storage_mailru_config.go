@@ -1,81 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageMailruConfig storage mailru config
-//
-// swagger:model storage.mailruConfig
-type StorageMailruConfig struct {
-
-	// What should copy do if file checksum is mismatched or invalid.
-	// Example: true
-	CheckHash *bool `json:"checkHash,omitempty"`
-
-	// The encoding for the backend.
-	Encoding *string `json:"encoding,omitempty"`
-
-	// Password.
-	Pass string `json:"pass,omitempty"`
-
-	// Comma separated list of internal maintenance flags.
-	Quirks string `json:"quirks,omitempty"`
-
-	// Skip full upload if there is another file with same data hash.
-	// Example: true
-	SpeedupEnable *bool `json:"speedupEnable,omitempty"`
-
-	// Comma separated list of file name patterns eligible for speedup (put by hash).
-	SpeedupFilePatterns *string `json:"speedupFilePatterns,omitempty"`
-
-	// This option allows you to disable speedup (put by hash) for large files.
-	// Example: 0
-	SpeedupMaxDisk *string `json:"speedupMaxDisk,omitempty"`
-
-	// Files larger than the size given below will always be hashed on disk.
-	// Example: 0
-	SpeedupMaxMemory *string `json:"speedupMaxMemory,omitempty"`
-
-	// User name (usually email).
-	User string `json:"user,omitempty"`
-
-	// HTTP user agent used internally by client.
-	UserAgent string `json:"userAgent,omitempty"`
-}
-
-// Validate validates this storage mailru config
-func (m *StorageMailruConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage mailru config based on context it is used
-func (m *StorageMailruConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageMailruConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageMailruConfig) UnmarshalBinary(b []byte) error {
-	var res StorageMailruConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
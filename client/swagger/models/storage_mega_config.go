@@ -1,65 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageMegaConfig storage mega config
-//
-// swagger:model storage.megaConfig
-type StorageMegaConfig struct {
-
-	// Output more debug from Mega.
-	Debug *bool `json:"debug,omitempty"`
-
-	// The encoding for the backend.
-	Encoding *string `json:"encoding,omitempty"`
-
-	// Delete files permanently rather than putting them into the trash.
-	HardDelete *bool `json:"hardDelete,omitempty"`
-
-	// Password.
-	Pass string `json:"pass,omitempty"`
-
-	// Use HTTPS for transfers.
-	UseHTTPS *bool `json:"useHttps,omitempty"`
-
-	// User name.
-	User string `json:"user,omitempty"`
-}
-
-// Validate validates this storage mega config
-func (m *StorageMegaConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage mega config based on context it is used
-func (m *StorageMegaConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageMegaConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageMegaConfig) UnmarshalBinary(b []byte) error {
-	var res StorageMegaConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
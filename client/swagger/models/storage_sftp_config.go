@@ -1,143 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageSftpConfig storage sftp config
-//
-// swagger:model storage.sftpConfig
-type StorageSftpConfig struct {
-
-	// Allow asking for SFTP password when needed.
-	AskPassword *bool `json:"askPassword,omitempty"`
-
-	// Upload and download chunk size.
-	ChunkSize *string `json:"chunkSize,omitempty"`
-
-	// Space separated list of ciphers to be used for session encryption, ordered by preference.
-	Ciphers string `json:"ciphers,omitempty"`
-
-	// The maximum number of outstanding requests for one file
-	Concurrency *int64 `json:"concurrency,omitempty"`
-
-	// If set don't use concurrent reads.
-	DisableConcurrentReads *bool `json:"disableConcurrentReads,omitempty"`
-
-	// If set don't use concurrent writes.
-	DisableConcurrentWrites *bool `json:"disableConcurrentWrites,omitempty"`
-
-	// Disable the execution of SSH commands to determine if remote file hashing is available.
-	DisableHashcheck *bool `json:"disableHashcheck,omitempty"`
-
-	// SSH host to connect to.
-	Host string `json:"host,omitempty"`
-
-	// Max time before closing idle connections.
-	IdleTimeout *string `json:"idleTimeout,omitempty"`
-
-	// Space separated list of key exchange algorithms, ordered by preference.
-	KeyExchange string `json:"keyExchange,omitempty"`
-
-	// Path to PEM-encoded private key file.
-	KeyFile string `json:"keyFile,omitempty"`
-
-	// The passphrase to decrypt the PEM-encoded private key file.
-	KeyFilePass string `json:"keyFilePass,omitempty"`
-
-	// Raw PEM-encoded private key.
-	KeyPem string `json:"keyPem,omitempty"`
-
-	// When set forces the usage of the ssh-agent.
-	KeyUseAgent *bool `json:"keyUseAgent,omitempty"`
-
-	// Optional path to known_hosts file.
-	// Example: ~/.ssh/known_hosts
-	KnownHostsFile string `json:"knownHostsFile,omitempty"`
-
-	// Space separated list of MACs (message authentication code) algorithms, ordered by preference.
-	Macs string `json:"macs,omitempty"`
-
-	// The command used to read md5 hashes.
-	Md5sumCommand string `json:"md5sumCommand,omitempty"`
-
-	// SSH password, leave blank to use ssh-agent.
-	Pass string `json:"pass,omitempty"`
-
-	// Override path used by SSH shell commands.
-	PathOverride string `json:"pathOverride,omitempty"`
-
-	// SSH port number.
-	Port *int64 `json:"port,omitempty"`
-
-	// Optional path to public key file.
-	PubkeyFile string `json:"pubkeyFile,omitempty"`
-
-	// Specifies the path or command to run a sftp server on the remote host.
-	ServerCommand string `json:"serverCommand,omitempty"`
-
-	// Environment variables to pass to sftp and commands
-	SetEnv string `json:"setEnv,omitempty"`
-
-	// Set the modified time on the remote if set.
-	SetModtime *bool `json:"setModtime,omitempty"`
-
-	// The command used to read sha1 hashes.
-	Sha1sumCommand string `json:"sha1sumCommand,omitempty"`
-
-	// The type of SSH shell on remote server, if any.
-	// Example: none
-	ShellType string `json:"shellType,omitempty"`
-
-	// Set to skip any symlinks and any other non regular files.
-	SkipLinks *bool `json:"skipLinks,omitempty"`
-
-	// Specifies the SSH2 subsystem on the remote host.
-	Subsystem *string `json:"subsystem,omitempty"`
-
-	// If set use fstat instead of stat.
-	UseFstat *bool `json:"useFstat,omitempty"`
-
-	// Enable the use of insecure ciphers and key exchange methods.
-	// Example: false
-	UseInsecureCipher *bool `json:"useInsecureCipher,omitempty"`
-
-	// SSH username.
-	User *string `json:"user,omitempty"`
-}
-
-// Validate validates this storage sftp config
-func (m *StorageSftpConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage sftp config based on context it is used
-func (m *StorageSftpConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageSftpConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageSftpConfig) UnmarshalBinary(b []byte) error {
-	var res StorageSftpConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
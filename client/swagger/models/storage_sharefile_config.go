@@ -1,62 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageSharefileConfig storage sharefile config
-//
-// swagger:model storage.sharefileConfig
-type StorageSharefileConfig struct {
-
-	// Upload chunk size.
-	ChunkSize *string `json:"chunkSize,omitempty"`
-
-	// The encoding for the backend.
-	Encoding *string `json:"encoding,omitempty"`
-
-	// Endpoint for API calls.
-	Endpoint string `json:"endpoint,omitempty"`
-
-	// ID of the root folder.
-	RootFolderID string `json:"rootFolderId,omitempty"`
-
-	// Cutoff for switching to multipart upload.
-	UploadCutoff *string `json:"uploadCutoff,omitempty"`
-}
-
-// Validate validates this storage sharefile config
-func (m *StorageSharefileConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage sharefile config based on context it is used
-func (m *StorageSharefileConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageSharefileConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageSharefileConfig) UnmarshalBinary(b []byte) error {
-	var res StorageSharefileConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
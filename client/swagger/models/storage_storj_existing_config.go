@@ -1,50 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageStorjExistingConfig storage storj existing config
-//
-// swagger:model storage.storjExistingConfig
-type StorageStorjExistingConfig struct {
-
-	// Access grant.
-	AccessGrant string `json:"accessGrant,omitempty"`
-}
-
-// Validate validates this storage storj existing config
-func (m *StorageStorjExistingConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage storj existing config based on context it is used
-func (m *StorageStorjExistingConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageStorjExistingConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageStorjExistingConfig) UnmarshalBinary(b []byte) error {
-	var res StorageStorjExistingConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
@@ -1,80 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageSugarsyncConfig storage sugarsync config
-//
-// swagger:model storage.sugarsyncConfig
-type StorageSugarsyncConfig struct {
-
-	// Sugarsync Access Key ID.
-	AccessKeyID string `json:"accessKeyId,omitempty"`
-
-	// Sugarsync App ID.
-	AppID string `json:"appId,omitempty"`
-
-	// Sugarsync authorization.
-	Authorization string `json:"authorization,omitempty"`
-
-	// Sugarsync authorization expiry.
-	AuthorizationExpiry string `json:"authorizationExpiry,omitempty"`
-
-	// Sugarsync deleted folder id.
-	DeletedID string `json:"deletedId,omitempty"`
-
-	// The encoding for the backend.
-	Encoding *string `json:"encoding,omitempty"`
-
-	// Permanently delete files if true
-	HardDelete *bool `json:"hardDelete,omitempty"`
-
-	// Sugarsync Private Access Key.
-	PrivateAccessKey string `json:"privateAccessKey,omitempty"`
-
-	// Sugarsync refresh token.
-	RefreshToken string `json:"refreshToken,omitempty"`
-
-	// Sugarsync root id.
-	RootID string `json:"rootId,omitempty"`
-
-	// Sugarsync user.
-	User string `json:"user,omitempty"`
-}
-
-// Validate validates this storage sugarsync config
-func (m *StorageSugarsyncConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage sugarsync config based on context it is used
-func (m *StorageSugarsyncConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageSugarsyncConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageSugarsyncConfig) UnmarshalBinary(b []byte) error {
-	var res StorageSugarsyncConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
@@ -1,119 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package models
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-)
-
-// StorageSwiftConfig storage swift config
-//
-// swagger:model storage.swiftConfig
-type StorageSwiftConfig struct {
-
-	// Application Credential ID (OS_APPLICATION_CREDENTIAL_ID).
-	ApplicationCredentialID string `json:"applicationCredentialId,omitempty"`
-
-	// Application Credential Name (OS_APPLICATION_CREDENTIAL_NAME).
-	ApplicationCredentialName string `json:"applicationCredentialName,omitempty"`
-
-	// Application Credential Secret (OS_APPLICATION_CREDENTIAL_SECRET).
-	ApplicationCredentialSecret string `json:"applicationCredentialSecret,omitempty"`
-
-	// Authentication URL for server (OS_AUTH_URL).
-	// Example: https://auth.api.rackspacecloud.com/v1.0
-	Auth string `json:"auth,omitempty"`
-
-	// Auth Token from alternate authentication - optional (OS_AUTH_TOKEN).
-	AuthToken string `json:"authToken,omitempty"`
-
-	// AuthVersion - optional - set to (1,2,3) if your auth URL has no version (ST_AUTH_VERSION).
-	AuthVersion int64 `json:"authVersion,omitempty"`
-
-	// Above this size files will be chunked into a _segments container.
-	ChunkSize *string `json:"chunkSize,omitempty"`
-
-	// User domain - optional (v3 auth) (OS_USER_DOMAIN_NAME)
-	Domain string `json:"domain,omitempty"`
-
-	// The encoding for the backend.
-	Encoding *string `json:"encoding,omitempty"`
-
-	// Endpoint type to choose from the service catalogue (OS_ENDPOINT_TYPE).
-	// Example: public
-	EndpointType *string `json:"endpointType,omitempty"`
-
-	// Get swift credentials from environment variables in standard OpenStack form.
-	// Example: false
-	EnvAuth *bool `json:"envAuth,omitempty"`
-
-	// API key or password (OS_PASSWORD).
-	Key string `json:"key,omitempty"`
-
-	// If true avoid calling abort upload on a failure.
-	LeavePartsOnError *bool `json:"leavePartsOnError,omitempty"`
-
-	// Don't chunk files during streaming upload.
-	NoChunk *bool `json:"noChunk,omitempty"`
-
-	// Disable support for static and dynamic large objects
-	NoLargeObjects *bool `json:"noLargeObjects,omitempty"`
-
-	// Region name - optional (OS_REGION_NAME).
-	Region string `json:"region,omitempty"`
-
-	// The storage policy to use when creating a new container.
-	StoragePolicy string `json:"storagePolicy,omitempty"`
-
-	// Storage URL - optional (OS_STORAGE_URL).
-	StorageURL string `json:"storageUrl,omitempty"`
-
-	// Tenant name - optional for v1 auth, this or tenant_id required otherwise (OS_TENANT_NAME or OS_PROJECT_NAME).
-	Tenant string `json:"tenant,omitempty"`
-
-	// Tenant domain - optional (v3 auth) (OS_PROJECT_DOMAIN_NAME).
-	TenantDomain string `json:"tenantDomain,omitempty"`
-
-	// Tenant ID - optional for v1 auth, this or tenant required otherwise (OS_TENANT_ID).
-	TenantID string `json:"tenantId,omitempty"`
-
-	// User name to log in (OS_USERNAME).
-	User string `json:"user,omitempty"`
-
-	// User ID to log in - optional - most swift systems use user and leave this blank (v3 auth) (OS_USER_ID).
-	UserID string `json:"userId,omitempty"`
-}
-
-// Validate validates this storage swift config
-func (m *StorageSwiftConfig) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this storage swift config based on context it is used
-func (m *StorageSwiftConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (m *StorageSwiftConfig) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *StorageSwiftConfig) UnmarshalBinary(b []byte) error {
-	var res StorageSwiftConfig
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
@@ -17,7 +17,7 @@ import (
 // swagger:model wallet.ImportRequest
 type WalletImportRequest struct {
 
-	// This is the exported private key from lotus wallet export
+	// This is the exported private key from lotus wallet export, a PKCS#11 URI of a secp256k1 key in a hardware security module, or a lotus-wallet URI of a key held by a remote wallet
 	PrivateKey string `json:"privateKey,omitempty"`
 }
 
@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/admin"
+	"github.com/urfave/cli/v2"
+)
+
+var BackupCmd = &cli.Command{
+	Name:      "backup",
+	Usage:     "Create a consistent snapshot of the database",
+	ArgsUsage: "<output_dir>",
+	Description: "Create a consistent snapshot of the database in the output directory, together with a manifest that contains\n" +
+		"the checksum of the snapshot and the piece CIDs of all CAR files in the output storages.\n" +
+		"The database is the only map to inline prepared data, so it should be backed up regularly.\n" +
+		"  - SQLite: the snapshot is taken with VACUUM INTO and is safe while singularity services are running\n" +
+		"  - Postgres: the snapshot is taken with pg_dump, which needs to be installed\n" +
+		"  - MySQL: not supported, please use mysqldump --single-transaction",
+	Action: func(c *cli.Context) error {
+		if c.NArg() != 1 {
+			return errors.WithStack(cliutil.ErrIncorrectNArgs)
+		}
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		manifest, err := admin.Default.BackupHandler(c.Context, db, admin.BackupRequest{
+			OutputDir: c.Args().Get(0),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, manifest)
+		return nil
+	},
+}
+
+var RestoreCmd = &cli.Command{
+	Name:      "restore",
+	Usage:     "Restore the database from a backup",
+	ArgsUsage: "<backup_dir>",
+	Description: "Verify the checksum of the snapshot, replace the database with the snapshot, and verify that the restored database\n" +
+		"references the same CAR files as listed in the manifest. All singularity services must be stopped before restoring.\n" +
+		"A Postgres backup is restored with pg_restore, which needs to be installed.",
+	Flags: []cli.Flag{cliutil.ReallyDotItFlag},
+	Action: func(c *cli.Context) error {
+		if c.NArg() != 1 {
+			return errors.WithStack(cliutil.ErrIncorrectNArgs)
+		}
+		if err := cliutil.HandleReallyDoIt(c); err != nil {
+			return errors.WithStack(err)
+		}
+		manifest, err := admin.RestoreBackup(c.Context, c.String("database-connection-string"), c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, manifest)
+		return nil
+	},
+}
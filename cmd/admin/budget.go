@@ -0,0 +1,121 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/admin"
+	"github.com/urfave/cli/v2"
+)
+
+var budgetPreparationFlag = &cli.StringFlag{
+	Name:        "preparation",
+	Usage:       "ID or name of the preparation whose deals count towards the budget",
+	DefaultText: "Global budget counting all deals",
+}
+
+var SetBudgetCmd = &cli.Command{
+	Name:  "set",
+	Usage: "Set the datacap and FIL budget of a preparation, or the global budget",
+	Description: "The deal pusher does not make a deal that would exceed the datacap of verified deals or the FIL price of deals\n" +
+		"of a budget, and alerts once 80% of a budget is consumed. A preparation budget counts the deals of its schedules,\n" +
+		"and the global budget counts all deals, including those that are not made by a schedule. Deals that are proposed,\n" +
+		"published, active, expired or slashed count towards the budgets.\n" +
+		"Budgets are only managed with direct access to the database, not through the API.",
+	Flags: []cli.Flag{
+		budgetPreparationFlag,
+		&cli.StringFlag{
+			Name:  "datacap",
+			Usage: "Maximum total size of verified deals, i.e. 1PiB. Use 0 to remove the limit",
+		},
+		&cli.Float64Flag{
+			Name:  "fil",
+			Usage: "Maximum total price of deals in FIL. Use 0 to remove the limit",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		request := admin.SetBudgetRequest{
+			Preparation: c.String("preparation"),
+			Datacap:     c.String("datacap"),
+		}
+		if c.IsSet("fil") {
+			fil := c.Float64("fil")
+			request.FIL = &fil
+		}
+		budget, err := admin.Default.SetBudgetHandler(c.Context, db, request)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, *budget)
+		return nil
+	},
+}
+
+var ListBudgetsCmd = &cli.Command{
+	Name:  "list",
+	Usage: "List all budgets with the datacap and FIL used",
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		budgets, err := admin.Default.ListBudgetsHandler(c.Context, db)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, budgets)
+		return nil
+	},
+}
+
+var RemoveBudgetCmd = &cli.Command{
+	Name:  "remove",
+	Usage: "Remove the budget of a preparation, or the global budget",
+	Flags: []cli.Flag{budgetPreparationFlag},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		return admin.Default.RemoveBudgetHandler(c.Context, db, c.String("preparation"))
+	},
+}
+
+var OverrideBudgetCmd = &cli.Command{
+	Name:      "override",
+	Usage:     "Allow the deal pusher to exceed a budget for a limited time",
+	ArgsUsage: "<duration>",
+	Description: "Allows deals beyond the budget of a preparation, or the global budget, for the given duration, i.e. 24h.\n" +
+		"Alerts are still sent while the budget is overridden. Use 0 to end the override.",
+	Flags:  []cli.Flag{budgetPreparationFlag},
+	Before: cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		duration, err := time.ParseDuration(c.Args().Get(0))
+		if err != nil {
+			return errors.Wrapf(err, "invalid duration %s", c.Args().Get(0))
+		}
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		budget, err := admin.Default.OverrideBudgetHandler(c.Context, db, admin.OverrideBudgetRequest{
+			Preparation: c.String("preparation"),
+			Duration:    duration,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, *budget)
+		return nil
+	},
+}
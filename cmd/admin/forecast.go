@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/admin"
+	"github.com/urfave/cli/v2"
+)
+
+var StorageForecastCmd = &cli.Command{
+	Name:  "storage-forecast",
+	Usage: "Forecast when each output storage will be full based on the output rates of the preparations",
+	Description: "Computes how fast each preparation has been writing CAR files to its output storages within the window,\n" +
+		"and forecasts when each output storage will be full from the free space reported by the storage system.\n" +
+		"Storages forecast to be full within --warn-within, or already full, get a warning, which is also logged and\n" +
+		"posted to --alert-webhook, the webhook of the budget alerts of the deal pusher. Warnings are sent on every run,\n" +
+		"so the forecast is meant to be run periodically, i.e. by cron.\n" +
+		"Storages that do not report their usage, i.e. some object storages, get the output rates but no forecast.",
+	Flags: []cli.Flag{
+		&cli.DurationFlag{
+			Name:  "window",
+			Usage: "How far back to look at the CAR files written to compute the output rates",
+			Value: admin.DefaultForecastDuration,
+		},
+		&cli.DurationFlag{
+			Name:  "warn-within",
+			Usage: "Warn about output storages forecast to be full within this duration",
+			Value: admin.DefaultForecastDuration,
+		},
+		&cli.StringFlag{
+			Name:  "alert-webhook",
+			Usage: "URL that the warnings are posted to as JSON, like the budget alerts of the deal pusher. Warnings are always logged",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		forecasts, err := admin.Default.StorageForecastHandler(c.Context, db, admin.StorageForecastRequest{
+			Window:       c.Duration("window").String(),
+			WarnWithin:   c.Duration("warn-within").String(),
+			AlertWebhook: c.String("alert-webhook"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, forecasts)
+		return nil
+	},
+}
@@ -0,0 +1,33 @@
+package admin
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/admin"
+	"github.com/urfave/cli/v2"
+)
+
+var FullTextSearchCmd = &cli.Command{
+	Name:  "full-text-search",
+	Usage: "Create or remove the full-text index used to search files by path",
+	Description: "Creates an optional full-text index over the path of all files, so searching files by path does not\n" +
+		"scan the whole files table. With the index, each word of the searched path matches the start of a word in the path.\n" +
+		"The index is supported by SQLite built with FTS5 and by Postgres, and is kept up to date by the database.\n" +
+		"Creating it indexes all existing paths, which may take a while for large databases.",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "disable",
+			Usage: "Remove the full-text index instead of creating it",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		return admin.Default.FullTextSearchHandler(c.Context, db, admin.FullTextSearchRequest{
+			Disable: c.Bool("disable"),
+		})
+	},
+}
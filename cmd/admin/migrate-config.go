@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/admin"
+	"github.com/urfave/cli/v2"
+)
+
+var MigrateConfigCmd = &cli.Command{
+	Name:  "migrate-config",
+	Usage: "Rewrite settings stored with legacy conventions to the current conventions",
+	Description: "Rewrite settings that were stored by older versions of singularity, so that long-lived deployments can be upgraded without manual SQL. This includes\n" +
+		"  1. Storage config keys written as API fields (e.g. accessKeyId) or CLI flags (e.g. s3-access-key-id) are renamed to the rclone option names (e.g. access_key_id)\n" +
+		"  2. Deprecated rclone options are renamed to their replacement, or removed if they are no longer needed\n" +
+		"  3. Preparations without a piece size get the default piece size derived from the max size\n" +
+		"Use --dry-run to review the changes before applying them. Config values are never printed.",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "Only print the changes without applying them",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		changes, err := admin.Default.MigrateConfigHandler(c.Context, db, admin.MigrateConfigRequest{
+			DryRun: c.Bool("dry-run"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, changes)
+		return nil
+	},
+}
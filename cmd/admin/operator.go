@@ -0,0 +1,87 @@
+package admin
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/admin"
+	"github.com/urfave/cli/v2"
+)
+
+var CreateOperatorCmd = &cli.Command{
+	Name:      "create",
+	Usage:     "Create an operator with a new API key",
+	ArgsUsage: "<name>",
+	Description: "Creates an operator and prints its API key, which is only shown once. Operators pass their API key with the\n" +
+		"global --api-key flag or the X-Singularity-Api-Key header, so schedules above the approval threshold can be\n" +
+		"attributed to the operator that requested them and approved by another one.",
+	Before: cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		operator, err := admin.Default.CreateOperatorHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, *operator)
+		return nil
+	},
+}
+
+var ListOperatorsCmd = &cli.Command{
+	Name:  "list",
+	Usage: "List all operators",
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		operators, err := admin.Default.ListOperatorsHandler(c.Context, db)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, operators)
+		return nil
+	},
+}
+
+var RemoveOperatorCmd = &cli.Command{
+	Name:      "remove",
+	Usage:     "Remove an operator and revoke its API key",
+	ArgsUsage: "<name>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		return admin.Default.RemoveOperatorHandler(c.Context, db, c.Args().Get(0))
+	},
+}
+
+var ApprovalThresholdCmd = &cli.Command{
+	Name:      "approval-threshold",
+	Usage:     "Set the total deal size above which a schedule needs the approval of a second operator",
+	ArgsUsage: "<size>",
+	Description: "Creating or modifying a deal schedule that can make deals above this total size, i.e. 1PiB, puts the schedule\n" +
+		"pending the approval of an operator other than the one that requested it, so a single compromised API key cannot\n" +
+		"spend all the datacap. The size of a schedule is the smaller of its total deal size and its total deal number times\n" +
+		"the piece size. A schedule without either limit is always above the threshold. Use 0 to disable approvals.\n" +
+		"Operators and the threshold are only managed with direct access to the database, not through the API.",
+	Before: cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		return admin.Default.SetApprovalThresholdHandler(c.Context, db, admin.ApprovalThresholdRequest{
+			Threshold: c.Args().Get(0),
+		})
+	},
+}
@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/admin"
+	"github.com/urfave/cli/v2"
+)
+
+var MergePreparationsCmd = &cli.Command{
+	Name:      "merge-preparations",
+	Usage:     "Merge a preparation into another preparation",
+	ArgsUsage: "<source preparation id|name> <target preparation id|name>",
+	Description: "Move all sources of the source preparation, together with their files, directories and jobs, to the target preparation,\n" +
+		"as well as all its pieces and deal schedules, so the piece and deal history is preserved.\n" +
+		"Output storages and wallets are attached to the target preparation. The source preparation is removed afterwards.\n" +
+		"Both preparations must have the same piece size and the same inline and dag settings, and no job can be running.",
+	Flags: []cli.Flag{cliutil.ReallyDotItFlag},
+	Action: func(c *cli.Context) error {
+		if c.NArg() != 2 {
+			return errors.WithStack(cliutil.ErrIncorrectNArgs)
+		}
+		if err := cliutil.HandleReallyDoIt(c); err != nil {
+			return errors.WithStack(err)
+		}
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		preparation, err := admin.Default.MergePreparationsHandler(c.Context, db, admin.MergePreparationsRequest{
+			Source: c.Args().Get(0),
+			Target: c.Args().Get(1),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, *preparation)
+		return nil
+	},
+}
+
+var SplitSourceCmd = &cli.Command{
+	Name:      "split-source",
+	Usage:     "Split a source out of a preparation into a new preparation",
+	ArgsUsage: "<preparation id|name> <source storage id|name> <new preparation name>",
+	Description: "Create a new preparation with the same settings, output storages and wallets, and move the source to it,\n" +
+		"together with its files, directories, jobs and the pieces made from it, so the piece history is preserved.\n" +
+		"Deal schedules stay with the original preparation, and deals already made for the moved pieces are kept.",
+	Action: func(c *cli.Context) error {
+		if c.NArg() != 3 {
+			return errors.WithStack(cliutil.ErrIncorrectNArgs)
+		}
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		preparation, err := admin.Default.SplitSourceHandler(c.Context, db, admin.SplitSourceRequest{
+			Preparation: c.Args().Get(0),
+			Source:      c.Args().Get(1),
+			Name:        c.Args().Get(2),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, *preparation)
+		return nil
+	},
+}
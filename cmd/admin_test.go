@@ -3,10 +3,13 @@ package cmd
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/data-preservation-programs/singularity/cmd/cliutil"
 	"github.com/data-preservation-programs/singularity/handler/admin"
+	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -56,3 +59,203 @@ func TestAdminReset_NoReallyDoIt(t *testing.T) {
 		require.ErrorIs(t, err, cliutil.ErrReallyDoIt)
 	})
 }
+
+func TestAdminMigrateConfig(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(admin.MockAdmin)
+		defer swapAdminHandler(mockHandler)()
+		mockHandler.On("MigrateConfigHandler", mock.Anything, mock.Anything, admin.MigrateConfigRequest{DryRun: true}).
+			Return([]admin.ConfigChange{{
+				Object: "storage",
+				Name:   "s3",
+				Field:  "config",
+				Old:    "accessKeyId",
+				New:    "access_key_id",
+				Reason: "legacy option name",
+			}}, nil)
+		_, _, err := runner.Run(ctx, "singularity admin migrate-config --dry-run")
+		require.NoError(t, err)
+	})
+}
+
+func TestAdminBackup(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(admin.MockAdmin)
+		defer swapAdminHandler(mockHandler)()
+		mockHandler.On("BackupHandler", mock.Anything, mock.Anything, admin.BackupRequest{OutputDir: "backup"}).
+			Return(&admin.BackupManifest{
+				Version:  "v0.5.13",
+				Database: "sqlite",
+				File:     "singularity.db",
+				Size:     4096,
+				SHA256:   "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			}, nil)
+		_, _, err := runner.Run(ctx, "singularity admin backup backup")
+		require.NoError(t, err)
+	})
+}
+
+func TestAdminRestore_NoReallyDoIt(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		_, _, err := runner.Run(ctx, "singularity admin restore backup")
+		require.ErrorIs(t, err, cliutil.ErrReallyDoIt)
+	})
+}
+
+func TestAdminMergePreparations(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(admin.MockAdmin)
+		defer swapAdminHandler(mockHandler)()
+		mockHandler.On("MergePreparationsHandler", mock.Anything, mock.Anything, admin.MergePreparationsRequest{Source: "prep1", Target: "prep2"}).
+			Return(&model.Preparation{ID: 2, Name: "prep2"}, nil)
+		_, _, err := runner.Run(ctx, "singularity admin merge-preparations --really-do-it prep1 prep2")
+		require.NoError(t, err)
+	})
+}
+
+func TestAdminMergePreparations_NoReallyDoIt(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		_, _, err := runner.Run(ctx, "singularity admin merge-preparations prep1 prep2")
+		require.ErrorIs(t, err, cliutil.ErrReallyDoIt)
+	})
+}
+
+func TestAdminSplitSource(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(admin.MockAdmin)
+		defer swapAdminHandler(mockHandler)()
+		mockHandler.On("SplitSourceHandler", mock.Anything, mock.Anything, admin.SplitSourceRequest{Preparation: "prep1", Source: "source", Name: "prep3"}).
+			Return(&model.Preparation{ID: 3, Name: "prep3"}, nil)
+		_, _, err := runner.Run(ctx, "singularity admin split-source prep1 source prep3")
+		require.NoError(t, err)
+	})
+}
+
+func TestAdminStorageForecast(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(admin.MockAdmin)
+		defer swapAdminHandler(mockHandler)()
+		mockHandler.On("StorageForecastHandler", mock.Anything, mock.Anything, admin.StorageForecastRequest{Window: "24h0m0s", WarnWithin: "168h0m0s", AlertWebhook: "http://localhost/alerts"}).
+			Return([]admin.StorageForecast{{
+				StorageID:   1,
+				Storage:     "output",
+				Path:        "/mnt/output",
+				Total:       100 << 30,
+				Free:        10 << 30,
+				BytesPerDay: 2 << 30,
+				Warning:     "storage is forecast to be full within 168h0m0s",
+				Preparations: []admin.PreparationOutputRate{{
+					PreparationID: 1,
+					Preparation:   "prep",
+					NumOfCars:     2,
+					Bytes:         2 << 30,
+					BytesPerDay:   2 << 30,
+				}},
+			}}, nil)
+		_, _, err := runner.Run(ctx, "singularity admin storage-forecast --window 24h --alert-webhook http://localhost/alerts")
+		require.NoError(t, err)
+	})
+}
+
+func TestAdminFullTextSearch(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(admin.MockAdmin)
+		defer swapAdminHandler(mockHandler)()
+		mockHandler.On("FullTextSearchHandler", mock.Anything, mock.Anything, admin.FullTextSearchRequest{}).Return(nil)
+		mockHandler.On("FullTextSearchHandler", mock.Anything, mock.Anything, admin.FullTextSearchRequest{Disable: true}).Return(nil)
+		_, _, err := runner.Run(ctx, "singularity admin full-text-search")
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, "singularity admin full-text-search --disable")
+		require.NoError(t, err)
+		mockHandler.AssertNumberOfCalls(t, "FullTextSearchHandler", 2)
+	})
+}
+
+func TestAdminOperator(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(admin.MockAdmin)
+		defer swapAdminHandler(mockHandler)()
+		operator := model.Operator{ID: 1, Name: "alice"}
+		mockHandler.On("CreateOperatorHandler", mock.Anything, mock.Anything, "alice").
+			Return(&admin.OperatorWithKey{ID: operator.ID, Name: operator.Name, APIKey: "key"}, nil)
+		mockHandler.On("ListOperatorsHandler", mock.Anything, mock.Anything).Return([]model.Operator{operator}, nil)
+		mockHandler.On("RemoveOperatorHandler", mock.Anything, mock.Anything, "alice").Return(nil)
+		out, _, err := runner.Run(ctx, "singularity admin operator create alice")
+		require.NoError(t, err)
+		require.Contains(t, out, "key")
+		_, _, err = runner.Run(ctx, "singularity admin operator list")
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, "singularity admin operator remove alice")
+		require.NoError(t, err)
+	})
+}
+
+func TestAdminApprovalThreshold(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(admin.MockAdmin)
+		defer swapAdminHandler(mockHandler)()
+		mockHandler.On("SetApprovalThresholdHandler", mock.Anything, mock.Anything, admin.ApprovalThresholdRequest{Threshold: "1PiB"}).Return(nil)
+		_, _, err := runner.Run(ctx, "singularity admin approval-threshold 1PiB")
+		require.NoError(t, err)
+		mockHandler.AssertNumberOfCalls(t, "SetApprovalThresholdHandler", 1)
+	})
+}
+
+func TestAdminBudget(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(admin.MockAdmin)
+		defer swapAdminHandler(mockHandler)()
+		budget := model.Budget{ID: 1, PreparationID: ptr.Of(model.PreparationID(1)), Datacap: 1 << 50, FIL: 10}
+		mockHandler.On("SetBudgetHandler", mock.Anything, mock.Anything, admin.SetBudgetRequest{
+			Preparation: "prep",
+			Datacap:     "1PiB",
+			FIL:         ptr.Of(10.0),
+		}).Return(&budget, nil)
+		mockHandler.On("ListBudgetsHandler", mock.Anything, mock.Anything).Return([]admin.BudgetStatus{{
+			ID:          1,
+			Scope:       "prep",
+			Datacap:     1 << 50,
+			DatacapUsed: 1 << 40,
+			FIL:         10,
+			FILUsed:     1,
+		}}, nil)
+		mockHandler.On("OverrideBudgetHandler", mock.Anything, mock.Anything, admin.OverrideBudgetRequest{
+			Preparation: "prep",
+			Duration:    24 * time.Hour,
+		}).Return(&budget, nil)
+		mockHandler.On("RemoveBudgetHandler", mock.Anything, mock.Anything, "").Return(nil)
+		_, _, err := runner.Run(ctx, "singularity admin budget set --preparation prep --datacap 1PiB --fil 10")
+		require.NoError(t, err)
+		out, _, err := runner.Run(ctx, "singularity admin budget list")
+		require.NoError(t, err)
+		require.Contains(t, out, "prep")
+		_, _, err = runner.Run(ctx, "singularity admin budget override --preparation prep 24h")
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, "singularity admin budget remove")
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, "singularity admin budget override soon")
+		require.Error(t, err)
+	})
+}
@@ -184,9 +184,10 @@ func setupPreparation(t *testing.T, ctx context.Context, testFileName string, te
 		BasePath: http.DefaultBasePath,
 	})
 	// Create source storage
-	response, err := client.Storage.CreateLocalStorage(&storage.CreateLocalStorageParams{
-		Request: &models.StorageCreateLocalStorageRequest{
-			Name: "source",
+	response, err := client.Storage.CreateStorage(&storage.CreateStorageParams{
+		Type: "local",
+		Request: &models.StorageCreateRequest{
+			Name: ptr.Of("source"),
 			Path: source,
 		},
 		Context: ctx,
@@ -195,9 +196,10 @@ func setupPreparation(t *testing.T, ctx context.Context, testFileName string, te
 	require.True(t, response.IsSuccess())
 	require.NotZero(t, response.Payload.ID)
 	// Create output storage
-	response, err = client.Storage.CreateLocalStorage(&storage.CreateLocalStorageParams{
-		Request: &models.StorageCreateLocalStorageRequest{
-			Name: "output",
+	response, err = client.Storage.CreateStorage(&storage.CreateStorageParams{
+		Type: "local",
+		Request: &models.StorageCreateRequest{
+			Name: ptr.Of("output"),
 			Path: output,
 		},
 		Context: ctx,
@@ -14,16 +14,27 @@ import (
 	"github.com/data-preservation-programs/singularity/cmd/cliutil"
 	"github.com/data-preservation-programs/singularity/cmd/dataprep"
 	"github.com/data-preservation-programs/singularity/cmd/deal"
+	"github.com/data-preservation-programs/singularity/cmd/deal/policy"
 	"github.com/data-preservation-programs/singularity/cmd/deal/schedule"
+	"github.com/data-preservation-programs/singularity/cmd/export"
 	"github.com/data-preservation-programs/singularity/cmd/ez"
+	"github.com/data-preservation-programs/singularity/cmd/report"
 	"github.com/data-preservation-programs/singularity/cmd/run"
+	"github.com/data-preservation-programs/singularity/cmd/sp"
 	"github.com/data-preservation-programs/singularity/cmd/storage"
+	"github.com/data-preservation-programs/singularity/cmd/telemetry"
 	"github.com/data-preservation-programs/singularity/cmd/tool"
 	"github.com/data-preservation-programs/singularity/cmd/wallet"
+	"github.com/data-preservation-programs/singularity/cmd/worker"
+	"github.com/data-preservation-programs/singularity/i18n"
+	"github.com/data-preservation-programs/singularity/operator"
+	"github.com/data-preservation-programs/singularity/signer"
+	"github.com/data-preservation-programs/singularity/version"
 	"github.com/filecoin-project/go-address"
 	"github.com/ipfs/go-log/v2"
 	"github.com/rclone/rclone/lib/terminal"
 	"github.com/urfave/cli/v2"
+	"golang.org/x/text/language"
 )
 
 var logger = log.Logger("singularity/cmd")
@@ -70,16 +81,43 @@ Upgrading:
 			Value:       "sqlite:" + "./singularity.db",
 			EnvVars:     []string{"DATABASE_CONNECTION_STRING"},
 		},
+		&cli.BoolFlag{
+			Name:    "read-only",
+			Usage:   "Reject all writes to the database, i.e. to audit the database of another instance without credentials",
+			Value:   false,
+			EnvVars: []string{"READ_ONLY"},
+		},
+		&cli.StringFlag{
+			Name:    "api-key",
+			Usage:   "API key of the operator, required to create or modify schedules above the approval threshold and to approve them",
+			EnvVars: []string{"SINGULARITY_API_KEY"},
+		},
 		&cli.BoolFlag{
 			Name:  "json",
 			Usage: "Enable JSON output",
 			Value: false,
 		},
+		&cli.BoolFlag{
+			Name:  "show-secrets",
+			Usage: "Show the secrets in storage configs, i.e. keys, tokens and passwords, in the JSON output instead of masking them. They are always masked by the API",
+			Value: false,
+		},
 		&cli.BoolFlag{
 			Name:  "verbose",
 			Usage: "Enable verbose output. This will print more columns for the result as well as full error trace",
 			Value: false,
 		},
+		&cli.StringFlag{
+			Name:        "locale",
+			Usage:       "Language of the messages, i.e. zh-CN or es. Messages without translation and the JSON output are in English",
+			DefaultText: "LC_ALL, LC_MESSAGES or LANG",
+			EnvVars:     []string{"SINGULARITY_LOCALE"},
+		},
+		&cli.StringSliceFlag{
+			Name:    "pkcs11-module",
+			Usage:   "Path of a PKCS#11 module, i.e. /usr/lib/pkcs11/yubihsm_pkcs11.so, that keys kept in hardware security modules are used from. Only these modules are loaded",
+			EnvVars: []string{signer.ModulesEnvVar},
+		},
 		&cli.StringFlag{
 			Name:     "lotus-api",
 			Category: "Lotus",
@@ -102,6 +140,10 @@ Upgrading:
 		},
 	},
 	Before: func(c *cli.Context) error {
+		c.Context = operator.WithKey(c.Context, c.String("api-key"))
+		c.Context = i18n.WithLocale(c.Context, locale(c))
+		storage.AddRegisteredBackends()
+		signer.SetAllowedModules(c.StringSlice("pkcs11-module"))
 		if c.Bool("lotus-test") {
 			address.CurrentNetwork = address.Testnet
 			logger.Infow("Current network is set to Testnet")
@@ -112,7 +154,9 @@ Upgrading:
 	},
 	Commands: []*cli.Command{
 		ez.PrepCmd,
+		ez.ExampleCmd,
 		VersionCmd,
+		SelfUpdateCmd,
 		{
 			Name:     "admin",
 			Usage:    "Admin commands",
@@ -122,10 +166,46 @@ Upgrading:
 				admin.ResetCmd,
 				admin.MigrateDatasetCmd,
 				admin.MigrateScheduleCmd,
+				admin.MigrateConfigCmd,
+				admin.BackupCmd,
+				admin.RestoreCmd,
+				admin.MergePreparationsCmd,
+				admin.SplitSourceCmd,
+				admin.StorageForecastCmd,
+				admin.FullTextSearchCmd,
+				admin.ApprovalThresholdCmd,
+				{
+					Name:  "operator",
+					Usage: "Manage the operators allowed to request and approve schedules",
+					Subcommands: []*cli.Command{
+						admin.CreateOperatorCmd,
+						admin.ListOperatorsCmd,
+						admin.RemoveOperatorCmd,
+					},
+				},
+				{
+					Name:  "budget",
+					Usage: "Manage the datacap and FIL budgets that the deal pusher will not exceed",
+					Subcommands: []*cli.Command{
+						admin.SetBudgetCmd,
+						admin.ListBudgetsCmd,
+						admin.RemoveBudgetCmd,
+						admin.OverrideBudgetCmd,
+					},
+				},
 			},
 		},
 		DownloadCmd,
 		tool.ExtractCarCmd,
+		tool.ExtractCmd,
+		tool.DecryptCarCmd,
+		tool.DecryptItemCmd,
+		tool.GenerateEncryptionKeyCmd,
+		tool.WarmCacheCmd,
+		tool.SyncPiecesCmd,
+		tool.RegenerateCarCmd,
+		tool.SplitCarCmd,
+		tool.VerifyPieceCmd,
 		{
 			Name:     "deal",
 			Usage:    "Replication / Deal making management",
@@ -141,10 +221,24 @@ Upgrading:
 						schedule.PauseCmd,
 						schedule.ResumeCmd,
 						schedule.RemoveCmd,
+						schedule.ApproveCmd,
+						schedule.CalendarCmd,
+						schedule.SimulateCmd,
+					},
+				},
+				{
+					Name:  "policy",
+					Usage: "Replication policies",
+					Subcommands: []*cli.Command{
+						policy.SetCmd,
+						policy.ListCmd,
+						policy.RemoveCmd,
 					},
 				},
 				deal.SendManualCmd,
 				deal.ListCmd,
+				deal.ListReceiptsCmd,
+				deal.ListPieceProvidersCmd,
 			},
 		},
 		{
@@ -154,10 +248,14 @@ Upgrading:
 			Subcommands: []*cli.Command{
 				run.APICmd,
 				run.DatasetWorkerCmd,
+				run.DatasetCoordinatorCmd,
+				run.PackOneCmd,
 				run.ContentProviderCmd,
 				run.DealTrackerCmd,
 				run.DealPusherCmd,
+				run.RetrievalSamplerCmd,
 				run.DownloadServerCmd,
+				run.IngestListenerCmd,
 			},
 		},
 		{
@@ -167,6 +265,7 @@ Upgrading:
 			Subcommands: []*cli.Command{
 				wallet.ImportCmd,
 				wallet.ListCmd,
+				wallet.BalanceCmd,
 				wallet.RemoveCmd,
 			},
 		},
@@ -181,6 +280,19 @@ Upgrading:
 				storage.RemoveCmd,
 				storage.UpdateCmd,
 				storage.RenameCmd,
+				storage.ReauthCmd,
+				storage.TypesCmd,
+				storage.SlowestCmd,
+			},
+		},
+		{
+			Name:     "telemetry",
+			Category: "Utility",
+			Usage:    "Manage anonymous usage telemetry",
+			Subcommands: []*cli.Command{
+				telemetry.ReportCmd,
+				telemetry.EnableCmd,
+				telemetry.DisableCmd,
 			},
 		},
 		{
@@ -191,18 +303,38 @@ Upgrading:
 				dataprep.CreateCmd,
 				dataprep.ListCmd,
 				dataprep.StatusCmd,
+				dataprep.PackTimingCmd,
 				dataprep.RenameCmd,
+				dataprep.PauseCmd,
+				dataprep.ResumeCmd,
 				dataprep.AttachSourceCmd,
+				dataprep.UpdateSourceCmd,
 				dataprep.AttachOutputCmd,
 				dataprep.DetachOutputCmd,
 				dataprep.StartScanCmd,
 				dataprep.PauseScanCmd,
+				dataprep.AppendFilesCmd,
+				dataprep.DumpDatabaseCmd,
+				dataprep.DiffSourceCmd,
 				dataprep.StartPackCmd,
 				dataprep.PausePackCmd,
 				dataprep.StartDagGenCmd,
 				dataprep.PauseDagGenCmd,
 				dataprep.ListPiecesCmd,
 				dataprep.AddPieceCmd,
+				dataprep.ExportPieceIndexCmd,
+				dataprep.ExportPieceKeysCmd,
+				dataprep.ExportItemKeysCmd,
+				dataprep.RotateRecipientsCmd,
+				dataprep.VerifySourcesCmd,
+				dataprep.CreateRetrievalTokenCmd,
+				dataprep.ListRetrievalTokensCmd,
+				dataprep.RevokeRetrievalTokenCmd,
+				dataprep.StatsCmd,
+				dataprep.ShareStatsCmd,
+				dataprep.UnshareStatsCmd,
+				dataprep.AggregatePiecesCmd,
+				dataprep.PieceProofCmd,
 				dataprep.ExploreCmd,
 				dataprep.AttachWalletCmd,
 				dataprep.ListWalletsCmd,
@@ -210,13 +342,46 @@ Upgrading:
 				dataprep.RemoveCmd,
 			},
 		},
+		{
+			Name:     "report",
+			Category: "Operations",
+			Usage:    "Reports for planning and monitoring dataset onboarding",
+			Subcommands: []*cli.Command{
+				report.CapacityCmd,
+				report.AuditCmd,
+				report.LineageCmd,
+			},
+		},
+		{
+			Name:     "export",
+			Category: "Operations",
+			Usage:    "Export the pieces for storage providers and data programs",
+			Subcommands: []*cli.Command{
+				export.ManifestCmd,
+			},
+		},
+		{
+			Name:     "worker",
+			Category: "Operations",
+			Usage:    "Monitor the workers of the fleet",
+			Subcommands: []*cli.Command{
+				worker.ListCmd,
+			},
+		},
+		{
+			Name:     "sp",
+			Category: "Utility",
+			Usage:    "Tools for storage providers receiving deals",
+			Subcommands: []*cli.Command{
+				sp.ImportDealsCmd,
+				sp.ImportMediaCmd,
+			},
+		},
 	},
 }
 
 var originalHelpPrinter = cli.HelpPrinter
 
-var Version string
-
 func SetVersionJSON(versionJSON []byte) error {
 	var v struct {
 		Version string `json:"version"`
@@ -226,10 +391,20 @@ func SetVersionJSON(versionJSON []byte) error {
 		return errors.Wrap(err, "cannot unmarshal version")
 	}
 
-	Version = v.Version
+	version.Version = v.Version
 	return nil
 }
 
+var ErrCommandNotFound = errors.New("command not found")
+
+// locale returns the locale of the messages, from the --locale flag or else the environment.
+func locale(c *cli.Context) language.Tag {
+	if c.String("locale") != "" {
+		return i18n.Match(c.String("locale"))
+	}
+	return i18n.Match(i18n.FromEnv())
+}
+
 func SetupErrorHandler() {
 	errHandler := func(c *cli.Context, err error) {
 		if err == nil {
@@ -245,7 +420,7 @@ func SetupErrorHandler() {
 			_, _ = App.Writer.Write(errMessage)
 			_, _ = App.Writer.Write([]byte("\n"))
 		} else {
-			concise := cliutil.Failure(err.Error()) + "\n"
+			concise := cliutil.Failure(i18n.Error(locale(c), err)) + "\n"
 			_, _ = App.Writer.Write([]byte(concise))
 		}
 		if c.Bool("verbose") {
@@ -265,7 +440,7 @@ func SetupErrorHandler() {
 		return err
 	}
 	App.CommandNotFound = func(c *cli.Context, command string) {
-		errHandler(c, errors.Newf("command not found: %s", command))
+		errHandler(c, errors.Wrap(ErrCommandNotFound, command))
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/table"
 	"github.com/fatih/color"
 	"github.com/ipfs/go-log/v2"
@@ -55,7 +56,12 @@ func PrintAsJSON(c *cli.Context, obj any) {
 	_, _ = c.App.Writer.Write(objJSON)
 }
 
+// Print prints the object as JSON or as a table. The secrets of the storages in the object are masked unless
+// --show-secrets is set.
 func Print(c *cli.Context, obj any) {
+	if !c.Bool("show-secrets") {
+		obj = model.MaskStorageSecrets(obj)
+	}
 	if c.Bool("json") {
 		PrintAsJSON(c, obj)
 		return
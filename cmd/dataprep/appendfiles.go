@@ -0,0 +1,47 @@
+package dataprep
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/file"
+	"github.com/urfave/cli/v2"
+)
+
+var AppendFilesCmd = &cli.Command{
+	Name:      "append-files",
+	Usage:     "Append files to a source and queue them for packing without rescanning the source",
+	Category:  "Job Management",
+	ArgsUsage: "<preparation id|name> <storage id|name> <path> [path...]",
+	Description: "Tell Singularity that new files landed in the source, so they are packed without a full rescan.\n" +
+		"The paths are relative to the source. All files are checked in the source before any of them is appended,\n" +
+		"and either all new files are appended or none of them. Files that already exist with the same size and\n" +
+		"last modified time are skipped.",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "finalize",
+			Usage: "Queue the last pack job for packing right away, even if it is not full yet",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if c.NArg() < 3 {
+			return errors.WithStack(cliutil.ErrIncorrectNArgs)
+		}
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		request := file.AppendRequest{Finalize: c.Bool("finalize")}
+		for _, path := range c.Args().Slice()[2:] {
+			request.Files = append(request.Files, file.Info{Path: path})
+		}
+		result, err := file.Default.AppendFilesHandler(c.Context, db, c.Args().Get(0), c.Args().Get(1), request)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, result)
+		return nil
+	},
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/data-preservation-programs/singularity/handler/dataprep"
 	"github.com/data-preservation-programs/singularity/handler/storage"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
 	"github.com/data-preservation-programs/singularity/util"
 	"github.com/urfave/cli/v2"
 	"gorm.io/gorm"
@@ -51,7 +52,7 @@ var CreateCmd = &cli.Command{
 		},
 		&cli.StringFlag{
 			Name:        "piece-size",
-			Usage:       "The target piece size of the CAR files used for piece commitment calculation",
+			Usage:       "The target piece size of the CAR files used for piece commitment calculation, a power of two up to 64GiB",
 			Value:       "",
 			DefaultText: "Determined by --max-size",
 		},
@@ -67,6 +68,98 @@ var CreateCmd = &cli.Command{
 			Name:  "no-dag",
 			Usage: "Whether to disable maintaining folder dag structure for the sources. If disabled, DagGen will not be possible and folders will not have an associated CID.",
 		},
+		&cli.StringFlag{
+			Name:  "blob-storage",
+			Usage: "The id or name of the storage to store the raw blocks (dag nodes) instead of the database. Can shrink the database for datasets with many small files.",
+		},
+		&cli.IntFlag{
+			Name:        "max-directory-depth",
+			Usage:       "The maximum number of nested directories of a file. Deeper files are skipped during scanning.",
+			DefaultText: "Unlimited",
+		},
+		&cli.StringFlag{
+			Name:  "conflict-policy",
+			Usage: "What to do when the same path is packed more than once, i.e. a rescan finds a new version of a file. One of overwrite (the version packed last replaces the existing one), newest (keep the latest modified version), keep_both (add the later packed version with a numbered suffix) or error (fail the pack job)",
+			Value: string(model.ConflictOverwrite),
+		},
+		&cli.DurationFlag{
+			Name:        "max-batch-age",
+			Usage:       "How long a pack job can be filled with files appended by the API or the ingest listener before it is packed even if it is under the max size, i.e. 6h",
+			DefaultText: "Disabled",
+		},
+		&cli.StringFlag{
+			Name:        "partition-by",
+			Usage:       "Organize files into date-partitioned virtual directories, i.e. 2024/06/15/ for day, based on the event time of files appended by the ingest listener or their last modified time. One of year, month, day or hour",
+			DefaultText: "Disabled",
+		},
+		&cli.StringFlag{
+			Name:        "piece-key-recipient",
+			Usage:       "The base64 encoded public key of the data owner, as generated by 'singularity generate-encryption-key'. Each CAR file is encrypted with its own piece key, which is wrapped for this public key and can be exported with 'singularity prep export-piece-keys'. Requires --no-inline",
+			DefaultText: "Disabled",
+		},
+		&cli.StringSliceFlag{
+			Name:        "encryption-recipient",
+			Usage:       "The age X25519 public key, i.e. age1... as generated by age-keygen, that each file is encrypted for as it is packed, with its own ephemeral file key, so it can be decrypted with 'age -d' and the identity of any of the recipients. Can be repeated. The recipients can be rotated with 'singularity prep rotate-recipients'. Requires --no-inline",
+			DefaultText: "Disabled",
+		},
+		&cli.StringFlag{
+			Name:        "encryption-kms-key",
+			Usage:       "The URI of the master key of a key management service, i.e. awskms://<key ARN>, gcpkms://projects/<project>/locations/<location>/keyRings/<key ring>/cryptoKeys/<key> or vault://<transit mount>/<key>. Each file is encrypted with AES-256-GCM as it is packed, with its own data key generated and wrapped by the KMS, and the wrapped data keys can be exported with 'singularity prep export-item-keys'. The credentials are read from the environment, as with the CLI of the KMS. Cannot be combined with --encryption-recipient. Requires --no-inline",
+			DefaultText: "Disabled",
+		},
+		&cli.StringFlag{
+			Name:  "hash-function",
+			Usage: "The multihash function of the CIDs of the blocks. One of sha2-256 or blake2b-256",
+			Value: string(model.HashSHA256),
+		},
+		&cli.StringFlag{
+			Name:  "leaf-codec",
+			Usage: "The codec of the leaf blocks holding the content of files. One of raw or dag-pb (UnixFS file nodes, as created by older IPFS implementations). dag-pb requires --no-inline",
+			Value: string(model.LeafCodecRaw),
+		},
+		&cli.StringFlag{
+			Name:  "cid-version",
+			Usage: "The version of the CIDs of the blocks. One of v1 or v0 (legacy CIDs starting with Qm, as created by 'ipfs add' by default), so the CIDs match content already added to IPFS. v0 requires --hash-function sha2-256 and --leaf-codec dag-pb",
+			Value: string(model.CidV1),
+		},
+		&cli.StringFlag{
+			Name:  "chunker",
+			Usage: "How the content of files is split into blocks, as in 'ipfs add --chunker'. One of size-{size} (fixed size), rabin, rabin-{avg}, rabin-{min}-{avg}-{max} or buzhash (content defined, for better deduplication). Sizes can have units, i.e. rabin-256KiB-512KiB-1MiB, and blocks cannot be larger than 1MiB. The default of ipfs add is size-262144",
+			Value: packutil.DefaultChunker,
+		},
+		&cli.IntFlag{
+			Name:        "small-file-limit",
+			Usage:       "Files up to this number of bytes are embedded in their CID, and so in the directory that links to them, rather than written as blocks of their own, like 'ipfs add --inline'. This saves a CAR block and its database row per small file. Up to 128. Requires the raw leaf codec",
+			DefaultText: "Disabled",
+		},
+		&cli.BoolFlag{
+			Name:  "checksum-sidecar",
+			Usage: "Whether to write a .sha256 and a .commp.json sidecar file next to each CAR file in the output storages, with the payload CID, the piece CID, the padded piece size and the SHA-256 checksum of the CAR file, so transfer tools can verify the CAR files without querying the API",
+		},
+		&cli.BoolFlag{
+			Name:  "car-source",
+			Usage: "Whether the sources are collections of existing CAR files, i.e. Filecoin snapshots. The blocks of the .car files are packed as is, with their offsets in the CAR files, rather than the files being chunked again, so third-party CAR files can be aggregated into larger pieces. Other files are skipped. Requires --no-dag",
+		},
+		&cli.StringFlag{
+			Name:        "car-compression",
+			Usage:       "Compress the CAR files in the output storages to save storage and transfer costs of highly compressible data. One of zstd. The CAR files are saved as .car.zst and decompressed by the content provider when they are served, and the piece CID is still the one of the uncompressed CAR file",
+			DefaultText: "Disabled",
+		},
+		&cli.StringFlag{
+			Name:  "car-name-template",
+			Usage: "Template of the paths of the CAR files in the output storages, without the .car extension. Placeholders are {piece_cid}, {preparation}, {sequence} (the number of the CAR file within the preparation) and {date} (the date it is packed, in UTC), and {piece_cid} is required so the names are unique. Slashes put the CAR files in subdirectories, i.e. {preparation}/{date}/{piece_cid}",
+			Value: packutil.DefaultCarNameTemplate,
+		},
+		&cli.IntFlag{
+			Name:        "car-shard-depth",
+			Usage:       "Shard the CAR files into this many levels of subdirectories named after two characters of the piece CID each, up to 3, so no directory of the output storages holds too many CAR files for the filesystem or the sync tools. Each level has up to 1024 subdirectories",
+			DefaultText: "Disabled",
+		},
+		&cli.StringFlag{
+			Name:        "immutability",
+			Usage:       "Verify that the sources are in buckets with object lock, i.e. S3 buckets with a default retention in governance or compliance mode, before each scan, and record their retention mode. One of warn (log mutable sources) or enforce (refuse to scan mutable sources), for compliance datasets that must not change after they are prepared. The sources can be verified with 'singularity prep verify-sources'",
+			DefaultText: "Disabled",
+		},
 	},
 	Action: func(c *cli.Context) error {
 		db, closer, err := database.OpenFromCLI(c)
@@ -98,15 +191,39 @@ var CreateCmd = &cli.Command{
 			outputStorages = append(outputStorages, output.Name)
 		}
 
+		var maxBatchAge string
+		if c.Duration("max-batch-age") > 0 {
+			maxBatchAge = c.Duration("max-batch-age").String()
+		}
+
 		prep, err := dataprep.Default.CreatePreparationHandler(c.Context, db, dataprep.CreateRequest{
-			SourceStorages:    sourceStorages,
-			OutputStorages:    outputStorages,
-			MaxSizeStr:        maxSizeStr,
-			PieceSizeStr:      pieceSizeStr,
-			DeleteAfterExport: c.Bool("delete-after-export"),
-			Name:              name,
-			NoInline:          c.Bool("no-inline"),
-			NoDag:             c.Bool("no-dag"),
+			SourceStorages:       sourceStorages,
+			OutputStorages:       outputStorages,
+			MaxSizeStr:           maxSizeStr,
+			PieceSizeStr:         pieceSizeStr,
+			DeleteAfterExport:    c.Bool("delete-after-export"),
+			Name:                 name,
+			NoInline:             c.Bool("no-inline"),
+			NoDag:                c.Bool("no-dag"),
+			BlobStorage:          c.String("blob-storage"),
+			MaxDirectoryDepth:    c.Int("max-directory-depth"),
+			ConflictPolicy:       c.String("conflict-policy"),
+			MaxBatchAge:          maxBatchAge,
+			PartitionBy:          c.String("partition-by"),
+			PieceKeyRecipient:    c.String("piece-key-recipient"),
+			EncryptionRecipients: c.StringSlice("encryption-recipient"),
+			EncryptionKMSKey:     c.String("encryption-kms-key"),
+			HashFunction:         c.String("hash-function"),
+			LeafCodec:            c.String("leaf-codec"),
+			CidVersion:           c.String("cid-version"),
+			Chunker:              c.String("chunker"),
+			SmallFileLimit:       c.Int("small-file-limit"),
+			ChecksumSidecar:      c.Bool("checksum-sidecar"),
+			CarSource:            c.Bool("car-source"),
+			CarCompression:       c.String("car-compression"),
+			CarNameTemplate:      c.String("car-name-template"),
+			CarShardDepth:        c.Int("car-shard-depth"),
+			Immutability:         c.String("immutability"),
 		})
 		if err != nil {
 			return errors.WithStack(err)
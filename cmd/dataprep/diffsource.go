@@ -0,0 +1,43 @@
+package dataprep
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/dataprep"
+	"github.com/urfave/cli/v2"
+)
+
+var DiffSourceCmd = &cli.Command{
+	Name:      "diff-source",
+	Usage:     "Compare the current state of a source storage against what has been prepared from it",
+	Category:  "Job Management",
+	ArgsUsage: "<preparation id|name> <storage id|name>",
+	Description: "Lists the source storage and reports the files that have been added, removed or changed since they were\n" +
+		"prepared, with their sizes, so the changes can be reviewed before deciding to prepare a new version with a rescan.\n" +
+		"A file is changed if its size, last modified time or hash differs from the latest prepared version of its path.\n" +
+		"The source is compared one directory at a time. The counts and sizes cover all files, while only up to --limit\n" +
+		"of the differing files are listed. Nothing is written to the database.",
+	Before: cliutil.CheckNArgs,
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:  "limit",
+			Usage: "Maximum number of added, removed and changed files to list",
+			Value: dataprep.DefaultDiffLimit,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		diff, err := dataprep.Default.DiffSourceHandler(c.Context, db, c.Args().Get(0), c.Args().Get(1),
+			dataprep.DiffSourceRequest{Limit: c.Int("limit")})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, *diff)
+		return nil
+	},
+}
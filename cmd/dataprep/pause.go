@@ -0,0 +1,54 @@
+package dataprep
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/dataprep"
+	"github.com/urfave/cli/v2"
+)
+
+var PauseCmd = &cli.Command{
+	Name:  "pause",
+	Usage: "Pause the scanning and packing of a preparation",
+	Description: "The workers stop picking up the scan, pack and daggen jobs of the preparation, without affecting the\n" +
+		"other preparations. The pack and daggen jobs in progress are finished, so no CAR file is left half written,\n" +
+		"while the scans in progress are stopped and start over once the preparation is resumed.",
+	ArgsUsage: "<name|id>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		preparation, err := dataprep.Default.PausePreparationHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, preparation)
+		return nil
+	},
+}
+
+var ResumeCmd = &cli.Command{
+	Name:      "resume",
+	Usage:     "Resume the scanning and packing of a paused preparation",
+	ArgsUsage: "<name|id>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		preparation, err := dataprep.Default.ResumePreparationHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, preparation)
+		return nil
+	},
+}
@@ -82,3 +82,154 @@ var AddPieceCmd = &cli.Command{
 		return nil
 	},
 }
+
+var ExportPieceIndexCmd = &cli.Command{
+	Name:     "export-piece-index",
+	Usage:    "Export the CARv2 index of the generated pieces, so they can be indexed by storage providers without scanning the CAR files",
+	Category: "Piece Management",
+	Description: "The index is built from the block records in the database and written to one file per piece in the output directory.\n" +
+		"  carv2: <piece_cid>.idx in the standard CARv2 multihash sorted index format\n" +
+		"  boost: <piece_cid>.full.idx, the same index in the layout of the boost dagstore index directory\n" +
+		"Pieces without block records, i.e. those prepared with --no-inline or added with add-piece, are skipped.",
+	ArgsUsage: "<preparation id|name> <output_dir>",
+	Before:    cliutil.CheckNArgs,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "Format of the index files, either carv2 or boost",
+			Value: dataprep.PieceIndexFormatCarV2,
+		},
+		&cli.StringSliceFlag{
+			Name:        "piece-cid",
+			Usage:       "Only export the index of these pieces",
+			DefaultText: "All pieces",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		indexes, err := dataprep.Default.ExportPieceIndexHandler(c.Context, db, c.Args().Get(0), dataprep.ExportPieceIndexRequest{
+			OutputDir: c.Args().Get(1),
+			Format:    c.String("format"),
+			PieceCIDs: c.StringSlice("piece-cid"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, indexes)
+		return nil
+	},
+}
+
+var ExportPieceKeysCmd = &cli.Command{
+	Name:     "export-piece-keys",
+	Usage:    "Export the wrapped piece keys of an encrypted preparation for key escrow",
+	Category: "Piece Management",
+	Description: "Each encrypted piece has its own key, which is wrapped for the piece key recipient of the preparation.\n" +
+		"The export can only be unwrapped with the identity of the recipient, so it can be safely stored with the data.\n" +
+		"Use 'singularity decrypt-car' with the identity and the wrapped key of a piece to decrypt its CAR file.",
+	ArgsUsage: "<preparation id|name>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		escrow, err := dataprep.Default.ExportPieceKeysHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, escrow)
+		return nil
+	},
+}
+
+var ExportItemKeysCmd = &cli.Command{
+	Name:     "export-item-keys",
+	Usage:    "Export the wrapped data keys of the files of a preparation encrypted with a KMS key",
+	Category: "Piece Management",
+	Description: "Each file range encrypted with the KMS key of the preparation has its own data key, which is wrapped by the KMS key.\n" +
+		"The export can only be unwrapped by the KMS, so it can be safely stored with the data.\n" +
+		"Use 'singularity decrypt-item' with the KMS key and the wrapped key of a file range to decrypt it after it is retrieved.",
+	ArgsUsage: "<preparation id|name>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		escrow, err := dataprep.Default.ExportItemKeysHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, escrow)
+		return nil
+	},
+}
+
+var AggregatePiecesCmd = &cli.Command{
+	Name:     "aggregate-pieces",
+	Usage:    "Aggregate the small pieces of a preparation into large pieces with a data segment index, so they can fill large sectors",
+	Category: "Piece Management",
+	Description: "The pieces smaller than the piece size are packed into aggregate pieces following the data segment index of FRC-0058 (PODSI).\n" +
+		"Each aggregate is written to an output storage of the preparation, named like the other CAR files, so the preparation needs an output storage.\n" +
+		"Aggregated pieces are then dealt through their aggregate instead of on their own.\n" +
+		"Use 'singularity prep piece-proof' to get the proof of the inclusion of an aggregated piece in its aggregate.",
+	ArgsUsage: "<preparation id|name>",
+	Before:    cliutil.CheckNArgs,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "piece-size",
+			Usage: "Size of the aggregate pieces",
+			Value: "32GiB",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		aggregates, err := dataprep.Default.AggregatePiecesHandler(c.Context, db, c.Args().Get(0), dataprep.AggregatePiecesRequest{
+			PieceSize: c.String("piece-size"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, aggregates)
+		return nil
+	},
+}
+
+var PieceProofCmd = &cli.Command{
+	Name:     "piece-proof",
+	Usage:    "Get the proof of the inclusion of an aggregated piece in its aggregate piece",
+	Category: "Piece Management",
+	Description: "The proof is made of the Merkle path from the piece to the aggregate piece, and the Merkle path from the entry\n" +
+		"of the piece in the data segment index to the aggregate piece. Use --json to print the proof along with the piece.",
+	ArgsUsage: "<piece_cid>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		proof, err := dataprep.Default.GetPieceProofHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, proof)
+		return nil
+	},
+}
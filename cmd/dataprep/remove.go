@@ -18,7 +18,12 @@ var RemoveCmd = &cli.Command{
   * All File and Directory data and CIDs
   * All Schedules
 This will not remove
-  * All deals ever made`,
+  * All deals ever made
+
+For a large preparation, use --purge to delete the CAR blocks, CARs, files, directories, jobs and
+schedules in batches of separate transactions first, rather than in a single transaction. With --cars,
+the CAR files of each batch are removed before their CARs. An interrupted purge can be resumed by
+running the command again.`,
 	ArgsUsage: "<name|id>",
 	Before:    cliutil.CheckNArgs,
 	Flags: []cli.Flag{
@@ -26,6 +31,10 @@ This will not remove
 			Name:  "cars",
 			Usage: "Also remove prepared CAR files",
 		},
+		&cli.BoolFlag{
+			Name:  "purge",
+			Usage: "Delete the data of the preparation in batches of separate transactions before the preparation itself",
+		},
 	},
 	Action: func(c *cli.Context) error {
 		db, closer, err := database.OpenFromCLI(c)
@@ -40,6 +49,7 @@ This will not remove
 			c.Context, db, c.Args().Get(0),
 			dataprep.RemoveRequest{
 				RemoveCars: removeCars,
+				Purge:      c.Bool("purge"),
 			})
 
 		return errors.WithStack(err)
@@ -0,0 +1,112 @@
+package dataprep
+
+import (
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/dataprep"
+	"github.com/urfave/cli/v2"
+)
+
+var CreateRetrievalTokenCmd = &cli.Command{
+	Name:     "create-retrieval-token",
+	Usage:    "Issue a token that allows a third party to retrieve the pieces of a preparation",
+	Category: "Retrieval Tokens",
+	Description: "The token is signed with a key derived from $SINGULARITY_RETRIEVAL_TOKEN_SECRET, which must be set to the same\n" +
+		"secret of at least 16 characters for this command and the content providers, and is only shown once.\n" +
+		"Content providers started with --require-retrieval-token only serve the pieces, piece metadata and sub-DAGs of\n" +
+		"the preparation to requests with the token, given as 'Authorization: Bearer <token>' or with the token query\n" +
+		"parameter, until the token expires, is revoked or has served its max bytes.",
+	ArgsUsage: "<preparation id|name>",
+	Before:    cliutil.CheckNArgs,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "name",
+			Usage: "Name of the token, i.e. the third party it is handed to",
+		},
+		&cli.StringSliceFlag{
+			Name:  "piece-cid",
+			Usage: "Only allow this piece of the preparation. All pieces are allowed if not set",
+		},
+		&cli.DurationFlag{
+			Name:  "expires-in",
+			Usage: "How long the token is valid. The token never expires if not set",
+		},
+		&cli.StringFlag{
+			Name:  "max-bytes",
+			Usage: "Maximum number of bytes served with the token, i.e. 1TiB. The number of bytes is not limited if not set",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		request := dataprep.CreateRetrievalTokenRequest{
+			Name:      c.String("name"),
+			PieceCIDs: c.StringSlice("piece-cid"),
+			MaxBytes:  c.String("max-bytes"),
+		}
+		if c.IsSet("expires-in") {
+			request.ExpiresIn = c.Duration("expires-in").String()
+		}
+		token, err := dataprep.Default.CreateRetrievalTokenHandler(c.Context, db, c.Args().Get(0), request)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, token)
+		return nil
+	},
+}
+
+var ListRetrievalTokensCmd = &cli.Command{
+	Name:      "list-retrieval-tokens",
+	Usage:     "List the retrieval tokens of a preparation with their usage",
+	Category:  "Retrieval Tokens",
+	ArgsUsage: "<preparation id|name>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		tokens, err := dataprep.Default.ListRetrievalTokensHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, tokens)
+		return nil
+	},
+}
+
+var RevokeRetrievalTokenCmd = &cli.Command{
+	Name:      "revoke-retrieval-token",
+	Usage:     "Revoke a retrieval token of a preparation",
+	Category:  "Retrieval Tokens",
+	ArgsUsage: "<preparation id|name> <token_id>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		tokenID, err := strconv.ParseUint(c.Args().Get(1), 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse retrieval token ID %s", c.Args().Get(1))
+		}
+		token, err := dataprep.Default.RevokeRetrievalTokenHandler(c.Context, db, c.Args().Get(0), tokenID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, token)
+		return nil
+	},
+}
@@ -0,0 +1,41 @@
+package dataprep
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/dataprep"
+	"github.com/urfave/cli/v2"
+)
+
+var RotateRecipientsCmd = &cli.Command{
+	Name:      "rotate-recipients",
+	Usage:     "Replace the age recipients that the files of a preparation are encrypted for",
+	ArgsUsage: "<preparation id|name>",
+	Description: "The files packed from then on are encrypted for the new recipients only. The pieces packed before keep the\n" +
+		"encryption key version of the recipients they were encrypted for, which is listed with 'singularity prep list-pieces --verbose'.",
+	Before: cliutil.CheckNArgs,
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:     "recipient",
+			Usage:    "The age X25519 public key, i.e. age1..., that the files are encrypted for. Can be repeated",
+			Required: true,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		preparation, err := dataprep.Default.RotateRecipientsHandler(c.Context, db, c.Args().Get(0), dataprep.RotateRecipientsRequest{
+			Recipients: c.StringSlice("recipient"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, preparation)
+		return nil
+	},
+}
@@ -28,3 +28,36 @@ var AttachSourceCmd = &cli.Command{
 		return nil
 	},
 }
+
+var UpdateSourceCmd = &cli.Command{
+	Name:      "update-source",
+	Usage:     "Update the settings of a source storage attached to a preparation",
+	ArgsUsage: "<preparation id|name> <storage id|name>",
+	Category:  "Preparation Management",
+	Description: "The rescan mode decides how a rescan of the source treats the files already scanned from it:\n" +
+		"  append: added and modified files are added, and removed files are kept in the directory tree (default)\n" +
+		"  incremental: files are compared against the scanned ones in bulk by size, last modified time and hash,\n" +
+		"    and files removed from the source are removed from the directory tree, so the next DAG matches the source",
+	Before: cliutil.CheckNArgs,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "rescan-mode",
+			Usage: "How a rescan treats the files already scanned from the source, either append or incremental",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		attachment, err := dataprep.Default.UpdateSourceHandler(c.Context, db, c.Args().Get(0), c.Args().Get(1), dataprep.UpdateSourceRequest{
+			RescanMode: c.String("rescan-mode"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, attachment)
+		return nil
+	},
+}
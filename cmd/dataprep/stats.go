@@ -0,0 +1,78 @@
+package dataprep
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/dataprep"
+	"github.com/urfave/cli/v2"
+)
+
+var StatsCmd = &cli.Command{
+	Name:      "stats",
+	Usage:     "Show the stats of a preparation: pieces prepared, deals, storage providers and retrieval checks",
+	Category:  "Public Stats",
+	ArgsUsage: "<preparation id|name>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		stats, err := dataprep.Default.GetStatsHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, stats)
+		return nil
+	},
+}
+
+var ShareStatsCmd = &cli.Command{
+	Name:     "share-stats",
+	Usage:    "Share the stats of a preparation publicly",
+	Category: "Public Stats",
+	Description: "Gives the preparation a random stats share ID. A content provider started with --enable-public-stats\n" +
+		"serves the stats of the preparation at /stats/<share id> as JSON, or as an HTML page that can be linked or\n" +
+		"embedded, without access to the API. Sharing the stats again keeps the share ID.",
+	ArgsUsage: "<preparation id|name>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		preparation, err := dataprep.Default.ShareStatsHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, preparation)
+		return nil
+	},
+}
+
+var UnshareStatsCmd = &cli.Command{
+	Name:      "unshare-stats",
+	Usage:     "Stop sharing the stats of a preparation publicly. Sharing them again gives a new share ID",
+	Category:  "Public Stats",
+	ArgsUsage: "<preparation id|name>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		preparation, err := dataprep.Default.UnshareStatsHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, preparation)
+		return nil
+	},
+}
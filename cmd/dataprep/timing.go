@@ -0,0 +1,34 @@
+package dataprep
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/job"
+	"github.com/urfave/cli/v2"
+)
+
+var PackTimingCmd = &cli.Command{
+	Name:  "pack-timing",
+	Usage: "Get the timing breakdown of the pieces generated for a preparation",
+	Description: "Each time a piece is packed, the time spent listing and reading the source files, hashing, writing the CAR\n" +
+		"file to the output storage, validating it and recording it in the database is recorded. The totals tell whether a\n" +
+		"slow preparation is bound by the source, the CPU, the output storage or the database.",
+	Category:  "Job Management",
+	ArgsUsage: "<preparation id|name>",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		report, err := job.Default.GetPackTimingsHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, *report)
+		return nil
+	},
+}
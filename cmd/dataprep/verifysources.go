@@ -0,0 +1,33 @@
+package dataprep
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/dataprep"
+	"github.com/urfave/cli/v2"
+)
+
+var VerifySourcesCmd = &cli.Command{
+	Name:      "verify-sources",
+	Usage:     "Verify and record the object lock retention mode of the source storages of a preparation",
+	ArgsUsage: "<preparation id|name>",
+	Description: "The retention mode of a source is none if its bucket can be modified, governance or compliance if its\n" +
+		"objects are retained by default with S3 object lock, or unsupported if the storage type cannot be verified.\n" +
+		"Preparations created with --immutability verify their sources again before each scan.",
+	Before: cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		attachments, err := dataprep.Default.VerifySourcesHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, attachments)
+		return nil
+	},
+}
@@ -6,7 +6,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
 	"github.com/data-preservation-programs/singularity/handler/dataprep"
+	"github.com/data-preservation-programs/singularity/handler/file"
 	"github.com/data-preservation-programs/singularity/handler/wallet"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/util/testutil"
@@ -77,6 +79,56 @@ func TestDataPrepRenameHandler(t *testing.T) {
 	})
 }
 
+func TestDataPrepRotateRecipientsHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		mockHandler.On("RotateRecipientsHandler", mock.Anything, mock.Anything, "1", dataprep.RotateRecipientsRequest{
+			Recipients: []string{"age1a", "age1b"},
+		}).Return(&testPreparation, nil)
+		_, _, err := runner.Run(ctx, "singularity prep rotate-recipients --recipient age1a --recipient age1b 1")
+		require.NoError(t, err)
+	})
+}
+
+func TestDataPrepVerifySourcesHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		mockHandler.On("VerifySourcesHandler", mock.Anything, mock.Anything, "1").Return([]model.SourceAttachment{{
+			ID:            1,
+			PreparationID: 1,
+			StorageID:     1,
+			RetentionMode: model.RetentionCompliance,
+		}}, nil)
+		_, _, err := runner.Run(ctx, "singularity prep verify-sources 1")
+		require.NoError(t, err)
+	})
+}
+
+func TestDataPrepPauseResumeHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		mockHandler.On("PausePreparationHandler", mock.Anything, mock.Anything, "1").Return(&testPreparation, nil)
+		_, _, err := runner.Run(ctx, "singularity prep pause 1")
+		require.NoError(t, err)
+
+		mockHandler.On("ResumePreparationHandler", mock.Anything, mock.Anything, "1").Return(&testPreparation, nil)
+		_, _, err = runner.Run(ctx, "singularity --verbose prep resume 1")
+		require.NoError(t, err)
+	})
+}
+
 func TestDataPrepCreateHandler(t *testing.T) {
 	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 		runner := NewRunner()
@@ -130,8 +182,12 @@ func TestDataPrepRemoveHandler(t *testing.T) {
 		mockHandler := new(dataprep.MockDataPrep)
 		defer swapDataPrepHandler(mockHandler)()
 
+		mockHandler.On("RemovePreparationHandler", mock.Anything, mock.Anything, "2", dataprep.RemoveRequest{RemoveCars: true, Purge: true}).Return(nil).Once()
+		_, _, err := runner.Run(ctx, "singularity prep remove --cars --purge 2")
+		require.NoError(t, err)
+
 		mockHandler.On("RemovePreparationHandler", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
-		_, _, err := runner.Run(ctx, "singularity prep remove 1")
+		_, _, err = runner.Run(ctx, "singularity prep remove 1")
 		require.NoError(t, err)
 
 		_, _, err = runner.Run(ctx, "singularity --verbose prep remove 1")
@@ -139,6 +195,41 @@ func TestDataPrepRemoveHandler(t *testing.T) {
 	})
 }
 
+func swapFileHandler(mockHandler file.Handler) func() {
+	actual := file.Default
+	file.Default = mockHandler
+	return func() {
+		file.Default = actual
+	}
+}
+
+func TestDataPrepAppendFilesHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(file.MockFile)
+		defer swapFileHandler(mockHandler)()
+
+		result := &file.AppendResult{
+			Files:   []model.File{{ID: 1, Path: "a.txt", Size: 100, AttachmentID: 1}},
+			Skipped: []string{"b.txt"},
+			Jobs:    []model.Job{{ID: 1, Type: model.Pack, State: model.Ready, AttachmentID: 1}},
+		}
+		mockHandler.On("AppendFilesHandler", mock.Anything, mock.Anything, "1", "source", file.AppendRequest{
+			Files:    []file.Info{{Path: "a.txt"}, {Path: "b.txt"}},
+			Finalize: true,
+		}).Return(result, nil)
+		_, _, err := runner.Run(ctx, "singularity prep append-files --finalize 1 source a.txt b.txt")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose prep append-files --finalize 1 source a.txt b.txt")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity prep append-files 1 source")
+		require.ErrorIs(t, err, cliutil.ErrIncorrectNArgs)
+	})
+}
+
 func TestDataPrepAttachSourceHandler(t *testing.T) {
 	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 		runner := NewRunner()
@@ -155,6 +246,21 @@ func TestDataPrepAttachSourceHandler(t *testing.T) {
 	})
 }
 
+func TestDataPrepUpdateSourceHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		mockHandler.On("UpdateSourceHandler", mock.Anything, mock.Anything, "1", "source", dataprep.UpdateSourceRequest{
+			RescanMode: "incremental",
+		}).Return(&model.SourceAttachment{ID: 1, RescanMode: model.RescanIncremental, PreparationID: 1, StorageID: 1}, nil)
+		_, _, err := runner.Run(ctx, "singularity prep update-source --rescan-mode incremental 1 source")
+		require.NoError(t, err)
+	})
+}
+
 func TestDataPrepAttachOutputHandler(t *testing.T) {
 	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 		runner := NewRunner()
@@ -383,3 +489,243 @@ func TestDataPreparationListPiecesHandler(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestDataPreparationExportPieceIndexHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		mockHandler.On("ExportPieceIndexHandler", mock.Anything, mock.Anything, "1", dataprep.ExportPieceIndexRequest{
+			OutputDir: "/tmp/index",
+			Format:    dataprep.PieceIndexFormatBoost,
+			PieceCIDs: []string{testutil.TestCid.String()},
+		}).Return([]dataprep.PieceIndex{{
+			PieceCID:  testutil.TestCid.String(),
+			Path:      "/tmp/index/" + testutil.TestCid.String() + ".full.idx",
+			NumBlocks: 10,
+		}}, nil)
+		_, _, err := runner.Run(ctx, "singularity prep export-piece-index --format boost --piece-cid "+testutil.TestCid.String()+" 1 /tmp/index")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose prep export-piece-index --format boost --piece-cid "+testutil.TestCid.String()+" 1 /tmp/index")
+		require.NoError(t, err)
+	})
+}
+
+func TestDataPreparationExportPieceKeysHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		mockHandler.On("ExportPieceKeysHandler", mock.Anything, mock.Anything, "1").Return(&dataprep.PieceKeyEscrow{
+			PreparationID: 1,
+			Preparation:   "prep",
+			Recipient:     "recipient",
+			Keys: []dataprep.PieceKey{{
+				CarID:       1,
+				PieceCID:    testutil.TestCid.String(),
+				PieceSize:   1024,
+				RootCID:     testutil.TestCid.String(),
+				FileSize:    1000,
+				StoragePath: "piece.car",
+				WrappedKey:  "wrapped",
+			}},
+		}, nil)
+		_, _, err := runner.Run(ctx, "singularity prep export-piece-keys 1")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose prep export-piece-keys 1")
+		require.NoError(t, err)
+	})
+}
+
+func TestDataPreparationExportItemKeysHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		mockHandler.On("ExportItemKeysHandler", mock.Anything, mock.Anything, "1").Return(&dataprep.ItemKeyEscrow{
+			PreparationID: 1,
+			Preparation:   "prep",
+			KMSKey:        "vault://transit/key",
+			Keys: []dataprep.ItemKey{{
+				FileRangeID:     1,
+				FileID:          1,
+				Path:            "file.txt",
+				Length:          1000,
+				CID:             testutil.TestCid.String(),
+				EncryptedLength: 1016,
+				WrappedKey:      "wrapped",
+			}},
+		}, nil)
+		_, _, err := runner.Run(ctx, "singularity prep export-item-keys 1")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose prep export-item-keys 1")
+		require.NoError(t, err)
+	})
+}
+
+func TestDataPreparationAggregatePiecesHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		mockHandler.On("AggregatePiecesHandler", mock.Anything, mock.Anything, "1", dataprep.AggregatePiecesRequest{
+			PieceSize: "64GiB",
+		}).Return([]model.Car{{
+			ID:            10,
+			PieceCID:      model.CID(testutil.TestCid),
+			PieceSize:     64 << 30,
+			RootCID:       model.CID(testutil.TestCid),
+			FileSize:      60 << 30,
+			StoragePath:   "aggregate.car",
+			NumOfFiles:    100,
+			PreparationID: 1,
+		}}, nil)
+		_, _, err := runner.Run(ctx, "singularity prep aggregate-pieces --piece-size 64GiB 1")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose prep aggregate-pieces --piece-size 64GiB 1")
+		require.NoError(t, err)
+	})
+}
+
+func TestDataPreparationGetPieceProofHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		mockHandler.On("GetPieceProofHandler", mock.Anything, mock.Anything, testutil.TestCid.String()).Return(&dataprep.PieceProof{
+			PieceCID:           model.CID(testutil.TestCid),
+			PieceSize:          1 << 20,
+			AggregatePieceCID:  model.CID(testutil.TestCid),
+			AggregatePieceSize: 32 << 30,
+			Offset:             1 << 20,
+		}, nil)
+		_, _, err := runner.Run(ctx, "singularity prep piece-proof "+testutil.TestCid.String())
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --json prep piece-proof "+testutil.TestCid.String())
+		require.NoError(t, err)
+	})
+}
+
+func TestDataPreparationRetrievalTokenHandlers(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		token := model.RetrievalToken{
+			ID:            1,
+			Name:          "partner",
+			PieceCIDs:     model.StringSlice{testutil.TestCid.String()},
+			MaxBytes:      1 << 30,
+			PreparationID: 1,
+		}
+		mockHandler.On("CreateRetrievalTokenHandler", mock.Anything, mock.Anything, "1", dataprep.CreateRetrievalTokenRequest{
+			Name:      "partner",
+			PieceCIDs: []string{testutil.TestCid.String()},
+			ExpiresIn: "720h0m0s",
+			MaxBytes:  "1GiB",
+		}).Return(&dataprep.IssuedRetrievalToken{Token: "srt_1_signature", RetrievalToken: token}, nil)
+		_, _, err := runner.Run(ctx, "singularity prep create-retrieval-token --name partner --piece-cid "+
+			testutil.TestCid.String()+" --expires-in 720h --max-bytes 1GiB 1")
+		require.NoError(t, err)
+
+		mockHandler.On("ListRetrievalTokensHandler", mock.Anything, mock.Anything, "1").Return([]model.RetrievalToken{token}, nil)
+		_, _, err = runner.Run(ctx, "singularity prep list-retrieval-tokens 1")
+		require.NoError(t, err)
+
+		token.Revoked = true
+		mockHandler.On("RevokeRetrievalTokenHandler", mock.Anything, mock.Anything, "1", uint64(1)).Return(&token, nil)
+		_, _, err = runner.Run(ctx, "singularity prep revoke-retrieval-token 1 1")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity prep revoke-retrieval-token 1 x")
+		require.ErrorContains(t, err, "failed to parse retrieval token ID")
+	})
+}
+
+func TestDataPreparationStatsHandlers(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		checked := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		mockHandler.On("GetStatsHandler", mock.Anything, mock.Anything, "1").Return(&dataprep.Stats{
+			Name:          "prep",
+			GeneratedAt:   checked,
+			Pieces:        3,
+			BytesPrepared: 6000,
+			ActiveDeals:   3,
+			ActiveBytes:   5 << 20,
+			Providers: []dataprep.ProviderStats{
+				{Provider: "f01", ActiveDeals: 2, ActiveBytes: 3 << 20},
+				{Provider: "f02", ActiveDeals: 1, ActiveBytes: 2 << 20},
+			},
+			LastRetrievalCheck:    &checked,
+			LastRetrievalSuccess:  true,
+			RetrievalChecks:       2,
+			RetrievalChecksPassed: 2,
+		}, nil)
+		_, _, err := runner.Run(ctx, "singularity prep stats 1")
+		require.NoError(t, err)
+
+		shareID := "0123456789abcdef0123456789abcdef"
+		mockHandler.On("ShareStatsHandler", mock.Anything, mock.Anything, "1").Return(&model.Preparation{ID: 1, Name: "prep", StatsShareID: &shareID}, nil)
+		_, _, err = runner.Run(ctx, "singularity --verbose prep share-stats 1")
+		require.NoError(t, err)
+
+		mockHandler.On("UnshareStatsHandler", mock.Anything, mock.Anything, "1").Return(&model.Preparation{ID: 1, Name: "prep"}, nil)
+		_, _, err = runner.Run(ctx, "singularity prep unshare-stats 1")
+		require.NoError(t, err)
+	})
+}
+
+func TestDataPreparationDiffSourceHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(dataprep.MockDataPrep)
+		defer swapDataPrepHandler(mockHandler)()
+
+		mockHandler.On("DiffSourceHandler", mock.Anything, mock.Anything, "1", "source", dataprep.DiffSourceRequest{Limit: 10}).Return(&dataprep.SourceDiff{
+			Added:     1,
+			AddedSize: 20,
+			Changed:   1,
+			Entries: []dataprep.DiffEntry{{
+				Status:       dataprep.DiffAdded,
+				Path:         "new.txt",
+				Size:         20,
+				LastModified: time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC),
+			}, {
+				Status:               dataprep.DiffChanged,
+				Path:                 "old.txt",
+				Size:                 30,
+				PreparedSize:         10,
+				LastModified:         time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC),
+				PreparedLastModified: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			}},
+		}, nil)
+		_, _, err := runner.Run(ctx, "singularity prep diff-source --limit 10 1 source")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose prep diff-source --limit 10 1 source")
+		require.NoError(t, err)
+	})
+}
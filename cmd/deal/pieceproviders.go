@@ -0,0 +1,48 @@
+package deal
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/deal"
+	"github.com/urfave/cli/v2"
+)
+
+var ListPieceProvidersCmd = &cli.Command{
+	Name:  "list-piece-providers",
+	Usage: "List the providers that already have a proposed, published or active deal for each piece",
+	Description: "A piece is never proposed twice to the same provider, neither by the schedules nor by 'singularity deal send-manual',\n" +
+		"unless the schedule or the proposal is forced. This lists the pieces each provider already has, so it is excluded from\n" +
+		"their deals.",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "preparation",
+			Usage: "Filter pieces by preparation id or name",
+		},
+		&cli.StringSliceFlag{
+			Name:  "piece",
+			Usage: "Filter pieces by piece CID",
+		},
+		&cli.StringSliceFlag{
+			Name:  "provider",
+			Usage: "Filter providers",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		pieces, err := deal.Default.ListPieceProvidersHandler(c.Context, db, deal.ListPieceProvidersRequest{
+			Preparations: c.StringSlice("preparation"),
+			Pieces:       c.StringSlice("piece"),
+			Providers:    c.StringSlice("provider"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, pieces)
+		return nil
+	},
+}
@@ -0,0 +1,28 @@
+package policy
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/deal/schedule"
+	"github.com/urfave/cli/v2"
+)
+
+var ListCmd = &cli.Command{
+	Name:  "list",
+	Usage: "List the replication policies of all preparations",
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		policies, err := schedule.Default.ListPoliciesHandler(c.Context, db)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		cliutil.Print(c, policies)
+		return nil
+	},
+}
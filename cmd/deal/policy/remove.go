@@ -0,0 +1,27 @@
+package policy
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/deal/schedule"
+	"github.com/urfave/cli/v2"
+)
+
+var RemoveCmd = &cli.Command{
+	Name:        "remove",
+	Usage:       "Remove the replication policy of a preparation",
+	Before:      cliutil.CheckNArgs,
+	ArgsUsage:   "<preparation id|name>",
+	Description: "The active schedules of the policy are paused. All deals made by them will remain for tracking purpose.",
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		err = schedule.Default.RemovePolicyHandler(c.Context, db, c.Args().Get(0))
+		return errors.WithStack(err)
+	},
+}
@@ -0,0 +1,154 @@
+package policy
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/deal/schedule"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/urfave/cli/v2"
+)
+
+var SetCmd = &cli.Command{
+	Name:      "set",
+	Usage:     "Set the replication policy of a preparation",
+	ArgsUsage: "<preparation id|name>",
+	Before:    cliutil.CheckNArgs,
+	Description: "A replication policy keeps a number of replicas of each piece of the preparation, spread across the given\n" +
+		"storage providers, i.e. 5 replicas, at most 2 with the providers of an organization, across at least 3 regions:\n" +
+		"  singularity deal policy set my_prep --replicas 5 --max-per-org 2 --min-regions 3 \\\n" +
+		"    --provider f01000:acme:eu-west --provider f01001:acme:us-east --provider f02000:globex:eu-west ...\n" +
+		"The deal pusher chooses the providers of the pieces missing replicas, and proposes the deals through a schedule\n" +
+		"of the policy for each provider. Replicas that expire or are slashed are proposed again. Setting the policy of a\n" +
+		"preparation that already has one replaces it.\n\n" +
+		"Instead of a hand-maintained list, providers can be chosen from the provider directory ingested by the deal\n" +
+		"tracker, i.e. the 10 best providers in Europe or Asia with a retrieval success rate of at least 90% that ask at\n" +
+		"most the price of the policy:\n" +
+		"  singularity deal policy set my_prep --replicas 5 --auto-select 10 --min-retrieval-rate 0.9 \\\n" +
+		"    --region Europe --region Asia\n" +
+		"The providers are ranked by retrieval success rate, then by ask price, and must be reachable and accept the\n" +
+		"piece size of the preparation.",
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:     "replicas",
+			Usage:    "Number of replicas of each piece to keep, counting the deals proposed, published or active",
+			Required: true,
+		},
+		&cli.IntFlag{
+			Name:        "max-per-org",
+			Usage:       "Maximum number of replicas of a piece with the providers of an organization",
+			DefaultText: "unlimited",
+		},
+		&cli.IntFlag{
+			Name:  "min-regions",
+			Usage: "Number of regions the replicas of a piece are spread across",
+		},
+		&cli.StringSliceFlag{
+			Name:  "provider",
+			Usage: "Storage provider to make replicas with, as provider[:org[:region]], i.e. f01000:acme:eu-west. A provider without an organization is its own organization",
+		},
+		&cli.IntFlag{
+			Name:     "auto-select",
+			Category: "Provider Selection",
+			Usage:    "Number of providers to also choose from the provider directory ingested by the deal tracker, ranked by retrieval success rate and ask price",
+		},
+		&cli.Float64Flag{
+			Name:     "min-retrieval-rate",
+			Category: "Provider Selection",
+			Usage:    "Minimum retrieval success rate of the providers chosen from the provider directory, between 0 and 1",
+		},
+		&cli.StringSliceFlag{
+			Name:     "region",
+			Category: "Provider Selection",
+			Usage:    "Region of the providers chosen from the provider directory, i.e. Europe. Any region if not set",
+		},
+		&cli.StringFlag{
+			Name:     "url-template",
+			Category: "Boost Only",
+			Aliases:  []string{"u"},
+			Usage:    "URL template with PIECE_CID placeholder for boost to fetch the CAR file, i.e. http://127.0.0.1/piece/{PIECE_CID}.car",
+		},
+		&cli.Float64Flag{
+			Name:     "price-per-gb-epoch",
+			Category: "Deal Proposal",
+			Usage:    "Price in FIL per GiB per epoch",
+		},
+		&cli.Float64Flag{
+			Name:     "price-per-gb",
+			Category: "Deal Proposal",
+			Usage:    "Price in FIL per GiB",
+		},
+		&cli.Float64Flag{
+			Name:     "price-per-deal",
+			Category: "Deal Proposal",
+			Usage:    "Price in FIL per deal",
+		},
+		&cli.BoolFlag{
+			Name:     "verified",
+			Category: "Deal Proposal",
+			Usage:    "Whether to propose deals as verified",
+			Value:    true,
+		},
+		&cli.BoolFlag{
+			Name:     "ipni",
+			Category: "Boost Only",
+			Usage:    "Whether to announce the deal to IPNI",
+			Value:    true,
+		},
+		&cli.BoolFlag{
+			Name:     "keep-unsealed",
+			Category: "Deal Proposal",
+			Usage:    "Whether to keep unsealed copy",
+			Value:    true,
+		},
+		&cli.StringFlag{
+			Name:        "start-delay",
+			Category:    "Deal Proposal",
+			Aliases:     []string{"s"},
+			Usage:       "Deal start delay in epoch or in duration format, i.e. 1000, 72h",
+			Value:       "72h",
+			DefaultText: "72h[3 days]",
+		},
+		&cli.StringFlag{
+			Name:        "duration",
+			Category:    "Deal Proposal",
+			Aliases:     []string{"d"},
+			Usage:       "Duration in epoch or in duration format, i.e. 1500000, 2400h",
+			Value:       "12840h",
+			DefaultText: "12840h[535 days]",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		request := schedule.SetPolicyRequest{
+			Replicas:         c.Int("replicas"),
+			MaxPerOrg:        c.Int("max-per-org"),
+			MinRegions:       c.Int("min-regions"),
+			Providers:        c.StringSlice("provider"),
+			AutoSelect:       c.Int("auto-select"),
+			MinRetrievalRate: c.Float64("min-retrieval-rate"),
+			Regions:          c.StringSlice("region"),
+			URLTemplate:      c.String("url-template"),
+			PricePerGBEpoch:  c.Float64("price-per-gb-epoch"),
+			PricePerGB:       c.Float64("price-per-gb"),
+			PricePerDeal:     c.Float64("price-per-deal"),
+			Verified:         c.Bool("verified"),
+			IPNI:             c.Bool("ipni"),
+			KeepUnsealed:     c.Bool("keep-unsealed"),
+			StartDelay:       c.String("start-delay"),
+			Duration:         c.String("duration"),
+		}
+		lotusClient := util.NewLotusClient(c.String("lotus-api"), c.String("lotus-token"))
+		policy, err := schedule.Default.SetPolicyHandler(c.Context, db, lotusClient, c.Args().Get(0), request)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		cliutil.Print(c, policy)
+		return nil
+	},
+}
@@ -0,0 +1,43 @@
+package deal
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/deal"
+	"github.com/urfave/cli/v2"
+)
+
+var ListReceiptsCmd = &cli.Command{
+	Name:  "list-receipts",
+	Usage: "List the signed receipts of pieces that have reached their replication target",
+	Description: "The deal tracker issues a receipt once a piece has active deals with as many distinct providers as its preparation\n" +
+		"is scheduled with. Each receipt lists the piece CID, the deal IDs, the providers and the deal epochs, and is signed with\n" +
+		"the receipt key of this Singularity instance. Use the global --json flag to archive the receipts as proof of storage.",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "preparation",
+			Usage: "Filter receipts by preparation id or name",
+		},
+		&cli.StringSliceFlag{
+			Name:  "piece",
+			Usage: "Filter receipts by piece CID",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		receipts, err := deal.Default.ListReceiptsHandler(c.Context, db, deal.ListReceiptRequest{
+			Preparations: c.StringSlice("preparation"),
+			Pieces:       c.StringSlice("piece"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, receipts)
+		return nil
+	},
+}
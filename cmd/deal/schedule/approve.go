@@ -0,0 +1,39 @@
+package schedule
+
+import (
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/deal/schedule"
+	"github.com/urfave/cli/v2"
+)
+
+var ApproveCmd = &cli.Command{
+	Name:      "approve",
+	Usage:     "Approve a schedule that is pending approval",
+	Before:    cliutil.CheckNArgs,
+	ArgsUsage: "<schedule_id>",
+	Description: "A schedule is pending approval when it has been created or modified with a total deal size above the approval threshold.\n" +
+		"It must be approved by an operator other than the one that requested it, identified by the global --api-key flag.",
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		scheduleID, err := strconv.ParseUint(c.Args().Get(0), 10, 32)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse schedule ID %s", c.Args().Get(0))
+		}
+
+		schedule, err := schedule.Default.ApproveHandler(c.Context, db, uint32(scheduleID))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, schedule)
+		return nil
+	},
+}
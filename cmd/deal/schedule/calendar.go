@@ -0,0 +1,52 @@
+package schedule
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/deal/schedule"
+	"github.com/urfave/cli/v2"
+)
+
+var CalendarCmd = &cli.Command{
+	Name:  "calendar",
+	Usage: "Show the deals the active schedules are expected to propose per day or week and per provider",
+	Description: "Replays the pacing of each active schedule against the pieces it has not made deals for yet.\n" +
+		"A schedule without cron proposes all its pieces right away, and a cron schedule proposes up to its\n" +
+		"schedule deal number and size at each run, until the pieces or the total deal number or size run out.\n" +
+		"The max pending deals, the budgets and the provider reputation may delay proposals, and pieces packed later\n" +
+		"are not known yet, so the calendar is a best-case estimate. Use --json to export it.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "interval",
+			Usage: "Period of the calendar entries, either day or week. Weeks start on Monday.",
+			Value: schedule.CalendarDay,
+		},
+		&cli.DurationFlag{
+			Name:  "horizon",
+			Usage: "How far ahead to project the deal proposals",
+			Value: schedule.DefaultCalendarHorizon,
+		},
+		&cli.StringFlag{
+			Name:  "provider",
+			Usage: "Only include the schedules of this storage provider",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		entries, err := schedule.Default.CalendarHandler(c.Context, db, schedule.CalendarRequest{
+			Interval: c.String("interval"),
+			Horizon:  c.Duration("horizon").String(),
+			Provider: c.String("provider"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, entries)
+		return nil
+	},
+}
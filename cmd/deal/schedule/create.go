@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"os"
 	"regexp"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/cmd/cliutil"
@@ -188,6 +189,44 @@ var CreateCmd = &cli.Command{
 			Usage:       "Max pending deal number overall for this request, i.e. 100TiB",
 			DefaultText: "Unlimited",
 		},
+		&cli.IntFlag{
+			Name:        "hourly-deal-number",
+			Category:    "Rate Limits",
+			Usage:       "Max number of deals proposed within any hour, i.e. 10",
+			DefaultText: "Unlimited",
+		},
+		&cli.StringFlag{
+			Name:        "hourly-deal-size",
+			Category:    "Rate Limits",
+			Usage:       "Max size of deals proposed within any hour, i.e. 1TiB",
+			DefaultText: "Unlimited",
+			Value:       "0",
+		},
+		&cli.IntFlag{
+			Name:        "daily-deal-number",
+			Category:    "Rate Limits",
+			Usage:       "Max number of deals proposed within any day, i.e. 100",
+			DefaultText: "Unlimited",
+		},
+		&cli.StringFlag{
+			Name:        "daily-deal-size",
+			Category:    "Rate Limits",
+			Usage:       "Max size of deals proposed within any day, i.e. 10TiB",
+			DefaultText: "Unlimited",
+			Value:       "0",
+		},
+		&cli.IntFlag{
+			Name:     "max-rejected-retries",
+			Category: "Deal Proposal",
+			Usage:    "Number of times a piece rejected by the storage provider is proposed again. 0 never proposes it again",
+			Value:    3,
+		},
+		&cli.DurationFlag{
+			Name:     "rejected-retry-delay",
+			Category: "Deal Proposal",
+			Usage:    "Delay before a piece rejected by the storage provider is proposed again",
+			Value:    time.Hour,
+		},
 		&cli.StringSliceFlag{
 			Name:        "allowed-piece-cid",
 			Category:    "Restrictions",
@@ -237,6 +276,12 @@ var CreateCmd = &cli.Command{
 			Notes:                c.String("notes"),
 			MaxPendingDealSize:   c.String("max-pending-deal-size"),
 			MaxPendingDealNumber: c.Int("max-pending-deal-number"),
+			HourlyDealNumber:     c.Int("hourly-deal-number"),
+			HourlyDealSize:       c.String("hourly-deal-size"),
+			DailyDealNumber:      c.Int("daily-deal-number"),
+			DailyDealSize:        c.String("daily-deal-size"),
+			MaxRejectedRetries:   c.Int("max-rejected-retries"),
+			RejectedRetryDelay:   c.Duration("rejected-retry-delay").String(),
 			AllowedPieceCIDs:     allowedPieceCIDs,
 			Force:                c.Bool("force"),
 		}
@@ -0,0 +1,70 @@
+package schedule
+
+import (
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/deal/schedule"
+	"github.com/urfave/cli/v2"
+)
+
+var SimulateCmd = &cli.Command{
+	Name:      "simulate",
+	Usage:     "Show which pieces the schedules would propose to which providers and when, without sending any proposal",
+	ArgsUsage: "[<schedule_id>...]",
+	Description: "Runs the piece selection and the pacing of the schedules against the current state of the database,\n" +
+		"so a new policy can be validated before it is enabled. All active schedules are simulated by default, or the\n" +
+		"given schedules in any state, i.e. paused or pending approval.\n" +
+		"The cron, the schedule, total and max pending deal number and size, and the hourly and daily limits are applied.\n" +
+		"Pending deals are assumed to stay pending, and the budgets, the provider reputation and the retries of rejected\n" +
+		"proposals are not simulated. Use --json to export the proposals.\n\n" +
+		"With --simulate-days, the simulation steps through the given number of days instead of the horizon, to check\n" +
+		"the renewals and expirations before the deals actually age. Each day, the deals that ended expire, the\n" +
+		"replication policies are applied, and the schedules propose the deals of the day. Pending deals and simulated\n" +
+		"proposals are assumed to be accepted and activated right away. Nothing is written to the database.\n\n" +
+		"Example:\n" +
+		"  singularity deal schedule simulate --simulate-days 540",
+	Flags: []cli.Flag{
+		&cli.DurationFlag{
+			Name:  "horizon",
+			Usage: "How far ahead to simulate the deal proposals",
+			Value: schedule.DefaultCalendarHorizon,
+		},
+		&cli.IntFlag{
+			Name:  "simulate-days",
+			Usage: "Number of days to simulate the expirations and renewals of the deals for, as a dry run",
+		},
+		&cli.StringFlag{
+			Name:  "provider",
+			Usage: "Only include the schedules of this storage provider",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		var scheduleIDs []uint32
+		for _, arg := range c.Args().Slice() {
+			scheduleID, err := strconv.ParseUint(arg, 10, 32)
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse schedule ID %s", arg)
+			}
+			scheduleIDs = append(scheduleIDs, uint32(scheduleID))
+		}
+		proposals, err := schedule.Default.SimulateHandler(c.Context, db, schedule.SimulateRequest{
+			Horizon:     c.Duration("horizon").String(),
+			Days:        c.Int("simulate-days"),
+			Provider:    c.String("provider"),
+			ScheduleIDs: scheduleIDs,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, proposals)
+		return nil
+	},
+}
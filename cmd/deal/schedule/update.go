@@ -161,6 +161,36 @@ var UpdateCmd = &cli.Command{
 			Aliases:  []string{"pending-number"},
 			Usage:    "Max pending deal number overall for this request, i.e. 100TiB",
 		},
+		&cli.IntFlag{
+			Name:     "hourly-deal-number",
+			Category: "Rate Limits",
+			Usage:    "Max number of deals proposed within any hour, i.e. 10",
+		},
+		&cli.StringFlag{
+			Name:     "hourly-deal-size",
+			Category: "Rate Limits",
+			Usage:    "Max size of deals proposed within any hour, i.e. 1TiB",
+		},
+		&cli.IntFlag{
+			Name:     "daily-deal-number",
+			Category: "Rate Limits",
+			Usage:    "Max number of deals proposed within any day, i.e. 100",
+		},
+		&cli.StringFlag{
+			Name:     "daily-deal-size",
+			Category: "Rate Limits",
+			Usage:    "Max size of deals proposed within any day, i.e. 10TiB",
+		},
+		&cli.IntFlag{
+			Name:     "max-rejected-retries",
+			Category: "Deal Proposal",
+			Usage:    "Number of times a piece rejected by the storage provider is proposed again. 0 never proposes it again",
+		},
+		&cli.DurationFlag{
+			Name:     "rejected-retry-delay",
+			Category: "Deal Proposal",
+			Usage:    "Delay before a piece rejected by the storage provider is proposed again",
+		},
 		&cli.StringSliceFlag{
 			Name:     "allowed-piece-cid",
 			Category: "Restrictions",
@@ -244,6 +274,24 @@ var UpdateCmd = &cli.Command{
 		if c.IsSet("max-pending-deal-number") {
 			request.MaxPendingDealNumber = ptr.Of(c.Int("max-pending-deal-number"))
 		}
+		if c.IsSet("hourly-deal-number") {
+			request.HourlyDealNumber = ptr.Of(c.Int("hourly-deal-number"))
+		}
+		if c.IsSet("hourly-deal-size") {
+			request.HourlyDealSize = ptr.Of(c.String("hourly-deal-size"))
+		}
+		if c.IsSet("daily-deal-number") {
+			request.DailyDealNumber = ptr.Of(c.Int("daily-deal-number"))
+		}
+		if c.IsSet("daily-deal-size") {
+			request.DailyDealSize = ptr.Of(c.String("daily-deal-size"))
+		}
+		if c.IsSet("max-rejected-retries") {
+			request.MaxRejectedRetries = ptr.Of(c.Int("max-rejected-retries"))
+		}
+		if c.IsSet("rejected-retry-delay") {
+			request.RejectedRetryDelay = ptr.Of(c.Duration("rejected-retry-delay").String())
+		}
 		if c.IsSet("force") {
 			request.Force = ptr.Of(c.Bool("force"))
 		}
@@ -21,13 +21,20 @@ var SendManualCmd = &cli.Command{
   Example: singularity deal send-manual --client f01234 --provider f05678 --piece-cid bagaxxxx --piece-size 32GiB
 Notes:
   * The client address must have been imported to the wallet using 'singularity wallet import'
+  * The proposal is sent directly to the storage provider over libp2p, using the boost deal protocol 1.2.0 or the legacy 1.1.1 one, so the boost CLI is not needed
+  * With --http-url, boost fetches the CAR file from the URL as an online deal, otherwise the deal is offline and the CAR file has to be imported by the storage provider
   * The deal proposal will not be saved in the database however will eventually be tracked if the deal tracker is running
+  * The proposal is refused if the provider already has a proposed, published or active deal for the piece, unless --force is set
   * There is a quick address verification using GLIF API which can be made faster by setting LOTUS_API and LOTUS_TOKEN to your own lotus node`,
 	Flags: []cli.Flag{
 		&cli.BoolFlag{
 			Name:  "save",
 			Usage: "Whether to save the deal proposal to the database for tracking purpose",
 		},
+		&cli.BoolFlag{
+			Name:  "force",
+			Usage: "Send the deal proposal even if the provider already has a deal for the piece",
+		},
 		&cli.StringFlag{
 			Name:     "client",
 			Category: "Deal Proposal",
@@ -161,6 +168,7 @@ Notes:
 			PieceCID:        c.String("piece-cid"),
 			PieceSize:       c.String("piece-size"),
 			FileSize:        c.Uint64("file-size"),
+			Force:           c.Bool("force"),
 		}
 		timeout := c.Duration("timeout")
 		db, closer, err := database.OpenFromCLI(c)
@@ -120,3 +120,55 @@ func TestListDealHandler(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestListReceiptsHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(deal.MockDeal)
+		defer swapDealHandler(mockHandler)()
+		mockHandler.On("ListReceiptsHandler", mock.Anything, mock.Anything, deal.ListReceiptRequest{
+			Preparations: []string{"1"},
+			Pieces:       []string{testutil.TestCid.String()},
+		}).Return([]model.PieceReceipt{
+			{
+				ID:            1,
+				PieceCID:      model.CID(testutil.TestCid),
+				PreparationID: 1,
+				Payload:       []byte(`{"version":1,"pieceCid":"` + testutil.TestCid.String() + `"}`),
+				Signer:        "signer",
+				PublicKey:     []byte("public_key"),
+				Signature:     []byte("signature"),
+			},
+		}, nil)
+		_, _, err := runner.Run(ctx, "singularity deal list-receipts --preparation 1 --piece "+testutil.TestCid.String())
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --json deal list-receipts --preparation 1 --piece "+testutil.TestCid.String())
+		require.NoError(t, err)
+	})
+}
+
+func TestListPieceProvidersHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(deal.MockDeal)
+		defer swapDealHandler(mockHandler)()
+		mockHandler.On("ListPieceProvidersHandler", mock.Anything, mock.Anything, deal.ListPieceProvidersRequest{
+			Preparations: []string{"1"},
+			Pieces:       []string{testutil.TestCid.String()},
+			Providers:    []string{"f01"},
+		}).Return([]deal.PieceProviders{
+			{
+				PieceCID:  testutil.TestCid.String(),
+				Providers: []string{"f01"},
+			},
+		}, nil)
+		_, _, err := runner.Run(ctx, "singularity deal list-piece-providers --preparation 1 --piece "+testutil.TestCid.String()+" --provider f01")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --json deal list-piece-providers --preparation 1 --piece "+testutil.TestCid.String()+" --provider f01")
+		require.NoError(t, err)
+	})
+}
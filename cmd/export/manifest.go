@@ -0,0 +1,134 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/export"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+var ManifestCmd = &cli.Command{
+	Name:  "manifest",
+	Usage: "Export the pieces with their payload CID, size and download URL, i.e. for the offline deal import of boost",
+	Description: "List the pieces of the preparations with their piece CID, payload CID, piece size, CAR size and the URL to\n" +
+		"download the CAR file from, so storage providers can import them, i.e. with the offline deal import of boost, or\n" +
+		"so they can be handed over to data programs like Spade or Slingshot. Aggregated pieces are listed through their\n" +
+		"aggregate.\n\n" +
+		"With --schedule, only the pieces the schedule is allowed to deal are listed, with its storage provider and the\n" +
+		"UUID of the deal it proposed for each piece, and the URL template of the schedule is used unless one is given.\n" +
+		"The provider can then download each CAR file and import it with 'boostd import-data <DealUUID> <file>'.\n\n" +
+		"The CSV has the PieceCID, PayloadCID, PieceSize, CarSize, URL, Provider and DealUUID columns, and the JSON is an\n" +
+		"array of objects with the same fields.\n\n" +
+		"Example:\n" +
+		"  singularity export manifest --preparation my-dataset --url-template 'https://cp.example.com/piece/{PIECE_CID}'\n" +
+		"  singularity export manifest --schedule 1 --format json --output deals.json",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "preparation",
+			Usage: "Only export the pieces of the given preparation id or name",
+		},
+		&cli.UintFlag{
+			Name:  "schedule",
+			Usage: "Only export the pieces of the given schedule id, with the deals it made",
+		},
+		&cli.StringFlag{
+			Name:        "url-template",
+			Usage:       "URL template with PIECE_CID placeholder to download the CAR files, i.e. http://127.0.0.1:7777/piece/{PIECE_CID}",
+			DefaultText: "The URL template of the schedule",
+		},
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "Format of the manifest, one of 'csv' or 'json'",
+			Value: FormatCSV,
+		},
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "Write the manifest to this file. Use '-' to write to stdout",
+			Value: "-",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		format := c.String("format")
+		if format != FormatCSV && format != FormatJSON {
+			return errors.Wrapf(handlererror.ErrInvalidParameter, "invalid format '%s', must be one of 'csv' or 'json'", format)
+		}
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		entries, err := export.Default.ManifestHandler(c.Context, db, export.ManifestRequest{
+			Preparations: c.StringSlice("preparation"),
+			ScheduleID:   uint32(c.Uint("schedule")),
+			URLTemplate:  c.String("url-template"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		write := writeManifestCSV
+		if format == FormatJSON {
+			write = writeManifestJSON
+		}
+		path := c.String("output")
+		if path == "-" {
+			return write(c.App.Writer, entries)
+		}
+		file, err := os.Create(path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create %s", path)
+		}
+		err = write(file, entries)
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+		return errors.WithStack(file.Close())
+	},
+}
+
+var manifestCSVHeader = []string{"PieceCID", "PayloadCID", "PieceSize", "CarSize", "URL", "Provider", "DealUUID"}
+
+// writeManifestCSV writes the manifest entries as CSV with a header row.
+func writeManifestCSV(w io.Writer, entries []export.ManifestEntry) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write(manifestCSVHeader)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, entry := range entries {
+		err = writer.Write([]string{
+			entry.PieceCID,
+			entry.PayloadCID,
+			strconv.FormatInt(entry.PieceSize, 10),
+			strconv.FormatInt(entry.CarSize, 10),
+			entry.URL,
+			entry.Provider,
+			entry.DealUUID,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	writer.Flush()
+	return errors.WithStack(writer.Error())
+}
+
+// writeManifestJSON writes the manifest entries as an indented JSON array.
+func writeManifestJSON(w io.Writer, entries []export.ManifestEntry) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return errors.WithStack(encoder.Encode(entries))
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/export"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func swapExportHandler(mockHandler export.Handler) func() {
+	actual := export.Default
+	export.Default = mockHandler
+	return func() {
+		export.Default = actual
+	}
+}
+
+func TestExportManifest(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(export.MockExport)
+		defer swapExportHandler(mockHandler)()
+		entries := []export.ManifestEntry{{
+			Preparation: "prep",
+			PieceCID:    testutil.TestCid.String(),
+			PayloadCID:  testutil.TestCid.String(),
+			PieceSize:   1 << 20,
+			CarSize:     1000,
+			URL:         "https://cp.example.com/piece/" + testutil.TestCid.String(),
+			Provider:    "f01",
+			DealUUID:    "11111111-2222-3333-4444-555555555555",
+		}}
+		mockHandler.On("ManifestHandler", mock.Anything, mock.Anything, export.ManifestRequest{
+			Preparations: []string{"prep"},
+			ScheduleID:   1,
+			URLTemplate:  "https://cp.example.com/piece/{PIECE_CID}",
+		}).Return(entries, nil)
+		mockHandler.On("ManifestHandler", mock.Anything, mock.Anything, export.ManifestRequest{
+			ScheduleID: 1,
+		}).Return(entries, nil)
+
+		stdout, _, err := runner.Run(ctx, "singularity export manifest --preparation prep --schedule 1 --url-template https://cp.example.com/piece/{PIECE_CID}")
+		require.NoError(t, err)
+		require.Equal(t, "PieceCID,PayloadCID,PieceSize,CarSize,URL,Provider,DealUUID\n"+
+			testutil.TestCid.String()+","+testutil.TestCid.String()+",1048576,1000,https://cp.example.com/piece/"+
+			testutil.TestCid.String()+",f01,11111111-2222-3333-4444-555555555555\n", stdout)
+
+		out := filepath.Join(t.TempDir(), "manifest.json")
+		_, _, err = runner.Run(ctx, "singularity export manifest --schedule 1 --format json --output "+testutil.EscapePath(out))
+		require.NoError(t, err)
+		content, err := os.ReadFile(out)
+		require.NoError(t, err)
+		var exported []export.ManifestEntry
+		require.NoError(t, json.Unmarshal(content, &exported))
+		require.Equal(t, entries, exported)
+
+		_, _, err = runner.Run(ctx, "singularity export manifest --format xml")
+		require.ErrorContains(t, err, "invalid format")
+	})
+}
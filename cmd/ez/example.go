@@ -0,0 +1,394 @@
+package ez
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/admin"
+	"github.com/data-preservation-programs/singularity/handler/dataprep"
+	"github.com/data-preservation-programs/singularity/handler/file"
+	"github.com/data-preservation-programs/singularity/handler/job"
+	"github.com/data-preservation-programs/singularity/handler/storage"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/service/datasetworker"
+	"github.com/data-preservation-programs/singularity/service/epochutil"
+	"github.com/ipfs/go-blockservice"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-merkledag"
+	uio "github.com/ipfs/go-unixfs/io"
+	carblockstore "github.com/ipld/go-car/v2/blockstore"
+	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+)
+
+const (
+	// exampleProvider is the storage provider the deals of the example are made with. It does not exist, the pieces are
+	// served from the local CAR files instead.
+	exampleProvider = "f01000"
+	// exampleClient is the wallet the deals of the example are made from.
+	exampleClient = "f0100"
+	// exampleDealDuration is the duration of the deals of the example, in epochs.
+	exampleDealDuration = 180 * 2880
+)
+
+// exampleFiles are the text files of the sample data, in addition to the random file.
+var exampleFiles = map[string]string{
+	"hello.txt":      "Hello, Singularity!\n",
+	"docs/README.md": "# Example\n\nThis dataset was prepared by `singularity init-example`.\n",
+	"docs/empty.txt": "",
+}
+
+// exampleRandomFile is larger than the default maximum size of the CAR files, so it is split into ranges that are
+// packed into different CAR files.
+const (
+	exampleRandomFile = "images/random.bin"
+	exampleRandomSize = 3 << 20
+)
+
+var ExampleCmd = &cli.Command{
+	Name:      "init-example",
+	Category:  "Utility",
+	ArgsUsage: "[dir]",
+	Usage:     "Run a complete example pipeline from sample data to retrieval",
+	Description: "This command provisions a small demo in a new directory, to be used as a working reference deployment:\n" +
+		"  1. Generate sample data under <dir>/data\n" +
+		"  2. Create a database at <dir>/singularity.db, with the sample data as source storage and <dir>/cars as output storage\n" +
+		"  3. Scan the source, pack it into CAR files and generate the DAG of its directories\n" +
+		"  4. Make an active deal for each piece with the mock storage provider " + exampleProvider + "\n" +
+		"  5. Retrieve every file from the mock storage provider, which serves the pieces from the CAR files, into <dir>/retrieved,\n" +
+		"     and verify it against the sample data\n" +
+		"The directory is kept afterwards, so the database can be explored with the other commands, i.e.\n" +
+		"  singularity --database-connection-string sqlite:<dir>/singularity.db prep list-pieces example\n" +
+		"If the directory is not specified, a new temporary directory is used.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "max-size",
+			Usage: "Maximum size of the CAR files to be created",
+			Value: "1000KiB",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		dir := c.Args().Get(0)
+		var err error
+		if dir == "" {
+			dir, err = os.MkdirTemp("", "singularity-example-")
+			if err != nil {
+				return errors.Wrap(err, "failed to create temporary directory")
+			}
+		}
+		dir, err = filepath.Abs(dir)
+		if err != nil {
+			return errors.Wrap(err, "failed to get absolute path")
+		}
+		databaseFile := filepath.Join(dir, "singularity.db")
+		_, err = os.Stat(databaseFile)
+		if err == nil {
+			return errors.Newf("%s already contains an example", dir)
+		}
+
+		// Step 1, generate the sample data
+		dataDir := filepath.Join(dir, "data")
+		carDir := filepath.Join(dir, "cars")
+		retrievedDir := filepath.Join(dir, "retrieved")
+		err = writeExampleData(dataDir)
+		if err != nil {
+			return err
+		}
+		err = os.MkdirAll(carDir, 0755)
+		if err != nil {
+			return errors.Wrap(err, "failed to create output directory")
+		}
+		printStep(c, "Generated sample data in %s", dataDir)
+
+		// Step 2, create the database, the storages and the preparation
+		db, closer, err := database.OpenWithLogger("sqlite:" + databaseFile)
+		if err != nil {
+			return errors.Wrapf(err, "failed to open database %s", databaseFile)
+		}
+		defer closer.Close()
+
+		err = admin.Default.InitHandler(c.Context, db)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = storage.Default.CreateStorageHandler(c.Context, db, "local", storage.CreateRequest{
+			Name: "data",
+			Path: dataDir,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create source storage")
+		}
+		_, err = storage.Default.CreateStorageHandler(c.Context, db, "local", storage.CreateRequest{
+			Name: "cars",
+			Path: carDir,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create output storage")
+		}
+		_, err = dataprep.Default.CreatePreparationHandler(c.Context, db, dataprep.CreateRequest{
+			Name:           "example",
+			SourceStorages: []string{"data"},
+			OutputStorages: []string{"cars"},
+			MaxSizeStr:     c.String("max-size"),
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create preparation")
+		}
+		printStep(c, "Created preparation 'example' in %s", databaseFile)
+
+		// Step 3, scan, pack and generate the DAG
+		_, err = job.Default.StartScanHandler(c.Context, db, "example", "data")
+		if err != nil {
+			return errors.Wrap(err, "failed to start scan")
+		}
+		err = runWorker(c.Context, db, datasetworker.Config{EnableScan: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to run dataset worker for scanning")
+		}
+		err = runWorker(c.Context, db, datasetworker.Config{EnablePack: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to run dataset worker for packing")
+		}
+		_, err = job.Default.StartDagGenHandler(c.Context, db, "example", "data")
+		if err != nil {
+			return errors.Wrap(err, "failed to start dag gen")
+		}
+		err = runWorker(c.Context, db, datasetworker.Config{EnableDag: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to run dataset worker for dag gen")
+		}
+		var cars []model.Car
+		err = db.WithContext(c.Context).Order("id asc").Find(&cars).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to list pieces")
+		}
+		printStep(c, "Packed the sample data into %d CAR files in %s", len(cars), carDir)
+
+		// Step 4, make a deal for each piece with the mock storage provider
+		deals, err := makeExampleDeals(c.Context, db, cars)
+		if err != nil {
+			return err
+		}
+		printStep(c, "Made %d active deals with the mock storage provider %s", len(deals), exampleProvider)
+
+		// Step 5, retrieve the files from the mock storage provider
+		retriever, err := newCarRetriever(carDir)
+		if err != nil {
+			return err
+		}
+		defer retriever.Close()
+		count, err := retrieveExampleFiles(c.Context, db, retriever, dataDir, retrievedDir)
+		if err != nil {
+			return err
+		}
+		printStep(c, "Retrieved and verified %d files in %s", count, retrievedDir)
+
+		printStep(c, "Explore the example with:\n  singularity --database-connection-string sqlite:%s prep list-pieces example", databaseFile)
+		cliutil.Print(c, deals)
+		return nil
+	},
+}
+
+func printStep(c *cli.Context, format string, args ...any) {
+	_, _ = fmt.Fprintf(c.App.ErrWriter, format+"\n", args...)
+}
+
+func runWorker(ctx context.Context, db *gorm.DB, config datasetworker.Config) error {
+	config.Concurrency = 1
+	config.ExitOnComplete = true
+	config.ExitOnError = true
+	return datasetworker.NewWorker(db, config).Run(ctx)
+}
+
+func writeExampleData(dataDir string) error {
+	random := make([]byte, exampleRandomSize)
+	_, err := rand.Read(random)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	files := map[string][]byte{exampleRandomFile: random}
+	for path, content := range exampleFiles {
+		files[path] = []byte(content)
+	}
+	for path, content := range files {
+		path = filepath.Join(dataDir, filepath.FromSlash(path))
+		err = os.MkdirAll(filepath.Dir(path), 0755)
+		if err != nil {
+			return errors.Wrap(err, "failed to create sample data directory")
+		}
+		err = os.WriteFile(path, content, 0644)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write sample file %s", path)
+		}
+	}
+	return nil
+}
+
+// makeExampleDeals records an active deal with the mock storage provider for each piece that holds file data, as
+// the deal tracker would once the provider has sealed the piece.
+func makeExampleDeals(ctx context.Context, db *gorm.DB, cars []model.Car) ([]model.Deal, error) {
+	db = db.WithContext(ctx)
+	err := db.Create(&model.Wallet{ID: exampleClient, Address: exampleClient}).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create mock wallet")
+	}
+	startEpoch := int32(epochutil.TimeToEpoch(time.Now()))
+	deals := make([]model.Deal, 0, len(cars))
+	for i, car := range cars {
+		dealID := uint64(i + 1)
+		deals = append(deals, model.Deal{
+			DealID:           &dealID,
+			State:            model.DealActive,
+			Provider:         exampleProvider,
+			Label:            car.RootCID.String(),
+			PieceCID:         car.PieceCID,
+			PieceSize:        car.PieceSize,
+			StartEpoch:       startEpoch,
+			EndEpoch:         startEpoch + exampleDealDuration,
+			SectorStartEpoch: startEpoch,
+			Price:            "0",
+			Verified:         true,
+			ClientID:         exampleClient,
+		})
+	}
+	err = db.Create(&deals).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create mock deals")
+	}
+	return deals, nil
+}
+
+// retrieveExampleFiles retrieves each file range from the providers of its deals, and verifies the retrieved files
+// against the sample data.
+func retrieveExampleFiles(
+	ctx context.Context,
+	db *gorm.DB,
+	retriever file.FilecoinRetriever,
+	dataDir string,
+	retrievedDir string,
+) (int, error) {
+	var files []model.File
+	err := db.WithContext(ctx).Order("id asc").Find(&files).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list files")
+	}
+	for _, f := range files {
+		rangeDeals, err := file.Default.GetFileDealsHandler(ctx, db, uint64(f.ID))
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		var retrieved bytes.Buffer
+		for _, rangeDeal := range rangeDeals {
+			providers := make([]string, 0, len(rangeDeal.Deals))
+			for _, deal := range rangeDeal.Deals {
+				providers = append(providers, deal.Provider)
+			}
+			fileRange := rangeDeal.FileRange
+			err = retriever.Retrieve(ctx, cid.Cid(fileRange.CID), 0, fileRange.Length, providers, &retrieved)
+			if err != nil {
+				return 0, errors.Wrapf(err, "failed to retrieve range %d of %s", fileRange.Offset, f.Path)
+			}
+		}
+
+		original, err := os.ReadFile(filepath.Join(dataDir, filepath.FromSlash(f.Path)))
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		if !bytes.Equal(original, retrieved.Bytes()) {
+			return 0, errors.Newf("retrieved file %s does not match the sample data", f.Path)
+		}
+		path := filepath.Join(retrievedDir, filepath.FromSlash(f.Path))
+		err = os.MkdirAll(filepath.Dir(path), 0755)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to create retrieval directory")
+		}
+		err = os.WriteFile(path, retrieved.Bytes(), 0644)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to write retrieved file %s", path)
+		}
+	}
+	return len(files), nil
+}
+
+// carRetriever is a mock storage provider that serves the file ranges from the CAR files of its deals.
+type carRetriever struct {
+	stores []*carblockstore.ReadOnly
+}
+
+func newCarRetriever(carDir string) (*carRetriever, error) {
+	paths, err := filepath.Glob(filepath.Join(carDir, "*.car"))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	r := &carRetriever{}
+	for _, path := range paths {
+		store, err := carblockstore.OpenReadOnly(path)
+		if err != nil {
+			r.Close()
+			return nil, errors.Wrapf(err, "failed to open CAR file %s", path)
+		}
+		r.stores = append(r.stores, store)
+	}
+	return r, nil
+}
+
+func (r *carRetriever) Close() {
+	for _, store := range r.stores {
+		_ = store.Close()
+	}
+}
+
+func (r *carRetriever) Retrieve(ctx context.Context, c cid.Cid, rangeStart int64, rangeEnd int64, sps []string, out io.Writer) error {
+	reader, err := r.RetrieveReader(ctx, c, rangeStart, rangeEnd, sps)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	_, err = io.Copy(out, reader)
+	return errors.WithStack(err)
+}
+
+func (r *carRetriever) RetrieveReader(ctx context.Context, c cid.Cid, rangeStart int64, rangeEnd int64, sps []string) (io.ReadCloser, error) {
+	found := false
+	for _, sp := range sps {
+		found = found || sp == exampleProvider
+	}
+	if !found {
+		return nil, errors.Newf("%s has no deal with the mock storage provider, only with %s", c, strings.Join(sps, ", "))
+	}
+	for _, store := range r.stores {
+		has, err := store.Has(ctx, c)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if !has {
+			continue
+		}
+		// All blocks of a file range are packed into the same CAR file
+		dagServ := merkledag.NewDAGService(blockservice.New(store, nil))
+		node, err := dagServ.Get(ctx, c)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get node for CID %s", c)
+		}
+		reader, err := uio.NewDagReader(ctx, node, dagServ)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read CID %s", c)
+		}
+		_, err = reader.Seek(rangeStart, io.SeekStart)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return io.NopCloser(io.LimitReader(reader, rangeEnd-rangeStart)), nil
+	}
+	return nil, errors.Newf("CID %s is not in any CAR file", c)
+}
@@ -60,3 +60,17 @@ func TestEzPrep(t *testing.T) {
 		})
 	}
 }
+
+func TestInitExample(t *testing.T) {
+	dir := t.TempDir()
+	runner := NewRunner()
+	defer runner.Save(t, dir)
+
+	out, _, err := runner.Run(context.Background(), "singularity init-example "+testutil.EscapePath(dir))
+	require.NoError(t, err)
+	require.Contains(t, out, "f01000")
+	CompareDirectories(t, filepath.Join(dir, "data"), filepath.Join(dir, "retrieved"))
+
+	_, _, err = runner.Run(context.Background(), "singularity init-example "+testutil.EscapePath(dir))
+	require.ErrorContains(t, err, "already contains an example")
+}
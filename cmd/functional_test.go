@@ -8,7 +8,9 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/data-preservation-programs/singularity/handler/dataprep"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
 	"github.com/data-preservation-programs/singularity/storagesystem"
 	"github.com/data-preservation-programs/singularity/util"
 	"github.com/data-preservation-programs/singularity/util/testutil"
@@ -194,6 +196,62 @@ func TestRescan(t *testing.T) {
 	})
 }
 
+// TestPieceEncryption tests that CAR files are encrypted with their own piece key when the preparation has a piece
+// key recipient, and that they can be extracted after being decrypted with the exported wrapped keys.
+func TestPieceEncryption(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		source := t.TempDir()
+		output := t.TempDir()
+		decrypted := t.TempDir()
+		extract := t.TempDir()
+		err := os.WriteFile(filepath.Join(source, "file1.txt"), []byte("hello file1"), 0777)
+		require.NoError(t, err)
+		runner := Runner{mode: Verbose}
+		defer runner.Save(t, source, output, decrypted, extract)
+		identity, recipient, err := encryption.GenerateIdentity()
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, fmt.Sprintf("singularity storage create local --name source --path %s", testutil.EscapePath(source)))
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, fmt.Sprintf("singularity prep create --source source --local-output %s --no-inline --piece-key-recipient %s",
+			testutil.EscapePath(output), recipient))
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, "singularity prep start-scan 1 source")
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, "singularity run dataset-worker --exit-on-complete=true --exit-on-error=true")
+		require.NoError(t, err)
+		exploreRootResult, _, err := runner.Run(ctx, "singularity prep explore 1 source")
+		require.NoError(t, err)
+		rootCID := GetFirstCID(exploreRootResult)
+		_, _, err = runner.Run(ctx, "singularity prep start-daggen 1 source")
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, "singularity run dataset-worker --exit-on-complete=true --exit-on-error=true")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity prep export-piece-keys 1")
+		require.NoError(t, err)
+		escrow, err := dataprep.Default.ExportPieceKeysHandler(ctx, db, "1")
+		require.NoError(t, err)
+		require.Len(t, escrow.Keys, 2)
+
+		// The encrypted CAR files cannot be extracted
+		_, _, err = runner.Run(ctx, fmt.Sprintf("singularity extract-car -i %s -o %s -c %s", testutil.EscapePath(output), testutil.EscapePath(extract), rootCID))
+		require.Error(t, err)
+
+		for _, key := range escrow.Keys {
+			_, _, err = runner.Run(ctx, fmt.Sprintf("singularity decrypt-car -i %s -o %s --wrapped-key %s --identity %s",
+				testutil.EscapePath(filepath.Join(output, key.StoragePath)), testutil.EscapePath(filepath.Join(decrypted, key.StoragePath)),
+				key.WrappedKey, identity))
+			require.NoError(t, err)
+		}
+		_, _, err = runner.Run(ctx, fmt.Sprintf("singularity extract-car -i %s -o %s -c %s", testutil.EscapePath(decrypted), testutil.EscapePath(extract), rootCID))
+		require.NoError(t, err)
+		content, err := os.ReadFile(filepath.Join(extract, "file1.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "hello file1", string(content))
+	})
+}
+
 // TestPrepCreateWithLocalSource tests the following scenario:
 // 1. Create a local source with a few files
 //   - file of different sizes
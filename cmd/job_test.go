@@ -190,3 +190,66 @@ func TestDataPreparationGetStatusHandler(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestDataPreparationGetPackTimingsHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(job.MockJob)
+		defer swapJobHandler(mockHandler)()
+
+		mockHandler.On("GetPackTimingsHandler", mock.Anything, mock.Anything, "1").Return(&job.PackTimingReport{
+			NumOfPieces: 1,
+			Reading:     3 * time.Second,
+			Hashing:     time.Second,
+			Writing:     time.Second,
+			Database:    500 * time.Millisecond,
+			Total:       6 * time.Second,
+			Bound:       "source",
+			Timings: []model.PackTiming{
+				{
+					ID:            1,
+					Reading:       3 * time.Second,
+					Hashing:       time.Second,
+					Writing:       time.Second,
+					Database:      500 * time.Millisecond,
+					Total:         6 * time.Second,
+					NumOfFiles:    10,
+					FileSize:      1 << 20,
+					JobID:         1,
+					CarID:         ptr.Of(model.CarID(1)),
+					PreparationID: 1,
+				},
+			},
+		}, nil)
+		_, _, err := runner.Run(ctx, "singularity prep pack-timing 1")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose prep pack-timing 1")
+		require.NoError(t, err)
+	})
+}
+
+func TestRunPackOneHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(job.MockJob)
+		defer swapJobHandler(mockHandler)()
+
+		mockHandler.On("PackOneHandler", mock.Anything, mock.Anything).Return(&model.Car{
+			ID:        1,
+			CreatedAt: time.Time{},
+			PieceCID:  model.CID(testutil.TestCid),
+			PieceSize: 1 << 21,
+			RootCID:   model.CID(testutil.TestCid),
+			FileSize:  1 << 20,
+			JobID:     ptr.Of(model.JobID(1)),
+		}, nil)
+		_, _, err := runner.Run(ctx, "singularity run pack-one")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose run pack-one")
+		require.NoError(t, err)
+	})
+}
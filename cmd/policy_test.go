@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/deal/schedule"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var testPolicy = model.ReplicationPolicy{
+	ID:         1,
+	Replicas:   3,
+	MaxPerOrg:  1,
+	MinRegions: 2,
+	Providers: model.PolicyProviders{
+		{Provider: "f01000", Org: "acme", Region: "eu-west"},
+		{Provider: "f02000", Org: "globex", Region: "us-east"},
+	},
+	Verified:       true,
+	KeepUnsealed:   true,
+	AnnounceToIPNI: true,
+	StartDelay:     300,
+	Duration:       400,
+	PreparationID:  5,
+}
+
+func TestPolicySetHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(schedule.MockSchedule)
+		defer swapScheduleHandler(mockHandler)()
+		mockHandler.On("SetPolicyHandler", mock.Anything, mock.Anything, mock.Anything, "5", mock.Anything).Return(&testPolicy, nil)
+		_, _, err := runner.Run(ctx, "singularity deal policy set --replicas 3 --max-per-org 1 --min-regions 2 --provider f01000:acme:eu-west --provider f02000:globex:us-east 5")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose deal policy set --replicas 3 --max-per-org 1 --min-regions 2 --provider f01000:acme:eu-west --provider f02000:globex:us-east 5")
+		require.NoError(t, err)
+		request := mockHandler.Calls[0].Arguments.Get(4).(schedule.SetPolicyRequest)
+		require.Equal(t, 3, request.Replicas)
+		require.Equal(t, []string{"f01000:acme:eu-west", "f02000:globex:us-east"}, request.Providers)
+	})
+}
+
+func TestPolicyListHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(schedule.MockSchedule)
+		defer swapScheduleHandler(mockHandler)()
+		mockHandler.On("ListPoliciesHandler", mock.Anything, mock.Anything).Return([]model.ReplicationPolicy{testPolicy}, nil)
+		_, _, err := runner.Run(ctx, "singularity deal policy list")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose deal policy list")
+		require.NoError(t, err)
+	})
+}
+
+func TestPolicyRemoveHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(schedule.MockSchedule)
+		defer swapScheduleHandler(mockHandler)()
+		mockHandler.On("RemovePolicyHandler", mock.Anything, mock.Anything, "5").Return(nil)
+		_, _, err := runner.Run(ctx, "singularity deal policy remove 5")
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,49 @@
+package report
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/report"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/urfave/cli/v2"
+)
+
+var AuditCmd = &cli.Command{
+	Name:  "audit",
+	Usage: "Verify piece indexes, on-chain deals and retrieval of pieces and produce an audit report",
+	Description: "Independently verify every piece of the preparations, so data owners can audit a Singularity deployment:\n" +
+		"  - the piece index must be consistent, i.e. the indexed blocks are contiguous and end at the end of the CAR file;\n" +
+		"  - each published or active deal with a deal ID must be active on chain for the same piece, provider and client;\n" +
+		"  - the piece must be retrievable from each of the probe URLs with a HEAD request to <url>/piece/<piece_cid>.\n" +
+		"The audit only reads from the database and needs no wallet or Lotus token. It is meant to run from a second instance\n" +
+		"with the global --read-only flag, either against the database of the deployment or against a backup restored with\n" +
+		"'singularity admin restore' into a local database.",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "preparation",
+			Usage: "Only audit the given preparation id or name",
+		},
+		&cli.StringSliceFlag{
+			Name:  "probe-url",
+			Usage: "Base URL of an HTTP piece retrieval endpoint to probe, i.e. http://127.0.0.1:7777",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		lotusClient := util.NewLotusClient(c.String("lotus-api"), c.String("lotus-token"))
+		auditReport, err := report.Default.AuditHandler(c.Context, db, lotusClient, report.AuditRequest{
+			Preparations: c.StringSlice("preparation"),
+			ProbeURLs:    c.StringSlice("probe-url"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, *auditReport)
+		return nil
+	},
+}
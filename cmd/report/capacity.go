@@ -0,0 +1,51 @@
+package report
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/report"
+	"github.com/urfave/cli/v2"
+)
+
+var CapacityCmd = &cli.Command{
+	Name:  "capacity",
+	Usage: "Project the completion date, staging disk and datacap needed for each preparation",
+	Description: "Given the pack and deal rates measured over the window and the remaining backlog, project when packing and\n" +
+		"deal making will complete for each preparation, and how much staging disk and datacap are still needed.\n" +
+		"Sizes are in bytes and rates are in bytes per day. Use the global --json flag to feed the report into dashboards.\n" +
+		"A completion date is empty if it cannot be projected because nothing was packed or no deal was made during the window.",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "preparation",
+			Usage: "Only report the given preparation id or name",
+		},
+		&cli.StringFlag{
+			Name:  "window",
+			Usage: "Period over which the current pack and deal rates are measured, i.e. 24h, 168h",
+			Value: "168h",
+		},
+		&cli.IntFlag{
+			Name:        "replicas",
+			Usage:       "Target number of replicas per piece",
+			DefaultText: "Number of providers the preparation is scheduled with, or 1",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		reports, err := report.Default.CapacityHandler(c.Context, db, report.CapacityRequest{
+			Preparations: c.StringSlice("preparation"),
+			Window:       c.String("window"),
+			Replicas:     c.Int("replicas"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, reports)
+		return nil
+	},
+}
@@ -0,0 +1,114 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/report"
+	"github.com/urfave/cli/v2"
+)
+
+var LineageCmd = &cli.Command{
+	Name:  "lineage",
+	Usage: "List every file with the pieces containing it and the deals made for those pieces, i.e. for Fil+ audits",
+	Description: "Trace every file of the preparations to the pieces that contain it, and to the deals made for those pieces\n" +
+		"with their storage providers, clients, on-chain deal IDs and epochs. A file split across several pieces has a\n" +
+		"row for each piece, and a piece with several deals has a row for each deal. Files that are not packed yet and\n" +
+		"pieces without deal have empty piece or deal columns. Deals rejected by the provider are left out.\n" +
+		"Use --csv to export the report as CSV, which is what Fil+ allocators usually ask clients to produce.",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "preparation",
+			Usage: "Only report the given preparation id or name",
+		},
+		&cli.StringFlag{
+			Name:  "csv",
+			Usage: "Write the report as CSV to this file. Use '-' to write to stdout",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		entries, err := report.Default.LineageHandler(c.Context, db, report.LineageRequest{
+			Preparations: c.StringSlice("preparation"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		path := c.String("csv")
+		if path == "" {
+			cliutil.Print(c, entries)
+			return nil
+		}
+		if path == "-" {
+			return writeLineageCSV(c.App.Writer, entries)
+		}
+		file, err := os.Create(path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create %s", path)
+		}
+		err = writeLineageCSV(file, entries)
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+		return errors.WithStack(file.Close())
+	},
+}
+
+var lineageCSVHeader = []string{
+	"preparation_id", "preparation", "file_id", "path", "file_size", "file_cid", "piece_cid", "piece_size",
+	"provider", "client_id", "deal_id", "state", "verified", "start_epoch", "end_epoch", "sector_start_epoch",
+}
+
+// writeLineageCSV writes the lineage entries as CSV with a header row. The piece and deal columns are left empty for
+// the files that are not packed yet and the pieces without deal.
+func writeLineageCSV(w io.Writer, entries []report.LineageEntry) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write(lineageCSVHeader)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, entry := range entries {
+		record := []string{
+			strconv.FormatUint(uint64(entry.PreparationID), 10),
+			entry.Preparation,
+			strconv.FormatUint(uint64(entry.FileID), 10),
+			entry.Path,
+			strconv.FormatInt(entry.FileSize, 10),
+			entry.FileCID,
+			entry.PieceCID,
+			"", "", "", "", "", "", "", "", "",
+		}
+		if entry.PieceCID != "" {
+			record[7] = strconv.FormatInt(entry.PieceSize, 10)
+		}
+		if entry.Provider != "" {
+			record[8] = entry.Provider
+			record[9] = entry.ClientID
+			if entry.DealID != 0 {
+				record[10] = strconv.FormatUint(entry.DealID, 10)
+			}
+			record[11] = string(entry.State)
+			record[12] = strconv.FormatBool(entry.Verified)
+			record[13] = strconv.FormatInt(int64(entry.StartEpoch), 10)
+			record[14] = strconv.FormatInt(int64(entry.EndEpoch), 10)
+			record[15] = strconv.FormatInt(int64(entry.SectorStartEpoch), 10)
+		}
+		err = writer.Write(record)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	writer.Flush()
+	return errors.WithStack(writer.Error())
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/report"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func swapReportHandler(mockHandler report.Handler) func() {
+	actual := report.Default
+	report.Default = mockHandler
+	return func() {
+		report.Default = actual
+	}
+}
+
+func TestReportCapacity(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(report.MockReport)
+		defer swapReportHandler(mockHandler)()
+		completion := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockHandler.On("CapacityHandler", mock.Anything, mock.Anything, report.CapacityRequest{
+			Preparations: []string{"prep"},
+			Window:       "24h",
+			Replicas:     3,
+		}).Return([]report.CapacityReport{{
+			PreparationID:      1,
+			Preparation:        "prep",
+			Replicas:           3,
+			PackedBytes:        1 << 30,
+			RemainingPackBytes: 1 << 30,
+			PackRate:           1 << 29,
+			PackCompletion:     &completion,
+			RemainingDealBytes: 6 << 30,
+			StagingBytes:       2 << 30,
+			DatacapBytes:       6 << 30,
+		}}, nil)
+		_, _, err := runner.Run(ctx, "singularity report capacity --preparation prep --window 24h --replicas 3")
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, "singularity --verbose report capacity --preparation prep --window 24h --replicas 3")
+		require.NoError(t, err)
+	})
+}
+
+func TestReportAudit(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(report.MockReport)
+		defer swapReportHandler(mockHandler)()
+		mockHandler.On("AuditHandler", mock.Anything, mock.Anything, mock.Anything, report.AuditRequest{
+			Preparations: []string{"prep"},
+			ProbeURLs:    []string{"http://127.0.0.1:7777"},
+		}).Return(&report.AuditReport{
+			GeneratedAt:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			TotalPieces:   1,
+			FailedPieces:  1,
+			ProbeFailures: 1,
+			Pieces: []report.PieceAudit{{
+				PreparationID: 1,
+				Preparation:   "prep",
+				PieceCID:      testutil.TestCid.String(),
+				PieceSize:     1 << 20,
+				IndexedBlocks: 2,
+				Deals:         []report.DealAudit{{DealID: 1, Provider: "f01", State: "active", OnChain: true}},
+				Probes:        []report.ProbeAudit{{URL: "http://127.0.0.1:7777/piece/" + testutil.TestCid.String(), Status: 404, Error: "404 Not Found"}},
+			}},
+		}, nil)
+		_, _, err := runner.Run(ctx, "singularity --read-only report audit --preparation prep --probe-url http://127.0.0.1:7777")
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, "singularity --verbose report audit --preparation prep --probe-url http://127.0.0.1:7777")
+		require.NoError(t, err)
+	})
+}
+
+func TestReportLineage(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(report.MockReport)
+		defer swapReportHandler(mockHandler)()
+		mockHandler.On("LineageHandler", mock.Anything, mock.Anything, report.LineageRequest{
+			Preparations: []string{"prep"},
+		}).Return([]report.LineageEntry{{
+			PreparationID:    1,
+			Preparation:      "prep",
+			FileID:           1,
+			Path:             "a/b.txt",
+			FileSize:         100,
+			FileCID:          testutil.TestCid.String(),
+			PieceCID:         testutil.TestCid.String(),
+			PieceSize:        1 << 20,
+			Provider:         "f01",
+			ClientID:         "f0100",
+			DealID:           10,
+			State:            "active",
+			Verified:         true,
+			StartEpoch:       100,
+			EndEpoch:         200,
+			SectorStartEpoch: 110,
+		}, {
+			PreparationID: 1,
+			Preparation:   "prep",
+			FileID:        2,
+			Path:          "a/c.txt",
+			FileSize:      10,
+		}}, nil)
+		_, _, err := runner.Run(ctx, "singularity report lineage --preparation prep")
+		require.NoError(t, err)
+
+		out := filepath.Join(t.TempDir(), "lineage.csv")
+		_, _, err = runner.Run(ctx, "singularity report lineage --preparation prep --csv "+testutil.EscapePath(out))
+		require.NoError(t, err)
+		content, err := os.ReadFile(out)
+		require.NoError(t, err)
+		require.Equal(t, "preparation_id,preparation,file_id,path,file_size,file_cid,piece_cid,piece_size,provider,client_id,deal_id,state,verified,start_epoch,end_epoch,sector_start_epoch\n"+
+			"1,prep,1,a/b.txt,100,"+testutil.TestCid.String()+","+testutil.TestCid.String()+",1048576,f01,f0100,10,active,true,100,200,110\n"+
+			"1,prep,2,a/c.txt,10,,,,,,,,,,,\n", string(content))
+	})
+}
@@ -15,6 +15,16 @@ var (
 				Usage: "Bind address for the API server",
 				Value: ":9090",
 			},
+			&cli.StringFlag{
+				Name:    "backup-root",
+				Usage:   "Directory the database backups requested through the API are written to. The output directory of each backup is relative to it. Backups through the API are disabled if not set",
+				EnvVars: []string{"SINGULARITY_BACKUP_ROOT"},
+			},
+			&cli.BoolFlag{
+				Name:    "enable-database-dump",
+				Usage:   "Allow the database dumps requested through the API, which run pg_dump, psql, mysqldump or mysql on this server",
+				EnvVars: []string{"SINGULARITY_ENABLE_DATABASE_DUMP"},
+			},
 		},
 		Action: api.Run,
 	}
@@ -1,9 +1,13 @@
 package run
 
 import (
+	"time"
+
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/database"
 	"github.com/data-preservation-programs/singularity/service/contentprovider"
+	"github.com/dustin/go-humanize"
+	"github.com/rjNemo/underscore"
 	"github.com/urfave/cli/v2"
 )
 
@@ -17,6 +21,11 @@ var ContentProviderCmd = &cli.Command{
 			Usage:    "Address to bind the HTTP server to",
 			Value:    "127.0.0.1:7777",
 		},
+		&cli.BoolFlag{
+			Category: "HTTP Retrieval",
+			Name:     "require-retrieval-token",
+			Usage:    "Only serve pieces, piece metadata and sub-DAGs to requests with a retrieval token that allows them, given as a bearer token or with the token query parameter. Requires $SINGULARITY_RETRIEVAL_TOKEN_SECRET to be set to the secret the tokens were issued with. Cannot be combined with bitswap retrieval",
+		},
 		&cli.BoolFlag{
 			Category: "HTTP Piece Retrieval",
 			Name:     "enable-http-piece",
@@ -24,12 +33,175 @@ var ContentProviderCmd = &cli.Command{
 			Aliases:  []string{"enable-http"},
 			Value:    true,
 		},
+		&cli.StringFlag{
+			Category: "HTTP Piece Retrieval",
+			Name:     "pending-deals-token",
+			Usage:    "Serve the deals pending import by a storage provider at /deal/pending/<provider> to requests with this bearer token, as used by 'singularity sp import-deals' and 'singularity sync-pieces --provider'. Not served if empty",
+			EnvVars:  []string{"PENDING_DEALS_TOKEN"},
+		},
+		&cli.DurationFlag{
+			Category: "HTTP Piece Retrieval",
+			Name:     "piece-metadata-cache-ttl",
+			Usage:    "How long the block index of a piece is cached after it is loaded or warmed",
+			Value:    contentprovider.DefaultPieceMetadataCacheTTL,
+		},
+		&cli.Uint64Flag{
+			Category: "HTTP Piece Retrieval",
+			Name:     "piece-metadata-cache-capacity",
+			Usage:    "Maximum number of pieces whose block index is cached. The least recently used pieces are evicted first. Use 0 for no limit",
+			Value:    contentprovider.DefaultPieceMetadataCacheCapacity,
+		},
+		&cli.BoolFlag{
+			Category: "HTTP Piece Retrieval",
+			Name:     "verify-blocks",
+			Usage:    "Re-hash each block read from the data source and verify it against its CID, aborting the retrieval if a source file was modified",
+		},
+		&cli.IntFlag{
+			Category: "HTTP Piece Retrieval",
+			Name:     "prefetch-concurrency",
+			Usage:    "Number of upcoming blocks of a piece read concurrently from the data source, so pieces of high-latency data sources such as S3 or HTTP are streamed without waiting for each file to be opened. Use 0 to disable prefetching",
+			Value:    0,
+		},
+		&cli.StringFlag{
+			Category: "HTTP Piece Retrieval",
+			Name:     "prefetch-buffer-size",
+			Usage:    "Maximum total size of the blocks of a piece that are read ahead when prefetching",
+			Value:    "64MiB",
+		},
+		&cli.StringFlag{
+			Category: "HTTP Piece Retrieval",
+			Name:     "max-warm-prefetch",
+			Usage:    "Maximum total number of bytes that one request to /piece/warm may prefetch across all its pieces",
+			Value:    "1GiB",
+		},
+		&cli.StringFlag{
+			Category: "HTTP Piece Retrieval",
+			Name:     "remote-car-mode",
+			Usage:    "How to serve pieces whose CAR file lives in S3. 'open' reads the CAR file through the storage, 'proxy' streams the requested range from a signed link of the CAR file, and 'redirect' redirects the client to the signed link",
+			Value:    contentprovider.RemoteCarModeOpen,
+		},
+		&cli.DurationFlag{
+			Category: "HTTP Piece Retrieval",
+			Name:     "remote-car-link-expiry",
+			Usage:    "How long the signed links of the CAR files are valid",
+			Value:    contentprovider.DefaultRemoteCarLinkExpiry,
+		},
 		&cli.BoolFlag{
 			Category: "HTTP Piece Metadata Retrieval",
 			Name:     "enable-http-piece-metadata",
 			Usage:    "Enable HTTP Piece Metadata, this is to be used with the download server",
 			Value:    true,
 		},
+		&cli.BoolFlag{
+			Category: "HTTP DAG Retrieval",
+			Name:     "enable-http-dag",
+			Usage:    "Enable retrieval of sub-DAGs as CAR files at /ipfs/<cid>[/<path>], selected by a UnixFS path and dag-scope, or by a dag-json encoded IPLD selector",
+			Value:    false,
+		},
+		&cli.BoolFlag{
+			Category: "HTTP Gateway",
+			Name:     "enable-http-gateway",
+			Usage:    "Enable the trustless IPFS gateway at /ipfs/<cid>[/<path>], serving raw blocks, CAR files or UnixFS files and directories, selected by the format query parameter or the Accept header. Supersedes --enable-http-dag, CAR files are served with ?format=car",
+			Value:    false,
+		},
+		&cli.StringFlag{
+			Category: "HTTP Access Log",
+			Name:     "access-log",
+			Usage:    "Path of the access log file. Use '-' to write to stdout. Access log is disabled if not set",
+		},
+		&cli.StringFlag{
+			Category: "HTTP Access Log",
+			Name:     "access-log-format",
+			Usage:    "Format of the access log, one of 'combined' or 'w3c'",
+			Value:    contentprovider.AccessLogFormatCombined,
+		},
+		&cli.StringFlag{
+			Category: "HTTP Access Log",
+			Name:     "access-log-max-size",
+			Usage:    "Rotate the access log file once it reaches this size. Use 0 to disable rotation",
+			Value:    "100MiB",
+		},
+		&cli.IntFlag{
+			Category: "HTTP Access Log",
+			Name:     "access-log-max-backups",
+			Usage:    "Number of rotated access log files to keep",
+			Value:    10,
+		},
+		&cli.BoolFlag{
+			Category: "HTTP Piece Manifest",
+			Name:     "enable-piece-manifest",
+			Usage:    "Serve a signed manifest of all retrievable pieces at " + contentprovider.ManifestPath + ". The manifest is signed with the libp2p identity key",
+			Value:    false,
+		},
+		&cli.StringFlag{
+			Category: "HTTP Piece Manifest",
+			Name:     "public-url",
+			Usage:    "Public URL of the content provider, used to build the retrieval endpoints in the manifest. Relative URLs are used if not set",
+		},
+		&cli.IntFlag{
+			Category: "HTTP Piece Manifest",
+			Name:     "piece-manifest-page-size",
+			Usage:    "Number of pieces in each page of the manifest",
+			Value:    contentprovider.DefaultManifestPageSize,
+		},
+		&cli.DurationFlag{
+			Category: "HTTP Piece Manifest",
+			Name:     "piece-manifest-refresh-interval",
+			Usage:    "How often the manifest is rebuilt from the database",
+			Value:    contentprovider.DefaultManifestRefreshInterval,
+		},
+		&cli.BoolFlag{
+			Category: "HTTP Public Stats",
+			Name:     "enable-public-stats",
+			Usage:    "Serve the stats of the preparations shared with 'singularity prep share-stats' at /stats/<share id>, as JSON or as an HTML page",
+			Value:    false,
+		},
+		&cli.IntFlag{
+			Category: "HTTP Public Stats",
+			Name:     "public-stats-rate-limit",
+			Usage:    "Number of requests to the public stats allowed per minute for each client IP",
+			Value:    contentprovider.DefaultPublicStatsLimit,
+		},
+		&cli.BoolFlag{
+			Category: "HTTP Transport",
+			Name:     "enable-http2",
+			Usage:    "Serve HTTP/2 over cleartext (h2c) in addition to HTTP/1.1, so clients can multiplex their requests over a single connection",
+			Value:    true,
+		},
+		&cli.StringSliceFlag{
+			Category: "HTTP Transport",
+			Name:     "http-compression",
+			Usage:    "Encodings the metadata responses are compressed with, in order of preference, among zstd and gzip. Piece and block content is never compressed. Set to '' to disable compression",
+			Value:    cli.NewStringSlice(contentprovider.EncodingZstd, contentprovider.EncodingGzip),
+		},
+		&cli.DurationFlag{
+			Category: "HTTP Transport",
+			Name:     "tcp-keepalive",
+			Usage:    "Keep-alive period of the TCP connections. Use a negative value to disable keep-alives",
+			Value:    3 * time.Minute,
+		},
+		&cli.StringFlag{
+			Category: "HTTP Transport",
+			Name:     "tcp-read-buffer",
+			Usage:    "Size of the receive buffer of the TCP connections. The OS default is used if empty",
+		},
+		&cli.StringFlag{
+			Category: "HTTP Transport",
+			Name:     "tcp-write-buffer",
+			Usage:    "Size of the send buffer of the TCP connections. A larger buffer keeps more data in flight to distant storage providers. The OS default is used if empty",
+			Value:    "4MiB",
+		},
+		&cli.StringFlag{
+			Category: "Block Cache",
+			Name:     "block-cache-size",
+			Usage:    "Maximum total size of the blocks read from the data sources that are cached, so pieces and blocks retrieved repeatedly are served without reading the data sources again. Use 0 to disable the cache",
+			Value:    "0",
+		},
+		&cli.StringFlag{
+			Category: "Block Cache",
+			Name:     "block-cache-dir",
+			Usage:    "Directory to store the cached blocks in. The blocks are kept in memory if not set",
+		},
 		&cli.BoolFlag{
 			Category: "Bitswap Retrieval",
 			Name:     "enable-bitswap",
@@ -39,7 +211,7 @@ var ContentProviderCmd = &cli.Command{
 		&cli.StringFlag{
 			Category:    "Bitswap Retrieval",
 			Name:        "libp2p-identity-key",
-			Usage:       "The base64 encoded private key for libp2p peer",
+			Usage:       "The base64 encoded private key for libp2p peer, also used to sign the piece manifest and the IPNI advertisements. It can also be a PKCS#11 URI of a secp256k1 key in a hardware security module, from a module allowed with --pkcs11-module",
 			Value:       "",
 			DefaultText: "AutoGenerated",
 		},
@@ -48,6 +220,61 @@ var ContentProviderCmd = &cli.Command{
 			Name:     "libp2p-listen",
 			Usage:    "Addresses to listen on for libp2p connections",
 		},
+		&cli.StringSliceFlag{
+			Category: "Bitswap Retrieval",
+			Name:     "bitswap-announce-url",
+			Usage:    "Delegated routing endpoint to announce the blocks served over bitswap to, so IPFS clients can find them, i.e. https://cid.contact. Blocks are not announced if not set",
+		},
+		&cli.StringFlag{
+			Category: "Bitswap Retrieval",
+			Name:     "bitswap-announce-strategy",
+			Usage:    "Which CIDs to announce, 'all' for all blocks, or 'roots' for the files and directories only",
+			Value:    contentprovider.AnnounceStrategyAll,
+		},
+		&cli.DurationFlag{
+			Category: "Bitswap Retrieval",
+			Name:     "bitswap-announce-interval",
+			Usage:    "How often all CIDs are announced again. The blocks added in the meantime are announced every 10 minutes",
+			Value:    contentprovider.DefaultAnnounceInterval,
+		},
+		&cli.StringSliceFlag{
+			Category: "Bitswap Retrieval",
+			Name:     "libp2p-announce-addr",
+			Usage:    "Public multiaddress of the libp2p host to announce, i.e. /dns4/bitswap.example.com/tcp/4001. The public listen addresses are announced if not set",
+		},
+		&cli.BoolFlag{
+			Category: "IPNI Announcement",
+			Name:     "enable-ipni",
+			Usage:    "Advertise the blocks of every prepared piece to the InterPlanetary Network Indexer, and retract them when the piece is removed. Requires bitswap or the HTTP gateway to be enabled",
+		},
+		&cli.StringSliceFlag{
+			Category: "IPNI Announcement",
+			Name:     "ipni-announce-url",
+			Usage:    "Indexer to announce new advertisements to",
+			Value:    cli.NewStringSlice(contentprovider.DefaultIPNIAnnounceURL),
+		},
+		&cli.StringSliceFlag{
+			Category: "IPNI Announcement",
+			Name:     "ipni-publisher-addr",
+			Usage:    "Public HTTP multiaddress of the content provider that indexers fetch the advertisements from, i.e. /dns4/cp.example.com/tcp/443/https",
+		},
+		&cli.StringSliceFlag{
+			Category: "IPNI Announcement",
+			Name:     "ipni-provider-addr",
+			Usage:    "Multiaddress to retrieve the content from, advertised in every advertisement. The bitswap announce addresses and, if the HTTP gateway is enabled, the publisher addresses are advertised if not set",
+		},
+		&cli.IntFlag{
+			Category: "IPNI Announcement",
+			Name:     "ipni-entries-chunk-size",
+			Usage:    "Number of multihashes in each entry chunk of an advertisement",
+			Value:    contentprovider.DefaultIPNIEntriesChunkSize,
+		},
+		&cli.DurationFlag{
+			Category: "IPNI Announcement",
+			Name:     "ipni-announce-interval",
+			Usage:    "How often the latest advertisement is announced again. New advertisements are published and announced every 10 minutes",
+			Value:    contentprovider.DefaultIPNIAnnounceInterval,
+		},
 	},
 	Action: func(c *cli.Context) error {
 		db, closer, err := database.OpenFromCLI(c)
@@ -56,16 +283,106 @@ var ContentProviderCmd = &cli.Command{
 		}
 		defer closer.Close()
 
+		accessLogMaxSize, err := humanize.ParseBytes(c.String("access-log-max-size"))
+		if err != nil {
+			return errors.Wrapf(err, "invalid access log max size '%s'", c.String("access-log-max-size"))
+		}
+
+		blockCacheSize, err := humanize.ParseBytes(c.String("block-cache-size"))
+		if err != nil {
+			return errors.Wrapf(err, "invalid block cache size '%s'", c.String("block-cache-size"))
+		}
+
+		prefetchBufferSize, err := humanize.ParseBytes(c.String("prefetch-buffer-size"))
+		if err != nil {
+			return errors.Wrapf(err, "invalid prefetch buffer size '%s'", c.String("prefetch-buffer-size"))
+		}
+
+		maxWarmPrefetch, err := humanize.ParseBytes(c.String("max-warm-prefetch"))
+		if err != nil {
+			return errors.Wrapf(err, "invalid max warm prefetch '%s'", c.String("max-warm-prefetch"))
+		}
+
+		var tcpReadBuffer, tcpWriteBuffer uint64
+		if c.String("tcp-read-buffer") != "" {
+			tcpReadBuffer, err = humanize.ParseBytes(c.String("tcp-read-buffer"))
+			if err != nil {
+				return errors.Wrapf(err, "invalid TCP read buffer size '%s'", c.String("tcp-read-buffer"))
+			}
+		}
+		if c.String("tcp-write-buffer") != "" {
+			tcpWriteBuffer, err = humanize.ParseBytes(c.String("tcp-write-buffer"))
+			if err != nil {
+				return errors.Wrapf(err, "invalid TCP write buffer size '%s'", c.String("tcp-write-buffer"))
+			}
+		}
+		compression := underscore.Filter(c.StringSlice("http-compression"), func(encoding string) bool {
+			return encoding != ""
+		})
+
 		config := contentprovider.Config{
 			HTTP: contentprovider.HTTPConfig{
-				EnablePiece:         c.Bool("enable-http-piece"),
-				EnablePieceMetadata: c.Bool("enable-http-piece-metadata"),
-				Bind:                c.String("http-bind"),
+				EnablePiece:           c.Bool("enable-http-piece"),
+				EnablePieceMetadata:   c.Bool("enable-http-piece-metadata"),
+				EnableSubDAG:          c.Bool("enable-http-dag"),
+				EnableGateway:         c.Bool("enable-http-gateway"),
+				Bind:                  c.String("http-bind"),
+				MetadataCacheTTL:      c.Duration("piece-metadata-cache-ttl"),
+				MetadataCacheCapacity: c.Uint64("piece-metadata-cache-capacity"),
+				VerifyBlocks:          c.Bool("verify-blocks"),
+				PrefetchConcurrency:   c.Int("prefetch-concurrency"),
+				PrefetchBufferSize:    int64(prefetchBufferSize),
+				MaxWarmPrefetch:       int64(maxWarmPrefetch),
+				RemoteCarMode:         c.String("remote-car-mode"),
+				RemoteCarLinkExpiry:   c.Duration("remote-car-link-expiry"),
+				RequireToken:          c.Bool("require-retrieval-token"),
+				PendingDealsToken:     c.String("pending-deals-token"),
+				AccessLog: contentprovider.AccessLogConfig{
+					Path:       c.String("access-log"),
+					Format:     c.String("access-log-format"),
+					MaxSize:    int64(accessLogMaxSize),
+					MaxBackups: c.Int("access-log-max-backups"),
+				},
+				Manifest: contentprovider.ManifestConfig{
+					Enable:          c.Bool("enable-piece-manifest"),
+					PublicURL:       c.String("public-url"),
+					PageSize:        c.Int("piece-manifest-page-size"),
+					RefreshInterval: c.Duration("piece-manifest-refresh-interval"),
+				},
+				PublicStats: contentprovider.PublicStatsConfig{
+					Enable:    c.Bool("enable-public-stats"),
+					RateLimit: c.Int("public-stats-rate-limit"),
+				},
+				Transport: contentprovider.TransportConfig{
+					EnableHTTP2: c.Bool("enable-http2"),
+					Compression: compression,
+					KeepAlive:   c.Duration("tcp-keepalive"),
+					ReadBuffer:  int(tcpReadBuffer),
+					WriteBuffer: int(tcpWriteBuffer),
+				},
 			},
 			Bitswap: contentprovider.BitswapConfig{
 				Enable:           c.Bool("enable-bitswap"),
 				IdentityKey:      c.String("libp2p-identity-key"),
 				ListenMultiAddrs: c.StringSlice("libp2p-listen"),
+				Announce: contentprovider.AnnounceConfig{
+					URLs:     c.StringSlice("bitswap-announce-url"),
+					Strategy: c.String("bitswap-announce-strategy"),
+					Interval: c.Duration("bitswap-announce-interval"),
+					Addrs:    c.StringSlice("libp2p-announce-addr"),
+				},
+			},
+			BlockCache: contentprovider.BlockCacheConfig{
+				MaxSize: int64(blockCacheSize),
+				Dir:     c.String("block-cache-dir"),
+			},
+			IPNI: contentprovider.IPNIConfig{
+				Enable:         c.Bool("enable-ipni"),
+				AnnounceURLs:   c.StringSlice("ipni-announce-url"),
+				PublisherAddrs: c.StringSlice("ipni-publisher-addr"),
+				ProviderAddrs:  c.StringSlice("ipni-provider-addr"),
+				ChunkSize:      c.Int("ipni-entries-chunk-size"),
+				Interval:       c.Duration("ipni-announce-interval"),
 			},
 		}
 
@@ -0,0 +1,47 @@
+package run
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/service"
+	"github.com/data-preservation-programs/singularity/service/datasetworker"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
+	"github.com/ipfs/go-log/v2"
+	"github.com/urfave/cli/v2"
+)
+
+var DatasetCoordinatorCmd = &cli.Command{
+	Name:  "dataset-coordinator",
+	Usage: "Start a coordinator for a pool of dataset workers sharing the same database",
+	Description: "The coordinator removes the dataset workers that stopped sending heartbeats, and releases their jobs and the\n" +
+		"jobs that have not made progress for the stuck threshold, so the other workers pick them up. It also seals the\n" +
+		"pack jobs that reached the max batch age of their preparation. Only one coordinator is active at a time, the\n" +
+		"others stand by and take over if it stops. Dataset workers run a coordinator by default, so this is only needed\n" +
+		"when the workers are started with --coordinator=false.",
+	Flags: []cli.Flag{
+		&cli.DurationFlag{
+			Name:  "interval",
+			Usage: "How often to release the jobs of dead and stuck workers and seal the expired pack jobs",
+			Value: 30 * time.Second,
+		},
+		&cli.DurationFlag{
+			Name:  "stuck-threshold",
+			Usage: "How long a job can go without progress before it is considered stuck and released. 0 only releases the jobs of workers that stopped sending heartbeats",
+			Value: healthcheck.DefaultStuckThreshold,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		coordinator := datasetworker.NewCoordinator(db, datasetworker.CoordinatorConfig{
+			Interval:       c.Duration("interval"),
+			StuckThreshold: c.Duration("stuck-threshold"),
+		})
+		return service.StartServers(c.Context, log.Logger("datasetworker"), coordinator)
+	},
+}
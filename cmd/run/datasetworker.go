@@ -6,12 +6,18 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/database"
 	"github.com/data-preservation-programs/singularity/service/datasetworker"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
 	"github.com/urfave/cli/v2"
 )
 
 var DatasetWorkerCmd = &cli.Command{
 	Name:  "dataset-worker",
 	Usage: "Start a dataset preparation worker to process dataset scanning and preparation tasks",
+	Description: "Workers on any number of machines can share the same Postgres or MySQL database. Each worker thread claims the\n" +
+		"next job that is ready, so idle workers pick up the work left, and the jobs of workers that stop are released\n" +
+		"and picked up by the others. The release of these jobs and the sealing of the pack jobs that reached their max\n" +
+		"batch age are done by a coordinator. Each worker runs one by default, of which only one is active at a time. For\n" +
+		"a large pool, run a dedicated 'singularity run dataset-coordinator' and start the workers with --coordinator=false.",
 	Flags: []cli.Flag{
 		&cli.IntFlag{
 			Name:  "concurrency",
@@ -53,6 +59,32 @@ var DatasetWorkerCmd = &cli.Command{
 			Usage: "How often to check for new jobs (maximum)",
 			Value: 160 * time.Second,
 		},
+		&cli.IntFlag{
+			Name:  "nice",
+			Usage: "Niceness of the worker, from -20 (highest priority) to 19 (lowest priority), so that packing yields the CPU to latency-sensitive services on the same host. 0 keeps the current niceness. Only supported on Linux",
+		},
+		&cli.StringFlag{
+			Name:  "io-priority",
+			Usage: "IO scheduling class of the worker as set by ionice, either 'idle' to only use the disk when no other process does, 'best-effort' or 'best-effort:<level>' with levels from 0 (highest priority) to 7 (lowest priority). Empty keeps the current class. Only supported on Linux. For hard limits, run the worker in a cgroup with CPU and IO weights or quotas",
+		},
+		&cli.IntFlag{
+			Name:  "hashing-threads",
+			Usage: "Maximum number of pieces and blocks hashed at once. Reading the source and writing the output storage are not limited. 0 hashes without a limit",
+		},
+		&cli.BoolFlag{
+			Name:  "skip-commp-validation",
+			Usage: "Skip validating the commP of each generated piece before it is recorded. By default, the CAR file is read back from the output storage, or the piece of an inline preparation is regenerated from the source. A piece that fails validation is quarantined and packed once more, and the job only fails if the second attempt also fails validation",
+		},
+		&cli.DurationFlag{
+			Name:  "stuck-threshold",
+			Usage: "How long a job can go without progress before it is considered stuck. Stuck jobs, and the jobs of workers that stopped sending heartbeats, are released and picked up by another worker. 0 only releases the jobs of workers that stopped sending heartbeats",
+			Value: healthcheck.DefaultStuckThreshold,
+		},
+		&cli.BoolFlag{
+			Name:  "coordinator",
+			Usage: "Run a coordinator in this worker, which becomes active if no other coordinator of the pool is. Disable when the pool has a dedicated 'singularity run dataset-coordinator'",
+			Value: true,
+		},
 	},
 	Action: func(c *cli.Context) error {
 		db, closer, err := database.OpenFromCLI(c)
@@ -63,14 +95,20 @@ var DatasetWorkerCmd = &cli.Command{
 		worker := datasetworker.NewWorker(
 			db,
 			datasetworker.Config{
-				Concurrency:    c.Int("concurrency"),
-				EnableScan:     c.Bool("enable-scan"),
-				EnablePack:     c.Bool("enable-pack"),
-				EnableDag:      c.Bool("enable-dag"),
-				ExitOnComplete: c.Bool("exit-on-complete"),
-				ExitOnError:    c.Bool("exit-on-error"),
-				MinInterval:    c.Duration("min-interval"),
-				MaxInterval:    c.Duration("max-interval"),
+				Concurrency:         c.Int("concurrency"),
+				EnableScan:          c.Bool("enable-scan"),
+				EnablePack:          c.Bool("enable-pack"),
+				EnableDag:           c.Bool("enable-dag"),
+				ExitOnComplete:      c.Bool("exit-on-complete"),
+				ExitOnError:         c.Bool("exit-on-error"),
+				MinInterval:         c.Duration("min-interval"),
+				MaxInterval:         c.Duration("max-interval"),
+				Nice:                c.Int("nice"),
+				IOPriority:          c.String("io-priority"),
+				HashingThreads:      c.Int("hashing-threads"),
+				SkipCommPValidation: c.Bool("skip-commp-validation"),
+				StuckThreshold:      c.Duration("stuck-threshold"),
+				DisableCoordinator:  !c.Bool("coordinator"),
 			})
 		err = worker.Run(c.Context)
 		if err != nil {
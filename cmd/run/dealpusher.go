@@ -25,6 +25,19 @@ var DealPusherCmd = &cli.Command{
 			Aliases:     []string{"M"},
 			DefaultText: "Unlimited",
 		},
+		&cli.BoolFlag{
+			Name:  "active-replicas-only",
+			Usage: "Only count the deals active on chain, as reconciled by the deal tracker, towards the max replication factor, so pieces whose deals were slashed, expired or never got sealed are replicated again. Pieces with deals still pending can be proposed to more providers",
+		},
+		&cli.Float64Flag{
+			Name:        "min-retrieval-success-rate",
+			Usage:       "Stop making deals with providers whose retrieval success rate, as ingested by the deal tracker or proven by the retrieval sampler, is below this ratio, i.e. 0.5",
+			DefaultText: "Disabled",
+		},
+		&cli.StringFlag{
+			Name:  "budget-alert-webhook",
+			Usage: "URL that budget alerts are posted to as JSON once 80% of a datacap or FIL budget is consumed. Alerts are always logged",
+		},
 	},
 	Action: func(c *cli.Context) error {
 		db, closer, err := database.OpenFromCLI(c)
@@ -39,7 +52,8 @@ var DealPusherCmd = &cli.Command{
 			return errors.WithStack(err)
 		}
 
-		dm, err := dealpusher.NewDealPusher(db, c.String("lotus-api"), c.String("lotus-token"), c.Uint("deal-attempts"), c.Uint("max-replication-factor"))
+		dm, err := dealpusher.NewDealPusher(db, c.String("lotus-api"), c.String("lotus-token"), c.Uint("deal-attempts"), c.Uint("max-replication-factor"),
+			c.Bool("active-replicas-only"), c.Float64("min-retrieval-success-rate"), c.String("budget-alert-webhook"))
 		if err != nil {
 			return errors.WithStack(err)
 		}
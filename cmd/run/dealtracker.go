@@ -8,12 +8,23 @@ import (
 	"github.com/data-preservation-programs/singularity/service"
 	"github.com/data-preservation-programs/singularity/service/dealtracker"
 	"github.com/data-preservation-programs/singularity/service/epochutil"
+	"github.com/data-preservation-programs/singularity/signer"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/urfave/cli/v2"
 )
 
 var DealTrackerCmd = &cli.Command{
-	Name:  "deal-tracker",
-	Usage: "Start a deal tracker that tracks the deal for all relevant wallets",
+	Name:    "deal-tracker",
+	Aliases: []string{"dealtracker"},
+	Usage:   "Start a deal tracker that tracks the deal for all relevant wallets",
+	Description: "The deal tracker polls the market deals of the chain, from the Lotus API or a ZST compressed snapshot of\n" +
+		"the state market deals, and reconciles the state of the deals of all wallets, so 'deal list' reflects the\n" +
+		"chain status of each deal: published, active, slashed, expired or proposal_expired. Deals made outside of\n" +
+		"Singularity are also imported. With --active-replicas-only, the deal pusher only counts the active deals as\n" +
+		"replicas. The balance and the datacap of the wallets are also tracked, so 'wallet list' shows them and the\n" +
+		"deal pusher stops proposing verified deals from a wallet once its datacap is below the piece size.\n" +
+		"The region, the ask price and the retrieval success rate of the storage providers of the provider directory\n" +
+		"are also ingested, so the replication policies with --auto-select can choose providers from them.",
 	Flags: []cli.Flag{
 		&cli.StringFlag{
 			Name:    "market-deal-url",
@@ -33,6 +44,23 @@ var DealTrackerCmd = &cli.Command{
 			Usage: "Run once and exit",
 			Value: false,
 		},
+		&cli.StringFlag{
+			Name:    "retrieval-stats-url",
+			Usage:   "The URL for the Spark retrieval success rate summary of storage providers, i.e. " + dealtracker.SparkRetrievalStatsURL + ". The retrieval stats are not ingested if not set.",
+			EnvVars: []string{"RETRIEVAL_STATS_URL"},
+		},
+		&cli.StringFlag{
+			Name:    "provider-directory-url",
+			Usage:   "The URL of a filrep.io compatible list of storage providers, to choose providers for the replication policies from. Set to empty to disable.",
+			EnvVars: []string{"PROVIDER_DIRECTORY_URL"},
+			Value:   dealtracker.DefaultProviderDirectoryURL,
+		},
+		&cli.StringFlag{
+			Name: "receipt-key",
+			Usage: "The key to sign the piece receipts with, either a base64 encoded libp2p private key or a PKCS#11 URI such as " +
+				"pkcs11:token=receipts;object=receipt, from a module allowed with --pkcs11-module. If not set, a key is generated and stored in the database.",
+			EnvVars: []string{"RECEIPT_KEY"},
+		},
 	},
 	Action: func(c *cli.Context) error {
 		db, closer, err := database.OpenFromCLI(c)
@@ -48,12 +76,28 @@ var DealTrackerCmd = &cli.Command{
 			return errors.WithStack(err)
 		}
 
+		var directory dealtracker.ProviderDirectory
+		if c.String("provider-directory-url") != "" {
+			directory = dealtracker.NewFilRep(c.String("provider-directory-url"))
+		}
+
+		var receiptKey crypto.PrivKey
+		if c.String("receipt-key") != "" {
+			receiptKey, err = signer.NewPrivKey(c.String("receipt-key"))
+			if err != nil {
+				return errors.Wrap(err, "failed to load receipt key")
+			}
+		}
+
 		tracker := dealtracker.NewDealTracker(db,
 			c.Duration("interval"),
 			c.String("market-deal-url"),
 			c.String("lotus-api"),
 			c.String("lotus-token"),
 			c.Bool("once"),
+			c.String("retrieval-stats-url"),
+			directory,
+			receiptKey,
 		)
 
 		return service.StartServers(c.Context, dealtracker.Logger, &tracker)
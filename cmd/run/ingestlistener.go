@@ -0,0 +1,111 @@
+package run
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/service"
+	"github.com/data-preservation-programs/singularity/service/ingestlistener"
+	"github.com/urfave/cli/v2"
+)
+
+var IngestListenerCmd = &cli.Command{
+	Name:  "ingest-listener",
+	Usage: "Start a listener that appends objects to a source as object created events arrive from Kafka, SQS or Pub/Sub",
+	Description: "Consume object created events and append the created objects to a source of a preparation, queuing them for\n" +
+		"packing without rescanning the source. Exactly one of --sqs-queue-url, --pubsub-subscription or --kafka-rest-proxy\n" +
+		"must be set. The following events are supported:\n" +
+		"  - S3 event notifications, also when delivered through SNS, and MinIO bucket notifications;\n" +
+		"  - Google Cloud Storage Pub/Sub notifications;\n" +
+		"  - custom events with a path relative to the source, i.e. {\"path\": \"dir/file.txt\"}.\n" +
+		"Objects of S3 and GCS events are appended if they are under the path of the source, i.e. bucket/prefix.\n" +
+		"Messages are acknowledged once the objects are appended. The listener exits if appending fails, so the messages\n" +
+		"are delivered again when it is restarted.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "preparation",
+			Usage:    "The preparation ID or name to append the objects to",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "source",
+			Usage:    "The source storage ID or name to append the objects to",
+			Required: true,
+		},
+		&cli.BoolFlag{
+			Name:  "finalize",
+			Usage: "Queue the appended objects for packing right away, even if the pack job is not full yet",
+		},
+		&cli.StringFlag{
+			Name:     "sqs-queue-url",
+			Category: "Amazon SQS",
+			Usage:    "URL of the SQS queue, i.e. https://sqs.us-east-1.amazonaws.com/123456789012/queue. AWS credentials are loaded from the environment or the shared configuration",
+			EnvVars:  []string{"SQS_QUEUE_URL"},
+		},
+		&cli.StringFlag{
+			Name:     "pubsub-subscription",
+			Category: "Google Cloud Pub/Sub",
+			Usage:    "Pub/Sub subscription, i.e. projects/project/subscriptions/subscription. The application default credentials are used",
+			EnvVars:  []string{"PUBSUB_SUBSCRIPTION"},
+		},
+		&cli.StringFlag{
+			Name:     "kafka-rest-proxy",
+			Category: "Kafka",
+			Usage:    "URL of the Kafka REST proxy, i.e. http://127.0.0.1:8082",
+			EnvVars:  []string{"KAFKA_REST_PROXY"},
+		},
+		&cli.StringFlag{
+			Name:     "kafka-topic",
+			Category: "Kafka",
+			Usage:    "Kafka topic to consume",
+			EnvVars:  []string{"KAFKA_TOPIC"},
+		},
+		&cli.StringFlag{
+			Name:     "kafka-group",
+			Category: "Kafka",
+			Usage:    "Kafka consumer group",
+			EnvVars:  []string{"KAFKA_GROUP"},
+			Value:    "singularity",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var consumer ingestlistener.Consumer
+		var err error
+		var configured int
+		for _, name := range []string{"sqs-queue-url", "pubsub-subscription", "kafka-rest-proxy"} {
+			if c.String(name) != "" {
+				configured++
+			}
+		}
+		if configured != 1 {
+			return errors.New("exactly one of --sqs-queue-url, --pubsub-subscription or --kafka-rest-proxy is required")
+		}
+		switch {
+		case c.String("sqs-queue-url") != "":
+			consumer, err = ingestlistener.NewSQSConsumer(c.String("sqs-queue-url"))
+		case c.String("pubsub-subscription") != "":
+			consumer, err = ingestlistener.NewPubSubConsumer(c.Context, c.String("pubsub-subscription"))
+		default:
+			if c.String("kafka-topic") == "" {
+				return errors.New("--kafka-topic is required with --kafka-rest-proxy")
+			}
+			consumer, err = ingestlistener.NewKafkaConsumer(c.Context, c.String("kafka-rest-proxy"), c.String("kafka-group"), c.String("kafka-topic"))
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			_ = consumer.Close()
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		listener := ingestlistener.NewIngestListener(db, consumer,
+			c.String("preparation"),
+			c.String("source"),
+			c.Bool("finalize"),
+		)
+		return service.StartServers(c.Context, ingestlistener.Logger, listener)
+	},
+}
@@ -0,0 +1,31 @@
+package run
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/job"
+	"github.com/urfave/cli/v2"
+)
+
+var PackOneCmd = &cli.Command{
+	Name:  "pack-one",
+	Usage: "Claim, pack and release exactly one pack job that is ready to be packed, then exit",
+	Description: "This is designed for serverless or batch environments, i.e. AWS Batch or AWS Lambda, where many short-lived workers fan out horizontally.\n" +
+		"The claimed job is leased to an ephemeral worker that sends heartbeats while packing. If the worker dies, the job is released back to the queue once the worker becomes stale.\n" +
+		"The jobs of paused preparations, and of storages that need to be re-authenticated, are skipped, like the dataset workers do.\n" +
+		"If there is no pack job ready to be packed, the command exits with an error.",
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		car, err := job.Default.PackOneHandler(c.Context, db)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, car)
+		return nil
+	},
+}
@@ -0,0 +1,89 @@
+package run
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/replication"
+	"github.com/data-preservation-programs/singularity/retriever"
+	"github.com/data-preservation-programs/singularity/retriever/endpointfinder"
+	"github.com/data-preservation-programs/singularity/service"
+	"github.com/data-preservation-programs/singularity/service/retrievalsampler"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/filecoin-project/lassie/pkg/lassie"
+	"github.com/urfave/cli/v2"
+)
+
+var RetrievalSamplerCmd = &cli.Command{
+	Name:  "retrieval-sampler",
+	Usage: "Start a retrieval sampler that proves the active deals are retrievable from their storage providers",
+	Description: "The retrieval sampler periodically retrieves a random block of the active deals from their storage\n" +
+		"providers with a trustless HTTP retrieval, verifies it against the CID stored when the piece was packed, and\n" +
+		"records the outcome as a retrieval proof of the deal. The deals sampled the longest time ago are sampled\n" +
+		"first. The proof success rate of each provider over the last week is stored in its reputation, so the deal\n" +
+		"pusher started with --min-retrieval-success-rate stops making deals with providers that fail the proofs.\n" +
+		"Deals that fail 3 proofs in a row are not counted as replicas by the replication policies, so their pieces\n" +
+		"are replicated to another provider.",
+	Flags: []cli.Flag{
+		&cli.DurationFlag{
+			Name:    "interval",
+			Usage:   "How often to sample the deals",
+			Aliases: []string{"i"},
+			Value:   time.Hour,
+		},
+		&cli.IntFlag{
+			Name:  "sample-size",
+			Usage: "Number of deals sampled each time",
+			Value: 10,
+		},
+		&cli.DurationFlag{
+			Name:  "timeout",
+			Usage: "How long to wait for the retrieval of a block before the proof fails",
+			Value: time.Minute,
+		},
+		&cli.BoolFlag{
+			Name:  "once",
+			Usage: "Run once and exit",
+			Value: false,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		h, err := util.InitHost(nil)
+		if err != nil {
+			return errors.Wrap(err, "failed to init host")
+		}
+		defer h.Close()
+		fetcher, err := lassie.NewLassie(c.Context, lassie.WithHost(h))
+		if err != nil {
+			return errors.Wrap(err, "failed to init lassie")
+		}
+		infoFetcher := replication.MinerInfoFetcher{
+			Client: util.NewLotusClient(c.String("lotus-api"), c.String("lotus-token")),
+		}
+		endpointFinder := endpointfinder.NewEndpointFinder(
+			infoFetcher,
+			h,
+			endpointfinder.WithLruSize(128),
+			endpointfinder.WithLruTimeout(time.Hour*2),
+			endpointfinder.WithErrorLruSize(128),
+			endpointfinder.WithErrorLruTimeout(time.Minute*5),
+		)
+
+		sampler := retrievalsampler.NewRetrievalSampler(db,
+			retriever.NewRetriever(fetcher, endpointFinder),
+			c.Duration("interval"),
+			c.Int("sample-size"),
+			c.Duration("timeout"),
+			c.Bool("once"),
+		)
+
+		return service.StartServers(c.Context, retrievalsampler.Logger, &sampler)
+	},
+}
@@ -76,6 +76,15 @@ func TestRunDealPusher(t *testing.T) {
 	})
 }
 
+func TestRunRetrievalSampler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		_, _, err := NewRunner().Run(ctx, "singularity run retrieval-sampler")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
 func TestRunDownloadServer(t *testing.T) {
 	ctx := context.Background()
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/data-preservation-programs/singularity/handler/deal/schedule"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/operator"
 	"github.com/data-preservation-programs/singularity/util/testutil"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -81,6 +82,22 @@ func TestScheduleResumeHandler(t *testing.T) {
 	})
 }
 
+func TestScheduleApproveHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(schedule.MockSchedule)
+		defer swapScheduleHandler(mockHandler)()
+		withKey := mock.MatchedBy(func(ctx context.Context) bool { return operator.KeyFromContext(ctx) == "key" })
+		mockHandler.On("ApproveHandler", withKey, mock.Anything, uint32(1)).Return(&testSchedule, nil)
+		_, _, err := runner.Run(ctx, "singularity --api-key key deal schedule approve 1")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose --api-key key deal schedule approve 1")
+		require.NoError(t, err)
+	})
+}
+
 func TestScheduleListHandler(t *testing.T) {
 	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 		runner := NewRunner()
@@ -96,6 +113,69 @@ func TestScheduleListHandler(t *testing.T) {
 	})
 }
 
+func TestScheduleCalendarHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(schedule.MockSchedule)
+		defer swapScheduleHandler(mockHandler)()
+		mockHandler.On("CalendarHandler", mock.Anything, mock.Anything, schedule.CalendarRequest{
+			Interval: "week",
+			Horizon:  "2160h0m0s",
+			Provider: "provider",
+		}).Return([]schedule.CalendarEntry{{
+			Period:      time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC),
+			Provider:    "provider",
+			DealNumber:  10,
+			DealSize:    10 << 35,
+			ScheduleIDs: []model.ScheduleID{1},
+		}}, nil)
+		_, _, err := runner.Run(ctx, "singularity deal schedule calendar --interval week --horizon 2160h --provider provider")
+		require.NoError(t, err)
+	})
+}
+
+func TestScheduleSimulateHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(schedule.MockSchedule)
+		defer swapScheduleHandler(mockHandler)()
+		mockHandler.On("SimulateHandler", mock.Anything, mock.Anything, schedule.SimulateRequest{
+			Horizon:     "48h0m0s",
+			Provider:    "provider",
+			ScheduleIDs: []uint32{1, 2},
+		}).Return([]schedule.SimulatedProposal{{
+			Time:       time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC),
+			ScheduleID: 1,
+			Provider:   "provider",
+			PieceCID:   model.CID(testutil.TestCid),
+			PieceSize:  1 << 35,
+			Verified:   true,
+		}}, nil)
+		_, _, err := runner.Run(ctx, "singularity deal schedule simulate --horizon 48h --provider provider 1 2")
+		require.NoError(t, err)
+
+		mockHandler.On("SimulateHandler", mock.Anything, mock.Anything, schedule.SimulateRequest{
+			Horizon: "720h0m0s",
+			Days:    540,
+		}).Return([]schedule.SimulatedProposal{{
+			Time:       time.Date(2025, 11, 30, 12, 0, 0, 0, time.UTC),
+			Event:      schedule.SimulatedRenew,
+			ScheduleID: 1,
+			Provider:   "provider",
+			PieceCID:   model.CID(testutil.TestCid),
+			PieceSize:  1 << 35,
+			Verified:   true,
+		}}, nil)
+		_, _, err = runner.Run(ctx, "singularity deal schedule simulate --simulate-days 540")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity deal schedule simulate invalid")
+		require.Error(t, err)
+	})
+}
+
 func TestScheduleRemoveHandler(t *testing.T) {
 	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 		runner := NewRunner()
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/version"
+	"github.com/urfave/cli/v2"
+)
+
+var SelfUpdateCmd = &cli.Command{
+	Name:  "self-update",
+	Usage: "Upgrade singularity in place to the latest release",
+	Description: "Download the latest release for the current platform, verify the signature of the release checksums " +
+		"and the checksum of the release archive, and replace the running executable.\n" +
+		"After upgrading to a new minor version, run \"singularity admin init\" to upgrade the database schema.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "public-key",
+			Usage:   "Base64 encoded ed25519 public key that signs the release checksums. Defaults to the key embedded at build time",
+			EnvVars: []string{"SINGULARITY_RELEASE_PUBLIC_KEY"},
+		},
+		&cli.BoolFlag{
+			Name:  "force",
+			Usage: "Reinstall the latest release even if it is not newer than the running version",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		publicKey := c.String("public-key")
+		if publicKey == "" {
+			publicKey = version.ReleasePublicKey
+		}
+		if publicKey == "" {
+			return version.ErrNoPublicKey
+		}
+
+		release, err := version.LatestRelease(c.Context)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !release.IsNewer() && !c.Bool("force") {
+			_, err = fmt.Fprintf(c.App.Writer, "singularity is up to date with the latest release %s\n", release.TagName)
+			return errors.WithStack(err)
+		}
+
+		executable, err := os.Executable()
+		if err != nil {
+			return errors.Wrap(err, "failed to locate the running executable")
+		}
+		executable, err = filepath.EvalSymlinks(executable)
+		if err != nil {
+			return errors.Wrap(err, "failed to locate the running executable")
+		}
+
+		err = version.Update(c.Context, *release, publicKey, executable)
+		if err != nil {
+			return errors.Wrap(err, "failed to upgrade singularity")
+		}
+		_, err = fmt.Fprintf(c.App.Writer, "singularity has been upgraded from %s to %s\n", version.Version, release.TagName)
+		return errors.WithStack(err)
+	},
+}
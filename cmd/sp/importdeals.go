@@ -0,0 +1,73 @@
+package sp
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/handler/sp"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/urfave/cli/v2"
+)
+
+var ImportDealsCmd = &cli.Command{
+	Name:  "import-deals",
+	Usage: "Download the pieces of pending offline deals from a content provider and import them with boost",
+	Description: "Run next to the boost of a storage provider. For each deal proposed to the provider that is pending import,\n" +
+		"as listed by the content provider, and that boost is waiting for data of:\n" +
+		"  1. download the piece from the content provider, resuming partial downloads left by a previous run;\n" +
+		"  2. verify the commP of the CAR file against the piece CID of the deal;\n" +
+		"  3. trigger the import of the CAR file with boost.\n" +
+		"Deals that boost does not know as offline deals waiting for data are skipped. The CAR files must be downloaded to\n" +
+		"a directory that boost can read from.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "boost-api",
+			Usage:    "Boost RPC API endpoint, i.e. http://127.0.0.1:1288/rpc/v0",
+			EnvVars:  []string{"BOOST_API"},
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:    "boost-token",
+			Usage:   "Boost RPC API token with admin permission, as printed by 'boostd auth create-token --perm admin'",
+			EnvVars: []string{"BOOST_API_TOKEN"},
+		},
+		&cli.StringFlag{
+			Name:     "provider",
+			Usage:    "Storage provider ID the deals are proposed to, i.e. f01234",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "content-provider",
+			Usage: "URL of the content provider serving the pieces",
+			Value: "http://127.0.0.1:7777",
+		},
+		&cli.StringFlag{
+			Name:    "content-provider-token",
+			Usage:   "Token the content provider requires to list the pending deals, as set with its --pending-deals-token",
+			EnvVars: []string{"PENDING_DEALS_TOKEN"},
+		},
+		&cli.StringFlag{
+			Name:  "download-dir",
+			Usage: "Directory to download the CAR files to",
+			Value: ".",
+		},
+		&cli.BoolFlag{
+			Name:  "delete-after-import",
+			Usage: "Let boost delete the CAR files once the pieces are added to a sector",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		boostClient := util.NewLotusClient(c.String("boost-api"), c.String("boost-token"))
+		results, err := sp.Default.ImportDealsHandler(c.Context, boostClient, sp.ImportDealsRequest{
+			Provider:             c.String("provider"),
+			ContentProvider:      c.String("content-provider"),
+			ContentProviderToken: c.String("content-provider-token"),
+			DownloadDir:          c.String("download-dir"),
+			DeleteAfterImport:    c.Bool("delete-after-import"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, results)
+		return nil
+	},
+}
@@ -0,0 +1,54 @@
+package sp
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/handler/sp"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/urfave/cli/v2"
+	"github.com/ybbus/jsonrpc/v3"
+)
+
+var ImportMediaCmd = &cli.Command{
+	Name:      "import-media",
+	Usage:     "Verify the pieces delivered on removable media and import them with boost",
+	ArgsUsage: "<dir>",
+	Description: "Read the manifest.json written by 'singularity sync-pieces' in the directory, and for each piece:\n" +
+		"  1. verify the size, the SHA-256 checksum and the commP of the CAR file;\n" +
+		"  2. trigger the import of the CAR file with boost for each deal of the piece that boost is waiting for data of.\n" +
+		"Without --boost-api, the pieces are only verified. The directory must be readable by boost.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "boost-api",
+			Usage:   "Boost RPC API endpoint, i.e. http://127.0.0.1:1288/rpc/v0. Pieces are only verified if not set",
+			EnvVars: []string{"BOOST_API"},
+		},
+		&cli.StringFlag{
+			Name:    "boost-token",
+			Usage:   "Boost RPC API token with admin permission, as printed by 'boostd auth create-token --perm admin'",
+			EnvVars: []string{"BOOST_API_TOKEN"},
+		},
+		&cli.BoolFlag{
+			Name:  "delete-after-import",
+			Usage: "Let boost delete the CAR files once the pieces are added to a sector",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if c.NArg() != 1 {
+			return errors.WithStack(cliutil.ErrIncorrectNArgs)
+		}
+		var boostClient jsonrpc.RPCClient
+		if c.String("boost-api") != "" {
+			boostClient = util.NewLotusClient(c.String("boost-api"), c.String("boost-token"))
+		}
+		results, err := sp.Default.ImportMediaHandler(c.Context, boostClient, sp.ImportMediaRequest{
+			Dir:               c.Args().Get(0),
+			DeleteAfterImport: c.Bool("delete-after-import"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, results)
+		return nil
+	},
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/handler/sp"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func swapSPHandler(mockHandler sp.Handler) func() {
+	actual := sp.Default
+	sp.Default = mockHandler
+	return func() {
+		sp.Default = actual
+	}
+}
+
+var testImportResults = []sp.ImportResult{
+	{
+		DealUUID: "2f2d0e7a-7c4e-4c1a-9d0e-1b7c0a3b9e11",
+		PieceCID: "baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq",
+		FilePath: "/tmp/import/baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq.car",
+		Status:   sp.ImportStatusImported,
+	},
+	{
+		DealUUID: "bafylegacy",
+		PieceCID: "baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq",
+		Status:   sp.ImportStatusSkipped,
+		Error:    "not a boost deal",
+	},
+}
+
+func TestSPImportDeals(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(sp.MockSP)
+		defer swapSPHandler(mockHandler)()
+
+		mockHandler.On("ImportDealsHandler", mock.Anything, mock.Anything, sp.ImportDealsRequest{
+			Provider:             "f01234",
+			ContentProvider:      "http://127.0.0.1:7777",
+			ContentProviderToken: "token",
+			DownloadDir:          "/tmp/import",
+			DeleteAfterImport:    true,
+		}).Return(testImportResults, nil)
+		_, _, err := runner.Run(ctx, "singularity sp import-deals --boost-api http://127.0.0.1:1288/rpc/v0 --provider f01234 "+
+			"--content-provider-token token --download-dir /tmp/import --delete-after-import")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose sp import-deals --boost-api http://127.0.0.1:1288/rpc/v0 --provider f01234 "+
+			"--content-provider-token token --download-dir /tmp/import --delete-after-import")
+		require.NoError(t, err)
+	})
+}
+
+func TestSPImportMedia(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(sp.MockSP)
+		defer swapSPHandler(mockHandler)()
+
+		mockHandler.On("ImportMediaHandler", mock.Anything, nil, sp.ImportMediaRequest{Dir: "/media/disk"}).
+			Return(testImportResults, nil)
+		_, _, err := runner.Run(ctx, "singularity sp import-media /media/disk")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose sp import-media /media/disk")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity sp import-media")
+		require.ErrorIs(t, err, cliutil.ErrIncorrectNArgs)
+	})
+}
+
+func TestSyncPieces(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(sp.MockSP)
+		defer swapSPHandler(mockHandler)()
+
+		manifest := &sp.MediaManifest{
+			Version:  sp.MediaManifestVersion,
+			Provider: "f01234",
+			Pieces: []sp.MediaPiece{{
+				PieceCID:  "baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq",
+				PieceSize: 2048,
+				FileName:  "baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq.car",
+				FileSize:  1000,
+				SHA256:    "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+				DealUUIDs: []string{"2f2d0e7a-7c4e-4c1a-9d0e-1b7c0a3b9e11"},
+			}},
+		}
+		mockHandler.On("SyncPiecesHandler", mock.Anything, sp.SyncPiecesRequest{
+			ContentProvider:      "http://127.0.0.1:7777",
+			ContentProviderToken: "token",
+			Dir:                  "/media/disk",
+			Provider:             "f01234",
+			Pieces:               []string{},
+		}).Return(manifest, nil)
+		_, _, err := runner.Run(ctx, "singularity sync-pieces --provider f01234 --content-provider-token token /media/disk")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose sync-pieces --provider f01234 --content-provider-token token /media/disk")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity sync-pieces")
+		require.ErrorIs(t, err, cliutil.ErrIncorrectNArgs)
+	})
+}
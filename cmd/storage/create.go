@@ -133,56 +133,72 @@ var httpClientConfigFlags = []cli.Flag{
 const localStorageType = "local"
 
 var CreateCmd = &cli.Command{
-	Name:  "create",
-	Usage: "Create a new storage which can be used as source or output",
-	Subcommands: underscore.Map(storagesystem.Backends, func(backend storagesystem.Backend) *cli.Command {
-		if len(backend.ProviderOptions) > 1 {
-			return &cli.Command{
-				Name:  backend.Prefix,
-				Usage: backend.Description,
-				Subcommands: underscore.Map(backend.ProviderOptions, func(providerOption storagesystem.ProviderOptions) *cli.Command {
-					command := providerOption.ToCLICommand(strings.ToLower(providerOption.Provider), providerOption.Provider, providerOption.ProviderDescription)
-					command.Action = func(c *cli.Context) error {
-						return createAction(c, backend.Prefix, providerOption.Provider)
-					}
-					command.Flags = append(command.Flags, &cli.StringFlag{
-						Name:        "name",
-						Usage:       "Name of the storage",
-						DefaultText: "Auto generated",
-						Category:    "General",
-					}, &cli.StringFlag{
-						Name:     "path",
-						Usage:    "Path of the storage",
-						Category: "General",
-						Required: true,
-					})
-					command.Flags = append(command.Flags, httpClientConfigFlags...)
-					command.Flags = append(command.Flags, CommonConfigFlags...)
-					return command
-				}),
-			}
+	Name:        "create",
+	Usage:       "Create a new storage which can be used as source or output",
+	Subcommands: underscore.Map(storagesystem.Backends, createBackendCommand),
+}
+
+// AddRegisteredBackends adds the create and update commands of the backends that were registered with
+// storagesystem.RegisterBackend after the commands were built, i.e. by packages initialized after this one.
+func AddRegisteredBackends() {
+	for _, backend := range storagesystem.Backends {
+		if CreateCmd.Command(backend.Prefix) == nil {
+			CreateCmd.Subcommands = append(CreateCmd.Subcommands, createBackendCommand(backend))
 		}
-		command := backend.ProviderOptions[0].ToCLICommand(backend.Prefix, backend.Name, backend.Description)
-		command.Action = func(c *cli.Context) error {
-			return createAction(c, backend.Prefix, "")
+		if UpdateCmd.Command(backend.Prefix) == nil {
+			UpdateCmd.Subcommands = append(UpdateCmd.Subcommands, updateBackendCommand(backend))
 		}
-		command.Flags = append(command.Flags, &cli.StringFlag{
-			Name:        "name",
-			Usage:       "Name of the storage",
-			DefaultText: "Auto generated",
-			Category:    "General",
-		}, &cli.StringFlag{
-			Name:     "path",
-			Usage:    "Path of the storage",
-			Category: "General",
-			Required: true,
-		})
-		if backend.Prefix != localStorageType {
-			command.Flags = append(command.Flags, httpClientConfigFlags...)
+	}
+}
+
+// createBackendCommand returns the command that creates a storage of a backend.
+func createBackendCommand(backend storagesystem.Backend) *cli.Command {
+	if len(backend.ProviderOptions) > 1 {
+		return &cli.Command{
+			Name:  backend.Prefix,
+			Usage: backend.Description,
+			Subcommands: underscore.Map(backend.ProviderOptions, func(providerOption storagesystem.ProviderOptions) *cli.Command {
+				command := providerOption.ToCLICommand(strings.ToLower(providerOption.Provider), providerOption.Provider, providerOption.ProviderDescription)
+				command.Action = func(c *cli.Context) error {
+					return createAction(c, backend.Prefix, providerOption.Provider)
+				}
+				command.Flags = append(command.Flags, &cli.StringFlag{
+					Name:        "name",
+					Usage:       "Name of the storage",
+					DefaultText: "Auto generated",
+					Category:    "General",
+				}, &cli.StringFlag{
+					Name:     "path",
+					Usage:    "Path of the storage",
+					Category: "General",
+					Required: true,
+				})
+				command.Flags = append(command.Flags, httpClientConfigFlags...)
+				command.Flags = append(command.Flags, CommonConfigFlags...)
+				return command
+			}),
 		}
-		command.Flags = append(command.Flags, CommonConfigFlags...)
-		return command
-	}),
+	}
+	command := backend.ProviderOptions[0].ToCLICommand(backend.Prefix, backend.Name, backend.Description)
+	command.Action = func(c *cli.Context) error {
+		return createAction(c, backend.Prefix, "")
+	}
+	command.Flags = append(command.Flags, &cli.StringFlag{
+		Name:        "name",
+		Usage:       "Name of the storage",
+		DefaultText: "Auto generated",
+		Category:    "General",
+	}, &cli.StringFlag{
+		Name:     "path",
+		Usage:    "Path of the storage",
+		Category: "General",
+		Required: true,
+	})
+	if backend.Prefix != localStorageType {
+		command.Flags = append(command.Flags, httpClientConfigFlags...)
+	}
+	command.Flags = append(command.Flags, CommonConfigFlags...)
+	return command
 }
 
 func createAction(c *cli.Context, storageType string, provider string) error {
@@ -210,7 +226,9 @@ func createAction(c *cli.Context, storageType string, provider string) error {
 	for _, flag := range CommonConfigFlags {
 		extraFlagNames = append(extraFlagNames, flag.Names()...)
 	}
-	for _, flagName := range c.LocalFlagNames() {
+	// Aliases of the flags, i.e. -L for --copy-links, are not config keys, so only the primary names are used.
+	for _, flag := range c.Command.Flags {
+		flagName := flag.Names()[0]
 		if slices.Contains(extraFlagNames, flagName) {
 			continue
 		}
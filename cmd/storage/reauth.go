@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/handler/storage"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+)
+
+var ReauthCmd = &cli.Command{
+	Name:      "reauth",
+	Usage:     "Re-authenticate a storage whose credentials were rejected, and resume the jobs that use it",
+	ArgsUsage: "<name|id>",
+	Description: "When the credentials of a storage are rejected, i.e. because an OAuth token expired or an access key was rotated,\n" +
+		"the storage is flagged as needing re-authentication and the jobs that use it are held instead of failing.\n" +
+		"This command replaces the credentials, checks them by listing the storage, and resumes the jobs.\n\n" +
+		"Without --token or --config, the credentials are asked for interactively. For storages authenticated with OAuth,\n" +
+		"i.e. Google Drive, OneDrive or Dropbox, run 'rclone authorize <type>' on a machine with a web browser and paste the\n" +
+		"token it prints. For other storages, the secret options are asked for one by one.\n\n" +
+		"Example:\n" +
+		"  singularity storage reauth --token '{\"access_token\":\"...\",\"refresh_token\":\"...\"}' my-drive\n" +
+		"  singularity storage reauth --config access_key_id=AKIA... --config secret_access_key=... my-s3",
+	Before: cliutil.CheckNArgs,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "token",
+			Usage: "OAuth token of the storage, as printed by 'rclone authorize <type>'",
+		},
+		&cli.StringSliceFlag{
+			Name:  "config",
+			Usage: "Config option of the storage to replace, i.e. secret_access_key=..., can be repeated",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		name := c.Args().Get(0)
+
+		config := make(map[string]string)
+		if c.IsSet("token") {
+			config["token"] = c.String("token")
+		}
+		for _, kv := range c.StringSlice("config") {
+			key, value, ok := strings.Cut(kv, "=")
+			if !ok {
+				return errors.Wrapf(handlererror.ErrInvalidParameter, "invalid config %s, must be key=value", kv)
+			}
+			config[key] = value
+		}
+		if len(config) == 0 {
+			config, err = promptCredentials(c, db, name)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		s, err := storage.Default.ReauthStorageHandler(c.Context, db, name, storage.ReauthRequest{Config: config})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, s)
+		return nil
+	},
+}
+
+// promptCredentials asks for the new credentials of a storage on the standard input. Storages authenticated with OAuth
+// are asked for a token, and other storages for each of their secret options. Empty answers keep the current value.
+func promptCredentials(c *cli.Context, db *gorm.DB, name string) (map[string]string, error) {
+	var s model.Storage
+	err := s.FindByIDOrName(db.WithContext(c.Context), name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "storage %s does not exist", name)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	backend, ok := storagesystem.BackendMap[s.Type]
+	if !ok {
+		return nil, errors.Newf("storage type %s is not supported", s.Type)
+	}
+	providerOptions, err := backend.FindProviderOptions(s.Config["provider"])
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	out := c.App.Writer
+	if s.NeedsReauth {
+		_, _ = fmt.Fprintf(out, "The credentials of storage %s were rejected: %s\n", s.Name, s.AuthError)
+	}
+	scanner := bufio.NewScanner(c.App.Reader)
+	ask := func(prompt string) (string, error) {
+		_, _ = fmt.Fprint(out, prompt)
+		if !scanner.Scan() {
+			return "", errors.Wrap(scanner.Err(), "failed to read from stdin")
+		}
+		return strings.TrimSpace(scanner.Text()), nil
+	}
+
+	config := make(map[string]string)
+	for _, option := range providerOptions.Options {
+		if option.Name == "token" {
+			_, _ = fmt.Fprintf(out, "Run the following command on a machine with a web browser, and paste the token it prints:\n"+
+				"  rclone authorize %q\n", s.Type)
+			token, err := ask("Token: ")
+			if err != nil {
+				return nil, err
+			}
+			if token != "" {
+				config["token"] = token
+			}
+			return config, nil
+		}
+	}
+	for _, option := range providerOptions.Options {
+		if option.Advanced || !model.IsSecretConfigName(option.Name) {
+			continue
+		}
+		value, err := ask(fmt.Sprintf("%s (leave empty to keep the current value): ", option.Name))
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			config[option.Name] = value
+		}
+	}
+	return config, nil
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/storage"
+	"github.com/urfave/cli/v2"
+)
+
+var SlowestCmd = &cli.Command{
+	Name:  "slowest",
+	Usage: "List the source storages from the slowest to read from",
+	Description: "Each time a piece is packed, the latency of opening each source file is recorded in one of four tiers:\n" +
+		"fast (under 100ms), moderate (under 1s), slow (under 10s) and very slow. The sources are scored from 0, when all\n" +
+		"reads are fast, to 100, when all reads are very slow. A source scoring 25 or more over at least 100 reads is\n" +
+		"chronically slow, and the dataset workers warn about it. It holds back every worker packing from it, so it is\n" +
+		"the one to mirror to a local storage first.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "since",
+			Usage: "Only score the reads of the pieces packed within this duration, i.e. 24h. All reads are scored if not set.",
+		},
+		&cli.IntFlag{
+			Name:  "limit",
+			Usage: "Max number of sources to list, or 0 for all",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		sources, err := storage.Default.ListSlowestSourcesHandler(c.Context, db, storage.ListSlowestSourcesRequest{
+			Since: c.String("since"),
+			Limit: c.Int("limit"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, sources)
+		return nil
+	},
+}
@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/storage"
+	"github.com/urfave/cli/v2"
+)
+
+var TypesCmd = &cli.Command{
+	Name:      "types",
+	Usage:     "List the supported storage types, or the config options of a storage type",
+	ArgsUsage: "[type]",
+	Before:    cliutil.CheckNArgs,
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		if c.NArg() == 0 {
+			types, err := storage.Default.ListStorageTypesHandler(c.Context, db)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			cliutil.Print(c, types)
+			return nil
+		}
+
+		storageType, err := storage.Default.GetStorageTypeHandler(c.Context, db, c.Args().Get(0))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, storageType)
+		return nil
+	},
+}
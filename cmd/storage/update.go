@@ -83,38 +83,41 @@ var HTTPClientConfigFlagsForUpdate = []cli.Flag{
 }
 
 var UpdateCmd = &cli.Command{
-	Name:  "update",
-	Usage: "Update the configuration of an existing storage connection",
-	Subcommands: underscore.Map(storagesystem.Backends, func(backend storagesystem.Backend) *cli.Command {
-		if len(backend.ProviderOptions) > 1 {
-			return &cli.Command{
-				Name:  backend.Prefix,
-				Usage: backend.Description,
-				Subcommands: underscore.Map(backend.ProviderOptions, func(providerOption storagesystem.ProviderOptions) *cli.Command {
-					command := providerOption.ToCLICommand(strings.ToLower(providerOption.Provider), providerOption.Provider, providerOption.ProviderDescription)
-					command.Action = func(c *cli.Context) error {
-						return updateAction(c, backend.Prefix, providerOption.Provider)
-					}
-					command.ArgsUsage = "<name|id>"
-					command.Before = cliutil.CheckNArgs
-					command.Flags = append(command.Flags, HTTPClientConfigFlagsForUpdate...)
-					command.Flags = append(command.Flags, CommonConfigFlags...)
-					return command
-				}),
-			}
-		}
-		command := backend.ProviderOptions[0].ToCLICommand(backend.Prefix, backend.Name, backend.Description)
-		command.Action = func(c *cli.Context) error {
-			return updateAction(c, backend.Prefix, "")
-		}
-		command.ArgsUsage = "<name|id>"
-		command.Before = cliutil.CheckNArgs
-		if backend.Prefix != "local" {
-			command.Flags = append(command.Flags, HTTPClientConfigFlagsForUpdate...)
+	Name:        "update",
+	Usage:       "Update the configuration of an existing storage connection",
+	Subcommands: underscore.Map(storagesystem.Backends, updateBackendCommand),
+}
+
+// updateBackendCommand returns the command that updates a storage of a backend.
+func updateBackendCommand(backend storagesystem.Backend) *cli.Command {
+	if len(backend.ProviderOptions) > 1 {
+		return &cli.Command{
+			Name:  backend.Prefix,
+			Usage: backend.Description,
+			Subcommands: underscore.Map(backend.ProviderOptions, func(providerOption storagesystem.ProviderOptions) *cli.Command {
+				command := providerOption.ToCLICommand(strings.ToLower(providerOption.Provider), providerOption.Provider, providerOption.ProviderDescription)
+				command.Action = func(c *cli.Context) error {
+					return updateAction(c, backend.Prefix, providerOption.Provider)
+				}
+				command.ArgsUsage = "<name|id>"
+				command.Before = cliutil.CheckNArgs
+				command.Flags = append(command.Flags, HTTPClientConfigFlagsForUpdate...)
+				command.Flags = append(command.Flags, CommonConfigFlags...)
+				return command
+			}),
 		}
-		command.Flags = append(command.Flags, CommonConfigFlags...)
-		return command
-	}),
+	}
+	command := backend.ProviderOptions[0].ToCLICommand(backend.Prefix, backend.Name, backend.Description)
+	command.Action = func(c *cli.Context) error {
+		return updateAction(c, backend.Prefix, "")
+	}
+	command.ArgsUsage = "<name|id>"
+	command.Before = cliutil.CheckNArgs
+	if backend.Prefix != "local" {
+		command.Flags = append(command.Flags, HTTPClientConfigFlagsForUpdate...)
+	}
+	command.Flags = append(command.Flags, CommonConfigFlags...)
+	return command
 }
 
 func updateAction(c *cli.Context, storageType string, provider string) error {
@@ -150,7 +153,9 @@ func updateAction(c *cli.Context, storageType string, provider string) error {
 		extraFlagNames = append(extraFlagNames, flag.Names()...)
 	}
 	config := make(map[string]string)
-	for _, flagName := range c.LocalFlagNames() {
+	// Aliases of the flags, i.e. -L for --copy-links, are not config keys, so only the primary names are used.
+	for _, flag := range c.Command.Flags {
+		flagName := flag.Names()[0]
 		if slices.Contains(extraFlagNames, flagName) {
 			continue
 		}
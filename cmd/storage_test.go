@@ -43,6 +43,30 @@ func TestStorageRenameHandler(t *testing.T) {
 	})
 }
 
+func TestStorageReauthHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(storage.MockStorage)
+		defer swapStorageHandler(mockHandler)()
+		mockHandler.On("ReauthStorageHandler", mock.Anything, mock.Anything, "name", storage.ReauthRequest{
+			Config: map[string]string{"token": "token", "client_id": "id"},
+		}).Return(&model.Storage{
+			ID:        1,
+			Name:      "name",
+			CreatedAt: time.Time{},
+			UpdatedAt: time.Time{},
+			Type:      "drive",
+			Path:      "path",
+		}, nil)
+		_, _, err := runner.Run(ctx, "singularity storage reauth --token token --config client_id=id name")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity storage reauth --config client_id name")
+		require.ErrorContains(t, err, "must be key=value")
+	})
+}
+
 func TestStorageExploreHandler(t *testing.T) {
 	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 		runner := NewRunner()
@@ -186,6 +210,34 @@ func TestStorageListHandler(t *testing.T) {
 	})
 }
 
+func TestStorageListHandler_Secrets(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(storage.MockStorage)
+		defer swapStorageHandler(mockHandler)()
+		mockHandler.On("ListStoragesHandler", mock.Anything, mock.Anything).Return([]model.Storage{{
+			ID:     1,
+			Name:   "name",
+			Type:   "s3",
+			Path:   "bucket",
+			Config: map[string]string{"provider": "AWS", "secret_access_key": "verysecret"},
+		}}, nil)
+		out, _, err := runner.Run(ctx, "singularity --json storage list")
+		require.NoError(t, err)
+		require.NotContains(t, out, "verysecret")
+		require.Contains(t, out, model.MaskedSecret)
+
+		out, _, err = runner.Run(ctx, "singularity --verbose storage list")
+		require.NoError(t, err)
+		require.NotContains(t, out, "verysecret")
+
+		out, _, err = runner.Run(ctx, "singularity --json --show-secrets storage list")
+		require.NoError(t, err)
+		require.Contains(t, out, "verysecret")
+	})
+}
+
 func TestStorageRemoveHandler(t *testing.T) {
 	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 		runner := NewRunner()
@@ -261,3 +313,65 @@ func TestStorageUpdateHandler_Local(t *testing.T) {
 
 	})
 }
+
+func TestStorageTypesHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(storage.MockStorage)
+		defer swapStorageHandler(mockHandler)()
+		localType := storage.StorageType{
+			Type:        "local",
+			Description: "Local Disk",
+			Providers: []storage.StorageProvider{{
+				Options: []storage.StorageOption{{
+					Name:    "copy_links",
+					Help:    "Follow symlinks and copy the pointed to item.",
+					Type:    "bool",
+					Default: "false",
+				}},
+			}},
+		}
+		mockHandler.On("ListStorageTypesHandler", mock.Anything, mock.Anything).Return([]storage.StorageType{localType}, nil)
+		mockHandler.On("GetStorageTypeHandler", mock.Anything, mock.Anything, "local").Return(&localType, nil)
+		_, _, err := runner.Run(ctx, "singularity storage types")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose storage types local")
+		require.NoError(t, err)
+	})
+}
+
+func TestStorageSlowestHandler(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(storage.MockStorage)
+		defer swapStorageHandler(mockHandler)()
+		mockHandler.On("ListSlowestSourcesHandler", mock.Anything, mock.Anything, storage.ListSlowestSourcesRequest{
+			Since: "24h",
+			Limit: 5,
+		}).Return([]storage.SlowSource{{
+			StorageID:       1,
+			Storage:         "remote",
+			Type:            "s3",
+			Score:           48.5,
+			ChronicallySlow: true,
+			NumOfPieces:     10,
+			NumOfReads:      200,
+			FastReads:       50,
+			ModerateReads:   50,
+			SlowReads:       80,
+			VerySlowReads:   20,
+			MeanLatency:     3 * time.Second,
+			MaxLatency:      25 * time.Second,
+			BytesRead:       1 << 30,
+			Throughput:      10 << 20,
+		}}, nil)
+		_, _, err := runner.Run(ctx, "singularity storage slowest --since 24h --limit 5")
+		require.NoError(t, err)
+
+		_, _, err = runner.Run(ctx, "singularity --verbose storage slowest --since 24h --limit 5")
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,33 @@
+package telemetry
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/analytics"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/urfave/cli/v2"
+)
+
+var EnableCmd = &cli.Command{
+	Name:  "enable",
+	Usage: "Opt in to sending anonymous usage telemetry",
+	Action: func(c *cli.Context) error {
+		return setTelemetry(c, true)
+	},
+}
+
+var DisableCmd = &cli.Command{
+	Name:  "disable",
+	Usage: "Opt out of sending anonymous usage telemetry",
+	Action: func(c *cli.Context) error {
+		return setTelemetry(c, false)
+	},
+}
+
+func setTelemetry(c *cli.Context, enabled bool) error {
+	db, closer, err := database.OpenFromCLI(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer closer.Close()
+	return errors.WithStack(analytics.SetTelemetryEnabled(c.Context, db, enabled))
+}
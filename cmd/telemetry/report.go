@@ -0,0 +1,45 @@
+package telemetry
+
+import (
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/analytics"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/urfave/cli/v2"
+)
+
+var ReportCmd = &cli.Command{
+	Name:  "report",
+	Usage: "Print the telemetry report exactly as it would be sent",
+	Description: "The report only contains aggregate and non-identifying usage statistics, i.e. versions, bucketed preparation sizes,\n" +
+		"storage types, job states, classes of job errors and deal states. It is sent at most once a day and only if telemetry is enabled.",
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		report, err := analytics.BuildTelemetryReport(c.Context, db)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		enabled, err := analytics.IsTelemetryEnabled(c.Context, db)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !c.Bool("json") {
+			if enabled {
+				_, _ = c.App.Writer.Write([]byte("Telemetry is enabled. Below report will be sent:\n"))
+			} else {
+				_, _ = c.App.Writer.Write([]byte("Telemetry is disabled. Below report would be sent if enabled:\n"))
+			}
+		}
+		out, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = c.App.Writer.Write(append(out, '\n'))
+		return errors.WithStack(err)
+	},
+}
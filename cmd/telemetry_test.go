@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestTelemetry(t *testing.T) {
+	testutil.One(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		out, _, err := runner.Run(ctx, "singularity telemetry report")
+		require.NoError(t, err)
+		require.Contains(t, out, "Telemetry is disabled")
+
+		_, _, err = runner.Run(ctx, "singularity telemetry enable")
+		require.NoError(t, err)
+		out, _, err = runner.Run(ctx, "singularity telemetry report")
+		require.NoError(t, err)
+		require.Contains(t, out, "Telemetry is enabled")
+
+		_, _, err = runner.Run(ctx, "singularity telemetry disable")
+		require.NoError(t, err)
+	})
+}
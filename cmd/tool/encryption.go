@@ -0,0 +1,95 @@
+package tool
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/handler/tool"
+	"github.com/urfave/cli/v2"
+)
+
+var GenerateEncryptionKeyCmd = &cli.Command{
+	Name:     "generate-encryption-key",
+	Category: "Utility",
+	Usage:    "Generate a key pair for piece encryption",
+	Description: "The recipient is the public key to pass to 'singularity prep create --piece-key-recipient'.\n" +
+		"The identity is the private key. Keep it safe, it is the only way to decrypt the pieces and is never stored by singularity.",
+	Action: func(c *cli.Context) error {
+		key, err := tool.GenerateEncryptionKeyHandler()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, *key)
+		return nil
+	},
+}
+
+var DecryptCarCmd = &cli.Command{
+	Name:     "decrypt-car",
+	Category: "Utility",
+	Usage:    "Decrypt an encrypted CAR file with its wrapped piece key",
+	Description: "The wrapped key of each piece can be exported with 'singularity prep export-piece-keys'.\n" +
+		"The decrypted CAR file can then be extracted with 'singularity extract-car'.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "input",
+			Usage:    "Path to the encrypted CAR file",
+			Required: true,
+			Aliases:  []string{"i"},
+		},
+		&cli.StringFlag{
+			Name:     "output",
+			Usage:    "Path to write the decrypted CAR file to",
+			Required: true,
+			Aliases:  []string{"o"},
+		},
+		&cli.StringFlag{
+			Name:     "wrapped-key",
+			Usage:    "Wrapped piece key of the CAR file",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "identity",
+			Usage:    "Identity generated by 'singularity generate-encryption-key'",
+			Required: true,
+			EnvVars:  []string{"SINGULARITY_IDENTITY"},
+		},
+	},
+	Action: func(c *cli.Context) error {
+		return tool.DecryptCarHandler(c.Context, c.String("input"), c.String("output"), c.String("wrapped-key"), c.String("identity"))
+	},
+}
+
+var DecryptItemCmd = &cli.Command{
+	Name:     "decrypt-item",
+	Category: "Utility",
+	Usage:    "Decrypt a file encrypted with a KMS key with its wrapped data key",
+	Description: "The wrapped data key of each file range can be exported with 'singularity prep export-item-keys'.\n" +
+		"The data key is unwrapped by the KMS, with the credentials read from the environment as with the CLI of the KMS.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "input",
+			Usage:    "Path to the encrypted file",
+			Required: true,
+			Aliases:  []string{"i"},
+		},
+		&cli.StringFlag{
+			Name:     "output",
+			Usage:    "Path to write the decrypted file to",
+			Required: true,
+			Aliases:  []string{"o"},
+		},
+		&cli.StringFlag{
+			Name:     "kms-key",
+			Usage:    "URI of the KMS key of the preparation, i.e. awskms://<key ARN>",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "wrapped-key",
+			Usage:    "Wrapped data key of the file range",
+			Required: true,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		return tool.DecryptItemHandler(c.Context, c.String("input"), c.String("output"), c.String("kms-key"), c.String("wrapped-key"))
+	},
+}
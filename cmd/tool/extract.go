@@ -0,0 +1,106 @@
+package tool
+
+import (
+	"os"
+	"strconv"
+
+	"filippo.io/age"
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/tool"
+	"github.com/urfave/cli/v2"
+)
+
+var ExtractCmd = &cli.Command{
+	Name:      "extract",
+	Category:  "Utility",
+	Usage:     "Retrieve a file range by range, decrypt it and reassemble the original file",
+	ArgsUsage: "<file_id>",
+	Before:    cliutil.CheckNArgs,
+	Description: "Retrieve each range of the file by its CID, either from a directory of CAR files or from the HTTP gateway of a\n" +
+		"content provider, and write the ranges in order to the output.\n" +
+		"With --decrypt, the ranges of a preparation with file encryption are decrypted, with the age identities of one of its\n" +
+		"recipients, or with the data keys unwrapped by its KMS key, with the credentials read from the environment as with\n" +
+		"the CLI of the KMS. The file is verified against the size of each range, and not kept if it cannot be decrypted.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "input-dir",
+			Usage:   "Directory containing the CAR files of the file. This directory will be scanned recursively",
+			Aliases: []string{"i"},
+		},
+		&cli.StringFlag{
+			Name:  "content-provider",
+			Usage: "URL of a content provider with the HTTP gateway enabled, to retrieve the file from instead of CAR files",
+		},
+		&cli.StringFlag{
+			Name:    "retrieval-token",
+			Usage:   "Retrieval token, if the content provider requires one",
+			EnvVars: []string{"SINGULARITY_RETRIEVAL_TOKEN"},
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "Path to write the file to",
+			Aliases:     []string{"o"},
+			DefaultText: "name of the file in the current directory",
+		},
+		&cli.BoolFlag{
+			Name:  "decrypt",
+			Usage: "Decrypt the ranges of a preparation with file encryption",
+		},
+		&cli.StringSliceFlag{
+			Name:  "identity",
+			Usage: "Path to a file with age identities, i.e. generated by age-keygen, to decrypt the ranges with",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		fileID, err := strconv.ParseUint(c.Args().Get(0), 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid file ID '%s'", c.Args().Get(0))
+		}
+
+		var identities []age.Identity
+		for _, path := range c.StringSlice("identity") {
+			f, err := os.Open(path)
+			if err != nil {
+				return errors.Wrapf(err, "failed to open identity file %s", path)
+			}
+			parsed, err := age.ParseIdentities(f)
+			f.Close()
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse identity file %s", path)
+			}
+			identities = append(identities, parsed...)
+		}
+
+		var fetcher tool.RangeFetcher
+		switch {
+		case c.String("input-dir") != "" && c.String("content-provider") != "":
+			return errors.New("only one of --input-dir and --content-provider can be specified")
+		case c.String("input-dir") != "":
+			carDir, err := tool.NewCarDirFetcher(c.String("input-dir"))
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer carDir.Close()
+			fetcher = carDir
+		case c.String("content-provider") != "":
+			fetcher = tool.ContentProviderFetcher{URL: c.String("content-provider"), Token: c.String("retrieval-token")}
+		default:
+			return errors.New("either --input-dir or --content-provider is required")
+		}
+
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		result, err := tool.ExtractFileHandler(c.Context, db, fileID, fetcher, c.String("output"), c.Bool("decrypt"), identities)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, *result)
+		return nil
+	},
+}
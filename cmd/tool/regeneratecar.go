@@ -0,0 +1,48 @@
+package tool
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/tool"
+	"github.com/urfave/cli/v2"
+)
+
+var RegenerateCarCmd = &cli.Command{
+	Name:      "regenerate-car",
+	Category:  "Utility",
+	Usage:     "Re-create the CAR files of pieces from their metadata and the data sources",
+	ArgsUsage: "<piece_cid> [piece_cid...]",
+	Description: "Regenerate the CAR files of the pieces from the block index in the database, reading the blocks from the data sources\n" +
+		"of the preparations, and write them to the output directory as <piece_cid>.car. This re-creates lost CAR files without\n" +
+		"running the content provider. The blocks are verified against their CID, so a modified source file fails the regeneration.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "output",
+			Usage:   "Directory to write the CAR files to",
+			Aliases: []string{"o"},
+			Value:   ".",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if c.NArg() == 0 {
+			return errors.Wrap(cliutil.ErrIncorrectNArgs, "at least one piece CID is required")
+		}
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		var results []tool.RegeneratedCar
+		for _, pieceCID := range c.Args().Slice() {
+			result, err := tool.RegenerateCarHandler(c.Context, db, pieceCID, c.String("output"))
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			results = append(results, *result)
+		}
+		cliutil.Print(c, results)
+		return nil
+	},
+}
@@ -0,0 +1,71 @@
+package tool
+
+import (
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/tool"
+	"github.com/dustin/go-humanize"
+	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+)
+
+var SplitCarCmd = &cli.Command{
+	Name:      "split-car",
+	Category:  "Utility",
+	Usage:     "Split an oversized CAR file into CAR files that fit into pieces",
+	ArgsUsage: "<car_file>",
+	Before:    cliutil.CheckNArgs,
+	Description: "Split an existing CAR file, i.e. one produced by an older preparation tool, into CAR files that fit into pieces of\n" +
+		"the piece size, and write them to the output directory as <piece_cid>.car. The blocks are copied in their order and never\n" +
+		"split, and a new CAR file is started whenever the next block would not fit. The first CAR file keeps the root of the input,\n" +
+		"each following CAR file has its first block as root.\n" +
+		"With --preparation, the pieces are registered in the preparation, as with 'singularity prep add-piece', so deals can be\n" +
+		"made for them, and the piece size defaults to the one of the preparation.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "output",
+			Usage:   "Directory to write the CAR files to. It will be created if it does not exist",
+			Aliases: []string{"o"},
+			Value:   ".",
+		},
+		&cli.StringFlag{
+			Name:        "piece-size",
+			Usage:       "Piece size the CAR files need to fit in",
+			DefaultText: "piece size of the preparation, or 32GiB",
+		},
+		&cli.StringFlag{
+			Name:  "preparation",
+			Usage: "ID or name of the preparation to register the pieces in",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var pieceSize uint64
+		var err error
+		if c.String("piece-size") != "" {
+			pieceSize, err = humanize.ParseBytes(c.String("piece-size"))
+			if err != nil {
+				return errors.Wrapf(err, "invalid piece size %s", c.String("piece-size"))
+			}
+		}
+
+		var db *gorm.DB
+		if c.String("preparation") != "" {
+			var closer io.Closer
+			db, closer, err = database.OpenFromCLI(c)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer closer.Close()
+		}
+
+		results, err := tool.SplitCarHandler(c.Context, db, c.Args().Get(0), c.String("output"), int64(pieceSize), c.String("preparation"))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, results)
+		return nil
+	},
+}
@@ -0,0 +1,53 @@
+package tool
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/handler/sp"
+	"github.com/urfave/cli/v2"
+)
+
+var SyncPiecesCmd = &cli.Command{
+	Name:      "sync-pieces",
+	Category:  "Utility",
+	Usage:     "Sync a selected set of pieces from a content provider to removable media for courier delivery",
+	ArgsUsage: "<dir> [piece_cid...]",
+	Description: "Download the selected pieces to the directory as <piece_cid>.car, verify their commP against the piece CID, and\n" +
+		"record them in the manifest.json of the directory together with their SHA-256 checksum and the deals they are for.\n" +
+		"Pieces are selected by piece CID, or with --provider, as all pieces of the deals pending import by the storage provider.\n" +
+		"The manifest is updated after every piece, so an interrupted sync is resumed by running the same command again.\n" +
+		"On the receiving side, the storage provider runs 'singularity sp import-media <dir>' to verify and import the pieces.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "content-provider",
+			Usage: "URL of the content provider serving the pieces",
+			Value: "http://127.0.0.1:7777",
+		},
+		&cli.StringFlag{
+			Name:    "content-provider-token",
+			Usage:   "Token the content provider requires to list the pending deals, as set with its --pending-deals-token",
+			EnvVars: []string{"PENDING_DEALS_TOKEN"},
+		},
+		&cli.StringFlag{
+			Name:  "provider",
+			Usage: "Sync the pieces of all deals pending import by this storage provider, i.e. f01234",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if c.NArg() == 0 {
+			return errors.WithStack(cliutil.ErrIncorrectNArgs)
+		}
+		manifest, err := sp.Default.SyncPiecesHandler(c.Context, sp.SyncPiecesRequest{
+			ContentProvider:      c.String("content-provider"),
+			ContentProviderToken: c.String("content-provider-token"),
+			Dir:                  c.Args().Get(0),
+			Provider:             c.String("provider"),
+			Pieces:               c.Args().Slice()[1:],
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, *manifest)
+		return nil
+	},
+}
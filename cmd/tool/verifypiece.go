@@ -0,0 +1,55 @@
+package tool
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/tool"
+	"github.com/urfave/cli/v2"
+)
+
+var VerifyPieceCmd = &cli.Command{
+	Name:      "verify-piece",
+	Category:  "Utility",
+	Usage:     "Verify that the pieces of a preparation can still be regenerated from the data sources",
+	ArgsUsage: "<preparation id|name> [piece_cid...]",
+	Description: "Regenerate the CAR files of the pieces from the block index in the database and the data sources, as the content\n" +
+		"provider would serve them, and recompute their piece CID. A piece whose regenerated CAR file has a different size or\n" +
+		"piece CID can no longer be served, usually because its source files have been modified since they were packed.\n" +
+		"All pieces of the preparation are verified if no piece CID is given. The command fails if any piece fails the verification,\n" +
+		"the report of all pieces is printed either way, i.e. as JSON with --json.",
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:    "concurrency",
+			Usage:   "Number of pieces to verify at the same time",
+			Aliases: []string{"j"},
+			Value:   1,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if c.NArg() == 0 {
+			return errors.Wrap(cliutil.ErrIncorrectNArgs, "preparation is required")
+		}
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		results, err := tool.VerifyPiecesHandler(c.Context, db, c.Args().First(), c.Args().Tail(), c.Int("concurrency"))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, results)
+		failed := 0
+		for _, result := range results {
+			if !result.Verified {
+				failed++
+			}
+		}
+		if failed > 0 {
+			return errors.Newf("%d of %d pieces failed the verification", failed, len(results))
+		}
+		return nil
+	},
+}
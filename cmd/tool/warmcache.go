@@ -0,0 +1,84 @@
+package tool
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/service/contentprovider"
+	"github.com/data-preservation-programs/singularity/version"
+	"github.com/urfave/cli/v2"
+)
+
+var WarmCacheCmd = &cli.Command{
+	Name:      "warm-cache",
+	Category:  "Utility",
+	Usage:     "Pre-warm the caches of a content provider for a list of pieces",
+	ArgsUsage: "<piece_cid> [piece_cid...]",
+	Description: "Load the block index of the pieces into the content provider's cache, and optionally prefetch the beginning of each piece.\n" +
+		"This is useful ahead of an announced storage provider pulling window to avoid cold start latency spikes.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "api",
+			Usage: "URL of the content provider",
+			Value: "http://127.0.0.1:7777",
+		},
+		&cli.Int64Flag{
+			Name:  "prefetch-bytes",
+			Usage: "Number of bytes to prefetch from the beginning of each piece. 0 to only load the block index",
+			Value: 0,
+		},
+		&cli.StringFlag{
+			Name:  "token",
+			Usage: "Retrieval token allowing the pieces, if the content provider requires one",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if c.NArg() == 0 {
+			return errors.Wrap(cliutil.ErrIncorrectNArgs, "at least one piece CID is required")
+		}
+		body, err := json.Marshal(contentprovider.WarmRequest{
+			Pieces:        c.Args().Slice(),
+			PrefetchBytes: c.Int64("prefetch-bytes"),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		url := strings.TrimSuffix(c.String("api"), "/") + "/piece/warm"
+		req, err := http.NewRequestWithContext(c.Context, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if c.IsSet("token") {
+			req.Header.Set("Authorization", "Bearer "+c.String("token"))
+		}
+		version.SetRequestHeader(req)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return errors.Wrap(err, "failed to call content provider")
+		}
+		defer resp.Body.Close()
+		err = version.CheckResponse(resp)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			respBody, _ := io.ReadAll(resp.Body)
+			return errors.Newf("content provider returned %d: %s", resp.StatusCode, string(respBody))
+		}
+
+		var results []contentprovider.WarmResult
+		err = json.NewDecoder(resp.Body).Decode(&results)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode response")
+		}
+		cliutil.Print(c, results)
+		return nil
+	},
+}
@@ -5,6 +5,8 @@ import (
 	"runtime/debug"
 
 	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/i18n"
+	"github.com/data-preservation-programs/singularity/version"
 	"github.com/urfave/cli/v2"
 )
 
@@ -12,15 +14,21 @@ var VersionCmd = &cli.Command{
 	Name:    "version",
 	Usage:   "Print version information",
 	Aliases: []string{"v"},
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "check",
+			Usage: "Check whether a newer release is available",
+		},
+	},
 	Action: func(context *cli.Context) error {
 		buildInfo, ok := debug.ReadBuildInfo()
 		if !ok {
-			fmt.Println("unknown version")
+			fmt.Println(i18n.Sprintf(context.Context, "unknown version"))
 		}
 
-		version := buildInfo.Main.Version
-		if version == "(devel)" || version == "" {
-			version = Version
+		current := buildInfo.Main.Version
+		if current == "(devel)" || current == "" {
+			current = version.Version
 		}
 		var revision string
 		var modified string
@@ -47,9 +55,22 @@ var VersionCmd = &cli.Command{
 		default:
 			modified = "-" + modified
 		}
-		v := fmt.Sprintf("singularity %s%s%s\n", version, revision, modified)
+		v := fmt.Sprintf("singularity %s%s%s\n", current, revision, modified)
 		_, err := context.App.Writer.Write([]byte(v))
+		if err != nil || !context.Bool("check") {
+			return errors.WithStack(err)
+		}
 
+		result, err := version.Check(context.Context)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if result.UpdateAvailable {
+			v = fmt.Sprintf("A newer version %s is available: %s\nRun \"singularity self-update\" to upgrade.\n", result.Latest, result.ReleaseURL)
+		} else {
+			v = fmt.Sprintf("singularity is up to date with the latest release %s\n", result.Latest)
+		}
+		_, err = context.App.Writer.Write([]byte(v))
 		return errors.WithStack(err)
 	},
 }
@@ -0,0 +1,34 @@
+package wallet
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/wallet"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/urfave/cli/v2"
+)
+
+var BalanceCmd = &cli.Command{
+	Name:  "balance",
+	Usage: "List the balance and the datacap of all imported wallets",
+	Description: "The balances are looked up from the Lotus API set with --lotus-api, which can be a Lotus gateway such as Glif.\n" +
+		"The balance is in FIL, and the datacap in bytes. The balances are only listed; the deal tracker saves them with the\n" +
+		"wallets on each run.",
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+
+		lotusClient := util.NewLotusClient(c.String("lotus-api"), c.String("lotus-token"))
+		balances, err := wallet.Default.BalanceHandler(c.Context, db, lotusClient)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		cliutil.Print(c, balances)
+		return nil
+	},
+}
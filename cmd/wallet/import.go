@@ -9,6 +9,7 @@ import (
 	"github.com/data-preservation-programs/singularity/cmd/cliutil"
 	"github.com/data-preservation-programs/singularity/database"
 	"github.com/data-preservation-programs/singularity/handler/wallet"
+	"github.com/data-preservation-programs/singularity/i18n"
 	"github.com/data-preservation-programs/singularity/util"
 	"github.com/urfave/cli/v2"
 )
@@ -17,6 +18,15 @@ var ImportCmd = &cli.Command{
 	Name:      "import",
 	Usage:     "Import a wallet from exported private key",
 	ArgsUsage: "[path, or stdin if omitted]",
+	Description: "The private key is the one exported with 'lotus wallet export'.\n" +
+		"To keep the key in a hardware security module such as a YubiHSM 2, import the PKCS#11 URI of a secp256k1 key instead, i.e.\n" +
+		"  pkcs11:token=wallet;object=client?pin-source=/etc/singularity/pin\n" +
+		"Only the URI is stored. The PIN is read from the pin-source file or the SINGULARITY_PKCS11_PIN environment variable when signing.\n" +
+		"The token is looked for in the PKCS#11 modules allowed with the global --pkcs11-module flag, i.e. /usr/lib/pkcs11/yubihsm_pkcs11.so.\n" +
+		"To delegate signing to a remote wallet such as a lotus-wallet daemon, import the lotus-wallet URI of the key instead, i.e.\n" +
+		"  lotus-wallet:f1abc...?api=http://127.0.0.1:1777/rpc/v0&token-source=/etc/singularity/wallet-token\n" +
+		"Only the URI is stored. The API token is read from the token-source file or the SINGULARITY_REMOTE_WALLET_TOKEN environment variable when signing.\n" +
+		"Both secp256k1 and BLS keys are supported.",
 	Action: func(c *cli.Context) error {
 		db, closer, err := database.OpenFromCLI(c)
 		if err != nil {
@@ -33,7 +43,7 @@ var ImportCmd = &cli.Command{
 			privateKey = string(privateKeyBytes)
 		} else {
 			scanner := bufio.NewScanner(os.Stdin)
-			fmt.Print("Enter the private key: ")
+			fmt.Print(i18n.Sprintf(c.Context, "Enter the private key: "))
 			if scanner.Scan() {
 				privateKey = scanner.Text()
 			} else {
@@ -66,6 +66,25 @@ func TestWalletList(t *testing.T) {
 	})
 }
 
+func TestWalletBalance(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(wallet.MockWallet)
+		defer swapWalletHandler(mockHandler)()
+		mockHandler.On("BalanceHandler", mock.Anything, mock.Anything, mock.Anything).Return([]wallet.Balance{{
+			ID:      "id1",
+			Address: "address1",
+			Balance: 1.5,
+			Datacap: 1 << 40,
+		}}, nil)
+		_, _, err := runner.Run(ctx, "singularity wallet balance")
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, "singularity --verbose wallet balance")
+		require.NoError(t, err)
+	})
+}
+
 func TestWalletRemove(t *testing.T) {
 	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 		runner := NewRunner()
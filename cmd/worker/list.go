@@ -0,0 +1,31 @@
+package worker
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/cmd/cliutil"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/worker"
+	"github.com/urfave/cli/v2"
+)
+
+var ListCmd = &cli.Command{
+	Name:  "list",
+	Usage: "List the workers with their current job and throughput",
+	Description: "Workers report a heartbeat every minute and are removed once they have missed heartbeats for 5 minutes.\n" +
+		"A worker is stale if it has missed its last heartbeats, stuck if its job has not made progress for 30 minutes,\n" +
+		"and outdated if it runs another version of Singularity than this one. The throughput is the average number of\n" +
+		"bytes packed per second since the worker started.",
+	Action: func(c *cli.Context) error {
+		db, closer, err := database.OpenFromCLI(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closer.Close()
+		workers, err := worker.Default.ListHandler(c.Context, db)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cliutil.Print(c, workers)
+		return nil
+	},
+}
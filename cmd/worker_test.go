@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/worker"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func swapWorkerHandler(mockHandler worker.Handler) func() {
+	actual := worker.Default
+	worker.Default = mockHandler
+	return func() {
+		worker.Default = actual
+	}
+}
+
+func TestWorkerList(t *testing.T) {
+	testutil.OneWithoutReset(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		runner := NewRunner()
+		defer runner.Save(t)
+		mockHandler := new(worker.MockWorker)
+		defer swapWorkerHandler(mockHandler)()
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockHandler.On("ListHandler", mock.Anything, mock.Anything).Return([]worker.Status{{
+			ID:            "a2b9c8f0-6b3c-4c8e-9d61-0f2b3c4d5e6f",
+			Type:          model.DatasetWorker,
+			Hostname:      "packer-01",
+			Version:       "v0.5.0",
+			StartedAt:     now.Add(-time.Hour),
+			LastHeartbeat: now,
+			JobID:         ptr.Of(model.JobID(1)),
+			JobType:       model.Pack,
+			Preparation:   "prep",
+			ProgressAt:    &now,
+			BytesPacked:   36 << 30,
+			Throughput:    10 << 20,
+		}, {
+			ID:            "0d4e5f6a-7b8c-4d9e-8f01-2a3b4c5d6e7f",
+			Type:          model.DealPusher,
+			Hostname:      "packer-02",
+			Version:       "v0.4.0",
+			Outdated:      true,
+			StartedAt:     now.Add(-time.Hour),
+			LastHeartbeat: now.Add(-3 * time.Minute),
+			Stale:         true,
+		}}, nil)
+		_, _, err := runner.Run(ctx, "singularity worker list")
+		require.NoError(t, err)
+		_, _, err = runner.Run(ctx, "singularity --verbose worker list")
+		require.NoError(t, err)
+	})
+}
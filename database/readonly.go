@@ -0,0 +1,39 @@
+package database
+
+import (
+	"github.com/cockroachdb/errors"
+	"gorm.io/gorm"
+)
+
+var ErrReadOnly = errors.New("database is opened in read-only mode")
+
+// ReadOnly makes the database connection reject all statements that write to the database, so that an instance
+// can be pointed at the database of another instance, i.e. to audit it, without any risk of modifying it.
+// Creates, updates, deletes and raw statements fail with ErrReadOnly before they reach the database,
+// while queries run as usual.
+//
+// Parameters:
+//   - db: The database connection to make read-only.
+//
+// Returns:
+//   - An error if the callbacks cannot be registered.
+func ReadOnly(db *gorm.DB) error {
+	reject := func(db *gorm.DB) {
+		_ = db.AddError(ErrReadOnly)
+	}
+	callbacks := db.Callback()
+	err := callbacks.Create().Before("gorm:create").Register("singularity:read_only", reject)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = callbacks.Update().Before("gorm:update").Register("singularity:read_only", reject)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = callbacks.Delete().Before("gorm:delete").Register("singularity:read_only", reject)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = callbacks.Raw().Before("gorm:raw").Register("singularity:read_only", reject)
+	return errors.WithStack(err)
+}
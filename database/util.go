@@ -8,6 +8,7 @@ import (
 
 	"github.com/avast/retry-go"
 	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/urfave/cli/v2"
 	"gorm.io/gorm"
@@ -56,6 +57,8 @@ func (d *databaseLogger) Error(ctx context.Context, s string, i ...any) {
 
 func (d *databaseLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
 	sql, rowsAffected := fc()
+	// Storage configs are rendered into the statements, so their secrets are masked before logging.
+	sql = model.MaskSecretsInText(sql)
 	elapsed := time.Since(begin)
 	lvl := logging.LevelDebug
 	if len(sql) > 1000 {
@@ -93,5 +96,14 @@ func OpenWithLogger(connString string) (*gorm.DB, io.Closer, error) {
 
 func OpenFromCLI(c *cli.Context) (*gorm.DB, io.Closer, error) {
 	connString := c.String("database-connection-string")
-	return OpenWithLogger(connString)
+	db, closer, err := OpenWithLogger(connString)
+	if err != nil || !c.Bool("read-only") {
+		return db, closer, err
+	}
+	err = ReadOnly(db)
+	if err != nil {
+		_ = closer.Close()
+		return nil, nil, err
+	}
+	return db, closer, nil
 }
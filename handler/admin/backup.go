@@ -0,0 +1,393 @@
+package admin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/version"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+const (
+	BackupManifestFile = "manifest.json"
+	sqliteSnapshotFile = "singularity.db"
+	pgDumpFile         = "singularity.dump"
+)
+
+var ErrBackupVerificationFailed = errors.New("backup verification failed")
+
+type BackupRequest struct {
+	OutputDir string `json:"outputDir"` // Directory to write the backup to. It is created if it does not exist. Through the API, it is relative to the backup root of the API server.
+}
+
+type backupRootKey struct{}
+
+// WithBackupRoot returns a copy of the context that confines the backups to the root directory. The output directory
+// of a backup is then resolved relative to the root and cannot escape it. An empty root disables the backups, which is
+// how the API server runs unless it is started with a backup root.
+func WithBackupRoot(ctx context.Context, root string) context.Context {
+	return context.WithValue(ctx, backupRootKey{}, root)
+}
+
+// resolveOutputDir returns the directory a backup is written to. Without a backup root in the context, i.e. from
+// the command line, the output directory is used as is.
+func resolveOutputDir(ctx context.Context, outputDir string) (string, error) {
+	root, confined := ctx.Value(backupRootKey{}).(string)
+	if !confined {
+		return outputDir, nil
+	}
+	if root == "" {
+		return "", errors.Wrap(handlererror.ErrInvalidParameter, "backups are disabled, the API server has no backup root")
+	}
+	if !filepath.IsLocal(outputDir) {
+		return "", errors.Wrapf(handlererror.ErrInvalidParameter, "output directory %s must be a relative path within the backup root", outputDir)
+	}
+	return filepath.Join(root, outputDir), nil
+}
+
+// listBackupOutputs lists the CAR files in the output storages from the tables of the given schema, i.e. "" for the
+// database itself or "snapshot." for a snapshot attached to the connection.
+func listBackupOutputs(db *gorm.DB, schema string) ([]BackupOutput, error) {
+	var rows []struct {
+		ID          model.CarID
+		Storage     *string
+		StoragePath string
+		PieceCID    model.CID `gorm:"column:piece_cid"`
+		FileSize    int64
+	}
+	//nolint:gosec
+	err := db.Raw("SELECT c.id, s.name AS storage, c.storage_path, c.piece_cid, c.file_size FROM " + schema + "cars c " +
+		"LEFT JOIN " + schema + "storages s ON s.id = c.storage_id WHERE c.storage_path <> '' ORDER BY c.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	outputs := make([]BackupOutput, 0, len(rows))
+	for _, row := range rows {
+		output := BackupOutput{
+			CarID:       row.ID,
+			StoragePath: row.StoragePath,
+			PieceCID:    row.PieceCID.String(),
+			FileSize:    row.FileSize,
+		}
+		if row.Storage != nil {
+			output.Storage = *row.Storage
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs, nil
+}
+
+// BackupOutput is a CAR file in an output storage. The piece CID is the checksum of the CAR file.
+type BackupOutput struct {
+	CarID       model.CarID `json:"carId"`
+	Storage     string      `json:"storage"`
+	StoragePath string      `json:"storagePath"`
+	PieceCID    string      `json:"pieceCid"`
+	FileSize    int64       `json:"fileSize"`
+}
+
+// BackupManifest describes a backup of the database. Since inline prepared data can only be retrieved
+// with the database, the manifest also lists the CAR files in the output storages, so that a restored
+// database can be checked against the output directories.
+type BackupManifest struct {
+	Version   string         `json:"version"`
+	CreatedAt time.Time      `json:"createdAt"`
+	Database  string         `json:"database"`
+	File      string         `json:"file"`
+	Size      int64          `json:"size"`
+	SHA256    string         `json:"sha256"`
+	Outputs   []BackupOutput `json:"outputs"   table:"-"`
+}
+
+func sha256File(path string) (string, int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", 0, errors.WithStack(err)
+	}
+	defer file.Close()
+	hasher := sha256.New()
+	size, err := io.Copy(hasher, file)
+	if err != nil {
+		return "", 0, errors.WithStack(err)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), size, nil
+}
+
+// BackupHandler creates a consistent snapshot of the database in the output directory, together with a manifest
+// containing the checksum of the snapshot and the CAR files in the output storages. The CAR files are listed from
+// the snapshot itself, so the manifest matches the restored database exactly.
+//   - For SQLite, the snapshot is created with VACUUM INTO, which is safe while other processes are using the database.
+//     The CAR files are then read from the snapshot file.
+//   - For Postgres, the snapshot is created with pg_dump, which needs to be installed. pg_dump uses the snapshot of
+//     the transaction that lists the CAR files.
+//   - MySQL is not supported, use mysqldump --single-transaction instead.
+//
+// Through the API, the output directory is relative to the backup root of the API server, see WithBackupRoot.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The BackupRequest that specifies the output directory.
+//
+// Returns:
+//   - A pointer to the BackupManifest that has been written to the output directory.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) BackupHandler(ctx context.Context, db *gorm.DB, request BackupRequest) (*BackupManifest, error) {
+	db = db.WithContext(ctx)
+	if request.OutputDir == "" {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "output directory is required")
+	}
+	outputDir, err := resolveOutputDir(ctx, request.OutputDir)
+	if err != nil {
+		return nil, err
+	}
+	_, err = os.Stat(filepath.Join(outputDir, BackupManifestFile))
+	if err == nil {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "output directory %s already contains a backup", request.OutputDir)
+	}
+	err = os.MkdirAll(outputDir, 0755)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create output directory %s", request.OutputDir)
+	}
+
+	manifest := BackupManifest{
+		Version:   version.Version,
+		CreatedAt: time.Now().UTC(),
+		Database:  db.Dialector.Name(),
+		Outputs:   make([]BackupOutput, 0),
+	}
+
+	switch manifest.Database {
+	case "sqlite":
+		manifest.File = sqliteSnapshotFile
+		snapshot := filepath.Join(outputDir, manifest.File)
+		err = db.Exec("VACUUM INTO ?", snapshot).Error
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create sqlite snapshot")
+		}
+		// VACUUM INTO cannot run in a transaction, so the CAR files are read from the snapshot on a single connection
+		err = db.Connection(func(db *gorm.DB) error {
+			err := db.Exec("ATTACH DATABASE ? AS snapshot", snapshot).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer db.Exec("DETACH DATABASE snapshot")
+			manifest.Outputs, err = listBackupOutputs(db, "snapshot.")
+			return err
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list the CAR files of the sqlite snapshot")
+		}
+	case "postgres":
+		dialector, ok := db.Dialector.(*postgres.Dialector)
+		if !ok {
+			return nil, errors.Wrapf(database.ErrDatabaseNotSupported, "unexpected postgres dialector")
+		}
+		manifest.File = pgDumpFile
+		// The snapshot of the transaction is exported to pg_dump, so the dump and the CAR files are from the same state
+		err = db.Transaction(func(db *gorm.DB) error {
+			err := db.Exec("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ").Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			var snapshotID string
+			err = db.Raw("SELECT pg_export_snapshot()").Scan(&snapshotID).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			manifest.Outputs, err = listBackupOutputs(db, "")
+			if err != nil {
+				return err
+			}
+			//nolint:gosec
+			cmd := exec.CommandContext(ctx, "pg_dump", "--format=custom", "--snapshot="+snapshotID,
+				"--file="+filepath.Join(outputDir, manifest.File), "--dbname="+dialector.Config.DSN)
+			output, err := cmd.CombinedOutput()
+			if err != nil {
+				return errors.Wrapf(err, "pg_dump failed: %s", string(output))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Wrapf(database.ErrDatabaseNotSupported,
+			"backup of %s is not supported, please use the native backup tool of the database", manifest.Database)
+	}
+
+	manifest.SHA256, manifest.Size, err = sha256File(filepath.Join(outputDir, manifest.File))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to checksum snapshot")
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = os.WriteFile(filepath.Join(outputDir, BackupManifestFile), data, 0644)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write manifest")
+	}
+	return &manifest, nil
+}
+
+// ReadBackupManifest reads the manifest of a backup and verifies the checksum of the snapshot.
+func ReadBackupManifest(dir string) (*BackupManifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, BackupManifestFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read manifest")
+	}
+	var manifest BackupManifest
+	err = json.Unmarshal(data, &manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse manifest")
+	}
+	checksum, size, err := sha256File(filepath.Join(dir, manifest.File))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to checksum snapshot")
+	}
+	if checksum != manifest.SHA256 || size != manifest.Size {
+		return nil, errors.Wrapf(ErrBackupVerificationFailed, "snapshot %s does not match the checksum in the manifest", manifest.File)
+	}
+	return &manifest, nil
+}
+
+// restoreSQLite replaces the database file with the snapshot. The write-ahead log of the old database is removed.
+func restoreSQLite(snapshot string, connString string) error {
+	target := strings.TrimPrefix(connString, "sqlite:")
+	target, _, _ = strings.Cut(target, "?")
+	target = strings.TrimPrefix(target, "file:")
+	if target == "" || strings.HasPrefix(target, ":memory:") {
+		return errors.Wrap(database.ErrDatabaseNotSupported, "cannot restore to an in-memory database")
+	}
+
+	src, err := os.Open(snapshot)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer src.Close()
+	tmp := target + ".restore"
+	dst, err := os.Create(tmp)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = io.Copy(dst, src)
+	if err != nil {
+		dst.Close()
+		return errors.WithStack(err)
+	}
+	err = dst.Close()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, suffix := range []string{"-wal", "-shm"} {
+		err = os.Remove(target + suffix)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.WithStack(err)
+		}
+	}
+	return errors.WithStack(os.Rename(tmp, target))
+}
+
+// verifyRestore checks that the restored database references the same CAR files as listed in the manifest.
+func verifyRestore(ctx context.Context, db *gorm.DB, manifest BackupManifest) error {
+	var cars []model.Car
+	err := db.WithContext(ctx).Where("storage_path <> ''").Order("id asc").Find(&cars).Error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if len(cars) != len(manifest.Outputs) {
+		return errors.Wrapf(ErrBackupVerificationFailed, "restored database has %d output CAR files, manifest has %d",
+			len(cars), len(manifest.Outputs))
+	}
+	for i, car := range cars {
+		output := manifest.Outputs[i]
+		if car.ID != output.CarID || car.PieceCID.String() != output.PieceCID || car.StoragePath != output.StoragePath {
+			return errors.Wrapf(ErrBackupVerificationFailed, "restored CAR file %d does not match the manifest", car.ID)
+		}
+	}
+	return nil
+}
+
+// RestoreBackup restores a backup created by BackupHandler into the database of the connection string,
+// and verifies the restored database against the manifest.
+// All singularity services using the database must be stopped before restoring.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - connString: The connection string of the database to restore into.
+//   - dir: The directory that contains the backup.
+//
+// Returns:
+//   - A pointer to the BackupManifest of the restored backup.
+//   - An error, if the backup fails verification or cannot be restored.
+func RestoreBackup(ctx context.Context, connString string, dir string) (*BackupManifest, error) {
+	manifest, err := ReadBackupManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := filepath.Join(dir, manifest.File)
+
+	switch manifest.Database {
+	case "sqlite":
+		if !strings.HasPrefix(connString, "sqlite:") {
+			return nil, errors.Wrap(handlererror.ErrInvalidParameter, "a sqlite backup can only be restored to a sqlite database")
+		}
+		err = restoreSQLite(snapshot, connString)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to restore sqlite database")
+		}
+	case "postgres":
+		if !strings.HasPrefix(connString, "postgres:") {
+			return nil, errors.Wrap(handlererror.ErrInvalidParameter, "a postgres backup can only be restored to a postgres database")
+		}
+		//nolint:gosec
+		cmd := exec.CommandContext(ctx, "pg_restore", "--clean", "--if-exists", "--no-owner", "--single-transaction",
+			"--dbname="+connString, snapshot)
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			return nil, errors.Wrapf(err, "pg_restore failed: %s", string(output))
+		}
+	default:
+		return nil, errors.Wrapf(database.ErrDatabaseNotSupported, "restore of %s is not supported", manifest.Database)
+	}
+
+	db, closer, err := database.OpenWithLogger(connString)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer closer.Close()
+	err = verifyRestore(ctx, db, *manifest)
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// @ID Backup
+// @Summary Create a consistent snapshot of the database
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body BackupRequest true "Backup Request"
+// @Success 200 {object} BackupManifest
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /admin/backup [post]
+func _() {}
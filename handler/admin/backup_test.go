@@ -0,0 +1,81 @@
+package admin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		if db.Dialector.Name() != "sqlite" {
+			t.Skip("pg_dump and pg_restore may not be available")
+		}
+		require.NoError(t, db.Create(&model.Preparation{Name: "prep"}).Error)
+		require.NoError(t, db.Create(&model.Storage{Name: "output", Type: "local", Path: "/tmp/output"}).Error)
+		storageID := model.StorageID(1)
+		require.NoError(t, db.Create(&model.Car{
+			PreparationID: 1,
+			StorageID:     &storageID,
+			StoragePath:   "test.car",
+			PieceCID:      model.CID(testutil.TestCid),
+			FileSize:      100,
+		}).Error)
+
+		dir := t.TempDir()
+		manifest, err := Default.BackupHandler(ctx, db, BackupRequest{OutputDir: dir})
+		require.NoError(t, err)
+		require.Equal(t, "sqlite", manifest.Database)
+		require.Len(t, manifest.Outputs, 1)
+		require.Equal(t, "output", manifest.Outputs[0].Storage)
+		require.Equal(t, testutil.TestCid.String(), manifest.Outputs[0].PieceCID)
+
+		_, err = Default.BackupHandler(ctx, db, BackupRequest{OutputDir: dir})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		connString := "sqlite:" + filepath.Join(t.TempDir(), "restored.db")
+		restored, err := RestoreBackup(ctx, connString, dir)
+		require.NoError(t, err)
+		require.Equal(t, manifest.SHA256, restored.SHA256)
+
+		restoredDB, closer, err := database.OpenWithLogger(connString)
+		require.NoError(t, err)
+		defer closer.Close()
+		var car model.Car
+		require.NoError(t, restoredDB.First(&car).Error)
+		require.Equal(t, "test.car", car.StoragePath)
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, manifest.File), []byte("corrupted"), 0644))
+		_, err = RestoreBackup(ctx, connString, dir)
+		require.ErrorIs(t, err, ErrBackupVerificationFailed)
+	})
+}
+
+func TestBackupHandler_BackupRoot(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		if db.Dialector.Name() != "sqlite" {
+			t.Skip("pg_dump may not be available")
+		}
+		_, err := Default.BackupHandler(WithBackupRoot(ctx, ""), db, BackupRequest{OutputDir: "backup"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		root := t.TempDir()
+		for _, outputDir := range []string{"../backup", "/tmp/backup"} {
+			_, err = Default.BackupHandler(WithBackupRoot(ctx, root), db, BackupRequest{OutputDir: outputDir})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		}
+
+		manifest, err := Default.BackupHandler(WithBackupRoot(ctx, root), db, BackupRequest{OutputDir: "backup"})
+		require.NoError(t, err)
+		require.FileExists(t, filepath.Join(root, "backup", manifest.File))
+		require.FileExists(t, filepath.Join(root, "backup", BackupManifestFile))
+	})
+}
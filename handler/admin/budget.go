@@ -0,0 +1,216 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/budget"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/dustin/go-humanize"
+	"gorm.io/gorm"
+)
+
+type SetBudgetRequest struct {
+	Preparation string   `json:"preparation"` // ID or name of the preparation. The global budget, which counts all deals, is set if empty.
+	Datacap     string   `json:"datacap"`     // Maximum size of verified deals in human readable format, i.e. 1PiB. 0 removes the limit. Unchanged if empty.
+	FIL         *float64 `json:"fil"`         // Maximum price of deals in FIL. 0 removes the limit. Unchanged if not set.
+}
+
+type OverrideBudgetRequest struct {
+	Preparation string        `json:"preparation"` // ID or name of the preparation. The global budget is overridden if empty.
+	Duration    time.Duration `json:"duration"`    // How long the budget may be exceeded. 0 ends the override.
+}
+
+type BudgetStatus struct {
+	ID            model.BudgetID `json:"id"`
+	Scope         string         `json:"scope"`         // Name of the preparation, or global
+	Datacap       int64          `json:"datacap"`       // Maximum size of verified deals in bytes, 0 if unlimited
+	DatacapUsed   int64          `json:"datacapUsed"`   // Size of verified deals in bytes
+	FIL           float64        `json:"fil"`           // Maximum price of deals in FIL, 0 if unlimited
+	FILUsed       float64        `json:"filUsed"`       // Price of deals in FIL
+	OverrideUntil *time.Time     `json:"overrideUntil"` // The budget may be exceeded until this time
+}
+
+// findBudget returns the budget of a preparation, or the global budget if the preparation is empty. If the budget does
+// not exist, a new unsaved budget is returned.
+func findBudget(db *gorm.DB, preparation string) (*model.Budget, error) {
+	query := db.Where("preparation_id IS NULL")
+	var preparationID *model.PreparationID
+	if preparation != "" {
+		var prep model.Preparation
+		err := prep.FindByIDOrName(db, preparation)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation %s does not exist", preparation)
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		query = db.Where("preparation_id = ?", prep.ID)
+		preparationID = &prep.ID
+	}
+
+	var found model.Budget
+	err := query.First(&found).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &model.Budget{PreparationID: preparationID}, nil
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &found, nil
+}
+
+// SetBudgetHandler sets the datacap and FIL budget of a preparation, or the global budget that counts all deals. The
+// deal pusher does not make a deal that would exceed a budget unless it is overridden, and alerts once 80% of a budget
+// is consumed. Changing a limit allows it to be alerted on again. The spent datacap and FIL of the budget are summed
+// from its deals again, and are then kept up to date by the deal pusher and the deal tracker.
+//
+// Like operators, budgets are only managed with direct access to the database, not through the API.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The SetBudgetRequest with the preparation and the new limits.
+//
+// Returns:
+//   - The updated budget.
+//   - An error, if the preparation does not exist, if no limit is given or is invalid, or if any other error occurred.
+func (DefaultHandler) SetBudgetHandler(ctx context.Context, db *gorm.DB, request SetBudgetRequest) (*model.Budget, error) {
+	db = db.WithContext(ctx)
+	if request.Datacap == "" && request.FIL == nil {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "either a datacap or a FIL limit is required")
+	}
+	found, err := findBudget(db, request.Preparation)
+	if err != nil {
+		return nil, err
+	}
+	if request.Datacap != "" {
+		datacap, err := humanize.ParseBytes(request.Datacap)
+		if err != nil {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid datacap %s", request.Datacap)
+		}
+		found.Datacap = int64(datacap)
+		found.DatacapAlerted = false
+	}
+	if request.FIL != nil {
+		if *request.FIL < 0 {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid FIL limit %g", *request.FIL)
+		}
+		found.FIL = *request.FIL
+		found.FILAlerted = false
+	}
+	err = budget.Sync(db, found)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = database.DoRetry(ctx, func() error {
+		return db.Save(found).Error
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return found, nil
+}
+
+// ListBudgetsHandler lists all budgets with the datacap and FIL spent by the deals that count towards them.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//
+// Returns:
+//   - A slice of BudgetStatus, with the global budget first.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) ListBudgetsHandler(ctx context.Context, db *gorm.DB) ([]BudgetStatus, error) {
+	db = db.WithContext(ctx)
+	var budgets []model.Budget
+	err := db.Preload("Preparation").Order("preparation_id IS NOT NULL, preparation_id asc").Find(&budgets).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	statuses := make([]BudgetStatus, 0, len(budgets))
+	for _, b := range budgets {
+		scope := "global"
+		if b.Preparation != nil {
+			scope = b.Preparation.Name
+		}
+		statuses = append(statuses, BudgetStatus{
+			ID:            b.ID,
+			Scope:         scope,
+			Datacap:       b.Datacap,
+			DatacapUsed:   b.DatacapSpent,
+			FIL:           b.FIL,
+			FILUsed:       b.FILSpent,
+			OverrideUntil: b.OverrideUntil,
+		})
+	}
+	return statuses, nil
+}
+
+// RemoveBudgetHandler removes the budget of a preparation, or the global budget.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - preparation: The ID or name of the preparation, or empty for the global budget.
+//
+// Returns:
+//   - An error, if the preparation or the budget does not exist or if any other error occurred.
+func (DefaultHandler) RemoveBudgetHandler(ctx context.Context, db *gorm.DB, preparation string) error {
+	db = db.WithContext(ctx)
+	found, err := findBudget(db, preparation)
+	if err != nil {
+		return err
+	}
+	if found.ID == 0 {
+		return errors.Wrap(handlererror.ErrNotFound, "budget does not exist")
+	}
+	return database.DoRetry(ctx, func() error {
+		return db.Delete(found).Error
+	})
+}
+
+// OverrideBudgetHandler allows the deal pusher to exceed the budget of a preparation, or the global budget, for a
+// limited time. Alerts are still sent while a budget is overridden.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The OverrideBudgetRequest with the preparation and how long the budget may be exceeded.
+//
+// Returns:
+//   - The overridden budget.
+//   - An error, if the preparation or the budget does not exist, if the duration is negative, or if any other error
+//     occurred.
+func (DefaultHandler) OverrideBudgetHandler(ctx context.Context, db *gorm.DB, request OverrideBudgetRequest) (*model.Budget, error) {
+	db = db.WithContext(ctx)
+	if request.Duration < 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid duration %s", request.Duration)
+	}
+	found, err := findBudget(db, request.Preparation)
+	if err != nil {
+		return nil, err
+	}
+	if found.ID == 0 {
+		return nil, errors.Wrap(handlererror.ErrNotFound, "budget does not exist")
+	}
+
+	var until *time.Time
+	if request.Duration > 0 {
+		t := time.Now().Add(request.Duration)
+		until = &t
+	}
+	err = database.DoRetry(ctx, func() error {
+		return db.Model(found).Update("override_until", until).Error
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	found.OverrideUntil = until
+	return found, nil
+}
@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestBudgetHandlers(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		require.NoError(t, db.Create(&model.Preparation{Name: "prep"}).Error)
+		schedule := model.Schedule{PreparationID: 1}
+		require.NoError(t, db.Create(&schedule).Error)
+		require.NoError(t, db.Create(&model.Deal{
+			State:      model.DealActive,
+			PieceSize:  1 << 35,
+			Verified:   true,
+			Price:      "2000000000000000000",
+			ScheduleID: &schedule.ID,
+			Wallet:     &model.Wallet{ID: "f01"},
+		}).Error)
+
+		_, err := Default.SetBudgetHandler(ctx, db, SetBudgetRequest{})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		_, err = Default.SetBudgetHandler(ctx, db, SetBudgetRequest{Preparation: "other", Datacap: "1PiB"})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+		_, err = Default.SetBudgetHandler(ctx, db, SetBudgetRequest{Datacap: "lots"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		global, err := Default.SetBudgetHandler(ctx, db, SetBudgetRequest{FIL: ptr.Of(100.0)})
+		require.NoError(t, err)
+		require.Nil(t, global.PreparationID)
+		prep, err := Default.SetBudgetHandler(ctx, db, SetBudgetRequest{Preparation: "prep", Datacap: "1PiB"})
+		require.NoError(t, err)
+		require.EqualValues(t, 1<<50, prep.Datacap)
+
+		// Updating a budget keeps the other limit and resets the alert of the changed one
+		require.NoError(t, db.Model(prep).Updates(map[string]any{"datacap_alerted": true, "fil_alerted": true}).Error)
+		updated, err := Default.SetBudgetHandler(ctx, db, SetBudgetRequest{Preparation: "1", FIL: ptr.Of(10.0)})
+		require.NoError(t, err)
+		require.Equal(t, prep.ID, updated.ID)
+		require.EqualValues(t, 1<<50, updated.Datacap)
+		require.True(t, updated.DatacapAlerted)
+		require.False(t, updated.FILAlerted)
+
+		statuses, err := Default.ListBudgetsHandler(ctx, db)
+		require.NoError(t, err)
+		require.Len(t, statuses, 2)
+		require.Equal(t, "global", statuses[0].Scope)
+		require.EqualValues(t, 100, statuses[0].FIL)
+		require.EqualValues(t, 2, statuses[0].FILUsed)
+		require.Equal(t, "prep", statuses[1].Scope)
+		require.EqualValues(t, 1<<35, statuses[1].DatacapUsed)
+
+		overridden, err := Default.OverrideBudgetHandler(ctx, db, OverrideBudgetRequest{Preparation: "prep", Duration: time.Hour})
+		require.NoError(t, err)
+		require.NotNil(t, overridden.OverrideUntil)
+		overridden, err = Default.OverrideBudgetHandler(ctx, db, OverrideBudgetRequest{Preparation: "prep"})
+		require.NoError(t, err)
+		require.Nil(t, overridden.OverrideUntil)
+		_, err = Default.OverrideBudgetHandler(ctx, db, OverrideBudgetRequest{Duration: -time.Hour})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		require.NoError(t, Default.RemoveBudgetHandler(ctx, db, ""))
+		require.ErrorIs(t, Default.RemoveBudgetHandler(ctx, db, ""), handlererror.ErrNotFound)
+		_, err = Default.OverrideBudgetHandler(ctx, db, OverrideBudgetRequest{Duration: time.Hour})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+	})
+}
@@ -0,0 +1,251 @@
+package admin
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/budget"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/ipfs/go-log/v2"
+	"gorm.io/gorm"
+)
+
+var forecastLogger = log.Logger("storage-forecast")
+
+const (
+	// DefaultForecastDuration is the default window and warning duration of the storage forecast
+	DefaultForecastDuration = 7 * 24 * time.Hour
+	// minForecastElapsed avoids extrapolating the rate of a preparation that has just started writing CAR files
+	minForecastElapsed = time.Hour
+)
+
+type StorageForecastRequest struct {
+	Window       string `default:"168h" json:"window"`     // How far back to look at the CAR files written to compute the output rates, i.e. 168h for a week
+	WarnWithin   string `default:"168h" json:"warnWithin"` // Warn about output storages forecast to fill within this duration, i.e. 72h
+	AlertWebhook string `json:"alertWebhook"`              // URL that the warnings are posted to as JSON, the same webhook as the budget alerts of the deal pusher. Warnings are always logged.
+}
+
+type PreparationOutputRate struct {
+	PreparationID model.PreparationID `json:"preparationId"`
+	Preparation   string              `json:"preparation"`
+	NumOfCars     int64               `json:"numOfCars"`   // Number of CAR files written to the storage within the window
+	Bytes         int64               `json:"bytes"`       // Total size of the CAR files written to the storage within the window
+	BytesPerDay   int64               `json:"bytesPerDay"` // Average output rate of the preparation to the storage
+}
+
+type StorageForecast struct {
+	StorageID    model.StorageID         `json:"storageId"`
+	Storage      string                  `json:"storage"`
+	Path         string                  `json:"path"`
+	Total        int64                   `json:"total"`             // Total size of the storage as reported by the storage system. -1 if unknown.
+	Free         int64                   `json:"free"`              // Free space of the storage as reported by the storage system. -1 if unknown.
+	BytesPerDay  int64                   `json:"bytesPerDay"`       // Combined output rate of all preparations writing to the storage
+	FullAt       *time.Time              `json:"fullAt,omitempty"`  // When the storage is forecast to be full at the current output rate. Empty if unknown or nothing is written.
+	Warning      string                  `json:"warning,omitempty"` // Why the storage needs attention, if any
+	Preparations []PreparationOutputRate `json:"preparations"            table:"expand"`
+}
+
+// StorageAlert is posted to the alert webhook for each output storage that needs attention.
+type StorageAlert struct {
+	Type        string          `json:"type"` // Always storage, to tell the alert apart from the budget alerts posted to the same webhook
+	StorageID   model.StorageID `json:"storageId"`
+	Storage     string          `json:"storage"`
+	Path        string          `json:"path"`
+	Free        int64           `json:"free"`
+	BytesPerDay int64           `json:"bytesPerDay"`
+	FullAt      *time.Time      `json:"fullAt,omitempty"`
+	Warning     string          `json:"warning"`
+	Time        time.Time       `json:"time"`
+}
+
+// StorageForecastHandler tracks how fast each preparation has been writing CAR files to its output storages and
+// forecasts when each output storage will be full, so operators can add capacity before packing stalls.
+//
+// The output rate of a preparation is the total size of the CAR files it wrote to the storage within the window,
+// divided by the time elapsed since the start of the window, or since the preparation was created if that is later.
+// The free space is reported by the storage system, i.e. the file system of a local directory; storages that do not
+// report their usage get no forecast. Storages forecast to be full within the warning duration, or that are already
+// full, get a warning, which is also logged and posted to the alert webhook through the same notifier as the budget
+// alerts. The warnings are sent on every run, so the forecast is meant to be run periodically, i.e. by cron.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The StorageForecastRequest with the window, the warning duration and the alert webhook.
+//
+// Returns:
+//   - A slice of StorageForecast, one for each output storage, sorted by storage ID.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) StorageForecastHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	request StorageForecastRequest,
+) ([]StorageForecast, error) {
+	db = db.WithContext(ctx)
+	window, err := parseForecastDuration(request.Window, "window")
+	if err != nil {
+		return nil, err
+	}
+	warnWithin, err := parseForecastDuration(request.WarnWithin, "warnWithin")
+	if err != nil {
+		return nil, err
+	}
+
+	var preparations []model.Preparation
+	err = db.Preload("OutputStorages").Find(&preparations).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	now := time.Now()
+	start := now.Add(-window)
+
+	type outputRow struct {
+		PreparationID model.PreparationID
+		StorageID     model.StorageID
+		NumOfCars     int64
+		Bytes         int64
+	}
+	var rows []outputRow
+	err = db.Model(&model.Car{}).
+		Select("preparation_id, storage_id, COUNT(*) AS num_of_cars, SUM(file_size) AS bytes").
+		Where("storage_id IS NOT NULL AND created_at >= ?", start.UTC()).
+		Group("preparation_id, storage_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	forecasts := make(map[model.StorageID]*StorageForecast)
+	for _, preparation := range preparations {
+		for _, storage := range preparation.OutputStorages {
+			forecast, ok := forecasts[storage.ID]
+			if !ok {
+				forecast = &StorageForecast{
+					StorageID:    storage.ID,
+					Storage:      storage.Name,
+					Path:         storage.Path,
+					Preparations: []PreparationOutputRate{},
+				}
+				forecast.Total, forecast.Free, err = storageUsage(ctx, storage)
+				if err != nil {
+					forecastLogger.Warnw("failed to get the usage of the storage", "storage", storage.Name, "error", err)
+				}
+				forecasts[storage.ID] = forecast
+			}
+			rate := PreparationOutputRate{
+				PreparationID: preparation.ID,
+				Preparation:   preparation.Name,
+			}
+			for _, row := range rows {
+				if row.PreparationID == preparation.ID && row.StorageID == storage.ID {
+					rate.NumOfCars = row.NumOfCars
+					rate.Bytes = row.Bytes
+				}
+			}
+			elapsed := now.Sub(start)
+			if preparation.CreatedAt.After(start) {
+				elapsed = now.Sub(preparation.CreatedAt)
+			}
+			if elapsed < minForecastElapsed {
+				elapsed = minForecastElapsed
+			}
+			rate.BytesPerDay = int64(float64(rate.Bytes) / elapsed.Hours() * 24)
+			forecast.BytesPerDay += rate.BytesPerDay
+			forecast.Preparations = append(forecast.Preparations, rate)
+		}
+	}
+
+	notifier := budget.NewNotifier(request.AlertWebhook)
+	result := make([]StorageForecast, 0, len(forecasts))
+	for _, forecast := range forecasts {
+		switch {
+		case forecast.Free < 0:
+			// The storage does not report its usage
+		case forecast.Free == 0:
+			forecast.FullAt = &now
+			forecast.Warning = "storage is full"
+		case forecast.BytesPerDay > 0:
+			untilFull := float64(forecast.Free) / float64(forecast.BytesPerDay) * float64(24*time.Hour)
+			if untilFull >= math.MaxInt64 {
+				// Too far in the future to be represented
+				break
+			}
+			fullAt := now.Add(time.Duration(untilFull))
+			forecast.FullAt = &fullAt
+			if fullAt.Sub(now) <= warnWithin {
+				forecast.Warning = "storage is forecast to be full within " + warnWithin.String()
+			}
+		}
+		if forecast.Warning != "" {
+			forecastLogger.Warnw(forecast.Warning, "storage", forecast.Storage, "path", forecast.Path,
+				"free", forecast.Free, "bytesPerDay", forecast.BytesPerDay, "fullAt", forecast.FullAt)
+			notifier.Notify(ctx, StorageAlert{
+				Type:        budget.AlertTypeStorage,
+				StorageID:   forecast.StorageID,
+				Storage:     forecast.Storage,
+				Path:        forecast.Path,
+				Free:        forecast.Free,
+				BytesPerDay: forecast.BytesPerDay,
+				FullAt:      forecast.FullAt,
+				Warning:     forecast.Warning,
+				Time:        now,
+			})
+		}
+		result = append(result, *forecast)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StorageID < result[j].StorageID
+	})
+	return result, nil
+}
+
+func parseForecastDuration(value string, name string) (time.Duration, error) {
+	if value == "" {
+		return DefaultForecastDuration, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid %s %s", name, value)
+	}
+	return duration, nil
+}
+
+// storageUsage returns the total size and the free space of the storage, or -1 if the storage does not report them.
+func storageUsage(ctx context.Context, storage model.Storage) (int64, int64, error) {
+	handler, err := storagesystem.NewRCloneHandler(ctx, storage)
+	if err != nil {
+		return -1, -1, errors.WithStack(err)
+	}
+	usage, err := handler.About(ctx)
+	if err != nil {
+		return -1, -1, errors.WithStack(err)
+	}
+	total, free := int64(-1), int64(-1)
+	if usage.Total != nil {
+		total = *usage.Total
+	}
+	switch {
+	case usage.Free != nil:
+		free = *usage.Free
+	case usage.Total != nil && usage.Used != nil:
+		free = *usage.Total - *usage.Used
+	}
+	return total, free, nil
+}
+
+// @ID StorageForecast
+// @Summary Forecast when each output storage will be full based on the output rates of the preparations
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body StorageForecastRequest true "Request body"
+// @Success 200 {array} StorageForecast
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /admin/storage-forecast [post]
+func _() {}
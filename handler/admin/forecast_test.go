@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/budget"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestStorageForecastHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		now := time.Now().UTC()
+		err := db.Create(&model.Preparation{
+			Name:      "prep",
+			CreatedAt: now.Add(-48 * time.Hour),
+			OutputStorages: []model.Storage{{
+				Name: "output",
+				Type: "local",
+				Path: t.TempDir(),
+			}},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create([]model.Car{
+			{PreparationID: 1, StorageID: ptr.Of(model.StorageID(1)), FileSize: 1 << 30, CreatedAt: now.Add(-24 * time.Hour), PieceCID: model.CID(testutil.TestCid)},
+			{PreparationID: 1, StorageID: ptr.Of(model.StorageID(1)), FileSize: 1 << 30, CreatedAt: now.Add(-time.Hour), PieceCID: model.CID(testutil.TestCid)},
+			// Outside of the window
+			{PreparationID: 1, StorageID: ptr.Of(model.StorageID(1)), FileSize: 1 << 40, CreatedAt: now.Add(-10 * 24 * time.Hour), PieceCID: model.CID(testutil.TestCid)},
+		}).Error
+		require.NoError(t, err)
+
+		forecasts, err := Default.StorageForecastHandler(ctx, db, StorageForecastRequest{Window: "168h", WarnWithin: "1s"})
+		require.NoError(t, err)
+		require.Len(t, forecasts, 1)
+		forecast := forecasts[0]
+		require.Equal(t, "output", forecast.Storage)
+		require.Len(t, forecast.Preparations, 1)
+		require.EqualValues(t, 2, forecast.Preparations[0].NumOfCars)
+		require.EqualValues(t, 2<<30, forecast.Preparations[0].Bytes)
+		// 2GiB written since the preparation was created 2 days ago
+		require.InDelta(t, 1<<30, forecast.BytesPerDay, 1<<20)
+		require.Positive(t, forecast.Free)
+		require.NotNil(t, forecast.FullAt)
+		require.True(t, forecast.FullAt.After(time.Now()))
+		require.Empty(t, forecast.Warning)
+
+		var alerts []StorageAlert
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var alert StorageAlert
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+			alerts = append(alerts, alert)
+		}))
+		defer server.Close()
+		forecasts, err = Default.StorageForecastHandler(ctx, db, StorageForecastRequest{Window: "168h", WarnWithin: "2000000h", AlertWebhook: server.URL})
+		require.NoError(t, err)
+		require.Contains(t, forecasts[0].Warning, "forecast to be full")
+		require.Len(t, alerts, 1)
+		require.Equal(t, budget.AlertTypeStorage, alerts[0].Type)
+		require.Equal(t, "output", alerts[0].Storage)
+		require.Equal(t, forecasts[0].Warning, alerts[0].Warning)
+	})
+}
+
+func TestStorageForecastHandler_Invalid(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.StorageForecastHandler(ctx, db, StorageForecastRequest{Window: "-1h"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		_, err = Default.StorageForecastHandler(ctx, db, StorageForecastRequest{WarnWithin: "soon"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		forecasts, err := Default.StorageForecastHandler(ctx, db, StorageForecastRequest{})
+		require.NoError(t, err)
+		require.Empty(t, forecasts)
+	})
+}
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+type FullTextSearchRequest struct {
+	Disable bool `json:"disable"` // Remove the full-text index instead of creating it
+}
+
+// FullTextSearchHandler creates or removes the optional full-text index over the path of all files, which is used
+// by the file search to avoid scanning the whole files table on large databases.
+//
+// The index is supported by SQLite, as long as it is built with FTS5, and by Postgres. Creating it on an existing
+// database indexes all paths, which may take a while for databases with hundreds of millions of files.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The FullTextSearchRequest telling whether to create or remove the index.
+//
+// Returns:
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) FullTextSearchHandler(ctx context.Context, db *gorm.DB, request FullTextSearchRequest) error {
+	db = db.WithContext(ctx)
+	if request.Disable {
+		return errors.WithStack(model.DisableFullTextSearch(db))
+	}
+
+	err := model.EnableFullTextSearch(db)
+	if errors.Is(err, model.ErrFullTextSearchNotSupported) {
+		return errors.Wrap(handlererror.ErrInvalidParameter, err.Error())
+	}
+	return errors.WithStack(err)
+}
+
+// @ID FullTextSearch
+// @Summary Create or remove the full-text index used to search files by path
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body FullTextSearchRequest true "Request body"
+// @Success 204
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /admin/full-text-search [post]
+func _() {}
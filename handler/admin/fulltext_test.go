@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestFullTextSearchHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		attachment := model.SourceAttachment{
+			Preparation: &model.Preparation{Name: "prep"},
+			Storage:     &model.Storage{Name: "source", Type: "local"},
+		}
+		require.NoError(t, db.Create(&attachment).Error)
+		require.NoError(t, db.Create(&model.File{Path: "2024/06/report_final.csv", AttachmentID: attachment.ID}).Error)
+
+		err := Default.FullTextSearchHandler(ctx, db, FullTextSearchRequest{})
+		if db.Dialector.Name() == "mysql" {
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+			return
+		}
+		if errors.Is(err, handlererror.ErrInvalidParameter) {
+			t.Skip("SQLite is built without FTS5")
+		}
+		require.NoError(t, err)
+		enabled, err := model.FullTextSearchEnabled(db)
+		require.NoError(t, err)
+		require.True(t, enabled)
+
+		// Files created after the index are indexed too
+		require.NoError(t, db.Create(&model.File{Path: "2024/07/reports/summary.txt", AttachmentID: attachment.ID}).Error)
+
+		search := func(term string) []string {
+			condition, arg, ok := model.FullTextPathCondition(db, term)
+			require.True(t, ok)
+			var paths []string
+			require.NoError(t, db.Model(&model.File{}).Where(condition, arg).Order("id").Pluck("path", &paths).Error)
+			return paths
+		}
+		require.Equal(t, []string{"2024/06/report_final.csv", "2024/07/reports/summary.txt"}, search("report"))
+		require.Equal(t, []string{"2024/06/report_final.csv"}, search("2024 FINAL.csv"))
+		require.Empty(t, search("port"))
+
+		require.NoError(t, Default.FullTextSearchHandler(ctx, db, FullTextSearchRequest{Disable: true}))
+		enabled, err = model.FullTextSearchEnabled(db)
+		require.NoError(t, err)
+		require.False(t, enabled)
+		require.NoError(t, db.Create(&model.File{Path: "after.txt", AttachmentID: attachment.ID}).Error)
+	})
+}
@@ -4,6 +4,7 @@ package admin
 import (
 	"context"
 
+	"github.com/data-preservation-programs/singularity/model"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
 )
@@ -12,6 +13,20 @@ type Handler interface {
 	InitHandler(ctx context.Context, db *gorm.DB) error
 	ResetHandler(ctx context.Context, db *gorm.DB) error
 	SetIdentityHandler(ctx context.Context, db *gorm.DB, request SetIdentityRequest) error
+	MigrateConfigHandler(ctx context.Context, db *gorm.DB, request MigrateConfigRequest) ([]ConfigChange, error)
+	BackupHandler(ctx context.Context, db *gorm.DB, request BackupRequest) (*BackupManifest, error)
+	MergePreparationsHandler(ctx context.Context, db *gorm.DB, request MergePreparationsRequest) (*model.Preparation, error)
+	SplitSourceHandler(ctx context.Context, db *gorm.DB, request SplitSourceRequest) (*model.Preparation, error)
+	StorageForecastHandler(ctx context.Context, db *gorm.DB, request StorageForecastRequest) ([]StorageForecast, error)
+	FullTextSearchHandler(ctx context.Context, db *gorm.DB, request FullTextSearchRequest) error
+	CreateOperatorHandler(ctx context.Context, db *gorm.DB, name string) (*OperatorWithKey, error)
+	ListOperatorsHandler(ctx context.Context, db *gorm.DB) ([]model.Operator, error)
+	RemoveOperatorHandler(ctx context.Context, db *gorm.DB, name string) error
+	SetApprovalThresholdHandler(ctx context.Context, db *gorm.DB, request ApprovalThresholdRequest) error
+	SetBudgetHandler(ctx context.Context, db *gorm.DB, request SetBudgetRequest) (*model.Budget, error)
+	ListBudgetsHandler(ctx context.Context, db *gorm.DB) ([]BudgetStatus, error)
+	RemoveBudgetHandler(ctx context.Context, db *gorm.DB, preparation string) error
+	OverrideBudgetHandler(ctx context.Context, db *gorm.DB, request OverrideBudgetRequest) (*model.Budget, error)
 }
 
 type DefaultHandler struct{}
@@ -38,3 +53,73 @@ func (m *MockAdmin) ResetHandler(ctx context.Context, db *gorm.DB) error {
 	args := m.Called(ctx, db)
 	return args.Error(0)
 }
+
+func (m *MockAdmin) MigrateConfigHandler(ctx context.Context, db *gorm.DB, request MigrateConfigRequest) ([]ConfigChange, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).([]ConfigChange), args.Error(1)
+}
+
+func (m *MockAdmin) BackupHandler(ctx context.Context, db *gorm.DB, request BackupRequest) (*BackupManifest, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).(*BackupManifest), args.Error(1)
+}
+
+func (m *MockAdmin) MergePreparationsHandler(ctx context.Context, db *gorm.DB, request MergePreparationsRequest) (*model.Preparation, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).(*model.Preparation), args.Error(1)
+}
+
+func (m *MockAdmin) SplitSourceHandler(ctx context.Context, db *gorm.DB, request SplitSourceRequest) (*model.Preparation, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).(*model.Preparation), args.Error(1)
+}
+
+func (m *MockAdmin) StorageForecastHandler(ctx context.Context, db *gorm.DB, request StorageForecastRequest) ([]StorageForecast, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).([]StorageForecast), args.Error(1)
+}
+
+func (m *MockAdmin) FullTextSearchHandler(ctx context.Context, db *gorm.DB, request FullTextSearchRequest) error {
+	args := m.Called(ctx, db, request)
+	return args.Error(0)
+}
+
+func (m *MockAdmin) CreateOperatorHandler(ctx context.Context, db *gorm.DB, name string) (*OperatorWithKey, error) {
+	args := m.Called(ctx, db, name)
+	return args.Get(0).(*OperatorWithKey), args.Error(1)
+}
+
+func (m *MockAdmin) ListOperatorsHandler(ctx context.Context, db *gorm.DB) ([]model.Operator, error) {
+	args := m.Called(ctx, db)
+	return args.Get(0).([]model.Operator), args.Error(1)
+}
+
+func (m *MockAdmin) RemoveOperatorHandler(ctx context.Context, db *gorm.DB, name string) error {
+	args := m.Called(ctx, db, name)
+	return args.Error(0)
+}
+
+func (m *MockAdmin) SetApprovalThresholdHandler(ctx context.Context, db *gorm.DB, request ApprovalThresholdRequest) error {
+	args := m.Called(ctx, db, request)
+	return args.Error(0)
+}
+
+func (m *MockAdmin) SetBudgetHandler(ctx context.Context, db *gorm.DB, request SetBudgetRequest) (*model.Budget, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).(*model.Budget), args.Error(1)
+}
+
+func (m *MockAdmin) ListBudgetsHandler(ctx context.Context, db *gorm.DB) ([]BudgetStatus, error) {
+	args := m.Called(ctx, db)
+	return args.Get(0).([]BudgetStatus), args.Error(1)
+}
+
+func (m *MockAdmin) RemoveBudgetHandler(ctx context.Context, db *gorm.DB, preparation string) error {
+	args := m.Called(ctx, db, preparation)
+	return args.Error(0)
+}
+
+func (m *MockAdmin) OverrideBudgetHandler(ctx context.Context, db *gorm.DB, request OverrideBudgetRequest) (*model.Budget, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).(*model.Budget), args.Error(1)
+}
@@ -0,0 +1,227 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/data-preservation-programs/singularity/util"
+	"gorm.io/gorm"
+)
+
+type MigrateConfigRequest struct {
+	DryRun bool `json:"dryRun"` // Only report the changes without applying them
+}
+
+// ConfigChange is a single rewrite of a legacy setting. Config values are never included since they may contain secrets.
+type ConfigChange struct {
+	Object string `json:"object"` // Type of the object, i.e. storage or preparation
+	Name   string `json:"name"`   // Name of the object
+	Field  string `json:"field"`
+	Old    string `json:"old"`
+	New    string `json:"new"`
+	Reason string `json:"reason"`
+}
+
+// deprecatedOptions maps the storage type to the deprecated rclone options and their replacements.
+// An empty replacement means the option is no longer needed and is removed.
+var deprecatedOptions = map[string]map[string]string{
+	"drive": {
+		"formats":          "export_formats",
+		"alternate_export": "",
+	},
+}
+
+// toSnakeCase converts an option key written as an API field (e.g. accessKeyId) or as a CLI flag
+// (e.g. s3-access-key-id) to the rclone option naming convention (e.g. access_key_id).
+func toSnakeCase(prefix string, key string) string {
+	lower := strings.ToLower(key)
+	for _, sep := range []string{"-", "_"} {
+		if strings.HasPrefix(lower, prefix+sep) {
+			key = key[len(prefix)+1:]
+			break
+		}
+	}
+	var sb strings.Builder
+	for i, r := range key {
+		switch {
+		case r == '-':
+			sb.WriteRune('_')
+		case unicode.IsUpper(r):
+			if i > 0 && key[i-1] != '-' && key[i-1] != '_' && !unicode.IsUpper(rune(key[i-1])) {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(unicode.ToLower(r))
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// migrateStorageConfig returns the rewritten rclone config of the storage, and the list of changes.
+func migrateStorageConfig(storage model.Storage) (model.ConfigMap, []ConfigChange) {
+	backend, ok := storagesystem.BackendMap[storage.Type]
+	if !ok {
+		return storage.Config, nil
+	}
+	known := make(map[string]struct{})
+	for _, providerOptions := range backend.ProviderOptions {
+		for _, option := range providerOptions.Options {
+			known[option.Name] = struct{}{}
+		}
+	}
+
+	config := make(model.ConfigMap, len(storage.Config))
+	for key, value := range storage.Config {
+		config[key] = value
+	}
+
+	var changes []ConfigChange
+	change := func(from, to, reason string) {
+		changes = append(changes, ConfigChange{
+			Object: "storage",
+			Name:   storage.Name,
+			Field:  "config",
+			Old:    from,
+			New:    to,
+			Reason: reason,
+		})
+	}
+
+	keys := make([]string, 0, len(storage.Config))
+	for key := range storage.Config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := storage.Config[key]
+		if replacement, ok := deprecatedOptions[storage.Type][key]; ok {
+			delete(config, key)
+			if replacement == "" {
+				change(key, "", "option is deprecated and no longer needed")
+				continue
+			}
+			if _, exists := config[replacement]; exists {
+				change(key, "", "option is deprecated and superseded by "+replacement)
+				continue
+			}
+			config[replacement] = value
+			change(key, replacement, "option is deprecated")
+			continue
+		}
+
+		if _, ok := known[key]; ok {
+			continue
+		}
+		normalized := toSnakeCase(backend.Prefix, key)
+		if _, ok := known[normalized]; !ok || normalized == key {
+			continue
+		}
+		delete(config, key)
+		if _, exists := storage.Config[normalized]; exists {
+			change(key, "", "legacy option name is superseded by "+normalized)
+			continue
+		}
+		config[normalized] = value
+		change(key, normalized, "legacy option name")
+	}
+
+	return config, changes
+}
+
+// MigrateConfigHandler rewrites settings stored with legacy conventions to the conventions of the current version,
+// so that long-lived deployments can be upgraded without manual SQL. The following rewrites are performed:
+//  1. Storage config keys written as API fields (e.g. accessKeyId) or CLI flags (e.g. s3-access-key-id) are
+//     renamed to the rclone option names (e.g. access_key_id).
+//  2. Deprecated rclone options are renamed to their replacement, or removed if they are no longer needed.
+//  3. Preparations created before the piece size was configurable get the default piece size derived from the max size.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The MigrateConfigRequest that specifies whether to only report the changes.
+//
+// Returns:
+//   - A slice of ConfigChange describing all rewrites, which have been applied unless it is a dry run.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) MigrateConfigHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	request MigrateConfigRequest,
+) ([]ConfigChange, error) {
+	db = db.WithContext(ctx)
+	changes := make([]ConfigChange, 0)
+	err := database.DoRetry(ctx, func() error {
+		changes = changes[:0]
+		return db.Transaction(func(db *gorm.DB) error {
+			var storages []model.Storage
+			err := db.Order("id asc").Find(&storages).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			for _, storage := range storages {
+				config, storageChanges := migrateStorageConfig(storage)
+				if len(storageChanges) == 0 {
+					continue
+				}
+				changes = append(changes, storageChanges...)
+				if request.DryRun {
+					continue
+				}
+				err = db.Model(&model.Storage{}).Where("id = ?", storage.ID).Update("config", config).Error
+				if err != nil {
+					return errors.WithStack(err)
+				}
+			}
+
+			var preparations []model.Preparation
+			err = db.Where("piece_size = 0").Order("id asc").Find(&preparations).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			for _, preparation := range preparations {
+				pieceSize := int64(util.NextPowerOfTwo(uint64(preparation.MaxSize)))
+				changes = append(changes, ConfigChange{
+					Object: "preparation",
+					Name:   preparation.Name,
+					Field:  "pieceSize",
+					Old:    "0",
+					New:    strconv.FormatInt(pieceSize, 10),
+					Reason: "piece size was not set by a legacy version",
+				})
+				if request.DryRun {
+					continue
+				}
+				err = db.Model(&model.Preparation{}).Where("id = ?", preparation.ID).Update("piece_size", pieceSize).Error
+				if err != nil {
+					return errors.WithStack(err)
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return changes, nil
+}
+
+// @ID MigrateConfig
+// @Summary Rewrite settings stored with legacy conventions to the current conventions
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body MigrateConfigRequest true "Migrate Config Request"
+// @Success 200 {array} ConfigChange
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /admin/migrate-config [post]
+func _() {}
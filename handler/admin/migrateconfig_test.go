@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestMigrateConfigHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		require.NoError(t, db.Create(&model.Storage{
+			Name: "s3",
+			Type: "s3",
+			Config: model.ConfigMap{
+				"provider":             "AWS",
+				"accessKeyId":          "key",
+				"s3-secret-access-key": "secret",
+				"region":               "us-east-1",
+			},
+		}).Error)
+		require.NoError(t, db.Create(&model.Storage{
+			Name: "drive",
+			Type: "drive",
+			Config: model.ConfigMap{
+				"formats":          "docx",
+				"alternate_export": "true",
+			},
+		}).Error)
+		require.NoError(t, db.Create(&model.Preparation{
+			Name:    "legacy",
+			MaxSize: 1 << 20,
+		}).Error)
+
+		changes, err := Default.MigrateConfigHandler(ctx, db, MigrateConfigRequest{DryRun: true})
+		require.NoError(t, err)
+		require.Len(t, changes, 5)
+		var storage model.Storage
+		require.NoError(t, db.Where("name = ?", "s3").First(&storage).Error)
+		require.Equal(t, "key", storage.Config["accessKeyId"])
+
+		changes, err = Default.MigrateConfigHandler(ctx, db, MigrateConfigRequest{})
+		require.NoError(t, err)
+		require.Len(t, changes, 5)
+
+		storage = model.Storage{}
+		require.NoError(t, db.Where("name = ?", "s3").First(&storage).Error)
+		require.Equal(t, model.ConfigMap{
+			"provider":          "AWS",
+			"access_key_id":     "key",
+			"secret_access_key": "secret",
+			"region":            "us-east-1",
+		}, storage.Config)
+
+		storage = model.Storage{}
+		require.NoError(t, db.Where("name = ?", "drive").First(&storage).Error)
+		require.Equal(t, model.ConfigMap{"export_formats": "docx"}, storage.Config)
+
+		var preparation model.Preparation
+		require.NoError(t, db.Where("name = ?", "legacy").First(&preparation).Error)
+		require.EqualValues(t, 1<<20, preparation.PieceSize)
+
+		changes, err = Default.MigrateConfigHandler(ctx, db, MigrateConfigRequest{})
+		require.NoError(t, err)
+		require.Empty(t, changes)
+	})
+}
@@ -0,0 +1,134 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/operator"
+	"github.com/dustin/go-humanize"
+	"gorm.io/gorm"
+)
+
+type OperatorWithKey struct {
+	ID     model.OperatorID `json:"id"`
+	Name   string           `json:"name"`
+	APIKey string           `json:"apiKey"` // API key of the operator. It is only returned once, when the operator is created.
+}
+
+type ApprovalThresholdRequest struct {
+	Threshold string `json:"threshold"` // Total deal size in human readable format above which a schedule needs approval, i.e. 1 PiB. 0 disables approvals.
+}
+
+// CreateOperatorHandler creates a new operator with a random API key. Only the hash of the key is stored, so the key
+// is returned once and cannot be recovered afterwards.
+//
+// Operators are only managed with direct access to the database, not through the API, so a compromised API key
+// cannot be used to create more operators and approve its own schedules.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - name: The unique name of the operator.
+//
+// Returns:
+//   - The created operator with its API key.
+//   - An error, if the name is empty or already taken, or if any other error occurred.
+func (DefaultHandler) CreateOperatorHandler(ctx context.Context, db *gorm.DB, name string) (*OperatorWithKey, error) {
+	db = db.WithContext(ctx)
+	if name == "" {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "operator name is required")
+	}
+	var existing int64
+	err := db.Model(&model.Operator{}).Where("name = ?", name).Count(&existing).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if existing > 0 {
+		return nil, errors.Wrapf(handlererror.ErrDuplicateRecord, "operator %s already exists", name)
+	}
+
+	key, err := operator.GenerateKey()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	created := model.Operator{
+		Name:    name,
+		KeyHash: operator.HashKey(key),
+	}
+	err = database.DoRetry(ctx, func() error {
+		return db.Create(&created).Error
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &OperatorWithKey{ID: created.ID, Name: created.Name, APIKey: key}, nil
+}
+
+// ListOperatorsHandler lists all operators, without their API keys.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//
+// Returns:
+//   - A slice of all operators.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) ListOperatorsHandler(ctx context.Context, db *gorm.DB) ([]model.Operator, error) {
+	db = db.WithContext(ctx)
+	var operators []model.Operator
+	err := db.Order("id asc").Find(&operators).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return operators, nil
+}
+
+// RemoveOperatorHandler removes an operator, which revokes its API key. Schedules it requested or approved are kept.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - name: The name of the operator to remove.
+//
+// Returns:
+//   - An error, if the operator does not exist or if any other error occurred.
+func (DefaultHandler) RemoveOperatorHandler(ctx context.Context, db *gorm.DB, name string) error {
+	db = db.WithContext(ctx)
+	var result *gorm.DB
+	err := database.DoRetry(ctx, func() error {
+		result = db.Where("name = ?", name).Delete(&model.Operator{})
+		return result.Error
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if result.RowsAffected == 0 {
+		return errors.Wrapf(handlererror.ErrNotFound, "operator %s does not exist", name)
+	}
+	return nil
+}
+
+// SetApprovalThresholdHandler sets the total deal size above which creating or modifying a deal schedule needs to be
+// approved by a second operator. The total deal size of a schedule is the smaller of its total deal size and its total
+// deal number times the piece size of the preparation. A schedule without either limit is always above the threshold.
+//
+// Like operators, the threshold is only managed with direct access to the database, not through the API.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The ApprovalThresholdRequest with the new threshold.
+//
+// Returns:
+//   - An error, if the threshold is invalid or if any other error occurred.
+func (DefaultHandler) SetApprovalThresholdHandler(ctx context.Context, db *gorm.DB, request ApprovalThresholdRequest) error {
+	db = db.WithContext(ctx)
+	threshold, err := humanize.ParseBytes(request.Threshold)
+	if err != nil {
+		return errors.Wrapf(handlererror.ErrInvalidParameter, "invalid threshold %s", request.Threshold)
+	}
+	return errors.WithStack(model.SetApprovalThreshold(db, int64(threshold)))
+}
@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/operator"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestOperatorHandlers(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		created, err := Default.CreateOperatorHandler(ctx, db, "alice")
+		require.NoError(t, err)
+		require.Equal(t, "alice", created.Name)
+		require.NotEmpty(t, created.APIKey)
+		var stored model.Operator
+		require.NoError(t, db.First(&stored, created.ID).Error)
+		require.Equal(t, operator.HashKey(created.APIKey), stored.KeyHash)
+
+		_, err = Default.CreateOperatorHandler(ctx, db, "alice")
+		require.ErrorIs(t, err, handlererror.ErrDuplicateRecord)
+		_, err = Default.CreateOperatorHandler(ctx, db, "")
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		operators, err := Default.ListOperatorsHandler(ctx, db)
+		require.NoError(t, err)
+		require.Len(t, operators, 1)
+
+		require.NoError(t, Default.RemoveOperatorHandler(ctx, db, "alice"))
+		require.ErrorIs(t, Default.RemoveOperatorHandler(ctx, db, "alice"), handlererror.ErrNotFound)
+	})
+}
+
+func TestSetApprovalThresholdHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := Default.SetApprovalThresholdHandler(ctx, db, ApprovalThresholdRequest{Threshold: "1PiB"})
+		require.NoError(t, err)
+		threshold, err := model.GetApprovalThreshold(db)
+		require.NoError(t, err)
+		require.EqualValues(t, 1<<50, threshold)
+
+		err = Default.SetApprovalThresholdHandler(ctx, db, ApprovalThresholdRequest{Threshold: "2PiB"})
+		require.NoError(t, err)
+		threshold, err = model.GetApprovalThreshold(db)
+		require.NoError(t, err)
+		require.EqualValues(t, 2<<50, threshold)
+
+		err = Default.SetApprovalThresholdHandler(ctx, db, ApprovalThresholdRequest{Threshold: "0"})
+		require.NoError(t, err)
+		threshold, err = model.GetApprovalThreshold(db)
+		require.NoError(t, err)
+		require.Zero(t, threshold)
+
+		err = Default.SetApprovalThresholdHandler(ctx, db, ApprovalThresholdRequest{Threshold: "lots"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+}
@@ -0,0 +1,316 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/rjNemo/underscore"
+	"gorm.io/gorm"
+)
+
+type MergePreparationsRequest struct {
+	Source string `json:"source"` // ID or name of the preparation to merge. It is removed after the merge.
+	Target string `json:"target"` // ID or name of the preparation to merge into
+}
+
+type SplitSourceRequest struct {
+	Preparation string `json:"preparation"` // ID or name of the preparation to split the source out of
+	Source      string `json:"source"`      // ID or name of the source storage to split out
+	Name        string `json:"name"`        // Name of the new preparation
+}
+
+// findPreparation finds a preparation by ID or name, with its output storages and wallets.
+func findPreparation(db *gorm.DB, id string) (*model.Preparation, error) {
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id, "OutputStorages", "Wallets")
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &preparation, nil
+}
+
+// checkNoActiveJobs returns an error if any of the source attachments has a job that is being processed.
+func checkNoActiveJobs(db *gorm.DB, attachments []model.SourceAttachment) error {
+	if len(attachments) == 0 {
+		return nil
+	}
+	attachmentIDs := underscore.Map(attachments, func(attachment model.SourceAttachment) model.SourceAttachmentID { return attachment.ID })
+	var activeCount int64
+	err := db.Model(&model.Job{}).Where("attachment_id in ? and state = ?", attachmentIDs, model.Processing).Count(&activeCount).Error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if activeCount > 0 {
+		return errors.Wrapf(handlererror.ErrInvalidParameter, "there are %d active jobs, pause them first", activeCount)
+	}
+	return nil
+}
+
+// addOutputsAndWallets attaches the output storages and the wallets to the preparation, skipping those already attached.
+func addOutputsAndWallets(db *gorm.DB, preparation *model.Preparation, outputs []model.Storage, wallets []model.Wallet) error {
+	for _, output := range outputs {
+		if underscore.Any(preparation.OutputStorages, func(s model.Storage) bool { return s.ID == output.ID }) {
+			continue
+		}
+		err := db.Create(&model.OutputAttachment{
+			PreparationID: preparation.ID,
+			StorageID:     output.ID,
+		}).Error
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	var missing []model.Wallet
+	for _, wallet := range wallets {
+		if !underscore.Any(preparation.Wallets, func(w model.Wallet) bool { return w.ID == wallet.ID }) {
+			missing = append(missing, wallet)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return errors.WithStack(db.Model(preparation).Association("Wallets").Append(&missing))
+}
+
+// MergePreparationsHandler merges a preparation into another one, for teams reorganizing the datasets of
+// a large onboarding program. All source attachments of the merged preparation, together with their files,
+// directories and jobs, are moved to the target preparation, as well as all its pieces and deal schedules,
+// so the piece and deal history is preserved. Output storages and wallets are attached to the target preparation
+// if they are not already. The merged preparation is removed afterwards.
+//
+// Both preparations must have the same piece size and the same inline and dag settings, none of their sources
+// can have an active job, and they cannot share a source storage.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The MergePreparationsRequest with the preparation to merge and the preparation to merge into.
+//
+// Returns:
+//   - A pointer to the target Preparation with its source and output storages.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) MergePreparationsHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	request MergePreparationsRequest,
+) (*model.Preparation, error) {
+	db = db.WithContext(ctx)
+	source, err := findPreparation(db, request.Source)
+	if err != nil {
+		return nil, err
+	}
+	target, err := findPreparation(db, request.Target)
+	if err != nil {
+		return nil, err
+	}
+	if source.ID == target.ID {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "cannot merge a preparation into itself")
+	}
+	if source.PieceSize != target.PieceSize {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "piece size %d of preparation %s does not match piece size %d of preparation %s",
+			source.PieceSize, source.Name, target.PieceSize, target.Name)
+	}
+	if source.NoInline != target.NoInline || source.NoDag != target.NoDag {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "preparations %s and %s have different inline or dag settings", source.Name, target.Name)
+	}
+	if source.PieceKeyRecipient != target.PieceKeyRecipient {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "preparations %s and %s encrypt pieces for different recipients", source.Name, target.Name)
+	}
+	if strings.Join(source.EncryptionRecipients, ",") != strings.Join(target.EncryptionRecipients, ",") {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "preparations %s and %s encrypt files for different recipients", source.Name, target.Name)
+	}
+	if source.EncryptionKMSKey != target.EncryptionKMSKey {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "preparations %s and %s encrypt files with different KMS keys", source.Name, target.Name)
+	}
+
+	sourceAttachments, err := source.SourceAttachments(db)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	targetAttachments, err := target.SourceAttachments(db)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, attachment := range sourceAttachments {
+		if underscore.Any(targetAttachments, func(a model.SourceAttachment) bool { return a.StorageID == attachment.StorageID }) {
+			return nil, errors.Wrapf(handlererror.ErrDuplicateRecord, "source storage %d is attached to both preparations", attachment.StorageID)
+		}
+	}
+	err = checkNoActiveJobs(db, append(sourceAttachments, targetAttachments...))
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.DoRetry(ctx, func() error {
+		return db.Transaction(func(db *gorm.DB) error {
+			err := db.Model(&model.SourceAttachment{}).Where("preparation_id = ?", source.ID).
+				Update("preparation_id", target.ID).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			err = db.Model(&model.Car{}).Where("preparation_id = ?", source.ID).
+				Update("preparation_id", target.ID).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			err = db.Model(&model.Schedule{}).Where("preparation_id = ?", source.ID).
+				Update("preparation_id", target.ID).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			err = addOutputsAndWallets(db, target, source.OutputStorages, source.Wallets)
+			if err != nil {
+				return err
+			}
+			// The wallet assignments do not cascade, so they need to be removed before the preparation
+			err = db.Model(source).Association("Wallets").Clear()
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return errors.WithStack(db.Delete(&model.Preparation{}, source.ID).Error)
+		})
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var merged model.Preparation
+	err = db.Preload("SourceStorages").Preload("OutputStorages").First(&merged, target.ID).Error
+	return &merged, errors.WithStack(err)
+}
+
+// SplitSourceHandler splits a source out of a preparation into a new preparation, for teams reorganizing
+// the datasets of a large onboarding program. The new preparation has the same settings, output storages and wallets
+// as the original one. The source attachment, together with its files, directories and jobs, is moved to the new
+// preparation, as well as the pieces made from it, so the piece history is preserved. Deal schedules stay with the
+// original preparation, and deals already made for the moved pieces are kept.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The SplitSourceRequest with the preparation, the source storage and the name of the new preparation.
+//
+// Returns:
+//   - A pointer to the new Preparation with its source and output storages.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) SplitSourceHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	request SplitSourceRequest,
+) (*model.Preparation, error) {
+	db = db.WithContext(ctx)
+	if request.Name == "" {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "name of the new preparation is required")
+	}
+	preparation, err := findPreparation(db, request.Preparation)
+	if err != nil {
+		return nil, err
+	}
+
+	var attachment model.SourceAttachment
+	err = attachment.FindByPreparationAndSource(db, request.Preparation, request.Source)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "source '%s' is not attached to preparation '%s'", request.Source, request.Preparation)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = checkNoActiveJobs(db, []model.SourceAttachment{attachment})
+	if err != nil {
+		return nil, err
+	}
+
+	var existing int64
+	err = db.Model(&model.Preparation{}).Where("name = ?", request.Name).Count(&existing).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if existing > 0 {
+		return nil, errors.Wrapf(handlererror.ErrDuplicateRecord, "preparation '%s' already exists", request.Name)
+	}
+
+	split := model.Preparation{
+		Name:                 request.Name,
+		DeleteAfterExport:    preparation.DeleteAfterExport,
+		MaxSize:              preparation.MaxSize,
+		PieceSize:            preparation.PieceSize,
+		NoInline:             preparation.NoInline,
+		NoDag:                preparation.NoDag,
+		BlobStorageID:        preparation.BlobStorageID,
+		MaxDirectoryDepth:    preparation.MaxDirectoryDepth,
+		ConflictPolicy:       preparation.ConflictPolicy,
+		PieceKeyRecipient:    preparation.PieceKeyRecipient,
+		EncryptionRecipients: preparation.EncryptionRecipients,
+		EncryptionKeyVersion: preparation.EncryptionKeyVersion,
+		EncryptionKMSKey:     preparation.EncryptionKMSKey,
+		HashFunction:         preparation.HashFunction,
+		LeafCodec:            preparation.LeafCodec,
+		CidVersion:           preparation.CidVersion,
+		Chunker:              preparation.Chunker,
+		SmallFileLimit:       preparation.SmallFileLimit,
+		ChecksumSidecar:      preparation.ChecksumSidecar,
+		CarCompression:       preparation.CarCompression,
+		CarNameTemplate:      preparation.CarNameTemplate,
+		CarShardDepth:        preparation.CarShardDepth,
+		CarSource:            preparation.CarSource,
+		Immutability:         preparation.Immutability,
+	}
+	err = database.DoRetry(ctx, func() error {
+		return db.Transaction(func(db *gorm.DB) error {
+			split.ID = 0
+			err := db.Create(&split).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			err = addOutputsAndWallets(db, &split, preparation.OutputStorages, preparation.Wallets)
+			if err != nil {
+				return err
+			}
+			err = db.Model(&model.SourceAttachment{}).Where("id = ?", attachment.ID).
+				Update("preparation_id", split.ID).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return errors.WithStack(db.Model(&model.Car{}).Where("attachment_id = ?", attachment.ID).
+				Update("preparation_id", split.ID).Error)
+		})
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var created model.Preparation
+	err = db.Preload("SourceStorages").Preload("OutputStorages").First(&created, split.ID).Error
+	return &created, errors.WithStack(err)
+}
+
+// @ID MergePreparations
+// @Summary Merge a preparation into another preparation
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body MergePreparationsRequest true "Merge Preparations Request"
+// @Success 200 {object} model.Preparation
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /admin/merge-preparations [post]
+func _() {}
+
+// @ID SplitSource
+// @Summary Split a source out of a preparation into a new preparation
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body SplitSourceRequest true "Split Source Request"
+// @Success 200 {object} model.Preparation
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /admin/split-source [post]
+func _() {}
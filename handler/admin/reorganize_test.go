@@ -0,0 +1,126 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// createReorganizeFixture creates two preparations with two and one sources, an output storage, a wallet, a piece for
+// every source and a schedule for the first preparation.
+func createReorganizeFixture(t *testing.T, db *gorm.DB) (model.Preparation, model.Preparation) {
+	storages := []model.Storage{{Name: "source1"}, {Name: "source2"}, {Name: "source3"}, {Name: "output"}}
+	require.NoError(t, db.Create(&storages).Error)
+	wallet := model.Wallet{ID: "f01000", Address: "f1wallet"}
+	require.NoError(t, db.Create(&wallet).Error)
+	prep1 := model.Preparation{
+		Name:           "prep1",
+		PieceSize:      1 << 20,
+		SourceStorages: []model.Storage{storages[0], storages[1]},
+		OutputStorages: []model.Storage{storages[3]},
+		Wallets:        []model.Wallet{wallet},
+	}
+	require.NoError(t, db.Create(&prep1).Error)
+	prep2 := model.Preparation{
+		Name:           "prep2",
+		PieceSize:      1 << 20,
+		SourceStorages: []model.Storage{storages[2]},
+	}
+	require.NoError(t, db.Create(&prep2).Error)
+
+	var attachments []model.SourceAttachment
+	require.NoError(t, db.Order("id asc").Find(&attachments).Error)
+	for i, attachment := range attachments {
+		require.NoError(t, db.Create(&model.Car{
+			PieceCID:      model.CID(testutil.TestCid),
+			PieceSize:     1 << 20,
+			PreparationID: attachment.PreparationID,
+			AttachmentID:  &attachments[i].ID,
+		}).Error)
+	}
+	require.NoError(t, db.Create(&model.Schedule{PreparationID: prep1.ID, Provider: "f01234"}).Error)
+	return prep1, prep2
+}
+
+func TestMergePreparationsHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		prep1, prep2 := createReorganizeFixture(t, db)
+
+		merged, err := Default.MergePreparationsHandler(ctx, db, MergePreparationsRequest{Source: "prep1", Target: "prep2"})
+		require.NoError(t, err)
+		require.Equal(t, prep2.ID, merged.ID)
+		require.Len(t, merged.SourceStorages, 3)
+		require.Len(t, merged.OutputStorages, 1)
+
+		var count int64
+		require.NoError(t, db.Model(&model.Preparation{}).Where("id = ?", prep1.ID).Count(&count).Error)
+		require.Zero(t, count)
+		require.NoError(t, db.Model(&model.Car{}).Where("preparation_id = ?", prep2.ID).Count(&count).Error)
+		require.EqualValues(t, 3, count)
+		require.NoError(t, db.Model(&model.Schedule{}).Where("preparation_id = ?", prep2.ID).Count(&count).Error)
+		require.EqualValues(t, 1, count)
+		var withWallets model.Preparation
+		require.NoError(t, db.Preload("Wallets").First(&withWallets, prep2.ID).Error)
+		require.Len(t, withWallets.Wallets, 1)
+	})
+}
+
+func TestMergePreparationsHandler_Invalid(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		createReorganizeFixture(t, db)
+
+		_, err := Default.MergePreparationsHandler(ctx, db, MergePreparationsRequest{Source: "prep1", Target: "notexist"})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		_, err = Default.MergePreparationsHandler(ctx, db, MergePreparationsRequest{Source: "prep1", Target: "prep1"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		require.NoError(t, db.Model(&model.Preparation{}).Where("name = ?", "prep2").Update("piece_size", 1<<30).Error)
+		_, err = Default.MergePreparationsHandler(ctx, db, MergePreparationsRequest{Source: "prep1", Target: "prep2"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "piece size")
+
+		require.NoError(t, db.Model(&model.Preparation{}).Where("name = ?", "prep2").Update("piece_size", 1<<20).Error)
+		require.NoError(t, db.Create(&model.Job{AttachmentID: 1, State: model.Processing}).Error)
+		_, err = Default.MergePreparationsHandler(ctx, db, MergePreparationsRequest{Source: "prep1", Target: "prep2"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "active jobs")
+	})
+}
+
+func TestSplitSourceHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		prep1, _ := createReorganizeFixture(t, db)
+
+		split, err := Default.SplitSourceHandler(ctx, db, SplitSourceRequest{Preparation: "prep1", Source: "source2", Name: "prep3"})
+		require.NoError(t, err)
+		require.Equal(t, "prep3", split.Name)
+		require.Equal(t, prep1.PieceSize, split.PieceSize)
+		require.Len(t, split.SourceStorages, 1)
+		require.Equal(t, "source2", split.SourceStorages[0].Name)
+		require.Len(t, split.OutputStorages, 1)
+
+		var cars []model.Car
+		require.NoError(t, db.Where("preparation_id = ?", split.ID).Find(&cars).Error)
+		require.Len(t, cars, 1)
+		var count int64
+		require.NoError(t, db.Model(&model.Car{}).Where("preparation_id = ?", prep1.ID).Count(&count).Error)
+		require.EqualValues(t, 1, count)
+		require.NoError(t, db.Model(&model.Schedule{}).Where("preparation_id = ?", prep1.ID).Count(&count).Error)
+		require.EqualValues(t, 1, count)
+
+		_, err = Default.SplitSourceHandler(ctx, db, SplitSourceRequest{Preparation: "prep1", Source: "source1", Name: "prep3"})
+		require.ErrorIs(t, err, handlererror.ErrDuplicateRecord)
+
+		_, err = Default.SplitSourceHandler(ctx, db, SplitSourceRequest{Preparation: "prep1", Source: "source3", Name: "prep4"})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		_, err = Default.SplitSourceHandler(ctx, db, SplitSourceRequest{Preparation: "prep1", Source: "source1"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+}
@@ -0,0 +1,395 @@
+package dataprep
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack"
+	"github.com/data-preservation-programs/singularity/pack/compression"
+	"github.com/data-preservation-programs/singularity/pack/datasegment"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/data-preservation-programs/singularity/store"
+	"github.com/dustin/go-humanize"
+	commp "github.com/filecoin-project/go-fil-commp-hashhash"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+)
+
+type AggregatePiecesRequest struct {
+	PieceSize string `default:"32GiB" json:"pieceSize"` // Size of the aggregate pieces. Only the pieces smaller than this size are aggregated.
+}
+
+type PieceProof struct {
+	PieceCID           model.CID                  `json:"pieceCid"           swaggertype:"string"`
+	PieceSize          int64                      `json:"pieceSize"`
+	AggregatePieceCID  model.CID                  `json:"aggregatePieceCid"  swaggertype:"string"`
+	AggregatePieceSize int64                      `json:"aggregatePieceSize"`
+	Offset             int64                      `json:"offset"`                       // Padded offset of the piece in the aggregate piece
+	Proof              datasegment.InclusionProof `json:"proof"              table:"-"` // Proof of the inclusion of the piece in the aggregate piece and its data segment index
+}
+
+// AggregatePiecesHandler aggregates the small pieces of a preparation into aggregate pieces following the data
+// segment index of FRC-0058 (PODSI), so that datasets of small pieces can still fill large sectors.
+//
+// The pieces smaller than the requested size that have not been aggregated yet are placed from the largest to the
+// smallest in the first aggregate they fit in. Each aggregate that holds at least two pieces is written to one of the
+// output storages of the preparation, named after the CAR filename template of the preparation, with the payload of
+// each piece read from its CAR file, decompressed if needed, or from the source if the CAR file has not been exported. The piece CID of the aggregate is checked against its payload
+// before the aggregate is saved. Aggregated pieces are then dealt through their aggregate instead of on their own, and
+// the inclusion proof of each piece can be retrieved with GetPieceProofHandler.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name for the desired Preparation record.
+//   - request: The AggregatePiecesRequest that specifies the size of the aggregate pieces.
+//
+// Returns:
+//   - A slice of the aggregate pieces that have been created.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) AggregatePiecesHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+	request AggregatePiecesRequest,
+) ([]model.Car, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id, "OutputStorages")
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(preparation.OutputStorages) == 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter,
+			"preparation '%s' has no output storage to write the aggregate pieces to", id)
+	}
+
+	if request.PieceSize == "" {
+		request.PieceSize = "32GiB"
+	}
+	dealSize, err := humanize.ParseBytes(request.PieceSize)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrapf(err, "invalid piece size %s", request.PieceSize))
+	}
+	_, err = datasegment.NewAggregate(dealSize)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+	}
+
+	// Existing aggregates are not aggregated again
+	var cars []model.Car
+	err = db.Preload("Storage").
+		Where("preparation_id = ? AND aggregate_id IS NULL AND piece_size < ?", preparation.ID, dealSize).
+		Where("id NOT IN (?)", db.Model(&model.Car{}).Select("aggregate_id").Where("aggregate_id IS NOT NULL")).
+		Order("piece_size desc, id asc").Find(&cars).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var aggregates []*datasegment.Aggregate
+	var aggregateCars [][]model.Car
+	for _, car := range cars {
+		piece := datasegment.Piece{CID: cid.Cid(car.PieceCID), Size: uint64(car.PieceSize)}
+		added := false
+		for i, aggregate := range aggregates {
+			err = aggregate.Add(piece)
+			if errors.Is(err, datasegment.ErrAggregateFull) {
+				continue
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to aggregate piece %s", piece.CID)
+			}
+			aggregateCars[i] = append(aggregateCars[i], car)
+			added = true
+			break
+		}
+		if added {
+			continue
+		}
+		aggregate, err := datasegment.NewAggregate(dealSize)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		err = aggregate.Add(piece)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to aggregate piece %s", piece.CID)
+		}
+		aggregates = append(aggregates, aggregate)
+		aggregateCars = append(aggregateCars, []model.Car{car})
+	}
+
+	var created []model.Car
+	for i, aggregate := range aggregates {
+		if len(aggregate.Segments) < 2 {
+			continue
+		}
+		aggregateCar, err := writeAggregate(ctx, db, preparation, aggregate, aggregateCars[i])
+		if err != nil {
+			return created, errors.WithStack(err)
+		}
+		created = append(created, *aggregateCar)
+	}
+	return created, nil
+}
+
+// writeAggregate writes the payload of an aggregate to an output storage of the preparation, and saves the aggregate
+// piece along with the offset of each of its pieces.
+func writeAggregate(
+	ctx context.Context,
+	db *gorm.DB,
+	preparation model.Preparation,
+	aggregate *datasegment.Aggregate,
+	cars []model.Car,
+) (*model.Car, error) {
+	pieceCID, err := aggregate.PieceCID()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	storageID, storageWriter, err := storagesystem.GetRandomOutputWriter(ctx, preparation.OutputStorages)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	filename, err := pack.CarPath(ctx, db, preparation, pieceCID, ".car")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	reader := aggregate.NewReader(func(i int) (io.ReadCloser, error) {
+		return openPiece(ctx, db, cars[i])
+	})
+	defer reader.Close()
+	calc := &commp.Calc{}
+	obj, err := storageWriter.Write(ctx, filename, io.TeeReader(reader, calc))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to write aggregate piece %s", pieceCID)
+	}
+	payloadPieceCID, _, err := pack.GetCommp(calc, aggregate.DealSize)
+	if err == nil && payloadPieceCID != pieceCID {
+		err = errors.Newf("payload of the aggregate has piece CID %s instead of %s", payloadPieceCID, pieceCID)
+	}
+	if err != nil {
+		removeErr := storageWriter.Remove(ctx, obj)
+		if removeErr != nil {
+			logger.Errorw("failed to remove aggregate piece", "path", filename, "err", removeErr)
+		}
+		return nil, errors.Wrapf(err, "failed to verify aggregate piece %s", pieceCID)
+	}
+
+	var numOfFiles int64
+	for _, car := range cars {
+		numOfFiles += car.NumOfFiles
+	}
+	aggregateCar := model.Car{
+		PieceCID:      model.CID(pieceCID),
+		PieceSize:     int64(aggregate.DealSize),
+		RootCID:       model.CID(packutil.EmptyFileCid),
+		FileSize:      aggregate.PayloadSize(),
+		StorageID:     storageID,
+		StoragePath:   filename,
+		NumOfFiles:    numOfFiles,
+		PreparationID: preparation.ID,
+	}
+	err = database.DoRetry(ctx, func() error {
+		return db.Transaction(func(db *gorm.DB) error {
+			err := db.Create(&aggregateCar).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			for i, segment := range aggregate.Segments {
+				err = db.Model(&model.Car{}).Where("id = ?", cars[i].ID).Updates(map[string]any{
+					"aggregate_id":     aggregateCar.ID,
+					"aggregate_offset": int64(segment.Offset),
+				}).Error
+				if err != nil {
+					return errors.WithStack(err)
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &aggregateCar, nil
+}
+
+// decompressedPiece is the decompressed payload of a compressed CAR file, which closes the CAR file once closed.
+type decompressedPiece struct {
+	io.ReadCloser
+	file io.Closer
+}
+
+func (d decompressedPiece) Close() error {
+	err := d.ReadCloser.Close()
+	fileErr := d.file.Close()
+	if err == nil {
+		err = fileErr
+	}
+	return errors.WithStack(err)
+}
+
+// openPiece opens the payload of a piece, from its CAR file if it has been exported, or from the source otherwise.
+// Compressed CAR files are decompressed.
+func openPiece(ctx context.Context, db *gorm.DB, car model.Car) (io.ReadCloser, error) {
+	if car.StoragePath != "" {
+		var file io.ReadCloser
+		if car.Storage == nil {
+			var err error
+			file, err = os.Open(car.StoragePath)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+		} else {
+			rclone, err := storagesystem.NewRCloneHandler(ctx, *car.Storage)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to create rclone handler with storage %d", car.Storage.ID)
+			}
+			file, _, err = storagesystem.Open(rclone, ctx, car.StoragePath)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+		if car.Compression == model.CarCompressionNone {
+			return file, nil
+		}
+		reader, err := compression.NewReader(file, car.Compression)
+		if err != nil {
+			_ = file.Close()
+			return nil, errors.WithStack(err)
+		}
+		return decompressedPiece{ReadCloser: reader, file: file}, nil
+	}
+	if car.AttachmentID == nil {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "piece %s has neither a CAR file nor a source",
+			cid.Cid(car.PieceCID))
+	}
+
+	var attachment model.SourceAttachment
+	err := db.Preload("Storage").First(&attachment, *car.AttachmentID).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var carBlocks []model.CarBlock
+	err = db.Where("car_id = ?", car.ID).Order("id ASC").Find(&carBlocks).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var files []model.File
+	err = db.Where("id IN (?)", db.Model(&model.CarBlock{}).Select("file_id").Where("car_id = ?", car.ID)).Find(&files).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	reader, err := store.NewPieceReader(ctx, car, *attachment.Storage, carBlocks, files)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create piece reader of piece %s", cid.Cid(car.PieceCID))
+	}
+	reader.UseBlobLoader(store.NewBlobLoader(db))
+	return reader, nil
+}
+
+// @ID AggregatePieces
+// @Summary Aggregate the small pieces of a preparation into large aggregate pieces with a data segment index
+// @Tags Piece
+// @Accept json
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Param request body AggregatePiecesRequest true "Aggregation options"
+// @Success 200 {array} model.Car
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/piece/aggregate [post]
+func _() {}
+
+// GetPieceProofHandler returns the proof of the inclusion of an aggregated piece in its aggregate piece, so the piece
+// can be shown to be part of a deal of the aggregate. The proof is computed again from the pieces of the aggregate.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - pieceCID: The piece CID of the aggregated piece.
+//
+// Returns:
+//   - A pointer to the PieceProof of the piece.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) GetPieceProofHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	pieceCID string,
+) (*PieceProof, error) {
+	db = db.WithContext(ctx)
+	parsed, err := cid.Parse(pieceCID)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrapf(err, "invalid piece CID %s", pieceCID))
+	}
+
+	var car model.Car
+	err = db.Preload("Aggregate").Where("piece_cid = ? AND aggregate_id IS NOT NULL", model.CID(parsed)).First(&car).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "piece %s has not been aggregated", pieceCID)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var cars []model.Car
+	err = db.Where("aggregate_id = ?", *car.AggregateID).Order("aggregate_offset asc").Find(&cars).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	aggregate, err := datasegment.NewAggregate(uint64(car.Aggregate.PieceSize))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	position := -1
+	for i, c := range cars {
+		err = aggregate.Add(datasegment.Piece{CID: cid.Cid(c.PieceCID), Size: uint64(c.PieceSize)})
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if aggregate.Segments[i].Offset != uint64(c.AggregateOffset) {
+			return nil, errors.Newf("piece %s is at offset %d of the aggregate instead of %d",
+				cid.Cid(c.PieceCID), aggregate.Segments[i].Offset, c.AggregateOffset)
+		}
+		if c.ID == car.ID {
+			position = i
+		}
+	}
+	aggregatePieceCID, err := aggregate.PieceCID()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if aggregatePieceCID != cid.Cid(car.Aggregate.PieceCID) {
+		return nil, errors.Newf("pieces of aggregate %s add up to piece CID %s",
+			cid.Cid(car.Aggregate.PieceCID), aggregatePieceCID)
+	}
+
+	return &PieceProof{
+		PieceCID:           car.PieceCID,
+		PieceSize:          car.PieceSize,
+		AggregatePieceCID:  car.Aggregate.PieceCID,
+		AggregatePieceSize: car.Aggregate.PieceSize,
+		Offset:             car.AggregateOffset,
+		Proof:              aggregate.InclusionProofs()[position],
+	}, nil
+}
+
+// @ID GetPieceProof
+// @Summary Get the proof of the inclusion of an aggregated piece in its aggregate piece
+// @Tags Piece
+// @Produce json
+// @Param id path string true "Piece CID"
+// @Success 200 {object} PieceProof
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /piece/{id}/proof [get]
+func _() {}
@@ -0,0 +1,125 @@
+package dataprep
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack"
+	"github.com/data-preservation-programs/singularity/pack/compression"
+	"github.com/data-preservation-programs/singularity/pack/datasegment"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	commp "github.com/filecoin-project/go-fil-commp-hashhash"
+	"github.com/gotidy/ptr"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestAggregatePiecesHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		sourceDir := t.TempDir()
+		outputDir := t.TempDir()
+		err := db.Create(&model.Preparation{
+			Name:           "prep",
+			SourceStorages: []model.Storage{{Name: "source", Type: "local", Path: sourceDir}},
+			OutputStorages: []model.Storage{{Name: "output", Type: "local", Path: outputDir}},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Preparation{Name: "no-output"}).Error
+		require.NoError(t, err)
+
+		// The last piece is already as large as an aggregate, and the CAR file of the third piece is compressed
+		for i, size := range []int{1000, 9000, 3000, 70000} {
+			content := testutil.GenerateRandomBytes(size)
+			stored := content
+			var carCompression model.CarCompression
+			if i == 2 {
+				carCompression = model.CarCompressionZstd
+				compressed, err := compression.Compress(bytes.NewReader(content), carCompression)
+				require.NoError(t, err)
+				stored, err = io.ReadAll(compressed)
+				require.NoError(t, err)
+			}
+			path := filepath.Join(sourceDir, "piece"+string(rune('a'+i))+".car")
+			require.NoError(t, os.WriteFile(path, stored, 0644))
+			calc := &commp.Calc{}
+			_, err = calc.Write(content)
+			require.NoError(t, err)
+			pieceCID, pieceSize, err := pack.GetCommp(calc, 0)
+			require.NoError(t, err)
+			err = db.Create(&model.Car{
+				PieceCID:      model.CID(pieceCID),
+				PieceSize:     int64(pieceSize),
+				RootCID:       model.CID(testutil.TestCid),
+				FileSize:      int64(size),
+				StoragePath:   path,
+				NumOfFiles:    1,
+				Compression:   carCompression,
+				PreparationID: 1,
+				AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+			}).Error
+			require.NoError(t, err)
+		}
+
+		t.Run("no output storage", func(t *testing.T) {
+			_, err := Default.AggregatePiecesHandler(ctx, db, "no-output", AggregatePiecesRequest{})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		})
+		t.Run("invalid piece size", func(t *testing.T) {
+			_, err := Default.AggregatePiecesHandler(ctx, db, "prep", AggregatePiecesRequest{PieceSize: "100KB"})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		})
+		t.Run("not aggregated", func(t *testing.T) {
+			_, err := Default.GetPieceProofHandler(ctx, db, testutil.TestCid.String())
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+		})
+		t.Run("success", func(t *testing.T) {
+			aggregates, err := Default.AggregatePiecesHandler(ctx, db, "prep", AggregatePiecesRequest{PieceSize: "64KiB"})
+			require.NoError(t, err)
+			require.Len(t, aggregates, 1)
+			aggregate := aggregates[0]
+			require.EqualValues(t, 64<<10, aggregate.PieceSize)
+			require.EqualValues(t, 3, aggregate.NumOfFiles)
+			require.NotNil(t, aggregate.StorageID)
+
+			// The payload of the aggregate matches its piece CID
+			content, err := os.ReadFile(filepath.Join(outputDir, aggregate.StoragePath))
+			require.NoError(t, err)
+			require.Len(t, content, int(aggregate.FileSize))
+			calc := &commp.Calc{}
+			_, err = calc.Write(content)
+			require.NoError(t, err)
+			pieceCID, _, err := pack.GetCommp(calc, 64<<10)
+			require.NoError(t, err)
+			require.Equal(t, cid.Cid(aggregate.PieceCID), pieceCID)
+
+			var cars []model.Car
+			require.NoError(t, db.Where("aggregate_id = ?", aggregate.ID).Order("aggregate_offset").Find(&cars).Error)
+			require.Len(t, cars, 3)
+			require.EqualValues(t, 2, cars[0].ID)
+			require.EqualValues(t, 0, cars[0].AggregateOffset)
+
+			for _, car := range cars {
+				proof, err := Default.GetPieceProofHandler(ctx, db, cid.Cid(car.PieceCID).String())
+				require.NoError(t, err)
+				require.Equal(t, aggregate.PieceCID, proof.AggregatePieceCID)
+				require.Equal(t, car.AggregateOffset, proof.Offset)
+				err = datasegment.VerifyInclusion(proof.Proof,
+					datasegment.Piece{CID: cid.Cid(car.PieceCID), Size: uint64(car.PieceSize)},
+					datasegment.Piece{CID: cid.Cid(aggregate.PieceCID), Size: uint64(aggregate.PieceSize)})
+				require.NoError(t, err)
+			}
+
+			// Aggregated pieces are not aggregated again
+			aggregates, err = Default.AggregatePiecesHandler(ctx, db, "prep", AggregatePiecesRequest{PieceSize: "64KiB"})
+			require.NoError(t, err)
+			require.Empty(t, aggregates)
+		})
+	})
+}
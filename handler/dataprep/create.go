@@ -2,32 +2,55 @@ package dataprep
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/database"
 	"github.com/data-preservation-programs/singularity/handler/handlererror"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
 	"github.com/data-preservation-programs/singularity/util"
 	"github.com/dustin/go-humanize"
+	"golang.org/x/exp/slices"
 	"gorm.io/gorm"
 )
 
 type CreateRequest struct {
-	Name              string   `binding:"required"    json:"name"`              // Name of the preparation
-	SourceStorages    []string `json:"sourceStorages"`                          // Name of Source storage systems to be used for the source
-	OutputStorages    []string `json:"outputStorages"`                          // Name of Output storage systems to be used for the output
-	MaxSizeStr        string   `default:"31.5GiB"     json:"maxSize"`           // Maximum size of the CAR files to be created
-	PieceSizeStr      string   `default:""            json:"pieceSize"`         // Target piece size of the CAR files used for piece commitment calculation
-	DeleteAfterExport bool     `default:"false"       json:"deleteAfterExport"` // Whether to delete the source files after export
-	NoInline          bool     `default:"false"       json:"noInline"`          // Whether to disable inline storage for the preparation. Can save database space but requires at least one output storage.
-	NoDag             bool     `default:"false"       json:"noDag"`             // Whether to disable maintaining folder dag structure for the sources. If disabled, DagGen will not be possible and folders will not have an associated CID.
+	Name                 string   `binding:"required"     json:"name"`              // Name of the preparation
+	SourceStorages       []string `json:"sourceStorages"`                           // Name of Source storage systems to be used for the source
+	OutputStorages       []string `json:"outputStorages"`                           // Name of Output storage systems to be used for the output
+	MaxSizeStr           string   `default:"31.5GiB"      json:"maxSize"`           // Maximum size of the CAR files to be created
+	PieceSizeStr         string   `default:""             json:"pieceSize"`         // Target piece size of the CAR files used for piece commitment calculation
+	DeleteAfterExport    bool     `default:"false"        json:"deleteAfterExport"` // Whether to delete the source files after export
+	NoInline             bool     `default:"false"        json:"noInline"`          // Whether to disable inline storage for the preparation. Can save database space but requires at least one output storage.
+	NoDag                bool     `default:"false"        json:"noDag"`             // Whether to disable maintaining folder dag structure for the sources. If disabled, DagGen will not be possible and folders will not have an associated CID.
+	BlobStorage          string   `json:"blobStorage"`                              // Name of the storage system to store the raw blocks (dag nodes) instead of the database. Can shrink the database for datasets with many small files.
+	MaxDirectoryDepth    int      `default:"0"            json:"maxDirectoryDepth"` // Maximum number of nested directories of a file. Deeper files are skipped during scanning. 0 means unlimited.
+	ConflictPolicy       string   `default:"overwrite"    json:"conflictPolicy"`    // What to do when the same path is packed more than once, i.e. a rescan finds a new version of a file. One of overwrite, newest, keep_both or error.
+	MaxBatchAge          string   `default:""             json:"maxBatchAge"`       // How long a pack job can be filled with appended files before it is packed even if it is not full, i.e. 6h. Empty means it waits until full.
+	PartitionBy          string   `default:""             json:"partitionBy"`       // Organize files into date-partitioned virtual directories based on their event time or last modified time, i.e. 2024/06/15/ for day. One of year, month, day or hour. Empty keeps the directory structure of the source.
+	PieceKeyRecipient    string   `default:""             json:"pieceKeyRecipient"` // Base64 encoded public key of the data owner. If set, each CAR file is encrypted with its own piece key, which is wrapped for this public key. Requires inline preparation to be disabled.
+	EncryptionRecipients []string `json:"encryptionRecipients"`                     // age X25519 public keys, i.e. age1..., that each file is encrypted for as it is packed, with its own ephemeral file key, so it can be decrypted with age -d and the identity of any of them. Files are not encrypted if empty. Requires inline preparation to be disabled.
+	EncryptionKMSKey     string   `default:""             json:"encryptionKmsKey"`  // URI of the master key of a key management service, i.e. awskms://<key ARN>, gcpkms://projects/<project>/locations/<location>/keyRings/<key ring>/cryptoKeys/<key> or vault://<transit mount>/<key>. Each file is encrypted with AES-256-GCM as it is packed, with its own data key generated and wrapped by the KMS. Cannot be combined with encryptionRecipients. Requires inline preparation to be disabled.
+	HashFunction         string   `default:"sha2-256"     json:"hashFunction"`      // Multihash function of the CIDs of the blocks. One of sha2-256 or blake2b-256.
+	LeafCodec            string   `default:"raw"          json:"leafCodec"`         // Codec of the leaf blocks holding the content of files. One of raw or dag-pb. dag-pb requires inline preparation to be disabled.
+	CidVersion           string   `default:"v1"           json:"cidVersion"`        // Version of the CIDs of the blocks. One of v1 or v0. v0 requires the sha2-256 hash function and the dag-pb leaf codec.
+	Chunker              string   `default:"size-1048576" json:"chunker"`           // Strategy splitting the content of files into leaf blocks, as in ipfs add. One of size-{size}, rabin, rabin-{avg}, rabin-{min}-{avg}-{max} or buzhash. Sizes can have units, i.e. rabin-256KiB-512KiB-1MiB.
+	SmallFileLimit       int      `default:"0"            json:"smallFileLimit"`    // Size in bytes of the largest file that is embedded in its CID, and so in its directory, rather than written as a block of its own. Up to 128. 0 disables it. Requires the raw leaf codec.
+	ChecksumSidecar      bool     `default:"false"        json:"checksumSidecar"`   // Whether to write .sha256 and .commp.json sidecar files with the payload CID, the piece CID, the piece size and the checksum next to each CAR file in the output storages, so transfer tools can verify them without querying the API.
+	CarSource            bool     `default:"false"        json:"carSource"`         // Whether the sources are collections of existing CAR files, i.e. Filecoin snapshots. The blocks of the .car files are indexed and packed as is, with their offsets in the CAR files, rather than the files being chunked again. Other files are skipped. Requires noDag.
+	CarCompression       string   `default:""             json:"carCompression"`    // How the CAR files are compressed in the output storages. Empty or zstd. Compressed CAR files are decompressed by the content provider when they are served, and the piece CID is still the one of the uncompressed CAR file. Requires at least one output storage.
+	CarNameTemplate      string   `default:""             json:"carNameTemplate"`   // Template of the paths of the CAR files in the output storages, without the .car extension. Placeholders are {piece_cid}, {preparation}, {sequence} and {date}, and {piece_cid} is required. Slashes put the CAR files in subdirectories, i.e. {preparation}/{date}/{piece_cid}. Empty means {piece_cid}.
+	CarShardDepth        int      `default:"0"            json:"carShardDepth"`     // Number of levels of subdirectories, named after two characters of the piece CID each, that the CAR files are sharded into, so no directory holds too many CAR files. Up to 3. 0 disables sharding.
+	Immutability         string   `default:""             json:"immutability"`      // Whether the sources are verified to be in buckets with object lock, i.e. S3 buckets with a default retention in governance or compliance mode, before they are scanned. One of warn, which logs mutable sources, or enforce, which refuses to scan them. Empty means they are not verified.
 }
 
 // ValidateCreateRequest processes and validates the creation request parameters.
 // The function checks the validity of the input parameters such as maxSize, pieceSize, and
 // the existence of source and output storages. The function also ensures that provided
 // parameters meet certain criteria, like the pieceSize being a power of two, and maxSize
-// allowing for padding. The encryption and storages compatibility is also validated.
+// allowing for padding. The piece encryption and storages compatibility is also validated.
 //
 // Parameters:
 //   - ctx: The context for database transactions and other operations.
@@ -69,7 +92,7 @@ func ValidateCreateRequest(ctx context.Context, db *gorm.DB, request CreateReque
 		}
 	}
 
-	if pieceSize > 1<<36 {
+	if pieceSize > util.MaxPieceSize {
 		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "pieceSize cannot be larger than 64 GiB")
 	}
 
@@ -111,16 +134,194 @@ func ValidateCreateRequest(ctx context.Context, db *gorm.DB, request CreateReque
 		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "inline preparation cannot be disabled without output storages")
 	}
 
-	return &model.Preparation{
-		MaxSize:           int64(maxSize),
-		PieceSize:         int64(pieceSize),
-		SourceStorages:    sources,
-		OutputStorages:    outputs,
-		DeleteAfterExport: request.DeleteAfterExport,
-		Name:              request.Name,
-		NoInline:          request.NoInline,
-		NoDag:             request.NoDag,
-	}, nil
+	if request.MaxDirectoryDepth < 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "maxDirectoryDepth cannot be negative")
+	}
+
+	var maxBatchAge time.Duration
+	if request.MaxBatchAge != "" {
+		maxBatchAge, err = time.ParseDuration(request.MaxBatchAge)
+		if err != nil || maxBatchAge < 0 {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid maxBatchAge %s", request.MaxBatchAge)
+		}
+	}
+
+	conflictPolicy := model.ConflictPolicy(request.ConflictPolicy)
+	if conflictPolicy == "" {
+		conflictPolicy = model.ConflictOverwrite
+	}
+	if !slices.Contains(model.ConflictPolicies, conflictPolicy) {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid conflictPolicy %s, must be one of %v", request.ConflictPolicy, model.ConflictPolicyStrings)
+	}
+
+	partitionBy := model.PartitionBy(request.PartitionBy)
+	if partitionBy != model.PartitionNone && !slices.Contains(model.PartitionByStrings, request.PartitionBy) {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid partitionBy %s, must be one of %v", request.PartitionBy, model.PartitionByStrings)
+	}
+
+	immutability := model.Immutability(request.Immutability)
+	if immutability != model.ImmutabilityNone && !slices.Contains(model.ImmutabilityStrings, request.Immutability) {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid immutability %s, must be one of %v", request.Immutability, model.ImmutabilityStrings)
+	}
+
+	hashFunction := model.HashFunction(request.HashFunction)
+	if hashFunction == "" {
+		hashFunction = model.HashSHA256
+	}
+	if !slices.Contains(model.HashFunctionStrings, string(hashFunction)) {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid hashFunction %s, must be one of %v", request.HashFunction, model.HashFunctionStrings)
+	}
+
+	leafCodec := model.LeafCodec(request.LeafCodec)
+	if leafCodec == "" {
+		leafCodec = model.LeafCodecRaw
+	}
+	if !slices.Contains(model.LeafCodecStrings, string(leafCodec)) {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid leafCodec %s, must be one of %v", request.LeafCodec, model.LeafCodecStrings)
+	}
+	// Inline preparation reads the leaf blocks back from the source files, which only works if they hold the bytes of
+	// the files as is.
+	if leafCodec == model.LeafCodecDagPB && !request.NoInline {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "dag-pb leaf codec requires inline preparation to be disabled")
+	}
+
+	cidVersion := model.CidVersion(request.CidVersion)
+	if cidVersion == "" {
+		cidVersion = model.CidV1
+	}
+	if !slices.Contains(model.CidVersionStrings, string(cidVersion)) {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid cidVersion %s, must be one of %v", request.CidVersion, model.CidVersionStrings)
+	}
+	// A CIDv0 is a bare sha2-256 multihash of a dag-pb block, so it cannot address raw leaves or other hash functions.
+	if cidVersion == model.CidV0 && (hashFunction != model.HashSHA256 || leafCodec != model.LeafCodecDagPB) {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "cidVersion v0 requires the sha2-256 hash function and the dag-pb leaf codec")
+	}
+
+	chunker, err := packutil.ParseChunker(request.Chunker)
+	if err != nil {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, err.Error())
+	}
+
+	if request.SmallFileLimit < 0 || request.SmallFileLimit > packutil.MaxSmallFileLimit {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid smallFileLimit %d, must be between 0 and %d", request.SmallFileLimit, packutil.MaxSmallFileLimit)
+	}
+	// Only raw leaves hold the bytes of the file as is, so that the file can be read back from its CID.
+	if request.SmallFileLimit > 0 && leafCodec != model.LeafCodecRaw {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "smallFileLimit requires the raw leaf codec")
+	}
+
+	// The CAR files are not UnixFS files, so they cannot be linked into the directory DAG of the sources.
+	if request.CarSource && !request.NoDag {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "carSource requires noDag")
+	}
+
+	carCompression := model.CarCompression(request.CarCompression)
+	if carCompression != model.CarCompressionNone && !slices.Contains(model.CarCompressionStrings, request.CarCompression) {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid carCompression %s, must be one of %v", request.CarCompression, model.CarCompressionStrings)
+	}
+	if carCompression != model.CarCompressionNone && len(outputs) == 0 {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "carCompression cannot be set without output storages")
+	}
+
+	err = packutil.ValidateCarNameTemplate(request.CarNameTemplate)
+	if err != nil {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, err.Error())
+	}
+	if request.CarShardDepth < 0 || request.CarShardDepth > packutil.MaxCarShardDepth {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid carShardDepth %d, must be between 0 and %d", request.CarShardDepth, packutil.MaxCarShardDepth)
+	}
+
+	if request.PieceKeyRecipient != "" {
+		if !request.NoInline {
+			return nil, errors.Wrap(handlererror.ErrInvalidParameter, "piece encryption requires inline preparation to be disabled")
+		}
+		_, err = encryption.ParseKey(request.PieceKeyRecipient)
+		if err != nil {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid pieceKeyRecipient: %s", err)
+		}
+	}
+
+	var keyVersion int
+	if len(request.EncryptionRecipients) > 0 {
+		if !request.NoInline {
+			return nil, errors.Wrap(handlererror.ErrInvalidParameter, "file encryption requires inline preparation to be disabled")
+		}
+		// The blocks of the CAR files of a CAR source are packed as is, so there is no file content to encrypt.
+		if request.CarSource {
+			return nil, errors.Wrap(handlererror.ErrInvalidParameter, "file encryption cannot be used with carSource")
+		}
+		_, err = encryption.ParseRecipients(request.EncryptionRecipients)
+		if err != nil {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid encryptionRecipients: %s", err)
+		}
+		keyVersion = 1
+	}
+	if request.EncryptionKMSKey != "" {
+		if len(request.EncryptionRecipients) > 0 {
+			return nil, errors.Wrap(handlererror.ErrInvalidParameter, "encryptionKmsKey cannot be combined with encryptionRecipients")
+		}
+		if !request.NoInline {
+			return nil, errors.Wrap(handlererror.ErrInvalidParameter, "file encryption requires inline preparation to be disabled")
+		}
+		if request.CarSource {
+			return nil, errors.Wrap(handlererror.ErrInvalidParameter, "file encryption cannot be used with carSource")
+		}
+		_, _, err = encryption.ParseKMSKey(request.EncryptionKMSKey)
+		if err != nil {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid encryptionKmsKey: %s", err)
+		}
+		keyVersion = 1
+	}
+
+	var blobStorage *model.Storage
+	if request.BlobStorage != "" {
+		if request.NoInline {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "blob storage cannot be used when inline preparation is disabled")
+		}
+		blobStorage = &model.Storage{}
+		err = blobStorage.FindByIDOrName(db, request.BlobStorage)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrapf(handlererror.ErrNotFound, "blob storage %s does not exist", request.BlobStorage)
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	preparation := &model.Preparation{
+		MaxSize:              int64(maxSize),
+		PieceSize:            int64(pieceSize),
+		SourceStorages:       sources,
+		OutputStorages:       outputs,
+		DeleteAfterExport:    request.DeleteAfterExport,
+		Name:                 request.Name,
+		NoInline:             request.NoInline,
+		NoDag:                request.NoDag,
+		MaxDirectoryDepth:    request.MaxDirectoryDepth,
+		ConflictPolicy:       conflictPolicy,
+		MaxBatchAge:          maxBatchAge,
+		PartitionBy:          partitionBy,
+		PieceKeyRecipient:    request.PieceKeyRecipient,
+		EncryptionRecipients: request.EncryptionRecipients,
+		EncryptionKeyVersion: keyVersion,
+		EncryptionKMSKey:     request.EncryptionKMSKey,
+		HashFunction:         hashFunction,
+		LeafCodec:            leafCodec,
+		CidVersion:           cidVersion,
+		Chunker:              chunker,
+		SmallFileLimit:       request.SmallFileLimit,
+		ChecksumSidecar:      request.ChecksumSidecar,
+		CarSource:            request.CarSource,
+		CarCompression:       carCompression,
+		CarNameTemplate:      request.CarNameTemplate,
+		CarShardDepth:        request.CarShardDepth,
+		Immutability:         immutability,
+	}
+	if blobStorage != nil {
+		preparation.BlobStorageID = &blobStorage.ID
+		preparation.BlobStorage = blobStorage
+	}
+	return preparation, nil
 }
 
 // CreatePreparationHandler handles the creation of a new Preparation entity based on the provided
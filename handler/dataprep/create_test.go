@@ -4,8 +4,12 @@ import (
 	"context"
 	"testing"
 
+	"filippo.io/age"
+
 	"github.com/data-preservation-programs/singularity/handler/handlererror"
 	"github.com/data-preservation-programs/singularity/handler/storage"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
 	"github.com/data-preservation-programs/singularity/util/testutil"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -51,6 +55,35 @@ func TestCreatePreparationHandler_MaxSizeTooLarge(t *testing.T) {
 	})
 }
 
+func TestCreatePreparationHandler_64GiBPieceSize(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "63GiB"})
+		require.NoError(t, err)
+		require.EqualValues(t, 63<<30, preparation.MaxSize)
+		require.EqualValues(t, 64<<30, preparation.PieceSize)
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name2", MaxSizeStr: "63.5GiB", PieceSizeStr: "64GiB"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "maxSize needs to be reduced to leave space for padding")
+	})
+}
+
+func TestCreatePreparationHandler_NegativeMaxDirectoryDepth(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", MaxDirectoryDepth: -1})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "maxDirectoryDepth cannot be negative")
+	})
+}
+
+func TestCreatePreparationHandler_InvalidConflictPolicy(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", ConflictPolicy: "oldest"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid conflictPolicy")
+	})
+}
+
 func TestCreatePreparationHandler_DeleteAfterExportWithoutOutput(t *testing.T) {
 	tmp1 := t.TempDir()
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
@@ -81,6 +114,178 @@ func TestCreatePreparationHandler_NoInlineWithoutOutput(t *testing.T) {
 	})
 }
 
+func TestCreatePreparationHandler_PieceKeyRecipient(t *testing.T) {
+	tmp1 := t.TempDir()
+	tmp2 := t.TempDir()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "source", Path: tmp1})
+		require.NoError(t, err)
+		_, err = storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "output", Path: tmp2})
+		require.NoError(t, err)
+		_, recipient, err := encryption.GenerateIdentity()
+		require.NoError(t, err)
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:              "name",
+			MaxSizeStr:        "2GB",
+			SourceStorages:    []string{"source"},
+			OutputStorages:    []string{"output"},
+			PieceKeyRecipient: recipient,
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "requires inline preparation to be disabled")
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:              "name",
+			MaxSizeStr:        "2GB",
+			SourceStorages:    []string{"source"},
+			OutputStorages:    []string{"output"},
+			NoInline:          true,
+			PieceKeyRecipient: "invalid",
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:              "name",
+			MaxSizeStr:        "2GB",
+			SourceStorages:    []string{"source"},
+			OutputStorages:    []string{"output"},
+			NoInline:          true,
+			PieceKeyRecipient: recipient,
+		})
+		require.NoError(t, err)
+		require.Equal(t, recipient, preparation.PieceKeyRecipient)
+	})
+}
+
+func TestCreatePreparationHandler_EncryptionRecipients(t *testing.T) {
+	tmp1 := t.TempDir()
+	tmp2 := t.TempDir()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "source", Path: tmp1})
+		require.NoError(t, err)
+		_, err = storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "output", Path: tmp2})
+		require.NoError(t, err)
+		identity, err := age.GenerateX25519Identity()
+		require.NoError(t, err)
+		recipient := identity.Recipient().String()
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:                 "name",
+			MaxSizeStr:           "2GB",
+			SourceStorages:       []string{"source"},
+			OutputStorages:       []string{"output"},
+			EncryptionRecipients: []string{recipient},
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "requires inline preparation to be disabled")
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:                 "name",
+			MaxSizeStr:           "2GB",
+			SourceStorages:       []string{"source"},
+			OutputStorages:       []string{"output"},
+			NoInline:             true,
+			EncryptionRecipients: []string{"age1invalid"},
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:                 "name",
+			MaxSizeStr:           "2GB",
+			SourceStorages:       []string{"source"},
+			OutputStorages:       []string{"output"},
+			NoInline:             true,
+			EncryptionRecipients: []string{recipient},
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.StringSlice{recipient}, preparation.EncryptionRecipients)
+		require.Equal(t, 1, preparation.EncryptionKeyVersion)
+	})
+}
+
+func TestCreatePreparationHandler_EncryptionKMSKey(t *testing.T) {
+	tmp1 := t.TempDir()
+	tmp2 := t.TempDir()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "source", Path: tmp1})
+		require.NoError(t, err)
+		_, err = storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "output", Path: tmp2})
+		require.NoError(t, err)
+		identity, err := age.GenerateX25519Identity()
+		require.NoError(t, err)
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:                 "name",
+			MaxSizeStr:           "2GB",
+			SourceStorages:       []string{"source"},
+			OutputStorages:       []string{"output"},
+			NoInline:             true,
+			EncryptionRecipients: []string{identity.Recipient().String()},
+			EncryptionKMSKey:     "vault://transit/key",
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "cannot be combined")
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:             "name",
+			MaxSizeStr:       "2GB",
+			SourceStorages:   []string{"source"},
+			OutputStorages:   []string{"output"},
+			EncryptionKMSKey: "vault://transit/key",
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "requires inline preparation to be disabled")
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:             "name",
+			MaxSizeStr:       "2GB",
+			SourceStorages:   []string{"source"},
+			OutputStorages:   []string{"output"},
+			NoInline:         true,
+			EncryptionKMSKey: "unknown://key",
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:             "name",
+			MaxSizeStr:       "2GB",
+			SourceStorages:   []string{"source"},
+			OutputStorages:   []string{"output"},
+			NoInline:         true,
+			EncryptionKMSKey: "vault://transit/key",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "vault://transit/key", preparation.EncryptionKMSKey)
+		require.Equal(t, 1, preparation.EncryptionKeyVersion)
+	})
+}
+
+func TestCreatePreparationHandler_Immutability(t *testing.T) {
+	tmp := t.TempDir()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "source", Path: tmp})
+		require.NoError(t, err)
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:           "name",
+			MaxSizeStr:     "2GB",
+			SourceStorages: []string{"source"},
+			Immutability:   "always",
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:           "name",
+			MaxSizeStr:     "2GB",
+			SourceStorages: []string{"source"},
+			Immutability:   "enforce",
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.ImmutabilityEnforce, preparation.Immutability)
+	})
+}
+
 func TestCreatePreparationHandler_NameAllDigits(t *testing.T) {
 	tmp1 := t.TempDir()
 	tmp2 := t.TempDir()
@@ -118,3 +323,204 @@ func TestCreatePreparationHandler_Success(t *testing.T) {
 		require.Greater(t, preparation.ID, uint32(0))
 	})
 }
+
+func TestCreatePreparationHandler_BlobStorage(t *testing.T) {
+	tmp1 := t.TempDir()
+	tmp2 := t.TempDir()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "source", Path: tmp1})
+		require.NoError(t, err)
+		blob, err := storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "blob", Path: tmp2})
+		require.NoError(t, err)
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:           "name",
+			MaxSizeStr:     "2GB",
+			SourceStorages: []string{"source"},
+			BlobStorage:    "notexist",
+		})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:           "name",
+			MaxSizeStr:     "2GB",
+			SourceStorages: []string{"source"},
+			BlobStorage:    "blob",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, preparation.BlobStorageID)
+		require.Equal(t, blob.ID, *preparation.BlobStorageID)
+	})
+}
+
+func TestCreatePreparationHandler_InvalidMaxBatchAge(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", MaxBatchAge: "-1h"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid maxBatchAge")
+	})
+}
+
+func TestCreatePreparationHandler_PartitionBy(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", PartitionBy: "week"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid partitionBy")
+
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", PartitionBy: "day"})
+		require.NoError(t, err)
+		require.Equal(t, model.PartitionDay, preparation.PartitionBy)
+	})
+}
+
+func TestCreatePreparationHandler_CidOptions(t *testing.T) {
+	tmp := t.TempDir()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", HashFunction: "md5"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid hashFunction")
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", LeafCodec: "dag-cbor"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid leafCodec")
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", LeafCodec: "dag-pb"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "requires inline preparation to be disabled")
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", CidVersion: "v2"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid cidVersion")
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", CidVersion: "v0"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "cidVersion v0 requires")
+
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "default", MaxSizeStr: "2GB"})
+		require.NoError(t, err)
+		require.Equal(t, model.HashSHA256, preparation.HashFunction)
+		require.Equal(t, model.LeafCodecRaw, preparation.LeafCodec)
+		require.Equal(t, model.CidV1, preparation.CidVersion)
+
+		_, err = storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "output", Path: tmp})
+		require.NoError(t, err)
+		preparation, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:           "custom",
+			MaxSizeStr:     "2GB",
+			OutputStorages: []string{"output"},
+			NoInline:       true,
+			HashFunction:   "blake2b-256",
+			LeafCodec:      "dag-pb",
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.HashBlake2b256, preparation.HashFunction)
+		require.Equal(t, model.LeafCodecDagPB, preparation.LeafCodec)
+
+		preparation, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:           "cidv0",
+			MaxSizeStr:     "2GB",
+			OutputStorages: []string{"output"},
+			NoInline:       true,
+			LeafCodec:      "dag-pb",
+			CidVersion:     "v0",
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.CidV0, preparation.CidVersion)
+	})
+}
+
+func TestCreatePreparationHandler_CarCompression(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", CarCompression: "gzip"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid carCompression")
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", CarCompression: "zstd"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "without output storages")
+
+		_, err = storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "output", Path: t.TempDir()})
+		require.NoError(t, err)
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:           "name",
+			MaxSizeStr:     "2GB",
+			OutputStorages: []string{"output"},
+			CarCompression: "zstd",
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.CarCompressionZstd, preparation.CarCompression)
+	})
+}
+
+func TestCreatePreparationHandler_CarNameTemplate(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", CarNameTemplate: "{preparation}/{date}"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "{piece_cid}")
+
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", CarShardDepth: 4})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid carShardDepth")
+
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:            "name",
+			MaxSizeStr:      "2GB",
+			CarNameTemplate: "{preparation}/{date}/{piece_cid}",
+			CarShardDepth:   2,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "{preparation}/{date}/{piece_cid}", preparation.CarNameTemplate)
+		require.Equal(t, 2, preparation.CarShardDepth)
+	})
+}
+
+func TestCreatePreparationHandler_Chunker(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", Chunker: "size-4MiB"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid chunker")
+
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "default", MaxSizeStr: "2GB"})
+		require.NoError(t, err)
+		require.Equal(t, "size-1048576", preparation.Chunker)
+
+		preparation, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "rabin", MaxSizeStr: "2GB", Chunker: "rabin-256KiB-512KiB-1MiB"})
+		require.NoError(t, err)
+		require.Equal(t, "rabin-262144-524288-1048576", preparation.Chunker)
+	})
+}
+
+func TestCreatePreparationHandler_SmallFileLimit(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", SmallFileLimit: 129})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid smallFileLimit")
+
+		_, err = storage.Default.CreateStorageHandler(ctx, db, "local", storage.CreateRequest{Name: "output", Path: t.TempDir()})
+		require.NoError(t, err)
+		_, err = Default.CreatePreparationHandler(ctx, db, CreateRequest{
+			Name:           "name",
+			MaxSizeStr:     "2GB",
+			OutputStorages: []string{"output"},
+			NoInline:       true,
+			LeafCodec:      "dag-pb",
+			SmallFileLimit: 64,
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "raw leaf codec")
+
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", SmallFileLimit: 64})
+		require.NoError(t, err)
+		require.Equal(t, 64, preparation.SmallFileLimit)
+	})
+}
+
+func TestCreatePreparationHandler_CarSource(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", CarSource: true})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "carSource requires noDag")
+
+		preparation, err := Default.CreatePreparationHandler(ctx, db, CreateRequest{Name: "name", MaxSizeStr: "2GB", CarSource: true, NoDag: true})
+		require.NoError(t, err)
+		require.True(t, preparation.CarSource)
+	})
+}
@@ -0,0 +1,331 @@
+package dataprep
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/push"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/rclone/rclone/fs"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"gorm.io/gorm"
+)
+
+type DiffStatus string
+
+const (
+	DiffAdded   DiffStatus = "added"   // The file is in the source but has not been prepared
+	DiffRemoved DiffStatus = "removed" // The file has been prepared but is no longer in the source
+	DiffChanged DiffStatus = "changed" // The file has been prepared but has a different size, last modified time or hash in the source
+)
+
+type DiffEntry struct {
+	Status               DiffStatus `json:"status"`
+	Path                 string     `json:"path"`                                                            // Path of the file relative to the source
+	Size                 int64      `json:"size"`                                                            // Size of the file in the source. 0 if removed.
+	PreparedSize         int64      `json:"preparedSize"`                                                    // Size of the file when it was prepared. 0 if added.
+	LastModified         time.Time  `json:"lastModified"         table:"format:2006-01-02 15:04:05"`         // Last modified time of the file in the source
+	PreparedLastModified time.Time  `json:"preparedLastModified" table:"verbose;format:2006-01-02 15:04:05"` // Last modified time of the file when it was prepared
+}
+
+type SourceDiff struct {
+	Added       int         `json:"added"`       // Number of files added to the source
+	AddedSize   int64       `json:"addedSize"`   // Total size of the added files
+	Removed     int         `json:"removed"`     // Number of prepared files removed from the source
+	RemovedSize int64       `json:"removedSize"` // Total size of the removed files, when they were prepared
+	Changed     int         `json:"changed"`     // Number of prepared files changed in the source
+	ChangedSize int64       `json:"changedSize"` // Total size of the changed files in the source
+	Unchanged   int         `json:"unchanged"`   // Number of prepared files unchanged in the source
+	Truncated   bool        `json:"truncated"`   // Whether more files differ than are listed in the entries
+	Entries     []DiffEntry `json:"entries"     table:"expand"`
+}
+
+// DefaultDiffLimit is the number of differing files listed when DiffSourceRequest does not set a limit.
+const DefaultDiffLimit = 1000
+
+type DiffSourceRequest struct {
+	Limit int `json:"limit"` // Maximum number of added, removed and changed files to list. The counts and sizes cover all files. 0 lists up to 1000 files.
+}
+
+// preparedFile is the latest prepared version of a path.
+type preparedFile struct {
+	Size             int64
+	LastModifiedNano int64
+	Hash             string
+}
+
+// sourceDiffer walks the source and the prepared directory tree side by side, one directory at a time, so that only
+// the entries of the directory being compared are held in memory.
+type sourceDiffer struct {
+	db       *gorm.DB
+	handler  *storagesystem.RCloneHandler
+	maxDepth int
+	limit    int
+	diff     SourceDiff
+}
+
+// DiffSourceHandler compares the current state of a source storage against what has been prepared from it, so the
+// changes can be reviewed before deciding to prepare a new version. Nothing is written to the database.
+//
+// The source is listed one directory at a time, skipping the files deeper than the maximum directory depth of the
+// preparation, and each directory is compared in batches against the files prepared in the same directory. A file
+// is considered changed if its size, last modified time or hash differs from the latest prepared version of its path.
+// Directories are not compared.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The preparation ID or name.
+//   - source: The source ID or name.
+//   - request: The maximum number of differing files to list.
+//
+// Returns:
+//   - A pointer to the SourceDiff with the counts of all added, removed and changed files, and up to the limit of
+//     them in the order the source is walked, directory by directory.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) DiffSourceHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+	source string,
+	request DiffSourceRequest,
+) (*SourceDiff, error) {
+	db = db.WithContext(ctx)
+	if request.Limit < 0 {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "limit cannot be negative")
+	}
+	if request.Limit == 0 {
+		request.Limit = DefaultDiffLimit
+	}
+	var attachment model.SourceAttachment
+	err := attachment.FindByPreparationAndSource(db, id, source)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "source '%s' is not attached to preparation %s", source, id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// A source that has never been scanned has no root directory, so all of its files are added.
+	rootID, err := attachment.RootDirectoryID(ctx, db)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.WithStack(err)
+	}
+
+	handler, err := storagesystem.NewRCloneHandler(ctx, *attachment.Storage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	d := sourceDiffer{
+		db:       db,
+		handler:  handler,
+		maxDepth: attachment.Preparation.MaxDirectoryDepth,
+		limit:    request.Limit,
+		diff:     SourceDiff{Entries: []DiffEntry{}},
+	}
+	err = d.compareDirectory(ctx, "", rootID)
+	if err != nil {
+		return nil, err
+	}
+	return &d.diff, nil
+}
+
+// add counts a differing file, and lists it unless the limit has been reached.
+func (d *sourceDiffer) add(entry DiffEntry) {
+	switch entry.Status {
+	case DiffAdded:
+		d.diff.Added++
+		d.diff.AddedSize += entry.Size
+	case DiffRemoved:
+		d.diff.Removed++
+		d.diff.RemovedSize += entry.PreparedSize
+	case DiffChanged:
+		d.diff.Changed++
+		d.diff.ChangedSize += entry.Size
+	}
+	if len(d.diff.Entries) >= d.limit {
+		d.diff.Truncated = true
+		return
+	}
+	d.diff.Entries = append(d.diff.Entries, entry)
+}
+
+// preparedFiles returns the latest prepared version of the files of a prepared directory, by path.
+func (d *sourceDiffer) preparedFiles(dirID model.DirectoryID) (map[string]preparedFile, error) {
+	prepared := make(map[string]preparedFile)
+	if dirID == 0 {
+		return prepared, nil
+	}
+	var files []model.File
+	err := d.db.Select("id", "path", "size", "last_modified_nano", "hash").
+		Where("directory_id = ?", dirID).
+		Order("id ASC").
+		FindInBatches(&files, util.BatchSize, func(_ *gorm.DB, _ int) error {
+			// Later versions of the same path override the earlier ones
+			for _, file := range files {
+				prepared[file.Path] = preparedFile{Size: file.Size, LastModifiedNano: file.LastModifiedNano, Hash: file.Hash}
+			}
+			return nil
+		}).Error
+	return prepared, errors.WithStack(err)
+}
+
+// preparedDirectories returns the IDs of the subdirectories of a prepared directory, by name.
+func (d *sourceDiffer) preparedDirectories(dirID model.DirectoryID) (map[string]model.DirectoryID, error) {
+	children := make(map[string]model.DirectoryID)
+	if dirID == 0 {
+		return children, nil
+	}
+	var dirs []model.Directory
+	err := d.db.Select("id", "name").
+		Where("parent_id = ?", dirID).
+		Order("id ASC").
+		FindInBatches(&dirs, util.BatchSize, func(_ *gorm.DB, _ int) error {
+			for _, dir := range dirs {
+				children[dir.Name] = dir.ID
+			}
+			return nil
+		}).Error
+	return children, errors.WithStack(err)
+}
+
+// compareDirectory compares the files of a directory of the source against the ones prepared in the same directory,
+// then its subdirectories. The prepared files and subdirectories that are no longer in the source are removed.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - dirPath: The path of the directory relative to the root of the source.
+//   - dirID: The ID of the prepared directory, or 0 if the directory has not been prepared.
+//
+// Returns:
+//   - An error if the directory cannot be listed, or the prepared directory cannot be read.
+func (d *sourceDiffer) compareDirectory(ctx context.Context, dirPath string, dirID model.DirectoryID) error {
+	entries, err := d.handler.List(ctx, dirPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list %s of the source", dirPath)
+	}
+	slices.SortFunc(entries, func(i, j fs.DirEntry) int {
+		return strings.Compare(i.Remote(), j.Remote())
+	})
+	prepared, err := d.preparedFiles(dirID)
+	if err != nil {
+		return err
+	}
+	children, err := d.preparedDirectories(dirID)
+	if err != nil {
+		return err
+	}
+
+	var subdirs []fs.Directory
+	for _, entry := range entries {
+		switch v := entry.(type) {
+		case fs.Directory:
+			subdirs = append(subdirs, v)
+		case fs.Object:
+			filePath := v.Remote()
+			if d.maxDepth > 0 && push.DirectoryDepth(filePath) > d.maxDepth {
+				continue
+			}
+			size, hashValue, lastModified := push.ExtractFromFsObject(ctx, v)
+			file, ok := prepared[filePath]
+			if !ok {
+				d.add(DiffEntry{
+					Status:       DiffAdded,
+					Path:         filePath,
+					Size:         size,
+					LastModified: lastModified,
+				})
+				continue
+			}
+			delete(prepared, filePath)
+			sameHash := file.Hash == "" || hashValue == "" || file.Hash == hashValue
+			if file.Size == size && file.LastModifiedNano == lastModified.UnixNano() && sameHash {
+				d.diff.Unchanged++
+				continue
+			}
+			d.add(DiffEntry{
+				Status:               DiffChanged,
+				Path:                 filePath,
+				Size:                 size,
+				PreparedSize:         file.Size,
+				LastModified:         lastModified,
+				PreparedLastModified: time.Unix(0, file.LastModifiedNano),
+			})
+		}
+	}
+	d.removeFiles(prepared)
+
+	for _, subdir := range subdirs {
+		childID := children[path.Base(subdir.Remote())]
+		delete(children, path.Base(subdir.Remote()))
+		if d.maxDepth > 0 && push.DirectoryDepth(subdir.Remote())+1 > d.maxDepth {
+			continue
+		}
+		err = d.compareDirectory(ctx, subdir.Remote(), childID)
+		if err != nil {
+			return err
+		}
+	}
+	return d.removeDirectories(children)
+}
+
+// removeFiles lists the prepared files that are no longer in the source as removed, sorted by path.
+func (d *sourceDiffer) removeFiles(prepared map[string]preparedFile) {
+	paths := maps.Keys(prepared)
+	slices.Sort(paths)
+	for _, filePath := range paths {
+		file := prepared[filePath]
+		d.add(DiffEntry{
+			Status:               DiffRemoved,
+			Path:                 filePath,
+			PreparedSize:         file.Size,
+			PreparedLastModified: time.Unix(0, file.LastModifiedNano),
+		})
+	}
+}
+
+// removeDirectories lists the files of the prepared directories that are no longer in the source as removed,
+// including the files of their subdirectories.
+func (d *sourceDiffer) removeDirectories(children map[string]model.DirectoryID) error {
+	names := maps.Keys(children)
+	slices.Sort(names)
+	for _, name := range names {
+		prepared, err := d.preparedFiles(children[name])
+		if err != nil {
+			return err
+		}
+		d.removeFiles(prepared)
+		grandchildren, err := d.preparedDirectories(children[name])
+		if err != nil {
+			return err
+		}
+		err = d.removeDirectories(grandchildren)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// @ID DiffSource
+// @Summary Compare the current state of a source storage against what has been prepared from it
+// @Tags Preparation
+// @Accept json
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Param name path string true "Source storage ID or name"
+// @Param request body DiffSourceRequest true "Diff Source Request"
+// @Success 200 {object} SourceDiff
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/source/{name}/diff [post]
+func _() {}
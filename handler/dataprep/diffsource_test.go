@@ -0,0 +1,103 @@
+package dataprep
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestDiffSourceHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		tmp := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(tmp, "dir", "deep"), 0755))
+		mtimes := make(map[string]int64)
+		for name, content := range map[string]string{
+			"same.txt":          "same",
+			"changed.txt":       "changed content",
+			"dir/added.txt":     "added",
+			"dir/same.txt":      "same too",
+			"dir/deep/skip.txt": "deeper than the maximum directory depth",
+			"rescanned.txt":     "new version",
+		} {
+			path := filepath.Join(tmp, name)
+			require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+			stat, err := os.Stat(path)
+			require.NoError(t, err)
+			mtimes[name] = stat.ModTime().UnixNano()
+		}
+		err := db.Create(&model.Preparation{
+			Name:              "prep",
+			MaxDirectoryDepth: 1,
+			SourceStorages: []model.Storage{{
+				Name: "source",
+				Type: "local",
+				Path: tmp,
+			}},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create([]model.Directory{
+			{AttachmentID: 1, Name: "source"},
+			{AttachmentID: 1, Name: "dir", ParentID: ptr.Of(model.DirectoryID(1))},
+			{AttachmentID: 1, Name: "gone", ParentID: ptr.Of(model.DirectoryID(1))},
+			{AttachmentID: 1, Name: "sub", ParentID: ptr.Of(model.DirectoryID(3))},
+		}).Error
+		require.NoError(t, err)
+		root, dir, gone, sub := ptr.Of(model.DirectoryID(1)), ptr.Of(model.DirectoryID(2)), ptr.Of(model.DirectoryID(3)), ptr.Of(model.DirectoryID(4))
+		err = db.Create([]model.File{
+			{AttachmentID: 1, DirectoryID: root, Path: "same.txt", Size: 4, LastModifiedNano: mtimes["same.txt"]},
+			{AttachmentID: 1, DirectoryID: root, Path: "changed.txt", Size: 7, LastModifiedNano: mtimes["changed.txt"]},
+			{AttachmentID: 1, DirectoryID: root, Path: "removed.txt", Size: 9, LastModifiedNano: 1},
+			{AttachmentID: 1, DirectoryID: root, Path: "rescanned.txt", Size: 11, LastModifiedNano: 1},
+			{AttachmentID: 1, DirectoryID: root, Path: "rescanned.txt", Size: 11, LastModifiedNano: mtimes["rescanned.txt"]},
+			{AttachmentID: 1, DirectoryID: dir, Path: "dir/same.txt", Size: 8, LastModifiedNano: mtimes["dir/same.txt"]},
+			{AttachmentID: 1, DirectoryID: gone, Path: "gone/old.txt", Size: 3, LastModifiedNano: 1},
+			{AttachmentID: 1, DirectoryID: sub, Path: "gone/sub/old.txt", Size: 5, LastModifiedNano: 1},
+		}).Error
+		require.NoError(t, err)
+
+		diff, err := Default.DiffSourceHandler(ctx, db, "prep", "source", DiffSourceRequest{})
+		require.NoError(t, err)
+		require.Equal(t, 1, diff.Added)
+		require.EqualValues(t, 5, diff.AddedSize)
+		require.Equal(t, 3, diff.Removed)
+		require.EqualValues(t, 17, diff.RemovedSize)
+		require.Equal(t, 1, diff.Changed)
+		require.EqualValues(t, 15, diff.ChangedSize)
+		require.Equal(t, 3, diff.Unchanged)
+		require.False(t, diff.Truncated)
+		require.Len(t, diff.Entries, 5)
+		require.Equal(t, "changed.txt", diff.Entries[0].Path)
+		require.Equal(t, DiffChanged, diff.Entries[0].Status)
+		require.EqualValues(t, 15, diff.Entries[0].Size)
+		require.EqualValues(t, 7, diff.Entries[0].PreparedSize)
+		require.Equal(t, "removed.txt", diff.Entries[1].Path)
+		require.Equal(t, DiffRemoved, diff.Entries[1].Status)
+		require.Equal(t, "dir/added.txt", diff.Entries[2].Path)
+		require.Equal(t, DiffAdded, diff.Entries[2].Status)
+		require.Equal(t, "gone/old.txt", diff.Entries[3].Path)
+		require.Equal(t, DiffRemoved, diff.Entries[3].Status)
+		require.Equal(t, "gone/sub/old.txt", diff.Entries[4].Path)
+		require.Equal(t, DiffRemoved, diff.Entries[4].Status)
+
+		limited, err := Default.DiffSourceHandler(ctx, db, "prep", "source", DiffSourceRequest{Limit: 2})
+		require.NoError(t, err)
+		require.True(t, limited.Truncated)
+		require.Equal(t, diff.Entries[:2], limited.Entries)
+		require.Equal(t, 3, limited.Removed)
+		require.EqualValues(t, 17, limited.RemovedSize)
+
+		_, err = Default.DiffSourceHandler(ctx, db, "prep", "source", DiffSourceRequest{Limit: -1})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		_, err = Default.DiffSourceHandler(ctx, db, "prep", "other", DiffSourceRequest{})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+	})
+}
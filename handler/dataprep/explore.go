@@ -40,8 +40,8 @@ type Version struct {
 //
 // This function starts by fetching the desired Storage record based on the provided name. It then fetches the
 // associated SourceAttachment record which connects a preparation to a storage. Using the RootDirectoryID method
-// of the source, it retrieves the root directory's ID and navigates to the desired directory by iterating
-// through the path segments. Once at the desired directory, it fetches the contained directories and files,
+// of the source, it retrieves the root directory's ID and finds the desired directory by its compressed path, or
+// navigates to it by iterating through the path segments if it has none. Once at the desired directory, it fetches the contained directories and files,
 // constructing a result list from the gathered data.
 //
 // Parameters:
@@ -79,6 +79,17 @@ func (DefaultHandler) ExploreHandler(
 
 	segments := underscore.Filter(strings.Split(path, "/"), func(p string) bool { return p != "" })
 	path = strings.Join(segments, "/")
+	// The directory is found by its compressed path if it has one, otherwise the tree is walked from the root.
+	if path != "" {
+		found, err := source.FindDirectories(ctx, db, []string{path})
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if dir, ok := found[path]; ok {
+			dirID = dir.ID
+			segments = nil
+		}
+	}
 	for _, segment := range segments {
 		var dir model.Directory
 		err = db.Where("parent_id = ? AND name = ?", dirID, segment).First(&dir).Error
@@ -4,6 +4,7 @@ package dataprep
 import (
 	"context"
 
+	"github.com/data-preservation-programs/singularity/handler/file"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
@@ -43,7 +44,61 @@ type Handler interface {
 		request AddPieceRequest,
 	) (*model.Car, error)
 
+	ExportPieceIndexHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		id string,
+		request ExportPieceIndexRequest,
+	) ([]PieceIndex, error)
+
+	ExportPieceKeysHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		id string,
+	) (*PieceKeyEscrow, error)
+
+	ExportItemKeysHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		id string,
+	) (*ItemKeyEscrow, error)
+
+	CreateRetrievalTokenHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		id string,
+		request CreateRetrievalTokenRequest,
+	) (*IssuedRetrievalToken, error)
+
+	ListRetrievalTokensHandler(ctx context.Context, db *gorm.DB, id string) ([]model.RetrievalToken, error)
+
+	RevokeRetrievalTokenHandler(ctx context.Context, db *gorm.DB, id string, tokenID uint64) (*model.RetrievalToken, error)
+	AggregatePiecesHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		id string,
+		request AggregatePiecesRequest,
+	) ([]model.Car, error)
+
+	GetPieceProofHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		pieceCID string,
+	) (*PieceProof, error)
+
+	GetStatsHandler(ctx context.Context, db *gorm.DB, id string) (*Stats, error)
+	GetSharedStatsHandler(ctx context.Context, db *gorm.DB, shareID string) (*Stats, error)
+	ShareStatsHandler(ctx context.Context, db *gorm.DB, id string) (*model.Preparation, error)
+	UnshareStatsHandler(ctx context.Context, db *gorm.DB, id string) (*model.Preparation, error)
+
 	AddSourceStorageHandler(ctx context.Context, db *gorm.DB, id string, source string) (*model.Preparation, error)
+	UpdateSourceHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		id string,
+		source string,
+		request UpdateSourceRequest,
+	) (*model.SourceAttachment, error)
 	ListSchedulesHandler(
 		ctx context.Context,
 		db *gorm.DB,
@@ -55,11 +110,52 @@ type Handler interface {
 		request RenameRequest,
 	) (*model.Preparation, error)
 
+	RotateRecipientsHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		id string,
+		request RotateRecipientsRequest,
+	) (*model.Preparation, error)
+
+	VerifySourcesHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		id string,
+	) ([]model.SourceAttachment, error)
+
+	PausePreparationHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		name string,
+	) (*model.Preparation, error)
+
+	ResumePreparationHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		name string,
+	) (*model.Preparation, error)
+
 	RemovePreparationHandler(
 		ctx context.Context,
 		db *gorm.DB,
 		name string,
 		request RemoveRequest) error
+
+	DumpDatabaseHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		id string,
+		source string,
+		request DumpDatabaseRequest,
+	) (*file.AppendResult, error)
+
+	DiffSourceHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		id string,
+		source string,
+		request DiffSourceRequest,
+	) (*SourceDiff, error)
 }
 
 type DefaultHandler struct{}
@@ -80,6 +176,26 @@ func (m *MockDataPrep) RenamePreparationHandler(ctx context.Context, db *gorm.DB
 	return args.Get(0).(*model.Preparation), args.Error(1)
 }
 
+func (m *MockDataPrep) RotateRecipientsHandler(ctx context.Context, db *gorm.DB, id string, request RotateRecipientsRequest) (*model.Preparation, error) {
+	args := m.Called(ctx, db, id, request)
+	return args.Get(0).(*model.Preparation), args.Error(1)
+}
+
+func (m *MockDataPrep) VerifySourcesHandler(ctx context.Context, db *gorm.DB, id string) ([]model.SourceAttachment, error) {
+	args := m.Called(ctx, db, id)
+	return args.Get(0).([]model.SourceAttachment), args.Error(1)
+}
+
+func (m *MockDataPrep) PausePreparationHandler(ctx context.Context, db *gorm.DB, name string) (*model.Preparation, error) {
+	args := m.Called(ctx, db, name)
+	return args.Get(0).(*model.Preparation), args.Error(1)
+}
+
+func (m *MockDataPrep) ResumePreparationHandler(ctx context.Context, db *gorm.DB, name string) (*model.Preparation, error) {
+	args := m.Called(ctx, db, name)
+	return args.Get(0).(*model.Preparation), args.Error(1)
+}
+
 func (m *MockDataPrep) ListSchedulesHandler(ctx context.Context, db *gorm.DB, id string) ([]model.Schedule, error) {
 	args := m.Called(ctx, db, id)
 	return args.Get(0).([]model.Schedule), args.Error(1)
@@ -115,6 +231,11 @@ func (m *MockDataPrep) ListPiecesHandler(ctx context.Context, db *gorm.DB, id st
 	return args.Get(0).([]PieceList), args.Error(1)
 }
 
+func (m *MockDataPrep) ExportPieceIndexHandler(ctx context.Context, db *gorm.DB, id string, request ExportPieceIndexRequest) ([]PieceIndex, error) {
+	args := m.Called(ctx, db, id, request)
+	return args.Get(0).([]PieceIndex), args.Error(1)
+}
+
 func (m *MockDataPrep) AddPieceHandler(ctx context.Context, db *gorm.DB, id string, request AddPieceRequest) (*model.Car, error) {
 	args := m.Called(ctx, db, id, request)
 	return args.Get(0).(*model.Car), args.Error(1)
@@ -125,4 +246,74 @@ func (m *MockDataPrep) AddSourceStorageHandler(ctx context.Context, db *gorm.DB,
 	return args.Get(0).(*model.Preparation), args.Error(1)
 }
 
+func (m *MockDataPrep) UpdateSourceHandler(ctx context.Context, db *gorm.DB, id string, source string, request UpdateSourceRequest) (*model.SourceAttachment, error) {
+	args := m.Called(ctx, db, id, source, request)
+	return args.Get(0).(*model.SourceAttachment), args.Error(1)
+}
+
+func (m *MockDataPrep) DumpDatabaseHandler(ctx context.Context, db *gorm.DB, id string, source string, request DumpDatabaseRequest) (*file.AppendResult, error) {
+	args := m.Called(ctx, db, id, source, request)
+	return args.Get(0).(*file.AppendResult), args.Error(1)
+}
+
+func (m *MockDataPrep) DiffSourceHandler(ctx context.Context, db *gorm.DB, id string, source string, request DiffSourceRequest) (*SourceDiff, error) {
+	args := m.Called(ctx, db, id, source, request)
+	return args.Get(0).(*SourceDiff), args.Error(1)
+}
+
+func (m *MockDataPrep) ExportPieceKeysHandler(ctx context.Context, db *gorm.DB, id string) (*PieceKeyEscrow, error) {
+	args := m.Called(ctx, db, id)
+	return args.Get(0).(*PieceKeyEscrow), args.Error(1)
+}
+
+func (m *MockDataPrep) ExportItemKeysHandler(ctx context.Context, db *gorm.DB, id string) (*ItemKeyEscrow, error) {
+	args := m.Called(ctx, db, id)
+	return args.Get(0).(*ItemKeyEscrow), args.Error(1)
+}
+
+func (m *MockDataPrep) CreateRetrievalTokenHandler(ctx context.Context, db *gorm.DB, id string, request CreateRetrievalTokenRequest) (*IssuedRetrievalToken, error) {
+	args := m.Called(ctx, db, id, request)
+	return args.Get(0).(*IssuedRetrievalToken), args.Error(1)
+}
+
+func (m *MockDataPrep) ListRetrievalTokensHandler(ctx context.Context, db *gorm.DB, id string) ([]model.RetrievalToken, error) {
+	args := m.Called(ctx, db, id)
+	return args.Get(0).([]model.RetrievalToken), args.Error(1)
+}
+
+func (m *MockDataPrep) RevokeRetrievalTokenHandler(ctx context.Context, db *gorm.DB, id string, tokenID uint64) (*model.RetrievalToken, error) {
+	args := m.Called(ctx, db, id, tokenID)
+	return args.Get(0).(*model.RetrievalToken), args.Error(1)
+}
+
+func (m *MockDataPrep) AggregatePiecesHandler(ctx context.Context, db *gorm.DB, id string, request AggregatePiecesRequest) ([]model.Car, error) {
+	args := m.Called(ctx, db, id, request)
+	return args.Get(0).([]model.Car), args.Error(1)
+}
+
+func (m *MockDataPrep) GetStatsHandler(ctx context.Context, db *gorm.DB, id string) (*Stats, error) {
+	args := m.Called(ctx, db, id)
+	return args.Get(0).(*Stats), args.Error(1)
+}
+
+func (m *MockDataPrep) GetSharedStatsHandler(ctx context.Context, db *gorm.DB, shareID string) (*Stats, error) {
+	args := m.Called(ctx, db, shareID)
+	return args.Get(0).(*Stats), args.Error(1)
+}
+
+func (m *MockDataPrep) ShareStatsHandler(ctx context.Context, db *gorm.DB, id string) (*model.Preparation, error) {
+	args := m.Called(ctx, db, id)
+	return args.Get(0).(*model.Preparation), args.Error(1)
+}
+
+func (m *MockDataPrep) UnshareStatsHandler(ctx context.Context, db *gorm.DB, id string) (*model.Preparation, error) {
+	args := m.Called(ctx, db, id)
+	return args.Get(0).(*model.Preparation), args.Error(1)
+}
+
+func (m *MockDataPrep) GetPieceProofHandler(ctx context.Context, db *gorm.DB, pieceCID string) (*PieceProof, error) {
+	args := m.Called(ctx, db, pieceCID)
+	return args.Get(0).(*PieceProof), args.Error(1)
+}
+
 var _ Handler = &MockDataPrep{}
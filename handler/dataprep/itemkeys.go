@@ -0,0 +1,122 @@
+package dataprep
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+type ItemKey struct {
+	FileRangeID     model.FileRangeID `json:"fileRangeId"`
+	FileID          model.FileID      `json:"fileId"          table:"verbose"`
+	Path            string            `json:"path"`
+	Offset          int64             `json:"offset"`
+	Length          int64             `json:"length"`
+	CID             string            `json:"cid"` // CID of the encryption of the range
+	EncryptedLength int64             `json:"encryptedLength" table:"verbose"`
+	WrappedKey      string            `json:"wrappedKey"` // Base64 encoded data key of the range, wrapped by the KMS key of the preparation
+}
+
+type ItemKeyEscrow struct {
+	PreparationID model.PreparationID `json:"preparationId"`
+	Preparation   string              `json:"preparation"`
+	KMSKey        string              `json:"kmsKey"` // URI of the KMS key the data keys are wrapped by
+	Keys          []ItemKey           `json:"keys"   table:"expand"`
+}
+
+type itemKeyRow struct {
+	ID              model.FileRangeID
+	FileID          model.FileID
+	Path            string
+	Offset          int64
+	Length          int64
+	CID             model.CID `gorm:"column:cid"`
+	EncryptedLength int64
+	WrappedKey      []byte
+}
+
+// ExportItemKeysHandler exports the wrapped data keys of all file ranges of a preparation that have been encrypted
+// with its KMS key, so the ranges can be decrypted after they are retrieved, i.e. with 'singularity decrypt-item'.
+// The data keys can only be unwrapped by the KMS, by whoever is allowed to decrypt with the KMS key, so the export
+// does not need to be kept secret.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name for the desired Preparation record.
+//
+// Returns:
+//   - An ItemKeyEscrow with the wrapped data key of each encrypted file range, in the order of the files.
+//   - An error, if the preparation does not exist or does not encrypt its files with a KMS key, or if any other error
+//     occurred.
+func (DefaultHandler) ExportItemKeysHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+) (*ItemKeyEscrow, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if preparation.EncryptionKMSKey == "" {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "preparation '%s' does not encrypt its files with a KMS key", id)
+	}
+
+	var rows []itemKeyRow
+	err = db.Table("file_ranges").
+		Select("file_ranges.id, file_ranges.file_id, files.path, file_ranges.offset, file_ranges.length, "+
+			"file_ranges.cid, file_ranges.encrypted_length, file_ranges.wrapped_key").
+		Joins("JOIN files ON files.id = file_ranges.file_id").
+		Joins("JOIN source_attachments ON source_attachments.id = files.attachment_id").
+		Where("source_attachments.preparation_id = ? AND file_ranges.wrapped_key IS NOT NULL", preparation.ID).
+		Order("file_ranges.file_id asc, file_ranges.offset asc").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	escrow := &ItemKeyEscrow{
+		PreparationID: preparation.ID,
+		Preparation:   preparation.Name,
+		KMSKey:        preparation.EncryptionKMSKey,
+		Keys:          make([]ItemKey, 0, len(rows)),
+	}
+	for _, row := range rows {
+		if len(row.WrappedKey) == 0 {
+			continue
+		}
+		escrow.Keys = append(escrow.Keys, ItemKey{
+			FileRangeID:     row.ID,
+			FileID:          row.FileID,
+			Path:            row.Path,
+			Offset:          row.Offset,
+			Length:          row.Length,
+			CID:             row.CID.String(),
+			EncryptedLength: row.EncryptedLength,
+			WrappedKey:      base64.StdEncoding.EncodeToString(row.WrappedKey),
+		})
+	}
+	return escrow, nil
+}
+
+// @ID ExportItemKeys
+// @Summary Export the wrapped data keys of the files of a preparation encrypted with a KMS key
+// @Tags Preparation
+// @Accept json
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Success 200 {object} ItemKeyEscrow
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/item-keys [get]
+func _() {}
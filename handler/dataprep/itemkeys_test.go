@@ -0,0 +1,67 @@
+package dataprep
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestExportItemKeysHandler(t *testing.T) {
+	t.Run("Preparation not found", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			_, err := Default.ExportItemKeysHandler(ctx, db, "name")
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+		})
+	})
+
+	t.Run("Preparation without KMS key", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			require.NoError(t, db.Create(&model.Preparation{Name: "prep"}).Error)
+			_, err := Default.ExportItemKeysHandler(ctx, db, "prep")
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		})
+	})
+
+	t.Run("success", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			attachment := model.SourceAttachment{
+				Preparation: &model.Preparation{Name: "prep", EncryptionKMSKey: "vault://transit/key", EncryptionKeyVersion: 1},
+				Storage:     &model.Storage{Name: "source", Type: "local"},
+			}
+			require.NoError(t, db.Create(&attachment).Error)
+			file := model.File{
+				Path:         "a.txt",
+				Size:         20,
+				AttachmentID: attachment.ID,
+				FileRanges: []model.FileRange{
+					{Offset: 0, Length: 10, CID: model.CID(testutil.TestCid), EncryptedLength: 26, WrappedKey: []byte("key1")},
+					{Offset: 10, Length: 10, CID: model.CID(testutil.TestCid), EncryptedLength: 26, WrappedKey: []byte("key2")},
+				},
+			}
+			require.NoError(t, db.Create(&file).Error)
+			// Ranges that are not packed yet have no wrapped key
+			require.NoError(t, db.Create(&model.File{
+				Path:         "b.txt",
+				Size:         10,
+				AttachmentID: attachment.ID,
+				FileRanges:   []model.FileRange{{Offset: 0, Length: 10}},
+			}).Error)
+
+			escrow, err := Default.ExportItemKeysHandler(ctx, db, "prep")
+			require.NoError(t, err)
+			require.Equal(t, "vault://transit/key", escrow.KMSKey)
+			require.Len(t, escrow.Keys, 2)
+			require.Equal(t, "a.txt", escrow.Keys[0].Path)
+			require.EqualValues(t, 10, escrow.Keys[1].Offset)
+			require.EqualValues(t, 26, escrow.Keys[1].EncryptedLength)
+			require.Equal(t, testutil.TestCid.String(), escrow.Keys[0].CID)
+			require.Equal(t, base64.StdEncoding.EncodeToString([]byte("key2")), escrow.Keys[1].WrappedKey)
+		})
+	})
+}
@@ -0,0 +1,104 @@
+package dataprep
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+// PausePreparationHandler pauses the scanning and packing of a preparation, without affecting the other preparations.
+//
+// Once paused, the workers no longer pick up the scan, pack and daggen jobs of the preparation. The pack and daggen
+// jobs that are already being processed are finished, so no CAR file is left half written, while the running scans
+// are stopped and reset to ready, so they start over once the preparation is resumed. The state of the jobs is
+// otherwise kept, so resuming the preparation carries on where it left off.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: The gorm.DB instance for making database queries.
+//   - name: The ID or name of the preparation to pause.
+//
+// Returns:
+//   - A pointer to the paused model.Preparation.
+//   - An error if the preparation does not exist, is already paused, or if there are database-related errors.
+func (DefaultHandler) PausePreparationHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	name string,
+) (*model.Preparation, error) {
+	return setPreparationPaused(ctx, db, name, true)
+}
+
+// @ID PausePreparation
+// @Summary Pause the scanning and packing of a preparation
+// @Tags Preparation
+// @Param name path string true "Preparation ID or name"
+// @Produce json
+// @Success 200 {object} model.Preparation
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{name}/pause [post]
+func _() {}
+
+// ResumePreparationHandler resumes the scanning and packing of a paused preparation, so the workers pick up its jobs
+// again.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: The gorm.DB instance for making database queries.
+//   - name: The ID or name of the preparation to resume.
+//
+// Returns:
+//   - A pointer to the resumed model.Preparation.
+//   - An error if the preparation does not exist, is not paused, or if there are database-related errors.
+func (DefaultHandler) ResumePreparationHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	name string,
+) (*model.Preparation, error) {
+	return setPreparationPaused(ctx, db, name, false)
+}
+
+// @ID ResumePreparation
+// @Summary Resume the scanning and packing of a paused preparation
+// @Tags Preparation
+// @Param name path string true "Preparation ID or name"
+// @Produce json
+// @Success 200 {object} model.Preparation
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{name}/resume [post]
+func _() {}
+
+func setPreparationPaused(ctx context.Context, db *gorm.DB, name string, paused bool) (*model.Preparation, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation %s does not exist", name)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if preparation.Paused == paused {
+		if paused {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "preparation %s is already paused", name)
+		}
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "preparation %s is not paused", name)
+	}
+
+	preparation.Paused = paused
+	err = database.DoRetry(ctx, func() error {
+		return db.Model(&model.Preparation{}).Where("id = ?", preparation.ID).Update("paused", paused).Error
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &preparation, nil
+}
@@ -0,0 +1,44 @@
+package dataprep
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestPausePreparationHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.PausePreparationHandler(ctx, db, "name")
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		err = db.Create([]model.Preparation{{Name: "name"}, {Name: "other"}}).Error
+		require.NoError(t, err)
+
+		_, err = Default.ResumePreparationHandler(ctx, db, "name")
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		preparation, err := Default.PausePreparationHandler(ctx, db, "name")
+		require.NoError(t, err)
+		require.True(t, preparation.Paused)
+		_, err = Default.PausePreparationHandler(ctx, db, "1")
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		var preparations []model.Preparation
+		err = db.Order("id").Find(&preparations).Error
+		require.NoError(t, err)
+		require.True(t, preparations[0].Paused)
+		require.False(t, preparations[1].Paused)
+
+		preparation, err = Default.ResumePreparationHandler(ctx, db, "name")
+		require.NoError(t, err)
+		require.False(t, preparation.Paused)
+		err = db.First(&preparations[0], 1).Error
+		require.NoError(t, err)
+		require.False(t, preparations[0].Paused)
+	})
+}
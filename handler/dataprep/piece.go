@@ -11,6 +11,7 @@ import (
 	"github.com/data-preservation-programs/singularity/handler/handlererror"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/util"
 	"github.com/gotidy/ptr"
 	"github.com/ipfs/go-cid"
 	"github.com/ipld/go-car"
@@ -172,6 +173,9 @@ func (DefaultHandler) AddPieceHandler(
 	if (pieceSize & (pieceSize - 1)) != 0 {
 		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "piece size must be a power of 2")
 	}
+	if pieceSize < util.MinPieceSize || pieceSize > util.MaxPieceSize {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "piece size %d must be between 128 B and 64 GiB", pieceSize)
+	}
 	rootCID := packutil.EmptyFileCid
 	fileSize := request.FileSize
 	if request.RootCID != "" {
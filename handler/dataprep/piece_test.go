@@ -105,6 +105,16 @@ func TestAddPieceHandler(t *testing.T) {
 			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
 			require.ErrorContains(t, err, "piece size must be a power of 2")
 		})
+		t.Run("pieceSize too large", func(t *testing.T) {
+			_, err := Default.AddPieceHandler(ctx, db, "1", AddPieceRequest{
+				PieceCID:  "baga6ea4seaqchxeb6cwpiephnus27kplk7lku225rdhrsgb3ej4smaqwgop6wkq",
+				PieceSize: "137438953472",
+				FilePath:  "",
+				RootCID:   "",
+			})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+			require.ErrorContains(t, err, "must be between 128 B and 64 GiB")
+		})
 		t.Run("invalid root cid", func(t *testing.T) {
 			_, err := Default.AddPieceHandler(ctx, db, "1", AddPieceRequest{
 				PieceCID:  "baga6ea4seaqchxeb6cwpiephnus27kplk7lku225rdhrsgb3ej4smaqwgop6wkq",
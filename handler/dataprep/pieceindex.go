@@ -0,0 +1,174 @@
+package dataprep
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-log/v2"
+	"github.com/ipld/go-car/v2/index"
+	"gorm.io/gorm"
+)
+
+var logger = log.Logger("singularity/handler/dataprep")
+
+const (
+	PieceIndexFormatCarV2 = "carv2"
+	PieceIndexFormatBoost = "boost"
+)
+
+type ExportPieceIndexRequest struct {
+	OutputDir string   `json:"outputDir"` // Directory to write the index files to. It is created if it does not exist.
+	Format    string   `json:"format"`    // Format of the index files, either carv2 (<piece_cid>.idx) or boost (<piece_cid>.full.idx, the layout of the boost dagstore index directory)
+	PieceCIDs []string `json:"pieceCids"` // Only export the index of these pieces. All pieces of the preparation are exported if empty.
+}
+
+type PieceIndex struct {
+	PieceCID  string `json:"pieceCid"`
+	Path      string `json:"path"`
+	NumBlocks int    `json:"numBlocks"`
+}
+
+// pieceIndexFileName returns the name of the index file of a piece for the given format.
+func pieceIndexFileName(pieceCID cid.Cid, format string) string {
+	if format == PieceIndexFormatBoost {
+		return pieceCID.String() + ".full.idx"
+	}
+	return pieceCID.String() + ".idx"
+}
+
+// writePieceIndex writes the CARv2 multihash sorted index of a piece, built from its CarBlocks.
+// The offsets in the CarBlocks are the offsets of the sections in the CARv1 payload, which is what the index expects.
+func writePieceIndex(path string, carBlocks []model.CarBlock) error {
+	records := make([]index.Record, 0, len(carBlocks))
+	for _, carBlock := range carBlocks {
+		records = append(records, index.Record{
+			Cid:    cid.Cid(carBlock.CID),
+			Offset: uint64(carBlock.CarOffset),
+		})
+	}
+	idx := index.NewMultihashSorted()
+	err := idx.Load(records)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = index.WriteTo(idx, file)
+	if err != nil {
+		file.Close()
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(file.Close())
+}
+
+// ExportPieceIndexHandler writes an index file for each piece of a preparation, built from the block
+// records in the database. The index files use the standard CARv2 multihash sorted index format,
+// so storage providers can index CAR files delivered offline without scanning them.
+// Pieces without block records, i.e. those prepared with inline preparation disabled or added manually, are skipped.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name for the desired Preparation record.
+//   - request: The ExportPieceIndexRequest that specifies the output directory, the format and the pieces.
+//
+// Returns:
+//   - A slice of PieceIndex describing the index files that have been written.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) ExportPieceIndexHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+	request ExportPieceIndexRequest,
+) ([]PieceIndex, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if request.OutputDir == "" {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "output directory is required")
+	}
+	if request.Format == "" {
+		request.Format = PieceIndexFormatCarV2
+	}
+	if request.Format != PieceIndexFormatCarV2 && request.Format != PieceIndexFormatBoost {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid index format %s, must be %s or %s",
+			request.Format, PieceIndexFormatCarV2, PieceIndexFormatBoost)
+	}
+
+	query := db.Where("preparation_id = ?", preparation.ID)
+	if len(request.PieceCIDs) > 0 {
+		pieceCIDs := make([]model.CID, 0, len(request.PieceCIDs))
+		for _, s := range request.PieceCIDs {
+			pieceCID, err := cid.Parse(s)
+			if err != nil {
+				return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrapf(err, "invalid piece CID %s", s))
+			}
+			pieceCIDs = append(pieceCIDs, model.CID(pieceCID))
+		}
+		query = query.Where("piece_cid IN ?", pieceCIDs)
+	}
+	var cars []model.Car
+	err = query.Order("id asc").Find(&cars).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = os.MkdirAll(request.OutputDir, 0755)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create output directory %s", request.OutputDir)
+	}
+
+	indexes := make([]PieceIndex, 0, len(cars))
+	for _, car := range cars {
+		// Raw blocks are not needed for the index
+		var carBlocks []model.CarBlock
+		err = db.Select("cid", "car_offset").Where("car_id = ?", car.ID).Order("id asc").Find(&carBlocks).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if len(carBlocks) == 0 {
+			logger.Warnw("piece has no block records, skipping", "piece", car.PieceCID.String())
+			continue
+		}
+		path := filepath.Join(request.OutputDir, pieceIndexFileName(cid.Cid(car.PieceCID), request.Format))
+		err = writePieceIndex(path, carBlocks)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to write index of piece %s", car.PieceCID.String())
+		}
+		indexes = append(indexes, PieceIndex{
+			PieceCID:  car.PieceCID.String(),
+			Path:      path,
+			NumBlocks: len(carBlocks),
+		})
+	}
+
+	return indexes, nil
+}
+
+// @ID ExportPieceIndex
+// @Summary Export the CARv2 index of the pieces of a preparation
+// @Tags Piece
+// @Accept json
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Param request body ExportPieceIndexRequest true "Export Piece Index Request"
+// @Success 200 {array} PieceIndex
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/piece/index [post]
+func _() {}
@@ -0,0 +1,83 @@
+package dataprep
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-car/v2/index"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestExportPieceIndexHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		pieceCID := cid.MustParse("baga6ea4seaqchxeb6cwpiephnus27kplk7lku225rdhrsgb3ej4smaqwgop6wkq")
+		err := db.Create(&model.Preparation{
+			Name: "name",
+		}).Error
+		require.NoError(t, err)
+		err = db.Create([]model.Car{{
+			PieceCID:      model.CID(pieceCID),
+			PreparationID: 1,
+		}, {
+			PieceCID:      model.CID(testutil.TestCid),
+			PreparationID: 1,
+		}}).Error
+		require.NoError(t, err)
+		blockCIDs := []cid.Cid{
+			cid.NewCidV1(cid.Raw, util.Hash([]byte("block1"))),
+			cid.NewCidV1(cid.Raw, util.Hash([]byte("block2"))),
+		}
+		err = db.Create([]model.CarBlock{{
+			CarID:     1,
+			CID:       model.CID(blockCIDs[0]),
+			CarOffset: 59,
+		}, {
+			CarID:     1,
+			CID:       model.CID(blockCIDs[1]),
+			CarOffset: 100,
+		}}).Error
+		require.NoError(t, err)
+
+		t.Run("not found", func(t *testing.T) {
+			_, err := Default.ExportPieceIndexHandler(ctx, db, "2", ExportPieceIndexRequest{OutputDir: t.TempDir()})
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+		})
+		t.Run("invalid format", func(t *testing.T) {
+			_, err := Default.ExportPieceIndexHandler(ctx, db, "name", ExportPieceIndexRequest{OutputDir: t.TempDir(), Format: "unknown"})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		})
+		for _, format := range []string{PieceIndexFormatCarV2, PieceIndexFormatBoost} {
+			t.Run(format, func(t *testing.T) {
+				tmp := t.TempDir()
+				indexes, err := Default.ExportPieceIndexHandler(ctx, db, "name", ExportPieceIndexRequest{OutputDir: tmp, Format: format})
+				require.NoError(t, err)
+				// The second piece has no block records
+				require.Len(t, indexes, 1)
+				require.Equal(t, pieceCID.String(), indexes[0].PieceCID)
+				require.Equal(t, 2, indexes[0].NumBlocks)
+				require.Equal(t, filepath.Join(tmp, pieceIndexFileName(pieceCID, format)), indexes[0].Path)
+
+				file, err := os.Open(indexes[0].Path)
+				require.NoError(t, err)
+				defer file.Close()
+				idx, err := index.ReadFrom(file)
+				require.NoError(t, err)
+				var offsets []uint64
+				err = idx.GetAll(blockCIDs[1], func(offset uint64) bool {
+					offsets = append(offsets, offset)
+					return true
+				})
+				require.NoError(t, err)
+				require.Equal(t, []uint64{100}, offsets)
+			})
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package dataprep
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+type PieceKey struct {
+	CarID       model.CarID `json:"carId"`
+	PieceCID    string      `json:"pieceCid"`
+	PieceSize   int64       `json:"pieceSize"`
+	RootCID     string      `json:"rootCid"     table:"verbose"`
+	FileSize    int64       `json:"fileSize"    table:"verbose"`
+	StoragePath string      `json:"storagePath" table:"verbose"`
+	WrappedKey  string      `json:"wrappedKey"` // Base64 encoded piece key, wrapped for the piece key recipient of the preparation
+}
+
+type PieceKeyEscrow struct {
+	PreparationID model.PreparationID `json:"preparationId"`
+	Preparation   string              `json:"preparation"`
+	Recipient     string              `json:"recipient"` // Public key the piece keys are wrapped for
+	Keys          []PieceKey          `json:"keys"          table:"expand"`
+}
+
+// ExportPieceKeysHandler exports the wrapped keys of all encrypted pieces of a preparation, so they can be escrowed by
+// the data owner. The keys can only be unwrapped with the identity matching the recipient of the preparation, so the
+// export does not need to be kept secret.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name for the desired Preparation record.
+//
+// Returns:
+//   - A PieceKeyEscrow with the wrapped key of each encrypted piece.
+//   - An error, if the preparation does not exist or does not encrypt its pieces, or if any other error occurred.
+func (DefaultHandler) ExportPieceKeysHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+) (*PieceKeyEscrow, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if preparation.PieceKeyRecipient == "" {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "preparation '%s' does not encrypt its pieces", id)
+	}
+
+	var cars []model.Car
+	err = db.Where("preparation_id = ? AND wrapped_key IS NOT NULL", preparation.ID).Order("id asc").Find(&cars).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	escrow := &PieceKeyEscrow{
+		PreparationID: preparation.ID,
+		Preparation:   preparation.Name,
+		Recipient:     preparation.PieceKeyRecipient,
+		Keys:          make([]PieceKey, 0, len(cars)),
+	}
+	for _, car := range cars {
+		if len(car.WrappedKey) == 0 {
+			continue
+		}
+		escrow.Keys = append(escrow.Keys, PieceKey{
+			CarID:       car.ID,
+			PieceCID:    car.PieceCID.String(),
+			PieceSize:   car.PieceSize,
+			RootCID:     car.RootCID.String(),
+			FileSize:    car.FileSize,
+			StoragePath: car.StoragePath,
+			WrappedKey:  base64.StdEncoding.EncodeToString(car.WrappedKey),
+		})
+	}
+	return escrow, nil
+}
+
+// @ID ExportPieceKeys
+// @Summary Export the wrapped piece keys of a preparation for key escrow
+// @Tags Piece
+// @Accept json
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Success 200 {object} PieceKeyEscrow
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/piece-keys [get]
+func _() {}
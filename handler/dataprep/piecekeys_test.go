@@ -0,0 +1,62 @@
+package dataprep
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestExportPieceKeysHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		identity, recipient, err := encryption.GenerateIdentity()
+		require.NoError(t, err)
+		key, wrapped, err := encryption.NewPieceKey(recipient)
+		require.NoError(t, err)
+		err = db.Create([]model.Preparation{{
+			Name:              "encrypted",
+			PieceKeyRecipient: recipient,
+		}, {
+			Name: "plain",
+		}}).Error
+		require.NoError(t, err)
+		err = db.Create([]model.Car{{
+			PieceCID:      model.CID(testutil.TestCid),
+			PreparationID: 1,
+			StoragePath:   "piece.car",
+			WrappedKey:    wrapped,
+		}, {
+			PieceCID:      model.CID(testutil.TestCid),
+			PreparationID: 1,
+		}}).Error
+		require.NoError(t, err)
+
+		t.Run("not found", func(t *testing.T) {
+			_, err := Default.ExportPieceKeysHandler(ctx, db, "3")
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+		})
+		t.Run("not encrypted", func(t *testing.T) {
+			_, err := Default.ExportPieceKeysHandler(ctx, db, "plain")
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		})
+		t.Run("success", func(t *testing.T) {
+			escrow, err := Default.ExportPieceKeysHandler(ctx, db, "encrypted")
+			require.NoError(t, err)
+			require.Equal(t, recipient, escrow.Recipient)
+			require.Len(t, escrow.Keys, 1)
+			require.Equal(t, model.CarID(1), escrow.Keys[0].CarID)
+			require.Equal(t, "piece.car", escrow.Keys[0].StoragePath)
+			decoded, err := base64.StdEncoding.DecodeString(escrow.Keys[0].WrappedKey)
+			require.NoError(t, err)
+			unwrapped, err := encryption.UnwrapKey(decoded, identity)
+			require.NoError(t, err)
+			require.Equal(t, key, unwrapped)
+		})
+	})
+}
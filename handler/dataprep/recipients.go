@@ -0,0 +1,89 @@
+package dataprep
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
+	"gorm.io/gorm"
+)
+
+type RotateRecipientsRequest struct {
+	Recipients []string `binding:"required" json:"recipients"` // New age X25519 public keys, i.e. age1..., that the files are encrypted for
+}
+
+// RotateRecipientsHandler replaces the age recipients that the files of a preparation are encrypted for, and
+// increments the encryption key version of the preparation. The files packed from then on are encrypted for the new
+// recipients only, while the pieces packed before keep the version of the recipients they were encrypted for, so they
+// can be found and packed again if an old key is compromised.
+//
+// Only preparations that already encrypt their files can be rotated, as a file whose ranges were packed before
+// encryption was enabled cannot be assembled from both plain and encrypted ranges.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: The gorm.DB instance for making database queries.
+//   - id: The ID or name of the preparation.
+//   - request: The RotateRecipientsRequest with the new recipients.
+//
+// Returns:
+//   - A pointer to the updated model.Preparation.
+//   - An error if the preparation does not exist or does not encrypt its files, if any of the recipients is invalid,
+//     or if the database update fails.
+func (DefaultHandler) RotateRecipientsHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+	request RotateRecipientsRequest,
+) (*model.Preparation, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation %s does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if preparation.EncryptionKMSKey != "" {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "preparation %s encrypts its files with a KMS key, which is rotated by the KMS", preparation.Name)
+	}
+	if len(preparation.EncryptionRecipients) == 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "preparation %s does not encrypt its files", preparation.Name)
+	}
+
+	_, err = encryption.ParseRecipients(request.Recipients)
+	if err != nil {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, err.Error())
+	}
+
+	preparation.EncryptionRecipients = request.Recipients
+	preparation.EncryptionKeyVersion++
+	err = database.DoRetry(ctx, func() error {
+		return db.Model(&model.Preparation{}).Where("id = ?", preparation.ID).Updates(map[string]any{
+			"encryption_recipients":  preparation.EncryptionRecipients,
+			"encryption_key_version": gorm.Expr("encryption_key_version + 1"),
+		}).Error
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &preparation, nil
+}
+
+// @ID RotateRecipients
+// @Summary Rotate the age recipients that the files of a preparation are encrypted for
+// @Tags Preparation
+// @Param id path string true "Preparation ID or name"
+// @Param request body RotateRecipientsRequest true "New recipients"
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.Preparation
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/recipients [put]
+func _() {}
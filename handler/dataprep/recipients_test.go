@@ -0,0 +1,65 @@
+package dataprep
+
+import (
+	"context"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestRotateRecipientsHandler(t *testing.T) {
+	newRecipient := func(t *testing.T) string {
+		identity, err := age.GenerateX25519Identity()
+		require.NoError(t, err)
+		return identity.Recipient().String()
+	}
+
+	t.Run("Preparation not found", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			_, err := Default.RotateRecipientsHandler(ctx, db, "name", RotateRecipientsRequest{Recipients: []string{newRecipient(t)}})
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+		})
+	})
+
+	t.Run("files are not encrypted", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			require.NoError(t, db.Create(&model.Preparation{Name: "plain"}).Error)
+			_, err := Default.RotateRecipientsHandler(ctx, db, "plain", RotateRecipientsRequest{Recipients: []string{newRecipient(t)}})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+			require.ErrorContains(t, err, "does not encrypt its files")
+		})
+	})
+
+	t.Run("success", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			old := newRecipient(t)
+			require.NoError(t, db.Create(&model.Preparation{
+				Name:                 "encrypted",
+				NoInline:             true,
+				EncryptionRecipients: model.StringSlice{old},
+				EncryptionKeyVersion: 1,
+			}).Error)
+
+			_, err := Default.RotateRecipientsHandler(ctx, db, "encrypted", RotateRecipientsRequest{})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+			_, err = Default.RotateRecipientsHandler(ctx, db, "encrypted", RotateRecipientsRequest{Recipients: []string{"age1invalid"}})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+			recipients := []string{newRecipient(t), newRecipient(t)}
+			preparation, err := Default.RotateRecipientsHandler(ctx, db, "encrypted", RotateRecipientsRequest{Recipients: recipients})
+			require.NoError(t, err)
+			require.Equal(t, model.StringSlice(recipients), preparation.EncryptionRecipients)
+			require.Equal(t, 2, preparation.EncryptionKeyVersion)
+
+			var stored model.Preparation
+			require.NoError(t, db.First(&stored, preparation.ID).Error)
+			require.Equal(t, model.StringSlice(recipients), stored.EncryptionRecipients)
+			require.Equal(t, 2, stored.EncryptionKeyVersion)
+		})
+	})
+}
@@ -14,10 +14,31 @@ import (
 	"gorm.io/gorm"
 )
 
+// purgeBatchSize is the number of rows deleted per transaction when a preparation is purged
+var purgeBatchSize = 1000
+
 type RemoveRequest struct {
 	RemoveCars bool `json:"removeCars"`
+	Purge      bool `json:"purge"` // Delete the CAR blocks, CARs, files, directories, jobs and schedules of the preparation in batches before the preparation itself
 }
 
+// RemovePreparationHandler removes a preparation and everything that belongs to it, except the deals.
+//
+// By default, the preparation is deleted in a single transaction and the database cascades the deletion to its
+// sources, jobs, files, directories, CARs, CAR blocks and schedules. For a large preparation, this holds the locks of
+// millions of rows for a long time. With Purge, these rows are first deleted in batches of separate transactions,
+// starting from the CAR blocks, so the deletion can be resumed by running it again if it is interrupted. The CAR files
+// of each batch of CARs are removed before their rows, so a CAR file is never left behind without its row. If a CAR
+// file cannot be removed, the purge stops and the remaining rows are kept for the next run.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - name: The ID or name of the preparation to remove.
+//   - request: The RemoveRequest with whether to remove the CAR files and whether to purge in batches.
+//
+// Returns:
+//   - An error, if any occurred during the operation. The CAR files that failed to be removed are aggregated.
 func (DefaultHandler) RemovePreparationHandler(ctx context.Context, db *gorm.DB, name string, request RemoveRequest) error {
 	db = db.WithContext(ctx)
 
@@ -44,14 +65,22 @@ func (DefaultHandler) RemovePreparationHandler(ctx context.Context, db *gorm.DB,
 		return errors.Wrapf(handlererror.ErrInvalidParameter, "preparation %s has %d active jobs", name, activeCount)
 	}
 
+	storageHandlers := make(map[model.StorageID]storagesystem.Handler)
 	var cars []model.Car
-	if request.RemoveCars {
+	if request.RemoveCars && !request.Purge {
 		err = db.Preload("Storage").Where("preparation_id = ?", preparation.ID).Find(&cars).Error
 		if err != nil {
 			return errors.WithStack(err)
 		}
 	}
 
+	if request.Purge {
+		err = purgePreparation(ctx, db, preparation.ID, attachmentIDs, request.RemoveCars, storageHandlers)
+		if err != nil {
+			return errors.Wrapf(err, "failed to purge preparation %s", name)
+		}
+	}
+
 	err = database.DoRetry(ctx, func() error {
 		return db.Transaction(func(db *gorm.DB) error {
 			return db.Delete(&preparation).Error
@@ -62,10 +91,20 @@ func (DefaultHandler) RemovePreparationHandler(ctx context.Context, db *gorm.DB,
 		return errors.WithStack(err)
 	}
 
-	storageHandlers := make(map[model.StorageID]storagesystem.Handler)
+	errs := removeCarFiles(ctx, storageHandlers, cars)
+	if len(errs) > 0 {
+		return util.AggregateError{Errors: errs}
+	}
+
+	return nil
+}
+
+// removeCarFiles removes the CAR files of the cars from their output storages. A CAR file that no longer exists, i.e.
+// because it was removed by an interrupted run, is skipped. The storage handlers are cached by storage ID.
+func removeCarFiles(ctx context.Context, storageHandlers map[model.StorageID]storagesystem.Handler, cars []model.Car) []error {
 	var errs []error
 	for _, car := range cars {
-		if car.StorageID == nil {
+		if car.StorageID == nil || car.Storage == nil {
 			continue
 		}
 		handler, ok := storageHandlers[*car.StorageID]
@@ -79,13 +118,16 @@ func (DefaultHandler) RemovePreparationHandler(ctx context.Context, db *gorm.DB,
 			storageHandlers[*car.StorageID] = handler
 		}
 		entry, err := handler.Check(ctx, car.StoragePath)
+		if errors.Is(err, fs.ErrorObjectNotFound) {
+			continue
+		}
 		if err != nil {
 			errs = append(errs, errors.Wrapf(err, "Unable to check file %s", car.StoragePath))
 			continue
 		}
 		obj, ok := entry.(fs.Object)
 		if !ok {
-			errs = append(errs, errors.Wrapf(err, "%s is not an object", car.StoragePath))
+			errs = append(errs, errors.Newf("%s is not an object", car.StoragePath))
 			continue
 		}
 		err = handler.Remove(ctx, obj)
@@ -93,11 +135,85 @@ func (DefaultHandler) RemovePreparationHandler(ctx context.Context, db *gorm.DB,
 			errs = append(errs, errors.Wrapf(err, "Failed to delete %s", car.StoragePath))
 		}
 	}
+	return errs
+}
 
-	if len(errs) > 0 {
-		return util.AggregateError{Errors: errs}
+// purgePreparation deletes the rows that belong to the preparation in batches, children before their parents, so no
+// transaction holds more than purgeBatchSize rows and no row is left pointing to a deleted one. The deals of the
+// schedules are kept and no longer point to a schedule. If removeCars is set, the CAR files of each batch of CARs are
+// removed before their rows.
+func purgePreparation(
+	ctx context.Context,
+	db *gorm.DB,
+	preparationID model.PreparationID,
+	attachmentIDs []model.SourceAttachmentID,
+	removeCars bool,
+	storageHandlers map[model.StorageID]storagesystem.Handler,
+) error {
+	cars := db.Model(&model.Car{}).Select("id").Where("preparation_id = ?", preparationID)
+	files := db.Model(&model.File{}).Select("id").Where("attachment_id IN ?", attachmentIDs)
+	var removeCarFilesOf func(ids []uint64) error
+	if removeCars {
+		removeCarFilesOf = func(ids []uint64) error {
+			var batch []model.Car
+			err := db.Preload("Storage").Where("id IN ?", ids).Find(&batch).Error
+			if err != nil {
+				return errors.Wrap(err, "failed to find cars")
+			}
+			errs := removeCarFiles(ctx, storageHandlers, batch)
+			if len(errs) > 0 {
+				return util.AggregateError{Errors: errs}
+			}
+			return nil
+		}
+	}
+	steps := []struct {
+		name   string
+		value  any
+		query  string
+		arg    any
+		before func(ids []uint64) error
+	}{
+		{"car blocks", &model.CarBlock{}, "car_id IN (?)", cars, nil},
+		{"cars", &model.Car{}, "preparation_id = ?", preparationID, removeCarFilesOf},
+		{"file ranges", &model.FileRange{}, "file_id IN (?)", files, nil},
+		{"files", &model.File{}, "attachment_id IN ?", attachmentIDs, nil},
+		// Directories are deleted from the highest ID, so subdirectories go before their parents
+		{"directories", &model.Directory{}, "attachment_id IN ?", attachmentIDs, nil},
+		{"jobs", &model.Job{}, "attachment_id IN ?", attachmentIDs, nil},
+		{"schedules", &model.Schedule{}, "preparation_id = ?", preparationID, nil},
+	}
+	for _, step := range steps {
+		var deleted int64
+		for {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			var ids []uint64
+			err := db.Model(step.value).Where(step.query, step.arg).
+				Order("id DESC").Limit(purgeBatchSize).Pluck("id", &ids).Error
+			if err != nil {
+				return errors.Wrapf(err, "failed to find %s", step.name)
+			}
+			if len(ids) == 0 {
+				break
+			}
+			if step.before != nil {
+				err = step.before(ids)
+				if err != nil {
+					return errors.Wrapf(err, "failed to remove files of %s", step.name)
+				}
+			}
+			err = database.DoRetry(ctx, func() error {
+				return db.Where("id IN ?", ids).Delete(step.value).Error
+			})
+			if err != nil {
+				return errors.Wrapf(err, "failed to delete %s", step.name)
+			}
+			deleted += int64(len(ids))
+		}
+		logger.Infow("purged "+step.name, "preparation", preparationID, "count", deleted)
 	}
-
 	return nil
 }
 
@@ -110,3 +110,140 @@ func TestRemovePreparationHandler_Success(t *testing.T) {
 		require.Len(t, entries, 0)
 	})
 }
+
+func TestRemovePreparationHandler_Purge(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		preparations := []model.Preparation{
+			{Name: "purged", SourceStorages: []model.Storage{{Name: "source1"}}},
+			{Name: "kept", SourceStorages: []model.Storage{{Name: "source2"}}},
+		}
+		err := db.Create(&preparations).Error
+		require.NoError(t, err)
+		for _, attachmentID := range []model.SourceAttachmentID{1, 2} {
+			root := model.Directory{AttachmentID: attachmentID}
+			err = db.Create(&root).Error
+			require.NoError(t, err)
+			sub := model.Directory{AttachmentID: attachmentID, ParentID: ptr.Of(root.ID)}
+			err = db.Create(&sub).Error
+			require.NoError(t, err)
+			job := model.Job{AttachmentID: attachmentID, State: model.Complete}
+			err = db.Create(&job).Error
+			require.NoError(t, err)
+			car := model.Car{
+				PreparationID: model.PreparationID(attachmentID),
+				AttachmentID:  ptr.Of(attachmentID),
+				JobID:         ptr.Of(job.ID),
+			}
+			err = db.Create(&car).Error
+			require.NoError(t, err)
+			for i := 0; i < 3; i++ {
+				file := model.File{
+					AttachmentID: attachmentID,
+					DirectoryID:  ptr.Of(sub.ID),
+					FileRanges:   []model.FileRange{{JobID: ptr.Of(job.ID)}, {JobID: ptr.Of(job.ID)}},
+				}
+				err = db.Create(&file).Error
+				require.NoError(t, err)
+				err = db.Create(&model.CarBlock{CarID: car.ID, FileID: ptr.Of(file.ID)}).Error
+				require.NoError(t, err)
+			}
+		}
+
+		schedule := model.Schedule{PreparationID: 1}
+		err = db.Create(&schedule).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Deal{ScheduleID: ptr.Of(schedule.ID), Wallet: &model.Wallet{ID: "f01"}}).Error
+		require.NoError(t, err)
+
+		err = Default.RemovePreparationHandler(ctx, db, "purged", RemoveRequest{Purge: true})
+		require.NoError(t, err)
+
+		var counts struct {
+			Files, FileRanges, CarBlocks, Directories int64
+		}
+		require.NoError(t, db.Model(&model.File{}).Where("attachment_id = ?", 1).Count(&counts.Files).Error)
+		require.NoError(t, db.Model(&model.FileRange{}).Count(&counts.FileRanges).Error)
+		require.NoError(t, db.Model(&model.CarBlock{}).Count(&counts.CarBlocks).Error)
+		require.NoError(t, db.Model(&model.Directory{}).Count(&counts.Directories).Error)
+		require.Zero(t, counts.Files)
+		require.EqualValues(t, 6, counts.FileRanges)
+		require.EqualValues(t, 3, counts.CarBlocks)
+		require.EqualValues(t, 2, counts.Directories)
+		var remaining model.Preparation
+		err = db.First(&remaining).Error
+		require.NoError(t, err)
+		require.Equal(t, "kept", remaining.Name)
+		// The deals of the purged schedules are kept
+		var deal model.Deal
+		require.NoError(t, db.First(&deal).Error)
+		require.Nil(t, deal.ScheduleID)
+		var scheduleCount int64
+		require.NoError(t, db.Model(&model.Schedule{}).Count(&scheduleCount).Error)
+		require.Zero(t, scheduleCount)
+	})
+}
+
+func TestRemovePreparationHandler_PurgeCarsResume(t *testing.T) {
+	purgeBatchSize = 1
+	defer func() {
+		purgeBatchSize = 1000
+	}()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		tmp := t.TempDir()
+		output := model.Storage{Type: "local", Path: tmp, Name: "output"}
+		err := db.Create(&output).Error
+		require.NoError(t, err)
+		preparation := model.Preparation{
+			Name:           "purged",
+			SourceStorages: []model.Storage{{Name: "source"}},
+			OutputStorages: []model.Storage{output},
+		}
+		err = db.Create(&preparation).Error
+		require.NoError(t, err)
+		// The first CAR cannot be removed since its path is a directory, which interrupts the purge
+		require.NoError(t, os.Mkdir(filepath.Join(tmp, "1.car"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, "2.car"), []byte("2"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, "3.car"), []byte("3"), 0o644))
+		for _, name := range []string{"1.car", "2.car", "3.car"} {
+			car := model.Car{
+				StorageID:     ptr.Of(output.ID),
+				StoragePath:   name,
+				PreparationID: preparation.ID,
+				AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+			}
+			err = db.Create(&car).Error
+			require.NoError(t, err)
+			err = db.Create(&model.CarBlock{CarID: car.ID}).Error
+			require.NoError(t, err)
+		}
+
+		err = Default.RemovePreparationHandler(ctx, db, "purged", RemoveRequest{RemoveCars: true, Purge: true})
+		require.ErrorContains(t, err, "1.car")
+
+		// The CARs whose files were removed are deleted, the others are kept for the next run
+		var paths []string
+		require.NoError(t, db.Model(&model.Car{}).Pluck("storage_path", &paths).Error)
+		require.Equal(t, []string{"1.car"}, paths)
+		var blockCount int64
+		require.NoError(t, db.Model(&model.CarBlock{}).Count(&blockCount).Error)
+		require.Zero(t, blockCount)
+		require.NoError(t, db.First(&model.Preparation{}, preparation.ID).Error)
+		entries, err := os.ReadDir(tmp)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		// Once the CAR file can be removed, the purge is resumed
+		require.NoError(t, os.Remove(filepath.Join(tmp, "1.car")))
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, "1.car"), []byte("1"), 0o644))
+		err = Default.RemovePreparationHandler(ctx, db, "purged", RemoveRequest{RemoveCars: true, Purge: true})
+		require.NoError(t, err)
+		var carCount int64
+		require.NoError(t, db.Model(&model.Car{}).Count(&carCount).Error)
+		require.Zero(t, carCount)
+		err = db.First(&model.Preparation{}, preparation.ID).Error
+		require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		entries, err = os.ReadDir(tmp)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+}
@@ -0,0 +1,216 @@
+package dataprep
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/retrievaltoken"
+	"github.com/dustin/go-humanize"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+)
+
+type CreateRetrievalTokenRequest struct {
+	Name      string   `json:"name"`      // Name of the token, i.e. the third party it is handed to
+	PieceCIDs []string `json:"pieceCids"` // Pieces of the preparation the token is limited to. All pieces are allowed if empty.
+	ExpiresIn string   `json:"expiresIn"` // How long the token is valid, i.e. 720h for 30 days. The token never expires if empty.
+	MaxBytes  string   `json:"maxBytes"`  // Maximum number of bytes served with the token, i.e. 1TiB. The number of bytes is not limited if empty.
+}
+
+type IssuedRetrievalToken struct {
+	Token          string               `json:"token"` // Signed token to hand to the third party. It cannot be obtained again.
+	RetrievalToken model.RetrievalToken `json:"retrievalToken" table:"expand"`
+}
+
+// CreateRetrievalTokenHandler issues a retrieval token for the pieces of a preparation, which the data owner can hand
+// to a third party to retrieve the pieces from a content provider started with --require-retrieval-token.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name for the desired Preparation record.
+//   - request: The CreateRetrievalTokenRequest with the scope, the expiry and the max bytes of the token.
+//
+// Returns:
+//   - A pointer to the IssuedRetrievalToken with the signed token.
+//   - An error, if the preparation does not exist, the request is invalid, or if any other error occurred.
+func (DefaultHandler) CreateRetrievalTokenHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+	request CreateRetrievalTokenRequest,
+) (*IssuedRetrievalToken, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token := model.RetrievalToken{
+		Name:          request.Name,
+		PieceCIDs:     model.StringSlice{},
+		PreparationID: preparation.ID,
+	}
+	for _, pieceCIDString := range request.PieceCIDs {
+		pieceCID, err := cid.Parse(pieceCIDString)
+		if err != nil || pieceCID.Type() != cid.FilCommitmentUnsealed {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid piece CID %s", pieceCIDString)
+		}
+		var count int64
+		err = db.Model(&model.Car{}).
+			Where("piece_cid = ? AND preparation_id = ?", model.CID(pieceCID), preparation.ID).
+			Count(&count).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if count == 0 {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "piece %s is not a piece of preparation '%s'", pieceCID, id)
+		}
+		token.PieceCIDs = append(token.PieceCIDs, pieceCID.String())
+	}
+	if request.ExpiresIn != "" {
+		expiresIn, err := time.ParseDuration(request.ExpiresIn)
+		if err != nil || expiresIn <= 0 {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid expiry %s", request.ExpiresIn)
+		}
+		expiresAt := time.Now().UTC().Add(expiresIn)
+		token.ExpiresAt = &expiresAt
+	}
+	if request.MaxBytes != "" {
+		maxBytes, err := humanize.ParseBytes(request.MaxBytes)
+		if err != nil || maxBytes == 0 {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid max bytes %s", request.MaxBytes)
+		}
+		token.MaxBytes = int64(maxBytes)
+	}
+
+	signed, err := retrievaltoken.Issue(ctx, db, &token)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &IssuedRetrievalToken{Token: signed, RetrievalToken: token}, nil
+}
+
+// ListRetrievalTokensHandler lists the retrieval tokens issued for a preparation, with their usage.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name for the desired Preparation record.
+//
+// Returns:
+//   - A slice of the retrieval tokens of the preparation.
+//   - An error, if the preparation does not exist, or if any other error occurred.
+func (DefaultHandler) ListRetrievalTokensHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+) ([]model.RetrievalToken, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var tokens []model.RetrievalToken
+	err = db.Where("preparation_id = ?", preparation.ID).Order("id asc").Find(&tokens).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return tokens, nil
+}
+
+// RevokeRetrievalTokenHandler revokes a retrieval token of a preparation, so content providers stop serving it.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name for the desired Preparation record.
+//   - tokenID: The ID of the retrieval token.
+//
+// Returns:
+//   - A pointer to the revoked retrieval token.
+//   - An error, if the preparation or the token does not exist, or if any other error occurred.
+func (DefaultHandler) RevokeRetrievalTokenHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+	tokenID uint64,
+) (*model.RetrievalToken, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var token model.RetrievalToken
+	err = db.Where("id = ? AND preparation_id = ?", tokenID, preparation.ID).First(&token).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "retrieval token %d of preparation '%s' does not exist", tokenID, id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	token.Revoked = true
+	err = database.DoRetry(ctx, func() error {
+		return db.Model(&token).Update("revoked", true).Error
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &token, nil
+}
+
+// @ID CreateRetrievalToken
+// @Summary Issue a retrieval token for the pieces of a preparation
+// @Tags Piece
+// @Accept json
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Param request body CreateRetrievalTokenRequest true "Request body"
+// @Success 200 {object} IssuedRetrievalToken
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/retrieval-token [post]
+func _() {}
+
+// @ID ListRetrievalTokens
+// @Summary List the retrieval tokens of a preparation
+// @Tags Piece
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Success 200 {array} model.RetrievalToken
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/retrieval-token [get]
+func _() {}
+
+// @ID RevokeRetrievalToken
+// @Summary Revoke a retrieval token of a preparation
+// @Tags Piece
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Param token_id path int true "Retrieval token ID"
+// @Success 200 {object} model.RetrievalToken
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/retrieval-token/{token_id}/revoke [post]
+func _() {}
@@ -0,0 +1,67 @@
+package dataprep
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/retrievaltoken"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestRetrievalTokenHandlers(t *testing.T) {
+	t.Setenv(retrievaltoken.SecretEnvVar, "0123456789abcdef")
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{Name: "prep"}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Car{PieceCID: model.CID(testutil.TestCid), PreparationID: 1}).Error
+		require.NoError(t, err)
+
+		t.Run("not found", func(t *testing.T) {
+			_, err := Default.CreateRetrievalTokenHandler(ctx, db, "2", CreateRetrievalTokenRequest{})
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+			_, err = Default.ListRetrievalTokensHandler(ctx, db, "2")
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+			_, err = Default.RevokeRetrievalTokenHandler(ctx, db, "prep", 100)
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+		})
+		t.Run("invalid parameters", func(t *testing.T) {
+			for _, request := range []CreateRetrievalTokenRequest{
+				{PieceCIDs: []string{"invalid"}},
+				{PieceCIDs: []string{testutil.TestCid.String()}},
+				{ExpiresIn: "-1h"},
+				{MaxBytes: "lots"},
+			} {
+				_, err := Default.CreateRetrievalTokenHandler(ctx, db, "prep", request)
+				require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+			}
+		})
+		t.Run("create, list and revoke", func(t *testing.T) {
+			issued, err := Default.CreateRetrievalTokenHandler(ctx, db, "prep", CreateRetrievalTokenRequest{
+				Name:      "partner",
+				ExpiresIn: "720h",
+				MaxBytes:  "1GiB",
+			})
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(issued.Token, retrievaltoken.Prefix))
+			require.Equal(t, "partner", issued.RetrievalToken.Name)
+			require.NotNil(t, issued.RetrievalToken.ExpiresAt)
+			require.EqualValues(t, 1<<30, issued.RetrievalToken.MaxBytes)
+
+			tokens, err := Default.ListRetrievalTokensHandler(ctx, db, "prep")
+			require.NoError(t, err)
+			require.Len(t, tokens, 1)
+			require.False(t, tokens[0].Revoked)
+
+			revoked, err := Default.RevokeRetrievalTokenHandler(ctx, db, "prep", uint64(issued.RetrievalToken.ID))
+			require.NoError(t, err)
+			require.True(t, revoked.Revoked)
+			_, err = retrievaltoken.Verify(ctx, db, issued.Token)
+			require.ErrorIs(t, err, retrievaltoken.ErrRevoked)
+		})
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/data-preservation-programs/singularity/handler/handlererror"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/util"
+	"golang.org/x/exp/slices"
 	"gorm.io/gorm"
 )
 
@@ -91,3 +92,68 @@ func (DefaultHandler) AddSourceStorageHandler(ctx context.Context, db *gorm.DB,
 // @Failure 500 {object} api.HTTPError
 // @Router /preparation/{id}/source/{name} [post]
 func _() {}
+
+type UpdateSourceRequest struct {
+	RescanMode string `json:"rescanMode"` // How a rescan treats the files already scanned from the source, either append or incremental. Empty keeps the current mode.
+}
+
+// UpdateSourceHandler updates the settings of a source storage attached to a preparation.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name of the Preparation.
+//   - source: The ID or name of the attached source storage.
+//   - request: The UpdateSourceRequest with the settings to change.
+//
+// Returns:
+//   - A pointer to the updated SourceAttachment.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) UpdateSourceHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+	source string,
+	request UpdateSourceRequest,
+) (*model.SourceAttachment, error) {
+	db = db.WithContext(ctx)
+	var attachment model.SourceAttachment
+	err := attachment.FindByPreparationAndSource(db, id, source)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "source '%s' is not attached to preparation %s", source, id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if request.RescanMode != "" {
+		if !slices.Contains(model.RescanModeStrings, request.RescanMode) {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "rescan mode %s is not supported, must be one of %v",
+				request.RescanMode, model.RescanModeStrings)
+		}
+		attachment.RescanMode = model.RescanMode(request.RescanMode)
+	}
+
+	err = database.DoRetry(ctx, func() error {
+		return db.Model(&attachment).Select("rescan_mode").Updates(attachment).Error
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &attachment, nil
+}
+
+// @ID UpdateSource
+// @Summary Update the settings of a source storage attached to a preparation
+// @Tags Preparation
+// @Accept json
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Param name path string true "Source storage ID or name"
+// @Param request body UpdateSourceRequest true "Request body"
+// @Success 200 {object} model.SourceAttachment
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/source/{name} [patch]
+func _() {}
@@ -76,3 +76,33 @@ func TestAddSourceStorageHandler_Success(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateSourceHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			Name: "prep",
+			SourceStorages: []model.Storage{{
+				Name: "source",
+			}},
+		}).Error
+		require.NoError(t, err)
+
+		attachment, err := Default.UpdateSourceHandler(ctx, db, "prep", "source", UpdateSourceRequest{RescanMode: "incremental"})
+		require.NoError(t, err)
+		require.Equal(t, model.RescanIncremental, attachment.RescanMode)
+		var saved model.SourceAttachment
+		err = db.First(&saved, attachment.ID).Error
+		require.NoError(t, err)
+		require.Equal(t, model.RescanIncremental, saved.RescanMode)
+
+		attachment, err = Default.UpdateSourceHandler(ctx, db, "prep", "source", UpdateSourceRequest{})
+		require.NoError(t, err)
+		require.Equal(t, model.RescanIncremental, attachment.RescanMode)
+
+		_, err = Default.UpdateSourceHandler(ctx, db, "prep", "source", UpdateSourceRequest{RescanMode: "full"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		_, err = Default.UpdateSourceHandler(ctx, db, "prep", "other", UpdateSourceRequest{RescanMode: "append"})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+	})
+}
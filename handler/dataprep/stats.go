@@ -0,0 +1,278 @@
+package dataprep
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+// statsProofWindow is the period over which the retrieval checks of the stats are counted.
+const statsProofWindow = 7 * 24 * time.Hour
+
+type ProviderStats struct {
+	Provider    string `json:"provider"`
+	ActiveDeals int64  `json:"activeDeals"` // Number of active deals with the provider
+	ActiveBytes int64  `json:"activeBytes"` // Total piece size of the active deals with the provider
+}
+
+// Stats is the progress of a preparation. It only holds aggregated numbers, so it can be shared publicly with the
+// data owners without exposing the files, the wallets or the schedules of the preparation.
+type Stats struct {
+	Name                  string          `json:"name"`
+	GeneratedAt           time.Time       `json:"generatedAt"           table:"format:2006-01-02 15:04:05"`
+	Pieces                int64           `json:"pieces"`                               // Number of pieces prepared
+	BytesPrepared         int64           `json:"bytesPrepared"`                        // Total size of the CAR files prepared
+	ActiveDeals           int64           `json:"activeDeals"`                          // Number of active deals of the pieces
+	PendingDeals          int64           `json:"pendingDeals"`                         // Number of deals of the pieces that are proposed or published
+	ActiveBytes           int64           `json:"activeBytes"`                          // Total piece size of the active deals
+	Providers             []ProviderStats `json:"providers"             table:"expand"` // Distribution of the active deals across storage providers
+	LastRetrievalCheck    *time.Time      `json:"lastRetrievalCheck"`                   // Time of the last retrieval proof of the deals, if any
+	LastRetrievalSuccess  bool            `json:"lastRetrievalSuccess"`                 // Whether the last retrieval proof succeeded
+	RetrievalChecks       int64           `json:"retrievalChecks"`                      // Number of retrieval proofs of the deals over the last week
+	RetrievalChecksPassed int64           `json:"retrievalChecksPassed"`                // Number of successful retrieval proofs of the deals over the last week
+}
+
+// GetStatsHandler computes the stats of a preparation: the pieces prepared, the deals of the pieces and their
+// distribution across storage providers, and the retrieval proofs sampled from the deals by the retrieval sampler.
+// Aggregated pieces count towards the pieces prepared, and their deals are those of their aggregate.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name for the desired Preparation record.
+//
+// Returns:
+//   - A pointer to the Stats of the preparation.
+//   - An error, if the preparation does not exist, or if any other error occurred.
+func (DefaultHandler) GetStatsHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+) (*Stats, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return getStats(db, preparation)
+}
+
+func getStats(db *gorm.DB, preparation model.Preparation) (*Stats, error) {
+	now := time.Now().UTC()
+	stats := Stats{
+		Name:        preparation.Name,
+		GeneratedAt: now,
+		Providers:   []ProviderStats{},
+	}
+	var prepared struct {
+		Pieces        int64
+		BytesPrepared int64
+	}
+	err := db.Model(&model.Car{}).
+		Select("COUNT(*) AS pieces, COALESCE(SUM(file_size), 0) AS bytes_prepared").
+		Where("preparation_id = ? AND id NOT IN (?)", preparation.ID,
+			db.Model(&model.Car{}).Select("aggregate_id").Where("aggregate_id IS NOT NULL")).
+		Scan(&prepared).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	stats.Pieces = prepared.Pieces
+	stats.BytesPrepared = prepared.BytesPrepared
+
+	pieces := db.Model(&model.Car{}).Select("piece_cid").Where("preparation_id = ?", preparation.ID)
+	err = db.Model(&model.Deal{}).
+		Select("provider, COUNT(*) AS active_deals, COALESCE(SUM(piece_size), 0) AS active_bytes").
+		Where("piece_cid IN (?) AND state = ?", pieces, model.DealActive).
+		Group("provider").
+		Order("active_bytes DESC, provider").
+		Scan(&stats.Providers).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, provider := range stats.Providers {
+		stats.ActiveDeals += provider.ActiveDeals
+		stats.ActiveBytes += provider.ActiveBytes
+	}
+	err = db.Model(&model.Deal{}).
+		Where("piece_cid IN (?) AND state IN ?", pieces, []model.DealState{model.DealProposed, model.DealPublished}).
+		Count(&stats.PendingDeals).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	proofs := func() *gorm.DB {
+		return db.Model(&model.RetrievalProof{}).Where("piece_cid IN (?)", pieces)
+	}
+	var last model.RetrievalProof
+	err = proofs().Order("created_at DESC, id DESC").Limit(1).Find(&last).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if last.ID != 0 {
+		stats.LastRetrievalCheck = &last.CreatedAt
+		stats.LastRetrievalSuccess = last.Success
+	}
+	err = proofs().Where("created_at > ?", now.Add(-statsProofWindow)).Count(&stats.RetrievalChecks).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = proofs().Where("created_at > ? AND success = ?", now.Add(-statsProofWindow), true).
+		Count(&stats.RetrievalChecksPassed).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &stats, nil
+}
+
+// GetSharedStatsHandler computes the stats of the preparation shared with a stats share ID, for the public stats
+// endpoint of the content provider.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - shareID: The stats share ID of the preparation.
+//
+// Returns:
+//   - A pointer to the Stats of the preparation.
+//   - An error, if no preparation is shared with the ID, or if any other error occurred.
+func (DefaultHandler) GetSharedStatsHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	shareID string,
+) (*Stats, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := db.Where("stats_share_id = ?", shareID).First(&preparation).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrap(handlererror.ErrNotFound, "no stats are shared with this ID")
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return getStats(db, preparation)
+}
+
+// ShareStatsHandler shares the stats of a preparation publicly. It sets a random stats share ID on the preparation,
+// which the content provider started with --enable-public-stats serves the stats at, so the data owner can link or
+// embed them without access to the API. Sharing the stats of a preparation that are already shared keeps its ID.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name for the desired Preparation record.
+//
+// Returns:
+//   - A pointer to the updated Preparation with its stats share ID.
+//   - An error, if the preparation does not exist, or if any other error occurred.
+func (DefaultHandler) ShareStatsHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+) (*model.Preparation, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if preparation.StatsShareID != nil {
+		return &preparation, nil
+	}
+
+	shareID := make([]byte, 16)
+	_, err = rand.Read(shareID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	encoded := hex.EncodeToString(shareID)
+	err = database.DoRetry(ctx, func() error {
+		return db.Model(&preparation).Update("stats_share_id", encoded).Error
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	preparation.StatsShareID = &encoded
+	return &preparation, nil
+}
+
+// UnshareStatsHandler stops sharing the stats of a preparation publicly. Sharing them again gives a new ID, so the
+// links to the previous one stop working.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name for the desired Preparation record.
+//
+// Returns:
+//   - A pointer to the updated Preparation.
+//   - An error, if the preparation does not exist, or if any other error occurred.
+func (DefaultHandler) UnshareStatsHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+) (*model.Preparation, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = database.DoRetry(ctx, func() error {
+		return db.Model(&preparation).Update("stats_share_id", nil).Error
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	preparation.StatsShareID = nil
+	return &preparation, nil
+}
+
+// @ID GetPreparationStats
+// @Summary Get the stats of a preparation
+// @Tags Preparation
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Success 200 {object} Stats
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/stats [get]
+func _() {}
+
+// @ID ShareStats
+// @Summary Share the stats of a preparation publicly
+// @Tags Preparation
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Success 200 {object} model.Preparation
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/stats/share [post]
+func _() {}
+
+// @ID UnshareStats
+// @Summary Stop sharing the stats of a preparation publicly
+// @Tags Preparation
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Success 200 {object} model.Preparation
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/stats/share [delete]
+func _() {}
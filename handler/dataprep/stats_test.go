@@ -0,0 +1,115 @@
+package dataprep
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestStatsHandlers(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		preparation := model.Preparation{Name: "prep"}
+		require.NoError(t, db.Create(&preparation).Error)
+		require.NoError(t, db.Create(&model.Wallet{ID: "f0client"}).Error)
+
+		t.Run("not found", func(t *testing.T) {
+			_, err := Default.GetStatsHandler(ctx, db, "2")
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+			_, err = Default.ShareStatsHandler(ctx, db, "2")
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+			_, err = Default.UnshareStatsHandler(ctx, db, "2")
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+			_, err = Default.GetSharedStatsHandler(ctx, db, "unknown")
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+		})
+
+		t.Run("empty", func(t *testing.T) {
+			stats, err := Default.GetStatsHandler(ctx, db, "prep")
+			require.NoError(t, err)
+			require.Equal(t, "prep", stats.Name)
+			require.Zero(t, stats.Pieces)
+			require.Empty(t, stats.Providers)
+			require.Nil(t, stats.LastRetrievalCheck)
+		})
+
+		t.Run("stats", func(t *testing.T) {
+			pieceCID := func(name string) model.CID {
+				return model.CID(cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte(name))))
+			}
+			piece1 := model.Car{PreparationID: preparation.ID, PieceCID: pieceCID("1"), PieceSize: 1 << 20, FileSize: 1000}
+			require.NoError(t, db.Create(&piece1).Error)
+			// Aggregated pieces count towards the pieces prepared, and their deals are those of the aggregate
+			aggregate := model.Car{PreparationID: preparation.ID, PieceCID: pieceCID("aggregate"), PieceSize: 1 << 21, FileSize: 5000}
+			require.NoError(t, db.Create(&aggregate).Error)
+			require.NoError(t, db.Create(&model.Car{PreparationID: preparation.ID, PieceCID: pieceCID("2"), FileSize: 2000, AggregateID: &aggregate.ID}).Error)
+			require.NoError(t, db.Create(&model.Car{PreparationID: preparation.ID, PieceCID: pieceCID("3"), FileSize: 3000, AggregateID: &aggregate.ID}).Error)
+			// Deals of other preparations are not counted
+			other := model.Preparation{Name: "other"}
+			require.NoError(t, db.Create(&other).Error)
+			require.NoError(t, db.Create(&model.Car{PreparationID: other.ID, PieceCID: pieceCID("other"), FileSize: 100}).Error)
+
+			deals := []model.Deal{
+				{PieceCID: piece1.PieceCID, PieceSize: piece1.PieceSize, Provider: "f01", State: model.DealActive, ClientID: "f0client"},
+				{PieceCID: aggregate.PieceCID, PieceSize: aggregate.PieceSize, Provider: "f01", State: model.DealActive, ClientID: "f0client"},
+				{PieceCID: aggregate.PieceCID, PieceSize: aggregate.PieceSize, Provider: "f02", State: model.DealActive, ClientID: "f0client"},
+				{PieceCID: piece1.PieceCID, PieceSize: piece1.PieceSize, Provider: "f03", State: model.DealProposed, ClientID: "f0client"},
+				{PieceCID: piece1.PieceCID, PieceSize: piece1.PieceSize, Provider: "f04", State: model.DealExpired, ClientID: "f0client"},
+				{PieceCID: pieceCID("other"), PieceSize: 1 << 20, Provider: "f05", State: model.DealActive, ClientID: "f0client"},
+			}
+			require.NoError(t, db.Create(&deals).Error)
+			now := time.Now()
+			require.NoError(t, db.Create(&[]model.RetrievalProof{
+				{CreatedAt: now.Add(-30 * 24 * time.Hour), DealID: deals[0].ID, PieceCID: piece1.PieceCID, Provider: "f01", Success: true},
+				{CreatedAt: now.Add(-2 * time.Hour), DealID: deals[1].ID, PieceCID: aggregate.PieceCID, Provider: "f01", Success: true},
+				{CreatedAt: now.Add(-time.Hour), DealID: deals[2].ID, PieceCID: aggregate.PieceCID, Provider: "f02", Success: false},
+				{CreatedAt: now, DealID: deals[5].ID, PieceCID: pieceCID("other"), Provider: "f05", Success: true},
+			}).Error)
+
+			stats, err := Default.GetStatsHandler(ctx, db, "prep")
+			require.NoError(t, err)
+			require.EqualValues(t, 3, stats.Pieces)
+			require.EqualValues(t, 6000, stats.BytesPrepared)
+			require.EqualValues(t, 3, stats.ActiveDeals)
+			require.EqualValues(t, 1, stats.PendingDeals)
+			require.EqualValues(t, 1<<20+2<<21, stats.ActiveBytes)
+			require.Equal(t, []ProviderStats{
+				{Provider: "f01", ActiveDeals: 2, ActiveBytes: 1<<20 + 1<<21},
+				{Provider: "f02", ActiveDeals: 1, ActiveBytes: 1 << 21},
+			}, stats.Providers)
+			require.NotNil(t, stats.LastRetrievalCheck)
+			require.False(t, stats.LastRetrievalSuccess)
+			require.EqualValues(t, 2, stats.RetrievalChecks)
+			require.EqualValues(t, 1, stats.RetrievalChecksPassed)
+		})
+
+		t.Run("share and unshare", func(t *testing.T) {
+			shared, err := Default.ShareStatsHandler(ctx, db, "prep")
+			require.NoError(t, err)
+			require.NotNil(t, shared.StatsShareID)
+			require.Len(t, *shared.StatsShareID, 32)
+
+			// Sharing again keeps the ID
+			again, err := Default.ShareStatsHandler(ctx, db, "prep")
+			require.NoError(t, err)
+			require.Equal(t, *shared.StatsShareID, *again.StatsShareID)
+
+			stats, err := Default.GetSharedStatsHandler(ctx, db, *shared.StatsShareID)
+			require.NoError(t, err)
+			require.Equal(t, "prep", stats.Name)
+
+			unshared, err := Default.UnshareStatsHandler(ctx, db, "prep")
+			require.NoError(t, err)
+			require.Nil(t, unshared.StatsShareID)
+			_, err = Default.GetSharedStatsHandler(ctx, db, *shared.StatsShareID)
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+		})
+	})
+}
@@ -0,0 +1,67 @@
+package dataprep
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/scan"
+	"gorm.io/gorm"
+)
+
+// VerifySourcesHandler verifies the object lock retention mode of the bucket of each source storage attached to a
+// preparation, and records it on the source attachment, so curators can check that the sources of a compliance
+// dataset are immutable before it is scanned. Sources whose storage type has no object lock verifier are recorded as
+// unsupported.
+//
+// Unlike a scan, this does not refuse mutable sources, whatever the immutability policy of the preparation.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name of the preparation.
+//
+// Returns:
+//   - The source attachments with their verified retention mode, and their storage.
+//   - An error if the preparation does not exist, if the object lock configuration of a bucket cannot be read, or if
+//     the database update fails.
+func (DefaultHandler) VerifySourcesHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id string,
+) ([]model.SourceAttachment, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation %s does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	attachments, err := preparation.SourceAttachments(db, "Storage")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for i := range attachments {
+		err = scan.RecordRetention(ctx, db, &attachments[i])
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	return attachments, nil
+}
+
+// @ID VerifySources
+// @Summary Verify and record the object lock retention mode of the source storages of a preparation
+// @Tags Preparation
+// @Param id path string true "Preparation ID or name"
+// @Produce json
+// @Success 200 {array} model.SourceAttachment
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/verify-sources [post]
+func _() {}
@@ -0,0 +1,41 @@
+package dataprep
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestVerifySourcesHandler(t *testing.T) {
+	t.Run("Preparation not found", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			_, err := Default.VerifySourcesHandler(ctx, db, "name")
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+		})
+	})
+
+	t.Run("success", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			require.NoError(t, db.Create(&model.SourceAttachment{
+				Preparation: &model.Preparation{Name: "prep"},
+				Storage:     &model.Storage{Name: "source", Type: "local", Path: t.TempDir()},
+			}).Error)
+
+			attachments, err := Default.VerifySourcesHandler(ctx, db, "prep")
+			require.NoError(t, err)
+			require.Len(t, attachments, 1)
+			require.Equal(t, model.RetentionUnsupported, attachments[0].RetentionMode)
+			require.NotNil(t, attachments[0].RetentionCheckedAt)
+
+			var stored model.SourceAttachment
+			require.NoError(t, db.First(&stored, attachments[0].ID).Error)
+			require.Equal(t, model.RetentionUnsupported, stored.RetentionMode)
+			require.NotNil(t, stored.RetentionCheckedAt)
+		})
+	})
+}
@@ -18,6 +18,8 @@ type Handler interface {
 		dealMaker replication.DealMaker,
 		request Proposal,
 	) (*model.Deal, error)
+	ListReceiptsHandler(ctx context.Context, db *gorm.DB, request ListReceiptRequest) ([]model.PieceReceipt, error)
+	ListPieceProvidersHandler(ctx context.Context, db *gorm.DB, request ListPieceProvidersRequest) ([]PieceProviders, error)
 }
 
 type DefaultHandler struct{}
@@ -39,3 +41,13 @@ func (m *MockDeal) SendManualHandler(ctx context.Context, db *gorm.DB, dealMaker
 	args := m.Called(ctx, db, dealMaker, request)
 	return args.Get(0).(*model.Deal), args.Error(1)
 }
+
+func (m *MockDeal) ListReceiptsHandler(ctx context.Context, db *gorm.DB, request ListReceiptRequest) ([]model.PieceReceipt, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).([]model.PieceReceipt), args.Error(1)
+}
+
+func (m *MockDeal) ListPieceProvidersHandler(ctx context.Context, db *gorm.DB, request ListPieceProvidersRequest) ([]PieceProviders, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).([]PieceProviders), args.Error(1)
+}
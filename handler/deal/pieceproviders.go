@@ -0,0 +1,110 @@
+package deal
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+)
+
+// liveDealStates are the states of the deals that count as a provider having a piece, so the piece is not proposed
+// to the provider again.
+var liveDealStates = []model.DealState{model.DealProposed, model.DealPublished, model.DealActive}
+
+type ListPieceProvidersRequest struct {
+	Preparations []string `json:"preparations"` // preparation ID or name filter
+	Pieces       []string `json:"pieces"`       // piece CID filter
+	Providers    []string `json:"providers"`    // provider filter
+}
+
+type PieceProviders struct {
+	PieceCID  string   `json:"pieceCid"`
+	Providers []string `json:"providers"` // Providers with a proposed, published or active deal for the piece
+}
+
+// ListPieceProvidersHandler lists which providers already have which pieces, i.e. have a proposed, published or
+// active deal for them, filtered by preparations, piece CIDs and providers. These are the pieces the deal pusher and
+// manual proposals will not propose to the providers again, unless forced to.
+//
+// Parameters:
+//   - ctx:      The context for the operation which provides facilities for timeouts and cancellations.
+//   - db:       The database connection for performing CRUD operations related to deals.
+//   - request:  The request object which contains the filtering criteria.
+//
+// Returns:
+//   - A PieceProviders for each piece with at least one provider, ordered by piece CID, with the providers sorted.
+//   - An error indicating any issues that occurred during the database operation.
+func (DefaultHandler) ListPieceProvidersHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	request ListPieceProvidersRequest,
+) ([]PieceProviders, error) {
+	db = db.WithContext(ctx)
+	statement := db.Model(&model.Deal{}).Where("state IN ?", liveDealStates)
+	if len(request.Preparations) > 0 {
+		var ids []uint64
+		var names []string
+		for _, preparation := range request.Preparations {
+			if id, err := strconv.ParseUint(preparation, 10, 32); err == nil {
+				ids = append(ids, id)
+			} else {
+				names = append(names, preparation)
+			}
+		}
+		statement = statement.Where("piece_cid IN (?)", db.Model(&model.Car{}).Select("piece_cid").
+			Where("preparation_id IN (?)", db.Model(&model.Preparation{}).Select("id").
+				Where("id in ? OR name in ?", ids, names)))
+	}
+
+	if len(request.Pieces) > 0 {
+		var pieceCIDs []model.CID
+		for _, piece := range request.Pieces {
+			pieceCID, err := cid.Parse(piece)
+			if err != nil {
+				return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid piece CID %s", piece)
+			}
+			pieceCIDs = append(pieceCIDs, model.CID(pieceCID))
+		}
+		statement = statement.Where("piece_cid IN ?", pieceCIDs)
+	}
+
+	if len(request.Providers) > 0 {
+		statement = statement.Where("provider IN ?", request.Providers)
+	}
+
+	var rows []struct {
+		PieceCID model.CID `gorm:"column:piece_cid"`
+		Provider string
+	}
+	err := statement.Distinct("piece_cid", "provider").Order("piece_cid asc, provider asc").Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var result []PieceProviders
+	for _, row := range rows {
+		pieceCID := row.PieceCID.String()
+		if len(result) == 0 || result[len(result)-1].PieceCID != pieceCID {
+			result = append(result, PieceProviders{PieceCID: pieceCID})
+		}
+		last := &result[len(result)-1]
+		last.Providers = append(last.Providers, row.Provider)
+	}
+	return result, nil
+}
+
+// @ID ListPieceProviders
+// @Summary List the providers that already have a proposed, published or active deal for each piece
+// @Tags Deal
+// @Accept json
+// @Produce json
+// @Param request body ListPieceProvidersRequest true "ListPieceProvidersRequest"
+// @Success 200 {array} PieceProviders
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /deal/piece_providers [post]
+func _() {}
@@ -0,0 +1,65 @@
+package deal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestListPieceProvidersHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		piece1 := model.CID(testutil.TestCid)
+		piece2 := model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("other"))))
+		preparations := []model.Preparation{{Name: "prep1"}, {Name: "prep2"}}
+		require.NoError(t, db.Create(&preparations).Error)
+		require.NoError(t, db.Create([]model.Car{
+			{PieceCID: piece1, PreparationID: preparations[0].ID},
+			{PieceCID: piece2, PreparationID: preparations[1].ID},
+		}).Error)
+		require.NoError(t, db.Create(&model.Wallet{ID: "f01000", Address: "f10000"}).Error)
+		require.NoError(t, db.Create([]model.Deal{
+			{Provider: "f02", PieceCID: piece1, State: model.DealActive, ClientID: "f01000"},
+			{Provider: "f01", PieceCID: piece1, State: model.DealProposed, ClientID: "f01000"},
+			{Provider: "f01", PieceCID: piece1, State: model.DealPublished, ClientID: "f01000"},
+			{Provider: "f03", PieceCID: piece1, State: model.DealExpired, ClientID: "f01000"},
+			{Provider: "f03", PieceCID: piece2, State: model.DealActive, ClientID: "f01000"},
+		}).Error)
+
+		pieces, err := Default.ListPieceProvidersHandler(ctx, db, ListPieceProvidersRequest{})
+		require.NoError(t, err)
+		require.Len(t, pieces, 2)
+		for _, piece := range pieces {
+			switch piece.PieceCID {
+			case piece1.String():
+				require.Equal(t, []string{"f01", "f02"}, piece.Providers)
+			case piece2.String():
+				require.Equal(t, []string{"f03"}, piece.Providers)
+			default:
+				t.Fatalf("unexpected piece %s", piece.PieceCID)
+			}
+		}
+
+		pieces, err = Default.ListPieceProvidersHandler(ctx, db, ListPieceProvidersRequest{
+			Preparations: []string{"prep1"},
+			Providers:    []string{"f02", "f03"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []PieceProviders{{PieceCID: piece1.String(), Providers: []string{"f02"}}}, pieces)
+
+		pieces, err = Default.ListPieceProvidersHandler(ctx, db, ListPieceProvidersRequest{
+			Pieces: []string{piece2.String()},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []PieceProviders{{PieceCID: piece2.String(), Providers: []string{"f03"}}}, pieces)
+
+		_, err = Default.ListPieceProvidersHandler(ctx, db, ListPieceProvidersRequest{Pieces: []string{"invalid"}})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+}
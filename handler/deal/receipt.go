@@ -0,0 +1,78 @@
+package deal
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+)
+
+type ListReceiptRequest struct {
+	Preparations []string `json:"preparations"` // preparation ID or name filter
+	Pieces       []string `json:"pieces"`       // piece CID filter
+}
+
+// ListReceiptsHandler retrieves the signed receipts of the pieces that have reached their replication target,
+// filtered by preparations and piece CIDs. Each receipt contains the signed payload, the signer and its public key,
+// so it can be archived and verified without access to the database.
+//
+// Parameters:
+//   - ctx:      The context for the operation which provides facilities for timeouts and cancellations.
+//   - db:       The database connection for performing CRUD operations related to receipts.
+//   - request:  The request object which contains the filtering criteria for the receipts retrieval.
+//
+// Returns:
+//   - A slice of model.PieceReceipt objects matching the filtering criteria.
+//   - An error indicating any issues that occurred during the database operation.
+func (DefaultHandler) ListReceiptsHandler(ctx context.Context, db *gorm.DB, request ListReceiptRequest) ([]model.PieceReceipt, error) {
+	db = db.WithContext(ctx)
+	statement := db
+	if len(request.Preparations) > 0 {
+		var ids []uint64
+		var names []string
+		for _, preparation := range request.Preparations {
+			if id, err := strconv.ParseUint(preparation, 10, 32); err == nil {
+				ids = append(ids, id)
+			} else {
+				names = append(names, preparation)
+			}
+		}
+		statement = statement.Where("preparation_id IN (?)", db.Model(&model.Preparation{}).Select("id").
+			Where("id in ? OR name in ?", ids, names))
+	}
+
+	if len(request.Pieces) > 0 {
+		var pieceCIDs []model.CID
+		for _, piece := range request.Pieces {
+			pieceCID, err := cid.Parse(piece)
+			if err != nil {
+				return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid piece CID %s", piece)
+			}
+			pieceCIDs = append(pieceCIDs, model.CID(pieceCID))
+		}
+		statement = statement.Where("piece_cid IN ?", pieceCIDs)
+	}
+
+	var receipts []model.PieceReceipt
+	err := db.Where(statement).Order("id asc").Find(&receipts).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return receipts, nil
+}
+
+// @ID ListReceipts
+// @Summary List the signed receipts of pieces that have reached their replication target
+// @Tags Deal
+// @Accept json
+// @Produce json
+// @Param request body ListReceiptRequest true "ListReceiptRequest"
+// @Success 200 {array} model.PieceReceipt
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /deal/receipt [post]
+func _() {}
@@ -0,0 +1,38 @@
+package deal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestListReceiptsHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		preparations := []model.Preparation{{Name: "prep1"}, {Name: "prep2"}}
+		require.NoError(t, db.Create(&preparations).Error)
+		require.NoError(t, db.Create([]model.PieceReceipt{
+			{PieceCID: model.CID(testutil.TestCid), PreparationID: preparations[0].ID, Payload: []byte(`{}`)},
+			{PieceCID: model.CID(testutil.TestCid), PreparationID: preparations[1].ID, Payload: []byte(`{}`)},
+		}).Error)
+
+		receipts, err := Default.ListReceiptsHandler(ctx, db, ListReceiptRequest{})
+		require.NoError(t, err)
+		require.Len(t, receipts, 2)
+
+		receipts, err = Default.ListReceiptsHandler(ctx, db, ListReceiptRequest{
+			Preparations: []string{"prep2"},
+			Pieces:       []string{testutil.TestCid.String()},
+		})
+		require.NoError(t, err)
+		require.Len(t, receipts, 1)
+		require.Equal(t, preparations[1].ID, receipts[0].PreparationID)
+
+		_, err = Default.ListReceiptsHandler(ctx, db, ListReceiptRequest{Pieces: []string{"invalid"}})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+}
@@ -0,0 +1,167 @@
+package schedule
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/operator"
+	"github.com/dustin/go-humanize"
+	"gorm.io/gorm"
+)
+
+// authenticate returns the operator with the API key carried by the context, or nil if the context has no API key.
+func authenticate(ctx context.Context, db *gorm.DB) (*model.Operator, error) {
+	key := operator.KeyFromContext(ctx)
+	if key == "" {
+		return nil, nil
+	}
+	var found model.Operator
+	err := db.Where("key_hash = ?", operator.HashKey(key)).First(&found).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrap(handlererror.ErrUnauthorized, "invalid operator API key")
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &found, nil
+}
+
+// scheduleSize returns the maximum total size of the deals that a schedule can make, or -1 if it is unlimited.
+func scheduleSize(totalDealSize int64, totalDealNumber int, pieceSize int64) int64 {
+	size := int64(-1)
+	if totalDealSize > 0 {
+		size = totalDealSize
+	}
+	if totalDealNumber > 0 && (size < 0 || int64(totalDealNumber)*pieceSize < size) {
+		size = int64(totalDealNumber) * pieceSize
+	}
+	return size
+}
+
+// checkApproval decides whether a schedule that is created or modified needs to be approved by a second operator,
+// which is the case if the total size of the deals it can make is above the approval threshold. An operator API key
+// is required to create or modify such a schedule, so it is known who requested it.
+//
+// Parameters:
+//   - ctx: The context carrying the API key of the operator, if any.
+//   - db: The database connection.
+//   - totalDealSize: The total deal size of the schedule, 0 if unlimited.
+//   - totalDealNumber: The total deal number of the schedule, 0 if unlimited.
+//   - pieceSize: The piece size of the preparation of the schedule.
+//
+// Returns:
+//   - The name of the operator that requested the change, or an empty string if unknown.
+//   - Whether the schedule needs to be approved.
+//   - An error if the API key is invalid or missing while needed, or if any other error occurred.
+func checkApproval(
+	ctx context.Context,
+	db *gorm.DB,
+	totalDealSize int64,
+	totalDealNumber int,
+	pieceSize int64,
+) (string, bool, error) {
+	requester, err := authenticate(ctx, db)
+	if err != nil {
+		return "", false, err
+	}
+	var requestedBy string
+	if requester != nil {
+		requestedBy = requester.Name
+	}
+
+	threshold, err := model.GetApprovalThreshold(db)
+	if err != nil {
+		return "", false, errors.WithStack(err)
+	}
+	size := scheduleSize(totalDealSize, totalDealNumber, pieceSize)
+	if threshold == 0 || (size >= 0 && size <= threshold) {
+		return requestedBy, false, nil
+	}
+	if requester == nil {
+		return "", false, errors.Wrapf(handlererror.ErrUnauthorized,
+			"the schedule is above the approval threshold of %s, an operator API key is required",
+			humanize.IBytes(uint64(threshold)))
+	}
+	return requestedBy, true, nil
+}
+
+// ApproveHandler approves a schedule that is pending approval, which activates it. A schedule is pending approval
+// when it has been created or modified with a total deal size above the approval threshold. It must be approved by
+// an operator other than the one that requested it, so a single compromised API key cannot spend all the datacap.
+//
+// Parameters:
+//   - ctx: The context for the operation, which must carry the API key of the approving operator.
+//   - db: The database connection used for operations.
+//   - scheduleID: The ID of the schedule to be approved.
+//
+// Returns:
+//   - A pointer to the approved Schedule if successful.
+//   - An error if the API key is missing or invalid, if the schedule is not found or not pending approval, or if the
+//     approving operator is the one that requested the schedule.
+func (DefaultHandler) ApproveHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	scheduleID uint32,
+) (*model.Schedule, error) {
+	db = db.WithContext(ctx)
+	approver, err := authenticate(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if approver == nil {
+		return nil, errors.Wrap(handlererror.ErrUnauthorized, "an operator API key is required to approve a schedule")
+	}
+
+	var schedule model.Schedule
+	err = db.First(&schedule, "id = ?", scheduleID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "schedule %d not found", scheduleID)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if schedule.State != model.SchedulePendingApproval {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "schedule %d is not pending approval, current state: %s", scheduleID, schedule.State)
+	}
+	if schedule.RequestedBy == approver.Name {
+		return nil, errors.Wrapf(handlererror.ErrForbidden, "schedule %d was requested by operator %s and must be approved by another operator",
+			scheduleID, approver.Name)
+	}
+
+	var rowsAffected int64
+	err = database.DoRetry(ctx, func() error {
+		result := db.Model(&model.Schedule{}).
+			Where("id = ? AND state = ?", scheduleID, model.SchedulePendingApproval).
+			Updates(map[string]any{"state": model.ScheduleActive, "approved_by": approver.Name})
+		rowsAffected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if rowsAffected == 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "schedule %d is no longer pending approval", scheduleID)
+	}
+
+	schedule.State = model.ScheduleActive
+	schedule.ApprovedBy = approver.Name
+	return &schedule, nil
+}
+
+// @ID ApproveSchedule
+// @Summary Approve a schedule that is pending approval
+// @Description The schedule must be approved by an operator other than the one that requested it, identified by the X-Singularity-Api-Key header.
+// @Tags Deal Schedule
+// @Produce json
+// @Param id path int true "Schedule ID"
+// @Success 200 {object} model.Schedule
+// @Failure 400 {object} api.HTTPError
+// @Failure 401 {object} api.HTTPError
+// @Failure 403 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /schedule/{id}/approve [post]
+func _() {}
@@ -0,0 +1,111 @@
+package schedule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/operator"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func createOperators(t *testing.T, db *gorm.DB, names ...string) {
+	for _, name := range names {
+		require.NoError(t, db.Create(&model.Operator{Name: name, KeyHash: operator.HashKey(name + "-key")}).Error)
+	}
+}
+
+func TestScheduleSize(t *testing.T) {
+	require.EqualValues(t, -1, scheduleSize(0, 0, 1<<35))
+	require.EqualValues(t, 1<<40, scheduleSize(1<<40, 0, 1<<35))
+	require.EqualValues(t, 10<<35, scheduleSize(0, 10, 1<<35))
+	require.EqualValues(t, 10<<35, scheduleSize(1<<40, 10, 1<<35))
+	require.EqualValues(t, 1<<36, scheduleSize(1<<36, 10, 1<<35))
+}
+
+func TestApproval(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		require.NoError(t, db.Create(&model.Preparation{
+			Name:      "name",
+			PieceSize: 1 << 35,
+			Wallets:   []model.Wallet{{ID: "f01"}},
+		}).Error)
+		createOperators(t, db, "alice", "bob")
+		require.NoError(t, model.SetApprovalThreshold(db, 1<<50))
+		aliceCtx := operator.WithKey(ctx, "alice-key")
+		bobCtx := operator.WithKey(ctx, "bob-key")
+
+		t.Run("below threshold", func(t *testing.T) {
+			schedule, err := Default.CreateHandler(ctx, db, getMockLotusClient(), createRequest)
+			require.NoError(t, err)
+			require.Equal(t, model.ScheduleActive, schedule.State)
+			require.Empty(t, schedule.RequestedBy)
+		})
+
+		t.Run("above threshold without key", func(t *testing.T) {
+			request := createRequest
+			request.TotalDealSize = "2PiB"
+			request.TotalDealNumber = 0
+			_, err := Default.CreateHandler(ctx, db, getMockLotusClient(), request)
+			require.ErrorIs(t, err, handlererror.ErrUnauthorized)
+		})
+
+		t.Run("invalid key", func(t *testing.T) {
+			_, err := Default.CreateHandler(operator.WithKey(ctx, "wrong"), db, getMockLotusClient(), createRequest)
+			require.ErrorIs(t, err, handlererror.ErrUnauthorized)
+		})
+
+		t.Run("above threshold", func(t *testing.T) {
+			request := createRequest
+			request.TotalDealSize = "2PiB"
+			request.TotalDealNumber = 0
+			schedule, err := Default.CreateHandler(aliceCtx, db, getMockLotusClient(), request)
+			require.NoError(t, err)
+			require.Equal(t, model.SchedulePendingApproval, schedule.State)
+			require.Equal(t, "alice", schedule.RequestedBy)
+
+			_, err = Default.ApproveHandler(ctx, db, uint32(schedule.ID))
+			require.ErrorIs(t, err, handlererror.ErrUnauthorized)
+			_, err = Default.ApproveHandler(aliceCtx, db, uint32(schedule.ID))
+			require.ErrorIs(t, err, handlererror.ErrForbidden)
+
+			approved, err := Default.ApproveHandler(bobCtx, db, uint32(schedule.ID))
+			require.NoError(t, err)
+			require.Equal(t, model.ScheduleActive, approved.State)
+			require.Equal(t, "bob", approved.ApprovedBy)
+
+			_, err = Default.ApproveHandler(bobCtx, db, uint32(schedule.ID))
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		})
+
+		t.Run("modified above threshold", func(t *testing.T) {
+			schedule, err := Default.CreateHandler(aliceCtx, db, getMockLotusClient(), createRequest)
+			require.NoError(t, err)
+			require.Equal(t, model.ScheduleActive, schedule.State)
+
+			raise := UpdateRequest{TotalDealNumber: ptr.Of(0), TotalDealSize: ptr.Of("2PiB")}
+			_, err = Default.UpdateHandler(ctx, db, uint32(schedule.ID), raise)
+			require.ErrorIs(t, err, handlererror.ErrUnauthorized)
+
+			_, err = Default.UpdateHandler(bobCtx, db, uint32(schedule.ID), raise)
+			require.NoError(t, err)
+			var updated model.Schedule
+			require.NoError(t, db.First(&updated, schedule.ID).Error)
+			require.Equal(t, model.SchedulePendingApproval, updated.State)
+			require.Equal(t, "bob", updated.RequestedBy)
+
+			approved, err := Default.ApproveHandler(aliceCtx, db, uint32(schedule.ID))
+			require.NoError(t, err)
+			require.Equal(t, model.ScheduleActive, approved.State)
+		})
+
+		t.Run("not found", func(t *testing.T) {
+			_, err := Default.ApproveHandler(bobCtx, db, 100)
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+		})
+	})
+}
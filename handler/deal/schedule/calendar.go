@@ -0,0 +1,264 @@
+package schedule
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/rjNemo/underscore"
+	"github.com/robfig/cron/v3"
+	"gorm.io/gorm"
+)
+
+const (
+	CalendarDay  = "day"
+	CalendarWeek = "week"
+	// DefaultCalendarHorizon is how far ahead the deal calendar is projected by default
+	DefaultCalendarHorizon = 30 * 24 * time.Hour
+)
+
+type CalendarRequest struct {
+	Interval string `default:"day"  json:"interval"` // Period of the calendar entries, either day or week. Weeks start on Monday.
+	Horizon  string `default:"720h" json:"horizon"`  // How far ahead to project the deal proposals, i.e. 720h for 30 days
+	Provider string `json:"provider"`                // Only include the schedules of this storage provider, if set
+}
+
+type CalendarEntry struct {
+	Period      time.Time          `json:"period"      table:"format:2006-01-02"` // Start of the day or week in UTC
+	Provider    string             `json:"provider"`
+	DealNumber  int                `json:"dealNumber"`  // Number of deals expected to be proposed within the period
+	DealSize    int64              `json:"dealSize"`    // Total piece size of the deals expected to be proposed within the period
+	ScheduleIDs []model.ScheduleID `json:"scheduleIds"` // Schedules expected to propose the deals
+}
+
+type sumResult struct {
+	DealNumber int
+	DealSize   int64
+}
+
+type calendarPiece struct {
+	PieceCID  model.CID `gorm:"column:piece_cid"`
+	PieceSize int64
+}
+
+// CalendarHandler projects the deals that the active schedules are expected to propose, per day or week and per
+// storage provider, so operators can see the upcoming proposals before they are made.
+//
+// The projection replays the pacing of each schedule against its backlog, which is the pieces of the preparation
+// that the provider does not have a deal for yet, or that the schedule has not made a deal for if the schedule is
+// forced. A schedule without cron proposes its whole backlog right away. A cron schedule proposes up to its schedule
+// deal number and size at each run of the cron. Both stop once the backlog or the total deal number or size of the
+// schedule is exhausted. A piece in the backlog of several schedules for the same provider is only counted for the
+// schedule with the lowest ID.
+//
+// The max pending deal number and size, the hourly and daily limits, the budgets, the provider reputation and the
+// retries of rejected proposals may delay proposals, and pieces packed later are not known yet, so the calendar is a
+// best-case estimate.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The CalendarRequest with the interval, the horizon and the provider.
+//
+// Returns:
+//   - A slice of CalendarEntry, sorted by period and provider. Periods without proposals are omitted.
+//   - An error, if any occurred during the operation.
+func (h DefaultHandler) CalendarHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	request CalendarRequest,
+) ([]CalendarEntry, error) {
+	db = db.WithContext(ctx)
+	interval := request.Interval
+	if interval == "" {
+		interval = CalendarDay
+	}
+	if interval != CalendarDay && interval != CalendarWeek {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid interval %s, must be %s or %s", interval, CalendarDay, CalendarWeek)
+	}
+	horizon := DefaultCalendarHorizon
+	if request.Horizon != "" {
+		var err error
+		horizon, err = time.ParseDuration(request.Horizon)
+		if err != nil || horizon <= 0 {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid horizon %s", request.Horizon)
+		}
+	}
+
+	query := db.Where("state = ?", model.ScheduleActive)
+	if request.Provider != "" {
+		query = query.Where("provider = ?", request.Provider)
+	}
+	var schedules []model.Schedule
+	err := query.Order("id").Find(&schedules).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	now := h.now()
+	until := now.Add(horizon)
+	cronParser := cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	type entryKey struct {
+		period   time.Time
+		provider string
+	}
+	entries := make(map[entryKey]*CalendarEntry)
+	claimed := make(map[string]map[string]struct{})
+	for _, schedule := range schedules {
+		// nextRun returns the next time the schedule proposes deals, or the zero time if it does not.
+		nextRun := func(time.Time) time.Time { return time.Time{} }
+		run := now
+		if schedule.ScheduleCron != "" {
+			cronSchedule, err := cronParser.Parse(schedule.ScheduleCron)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid schedule cron %s of schedule %d", schedule.ScheduleCron, schedule.ID)
+			}
+			nextRun = cronSchedule.Next
+			run = nextRun(now)
+		}
+		if run.IsZero() || run.After(until) {
+			continue
+		}
+
+		backlog, err := scheduleBacklog(db, schedule)
+		if err != nil {
+			return nil, err
+		}
+		if claimed[schedule.Provider] == nil {
+			claimed[schedule.Provider] = make(map[string]struct{})
+		}
+		backlog = underscore.Filter(backlog, func(piece calendarPiece) bool {
+			_, ok := claimed[schedule.Provider][piece.PieceCID.String()]
+			return !ok
+		})
+
+		var total sumResult
+		err = db.Model(&model.Deal{}).
+			Where("schedule_id = ? AND state IN (?)", schedule.ID, []model.DealState{
+				model.DealActive, model.DealProposed, model.DealPublished,
+			}).Select("COUNT(*) AS deal_number, SUM(piece_size) AS deal_size").Scan(&total).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		next := 0
+		exhausted := false
+		for ; !exhausted && !run.IsZero() && !run.After(until); run = nextRun(run) {
+			var current sumResult
+			for {
+				if next >= len(backlog) ||
+					schedule.TotalDealNumber > 0 && total.DealNumber >= schedule.TotalDealNumber ||
+					schedule.TotalDealSize > 0 && total.DealSize >= schedule.TotalDealSize {
+					exhausted = true
+					break
+				}
+				if schedule.ScheduleCron != "" && schedule.ScheduleDealNumber > 0 && current.DealNumber >= schedule.ScheduleDealNumber {
+					break
+				}
+				if schedule.ScheduleCron != "" && schedule.ScheduleDealSize > 0 && current.DealSize >= schedule.ScheduleDealSize {
+					break
+				}
+				piece := backlog[next]
+				next++
+				claimed[schedule.Provider][piece.PieceCID.String()] = struct{}{}
+				current.DealNumber++
+				current.DealSize += piece.PieceSize
+				total.DealNumber++
+				total.DealSize += piece.PieceSize
+			}
+			if current.DealNumber == 0 {
+				continue
+			}
+			key := entryKey{period: calendarPeriod(run, interval), provider: schedule.Provider}
+			entry, ok := entries[key]
+			if !ok {
+				entry = &CalendarEntry{Period: key.period, Provider: key.provider}
+				entries[key] = entry
+			}
+			entry.DealNumber += current.DealNumber
+			entry.DealSize += current.DealSize
+			if len(entry.ScheduleIDs) == 0 || entry.ScheduleIDs[len(entry.ScheduleIDs)-1] != schedule.ID {
+				entry.ScheduleIDs = append(entry.ScheduleIDs, schedule.ID)
+			}
+		}
+	}
+
+	result := make([]CalendarEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, *entry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].Period.Equal(result[j].Period) {
+			return result[i].Period.Before(result[j].Period)
+		}
+		return result[i].Provider < result[j].Provider
+	})
+	return result, nil
+}
+
+// scheduleBacklog returns the pieces that the schedule can still make deals for, in the order the deal pusher picks
+// them.
+func scheduleBacklog(db *gorm.DB, schedule model.Schedule) ([]calendarPiece, error) {
+	existingPieceCIDQuery := db.Table("deals").Select("piece_cid").
+		Where("provider = ? AND state IN (?)", schedule.Provider, []model.DealState{
+			model.DealProposed, model.DealPublished, model.DealActive,
+		})
+	if schedule.Force {
+		existingPieceCIDQuery = db.Table("deals").Select("piece_cid").Where("schedule_id = ?", schedule.ID)
+	}
+	// Aggregated pieces are dealt through their aggregate, which has no source attachment
+	attachmentIDs := db.Model(&model.SourceAttachment{}).Select("id").Where("preparation_id = ?", schedule.PreparationID)
+	dealablePieces := db.Where("attachment_id IN (?) AND aggregate_id IS NULL", attachmentIDs).
+		Or("id IN (?)", db.Model(&model.Car{}).Select("aggregate_id").Where("attachment_id IN (?)", attachmentIDs))
+	var cars []calendarPiece
+	err := db.Model(&model.Car{}).Select("piece_cid, piece_size").
+		Where(dealablePieces).Where("piece_cid NOT IN (?)", existingPieceCIDQuery).
+		Order("id").Find(&cars).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	allowed := make(map[string]struct{}, len(schedule.AllowedPieceCIDs))
+	for _, c := range schedule.AllowedPieceCIDs {
+		allowed[c] = struct{}{}
+	}
+	seen := make(map[string]struct{}, len(cars))
+	backlog := make([]calendarPiece, 0, len(cars))
+	for _, car := range cars {
+		pieceCID := car.PieceCID.String()
+		if _, ok := seen[pieceCID]; ok {
+			continue
+		}
+		if _, ok := allowed[pieceCID]; len(allowed) > 0 && !ok {
+			continue
+		}
+		seen[pieceCID] = struct{}{}
+		backlog = append(backlog, car)
+	}
+	return backlog, nil
+}
+
+// calendarPeriod returns the start of the day or week, in UTC, that the time falls into.
+func calendarPeriod(t time.Time, interval string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if interval == CalendarWeek {
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	}
+	return day
+}
+
+// @ID ScheduleCalendar
+// @Summary Project the deals the active schedules are expected to propose per day or week and per provider
+// @Tags Deal Schedule
+// @Accept json
+// @Produce json
+// @Param request body CalendarRequest true "Request body"
+// @Success 200 {array} CalendarEntry
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /schedule/calendar [post]
+func _() {}
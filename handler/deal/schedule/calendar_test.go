@@ -0,0 +1,127 @@
+package schedule
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestCalendarHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			SourceStorages: []model.Storage{{}},
+			Wallets: []model.Wallet{{
+				ID: "f01",
+			}},
+		}).Error
+		require.NoError(t, err)
+		var pieceCIDs []model.CID
+		for i := 0; i < 5; i++ {
+			pieceCID := model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte(strconv.Itoa(i)))))
+			pieceCIDs = append(pieceCIDs, pieceCID)
+			err = db.Create(&model.Car{
+				AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+				PreparationID: 1,
+				PieceCID:      pieceCID,
+				PieceSize:     1024,
+			}).Error
+			require.NoError(t, err)
+		}
+		// The same piece packed twice only needs one deal
+		err = db.Create(&model.Car{
+			AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+			PreparationID: 1,
+			PieceCID:      pieceCIDs[4],
+			PieceSize:     1024,
+		}).Error
+		require.NoError(t, err)
+
+		err = db.Create([]model.Schedule{
+			{PreparationID: 1, State: model.ScheduleActive, Provider: "f0a", TotalDealNumber: 3},
+			{PreparationID: 1, State: model.ScheduleActive, Provider: "f0b", ScheduleCron: "0 0 * * *", ScheduleDealNumber: 2},
+			{PreparationID: 1, State: model.SchedulePaused, Provider: "f0c"},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Deal{
+			ClientID:   "f01",
+			Provider:   "f0a",
+			PieceCID:   pieceCIDs[0],
+			PieceSize:  1024,
+			State:      model.DealActive,
+			ScheduleID: ptr.Of(model.ScheduleID(1)),
+		}).Error
+		require.NoError(t, err)
+
+		entries, err := Default.CalendarHandler(ctx, db, CalendarRequest{})
+		require.NoError(t, err)
+		today := calendarPeriod(time.Now(), CalendarDay)
+		require.Equal(t, []CalendarEntry{
+			{Period: today, Provider: "f0a", DealNumber: 2, DealSize: 2048, ScheduleIDs: []model.ScheduleID{1}},
+			{Period: today.AddDate(0, 0, 1), Provider: "f0b", DealNumber: 2, DealSize: 2048, ScheduleIDs: []model.ScheduleID{2}},
+			{Period: today.AddDate(0, 0, 2), Provider: "f0b", DealNumber: 2, DealSize: 2048, ScheduleIDs: []model.ScheduleID{2}},
+			{Period: today.AddDate(0, 0, 3), Provider: "f0b", DealNumber: 1, DealSize: 1024, ScheduleIDs: []model.ScheduleID{2}},
+		}, entries)
+
+		entries, err = Default.CalendarHandler(ctx, db, CalendarRequest{Horizon: "24h", Provider: "f0b"})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, 2, entries[0].DealNumber)
+
+		_, err = Default.CalendarHandler(ctx, db, CalendarRequest{Interval: "month"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		_, err = Default.CalendarHandler(ctx, db, CalendarRequest{Horizon: "-1h"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+}
+
+func TestCalendarHandler_Aggregate(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			SourceStorages: []model.Storage{{}},
+		}).Error
+		require.NoError(t, err)
+		// Aggregated pieces are dealt through their aggregate
+		err = db.Create(&model.Car{
+			PreparationID: 1,
+			PieceCID:      model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("aggregate")))),
+			PieceSize:     4096,
+		}).Error
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			err = db.Create(&model.Car{
+				AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+				PreparationID: 1,
+				PieceCID:      model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte(strconv.Itoa(i))))),
+				PieceSize:     1024,
+				AggregateID:   ptr.Of(model.CarID(1)),
+			}).Error
+			require.NoError(t, err)
+		}
+		err = db.Create(&model.Schedule{PreparationID: 1, State: model.ScheduleActive, Provider: "f0a"}).Error
+		require.NoError(t, err)
+
+		entries, err := Default.CalendarHandler(ctx, db, CalendarRequest{})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, 1, entries[0].DealNumber)
+		require.EqualValues(t, 4096, entries[0].DealSize)
+	})
+}
+
+func TestCalendarPeriod(t *testing.T) {
+	thursday := time.Date(2024, 6, 13, 15, 4, 5, 0, time.UTC)
+	sunday := time.Date(2024, 6, 16, 23, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2024, 6, 13, 0, 0, 0, 0, time.UTC), calendarPeriod(thursday, CalendarDay))
+	require.Equal(t, time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), calendarPeriod(thursday, CalendarWeek))
+	require.Equal(t, time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), calendarPeriod(sunday, CalendarWeek))
+}
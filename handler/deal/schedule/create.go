@@ -22,27 +22,33 @@ import (
 
 //nolint:lll
 type CreateRequest struct {
-	Preparation           string   `json:"preparation"           validation:"required"`  // Preparation ID or name
-	Provider              string   `json:"provider"              validation:"required"`  // Provider
-	HTTPHeaders           []string `json:"httpHeaders"`                                  // http headers to be passed with the request (i.e. key=value)
-	URLTemplate           string   `json:"urlTemplate"`                                  // URL template with PIECE_CID placeholder for boost to fetch the CAR file, i.e. http://127.0.0.1/piece/{PIECE_CID}.car
-	PricePerGBEpoch       float64  `default:"0"                  json:"pricePerGbEpoch"` // Price in FIL per GiB per epoch
-	PricePerGB            float64  `default:"0"                  json:"pricePerGb"`      // Price in FIL  per GiB
-	PricePerDeal          float64  `default:"0"                  json:"pricePerDeal"`    // Price in FIL per deal
-	Verified              bool     `default:"true"               json:"verified"`        // Whether the deal should be verified
-	IPNI                  bool     `default:"true"               json:"ipni"`            // Whether the deal should be IPNI
-	KeepUnsealed          bool     `default:"true"               json:"keepUnsealed"`    // Whether the deal should be kept unsealed
-	StartDelay            string   `default:"72h"                json:"startDelay"`      // Deal start delay in epoch or in duration format, i.e. 1000, 72h
-	Duration              string   `default:"12840h"             json:"duration"`        // Duration in epoch or in duration format, i.e. 1500000, 2400h
-	ScheduleCron          string   `json:"scheduleCron"`                                 // Schedule cron pattern
-	ScheduleCronPerpetual bool     `json:"scheduleCronPerpetual"`                        // Whether a cron schedule should run in definitely
-	ScheduleDealNumber    int      `json:"scheduleDealNumber"`                           // Number of deals per scheduled time
-	TotalDealNumber       int      `json:"totalDealNumber"`                              // Total number of deals
-	ScheduleDealSize      string   `json:"scheduleDealSize"`                             // Size of deals per schedule trigger in human readable format, i.e. 100 TiB
-	TotalDealSize         string   `json:"totalDealSize"`                                // Total size of deals in human readable format, i.e. 100 TiB
-	Notes                 string   `json:"notes"`                                        // Notes
-	MaxPendingDealSize    string   `json:"maxPendingDealSize"`                           // Max pending deal size in human readable format, i.e. 100 TiB
-	MaxPendingDealNumber  int      `json:"maxPendingDealNumber"`                         // Max pending deal number
+	Preparation           string   `json:"preparation"           validation:"required"`     // Preparation ID or name
+	Provider              string   `json:"provider"              validation:"required"`     // Provider
+	HTTPHeaders           []string `json:"httpHeaders"`                                     // http headers to be passed with the request (i.e. key=value)
+	URLTemplate           string   `json:"urlTemplate"`                                     // URL template with PIECE_CID placeholder for boost to fetch the CAR file, i.e. http://127.0.0.1/piece/{PIECE_CID}.car
+	PricePerGBEpoch       float64  `default:"0"                  json:"pricePerGbEpoch"`    // Price in FIL per GiB per epoch
+	PricePerGB            float64  `default:"0"                  json:"pricePerGb"`         // Price in FIL  per GiB
+	PricePerDeal          float64  `default:"0"                  json:"pricePerDeal"`       // Price in FIL per deal
+	Verified              bool     `default:"true"               json:"verified"`           // Whether the deal should be verified
+	IPNI                  bool     `default:"true"               json:"ipni"`               // Whether the deal should be IPNI
+	KeepUnsealed          bool     `default:"true"               json:"keepUnsealed"`       // Whether the deal should be kept unsealed
+	StartDelay            string   `default:"72h"                json:"startDelay"`         // Deal start delay in epoch or in duration format, i.e. 1000, 72h
+	Duration              string   `default:"12840h"             json:"duration"`           // Duration in epoch or in duration format, i.e. 1500000, 2400h
+	ScheduleCron          string   `json:"scheduleCron"`                                    // Schedule cron pattern
+	ScheduleCronPerpetual bool     `json:"scheduleCronPerpetual"`                           // Whether a cron schedule should run in definitely
+	ScheduleDealNumber    int      `json:"scheduleDealNumber"`                              // Number of deals per scheduled time
+	TotalDealNumber       int      `json:"totalDealNumber"`                                 // Total number of deals
+	ScheduleDealSize      string   `json:"scheduleDealSize"`                                // Size of deals per schedule trigger in human readable format, i.e. 100 TiB
+	TotalDealSize         string   `json:"totalDealSize"`                                   // Total size of deals in human readable format, i.e. 100 TiB
+	Notes                 string   `json:"notes"`                                           // Notes
+	MaxPendingDealSize    string   `json:"maxPendingDealSize"`                              // Max pending deal size in human readable format, i.e. 100 TiB
+	MaxPendingDealNumber  int      `json:"maxPendingDealNumber"`                            // Max pending deal number
+	HourlyDealNumber      int      `json:"hourlyDealNumber"`                                // Max number of deals proposed within any hour
+	HourlyDealSize        string   `json:"hourlyDealSize"`                                  // Max size of deals proposed within any hour in human readable format, i.e. 10 TiB
+	DailyDealNumber       int      `json:"dailyDealNumber"`                                 // Max number of deals proposed within any day
+	DailyDealSize         string   `json:"dailyDealSize"`                                   // Max size of deals proposed within any day in human readable format, i.e. 100 TiB
+	MaxRejectedRetries    int      `default:"3"                  json:"maxRejectedRetries"` // Number of times a piece rejected by the provider is proposed again
+	RejectedRetryDelay    string   `default:"1h"                 json:"rejectedRetryDelay"` // Delay before a piece rejected by the provider is proposed again, in duration format, i.e. 1h
 	//nolint:tagliatelle
 	AllowedPieceCIDs []string `json:"allowedPieceCids"` // Allowed piece CIDs in this schedule
 	Force            bool     `json:"force"`            // Force to send out deals regardless of replication restriction
@@ -60,6 +66,18 @@ func argToDuration(s string) (time.Duration, error) {
 	return time.Duration(epochs) * 30 * time.Second, nil
 }
 
+// parseRejectedRetryDelay parses the delay before a rejected piece is proposed again. An empty delay retries right away.
+func parseRejectedRetryDelay(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	delay, err := time.ParseDuration(s)
+	if err != nil || delay < 0 {
+		return 0, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid rejected retry delay %s", s)
+	}
+	return delay, nil
+}
+
 // CreateHandler creates a new schedule based on the provided CreateRequest.
 //
 // The function performs the following steps:
@@ -68,13 +86,15 @@ func argToDuration(s string) (time.Duration, error) {
 //  3. Parses the provided start delay and duration to ensure valid durations.
 //  4. If a ScheduleCron string is provided, it validates its correctness.
 //  5. Parses and validates the provided sizes: TotalDealSize, ScheduleDealSize,
-//     and MaxPendingDealSize.
+//     MaxPendingDealSize, HourlyDealSize and DailyDealSize, and the retries of rejected proposals.
 //  6. Verifies all provided piece CIDs in AllowedPieceCIDs to ensure their correctness.
 //  7. Checks for the presence of wallets attached to the preparation.
 //  8. Uses the lotusClient to retrieve the provider actor.
-//  9. Constructs a new model.Schedule instance from the provided and parsed data.
-//  10. Inserts the newly created schedule into the database.
-//  11. Returns the newly created schedule.
+//  9. Checks whether the schedule is above the approval threshold, in which case it is created pending the approval
+//     of a second operator.
+//  10. Constructs a new model.Schedule instance from the provided and parsed data.
+//  11. Inserts the newly created schedule into the database.
+//  12. Returns the newly created schedule.
 //
 // Parameters:
 //   - ctx: The context for the operation, used for timeouts and cancellation.
@@ -101,6 +121,12 @@ func (DefaultHandler) CreateHandler(
 	if request.MaxPendingDealSize == "" {
 		request.MaxPendingDealSize = "0"
 	}
+	if request.HourlyDealSize == "" {
+		request.HourlyDealSize = "0"
+	}
+	if request.DailyDealSize == "" {
+		request.DailyDealSize = "0"
+	}
 	var preparation model.Preparation
 	err := preparation.FindByIDOrName(db, request.Preparation, "Wallets")
 	if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -143,6 +169,24 @@ func (DefaultHandler) CreateHandler(
 	if err != nil {
 		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid max pending deal size %s", request.MaxPendingDealSize)
 	}
+	hourlyDealSize, err := humanize.ParseBytes(request.HourlyDealSize)
+	if err != nil {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid hourly deal size %s", request.HourlyDealSize)
+	}
+	dailyDealSize, err := humanize.ParseBytes(request.DailyDealSize)
+	if err != nil {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid daily deal size %s", request.DailyDealSize)
+	}
+	if request.HourlyDealNumber < 0 || request.DailyDealNumber < 0 {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "hourly and daily deal numbers cannot be negative")
+	}
+	if request.MaxRejectedRetries < 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid max rejected retries %d", request.MaxRejectedRetries)
+	}
+	rejectedRetryDelay, err := parseRejectedRetryDelay(request.RejectedRetryDelay)
+	if err != nil {
+		return nil, err
+	}
 	if scheduleCron != "" && scheduleDealSize == 0 && request.ScheduleDealNumber == 0 {
 		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "schedule deal number or size must be set when using cron schedule")
 	}
@@ -181,6 +225,15 @@ func (DefaultHandler) CreateHandler(
 		}
 	}
 
+	requestedBy, pending, err := checkApproval(ctx, db, int64(totalDealSize), request.TotalDealNumber, preparation.PieceSize)
+	if err != nil {
+		return nil, err
+	}
+	state := model.ScheduleActive
+	if pending {
+		state = model.SchedulePendingApproval
+	}
+
 	schedule := model.Schedule{
 		PreparationID:         preparation.ID,
 		URLTemplate:           request.URLTemplate,
@@ -193,11 +246,17 @@ func (DefaultHandler) CreateHandler(
 		AnnounceToIPNI:        request.IPNI,
 		StartDelay:            startDelay,
 		Duration:              duration,
-		State:                 model.ScheduleActive,
+		State:                 state,
 		ScheduleDealNumber:    request.ScheduleDealNumber,
 		ScheduleDealSize:      int64(scheduleDealSize),
 		MaxPendingDealNumber:  request.MaxPendingDealNumber,
 		MaxPendingDealSize:    int64(pendingDealSize),
+		HourlyDealNumber:      request.HourlyDealNumber,
+		HourlyDealSize:        int64(hourlyDealSize),
+		DailyDealNumber:       request.DailyDealNumber,
+		DailyDealSize:         int64(dailyDealSize),
+		MaxRejectedRetries:    request.MaxRejectedRetries,
+		RejectedRetryDelay:    rejectedRetryDelay,
 		Notes:                 request.Notes,
 		AllowedPieceCIDs:      underscore.Unique(request.AllowedPieceCIDs),
 		ScheduleCron:          scheduleCron,
@@ -206,6 +265,7 @@ func (DefaultHandler) CreateHandler(
 		PricePerDeal:          request.PricePerDeal,
 		ScheduleCronPerpetual: request.ScheduleCronPerpetual,
 		Force:                 request.Force,
+		RequestedBy:           requestedBy,
 	}
 
 	if err := database.DoRetry(ctx, func() error {
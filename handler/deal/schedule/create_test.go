@@ -3,6 +3,7 @@ package schedule
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/handler/handlererror"
@@ -71,6 +72,12 @@ var createRequest = CreateRequest{
 	Notes:                 "notes",
 	MaxPendingDealSize:    "10TiB",
 	MaxPendingDealNumber:  100,
+	HourlyDealNumber:      10,
+	HourlyDealSize:        "1TiB",
+	DailyDealNumber:       100,
+	DailyDealSize:         "10TiB",
+	MaxRejectedRetries:    3,
+	RejectedRetryDelay:    "1h",
 	AllowedPieceCIDs:      []string{"baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq"},
 	ScheduleCronPerpetual: true,
 	Force:                 true,
@@ -152,6 +159,30 @@ func TestCreateHandler_InvalidPendingDealSize(t *testing.T) {
 	})
 }
 
+func TestCreateHandler_InvalidHourlyDealSize(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{}).Error
+		require.NoError(t, err)
+		badRequest := createRequest
+		badRequest.HourlyDealSize = "One TB"
+		_, err = Default.CreateHandler(ctx, db, getMockLotusClient(), badRequest)
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid hourly deal size")
+	})
+}
+
+func TestCreateHandler_InvalidRejectedRetryDelay(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{}).Error
+		require.NoError(t, err)
+		badRequest := createRequest
+		badRequest.RejectedRetryDelay = "-1h"
+		_, err = Default.CreateHandler(ctx, db, getMockLotusClient(), badRequest)
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid rejected retry delay")
+	})
+}
+
 func TestCreateHandler_InvalidAllowedPieceCID_NotCID(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 		err := db.Create(&model.Preparation{}).Error
@@ -261,6 +292,12 @@ func TestCreateHandler_Success(t *testing.T) {
 				require.NoError(t, err)
 				require.NotNil(t, schedule)
 				require.True(t, createRequest.Force)
+				require.Equal(t, 10, schedule.HourlyDealNumber)
+				require.EqualValues(t, 1<<40, schedule.HourlyDealSize)
+				require.Equal(t, 100, schedule.DailyDealNumber)
+				require.EqualValues(t, 10<<40, schedule.DailyDealSize)
+				require.Equal(t, 3, schedule.MaxRejectedRetries)
+				require.Equal(t, time.Hour, schedule.RejectedRetryDelay)
 			})
 		})
 	}
@@ -3,7 +3,9 @@ package schedule
 
 import (
 	"context"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/stretchr/testify/mock"
 	"github.com/ybbus/jsonrpc/v3"
@@ -42,9 +44,50 @@ type Handler interface {
 		db *gorm.DB,
 		scheduleID uint32,
 	) (*model.Schedule, error)
+	ApproveHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		scheduleID uint32,
+	) (*model.Schedule, error)
+	CalendarHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		request CalendarRequest,
+	) ([]CalendarEntry, error)
+	SimulateHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		request SimulateRequest,
+	) ([]SimulatedProposal, error)
+	SetPolicyHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		lotusClient jsonrpc.RPCClient,
+		preparation string,
+		request SetPolicyRequest,
+	) (*model.ReplicationPolicy, error)
+	ListPoliciesHandler(
+		ctx context.Context,
+		db *gorm.DB,
+	) ([]model.ReplicationPolicy, error)
+	RemovePolicyHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		preparation string,
+	) error
 }
 
-type DefaultHandler struct{}
+type DefaultHandler struct {
+	Clock clock.Clock // Clock the simulations and projections start from, the wall clock if not set
+}
+
+// now returns the current time of the clock of the handler, in UTC.
+func (h DefaultHandler) now() time.Time {
+	if h.Clock == nil {
+		return time.Now().UTC()
+	}
+	return h.Clock.Now().UTC()
+}
 
 var Default Handler = &DefaultHandler{}
 
@@ -83,3 +126,33 @@ func (m *MockSchedule) ResumeHandler(ctx context.Context, db *gorm.DB, scheduleI
 	args := m.Called(ctx, db, scheduleID)
 	return args.Get(0).(*model.Schedule), args.Error(1)
 }
+
+func (m *MockSchedule) ApproveHandler(ctx context.Context, db *gorm.DB, scheduleID uint32) (*model.Schedule, error) {
+	args := m.Called(ctx, db, scheduleID)
+	return args.Get(0).(*model.Schedule), args.Error(1)
+}
+
+func (m *MockSchedule) CalendarHandler(ctx context.Context, db *gorm.DB, request CalendarRequest) ([]CalendarEntry, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).([]CalendarEntry), args.Error(1)
+}
+
+func (m *MockSchedule) SimulateHandler(ctx context.Context, db *gorm.DB, request SimulateRequest) ([]SimulatedProposal, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).([]SimulatedProposal), args.Error(1)
+}
+
+func (m *MockSchedule) SetPolicyHandler(ctx context.Context, db *gorm.DB, lotusClient jsonrpc.RPCClient, preparation string, request SetPolicyRequest) (*model.ReplicationPolicy, error) {
+	args := m.Called(ctx, db, lotusClient, preparation, request)
+	return args.Get(0).(*model.ReplicationPolicy), args.Error(1)
+}
+
+func (m *MockSchedule) ListPoliciesHandler(ctx context.Context, db *gorm.DB) ([]model.ReplicationPolicy, error) {
+	args := m.Called(ctx, db)
+	return args.Get(0).([]model.ReplicationPolicy), args.Error(1)
+}
+
+func (m *MockSchedule) RemovePolicyHandler(ctx context.Context, db *gorm.DB, preparation string) error {
+	args := m.Called(ctx, db, preparation)
+	return args.Error(0)
+}
@@ -0,0 +1,339 @@
+package schedule
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ybbus/jsonrpc/v3"
+	"gorm.io/gorm"
+)
+
+//nolint:lll
+type SetPolicyRequest struct {
+	Replicas         int      `json:"replicas"        validation:"required"`  // Number of replicas of each piece to keep, counting the deals proposed, published or active
+	MaxPerOrg        int      `json:"maxPerOrg"`                              // Maximum number of replicas of a piece with the providers of an organization, 0 for no limit
+	MinRegions       int      `json:"minRegions"`                             // Number of regions the replicas of a piece are spread across
+	Providers        []string `json:"providers"`                              // Storage providers to make the replicas with, as provider[:org[:region]], i.e. f01000:acme:eu-west
+	AutoSelect       int      `json:"autoSelect"`                             // Number of providers to also choose from the provider reputations by retrieval success rate and price
+	MinRetrievalRate float64  `json:"minRetrievalRate"`                       // Minimum retrieval success rate of the providers chosen from the provider reputations, between 0 and 1
+	Regions          []string `json:"regions"`                                // Regions of the providers chosen from the provider reputations, i.e. Europe. Any region if empty.
+	URLTemplate      string   `json:"urlTemplate"`                            // URL template with PIECE_CID placeholder for boost to fetch the CAR file, i.e. http://127.0.0.1/piece/{PIECE_CID}.car
+	PricePerGBEpoch  float64  `default:"0"            json:"pricePerGbEpoch"` // Price in FIL per GiB per epoch
+	PricePerGB       float64  `default:"0"            json:"pricePerGb"`      // Price in FIL  per GiB
+	PricePerDeal     float64  `default:"0"            json:"pricePerDeal"`    // Price in FIL per deal
+	Verified         bool     `default:"true"         json:"verified"`        // Whether the deals should be verified
+	IPNI             bool     `default:"true"         json:"ipni"`            // Whether the deals should be announced to IPNI
+	KeepUnsealed     bool     `default:"true"         json:"keepUnsealed"`    // Whether the deals should be kept unsealed
+	StartDelay       string   `default:"72h"          json:"startDelay"`      // Deal start delay in epoch or in duration format, i.e. 1000, 72h
+	Duration         string   `default:"12840h"       json:"duration"`        // Duration in epoch or in duration format, i.e. 1500000, 2400h
+}
+
+// parsePolicyProvider parses a provider of a replication policy given as provider[:org[:region]].
+func parsePolicyProvider(s string) (model.PolicyProvider, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 || parts[0] == "" {
+		return model.PolicyProvider{}, errors.Wrapf(handlererror.ErrInvalidParameter,
+			"invalid provider %s, must be provider[:org[:region]]", s)
+	}
+	provider := model.PolicyProvider{Provider: parts[0]}
+	if len(parts) > 1 {
+		provider.Org = parts[1]
+	}
+	if len(parts) > 2 {
+		provider.Region = parts[2]
+	}
+	return provider, nil
+}
+
+// SetPolicyHandler sets the replication policy of a preparation, i.e. keep 5 replicas of each piece, at most 2 with
+// the providers of an organization, across at least 3 regions. The deal pusher then chooses the providers of the
+// pieces that are missing replicas among the providers of the policy, and proposes the deals through a schedule of the
+// policy for each provider. Replicas that expire or are slashed are proposed again.
+//
+// With AutoSelect, the deal pusher also chooses from the best providers of the provider reputations that match the
+// minimum retrieval success rate, the regions and the price of the policy, so the providers can be left empty.
+//
+// Setting the policy of a preparation that already has one replaces it. The schedules of the policy are updated with
+// the new deal parameters, and the schedules of the providers no longer in the policy are paused, unless the policy
+// chooses providers from the provider reputations.
+//
+// Parameters:
+//   - ctx: The context for the operation, carrying the API key of the operator, if any.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - lotusClient: The Lotus client, used to resolve the providers.
+//   - preparation: The ID or name of the preparation.
+//   - request: The policy to set.
+//
+// Returns:
+//   - The replication policy of the preparation.
+//   - An error, if the preparation does not exist, has no wallet, or the policy is not valid.
+func (DefaultHandler) SetPolicyHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	lotusClient jsonrpc.RPCClient,
+	preparation string,
+	request SetPolicyRequest,
+) (*model.ReplicationPolicy, error) {
+	db = db.WithContext(ctx)
+	var prep model.Preparation
+	err := prep.FindByIDOrName(db, preparation, "Wallets")
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation %s not found", preparation)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(prep.Wallets) == 0 {
+		return nil, errors.Wrap(handlererror.ErrNotFound, "no wallet attached to preparation")
+	}
+
+	if request.Replicas <= 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid replicas %d, must be positive", request.Replicas)
+	}
+	if request.MaxPerOrg < 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid max per org %d", request.MaxPerOrg)
+	}
+	if request.MinRegions < 0 || request.MinRegions > request.Replicas {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter,
+			"invalid min regions %d, must be between 0 and the number of replicas", request.MinRegions)
+	}
+	if request.AutoSelect < 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid auto select %d", request.AutoSelect)
+	}
+	if request.MinRetrievalRate < 0 || request.MinRetrievalRate > 1 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter,
+			"invalid min retrieval rate %v, must be between 0 and 1", request.MinRetrievalRate)
+	}
+	startDelay, err := argToDuration(request.StartDelay)
+	if err != nil {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid start delay %s", request.StartDelay)
+	}
+	duration, err := argToDuration(request.Duration)
+	if err != nil {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid duration %s", request.Duration)
+	}
+
+	var providers model.PolicyProviders
+	orgs := make(map[string]int)
+	regions := make(map[string]struct{})
+	seen := make(map[string]struct{})
+	for _, s := range request.Providers {
+		provider, err := parsePolicyProvider(s)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[provider.Provider]; ok {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "duplicate provider %s", provider.Provider)
+		}
+		seen[provider.Provider] = struct{}{}
+		var providerActor string
+		err = lotusClient.CallFor(ctx, &providerActor, "Filecoin.StateLookupID", provider.Provider, nil)
+		if err != nil {
+			return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrapf(err, "provider %s cannot be resolved", provider.Provider))
+		}
+		providers = append(providers, provider)
+		org := provider.Org
+		if org == "" {
+			org = provider.Provider
+		}
+		orgs[org]++
+		if provider.Region != "" {
+			regions[provider.Region] = struct{}{}
+		}
+	}
+
+	// Check that the policy can be satisfied by its providers, so it does not silently fall short. The providers chosen
+	// from the provider reputations are their own organization, and their regions are only known once they are chosen.
+	capacity := request.AutoSelect
+	for _, count := range orgs {
+		if request.MaxPerOrg > 0 && count > request.MaxPerOrg {
+			count = request.MaxPerOrg
+		}
+		capacity += count
+	}
+	if capacity < request.Replicas {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter,
+			"the providers can only hold %d replicas of each piece with at most %d per org", capacity, request.MaxPerOrg)
+	}
+	if request.AutoSelect == 0 && len(regions) < request.MinRegions {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter,
+			"the providers are in %d regions, fewer than the min regions %d", len(regions), request.MinRegions)
+	}
+
+	// A policy keeps making deals with no limit, so it needs the approval of a second operator above the threshold
+	requestedBy, needsApproval, err := checkApproval(ctx, db, 0, 0, prep.PieceSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy model.ReplicationPolicy
+	err = db.Where("preparation_id = ?", prep.ID).Limit(1).Find(&policy).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	policy.PreparationID = prep.ID
+	policy.Replicas = request.Replicas
+	policy.MaxPerOrg = request.MaxPerOrg
+	policy.MinRegions = request.MinRegions
+	policy.Providers = providers
+	policy.AutoSelect = request.AutoSelect
+	policy.MinRetrievalRate = request.MinRetrievalRate
+	policy.Regions = request.Regions
+	policy.URLTemplate = request.URLTemplate
+	policy.PricePerGBEpoch = request.PricePerGBEpoch
+	policy.PricePerGB = request.PricePerGB
+	policy.PricePerDeal = request.PricePerDeal
+	policy.Verified = request.Verified
+	policy.KeepUnsealed = request.KeepUnsealed
+	policy.AnnounceToIPNI = request.IPNI
+	policy.StartDelay = startDelay
+	policy.Duration = duration
+	policy.RequestedBy = requestedBy
+	policy.NeedsApproval = needsApproval
+
+	providerIDs := make([]string, 0, len(providers))
+	for _, provider := range providers {
+		providerIDs = append(providerIDs, provider.Provider)
+	}
+	err = database.DoRetry(ctx, func() error {
+		return db.Transaction(func(db *gorm.DB) error {
+			err := db.Save(&policy).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			err = db.Model(&model.Schedule{}).Where("policy_id = ?", policy.ID).Updates(map[string]any{
+				"url_template":       policy.URLTemplate,
+				"price_per_gb_epoch": policy.PricePerGBEpoch,
+				"price_per_gb":       policy.PricePerGB,
+				"price_per_deal":     policy.PricePerDeal,
+				"verified":           policy.Verified,
+				"keep_unsealed":      policy.KeepUnsealed,
+				"announce_to_ipni":   policy.AnnounceToIPNI,
+				"start_delay":        policy.StartDelay,
+				"duration":           policy.Duration,
+			}).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			// The providers chosen from the provider reputations are not in the policy, so their schedules are kept
+			if policy.AutoSelect > 0 {
+				return nil
+			}
+			return db.Model(&model.Schedule{}).
+				Where("policy_id = ? AND provider NOT IN ? AND state = ?", policy.ID, providerIDs, model.ScheduleActive).
+				Update("state", model.SchedulePaused).Error
+		})
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &policy, nil
+}
+
+// @ID SetReplicationPolicy
+// @Summary Set the replication policy of a preparation
+// @Tags Deal Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Param request body SetPolicyRequest true "SetPolicyRequest"
+// @Success 200 {object} model.ReplicationPolicy
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/policy [put]
+func _() {}
+
+// ListPoliciesHandler lists the replication policies of all preparations.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//
+// Returns:
+//   - All replication policies.
+//   - An error, if the query fails.
+func (DefaultHandler) ListPoliciesHandler(
+	ctx context.Context,
+	db *gorm.DB,
+) ([]model.ReplicationPolicy, error) {
+	db = db.WithContext(ctx)
+	var policies []model.ReplicationPolicy
+	err := db.Find(&policies).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return policies, nil
+}
+
+// @ID ListReplicationPolicies
+// @Summary List the replication policies of all preparations
+// @Tags Deal Schedule
+// @Produce json
+// @Success 200 {array} model.ReplicationPolicy
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /policy [get]
+func _() {}
+
+// RemovePolicyHandler removes the replication policy of a preparation. The active schedules of the policy are paused,
+// and the deals they made remain for tracking purpose.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - preparation: The ID or name of the preparation.
+//
+// Returns:
+//   - An error, if the preparation or its policy does not exist.
+func (DefaultHandler) RemovePolicyHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	preparation string,
+) error {
+	db = db.WithContext(ctx)
+	var prep model.Preparation
+	err := prep.FindByIDOrName(db, preparation)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.Wrapf(handlererror.ErrNotFound, "preparation %s not found", preparation)
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var policy model.ReplicationPolicy
+	err = db.Where("preparation_id = ?", prep.ID).First(&policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.Wrapf(handlererror.ErrNotFound, "preparation %s has no replication policy", preparation)
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = database.DoRetry(ctx, func() error {
+		return db.Transaction(func(db *gorm.DB) error {
+			err := db.Model(&model.Schedule{}).Where("policy_id = ? AND state = ?", policy.ID, model.ScheduleActive).
+				Update("state", model.SchedulePaused).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return db.Delete(&policy).Error
+		})
+	})
+	return errors.WithStack(err)
+}
+
+// @ID RemoveReplicationPolicy
+// @Summary Remove the replication policy of a preparation
+// @Tags Deal Schedule
+// @Param id path string true "Preparation ID or name"
+// @Success 204
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/policy [delete]
+func _() {}
@@ -0,0 +1,149 @@
+package schedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/operator"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var setPolicyRequest = SetPolicyRequest{
+	Replicas:     3,
+	MaxPerOrg:    1,
+	MinRegions:   2,
+	Providers:    []string{"f01000:acme:eu-west", "f01001:acme:us-east", "f02000:globex:eu-west", "f03000::us-east"},
+	Verified:     true,
+	IPNI:         true,
+	KeepUnsealed: true,
+	StartDelay:   "24h",
+	Duration:     "2400h",
+}
+
+func TestSetPolicyHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.SetPolicyHandler(ctx, db, getMockLotusClient(), "prep", setPolicyRequest)
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		require.NoError(t, db.Create(&model.Preparation{Name: "prep", Wallets: []model.Wallet{{ID: "f01"}}}).Error)
+
+		t.Run("invalid", func(t *testing.T) {
+			for _, tc := range []struct {
+				modify func(request *SetPolicyRequest)
+				err    string
+			}{
+				{func(request *SetPolicyRequest) { request.Replicas = 0 }, "invalid replicas"},
+				{func(request *SetPolicyRequest) { request.MinRegions = 4 }, "invalid min regions"},
+				{func(request *SetPolicyRequest) { request.StartDelay = "1year" }, "invalid start delay"},
+				{func(request *SetPolicyRequest) { request.Providers = []string{"f01000:a:b:c"} }, "invalid provider"},
+				{func(request *SetPolicyRequest) { request.Providers = []string{"f01000", "f01000"} }, "duplicate provider"},
+				{func(request *SetPolicyRequest) { request.Replicas = 4 }, "can only hold 3 replicas"},
+				{func(request *SetPolicyRequest) { request.Providers = request.Providers[:3] }, "can only hold 2 replicas"},
+				{func(request *SetPolicyRequest) { request.MinRegions = 3 }, "fewer than the min regions 3"},
+				{func(request *SetPolicyRequest) { request.AutoSelect = -1 }, "invalid auto select"},
+				{func(request *SetPolicyRequest) { request.MinRetrievalRate = 1.5 }, "invalid min retrieval rate"},
+				{func(request *SetPolicyRequest) { request.Providers = nil }, "can only hold 0 replicas"},
+			} {
+				request := setPolicyRequest
+				tc.modify(&request)
+				_, err := Default.SetPolicyHandler(ctx, db, getMockLotusClient(), "prep", request)
+				require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+
+		policy, err := Default.SetPolicyHandler(ctx, db, getMockLotusClient(), "prep", setPolicyRequest)
+		require.NoError(t, err)
+		require.Equal(t, 3, policy.Replicas)
+		require.Equal(t, model.PolicyProviders{
+			{Provider: "f01000", Org: "acme", Region: "eu-west"},
+			{Provider: "f01001", Org: "acme", Region: "us-east"},
+			{Provider: "f02000", Org: "globex", Region: "eu-west"},
+			{Provider: "f03000", Region: "us-east"},
+		}, policy.Providers)
+		require.Equal(t, 24*time.Hour, policy.StartDelay)
+		require.False(t, policy.NeedsApproval)
+
+		// Setting the policy again replaces it, updates its schedules and pauses those of the removed providers
+		require.NoError(t, db.Create(&[]model.Schedule{
+			{PreparationID: policy.PreparationID, PolicyID: &policy.ID, Provider: "f01000", State: model.ScheduleActive},
+			{PreparationID: policy.PreparationID, PolicyID: &policy.ID, Provider: "f03000", State: model.ScheduleActive},
+		}).Error)
+		request := setPolicyRequest
+		request.Providers = request.Providers[:3]
+		request.Replicas = 2
+		request.PricePerDeal = 0.1
+		updated, err := Default.SetPolicyHandler(ctx, db, getMockLotusClient(), "1", request)
+		require.NoError(t, err)
+		require.Equal(t, policy.ID, updated.ID)
+		require.Equal(t, 2, updated.Replicas)
+		var schedules []model.Schedule
+		require.NoError(t, db.Order("provider").Find(&schedules).Error)
+		require.Len(t, schedules, 2)
+		require.Equal(t, model.ScheduleActive, schedules[0].State)
+		require.Equal(t, model.SchedulePaused, schedules[1].State)
+		require.Equal(t, 0.1, schedules[0].PricePerDeal)
+
+		// Providers chosen from the provider reputations can replace the list, and their schedules are kept
+		request = setPolicyRequest
+		request.Providers = nil
+		request.MinRegions = 3
+		request.AutoSelect = 5
+		request.MinRetrievalRate = 0.9
+		request.Regions = []string{"Europe"}
+		updated, err = Default.SetPolicyHandler(ctx, db, getMockLotusClient(), "prep", request)
+		require.NoError(t, err)
+		require.Empty(t, updated.Providers)
+		require.Equal(t, 5, updated.AutoSelect)
+		require.Equal(t, model.StringSlice{"Europe"}, updated.Regions)
+		require.NoError(t, db.Order("provider").Find(&schedules).Error)
+		require.Equal(t, model.ScheduleActive, schedules[0].State)
+
+		policies, err := Default.ListPoliciesHandler(ctx, db)
+		require.NoError(t, err)
+		require.Len(t, policies, 1)
+	})
+}
+
+func TestSetPolicyHandler_Approval(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		require.NoError(t, db.Create(&model.Preparation{Name: "prep", PieceSize: 1 << 35, Wallets: []model.Wallet{{ID: "f01"}}}).Error)
+		createOperators(t, db, "alice")
+		require.NoError(t, model.SetApprovalThreshold(db, 1<<50))
+
+		_, err := Default.SetPolicyHandler(ctx, db, getMockLotusClient(), "prep", setPolicyRequest)
+		require.ErrorIs(t, err, handlererror.ErrUnauthorized)
+
+		policy, err := Default.SetPolicyHandler(operator.WithKey(ctx, "alice-key"), db, getMockLotusClient(), "prep", setPolicyRequest)
+		require.NoError(t, err)
+		require.True(t, policy.NeedsApproval)
+		require.Equal(t, "alice", policy.RequestedBy)
+	})
+}
+
+func TestRemovePolicyHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		require.NoError(t, db.Create(&model.Preparation{Name: "prep", Wallets: []model.Wallet{{ID: "f01"}}}).Error)
+		err := Default.RemovePolicyHandler(ctx, db, "prep")
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		policy, err := Default.SetPolicyHandler(ctx, db, getMockLotusClient(), "prep", setPolicyRequest)
+		require.NoError(t, err)
+		schedule := model.Schedule{PreparationID: policy.PreparationID, PolicyID: &policy.ID, Provider: "f01000", State: model.ScheduleActive}
+		require.NoError(t, db.Create(&schedule).Error)
+
+		err = Default.RemovePolicyHandler(ctx, db, "prep")
+		require.NoError(t, err)
+		var count int64
+		require.NoError(t, db.Model(&model.ReplicationPolicy{}).Count(&count).Error)
+		require.Zero(t, count)
+		require.NoError(t, db.First(&schedule, schedule.ID).Error)
+		require.Equal(t, model.SchedulePaused, schedule.State)
+		require.Nil(t, schedule.PolicyID)
+	})
+}
@@ -12,7 +12,7 @@ import (
 )
 
 var removableStates = []model.ScheduleState{
-	model.ScheduleError, model.ScheduleCompleted, model.SchedulePaused,
+	model.ScheduleError, model.ScheduleCompleted, model.SchedulePaused, model.SchedulePendingApproval,
 }
 
 func (DefaultHandler) RemoveHandler(
@@ -0,0 +1,404 @@
+package schedule
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/service/dealpusher"
+	"github.com/data-preservation-programs/singularity/service/dealtracker"
+	"github.com/data-preservation-programs/singularity/service/epochutil"
+	"github.com/gotidy/ptr"
+	"github.com/rjNemo/underscore"
+	"github.com/robfig/cron/v3"
+	"gorm.io/gorm"
+)
+
+type SimulateRequest struct {
+	Horizon     string   `default:"720h" json:"horizon"` // How far ahead to simulate the deal proposals, i.e. 720h for 30 days
+	Days        int      `json:"days"`                   // Number of days to simulate the expirations and renewals of the deals for, instead of the horizon
+	Provider    string   `json:"provider"`               // Only include the schedules of this storage provider, if set
+	ScheduleIDs []uint32 `json:"scheduleIds"`            // Schedules to simulate in any state, i.e. paused or pending approval. All active schedules are simulated if not set
+}
+
+const (
+	SimulatedPropose = "propose" // A deal is proposed
+	SimulatedRenew   = "renew"   // A deal is proposed for a piece whose replica expired
+	SimulatedExpire  = "expire"  // An active deal expires
+)
+
+type SimulatedProposal struct {
+	Time       time.Time        `json:"time"       table:"format:2006-01-02 15:04:05"` // When the deal would be proposed or expire, in UTC
+	Event      string           `json:"event"`                                         // What happens to the deal: propose, renew or expire
+	ScheduleID model.ScheduleID `json:"scheduleId"`
+	Provider   string           `json:"provider"`
+	PieceCID   model.CID        `json:"pieceCid"   swaggertype:"string"`
+	PieceSize  int64            `json:"pieceSize"`
+	Verified   bool             `json:"verified"`
+}
+
+type simulatedDeal struct {
+	time time.Time
+	size int64
+}
+
+// slidingWindow holds the deals proposed within the last hour or day of a schedule, and the limits on their number
+// and size.
+type slidingWindow struct {
+	length     time.Duration
+	dealNumber int
+	dealSize   int64
+	deals      []simulatedDeal
+	size       int64
+}
+
+func (w *slidingWindow) add(deal simulatedDeal) {
+	w.deals = append(w.deals, deal)
+	w.size += deal.size
+}
+
+// next returns the earliest time from t on at which the limits of the window allow another proposal, which is once
+// enough of the older proposals have left the window.
+func (w *slidingWindow) next(t time.Time) time.Time {
+	for {
+		for len(w.deals) > 0 && !w.deals[0].time.After(t.Add(-w.length)) {
+			w.size -= w.deals[0].size
+			w.deals = w.deals[1:]
+		}
+		if (w.dealNumber <= 0 || len(w.deals) < w.dealNumber) && (w.dealSize <= 0 || w.size < w.dealSize) {
+			return t
+		}
+		t = w.deals[0].time.Add(w.length)
+	}
+}
+
+// SimulateHandler runs the selection and pacing of the deal schedules against the current state of the database
+// without sending any proposal, and returns which pieces would be proposed to which storage provider and when. It is
+// meant to validate the policy of a schedule before it is enabled, so schedules can be selected by ID in any state.
+//
+// Each schedule picks the pieces of its backlog in the order the deal pusher picks them. A schedule without cron
+// proposes right away, and a cron schedule proposes up to its schedule deal number and size at each run of the cron.
+// The hourly and daily limits are applied over sliding windows that start with the deals the schedule proposed within
+// the last hour and day, and delay the next proposal until older proposals leave the window. A schedule stops once its
+// backlog or its total deal number or size is exhausted, or once its max pending deal number or size is reached, since
+// the simulation cannot tell when pending deals will be published. A piece in the backlog of several schedules for the
+// same provider is only proposed by the schedule with the lowest ID.
+//
+// With Days set, the horizon is ignored and the simulation steps through the given number of days instead, so the
+// renewals and expirations can be checked before the deals actually age. Each day, the deals that ended are expired
+// as the deal tracker does, the replication policies are applied as the deal pusher does, and the schedules propose
+// the deals of the day. Pending deals and simulated proposals are assumed to be accepted and activated right away, and
+// schedules whose preparation has no wallet are left out since no deal can be made for them. The changes are made in
+// a transaction that is always rolled back, so the database is left untouched.
+//
+// The budgets, the provider reputation and the retries of rejected proposals are not simulated, and pieces packed
+// later are not known yet.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The SimulateRequest with the horizon, the number of days, the provider and the schedule IDs.
+//
+// Returns:
+//   - A slice of SimulatedProposal, sorted by time.
+//   - An error, if any occurred during the operation.
+func (h DefaultHandler) SimulateHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	request SimulateRequest,
+) ([]SimulatedProposal, error) {
+	db = db.WithContext(ctx)
+	horizon := DefaultCalendarHorizon
+	if request.Horizon != "" {
+		var err error
+		horizon, err = time.ParseDuration(request.Horizon)
+		if err != nil || horizon <= 0 {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid horizon %s", request.Horizon)
+		}
+	}
+	if request.Days < 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid number of days %d", request.Days)
+	}
+
+	findSchedules := func(db *gorm.DB) ([]model.Schedule, error) {
+		query := db.Where("state = ?", model.ScheduleActive)
+		if len(request.ScheduleIDs) > 0 {
+			query = db.Where("id IN ?", request.ScheduleIDs)
+		}
+		if request.Provider != "" {
+			query = query.Where("provider = ?", request.Provider)
+		}
+		var schedules []model.Schedule
+		err := query.Preload("Preparation.Wallets").Order("id").Find(&schedules).Error
+		return schedules, errors.WithStack(err)
+	}
+	schedules, err := findSchedules(db)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.ScheduleIDs) > 0 && request.Provider == "" && len(schedules) < len(underscore.Unique(request.ScheduleIDs)) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "schedules %v not found", request.ScheduleIDs)
+	}
+
+	now := h.now()
+	var proposals []SimulatedProposal
+	if request.Days > 0 {
+		proposals, err = simulateDays(ctx, db, request.Days, now, findSchedules)
+	} else {
+		proposals, err = simulateProposals(db, schedules, now, now.Add(horizon))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(proposals, func(i, j int) bool {
+		return proposals[i].Time.Before(proposals[j].Time)
+	})
+	return proposals, nil
+}
+
+// errSimulationDone rolls back the transaction the days are simulated in.
+var errSimulationDone = errors.New("simulation done")
+
+// simulateDays steps through a number of days from now, expiring the deals that ended, applying the replication
+// policies and proposing the deals of the schedules day by day. The proposals are saved as active deals so they count
+// as replicas and expire in turn. A proposal of a piece whose replica expired earlier in the simulation is a renewal.
+// All changes are rolled back.
+func simulateDays(
+	ctx context.Context,
+	db *gorm.DB,
+	days int,
+	now time.Time,
+	findSchedules func(db *gorm.DB) ([]model.Schedule, error),
+) ([]SimulatedProposal, error) {
+	proposals := make([]SimulatedProposal, 0)
+	err := db.Transaction(func(db *gorm.DB) error {
+		err := db.Model(&model.Deal{}).
+			Where("state IN ?", []model.DealState{model.DealProposed, model.DealPublished}).
+			Update("state", model.DealActive).Error
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		var policies []model.ReplicationPolicy
+		err = db.Find(&policies).Error
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		// expired counts the replicas of each piece that expired and are yet to be renewed
+		expired := make(map[string]int)
+		for day := 0; day < days; day++ {
+			t := now.Add(time.Duration(day) * 24 * time.Hour)
+			expiredDeals, err := dealtracker.ExpireDeals(ctx, db, int32(epochutil.TimeToEpoch(t)))
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			for _, deal := range expiredDeals {
+				expired[deal.PieceCID.String()]++
+				proposal := SimulatedProposal{
+					Time:      t,
+					Event:     SimulatedExpire,
+					Provider:  deal.Provider,
+					PieceCID:  deal.PieceCID,
+					PieceSize: deal.PieceSize,
+					Verified:  deal.Verified,
+				}
+				if deal.ScheduleID != nil {
+					proposal.ScheduleID = *deal.ScheduleID
+				}
+				proposals = append(proposals, proposal)
+			}
+
+			for _, policy := range policies {
+				_, err = dealpusher.ApplyPolicy(ctx, db, policy)
+				if err != nil {
+					return errors.Wrapf(err, "failed to apply replication policy of preparation %d", policy.PreparationID)
+				}
+			}
+
+			schedules, err := findSchedules(db)
+			if err != nil {
+				return err
+			}
+			schedules = underscore.Filter(schedules, func(schedule model.Schedule) bool {
+				return schedule.Preparation != nil && len(schedule.Preparation.Wallets) > 0
+			})
+			scheduleMap := make(map[model.ScheduleID]model.Schedule, len(schedules))
+			for _, schedule := range schedules {
+				scheduleMap[schedule.ID] = schedule
+			}
+			dayProposals, err := simulateProposals(db, schedules, t, t.Add(24*time.Hour))
+			if err != nil {
+				return err
+			}
+			deals := make([]model.Deal, 0, len(dayProposals))
+			for i, proposal := range dayProposals {
+				pieceCID := proposal.PieceCID.String()
+				if expired[pieceCID] > 0 {
+					expired[pieceCID]--
+					dayProposals[i].Event = SimulatedRenew
+				}
+				schedule := scheduleMap[proposal.ScheduleID]
+				start := proposal.Time.Add(schedule.StartDelay)
+				deals = append(deals, model.Deal{
+					CreatedAt:  proposal.Time,
+					State:      model.DealActive,
+					ClientID:   schedule.Preparation.Wallets[0].ID,
+					Provider:   proposal.Provider,
+					PieceCID:   proposal.PieceCID,
+					PieceSize:  proposal.PieceSize,
+					StartEpoch: int32(epochutil.TimeToEpoch(start)),
+					EndEpoch:   int32(epochutil.TimeToEpoch(start.Add(schedule.Duration))),
+					Verified:   proposal.Verified,
+					ScheduleID: ptr.Of(proposal.ScheduleID),
+				})
+			}
+			if len(deals) > 0 {
+				err = db.Create(&deals).Error
+				if err != nil {
+					return errors.WithStack(err)
+				}
+			}
+			proposals = append(proposals, dayProposals...)
+		}
+		return errSimulationDone
+	})
+	if !errors.Is(err, errSimulationDone) {
+		return nil, err
+	}
+	return proposals, nil
+}
+
+// simulateProposals simulates the proposals of the schedules from now until a time, against the deals in the database.
+// The proposals are sorted by schedule.
+func simulateProposals(db *gorm.DB, schedules []model.Schedule, now time.Time, until time.Time) ([]SimulatedProposal, error) {
+	cronParser := cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	claimed := make(map[string]map[string]struct{})
+	proposals := make([]SimulatedProposal, 0)
+	for _, schedule := range schedules {
+		// nextRun returns the next time the schedule proposes deals, or the zero time if it does not.
+		nextRun := func(time.Time) time.Time { return time.Time{} }
+		run := now
+		if schedule.ScheduleCron != "" {
+			cronSchedule, err := cronParser.Parse(schedule.ScheduleCron)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid schedule cron %s of schedule %d", schedule.ScheduleCron, schedule.ID)
+			}
+			nextRun = cronSchedule.Next
+			run = nextRun(now)
+		}
+		if run.IsZero() || run.After(until) {
+			continue
+		}
+
+		backlog, err := scheduleBacklog(db, schedule)
+		if err != nil {
+			return nil, err
+		}
+		if claimed[schedule.Provider] == nil {
+			claimed[schedule.Provider] = make(map[string]struct{})
+		}
+		backlog = underscore.Filter(backlog, func(piece calendarPiece) bool {
+			_, ok := claimed[schedule.Provider][piece.PieceCID.String()]
+			return !ok
+		})
+
+		var total, pending sumResult
+		err = db.Model(&model.Deal{}).
+			Where("schedule_id = ? AND state IN (?)", schedule.ID, []model.DealState{
+				model.DealActive, model.DealProposed, model.DealPublished,
+			}).Select("COUNT(*) AS deal_number, SUM(piece_size) AS deal_size").Scan(&total).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		err = db.Model(&model.Deal{}).
+			Where("schedule_id = ? AND state IN (?)", schedule.ID, []model.DealState{
+				model.DealProposed, model.DealPublished,
+			}).Select("COUNT(*) AS deal_number, SUM(piece_size) AS deal_size").Scan(&pending).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		var recent []model.Deal
+		err = db.Select("created_at", "piece_size").
+			Where("schedule_id = ? AND state <> ? AND created_at >= ?", schedule.ID, model.DealRejected, now.Add(-24*time.Hour)).
+			Order("created_at").Find(&recent).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		hourly := &slidingWindow{length: time.Hour, dealNumber: schedule.HourlyDealNumber, dealSize: schedule.HourlyDealSize}
+		daily := &slidingWindow{length: 24 * time.Hour, dealNumber: schedule.DailyDealNumber, dealSize: schedule.DailyDealSize}
+		for _, deal := range recent {
+			hourly.add(simulatedDeal{time: deal.CreatedAt.UTC(), size: deal.PieceSize})
+			daily.add(simulatedDeal{time: deal.CreatedAt.UTC(), size: deal.PieceSize})
+		}
+
+		next := 0
+		exhausted := false
+		for !exhausted && !run.IsZero() && !run.After(until) {
+			t := run
+			var current sumResult
+			for {
+				if next >= len(backlog) ||
+					schedule.TotalDealNumber > 0 && total.DealNumber >= schedule.TotalDealNumber ||
+					schedule.TotalDealSize > 0 && total.DealSize >= schedule.TotalDealSize ||
+					schedule.MaxPendingDealNumber > 0 && pending.DealNumber >= schedule.MaxPendingDealNumber ||
+					schedule.MaxPendingDealSize > 0 && pending.DealSize >= schedule.MaxPendingDealSize {
+					exhausted = true
+					break
+				}
+				if schedule.ScheduleCron != "" && schedule.ScheduleDealNumber > 0 && current.DealNumber >= schedule.ScheduleDealNumber {
+					break
+				}
+				if schedule.ScheduleCron != "" && schedule.ScheduleDealSize > 0 && current.DealSize >= schedule.ScheduleDealSize {
+					break
+				}
+				// The windows only free up as time passes, so waiting for one of them does not fill up the other
+				t = daily.next(hourly.next(t))
+				if t.After(until) {
+					exhausted = true
+					break
+				}
+				piece := backlog[next]
+				next++
+				claimed[schedule.Provider][piece.PieceCID.String()] = struct{}{}
+				proposals = append(proposals, SimulatedProposal{
+					Time:       t,
+					Event:      SimulatedPropose,
+					ScheduleID: schedule.ID,
+					Provider:   schedule.Provider,
+					PieceCID:   piece.PieceCID,
+					PieceSize:  piece.PieceSize,
+					Verified:   schedule.Verified,
+				})
+				hourly.add(simulatedDeal{time: t, size: piece.PieceSize})
+				daily.add(simulatedDeal{time: t, size: piece.PieceSize})
+				current.DealNumber++
+				current.DealSize += piece.PieceSize
+				total.DealNumber++
+				total.DealSize += piece.PieceSize
+				pending.DealNumber++
+				pending.DealSize += piece.PieceSize
+			}
+			// The next run of the cron starts once the batch is done
+			run = nextRun(t)
+		}
+	}
+
+	return proposals, nil
+}
+
+// @ID ScheduleSimulate
+// @Summary Simulate which pieces the schedules would propose to which providers and when, without sending proposals
+// @Tags Deal Schedule
+// @Accept json
+// @Produce json
+// @Param request body SimulateRequest true "Request body"
+// @Success 200 {array} SimulatedProposal
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /schedule/simulate [post]
+func _() {}
@@ -0,0 +1,174 @@
+package schedule
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/service/epochutil"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestSimulateHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			SourceStorages: []model.Storage{{}},
+			Wallets: []model.Wallet{{
+				ID: "f01",
+			}},
+		}).Error
+		require.NoError(t, err)
+		var pieceCIDs []model.CID
+		for i := 0; i < 5; i++ {
+			pieceCID := model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte(strconv.Itoa(i)))))
+			pieceCIDs = append(pieceCIDs, pieceCID)
+			err = db.Create(&model.Car{
+				AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+				PreparationID: 1,
+				PieceCID:      pieceCID,
+				PieceSize:     1024,
+			}).Error
+			require.NoError(t, err)
+		}
+
+		err = db.Create([]model.Schedule{
+			{PreparationID: 1, State: model.ScheduleActive, Provider: "f0a", HourlyDealNumber: 2, Verified: true},
+			{PreparationID: 1, State: model.SchedulePaused, Provider: "f0b", DailyDealSize: 2048},
+			{PreparationID: 1, State: model.SchedulePendingApproval, Provider: "f0c", MaxPendingDealNumber: 3},
+		}).Error
+		require.NoError(t, err)
+		// A deal proposed half an hour ago counts towards the hourly limit
+		proposedAt := time.Now().UTC().Add(-30 * time.Minute).Truncate(time.Second)
+		err = db.Create(&model.Deal{
+			CreatedAt:  proposedAt,
+			ClientID:   "f01",
+			Provider:   "f0a",
+			PieceCID:   pieceCIDs[0],
+			PieceSize:  1024,
+			State:      model.DealProposed,
+			ScheduleID: ptr.Of(model.ScheduleID(1)),
+		}).Error
+		require.NoError(t, err)
+
+		before := time.Now().UTC()
+		proposals, err := Default.SimulateHandler(ctx, db, SimulateRequest{})
+		require.NoError(t, err)
+		require.Len(t, proposals, 4)
+		for i, proposal := range proposals {
+			require.EqualValues(t, 1, proposal.ScheduleID)
+			require.Equal(t, "f0a", proposal.Provider)
+			require.Equal(t, pieceCIDs[i+1], proposal.PieceCID)
+			require.True(t, proposal.Verified)
+		}
+		require.False(t, proposals[0].Time.Before(before))
+		require.True(t, proposals[1].Time.Equal(proposedAt.Add(time.Hour)))
+		require.True(t, proposals[2].Time.Equal(proposals[0].Time.Add(time.Hour)))
+		require.True(t, proposals[3].Time.Equal(proposals[1].Time.Add(time.Hour)))
+
+		// A horizon shorter than the wait leaves out the delayed proposals
+		proposals, err = Default.SimulateHandler(ctx, db, SimulateRequest{Horizon: "10m"})
+		require.NoError(t, err)
+		require.Len(t, proposals, 1)
+
+		// Schedules that are not active can be simulated by ID
+		proposals, err = Default.SimulateHandler(ctx, db, SimulateRequest{ScheduleIDs: []uint32{2, 3}, Horizon: "72h"})
+		require.NoError(t, err)
+		var f0b, f0c []SimulatedProposal
+		for _, proposal := range proposals {
+			switch proposal.Provider {
+			case "f0b":
+				f0b = append(f0b, proposal)
+			case "f0c":
+				f0c = append(f0c, proposal)
+			}
+		}
+		require.Len(t, f0b, 5)
+		require.True(t, f0b[2].Time.Equal(f0b[0].Time.Add(24*time.Hour)))
+		require.True(t, f0b[4].Time.Equal(f0b[0].Time.Add(48*time.Hour)))
+		require.Len(t, f0c, 3)
+
+		_, err = Default.SimulateHandler(ctx, db, SimulateRequest{ScheduleIDs: []uint32{4}})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+		_, err = Default.SimulateHandler(ctx, db, SimulateRequest{Horizon: "-1h"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+}
+
+func TestSimulateHandler_Days(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			SourceStorages: []model.Storage{{}},
+			Wallets: []model.Wallet{{
+				ID: "f01",
+			}},
+		}).Error
+		require.NoError(t, err)
+		pieceCID := model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("piece"))))
+		err = db.Create(&model.Car{
+			AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+			PreparationID: 1,
+			PieceCID:      pieceCID,
+			PieceSize:     1024,
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.ReplicationPolicy{
+			PreparationID: 1,
+			Replicas:      1,
+			Providers:     model.PolicyProviders{{Provider: "f0x"}},
+			Duration:      72 * time.Hour,
+		}).Error
+		require.NoError(t, err)
+
+		mockClock := clock.NewMock()
+		mockClock.Set(time.Now().UTC().Truncate(time.Hour))
+		now := mockClock.Now().UTC()
+		// The only replica of the piece ends in a day and a half
+		err = db.Create(&model.Deal{
+			ClientID:  "f01",
+			Provider:  "f0x",
+			PieceCID:  pieceCID,
+			PieceSize: 1024,
+			State:     model.DealActive,
+			EndEpoch:  int32(epochutil.TimeToEpoch(now.Add(36 * time.Hour))),
+		}).Error
+		require.NoError(t, err)
+
+		handler := DefaultHandler{Clock: mockClock}
+		proposals, err := handler.SimulateHandler(ctx, db, SimulateRequest{Days: 5})
+		require.NoError(t, err)
+		require.Len(t, proposals, 2)
+		require.Equal(t, SimulatedExpire, proposals[0].Event)
+		require.Equal(t, "f0x", proposals[0].Provider)
+		require.True(t, proposals[0].Time.Equal(now.Add(48*time.Hour)))
+		require.Equal(t, SimulatedRenew, proposals[1].Event)
+		require.Equal(t, "f0x", proposals[1].Provider)
+		require.Equal(t, pieceCID, proposals[1].PieceCID)
+		require.True(t, proposals[1].Time.Equal(now.Add(48*time.Hour)))
+
+		// The simulation leaves the database untouched
+		var deals []model.Deal
+		require.NoError(t, db.Find(&deals).Error)
+		require.Len(t, deals, 1)
+		require.Equal(t, model.DealActive, deals[0].State)
+		var schedules int64
+		require.NoError(t, db.Model(&model.Schedule{}).Count(&schedules).Error)
+		require.Zero(t, schedules)
+
+		// Within the horizon, nothing is proposed since the replica is still active
+		proposals, err = handler.SimulateHandler(ctx, db, SimulateRequest{})
+		require.NoError(t, err)
+		require.Empty(t, proposals)
+
+		_, err = handler.SimulateHandler(ctx, db, SimulateRequest{Days: -1})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+}
@@ -36,6 +36,12 @@ type UpdateRequest struct {
 	Notes                 *string  `json:"notes"`                                        // Notes
 	MaxPendingDealSize    *string  `json:"maxPendingDealSize"`                           // Max pending deal size in human readable format, i.e. 100 TiB
 	MaxPendingDealNumber  *int     `json:"maxPendingDealNumber"`                         // Max pending deal number
+	HourlyDealNumber      *int     `json:"hourlyDealNumber"`                             // Max number of deals proposed within any hour
+	HourlyDealSize        *string  `json:"hourlyDealSize"`                               // Max size of deals proposed within any hour in human readable format, i.e. 10 TiB
+	DailyDealNumber       *int     `json:"dailyDealNumber"`                              // Max number of deals proposed within any day
+	DailyDealSize         *string  `json:"dailyDealSize"`                                // Max size of deals proposed within any day in human readable format, i.e. 100 TiB
+	MaxRejectedRetries    *int     `json:"maxRejectedRetries"`                           // Number of times a piece rejected by the provider is proposed again
+	RejectedRetryDelay    *string  `json:"rejectedRetryDelay"`                           // Delay before a piece rejected by the provider is proposed again, in duration format, i.e. 1h
 	//nolint:tagliatelle
 	AllowedPieceCIDs []string `json:"allowedPieceCids"` // Allowed piece CIDs in this schedule
 	Force            *bool    `json:"force"`            // Force to send out deals regardless of replication restriction
@@ -46,6 +52,8 @@ type UpdateRequest struct {
 // It looks for the schedule record by the given schedule ID. If found, it processes
 // the provided UpdateRequest to determine which fields should be updated. Once the
 // desired changes are captured, the function commits these updates to the database.
+// If the schedule is above the approval threshold after the changes, it is put back
+// pending the approval of a second operator, which activates it again.
 //
 // Parameters:
 //   - ctx: The context for managing timeouts and cancellation.
@@ -232,10 +240,88 @@ func (DefaultHandler) UpdateHandler(
 		updates["max_pending_deal_size"] = maxPendingDealSize
 	}
 
+	if request.HourlyDealNumber != nil {
+		if *request.HourlyDealNumber < 0 {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid hourly deal number: %d", *request.HourlyDealNumber)
+		}
+		updates["hourly_deal_number"] = *request.HourlyDealNumber
+	}
+
+	if request.HourlyDealSize != nil {
+		hourlyDealSize := uint64(0)
+		if *request.HourlyDealSize != "" {
+			hourlyDealSize, err = humanize.ParseBytes(*request.HourlyDealSize)
+			if err != nil {
+				return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid hourly deal size: %s", *request.HourlyDealSize)
+			}
+		}
+		updates["hourly_deal_size"] = hourlyDealSize
+	}
+
+	if request.DailyDealNumber != nil {
+		if *request.DailyDealNumber < 0 {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid daily deal number: %d", *request.DailyDealNumber)
+		}
+		updates["daily_deal_number"] = *request.DailyDealNumber
+	}
+
+	if request.DailyDealSize != nil {
+		dailyDealSize := uint64(0)
+		if *request.DailyDealSize != "" {
+			dailyDealSize, err = humanize.ParseBytes(*request.DailyDealSize)
+			if err != nil {
+				return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid daily deal size: %s", *request.DailyDealSize)
+			}
+		}
+		updates["daily_deal_size"] = dailyDealSize
+	}
+
+	if request.MaxRejectedRetries != nil {
+		if *request.MaxRejectedRetries < 0 {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid max rejected retries: %d", *request.MaxRejectedRetries)
+		}
+		updates["max_rejected_retries"] = *request.MaxRejectedRetries
+	}
+
+	if request.RejectedRetryDelay != nil {
+		rejectedRetryDelay, err := parseRejectedRetryDelay(*request.RejectedRetryDelay)
+		if err != nil {
+			return nil, err
+		}
+		updates["rejected_retry_delay"] = rejectedRetryDelay
+	}
+
 	if request.Force != nil {
 		updates["force"] = *request.Force
 	}
 
+	if len(updates) > 0 {
+		var preparation model.Preparation
+		err = db.First(&preparation, schedule.PreparationID).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		totalDealSize := schedule.TotalDealSize
+		if size, ok := updates["total_deal_size"]; ok {
+			totalDealSize = int64(size.(uint64))
+		}
+		totalDealNumber := schedule.TotalDealNumber
+		if request.TotalDealNumber != nil {
+			totalDealNumber = *request.TotalDealNumber
+		}
+		requestedBy, pending, err := checkApproval(ctx, db, totalDealSize, totalDealNumber, preparation.PieceSize)
+		if err != nil {
+			return nil, err
+		}
+		if requestedBy != "" {
+			updates["requested_by"] = requestedBy
+		}
+		if pending {
+			updates["state"] = model.SchedulePendingApproval
+			updates["approved_by"] = ""
+		}
+	}
+
 	err = db.Model(&schedule).Updates(updates).Error
 	if err != nil {
 		return nil, errors.WithStack(err)
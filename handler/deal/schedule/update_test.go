@@ -3,6 +3,7 @@ package schedule
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/data-preservation-programs/singularity/handler/handlererror"
 	"github.com/data-preservation-programs/singularity/model"
@@ -31,6 +32,12 @@ var updateRequest = UpdateRequest{
 	Notes:                 ptr.Of("notes"),
 	MaxPendingDealSize:    ptr.Of("10TiB"),
 	MaxPendingDealNumber:  ptr.Of(100),
+	HourlyDealNumber:      ptr.Of(10),
+	HourlyDealSize:        ptr.Of("1TiB"),
+	DailyDealNumber:       ptr.Of(100),
+	DailyDealSize:         ptr.Of("10TiB"),
+	MaxRejectedRetries:    ptr.Of(5),
+	RejectedRetryDelay:    ptr.Of("30m"),
 	AllowedPieceCIDs:      []string{"baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq"},
 	ScheduleCronPerpetual: ptr.Of(true),
 	Force:                 ptr.Of(true),
@@ -164,6 +171,34 @@ func TestUpdateHandler_InvalidPendingDealSize(t *testing.T) {
 	})
 }
 
+func TestUpdateHandler_InvalidHourlyDealNumber(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Schedule{
+			Preparation: &model.Preparation{},
+		}).Error
+		require.NoError(t, err)
+		badRequest := updateRequest
+		badRequest.HourlyDealNumber = ptr.Of(-1)
+		_, err = Default.UpdateHandler(ctx, db, 1, badRequest)
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid hourly deal number")
+	})
+}
+
+func TestUpdateHandler_InvalidRejectedRetryDelay(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Schedule{
+			Preparation: &model.Preparation{},
+		}).Error
+		require.NoError(t, err)
+		badRequest := updateRequest
+		badRequest.RejectedRetryDelay = ptr.Of("soon")
+		_, err = Default.UpdateHandler(ctx, db, 1, badRequest)
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "invalid rejected retry delay")
+	})
+}
+
 func TestUpdateHandler_InvalidAllowedPieceCID_NotCID(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 		err := db.Create(&model.Schedule{
@@ -204,6 +239,10 @@ func TestUpdateHandler_Success(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, schedule)
 		require.True(t, schedule.Force)
+		require.Equal(t, 10, schedule.HourlyDealNumber)
+		require.EqualValues(t, 10<<40, schedule.DailyDealSize)
+		require.Equal(t, 5, schedule.MaxRejectedRetries)
+		require.Equal(t, 30*time.Minute, schedule.RejectedRetryDelay)
 	})
 }
 
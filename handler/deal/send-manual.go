@@ -13,6 +13,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/replication"
+	"github.com/data-preservation-programs/singularity/util"
 	"github.com/ipfs/go-cid"
 	"gorm.io/gorm"
 )
@@ -35,6 +36,7 @@ type Proposal struct {
 	PieceCID        string   `json:"pieceCid"`                             // Piece CID
 	PieceSize       string   `json:"pieceSize"`                            // Piece size
 	FileSize        uint64   `json:"fileSize"`                             // File size in bytes for boost to fetch the CAR file
+	Force           bool     `json:"force"`                                // Send the proposal even if the provider already has a proposed, published or active deal for the piece
 }
 
 func argToDuration(s string) (time.Duration, error) {
@@ -55,6 +57,9 @@ func argToDuration(s string) (time.Duration, error) {
 // pieceCID, rootCID, piece size, etc., and then uses the dealMaker to create a deal. The result is a model.Deal that
 // represents the proposal. Any issues during these operations result in an appropriate error response.
 //
+// A piece is never proposed twice to the same provider: if the provider already has a proposed, published or active
+// deal for the piece, as made by a schedule or by a saved manual proposal, the proposal is refused unless Force is set.
+//
 // Parameters:
 //   - ctx:       The context for the operation which can be used for timeouts and cancellations.
 //   - db:        The database connection for accessing and storing related data.
@@ -63,7 +68,8 @@ func argToDuration(s string) (time.Duration, error) {
 //
 // Returns:
 //   - A pointer to a model.Deal object representing the created deal.
-//   - An error indicating any issues that occurred during the process.
+//   - An error indicating any issues that occurred during the process, i.e. handlererror.ErrDuplicateRecord if the
+//     provider already has a deal for the piece.
 func (DefaultHandler) SendManualHandler(
 	ctx context.Context,
 	db *gorm.DB,
@@ -95,6 +101,21 @@ func (DefaultHandler) SendManualHandler(
 	if (pieceSize & (pieceSize - 1)) != 0 {
 		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "piece size %d must be a power of 2", pieceSize)
 	}
+	if pieceSize < util.MinPieceSize || pieceSize > util.MaxPieceSize {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "piece size %d must be between 128 B and 64 GiB", pieceSize)
+	}
+	if !request.Force {
+		var existing []model.Deal
+		err = db.Where("provider = ? AND piece_cid = ? AND state IN ?", request.ProviderID, model.CID(pieceCID), liveDealStates).
+			Limit(1).Find(&existing).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if len(existing) > 0 {
+			return nil, errors.Wrapf(handlererror.ErrDuplicateRecord, "provider %s already has a %s deal for piece %s",
+				request.ProviderID, existing[0].State, request.PieceCID)
+		}
+	}
 	rootCID, err := cid.Parse(request.RootCID)
 	if err != nil {
 		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid root CID: %s", request.RootCID)
@@ -9,6 +9,7 @@ import (
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/replication"
 	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/go-cid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -137,6 +138,47 @@ func TestSendManualHandler_InvalidPieceSize_NotPowerOfTwo(t *testing.T) {
 	})
 }
 
+func TestSendManualHandler_InvalidPieceSize_TooLarge(t *testing.T) {
+	wallet := model.Wallet{
+		ID:      "f01000",
+		Address: "f10000",
+	}
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&wallet).Error
+		require.NoError(t, err)
+
+		mockDealMaker := new(MockDealMaker)
+		mockDealMaker.On("MakeDeal", ctx, wallet, mock.Anything, mock.Anything).Return(&model.Deal{}, nil)
+		badProposal := proposal
+		badProposal.PieceSize = "128GiB"
+		_, err = Default.SendManualHandler(ctx, db, mockDealMaker, badProposal)
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "must be between 128 B and 64 GiB")
+	})
+}
+
+func TestSendManualHandler_64GiBPieceSize(t *testing.T) {
+	wallet := model.Wallet{
+		ID:      "f01000",
+		Address: "f10000",
+	}
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&wallet).Error
+		require.NoError(t, err)
+
+		mockDealMaker := new(MockDealMaker)
+		mockDealMaker.On("MakeDeal", ctx, wallet, mock.Anything, mock.Anything).Return(&model.Deal{}, nil)
+		largeProposal := proposal
+		largeProposal.PieceSize = "64GiB"
+		_, err = Default.SendManualHandler(ctx, db, mockDealMaker, largeProposal)
+		require.NoError(t, err)
+		car := mockDealMaker.Calls[0].Arguments[2].(model.Car)
+		require.EqualValues(t, 64<<30, car.PieceSize)
+	})
+}
+
 func TestSendManualHandler_InvalidRootCID(t *testing.T) {
 	wallet := model.Wallet{
 		ID:      "f01000",
@@ -227,3 +269,41 @@ func TestSendManualHandler(t *testing.T) {
 		require.NotNil(t, resp)
 	})
 }
+
+func TestSendManualHandler_DuplicateDeal(t *testing.T) {
+	wallet := model.Wallet{
+		ID:      "f01000",
+		Address: "f10000",
+	}
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&wallet).Error
+		require.NoError(t, err)
+		pieceCID, err := cid.Parse(proposal.PieceCID)
+		require.NoError(t, err)
+		err = db.Create(&model.Deal{
+			Provider: proposal.ProviderID,
+			PieceCID: model.CID(pieceCID),
+			State:    model.DealPublished,
+			ClientID: wallet.ID,
+		}).Error
+		require.NoError(t, err)
+
+		mockDealMaker := new(MockDealMaker)
+		mockDealMaker.On("MakeDeal", ctx, wallet, mock.Anything, mock.Anything).Return(&model.Deal{}, nil)
+		_, err = Default.SendManualHandler(ctx, db, mockDealMaker, proposal)
+		require.ErrorIs(t, err, handlererror.ErrDuplicateRecord)
+		mockDealMaker.AssertNotCalled(t, "MakeDeal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		forced := proposal
+		forced.Force = true
+		resp, err := Default.SendManualHandler(ctx, db, mockDealMaker, forced)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		other := proposal
+		other.ProviderID = "f01002"
+		_, err = Default.SendManualHandler(ctx, db, mockDealMaker, other)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,28 @@
+//nolint:forcetypeassert
+package export
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type Handler interface {
+	ManifestHandler(ctx context.Context, db *gorm.DB, request ManifestRequest) ([]ManifestEntry, error)
+}
+
+type DefaultHandler struct{}
+
+var Default Handler = &DefaultHandler{}
+
+var _ Handler = &MockExport{}
+
+type MockExport struct {
+	mock.Mock
+}
+
+func (m *MockExport) ManifestHandler(ctx context.Context, db *gorm.DB, request ManifestRequest) ([]ManifestEntry, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).([]ManifestEntry), args.Error(1)
+}
@@ -0,0 +1,161 @@
+package export
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+type ManifestRequest struct {
+	Preparations []string `json:"preparations"` // preparation ID or name filter
+	ScheduleID   uint32   `json:"scheduleId"`   // Only export the pieces of this schedule, with the deals it made. All pieces are exported if not set.
+	URLTemplate  string   `json:"urlTemplate"`  // URL template with PIECE_CID placeholder to download the CAR file, i.e. http://127.0.0.1/piece/{PIECE_CID}. The URL template of the schedule is used if not set.
+}
+
+// ManifestEntry is a piece to import into a storage provider, with the URL to download its CAR file from.
+type ManifestEntry struct {
+	Preparation string `json:"preparation" table:"verbose"`
+	PieceCID    string `json:"pieceCid"`
+	PayloadCID  string `json:"payloadCid"` // Root CID of the CAR file
+	PieceSize   int64  `json:"pieceSize"`  // Padded size of the piece
+	CarSize     int64  `json:"carSize"`    // Size of the CAR file
+	URL         string `json:"url"`        // Empty if no URL template is set
+	Provider    string `json:"provider"`   // Storage provider of the schedule, if exported by schedule
+	DealUUID    string `json:"dealUuid"`   // UUID of the deal proposal made by the schedule for the piece, to import the CAR file into boost. Empty if no deal is made yet.
+}
+
+type manifestRow struct {
+	Preparation string
+	PieceCID    model.CID `gorm:"column:piece_cid"`
+	RootCID     model.CID `gorm:"column:root_cid"`
+	PieceSize   int64
+	FileSize    int64
+}
+
+// ManifestHandler lists the pieces of the preparations with their payload CID, size and download URL, in the order
+// they were packed, so they can be imported into storage providers, i.e. with the offline deal import of boost, or
+// handed over to data programs like Spade or Slingshot. Pieces that were aggregated are listed through their
+// aggregate, as that is the piece that is dealt.
+//
+// With a schedule, only the pieces of its preparation that it is allowed to deal are listed, with its storage provider
+// and the UUID of the latest proposed, published or active deal it made for each piece, so the provider can import the
+// CAR files of its offline deals. The URL template of the schedule is used unless one is given.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The ManifestRequest with the preparation filter, the schedule and the URL template.
+//
+// Returns:
+//   - A slice of ManifestEntry, one for each piece.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) ManifestHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	request ManifestRequest,
+) ([]ManifestEntry, error) {
+	db = db.WithContext(ctx)
+	var preparationIDs []model.PreparationID
+	for _, id := range request.Preparations {
+		var preparation model.Preparation
+		err := preparation.FindByIDOrName(db, id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		preparationIDs = append(preparationIDs, preparation.ID)
+	}
+
+	statement := db.Table("cars").
+		Select("preparations.name AS preparation, cars.piece_cid, cars.root_cid, cars.piece_size, cars.file_size").
+		Joins("JOIN preparations ON preparations.id = cars.preparation_id").
+		Where("cars.aggregate_id IS NULL")
+	if len(preparationIDs) > 0 {
+		statement = statement.Where("cars.preparation_id IN ?", preparationIDs)
+	}
+
+	urlTemplate := request.URLTemplate
+	var schedule model.Schedule
+	deals := make(map[string]model.Deal)
+	if request.ScheduleID != 0 {
+		err := db.First(&schedule, request.ScheduleID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrapf(handlererror.ErrNotFound, "schedule %d does not exist", request.ScheduleID)
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		statement = statement.Where("cars.preparation_id = ?", schedule.PreparationID)
+		if urlTemplate == "" {
+			urlTemplate = schedule.URLTemplate
+		}
+
+		var scheduleDeals []model.Deal
+		err = db.Where("schedule_id = ? AND state IN ?", schedule.ID, []model.DealState{
+			model.DealProposed, model.DealPublished, model.DealActive,
+		}).Order("id").Find(&scheduleDeals).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, deal := range scheduleDeals {
+			deals[deal.PieceCID.String()] = deal
+		}
+	}
+
+	var rows []manifestRow
+	err := statement.Order("cars.id").Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	allowed := make(map[string]struct{}, len(schedule.AllowedPieceCIDs))
+	for _, pieceCID := range schedule.AllowedPieceCIDs {
+		allowed[pieceCID] = struct{}{}
+	}
+	entries := make([]ManifestEntry, 0, len(rows))
+	seen := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		pieceCID := row.PieceCID.String()
+		// A piece packed in several CAR files is only listed once
+		if _, ok := seen[pieceCID]; ok {
+			continue
+		}
+		seen[pieceCID] = struct{}{}
+		if _, ok := allowed[pieceCID]; len(allowed) > 0 && !ok {
+			continue
+		}
+		entry := ManifestEntry{
+			Preparation: row.Preparation,
+			PieceCID:    pieceCID,
+			PayloadCID:  row.RootCID.String(),
+			PieceSize:   row.PieceSize,
+			CarSize:     row.FileSize,
+			Provider:    schedule.Provider,
+			DealUUID:    deals[pieceCID].ProposalID,
+		}
+		if urlTemplate != "" {
+			entry.URL = strings.Replace(urlTemplate, "{PIECE_CID}", pieceCID, 1)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// @ID ExportManifest
+// @Summary List the pieces with their payload CID, size and download URL, to import them into storage providers
+// @Tags Export
+// @Accept json
+// @Produce json
+// @Param request body ManifestRequest true "Request body"
+// @Success 200 {array} ManifestEntry
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /export/manifest [post]
+func _() {}
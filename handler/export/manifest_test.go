@@ -0,0 +1,89 @@
+package export
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	boxoutil "github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestManifestHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		attachment := model.SourceAttachment{
+			Preparation: &model.Preparation{Name: "prep"},
+			Storage:     &model.Storage{Name: "source"},
+		}
+		require.NoError(t, db.Create(&attachment).Error)
+		require.NoError(t, db.Create(&model.Preparation{Name: "other"}).Error)
+		newCID := func(s string) model.CID {
+			return model.CID(cid.NewCidV1(cid.Raw, boxoutil.Hash([]byte(s))))
+		}
+		piece1, piece2, aggregated := newCID("piece1"), newCID("piece2"), newCID("aggregated")
+		root := model.CID(testutil.TestCid)
+		cars := []model.Car{
+			{PieceCID: piece1, PieceSize: 1 << 20, RootCID: root, FileSize: 1000, PreparationID: attachment.PreparationID, AttachmentID: &attachment.ID},
+			{PieceCID: piece2, PieceSize: 1 << 21, RootCID: root, FileSize: 2000, PreparationID: attachment.PreparationID, AttachmentID: &attachment.ID},
+			{PieceCID: newCID("other"), PieceSize: 1 << 20, PreparationID: 2},
+		}
+		require.NoError(t, db.Create(&cars).Error)
+		require.NoError(t, db.Create(&model.Car{PieceCID: aggregated, PieceSize: 1 << 10, PreparationID: attachment.PreparationID,
+			AttachmentID: &attachment.ID, AggregateID: &cars[0].ID}).Error)
+		schedule := model.Schedule{
+			PreparationID:    attachment.PreparationID,
+			Provider:         "f01",
+			URLTemplate:      "http://cp.example.com/piece/{PIECE_CID}",
+			AllowedPieceCIDs: model.StringSlice{piece1.String()},
+		}
+		require.NoError(t, db.Create(&schedule).Error)
+		require.NoError(t, db.Create(&model.Wallet{ID: "f0100", Address: "f1wallet"}).Error)
+		require.NoError(t, db.Create([]model.Deal{
+			{PieceCID: piece1, Provider: "f01", ClientID: "f0100", State: model.DealRejected, ProposalID: "rejected",
+				ScheduleID: ptr.Of(schedule.ID)},
+			{PieceCID: piece1, Provider: "f01", ClientID: "f0100", State: model.DealProposed, ProposalID: "proposed",
+				ScheduleID: ptr.Of(schedule.ID)},
+		}).Error)
+
+		entries, err := Default.ManifestHandler(ctx, db, ManifestRequest{
+			Preparations: []string{"prep"},
+			URLTemplate:  "https://example.com/{PIECE_CID}.car",
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, ManifestEntry{
+			Preparation: "prep",
+			PieceCID:    piece1.String(),
+			PayloadCID:  root.String(),
+			PieceSize:   1 << 20,
+			CarSize:     1000,
+			URL:         "https://example.com/" + piece1.String() + ".car",
+		}, entries[0])
+		require.Equal(t, piece2.String(), entries[1].PieceCID)
+
+		entries, err = Default.ManifestHandler(ctx, db, ManifestRequest{})
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		require.Empty(t, entries[2].URL)
+		require.Empty(t, entries[2].PayloadCID)
+
+		// A schedule only exports the pieces it is allowed to deal, with its deals
+		entries, err = Default.ManifestHandler(ctx, db, ManifestRequest{ScheduleID: uint32(schedule.ID)})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, piece1.String(), entries[0].PieceCID)
+		require.Equal(t, "f01", entries[0].Provider)
+		require.Equal(t, "proposed", entries[0].DealUUID)
+		require.Equal(t, "http://cp.example.com/piece/"+piece1.String(), entries[0].URL)
+
+		_, err = Default.ManifestHandler(ctx, db, ManifestRequest{ScheduleID: 100})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+		_, err = Default.ManifestHandler(ctx, db, ManifestRequest{Preparations: []string{"missing"}})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+	})
+}
@@ -0,0 +1,176 @@
+package file
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/push"
+	"github.com/data-preservation-programs/singularity/scan"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/rclone/rclone/fs"
+	"gorm.io/gorm"
+)
+
+type AppendRequest struct {
+	Files    []Info `json:"files"`    // Files to append, relative to the source
+	Finalize bool   `json:"finalize"` // Whether to also queue the last, partially filled pack job for packing
+}
+
+type AppendResult struct {
+	Files   []model.File `json:"files"   table:"expand"` // Files appended to the source
+	Skipped []string     `json:"skipped"`                // Paths of the files that already exist with the same size and last modified time
+	Jobs    []model.Job  `json:"jobs"    table:"expand"` // Pack jobs the file ranges of the appended files are assigned to
+}
+
+// AppendFilesHandler appends a list of files to a source of a preparation without rescanning the source, and queues
+// them straight into packing. This is meant for pipelines that know exactly which new files landed in the source.
+//
+// All files are checked in the storage system before any of them is appended, and they are appended, assigned to pack
+// jobs and queued in a single transaction, so the request either appends all new files or none of them. The file ranges of the appended files are added to the pack job of the source that is still
+// being filled, and pack jobs that are full are marked as ready. With Finalize, the last pack job is marked as ready
+// as well, otherwise it keeps being filled by the next append or scan.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: The gorm.DB instance for database operations.
+//   - preparation: The preparation ID or name.
+//   - source: The source ID or name.
+//   - request: The AppendRequest with the files to append.
+//
+// Returns:
+//   - A pointer to the AppendResult with the appended and skipped files and the pack jobs they are assigned to.
+//   - An error if any issues occur during the operation, including when the source isn't attached to
+//     the preparation or if any of the files doesn't exist in the storage system.
+func (DefaultHandler) AppendFilesHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	preparation string,
+	source string,
+	request AppendRequest,
+) (*AppendResult, error) {
+	db = db.WithContext(ctx)
+	if len(request.Files) == 0 {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "no file to append")
+	}
+	var attachment model.SourceAttachment
+	err := attachment.FindByPreparationAndSource(db, preparation, source)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "source '%s' is not attached to preparation %s", source, preparation)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	rclone, err := storagesystem.NewRCloneHandler(ctx, *attachment.Storage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	objects := make([]fs.ObjectInfo, 0, len(request.Files))
+	for _, fileInfo := range request.Files {
+		entry, err := rclone.Check(ctx, fileInfo.Path)
+		if err != nil {
+			return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrapf(err, "failed to check file '%s'", fileInfo.Path))
+		}
+		obj, ok := entry.(fs.ObjectInfo)
+		if !ok {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "file '%s' is not an object", fileInfo.Path)
+		}
+		maxDepth := attachment.Preparation.MaxDirectoryDepth
+		if maxDepth > 0 && push.DirectoryDepth(obj.Remote()) > maxDepth {
+			return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrapf(push.ErrDirectoryTooDeep,
+				"%s has depth %d, maximum is %d", obj.Remote(), push.DirectoryDepth(obj.Remote()), maxDepth))
+		}
+		if attachment.Preparation.CarSource && !push.IsCarFile(obj.Remote()) {
+			return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrapf(push.ErrNotCarFile,
+				"%s is not a .car file", obj.Remote()))
+		}
+		objects = append(objects, obj)
+	}
+
+	var result AppendResult
+	err = database.DoRetry(ctx, func() error {
+		return db.Transaction(func(db *gorm.DB) error {
+			result = AppendResult{Files: []model.File{}, Skipped: []string{}, Jobs: []model.Job{}}
+			directoryCache := make(map[string]model.DirectoryID)
+			var fileRanges []model.FileRange
+			for i, obj := range objects {
+				file, ranges, err := push.PushFileWithEventTime(ctx, db, obj, attachment, directoryCache, request.Files[i].eventTime())
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				if file == nil {
+					result.Skipped = append(result.Skipped, obj.Remote())
+					continue
+				}
+				file.FileRanges = ranges
+				result.Files = append(result.Files, *file)
+				fileRanges = append(fileRanges, ranges...)
+			}
+			if len(fileRanges) == 0 {
+				return nil
+			}
+
+			_, err := scan.PrepareToPackFileRanges(ctx, db, attachment, fileRanges)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if request.Finalize {
+				return scan.MarkPackJobsReady(ctx, db, attachment.ID)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(result.Files) == 0 {
+		return &result, nil
+	}
+
+	jobIDs := make(map[model.JobID]struct{})
+	fileIDs := make([]model.FileID, 0, len(result.Files))
+	for _, file := range result.Files {
+		fileIDs = append(fileIDs, file.ID)
+	}
+	for _, chunk := range util.ChunkSlice(fileIDs, util.BatchSize) {
+		var ids []model.JobID
+		err = db.Model(&model.FileRange{}).Where("file_id IN ? AND job_id IS NOT NULL", chunk).
+			Distinct("job_id").Pluck("job_id", &ids).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, id := range ids {
+			jobIDs[id] = struct{}{}
+		}
+	}
+	ids := make([]model.JobID, 0, len(jobIDs))
+	for id := range jobIDs {
+		ids = append(ids, id)
+	}
+	err = db.Where("id IN ?", ids).Order("id asc").Find(&result.Jobs).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &result, nil
+}
+
+// @ID AppendFiles
+// @Summary Append files to a source and queue them for packing without rescanning the source
+// @Description Tells Singularity that a list of new files landed in the source, so they are packed without a full rescan
+// @Tags File
+// @Accept json
+// @Produce json
+// @Param id path string true "Preparation ID or name"
+// @Param name path string true "Source storage ID or name"
+// @Param request body AppendRequest true "Append Request"
+// @Success 200 {object} AppendResult
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/source/{name}/files [post]
+func _() {}
@@ -0,0 +1,98 @@
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestAppendFilesHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		tmpdir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(tmpdir, "sub"), 0o755))
+		for _, name := range []string{"a.txt", "sub/b.txt", "c.txt"} {
+			require.NoError(t, os.WriteFile(filepath.Join(tmpdir, name), []byte("test"), 0o644))
+		}
+		err := db.Create(&model.Preparation{
+			Name:      "prep",
+			MaxSize:   1 << 20,
+			PieceSize: 1 << 21,
+			SourceStorages: []model.Storage{{
+				Name: "source",
+				Type: "local",
+				Path: tmpdir,
+			}},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Directory{
+			AttachmentID: 1,
+		}).Error
+		require.NoError(t, err)
+
+		result, err := Default.AppendFilesHandler(ctx, db, "prep", "source", AppendRequest{
+			Files: []Info{{Path: "a.txt"}, {Path: "sub/b.txt"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Files, 2)
+		require.Empty(t, result.Skipped)
+		require.Len(t, result.Jobs, 1)
+		require.Equal(t, model.Pack, result.Jobs[0].Type)
+		require.Equal(t, model.Created, result.Jobs[0].State)
+		var dir model.Directory
+		require.NoError(t, db.Where("name = ?", "sub").First(&dir).Error)
+		require.Equal(t, dir.ID, *result.Files[1].DirectoryID)
+
+		result, err = Default.AppendFilesHandler(ctx, db, "prep", "source", AppendRequest{
+			Files:    []Info{{Path: "a.txt"}, {Path: "c.txt"}},
+			Finalize: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Files, 1)
+		require.Equal(t, []string{"a.txt"}, result.Skipped)
+		require.Len(t, result.Jobs, 1)
+		require.Equal(t, model.Ready, result.Jobs[0].State)
+
+		var count int64
+		require.NoError(t, db.Model(&model.FileRange{}).Where("job_id = ?", result.Jobs[0].ID).Count(&count).Error)
+		require.EqualValues(t, 3, count)
+	})
+}
+
+func TestAppendFilesHandler_Invalid(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.AppendFilesHandler(ctx, db, "prep", "source", AppendRequest{Files: []Info{{Path: "a.txt"}}})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		tmpdir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(tmpdir, "a.txt"), []byte("test"), 0o644))
+		err = db.Create(&model.Preparation{
+			Name:      "prep",
+			MaxSize:   1 << 20,
+			PieceSize: 1 << 21,
+			SourceStorages: []model.Storage{{
+				Name: "source",
+				Type: "local",
+				Path: tmpdir,
+			}},
+		}).Error
+		require.NoError(t, err)
+
+		_, err = Default.AppendFilesHandler(ctx, db, "prep", "source", AppendRequest{})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		_, err = Default.AppendFilesHandler(ctx, db, "prep", "source", AppendRequest{
+			Files: []Info{{Path: "a.txt"}, {Path: "notexist"}},
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		var count int64
+		require.NoError(t, db.Model(&model.File{}).Count(&count).Error)
+		require.Zero(t, count)
+	})
+}
@@ -43,12 +43,33 @@ type Handler interface {
 		fileInfo Info,
 	) (*model.File, error)
 
+	AppendFilesHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		preparation string,
+		source string,
+		request AppendRequest,
+	) (*AppendResult, error)
+
 	RetrieveFileHandler(
 		ctx context.Context,
 		db *gorm.DB,
 		retriever FilecoinRetriever,
 		id uint64,
 	) (data io.ReadSeekCloser, name string, modTime time.Time, err error)
+
+	PreviewFileHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		id uint64,
+		request PreviewRequest,
+	) (*Preview, error)
+
+	SearchFilesHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		request SearchRequest,
+	) ([]SearchResult, error)
 }
 
 type DefaultHandler struct{}
@@ -71,6 +92,11 @@ func (m *MockFile) PushFileHandler(ctx context.Context, db *gorm.DB, preparation
 	return args.Get(0).(*model.File), args.Error(1)
 }
 
+func (m *MockFile) AppendFilesHandler(ctx context.Context, db *gorm.DB, preparation string, source string, request AppendRequest) (*AppendResult, error) {
+	args := m.Called(ctx, db, preparation, source, request)
+	return args.Get(0).(*AppendResult), args.Error(1)
+}
+
 func (m *MockFile) GetFileHandler(ctx context.Context, db *gorm.DB, id uint64) (*model.File, error) {
 	args := m.Called(ctx, db, id)
 	return args.Get(0).(*model.File), args.Error(1)
@@ -94,3 +120,13 @@ func (m *MockFile) RetrieveFileHandler(
 	args := m.Called(ctx, db, retriever, id)
 	return args.Get(0).(io.ReadSeekCloser), args.Get(1).(string), args.Get(2).(time.Time), args.Error(3)
 }
+
+func (m *MockFile) PreviewFileHandler(ctx context.Context, db *gorm.DB, id uint64, request PreviewRequest) (*Preview, error) {
+	args := m.Called(ctx, db, id, request)
+	return args.Get(0).(*Preview), args.Error(1)
+}
+
+func (m *MockFile) SearchFilesHandler(ctx context.Context, db *gorm.DB, request SearchRequest) ([]SearchResult, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).([]SearchResult), args.Error(1)
+}
@@ -0,0 +1,264 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"image"
+	_ "image/gif"  // Register the GIF decoder for thumbnails
+	_ "image/jpeg" // Register the JPEG decoder for thumbnails
+	"image/png"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/data-preservation-programs/singularity/store"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPreviewLength = 64 << 10
+	MaxPreviewLength     = 16 << 20
+	thumbnailSize        = 256
+	// maxThumbnailPixels is the largest image a thumbnail is made of. The decoded image takes 4 bytes or more per pixel,
+	// so a small file declaring huge dimensions could otherwise exhaust the memory of the server.
+	maxThumbnailPixels = 8192 * 8192
+)
+
+type PreviewRequest struct {
+	Length    int64  `json:"length"`    // Number of bytes from the beginning of the file to return. Defaults to 64KiB, at most 16MiB.
+	Transform string `json:"transform"` // Name of a transform to apply to the whole file instead, i.e. thumbnail to get a PNG thumbnail of an image
+}
+
+type Preview struct {
+	Name        string `json:"name"`        // Name of the file
+	Size        int64  `json:"size"`        // Size of the file
+	ContentType string `json:"contentType"` // Content type of the preview
+	Data        []byte `json:"data"`        // Content of the preview
+}
+
+// PreviewTransform turns the content of a file into a preview, i.e. a thumbnail of an image.
+// It returns the content type and the content of the preview.
+type PreviewTransform func(name string, data []byte) (contentType string, preview []byte, err error)
+
+var (
+	previewTransformsMu sync.RWMutex
+	previewTransforms   = map[string]PreviewTransform{"thumbnail": Thumbnail}
+)
+
+// RegisterPreviewTransform makes a transform available to the preview API under the given name,
+// replacing any transform registered with the same name.
+func RegisterPreviewTransform(name string, transform PreviewTransform) {
+	previewTransformsMu.Lock()
+	defer previewTransformsMu.Unlock()
+	previewTransforms[name] = transform
+}
+
+func getPreviewTransform(name string) (PreviewTransform, bool) {
+	previewTransformsMu.RLock()
+	defer previewTransformsMu.RUnlock()
+	transform, ok := previewTransforms[name]
+	return transform, ok
+}
+
+// PreviewFileHandler returns the first bytes of a prepared file, or a preview produced by a transform such as a
+// thumbnail of an image, so curators can verify the content without retrieving the whole file.
+//
+// The content is read through the block index of the packed file: each raw block is read from the source storage at
+// its offset in the file and verified against its CID, so the preview shows exactly what has been packed. Only the
+// packed blocks at the beginning of the file are used, so files that have not been packed yet, or were packed with
+// inline preparation disabled, cannot be previewed. A transform needs the whole file to be packed and at most
+// MaxPreviewLength bytes.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: The gorm.DB instance for database operations.
+//   - id: The ID of the file to preview.
+//   - request: The PreviewRequest with the length or the transform of the preview.
+//
+// Returns:
+//   - A pointer to the Preview of the file.
+//   - An error if any issues occur during the operation, including when the file is not found or has no packed block.
+func (DefaultHandler) PreviewFileHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	id uint64,
+	request PreviewRequest,
+) (*Preview, error) {
+	db = db.WithContext(ctx)
+	length := request.Length
+	if length == 0 {
+		length = DefaultPreviewLength
+	}
+	if length < 0 || length > MaxPreviewLength {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "length must be between 1 and %d", MaxPreviewLength)
+	}
+	var transform PreviewTransform
+	if request.Transform != "" {
+		var ok bool
+		transform, ok = getPreviewTransform(request.Transform)
+		if !ok {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "unknown transform %s", request.Transform)
+		}
+	}
+
+	var file model.File
+	err := db.Preload("Attachment.Storage").First(&file, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "file '%d' does not exist", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if transform != nil {
+		if file.Size > MaxPreviewLength {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "file '%d' is larger than %d bytes", id, MaxPreviewLength)
+		}
+		length = file.Size
+	}
+	if file.Size >= 0 && length > file.Size {
+		length = file.Size
+	}
+
+	data, err := readPackedBlocks(ctx, db, file, length)
+	if err != nil {
+		return nil, err
+	}
+	if transform != nil && int64(len(data)) < file.Size {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "file '%d' has not been fully packed", id)
+	}
+
+	preview := Preview{
+		Name: file.FileName(),
+		Size: file.Size,
+		Data: data,
+	}
+	if transform == nil {
+		preview.ContentType = http.DetectContentType(data)
+		return &preview, nil
+	}
+	preview.ContentType, preview.Data, err = transform(file.FileName(), data)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrapf(err, "failed to transform file '%d'", id))
+	}
+	return &preview, nil
+}
+
+// readPackedBlocks reads up to length bytes from the beginning of the file, following the raw blocks of the block
+// index that cover the file contiguously from offset 0. Each block is verified against its CID. A small file embedded
+// in its CID has no block, so it is read from its CID.
+func readPackedBlocks(ctx context.Context, db *gorm.DB, file model.File, length int64) ([]byte, error) {
+	if data, ok := packutil.InlineData(cid.Cid(file.CID)); ok && int64(len(data)) == file.Size {
+		return data[:length], nil
+	}
+	var carBlocks []model.CarBlock
+	err := db.Where("file_id = ? AND file_offset < ?", file.ID, length).
+		Order("file_offset ASC").Find(&carBlocks).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if length > 0 && (len(carBlocks) == 0 || carBlocks[0].FileOffset != 0) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "file '%d' has no packed block at its beginning", file.ID)
+	}
+
+	// The same range can be packed more than once, so only the first block at each offset is kept
+	blocks := make([]model.CarBlock, 0, len(carBlocks))
+	end := int64(0)
+	for _, carBlock := range carBlocks {
+		if carBlock.FileOffset < end {
+			continue
+		}
+		if carBlock.FileOffset > end {
+			break
+		}
+		blocks = append(blocks, carBlock)
+		end += int64(carBlock.BlockLength())
+	}
+	if len(blocks) == 0 {
+		return []byte{}, nil
+	}
+
+	handler, err := storagesystem.NewRCloneHandler(ctx, *file.Attachment.Storage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	reader, obj, err := handler.Read(ctx, file.Path, 0, end)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read file '%d'", file.ID)
+	}
+	defer reader.Close()
+	isSameEntry, explanation := storagesystem.IsSameEntry(ctx, file, obj)
+	if !isSameEntry {
+		return nil, errors.Wrapf(store.ErrFileHasChanged, "file '%d': %s", file.ID, explanation)
+	}
+
+	data := make([]byte, end)
+	for _, block := range blocks {
+		blockData := data[block.FileOffset : block.FileOffset+int64(block.BlockLength())]
+		_, err = io.ReadFull(reader, blockData)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read block at offset %d of file '%d'", block.FileOffset, file.ID)
+		}
+		c := cid.Cid(block.CID)
+		sum, err := c.Prefix().Sum(blockData)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if !sum.Equals(c) {
+			return nil, errors.Wrapf(store.ErrBlockMismatch, "block %s at offset %d of file '%d' read from the source", c, block.FileOffset, file.ID)
+		}
+	}
+	if end > length {
+		data = data[:length]
+	}
+	return data, nil
+}
+
+// Thumbnail is a PreviewTransform that scales a GIF, JPEG or PNG image down to fit in 256x256 pixels and encodes it
+// as PNG. Images that are already small enough keep their size. The dimensions are read from the header before the
+// image is decoded, and images larger than 8192x8192 pixels are rejected.
+func Thumbnail(_ string, data []byte) (string, []byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, errors.Wrap(err, "not a supported image")
+	}
+	if config.Width <= 0 || config.Height <= 0 || int64(config.Width)*int64(config.Height) > maxThumbnailPixels {
+		return "", nil, errors.Newf("image of %dx%d pixels is too large for a thumbnail", config.Width, config.Height)
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, errors.Wrap(err, "not a supported image")
+	}
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > thumbnailSize || height > thumbnailSize {
+		if width > height {
+			width, height = thumbnailSize, height*thumbnailSize/width
+		} else {
+			width, height = width*thumbnailSize/height, thumbnailSize
+		}
+		if width == 0 {
+			width = 1
+		}
+		if height == 0 {
+			height = 1
+		}
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			dst.Set(x, y, src.At(bounds.Min.X+x*bounds.Dx()/width, bounds.Min.Y+y*bounds.Dy()/height))
+		}
+	}
+	var buf bytes.Buffer
+	err = png.Encode(&buf, dst)
+	if err != nil {
+		return "", nil, errors.WithStack(err)
+	}
+	return "image/png", buf.Bytes(), nil
+}
@@ -0,0 +1,167 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/store"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-varint"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// createPackedFile writes the file to the source and creates the block index of the file, with blocks of the given size.
+// If corrupt is set, the CIDs of the blocks do not match the content.
+func createPackedFile(t *testing.T, db *gorm.DB, dir string, name string, content []byte, blockSize int, corrupt bool) model.File {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, content, 0644))
+	stat, err := os.Stat(path)
+	require.NoError(t, err)
+	file := model.File{
+		AttachmentID:     1,
+		Path:             name,
+		Size:             int64(len(content)),
+		LastModifiedNano: stat.ModTime().UnixNano(),
+	}
+	require.NoError(t, db.Create(&file).Error)
+	var carBlocks []model.CarBlock
+	for offset := 0; offset < len(content); offset += blockSize {
+		end := offset + blockSize
+		if end > len(content) {
+			end = len(content)
+		}
+		data := content[offset:end]
+		if corrupt {
+			data = []byte("corrupted")
+		}
+		c := cid.NewCidV1(cid.Raw, util.Hash(data))
+		vint := varint.ToUvarint(uint64(c.ByteLen() + end - offset))
+		carBlocks = append(carBlocks, model.CarBlock{
+			CarID:          1,
+			CID:            model.CID(c),
+			CarBlockLength: int32(len(vint) + c.ByteLen() + end - offset),
+			Varint:         vint,
+			FileOffset:     int64(offset),
+			FileID:         &file.ID,
+		})
+	}
+	require.NoError(t, db.Create(&carBlocks).Error)
+	return file
+}
+
+func TestPreviewFileHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		tmp := t.TempDir()
+		err := db.Create(&model.Preparation{
+			Name: "prep",
+			SourceStorages: []model.Storage{{
+				Name: "source",
+				Type: "local",
+				Path: tmp,
+			}},
+		}).Error
+		require.NoError(t, err)
+		require.NoError(t, db.Create(&model.Car{PreparationID: 1, PieceCID: model.CID(testutil.TestCid)}).Error)
+
+		text := createPackedFile(t, db, tmp, "a.txt", []byte("0123456789abcdefghijKLMNOPQRST"), 10, false)
+		// The first block packed again in another job
+		createdAgain := createPackedFile(t, db, t.TempDir(), "ignored.txt", []byte("0123456789"), 10, false)
+		require.NoError(t, db.Model(&model.CarBlock{}).Where("file_id = ?", createdAgain.ID).Update("file_id", text.ID).Error)
+
+		preview, err := Default.PreviewFileHandler(ctx, db, uint64(text.ID), PreviewRequest{Length: 15})
+		require.NoError(t, err)
+		require.Equal(t, "a.txt", preview.Name)
+		require.EqualValues(t, 30, preview.Size)
+		require.Equal(t, "text/plain; charset=utf-8", preview.ContentType)
+		require.Equal(t, "0123456789abcde", string(preview.Data))
+
+		preview, err = Default.PreviewFileHandler(ctx, db, uint64(text.ID), PreviewRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "0123456789abcdefghijKLMNOPQRST", string(preview.Data))
+
+		img := image.NewRGBA(image.Rect(0, 0, 512, 256))
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, img))
+		picture := createPackedFile(t, db, tmp, "picture.png", buf.Bytes(), 1<<20, false)
+		preview, err = Default.PreviewFileHandler(ctx, db, uint64(picture.ID), PreviewRequest{Transform: "thumbnail"})
+		require.NoError(t, err)
+		require.Equal(t, "image/png", preview.ContentType)
+		thumbnail, err := png.Decode(bytes.NewReader(preview.Data))
+		require.NoError(t, err)
+		require.Equal(t, image.Rect(0, 0, 256, 128), thumbnail.Bounds())
+
+		_, err = Default.PreviewFileHandler(ctx, db, uint64(text.ID), PreviewRequest{Transform: "thumbnail"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		corrupted := createPackedFile(t, db, tmp, "b.txt", []byte("0123456789"), 10, true)
+		_, err = Default.PreviewFileHandler(ctx, db, uint64(corrupted.ID), PreviewRequest{})
+		require.ErrorIs(t, err, store.ErrBlockMismatch)
+
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, "a.txt"), []byte("changed"), 0644))
+		_, err = Default.PreviewFileHandler(ctx, db, uint64(text.ID), PreviewRequest{})
+		require.ErrorIs(t, err, store.ErrFileHasChanged)
+
+		// A small file embedded in its CID is previewed without its source
+		inlineCid, ok := packutil.CidOptions{LeafCodec: cid.Raw, SmallFileLimit: 128}.NewInlineCid([]byte("small"))
+		require.True(t, ok)
+		small := model.File{AttachmentID: 1, Path: "missing.txt", Size: 5, CID: model.CID(inlineCid)}
+		require.NoError(t, db.Create(&small).Error)
+		preview, err = Default.PreviewFileHandler(ctx, db, uint64(small.ID), PreviewRequest{Length: 3})
+		require.NoError(t, err)
+		require.Equal(t, "sma", string(preview.Data))
+	})
+}
+
+func TestPreviewFileHandler_Invalid(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.PreviewFileHandler(ctx, db, 1, PreviewRequest{})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		_, err = Default.PreviewFileHandler(ctx, db, 1, PreviewRequest{Length: MaxPreviewLength + 1})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		_, err = Default.PreviewFileHandler(ctx, db, 1, PreviewRequest{Transform: "unknown"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		tmp := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, "a.txt"), []byte("test"), 0644))
+		err = db.Create(&model.Preparation{
+			Name: "prep",
+			SourceStorages: []model.Storage{{
+				Name: "source",
+				Type: "local",
+				Path: tmp,
+			}},
+		}).Error
+		require.NoError(t, err)
+		require.NoError(t, db.Create(&model.File{AttachmentID: 1, Path: "a.txt", Size: 4}).Error)
+		_, err = Default.PreviewFileHandler(ctx, db, 1, PreviewRequest{})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+	})
+}
+
+func TestThumbnail_TooLarge(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))))
+	// Declare 100000x100000 pixels in the IHDR chunk, which starts after the 8 byte signature
+	data := buf.Bytes()
+	binary.BigEndian.PutUint32(data[16:20], 100000)
+	binary.BigEndian.PutUint32(data[20:24], 100000)
+	binary.BigEndian.PutUint32(data[29:33], crc32.ChecksumIEEE(data[12:29]))
+
+	_, _, err := Thumbnail("image.png", data)
+	require.ErrorContains(t, err, "too large")
+}
@@ -2,6 +2,7 @@ package file
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/handler/handlererror"
@@ -13,7 +14,15 @@ import (
 )
 
 type Info struct {
-	Path string `json:"path"` // Path to the new file, relative to the source
+	Path      string     `json:"path"`                // Path to the new file, relative to the source
+	EventTime *time.Time `json:"eventTime,omitempty"` // Time of the event that created the file. Decides the partition of the file if the preparation is partitioned, instead of its last modified time.
+}
+
+func (i Info) eventTime() time.Time {
+	if i.EventTime == nil {
+		return time.Time{}
+	}
+	return *i.EventTime
 }
 
 // PushFileHandler pushes a file to the database using specified preparation and source details.
@@ -66,8 +75,10 @@ func (DefaultHandler) PushFileHandler(
 		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "file '%s' is not an object", fileInfo.Path)
 	}
 
-	file, fileRanges, err := push.PushFile(ctx, db, obj, attachment, map[string]model.DirectoryID{})
-
+	file, fileRanges, err := push.PushFileWithEventTime(ctx, db, obj, attachment, map[string]model.DirectoryID{}, fileInfo.eventTime())
+	if errors.Is(err, push.ErrDirectoryTooDeep) || errors.Is(err, push.ErrNotCarFile) {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+	}
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
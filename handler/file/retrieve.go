@@ -10,6 +10,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/handler/handlererror"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
 	"github.com/data-preservation-programs/singularity/storagesystem"
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-log/v2"
@@ -195,22 +196,9 @@ func (r *filecoinReader) writeToN(w io.Writer, readLen int64) (int64, error) {
 		// and has remainingRange bytes left to read. Now read rangeReadLen
 		// bytes of the remaining bytes this range.
 
-		if fileRange.JobID == nil {
-			return read, UnableToServeRangeError{Start: r.offset, End: r.offset + rangeReadLen, Err: ErrNoJobRecord}
-		}
-		providers, err := findProviders(r.db, *fileRange.JobID)
-		if err != nil || len(providers) == 0 {
-			return read, UnableToServeRangeError{Start: r.offset, End: r.offset + rangeReadLen, Err: ErrNoFilecoinDeals}
-		}
-
-		// Get a reader that reads until the end of the range.
-		rd, err := r.retriever.RetrieveReader(r.ctx, cid.Cid(fileRange.CID), offsetInRange, offsetInRange+remainingRange, providers)
+		rd, err := r.openRange(fileRange, offsetInRange, remainingRange)
 		if err != nil {
-			return read, UnableToServeRangeError{
-				Start: r.offset,
-				End:   r.offset + rangeReadLen,
-				Err:   fmt.Errorf("unable to retrieve data from filecoin: %w", err),
-			}
+			return read, UnableToServeRangeError{Start: r.offset, End: r.offset + rangeReadLen, Err: err}
 		}
 		rr = &rangeReader{
 			offset:    r.offset,
@@ -251,6 +239,28 @@ func (r *filecoinReader) writeToN(w io.Writer, readLen int64) (int64, error) {
 	return read, nil
 }
 
+// openRange returns a reader of the remaining bytes of a file range, from the offset in the range. A small file
+// embedded in its CID is read from the CID, and any other range is retrieved from the storage providers of its job.
+func (r *filecoinReader) openRange(fileRange model.FileRange, offsetInRange int64, remainingRange int64) (io.ReadCloser, error) {
+	if data, ok := packutil.InlineData(cid.Cid(fileRange.CID)); ok && int64(len(data)) == fileRange.Length {
+		return io.NopCloser(bytes.NewReader(data[offsetInRange:])), nil
+	}
+	if fileRange.JobID == nil {
+		return nil, ErrNoJobRecord
+	}
+	providers, err := findProviders(r.db, *fileRange.JobID)
+	if err != nil || len(providers) == 0 {
+		return nil, ErrNoFilecoinDeals
+	}
+
+	// Get a reader that reads until the end of the range.
+	rd, err := r.retriever.RetrieveReader(r.ctx, cid.Cid(fileRange.CID), offsetInRange, offsetInRange+remainingRange, providers)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve data from filecoin: %w", err)
+	}
+	return rd, nil
+}
+
 func (r *filecoinReader) Seek(offset int64, whence int) (int64, error) {
 	var newOffset int64
 
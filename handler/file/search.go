@@ -0,0 +1,253 @@
+package file
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultSearchLimit = 100
+	MaxSearchLimit     = 1000
+)
+
+type SearchRequest struct {
+	Path         string   `json:"path"`         // Case insensitive substring of the file path, i.e. report.csv. Words only match the start of words in the path if the full-text index is enabled.
+	CID          string   `json:"cid"`          // CID of the file, of one of its file ranges, or of a piece that contains it
+	MinSize      int64    `json:"minSize"`      // Minimum file size in bytes
+	MaxSize      int64    `json:"maxSize"`      // Maximum file size in bytes. 0 means unlimited.
+	Label        string   `json:"label"`        // Label of a deal made for a piece that contains the file
+	Preparations []string `json:"preparations"` // preparation ID or name filter
+	Limit        int      `json:"limit"`        // Maximum number of files returned. Defaults to 100, up to 1000.
+}
+
+type SearchResult struct {
+	FileID        model.FileID        `json:"fileId"`
+	Path          string              `json:"path"`
+	Size          int64               `json:"size"`
+	CID           model.CID           `json:"cid"           swaggertype:"string" table:"verbose"`
+	PreparationID model.PreparationID `json:"preparationId" table:"verbose"`
+	Preparation   string              `json:"preparation"`
+	StorageID     model.StorageID     `json:"storageId"     table:"verbose"`
+	Storage       string              `json:"storage"` // Name of the source storage of the file
+	Pieces        []SearchPiece       `json:"pieces"        table:"expand"`
+}
+
+type SearchPiece struct {
+	CarID     model.CarID  `json:"carId"     table:"verbose"`
+	PieceCID  model.CID    `json:"pieceCid"  swaggertype:"string"`
+	PieceSize int64        `json:"pieceSize"`
+	Deals     []model.Deal `json:"deals"     table:"expand"`
+}
+
+// searchRow is a file joined with its source attachment, preparation and source storage.
+type searchRow struct {
+	ID            model.FileID
+	Path          string
+	Size          int64
+	CID           model.CID
+	PreparationID model.PreparationID
+	Preparation   string
+	StorageID     model.StorageID
+	Storage       string
+}
+
+// SearchFilesHandler searches files across all preparations, and returns for each file the preparation and source
+// storage it belongs to, the pieces that contain it and the deals made for those pieces.
+//
+// All filters in the request are combined. The path filter is a case insensitive substring match, so it cannot use an
+// index and is applied after the indexed filters on the file size, the CIDs and the deal label. If the full-text index
+// has been enabled, the path filter uses it as well, in which case each word of the path filter only matches the
+// start of a word in the path. A CID matches the file itself, one of its file ranges, or a piece that contains one of
+// its file ranges.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: The gorm.DB instance for database operations.
+//   - request: The SearchRequest with the filters and the maximum number of files returned.
+//
+// Returns:
+//   - A slice of SearchResult, ordered by file ID.
+//   - An error if the request is invalid or if any issues occur during the database operation.
+func (DefaultHandler) SearchFilesHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	request SearchRequest,
+) ([]SearchResult, error) {
+	db = db.WithContext(ctx)
+	if request.Path == "" && request.CID == "" && request.Label == "" && request.MinSize == 0 && request.MaxSize == 0 {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "at least one of path, cid, size range or label is required")
+	}
+	if request.MinSize < 0 || request.MaxSize < 0 || (request.MaxSize > 0 && request.MaxSize < request.MinSize) {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid size range %d-%d", request.MinSize, request.MaxSize)
+	}
+	switch {
+	case request.Limit < 0:
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid limit %d", request.Limit)
+	case request.Limit == 0:
+		request.Limit = DefaultSearchLimit
+	case request.Limit > MaxSearchLimit:
+		request.Limit = MaxSearchLimit
+	}
+
+	statement := db.Table("files").
+		Select("files.id, files.path, files.size, files.cid, source_attachments.preparation_id, " +
+			"preparations.name AS preparation, source_attachments.storage_id, storages.name AS storage").
+		Joins("JOIN source_attachments ON source_attachments.id = files.attachment_id").
+		Joins("JOIN preparations ON preparations.id = source_attachments.preparation_id").
+		Joins("JOIN storages ON storages.id = source_attachments.storage_id")
+
+	if request.MinSize > 0 {
+		statement = statement.Where("files.size >= ?", request.MinSize)
+	}
+	if request.MaxSize > 0 {
+		statement = statement.Where("files.size <= ?", request.MaxSize)
+	}
+
+	if request.CID != "" {
+		c, err := cid.Decode(request.CID)
+		if err != nil {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid cid %s", request.CID)
+		}
+		statement = statement.Where("(files.cid = ? OR files.id IN (?) OR files.id IN (?))",
+			model.CID(c),
+			db.Model(&model.FileRange{}).Select("file_id").Where("cid = ?", model.CID(c)),
+			db.Model(&model.FileRange{}).Select("file_id").Where("job_id IN (?)",
+				db.Model(&model.Car{}).Select("job_id").Where("piece_cid = ?", model.CID(c))))
+	}
+
+	if request.Label != "" {
+		statement = statement.Where("files.id IN (?)",
+			db.Model(&model.FileRange{}).Select("file_id").Where("job_id IN (?)",
+				db.Model(&model.Car{}).Select("job_id").Where("piece_cid IN (?)",
+					db.Model(&model.Deal{}).Select("piece_cid").Where("label = ?", request.Label))))
+	}
+
+	if len(request.Preparations) > 0 {
+		var ids []uint64
+		var names []string
+		for _, preparation := range request.Preparations {
+			if id, err := strconv.ParseUint(preparation, 10, 32); err == nil {
+				ids = append(ids, id)
+			} else {
+				names = append(names, preparation)
+			}
+		}
+		statement = statement.Where("(preparations.id IN ? OR preparations.name IN ?)", ids, names)
+	}
+
+	if request.Path != "" {
+		enabled, err := model.FullTextSearchEnabled(db)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if enabled {
+			if condition, arg, ok := model.FullTextPathCondition(db, request.Path); ok {
+				statement = statement.Where(condition, arg)
+			}
+		}
+		statement = statement.Where("LOWER(files.path) LIKE ? ESCAPE '!'", "%"+escapeLike(strings.ToLower(request.Path))+"%")
+	}
+
+	var rows []searchRow
+	err := statement.Order("files.id").Limit(request.Limit).Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	results := make([]SearchResult, 0, len(rows))
+	if len(rows) == 0 {
+		return results, nil
+	}
+
+	fileIDs := make([]model.FileID, 0, len(rows))
+	for _, row := range rows {
+		fileIDs = append(fileIDs, row.ID)
+	}
+
+	var pieces []struct {
+		FileID    model.FileID
+		ID        model.CarID
+		PieceCID  model.CID `gorm:"column:piece_cid"`
+		PieceSize int64
+	}
+	err = db.Table("file_ranges").Distinct("file_ranges.file_id", "cars.id", "cars.piece_cid", "cars.piece_size").
+		Joins("JOIN cars ON cars.job_id = file_ranges.job_id").
+		Where("file_ranges.file_id IN ?", fileIDs).
+		Order("cars.id").
+		Scan(&pieces).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	piecesByFile := make(map[model.FileID][]SearchPiece)
+	var pieceCIDs []model.CID
+	seen := make(map[string]struct{})
+	for _, piece := range pieces {
+		piecesByFile[piece.FileID] = append(piecesByFile[piece.FileID], SearchPiece{
+			CarID:     piece.ID,
+			PieceCID:  piece.PieceCID,
+			PieceSize: piece.PieceSize,
+		})
+		if _, ok := seen[piece.PieceCID.String()]; !ok {
+			seen[piece.PieceCID.String()] = struct{}{}
+			pieceCIDs = append(pieceCIDs, piece.PieceCID)
+		}
+	}
+
+	dealsByPiece := make(map[string][]model.Deal)
+	if len(pieceCIDs) > 0 {
+		var deals []model.Deal
+		err = db.Where("piece_cid IN ?", pieceCIDs).Order("id").Find(&deals).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, deal := range deals {
+			dealsByPiece[deal.PieceCID.String()] = append(dealsByPiece[deal.PieceCID.String()], deal)
+		}
+	}
+
+	for _, row := range rows {
+		filePieces := piecesByFile[row.ID]
+		for i := range filePieces {
+			filePieces[i].Deals = dealsByPiece[filePieces[i].PieceCID.String()]
+		}
+		results = append(results, SearchResult{
+			FileID:        row.ID,
+			Path:          row.Path,
+			Size:          row.Size,
+			CID:           row.CID,
+			PreparationID: row.PreparationID,
+			Preparation:   row.Preparation,
+			StorageID:     row.StorageID,
+			Storage:       row.Storage,
+			Pieces:        filePieces,
+		})
+	}
+
+	return results, nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, using '!' as the escape character
+// since a backslash is not treated the same way by all supported databases.
+func escapeLike(s string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(s)
+}
+
+// @ID SearchFiles
+// @Summary Search files across all preparations by path, CID, size range or deal label
+// @Tags File
+// @Accept json
+// @Produce json
+// @Param request body SearchRequest true "SearchRequest"
+// @Success 200 {array} SearchResult
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /file/search [post]
+func _() {}
@@ -0,0 +1,178 @@
+package file
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestSearchFilesHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		fileCID := cid.NewCidV1(cid.Raw, util.Hash([]byte("file")))
+		rangeCID := cid.NewCidV1(cid.Raw, util.Hash([]byte("range")))
+		pieceCID := cid.NewCidV1(cid.Raw, util.Hash([]byte("piece")))
+		attachments := []model.SourceAttachment{{
+			Preparation: &model.Preparation{Name: "prep1"},
+			Storage:     &model.Storage{Name: "source1", Type: "local"},
+		}, {
+			Preparation: &model.Preparation{Name: "prep2"},
+			Storage:     &model.Storage{Name: "source2", Type: "local"},
+		}}
+		require.NoError(t, db.Create(&attachments).Error)
+		job := model.Job{AttachmentID: attachments[0].ID}
+		require.NoError(t, db.Create(&job).Error)
+
+		files := []model.File{{
+			Path:         "2024/Report_100%.csv",
+			Size:         100,
+			CID:          model.CID(fileCID),
+			AttachmentID: attachments[0].ID,
+			FileRanges: []model.FileRange{{
+				Length: 100,
+				CID:    model.CID(rangeCID),
+				JobID:  ptr.Of(job.ID),
+			}},
+		}, {
+			Path:         "2024/report_1000.csv",
+			Size:         1000,
+			AttachmentID: attachments[1].ID,
+		}, {
+			Path:         "images/cat.png",
+			Size:         5000,
+			AttachmentID: attachments[1].ID,
+		}}
+		require.NoError(t, db.Create(&files).Error)
+		car := model.Car{
+			PieceCID:      model.CID(pieceCID),
+			PieceSize:     1024,
+			PreparationID: attachments[0].PreparationID,
+			JobID:         ptr.Of(job.ID),
+		}
+		require.NoError(t, db.Create(&car).Error)
+		deal := model.Deal{
+			PieceCID: model.CID(pieceCID),
+			Label:    "archive-2024",
+			Provider: "f01000",
+			Wallet:   &model.Wallet{},
+		}
+		require.NoError(t, db.Create(&deal).Error)
+
+		t.Run("path", func(t *testing.T) {
+			results, err := Default.SearchFilesHandler(ctx, db, SearchRequest{Path: "REPORT_100"})
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			require.Equal(t, files[0].ID, results[0].FileID)
+			require.Equal(t, "prep1", results[0].Preparation)
+			require.Equal(t, "source1", results[0].Storage)
+			require.Len(t, results[0].Pieces, 1)
+			require.Equal(t, car.ID, results[0].Pieces[0].CarID)
+			require.Equal(t, model.CID(pieceCID), results[0].Pieces[0].PieceCID)
+			require.Len(t, results[0].Pieces[0].Deals, 1)
+			require.Equal(t, "f01000", results[0].Pieces[0].Deals[0].Provider)
+			require.Equal(t, "prep2", results[1].Preparation)
+			require.Empty(t, results[1].Pieces)
+		})
+
+		t.Run("wildcards are escaped", func(t *testing.T) {
+			results, err := Default.SearchFilesHandler(ctx, db, SearchRequest{Path: "100%"})
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			require.Equal(t, files[0].ID, results[0].FileID)
+		})
+
+		t.Run("cid", func(t *testing.T) {
+			for _, c := range []cid.Cid{fileCID, rangeCID, pieceCID} {
+				results, err := Default.SearchFilesHandler(ctx, db, SearchRequest{CID: c.String()})
+				require.NoError(t, err)
+				require.Len(t, results, 1)
+				require.Equal(t, files[0].ID, results[0].FileID)
+			}
+		})
+
+		t.Run("size range", func(t *testing.T) {
+			results, err := Default.SearchFilesHandler(ctx, db, SearchRequest{MinSize: 500, MaxSize: 5000})
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			require.Equal(t, files[1].ID, results[0].FileID)
+			require.Equal(t, files[2].ID, results[1].FileID)
+		})
+
+		t.Run("label", func(t *testing.T) {
+			results, err := Default.SearchFilesHandler(ctx, db, SearchRequest{Label: "archive-2024"})
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			require.Equal(t, files[0].ID, results[0].FileID)
+		})
+
+		t.Run("preparation and limit", func(t *testing.T) {
+			results, err := Default.SearchFilesHandler(ctx, db, SearchRequest{Path: ".", Preparations: []string{"prep2"}, Limit: 1})
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			require.Equal(t, files[1].ID, results[0].FileID)
+		})
+
+		t.Run("no match", func(t *testing.T) {
+			results, err := Default.SearchFilesHandler(ctx, db, SearchRequest{Path: "missing"})
+			require.NoError(t, err)
+			require.Empty(t, results)
+		})
+	})
+}
+
+func TestSearchFilesHandler_FullTextSearch(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := model.EnableFullTextSearch(db)
+		if errors.Is(err, model.ErrFullTextSearchNotSupported) {
+			t.Skip("Full-text search is not supported")
+		}
+		require.NoError(t, err)
+		attachment := model.SourceAttachment{
+			Preparation: &model.Preparation{Name: "prep"},
+			Storage:     &model.Storage{Name: "source", Type: "local"},
+		}
+		require.NoError(t, db.Create(&attachment).Error)
+		files := []model.File{
+			{Path: "logs/2024/app_report.log", AttachmentID: attachment.ID},
+			{Path: "logs/2024/apprentice.log", AttachmentID: attachment.ID},
+			{Path: "logs/2023/app_report.log", AttachmentID: attachment.ID},
+		}
+		require.NoError(t, db.Create(&files).Error)
+
+		results, err := Default.SearchFilesHandler(ctx, db, SearchRequest{Path: "2024/app_"})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, files[0].ID, results[0].FileID)
+
+		// Words only match the start of words in the path
+		results, err = Default.SearchFilesHandler(ctx, db, SearchRequest{Path: "report"})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		results, err = Default.SearchFilesHandler(ctx, db, SearchRequest{Path: "eport"})
+		require.NoError(t, err)
+		require.Empty(t, results)
+	})
+}
+
+func TestSearchFilesHandler_InvalidParameters(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		for _, request := range []SearchRequest{
+			{},
+			{CID: "not a cid"},
+			{MinSize: 100, MaxSize: 10},
+			{MinSize: -1},
+			{Path: "a", Limit: -1},
+		} {
+			_, err := Default.SearchFilesHandler(ctx, db, request)
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		}
+	})
+}
@@ -9,3 +9,7 @@ var ErrInvalidParameter = errors.New("invalid parameter")
 var ErrNotFound = errors.New("not found")
 
 var ErrDuplicateRecord = errors.New("duplicate record")
+
+var ErrUnauthorized = errors.New("unauthorized")
+
+var ErrForbidden = errors.New("forbidden")
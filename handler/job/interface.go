@@ -50,11 +50,17 @@ type Handler interface {
 
 	GetStatusHandler(ctx context.Context, db *gorm.DB, id string) ([]SourceStatus, error)
 
+	GetPackTimingsHandler(ctx context.Context, db *gorm.DB, id string) (*PackTimingReport, error)
+
 	PackHandler(
 		ctx context.Context,
 		db *gorm.DB,
 		jobID uint64) (*model.Car, error)
 
+	PackOneHandler(
+		ctx context.Context,
+		db *gorm.DB) (*model.Car, error)
+
 	PrepareToPackSourceHandler(
 		ctx context.Context,
 		db *gorm.DB,
@@ -78,6 +84,11 @@ func (m *MockJob) PackHandler(ctx context.Context, db *gorm.DB, jobID uint64) (*
 	return args.Get(0).(*model.Car), args.Error(1)
 }
 
+func (m *MockJob) PackOneHandler(ctx context.Context, db *gorm.DB) (*model.Car, error) {
+	args := m.Called(ctx, db)
+	return args.Get(0).(*model.Car), args.Error(1)
+}
+
 func (m *MockJob) PrepareToPackSourceHandler(ctx context.Context, db *gorm.DB, id string, name string) error {
 	args := m.Called(ctx, db, id, name)
 	return args.Error(0)
@@ -98,6 +109,11 @@ func (m *MockJob) GetStatusHandler(ctx context.Context, db *gorm.DB, id string)
 	return args.Get(0).([]SourceStatus), args.Error(1)
 }
 
+func (m *MockJob) GetPackTimingsHandler(ctx context.Context, db *gorm.DB, id string) (*PackTimingReport, error) {
+	args := m.Called(ctx, db, id)
+	return args.Get(0).(*PackTimingReport), args.Error(1)
+}
+
 func (m *MockJob) StartPackHandler(ctx context.Context, db *gorm.DB, id string, name string, jobID int64) ([]model.Job, error) {
 	args := m.Called(ctx, db, id, name, jobID)
 	return args.Get(0).([]model.Job), args.Error(1)
@@ -222,6 +222,7 @@ func (DefaultHandler) PackHandler(
 	err := db.
 		Preload("Attachment.Storage").
 		Preload("Attachment.Preparation.OutputStorages").
+		Preload("Attachment.Preparation.BlobStorage").
 		First(&packJob, jobID).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, errors.Wrapf(handlererror.ErrNotFound, "pack job %d does not exist", jobID)
@@ -0,0 +1,167 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
+	"github.com/google/uuid"
+	"github.com/ipfs/go-log/v2"
+	"gorm.io/gorm"
+)
+
+var logger = log.Logger("singularity/handler/job")
+
+const releaseTimeout = 10 * time.Second
+
+// PackOneHandler claims exactly one pack job that is ready to be packed, packs it, and releases it.
+//
+// The function is designed for short-lived, horizontally scaled workers (i.e. AWS Batch or Lambda style
+// functions). The lease on the claimed job is the same as the one used by the dataset worker: an ephemeral
+// worker is registered and keeps sending heartbeats while the job is being packed. If the process dies
+// without releasing the job, the health check cleanup will put the job back to 'Ready' once the worker
+// becomes stale, so that another worker can pick it up.
+//
+// Once packing is done, the job is marked as 'Complete'. If packing fails, the job is marked as 'Error'.
+// If the context is cancelled, i.e. the function is about to time out, the job is released back to 'Ready'.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: The gorm.DB instance for database operations.
+//
+// Returns:
+//   - A pointer to the packed model.Car, if successful.
+//   - An error if there is no pack job ready to be packed, or if any issues occur during the operation.
+func (DefaultHandler) PackOneHandler(
+	ctx context.Context,
+	db *gorm.DB) (*model.Car, error) {
+	db = db.WithContext(ctx)
+	workerID := uuid.New()
+	_, err := healthcheck.Register(ctx, db, workerID, model.DatasetWorker, true)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		//nolint:contextcheck
+		ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+		defer cancel()
+		err := database.DoRetry(ctx, func() error {
+			return db.WithContext(ctx).Where("id = ?", workerID.String()).Delete(&model.Worker{}).Error
+		})
+		if err != nil {
+			logger.Errorw("failed to unregister worker", "workerID", workerID, "error", err)
+		}
+	}()
+
+	heartbeatCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go healthcheck.StartReportHealth(heartbeatCtx, db, workerID, model.DatasetWorker)
+
+	packJob, err := claimPackJob(ctx, db, workerID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	car, err := pack.Pack(ctx, db, *packJob)
+	if err != nil {
+		releaseErr := releasePackJob(ctx, db, packJob.ID, err)
+		if releaseErr != nil {
+			logger.Errorw("failed to release pack job", "jobID", packJob.ID, "error", releaseErr)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	err = releasePackJob(ctx, db, packJob.ID, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return car, nil
+}
+
+// claimPackJob finds a pack job that is either 'Ready' or 'Processing' without a worker and assigns it to
+// the given worker in a serializable transaction, so that concurrent workers never claim the same job. Like the
+// dataset workers, it skips the jobs of paused preparations and of storages that need to be re-authenticated.
+func claimPackJob(ctx context.Context, db *gorm.DB, workerID uuid.UUID) (*model.Job, error) {
+	txOpts := &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	}
+	var packJob model.Job
+	err := database.DoRetry(ctx, func() error {
+		return db.Transaction(func(db *gorm.DB) error {
+			err := model.ClaimableJobs(db).
+				Preload("Attachment.Preparation.OutputStorages").Preload("Attachment.Preparation.BlobStorage").Preload("Attachment.Storage").
+				Where("type = ? AND (state = ? OR (state = ? AND worker_id IS NULL))", model.Pack, model.Ready, model.Processing).
+				Order("id asc").
+				First(&packJob).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			// The progress of the job is not reported, so it is only released once the worker stops sending heartbeats
+			return db.Model(&packJob).Updates(map[string]any{
+				"state":         model.Processing,
+				"worker_id":     workerID.String(),
+				"error_message": "",
+				"progress_at":   nil,
+			}).Error
+		}, txOpts)
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrap(handlererror.ErrNotFound, "there is no pack job ready to be packed")
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var fileRanges []model.FileRange
+	err = db.Joins("File").Where("file_ranges.job_id = ?", packJob.ID).Order("file_ranges.id asc").Find(&fileRanges).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	packJob.FileRanges = fileRanges
+	return &packJob, nil
+}
+
+// releasePackJob releases the lease of a claimed pack job. The job becomes 'Complete' if packErr is nil,
+// 'Ready' if packing was cancelled, or 'Error' otherwise.
+func releasePackJob(ctx context.Context, db *gorm.DB, jobID model.JobID, packErr error) error {
+	updates := map[string]any{
+		"worker_id":         nil,
+		"error_message":     "",
+		"error_stack_trace": "",
+		"state":             model.Complete,
+	}
+	if errors.Is(packErr, context.Canceled) || errors.Is(packErr, context.DeadlineExceeded) {
+		updates["state"] = model.Ready
+		var cancel context.CancelFunc
+		//nolint:contextcheck
+		ctx, cancel = context.WithTimeout(context.Background(), releaseTimeout)
+		defer cancel()
+	} else if packErr != nil {
+		updates["error_message"] = packErr.Error()
+		updates["error_stack_trace"] = fmt.Sprintf("%+v", packErr)
+		updates["state"] = model.Error
+	}
+	return database.DoRetry(ctx, func() error {
+		return db.WithContext(ctx).Model(&model.Job{}).Where("id = ?", jobID).Updates(updates).Error
+	})
+}
+
+// @ID PackOne
+// @Summary Claim, pack and release exactly one pack job that is ready to be packed
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.Car
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /job/pack-one [post]
+func _() {}
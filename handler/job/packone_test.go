@@ -0,0 +1,205 @@
+package job
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestPackOneHandler_NoJob(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.PackOneHandler(ctx, db)
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		var workers []model.Worker
+		err = db.Find(&workers).Error
+		require.NoError(t, err)
+		require.Len(t, workers, 0)
+	})
+}
+
+func TestPackOneHandler_Success(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		tmpdir := t.TempDir()
+		err := os.WriteFile(filepath.Join(tmpdir, "test.txt"), []byte("test"), 0644)
+		require.NoError(t, err)
+		stat, err := os.Stat(filepath.Join(tmpdir, "test.txt"))
+		require.NoError(t, err)
+		job := model.Job{
+			Type:  model.Pack,
+			State: model.Ready,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{
+					MaxSize:   1 << 34,
+					PieceSize: 1 << 35,
+					Name:      "prep",
+				},
+				Storage: &model.Storage{
+					Name: "source",
+					Type: "local",
+					Path: tmpdir,
+				},
+			},
+			FileRanges: []model.FileRange{
+				{
+					Offset: 0,
+					Length: 4,
+					File: &model.File{
+						Path:             "test.txt",
+						Size:             4,
+						LastModifiedNano: stat.ModTime().UnixNano(),
+						AttachmentID:     1,
+						Directory: &model.Directory{
+							AttachmentID: 1,
+						},
+					},
+				},
+			},
+		}
+		err = db.Create(&job).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Job{
+			Type:         model.Pack,
+			State:        model.Paused,
+			AttachmentID: 1,
+		}).Error
+		require.NoError(t, err)
+
+		car, err := Default.PackOneHandler(ctx, db)
+		require.NoError(t, err)
+		require.NotNil(t, car)
+		require.EqualValues(t, 100, car.FileSize)
+		require.EqualValues(t, "baga6ea4seaqbuglmtahbspkbeunqohciieh4yjivfhcqawufwgs4gt7mzmyfmmi", car.PieceCID.String())
+
+		var jobs []model.Job
+		err = db.Order("id asc").Find(&jobs).Error
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		require.Equal(t, model.Complete, jobs[0].State)
+		require.Nil(t, jobs[0].WorkerID)
+		require.Equal(t, model.Paused, jobs[1].State)
+
+		var workers []model.Worker
+		err = db.Find(&workers).Error
+		require.NoError(t, err)
+		require.Len(t, workers, 0)
+
+		// The only remaining pack job is paused, so there is nothing left to claim
+		_, err = Default.PackOneHandler(ctx, db)
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+	})
+}
+
+func TestPackOneHandler_PausedPreparation(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Job{
+			Type:  model.Pack,
+			State: model.Ready,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{Name: "prep", Paused: true},
+				Storage:     &model.Storage{Name: "source", Type: "local", Path: t.TempDir()},
+			},
+		}).Error
+		require.NoError(t, err)
+
+		_, err = Default.PackOneHandler(ctx, db)
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		var job model.Job
+		require.NoError(t, db.First(&job, 1).Error)
+		require.Equal(t, model.Ready, job.State)
+		require.Nil(t, job.WorkerID)
+	})
+}
+
+func TestPackOneHandler_NeedsReauth(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Job{
+			Type:  model.Pack,
+			State: model.Ready,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{Name: "prep"},
+				Storage:     &model.Storage{Name: "source", Type: "local", Path: t.TempDir(), NeedsReauth: true},
+			},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Job{
+			Type:  model.Pack,
+			State: model.Ready,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{
+					Name:           "output",
+					OutputStorages: []model.Storage{{Name: "expired", Type: "local", Path: t.TempDir(), NeedsReauth: true}},
+				},
+				Storage: &model.Storage{Name: "other", Type: "local", Path: t.TempDir()},
+			},
+		}).Error
+		require.NoError(t, err)
+
+		// Neither the source storage nor the output storage of a job can be used until it is re-authenticated
+		_, err = Default.PackOneHandler(ctx, db)
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		var jobs []model.Job
+		require.NoError(t, db.Find(&jobs).Error)
+		require.Len(t, jobs, 2)
+		for _, job := range jobs {
+			require.Equal(t, model.Ready, job.State)
+			require.Nil(t, job.WorkerID)
+		}
+	})
+}
+
+func TestPackOneHandler_Error(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		tmpdir := t.TempDir()
+		job := model.Job{
+			Type:  model.Pack,
+			State: model.Ready,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{
+					MaxSize:   1 << 34,
+					PieceSize: 1 << 35,
+					Name:      "prep",
+				},
+				Storage: &model.Storage{
+					Name: "source",
+					Type: "local",
+					Path: tmpdir,
+				},
+			},
+			FileRanges: []model.FileRange{
+				{
+					Offset: 0,
+					Length: 4,
+					File: &model.File{
+						Path:         "missing.txt",
+						Size:         4,
+						AttachmentID: 1,
+						Directory: &model.Directory{
+							AttachmentID: 1,
+						},
+					},
+				},
+			},
+		}
+		err := db.Create(&job).Error
+		require.NoError(t, err)
+
+		_, err = Default.PackOneHandler(ctx, db)
+		require.Error(t, err)
+
+		err = db.First(&job, 1).Error
+		require.NoError(t, err)
+		require.Equal(t, model.Error, job.State)
+		require.Nil(t, job.WorkerID)
+		require.NotEmpty(t, job.ErrorMessage)
+	})
+}
@@ -0,0 +1,94 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+// PackTimingReport is the timing breakdown of the pieces generated for a preparation, with the total time spent in
+// each phase across all pieces.
+type PackTimingReport struct {
+	NumOfPieces int                `json:"numOfPieces"`
+	Listing     time.Duration      `json:"listing"     swaggertype:"primitive,integer"`
+	Reading     time.Duration      `json:"reading"     swaggertype:"primitive,integer"`
+	Hashing     time.Duration      `json:"hashing"     swaggertype:"primitive,integer"`
+	Writing     time.Duration      `json:"writing"     swaggertype:"primitive,integer"`
+	Validating  time.Duration      `json:"validating"  swaggertype:"primitive,integer"`
+	Database    time.Duration      `json:"database"    swaggertype:"primitive,integer"`
+	Total       time.Duration      `json:"total"       swaggertype:"primitive,integer"`
+	Bound       string             `json:"bound"` // Bound is the part of the system the pieces spent the most time on, one of source, cpu, output or database
+	Timings     []model.PackTiming `json:"timings"     table:"expand"`
+}
+
+// GetPackTimingsHandler returns the timing breakdown of each piece generated for a preparation, recorded when the
+// piece was packed, so operators can tell whether a slow preparation is bound by the source, the CPU, the output
+// storage or the database without profiling the workers.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - id: The ID or name of the preparation.
+//
+// Returns:
+//   - The timings of the pieces, from the newest, and their totals.
+//   - An error, if the preparation does not exist or the database query fails.
+func (DefaultHandler) GetPackTimingsHandler(ctx context.Context, db *gorm.DB, id string) (*PackTimingReport, error) {
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := preparation.FindByIDOrName(db, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation %s cannot be found", id)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var timings []model.PackTiming
+	err = db.Where("preparation_id = ?", preparation.ID).Order("id DESC").Find(&timings).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var sum model.PackTiming
+	for _, timing := range timings {
+		sum.Listing += timing.Listing
+		sum.Reading += timing.Reading
+		sum.Hashing += timing.Hashing
+		sum.Writing += timing.Writing
+		sum.Validating += timing.Validating
+		sum.Database += timing.Database
+		sum.Total += timing.Total
+	}
+	report := &PackTimingReport{
+		NumOfPieces: len(timings),
+		Listing:     sum.Listing,
+		Reading:     sum.Reading,
+		Hashing:     sum.Hashing,
+		Writing:     sum.Writing,
+		Validating:  sum.Validating,
+		Database:    sum.Database,
+		Total:       sum.Total,
+		Timings:     timings,
+	}
+	if len(timings) > 0 {
+		report.Bound = sum.Bound()
+	}
+	return report, nil
+}
+
+// @ID GetPackTimings
+// @Summary Get the timing breakdown of the pieces generated for a preparation
+// @Tags Job
+// @Param id path string true "Preparation ID or name"
+// @Produce json
+// @Success 200 {object} PackTimingReport
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /preparation/{id}/timing [get]
+func _() {}
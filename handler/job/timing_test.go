@@ -0,0 +1,50 @@
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestGetPackTimingsHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.GetPackTimingsHandler(ctx, db, "name")
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		err = db.Create(&model.Preparation{
+			Name:           "name",
+			SourceStorages: []model.Storage{{Name: "source"}},
+		}).Error
+		require.NoError(t, err)
+
+		report, err := Default.GetPackTimingsHandler(ctx, db, "name")
+		require.NoError(t, err)
+		require.Zero(t, report.NumOfPieces)
+		require.Empty(t, report.Bound)
+
+		err = db.Create(&model.Job{AttachmentID: 1, Type: model.Pack, State: model.Complete}).Error
+		require.NoError(t, err)
+		err = db.Create(&[]model.PackTiming{
+			{JobID: 1, PreparationID: 1, Reading: 3 * time.Second, Hashing: time.Second, Database: time.Second, Total: 5 * time.Second},
+			{JobID: 1, PreparationID: 1, Reading: time.Second, Hashing: 2 * time.Second, Database: time.Second, Total: 4 * time.Second},
+		}).Error
+		require.NoError(t, err)
+
+		report, err = Default.GetPackTimingsHandler(ctx, db, "1")
+		require.NoError(t, err)
+		require.Equal(t, 2, report.NumOfPieces)
+		require.Len(t, report.Timings, 2)
+		require.EqualValues(t, 2, report.Timings[0].ID)
+		require.Equal(t, 4*time.Second, report.Reading)
+		require.Equal(t, 3*time.Second, report.Hashing)
+		require.Equal(t, 9*time.Second, report.Total)
+		require.Equal(t, "source", report.Bound)
+		require.Equal(t, "cpu", report.Timings[0].Bound())
+	})
+}
@@ -0,0 +1,277 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ybbus/jsonrpc/v3"
+	"gorm.io/gorm"
+)
+
+const probeTimeout = 30 * time.Second
+
+type AuditRequest struct {
+	Preparations []string `json:"preparations"` // preparation ID or name filter
+	ProbeURLs    []string `json:"probeUrls"`    // Base URLs of HTTP piece retrieval endpoints to probe, i.e. http://127.0.0.1:7777
+}
+
+type DealAudit struct {
+	DealID   uint64          `json:"dealId"`
+	Provider string          `json:"provider"`
+	State    model.DealState `json:"state" swaggertype:"string"` // State of the deal in the database
+	OnChain  bool            `json:"onChain"`                    // Whether the deal is active on chain with the same piece, provider and client
+	Error    string          `json:"error,omitempty"`
+}
+
+type ProbeAudit struct {
+	URL    string `json:"url"`
+	Status int    `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type PieceAudit struct {
+	PreparationID model.PreparationID `json:"preparationId"`
+	Preparation   string              `json:"preparation"`
+	PieceCID      string              `json:"pieceCid"`
+	PieceSize     int64               `json:"pieceSize"`
+	IndexedBlocks int                 `json:"indexedBlocks"` // Number of blocks in the piece index. Pieces of preparations without inline data have no index.
+	IndexError    string              `json:"indexError,omitempty"`
+	Deals         []DealAudit         `json:"deals"         table:"expand"`
+	Probes        []ProbeAudit        `json:"probes"        table:"expand"`
+	OK            bool                `json:"ok"`
+}
+
+type AuditReport struct {
+	GeneratedAt   time.Time    `json:"generatedAt"   table:"format:2006-01-02 15:04:05"`
+	TotalPieces   int          `json:"totalPieces"`
+	FailedPieces  int          `json:"failedPieces"`
+	IndexErrors   int          `json:"indexErrors"`
+	DealErrors    int          `json:"dealErrors"`
+	ProbeFailures int          `json:"probeFailures"`
+	Pieces        []PieceAudit `json:"pieces"        table:"expand"`
+}
+
+// marketDeal is the subset of the on-chain market deal that is audited.
+type marketDeal struct {
+	Proposal struct {
+		PieceCID struct {
+			Root string `json:"/"`
+		}
+		Client   string
+		Provider string
+	}
+	State struct {
+		SectorStartEpoch int32
+		SlashEpoch       int32
+	}
+}
+
+// AuditHandler independently verifies the pieces of the preparations, so a second instance given the database, or a
+// restored backup of it, and no credentials can produce an audit report for data owners. For each piece, it checks:
+//   - that the piece index is consistent, i.e. the indexed blocks are contiguous and end at the end of the CAR file;
+//   - that each deal with a deal ID is active on chain for the same piece, provider and client;
+//   - that the piece can be retrieved from each of the probe URLs.
+//
+// The handler only reads from the database, so it can run against a database opened in read-only mode, and
+// on-chain deals are looked up with the Lotus API, which does not require a token.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - lotusClient: The Lotus RPC client used to look up the deals on chain.
+//   - request: The AuditRequest with the preparation filter and the probe URLs.
+//
+// Returns:
+//   - A pointer to the AuditReport with the result of every piece.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) AuditHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	lotusClient jsonrpc.RPCClient,
+	request AuditRequest,
+) (*AuditReport, error) {
+	db = db.WithContext(ctx)
+	var preparationIDs []model.PreparationID
+	for _, id := range request.Preparations {
+		var preparation model.Preparation
+		err := preparation.FindByIDOrName(db, id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		preparationIDs = append(preparationIDs, preparation.ID)
+	}
+	for _, probeURL := range request.ProbeURLs {
+		if !strings.HasPrefix(probeURL, "http://") && !strings.HasPrefix(probeURL, "https://") {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid probe URL %s", probeURL)
+		}
+	}
+
+	statement := db.Preload("Preparation")
+	if len(preparationIDs) > 0 {
+		statement = statement.Where("preparation_id IN ?", preparationIDs)
+	}
+	var cars []model.Car
+	err := statement.Order("id asc").Find(&cars).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	httpClient := &http.Client{Timeout: probeTimeout}
+	report := AuditReport{GeneratedAt: time.Now().UTC()}
+	audited := make(map[string]struct{})
+	for _, car := range cars {
+		pieceCID := car.PieceCID.String()
+		key := fmt.Sprintf("%d/%s", car.PreparationID, pieceCID)
+		if _, ok := audited[key]; ok {
+			continue
+		}
+		audited[key] = struct{}{}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		audit := PieceAudit{
+			PreparationID: car.PreparationID,
+			Preparation:   car.Preparation.Name,
+			PieceCID:      pieceCID,
+			PieceSize:     car.PieceSize,
+			OK:            true,
+		}
+
+		audit.IndexedBlocks, err = auditPieceIndex(db, car)
+		if err != nil {
+			audit.IndexError = err.Error()
+			audit.OK = false
+			report.IndexErrors++
+		}
+
+		var deals []model.Deal
+		err = db.Where("piece_cid = ? AND deal_id IS NOT NULL AND state IN ?", car.PieceCID,
+			[]model.DealState{model.DealPublished, model.DealActive}).Order("deal_id asc").Find(&deals).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, deal := range deals {
+			dealAudit := auditDeal(ctx, lotusClient, deal)
+			if !dealAudit.OnChain {
+				audit.OK = false
+				report.DealErrors++
+			}
+			audit.Deals = append(audit.Deals, dealAudit)
+		}
+
+		for _, probeURL := range request.ProbeURLs {
+			probe := probePiece(ctx, httpClient, probeURL, pieceCID)
+			if probe.Status != http.StatusOK {
+				audit.OK = false
+				report.ProbeFailures++
+			}
+			audit.Probes = append(audit.Probes, probe)
+		}
+
+		if !audit.OK {
+			report.FailedPieces++
+		}
+		report.Pieces = append(report.Pieces, audit)
+	}
+	report.TotalPieces = len(report.Pieces)
+	return &report, nil
+}
+
+// auditPieceIndex checks that the indexed blocks of the CAR file are contiguous, start after the CAR header and end at
+// the end of the CAR file. It returns the number of indexed blocks.
+func auditPieceIndex(db *gorm.DB, car model.Car) (int, error) {
+	var blocks []model.CarBlock
+	err := db.Select("id", "car_offset", "car_block_length").Where("car_id = ?", car.ID).
+		Order("car_offset asc").Find(&blocks).Error
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if len(blocks) == 0 {
+		return 0, nil
+	}
+	if blocks[0].CarOffset <= 0 {
+		return len(blocks), errors.Newf("block %d overlaps with the CAR header", blocks[0].ID)
+	}
+	end := blocks[0].CarOffset
+	for _, block := range blocks {
+		if block.CarOffset != end {
+			return len(blocks), errors.Newf("block %d is at offset %d instead of %d", block.ID, block.CarOffset, end)
+		}
+		end += int64(block.CarBlockLength)
+	}
+	if car.FileSize > 0 && end != car.FileSize {
+		return len(blocks), errors.Newf("indexed blocks end at offset %d but the CAR file has %d bytes", end, car.FileSize)
+	}
+	return len(blocks), nil
+}
+
+// auditDeal looks up the deal on chain and checks that it is active for the same piece, provider and client.
+func auditDeal(ctx context.Context, lotusClient jsonrpc.RPCClient, deal model.Deal) DealAudit {
+	audit := DealAudit{
+		DealID:   *deal.DealID,
+		Provider: deal.Provider,
+		State:    deal.State,
+	}
+	var onChain marketDeal
+	err := lotusClient.CallFor(ctx, &onChain, "Filecoin.StateMarketStorageDeal", *deal.DealID, nil)
+	switch {
+	case err != nil:
+		audit.Error = "failed to get deal from chain: " + err.Error()
+	case onChain.Proposal.PieceCID.Root != deal.PieceCID.String():
+		audit.Error = "piece CID on chain is " + onChain.Proposal.PieceCID.Root
+	case onChain.Proposal.Provider != deal.Provider:
+		audit.Error = "provider on chain is " + onChain.Proposal.Provider
+	case onChain.Proposal.Client != deal.ClientID:
+		audit.Error = "client on chain is " + onChain.Proposal.Client
+	case onChain.State.SlashEpoch > 0:
+		audit.Error = fmt.Sprintf("deal was slashed at epoch %d", onChain.State.SlashEpoch)
+	case onChain.State.SectorStartEpoch <= 0:
+		audit.Error = "deal is not active on chain"
+	default:
+		audit.OnChain = true
+	}
+	return audit
+}
+
+// probePiece checks that the piece can be retrieved from the HTTP piece retrieval endpoint at the base URL.
+func probePiece(ctx context.Context, client *http.Client, baseURL string, pieceCID string) ProbeAudit {
+	probe := ProbeAudit{URL: strings.TrimSuffix(baseURL, "/") + "/piece/" + pieceCID}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, probe.URL, nil)
+	if err != nil {
+		probe.Error = err.Error()
+		return probe
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		probe.Error = err.Error()
+		return probe
+	}
+	_ = resp.Body.Close()
+	probe.Status = resp.StatusCode
+	if resp.StatusCode != http.StatusOK {
+		probe.Error = resp.Status
+	}
+	return probe
+}
+
+// @ID AuditPieces
+// @Summary Verify piece indexes, on-chain deals and retrieval of pieces and produce an audit report
+// @Tags Report
+// @Accept json
+// @Produce json
+// @Param request body AuditRequest true "Audit Request"
+// @Success 200 {object} AuditReport
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /report/audit [post]
+func _() {}
@@ -0,0 +1,130 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	boxoutil "github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupLotusServer serves Filecoin.StateMarketStorageDeal with the given deals by deal ID.
+func setupLotusServer(t *testing.T, deals map[uint64]marketDeal) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     int    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		require.Equal(t, "Filecoin.StateMarketStorageDeal", request.Method)
+		response := map[string]any{"jsonrpc": "2.0", "id": request.ID}
+		deal, ok := deals[uint64(request.Params[0].(float64))]
+		if ok {
+			response["result"] = deal
+		} else {
+			response["error"] = map[string]any{"code": 1, "message": "deal not found"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func onChainDeal(pieceCID model.CID, provider string, sectorStartEpoch int32) marketDeal {
+	var deal marketDeal
+	deal.Proposal.PieceCID.Root = pieceCID.String()
+	deal.Proposal.Client = "f01000"
+	deal.Proposal.Provider = provider
+	deal.State.SectorStartEpoch = sectorStartEpoch
+	deal.State.SlashEpoch = -1
+	return deal
+}
+
+func TestAuditHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		preparation := model.Preparation{Name: "prep", PieceSize: 1 << 20}
+		require.NoError(t, db.Create(&preparation).Error)
+		piece1 := model.CID(cid.NewCidV1(cid.Raw, boxoutil.Hash([]byte("piece1"))))
+		piece2 := model.CID(cid.NewCidV1(cid.Raw, boxoutil.Hash([]byte("piece2"))))
+		cars := []model.Car{
+			{PieceCID: piece1, PieceSize: 1 << 20, FileSize: 150, PreparationID: preparation.ID},
+			{PieceCID: piece2, PieceSize: 1 << 20, FileSize: 160, PreparationID: preparation.ID},
+		}
+		require.NoError(t, db.Create(&cars).Error)
+		// The index of the second piece has a gap between its blocks
+		require.NoError(t, db.Create([]model.CarBlock{
+			{CarID: cars[0].ID, CID: model.CID(testutil.TestCid), CarOffset: 60, CarBlockLength: 40},
+			{CarID: cars[0].ID, CID: model.CID(testutil.TestCid), CarOffset: 100, CarBlockLength: 50},
+			{CarID: cars[1].ID, CID: model.CID(testutil.TestCid), CarOffset: 60, CarBlockLength: 40},
+			{CarID: cars[1].ID, CID: model.CID(testutil.TestCid), CarOffset: 110, CarBlockLength: 50},
+		}).Error)
+		require.NoError(t, db.Create(&model.Wallet{ID: "f01000", Address: "f1wallet"}).Error)
+		require.NoError(t, db.Create([]model.Deal{
+			{DealID: ptr.Of(uint64(1)), PieceCID: piece1, Provider: "f01", ClientID: "f01000", State: model.DealActive},
+			{DealID: ptr.Of(uint64(2)), PieceCID: piece2, Provider: "f01", ClientID: "f01000", State: model.DealActive},
+			{PieceCID: piece2, Provider: "f02", ClientID: "f01000", State: model.DealProposed},
+		}).Error)
+
+		// The second deal is with another provider on chain
+		lotus := setupLotusServer(t, map[uint64]marketDeal{
+			1: onChainDeal(piece1, "f01", 100),
+			2: onChainDeal(piece2, "f09", 100),
+		})
+		probe := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodHead, r.Method)
+			if strings.HasSuffix(r.URL.Path, piece1.String()) {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer probe.Close()
+
+		report, err := Default.AuditHandler(ctx, db, util.NewLotusClient(lotus.URL, ""), AuditRequest{
+			Preparations: []string{"prep"},
+			ProbeURLs:    []string{probe.URL + "/"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, report.TotalPieces)
+		require.Equal(t, 1, report.FailedPieces)
+		require.Equal(t, 1, report.IndexErrors)
+		require.Equal(t, 1, report.DealErrors)
+		require.Equal(t, 1, report.ProbeFailures)
+
+		require.True(t, report.Pieces[0].OK)
+		require.Equal(t, 2, report.Pieces[0].IndexedBlocks)
+		require.Len(t, report.Pieces[0].Deals, 1)
+		require.True(t, report.Pieces[0].Deals[0].OnChain)
+		require.Equal(t, http.StatusOK, report.Pieces[0].Probes[0].Status)
+		require.Equal(t, probe.URL+"/piece/"+piece1.String(), report.Pieces[0].Probes[0].URL)
+
+		require.False(t, report.Pieces[1].OK)
+		require.Contains(t, report.Pieces[1].IndexError, "instead of 100")
+		require.Len(t, report.Pieces[1].Deals, 1)
+		require.Contains(t, report.Pieces[1].Deals[0].Error, "f09")
+		require.Equal(t, http.StatusNotFound, report.Pieces[1].Probes[0].Status)
+	})
+}
+
+func TestAuditHandler_Invalid(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.AuditHandler(ctx, db, nil, AuditRequest{Preparations: []string{"notexist"}})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		_, err = Default.AuditHandler(ctx, db, nil, AuditRequest{ProbeURLs: []string{"ftp://host"}})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+}
@@ -0,0 +1,233 @@
+package report
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+const day = 24 * time.Hour
+
+// replicatedDealStates are the deal states that count towards the replication of a piece.
+var replicatedDealStates = []model.DealState{model.DealProposed, model.DealPublished, model.DealActive}
+
+type CapacityRequest struct {
+	Preparations []string `json:"preparations"`             // preparation ID or name filter
+	Window       string   `default:"168h"    json:"window"` // Period over which the current pack and deal rates are measured, i.e. 24h, 168h
+	Replicas     int      `json:"replicas"`                 // Target number of replicas per piece. Defaults to the number of providers the preparation is scheduled with, or 1.
+}
+
+type CapacityReport struct {
+	PreparationID      model.PreparationID `json:"preparationId"`
+	Preparation        string              `json:"preparation"`
+	Replicas           int                 `json:"replicas"`
+	PackedBytes        int64               `json:"packedBytes"        table:"verbose"` // Total size of CAR files packed so far
+	RemainingPackBytes int64               `json:"remainingPackBytes"`                 // Size of the data that is not packed yet
+	PackRate           int64               `json:"packRate"`                           // Bytes packed per day during the window
+	PackCompletion     *time.Time          `json:"packCompletion"     table:"format:2006-01-02"`
+	DealBytes          int64               `json:"dealBytes"          table:"verbose"` // Total size of the pieces proposed, published or active so far
+	RemainingDealBytes int64               `json:"remainingDealBytes"`                 // Size of the pieces still to be replicated, including pieces not packed yet
+	DealRate           int64               `json:"dealRate"`                           // Bytes of deals made per day during the window
+	DealCompletion     *time.Time          `json:"dealCompletion"     table:"format:2006-01-02"`
+	Completion         *time.Time          `json:"completion"         table:"format:2006-01-02"` // Projected completion date. Empty if it cannot be projected because nothing happened during the window.
+	StagingBytes       int64               `json:"stagingBytes"`                                 // Staging disk needed for CAR files that are waiting for, or not packed yet for, replication
+	DatacapBytes       int64               `json:"datacapBytes"`                                 // Datacap needed for the remaining verified deals
+}
+
+// CapacityHandler projects, for each preparation, when packing and deal making will complete given the current rates
+// and the remaining backlog, and how much staging disk and datacap are still needed to get there.
+//
+// The pack rate is the size of CAR files created during the window, and the deal rate is the size of the deals made for
+// the preparation during the window. The pack backlog is the size of all file ranges that are not packed yet, and the
+// deal backlog is the size of the pieces missing replicas, plus the pieces that will be made from the pack backlog.
+// The staging disk is the size of the exported CAR files still missing replicas, plus the pack backlog if the preparation
+// has output storages. Datacap is needed for the deal backlog unless all schedules of the preparation make non-verified deals.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The CapacityRequest with the preparation filter, the window and the target number of replicas.
+//
+// Returns:
+//   - A slice of CapacityReport, one for each preparation.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) CapacityHandler(ctx context.Context, db *gorm.DB, request CapacityRequest) ([]CapacityReport, error) {
+	db = db.WithContext(ctx)
+	if request.Window == "" {
+		request.Window = "168h"
+	}
+	window, err := time.ParseDuration(request.Window)
+	if err != nil || window <= 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid window %s", request.Window)
+	}
+	if request.Replicas < 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid number of replicas %d", request.Replicas)
+	}
+
+	var preparations []model.Preparation
+	if len(request.Preparations) == 0 {
+		err = db.Preload("OutputStorages").Find(&preparations).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	for _, id := range request.Preparations {
+		var preparation model.Preparation
+		err = preparation.FindByIDOrName(db, id, "OutputStorages")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		preparations = append(preparations, preparation)
+	}
+
+	now := time.Now()
+	reports := make([]CapacityReport, 0, len(preparations))
+	for _, preparation := range preparations {
+		report, err := capacityReport(db, preparation, request.Replicas, window, now)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build capacity report for preparation %s", preparation.Name)
+		}
+		reports = append(reports, *report)
+	}
+	return reports, nil
+}
+
+func capacityReport(db *gorm.DB, preparation model.Preparation, replicas int, window time.Duration, now time.Time) (*CapacityReport, error) {
+	since := now.Add(-window)
+	report := CapacityReport{
+		PreparationID: preparation.ID,
+		Preparation:   preparation.Name,
+	}
+
+	err := db.Model(&model.FileRange{}).Select("COALESCE(SUM(file_ranges.length), 0)").
+		Joins("JOIN files ON files.id = file_ranges.file_id").
+		Where("files.attachment_id IN (?) AND file_ranges.cid IS NULL AND file_ranges.length > 0",
+			db.Model(&model.SourceAttachment{}).Select("id").Where("preparation_id = ?", preparation.ID)).
+		Scan(&report.RemainingPackBytes).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var schedules []model.Schedule
+	err = db.Where("preparation_id = ?", preparation.ID).Find(&schedules).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	verified := len(schedules) == 0
+	for _, schedule := range schedules {
+		verified = verified || schedule.Verified
+	}
+	report.Replicas = replicas
+	if report.Replicas == 0 {
+		report.Replicas, err = preparation.ReplicationTarget(db)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var deals []model.Deal
+	err = db.Select("piece_cid", "piece_size", "provider", "created_at").
+		Where("schedule_id IN (?) AND state IN ?",
+			db.Model(&model.Schedule{}).Select("id").Where("preparation_id = ?", preparation.ID), replicatedDealStates).
+		Find(&deals).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	pieceProviders := make(map[model.CID]map[string]struct{})
+	var dealBytesInWindow int64
+	for _, deal := range deals {
+		report.DealBytes += deal.PieceSize
+		if deal.CreatedAt.After(since) {
+			dealBytesInWindow += deal.PieceSize
+		}
+		if _, ok := pieceProviders[deal.PieceCID]; !ok {
+			pieceProviders[deal.PieceCID] = make(map[string]struct{})
+		}
+		pieceProviders[deal.PieceCID][deal.Provider] = struct{}{}
+	}
+
+	var cars []model.Car
+	err = db.Select("piece_cid", "piece_size", "file_size", "storage_id", "storage_path", "created_at").
+		Where("preparation_id = ?", preparation.ID).Find(&cars).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var packedBytesInWindow int64
+	for _, car := range cars {
+		report.PackedBytes += car.FileSize
+		if car.CreatedAt.After(since) {
+			packedBytesInWindow += car.FileSize
+		}
+		missing := int64(report.Replicas - len(pieceProviders[car.PieceCID]))
+		if missing <= 0 {
+			continue
+		}
+		report.RemainingDealBytes += missing * car.PieceSize
+		if car.StorageID != nil || car.StoragePath != "" {
+			report.StagingBytes += car.FileSize
+		}
+	}
+
+	if report.RemainingPackBytes > 0 {
+		pieceCount := int64(1)
+		if preparation.MaxSize > 0 {
+			pieceCount = (report.RemainingPackBytes + preparation.MaxSize - 1) / preparation.MaxSize
+		}
+		report.RemainingDealBytes += pieceCount * preparation.PieceSize * int64(report.Replicas)
+		if len(preparation.OutputStorages) > 0 {
+			report.StagingBytes += report.RemainingPackBytes
+		}
+	}
+	if verified {
+		report.DatacapBytes = report.RemainingDealBytes
+	}
+
+	report.PackRate = int64(float64(packedBytesInWindow) / window.Hours() * 24)
+	report.DealRate = int64(float64(dealBytesInWindow) / window.Hours() * 24)
+	report.PackCompletion = projectCompletion(report.RemainingPackBytes, report.PackRate, now)
+	report.DealCompletion = projectCompletion(report.RemainingDealBytes, report.DealRate, now)
+	if report.PackCompletion != nil && report.DealCompletion != nil {
+		report.Completion = report.DealCompletion
+		if report.PackCompletion.After(*report.DealCompletion) {
+			report.Completion = report.PackCompletion
+		}
+	}
+	return &report, nil
+}
+
+// projectCompletion returns when the remaining bytes will be done at the given rate per day, or nil if
+// there are remaining bytes but no progress at all, or the projection is too far away to represent.
+func projectCompletion(remaining int64, rate int64, now time.Time) *time.Time {
+	if remaining <= 0 {
+		return &now
+	}
+	if rate <= 0 {
+		return nil
+	}
+	duration := float64(remaining) / float64(rate) * float64(day)
+	if duration >= math.MaxInt64 {
+		return nil
+	}
+	completion := now.Add(time.Duration(duration))
+	return &completion
+}
+
+// @ID GetCapacityReport
+// @Summary Project the completion date, staging disk and datacap needed for each preparation
+// @Tags Report
+// @Accept json
+// @Produce json
+// @Param request body CapacityRequest true "Capacity Request"
+// @Success 200 {array} CapacityReport
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /report/capacity [post]
+func _() {}
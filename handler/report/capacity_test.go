@@ -0,0 +1,119 @@
+package report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestCapacityHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		output := model.Storage{Name: "output"}
+		require.NoError(t, db.Create(&output).Error)
+		preparation := model.Preparation{
+			Name:           "prep",
+			PieceSize:      1 << 20,
+			MaxSize:        1 << 19,
+			SourceStorages: []model.Storage{{Name: "source"}},
+			OutputStorages: []model.Storage{output},
+		}
+		require.NoError(t, db.Create(&preparation).Error)
+		require.NoError(t, db.Create(&model.Preparation{Name: "idle"}).Error)
+		var attachment model.SourceAttachment
+		require.NoError(t, db.Where("preparation_id = ?", preparation.ID).First(&attachment).Error)
+
+		// One range is packed and the other one, spanning two pieces, is not
+		file := model.File{Path: "test.txt", Size: 1000 + 600000, AttachmentID: attachment.ID}
+		require.NoError(t, db.Create(&file).Error)
+		require.NoError(t, db.Create([]model.FileRange{
+			{FileID: file.ID, Offset: 0, Length: 1000, CID: model.CID(testutil.TestCid)},
+			{FileID: file.ID, Offset: 1000, Length: 600000},
+		}).Error)
+
+		piece1 := model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("piece1"))))
+		piece2 := model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("piece2"))))
+		require.NoError(t, db.Create([]model.Car{
+			{PieceCID: piece1, PieceSize: 1 << 20, FileSize: 1000, StorageID: ptr.Of(output.ID), StoragePath: "piece1.car",
+				PreparationID: preparation.ID, AttachmentID: ptr.Of(attachment.ID)},
+			{PieceCID: piece2, PieceSize: 1 << 20, FileSize: 2000, StorageID: ptr.Of(output.ID), StoragePath: "piece2.car",
+				PreparationID: preparation.ID, AttachmentID: ptr.Of(attachment.ID), CreatedAt: time.Now().Add(-30 * 24 * time.Hour)},
+		}).Error)
+
+		require.NoError(t, db.Create(&model.Wallet{ID: "f01000", Address: "f1wallet"}).Error)
+		schedules := []model.Schedule{
+			{PreparationID: preparation.ID, Provider: "f01", Verified: true},
+			{PreparationID: preparation.ID, Provider: "f02", Verified: true},
+		}
+		require.NoError(t, db.Create(&schedules).Error)
+		require.NoError(t, db.Create([]model.Deal{
+			{PieceCID: piece1, PieceSize: 1 << 20, Provider: "f01", State: model.DealActive, ScheduleID: &schedules[0].ID, ClientID: "f01000"},
+			{PieceCID: piece1, PieceSize: 1 << 20, Provider: "f02", State: model.DealActive, ScheduleID: &schedules[1].ID, ClientID: "f01000"},
+			{PieceCID: piece2, PieceSize: 1 << 20, Provider: "f01", State: model.DealProposed, ScheduleID: &schedules[0].ID, ClientID: "f01000"},
+			{PieceCID: piece2, PieceSize: 1 << 20, Provider: "f02", State: model.DealRejected, ScheduleID: &schedules[1].ID, ClientID: "f01000"},
+		}).Error)
+
+		reports, err := Default.CapacityHandler(ctx, db, CapacityRequest{})
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+		report := reports[0]
+		require.Equal(t, "prep", report.Preparation)
+		require.Equal(t, 2, report.Replicas)
+		require.EqualValues(t, 3000, report.PackedBytes)
+		require.EqualValues(t, 600000, report.RemainingPackBytes)
+		require.EqualValues(t, 1000/7, report.PackRate)
+		require.EqualValues(t, 3<<20, report.DealBytes)
+		require.EqualValues(t, (3<<20)/7, report.DealRate)
+		// One missing replica of piece2, and two replicas of the two pieces not packed yet
+		require.EqualValues(t, 5<<20, report.RemainingDealBytes)
+		require.EqualValues(t, 2000+600000, report.StagingBytes)
+		require.EqualValues(t, 5<<20, report.DatacapBytes)
+		require.NotNil(t, report.PackCompletion)
+		require.NotNil(t, report.DealCompletion)
+		require.NotNil(t, report.Completion)
+		require.True(t, report.PackCompletion.After(time.Now().Add(24*time.Hour)))
+		require.Equal(t, *report.PackCompletion, *report.Completion)
+
+		idle := reports[1]
+		require.Equal(t, "idle", idle.Preparation)
+		require.Equal(t, 1, idle.Replicas)
+		require.Zero(t, idle.RemainingDealBytes)
+		require.NotNil(t, idle.Completion)
+
+		reports, err = Default.CapacityHandler(ctx, db, CapacityRequest{Preparations: []string{"prep"}, Replicas: 1, Window: "1h"})
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		require.EqualValues(t, 2<<20, reports[0].RemainingDealBytes)
+		require.EqualValues(t, 600000, reports[0].StagingBytes)
+
+		require.NoError(t, db.Model(&model.Schedule{}).Where("preparation_id = ?", preparation.ID).Update("verified", false).Error)
+		require.NoError(t, db.Model(&model.Deal{}).Where("1 = 1").Update("created_at", time.Now().Add(-30*24*time.Hour)).Error)
+		reports, err = Default.CapacityHandler(ctx, db, CapacityRequest{Preparations: []string{"prep"}})
+		require.NoError(t, err)
+		require.Zero(t, reports[0].DatacapBytes)
+		require.Zero(t, reports[0].DealRate)
+		require.Nil(t, reports[0].DealCompletion)
+		require.Nil(t, reports[0].Completion)
+	})
+}
+
+func TestCapacityHandler_Invalid(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.CapacityHandler(ctx, db, CapacityRequest{Preparations: []string{"notexist"}})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		_, err = Default.CapacityHandler(ctx, db, CapacityRequest{Window: "bad"})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		_, err = Default.CapacityHandler(ctx, db, CapacityRequest{Replicas: -1})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+}
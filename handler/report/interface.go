@@ -0,0 +1,41 @@
+//nolint:forcetypeassert
+package report
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/ybbus/jsonrpc/v3"
+	"gorm.io/gorm"
+)
+
+type Handler interface {
+	CapacityHandler(ctx context.Context, db *gorm.DB, request CapacityRequest) ([]CapacityReport, error)
+	AuditHandler(ctx context.Context, db *gorm.DB, lotusClient jsonrpc.RPCClient, request AuditRequest) (*AuditReport, error)
+	LineageHandler(ctx context.Context, db *gorm.DB, request LineageRequest) ([]LineageEntry, error)
+}
+
+type DefaultHandler struct{}
+
+var Default Handler = &DefaultHandler{}
+
+var _ Handler = &MockReport{}
+
+type MockReport struct {
+	mock.Mock
+}
+
+func (m *MockReport) CapacityHandler(ctx context.Context, db *gorm.DB, request CapacityRequest) ([]CapacityReport, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).([]CapacityReport), args.Error(1)
+}
+
+func (m *MockReport) AuditHandler(ctx context.Context, db *gorm.DB, lotusClient jsonrpc.RPCClient, request AuditRequest) (*AuditReport, error) {
+	args := m.Called(ctx, db, lotusClient, request)
+	return args.Get(0).(*AuditReport), args.Error(1)
+}
+
+func (m *MockReport) LineageHandler(ctx context.Context, db *gorm.DB, request LineageRequest) ([]LineageEntry, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).([]LineageEntry), args.Error(1)
+}
@@ -0,0 +1,168 @@
+package report
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+type LineageRequest struct {
+	Preparations []string `json:"preparations"` // preparation ID or name filter
+}
+
+// LineageEntry links a file to a piece that contains it and to a deal made for that piece. A file split across
+// several pieces has an entry for each piece, and a piece with several deals has an entry for each deal.
+type LineageEntry struct {
+	PreparationID    model.PreparationID `json:"preparationId"    table:"verbose"`
+	Preparation      string              `json:"preparation"`
+	FileID           model.FileID        `json:"fileId"           table:"verbose"`
+	Path             string              `json:"path"`
+	FileSize         int64               `json:"fileSize"`
+	FileCID          string              `json:"fileCid"          table:"verbose"`
+	PieceCID         string              `json:"pieceCid"` // Empty if the file is not packed yet
+	PieceSize        int64               `json:"pieceSize"        table:"verbose"`
+	Provider         string              `json:"provider"` // Empty if no deal is made for the piece yet
+	ClientID         string              `json:"clientId"`
+	DealID           uint64              `json:"dealId"` // On-chain deal ID, 0 if the deal is not published yet
+	State            model.DealState     `json:"state"`
+	Verified         bool                `json:"verified"         table:"verbose"`
+	StartEpoch       int32               `json:"startEpoch"`
+	EndEpoch         int32               `json:"endEpoch"`
+	SectorStartEpoch int32               `json:"sectorStartEpoch" table:"verbose"`
+}
+
+// lineageStates are the states of the deals that are reported. Deals rejected by the provider or that failed to be
+// proposed never reached the chain.
+var lineageStates = []model.DealState{
+	model.DealProposed, model.DealPublished, model.DealActive, model.DealExpired, model.DealSlashed,
+}
+
+type lineageRow struct {
+	PreparationID    model.PreparationID
+	Preparation      string
+	FileID           model.FileID
+	Path             string
+	FileSize         int64
+	FileCID          model.CID `gorm:"column:file_cid"`
+	PieceCID         model.CID `gorm:"column:piece_cid"`
+	PieceSize        *int64
+	DealRowID        *model.DealID
+	Provider         *string
+	ClientID         *string
+	DealID           *uint64
+	State            *model.DealState
+	Verified         *bool
+	StartEpoch       *int32
+	EndEpoch         *int32
+	SectorStartEpoch *int32
+}
+
+// LineageHandler traces every file of the preparations to the pieces that contain it, and to the deals made for those
+// pieces with their storage providers, on-chain deal IDs and epochs. This is the item-to-deal lineage that Fil+
+// allocators ask clients to produce.
+//
+// Files that are not packed yet have a single entry without piece, and pieces without deal have a single entry
+// without deal. Deals rejected by the provider or that failed to be proposed are left out.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The LineageRequest with the preparation filter.
+//
+// Returns:
+//   - A slice of LineageEntry, sorted by file, piece and deal.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) LineageHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	request LineageRequest,
+) ([]LineageEntry, error) {
+	db = db.WithContext(ctx)
+	var preparationIDs []model.PreparationID
+	for _, id := range request.Preparations {
+		var preparation model.Preparation
+		err := preparation.FindByIDOrName(db, id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", id)
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		preparationIDs = append(preparationIDs, preparation.ID)
+	}
+
+	statement := db.Table("files").
+		Select("source_attachments.preparation_id, preparations.name AS preparation, files.id AS file_id, files.path, "+
+			"files.size AS file_size, files.cid AS file_cid, cars.piece_cid, cars.piece_size, deals.id AS deal_row_id, "+
+			"deals.provider, deals.client_id, deals.deal_id, deals.state, deals.verified, deals.start_epoch, "+
+			"deals.end_epoch, deals.sector_start_epoch").
+		Joins("JOIN source_attachments ON source_attachments.id = files.attachment_id").
+		Joins("JOIN preparations ON preparations.id = source_attachments.preparation_id").
+		Joins("LEFT JOIN file_ranges ON file_ranges.file_id = files.id").
+		Joins("LEFT JOIN cars ON cars.job_id = file_ranges.job_id").
+		Joins("LEFT JOIN deals ON deals.piece_cid = cars.piece_cid AND deals.state IN ?", lineageStates)
+	if len(preparationIDs) > 0 {
+		statement = statement.Where("source_attachments.preparation_id IN ?", preparationIDs)
+	}
+	var rows []lineageRow
+	err := statement.Order("files.id, file_ranges.id, cars.id, deals.id").Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	entries := make([]LineageEntry, 0, len(rows))
+	seen := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		entry := LineageEntry{
+			PreparationID: row.PreparationID,
+			Preparation:   row.Preparation,
+			FileID:        row.FileID,
+			Path:          row.Path,
+			FileSize:      row.FileSize,
+			FileCID:       row.FileCID.String(),
+		}
+		if row.PieceSize != nil {
+			entry.PieceCID = row.PieceCID.String()
+			entry.PieceSize = *row.PieceSize
+		}
+		var dealRowID model.DealID
+		if row.DealRowID != nil {
+			dealRowID = *row.DealRowID
+			entry.Provider = *row.Provider
+			entry.ClientID = *row.ClientID
+			entry.State = *row.State
+			entry.Verified = *row.Verified
+			entry.StartEpoch = *row.StartEpoch
+			entry.EndEpoch = *row.EndEpoch
+			entry.SectorStartEpoch = *row.SectorStartEpoch
+			if row.DealID != nil {
+				entry.DealID = *row.DealID
+			}
+		}
+		// A file with several ranges in the same piece, or a piece packed in several CAR files, is only reported once
+		key := fmt.Sprintf("%d/%s/%d", entry.FileID, entry.PieceCID, dealRowID)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// @ID GetLineageReport
+// @Summary Trace every file of the preparations to the pieces containing it and to the deals made for those pieces
+// @Tags Report
+// @Accept json
+// @Produce json
+// @Param request body LineageRequest true "Request body"
+// @Success 200 {array} LineageEntry
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /report/lineage [post]
+func _() {}
@@ -0,0 +1,88 @@
+package report
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	boxoutil "github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestLineageHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		attachment := model.SourceAttachment{
+			Preparation: &model.Preparation{Name: "prep"},
+			Storage:     &model.Storage{Name: "source"},
+		}
+		require.NoError(t, db.Create(&attachment).Error)
+		jobs := []model.Job{
+			{Type: model.Pack, State: model.Complete, AttachmentID: attachment.ID},
+			{Type: model.Pack, State: model.Complete, AttachmentID: attachment.ID},
+		}
+		require.NoError(t, db.Create(&jobs).Error)
+		piece1 := model.CID(cid.NewCidV1(cid.Raw, boxoutil.Hash([]byte("piece1"))))
+		piece2 := model.CID(cid.NewCidV1(cid.Raw, boxoutil.Hash([]byte("piece2"))))
+		require.NoError(t, db.Create([]model.Car{
+			{PieceCID: piece1, PieceSize: 1 << 20, JobID: &jobs[0].ID, PreparationID: attachment.PreparationID, AttachmentID: &attachment.ID},
+			{PieceCID: piece2, PieceSize: 1 << 20, JobID: &jobs[1].ID, PreparationID: attachment.PreparationID, AttachmentID: &attachment.ID},
+		}).Error)
+		// a.txt is split across both pieces, b.txt has two ranges in the first piece and c.txt is not packed yet
+		files := []model.File{
+			{Path: "a.txt", Size: 200, CID: model.CID(testutil.TestCid), AttachmentID: attachment.ID, FileRanges: []model.FileRange{
+				{Offset: 0, Length: 100, JobID: &jobs[0].ID},
+				{Offset: 100, Length: 100, JobID: &jobs[1].ID},
+			}},
+			{Path: "b.txt", Size: 20, AttachmentID: attachment.ID, FileRanges: []model.FileRange{
+				{Offset: 0, Length: 10, JobID: &jobs[0].ID},
+				{Offset: 10, Length: 10, JobID: &jobs[0].ID},
+			}},
+			{Path: "c.txt", Size: 30, AttachmentID: attachment.ID, FileRanges: []model.FileRange{
+				{Offset: 0, Length: 30},
+			}},
+		}
+		require.NoError(t, db.Create(&files).Error)
+		require.NoError(t, db.Create(&model.Wallet{ID: "f0100", Address: "f1wallet"}).Error)
+		require.NoError(t, db.Create([]model.Deal{
+			{DealID: ptr.Of(uint64(10)), PieceCID: piece1, Provider: "f01", ClientID: "f0100", State: model.DealActive,
+				Verified: true, StartEpoch: 100, EndEpoch: 200, SectorStartEpoch: 110},
+			{PieceCID: piece1, Provider: "f02", ClientID: "f0100", State: model.DealProposed, StartEpoch: 100, EndEpoch: 200},
+			{PieceCID: piece1, Provider: "f03", ClientID: "f0100", State: model.DealRejected},
+		}).Error)
+
+		entries, err := Default.LineageHandler(ctx, db, LineageRequest{Preparations: []string{"prep"}})
+		require.NoError(t, err)
+		require.Len(t, entries, 6)
+
+		require.Equal(t, "a.txt", entries[0].Path)
+		require.Equal(t, "prep", entries[0].Preparation)
+		require.Equal(t, testutil.TestCid.String(), entries[0].FileCID)
+		require.Equal(t, piece1.String(), entries[0].PieceCID)
+		require.Equal(t, "f01", entries[0].Provider)
+		require.EqualValues(t, 10, entries[0].DealID)
+		require.Equal(t, model.DealActive, entries[0].State)
+		require.True(t, entries[0].Verified)
+		require.EqualValues(t, 110, entries[0].SectorStartEpoch)
+		require.Equal(t, "f02", entries[1].Provider)
+		require.Zero(t, entries[1].DealID)
+		require.Equal(t, piece2.String(), entries[2].PieceCID)
+		require.Empty(t, entries[2].Provider)
+
+		require.Equal(t, "b.txt", entries[3].Path)
+		require.Equal(t, "f01", entries[3].Provider)
+		require.Equal(t, "b.txt", entries[4].Path)
+		require.Equal(t, "f02", entries[4].Provider)
+
+		require.Equal(t, "c.txt", entries[5].Path)
+		require.Empty(t, entries[5].PieceCID)
+		require.Empty(t, entries[5].Provider)
+
+		_, err = Default.LineageHandler(ctx, db, LineageRequest{Preparations: []string{"notexist"}})
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+	})
+}
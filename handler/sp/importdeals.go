@@ -0,0 +1,340 @@
+package sp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/version"
+	commcid "github.com/filecoin-project/go-fil-commcid"
+	commp "github.com/filecoin-project/go-fil-commp-hashhash"
+	"github.com/google/uuid"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-log/v2"
+	"github.com/ybbus/jsonrpc/v3"
+)
+
+var logger = log.Logger("sp")
+
+const (
+	ImportStatusImported = "imported"
+	ImportStatusSkipped  = "skipped"
+	ImportStatusFailed   = "failed"
+)
+
+type ImportDealsRequest struct {
+	Provider             string // Storage provider ID the deals are proposed to, i.e. f01234
+	ContentProvider      string // Base URL of the content provider serving the pieces, i.e. http://127.0.0.1:7777
+	ContentProviderToken string // Token the content provider requires to list the pending deals
+	DownloadDir          string // Directory to download the CAR files to
+	DeleteAfterImport    bool   // Whether boost deletes the CAR file once the piece is added to a sector
+}
+
+type ImportResult struct {
+	DealUUID string `json:"dealUuid"`
+	PieceCID string `json:"pieceCid"`
+	FilePath string `json:"filePath" table:"verbose"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+}
+
+// boostDeal is the subset of the boost provider deal state that is needed to import offline deals.
+type boostDeal struct {
+	DealUUID           string `json:"DealUuid"`
+	IsOffline          bool
+	InboundFilePath    string
+	ClientDealProposal struct {
+		Proposal struct {
+			PieceCID struct {
+				Root string `json:"/"`
+			}
+		}
+	}
+}
+
+type boostRejection struct {
+	Accepted bool
+	Reason   string
+}
+
+// ImportDealsHandler runs next to the boost of a storage provider and imports the offline deals proposed to it.
+// It lists the deals pending import from the content provider and, for each deal that boost is waiting for data of,
+// downloads the piece from the content provider, verifies its commP against the piece CID of the deal, and triggers
+// the import of the CAR file with boost.
+//
+// Downloads are resumed from partial files left by a previous run, and CAR files that were already downloaded
+// are verified again before being imported. A failure to import one deal does not stop the other deals.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - boostClient: The RPC client of the boost API.
+//   - request: The ImportDealsRequest with the provider, the content provider and the download directory.
+//
+// Returns:
+//   - A slice of ImportResult, one for each pending deal.
+//   - An error if the pending deals cannot be listed.
+func (DefaultHandler) ImportDealsHandler(ctx context.Context, boostClient jsonrpc.RPCClient, request ImportDealsRequest) ([]ImportResult, error) {
+	if request.Provider == "" {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "provider is required")
+	}
+	if request.DownloadDir == "" {
+		request.DownloadDir = "."
+	}
+	downloadDir, err := filepath.Abs(request.DownloadDir)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = os.MkdirAll(downloadDir, 0755)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create download directory %s", downloadDir)
+	}
+	contentProvider := strings.TrimSuffix(request.ContentProvider, "/")
+
+	var pending []PendingDeal
+	err = getJSON(ctx, contentProvider+"/deal/pending/"+url.PathEscape(request.Provider), request.ContentProviderToken, &pending)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pending deals")
+	}
+
+	results := make([]ImportResult, 0, len(pending))
+	for _, deal := range pending {
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+		result := ImportResult{
+			DealUUID: deal.DealUUID,
+			PieceCID: deal.PieceCID,
+			Status:   ImportStatusFailed,
+		}
+		// The piece CID comes from the content provider, so the file name is derived from the parsed CID only
+		fileName, err := carFileName(deal.PieceCID)
+		if err != nil {
+			result.Error = err.Error()
+			results = append(results, result)
+			continue
+		}
+		deal.PieceCID = strings.TrimSuffix(fileName, ".car")
+		result.FilePath = filepath.Join(downloadDir, fileName)
+		status, err := importDeal(ctx, boostClient, contentProvider, deal, result.FilePath, request.DeleteAfterImport)
+		if err != nil {
+			logger.Warnw("failed to import deal", "deal", deal.DealUUID, "piece", deal.PieceCID, "error", err)
+			result.Error = err.Error()
+		}
+		if status != "" {
+			result.Status = status
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// importDeal imports a single deal. It returns ImportStatusSkipped with the reason as error if boost is not
+// waiting for data of the deal.
+func importDeal(
+	ctx context.Context,
+	boostClient jsonrpc.RPCClient,
+	contentProvider string,
+	deal PendingDeal,
+	filePath string,
+	deleteAfterImport bool,
+) (string, error) {
+	status, err := checkBoostDeal(ctx, boostClient, deal.DealUUID, deal.PieceCID)
+	if err != nil {
+		return status, err
+	}
+	err = downloadPiece(ctx, contentProvider+"/piece/"+deal.PieceCID, filePath)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to download piece")
+	}
+	_, _, err = verifyPiece(filePath, deal.PieceCID, deal.PieceSize)
+	if err != nil {
+		_ = os.Remove(filePath)
+		return "", err
+	}
+	return importWithBoost(ctx, boostClient, deal.DealUUID, deal.PieceCID, filePath, deleteAfterImport)
+}
+
+// checkBoostDeal checks that boost is waiting for data of the deal. It returns ImportStatusSkipped with the reason as
+// error if it is not.
+func checkBoostDeal(ctx context.Context, boostClient jsonrpc.RPCClient, dealUUID string, pieceCID string) (string, error) {
+	if _, err := uuid.Parse(dealUUID); err != nil {
+		return ImportStatusSkipped, errors.New("not a boost deal")
+	}
+	var state boostDeal
+	err := boostClient.CallFor(ctx, &state, "Boost.BoostDeal", dealUUID)
+	if err != nil {
+		return ImportStatusSkipped, errors.Wrap(err, "failed to get deal from boost")
+	}
+	if !state.IsOffline {
+		return ImportStatusSkipped, errors.New("not an offline deal")
+	}
+	if state.InboundFilePath != "" {
+		return ImportStatusSkipped, errors.Newf("already imported from %s", state.InboundFilePath)
+	}
+	if state.ClientDealProposal.Proposal.PieceCID.Root != pieceCID {
+		return "", errors.Newf("piece CID in boost is %s", state.ClientDealProposal.Proposal.PieceCID.Root)
+	}
+	return "", nil
+}
+
+// importWithBoost triggers the import of the CAR file of an offline deal with boost.
+func importWithBoost(
+	ctx context.Context,
+	boostClient jsonrpc.RPCClient,
+	dealUUID string,
+	pieceCID string,
+	filePath string,
+	deleteAfterImport bool,
+) (string, error) {
+	var rejection boostRejection
+	err := boostClient.CallFor(ctx, &rejection, "Boost.BoostOfflineDealWithData", dealUUID, filePath, deleteAfterImport)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to import deal with boost")
+	}
+	if !rejection.Accepted {
+		return "", errors.Newf("boost rejected the import: %s", rejection.Reason)
+	}
+	logger.Infow("imported deal", "deal", dealUUID, "piece", pieceCID, "path", filePath)
+	return ImportStatusImported, nil
+}
+
+// carFileName returns the name of the CAR file of a piece, which is the canonical string of the piece CID with a .car
+// extension, so a piece CID from an untrusted source cannot name a file outside of the directory.
+func carFileName(pieceCID string) (string, error) {
+	parsed, err := cid.Parse(pieceCID)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid piece CID %q", pieceCID)
+	}
+	return parsed.String() + ".car", nil
+}
+
+// getJSON gets a JSON response from the content provider, with the token as bearer token if it is not empty.
+func getJSON(ctx context.Context, url string, token string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	version.SetRequestHeader(req)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return errors.Newf("content provider returned %d: %s", resp.StatusCode, string(body))
+	}
+	return errors.WithStack(json.NewDecoder(resp.Body).Decode(out))
+}
+
+// downloadPiece downloads the piece to the file path, unless the file already exists. The piece is downloaded to a
+// partial file first, which is resumed with a range request if it is left over by a previous run.
+func downloadPiece(ctx context.Context, url string, filePath string) error {
+	if _, err := os.Stat(filePath); err == nil {
+		return nil
+	}
+	partPath := filePath + ".part"
+	var offset int64
+	if stat, err := os.Stat(partPath); err == nil {
+		offset = stat.Size()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	version.SetRequestHeader(req)
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	flag := os.O_CREATE | os.O_WRONLY
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+		flag |= os.O_APPEND
+	case resp.StatusCode == http.StatusOK:
+		flag |= os.O_TRUNC
+	default:
+		return errors.Newf("content provider returned %s", resp.Status)
+	}
+	file, err := os.OpenFile(partPath, flag, 0644)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = io.Copy(file, resp.Body)
+	if err != nil {
+		_ = file.Close()
+		return errors.WithStack(err)
+	}
+	err = file.Close()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(partPath, filePath))
+}
+
+// verifyPiece checks that the commP of the CAR file matches the piece CID, and returns the SHA-256 checksum of the
+// CAR file and the piece size. The commP is padded to the piece size, or, if the piece size is unknown, to every
+// power of two up to the maximum sector size until it matches.
+func verifyPiece(filePath string, pieceCID string, pieceSize int64) (string, int64, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", 0, errors.WithStack(err)
+	}
+	defer file.Close()
+	calc := &commp.Calc{}
+	hasher := sha256.New()
+	_, err = io.Copy(io.MultiWriter(calc, hasher), file)
+	if err != nil {
+		return "", 0, errors.Wrap(err, "failed to calculate commP")
+	}
+	checksum := hex.EncodeToString(hasher.Sum(nil))
+	rawCommP, rawPieceSize, err := calc.Digest()
+	if err != nil {
+		return "", 0, errors.Wrap(err, "failed to calculate commP")
+	}
+	targets := []uint64{uint64(pieceSize)}
+	if pieceSize <= 0 {
+		targets = nil
+		for size := rawPieceSize; size <= util.MaxPieceSize; size *= 2 {
+			targets = append(targets, size)
+		}
+	}
+	var commCid cid.Cid
+	for _, target := range targets {
+		padded := rawCommP
+		if rawPieceSize < target {
+			padded, err = commp.PadCommP(rawCommP, rawPieceSize, target)
+			if err != nil {
+				return "", 0, errors.Wrap(err, "failed to pad commP")
+			}
+		}
+		commCid, err = commcid.DataCommitmentV1ToCID(padded)
+		if err != nil {
+			return "", 0, errors.WithStack(err)
+		}
+		if commCid.String() == pieceCID {
+			return checksum, int64(target), nil
+		}
+	}
+	return "", 0, errors.Newf("commP of the downloaded piece is %s", commCid.String())
+}
@@ -0,0 +1,154 @@
+package sp
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack"
+	"github.com/data-preservation-programs/singularity/util"
+	commp "github.com/filecoin-project/go-fil-commp-hashhash"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "token"
+
+func randomPiece(t *testing.T) ([]byte, string) {
+	content := make([]byte, 1000)
+	_, err := rand.Read(content)
+	require.NoError(t, err)
+	calc := &commp.Calc{}
+	_, err = bytes.NewBuffer(content).WriteTo(calc)
+	require.NoError(t, err)
+	pieceCID, _, err := pack.GetCommp(calc, 2048)
+	require.NoError(t, err)
+	return content, pieceCID.String()
+}
+
+// newContentProvider serves the pending deals of f01234 to the holders of the token and the content of the pieces.
+func newContentProvider(t *testing.T, pending []PendingDeal, pieces map[string][]byte) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/deal/pending/f01234" {
+			if r.Header.Get("Authorization") != "Bearer "+testToken {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(pending))
+			return
+		}
+		content, ok := pieces[strings.TrimPrefix(r.URL.Path, "/piece/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newBoost serves the boost RPC API for the given offline deals, and records the imported deals.
+func newBoost(t *testing.T, offline map[string]string, imported *[]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     int    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		dealUUID := request.Params[0].(string)
+		var result any
+		switch request.Method {
+		case "Boost.BoostDeal":
+			piece, ok := offline[dealUUID]
+			result = map[string]any{
+				"DealUuid":           dealUUID,
+				"IsOffline":          ok,
+				"ClientDealProposal": map[string]any{"Proposal": map[string]any{"PieceCID": map[string]string{"/": piece}}},
+			}
+		case "Boost.BoostOfflineDealWithData":
+			*imported = append(*imported, dealUUID)
+			result = map[string]any{"Accepted": true}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": result}))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestImportDealsHandler(t *testing.T) {
+	content1, piece1 := randomPiece(t)
+	content2, piece2 := randomPiece(t)
+	_, piece3 := randomPiece(t)
+	uuid1, uuid2, uuid3 := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	cp := newContentProvider(t, []PendingDeal{
+		{DealUUID: uuid1, PieceCID: piece1, PieceSize: 2048, State: model.DealProposed},
+		{DealUUID: uuid2, PieceCID: piece2, PieceSize: 2048, State: model.DealProposed},
+		{DealUUID: uuid3, PieceCID: piece3, PieceSize: 2048, State: model.DealProposed},
+		{DealUUID: "bafylegacy", PieceCID: piece1, PieceSize: 2048, State: model.DealPublished},
+		// A piece CID that is not a CID cannot name a file outside of the download directory
+		{DealUUID: uuid.NewString(), PieceCID: "../escaped", PieceSize: 2048, State: model.DealProposed},
+	}, map[string][]byte{
+		piece1: content1,
+		piece2: content2,
+		// The content provider serves the wrong content for piece3
+		piece3: content2,
+	})
+	var imported []string
+	boost := newBoost(t, map[string]string{uuid1: piece1, uuid3: piece3}, &imported)
+
+	// A partial download of piece1 is resumed
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, piece1+".car.part"), content1[:100], 0644))
+
+	request := ImportDealsRequest{
+		Provider:        "f01234",
+		ContentProvider: cp.URL + "/",
+		DownloadDir:     dir,
+	}
+	_, err := Default.ImportDealsHandler(context.Background(), util.NewLotusClient(boost.URL, ""), request)
+	require.ErrorContains(t, err, "401")
+
+	request.ContentProviderToken = testToken
+	results, err := Default.ImportDealsHandler(context.Background(), util.NewLotusClient(boost.URL, ""), request)
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	require.Equal(t, ImportStatusImported, results[0].Status)
+	require.Equal(t, filepath.Join(dir, piece1+".car"), results[0].FilePath)
+	downloaded, err := os.ReadFile(results[0].FilePath)
+	require.NoError(t, err)
+	require.Equal(t, content1, downloaded)
+
+	require.Equal(t, ImportStatusSkipped, results[1].Status)
+	require.Contains(t, results[1].Error, "not an offline deal")
+
+	require.Equal(t, ImportStatusFailed, results[2].Status)
+	require.Contains(t, results[2].Error, "commP")
+	require.NoFileExists(t, results[2].FilePath)
+
+	require.Equal(t, ImportStatusSkipped, results[3].Status)
+	require.Contains(t, results[3].Error, "not a boost deal")
+
+	require.Equal(t, ImportStatusFailed, results[4].Status)
+	require.Contains(t, results[4].Error, "invalid piece CID")
+	require.Empty(t, results[4].FilePath)
+
+	require.Equal(t, []string{uuid1}, imported)
+}
+
+func TestImportDealsHandler_Invalid(t *testing.T) {
+	_, err := Default.ImportDealsHandler(context.Background(), nil, ImportDealsRequest{ContentProvider: "http://127.0.0.1:7777"})
+	require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+}
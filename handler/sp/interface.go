@@ -0,0 +1,65 @@
+//nolint:forcetypeassert
+package sp
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/ybbus/jsonrpc/v3"
+	"gorm.io/gorm"
+)
+
+type Handler interface {
+	ListPendingDealsHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		provider string,
+	) ([]PendingDeal, error)
+
+	ImportDealsHandler(
+		ctx context.Context,
+		boostClient jsonrpc.RPCClient,
+		request ImportDealsRequest,
+	) ([]ImportResult, error)
+
+	SyncPiecesHandler(
+		ctx context.Context,
+		request SyncPiecesRequest,
+	) (*MediaManifest, error)
+
+	ImportMediaHandler(
+		ctx context.Context,
+		boostClient jsonrpc.RPCClient,
+		request ImportMediaRequest,
+	) ([]ImportResult, error)
+}
+
+type DefaultHandler struct{}
+
+var Default Handler = &DefaultHandler{}
+
+var _ Handler = &MockSP{}
+
+type MockSP struct {
+	mock.Mock
+}
+
+func (m *MockSP) ListPendingDealsHandler(ctx context.Context, db *gorm.DB, provider string) ([]PendingDeal, error) {
+	args := m.Called(ctx, db, provider)
+	return args.Get(0).([]PendingDeal), args.Error(1)
+}
+
+func (m *MockSP) ImportDealsHandler(ctx context.Context, boostClient jsonrpc.RPCClient, request ImportDealsRequest) ([]ImportResult, error) {
+	args := m.Called(ctx, boostClient, request)
+	return args.Get(0).([]ImportResult), args.Error(1)
+}
+
+func (m *MockSP) SyncPiecesHandler(ctx context.Context, request SyncPiecesRequest) (*MediaManifest, error) {
+	args := m.Called(ctx, request)
+	return args.Get(0).(*MediaManifest), args.Error(1)
+}
+
+func (m *MockSP) ImportMediaHandler(ctx context.Context, boostClient jsonrpc.RPCClient, request ImportMediaRequest) ([]ImportResult, error) {
+	args := m.Called(ctx, boostClient, request)
+	return args.Get(0).([]ImportResult), args.Error(1)
+}
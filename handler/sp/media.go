@@ -0,0 +1,303 @@
+package sp
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/ipfs/go-cid"
+	"github.com/ybbus/jsonrpc/v3"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	MediaManifestFile    = "manifest.json"
+	MediaManifestVersion = 1
+	ImportStatusVerified = "verified"
+)
+
+// MediaPiece is a CAR file synced to removable media.
+type MediaPiece struct {
+	PieceCID  string   `json:"pieceCid"`
+	PieceSize int64    `json:"pieceSize"`
+	FileName  string   `json:"fileName"  table:"verbose"` // Name of the CAR file, relative to the manifest
+	FileSize  int64    `json:"fileSize"`
+	SHA256    string   `json:"sha256"    table:"verbose"`
+	DealUUIDs []string `json:"dealUuids"` // Deals pending import of the piece by the receiving storage provider
+}
+
+// MediaManifest lists the CAR files synced to removable media, so the receiving storage provider can verify them and
+// import the deals they are for.
+type MediaManifest struct {
+	Version   int          `json:"version"`
+	UpdatedAt time.Time    `json:"updatedAt" table:"format:2006-01-02 15:04:05"`
+	Provider  string       `json:"provider"`
+	Pieces    []MediaPiece `json:"pieces"    table:"expand"`
+}
+
+type SyncPiecesRequest struct {
+	ContentProvider      string   // Base URL of the content provider serving the pieces, i.e. http://127.0.0.1:7777
+	ContentProviderToken string   // Token the content provider requires to list the pending deals
+	Dir                  string   // Directory on the removable media to sync the pieces to
+	Provider             string   // Storage provider ID to sync the pieces of all deals pending import for
+	Pieces               []string // Piece CIDs to sync
+}
+
+type ImportMediaRequest struct {
+	Dir               string // Directory on the removable media with the manifest
+	DeleteAfterImport bool   // Whether boost deletes the CAR file once the piece is added to a sector
+}
+
+// SyncPiecesHandler syncs a selected set of pieces from a content provider to removable media, for couriers to deliver
+// offline deals. The pieces are selected by piece CID, or as all pieces of the deals pending import by a storage
+// provider. Each piece is downloaded as <piece_cid>.car, its commP is verified against the piece CID, and it is added
+// to the manifest together with its SHA-256 checksum and the deals it is for.
+//
+// The manifest is written after every piece, so an interrupted sync is resumed by running it again: pieces already in
+// the manifest are skipped, and partial downloads are resumed.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - request: The SyncPiecesRequest with the content provider, the directory and the selected pieces.
+//
+// Returns:
+//   - A pointer to the updated MediaManifest.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) SyncPiecesHandler(ctx context.Context, request SyncPiecesRequest) (*MediaManifest, error) {
+	if request.Provider == "" && len(request.Pieces) == 0 {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "either a provider or pieces are required")
+	}
+	for _, piece := range request.Pieces {
+		if _, err := cid.Parse(piece); err != nil {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid piece CID %s", piece)
+		}
+	}
+	err := os.MkdirAll(request.Dir, 0755)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create directory %s", request.Dir)
+	}
+	manifest, err := readMediaManifest(request.Dir)
+	if errors.Is(err, os.ErrNotExist) {
+		manifest = &MediaManifest{Version: MediaManifestVersion, Provider: request.Provider}
+	} else if err != nil {
+		return nil, err
+	}
+	if request.Provider != "" {
+		if manifest.Provider != "" && manifest.Provider != request.Provider {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "the media is synced for provider %s", manifest.Provider)
+		}
+		manifest.Provider = request.Provider
+	}
+	contentProvider := strings.TrimSuffix(request.ContentProvider, "/")
+
+	// Select the pieces, keeping the order in which they were requested
+	var selected []MediaPiece
+	index := make(map[string]int)
+	selectPiece := func(pieceCID string, pieceSize int64, dealUUID string) error {
+		fileName, err := carFileName(pieceCID)
+		if err != nil {
+			return err
+		}
+		pieceCID = strings.TrimSuffix(fileName, ".car")
+		i, ok := index[pieceCID]
+		if !ok {
+			i = len(selected)
+			index[pieceCID] = i
+			selected = append(selected, MediaPiece{PieceCID: pieceCID, PieceSize: pieceSize, FileName: fileName})
+		}
+		if dealUUID != "" {
+			selected[i].DealUUIDs = append(selected[i].DealUUIDs, dealUUID)
+		}
+		return nil
+	}
+	if request.Provider != "" {
+		var pending []PendingDeal
+		err = getJSON(ctx, contentProvider+"/deal/pending/"+url.PathEscape(request.Provider), request.ContentProviderToken, &pending)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list pending deals")
+		}
+		for _, deal := range pending {
+			err = selectPiece(deal.PieceCID, deal.PieceSize, deal.DealUUID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid pending deal %s", deal.DealUUID)
+			}
+		}
+	}
+	for _, piece := range request.Pieces {
+		err = selectPiece(piece, 0, "")
+		if err != nil {
+			return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+		}
+	}
+
+	synced := make(map[string]int)
+	for i, piece := range manifest.Pieces {
+		synced[piece.PieceCID] = i
+	}
+	for _, piece := range selected {
+		if ctx.Err() != nil {
+			return manifest, ctx.Err()
+		}
+		if i, ok := synced[piece.PieceCID]; ok {
+			// Already synced, only record the deals that are new since
+			for _, dealUUID := range piece.DealUUIDs {
+				if !slices.Contains(manifest.Pieces[i].DealUUIDs, dealUUID) {
+					manifest.Pieces[i].DealUUIDs = append(manifest.Pieces[i].DealUUIDs, dealUUID)
+				}
+			}
+			continue
+		}
+		filePath := filepath.Join(request.Dir, piece.FileName)
+		err = downloadPiece(ctx, contentProvider+"/piece/"+piece.PieceCID, filePath)
+		if err != nil {
+			return manifest, errors.Wrapf(err, "failed to download piece %s", piece.PieceCID)
+		}
+		piece.SHA256, piece.PieceSize, err = verifyPiece(filePath, piece.PieceCID, piece.PieceSize)
+		if err != nil {
+			_ = os.Remove(filePath)
+			return manifest, errors.Wrapf(err, "failed to verify piece %s", piece.PieceCID)
+		}
+		stat, err := os.Stat(filePath)
+		if err != nil {
+			return manifest, errors.WithStack(err)
+		}
+		piece.FileSize = stat.Size()
+		synced[piece.PieceCID] = len(manifest.Pieces)
+		manifest.Pieces = append(manifest.Pieces, piece)
+		err = writeMediaManifest(request.Dir, manifest)
+		if err != nil {
+			return manifest, err
+		}
+		logger.Infow("synced piece", "piece", piece.PieceCID, "path", filePath)
+	}
+	return manifest, writeMediaManifest(request.Dir, manifest)
+}
+
+// ImportMediaHandler runs on the receiving storage provider side. It verifies the size, the SHA-256 checksum and the
+// commP of every CAR file listed in the manifest of the removable media and, if a boost client is given, triggers the
+// import of each deal the piece is for that boost is waiting for data of.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - boostClient: The RPC client of the boost API, or nil to only verify the CAR files.
+//   - request: The ImportMediaRequest with the directory of the manifest.
+//
+// Returns:
+//   - A slice of ImportResult, one for each deal, or for each piece without deals.
+//   - An error if the manifest cannot be read.
+func (DefaultHandler) ImportMediaHandler(ctx context.Context, boostClient jsonrpc.RPCClient, request ImportMediaRequest) ([]ImportResult, error) {
+	dir, err := filepath.Abs(request.Dir)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	manifest, err := readMediaManifest(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "no manifest found in %s", dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var results []ImportResult
+	for _, piece := range manifest.Pieces {
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+		// The manifest comes from the media, so only the CAR file named after the piece CID in the directory is read
+		filePath := filepath.Join(dir, piece.FileName)
+		dealUUIDs := piece.DealUUIDs
+		if boostClient == nil || len(dealUUIDs) == 0 {
+			dealUUIDs = []string{""}
+		}
+		fileName, err := carFileName(piece.PieceCID)
+		if err == nil && piece.FileName != fileName {
+			err = errors.Newf("file name %q does not match the piece CID", piece.FileName)
+		}
+		if err == nil {
+			err = verifyMediaPiece(filePath, piece)
+		}
+		for _, dealUUID := range dealUUIDs {
+			result := ImportResult{
+				DealUUID: dealUUID,
+				PieceCID: piece.PieceCID,
+				FilePath: filePath,
+				Status:   ImportStatusVerified,
+			}
+			switch {
+			case err != nil:
+				result.Status = ImportStatusFailed
+				result.Error = err.Error()
+			case boostClient != nil && dealUUID != "":
+				status, err := checkBoostDeal(ctx, boostClient, dealUUID, piece.PieceCID)
+				if err == nil {
+					status, err = importWithBoost(ctx, boostClient, dealUUID, piece.PieceCID, filePath, request.DeleteAfterImport)
+				}
+				result.Status = status
+				if err != nil {
+					result.Error = err.Error()
+				}
+				if result.Status == "" {
+					result.Status = ImportStatusFailed
+				}
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+func verifyMediaPiece(filePath string, piece MediaPiece) error {
+	stat, err := os.Stat(filePath)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if stat.Size() != piece.FileSize {
+		return errors.Newf("file size is %d instead of %d", stat.Size(), piece.FileSize)
+	}
+	checksum, _, err := verifyPiece(filePath, piece.PieceCID, piece.PieceSize)
+	if err != nil {
+		return err
+	}
+	if checksum != piece.SHA256 {
+		return errors.Newf("checksum is %s instead of %s", checksum, piece.SHA256)
+	}
+	return nil
+}
+
+func readMediaManifest(dir string) (*MediaManifest, error) {
+	content, err := os.ReadFile(filepath.Join(dir, MediaManifestFile))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var manifest MediaManifest
+	err = json.Unmarshal(content, &manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode manifest")
+	}
+	if manifest.Version != MediaManifestVersion {
+		return nil, errors.Newf("unsupported manifest version %d", manifest.Version)
+	}
+	return &manifest, nil
+}
+
+// writeMediaManifest writes the manifest to a temporary file first, so the manifest is never left half written if the
+// media is removed during the sync.
+func writeMediaManifest(dir string, manifest *MediaManifest) error {
+	manifest.UpdatedAt = time.Now().UTC()
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	path := filepath.Join(dir, MediaManifestFile)
+	err = os.WriteFile(path+".tmp", content, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to write manifest")
+	}
+	return errors.Wrap(os.Rename(path+".tmp", path), "failed to write manifest")
+}
@@ -0,0 +1,98 @@
+package sp
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncPiecesAndImportMedia(t *testing.T) {
+	ctx := context.Background()
+	content1, piece1 := randomPiece(t)
+	content2, piece2 := randomPiece(t)
+	content3, piece3 := randomPiece(t)
+	uuid1, uuid2 := uuid.NewString(), uuid.NewString()
+	pending := []PendingDeal{
+		{DealUUID: uuid1, PieceCID: piece1, PieceSize: 2048, State: model.DealProposed},
+		{DealUUID: uuid2, PieceCID: piece2, PieceSize: 2048, State: model.DealProposed},
+	}
+	pieces := map[string][]byte{piece1: content1, piece2: content1, piece3: content3}
+	cp := newContentProvider(t, pending, pieces)
+	dir := t.TempDir()
+	request := SyncPiecesRequest{
+		ContentProvider:      cp.URL,
+		ContentProviderToken: testToken,
+		Dir:                  dir,
+		Provider:             "f01234",
+		Pieces:               []string{piece3},
+	}
+
+	// piece2 is corrupted, so the sync stops after piece1
+	_, err := Default.SyncPiecesHandler(ctx, request)
+	require.ErrorContains(t, err, "commP")
+	manifest, err := readMediaManifest(dir)
+	require.NoError(t, err)
+	require.Len(t, manifest.Pieces, 1)
+	require.NoFileExists(t, filepath.Join(dir, piece2+".car"))
+
+	// The sync is resumed once the content provider serves the right content
+	pieces[piece2] = content2
+	manifest, err = Default.SyncPiecesHandler(ctx, request)
+	require.NoError(t, err)
+	require.Equal(t, "f01234", manifest.Provider)
+	require.Len(t, manifest.Pieces, 3)
+	require.Equal(t, piece1, manifest.Pieces[0].PieceCID)
+	require.Equal(t, []string{uuid1}, manifest.Pieces[0].DealUUIDs)
+	require.EqualValues(t, len(content1), manifest.Pieces[0].FileSize)
+	require.Len(t, manifest.Pieces[0].SHA256, 64)
+	require.Equal(t, piece2, manifest.Pieces[1].PieceCID)
+	require.Equal(t, piece3, manifest.Pieces[2].PieceCID)
+	require.EqualValues(t, 2048, manifest.Pieces[2].PieceSize)
+	require.Empty(t, manifest.Pieces[2].DealUUIDs)
+
+	// Without boost, the CAR files are only verified
+	require.NoError(t, os.WriteFile(filepath.Join(dir, piece3+".car"), content1, 0644))
+	results, err := Default.ImportMediaHandler(ctx, nil, ImportMediaRequest{Dir: dir})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, ImportStatusVerified, results[0].Status)
+	require.Equal(t, ImportStatusVerified, results[1].Status)
+	require.Equal(t, ImportStatusFailed, results[2].Status)
+
+	var imported []string
+	boost := newBoost(t, map[string]string{uuid1: piece1, uuid2: piece2}, &imported)
+	results, err = Default.ImportMediaHandler(ctx, util.NewLotusClient(boost.URL, ""), ImportMediaRequest{Dir: dir})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, uuid1, results[0].DealUUID)
+	require.Equal(t, ImportStatusImported, results[0].Status)
+	require.Equal(t, ImportStatusImported, results[1].Status)
+	require.Equal(t, []string{uuid1, uuid2}, imported)
+
+	// A CAR file outside of the directory is never read from a tampered manifest
+	manifest.Pieces[0].FileName = "../" + piece1 + ".car"
+	require.NoError(t, writeMediaManifest(dir, manifest))
+	results, err = Default.ImportMediaHandler(ctx, nil, ImportMediaRequest{Dir: dir})
+	require.NoError(t, err)
+	require.Equal(t, ImportStatusFailed, results[0].Status)
+	require.Contains(t, results[0].Error, "does not match the piece CID")
+}
+
+func TestSyncPiecesHandler_Invalid(t *testing.T) {
+	ctx := context.Background()
+	_, err := Default.SyncPiecesHandler(ctx, SyncPiecesRequest{Dir: t.TempDir()})
+	require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+	_, err = Default.SyncPiecesHandler(ctx, SyncPiecesRequest{Dir: t.TempDir(), Pieces: []string{"invalid"}})
+	require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+	_, err = Default.ImportMediaHandler(ctx, nil, ImportMediaRequest{Dir: t.TempDir()})
+	require.ErrorIs(t, err, handlererror.ErrNotFound)
+}
@@ -0,0 +1,54 @@
+package sp
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+// PendingDeal is a deal proposed to a storage provider that is still waiting for its piece to be imported.
+type PendingDeal struct {
+	DealUUID   string          `json:"dealUuid"` // Deal UUID for boost deals, or the proposal CID for legacy market deals
+	PieceCID   string          `json:"pieceCid"`
+	PieceSize  int64           `json:"pieceSize"`
+	StartEpoch int32           `json:"startEpoch"`
+	State      model.DealState `json:"state"`
+}
+
+// ListPendingDealsHandler lists the deals proposed to a storage provider that are not active yet, ordered by start
+// epoch, so the storage provider knows which pieces to download and import.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - provider: The storage provider ID the deals are proposed to, i.e. f01234.
+//
+// Returns:
+//   - A slice of PendingDeal, ordered by start epoch.
+//   - An error, if the provider is empty or if any other error occurred.
+func (DefaultHandler) ListPendingDealsHandler(ctx context.Context, db *gorm.DB, provider string) ([]PendingDeal, error) {
+	db = db.WithContext(ctx)
+	if provider == "" {
+		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "provider is required")
+	}
+	var deals []model.Deal
+	err := db.Where("provider = ? AND state IN ?", provider, []model.DealState{model.DealProposed, model.DealPublished}).
+		Order("start_epoch asc").Order("id asc").Find(&deals).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	pending := make([]PendingDeal, 0, len(deals))
+	for _, deal := range deals {
+		pending = append(pending, PendingDeal{
+			DealUUID:   deal.ProposalID,
+			PieceCID:   deal.PieceCID.String(),
+			PieceSize:  deal.PieceSize,
+			StartEpoch: deal.StartEpoch,
+			State:      deal.State,
+		})
+	}
+	return pending, nil
+}
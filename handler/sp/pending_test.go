@@ -0,0 +1,36 @@
+package sp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestListPendingDealsHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		require.NoError(t, db.Create(&model.Wallet{ID: "f01000", Address: "f1wallet"}).Error)
+		require.NoError(t, db.Create([]model.Deal{
+			{ProposalID: "uuid2", PieceCID: model.CID(testutil.TestCid), PieceSize: 2048, Provider: "f01234", ClientID: "f01000", State: model.DealPublished, StartEpoch: 200},
+			{ProposalID: "uuid1", PieceCID: model.CID(testutil.TestCid), PieceSize: 2048, Provider: "f01234", ClientID: "f01000", State: model.DealProposed, StartEpoch: 100},
+			{ProposalID: "uuid3", PieceCID: model.CID(testutil.TestCid), PieceSize: 2048, Provider: "f01234", ClientID: "f01000", State: model.DealActive, StartEpoch: 100},
+			{ProposalID: "uuid4", PieceCID: model.CID(testutil.TestCid), PieceSize: 2048, Provider: "f05678", ClientID: "f01000", State: model.DealProposed, StartEpoch: 100},
+		}).Error)
+
+		pending, err := Default.ListPendingDealsHandler(ctx, db, "f01234")
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		require.Equal(t, "uuid1", pending[0].DealUUID)
+		require.Equal(t, testutil.TestCid.String(), pending[0].PieceCID)
+		require.EqualValues(t, 2048, pending[0].PieceSize)
+		require.Equal(t, "uuid2", pending[1].DealUUID)
+		require.Equal(t, model.DealPublished, pending[1].State)
+
+		_, err = Default.ListPendingDealsHandler(ctx, db, "")
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+}
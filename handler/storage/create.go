@@ -3,7 +3,6 @@ package storage
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/database"
@@ -11,7 +10,6 @@ import (
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/storagesystem"
 	"github.com/data-preservation-programs/singularity/util"
-	"github.com/rjNemo/underscore"
 	"gorm.io/gorm"
 )
 
@@ -25,7 +23,9 @@ type CreateRequest struct {
 
 // CreateStorageHandler initializes a new storage using the provided configurations
 // and attempts to create a connection to the storage to ensure it is valid. If successful,
-// it creates a new storage entry in the database.
+// it creates a new storage entry in the database. The shared drive of a Google Drive storage
+// may be given by name, in which case it is stored as its ID, and the authentication config of an
+// Azure Blob Storage storage is checked for consistency.
 //
 // Parameters:
 //   - ctx: The context for database transactions and other operations.
@@ -34,7 +34,9 @@ type CreateRequest struct {
 //   - provider: The provider for the storage system (e.g., AWS, Google, etc.).
 //   - name: A unique name to represent the storage in the database.
 //   - path: The path or endpoint to access the storage.
-//   - config: A map containing the configuration key-value pairs required by the storage backend.
+//   - config: A map containing the configuration key-value pairs required by the storage backend. The keys and values
+//     are validated against the options of the provider, and the keys may be given in snake_case, kebab-case or
+//     camelCase.
 //
 // Returns:
 //   - A pointer to the newly created Storage model if successful.
@@ -68,14 +70,17 @@ func (DefaultHandler) CreateStorageHandler(
 		config["provider"] = provider
 	}
 
-	rcloneConfig := make(map[string]string)
-	providerOptions, err := underscore.Find(backend.ProviderOptions, func(providerOption storagesystem.ProviderOptions) bool {
-		return strings.EqualFold(providerOption.Provider, provider)
-	})
+	providerOptions, err := backend.FindProviderOptions(provider)
 	if err != nil {
-		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "provider '%s' is not supported", provider)
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
 	}
 
+	config, err = providerOptions.ValidateConfig(config)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+	}
+
+	rcloneConfig := make(map[string]string)
 	for _, option := range providerOptions.Options {
 		if option.Default != nil {
 			rcloneConfig[option.Name] = fmt.Sprintf("%v", option.Default)
@@ -93,6 +98,19 @@ func (DefaultHandler) CreateStorageHandler(
 		Config:       rcloneConfig,
 		ClientConfig: request.ClientConfig,
 	}
+	err = storagesystem.ResolveDriveConfig(ctx, &storage)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+	}
+	err = storagesystem.CheckAzureBlobConfig(storage)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+	}
+	err = storagesystem.CheckSFTPConfig(storage)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+	}
+
 	rclone, err := storagesystem.NewRCloneHandler(ctx, storage)
 	if err != nil {
 		return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrap(err, "creating rclone handler failed"))
@@ -113,3 +131,32 @@ func (DefaultHandler) CreateStorageHandler(
 
 	return &storage, err
 }
+
+// @ID CreateStorage
+// @Summary Create a storage of a storage type without providers, i.e. local
+// @Description The config keys and values are validated against the options listed by /storage/types.
+// @Tags Storage
+// @Accept json
+// @Produce json
+// @Param type path string true "Storage type, i.e. local"
+// @Param request body CreateRequest true "Request body"
+// @Success 200 {object} model.Storage
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /storage/{type} [post]
+func _() {}
+
+// @ID CreateStorageWithProvider
+// @Summary Create a storage of a storage type with a provider, i.e. s3 with aws
+// @Description The config keys and values are validated against the options listed by /storage/types.
+// @Tags Storage
+// @Accept json
+// @Produce json
+// @Param type path string true "Storage type, i.e. s3"
+// @Param provider path string true "Provider of the storage type, i.e. aws"
+// @Param request body CreateRequest true "Request body"
+// @Success 200 {object} model.Storage
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /storage/{type}/{provider} [post]
+func _() {}
@@ -51,6 +51,31 @@ func TestCreate(t *testing.T) {
 		})
 	})
 
+	t.Run("local path with config in camelCase", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			tmp := t.TempDir()
+			storage, err := Default.CreateStorageHandler(ctx, db, "local", CreateRequest{"", "name", tmp,
+				map[string]string{
+					"copyLinks": "true",
+				}, model.ClientConfig{}})
+			require.NoError(t, err)
+			require.Equal(t, "true", storage.Config["copy_links"])
+			require.NotContains(t, storage.Config, "copyLinks")
+		})
+	})
+
+	t.Run("local path with unknown config key", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			tmp := t.TempDir()
+			_, err := Default.CreateStorageHandler(ctx, db, "local", CreateRequest{"", "name", tmp,
+				map[string]string{
+					"copy_link": "true",
+				}, model.ClientConfig{}})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+			require.ErrorContains(t, err, "did you mean copy_links?")
+		})
+	})
+
 	t.Run("local path with inaccessible path", func(t *testing.T) {
 		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 			_, err := Default.CreateStorageHandler(ctx, db, "local", CreateRequest{"", "name", "/invalid/path", nil, model.ClientConfig{}})
@@ -58,6 +83,17 @@ func TestCreate(t *testing.T) {
 		})
 	})
 
+	t.Run("azureblob with sas url of another container", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			_, err := Default.CreateStorageHandler(ctx, db, "azureblob", CreateRequest{"", "name", "other/dir",
+				map[string]string{
+					"sas_url": "https://account.blob.core.windows.net/data?sv=2021-08-06&sr=c&sp=rl&sig=abc",
+				}, model.ClientConfig{}})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+			require.ErrorContains(t, err, "only grants access to container 'data'")
+		})
+	})
+
 	t.Run("invalid provider", func(t *testing.T) {
 		tmp := t.TempDir()
 		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
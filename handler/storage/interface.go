@@ -41,6 +41,26 @@ type Handler interface {
 		name string,
 		request RenameRequest,
 	) (*model.Storage, error)
+	ReauthStorageHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		name string,
+		request ReauthRequest,
+	) (*model.Storage, error)
+	ListStorageTypesHandler(
+		ctx context.Context,
+		db *gorm.DB,
+	) ([]StorageType, error)
+	GetStorageTypeHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		storageType string,
+	) (*StorageType, error)
+	ListSlowestSourcesHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		request ListSlowestSourcesRequest,
+	) ([]SlowSource, error)
 }
 
 type DefaultHandler struct{}
@@ -82,3 +102,23 @@ func (m *MockStorage) UpdateStorageHandler(ctx context.Context, db *gorm.DB, nam
 	args := m.Called(ctx, db, name, request)
 	return args.Get(0).(*model.Storage), args.Error(1)
 }
+
+func (m *MockStorage) ReauthStorageHandler(ctx context.Context, db *gorm.DB, name string, request ReauthRequest) (*model.Storage, error) {
+	args := m.Called(ctx, db, name, request)
+	return args.Get(0).(*model.Storage), args.Error(1)
+}
+
+func (m *MockStorage) ListStorageTypesHandler(ctx context.Context, db *gorm.DB) ([]StorageType, error) {
+	args := m.Called(ctx, db)
+	return args.Get(0).([]StorageType), args.Error(1)
+}
+
+func (m *MockStorage) GetStorageTypeHandler(ctx context.Context, db *gorm.DB, storageType string) (*StorageType, error) {
+	args := m.Called(ctx, db, storageType)
+	return args.Get(0).(*StorageType), args.Error(1)
+}
+
+func (m *MockStorage) ListSlowestSourcesHandler(ctx context.Context, db *gorm.DB, request ListSlowestSourcesRequest) ([]SlowSource, error) {
+	args := m.Called(ctx, db, request)
+	return args.Get(0).([]SlowSource), args.Error(1)
+}
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+type ReauthRequest struct {
+	Config map[string]string `json:"config"` // Credentials to replace, i.e. the OAuth token or the access keys. The current credentials are checked again if empty.
+}
+
+// ReauthStorageHandler re-authenticates a storage whose credentials were rejected, i.e. because an OAuth token
+// expired. The new credentials are merged into the config of the storage and checked by listing the storage, as with
+// UpdateStorageHandler. Once the storage can be listed, it no longer needs to be re-authenticated, and the jobs held
+// because of it are picked up again by the workers.
+//
+// Parameters:
+//   - ctx: A context.Context for request-scoped values, cancellation signals, and deadlines.
+//   - db: A *gorm.DB instance for database interactions.
+//   - name: The ID or name of the storage to re-authenticate.
+//   - request: The ReauthRequest with the new credentials.
+//
+// Returns:
+//   - A pointer to the re-authenticated storage.
+//   - An error wrapping handlererror.ErrNotFound if the storage does not exist, or handlererror.ErrInvalidParameter
+//     if the credentials are invalid or still rejected.
+func (d DefaultHandler) ReauthStorageHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	name string,
+	request ReauthRequest,
+) (*model.Storage, error) {
+	return d.UpdateStorageHandler(ctx, db, name, UpdateRequest{Config: request.Config})
+}
+
+// @ID ReauthStorage
+// @Summary Re-authenticate a storage whose credentials were rejected, and resume the jobs that use it
+// @Tags Storage
+// @Param name path string true "Storage ID or name"
+// @Param request body ReauthRequest true "Request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.Storage
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /storage/{name}/reauth [post]
+func _() {}
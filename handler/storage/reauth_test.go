@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestReauthStorageHandler(t *testing.T) {
+	t.Run("storage not found", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			_, err := Default.ReauthStorageHandler(ctx, db, "test", ReauthRequest{})
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+		})
+	})
+	t.Run("clears needs reauth", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			err := db.Create(&model.Storage{
+				Name:        "name",
+				Type:        "local",
+				Path:        t.TempDir(),
+				NeedsReauth: true,
+				AuthError:   "401 Unauthorized",
+			}).Error
+			require.NoError(t, err)
+			storage, err := Default.ReauthStorageHandler(ctx, db, "name", ReauthRequest{Config: map[string]string{
+				"copy_links": "true",
+			}})
+			require.NoError(t, err)
+			require.False(t, storage.NeedsReauth)
+			require.Empty(t, storage.AuthError)
+
+			var saved model.Storage
+			require.NoError(t, db.First(&saved, storage.ID).Error)
+			require.False(t, saved.NeedsReauth)
+			require.Empty(t, saved.AuthError)
+			require.Equal(t, "true", saved.Config["copy_links"])
+		})
+	})
+}
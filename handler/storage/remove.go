@@ -12,7 +12,7 @@ import (
 
 // RemoveHandler deletes the storage entry with the specified name from the database.
 // Before deletion, it checks if any attachments are still using the storage,
-// and if so, returns an error.
+// or if any preparation stores raw blocks in the storage, and if so, returns an error.
 //
 // Parameters:
 //   - ctx: The context for database transactions and other operations.
@@ -49,7 +49,13 @@ func (DefaultHandler) RemoveHandler(
 				return errors.WithStack(err)
 			}
 
-			if sourceCount > 0 || outputCount > 0 {
+			var blobCount int64
+			err = db.Model(&model.Preparation{}).Where("blob_storage_id = ?", storage.ID).Count(&blobCount).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			if sourceCount > 0 || outputCount > 0 || blobCount > 0 {
 				return errors.Wrapf(handlererror.ErrInvalidParameter, "storage %s is still in use", name)
 			}
 
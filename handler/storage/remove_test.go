@@ -46,4 +46,15 @@ func TestRemoveStorageHandler(t *testing.T) {
 			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
 		})
 	})
+	t.Run("remove storage that is still used as blob storage", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			tmp := t.TempDir()
+			blob, err := Default.CreateStorageHandler(ctx, db, "local", CreateRequest{"", "name", tmp, nil, model.ClientConfig{}})
+			require.NoError(t, err)
+			err = db.Create(&model.Preparation{BlobStorageID: &blob.ID}).Error
+			require.NoError(t, err)
+			err = Default.RemoveHandler(ctx, db, "name")
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		})
+	})
 }
@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+type ListSlowestSourcesRequest struct {
+	Since string `json:"since"` // Only score the reads of the pieces packed within this duration, i.e. 24h. All reads are scored if empty.
+	Limit int    `json:"limit"` // Max number of sources to list, or 0 for all
+}
+
+type SlowSource struct {
+	StorageID       model.StorageID `json:"storageId"       table:"verbose"`
+	Storage         string          `json:"storage"`
+	Type            string          `json:"type"`
+	Score           float64         `json:"score"`                                           // Score rates how slow the reads are, from 0 if they are all fast to 100 if they are all very slow
+	ChronicallySlow bool            `json:"chronicallySlow"`                                 // ChronicallySlow is whether the source is worth mirroring locally
+	NumOfPieces     int64           `json:"numOfPieces"     table:"verbose"`                 // NumOfPieces is the number of pieces the reads were recorded for
+	NumOfReads      int64           `json:"numOfReads"`                                      // NumOfReads is the number of file ranges read
+	FastReads       int64           `json:"fastReads"       table:"verbose"`                 // FastReads is the number of reads under 100ms
+	ModerateReads   int64           `json:"moderateReads"   table:"verbose"`                 // ModerateReads is the number of reads from 100ms to 1s
+	SlowReads       int64           `json:"slowReads"`                                       // SlowReads is the number of reads from 1s to 10s
+	VerySlowReads   int64           `json:"verySlowReads"`                                   // VerySlowReads is the number of reads of 10s or more
+	MeanLatency     time.Duration   `json:"meanLatency"     swaggertype:"primitive,integer"` // MeanLatency is the average latency of the reads
+	MaxLatency      time.Duration   `json:"maxLatency"      swaggertype:"primitive,integer"` // MaxLatency is the latency of the slowest read
+	BytesRead       int64           `json:"bytesRead"       table:"verbose"`                 // BytesRead is the number of bytes read from the source
+	Throughput      int64           `json:"throughput"`                                      // Throughput is the number of bytes read per second while reading the content of the source files
+}
+
+// ListSlowestSourcesHandler scores the source storages by the latency of their reads, recorded each time a piece is
+// packed, and lists them from the slowest. A source that is chronically slow holds back every worker that packs from
+// it, so it is the one to mirror to a local storage first.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - request: The time window of the reads to score and the max number of sources to list.
+//
+// Returns:
+//   - A SlowSource for each source with recorded reads, from the highest score, then from the highest mean latency.
+//   - An error, if any occurred during the operation, i.e. handlererror.ErrInvalidParameter if the time window or the
+//     limit is invalid.
+func (DefaultHandler) ListSlowestSourcesHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	request ListSlowestSourcesRequest,
+) ([]SlowSource, error) {
+	db = db.WithContext(ctx)
+	if request.Limit < 0 {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "limit %d cannot be negative", request.Limit)
+	}
+	statement := db.Model(&model.SourceRead{})
+	if request.Since != "" {
+		since, err := time.ParseDuration(request.Since)
+		if err != nil || since <= 0 {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid duration %s", request.Since)
+		}
+		statement = statement.Where("created_at >= ?", time.Now().Add(-since))
+	}
+
+	var rows []struct {
+		StorageID     model.StorageID
+		NumOfPieces   int64
+		NumOfReads    int64
+		FastReads     int64
+		ModerateReads int64
+		SlowReads     int64
+		VerySlowReads int64
+		Latency       time.Duration
+		MaxLatency    time.Duration
+		BytesRead     int64
+		ReadTime      time.Duration
+	}
+	err := statement.Select("storage_id, COUNT(*) AS num_of_pieces, SUM(num_of_reads) AS num_of_reads, " +
+		"SUM(fast_reads) AS fast_reads, SUM(moderate_reads) AS moderate_reads, SUM(slow_reads) AS slow_reads, " +
+		"SUM(very_slow_reads) AS very_slow_reads, SUM(latency) AS latency, MAX(max_latency) AS max_latency, " +
+		"SUM(bytes_read) AS bytes_read, SUM(read_time) AS read_time").
+		Group("storage_id").Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	storageIDs := make([]model.StorageID, 0, len(rows))
+	for _, row := range rows {
+		storageIDs = append(storageIDs, row.StorageID)
+	}
+	var storages []model.Storage
+	err = db.Where("id IN ?", storageIDs).Find(&storages).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	storagesByID := make(map[model.StorageID]model.Storage, len(storages))
+	for _, storage := range storages {
+		storagesByID[storage.ID] = storage
+	}
+
+	sources := make([]SlowSource, 0, len(rows))
+	for _, row := range rows {
+		sourceRead := model.SourceRead{
+			NumOfReads:    row.NumOfReads,
+			FastReads:     row.FastReads,
+			ModerateReads: row.ModerateReads,
+			SlowReads:     row.SlowReads,
+			VerySlowReads: row.VerySlowReads,
+		}
+		source := SlowSource{
+			StorageID:       row.StorageID,
+			Storage:         storagesByID[row.StorageID].Name,
+			Type:            storagesByID[row.StorageID].Type,
+			Score:           sourceRead.Score(),
+			ChronicallySlow: sourceRead.ChronicallySlow(),
+			NumOfPieces:     row.NumOfPieces,
+			NumOfReads:      row.NumOfReads,
+			FastReads:       row.FastReads,
+			ModerateReads:   row.ModerateReads,
+			SlowReads:       row.SlowReads,
+			VerySlowReads:   row.VerySlowReads,
+			MaxLatency:      row.MaxLatency,
+			BytesRead:       row.BytesRead,
+		}
+		if row.NumOfReads > 0 {
+			source.MeanLatency = row.Latency / time.Duration(row.NumOfReads)
+		}
+		if row.ReadTime > 0 {
+			source.Throughput = int64(float64(row.BytesRead) / row.ReadTime.Seconds())
+		}
+		sources = append(sources, source)
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		if sources[i].Score != sources[j].Score {
+			return sources[i].Score > sources[j].Score
+		}
+		if sources[i].MeanLatency != sources[j].MeanLatency {
+			return sources[i].MeanLatency > sources[j].MeanLatency
+		}
+		return sources[i].StorageID < sources[j].StorageID
+	})
+	if request.Limit > 0 && len(sources) > request.Limit {
+		sources = sources[:request.Limit]
+	}
+	return sources, nil
+}
+
+// @ID ListSlowestSources
+// @Summary List the source storages from the slowest to read from, scored by the latency of their reads
+// @Tags Storage
+// @Accept json
+// @Produce json
+// @Param request body ListSlowestSourcesRequest true "ListSlowestSourcesRequest"
+// @Success 200 {array} SlowSource
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /storage/slowest [post]
+func _() {}
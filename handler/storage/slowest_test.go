@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestListSlowestSourcesHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		preparation := model.Preparation{Name: "prep"}
+		require.NoError(t, db.Create(&preparation).Error)
+		var jobs []model.Job
+		for _, name := range []string{"fast", "slow"} {
+			job := model.Job{
+				Type: model.Pack,
+				Attachment: &model.SourceAttachment{
+					PreparationID: preparation.ID,
+					Storage:       &model.Storage{Name: name, Type: "local"},
+				},
+			}
+			require.NoError(t, db.Create(&job).Error)
+			jobs = append(jobs, job)
+		}
+
+		var fast, slow model.SourceRead
+		for i := 0; i < 100; i++ {
+			fast.Add(10 * time.Millisecond)
+			slow.Add(2 * time.Second)
+		}
+		fast.BytesRead, fast.ReadTime = 2000, time.Second
+		slow.Add(20 * time.Second)
+		for i, sourceRead := range []model.SourceRead{fast, slow} {
+			sourceRead.StorageID = jobs[i].Attachment.StorageID
+			sourceRead.JobID = jobs[i].ID
+			sourceRead.PreparationID = preparation.ID
+			require.NoError(t, db.Create(&sourceRead).Error)
+		}
+		old := model.SourceRead{
+			CreatedAt:     time.Now().Add(-48 * time.Hour),
+			StorageID:     jobs[0].Attachment.StorageID,
+			JobID:         jobs[0].ID,
+			PreparationID: preparation.ID,
+		}
+		old.Add(30 * time.Second)
+		require.NoError(t, db.Create(&old).Error)
+
+		t.Run("all", func(t *testing.T) {
+			sources, err := Default.ListSlowestSourcesHandler(ctx, db, ListSlowestSourcesRequest{})
+			require.NoError(t, err)
+			require.Len(t, sources, 2)
+			require.Equal(t, "slow", sources[0].Storage)
+			require.True(t, sources[0].ChronicallySlow)
+			require.EqualValues(t, 101, sources[0].NumOfReads)
+			require.EqualValues(t, 100, sources[0].SlowReads)
+			require.EqualValues(t, 1, sources[0].VerySlowReads)
+			require.Equal(t, 20*time.Second, sources[0].MaxLatency)
+			require.Equal(t, "fast", sources[1].Storage)
+			require.False(t, sources[1].ChronicallySlow)
+			require.EqualValues(t, 2, sources[1].NumOfPieces)
+			require.EqualValues(t, 101, sources[1].NumOfReads)
+			require.Equal(t, 30*time.Second, sources[1].MaxLatency)
+			require.EqualValues(t, 2000, sources[1].Throughput)
+		})
+		t.Run("since", func(t *testing.T) {
+			sources, err := Default.ListSlowestSourcesHandler(ctx, db, ListSlowestSourcesRequest{Since: "24h", Limit: 2})
+			require.NoError(t, err)
+			require.Len(t, sources, 2)
+			require.Equal(t, "fast", sources[1].Storage)
+			require.Zero(t, sources[1].Score)
+			require.Equal(t, 10*time.Millisecond, sources[1].MeanLatency)
+		})
+		t.Run("limit", func(t *testing.T) {
+			sources, err := Default.ListSlowestSourcesHandler(ctx, db, ListSlowestSourcesRequest{Limit: 1})
+			require.NoError(t, err)
+			require.Len(t, sources, 1)
+			require.Equal(t, "slow", sources[0].Storage)
+		})
+		t.Run("invalid", func(t *testing.T) {
+			_, err := Default.ListSlowestSourcesHandler(ctx, db, ListSlowestSourcesRequest{Since: "yesterday"})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+			_, err = Default.ListSlowestSourcesHandler(ctx, db, ListSlowestSourcesRequest{Limit: -1})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		})
+	})
+}
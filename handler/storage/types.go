@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/rclone/rclone/fs"
+	"github.com/rjNemo/underscore"
+	"gorm.io/gorm"
+)
+
+type StorageOption struct {
+	Name      string                 `json:"name"`      // Name of the config key
+	Help      string                 `json:"help"`      // Description of the option
+	Type      string                 `json:"type"`      // Type of the value, i.e. string, bool, int, SizeSuffix or Duration
+	Default   string                 `json:"default"`   // Default value
+	Required  bool                   `json:"required"`  // Whether the option must be set
+	Advanced  bool                   `json:"advanced"`  // Whether the option is advanced
+	Exclusive bool                   `json:"exclusive"` // Whether the value must be one of the examples
+	Secret    bool                   `json:"secret"`    // Whether the value is a secret, which is masked in API responses, CLI output and logs
+	Examples  []StorageOptionExample `json:"examples"`  // Example values
+}
+
+type StorageOptionExample struct {
+	Value string `json:"value"`
+	Help  string `json:"help"` // Description of the value, i.e. to label it in a dropdown
+}
+
+type StorageProvider struct {
+	Provider    string          `json:"provider"` // Name of the provider, empty if the storage type has no providers
+	Description string          `json:"description"`
+	Options     []StorageOption `json:"options"`
+}
+
+type StorageType struct {
+	Type        string            `json:"type"`
+	Description string            `json:"description"`
+	Providers   []StorageProvider `json:"providers"`
+}
+
+// ListStorageTypesHandler lists the supported storage types with the config options of each provider. The config
+// of a storage created or updated through the API is validated against these options, so new storage types do not
+// need their own request models, and clients can render the forms of each storage type from these options.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//
+// Returns:
+//   - A slice of StorageType, sorted by type.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) ListStorageTypesHandler(
+	ctx context.Context,
+	db *gorm.DB,
+) ([]StorageType, error) {
+	types := make([]StorageType, 0, len(storagesystem.Backends))
+	for _, backend := range storagesystem.Backends {
+		types = append(types, newStorageType(backend))
+	}
+	return types, nil
+}
+
+// GetStorageTypeHandler returns a single supported storage type with the config options of each provider.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - storageType: The type of the storage, i.e. s3 or local.
+//
+// Returns:
+//   - A pointer to the StorageType.
+//   - An error, if any occurred during the operation, i.e. handlererror.ErrNotFound if the storage type is not supported.
+func (DefaultHandler) GetStorageTypeHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	storageType string,
+) (*StorageType, error) {
+	backend, ok := storagesystem.BackendMap[storageType]
+	if !ok {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "storage type %s is not supported", storageType)
+	}
+	t := newStorageType(backend)
+	return &t, nil
+}
+
+func newStorageType(backend storagesystem.Backend) StorageType {
+	storageType := StorageType{
+		Type:        backend.Prefix,
+		Description: backend.Description,
+	}
+	for _, providerOptions := range backend.ProviderOptions {
+		provider := StorageProvider{
+			Provider:    providerOptions.Provider,
+			Description: providerOptions.ProviderDescription,
+		}
+		for _, option := range providerOptions.Options {
+			o := fs.Option(option)
+			provider.Options = append(provider.Options, StorageOption{
+				Name:      option.Name,
+				Help:      option.Help,
+				Type:      o.Type(),
+				Default:   fmt.Sprint(o.GetValue()),
+				Required:  option.Required,
+				Advanced:  option.Advanced,
+				Exclusive: option.Exclusive,
+				Secret:    option.IsSecret(),
+				Examples: underscore.Map(option.Examples, func(example fs.OptionExample) StorageOptionExample {
+					return StorageOptionExample{Value: example.Value, Help: example.Help}
+				}),
+			})
+		}
+		storageType.Providers = append(storageType.Providers, provider)
+	}
+	return storageType
+}
+
+// @ID ListStorageTypes
+// @Summary List the supported storage types and their config options
+// @Tags Storage
+// @Produce json
+// @Success 200 {array} StorageType
+// @Failure 500 {object} api.HTTPError
+// @Router /storage/types [get]
+func _() {}
+
+// @ID GetStorageType
+// @Summary Get a supported storage type and its config options
+// @Tags Storage
+// @Produce json
+// @Param type path string true "Storage type"
+// @Success 200 {object} StorageType
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /storage/types/{type} [get]
+func _() {}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestListStorageTypesHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		types, err := Default.ListStorageTypesHandler(ctx, db)
+		require.NoError(t, err)
+		require.NotEmpty(t, types)
+
+		var local *StorageType
+		for i := range types {
+			if types[i].Type == "local" {
+				local = &types[i]
+			}
+		}
+		require.NotNil(t, local)
+		require.Len(t, local.Providers, 1)
+		var found bool
+		for _, option := range local.Providers[0].Options {
+			if option.Name == "copy_links" {
+				found = true
+				require.Equal(t, "bool", option.Type)
+				require.Equal(t, "false", option.Default)
+			}
+		}
+		require.True(t, found)
+	})
+}
+
+func TestGetStorageTypeHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := Default.GetStorageTypeHandler(ctx, db, "invalid")
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		s3, err := Default.GetStorageTypeHandler(ctx, db, "s3")
+		require.NoError(t, err)
+		require.Equal(t, "s3", s3.Type)
+		require.Greater(t, len(s3.Providers), 1)
+		var found bool
+		for _, provider := range s3.Providers {
+			if provider.Provider != "AWS" {
+				continue
+			}
+			for _, option := range provider.Options {
+				switch option.Name {
+				case "secret_access_key":
+					require.True(t, option.Secret)
+				case "region":
+					found = true
+					require.NotEmpty(t, option.Examples)
+					require.Equal(t, "us-east-1", option.Examples[0].Value)
+					require.NotEmpty(t, option.Examples[0].Help)
+				}
+			}
+		}
+		require.True(t, found)
+	})
+}
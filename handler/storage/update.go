@@ -9,7 +9,6 @@ import (
 	"github.com/data-preservation-programs/singularity/handler/handlererror"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/storagesystem"
-	"github.com/rjNemo/underscore"
 	"gorm.io/gorm"
 )
 
@@ -23,9 +22,13 @@ type UpdateRequest struct {
 // This method performs the following operations:
 //   - Retrieves the storage system with the specified name.
 //   - Merges the new configuration with the current configuration.
-//   - Ensures the provided configuration is valid by checking the storage type's backend compatibility.
+//   - Validates the keys and values of the new configuration against the options of the storage type and provider.
+//     Secrets that are masked, i.e. because the configuration was read from the API, keep their current value.
+//   - Resolves the shared drive of a Google Drive storage, if given by name, to its ID.
+//   - Checks the authentication config of an Azure Blob Storage storage for consistency.
 //   - Initializes an RCloneHandler with the merged configuration to validate the config against the actual storage backend.
-//   - Updates the storage system's configuration in the database.
+//   - Updates the storage system's configuration in the database. Since the storage could be listed, it no longer
+//     needs to be re-authenticated, so the jobs held because its credentials were rejected are resumed.
 //
 // Parameters:
 //   - ctx: A context.Context for request-scoped values, cancellation signals, and deadlines.
@@ -68,14 +71,17 @@ func (DefaultHandler) UpdateStorageHandler(
 		provider = p
 	}
 
-	rcloneConfig := make(map[string]string)
-	providerOptions, err := underscore.Find(backend.ProviderOptions, func(providerOption storagesystem.ProviderOptions) bool {
-		return providerOption.Provider == provider
-	})
+	providerOptions, err := backend.FindProviderOptions(provider)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+	}
+
+	requestConfig, err := providerOptions.ValidateConfig(request.Config)
 	if err != nil {
-		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "provider '%s' is not supported", provider)
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
 	}
 
+	rcloneConfig := make(map[string]string)
 	for _, option := range providerOptions.Options {
 		if option.Default != nil {
 			rcloneConfig[option.Name] = fmt.Sprintf("%v", option.Default)
@@ -86,12 +92,29 @@ func (DefaultHandler) UpdateStorageHandler(
 		rcloneConfig[key] = value
 	}
 
-	for key, value := range request.Config {
+	for key, value := range requestConfig {
+		// A masked secret, i.e. from a storage returned by the API, keeps the current secret.
+		if value == model.MaskedSecret && model.IsSecretConfigName(key) {
+			continue
+		}
 		rcloneConfig[key] = value
 	}
 
 	storage.Config = rcloneConfig
 	OverrideStorageWithClientConfig(&storage, request.ClientConfig)
+	err = storagesystem.ResolveDriveConfig(ctx, &storage)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+	}
+	err = storagesystem.CheckAzureBlobConfig(storage)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+	}
+	err = storagesystem.CheckSFTPConfig(storage)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+	}
+
 	rclone, err := storagesystem.NewRCloneHandler(ctx, storage)
 	if err != nil {
 		return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrap(err, "creating rclone handler failed"))
@@ -102,10 +125,14 @@ func (DefaultHandler) UpdateStorageHandler(
 		return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrap(err, "listing the storage failed"))
 	}
 
+	storage.NeedsReauth = false
+	storage.AuthError = ""
 	err = database.DoRetry(ctx, func() error {
 		return db.Model(&model.Storage{}).Where("id = ?", storage.ID).Updates(map[string]any{
 			"config":        storage.Config,
 			"client_config": storage.ClientConfig,
+			"needs_reauth":  false,
+			"auth_error":    "",
 		}).Error
 	})
 	if err != nil {
@@ -167,6 +194,9 @@ func OverrideStorageWithClientConfig(storage *model.Storage, config model.Client
 				storage.ClientConfig.Headers = make(map[string]string)
 				break
 			}
+			if value == model.MaskedSecret {
+				continue
+			}
 			if value == "" {
 				delete(storage.ClientConfig.Headers, key)
 			} else {
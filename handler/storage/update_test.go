@@ -36,6 +36,31 @@ func TestUpdateStorageHandler(t *testing.T) {
 			})
 		}
 	})
+	t.Run("unknown config key", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			tmp := t.TempDir()
+			_, err := Default.CreateStorageHandler(ctx, db, "local", CreateRequest{"", "name", tmp, nil, model.ClientConfig{}})
+			require.NoError(t, err)
+			_, err = Default.UpdateStorageHandler(ctx, db, "name", UpdateRequest{Config: map[string]string{
+				"not_an_option": "true",
+			}})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		})
+	})
+	t.Run("masked secret is kept", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			tmp := t.TempDir()
+			_, err := Default.CreateStorageHandler(ctx, db, "local", CreateRequest{"", "name", tmp, nil, model.ClientConfig{
+				Headers: map[string]string{"Authorization": "Bearer secret"},
+			}})
+			require.NoError(t, err)
+			storage, err := Default.UpdateStorageHandler(ctx, db, "name", UpdateRequest{ClientConfig: model.ClientConfig{
+				Headers: map[string]string{"Authorization": model.MaskedSecret},
+			}})
+			require.NoError(t, err)
+			require.Equal(t, "Bearer secret", storage.ClientConfig.Headers["Authorization"])
+		})
+	})
 	t.Run("change client config", func(t *testing.T) {
 		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 			tmp := t.TempDir()
@@ -0,0 +1,136 @@
+package tool
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"os"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
+)
+
+type EncryptionKey struct {
+	Identity  string `json:"identity"`  // Private key to be kept by the data owner, used to unwrap the piece keys
+	Recipient string `json:"recipient"` // Public key to encrypt the pieces of a preparation for
+}
+
+// GenerateEncryptionKeyHandler generates a new key pair for piece encryption.
+//
+// Returns:
+//   - The EncryptionKey with the identity and the recipient.
+//   - An error if the key pair cannot be generated.
+func GenerateEncryptionKeyHandler() (*EncryptionKey, error) {
+	identity, recipient, err := encryption.GenerateIdentity()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &EncryptionKey{Identity: identity, Recipient: recipient}, nil
+}
+
+// DecryptCarHandler decrypts an encrypted CAR file with its wrapped piece key, as exported by the piece key escrow.
+//
+// Parameters:
+//   - ctx: The context for cancellation.
+//   - input: The path to the encrypted CAR file.
+//   - output: The path to write the decrypted CAR file to.
+//   - wrappedKey: The base64 encoded wrapped piece key of the CAR file.
+//   - identity: The base64 encoded private key of the piece key recipient.
+//
+// Returns:
+//   - An error if the piece key cannot be unwrapped or the CAR file cannot be decrypted.
+func DecryptCarHandler(ctx context.Context, input string, output string, wrappedKey string, identity string) error {
+	wrapped, err := base64.StdEncoding.DecodeString(wrappedKey)
+	if err != nil {
+		return errors.Wrap(encryption.ErrInvalidKey, "wrapped key is not base64 encoded")
+	}
+	key, err := encryption.UnwrapKey(wrapped, identity)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	in, err := os.Open(input)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", input)
+	}
+	defer in.Close()
+	reader, err := encryption.NewReader(in, key)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	out, err := os.Create(output)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %s", output)
+	}
+	_, err = io.Copy(out, contextReader{ctx: ctx, r: reader})
+	if err != nil {
+		out.Close()
+		return errors.Wrapf(err, "failed to decrypt %s", input)
+	}
+	return errors.WithStack(out.Close())
+}
+
+// DecryptItemHandler decrypts a file, or a range of a file, that was encrypted with a data key of the KMS key of its
+// preparation, as exported by 'singularity prep export-item-keys'. The data key is unwrapped by the KMS, so the
+// master key never leaves the KMS.
+//
+// Parameters:
+//   - ctx: The context for cancellation.
+//   - input: The path to the encrypted file, i.e. as retrieved by its CID.
+//   - output: The path to write the decrypted file to.
+//   - kmsKey: The URI of the KMS key of the preparation.
+//   - wrappedKey: The base64 encoded wrapped data key of the file range.
+//
+// Returns:
+//   - An error if the data key cannot be unwrapped, or the file cannot be decrypted, i.e. because it has been modified.
+func DecryptItemHandler(ctx context.Context, input string, output string, kmsKey string, wrappedKey string) error {
+	wrapped, err := base64.StdEncoding.DecodeString(wrappedKey)
+	if err != nil {
+		return errors.Wrap(encryption.ErrInvalidKey, "wrapped key is not base64 encoded")
+	}
+	kms, err := encryption.OpenKMS(ctx, kmsKey)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	key, err := kms.Decrypt(ctx, wrapped)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	in, err := os.Open(input)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", input)
+	}
+	defer in.Close()
+	reader, err := encryption.NewGCMDecryptReader(in, key)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	out, err := os.Create(output)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %s", output)
+	}
+	_, err = io.Copy(out, contextReader{ctx: ctx, r: reader})
+	if err != nil {
+		out.Close()
+		// A partially decrypted file is not authenticated, so it is not kept
+		_ = os.Remove(output)
+		return errors.Wrapf(err, "failed to decrypt %s", input)
+	}
+	return errors.WithStack(out.Close())
+}
+
+// contextReader stops reading once the context is cancelled.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
@@ -0,0 +1,252 @@
+package tool
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
+	"github.com/ipfs/go-blockservice"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/go-merkledag"
+	uio "github.com/ipfs/go-unixfs/io"
+	"gorm.io/gorm"
+)
+
+// RangeFetcher fetches the content of a file range by its CID. For an encrypted preparation, the content is the
+// encryption of the range.
+type RangeFetcher interface {
+	Fetch(ctx context.Context, c cid.Cid) (io.ReadCloser, error)
+}
+
+// CarDirFetcher fetches the file ranges from the CAR files in a local directory.
+type CarDirFetcher struct {
+	dagServ ipld.DAGService
+	closer  func()
+}
+
+// NewCarDirFetcher opens all CAR files found recursively in the input directory. The fetcher needs to be closed.
+func NewCarDirFetcher(inputDir string) (*CarDirFetcher, error) {
+	bs, closer, err := openCarDir(inputDir)
+	if err != nil {
+		return nil, err
+	}
+	return &CarDirFetcher{
+		dagServ: merkledag.NewDAGService(blockservice.New(bs, nil)),
+		closer:  closer,
+	}, nil
+}
+
+func (f *CarDirFetcher) Fetch(ctx context.Context, c cid.Cid) (io.ReadCloser, error) {
+	node, err := f.dagServ.Get(ctx, c)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get node for CID %s", c)
+	}
+	reader, err := uio.NewDagReader(ctx, node, f.dagServ)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create dag reader for CID %s", c)
+	}
+	return reader, nil
+}
+
+func (f *CarDirFetcher) Close() {
+	f.closer()
+}
+
+// ContentProviderFetcher fetches the file ranges from the HTTP gateway of a content provider.
+type ContentProviderFetcher struct {
+	Client *http.Client
+	URL    string
+	Token  string // Retrieval token, if the content provider requires one
+}
+
+func (f ContentProviderFetcher) Fetch(ctx context.Context, c cid.Cid) (io.ReadCloser, error) {
+	u, err := url.JoinPath(f.URL, "ipfs", c.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid content provider URL %s", f.URL)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if f.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+f.Token)
+	}
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve %s", c)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, errors.Newf("failed to retrieve %s: %s %s", c, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return resp.Body, nil
+}
+
+type ExtractedFile struct {
+	FileID    model.FileID `json:"fileId"`
+	Path      string       `json:"path"`   // Path of the file in its source
+	Output    string       `json:"output"` // Path the file was written to
+	Size      int64        `json:"size"`
+	Ranges    int          `json:"ranges"`
+	Decrypted bool         `json:"decrypted"`
+}
+
+// ExtractFileHandler retrieves a file range by range, decrypts each range if its preparation encrypts its files, and
+// reassembles the original file. The ranges of an encrypted preparation are decrypted with the age identities of one
+// of its recipients, or with the data keys unwrapped by its KMS key, as with 'singularity decrypt-item'.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - fileID: The ID of the file to extract.
+//   - fetcher: Where to retrieve the ranges from, i.e. local CAR files or a content provider.
+//   - output: The path to write the file to, or empty for its name in the current directory. A partially extracted
+//     file is removed.
+//   - decrypt: Whether to decrypt the ranges of an encrypted preparation.
+//   - identities: The age identities to decrypt the ranges with, if the preparation encrypts its files for age recipients.
+//
+// Returns:
+//   - An ExtractedFile describing the written file.
+//   - An error, if any occurred during the operation, i.e. handlererror.ErrInvalidParameter if the file is encrypted
+//     but decrypt is not set, or if a range does not match its metadata after decryption.
+func ExtractFileHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	fileID uint64,
+	fetcher RangeFetcher,
+	output string,
+	decrypt bool,
+	identities []age.Identity,
+) (*ExtractedFile, error) {
+	db = db.WithContext(ctx)
+	var file model.File
+	err := db.Preload("Attachment.Preparation").
+		Preload("FileRanges", func(db *gorm.DB) *gorm.DB { return db.Order("\"offset\" asc") }).
+		First(&file, fileID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "file '%d' does not exist", fileID)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, fileRange := range file.FileRanges {
+		if fileRange.CID == model.CID(cid.Undef) {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "file '%d' has not been packed yet", fileID)
+		}
+	}
+
+	preparation := file.Attachment.Preparation
+	encrypted := len(preparation.EncryptionRecipients) > 0 || preparation.EncryptionKMSKey != ""
+	if encrypted && !decrypt {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "file '%d' is encrypted, it needs to be decrypted", fileID)
+	}
+	decrypt = decrypt && encrypted
+	var kms encryption.KMS
+	switch {
+	case !decrypt:
+	case preparation.EncryptionKMSKey != "":
+		kms, err = encryption.OpenKMS(ctx, preparation.EncryptionKMSKey)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	case len(identities) == 0:
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "file '%d' is encrypted for age recipients, an identity is required", fileID)
+	}
+
+	if output == "" {
+		output = file.FileName()
+	}
+	out, err := os.Create(output)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s", output)
+	}
+	var size int64
+	for _, fileRange := range file.FileRanges {
+		var n int64
+		n, err = extractRange(ctx, fetcher, fileRange, out, decrypt, kms, identities)
+		size += n
+		if err != nil {
+			break
+		}
+	}
+	if err == nil && size != file.Size {
+		err = errors.Wrapf(handlererror.ErrInvalidParameter, "extracted %d bytes, expected %d", size, file.Size)
+	}
+	if err != nil {
+		out.Close()
+		_ = os.Remove(output)
+		return nil, err
+	}
+	err = out.Close()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ExtractedFile{
+		FileID:    file.ID,
+		Path:      file.Path,
+		Output:    output,
+		Size:      size,
+		Ranges:    len(file.FileRanges),
+		Decrypted: decrypt,
+	}, nil
+}
+
+// extractRange retrieves a file range, decrypts it if needed, and writes it to out.
+func extractRange(
+	ctx context.Context,
+	fetcher RangeFetcher,
+	fileRange model.FileRange,
+	out io.Writer,
+	decrypt bool,
+	kms encryption.KMS,
+	identities []age.Identity,
+) (int64, error) {
+	c := cid.Cid(fileRange.CID)
+	content, err := fetcher.Fetch(ctx, c)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer content.Close()
+
+	var reader io.Reader = contextReader{ctx: ctx, r: content}
+	switch {
+	case !decrypt:
+	case kms != nil:
+		key, err := kms.Decrypt(ctx, fileRange.WrappedKey)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to unwrap the data key of range %d", fileRange.ID)
+		}
+		reader, err = encryption.NewGCMDecryptReader(reader, key)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+	default:
+		reader, err = age.Decrypt(reader, identities...)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to decrypt range %d", fileRange.ID)
+		}
+	}
+
+	n, err := io.Copy(out, reader)
+	if err != nil {
+		return n, errors.Wrapf(err, "failed to extract range %d from %s", fileRange.ID, c)
+	}
+	if n != fileRange.Length {
+		return n, errors.Wrapf(handlererror.ErrInvalidParameter, "range %d has %d bytes, expected %d", fileRange.ID, n, fileRange.Length)
+	}
+	return n, nil
+}
@@ -0,0 +1,167 @@
+package tool
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// prefixKMS wraps data keys by prefixing them, so they can be unwrapped without a master key.
+type prefixKMS struct{}
+
+func (prefixKMS) GenerateDataKey(context.Context) ([]byte, []byte, error) {
+	key := testutil.GenerateRandomBytes(encryption.KeySize)
+	return key, append([]byte("wrapped:"), key...), nil
+}
+
+func (prefixKMS) Decrypt(_ context.Context, wrapped []byte) ([]byte, error) {
+	return bytes.TrimPrefix(wrapped, []byte("wrapped:")), nil
+}
+
+// createEncryptedFile encrypts the plaintext in two ranges with the encrypter, writes the encrypted ranges into a CAR
+// file in carDir as raw blocks, and records the file with its ranges.
+func createEncryptedFile(
+	ctx context.Context,
+	t *testing.T,
+	db *gorm.DB,
+	preparation model.Preparation,
+	encrypter encryption.ItemEncrypter,
+	plaintext []byte,
+	carDir string,
+) model.File {
+	file := model.File{
+		Path: "dir/test.txt",
+		Size: int64(len(plaintext)),
+		Attachment: &model.SourceAttachment{
+			Preparation: &preparation,
+			Storage:     &model.Storage{Name: "source", Type: "local"},
+		},
+	}
+	split := len(plaintext) / 3
+	var blks []blocks.Block
+	for _, part := range [][]byte{plaintext[:split], plaintext[split:]} {
+		item, wrapped, err := encrypter.EncryptItem(ctx, bytes.NewReader(part))
+		require.NoError(t, err)
+		ciphertext, err := io.ReadAll(item)
+		require.NoError(t, err)
+		blk, err := blocks.NewBlockWithCid(ciphertext, cid.NewCidV1(cid.Raw, util.Hash(ciphertext)))
+		require.NoError(t, err)
+		blks = append(blks, blk)
+		file.FileRanges = append(file.FileRanges, model.FileRange{
+			Offset:          int64(len(file.FileRanges) * split),
+			Length:          int64(len(part)),
+			CID:             model.CID(blk.Cid()),
+			EncryptedLength: int64(len(ciphertext)),
+			WrappedKey:      wrapped,
+		})
+	}
+	require.NoError(t, db.Create(&file).Error)
+	writeTestCar(t, filepath.Join(carDir, "test.car"), blks)
+	return file
+}
+
+func TestExtractFileHandler(t *testing.T) {
+	plaintext := testutil.GenerateRandomBytes(10_000)
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	other, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	t.Run("age", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			carDir := t.TempDir()
+			file := createEncryptedFile(ctx, t, db, model.Preparation{
+				Name:                 "prep",
+				EncryptionRecipients: model.StringSlice{identity.Recipient().String()},
+			}, encryption.AgeEncrypter{Recipients: []age.Recipient{identity.Recipient()}}, plaintext, carDir)
+			fetcher, err := NewCarDirFetcher(carDir)
+			require.NoError(t, err)
+			defer fetcher.Close()
+			output := filepath.Join(t.TempDir(), "test.txt")
+
+			_, err = ExtractFileHandler(ctx, db, 100, fetcher, output, true, []age.Identity{identity})
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+			_, err = ExtractFileHandler(ctx, db, uint64(file.ID), fetcher, output, false, nil)
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+			_, err = ExtractFileHandler(ctx, db, uint64(file.ID), fetcher, output, true, nil)
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+			_, err = ExtractFileHandler(ctx, db, uint64(file.ID), fetcher, output, true, []age.Identity{other})
+			require.Error(t, err)
+			require.NoFileExists(t, output)
+
+			result, err := ExtractFileHandler(ctx, db, uint64(file.ID), fetcher, output, true, []age.Identity{other, identity})
+			require.NoError(t, err)
+			require.True(t, result.Decrypted)
+			require.Equal(t, 2, result.Ranges)
+			require.EqualValues(t, len(plaintext), result.Size)
+			content, err := os.ReadFile(output)
+			require.NoError(t, err)
+			require.Equal(t, plaintext, content)
+		})
+	})
+
+	t.Run("kms via content provider", func(t *testing.T) {
+		encryption.RegisterKMS("prefix", func(context.Context, string) (encryption.KMS, error) { return prefixKMS{}, nil })
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			carDir := t.TempDir()
+			file := createEncryptedFile(ctx, t, db, model.Preparation{
+				Name:             "prep",
+				EncryptionKMSKey: "prefix://key",
+			}, encryption.KMSEncrypter{KMS: prefixKMS{}}, plaintext, carDir)
+			carFetcher, err := NewCarDirFetcher(carDir)
+			require.NoError(t, err)
+			defer carFetcher.Close()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				c, err := cid.Parse(strings.TrimPrefix(r.URL.Path, "/ipfs/"))
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				content, err := carFetcher.Fetch(r.Context(), c)
+				if err != nil {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				defer content.Close()
+				_, _ = io.Copy(w, content)
+			}))
+			defer server.Close()
+			output := filepath.Join(t.TempDir(), "test.txt")
+
+			_, err = ExtractFileHandler(ctx, db, uint64(file.ID), ContentProviderFetcher{URL: server.URL}, output, true, nil)
+			require.ErrorContains(t, err, "401")
+
+			result, err := ExtractFileHandler(ctx, db, uint64(file.ID), ContentProviderFetcher{URL: server.URL, Token: "token"}, output, true, nil)
+			require.NoError(t, err)
+			require.True(t, result.Decrypted)
+			content, err := os.ReadFile(output)
+			require.NoError(t, err)
+			require.Equal(t, plaintext, content)
+		})
+	})
+}
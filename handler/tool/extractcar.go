@@ -118,6 +118,20 @@ func ExtractCarHandler(ctx *cli.Context, inputDir string, output string, c cid.C
 		return errors.New("unsupported CID type")
 	}
 
+	bs, closer, err := openCarDir(inputDir)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	bserv := blockservice.New(bs, nil)
+	dagServ := merkledag.NewDAGService(bserv)
+	return writeToOutput(ctx, dagServ, output, c, true)
+}
+
+// openCarDir opens all CAR files found recursively in the input directory as a single read-only blockstore, which
+// needs to be closed with the returned function.
+func openCarDir(inputDir string) (*multiBlockstore, func(), error) {
 	var files []string
 	err := filepath.WalkDir(inputDir, func(path string, d os.DirEntry, err error) error {
 		if err != nil {
@@ -130,27 +144,30 @@ func ExtractCarHandler(ctx *cli.Context, inputDir string, output string, c cid.C
 	})
 
 	if err != nil {
-		return errors.Wrap(err, "failed to walk input directory")
+		return nil, nil, errors.Wrap(err, "failed to walk input directory")
 	}
 
 	if len(files) == 0 {
-		return errors.New("no CAR files found in input directory")
+		return nil, nil, errors.New("no CAR files found in input directory")
 	}
 
-	var bss []blockstore.Blockstore
+	var stores []*carblockstore.ReadOnly
+	closer := func() {
+		for _, store := range stores {
+			_ = store.Close()
+		}
+	}
+	bs := &multiBlockstore{}
 	for _, f := range files {
-		bs, err := carblockstore.OpenReadOnly(f)
+		store, err := carblockstore.OpenReadOnly(f)
 		if err != nil {
-			return errors.Wrapf(err, "failed to open CAR file %s", f)
+			closer()
+			return nil, nil, errors.Wrapf(err, "failed to open CAR file %s", f)
 		}
-		bss = append(bss, bs)
-		defer bs.Close()
+		stores = append(stores, store)
+		bs.bss = append(bs.bss, store)
 	}
-
-	bs := &multiBlockstore{bss: bss}
-	bserv := blockservice.New(bs, nil)
-	dagServ := merkledag.NewDAGService(bserv)
-	return writeToOutput(ctx, dagServ, output, c, true)
+	return bs, closer, nil
 }
 
 func getOutPathForFile(outPath string, c cid.Cid) (string, error) {
@@ -0,0 +1,71 @@
+package tool
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/service/contentprovider"
+	"github.com/data-preservation-programs/singularity/store"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+)
+
+type RegeneratedCar struct {
+	PieceCID string `json:"pieceCid"`
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+}
+
+// RegenerateCarHandler re-creates the CAR file of a piece from the metadata in the database and the data sources,
+// and writes it to outDir as <piece_cid>.car. If the piece has been packed more than once, the first CAR file that
+// can be regenerated is written.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - pieceCID: The CID of the piece to regenerate.
+//   - outDir: The directory to write the CAR file to.
+//
+// Returns:
+//   - A RegeneratedCar describing the written CAR file.
+//   - An error, if any occurred during the operation, i.e. handlererror.ErrNotFound if the piece is unknown.
+func RegenerateCarHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	pieceCID string,
+	outDir string,
+) (*RegeneratedCar, error) {
+	pieceCid, err := cid.Parse(pieceCID)
+	if err != nil {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid piece CID %s", pieceCID)
+	}
+
+	// A nil cache loads the metadata from the database
+	var cache *contentprovider.PieceMetadataCache
+	metadata, err := cache.Get(ctx, db, pieceCid)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(metadata) == 0 {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "piece %s not found or not backed by a data source", pieceCID)
+	}
+
+	outPath := filepath.Join(outDir, pieceCid.String()+".car")
+	var errs []error
+	for _, m := range metadata {
+		size, err := store.WritePiece(ctx, m.Car, m.Storage, m.CarBlocks, m.Files, outPath)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to regenerate car %d", m.Car.ID))
+			continue
+		}
+		return &RegeneratedCar{
+			PieceCID: pieceCid.String(),
+			Path:     outPath,
+			Size:     size,
+		}, nil
+	}
+	return nil, &util.AggregateError{Errors: errs}
+}
@@ -0,0 +1,65 @@
+package tool
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-varint"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestRegenerateCarHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		outDir := t.TempDir()
+		pieceCID := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("test")))
+		err := db.Create(&model.Car{
+			PieceCID:      model.CID(pieceCID),
+			PieceSize:     128,
+			FileSize:      59 + 1 + 36 + 5,
+			PreparationID: 1,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{},
+				Storage: &model.Storage{
+					Type: "local",
+				},
+			},
+			RootCID: model.CID(testutil.TestCid),
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.CarBlock{
+			CarID:          1,
+			CID:            model.CID(testutil.TestCid),
+			CarOffset:      59,
+			CarBlockLength: 1 + 36 + 5,
+			Varint:         varint.ToUvarint(36 + 5),
+			RawBlock:       []byte("hello"),
+		}).Error
+		require.NoError(t, err)
+
+		_, err = RegenerateCarHandler(ctx, db, "invalid", outDir)
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		notFound := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("not_exist")))
+		_, err = RegenerateCarHandler(ctx, db, notFound.String(), outDir)
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		result, err := RegenerateCarHandler(ctx, db, pieceCID.String(), outDir)
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(outDir, pieceCID.String()+".car"), result.Path)
+		require.EqualValues(t, 59+1+36+5, result.Size)
+		content, err := os.ReadFile(result.Path)
+		require.NoError(t, err)
+		require.Len(t, content, 59+1+36+5)
+		require.Equal(t, []byte("hello"), content[len(content)-5:])
+		_, err = os.Stat(result.Path + ".tmp")
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,252 @@
+package tool
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/dataprep"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/util"
+	commp "github.com/filecoin-project/go-fil-commp-hashhash"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-car/v2"
+	"github.com/multiformats/go-varint"
+	"gorm.io/gorm"
+)
+
+// DefaultSplitPieceSize is the piece size the CAR files are split for if neither a piece size nor a preparation is
+// given.
+const DefaultSplitPieceSize = 32 << 30
+
+type SplitCar struct {
+	PieceCID  string       `json:"pieceCid"`
+	PieceSize int64        `json:"pieceSize"`
+	RootCID   string       `json:"rootCid"`
+	Path      string       `json:"path"`
+	FileSize  int64        `json:"fileSize"`
+	Blocks    int          `json:"blocks"`
+	CarID     *model.CarID `json:"carId"    table:"verbose"` // ID of the piece registered in the preparation, if any
+}
+
+// splitWriter writes the blocks of a CAR file into the current part, computing the piece commitment as it goes.
+type splitWriter struct {
+	outDir    string
+	pieceSize int64
+	file      *os.File
+	buffered  *bufio.Writer
+	calc      *commp.Calc
+	root      cid.Cid
+	size      int64
+	blocks    int
+}
+
+func (w *splitWriter) open(root cid.Cid) error {
+	file, err := os.CreateTemp(w.outDir, "split-*.car.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to create CAR file")
+	}
+	w.file = file
+	w.calc = &commp.Calc{}
+	w.buffered = bufio.NewWriter(io.MultiWriter(file, w.calc))
+	w.root = root
+	w.blocks = 0
+	header, err := packutil.WriteCarHeader(w.buffered, root)
+	if err != nil {
+		return errors.Wrap(err, "failed to write CAR header")
+	}
+	w.size = int64(len(header))
+	return nil
+}
+
+func (w *splitWriter) write(block blocks.Block) error {
+	n, err := packutil.WriteCarBlock(w.buffered, block)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write block %s", block.Cid())
+	}
+	w.size += n
+	w.blocks++
+	return nil
+}
+
+// close finishes the current part and renames it to <piece_cid>.car.
+func (w *splitWriter) close() (*SplitCar, error) {
+	err := w.buffered.Flush()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = w.file.Close()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	tmpPath := w.file.Name()
+	w.file = nil
+	pieceCID, pieceSize, err := pack.GetCommp(w.calc, uint64(w.pieceSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate piece CID")
+	}
+	path := filepath.Join(w.outDir, pieceCID.String()+".car")
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to rename CAR file to %s", path)
+	}
+	return &SplitCar{
+		PieceCID:  pieceCID.String(),
+		PieceSize: int64(pieceSize),
+		RootCID:   w.root.String(),
+		Path:      path,
+		FileSize:  w.size,
+		Blocks:    w.blocks,
+	}, nil
+}
+
+func (w *splitWriter) abort() {
+	if w.file != nil {
+		_ = w.file.Close()
+		_ = os.Remove(w.file.Name())
+	}
+}
+
+// SplitCarHandler splits an existing CAR file, i.e. one produced by an older preparation tool that is too large to
+// be a single piece, into CAR files that fit into pieces of the given size. The blocks are copied in their order, and
+// a new CAR file is started whenever the next block would not fit, so no block is ever split. The first CAR file keeps
+// the root of the input. Each following CAR file has its first block as root, since the DAG of the input spans all
+// of them. The CAR files are written to outDir as <piece_cid>.car.
+//
+// If a preparation is given, the resulting pieces are registered in it, as with 'singularity prep add-piece', so
+// deals can be made for them, and its piece size is used if pieceSize is 0.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: A pointer to the gorm.DB instance representing the database connection. Only used with a preparation.
+//   - input: The path of the CAR file to split.
+//   - outDir: The directory to write the CAR files to.
+//   - pieceSize: The piece size the CAR files need to fit in, or 0 for the piece size of the preparation.
+//   - preparation: The ID or name of the preparation to register the pieces in, or empty to only split the CAR file.
+//
+// Returns:
+//   - A SplitCar describing each written CAR file, in the order of the blocks.
+//   - An error, if any occurred during the operation, i.e. handlererror.ErrInvalidParameter if a block of the input
+//     does not fit into a piece on its own.
+func SplitCarHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	input string,
+	outDir string,
+	pieceSize int64,
+	preparation string,
+) ([]SplitCar, error) {
+	if preparation != "" {
+		var prep model.Preparation
+		err := prep.FindByIDOrName(db.WithContext(ctx), preparation)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", preparation)
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if pieceSize == 0 {
+			pieceSize = prep.PieceSize
+		}
+	}
+	if pieceSize == 0 {
+		pieceSize = DefaultSplitPieceSize
+	}
+	if (pieceSize&(pieceSize-1)) != 0 || pieceSize < util.MinPieceSize || pieceSize > util.MaxPieceSize {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "piece size %d must be a power of 2 between 128 B and 64 GiB", pieceSize)
+	}
+	// The CAR file is padded with fr32 before the piece commitment is calculated
+	maxSize := pieceSize / 128 * 127
+
+	f, err := os.Open(input)
+	if err != nil {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "failed to open CAR file %s", input)
+	}
+	defer f.Close()
+	reader, err := car.NewBlockReader(bufio.NewReader(f))
+	if err != nil {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "failed to read CAR file %s: %s", input, err)
+	}
+	root := cid.Undef
+	if len(reader.Roots) == 1 {
+		root = reader.Roots[0]
+	}
+
+	err = os.MkdirAll(outDir, 0755)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create output directory %s", outDir)
+	}
+	writer := &splitWriter{outDir: outDir, pieceSize: pieceSize}
+	defer writer.abort()
+	var results []SplitCar
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		block, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read block from %s", input)
+		}
+		sectionLength := uint64(block.Cid().ByteLen() + len(block.RawData()))
+		sectionSize := int64(varint.UvarintSize(sectionLength)) + int64(sectionLength)
+		if writer.file != nil && writer.size+sectionSize > maxSize {
+			result, err := writer.close()
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, *result)
+		}
+		if writer.file == nil {
+			if root == cid.Undef || len(results) > 0 {
+				root = block.Cid()
+			}
+			err = writer.open(root)
+			if err != nil {
+				return nil, err
+			}
+			if writer.size+sectionSize > maxSize {
+				return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "block %s of %d bytes does not fit into a piece of %d bytes", block.Cid(), len(block.RawData()), pieceSize)
+			}
+		}
+		err = writer.write(block)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if writer.file != nil {
+		result, err := writer.close()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *result)
+	}
+
+	if preparation == "" {
+		return results, nil
+	}
+	for i, result := range results {
+		mCar, err := dataprep.Default.AddPieceHandler(ctx, db, preparation, dataprep.AddPieceRequest{
+			PieceCID:  result.PieceCID,
+			PieceSize: strconv.FormatInt(result.PieceSize, 10),
+			FilePath:  result.Path,
+			RootCID:   result.RootCID,
+			FileSize:  result.FileSize,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to register piece %s", result.PieceCID)
+		}
+		results[i].CarID = &mCar.ID
+	}
+	return results, nil
+}
@@ -0,0 +1,128 @@
+package tool
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipld/go-car/v2"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// writeTestCar writes a CAR file with the given blocks, rooted at the first one.
+func writeTestCar(t *testing.T, path string, blks []blocks.Block) {
+	var buf bytes.Buffer
+	_, err := packutil.WriteCarHeader(&buf, blks[0].Cid())
+	require.NoError(t, err)
+	for _, blk := range blks {
+		_, err = packutil.WriteCarBlock(&buf, blk)
+		require.NoError(t, err)
+	}
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+}
+
+func readTestCar(t *testing.T, path string) *car.BlockReader {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+	reader, err := car.NewBlockReader(f)
+	require.NoError(t, err)
+	return reader
+}
+
+func TestSplitCarHandler(t *testing.T) {
+	var blks []blocks.Block
+	for i := 0; i < 10; i++ {
+		blks = append(blks, blocks.NewBlock(testutil.GenerateRandomBytes(1000)))
+	}
+	input := filepath.Join(t.TempDir(), "input.car")
+	writeTestCar(t, input, blks)
+
+	t.Run("invalid piece size", func(t *testing.T) {
+		_, err := SplitCarHandler(context.Background(), nil, input, t.TempDir(), 3000, "")
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+
+	t.Run("block larger than piece", func(t *testing.T) {
+		outDir := t.TempDir()
+		_, err := SplitCarHandler(context.Background(), nil, input, outDir, 1024, "")
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		entries, err := os.ReadDir(outDir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("split", func(t *testing.T) {
+		outDir := t.TempDir()
+		results, err := SplitCarHandler(context.Background(), nil, input, outDir, 4096, "")
+		require.NoError(t, err)
+		// Each CAR file holds up to 4064 bytes, so 3 blocks of about 1040 bytes with the header
+		require.Len(t, results, 4)
+		require.Equal(t, blks[0].Cid().String(), results[0].RootCID)
+		var split []blocks.Block
+		for _, result := range results {
+			require.EqualValues(t, 4096, result.PieceSize)
+			require.Nil(t, result.CarID)
+			stat, err := os.Stat(result.Path)
+			require.NoError(t, err)
+			require.Equal(t, result.FileSize, stat.Size())
+			require.LessOrEqual(t, stat.Size(), int64(4064))
+			require.Equal(t, filepath.Join(outDir, result.PieceCID+".car"), result.Path)
+
+			reader := readTestCar(t, result.Path)
+			require.Equal(t, result.RootCID, reader.Roots[0].String())
+			count := 0
+			for {
+				blk, err := reader.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				if count == 0 {
+					require.Equal(t, result.RootCID, blk.Cid().String())
+				}
+				split = append(split, blk)
+				count++
+			}
+			require.Equal(t, result.Blocks, count)
+		}
+		require.Len(t, split, len(blks))
+		for i := range blks {
+			require.Equal(t, blks[i].Cid(), split[i].Cid())
+			require.Equal(t, blks[i].RawData(), split[i].RawData())
+		}
+	})
+
+	t.Run("register in preparation", func(t *testing.T) {
+		testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+			_, err := SplitCarHandler(ctx, db, input, t.TempDir(), 0, "prep")
+			require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+			require.NoError(t, db.Create(&model.Preparation{Name: "prep", PieceSize: 8192}).Error)
+			results, err := SplitCarHandler(ctx, db, input, t.TempDir(), 0, "prep")
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+
+			var cars []model.Car
+			require.NoError(t, db.Order("id asc").Find(&cars).Error)
+			require.Len(t, cars, 2)
+			for i, c := range cars {
+				require.Equal(t, c.ID, *results[i].CarID)
+				require.Equal(t, results[i].PieceCID, c.PieceCID.String())
+				require.EqualValues(t, 8192, c.PieceSize)
+				require.Equal(t, results[i].RootCID, c.RootCID.String())
+				require.Equal(t, results[i].Path, c.StoragePath)
+				require.Equal(t, results[i].FileSize, c.FileSize)
+			}
+		})
+	})
+}
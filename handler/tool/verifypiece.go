@@ -0,0 +1,182 @@
+package tool
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack"
+	"github.com/data-preservation-programs/singularity/service/contentprovider"
+	"github.com/data-preservation-programs/singularity/store"
+	commp "github.com/filecoin-project/go-fil-commp-hashhash"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+)
+
+type PieceVerification struct {
+	CarID            model.CarID `json:"carId"            table:"verbose"`
+	PieceCID         string      `json:"pieceCid"`
+	PieceSize        int64       `json:"pieceSize"`
+	FileSize         int64       `json:"fileSize"`
+	RegeneratedSize  int64       `json:"regeneratedSize"  table:"verbose"` // Number of bytes of the CAR file regenerated from the data source
+	ComputedPieceCID string      `json:"computedPieceCid" table:"verbose"` // Piece CID of the regenerated CAR file
+	Verified         bool        `json:"verified"`
+	Error            string      `json:"error,omitempty"`
+}
+
+// VerifyPiecesHandler regenerates the CAR files of the pieces of a preparation from the block index in the database
+// and the data sources, as the content provider would serve them, and recomputes their piece CID. A piece whose
+// regenerated CAR file has a different size or piece CID can no longer be served, usually because its source files
+// have been modified since they were packed. Only the pieces that are backed by a data source, i.e. not the ones
+// added with 'singularity prep add-piece', can be verified.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - preparation: The ID or name of the preparation.
+//   - pieceCIDs: The CIDs of the pieces to verify, or empty to verify all pieces of the preparation.
+//   - concurrency: The number of pieces to verify at the same time.
+//
+// Returns:
+//   - A PieceVerification for each verified CAR file, in the order the pieces were packed. A mismatch is reported in
+//     the PieceVerification rather than as an error.
+//   - An error, if the preparation or one of the pieces does not exist, if the preparation has inline preparation
+//     disabled, or if any other error occurred.
+func VerifyPiecesHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	preparation string,
+	pieceCIDs []string,
+	concurrency int,
+) ([]PieceVerification, error) {
+	db = db.WithContext(ctx)
+	var prep model.Preparation
+	err := prep.FindByIDOrName(db, preparation)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(handlererror.ErrNotFound, "preparation '%s' does not exist", preparation)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if prep.NoInline {
+		return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "preparation '%s' has inline preparation disabled, its pieces cannot be regenerated", preparation)
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	query := db.Where("preparation_id = ? AND attachment_id IS NOT NULL", prep.ID)
+	if len(pieceCIDs) > 0 {
+		var cids []model.CID
+		for _, pieceCID := range pieceCIDs {
+			c, err := cid.Parse(pieceCID)
+			if err != nil {
+				return nil, errors.Wrapf(handlererror.ErrInvalidParameter, "invalid piece CID %s", pieceCID)
+			}
+			cids = append(cids, model.CID(c))
+		}
+		query = query.Where("piece_cid IN ?", cids)
+	}
+	var cars []model.Car
+	err = query.Order("id asc").Find(&cars).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	found := make(map[string]struct{}, len(cars))
+	for _, car := range cars {
+		found[car.PieceCID.String()] = struct{}{}
+	}
+	for _, pieceCID := range pieceCIDs {
+		c, _ := cid.Parse(pieceCID)
+		if _, ok := found[c.String()]; !ok {
+			return nil, errors.Wrapf(handlererror.ErrNotFound, "piece %s not found in preparation '%s' or not backed by a data source", pieceCID, preparation)
+		}
+	}
+
+	results := make([]PieceVerification, len(cars))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				results[index] = verifyPiece(ctx, db, cars[index])
+			}
+		}()
+	}
+	for i := range cars {
+		if ctx.Err() != nil {
+			break
+		}
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return results, nil
+}
+
+// verifyPiece regenerates the CAR file of a piece and compares its size and piece CID with the ones recorded when it
+// was packed.
+func verifyPiece(ctx context.Context, db *gorm.DB, car model.Car) PieceVerification {
+	result := PieceVerification{
+		CarID:     car.ID,
+		PieceCID:  car.PieceCID.String(),
+		PieceSize: car.PieceSize,
+		FileSize:  car.FileSize,
+	}
+
+	// A nil cache loads the metadata from the database
+	var cache *contentprovider.PieceMetadataCache
+	metadata, err := cache.Get(ctx, db, cid.Cid(car.PieceCID))
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	var piece *contentprovider.PieceMetadata
+	for i := range metadata {
+		if metadata[i].Car.ID == car.ID {
+			piece = &metadata[i]
+		}
+	}
+	if piece == nil {
+		result.Error = "piece metadata not found"
+		return result
+	}
+
+	reader, err := store.NewPieceReader(ctx, piece.Car, piece.Storage, piece.CarBlocks, piece.Files)
+	if err != nil {
+		result.Error = errors.Wrap(err, "failed to create piece reader").Error()
+		return result
+	}
+	defer reader.Close()
+	reader.UseBlobLoader(store.NewBlobLoader(db))
+	calc := &commp.Calc{}
+	result.RegeneratedSize, err = io.Copy(calc, reader)
+	if err != nil {
+		result.Error = errors.Wrap(err, "failed to regenerate piece").Error()
+		return result
+	}
+	if result.RegeneratedSize != car.FileSize {
+		result.Error = errors.Newf("regenerated %d bytes, expected %d", result.RegeneratedSize, car.FileSize).Error()
+		return result
+	}
+	computed, _, err := pack.GetCommp(calc, uint64(car.PieceSize))
+	if err != nil {
+		result.Error = errors.Wrap(err, "failed to calculate piece CID").Error()
+		return result
+	}
+	result.ComputedPieceCID = computed.String()
+	if computed != cid.Cid(car.PieceCID) {
+		result.Error = "piece CID mismatch, the source has changed since it was packed"
+		return result
+	}
+	result.Verified = true
+	return result
+}
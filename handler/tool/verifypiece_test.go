@@ -0,0 +1,83 @@
+package tool
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestVerifyPiecesHandler(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		tmp := t.TempDir()
+		path := filepath.Join(tmp, "test.txt")
+		require.NoError(t, os.WriteFile(path, testutil.GenerateRandomBytes(10_000), 0644))
+		stat, err := os.Stat(path)
+		require.NoError(t, err)
+
+		job := model.Job{
+			Type:  model.Pack,
+			State: model.Processing,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{
+					Name:      "prep",
+					MaxSize:   2000000,
+					PieceSize: 1 << 21,
+				},
+				Storage: &model.Storage{
+					Name: "source",
+					Type: "local",
+					Path: tmp,
+				},
+			},
+			FileRanges: []model.FileRange{{
+				Offset: 0,
+				Length: stat.Size(),
+				File: &model.File{
+					Path:             "test.txt",
+					Size:             stat.Size(),
+					LastModifiedNano: stat.ModTime().UnixNano(),
+					AttachmentID:     1,
+					Directory:        &model.Directory{AttachmentID: 1},
+				},
+			}},
+		}
+		require.NoError(t, db.Create(&job).Error)
+		car, err := pack.Pack(ctx, db, job)
+		require.NoError(t, err)
+
+		_, err = VerifyPiecesHandler(ctx, db, "other", nil, 1)
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		_, err = VerifyPiecesHandler(ctx, db, "prep", []string{testutil.TestCid.String()}, 1)
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+
+		results, err := VerifyPiecesHandler(ctx, db, "prep", []string{car.PieceCID.String()}, 1)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.True(t, results[0].Verified, results[0].Error)
+		require.Equal(t, car.PieceCID.String(), results[0].ComputedPieceCID)
+		require.Equal(t, car.FileSize, results[0].RegeneratedSize)
+
+		// The source drifts with the same size and modification time, so only the piece CID reveals it
+		require.NoError(t, os.WriteFile(path, testutil.GenerateRandomBytes(10_000), 0644))
+		require.NoError(t, os.Chtimes(path, time.Now(), stat.ModTime()))
+		results, err = VerifyPiecesHandler(ctx, db, "prep", nil, 4)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.False(t, results[0].Verified)
+		require.Contains(t, results[0].Error, "mismatch")
+
+		require.NoError(t, db.Model(&model.Preparation{}).Where("id = ?", 1).Update("no_inline", true).Error)
+		_, err = VerifyPiecesHandler(ctx, db, "prep", nil, 1)
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+	})
+}
@@ -0,0 +1,91 @@
+package wallet
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/budget"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ybbus/jsonrpc/v3"
+	"gorm.io/gorm"
+)
+
+// Balance is the balance and the datacap of a wallet on chain.
+type Balance struct {
+	ID      string  `json:"id"`      // ID is the short ID of the wallet
+	Address string  `json:"address"` // Address is the Filecoin full address of the wallet
+	Balance float64 `json:"balance"` // Balance is the balance of the wallet in FIL
+	Datacap int64   `json:"datacap"` // Datacap is the remaining datacap of the wallet in bytes, 0 if it is not a verified client
+}
+
+// BalanceHandler looks up the balance and the datacap of all the wallets stored in the database from a Lotus API,
+// which can be a Lotus gateway such as Glif, so operators can tell whether the wallets can still pay for deals without
+// a Lotus node of their own. Nothing is written to the database; the deal tracker saves the balances with the wallets
+// on each run.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - lotusClient: The RPC client used to interact with a Lotus node or gateway.
+//
+// Returns:
+//   - The balance of each wallet.
+//   - An error, if the wallets cannot be fetched from the database or their balance cannot be looked up.
+func (DefaultHandler) BalanceHandler(
+	ctx context.Context,
+	db *gorm.DB,
+	lotusClient jsonrpc.RPCClient,
+) ([]Balance, error) {
+	db = db.WithContext(ctx)
+	var wallets []model.Wallet
+	err := db.Find(&wallets).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	balances := make([]Balance, 0, len(wallets))
+	for _, wallet := range wallets {
+		var balance string
+		err = lotusClient.CallFor(ctx, &balance, "Filecoin.WalletBalance", wallet.Address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get balance of wallet %s", wallet.Address)
+		}
+		amount, ok := new(big.Int).SetString(balance, 10)
+		if !ok {
+			return nil, errors.Newf("invalid balance %s of wallet %s", balance, wallet.Address)
+		}
+
+		var datacap *string
+		err = lotusClient.CallFor(ctx, &datacap, "Filecoin.StateVerifiedClientStatus", wallet.Address, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get datacap of wallet %s", wallet.Address)
+		}
+		var remaining int64
+		if datacap != nil {
+			value, ok := new(big.Int).SetString(*datacap, 10)
+			if !ok {
+				return nil, errors.Newf("invalid datacap %s of wallet %s", *datacap, wallet.Address)
+			}
+			remaining = value.Int64()
+		}
+
+		balances = append(balances, Balance{
+			ID:      wallet.ID,
+			Address: wallet.Address,
+			Balance: budget.ToFIL(amount),
+			Datacap: remaining,
+		})
+	}
+	return balances, nil
+}
+
+// @ID GetWalletBalances
+// @Summary Get the balance and the datacap of all imported wallets
+// @Tags Wallet
+// @Produce json
+// @Success 200 {array} Balance
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /wallet/balance [get]
+func _() {}
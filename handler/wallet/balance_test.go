@@ -0,0 +1,56 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestBalanceHandler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     int    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		var result any
+		switch request.Method {
+		case "Filecoin.WalletBalance":
+			result = "1500000000000000000"
+		case "Filecoin.StateVerifiedClientStatus":
+			if request.Params[0] == "f1verified" {
+				result = "1099511627776"
+			}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": result}))
+	}))
+	defer server.Close()
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create([]model.Wallet{{ID: "f01", Address: "f1verified"}, {ID: "f02", Address: "f1other"}}).Error
+		require.NoError(t, err)
+
+		balances, err := Default.BalanceHandler(ctx, db, util.NewLotusClient(server.URL, ""))
+		require.NoError(t, err)
+		require.Equal(t, []Balance{
+			{ID: "f01", Address: "f1verified", Balance: 1.5, Datacap: 1 << 40},
+			{ID: "f02", Address: "f1other", Balance: 1.5},
+		}, balances)
+
+		// Looking the balances up does not save them
+		var wallet model.Wallet
+		require.NoError(t, db.First(&wallet, "id = ?", "f01").Error)
+		require.Zero(t, wallet.Balance)
+		require.Zero(t, wallet.Datacap)
+		require.Nil(t, wallet.BalanceUpdatedAt)
+	})
+}
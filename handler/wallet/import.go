@@ -2,15 +2,16 @@ package wallet
 
 import (
 	"context"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/database"
 	"github.com/data-preservation-programs/singularity/handler/handlererror"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/signer"
 	"github.com/data-preservation-programs/singularity/util"
 	"github.com/filecoin-project/go-address"
 	"github.com/ipfs/go-log/v2"
-	"github.com/jsign/go-filsigner/wallet"
 	"github.com/ybbus/jsonrpc/v3"
 	"gorm.io/gorm"
 )
@@ -18,7 +19,7 @@ import (
 var logger = log.Logger("singularity/handler/wallet")
 
 type ImportRequest struct {
-	PrivateKey string `json:"privateKey"` // This is the exported private key from lotus wallet export
+	PrivateKey string `json:"privateKey"` // This is the exported private key from lotus wallet export, a PKCS#11 URI of a secp256k1 key in a hardware security module, or a lotus-wallet URI of a key held by a remote wallet
 }
 
 // @ID ImportWallet
@@ -38,6 +39,15 @@ func _() {}
 // Lotus system using the provided RPC client. After confirming the actor ID from the Lotus system, it creates a
 // new wallet record in the local database.
 //
+// The private key can also be a PKCS#11 URI of a secp256k1 key kept in a hardware security module. The public key is
+// then read from the token, so the token has to be available, and only the URI is stored. The URI must not contain the
+// PIN of the token, which is read from the pin-source attribute or the SINGULARITY_PKCS11_PIN environment variable.
+// The URI must not contain a module-path either, as the module is a native library loaded into the server, so the
+// token is looked for in the modules the server allows with --pkcs11-module or SINGULARITY_PKCS11_MODULES.
+//
+// The private key can also be a lotus-wallet URI of a key held by a remote wallet, such as a lotus-wallet daemon, which
+// then signs the deal proposals. The remote wallet has to be reachable and hold the key, and only the URI is stored.
+//
 // Parameters:
 //   - ctx: The context for database transactions and other operations.
 //   - db: A pointer to the gorm.DB instance representing the database connection.
@@ -54,10 +64,37 @@ func (DefaultHandler) ImportHandler(
 	request ImportRequest,
 ) (*model.Wallet, error) {
 	db = db.WithContext(ctx)
-	addr, err := wallet.PublicKey(request.PrivateKey)
+	if signer.IsPKCS11(request.PrivateKey) {
+		uri, err := signer.ParsePKCS11URI(strings.TrimSpace(request.PrivateKey))
+		if err != nil {
+			return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+		}
+		if uri.PINValue != "" {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter,
+				"pin-value would be stored in the database, use pin-source or %s instead", signer.PINEnvVar)
+		}
+		if uri.ModulePath != "" {
+			return nil, errors.Wrapf(handlererror.ErrInvalidParameter,
+				"module-path cannot be set, the module is one of the allowed modules set with --pkcs11-module or %s", signer.ModulesEnvVar)
+		}
+	}
+	walletSigner, err := signer.New(request.PrivateKey)
+	if err != nil {
+		return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+	}
+	if remote, ok := walletSigner.(signer.RemoteSigner); ok {
+		has, err := remote.Has(ctx)
+		if err != nil {
+			return nil, errors.Join(handlererror.ErrInvalidParameter, err)
+		}
+		if !has {
+			return nil, errors.Wrap(handlererror.ErrInvalidParameter, "the remote wallet does not hold the key of the address")
+		}
+	}
+	addr, err := walletSigner.Address()
 	if err != nil {
 		logger.Errorw("failed to instantiate wallet address from private key", "err", err)
-		return nil, errors.Wrap(handlererror.ErrInvalidParameter, "invalid private key")
+		return nil, errors.Join(handlererror.ErrInvalidParameter, errors.Wrap(err, "invalid private key"))
 	}
 
 	var result string
@@ -75,7 +112,7 @@ func (DefaultHandler) ImportHandler(
 	wallet := model.Wallet{
 		ID:         result,
 		Address:    result[:1] + addr.String()[1:],
-		PrivateKey: request.PrivateKey,
+		PrivateKey: strings.TrimSpace(request.PrivateKey),
 	}
 
 	err = database.DoRetry(ctx, func() error {
@@ -2,6 +2,9 @@ package wallet
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -36,6 +39,31 @@ func TestImportHandler(t *testing.T) {
 			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
 		})
 
+		t.Run("invalid PKCS#11 URI", func(t *testing.T) {
+			_, err := Default.ImportHandler(ctx, db, lotusClient, ImportRequest{
+				PrivateKey: "pkcs11:token=wallet",
+			})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+			_, err = Default.ImportHandler(ctx, db, lotusClient, ImportRequest{
+				PrivateKey: "pkcs11:object=client?pin-value=123456",
+			})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+			require.ErrorContains(t, err, "pin-value")
+
+			_, err = Default.ImportHandler(ctx, db, lotusClient, ImportRequest{
+				PrivateKey: "pkcs11:object=client?module-path=/tmp/evil.so",
+			})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+			require.ErrorContains(t, err, "module-path")
+
+			_, err = Default.ImportHandler(ctx, db, lotusClient, ImportRequest{
+				PrivateKey: "pkcs11:object=client",
+			})
+			require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+			require.ErrorContains(t, err, "no PKCS#11 module is allowed")
+		})
+
 		t.Run("invalid response", func(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 			defer cancel()
@@ -47,3 +75,45 @@ func TestImportHandler(t *testing.T) {
 		})
 	})
 }
+
+func TestImportHandler_RemoteWallet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     int    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		var result any
+		switch request.Method {
+		case "Filecoin.WalletHas":
+			result = request.Params[0].(string)[1:] == testutil.TestWalletAddr[1:]
+		case "Filecoin.StateLookupID":
+			result = "f0100"
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": result}))
+	}))
+	defer server.Close()
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		lotusClient := util.NewLotusClient(server.URL, "")
+
+		_, err := Default.ImportHandler(ctx, db, lotusClient, ImportRequest{
+			PrivateKey: "lotus-wallet:" + testutil.TestWalletAddr + "?api=" + server.URL + "&token=secret",
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+
+		_, err = Default.ImportHandler(ctx, db, lotusClient, ImportRequest{
+			PrivateKey: "lotus-wallet:f1abjxfbp274xpdqcpuaykwkfb43omjotacm2p3za?api=" + server.URL,
+		})
+		require.ErrorIs(t, err, handlererror.ErrInvalidParameter)
+		require.ErrorContains(t, err, "does not hold the key")
+
+		privateKey := "lotus-wallet:" + testutil.TestWalletAddr + "?api=" + server.URL
+		w, err := Default.ImportHandler(ctx, db, lotusClient, ImportRequest{PrivateKey: privateKey})
+		require.NoError(t, err)
+		require.Equal(t, "f0100", w.ID)
+		require.Equal(t, testutil.TestWalletAddr, w.Address)
+		require.Equal(t, privateKey, w.PrivateKey)
+	})
+}
@@ -23,6 +23,11 @@ type Handler interface {
 		preparation string,
 		wallet string,
 	) (*model.Preparation, error)
+	BalanceHandler(
+		ctx context.Context,
+		db *gorm.DB,
+		lotusClient jsonrpc.RPCClient,
+	) ([]Balance, error)
 	ImportHandler(
 		ctx context.Context,
 		db *gorm.DB,
@@ -65,6 +70,11 @@ func (m *MockWallet) DetachHandler(ctx context.Context, db *gorm.DB, preparation
 	return args.Get(0).(*model.Preparation), args.Error(1)
 }
 
+func (m *MockWallet) BalanceHandler(ctx context.Context, db *gorm.DB, lotusClient jsonrpc.RPCClient) ([]Balance, error) {
+	args := m.Called(ctx, db, lotusClient)
+	return args.Get(0).([]Balance), args.Error(1)
+}
+
 func (m *MockWallet) ImportHandler(ctx context.Context, db *gorm.DB, lotusClient jsonrpc.RPCClient, request ImportRequest) (*model.Wallet, error) {
 	args := m.Called(ctx, db, lotusClient, request)
 	return args.Get(0).(*model.Wallet), args.Error(1)
@@ -0,0 +1,28 @@
+//nolint:forcetypeassert
+package worker
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type Handler interface {
+	ListHandler(ctx context.Context, db *gorm.DB) ([]Status, error)
+}
+
+type DefaultHandler struct{}
+
+var Default Handler = &DefaultHandler{}
+
+var _ Handler = &MockWorker{}
+
+type MockWorker struct {
+	mock.Mock
+}
+
+func (m *MockWorker) ListHandler(ctx context.Context, db *gorm.DB) ([]Status, error) {
+	args := m.Called(ctx, db)
+	return args.Get(0).([]Status), args.Error(1)
+}
@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
+	"github.com/data-preservation-programs/singularity/version"
+	"gorm.io/gorm"
+)
+
+type Status struct {
+	ID            string           `json:"id"`
+	Type          model.WorkerType `json:"type"`
+	Hostname      string           `json:"hostname"`
+	Version       string           `json:"version"`
+	Outdated      bool             `json:"outdated"` // Whether the worker runs another version of Singularity than this instance
+	StartedAt     time.Time        `json:"startedAt"     table:"format:2006-01-02 15:04:05"`
+	LastHeartbeat time.Time        `json:"lastHeartbeat" table:"format:2006-01-02 15:04:05"`
+	Stale         bool             `json:"stale"`           // Whether the worker has missed its last heartbeats
+	JobID         *model.JobID     `json:"jobId,omitempty"` // The job the worker is working on
+	JobType       model.JobType    `json:"jobType,omitempty"`
+	Preparation   string           `json:"preparation,omitempty"`
+	ProgressAt    *time.Time       `json:"progressAt,omitempty"` // Last time the job of the worker made progress
+	Stuck         bool             `json:"stuck"`                // Whether the job of the worker has made no progress for the default stuck threshold
+	BytesPacked   int64            `json:"bytesPacked"`          // Total size of the CAR files packed by the worker since it started
+	Throughput    int64            `json:"throughput"`           // Average bytes packed per second since the worker started
+}
+
+// ListHandler lists the workers that are sending heartbeats, with the job each of them is working on and their
+// throughput, so that workers that are stuck, cut off from the database or running an outdated version of Singularity
+// can be spotted across a fleet.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//
+// Returns:
+//   - A slice of the status of each worker, ordered by hostname and start time.
+//   - An error, if any occurred during the operation.
+func (DefaultHandler) ListHandler(ctx context.Context, db *gorm.DB) ([]Status, error) {
+	db = db.WithContext(ctx)
+	var workers []model.Worker
+	err := db.Order("hostname asc, started_at asc, id asc").Find(&workers).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var jobs []model.Job
+	err = db.Preload("Attachment.Preparation").Where("state = ? AND worker_id IS NOT NULL", model.Processing).Find(&jobs).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	jobsByWorker := make(map[string]model.Job, len(jobs))
+	for _, job := range jobs {
+		jobsByWorker[*job.WorkerID] = job
+	}
+
+	statuses := make([]Status, 0, len(workers))
+	for _, worker := range workers {
+		status := Status{
+			ID:            worker.ID,
+			Type:          worker.Type,
+			Hostname:      worker.Hostname,
+			Version:       worker.Version,
+			Outdated:      worker.Version != "" && version.Version != "" && worker.Version != version.Version,
+			StartedAt:     worker.StartedAt,
+			LastHeartbeat: worker.LastHeartbeat,
+			Stale:         healthcheck.IsStale(worker.LastHeartbeat),
+			BytesPacked:   worker.BytesPacked,
+		}
+		if elapsed := worker.LastHeartbeat.Sub(worker.StartedAt); elapsed >= time.Second {
+			status.Throughput = int64(float64(worker.BytesPacked) / elapsed.Seconds())
+		}
+		if job, ok := jobsByWorker[worker.ID]; ok {
+			status.JobID = &job.ID
+			status.JobType = job.Type
+			if job.Attachment != nil && job.Attachment.Preparation != nil {
+				status.Preparation = job.Attachment.Preparation.Name
+			}
+			status.ProgressAt = job.ProgressAt
+			status.Stuck = job.ProgressAt != nil && time.Since(*job.ProgressAt) > healthcheck.DefaultStuckThreshold
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// @ID ListWorkers
+// @Summary List the workers with their current job and throughput
+// @Tags Worker
+// @Produce json
+// @Success 200 {array} Status
+// @Failure 500 {object} api.HTTPError
+// @Router /worker [get]
+func _() {}
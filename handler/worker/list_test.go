@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/data-preservation-programs/singularity/version"
+	"github.com/gotidy/ptr"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestListHandler(t *testing.T) {
+	oldVersion := version.Version
+	version.Version = "v0.5.0"
+	defer func() {
+		version.Version = oldVersion
+	}()
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		now := time.Now().UTC()
+		err := db.Create([]model.Worker{{
+			ID:            "busy",
+			Hostname:      "host1",
+			Type:          model.DatasetWorker,
+			Version:       "v0.5.0",
+			StartedAt:     now.Add(-100 * time.Second),
+			LastHeartbeat: now,
+			BytesPacked:   1000,
+		}, {
+			ID:            "stuck",
+			Hostname:      "host2",
+			Type:          model.DatasetWorker,
+			Version:       "v0.4.0",
+			StartedAt:     now.Add(-time.Hour),
+			LastHeartbeat: now.Add(-3 * time.Minute),
+		}, {
+			ID:            "idle",
+			Hostname:      "host1",
+			Type:          model.DealPusher,
+			Version:       "v0.5.0",
+			StartedAt:     now.Add(-time.Hour),
+			LastHeartbeat: now,
+		}}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Preparation{Name: "prep"}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Storage{Name: "source", Type: "local"}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.SourceAttachment{PreparationID: 1, StorageID: 1}).Error
+		require.NoError(t, err)
+		err = db.Create([]model.Job{{
+			Type:         model.Pack,
+			State:        model.Processing,
+			WorkerID:     ptr.Of("busy"),
+			ProgressAt:   ptr.Of(now),
+			AttachmentID: 1,
+		}, {
+			Type:         model.Scan,
+			State:        model.Processing,
+			WorkerID:     ptr.Of("stuck"),
+			ProgressAt:   ptr.Of(now.Add(-time.Hour)),
+			AttachmentID: 1,
+		}}).Error
+		require.NoError(t, err)
+
+		statuses, err := Default.ListHandler(ctx, db)
+		require.NoError(t, err)
+		require.Len(t, statuses, 3)
+
+		require.Equal(t, "idle", statuses[0].ID)
+		require.Nil(t, statuses[0].JobID)
+		require.False(t, statuses[0].Stuck)
+		require.False(t, statuses[0].Outdated)
+		require.Zero(t, statuses[0].Throughput)
+
+		require.Equal(t, "busy", statuses[1].ID)
+		require.NotNil(t, statuses[1].JobID)
+		require.Equal(t, model.JobID(1), *statuses[1].JobID)
+		require.Equal(t, model.Pack, statuses[1].JobType)
+		require.Equal(t, "prep", statuses[1].Preparation)
+		require.False(t, statuses[1].Stuck)
+		require.False(t, statuses[1].Stale)
+		require.EqualValues(t, 10, statuses[1].Throughput)
+
+		require.Equal(t, "stuck", statuses[2].ID)
+		require.Equal(t, model.Scan, statuses[2].JobType)
+		require.True(t, statuses[2].Stuck)
+		require.True(t, statuses[2].Stale)
+		require.True(t, statuses[2].Outdated)
+	})
+}
@@ -0,0 +1,141 @@
+// Package i18n translates the messages shown to users by the CLI and the API, such as the error categories of the
+// handlers, into the locale of the user. English is the source language and the fallback of every message that has no
+// translation yet.
+package i18n
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// Supported are the locales that messages are translated to, with English first as the fallback.
+var Supported = []language.Tag{
+	language.English,
+	language.MustParse("zh-CN"),
+	language.Spanish,
+}
+
+var matcher = language.NewMatcher(Supported)
+
+var cat = catalog.NewBuilder(catalog.Fallback(language.English))
+
+// keys are the English messages that have translations, so only those are looked up in the catalog.
+var keys = make(map[string]struct{})
+
+func init() {
+	for key, translations := range messages {
+		keys[key] = struct{}{}
+		for tag, translation := range translations {
+			err := cat.SetString(language.MustParse(tag), key, translation)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// Match returns the supported locale that best matches a locale, which can be a BCP 47 tag such as "zh-CN", a POSIX
+// locale such as "es_ES.UTF-8" or the value of an Accept-Language header. It returns English if none matches.
+func Match(locale string) language.Tag {
+	locale = strings.TrimSpace(locale)
+	// POSIX locales carry a charset and a modifier, i.e. zh_CN.UTF-8@pinyin, and use an underscore
+	if i := strings.IndexAny(locale, ".@"); i >= 0 && !strings.ContainsAny(locale, ",;") {
+		locale = locale[:i]
+	}
+	locale = strings.ReplaceAll(locale, "_", "-")
+	if locale == "" || locale == "C" || locale == "POSIX" {
+		return language.English
+	}
+	tags, _, err := language.ParseAcceptLanguage(locale)
+	if err != nil || len(tags) == 0 {
+		return language.English
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return language.English
+	}
+	return Supported[index]
+}
+
+// FromEnv returns the locale of the environment, from the LC_ALL, LC_MESSAGES or LANG environment variable.
+func FromEnv() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// Printer returns a printer that formats messages in a locale, translating the messages of the catalog.
+func Printer(tag language.Tag) *message.Printer {
+	return message.NewPrinter(tag, message.Catalog(cat))
+}
+
+// Sprintf formats a message in the locale carried by the context.
+func Sprintf(ctx context.Context, key string, args ...any) string {
+	return Printer(FromContext(ctx)).Sprintf(key, args...)
+}
+
+// Translate returns the translation of a message in a locale, or the message itself if it has no translation.
+// Unlike Sprintf, the message is not interpreted as a format string.
+func Translate(tag language.Tag, msg string) string {
+	if _, ok := keys[msg]; !ok {
+		return msg
+	}
+	return Printer(tag).Sprintf(msg)
+}
+
+// Error returns the message of an error in a locale. The message of the innermost error, i.e.
+// handlererror.ErrNotFound, is translated, while the context it is wrapped with, which is built at runtime, is kept.
+func Error(tag language.Tag, err error) string {
+	msg := err.Error()
+	cause := errors.UnwrapAll(err).Error()
+	if _, ok := keys[cause]; !ok {
+		return Translate(tag, msg)
+	}
+	if msg == cause {
+		return Translate(tag, cause)
+	}
+	if prefix, ok := strings.CutSuffix(msg, ": "+cause); ok {
+		return prefix + ": " + Translate(tag, cause)
+	}
+	return msg
+}
+
+type contextKey struct{}
+
+// WithLocale returns a copy of the context that carries the locale of the user.
+func WithLocale(ctx context.Context, tag language.Tag) context.Context {
+	return context.WithValue(ctx, contextKey{}, tag)
+}
+
+// FromContext returns the locale carried by the context, or English if there is none.
+func FromContext(ctx context.Context) language.Tag {
+	tag, ok := ctx.Value(contextKey{}).(language.Tag)
+	if !ok {
+		return language.English
+	}
+	return tag
+}
+
+// Middleware is an echo middleware that passes the locale of the Accept-Language request header to the handlers
+// through the request context, and advertises it in the Content-Language response header.
+func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		header := c.Request().Header.Get("Accept-Language")
+		if header != "" {
+			tag := Match(header)
+			c.Response().Header().Set("Content-Language", tag.String())
+			c.SetRequest(c.Request().WithContext(WithLocale(c.Request().Context(), tag)))
+		}
+		return next(c)
+	}
+}
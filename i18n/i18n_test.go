@@ -0,0 +1,68 @@
+package i18n
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestMatch(t *testing.T) {
+	zhCN := language.MustParse("zh-CN")
+	for locale, expected := range map[string]language.Tag{
+		"":                   language.English,
+		"C":                  language.English,
+		"C.UTF-8":            language.English,
+		"en_US.UTF-8":        language.English,
+		"fr-FR":              language.English,
+		"zh-CN":              zhCN,
+		"zh_CN.UTF-8":        zhCN,
+		"zh-Hans":            zhCN,
+		"es":                 language.Spanish,
+		"es_MX.UTF-8@euro":   language.Spanish,
+		"fr;q=0.9, es;q=0.8": language.Spanish,
+		"es;q=0.5, zh-CN":    zhCN,
+	} {
+		require.Equal(t, expected, Match(locale), locale)
+	}
+}
+
+func TestError(t *testing.T) {
+	zhCN := language.MustParse("zh-CN")
+	notFound := errors.New("not found")
+	require.Equal(t, "未找到", Error(zhCN, notFound))
+	require.Equal(t, "preparation 'prep': no encontrado",
+		Error(language.Spanish, errors.WithStack(errors.Wrapf(notFound, "preparation '%s'", "prep"))))
+	require.Equal(t, "preparation 'prep': not found",
+		Error(language.English, errors.Wrapf(notFound, "preparation '%s'", "prep")))
+	// Messages without translation are kept, and are not interpreted as format strings
+	require.Equal(t, "100% done", Error(zhCN, errors.New("100% done")))
+	require.Equal(t, "无法绑定请求正文：EOF", Printer(zhCN).Sprintf("failed to bind request body: %s", "EOF"))
+}
+
+func TestMiddleware(t *testing.T) {
+	e := echo.New()
+	handler := Middleware(func(c echo.Context) error {
+		return c.String(http.StatusOK, Sprintf(c.Request().Context(), "unknown version"))
+	})
+	for header, expected := range map[string]string{
+		"":               "unknown version",
+		"zh-CN,zh;q=0.9": "未知版本",
+		"es-ES,es;q=0.9": "versión desconocida",
+		"de-DE,de;q=0.9": "unknown version",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if header != "" {
+			req.Header.Set("Accept-Language", header)
+		}
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler(e.NewContext(req, rec)))
+		require.Equal(t, expected, rec.Body.String(), header)
+	}
+	require.Equal(t, language.English, FromContext(context.Background()))
+}
@@ -0,0 +1,67 @@
+package i18n
+
+// messages are the translations of the English messages, keyed by the message and then by the locale. The messages
+// with verbs are format strings, and their translations must keep the same verbs.
+var messages = map[string]map[string]string{
+	// Error categories of the handlers, see handler/handlererror
+	"invalid parameter": {
+		"zh-CN": "参数无效",
+		"es":    "parámetro no válido",
+	},
+	"not found": {
+		"zh-CN": "未找到",
+		"es":    "no encontrado",
+	},
+	"duplicate record": {
+		"zh-CN": "记录重复",
+		"es":    "registro duplicado",
+	},
+	"unauthorized": {
+		"zh-CN": "未经授权",
+		"es":    "no autorizado",
+	},
+	"forbidden": {
+		"zh-CN": "禁止访问",
+		"es":    "prohibido",
+	},
+
+	// CLI
+	"command not found": {
+		"zh-CN": "未找到命令",
+		"es":    "comando no encontrado",
+	},
+	"incorrect number of arguments": {
+		"zh-CN": "参数数量不正确",
+		"es":    "número de argumentos incorrecto",
+	},
+	"you must pass --really-do-it to do this": {
+		"zh-CN": "必须指定 --really-do-it 才能执行此操作",
+		"es":    "debe indicar --really-do-it para hacer esto",
+	},
+	"unknown version": {
+		"zh-CN": "未知版本",
+		"es":    "versión desconocida",
+	},
+	"Enter the private key: ": {
+		"zh-CN": "请输入私钥：",
+		"es":    "Introduzca la clave privada: ",
+	},
+
+	// API
+	"invalid handler function signature": {
+		"zh-CN": "处理函数签名无效",
+		"es":    "firma de la función del controlador no válida",
+	},
+	"failed to decode path parameter": {
+		"zh-CN": "无法解码路径参数",
+		"es":    "no se pudo decodificar el parámetro de la ruta",
+	},
+	"failed to parse path parameter as number": {
+		"zh-CN": "无法将路径参数解析为数字",
+		"es":    "no se pudo interpretar el parámetro de la ruta como un número",
+	},
+	"failed to bind request body: %s": {
+		"zh-CN": "无法绑定请求正文：%s",
+		"es":    "no se pudo vincular el cuerpo de la solicitud: %s",
+	},
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// approvalThresholdKey is the key of the Global that holds the approval threshold of deal schedules.
+const approvalThresholdKey = "schedule_approval_threshold"
+
+// approvalThresholdWhere matches the Global of the approval threshold. The key column is quoted since it is a reserved word in MySQL.
+func approvalThresholdWhere() clause.Where {
+	return clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Name: "key"}, Value: approvalThresholdKey},
+	}}
+}
+
+// GetApprovalThreshold returns the total deal size in bytes above which creating or modifying a deal schedule needs
+// to be approved by a second operator, or 0 if no approval is needed.
+func GetApprovalThreshold(db *gorm.DB) (int64, error) {
+	var global Global
+	err := db.Clauses(approvalThresholdWhere()).First(&global).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	threshold, err := strconv.ParseInt(global.Value, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid approval threshold %s", global.Value)
+	}
+	return threshold, nil
+}
+
+// SetApprovalThreshold sets the total deal size in bytes above which creating or modifying a deal schedule needs to
+// be approved by a second operator. A threshold of 0 removes the need for approval.
+func SetApprovalThreshold(db *gorm.DB, threshold int64) error {
+	if threshold <= 0 {
+		err := db.Clauses(approvalThresholdWhere()).Delete(&Global{}).Error
+		return errors.Wrap(err, "failed to remove approval threshold")
+	}
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value"}),
+	}).Create(&Global{Key: approvalThresholdKey, Value: strconv.FormatInt(threshold, 10)}).Error
+	return errors.Wrap(err, "failed to set approval threshold")
+}
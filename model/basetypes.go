@@ -17,6 +17,7 @@ var ErrInvalidCIDEntry = errors.New("invalid CID entry in the database")
 var ErrInvalidStringSliceEntry = errors.New("invalid string slice entry in the database")
 var ErrInvalidStringMapEntry = errors.New("invalid string map entry in the database")
 var ErrInvalidHTTPConfigEntry = errors.New("invalid ClientConfig entry in the database")
+var ErrInvalidPolicyProvidersEntry = errors.New("invalid policy providers entry in the database")
 
 type StringSlice []string
 
@@ -153,6 +154,24 @@ func (ss *StringSlice) Scan(src any) error {
 	return json.Unmarshal(source, ss)
 }
 
+func (p PolicyProviders) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
+func (p *PolicyProviders) Scan(src any) error {
+	if src == nil {
+		*p = nil
+		return nil
+	}
+
+	source, ok := src.([]byte)
+	if !ok {
+		return ErrInvalidPolicyProvidersEntry
+	}
+
+	return json.Unmarshal(source, p)
+}
+
 func (m *ConfigMap) Scan(src any) error {
 	if src == nil {
 		*m = nil
@@ -167,11 +186,6 @@ func (m *ConfigMap) Scan(src any) error {
 	return json.Unmarshal(source, m)
 }
 
-func IsSecretConfigName(key string) bool {
-	k := strings.ToLower(key)
-	return strings.Contains(k, "secret") || strings.Contains(k, "pass") || strings.Contains(k, "token") || strings.Contains(k, "key")
-}
-
 func (m ConfigMap) String() string {
 	if m == nil {
 		return "<nil>"
@@ -182,7 +196,7 @@ func (m ConfigMap) String() string {
 			continue
 		}
 		if IsSecretConfigName(k) {
-			v = "*"
+			v = MaskedSecret
 		}
 		values = append(values, k+":"+v)
 	}
@@ -280,9 +294,11 @@ type JobState string
 type JobType string
 
 const (
-	DealTracker   WorkerType = "deal_tracker"
-	DealPusher    WorkerType = "deal_pusher"
-	DatasetWorker WorkerType = "dataset_worker"
+	DealTracker        WorkerType = "deal_tracker"
+	DealPusher         WorkerType = "deal_pusher"
+	DatasetWorker      WorkerType = "dataset_worker"
+	DatasetCoordinator WorkerType = "dataset_coordinator"
+	RetrievalSampler   WorkerType = "retrieval_sampler"
 )
 
 const (
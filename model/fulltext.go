@@ -0,0 +1,170 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrFullTextSearchNotSupported = errors.New("full-text search is not supported by this database")
+
+// fullTextSearchKey is the key of the Global that records whether the full-text index has been created.
+const fullTextSearchKey = "full_text_search"
+
+// fullTextTable is the SQLite FTS5 table that indexes the path of files, using the files table as external content.
+const fullTextTable = "files_fts"
+
+// fullTextVector returns the Postgres expression that is indexed for the path of files. Path separators and punctuation
+// are replaced with spaces first, otherwise the default parser keeps a whole path as a single token.
+func fullTextVector(column string) string {
+	return "to_tsvector('simple', translate(" + column + ", '/._-', '    '))"
+}
+
+var sqliteFullTextStatements = []string{
+	"CREATE VIRTUAL TABLE IF NOT EXISTS files_fts USING fts5(path, content='files', content_rowid='id')",
+	`CREATE TRIGGER IF NOT EXISTS files_fts_insert AFTER INSERT ON files BEGIN
+	INSERT INTO files_fts(rowid, path) VALUES (new.id, new.path);
+END`,
+	`CREATE TRIGGER IF NOT EXISTS files_fts_delete AFTER DELETE ON files BEGIN
+	INSERT INTO files_fts(files_fts, rowid, path) VALUES ('delete', old.id, old.path);
+END`,
+	`CREATE TRIGGER IF NOT EXISTS files_fts_update AFTER UPDATE OF path ON files BEGIN
+	INSERT INTO files_fts(files_fts, rowid, path) VALUES ('delete', old.id, old.path);
+	INSERT INTO files_fts(rowid, path) VALUES (new.id, new.path);
+END`,
+	"INSERT INTO files_fts(files_fts) VALUES ('rebuild')",
+}
+
+var sqliteDropFullTextStatements = []string{
+	"DROP TRIGGER IF EXISTS files_fts_insert",
+	"DROP TRIGGER IF EXISTS files_fts_delete",
+	"DROP TRIGGER IF EXISTS files_fts_update",
+	"DROP TABLE IF EXISTS files_fts",
+}
+
+// EnableFullTextSearch creates a full-text index over the path of all files, so they can be searched by words
+// without scanning the whole files table. The index is kept up to date by the database itself.
+//
+// SQLite uses an FTS5 table maintained by triggers, and Postgres uses a GIN index over a tsvector expression.
+// FTS5 is only available in SQLite builds that include it, i.e. when built with the sqlite_fts5 tag if CGO is enabled.
+// Building the index for an existing database may take a while as all paths are indexed.
+//
+// Parameters:
+//   - db: A pointer to a gorm.DB object, which provides database access.
+//
+// Returns:
+//   - ErrFullTextSearchNotSupported if the database does not support it, or any other error that occurred.
+func EnableFullTextSearch(db *gorm.DB) error {
+	switch db.Dialector.Name() {
+	case "sqlite":
+		for _, statement := range sqliteFullTextStatements {
+			err := db.Exec(statement).Error
+			if err != nil && strings.Contains(err.Error(), "no such module") {
+				return errors.Wrap(ErrFullTextSearchNotSupported, err.Error())
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed to create full-text index")
+			}
+		}
+	case "postgres":
+		err := db.Exec("CREATE INDEX IF NOT EXISTS idx_files_path_fts ON files USING GIN (" + fullTextVector("path") + ")").Error
+		if err != nil {
+			return errors.Wrap(err, "failed to create full-text index")
+		}
+	default:
+		return errors.Wrapf(ErrFullTextSearchNotSupported, "dialect %s", db.Dialector.Name())
+	}
+
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value"}),
+	}).Create(&Global{Key: fullTextSearchKey, Value: "true"}).Error
+	return errors.Wrap(err, "failed to record full-text search")
+}
+
+// DisableFullTextSearch removes the full-text index created by EnableFullTextSearch. It does nothing if the index
+// does not exist.
+//
+// Parameters:
+//   - db: A pointer to a gorm.DB object, which provides database access.
+//
+// Returns:
+//   - An error if any issues arise while removing the index, otherwise nil.
+func DisableFullTextSearch(db *gorm.DB) error {
+	switch db.Dialector.Name() {
+	case "sqlite":
+		for _, statement := range sqliteDropFullTextStatements {
+			err := db.Exec(statement).Error
+			if err != nil {
+				return errors.Wrap(err, "failed to drop full-text index")
+			}
+		}
+	case "postgres":
+		err := db.Exec("DROP INDEX IF EXISTS idx_files_path_fts").Error
+		if err != nil {
+			return errors.Wrap(err, "failed to drop full-text index")
+		}
+	}
+
+	err := db.Clauses(fullTextSearchWhere()).Delete(&Global{}).Error
+	return errors.Wrap(err, "failed to record full-text search")
+}
+
+// fullTextSearchWhere matches the Global of the full-text index. The key column is quoted since it is a reserved word in MySQL.
+func fullTextSearchWhere() clause.Where {
+	return clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Name: "key"}, Value: fullTextSearchKey},
+	}}
+}
+
+// FullTextSearchEnabled returns whether the full-text index has been created by EnableFullTextSearch.
+func FullTextSearchEnabled(db *gorm.DB) (bool, error) {
+	var global Global
+	err := db.Clauses(fullTextSearchWhere()).First(&global).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return global.Value == "true", nil
+}
+
+// FullTextPathCondition builds a condition on the files table that matches the paths containing words starting with
+// each word of the term, i.e. "2024 report" matches "2024/06/reports.csv", using the full-text index.
+//
+// Parameters:
+//   - db: A pointer to a gorm.DB object, which decides the dialect of the condition.
+//   - term: The search term. Punctuation in the term separates words and is otherwise ignored.
+//
+// Returns:
+//   - The condition and its argument, and false if the term has no words or the database does not support full-text search.
+func FullTextPathCondition(db *gorm.DB, term string) (string, any, bool) {
+	words := strings.FieldsFunc(strings.ToLower(term), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return "", nil, false
+	}
+
+	switch db.Dialector.Name() {
+	case "sqlite":
+		query := make([]string, 0, len(words))
+		for _, word := range words {
+			query = append(query, `"`+word+`"*`)
+		}
+		return "files.id IN (SELECT rowid FROM " + fullTextTable + " WHERE " + fullTextTable + " MATCH ?)",
+			strings.Join(query, " "), true
+	case "postgres":
+		query := make([]string, 0, len(words))
+		for _, word := range words {
+			query = append(query, word+":*")
+		}
+		return fullTextVector("files.path") + " @@ to_tsquery('simple', ?)", strings.Join(query, " & "), true
+	default:
+		return "", nil, false
+	}
+}
@@ -14,6 +14,7 @@ import (
 var Tables = []any{
 	&Worker{},
 	&Global{},
+	&Lease{},
 	&Preparation{},
 	&Storage{},
 	&OutputAttachment{},
@@ -24,9 +25,20 @@ var Tables = []any{
 	&Directory{},
 	&Car{},
 	&CarBlock{},
+	&PackTiming{},
+	&SourceRead{},
 	&Deal{},
+	&ReplicationPolicy{},
 	&Schedule{},
 	&Wallet{},
+	&Operator{},
+	&Budget{},
+	&PieceReceipt{},
+	&ProviderReputation{},
+	&RetrievalProof{},
+	&RetrievalToken{},
+	&IndexAdvertisement{},
+	&IndexEntryChunk{},
 }
 
 var logger = logging.Logger("model")
@@ -98,6 +110,14 @@ func AutoMigrate(db *gorm.DB) error {
 //   - An error if any issues arise during the table drop process, otherwise nil.
 func DropAll(db *gorm.DB) error {
 	logger.Info("Dropping all tables")
+	if db.Dialector.Name() == "sqlite" {
+		for _, statement := range sqliteDropFullTextStatements {
+			err := db.Exec(statement).Error
+			if err != nil {
+				return errors.Wrap(err, "failed to drop full-text index")
+			}
+		}
+	}
 	for _, table := range Tables {
 		err := db.Migrator().DropTable(table)
 		if err != nil {
@@ -2,6 +2,8 @@ package model
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +18,9 @@ type Worker struct {
 	LastHeartbeat time.Time  `json:"lastHeartbeat"`
 	Hostname      string     `json:"hostname"`
 	Type          WorkerType `json:"type"`
+	Version       string     `json:"version"`     // Version of Singularity run by the worker
+	StartedAt     time.Time  `json:"startedAt"`   // When the worker registered
+	BytesPacked   int64      `json:"bytesPacked"` // Total size of the CAR files packed by the worker since it registered
 }
 
 type Global struct {
@@ -23,21 +28,208 @@ type Global struct {
 	Value string `json:"value"`
 }
 
+// Lease is held by at most one replica of a service at a time, and is used to elect a leader among the replicas.
+type Lease struct {
+	Name      string    `gorm:"primaryKey;size:255" json:"name"`
+	Holder    string    `json:"holder"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
 type PreparationID uint32
 
+// ConflictPolicy decides what happens when a directory already has an entry with the same name as a file being packed,
+// i.e. when a rescan has found a new version of a file that has already been packed.
+type ConflictPolicy string
+
+const (
+	ConflictOverwrite ConflictPolicy = "overwrite" // The version packed last replaces the existing entry, as before conflict policies were introduced
+	ConflictNewest    ConflictPolicy = "newest"    // The version with the latest modification time is kept
+	ConflictKeepBoth  ConflictPolicy = "keep_both" // Both versions are kept, and the later packed one gets a numbered suffix, i.e. "file (1).txt"
+	ConflictError     ConflictPolicy = "error"     // Packing fails, so the conflict can be resolved manually
+)
+
+var ConflictPolicies = []ConflictPolicy{
+	ConflictOverwrite,
+	ConflictNewest,
+	ConflictKeepBoth,
+	ConflictError,
+}
+
+var ConflictPolicyStrings = []string{
+	string(ConflictOverwrite),
+	string(ConflictNewest),
+	string(ConflictKeepBoth),
+	string(ConflictError),
+}
+
+// PartitionBy decides how files are organized into date-partitioned virtual directories, i.e. 2024/06/15/ when
+// partitioned by day, so retrievals of a time range map onto a small set of directories and pieces.
+type PartitionBy string
+
+const (
+	PartitionNone  PartitionBy = ""      // Files keep the directory structure of the source
+	PartitionYear  PartitionBy = "year"  // Files are organized into 2024/<path>
+	PartitionMonth PartitionBy = "month" // Files are organized into 2024/06/<path>
+	PartitionDay   PartitionBy = "day"   // Files are organized into 2024/06/15/<path>
+	PartitionHour  PartitionBy = "hour"  // Files are organized into 2024/06/15/13/<path>
+)
+
+var PartitionByStrings = []string{
+	string(PartitionYear),
+	string(PartitionMonth),
+	string(PartitionDay),
+	string(PartitionHour),
+}
+
+// Directory returns the virtual directory of a file with the given time, i.e. 2024/06/15 when partitioned by day.
+// Times are converted to UTC. An empty string is returned if files are not partitioned.
+func (p PartitionBy) Directory(t time.Time) string {
+	t = t.UTC()
+	switch p {
+	case PartitionYear:
+		return t.Format("2006")
+	case PartitionMonth:
+		return t.Format("2006/01")
+	case PartitionDay:
+		return t.Format("2006/01/02")
+	case PartitionHour:
+		return t.Format("2006/01/02/15")
+	default:
+		return ""
+	}
+}
+
+// RescanMode decides how a rescan of a source treats the files that have already been scanned.
+type RescanMode string
+
+const (
+	RescanAppend      RescanMode = "append"      // Added and modified files are added, and removed files are kept in the directory tree
+	RescanIncremental RescanMode = "incremental" // Files are compared against the scanned ones in bulk, and removed files are removed from the directory tree
+)
+
+var RescanModeStrings = []string{
+	string(RescanAppend),
+	string(RescanIncremental),
+}
+
+// RetentionMode is the object lock retention mode of the bucket of a source, as found when it was last verified.
+type RetentionMode string
+
+const (
+	RetentionNotVerified RetentionMode = ""            // The bucket has not been verified
+	RetentionNone        RetentionMode = "none"        // Object lock is not enabled, or enabled without a default retention, so objects can be overwritten or deleted
+	RetentionGovernance  RetentionMode = "governance"  // Objects are retained by default, but users with special permissions can overwrite or delete them
+	RetentionCompliance  RetentionMode = "compliance"  // Objects are retained by default, and cannot be overwritten or deleted by any user until the retention expires
+	RetentionUnsupported RetentionMode = "unsupported" // The storage type has no object lock verifier
+)
+
+// IsImmutable returns whether the objects of a bucket with this retention mode are write-once-read-many by default.
+func (r RetentionMode) IsImmutable() bool {
+	return r == RetentionGovernance || r == RetentionCompliance
+}
+
+// Immutability decides whether a preparation verifies that its sources are immutable before they are scanned.
+type Immutability string
+
+const (
+	ImmutabilityNone    Immutability = ""        // Sources are not verified
+	ImmutabilityWarn    Immutability = "warn"    // Sources are verified, and a warning is logged if they are mutable
+	ImmutabilityEnforce Immutability = "enforce" // Sources are verified, and mutable sources are refused
+)
+
+var ImmutabilityStrings = []string{
+	string(ImmutabilityWarn),
+	string(ImmutabilityEnforce),
+}
+
+// HashFunction is the multihash function used to build the CIDs of the blocks of a preparation.
+type HashFunction string
+
+const (
+	HashSHA256     HashFunction = "sha2-256"    // The default, used by most IPFS implementations
+	HashBlake2b256 HashFunction = "blake2b-256" // Faster to compute than sha2-256 on most CPUs
+)
+
+var HashFunctionStrings = []string{
+	string(HashSHA256),
+	string(HashBlake2b256),
+}
+
+// LeafCodec is the codec of the leaf blocks that hold the content of files.
+type LeafCodec string
+
+const (
+	LeafCodecRaw   LeafCodec = "raw"    // The default. Leaf blocks hold the bytes of the file as is
+	LeafCodecDagPB LeafCodec = "dag-pb" // Leaf blocks are UnixFS file nodes, as created by older IPFS implementations
+)
+
+var LeafCodecStrings = []string{
+	string(LeafCodecRaw),
+	string(LeafCodecDagPB),
+}
+
+// CidVersion is the version of the CIDs of the blocks of a preparation.
+type CidVersion string
+
+const (
+	CidV1 CidVersion = "v1" // The default
+	CidV0 CidVersion = "v0" // Legacy CIDs starting with Qm, as created by ipfs add by default. Only sha2-256 and dag-pb blocks can have a CIDv0
+)
+
+var CidVersionStrings = []string{
+	string(CidV1),
+	string(CidV0),
+}
+
+// CarCompression is how the CAR files of a preparation are compressed in the output storages. The piece CID is always
+// calculated over the uncompressed CAR file, which is what is sent to the storage providers.
+type CarCompression string
+
+const (
+	CarCompressionNone CarCompression = ""     // The default. CAR files are stored as is
+	CarCompressionZstd CarCompression = "zstd" // CAR files are stored as .car.zst and decompressed when they are served
+)
+
+var CarCompressionStrings = []string{
+	string(CarCompressionZstd),
+}
+
 // Preparation is a data preparation definition that can attach multiple source storages and up to one output storage.
 type Preparation struct {
-	ID                PreparationID `gorm:"primaryKey"        json:"id"`
-	Name              string        `gorm:"unique"            json:"name"`
-	CreatedAt         time.Time     `json:"createdAt"         table:"verbose;format:2006-01-02 15:04:05"`
-	UpdatedAt         time.Time     `json:"updatedAt"         table:"verbose;format:2006-01-02 15:04:05"`
-	DeleteAfterExport bool          `json:"deleteAfterExport"` // DeleteAfterExport is a flag that indicates whether the source files should be deleted after export.
-	MaxSize           int64         `json:"maxSize"`
-	PieceSize         int64         `json:"pieceSize"`
-	NoInline          bool          `json:"noInline"`
-	NoDag             bool          `json:"noDag"`
+	ID                   PreparationID  `gorm:"primaryKey"        json:"id"`
+	Name                 string         `gorm:"unique"            json:"name"`
+	CreatedAt            time.Time      `json:"createdAt"         table:"verbose;format:2006-01-02 15:04:05"`
+	UpdatedAt            time.Time      `json:"updatedAt"         table:"verbose;format:2006-01-02 15:04:05"`
+	DeleteAfterExport    bool           `json:"deleteAfterExport"` // DeleteAfterExport is a flag that indicates whether the source files should be deleted after export.
+	MaxSize              int64          `json:"maxSize"`
+	PieceSize            int64          `json:"pieceSize"`
+	NoInline             bool           `json:"noInline"`
+	NoDag                bool           `json:"noDag"`
+	Paused               bool           `json:"paused"`                                                            // Paused is a flag that indicates whether the workers should stop picking up the scan, pack and daggen jobs of the preparation.
+	BlobStorageID        *StorageID     `json:"blobStorageId,omitempty" table:"verbose"`                           // BlobStorageID is the storage that holds the raw blocks (dag nodes) instead of the database.
+	MaxDirectoryDepth    int            `json:"maxDirectoryDepth"       table:"verbose"`                           // MaxDirectoryDepth is the maximum number of nested directories of a file. Deeper files are skipped during scanning. 0 means unlimited.
+	ConflictPolicy       ConflictPolicy `json:"conflictPolicy" swaggertype:"string" table:"verbose"`               // ConflictPolicy decides which version of a file is kept when the same path is packed more than once. Empty means overwrite.
+	MaxBatchAge          time.Duration  `json:"maxBatchAge" swaggertype:"primitive,integer" table:"verbose"`       // MaxBatchAge is how long a pack job can be filled with appended files before it is packed even if it is not full. 0 means it waits until full.
+	PartitionBy          PartitionBy    `json:"partitionBy" swaggertype:"string" table:"verbose"`                  // PartitionBy organizes files into date-partitioned virtual directories based on their event time or modification time. Empty means the directory structure of the source is kept.
+	PieceKeyRecipient    string         `json:"pieceKeyRecipient"       table:"verbose"`                           // PieceKeyRecipient is the base64 encoded public key of the data owner. If set, each CAR file is encrypted with its own piece key, which is wrapped for this public key.
+	EncryptionRecipients StringSlice    `gorm:"type:JSON" json:"encryptionRecipients" table:"verbose"`             // EncryptionRecipients are the age X25519 public keys that the items are encrypted for as they are packed, each with its own ephemeral file key. Items are not encrypted if empty.
+	EncryptionKeyVersion int            `json:"encryptionKeyVersion" table:"verbose"`                              // EncryptionKeyVersion is incremented each time the encryption recipients are rotated, so the pieces packed for older recipients can be found. 0 if items are not encrypted.
+	EncryptionKMSKey     string         `json:"encryptionKmsKey"        table:"verbose"`                           // EncryptionKMSKey is the URI of the master key of a key management service, i.e. awskms://<key ARN>, that generates and wraps a data key for each item, which is encrypted with AES-256-GCM as it is packed. Items are not encrypted with a KMS key if empty.
+	HashFunction         HashFunction   `json:"hashFunction" swaggertype:"string" table:"verbose"`                 // HashFunction is the multihash function of the CIDs of the blocks. Empty means sha2-256.
+	LeafCodec            LeafCodec      `json:"leafCodec" swaggertype:"string" table:"verbose"`                    // LeafCodec is the codec of the leaf blocks of files. Empty means raw.
+	CidVersion           CidVersion     `json:"cidVersion" swaggertype:"string" table:"verbose"`                   // CidVersion is the version of the CIDs of the blocks. Empty means v1.
+	Chunker              string         `json:"chunker"                 table:"verbose"`                           // Chunker is the strategy splitting the content of files into leaf blocks, i.e. size-262144 or rabin-262144-524288-1048576. Empty means size-1048576.
+	SmallFileLimit       int            `json:"smallFileLimit"          table:"verbose"`                           // SmallFileLimit is the size of the largest file that is embedded in its CID, and so in its directory, rather than written as a block. 0 disables it.
+	ChecksumSidecar      bool           `json:"checksumSidecar"         table:"verbose"`                           // ChecksumSidecar is a flag that indicates whether .sha256 and .commp.json sidecar files are written next to each CAR file in the output storage.
+	CarSource            bool           `json:"carSource"               table:"verbose"`                           // CarSource is a flag that indicates whether the sources are collections of existing CAR files, whose blocks are packed as is rather than chunked again.
+	CarCompression       CarCompression `json:"carCompression" swaggertype:"string" table:"verbose"`               // CarCompression is how the CAR files are compressed in the output storage. Empty means they are not compressed.
+	CarNameTemplate      string         `json:"carNameTemplate"         table:"verbose"`                           // CarNameTemplate is the template of the paths of the CAR files in the output storage, i.e. {preparation}/{date}/{piece_cid}. Empty means {piece_cid}.
+	CarShardDepth        int            `json:"carShardDepth"           table:"verbose"`                           // CarShardDepth is the number of levels of subdirectories named after the piece CID that the CAR files are sharded into. 0 disables sharding.
+	StatsShareID         *string        `gorm:"uniqueIndex;size:64" json:"statsShareId,omitempty" table:"verbose"` // StatsShareID is the ID the stats of the preparation are publicly shared at by the content provider, or nil if they are not shared.
+	Immutability         Immutability   `json:"immutability" swaggertype:"string" table:"verbose"`                 // Immutability decides whether the sources are verified to be in buckets with object lock before they are scanned, and whether mutable sources are refused. Empty means they are not verified.
 
 	// Associations
+	BlobStorage    *Storage  `gorm:"foreignKey:BlobStorageID;constraint:OnDelete:SET NULL"    json:"blobStorage,omitempty"    swaggerignore:"true"                   table:"-"`
 	Wallets        []Wallet  `gorm:"many2many:wallet_assignments"                             json:"wallets,omitempty"        swaggerignore:"true"                   table:"expand"`
 	SourceStorages []Storage `gorm:"many2many:source_attachments;constraint:OnDelete:CASCADE" json:"sourceStorages,omitempty" table:"expand;header:Source Storages:"`
 	OutputStorages []Storage `gorm:"many2many:output_attachments;constraint:OnDelete:CASCADE" json:"outputStorages,omitempty" table:"expand;header:Output Storages:"`
@@ -67,6 +259,20 @@ func (s *Preparation) SourceAttachments(db *gorm.DB, preloads ...string) ([]Sour
 	return attachments, errors.WithStack(err)
 }
 
+// ReplicationTarget returns the number of replicas each piece of the preparation is expected to reach, which is the
+// number of distinct storage providers the preparation is scheduled with, or 1 if it has no schedule.
+func (s *Preparation) ReplicationTarget(db *gorm.DB) (int, error) {
+	var providers int64
+	err := db.Model(&Schedule{}).Where("preparation_id = ?", s.ID).Distinct("provider").Count(&providers).Error
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if providers == 0 {
+		return 1, nil
+	}
+	return int(providers), nil
+}
+
 type StorageID uint32
 
 // Storage is a storage system definition that can be used as either source or output of a Preparation.
@@ -79,6 +285,8 @@ type Storage struct {
 	Path         string       `cbor:"2,keyasint,omitempty" json:"path"`                                                                  // Path is the path to the storage root.
 	Config       ConfigMap    `cbor:"3,keyasint,omitempty" gorm:"type:JSON"  json:"config"                              table:"verbose"` // Config is a map of key-value pairs that can be used to store RClone options.
 	ClientConfig ClientConfig `cbor:"4,keyasint,omitempty" gorm:"type:JSON"  json:"clientConfig"                        table:"verbose"` // ClientConfig is the HTTP configuration for the storage, if applicable.
+	NeedsReauth  bool         `cbor:"-"                    json:"needsReauth"`                                                           // NeedsReauth is set when the credentials of the storage are rejected. The jobs that use the storage are held until it is re-authenticated.
+	AuthError    string       `cbor:"-"                    json:"authError,omitempty"                   table:"verbose"`                 // AuthError is the error the credentials of the storage were rejected with.
 
 	// Associations
 	PreparationsAsSource []Preparation `cbor:"-" gorm:"many2many:source_attachments;constraint:OnDelete:CASCADE" json:"preparationsAsSource,omitempty" table:"expand;header:As Source: "`
@@ -104,7 +312,10 @@ type SourceAttachmentID uint32
 
 // SourceAttachment is a link between a Preparation and a Storage that is used as a source.
 type SourceAttachment struct {
-	ID SourceAttachmentID `gorm:"primaryKey" json:"id"`
+	ID                 SourceAttachmentID `gorm:"primaryKey" json:"id"`
+	RescanMode         RescanMode         `json:"rescanMode" swaggertype:"string"`                               // RescanMode decides how a rescan treats the files already scanned from the source. Empty means append.
+	RetentionMode      RetentionMode      `json:"retentionMode" swaggertype:"string"`                            // RetentionMode is the object lock retention mode of the bucket of the source when it was last verified. Empty means it has not been verified.
+	RetentionCheckedAt *time.Time         `json:"retentionCheckedAt" table:"verbose;format:2006-01-02 15:04:05"` // RetentionCheckedAt is when the retention mode was last verified.
 
 	// Associations
 	PreparationID PreparationID `gorm:"uniqueIndex:prep_source"                              json:"preparationId"`
@@ -113,6 +324,28 @@ type SourceAttachment struct {
 	Storage       *Storage      `gorm:"foreignKey:StorageID;constraint:OnDelete:CASCADE"     json:"storage,omitempty"     swaggerignore:"true"`
 }
 
+// PausedAttachments returns the query of the IDs of the source attachments that belong to paused preparations.
+func PausedAttachments(db *gorm.DB) *gorm.DB {
+	return db.Model(&SourceAttachment{}).Select("id").
+		Where("preparation_id IN (?)", db.Model(&Preparation{}).Select("id").Where("paused = ?", true))
+}
+
+// ReauthAttachments returns the query of the IDs of the source attachments that use a storage that needs to be
+// re-authenticated, either as the source storage or as an output storage of the preparation.
+func ReauthAttachments(db *gorm.DB) *gorm.DB {
+	needsReauth := db.Model(&Storage{}).Select("id").Where("needs_reauth = ?", true)
+	return db.Model(&SourceAttachment{}).Select("id").
+		Where("storage_id IN (?) OR preparation_id IN (?)", needsReauth,
+			db.Model(&OutputAttachment{}).Select("preparation_id").Where("storage_id IN (?)", needsReauth))
+}
+
+// ClaimableJobs filters a query of jobs down to the jobs a worker may claim, leaving out the jobs of paused
+// preparations and the jobs that use a storage that needs to be re-authenticated. Every path that claims jobs uses it,
+// so that they skip the same jobs.
+func ClaimableJobs(db *gorm.DB) *gorm.DB {
+	return db.Where("attachment_id NOT IN (?) AND attachment_id NOT IN (?)", PausedAttachments(db), ReauthAttachments(db))
+}
+
 func (s *SourceAttachment) FindByPreparationAndSource(db *gorm.DB, preparation string, source string) error {
 	var prep Preparation
 	err := prep.FindByIDOrName(db, preparation)
@@ -150,6 +383,47 @@ func (s *SourceAttachment) RootDirectoryID(ctx context.Context, db *gorm.DB) (Di
 	return root.ID, errors.WithStack(err)
 }
 
+// directoryLookupBatchSize is the number of directories looked up with a single query.
+const directoryLookupBatchSize = 100
+
+// FindDirectories finds the directories of the source attachment by their paths, relative to the root of the
+// source, using their PathKey. Directories whose PathKey has not been set yet are not found, so callers fall back
+// to walking the tree from the root for the paths that are missing from the result.
+//
+// Parameters:
+//   - ctx: The context for the database queries.
+//   - db: The database connection.
+//   - paths: The paths of the directories to find.
+//
+// Returns:
+//   - A map from the path to the directory, for the directories that are found, and an error if a query failed.
+func (s *SourceAttachment) FindDirectories(ctx context.Context, db *gorm.DB, paths []string) (map[string]Directory, error) {
+	db = db.WithContext(ctx)
+	pathOf := make(map[string]string, len(paths))
+	keys := make([]string, 0, len(paths))
+	for _, path := range paths {
+		key := DirectoryPathKey(s.ID, path)
+		pathOf[key] = path
+		keys = append(keys, key)
+	}
+	found := make(map[string]Directory)
+	for start := 0; start < len(keys); start += directoryLookupBatchSize {
+		end := start + directoryLookupBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		var dirs []Directory
+		err := db.Where("path_key IN ? AND attachment_id = ?", keys[start:end], s.ID).Find(&dirs).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, dir := range dirs {
+			found[pathOf[dir.PathKey]] = dir
+		}
+	}
+	return found, nil
+}
+
 type OutputAttachmentID uint32
 
 // OutputAttachment is a link between a Preparation and a Storage that is used as an output.
@@ -168,11 +442,13 @@ type JobID uint64
 // Job is a job that is executed by a worker.
 // The composite index on Type and State is used to find jobs that are ready to be executed.
 type Job struct {
-	ID              JobID    `gorm:"primaryKey"           json:"id"`
-	Type            JobType  `gorm:"index:job_type_state" json:"type"`
-	State           JobState `gorm:"index:job_type_state" json:"state"`
-	ErrorMessage    string   `json:"errorMessage"`
-	ErrorStackTrace string   `json:"errorStackTrace"      table:"verbose"`
+	ID              JobID      `gorm:"primaryKey"           json:"id"`
+	Type            JobType    `gorm:"index:job_type_state" json:"type"`
+	State           JobState   `gorm:"index:job_type_state" json:"state"`
+	ErrorMessage    string     `json:"errorMessage"`
+	ErrorStackTrace string     `json:"errorStackTrace"      table:"verbose"`
+	CreatedAt       time.Time  `json:"createdAt"            table:"verbose;format:2006-01-02 15:04:05"`
+	ProgressAt      *time.Time `json:"progressAt,omitempty" table:"verbose;format:2006-01-02 15:04:05"` // Last time the worker holding the job reported progress
 
 	// Associations
 	WorkerID     *string            `gorm:"size:63"                                                        json:"workerId,omitempty"`
@@ -182,17 +458,141 @@ type Job struct {
 	FileRanges   []FileRange        `gorm:"foreignKey:JobID;constraint:OnDelete:SET NULL"                  json:"fileRanges,omitempty" swaggerignore:"true" table:"-"`
 }
 
+type PackTimingID uint64
+
+// PackTiming is the timing breakdown of packing a Job into a piece, recorded each time a piece is generated, so
+// operators can tell whether a slow preparation is bound by the source, the CPU, the output storage or the database.
+// The phases of a pack are interleaved as the CAR file is streamed, so each phase is the total time spent in it, and
+// the phases do not add up to Total exactly.
+type PackTiming struct {
+	ID         PackTimingID  `gorm:"primaryKey"          json:"id"                         table:"verbose"`
+	CreatedAt  time.Time     `json:"createdAt"           table:"format:2006-01-02 15:04:05"`
+	Listing    time.Duration `json:"listing"             swaggertype:"primitive,integer"`                  // Listing is the time spent opening the source files and checking that they have not changed
+	Reading    time.Duration `json:"reading"             swaggertype:"primitive,integer"`                  // Reading is the time spent reading the content of the source files
+	Hashing    time.Duration `json:"hashing"             swaggertype:"primitive,integer"`                  // Hashing is the time spent building the blocks and their CIDs, and calculating the piece commitment
+	Writing    time.Duration `json:"writing"             swaggertype:"primitive,integer"`                  // Writing is the time spent writing the CAR file to the output storage, excluding the time spent producing it
+	Validating time.Duration `json:"validating"          swaggertype:"primitive,integer"  table:"verbose"` // Validating is the time spent validating the piece commitment, if the piece is validated
+	Database   time.Duration `json:"database"            swaggertype:"primitive,integer"`                  // Database is the time spent recording the piece, its blocks and the CIDs of its files and directories
+	Total      time.Duration `json:"total"               swaggertype:"primitive,integer"`                  // Total is the time from the start of the pack to the piece being recorded
+	NumOfFiles int64         `json:"numOfFiles"          table:"verbose"`                                  // NumOfFiles is the number of file ranges of the Job
+	FileSize   int64         `json:"fileSize"`                                                             // FileSize is the size of the CAR file
+	WorkerID   *string       `gorm:"size:63"             json:"workerId,omitempty"         table:"verbose"`
+
+	// Associations
+	JobID         JobID         `gorm:"index"                                                json:"jobId"`
+	Job           *Job          `gorm:"foreignKey:JobID;constraint:OnDelete:CASCADE"         json:"job,omitempty"         swaggerignore:"true" table:"-"`
+	CarID         *CarID        `json:"carId,omitempty"                                      table:"verbose"`
+	Car           *Car          `gorm:"foreignKey:CarID;constraint:OnDelete:SET NULL"        json:"car,omitempty"         swaggerignore:"true" table:"-"`
+	PreparationID PreparationID `gorm:"index"                                                json:"preparationId"         table:"verbose"`
+	Preparation   *Preparation  `gorm:"foreignKey:PreparationID;constraint:OnDelete:CASCADE" json:"preparation,omitempty" swaggerignore:"true" table:"-"`
+}
+
+// Bound returns the part of the system the pack spent the most time on: "source" for listing and reading the source
+// files, "cpu" for hashing, "output" for writing and validating the CAR file, or "database".
+func (t PackTiming) Bound() string {
+	bound, longest := "source", t.Listing+t.Reading
+	if t.Hashing > longest {
+		bound, longest = "cpu", t.Hashing
+	}
+	if t.Writing+t.Validating > longest {
+		bound, longest = "output", t.Writing+t.Validating
+	}
+	if t.Database > longest {
+		bound = "database"
+	}
+	return bound
+}
+
+// The tiers of the latency of reading a source file, i.e. the time to open a file range and check that the file has
+// not changed before its content is streamed. Reads under ModerateSourceRead are fast.
+const (
+	ModerateSourceRead = 100 * time.Millisecond
+	SlowSourceRead     = time.Second
+	VerySlowSourceRead = 10 * time.Second
+)
+
+// A source is chronically slow if its reads score at least ChronicallySlowSourceScore over at least
+// ChronicallySlowSourceMinReads reads, so a few slow reads of an otherwise fast source do not count.
+const (
+	ChronicallySlowSourceScore    = 25
+	ChronicallySlowSourceMinReads = 100
+)
+
+type SourceReadID uint64
+
+// SourceRead is the latency distribution of the reads from the source storage of a Job, recorded each time a piece
+// is generated alongside its PackTiming, so the sources that hold back the preparations can be found and mirrored
+// locally. Each read is counted in the tier of its latency.
+type SourceRead struct {
+	ID            SourceReadID  `gorm:"primaryKey"     json:"id"                         table:"verbose"`
+	CreatedAt     time.Time     `json:"createdAt"      table:"format:2006-01-02 15:04:05"`
+	NumOfReads    int64         `json:"numOfReads"`                                                      // NumOfReads is the number of file ranges read
+	FastReads     int64         `json:"fastReads"`                                                       // FastReads is the number of reads under 100ms
+	ModerateReads int64         `json:"moderateReads"`                                                   // ModerateReads is the number of reads from 100ms to 1s
+	SlowReads     int64         `json:"slowReads"`                                                       // SlowReads is the number of reads from 1s to 10s
+	VerySlowReads int64         `json:"verySlowReads"`                                                   // VerySlowReads is the number of reads of 10s or more
+	Latency       time.Duration `json:"latency"        swaggertype:"primitive,integer"`                  // Latency is the total latency of the reads
+	MaxLatency    time.Duration `json:"maxLatency"     swaggertype:"primitive,integer"`                  // MaxLatency is the latency of the slowest read
+	BytesRead     int64         `json:"bytesRead"      table:"verbose"`                                  // BytesRead is the number of bytes read from the source
+	ReadTime      time.Duration `json:"readTime"       swaggertype:"primitive,integer"  table:"verbose"` // ReadTime is the time spent reading the content of the source files, after they are opened
+	WorkerID      *string       `gorm:"size:63"        json:"workerId,omitempty"         table:"verbose"`
+
+	// Associations
+	StorageID     StorageID     `gorm:"index"                                                json:"storageId"`
+	Storage       *Storage      `gorm:"foreignKey:StorageID;constraint:OnDelete:CASCADE"     json:"storage,omitempty"     swaggerignore:"true" table:"-"`
+	JobID         JobID         `gorm:"index"                                                json:"jobId"                 table:"verbose"`
+	Job           *Job          `gorm:"foreignKey:JobID;constraint:OnDelete:CASCADE"         json:"job,omitempty"         swaggerignore:"true" table:"-"`
+	PreparationID PreparationID `gorm:"index"                                                json:"preparationId"         table:"verbose"`
+	Preparation   *Preparation  `gorm:"foreignKey:PreparationID;constraint:OnDelete:CASCADE" json:"preparation,omitempty" swaggerignore:"true" table:"-"`
+}
+
+// Add counts a read of the given latency in its tier.
+func (r *SourceRead) Add(latency time.Duration) {
+	r.NumOfReads++
+	r.Latency += latency
+	if latency > r.MaxLatency {
+		r.MaxLatency = latency
+	}
+	switch {
+	case latency >= VerySlowSourceRead:
+		r.VerySlowReads++
+	case latency >= SlowSourceRead:
+		r.SlowReads++
+	case latency >= ModerateSourceRead:
+		r.ModerateReads++
+	default:
+		r.FastReads++
+	}
+}
+
+// Score rates how slow the reads are, from 0 if they are all fast to 100 if they are all very slow. A moderate read
+// weighs 1, a slow read 5 and a very slow read 10, so a source with a few very slow reads scores worse than one
+// whose reads are all a bit slow.
+func (r SourceRead) Score() float64 {
+	if r.NumOfReads == 0 {
+		return 0
+	}
+	weighted := r.ModerateReads + 5*r.SlowReads + 10*r.VerySlowReads
+	return float64(weighted) * 10 / float64(r.NumOfReads)
+}
+
+// ChronicallySlow returns whether the reads are enough and slow enough for the source to be worth mirroring locally.
+func (r SourceRead) ChronicallySlow() bool {
+	return r.NumOfReads >= ChronicallySlowSourceMinReads && r.Score() >= ChronicallySlowSourceScore
+}
+
 type FileID uint64
 
 // File makes a reference to the source storage file, e.g., a local file.
 // The index on Path is used as part of scanning to find existing file and add new versions.
 // The index on DirectoryID is used to find all files in a directory.
+// The indexes on CID and Size are used to search files across all preparations.
 type File struct {
-	ID               FileID `cbor:"1,keyasint,omitempty" gorm:"primaryKey"                     json:"id"`
-	CID              CID    `cbor:"-"                    gorm:"column:cid;type:bytes;size:255" json:"cid"  swaggertype:"string"` // CID is the CID of the file.
-	Path             string `cbor:"2,keyasint,omitempty" gorm:"index"                          json:"path"`                      // Path is the relative path to the file inside the storage.
-	Hash             string `cbor:"3,keyasint,omitempty" json:"hash"`                                                            // Hash is the hash of the file.
-	Size             int64  `cbor:"4,keyasint,omitempty" json:"size"`                                                            // Size is the size of the file in bytes.
+	ID               FileID `cbor:"1,keyasint,omitempty" gorm:"primaryKey"                           json:"id"`
+	CID              CID    `cbor:"-"                    gorm:"index;column:cid;type:bytes;size:255" json:"cid"  swaggertype:"string"` // CID is the CID of the file.
+	Path             string `cbor:"2,keyasint,omitempty" gorm:"index"                                json:"path"`                      // Path is the relative path to the file inside the storage.
+	Hash             string `cbor:"3,keyasint,omitempty" json:"hash"`                                                                  // Hash is the hash of the file.
+	Size             int64  `cbor:"4,keyasint,omitempty" gorm:"index"                                json:"size"`                      // Size is the size of the file in bytes.
 	LastModifiedNano int64  `cbor:"5,keyasint,omitempty" json:"lastModifiedNano"`
 
 	// Associations
@@ -211,12 +611,14 @@ type DirectoryID uint64
 
 // Directory is a link between parent and child directories.
 // The index on AttachmentID and ParentID is used to find all root directories, as well as all directories in a directory.
+// The index on PathKey is used to find a directory by its path with a single query, however deeply it is nested.
 type Directory struct {
 	ID       DirectoryID `gorm:"primaryKey"            json:"id"`
 	CID      CID         `gorm:"column:cid;type:bytes" json:"cid" swaggertype:"string"` // CID is the CID of the directory.
 	Data     []byte      `gorm:"column:data"           json:"-"   swaggerignore:"true"` // Data is the serialized directory data.
 	Name     string      `json:"name"`                                                  // Name is the name of the directory.
 	Exported bool        `json:"exported"`                                              // Exported is a flag that indicates whether the directory has been exported to the DAG.
+	PathKey  string      `gorm:"index;size:64"         json:"-"   swaggerignore:"true"` // PathKey is the compressed path of the directory, see DirectoryPathKey. Empty for root directories and for directories whose key has not been set yet.
 
 	// Associations
 	AttachmentID SourceAttachmentID `gorm:"index:directory_source_parent"                       json:"attachmentId"`
@@ -225,16 +627,27 @@ type Directory struct {
 	Parent       *Directory         `gorm:"foreignKey:ParentID;constraint:OnDelete:CASCADE"     json:"parent,omitempty"     swaggerignore:"true"`
 }
 
+// DirectoryPathKey compresses the path of a directory, relative to the root of its source attachment, into a
+// fixed size key. The whole chain of parent directories is then replaced by a single indexed lookup, so the
+// directories of very deep trees are found without walking them one level at a time.
+func DirectoryPathKey(attachmentID SourceAttachmentID, path string) string {
+	sum := sha256.Sum256([]byte(strconv.FormatUint(uint64(attachmentID), 10) + "/" + path))
+	return hex.EncodeToString(sum[:])
+}
+
 type FileRangeID uint64
 
 // FileRange is a range of bytes inside File.
 // The index on FileID is used to find all FileRange in a file.
 // The index on JobID is used to find all FileRange in a job.
+// The index on CID is used to search files by the CID of one of their ranges.
 type FileRange struct {
-	ID     FileRangeID `gorm:"primaryKey"            json:"id"`
-	Offset int64       `json:"offset"`                                                // Offset is the offset of the range inside the file.
-	Length int64       `json:"length"`                                                // Length is the length of the range in bytes.
-	CID    CID         `gorm:"column:cid;type:bytes" json:"cid" swaggertype:"string"` // CID is the CID of the range.
+	ID              FileRangeID `gorm:"primaryKey"                           json:"id"`
+	Offset          int64       `json:"offset"`                                                               // Offset is the offset of the range inside the file.
+	Length          int64       `json:"length"`                                                               // Length is the length of the range in bytes.
+	CID             CID         `gorm:"index;column:cid;type:bytes;size:255" json:"cid" swaggertype:"string"` // CID is the CID of the range.
+	EncryptedLength int64       `json:"encryptedLength,omitempty"`                                            // EncryptedLength is the length of the age file, or of the AES-256-GCM encryption, the range is encrypted into, which is what the CID addresses. 0 if the range is not encrypted.
+	WrappedKey      []byte      `json:"wrappedKey,omitempty" table:"-"`                                       // WrappedKey is the data key the range is encrypted with, wrapped by the KMS key of the preparation. Empty if the range is not encrypted with a KMS key.
 
 	// Associations
 	JobID  *JobID `gorm:"index"                                         json:"jobId"`
@@ -250,16 +663,20 @@ type CarID uint32
 // on the fly using CarBlock.
 // The index on PieceCID is to find all CARs that can matches the PieceCID
 type Car struct {
-	ID          CarID      `cbor:"-"                    gorm:"primaryKey"                                        json:"id"                                  table:"verbose"`
-	CreatedAt   time.Time  `cbor:"-"                    json:"createdAt"                                         table:"verbose;format:2006-01-02 15:04:05"`
-	PieceCID    CID        `cbor:"1,keyasint,omitempty" gorm:"column:piece_cid;index;type:bytes;size:255"        json:"pieceCid"                            swaggertype:"string"`
-	PieceSize   int64      `cbor:"2,keyasint,omitempty" json:"pieceSize"`
-	RootCID     CID        `cbor:"3,keyasint,omitempty" gorm:"column:root_cid;type:bytes"                        json:"rootCid"                             swaggertype:"string"`
-	FileSize    int64      `cbor:"4,keyasint,omitempty" json:"fileSize"`
-	StorageID   *StorageID `cbor:"-"                    json:"storageId"                                         table:"verbose"`
-	Storage     *Storage   `cbor:"-"                    gorm:"foreignKey:StorageID;constraint:OnDelete:SET NULL" json:"storage,omitempty"                   swaggerignore:"true" table:"expand"`
-	StoragePath string     `cbor:"-"                    json:"storagePath"` // StoragePath is the path to the CAR file inside the storage. If the StorageID is nil but StoragePath is not empty, it means the CAR file is stored at the local absolute path.
-	NumOfFiles  int64      `cbor:"-"                    json:"numOfFiles"                                        table:"verbose"`
+	ID                   CarID          `cbor:"-"                    gorm:"primaryKey"                                        json:"id"                                  table:"verbose"`
+	CreatedAt            time.Time      `cbor:"-"                    json:"createdAt"                                         table:"verbose;format:2006-01-02 15:04:05"`
+	PieceCID             CID            `cbor:"1,keyasint,omitempty" gorm:"column:piece_cid;index;type:bytes;size:255"        json:"pieceCid"                            swaggertype:"string"`
+	PieceSize            int64          `cbor:"2,keyasint,omitempty" json:"pieceSize"`
+	RootCID              CID            `cbor:"3,keyasint,omitempty" gorm:"column:root_cid;type:bytes"                        json:"rootCid"                             swaggertype:"string"`
+	FileSize             int64          `cbor:"4,keyasint,omitempty" json:"fileSize"`
+	StorageID            *StorageID     `cbor:"-"                    json:"storageId"                                         table:"verbose"`
+	Storage              *Storage       `cbor:"-"                    gorm:"foreignKey:StorageID;constraint:OnDelete:SET NULL" json:"storage,omitempty"                   swaggerignore:"true" table:"expand"`
+	StoragePath          string         `cbor:"-"                    json:"storagePath"` // StoragePath is the path to the CAR file inside the storage. If the StorageID is nil but StoragePath is not empty, it means the CAR file is stored at the local absolute path.
+	NumOfFiles           int64          `cbor:"-"                    json:"numOfFiles"                                        table:"verbose"`
+	WrappedKey           []byte         `cbor:"-"                    json:"wrappedKey,omitempty"                              table:"-"`       // WrappedKey is the piece key the CAR file is encrypted with, wrapped for the piece key recipient of the preparation. Empty if the CAR file is not encrypted.
+	Compression          CarCompression `cbor:"-"                    json:"compression,omitempty" swaggertype:"string"        table:"verbose"` // Compression is how the CAR file at StoragePath is compressed. FileSize is always the size of the uncompressed CAR file.
+	AggregateOffset      int64          `cbor:"-"                    json:"aggregateOffset,omitempty"                         table:"verbose"` // AggregateOffset is the padded offset of the piece in its aggregate piece, if it has been aggregated.
+	EncryptionKeyVersion int            `cbor:"-"                    json:"encryptionKeyVersion,omitempty"                    table:"verbose"` // EncryptionKeyVersion is the version of the encryption recipients of the preparation that the items of the CAR file are encrypted for. 0 if the items are not encrypted.
 
 	// Association
 	PreparationID PreparationID       `cbor:"-" json:"preparationId"                                        table:"-"`
@@ -268,6 +685,8 @@ type Car struct {
 	Attachment    *SourceAttachment   `cbor:"-" gorm:"foreignKey:AttachmentID;constraint:OnDelete:CASCADE"  json:"attachment,omitempty"  swaggerignore:"true" table:"-"`
 	JobID         *JobID              `cbor:"-" json:"jobId,omitempty"                                      table:"-"`
 	Job           *Job                `cbor:"-" gorm:"foreignKey:JobID;constraint:OnDelete:SET NULL"        json:"job,omitempty"         swaggerignore:"true" table:"-"`
+	AggregateID   *CarID              `cbor:"-" gorm:"index"                                                json:"aggregateId,omitempty" table:"verbose"` // AggregateID is the aggregate piece the piece has been aggregated into. Aggregated pieces are dealt through their aggregate.
+	Aggregate     *Car                `cbor:"-" gorm:"foreignKey:AggregateID;constraint:OnDelete:SET NULL"  json:"aggregate,omitempty"   swaggerignore:"true" table:"-"`
 }
 
 type CarBlockID uint64
@@ -286,6 +705,7 @@ type CarBlock struct {
 	Varint         []byte     `cbor:"4,keyasint,omitempty" json:"varint"`                                                               // Varint is the varint that represents the length of the block and the CID.
 	RawBlock       []byte     `cbor:"5,keyasint,omitempty" json:"rawBlock"`                                                             // Raw block
 	FileOffset     int64      `cbor:"6,keyasint,omitempty" json:"fileOffset"`                                                           // Offset of the block in the File
+	BlobStorageID  *StorageID `cbor:"-"                    json:"blobStorageId,omitempty"`                                              // Storage that holds the raw block, if it is not stored in the database
 
 	// Internal Caching
 	blockLength int32 // Block length in bytes
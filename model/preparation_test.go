@@ -2,6 +2,7 @@ package model
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ipfs/boxo/util"
 	"github.com/ipfs/go-cid"
@@ -38,3 +39,33 @@ func TestCarBlock_BlockLength(t *testing.T) {
 	}
 	require.EqualValues(t, 100-4-36, carBlock.BlockLength())
 }
+
+func TestPackTiming_Bound(t *testing.T) {
+	require.Equal(t, "source", PackTiming{Listing: time.Second, Reading: time.Second, Hashing: time.Second}.Bound())
+	require.Equal(t, "cpu", PackTiming{Reading: time.Second, Hashing: 2 * time.Second}.Bound())
+	require.Equal(t, "output", PackTiming{Hashing: time.Second, Writing: time.Second, Validating: time.Second}.Bound())
+	require.Equal(t, "database", PackTiming{Writing: time.Second, Database: 2 * time.Second}.Bound())
+}
+
+func TestSourceRead_Score(t *testing.T) {
+	var r SourceRead
+	require.Zero(t, r.Score())
+	r.Add(time.Millisecond)
+	r.Add(200 * time.Millisecond)
+	r.Add(2 * time.Second)
+	r.Add(20 * time.Second)
+	require.EqualValues(t, 4, r.NumOfReads)
+	require.EqualValues(t, 1, r.FastReads)
+	require.EqualValues(t, 1, r.ModerateReads)
+	require.EqualValues(t, 1, r.SlowReads)
+	require.EqualValues(t, 1, r.VerySlowReads)
+	require.Equal(t, 20*time.Second, r.MaxLatency)
+	require.InDelta(t, 40, r.Score(), 0.001)
+	// Not enough reads to tell
+	require.False(t, r.ChronicallySlow())
+
+	slow := SourceRead{NumOfReads: 100, SlowReads: 50, FastReads: 50}
+	require.True(t, slow.ChronicallySlow())
+	fast := SourceRead{NumOfReads: 100, ModerateReads: 100}
+	require.False(t, fast.ChronicallySlow())
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
@@ -44,10 +45,11 @@ var DealStates = []DealState{
 }
 
 const (
-	ScheduleActive    ScheduleState = "active"
-	SchedulePaused    ScheduleState = "paused"
-	ScheduleError     ScheduleState = "error"
-	ScheduleCompleted ScheduleState = "completed"
+	ScheduleActive          ScheduleState = "active"
+	SchedulePaused          ScheduleState = "paused"
+	ScheduleError           ScheduleState = "error"
+	ScheduleCompleted       ScheduleState = "completed"
+	SchedulePendingApproval ScheduleState = "pending_approval" // The schedule is above the approval threshold and waits for a second operator
 )
 
 var ScheduleStates = []ScheduleState{
@@ -55,6 +57,7 @@ var ScheduleStates = []ScheduleState{
 	SchedulePaused,
 	ScheduleError,
 	ScheduleCompleted,
+	SchedulePendingApproval,
 }
 
 var ScheduleStateStrings = []string{
@@ -62,6 +65,7 @@ var ScheduleStateStrings = []string{
 	string(SchedulePaused),
 	string(ScheduleError),
 	string(ScheduleCompleted),
+	string(SchedulePendingApproval),
 }
 
 func StoragePricePerEpochToPricePerDeal(price string, dealSize int64, durationEpoch int32) float64 {
@@ -74,6 +78,7 @@ type DealID uint64
 // Deal is the deal model for all deals made by deal pusher or tracked by the tracker.
 // The index on PieceCID is used to track replication of the same piece CID.
 // The index on State and ClientID is used to calculate number and size of pending deals.
+// The index on Label is used to search files by the label of the deals made for their pieces.
 type Deal struct {
 	ID               DealID     `gorm:"primaryKey"                      json:"id"                                  table:"verbose"`
 	CreatedAt        time.Time  `json:"createdAt"                       table:"verbose;format:2006-01-02 15:04:05"`
@@ -83,7 +88,7 @@ type Deal struct {
 	State            DealState  `gorm:"index:idx_pending"               json:"state"`
 	Provider         string     `json:"provider"`
 	ProposalID       string     `json:"proposalId"                      table:"verbose"`
-	Label            string     `json:"label"                           table:"verbose"`
+	Label            string     `gorm:"index;size:255"                  json:"label"                               table:"verbose"`
 	PieceCID         CID        `gorm:"column:piece_cid;index;size:255" json:"pieceCid"                            swaggertype:"string"`
 	PieceSize        int64      `json:"pieceSize"`
 	StartEpoch       int32      `json:"startEpoch"`
@@ -92,6 +97,8 @@ type Deal struct {
 	Price            string     `json:"price"`
 	Verified         bool       `json:"verified"`
 	ErrorMessage     string     `json:"errorMessage"                    table:"verbose"`
+	LastSampledAt    *time.Time `json:"lastSampledAt"                   table:"verbose;format:2006-01-02 15:04:05"` // LastSampledAt is the last time a block of the deal was retrieved from the provider to prove it is retrievable
+	FailedProofs     int        `json:"failedProofs"                    table:"verbose"`                            // FailedProofs is the number of consecutive failed retrieval proofs of the deal
 
 	// Associations
 	ScheduleID *ScheduleID `json:"scheduleId"                                         table:"verbose"`
@@ -131,18 +138,227 @@ type Schedule struct {
 	ScheduleDealSize      int64         `json:"scheduleDealSize"`
 	MaxPendingDealNumber  int           `json:"maxPendingDealNumber"`
 	MaxPendingDealSize    int64         `json:"maxPendingDealSize"`
+	HourlyDealNumber      int           `json:"hourlyDealNumber"                    table:"verbose"`
+	HourlyDealSize        int64         `json:"hourlyDealSize"                      table:"verbose"`
+	DailyDealNumber       int           `json:"dailyDealNumber"                     table:"verbose"`
+	DailyDealSize         int64         `json:"dailyDealSize"                       table:"verbose"`
+	MaxRejectedRetries    int           `json:"maxRejectedRetries"                  table:"verbose"`
+	RejectedRetryDelay    time.Duration `json:"rejectedRetryDelay"                  swaggertype:"primitive,integer"            table:"verbose"`
 	Notes                 string        `json:"notes"`
 	ErrorMessage          string        `json:"errorMessage"                        table:"verbose"`
 	AllowedPieceCIDs      StringSlice   `gorm:"type:JSON;column:allowed_piece_cids" json:"allowedPieceCids"                    table:"verbose"`
 	Force                 bool          `json:"force"`
+	RequestedBy           string        `json:"requestedBy"                         table:"verbose"` // RequestedBy is the operator that last created or modified the schedule, if known
+	ApprovedBy            string        `json:"approvedBy"                          table:"verbose"` // ApprovedBy is the operator that approved the schedule, if it needed approval
 
 	// Associations
-	PreparationID PreparationID `json:"preparationId"`
+	PreparationID PreparationID        `json:"preparationId"`
+	Preparation   *Preparation         `gorm:"foreignKey:PreparationID;constraint:OnDelete:CASCADE" json:"preparation,omitempty" swaggerignore:"true" table:"expand"`
+	PolicyID      *ReplicationPolicyID `json:"policyId"                                             table:"verbose"` // PolicyID is the replication policy the schedule proposes the replicas of, if it was created by one
+	Policy        *ReplicationPolicy   `gorm:"foreignKey:PolicyID;constraint:OnDelete:SET NULL"     json:"policy,omitempty"      swaggerignore:"true" table:"expand"`
+}
+
+type ReplicationPolicyID uint32
+
+// ReplicationPolicy declares how many replicas of each piece of a preparation to keep and how to spread them across
+// storage providers, i.e. 5 replicas of each piece, at most 2 with the providers of an organization, across at least
+// 3 regions. The deal pusher chooses the providers of the pieces missing replicas among the providers of the policy,
+// and proposes the deals through a schedule of the policy for each provider, so replicas that expire or are slashed
+// are proposed again. With AutoSelect, the best providers of the provider reputations by retrieval success rate and
+// price are also chosen from, so the policy does not need a hand-maintained list of providers.
+type ReplicationPolicy struct {
+	ID               ReplicationPolicyID `gorm:"primaryKey"               json:"id"`
+	CreatedAt        time.Time           `json:"createdAt"                table:"verbose;format:2006-01-02 15:04:05"`
+	UpdatedAt        time.Time           `json:"updatedAt"                table:"verbose;format:2006-01-02 15:04:05"`
+	Replicas         int                 `json:"replicas"`                                                   // Replicas is the number of replicas of each piece to keep, counting the deals proposed, published or active
+	MaxPerOrg        int                 `json:"maxPerOrg"`                                                  // MaxPerOrg is the maximum number of replicas of a piece with the providers of an organization, or 0 for no limit
+	MinRegions       int                 `json:"minRegions"`                                                 // MinRegions is the number of regions the replicas of a piece are spread across
+	Providers        PolicyProviders     `gorm:"type:JSON"                json:"providers"`                  // Providers are the storage providers the replicas are made with
+	AutoSelect       int                 `json:"autoSelect"`                                                 // AutoSelect is the number of providers chosen from the provider reputations in addition to Providers, or 0 to only use Providers
+	MinRetrievalRate float64             `json:"minRetrievalRate"         table:"verbose"`                   // MinRetrievalRate is the minimum retrieval success rate of the providers chosen from the provider reputations
+	Regions          StringSlice         `gorm:"type:JSON"                json:"regions"    table:"verbose"` // Regions are the regions the providers chosen from the provider reputations are in. Any region if empty.
+	URLTemplate      string              `json:"urlTemplate"              table:"verbose"`
+	PricePerGBEpoch  float64             `json:"pricePerGbEpoch"          table:"verbose"`
+	PricePerGB       float64             `json:"pricePerGb"               table:"verbose"`
+	PricePerDeal     float64             `json:"pricePerDeal"             table:"verbose"`
+	Verified         bool                `json:"verified"`
+	KeepUnsealed     bool                `json:"keepUnsealed"             table:"verbose"`
+	AnnounceToIPNI   bool                `gorm:"column:announce_to_ipni" json:"announceToIpni"                             table:"verbose"`
+	StartDelay       time.Duration       `json:"startDelay"               swaggertype:"primitive,integer"                   table:"verbose"`
+	Duration         time.Duration       `json:"duration"                 swaggertype:"primitive,integer"                   table:"verbose"`
+	RequestedBy      string              `json:"requestedBy"              table:"verbose"` // RequestedBy is the operator that last set the policy, if known
+	NeedsApproval    bool                `json:"needsApproval"            table:"verbose"` // NeedsApproval is whether the schedules of the policy are created pending the approval of a second operator, since the policy is above the approval threshold
+
+	// Associations
+	PreparationID PreparationID `gorm:"uniqueIndex"                                          json:"preparationId"`
 	Preparation   *Preparation  `gorm:"foreignKey:PreparationID;constraint:OnDelete:CASCADE" json:"preparation,omitempty" swaggerignore:"true" table:"expand"`
 }
 
+// PolicyProvider is a storage provider of a replication policy, with the organization that runs it and the region its
+// data is stored in, as declared by the user.
+type PolicyProvider struct {
+	Provider string `json:"provider"`
+	Org      string `json:"org"`    // Org is the organization that runs the provider. Providers without an organization are their own organization.
+	Region   string `json:"region"` // Region is where the provider stores the data. Providers without a region do not count towards MinRegions.
+}
+
+type PolicyProviders []PolicyProvider
+
 type Wallet struct {
-	ID         string `gorm:"primaryKey;size:15"   json:"id"`      // ID is the short ID of the wallet
-	Address    string `gorm:"index"                json:"address"` // Address is the Filecoin full address of the wallet
-	PrivateKey string `json:"privateKey,omitempty" table:"-"`      // PrivateKey is the private key of the wallet
+	ID               string     `gorm:"primaryKey;size:15"   json:"id"`                              // ID is the short ID of the wallet
+	Address          string     `gorm:"index"                json:"address"`                         // Address is the Filecoin full address of the wallet
+	PrivateKey       string     `json:"privateKey,omitempty" table:"-"`                              // PrivateKey is the private key of the wallet, or the PKCS#11 URI of the key if it is kept in a hardware security module
+	Balance          float64    `json:"balance"`                                                     // Balance is the balance of the wallet in FIL
+	Datacap          int64      `json:"datacap"`                                                     // Datacap is the remaining datacap of the wallet in bytes
+	BalanceUpdatedAt *time.Time `json:"balanceUpdatedAt" table:"verbose;format:2006-01-02 15:04:05"` // BalanceUpdatedAt is when the balance and the datacap were last tracked by the deal tracker or looked up, nil if they never were
+}
+
+type OperatorID uint32
+
+// Operator is a person or system allowed to act on deal schedules with its own API key. Schedules above the approval
+// threshold need to be approved by an operator other than the one that created or modified them, so a single
+// compromised key cannot spend all the datacap. Only the SHA-256 hash of the API key is stored.
+type Operator struct {
+	ID        OperatorID `gorm:"primaryKey"          json:"id"`
+	CreatedAt time.Time  `json:"createdAt"           table:"format:2006-01-02 15:04:05"`
+	Name      string     `gorm:"unique"              json:"name"`
+	KeyHash   string     `gorm:"uniqueIndex;size:64" json:"-"                            table:"-"`
+}
+
+type BudgetID uint32
+
+// Budget is a hard limit on the datacap and FIL spent on deals, either by the deals of the schedules of a preparation
+// or, without a preparation, by all deals. The deal pusher does not make a deal that would exceed a budget unless the
+// budget is overridden, and alerts once 80% of a budget is consumed.
+type Budget struct {
+	ID             BudgetID       `gorm:"primaryKey"                                           json:"id"`
+	UpdatedAt      time.Time      `json:"updatedAt"                                            table:"verbose;format:2006-01-02 15:04:05"`
+	PreparationID  *PreparationID `gorm:"uniqueIndex"                                          json:"preparationId"` // PreparationID is nil for the global budget
+	Preparation    *Preparation   `gorm:"foreignKey:PreparationID;constraint:OnDelete:CASCADE" json:"preparation,omitempty"               swaggerignore:"true" table:"-"`
+	Datacap        int64          `json:"datacap"`                                                                                 // Datacap is the maximum size of verified deals in bytes, 0 if unlimited
+	FIL            float64        `json:"fil"`                                                                                     // FIL is the maximum price of deals in FIL, 0 if unlimited
+	OverrideUntil  *time.Time     `json:"overrideUntil"                                        table:"format:2006-01-02 15:04:05"` // OverrideUntil is the time until which the budget may be exceeded
+	DatacapAlerted bool           `json:"datacapAlerted"                                       table:"verbose"`                    // DatacapAlerted is whether the datacap alert has been sent since the limit was set
+	FILAlerted     bool           `json:"filAlerted"                                           table:"verbose"`                    // FILAlerted is whether the FIL alert has been sent since the limit was set
+	DatacapSpent   int64          `json:"datacapSpent"                                         table:"verbose"`                    // DatacapSpent is the size of the verified deals reserved from the budget in bytes
+	FILSpent       float64        `json:"filSpent"                                             table:"verbose"`                    // FILSpent is the price of the deals reserved from the budget in FIL
+}
+
+type PieceReceiptID uint64
+
+// PieceReceipt is a receipt that a piece of a preparation has reached its replication target, signed with the receipt
+// key of the Singularity instance. The payload lists the piece and all its active deals, so data owners can archive the
+// receipt as proof of storage and verify it on chain independently of the database.
+// The unique index on PreparationID and PieceCID makes sure only one receipt is issued for each piece of a preparation.
+type PieceReceipt struct {
+	ID        PieceReceiptID  `gorm:"primaryKey"                                                   json:"id"`
+	CreatedAt time.Time       `json:"createdAt"                                                    table:"format:2006-01-02 15:04:05"`
+	PieceCID  CID             `gorm:"column:piece_cid;type:bytes;size:255;uniqueIndex:idx_receipt" json:"pieceCid"                         swaggertype:"string"`
+	Payload   json.RawMessage `gorm:"type:bytes"                                                   json:"payload"                          swaggertype:"object" table:"-"` // Payload is the JSON encoded receipt that is signed
+	Signer    string          `json:"signer"                                                       table:"verbose"`                                                        // Signer is the peer ID of the receipt key
+	PublicKey []byte          `json:"publicKey"                                                    table:"-"`                                                              // PublicKey is the protobuf encoded public key of the receipt key
+	Signature []byte          `json:"signature"                                                    table:"-"`
+
+	// Associations
+	PreparationID PreparationID `gorm:"uniqueIndex:idx_receipt"                              json:"preparationId"`
+	Preparation   *Preparation  `gorm:"foreignKey:PreparationID;constraint:OnDelete:CASCADE" json:"preparation,omitempty" swaggerignore:"true" table:"expand"`
+}
+
+// ProviderReputation is the reputation of a storage provider. It holds the retrieval success rate measured by the
+// Spark retrieval checker of Filecoin Station, which the deal tracker ingests for the providers holding or scheduled
+// to hold our deals or listed in the provider directory, and the success rate of the retrieval proofs sampled by the
+// retrieval sampler. The deal pusher uses it to avoid replicating to providers that are poorly retrievable in
+// practice. The region, the ask price and the piece sizes of the providers are ingested from a provider directory
+// such as filrep.io, so the replication policies can choose providers automatically.
+type ProviderReputation struct {
+	Provider             string    `gorm:"primaryKey;size:255" json:"provider"`
+	UpdatedAt            time.Time `json:"updatedAt"           table:"format:2006-01-02 15:04:05"`
+	RetrievalTotal       int64     `json:"retrievalTotal"`                      // Number of retrieval checks during the measurement window
+	RetrievalSuccessful  int64     `json:"retrievalSuccessful"`                 // Number of successful retrieval checks during the measurement window
+	RetrievalSuccessRate float64   `json:"retrievalSuccessRate"`                // Ratio of successful retrieval checks, between 0 and 1
+	Source               string    `json:"source"              table:"verbose"` // URL the retrieval metrics were ingested from
+	ProofTotal           int64     `json:"proofTotal"`                          // Number of retrieval proofs sampled from the provider during the measurement window
+	ProofSuccessful      int64     `json:"proofSuccessful"`                     // Number of successful retrieval proofs during the measurement window
+	ProofSuccessRate     float64   `json:"proofSuccessRate"`                    // Ratio of successful retrieval proofs, between 0 and 1
+	Listed               bool      `json:"listed"              table:"verbose"` // Whether the provider is listed by the provider directory and can be chosen by the replication policies
+	Reachable            bool      `json:"reachable"`                           // Whether the provider was reachable when last checked by the provider directory
+	Region               string    `json:"region"`                              // Region of the provider according to the provider directory
+	Country              string    `json:"country"             table:"verbose"` // ISO country code of the provider according to the provider directory
+	Price                float64   `json:"price"`                               // Ask price for regular deals in FIL per GiB per epoch
+	VerifiedPrice        float64   `json:"verifiedPrice"`                       // Ask price for verified deals in FIL per GiB per epoch
+	MinPieceSize         int64     `json:"minPieceSize"        table:"verbose"` // Minimum piece size accepted by the provider
+	MaxPieceSize         int64     `json:"maxPieceSize"        table:"verbose"` // Maximum piece size accepted by the provider, 0 if unknown
+	Directory            string    `json:"directory"           table:"verbose"` // URL the directory information was ingested from
+}
+
+// UnretrievableProofFailures is the number of consecutive failed retrieval proofs after which a deal is considered
+// unretrievable, so it no longer counts as a replica of its piece for the replication policies.
+const UnretrievableProofFailures = 3
+
+type RetrievalProofID uint64
+
+// RetrievalProof is the outcome of a trustless retrieval of a random block of a deal from its provider. The block is
+// verified against the CID stored when the piece was packed, so a successful proof shows that the provider still
+// holds and serves the data of the deal.
+type RetrievalProof struct {
+	ID        RetrievalProofID `gorm:"primaryKey"                          json:"id"`
+	CreatedAt time.Time        `gorm:"index"                               json:"createdAt"                table:"format:2006-01-02 15:04:05"`
+	Provider  string           `gorm:"index"                               json:"provider"`
+	PieceCID  CID              `gorm:"column:piece_cid;size:255"           json:"pieceCid"                 swaggertype:"string"`
+	BlockCID  CID              `gorm:"column:block_cid;size:255"           json:"blockCid"                 swaggertype:"string"`
+	Success   bool             `json:"success"`
+	Error     string           `json:"error"                               table:"verbose"`                 // Error of the retrieval or the verification, if the proof failed
+	Latency   time.Duration    `json:"latency"                             swaggertype:"primitive,integer"` // Time taken to retrieve the block
+	DealID    DealID           `gorm:"index"                               json:"dealId"`                   // DealID is the deal the block was sampled from
+}
+
+type RetrievalTokenID uint64
+
+// RetrievalToken allows a third party to retrieve the pieces of a preparation from a content provider that requires
+// retrieval tokens. The token handed to the third party carries the ID and is signed with the retrieval token secret
+// of the instance, so it cannot be forged, while its scope and usage are kept here so it can be revoked.
+type RetrievalToken struct {
+	ID          RetrievalTokenID `gorm:"primaryKey"                  json:"id"`
+	CreatedAt   time.Time        `json:"createdAt"                   table:"format:2006-01-02 15:04:05"`
+	Name        string           `json:"name"`                                                                           // Name of the token, i.e. the third party it is handed to
+	PieceCIDs   StringSlice      `gorm:"type:JSON;column:piece_cids" json:"pieceCids"                   table:"verbose"` // Pieces the token is limited to. All pieces of the preparation are allowed if empty.
+	ExpiresAt   *time.Time       `json:"expiresAt"                   table:"format:2006-01-02 15:04:05"`                 // ExpiresAt is the time the token expires, or nil if it never expires
+	MaxBytes    int64            `json:"maxBytes"`                                                                       // Maximum number of bytes served with the token, or 0 for no limit
+	BytesServed int64            `json:"bytesServed"`                                                                    // Number of bytes served with the token so far
+	Revoked     bool             `json:"revoked"`
+
+	// Associations
+	PreparationID PreparationID `gorm:"index"                                                json:"preparationId"`
+	Preparation   *Preparation  `gorm:"foreignKey:PreparationID;constraint:OnDelete:CASCADE" json:"preparation,omitempty" swaggerignore:"true" table:"expand"`
+}
+
+type IndexAdvertisementID uint64
+
+// IndexAdvertisement is an advertisement published to the InterPlanetary Network Indexer (IPNI), announcing that the
+// content provider serves the blocks of a piece, or retracting the announcement once the piece is removed. The
+// advertisements form a chain, each linking to the previous one, that the indexers fetch from the content provider.
+type IndexAdvertisement struct {
+	ID         IndexAdvertisementID `gorm:"primaryKey"                      json:"id"`
+	CreatedAt  time.Time            `json:"createdAt"                       table:"format:2006-01-02 15:04:05"`
+	CID        CID                  `gorm:"column:cid;index;size:255"       json:"cid"                         swaggertype:"string"`
+	PieceCID   CID                  `gorm:"column:piece_cid;index;size:255" json:"pieceCid"                    swaggertype:"string"`                 // Piece the advertisement is for, which is its context ID
+	IsRm       bool                 `json:"isRm"`                                                                                                    // Whether the advertisement retracts the previous advertisement of the piece
+	EntriesCID CID                  `gorm:"column:entries_cid;size:255"     json:"entriesCid"                  swaggertype:"string" table:"verbose"` // First entry chunk of the multihashes, or the IPNI NoEntries CID for retractions
+	NumEntries int64                `json:"numEntries"`
+	Block      []byte               `json:"-"                               table:"-"` // Block is the dag-json encoded advertisement served to the indexers
+	// CarID is the CAR file the multihashes of the entries are read from. It is not a foreign key, so the advertisement
+	// is kept when the piece is removed, to be retracted.
+	CarID *CarID `json:"carId" table:"verbose"`
+}
+
+// IndexEntryChunk is a chunk of the multihashes of an IndexAdvertisement. The multihashes are not stored, but read from
+// the blocks of the CAR file of the advertisement, which are immutable, so the chunk always encodes to the same CID.
+type IndexEntryChunk struct {
+	ID              uint64               `gorm:"primaryKey"                                             json:"id"`
+	CID             CID                  `gorm:"column:cid;index;size:255"                              json:"cid"                     swaggertype:"string"`
+	NextCID         CID                  `gorm:"column:next_cid;size:255"                               json:"nextCid"                 swaggertype:"string"` // Next chunk of the advertisement, if any
+	Offset          int                  `json:"offset"`                                                                                                     // Offset of the first multihash of the chunk among the blocks of the CAR file
+	Length          int                  `json:"length"`
+	AdvertisementID IndexAdvertisementID `gorm:"index"                                                  json:"advertisementId"`
+	Advertisement   *IndexAdvertisement  `gorm:"foreignKey:AdvertisementID;constraint:OnDelete:CASCADE" json:"advertisement,omitempty" swaggerignore:"true" table:"-"`
 }
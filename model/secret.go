@@ -0,0 +1,218 @@
+package model
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// MaskedSecret replaces the value of a secret in API responses, CLI output and logs.
+const MaskedSecret = "********"
+
+// configOptionSecrets records whether each known storage config option holds a secret.
+var configOptionSecrets = make(map[string]bool)
+
+// RegisterConfigOption records whether the storage config option with the given name holds a secret. The options of
+// the storage backends are registered by the storagesystem package when it is initialized. An option is a secret if
+// it is registered as a secret by any backend.
+//
+// Parameters:
+//   - name: The name of the option, i.e. secret_access_key.
+//   - secret: Whether the option holds a secret, i.e. a key, a token or a password.
+func RegisterConfigOption(name string, secret bool) {
+	configOptionSecrets[name] = configOptionSecrets[name] || secret
+}
+
+// looksLikeSecret returns whether the name of a config key or an HTTP header suggests that it holds a secret.
+func looksLikeSecret(key string) bool {
+	k := strings.ToLower(key)
+	return strings.Contains(k, "secret") || strings.Contains(k, "pass") || strings.Contains(k, "token") ||
+		strings.Contains(k, "key") || k == "authorization" || k == "cookie"
+}
+
+// IsSecretConfigName returns whether a storage config key holds a secret. Registered options are marked individually,
+// while unknown keys are treated as secrets if their name looks like one.
+func IsSecretConfigName(key string) bool {
+	if secret, ok := configOptionSecrets[key]; ok {
+		return secret
+	}
+	return looksLikeSecret(key)
+}
+
+// MaskSecrets returns a copy of the config with the values of the secrets replaced by MaskedSecret. Empty values are
+// kept, so it is still visible that a secret is not set.
+func (m ConfigMap) MaskSecrets() ConfigMap {
+	if m == nil {
+		return nil
+	}
+	masked := make(ConfigMap, len(m))
+	for k, v := range m {
+		if v != "" && IsSecretConfigName(k) {
+			v = MaskedSecret
+		}
+		masked[k] = v
+	}
+	return masked
+}
+
+// MaskSecrets replaces the secrets in the config and the HTTP headers of the storage by MaskedSecret. The maps are
+// replaced rather than modified, so they can be shared with other copies of the storage.
+func (s *Storage) MaskSecrets() {
+	s.Config = s.Config.MaskSecrets()
+	if s.ClientConfig.Headers != nil {
+		headers := make(map[string]string, len(s.ClientConfig.Headers))
+		for k, v := range s.ClientConfig.Headers {
+			if v != "" && looksLikeSecret(k) {
+				v = MaskedSecret
+			}
+			headers[k] = v
+		}
+		s.ClientConfig.Headers = headers
+	}
+}
+
+var storageType = reflect.TypeOf(Storage{})
+
+// mayContainStorage caches whether values of a type may reference a Storage, so that large results without storages,
+// i.e. lists of files, are not walked.
+var mayContainStorage sync.Map
+
+func typeMayContainStorage(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if cached, ok := mayContainStorage.Load(t); ok {
+		//nolint:forcetypeassert
+		return cached.(bool)
+	}
+	if seen[t] {
+		// Recursive types are resolved by the outermost call.
+		return false
+	}
+	seen[t] = true
+	var result bool
+	switch t.Kind() {
+	case reflect.Interface:
+		result = true
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		result = typeMayContainStorage(t.Elem(), seen)
+	case reflect.Map:
+		result = typeMayContainStorage(t.Elem(), seen)
+	case reflect.Struct:
+		result = t == storageType
+		for i := 0; i < t.NumField() && !result; i++ {
+			if t.Field(i).IsExported() {
+				result = typeMayContainStorage(t.Field(i).Type, seen)
+			}
+		}
+	default:
+		result = false
+	}
+	delete(seen, t)
+	if len(seen) == 0 || result {
+		mayContainStorage.Store(t, result)
+	}
+	return result
+}
+
+// MaskStorageSecrets returns a copy of a value with the secrets of all referenced storages masked, i.e. a handler
+// result that is about to be returned by the API or printed by the CLI. The parts of the value that reference a
+// storage are copied, so the original value is never modified.
+//
+// Parameters:
+//   - value: The value to mask, i.e. a storage, a preparation with its storages, or a slice of them.
+//
+// Returns:
+//   - The value with the secrets of all storages masked.
+func MaskStorageSecrets(value any) any {
+	if value == nil {
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	if !typeMayContainStorage(v.Type(), make(map[reflect.Type]bool)) {
+		return value
+	}
+	return maskStorageSecrets(v, make(map[uintptr]reflect.Value)).Interface()
+}
+
+func maskStorageSecrets(v reflect.Value, copies map[uintptr]reflect.Value) reflect.Value {
+	if !typeMayContainStorage(v.Type(), make(map[reflect.Type]bool)) {
+		return v
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		if copied, ok := copies[v.Pointer()]; ok {
+			return copied
+		}
+		copied := reflect.New(v.Type().Elem())
+		copies[v.Pointer()] = copied
+		copied.Elem().Set(maskStorageSecrets(v.Elem(), copies))
+		return copied
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(maskStorageSecrets(v.Elem(), copies))
+		return copied
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(maskStorageSecrets(v.Index(i), copies))
+		}
+		return copied
+	case reflect.Array:
+		copied := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(maskStorageSecrets(v.Index(i), copies))
+		}
+		return copied
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(iter.Key(), maskStorageSecrets(iter.Value(), copies))
+		}
+		return copied
+	case reflect.Struct:
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				copied.Field(i).Set(maskStorageSecrets(v.Field(i), copies))
+			}
+		}
+		if v.Type() == storageType {
+			//nolint:forcetypeassert
+			copied.Addr().Interface().(*Storage).MaskSecrets()
+		}
+		return copied
+	default:
+		return v
+	}
+}
+
+// jsonStringPair matches a "key":"value" pair of JSON strings, i.e. in a storage config rendered in an SQL statement.
+var jsonStringPair = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"(\s*:\s*)"((?:[^"\\]|\\.)*)"`)
+
+// MaskSecretsInText replaces the values of the JSON encoded secrets in a text, i.e. an SQL statement that is logged,
+// by MaskedSecret.
+func MaskSecretsInText(text string) string {
+	if !strings.Contains(text, `":`) {
+		return text
+	}
+	return jsonStringPair.ReplaceAllStringFunc(text, func(pair string) string {
+		match := jsonStringPair.FindStringSubmatch(pair)
+		if match[3] == "" || !IsSecretConfigName(match[1]) {
+			return pair
+		}
+		return `"` + match[1] + `"` + match[2] + `"` + MaskedSecret + `"`
+	})
+}
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSecretConfigName(t *testing.T) {
+	require.True(t, IsSecretConfigName("secret_access_key"))
+	require.True(t, IsSecretConfigName("pass"))
+	require.False(t, IsSecretConfigName("region"))
+
+	RegisterConfigOption("test_token_url", false)
+	require.False(t, IsSecretConfigName("test_token_url"))
+	RegisterConfigOption("test_grant", true)
+	RegisterConfigOption("test_grant", false)
+	require.True(t, IsSecretConfigName("test_grant"))
+}
+
+func TestConfigMap_MaskSecrets(t *testing.T) {
+	config := ConfigMap{"secret_access_key": "secret", "session_token": "", "region": "us-east-1"}
+	masked := config.MaskSecrets()
+	require.Equal(t, ConfigMap{"secret_access_key": MaskedSecret, "session_token": "", "region": "us-east-1"}, masked)
+	require.Equal(t, "secret", config["secret_access_key"])
+	require.Nil(t, ConfigMap(nil).MaskSecrets())
+	require.Equal(t, "region:us-east-1 secret_access_key:"+MaskedSecret, config.String())
+}
+
+func TestMaskStorageSecrets(t *testing.T) {
+	newStorage := func() Storage {
+		return Storage{
+			Name:   "s3",
+			Config: ConfigMap{"secret_access_key": "secret", "region": "us-east-1"},
+			ClientConfig: ClientConfig{
+				Headers: map[string]string{"Authorization": "Bearer secret", "Accept": "*/*"},
+			},
+		}
+	}
+	requireMasked := func(t *testing.T, storage Storage) {
+		t.Helper()
+		require.Equal(t, MaskedSecret, storage.Config["secret_access_key"])
+		require.Equal(t, "us-east-1", storage.Config["region"])
+		require.Equal(t, MaskedSecret, storage.ClientConfig.Headers["Authorization"])
+		require.Equal(t, "*/*", storage.ClientConfig.Headers["Accept"])
+	}
+
+	t.Run("storage", func(t *testing.T) {
+		storage := newStorage()
+		masked := MaskStorageSecrets(storage)
+		requireMasked(t, masked.(Storage))
+		require.Equal(t, "secret", storage.Config["secret_access_key"])
+	})
+	t.Run("pointer", func(t *testing.T) {
+		storage := newStorage()
+		masked := MaskStorageSecrets(&storage)
+		requireMasked(t, *masked.(*Storage))
+		require.Equal(t, "secret", storage.Config["secret_access_key"])
+	})
+	t.Run("slice", func(t *testing.T) {
+		storages := []Storage{newStorage(), newStorage()}
+		masked := MaskStorageSecrets(storages).([]Storage)
+		requireMasked(t, masked[0])
+		requireMasked(t, masked[1])
+		require.Equal(t, "secret", storages[0].Config["secret_access_key"])
+	})
+	t.Run("nested", func(t *testing.T) {
+		preparation := &Preparation{
+			SourceStorages: []Storage{newStorage()},
+			OutputStorages: []Storage{newStorage()},
+		}
+		preparation.SourceStorages[0].PreparationsAsSource = []Preparation{{OutputStorages: []Storage{newStorage()}}}
+		masked := MaskStorageSecrets([]*Preparation{preparation, preparation}).([]*Preparation)
+		require.Same(t, masked[0], masked[1])
+		requireMasked(t, masked[0].SourceStorages[0])
+		requireMasked(t, masked[0].OutputStorages[0])
+		requireMasked(t, masked[0].SourceStorages[0].PreparationsAsSource[0].OutputStorages[0])
+		require.Equal(t, "secret", preparation.SourceStorages[0].Config["secret_access_key"])
+	})
+	t.Run("interface", func(t *testing.T) {
+		result := map[string]any{"storage": newStorage()}
+		masked := MaskStorageSecrets(result).(map[string]any)
+		requireMasked(t, masked["storage"].(Storage))
+		require.Equal(t, "secret", result["storage"].(Storage).Config["secret_access_key"])
+	})
+	t.Run("no storage", func(t *testing.T) {
+		require.Nil(t, MaskStorageSecrets(nil))
+		require.Equal(t, []string{"a"}, MaskStorageSecrets([]string{"a"}))
+	})
+}
+
+func TestMaskSecretsInText(t *testing.T) {
+	sql := `INSERT INTO "storages" ("name","config") VALUES ("s3",'{"region":"us-east-1","secret_access_key":"se\"cret","session_token":""}')`
+	require.Equal(t,
+		`INSERT INTO "storages" ("name","config") VALUES ("s3",'{"region":"us-east-1","secret_access_key":"`+MaskedSecret+`","session_token":""}')`,
+		MaskSecretsInText(sql))
+	require.Equal(t, "SELECT 1", MaskSecretsInText("SELECT 1"))
+}
@@ -0,0 +1,89 @@
+// Package operator carries the API key of the operator behind a request, so actions on deal schedules can be
+// attributed to an operator and approved by another one.
+package operator
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo/v4"
+)
+
+// Header is the HTTP header used by clients to send the API key of the operator.
+const Header = "X-Singularity-Api-Key"
+
+type contextKey struct{}
+
+// WithKey returns a copy of the context that carries the API key of the operator.
+//
+// Parameters:
+//   - ctx: The context to carry the key.
+//   - key: The API key of the operator. An empty key leaves the context unchanged.
+//
+// Returns:
+//   - The context carrying the API key.
+func WithKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, key)
+}
+
+// KeyFromContext returns the API key of the operator carried by the context.
+//
+// Parameters:
+//   - ctx: The context of the request.
+//
+// Returns:
+//   - The API key of the operator, or an empty string if the context carries none.
+func KeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(contextKey{}).(string)
+	return key
+}
+
+// Middleware is an echo middleware that passes the API key sent in the request header to the handlers through
+// the request context. The key is only verified by the handlers that need to know the operator.
+//
+// Parameters:
+//   - next: The handler to call with the request carrying the API key.
+//
+// Returns:
+//   - The handler that passes the API key to next.
+func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get(Header)
+		if key != "" {
+			c.SetRequest(c.Request().WithContext(WithKey(c.Request().Context(), key)))
+		}
+		return next(c)
+	}
+}
+
+// GenerateKey generates a new random API key.
+//
+// Returns:
+//   - The hex encoded 32 byte API key.
+//   - An error if the random source failed.
+func GenerateKey() (string, error) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// HashKey returns the hash of an API key, which is what is stored in the database.
+//
+// Parameters:
+//   - key: The API key of the operator.
+//
+// Returns:
+//   - The hex encoded SHA-256 hash of the key.
+func HashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
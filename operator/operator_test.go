@@ -0,0 +1,50 @@
+package operator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateKey(t *testing.T) {
+	key1, err := GenerateKey()
+	require.NoError(t, err)
+	key2, err := GenerateKey()
+	require.NoError(t, err)
+	require.Len(t, key1, 64)
+	require.NotEqual(t, key1, key2)
+	require.Len(t, HashKey(key1), 64)
+	require.Equal(t, HashKey(key1), HashKey(key1))
+	require.NotEqual(t, HashKey(key1), HashKey(key2))
+}
+
+func TestWithKey(t *testing.T) {
+	ctx := context.Background()
+	require.Empty(t, KeyFromContext(ctx))
+	require.Equal(t, ctx, WithKey(ctx, ""))
+	require.Equal(t, "key", KeyFromContext(WithKey(ctx, "key")))
+}
+
+func TestMiddleware(t *testing.T) {
+	e := echo.New()
+	var key string
+	handler := Middleware(func(c echo.Context) error {
+		key = KeyFromContext(c.Request().Context())
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(Header, "key")
+	err := handler(e.NewContext(req, httptest.NewRecorder()))
+	require.NoError(t, err)
+	require.Equal(t, "key", key)
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	err = handler(e.NewContext(req, httptest.NewRecorder()))
+	require.NoError(t, err)
+	require.Empty(t, key)
+}
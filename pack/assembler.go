@@ -4,17 +4,21 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
 	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
 	"github.com/data-preservation-programs/singularity/storagesystem"
 	"github.com/data-preservation-programs/singularity/util"
 	"github.com/gotidy/ptr"
+	chunk "github.com/ipfs/boxo/chunker"
+	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-cid"
 	format "github.com/ipfs/go-ipld-format"
-	"github.com/minio/sha256-simd"
-	"github.com/multiformats/go-multihash"
+	"github.com/ipfs/go-merkledag"
 	"github.com/multiformats/go-varint"
 	"github.com/rclone/rclone/fs"
 )
@@ -39,8 +43,8 @@ type Assembler struct {
 	buffer io.Reader
 	// fileReadCloser reads the actual content from files.
 	fileReadCloser io.ReadCloser
-	// buf is a buffer for temporarily holding data.
-	buf []byte
+	// splitter chunks the content read by fileReadCloser into leaf blocks.
+	splitter chunk.Splitter
 	// fileOffset tracks the offset into the current file being read.
 	fileOffset int64
 	// carOffset tracks the offset within a CAR (Content Addressable Archive).
@@ -54,6 +58,24 @@ type Assembler struct {
 	noInline              bool
 	skipInaccessibleFiles bool
 	fileLengthCorrection  map[model.FileID]int64
+	// cidOptions decides the hash function, the codec of the leaf blocks and the chunker.
+	cidOptions packutil.CidOptions
+	// carSource is whether the files are CAR files whose blocks are packed as is, rather than chunked into blocks.
+	carSource bool
+	// carReader reads the blocks of the current CAR file of a CAR source.
+	carReader *carSourceReader
+	// encrypter encrypts each file range on its own, i.e. into an age file or with a data key of a KMS. The file
+	// ranges are not encrypted if nil.
+	encrypter encryption.ItemEncrypter
+	// item encrypts the content of the current file range, if it is encrypted.
+	item encryption.Item
+	// listing, reading and hashing are the time spent opening the source files, reading their content, and building
+	// the blocks and their CIDs.
+	listing time.Duration
+	reading time.Duration
+	hashing time.Duration
+	// sourceRead is the latency distribution of opening the file ranges, and the number of bytes read from them.
+	sourceRead model.SourceRead
 }
 
 // Close closes the assembler and all of its underlying readers
@@ -65,21 +87,26 @@ func (a *Assembler) Close() error {
 		}
 		a.fileReadCloser = nil
 	}
+	a.carReader = nil
+	a.item = nil
 	return nil
 }
 
 // NewAssembler initializes a new Assembler instance with the given parameters.
 func NewAssembler(ctx context.Context, reader storagesystem.Reader,
-	fileRanges []model.FileRange, noInline bool, skipInaccessibleFiles bool) *Assembler {
+	fileRanges []model.FileRange, noInline bool, skipInaccessibleFiles bool, carSource bool, cidOptions packutil.CidOptions,
+	encrypter encryption.ItemEncrypter) *Assembler {
 	return &Assembler{
 		ctx:                   ctx,
 		reader:                reader,
 		fileRanges:            fileRanges,
-		buf:                   make([]byte, packutil.ChunkSize),
 		objects:               make(map[model.FileID]fs.Object),
 		noInline:              noInline,
 		skipInaccessibleFiles: skipInaccessibleFiles,
 		fileLengthCorrection:  make(map[model.FileID]int64),
+		cidOptions:            cidOptions,
+		carSource:             carSource,
+		encrypter:             encrypter,
 	}
 }
 
@@ -141,7 +168,12 @@ func (a *Assembler) assembleLinks() error {
 		return nil
 	}
 
-	blks, rootNode, err := packutil.AssembleFileFromLinks(a.pendingLinks)
+	var blks []blocks.Block
+	var rootNode *merkledag.ProtoNode
+	var err error
+	hash(&a.hashing, func() {
+		blks, rootNode, err = a.cidOptions.AssembleFileFromLinks(a.pendingLinks)
+	})
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -176,9 +208,11 @@ func (a *Assembler) prefetch() error {
 	firstChunk := false
 	if a.fileReadCloser == nil {
 		fileRange := a.fileRanges[a.index]
+		start := time.Now()
 		readCloser, obj, err := a.reader.Read(a.ctx, fileRange.File.Path, fileRange.Offset, fileRange.Length)
 		if err != nil {
-			if a.skipInaccessibleFiles {
+			// A storage whose credentials are rejected makes all files inaccessible, so they are not skipped
+			if a.skipInaccessibleFiles && !storagesystem.IsAuthError(err) {
 				logger.Warnf("skipping inaccessible file %s: %v", fileRange.File.Path, err)
 				a.index++
 				return nil
@@ -187,57 +221,86 @@ func (a *Assembler) prefetch() error {
 			}
 		}
 		same, detail := storagesystem.IsSameEntry(a.ctx, *fileRange.File, obj)
+		latency := time.Since(start)
+		a.listing += latency
 		if !same {
 			return errors.Wrapf(ErrFileModified, "fileRange has been modified: %s, %s", fileRange.File.Path, detail)
 		}
+		a.recordRead(fileRange.File.Path, latency)
+		var content io.Reader = &timedReader{reader: readCloser, elapsed: &a.reading, bytes: &a.sourceRead.BytesRead}
+		var item encryption.Item
+		if a.encrypter != nil {
+			// The blocks of an encrypted file range are the blocks of its encryption
+			var wrappedKey []byte
+			item, wrappedKey, err = a.encrypter.EncryptItem(a.ctx, content)
+			if err != nil {
+				readCloser.Close()
+				return errors.WithStack(err)
+			}
+			a.fileRanges[a.index].WrappedKey = wrappedKey
+			content = item
+		}
+		splitter, err := a.cidOptions.NewSplitter(content)
+		if err != nil {
+			readCloser.Close()
+			return errors.WithStack(err)
+		}
 		a.objects[fileRange.File.ID] = obj
 		a.fileReadCloser = readCloser
+		a.splitter = splitter
+		a.item = item
 		a.fileOffset = fileRange.Offset
 		firstChunk = true
 		a.pendingLinks = nil
 	}
 
-	hasher := sha256.New()
-	reader := io.TeeReader(a.fileReadCloser, hasher)
-	n, err := io.ReadFull(reader, a.buf)
+	data, err := a.splitter.NextBytes()
+	n := len(data)
 
 	// Last empty chunk of a file
 	if err == io.EOF && !firstChunk {
 		a.assembleLinkFor = ptr.Of(a.index)
-		a.fileReadCloser = nil
+		a.endRange(a.fileOffset)
 		a.Close()
-		if a.fileRanges[a.index].Length < 0 {
-			a.fileLengthCorrection[a.fileRanges[a.index].FileID] = a.fileOffset
-		}
+		a.fileReadCloser = nil
 		a.index++
 		return nil
 	}
 
 	// read more than 0 bytes, or the first block of an empty file
 	// nolint:goerr113
-	if err == nil || err == io.ErrUnexpectedEOF || err == io.EOF {
-		var cidValue cid.Cid
-		var vint []byte
-		if err == io.EOF {
-			cidValue = packutil.EmptyFileCid
-			vint = packutil.EmptyFileVarint
-		} else {
-			sum := hasher.Sum(nil)
-			mh, err2 := multihash.Encode(sum, multihash.SHA2_256)
-			if err2 != nil {
-				return errors.WithStack(err2)
+	if err == nil || err == io.EOF {
+		// A small file that is read whole is embedded in its CID, unless it would be the first block of the CAR, so
+		// that no CAR file is left without content.
+		fileRange := a.fileRanges[a.index]
+		if firstChunk && a.item == nil && a.carOffset > 0 && fileRange.Offset == 0 && fileRange.Length == fileRange.File.Size && int64(n) == fileRange.Length {
+			if inlineCid, ok := a.cidOptions.NewInlineCid(data); ok {
+				a.fileRanges[a.index].CID = model.CID(inlineCid)
+				a.Close()
+				a.fileReadCloser = nil
+				a.index++
+				return nil
 			}
-			cidValue = cid.NewCidV1(cid.Raw, mh)
-			vint = varint.ToUvarint(uint64(cidValue.ByteLen() + n))
 		}
+
+		var blk blocks.Block
+		var err2 error
+		hash(&a.hashing, func() {
+			blk, err2 = a.cidOptions.NewLeaf(data)
+		})
+		if err2 != nil {
+			return errors.WithStack(err2)
+		}
+		cidValue := blk.Cid()
+		vint := varint.ToUvarint(uint64(cidValue.ByteLen() + len(blk.RawData())))
 		carBlocks := []model.CarBlock{{
 			CID:        model.CID(cidValue),
-			RawBlock:   a.buf[:n],
+			RawBlock:   blk.RawData(),
 			Varint:     vint,
 			FileOffset: a.fileOffset,
 			FileID:     &a.fileRanges[a.index].FileID,
 		}}
-		err2 := a.populateBuffer(carBlocks)
+		err2 = a.populateBuffer(carBlocks)
 		if err2 != nil {
 			return errors.WithStack(err2)
 		}
@@ -256,10 +319,8 @@ func (a *Assembler) prefetch() error {
 		}
 
 		a.assembleLinkFor = ptr.Of(a.index)
+		a.endRange(a.fileOffset + int64(n))
 		a.Close()
-		if a.fileRanges[a.index].Length < 0 {
-			a.fileLengthCorrection[a.fileRanges[a.index].FileID] = a.fileOffset + int64(n)
-		}
 		a.fileReadCloser = nil
 		a.index++
 
@@ -269,12 +330,105 @@ func (a *Assembler) prefetch() error {
 	return errors.WithStack(err)
 }
 
+// endRange records the length of the current file range once it has been read whole, if it was unknown, and the
+// length of its encryption if it is encrypted. end is the offset in the file that the range was read up to.
+func (a *Assembler) endRange(end int64) {
+	fileRange := &a.fileRanges[a.index]
+	if a.item != nil {
+		fileRange.EncryptedLength = a.item.Size()
+		// The offsets are the ones in the encryption, so the length of the file is the length of what was encrypted
+		end = fileRange.Offset + a.item.PlaintextSize()
+	}
+	if fileRange.Length < 0 {
+		a.fileLengthCorrection[fileRange.FileID] = end
+	}
+}
+
+// prefetchCar reads the next block of the CAR file of the current file range, for CAR sources, and fills the buffer.
+// The block is packed as is, and its CarBlock refers to the offset of its data in the CAR file, so the piece can be
+// served back from the CAR file. A file range holds the blocks whose section starts within the range, so a CAR file
+// that is split into several ranges is split between blocks, and the CID of each range is the root of the CAR file.
+func (a *Assembler) prefetchCar() error {
+	fileRange := a.fileRanges[a.index]
+	if a.carReader == nil {
+		// The blocks can only be found by reading the CAR file from its start, even for a range that starts later
+		start := time.Now()
+		readCloser, obj, err := a.reader.Read(a.ctx, fileRange.File.Path, 0, -1)
+		if err != nil {
+			// A storage whose credentials are rejected makes all files inaccessible, so they are not skipped
+			if a.skipInaccessibleFiles && !storagesystem.IsAuthError(err) {
+				logger.Warnf("skipping inaccessible file %s: %v", fileRange.File.Path, err)
+				a.index++
+				return nil
+			}
+			return errors.Wrapf(err, "failed to open file %s", fileRange.File.Path)
+		}
+		same, detail := storagesystem.IsSameEntry(a.ctx, *fileRange.File, obj)
+		latency := time.Since(start)
+		a.listing += latency
+		if !same {
+			readCloser.Close()
+			return errors.Wrapf(ErrFileModified, "fileRange has been modified: %s, %s", fileRange.File.Path, detail)
+		}
+		a.recordRead(fileRange.File.Path, latency)
+		a.objects[fileRange.File.ID] = obj
+		a.fileReadCloser = readCloser
+		a.carReader, err = newCarSourceReader(readCloser)
+		if err != nil {
+			a.Close()
+			return errors.Wrapf(err, "failed to read CAR file %s", fileRange.File.Path)
+		}
+		a.fileRanges[a.index].CID = model.CID(a.carReader.root)
+	}
+
+	for {
+		// The blocks of a CAR source are verified against their CIDs as they are read, which is counted as reading.
+		readStart := time.Now()
+		start, blockCID, data, dataOffset, err := a.carReader.next()
+		a.reading += time.Since(readStart)
+		if errors.Is(err, io.EOF) || (err == nil && fileRange.Length >= 0 && start >= fileRange.Offset+fileRange.Length) {
+			if fileRange.Length < 0 {
+				a.fileLengthCorrection[fileRange.FileID] = a.carReader.offset
+			}
+			a.sourceRead.BytesRead += a.carReader.offset
+			a.Close()
+			a.index++
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read CAR file %s", fileRange.File.Path)
+		}
+		if start < fileRange.Offset {
+			continue
+		}
+
+		vint := varint.ToUvarint(uint64(blockCID.ByteLen() + len(data)))
+		carBlocks := []model.CarBlock{{
+			CID:        model.CID(blockCID),
+			RawBlock:   data,
+			Varint:     vint,
+			FileOffset: dataOffset,
+			FileID:     &a.fileRanges[a.index].FileID,
+		}}
+		err = a.populateBuffer(carBlocks)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		carBlocks[0].RawBlock = nil
+		if !a.noInline {
+			a.carBlocks = append(a.carBlocks, carBlocks...)
+		}
+		return nil
+	}
+}
+
 // Read reads data from the buffer, or fetches the next chunk from fileRanges if the buffer is empty.
 // It will assemble links if needed and respect the context's cancellation or deadline.
 func (a *Assembler) Read(p []byte) (int, error) {
 	if a.ctx.Err() != nil {
 		return 0, a.ctx.Err()
 	}
+	healthcheck.ReportProgress(a.ctx)
 
 	if a.buffer != nil {
 		return a.readBuffer(p)
@@ -288,5 +442,8 @@ func (a *Assembler) Read(p []byte) (int, error) {
 		return 0, io.EOF
 	}
 
+	if a.carSource {
+		return 0, a.prefetchCar()
+	}
 	return 0, a.prefetch()
 }
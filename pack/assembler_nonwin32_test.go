@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
 	"github.com/data-preservation-programs/singularity/storagesystem"
 	"github.com/stretchr/testify/require"
 )
@@ -39,7 +40,7 @@ func TestAssembler_InaccessibleFile(t *testing.T) {
 				LastModifiedNano: stat.ModTime().UnixNano(),
 			},
 		},
-	}, false, false)
+	}, false, false, false, packutil.DefaultCidOptions, nil)
 	defer assembler.Close()
 
 	_, err = io.ReadAll(assembler)
@@ -55,7 +56,7 @@ func TestAssembler_InaccessibleFile(t *testing.T) {
 				LastModifiedNano: stat.ModTime().UnixNano(),
 			},
 		},
-	}, false, true)
+	}, false, true, false, packutil.DefaultCidOptions, nil)
 	defer assembler2.Close()
 
 	_, err = io.ReadAll(assembler2)
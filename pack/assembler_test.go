@@ -8,11 +8,14 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
 	"github.com/data-preservation-programs/singularity/storagesystem"
 	"github.com/data-preservation-programs/singularity/util/testutil"
+	chunk "github.com/ipfs/boxo/chunker"
 	"github.com/ipfs/go-cid"
 	"github.com/ipld/go-car"
 	"github.com/rjNemo/underscore"
@@ -76,7 +79,7 @@ func TestAssembler(t *testing.T) {
 		})
 		require.NoError(t, err)
 		t.Run(fmt.Sprintf("single size=%d", size), func(t *testing.T) {
-			assembler := NewAssembler(context.Background(), reader, []model.FileRange{fileRange}, false, false)
+			assembler := NewAssembler(context.Background(), reader, []model.FileRange{fileRange}, false, false, false, packutil.DefaultCidOptions, nil)
 			defer assembler.Close()
 			content, err := io.ReadAll(assembler)
 			require.NoError(t, err)
@@ -96,7 +99,7 @@ func TestAssembler(t *testing.T) {
 		return allFileRanges[i].ID < allFileRanges[j].ID
 	})
 	t.Run("all", func(t *testing.T) {
-		assembler := NewAssembler(context.Background(), reader, allFileRanges, false, false)
+		assembler := NewAssembler(context.Background(), reader, allFileRanges, false, false, false, packutil.DefaultCidOptions, nil)
 		defer assembler.Close()
 		content, err := io.ReadAll(assembler)
 		require.NoError(t, err)
@@ -106,7 +109,7 @@ func TestAssembler(t *testing.T) {
 		require.Greater(t, len(assembler.carBlocks), 0)
 	})
 	t.Run("noinline", func(t *testing.T) {
-		assembler := NewAssembler(context.Background(), reader, allFileRanges, true, false)
+		assembler := NewAssembler(context.Background(), reader, allFileRanges, true, false, false, packutil.DefaultCidOptions, nil)
 		defer assembler.Close()
 		content, err := io.ReadAll(assembler)
 		require.NoError(t, err)
@@ -117,6 +120,116 @@ func TestAssembler(t *testing.T) {
 	})
 }
 
+func TestAssembler_Chunker(t *testing.T) {
+	tmp := t.TempDir()
+	data := testutil.GenerateRandomBytes(3 << 20)
+	err := os.WriteFile(filepath.Join(tmp, "test.bin"), data, 0644)
+	require.NoError(t, err)
+	stat, err := os.Stat(filepath.Join(tmp, "test.bin"))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	reader, err := storagesystem.NewRCloneHandler(ctx, model.Storage{
+		Type: "local",
+		Path: tmp,
+	})
+	require.NoError(t, err)
+
+	for _, chunker := range []string{"size-262144", "rabin-16384-65536-262144", "buzhash"} {
+		t.Run(chunker, func(t *testing.T) {
+			options, err := packutil.NewCidOptions("", "", "", chunker)
+			require.NoError(t, err)
+			fileRanges := []model.FileRange{{
+				ID:     1,
+				Length: int64(len(data)),
+				FileID: 1,
+				File: &model.File{
+					ID:               1,
+					Path:             "test.bin",
+					Size:             int64(len(data)),
+					LastModifiedNano: stat.ModTime().UnixNano(),
+				},
+			}}
+			assembler := NewAssembler(ctx, reader, fileRanges, false, false, false, options, nil)
+			defer assembler.Close()
+			content, err := io.ReadAll(assembler)
+			require.NoError(t, err)
+			validateCarContent(t, content)
+			validateAssembler(t, assembler)
+
+			// The leaf blocks are the chunks of the IPFS chunker
+			splitter, err := chunk.FromString(bytes.NewReader(data), chunker)
+			require.NoError(t, err)
+			var offset int64
+			var leaves []model.CarBlock
+			for _, carBlock := range assembler.carBlocks {
+				if carBlock.FileID != nil {
+					leaves = append(leaves, carBlock)
+				}
+			}
+			for _, leaf := range leaves {
+				expected, err := splitter.NextBytes()
+				require.NoError(t, err)
+				require.Equal(t, offset, leaf.FileOffset)
+				require.EqualValues(t, len(expected), leaf.BlockLength())
+				offset += int64(len(expected))
+			}
+			_, err = splitter.NextBytes()
+			require.ErrorIs(t, err, io.EOF)
+			require.EqualValues(t, len(data), offset)
+		})
+	}
+}
+
+func TestAssembler_SmallFileLimit(t *testing.T) {
+	tmp := t.TempDir()
+	ctx := context.Background()
+	reader, err := storagesystem.NewRCloneHandler(ctx, model.Storage{
+		Type: "local",
+		Path: tmp,
+	})
+	require.NoError(t, err)
+
+	var fileRanges []model.FileRange
+	for i := 0; i < 4; i++ {
+		name := fmt.Sprintf("%d.txt", i)
+		data := []byte(strings.Repeat(name, 10))
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, name), data, 0644))
+		stat, err := os.Stat(filepath.Join(tmp, name))
+		require.NoError(t, err)
+		fileRanges = append(fileRanges, model.FileRange{
+			ID:     model.FileRangeID(i + 1),
+			Length: int64(len(data)),
+			FileID: model.FileID(i + 1),
+			File: &model.File{
+				ID:               model.FileID(i + 1),
+				Path:             name,
+				Size:             int64(len(data)),
+				LastModifiedNano: stat.ModTime().UnixNano(),
+			},
+		})
+	}
+
+	options := packutil.DefaultCidOptions
+	options.SmallFileLimit = packutil.MaxSmallFileLimit
+	assembler := NewAssembler(ctx, reader, fileRanges, false, false, false, options, nil)
+	defer assembler.Close()
+	content, err := io.ReadAll(assembler)
+	require.NoError(t, err)
+	validateCarContent(t, content)
+	validateAssembler(t, assembler)
+
+	// The first file is packed so the CAR is not empty, the others are embedded in their CIDs
+	require.Len(t, assembler.carBlocks, 1)
+	for i, fileRange := range assembler.fileRanges {
+		data, ok := packutil.InlineData(cid.Cid(fileRange.CID))
+		require.Equal(t, i > 0, ok)
+		if ok {
+			require.Equal(t, strings.Repeat(fileRange.File.Path, 10), string(data))
+		}
+	}
+}
+
 func validateCarContent(t *testing.T, content []byte) {
 	reader, err := car.NewCarReader(bytes.NewReader(content))
 	require.NoError(t, err)
@@ -0,0 +1,149 @@
+package pack
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"github.com/ipfs/go-cid"
+	carv1 "github.com/ipld/go-car"
+	carv2 "github.com/ipld/go-car/v2"
+	"github.com/multiformats/go-varint"
+)
+
+var ErrInvalidCarFile = errors.New("invalid CAR file")
+
+// maxCarSectionSize is the size of the largest block accepted in a CAR file of a CAR source, which is the limit of
+// go-car, so that a corrupted length does not allocate a huge buffer.
+const maxCarSectionSize = 32 << 20
+
+// carSourceReader reads the blocks of a CAR file of a CAR source, along with their offsets in the file, so that the
+// blocks can be packed as is and served back from the CAR file. Both CARv1 and CARv2 files are supported.
+type carSourceReader struct {
+	r *bufio.Reader
+	// offset is the offset in the CAR file of the next byte of r.
+	offset int64
+	// end is the offset in the CAR file of the end of the CARv1 payload, or -1 if it ends with the file.
+	end int64
+	// root is the first root of the CAR file.
+	root cid.Cid
+}
+
+// newCarSourceReader reads the header of a CAR file of a CAR source, which is read from its start.
+//
+// Parameters:
+//   - r: The reader of the CAR file, from its start.
+//
+// Returns:
+//   - The reader of the blocks of the CAR file.
+//   - ErrInvalidCarFile if the CAR file is invalid or has no root.
+func newCarSourceReader(r io.Reader) (*carSourceReader, error) {
+	c := &carSourceReader{r: bufio.NewReader(r), end: -1}
+	header, err := c.readHeader()
+	if err != nil {
+		return nil, err
+	}
+	if header.Version == 2 {
+		// The CARv2 pragma reads as a CARv1 header of version 2, and is followed by the CARv2 header
+		var v2Header carv2.Header
+		n, err := v2Header.ReadFrom(c.r)
+		c.offset += n
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidCarFile, "failed to read CARv2 header: %s", err)
+		}
+		if int64(v2Header.DataOffset) < c.offset {
+			return nil, errors.Wrapf(ErrInvalidCarFile, "invalid CARv2 data offset %d", v2Header.DataOffset)
+		}
+		skipped, err := io.CopyN(io.Discard, c.r, int64(v2Header.DataOffset)-c.offset)
+		c.offset += skipped
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidCarFile, "failed to skip to CARv2 data: %s", err)
+		}
+		c.end = int64(v2Header.DataOffset + v2Header.DataSize)
+		header, err = c.readHeader()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if header.Version != 1 {
+		return nil, errors.Wrapf(ErrInvalidCarFile, "unsupported CAR version %d", header.Version)
+	}
+	if len(header.Roots) == 0 {
+		return nil, errors.Wrap(ErrInvalidCarFile, "CAR file has no root")
+	}
+	c.root = header.Roots[0]
+	return c, nil
+}
+
+// readHeader reads a CARv1 header, or the CARv2 pragma.
+func (c *carSourceReader) readHeader() (*carv1.CarHeader, error) {
+	length, err := varint.ReadUvarint(c.r)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidCarFile, "failed to read header length: %s", err)
+	}
+	if length == 0 || length > maxCarSectionSize {
+		return nil, errors.Wrapf(ErrInvalidCarFile, "invalid header length %d", length)
+	}
+	section := make([]byte, varint.UvarintSize(length)+int(length))
+	varint.PutUvarint(section, length)
+	_, err = io.ReadFull(c.r, section[varint.UvarintSize(length):])
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidCarFile, "failed to read header: %s", err)
+	}
+	c.offset += int64(len(section))
+	header, err := carv1.ReadHeader(bufio.NewReader(bytes.NewReader(section)))
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidCarFile, "failed to decode header: %s", err)
+	}
+	return header, nil
+}
+
+// next reads the next block of the CAR file. The data of the block is verified against its CID.
+//
+// Returns:
+//   - The offset in the CAR file of the start of the section of the block, which is its length prefix.
+//   - The CID of the block.
+//   - The data of the block.
+//   - The offset in the CAR file of the data of the block.
+//   - io.EOF once all blocks have been read, or ErrInvalidCarFile if the CAR file is invalid.
+func (c *carSourceReader) next() (int64, cid.Cid, []byte, int64, error) {
+	start := c.offset
+	if c.end >= 0 && start >= c.end {
+		return 0, cid.Undef, nil, 0, io.EOF
+	}
+	length, err := varint.ReadUvarint(c.r)
+	if errors.Is(err, io.EOF) && c.end < 0 {
+		return 0, cid.Undef, nil, 0, io.EOF
+	}
+	if err != nil {
+		return 0, cid.Undef, nil, 0, errors.Wrapf(ErrInvalidCarFile, "failed to read section length at offset %d: %s", start, err)
+	}
+	// Some CAR files are padded with zeros
+	if length == 0 {
+		return 0, cid.Undef, nil, 0, io.EOF
+	}
+	if length > maxCarSectionSize {
+		return 0, cid.Undef, nil, 0, errors.Wrapf(ErrInvalidCarFile, "section at offset %d is too large: %d", start, length)
+	}
+	section := make([]byte, length)
+	_, err = io.ReadFull(c.r, section)
+	if err != nil {
+		return 0, cid.Undef, nil, 0, errors.Wrapf(ErrInvalidCarFile, "failed to read section at offset %d: %s", start, err)
+	}
+	c.offset += int64(varint.UvarintSize(length)) + int64(length)
+
+	n, blockCID, err := cid.CidFromBytes(section)
+	if err != nil {
+		return 0, cid.Undef, nil, 0, errors.Wrapf(ErrInvalidCarFile, "invalid CID at offset %d: %s", start, err)
+	}
+	data := section[n:]
+	expected, err := blockCID.Prefix().Sum(data)
+	if err != nil {
+		return 0, cid.Undef, nil, 0, errors.Wrapf(ErrInvalidCarFile, "failed to hash block %s at offset %d: %s", blockCID, start, err)
+	}
+	if !expected.Equals(blockCID) {
+		return 0, cid.Undef, nil, 0, errors.Wrapf(ErrInvalidCarFile, "block at offset %d does not match its CID %s", start, blockCID)
+	}
+	return start, blockCID, data, start + int64(varint.UvarintSize(length)) + int64(n), nil
+}
@@ -0,0 +1,73 @@
+package pack
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	blocks "github.com/ipfs/go-block-format"
+	carv2 "github.com/ipld/go-car/v2"
+	"github.com/multiformats/go-varint"
+	"github.com/stretchr/testify/require"
+)
+
+// generateCarFile returns a CARv1 file with the given number of random blocks, rooted at the first block.
+func generateCarFile(t *testing.T, numBlocks int) ([]byte, []blocks.Block) {
+	var blks []blocks.Block
+	for i := 0; i < numBlocks; i++ {
+		blk, err := packutil.DefaultCidOptions.NewLeaf(testutil.GenerateRandomBytes(1000))
+		require.NoError(t, err)
+		blks = append(blks, blk)
+	}
+	header, err := util.GenerateCarHeader(blks[0].Cid())
+	require.NoError(t, err)
+	content := bytes.NewBuffer(header)
+	for _, blk := range blks {
+		content.Write(varint.ToUvarint(uint64(blk.Cid().ByteLen() + len(blk.RawData()))))
+		content.Write(blk.Cid().Bytes())
+		content.Write(blk.RawData())
+	}
+	return content.Bytes(), blks
+}
+
+func TestCarSourceReader(t *testing.T) {
+	v1, blks := generateCarFile(t, 3)
+	var v2 bytes.Buffer
+	err := carv2.WrapV1(bytes.NewReader(v1), &v2)
+	require.NoError(t, err)
+
+	for name, content := range map[string][]byte{"v1": v1, "v2": v2.Bytes()} {
+		t.Run(name, func(t *testing.T) {
+			reader, err := newCarSourceReader(bytes.NewReader(content))
+			require.NoError(t, err)
+			require.Equal(t, blks[0].Cid(), reader.root)
+			for _, blk := range blks {
+				_, blockCID, data, dataOffset, err := reader.next()
+				require.NoError(t, err)
+				require.Equal(t, blk.Cid(), blockCID)
+				require.Equal(t, blk.RawData(), data)
+				require.Equal(t, blk.RawData(), content[dataOffset:dataOffset+int64(len(data))])
+			}
+			_, _, _, _, err = reader.next()
+			require.ErrorIs(t, err, io.EOF)
+		})
+	}
+
+	_, err = newCarSourceReader(bytes.NewReader([]byte("not a car file")))
+	require.ErrorIs(t, err, ErrInvalidCarFile)
+
+	// A block that does not match its CID
+	corrupted := bytes.Clone(v1)
+	corrupted[len(corrupted)-1]++
+	reader, err := newCarSourceReader(bytes.NewReader(corrupted))
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, _, _, _, err = reader.next()
+		require.NoError(t, err)
+	}
+	_, _, _, _, err = reader.next()
+	require.ErrorIs(t, err, ErrInvalidCarFile)
+}
@@ -0,0 +1,206 @@
+// Package compression compresses the CAR files written to the output storages, and decompresses them when they are
+// read back. The piece CID and the file size of a CAR file are always those of the uncompressed CAR file, so compressed
+// CAR files are decompressed before they are served to the storage providers.
+package compression
+
+import (
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/klauspost/compress/zstd"
+)
+
+var ErrUnsupportedCompression = errors.New("unsupported CAR compression")
+
+var ErrNegativeOffset = errors.New("cannot seek to a negative offset")
+
+// Extension returns the extension appended to the name of a CAR file compressed with the given compression, i.e.
+// .zst, so it is saved as .car.zst.
+func Extension(compression model.CarCompression) string {
+	if compression == model.CarCompressionZstd {
+		return ".zst"
+	}
+	return ""
+}
+
+// Reader is the compressed content of a CAR file. It also counts the bytes of the uncompressed CAR file, which are
+// the file size of the CAR file.
+type Reader struct {
+	reader io.ReadCloser
+	size   counter
+}
+
+type counter int64
+
+func (c *counter) Write(p []byte) (int, error) {
+	*c += counter(len(p))
+	return len(p), nil
+}
+
+func (r *Reader) Read(p []byte) (int, error) {
+	return r.reader.Read(p)
+}
+
+// Close stops the compression, if the compressed content has not been read to the end.
+func (r *Reader) Close() error {
+	return r.reader.Close()
+}
+
+// Size returns the number of bytes of the uncompressed CAR file read so far.
+func (r *Reader) Size() int64 {
+	return int64(r.size)
+}
+
+// Compress compresses a CAR file as it is read. The content is returned as is if the compression is empty.
+//
+// Parameters:
+//   - reader: The reader of the uncompressed CAR file.
+//   - compression: The compression of the CAR file.
+//
+// Returns:
+//   - The reader of the compressed CAR file, which must be closed.
+//   - ErrUnsupportedCompression if the compression is not supported.
+func Compress(reader io.Reader, compression model.CarCompression) (*Reader, error) {
+	compressed := &Reader{}
+	reader = io.TeeReader(reader, &compressed.size)
+	switch compression {
+	case model.CarCompressionNone:
+		compressed.reader = io.NopCloser(reader)
+	case model.CarCompressionZstd:
+		pipeReader, pipeWriter := io.Pipe()
+		encoder, err := zstd.NewWriter(pipeWriter)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		go func() {
+			_, err := io.Copy(encoder, reader)
+			closeErr := encoder.Close()
+			if err == nil {
+				err = closeErr
+			}
+			_ = pipeWriter.CloseWithError(err)
+		}()
+		compressed.reader = pipeReader
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedCompression, "%s", compression)
+	}
+	return compressed, nil
+}
+
+// NewReader returns a reader of the uncompressed content of a CAR file, to read it from the start to the end.
+//
+// Parameters:
+//   - reader: The reader of the CAR file as it is stored.
+//   - compression: The compression of the CAR file.
+//
+// Returns:
+//   - The reader of the uncompressed CAR file. Closing it does not close the underlying reader.
+//   - ErrUnsupportedCompression if the compression is not supported.
+func NewReader(reader io.Reader, compression model.CarCompression) (io.ReadCloser, error) {
+	switch compression {
+	case model.CarCompressionNone:
+		return io.NopCloser(reader), nil
+	case model.CarCompressionZstd:
+		decoder, err := zstd.NewReader(reader, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return decoder.IOReadCloser(), nil
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedCompression, "%s", compression)
+	}
+}
+
+// ReadSeeker serves ranges of a compressed CAR file, so it can be served with http.ServeContent like an uncompressed
+// one. A zstd stream can only be decompressed from the start, so reads after a seek forward skip the decompressed
+// bytes up to the offset, and reads after a seek backward decompress the CAR file again from the start. Downloads of
+// a whole piece, and downloads resumed with a Range request, decompress the CAR file only once.
+type ReadSeeker struct {
+	source   io.ReadSeekCloser
+	decoder  *zstd.Decoder
+	size     int64
+	position int64 // The offset of the next byte of the decoder
+	offset   int64 // The offset of the next byte to read
+}
+
+// NewReadSeeker returns a ReadSeeker of the uncompressed content of a CAR file. The content is returned as is if the
+// compression is empty.
+//
+// Parameters:
+//   - source: The reader of the CAR file as it is stored. It is closed when the ReadSeeker is closed.
+//   - compression: The compression of the CAR file.
+//   - size: The size of the uncompressed CAR file.
+//
+// Returns:
+//   - The reader of the uncompressed CAR file.
+//   - ErrUnsupportedCompression if the compression is not supported.
+func NewReadSeeker(source io.ReadSeekCloser, compression model.CarCompression, size int64) (io.ReadSeekCloser, error) {
+	switch compression {
+	case model.CarCompressionNone:
+		return source, nil
+	case model.CarCompressionZstd:
+		decoder, err := zstd.NewReader(source, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return &ReadSeeker{
+			source:  source,
+			decoder: decoder,
+			size:    size,
+		}, nil
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedCompression, "%s", compression)
+	}
+}
+
+func (r *ReadSeeker) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+	if r.offset < r.position {
+		_, err := r.source.Seek(0, io.SeekStart)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		err = r.decoder.Reset(r.source)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		r.position = 0
+	}
+	if r.offset > r.position {
+		skipped, err := io.CopyN(io.Discard, r.decoder, r.offset-r.position)
+		r.position += skipped
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+	}
+	n, err := r.decoder.Read(p)
+	r.position += int64(n)
+	r.offset = r.position
+	return n, err
+}
+
+// Seek sets the offset of the next read. The CAR file is not decompressed until the next read.
+func (r *ReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.Newf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, ErrNegativeOffset
+	}
+	r.offset = offset
+	return offset, nil
+}
+
+func (r *ReadSeeker) Close() error {
+	r.decoder.Close()
+	return r.source.Close()
+}
@@ -0,0 +1,107 @@
+package compression
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type readSeekCloser struct {
+	*bytes.Reader
+	closed bool
+}
+
+func (r *readSeekCloser) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestCompress(t *testing.T) {
+	content := bytes.Repeat(testutil.GenerateRandomBytes(1000), 100)
+
+	reader, err := Compress(bytes.NewReader(content), model.CarCompressionNone)
+	require.NoError(t, err)
+	stored, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, content, stored)
+	require.EqualValues(t, len(content), reader.Size())
+	require.Empty(t, Extension(model.CarCompressionNone))
+
+	reader, err = Compress(bytes.NewReader(content), model.CarCompressionZstd)
+	require.NoError(t, err)
+	stored, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Less(t, len(stored), len(content)/10)
+	require.EqualValues(t, len(content), reader.Size())
+	require.Equal(t, ".zst", Extension(model.CarCompressionZstd))
+
+	decompressed, err := NewReader(bytes.NewReader(stored), model.CarCompressionZstd)
+	require.NoError(t, err)
+	defer decompressed.Close()
+	actual, err := io.ReadAll(decompressed)
+	require.NoError(t, err)
+	require.Equal(t, content, actual)
+
+	_, err = Compress(bytes.NewReader(content), "gzip")
+	require.ErrorIs(t, err, ErrUnsupportedCompression)
+	_, err = NewReader(bytes.NewReader(stored), "gzip")
+	require.ErrorIs(t, err, ErrUnsupportedCompression)
+}
+
+func TestCompress_StopReading(t *testing.T) {
+	reader, err := Compress(bytes.NewReader(testutil.GenerateRandomBytes(10_000_000)), model.CarCompressionZstd)
+	require.NoError(t, err)
+	_, err = reader.Read(make([]byte, 100))
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	_, err = reader.Read(make([]byte, 100))
+	require.ErrorIs(t, err, io.ErrClosedPipe)
+}
+
+func TestReadSeeker(t *testing.T) {
+	content := bytes.Repeat(testutil.GenerateRandomBytes(1000), 1000)
+	reader, err := Compress(bytes.NewReader(content), model.CarCompressionZstd)
+	require.NoError(t, err)
+	stored, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	source := &readSeekCloser{Reader: bytes.NewReader(stored)}
+	seeker, err := NewReadSeeker(source, model.CarCompressionZstd, int64(len(content)))
+	require.NoError(t, err)
+
+	size, err := seeker.Seek(0, io.SeekEnd)
+	require.NoError(t, err)
+	require.EqualValues(t, len(content), size)
+
+	for _, offset := range []int64{500_000, 10, 999_990, 0, 123_456} {
+		_, err = seeker.Seek(offset, io.SeekStart)
+		require.NoError(t, err)
+		buf := make([]byte, 10)
+		_, err = io.ReadFull(seeker, buf)
+		require.NoError(t, err)
+		require.Equal(t, content[offset:offset+10], buf)
+	}
+
+	offset, err := seeker.Seek(-100, io.SeekEnd)
+	require.NoError(t, err)
+	rest, err := io.ReadAll(seeker)
+	require.NoError(t, err)
+	require.Equal(t, content[offset:], rest)
+
+	_, err = seeker.Seek(-1, io.SeekStart)
+	require.ErrorIs(t, err, ErrNegativeOffset)
+
+	require.NoError(t, seeker.Close())
+	require.True(t, source.closed)
+
+	plain := &readSeekCloser{Reader: bytes.NewReader(content)}
+	seeker, err = NewReadSeeker(plain, model.CarCompressionNone, int64(len(content)))
+	require.NoError(t, err)
+	require.Same(t, plain, seeker)
+}
@@ -0,0 +1,99 @@
+package pack
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/daggen"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+)
+
+var ErrPathConflict = errors.New("path already exists in the directory with a different content")
+
+// SuffixedName returns the name of a file with a numbered suffix inserted before the extension,
+// i.e. "file.txt" becomes "file (1).txt". Names without an extension, or dot files, get the suffix at the end.
+func SuffixedName(name string, n int) string {
+	suffix := " (" + strconv.Itoa(n) + ")"
+	ext := path.Ext(name)
+	if ext == "" || ext == name {
+		return name + suffix
+	}
+	return strings.TrimSuffix(name, ext) + suffix + ext
+}
+
+// resolvePathConflict decides the name under which a file is added to its directory, according to the conflict policy
+// of the preparation. A conflict happens when the directory already has an entry with the same name but a different CID,
+// which is the case when a rescan has found a new version of a file that has already been packed.
+//
+// Parameters:
+//   - ctx: The context for database queries and directory lookups.
+//   - db: The database used to look up the other versions of the file.
+//   - policy: The conflict policy of the preparation. An empty policy is treated as overwrite.
+//   - dir: The directory the file is added to.
+//   - file: The file to be added.
+//
+// Returns:
+//   - The name to add the file under, or an empty string if the file should not be added because a newer version wins.
+//     With the overwrite policy, the file always replaces the existing entry, so the version packed last wins.
+//   - An error if the policy is error and there is a conflict, or if the lookups fail.
+func resolvePathConflict(
+	ctx context.Context,
+	db *gorm.DB,
+	policy model.ConflictPolicy,
+	dir *daggen.DirectoryData,
+	file model.File,
+) (string, error) {
+	name := file.FileName()
+	existing, err := dir.Child(ctx, name)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if !existing.Defined() || existing == cid.Cid(file.CID) {
+		return name, nil
+	}
+
+	switch policy {
+	case model.ConflictError:
+		return "", errors.Wrapf(ErrPathConflict, "%s already exists with CID %s, packing %s", file.Path, existing, cid.Cid(file.CID))
+	case model.ConflictKeepBoth:
+		for i := 1; ; i++ {
+			candidate := SuffixedName(name, i)
+			c, err := dir.Child(ctx, candidate)
+			if err != nil {
+				return "", errors.WithStack(err)
+			}
+			if !c.Defined() || c == cid.Cid(file.CID) {
+				logger.Infow("path conflict, keeping both versions", "path", file.Path, "name", candidate)
+				return candidate, nil
+			}
+		}
+	case model.ConflictNewest:
+		var other model.File
+		err = db.WithContext(ctx).
+			Where("directory_id = ? AND path = ? AND cid = ? AND id <> ?", *file.DirectoryID, file.Path, model.CID(existing), file.ID).
+			Order("last_modified_nano desc, id desc").
+			First(&other).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// The existing entry is not another version of this file
+			return name, nil
+		}
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		if other.LastModifiedNano > file.LastModifiedNano ||
+			(other.LastModifiedNano == file.LastModifiedNano && other.ID > file.ID) {
+			logger.Infow("path conflict, keeping the newer version", "path", file.Path, "file", other.ID, "skipped", file.ID)
+			return "", nil
+		}
+		logger.Infow("path conflict, replacing the older version", "path", file.Path, "file", file.ID, "replaced", other.ID)
+		return name, nil
+	default:
+		logger.Infow("path conflict, overwriting the existing entry", "path", file.Path, "file", file.ID, "replaced", existing)
+		return name, nil
+	}
+}
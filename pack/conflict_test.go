@@ -0,0 +1,109 @@
+package pack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/daggen"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestSuffixedName(t *testing.T) {
+	require.Equal(t, "file (1).txt", SuffixedName("file.txt", 1))
+	require.Equal(t, "archive.tar (2).gz", SuffixedName("archive.tar.gz", 2))
+	require.Equal(t, "README (1)", SuffixedName("README", 1))
+	require.Equal(t, ".bashrc (1)", SuffixedName(".bashrc", 1))
+}
+
+func TestResolvePathConflict(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		attachment := model.SourceAttachment{
+			Preparation: &model.Preparation{},
+			Storage:     &model.Storage{},
+		}
+		require.NoError(t, db.Create(&attachment).Error)
+		root := model.Directory{AttachmentID: attachment.ID}
+		require.NoError(t, db.Create(&root).Error)
+		older := model.File{
+			Path:             "sub/test.txt",
+			CID:              model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("old")))),
+			Size:             3,
+			LastModifiedNano: 100,
+			AttachmentID:     attachment.ID,
+			DirectoryID:      &root.ID,
+		}
+		newer := model.File{
+			Path:             "sub/test.txt",
+			CID:              model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("new")))),
+			Size:             3,
+			LastModifiedNano: 200,
+			AttachmentID:     attachment.ID,
+			DirectoryID:      &root.ID,
+		}
+		require.NoError(t, db.Create(&older).Error)
+		require.NoError(t, db.Create(&newer).Error)
+
+		setup := func(t *testing.T, existing model.File) *daggen.DirectoryData {
+			dir := daggen.NewDirectoryData()
+			require.NoError(t, dir.AddFile(ctx, existing.FileName(), cid.Cid(existing.CID), uint64(existing.Size)))
+			return &dir
+		}
+
+		t.Run("no conflict", func(t *testing.T) {
+			dir := daggen.NewDirectoryData()
+			name, err := resolvePathConflict(ctx, db, model.ConflictError, &dir, newer)
+			require.NoError(t, err)
+			require.Equal(t, "test.txt", name)
+		})
+
+		t.Run("same content", func(t *testing.T) {
+			name, err := resolvePathConflict(ctx, db, model.ConflictError, setup(t, newer), newer)
+			require.NoError(t, err)
+			require.Equal(t, "test.txt", name)
+		})
+
+		t.Run("newest replaces older version", func(t *testing.T) {
+			name, err := resolvePathConflict(ctx, db, model.ConflictNewest, setup(t, older), newer)
+			require.NoError(t, err)
+			require.Equal(t, "test.txt", name)
+		})
+
+		t.Run("newest keeps newer version", func(t *testing.T) {
+			name, err := resolvePathConflict(ctx, db, model.ConflictNewest, setup(t, newer), older)
+			require.NoError(t, err)
+			require.Empty(t, name)
+		})
+
+		t.Run("overwrite by default", func(t *testing.T) {
+			name, err := resolvePathConflict(ctx, db, "", setup(t, newer), older)
+			require.NoError(t, err)
+			require.Equal(t, "test.txt", name)
+			name, err = resolvePathConflict(ctx, db, model.ConflictOverwrite, setup(t, newer), older)
+			require.NoError(t, err)
+			require.Equal(t, "test.txt", name)
+		})
+
+		t.Run("keep both", func(t *testing.T) {
+			dir := setup(t, older)
+			name, err := resolvePathConflict(ctx, db, model.ConflictKeepBoth, dir, newer)
+			require.NoError(t, err)
+			require.Equal(t, "test (1).txt", name)
+			require.NoError(t, dir.AddFile(ctx, name, cid.Cid(newer.CID), uint64(newer.Size)))
+
+			// Packing the same version again reuses the suffixed name
+			name, err = resolvePathConflict(ctx, db, model.ConflictKeepBoth, dir, newer)
+			require.NoError(t, err)
+			require.Equal(t, "test (1).txt", name)
+		})
+
+		t.Run("error", func(t *testing.T) {
+			_, err := resolvePathConflict(ctx, db, model.ConflictError, setup(t, older), newer)
+			require.ErrorIs(t, err, ErrPathConflict)
+		})
+	})
+}
@@ -3,6 +3,7 @@ package daggen
 import (
 	"bytes"
 	"context"
+	"os"
 
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/model"
@@ -11,7 +12,6 @@ import (
 	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-cid"
 	format "github.com/ipfs/go-ipld-format"
-	"github.com/ipfs/go-merkledag"
 	uio "github.com/ipfs/go-unixfs/io"
 	"github.com/klauspost/compress/zstd"
 )
@@ -27,12 +27,14 @@ type DirectoryDetail struct {
 type DirectoryTree struct {
 	cache         map[model.DirectoryID]*DirectoryDetail
 	childrenCache map[model.DirectoryID][]model.DirectoryID // This is known children for this pack only
+	cidOptions    packutil.CidOptions                       // The CID options of the preparation, used for the directory nodes
 }
 
-func NewDirectoryTree() DirectoryTree {
+func NewDirectoryTree(cidOptions packutil.CidOptions) DirectoryTree {
 	return DirectoryTree{
 		cache:         make(map[model.DirectoryID]*DirectoryDetail),
 		childrenCache: make(map[model.DirectoryID][]model.DirectoryID),
+		cidOptions:    cidOptions,
 	}
 }
 
@@ -63,6 +65,7 @@ func (t DirectoryTree) Add(ctx context.Context, dir *model.Directory) error {
 	if err != nil {
 		return errors.WithStack(err)
 	}
+	data.SetCidOptions(t.cidOptions)
 	if dir.ParentID != nil {
 		t.childrenCache[*dir.ParentID] = append(t.childrenCache[*dir.ParentID], dir.ID)
 	}
@@ -73,9 +76,12 @@ func (t DirectoryTree) Add(ctx context.Context, dir *model.Directory) error {
 	return nil
 }
 
-// Resolve recursively constructs the IPLD (InterPlanetary Linked Data) structure for a directory and its subdirectories,
+// Resolve constructs the IPLD (InterPlanetary Linked Data) structure for a directory and its subdirectories,
 // and returns a link pointing to the root of this structure.
 //
+// The tree is walked in post-order with an explicit stack rather than recursion, so that each directory is
+// only built after all its children, and arbitrarily deep directory trees do not grow the goroutine stack.
+//
 // Parameters:
 //   - ctx: Context that allows for asynchronous task cancellation.
 //   - dirID: The ID of the directory that needs to be resolved.
@@ -84,35 +90,56 @@ func (t DirectoryTree) Add(ctx context.Context, dir *model.Directory) error {
 //   - *format.Link: A link that points to the root of the IPLD structure for the directory.
 //   - error: The error encountered during the operation, if any.
 func (t DirectoryTree) Resolve(ctx context.Context, dirID model.DirectoryID) (*format.Link, error) {
-	detail, ok := t.cache[dirID]
-	if !ok {
-		return nil, errors.Errorf("no directory detail for dir %d", dirID)
-	}
+	type frame struct {
+		dirID    model.DirectoryID
+		expanded bool
+	}
+	links := make(map[model.DirectoryID]*format.Link)
+	stack := []frame{{dirID: dirID}}
+	for len(stack) > 0 {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		top := &stack[len(stack)-1]
+		if !top.expanded {
+			top.expanded = true
+			children := t.childrenCache[top.dirID]
+			for i := len(children) - 1; i >= 0; i-- {
+				stack = append(stack, frame{dirID: children[i]})
+			}
+			continue
+		}
+		current := top.dirID
+		stack = stack[:len(stack)-1]
 
-	for _, child := range t.childrenCache[dirID] {
-		link, err := t.Resolve(ctx, child)
+		detail, ok := t.cache[current]
+		if !ok {
+			return nil, errors.Errorf("no directory detail for dir %d", current)
+		}
+		for _, child := range t.childrenCache[current] {
+			link := links[child]
+			delete(links, child)
+			err := detail.Data.AddFile(ctx, link.Name, link.Cid, link.Size)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to add child %d to directory", child)
+			}
+		}
+
+		node, err := detail.Data.Node()
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to resolve child %d", child)
+			return nil, errors.WithStack(err)
 		}
-		err = detail.Data.AddFile(ctx, link.Name, link.Cid, link.Size)
+		size, err := node.Size()
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to add child %d to directory", child)
+			return nil, errors.WithStack(err)
+		}
+		links[current] = &format.Link{
+			Name: detail.Dir.Name,
+			Size: size,
+			Cid:  node.Cid(),
 		}
 	}
-
-	node, err := detail.Data.Node()
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	size, err := node.Size()
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &format.Link{
-		Name: detail.Dir.Name,
-		Size: size,
-		Cid:  node.Cid(),
-	}, nil
+	return links[dirID], nil
 }
 
 // DirectoryData represents a structured directory in a content-addressed file system.
@@ -132,6 +159,7 @@ type DirectoryData struct {
 	node       format.Node
 	nodeDirty  bool
 	additional map[cid.Cid][]byte
+	cidOptions packutil.CidOptions
 }
 
 // Node retrieves the format.Node representation of the current DirectoryData.
@@ -171,15 +199,27 @@ func (d *DirectoryData) Node() (format.Node, error) {
 func NewDirectoryData() DirectoryData {
 	dagServ := NewRecordedDagService()
 	dir := uio.NewDirectory(dagServ)
-	dir.SetCidBuilder(merkledag.V1CidPrefix())
+	dir.SetCidBuilder(packutil.DefaultCidOptions.NodePrefix())
 	return DirectoryData{
 		dir:        dir,
 		nodeDirty:  true,
 		dagServ:    dagServ,
 		additional: make(map[cid.Cid][]byte),
+		cidOptions: packutil.DefaultCidOptions,
 	}
 }
 
+// SetCidOptions sets the CID options used for the directory node and the files assembled by AddFileFromLinks.
+// The directory keeps its current CID until it is modified.
+//
+// Parameters:
+//
+//   - cidOptions : The CID options of the preparation.
+func (d *DirectoryData) SetCidOptions(cidOptions packutil.CidOptions) {
+	d.cidOptions = cidOptions
+	d.dir.SetCidBuilder(cidOptions.NodePrefix())
+}
+
 // AddFile adds a new file to the directory with the specified name, content identifier (CID), and length.
 // It creates a new dummy node with the provided length and CID, and then adds this node as a child
 // to the current directory under the given name.
@@ -201,6 +241,47 @@ func (d *DirectoryData) AddFile(ctx context.Context, name string, c cid.Cid, len
 	return d.dir.AddChild(ctx, name, node)
 }
 
+// Child returns the CID of the entry with the given name in the directory.
+//
+// Parameters:
+//
+//   - ctx  : Context used to control cancellations or timeouts.
+//   - name : Name of the entry to look up.
+//
+// Returns:
+//
+//   - cid.Cid : Content Identifier (CID) of the entry, or cid.Undef if the directory has no entry with the name.
+//   - error   : An error is returned if the directory cannot be read.
+func (d *DirectoryData) Child(ctx context.Context, name string) (cid.Cid, error) {
+	node, err := d.dir.Find(ctx, name)
+	if errors.Is(err, os.ErrNotExist) {
+		return cid.Undef, nil
+	}
+	if err != nil {
+		return cid.Undef, errors.WithStack(err)
+	}
+	return node.Cid(), nil
+}
+
+// RemoveChild removes the entry with the given name from the directory.
+//
+// Parameters:
+//
+//   - ctx  : Context used to control cancellations or timeouts.
+//   - name : Name of the entry to be removed.
+//
+// Returns:
+//
+//	error  : An error is returned if the directory has no entry with the name or removing it fails, otherwise it returns nil.
+func (d *DirectoryData) RemoveChild(ctx context.Context, name string) error {
+	err := d.dir.RemoveChild(ctx, name)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	d.nodeDirty = true
+	return nil
+}
+
 // AddFileFromLinks constructs a new file from a set of links and adds it to the directory.
 // It first assembles the file from the provided links, then adds this file as a child to
 // the current directory with the specified name. The assembled file and its constituent
@@ -219,7 +300,7 @@ func (d *DirectoryData) AddFile(ctx context.Context, name string, c cid.Cid, len
 //     adding the child to the directory fails, or putting blocks into the blockstore fails.
 //     Otherwise, it returns nil.
 func (d *DirectoryData) AddFileFromLinks(ctx context.Context, name string, links []format.Link) (cid.Cid, error) {
-	blks, node, err := packutil.AssembleFileFromLinks(links)
+	blks, node, err := d.cidOptions.AssembleFileFromLinks(links)
 	if err != nil {
 		return cid.Undef, errors.WithStack(err)
 	}
@@ -386,12 +467,13 @@ func (d *DirectoryData) UnmarshalBinary(ctx context.Context, in []byte) error {
 	dagServ := NewRecordedDagService()
 	if len(in) == 0 {
 		dir := uio.NewDirectory(dagServ)
-		dir.SetCidBuilder(merkledag.V1CidPrefix())
+		dir.SetCidBuilder(packutil.DefaultCidOptions.NodePrefix())
 		*d = DirectoryData{
 			dir:        dir,
 			nodeDirty:  true,
 			dagServ:    dagServ,
 			additional: make(map[cid.Cid][]byte),
+			cidOptions: packutil.DefaultCidOptions,
 		}
 		return nil
 	}
@@ -433,13 +515,14 @@ func (d *DirectoryData) UnmarshalBinary(ctx context.Context, in []byte) error {
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	dir.SetCidBuilder(merkledag.V1CidPrefix())
+	dir.SetCidBuilder(packutil.DefaultCidOptions.NodePrefix())
 	*d = DirectoryData{
 		dir:        dir,
 		node:       root,
 		nodeDirty:  false,
 		dagServ:    dagServ,
 		additional: data.Additional,
+		cidOptions: packutil.DefaultCidOptions,
 	}
 	return nil
 }
@@ -187,6 +187,29 @@ func TestDirectoryData(t *testing.T) {
 	require.EqualValues(t, 213, size)
 }
 
+func TestDirectoryData_RemoveChild(t *testing.T) {
+	ctx := context.Background()
+	d := NewDirectoryData()
+	c := cid.NewCidV1(cid.Raw, util.Hash([]byte("test")))
+	err := d.AddFile(ctx, "test", c, 4)
+	require.NoError(t, err)
+	child, err := d.Child(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, c, child)
+
+	err = d.RemoveChild(ctx, "test")
+	require.NoError(t, err)
+	child, err = d.Child(ctx, "test")
+	require.NoError(t, err)
+	require.False(t, child.Defined())
+	root, err := d.Node()
+	require.NoError(t, err)
+	require.Empty(t, root.Links())
+
+	err = d.RemoveChild(ctx, "test")
+	require.Error(t, err)
+}
+
 func TestResolveDirectoryTree(t *testing.T) {
 	ctx := context.Background()
 	root := NewDirectoryData()
@@ -216,3 +239,33 @@ func TestResolveDirectoryTree(t *testing.T) {
 	require.Equal(t, "name", node.Links()[0].Name)
 	require.Equal(t, "test", node.Links()[1].Name)
 }
+
+func TestResolveDirectoryTree_Deep(t *testing.T) {
+	ctx := context.Background()
+	const depth = 5000
+	cache := make(map[model.DirectoryID]*DirectoryDetail)
+	children := make(map[model.DirectoryID][]model.DirectoryID)
+	for i := 1; i <= depth; i++ {
+		data := NewDirectoryData()
+		dir := &model.Directory{ID: model.DirectoryID(i), Name: "sub"}
+		if i > 1 {
+			dir.ParentID = ptr.Of(model.DirectoryID(i - 1))
+			children[model.DirectoryID(i-1)] = []model.DirectoryID{model.DirectoryID(i)}
+		}
+		cache[dir.ID] = &DirectoryDetail{Dir: dir, Data: &data}
+	}
+	err := cache[depth].Data.AddFile(ctx, "test", cid.NewCidV1(cid.Raw, util.Hash([]byte("test"))), 4)
+	require.NoError(t, err)
+
+	link, err := DirectoryTree{cache: cache, childrenCache: children}.Resolve(ctx, 1)
+	require.NoError(t, err)
+	root, err := cache[1].Data.Node()
+	require.NoError(t, err)
+	require.Equal(t, root.Cid(), link.Cid)
+	require.Len(t, root.Links(), 1)
+	leaf, err := cache[depth].Data.Node()
+	require.NoError(t, err)
+	parent, err := cache[depth-1].Data.Node()
+	require.NoError(t, err)
+	require.Equal(t, leaf.Cid(), parent.Links()[0].Cid)
+}
@@ -0,0 +1,382 @@
+// Package datasegment aggregates small pieces into a single large piece following the data segment index of
+// FRC-0058 (PODSI), so that datasets of small pieces can still fill a sector. The aggregate holds the payloads of the
+// pieces at aligned offsets, followed by an index of the pieces at the end of the deal, and the inclusion of each piece
+// can be proven against the piece CID of the aggregate, without the data of the aggregate.
+package datasegment
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"math/bits"
+
+	"github.com/cockroachdb/errors"
+	commcid "github.com/filecoin-project/go-fil-commcid"
+	"github.com/ipfs/go-cid"
+	sha256simd "github.com/minio/sha256-simd"
+)
+
+// EntrySize is the size of an entry of the data segment index, in padded bytes.
+const EntrySize = 64
+
+// nodeSize is the size of a node of the piece tree, which is also the size of a leaf in padded bytes.
+const nodeSize = 32
+
+// MinPieceSize is the smallest padded size of a piece.
+const MinPieceSize = 128
+
+var (
+	ErrInvalidPiece    = errors.New("invalid piece")
+	ErrInvalidDealSize = errors.New("invalid deal size")
+	ErrAggregateFull   = errors.New("aggregate is full")
+	ErrInvalidProof    = errors.New("invalid inclusion proof")
+	ErrPayloadTooLarge = errors.New("payload is larger than the piece")
+)
+
+// Node is a node of a piece tree, which is hex encoded in JSON.
+type Node [nodeSize]byte
+
+func (n Node) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(n[:])), nil
+}
+
+func (n *Node) UnmarshalText(text []byte) error {
+	decoded, err := hex.DecodeString(string(text))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if len(decoded) != nodeSize {
+		return errors.Newf("node must be %d bytes long, got %d bytes", nodeSize, len(decoded))
+	}
+	copy(n[:], decoded)
+	return nil
+}
+
+// zeroComms holds the root of a piece tree made of zeros for each level, where level l covers 2^l leaves.
+var zeroComms [64]Node
+
+func init() {
+	for l := 1; l < len(zeroComms); l++ {
+		zeroComms[l] = hashNodes(zeroComms[l-1], zeroComms[l-1])
+	}
+}
+
+// hashNodes returns the parent of two nodes of a piece tree, which is their SHA-256 truncated to 254 bits.
+func hashNodes(left, right Node) Node {
+	h := sha256simd.New()
+	h.Write(left[:])
+	h.Write(right[:])
+	var out Node
+	h.Sum(out[:0])
+	out[nodeSize-1] &= 0x3f
+	return out
+}
+
+// log2Ceil returns the smallest l such that 2^l >= x.
+func log2Ceil(x uint64) int {
+	if x <= 1 {
+		return 0
+	}
+	return bits.Len64(x - 1)
+}
+
+// unpaddedSize returns the number of payload bytes encoded in a padded size, which is a multiple of 128.
+func unpaddedSize(padded uint64) uint64 {
+	return padded - padded/128
+}
+
+// MaxIndexEntries returns the number of entries of the data segment index of a deal, which bounds the number of pieces
+// in an aggregate of that size.
+func MaxIndexEntries(dealSize uint64) int {
+	entries := 1 << log2Ceil(dealSize/2048/EntrySize)
+	if entries < 4 {
+		return 4
+	}
+	return entries
+}
+
+// IndexStart returns the padded offset of the data segment index in a deal, which takes the end of the deal.
+func IndexStart(dealSize uint64) uint64 {
+	return dealSize - uint64(MaxIndexEntries(dealSize))*EntrySize
+}
+
+// Piece is a piece to be included in an aggregate.
+type Piece struct {
+	CID  cid.Cid // CID is the piece CID.
+	Size uint64  // Size is the padded size of the piece.
+}
+
+// Segment is a piece placed in an aggregate.
+type Segment struct {
+	Piece
+	Offset uint64 // Offset is the padded offset of the piece in the aggregate.
+	commD  Node
+}
+
+// Entry returns the entry of the segment in the data segment index, which is made of the piece commitment, the
+// padded offset and size of the piece as little endian integers, and a checksum of those.
+func (s Segment) Entry() [EntrySize]byte {
+	var entry [EntrySize]byte
+	copy(entry[:nodeSize], s.commD[:])
+	binary.LittleEndian.PutUint64(entry[32:40], s.Offset)
+	binary.LittleEndian.PutUint64(entry[40:48], s.Size)
+	checksum := sha256simd.Sum256(entry[:48])
+	copy(entry[48:], checksum[:16])
+	entry[EntrySize-1] &= 0x3f
+	return entry
+}
+
+// Aggregate places pieces in a deal of a given size, along with the data segment index of the pieces.
+type Aggregate struct {
+	DealSize uint64
+	Segments []Segment
+	// end is the padded offset of the end of the last segment.
+	end uint64
+}
+
+// NewAggregate returns an empty aggregate for a deal of the given padded size.
+//
+// Parameters:
+//   - dealSize: The padded size of the aggregate piece, which must be a power of 2 large enough to hold the index.
+//
+// Returns:
+//   - The empty aggregate.
+//   - ErrInvalidDealSize if the deal size is not valid.
+func NewAggregate(dealSize uint64) (*Aggregate, error) {
+	if bits.OnesCount64(dealSize) != 1 || dealSize < 1024 {
+		return nil, errors.Wrapf(ErrInvalidDealSize, "deal size %d must be a power of 2 of at least 1KiB", dealSize)
+	}
+	return &Aggregate{DealSize: dealSize}, nil
+}
+
+// Add places a piece after the pieces already in the aggregate, at the next offset aligned to the size of the piece.
+// Pieces are best added from the largest to the smallest, so no space is wasted by the alignment.
+//
+// Parameters:
+//   - piece: The piece to add.
+//
+// Returns:
+//   - ErrInvalidPiece if the piece CID is not a piece commitment or the piece size is not valid.
+//   - ErrAggregateFull if the piece does not fit in the aggregate, or the index has no entry left.
+func (a *Aggregate) Add(piece Piece) error {
+	commD, err := commcid.CIDToDataCommitmentV1(piece.CID)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidPiece, "%s is not a piece CID: %s", piece.CID, err)
+	}
+	if bits.OnesCount64(piece.Size) != 1 || piece.Size < MinPieceSize {
+		return errors.Wrapf(ErrInvalidPiece, "piece size %d of %s is not a power of 2 of at least %d",
+			piece.Size, piece.CID, MinPieceSize)
+	}
+	if len(a.Segments) >= MaxIndexEntries(a.DealSize) {
+		return errors.Wrapf(ErrAggregateFull, "index of the aggregate is limited to %d pieces", len(a.Segments))
+	}
+	offset := (a.end + piece.Size - 1) / piece.Size * piece.Size
+	if offset+piece.Size > IndexStart(a.DealSize) {
+		return errors.Wrapf(ErrAggregateFull, "piece %s of size %d does not fit in the aggregate", piece.CID, piece.Size)
+	}
+	segment := Segment{Piece: piece, Offset: offset}
+	copy(segment.commD[:], commD)
+	a.Segments = append(a.Segments, segment)
+	a.end = offset + piece.Size
+	return nil
+}
+
+// PieceCID returns the piece CID of the aggregate.
+func (a *Aggregate) PieceCID() (cid.Cid, error) {
+	t := a.tree()
+	root := t.get(t.depth, 0)
+	return commcid.DataCommitmentV1ToCID(root[:])
+}
+
+// PayloadSize returns the size of the payload of the aggregate, which ends with the used entries of the index. The
+// zeros after the used entries are left out, as they do not change the piece CID.
+func (a *Aggregate) PayloadSize() int64 {
+	return int64(unpaddedSize(IndexStart(a.DealSize))) + int64(len(a.indexPayload()))
+}
+
+// indexPayload returns the payload of the used entries of the index, padded with empty entries to a whole number of
+// 128 padded bytes.
+func (a *Aggregate) indexPayload() []byte {
+	padded := make([]byte, (len(a.Segments)*EntrySize+127)/128*128)
+	for i, segment := range a.Segments {
+		entry := segment.Entry()
+		copy(padded[i*EntrySize:], entry[:])
+	}
+	return unpad(padded)
+}
+
+// unpad reverts the fr32 padding of the data, whose length is a multiple of 128. Each 128 padded bytes are made of 4
+// quarters of 254 bits of payload followed by 2 zero bits, which encode 127 bytes of payload.
+func unpad(padded []byte) []byte {
+	out := make([]byte, len(padded)/128*127)
+	for chunk := 0; chunk < len(padded)/128; chunk++ {
+		in := padded[chunk*128 : (chunk+1)*128]
+		dst := out[chunk*127 : (chunk+1)*127]
+		outBit := 0
+		for quarter := 0; quarter < 4; quarter++ {
+			for b := 0; b < 254; b++ {
+				inBit := quarter*256 + b
+				if in[inBit/8]>>(inBit%8)&1 != 0 {
+					dst[outBit/8] |= 1 << (outBit % 8)
+				}
+				outBit++
+			}
+		}
+	}
+	return out
+}
+
+// tree is the sparse piece tree of an aggregate, which only holds the nodes that are not made of zeros only.
+type tree struct {
+	depth int
+	// levels holds the nodes of each level by index, where level l covers 2^l leaves.
+	levels []map[uint64]Node
+}
+
+func (t *tree) get(level int, index uint64) Node {
+	node, ok := t.levels[level][index]
+	if !ok {
+		return zeroComms[level]
+	}
+	return node
+}
+
+// proof returns the path from a node to the root of the tree.
+func (t *tree) proof(level int, index uint64) ProofData {
+	proof := ProofData{Index: index}
+	for ; level < t.depth; level++ {
+		proof.Path = append(proof.Path, t.get(level, index^1))
+		index >>= 1
+	}
+	return proof
+}
+
+// tree computes the piece tree of the aggregate, from the piece commitments of the segments, which are subtrees of
+// the tree, and the entries of the index.
+func (a *Aggregate) tree() *tree {
+	t := &tree{depth: log2Ceil(a.DealSize / nodeSize)}
+	t.levels = make([]map[uint64]Node, t.depth+1)
+	for l := range t.levels {
+		t.levels[l] = make(map[uint64]Node)
+	}
+	for _, segment := range a.Segments {
+		level := log2Ceil(segment.Size / nodeSize)
+		t.levels[level][segment.Offset/segment.Size] = segment.commD
+	}
+	indexLeaf := IndexStart(a.DealSize) / nodeSize
+	for i, segment := range a.Segments {
+		entry := segment.Entry()
+		var left, right Node
+		copy(left[:], entry[:nodeSize])
+		copy(right[:], entry[nodeSize:])
+		t.levels[0][indexLeaf+uint64(2*i)] = left
+		t.levels[0][indexLeaf+uint64(2*i)+1] = right
+	}
+	for l := 0; l < t.depth; l++ {
+		for index := range t.levels[l] {
+			parent := index / 2
+			if _, ok := t.levels[l+1][parent]; ok {
+				continue
+			}
+			t.levels[l+1][parent] = hashNodes(t.get(l, parent*2), t.get(l, parent*2+1))
+		}
+	}
+	return t
+}
+
+// ProofData is a Merkle path from a node of a piece tree to its root.
+type ProofData struct {
+	Path  []Node `json:"path"`  // Path holds the sibling of the node at each level, from the node to the root.
+	Index uint64 `json:"index"` // Index is the index of the node at its level.
+}
+
+// ComputeRoot returns the root of the tree reached by following the path from the node.
+func (p ProofData) ComputeRoot(node Node) Node {
+	index := p.Index
+	for _, sibling := range p.Path {
+		if index&1 == 0 {
+			node = hashNodes(node, sibling)
+		} else {
+			node = hashNodes(sibling, node)
+		}
+		index >>= 1
+	}
+	return node
+}
+
+// InclusionProof proves that a piece is included in an aggregate, and is listed in its data segment index.
+type InclusionProof struct {
+	ProofSubtree ProofData `json:"proofSubtree"` // ProofSubtree is the path from the piece commitment to the aggregate.
+	ProofIndex   ProofData `json:"proofIndex"`   // ProofIndex is the path from the index entry of the piece to the aggregate.
+}
+
+// InclusionProofs returns the inclusion proof of each segment of the aggregate, in the order of the segments.
+func (a *Aggregate) InclusionProofs() []InclusionProof {
+	t := a.tree()
+	indexEntry := IndexStart(a.DealSize) / EntrySize
+	proofs := make([]InclusionProof, len(a.Segments))
+	for i, segment := range a.Segments {
+		proofs[i] = InclusionProof{
+			ProofSubtree: t.proof(log2Ceil(segment.Size/nodeSize), segment.Offset/segment.Size),
+			// An index entry is 2 leaves, so it is a node at level 1
+			ProofIndex: t.proof(1, indexEntry+uint64(i)),
+		}
+	}
+	return proofs
+}
+
+// VerifyInclusion verifies the inclusion proof of a piece in an aggregate.
+//
+// Parameters:
+//   - proof: The inclusion proof of the piece.
+//   - piece: The piece that is included.
+//   - aggregate: The aggregate piece, with its padded size as the deal size.
+//
+// Returns:
+//   - ErrInvalidProof if the proof does not prove the inclusion of the piece in the aggregate.
+func VerifyInclusion(proof InclusionProof, piece Piece, aggregate Piece) error {
+	commD, err := commcid.CIDToDataCommitmentV1(piece.CID)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidPiece, "%s is not a piece CID: %s", piece.CID, err)
+	}
+	aggregateCommD, err := commcid.CIDToDataCommitmentV1(aggregate.CID)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidPiece, "%s is not a piece CID: %s", aggregate.CID, err)
+	}
+	if bits.OnesCount64(piece.Size) != 1 || piece.Size < MinPieceSize || piece.Size > aggregate.Size {
+		return errors.Wrapf(ErrInvalidPiece, "invalid piece size %d", piece.Size)
+	}
+	depth := log2Ceil(aggregate.Size / nodeSize)
+
+	pieceLevel := log2Ceil(piece.Size / nodeSize)
+	if len(proof.ProofSubtree.Path) != depth-pieceLevel {
+		return errors.Wrapf(ErrInvalidProof, "subtree proof must have %d nodes, got %d",
+			depth-pieceLevel, len(proof.ProofSubtree.Path))
+	}
+	segment := Segment{Piece: piece, Offset: proof.ProofSubtree.Index * piece.Size}
+	copy(segment.commD[:], commD)
+	root := proof.ProofSubtree.ComputeRoot(segment.commD)
+	if !bytes.Equal(root[:], aggregateCommD) {
+		return errors.Wrap(ErrInvalidProof, "subtree proof does not match the aggregate")
+	}
+	if segment.Offset+segment.Size > IndexStart(aggregate.Size) {
+		return errors.Wrap(ErrInvalidProof, "piece overlaps the index of the aggregate")
+	}
+
+	if len(proof.ProofIndex.Path) != depth-1 {
+		return errors.Wrapf(ErrInvalidProof, "index proof must have %d nodes, got %d", depth-1, len(proof.ProofIndex.Path))
+	}
+	indexEntry := IndexStart(aggregate.Size) / EntrySize
+	if proof.ProofIndex.Index < indexEntry || proof.ProofIndex.Index >= aggregate.Size/EntrySize {
+		return errors.Wrap(ErrInvalidProof, "index proof is not for an entry of the index")
+	}
+	entry := segment.Entry()
+	var left, right Node
+	copy(left[:], entry[:nodeSize])
+	copy(right[:], entry[nodeSize:])
+	root = proof.ProofIndex.ComputeRoot(hashNodes(left, right))
+	if !bytes.Equal(root[:], aggregateCommD) {
+		return errors.Wrap(ErrInvalidProof, "index proof does not match the aggregate")
+	}
+	return nil
+}
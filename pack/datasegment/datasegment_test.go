@@ -0,0 +1,116 @@
+package datasegment
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	commcid "github.com/filecoin-project/go-fil-commcid"
+	commp "github.com/filecoin-project/go-fil-commp-hashhash"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+)
+
+func calculatePieceCID(t *testing.T, payload []byte, targetSize uint64) (cid.Cid, uint64) {
+	calc := &commp.Calc{}
+	_, err := calc.Write(payload)
+	require.NoError(t, err)
+	rawCommP, size, err := calc.Digest()
+	require.NoError(t, err)
+	if targetSize > size {
+		rawCommP, err = commp.PadCommP(rawCommP, size, targetSize)
+		require.NoError(t, err)
+		size = targetSize
+	}
+	pieceCID, err := commcid.DataCommitmentV1ToCID(rawCommP)
+	require.NoError(t, err)
+	return pieceCID, size
+}
+
+func TestAggregate(t *testing.T) {
+	// The second piece is padded to a larger size than its payload needs
+	payloads := [][]byte{
+		testutil.GenerateRandomBytes(9000),
+		testutil.GenerateRandomBytes(1000),
+		testutil.GenerateRandomBytes(3000),
+		testutil.GenerateRandomBytes(100),
+	}
+	targetSizes := []uint64{0, 4096, 0, 0}
+	aggregate, err := NewAggregate(64 << 10)
+	require.NoError(t, err)
+	var pieces []Piece
+	for i, payload := range payloads {
+		pieceCID, size := calculatePieceCID(t, payload, targetSizes[i])
+		pieces = append(pieces, Piece{CID: pieceCID, Size: size})
+		require.NoError(t, aggregate.Add(pieces[i]))
+	}
+	require.EqualValues(t, 0, aggregate.Segments[0].Offset)
+	require.EqualValues(t, 16384, aggregate.Segments[1].Offset)
+	require.EqualValues(t, 20480, aggregate.Segments[2].Offset)
+	require.EqualValues(t, 24576, aggregate.Segments[3].Offset)
+
+	// The piece CID of the aggregate matches the piece CID of its payload
+	pieceCID, err := aggregate.PieceCID()
+	require.NoError(t, err)
+	reader := aggregate.NewReader(func(i int) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(payloads[i])), nil
+	})
+	payload, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Len(t, payload, int(aggregate.PayloadSize()))
+	require.Equal(t, payloads[1], payload[16384/128*127:16384/128*127+1000])
+	payloadPieceCID, _ := calculatePieceCID(t, payload, 64<<10)
+	require.Equal(t, payloadPieceCID, pieceCID)
+
+	// Each piece can be proven to be in the aggregate
+	proofs := aggregate.InclusionProofs()
+	require.Len(t, proofs, len(pieces))
+	aggregatePiece := Piece{CID: pieceCID, Size: 64 << 10}
+	for i, proof := range proofs {
+		require.NoError(t, VerifyInclusion(proof, pieces[i], aggregatePiece))
+	}
+	require.ErrorIs(t, VerifyInclusion(proofs[0], pieces[1], aggregatePiece), ErrInvalidProof)
+	tampered := proofs[2]
+	tampered.ProofIndex.Path = append([]Node{{1}}, tampered.ProofIndex.Path[1:]...)
+	require.ErrorIs(t, VerifyInclusion(tampered, pieces[2], aggregatePiece), ErrInvalidProof)
+
+	// Proofs survive a round trip through JSON
+	encoded, err := json.Marshal(proofs[3])
+	require.NoError(t, err)
+	var decoded InclusionProof
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.NoError(t, VerifyInclusion(decoded, pieces[3], aggregatePiece))
+}
+
+func TestAggregate_Full(t *testing.T) {
+	pieceCID, _ := calculatePieceCID(t, testutil.GenerateRandomBytes(100), 0)
+	_, err := NewAggregate(3000)
+	require.ErrorIs(t, err, ErrInvalidDealSize)
+
+	aggregate, err := NewAggregate(2048)
+	require.NoError(t, err)
+	require.Equal(t, 4, MaxIndexEntries(2048))
+	require.ErrorIs(t, aggregate.Add(Piece{CID: pieceCID, Size: 100}), ErrInvalidPiece)
+	require.ErrorIs(t, aggregate.Add(Piece{CID: cid.NewCidV1(cid.Raw, pieceCID.Hash()), Size: 128}), ErrInvalidPiece)
+	require.ErrorIs(t, aggregate.Add(Piece{CID: pieceCID, Size: 2048}), ErrAggregateFull)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, aggregate.Add(Piece{CID: pieceCID, Size: 128}))
+	}
+	require.ErrorIs(t, aggregate.Add(Piece{CID: pieceCID, Size: 128}), ErrAggregateFull)
+}
+
+func TestAggregate_PayloadTooLarge(t *testing.T) {
+	payload := testutil.GenerateRandomBytes(200)
+	pieceCID, size := calculatePieceCID(t, payload, 0)
+	aggregate, err := NewAggregate(2048)
+	require.NoError(t, err)
+	require.NoError(t, aggregate.Add(Piece{CID: pieceCID, Size: size}))
+	reader := aggregate.NewReader(func(i int) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(testutil.GenerateRandomBytes(int(size)))), nil
+	})
+	_, err = io.ReadAll(reader)
+	require.ErrorIs(t, err, ErrPayloadTooLarge)
+}
@@ -0,0 +1,151 @@
+package datasegment
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/cockroachdb/errors"
+)
+
+// OpenFunc opens the payload of the segment at the given position in the aggregate.
+type OpenFunc func(i int) (io.ReadCloser, error)
+
+// NewReader returns the payload of the aggregate: the payload of each segment at its unpadded offset, followed by
+// zeros up to the next segment, and the used entries of the index at the unpadded offset of the index. The payload of
+// each segment is only opened once it is reached.
+//
+// Parameters:
+//   - open: The function that opens the payload of a segment, which must not be larger than the unpadded size of the
+//     piece, and must have the piece CID of the piece once padded with zeros to the size of the piece.
+//
+// Returns:
+//   - The reader of the payload of the aggregate, which fails with ErrPayloadTooLarge if the payload of a segment is
+//     larger than its piece.
+func (a *Aggregate) NewReader(open OpenFunc) io.ReadCloser {
+	return &reader{aggregate: a, open: open, index: -1}
+}
+
+type reader struct {
+	aggregate *Aggregate
+	open      OpenFunc
+	// index is the position of the current segment, or len(Segments) once the index is being read.
+	index int
+	// current is the payload of the current segment, or nil once it has been read to the end.
+	current io.ReadCloser
+	opened  bool
+	// read is the number of bytes read from the current part, which is either a segment with the zeros that follow
+	// it, or the index.
+	read int64
+	// size is the size of the current part.
+	size int64
+	// payloadSize is the size of the payload of the current segment, so the zeros that follow can be told apart.
+	payloadSize int64
+	indexReader io.Reader
+}
+
+// nextPart moves on to the next segment, or to the index after the last segment.
+func (r *reader) nextPart() {
+	r.index++
+	r.read = 0
+	r.opened = false
+	if r.index < len(r.aggregate.Segments) {
+		r.payloadSize = int64(unpaddedSize(r.aggregate.Segments[r.index].Size))
+		end := IndexStart(r.aggregate.DealSize)
+		if r.index+1 < len(r.aggregate.Segments) {
+			end = r.aggregate.Segments[r.index+1].Offset
+		}
+		r.size = int64(unpaddedSize(end) - unpaddedSize(r.aggregate.Segments[r.index].Offset))
+		return
+	}
+	payload := r.aggregate.indexPayload()
+	r.size = int64(len(payload))
+	r.indexReader = bytes.NewReader(payload)
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if r.index < 0 {
+		r.nextPart()
+	}
+	for r.read >= r.size {
+		err := r.closeCurrent()
+		if err != nil {
+			return 0, err
+		}
+		if r.index >= len(r.aggregate.Segments) {
+			return 0, io.EOF
+		}
+		r.nextPart()
+	}
+	if int64(len(p)) > r.size-r.read {
+		p = p[:r.size-r.read]
+	}
+
+	if r.index >= len(r.aggregate.Segments) {
+		n, err := r.indexReader.Read(p)
+		r.read += int64(n)
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+		return n, err
+	}
+
+	if !r.opened {
+		r.opened = true
+		current, err := r.open(r.index)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to open piece %s", r.aggregate.Segments[r.index].CID)
+		}
+		r.current = current
+	}
+
+	if r.current != nil {
+		n, err := r.current.Read(p)
+		r.read += int64(n)
+		if r.read > r.payloadSize {
+			return n, errors.Wrapf(ErrPayloadTooLarge, "payload of piece %s is larger than %d bytes",
+				r.aggregate.Segments[r.index].CID, r.payloadSize)
+		}
+		if errors.Is(err, io.EOF) {
+			err = r.current.Close()
+			r.current = nil
+			if err != nil {
+				return n, errors.WithStack(err)
+			}
+			return n, nil
+		}
+		return n, err
+	}
+
+	// Zeros up to the next segment
+	for i := range p {
+		p[i] = 0
+	}
+	r.read += int64(len(p))
+	return len(p), nil
+}
+
+// closeCurrent closes the payload of the current segment once the segment and the zeros that follow have been read,
+// after making sure that the payload has no byte left.
+func (r *reader) closeCurrent() error {
+	if r.current == nil {
+		return nil
+	}
+	n, err := r.current.Read(make([]byte, 1))
+	if n > 0 {
+		return errors.Wrapf(ErrPayloadTooLarge, "payload of piece %s is larger than %d bytes",
+			r.aggregate.Segments[r.index].CID, r.payloadSize)
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return errors.WithStack(err)
+	}
+	return r.Close()
+}
+
+func (r *reader) Close() error {
+	if r.current == nil {
+		return nil
+	}
+	err := r.current.Close()
+	r.current = nil
+	return errors.WithStack(err)
+}
@@ -0,0 +1,116 @@
+package encryption
+
+import (
+	"bytes"
+	"io"
+
+	"filippo.io/age"
+	"github.com/cockroachdb/errors"
+)
+
+// ErrInvalidRecipient is returned when an encryption recipient is not an age X25519 public key.
+var ErrInvalidRecipient = errors.New("invalid encryption recipient")
+
+// ErrNoRecipient is returned when items are to be encrypted without any recipient.
+var ErrNoRecipient = errors.New("at least one encryption recipient is required")
+
+// itemReadSize is how much of the item is read, and encrypted, at a time.
+const itemReadSize = 64 * 1024
+
+// ParseRecipients parses the age X25519 public keys, i.e. age1..., that items are encrypted for.
+//
+// Parameters:
+//   - recipients: The public keys, as generated by age-keygen.
+//
+// Returns:
+//   - The parsed recipients, in the same order.
+//   - An error if any of the public keys is invalid, or if there is none.
+func ParseRecipients(recipients []string) ([]age.Recipient, error) {
+	if len(recipients) == 0 {
+		return nil, errors.WithStack(ErrNoRecipient)
+	}
+	parsed := make([]age.Recipient, 0, len(recipients))
+	seen := make(map[string]struct{}, len(recipients))
+	for _, recipient := range recipients {
+		r, err := age.ParseX25519Recipient(recipient)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidRecipient, "%s: %s", recipient, err)
+		}
+		if _, ok := seen[recipient]; ok {
+			return nil, errors.Wrapf(ErrInvalidRecipient, "%s is listed more than once", recipient)
+		}
+		seen[recipient] = struct{}{}
+		parsed = append(parsed, r)
+	}
+	return parsed, nil
+}
+
+// ItemReader encrypts an item, i.e. a file or a range of a file, into an age file as it is read. Each item is
+// encrypted with its own ephemeral file key, which is wrapped for every recipient in the header of the age file, so
+// the item can be decrypted on its own with the identity of any of the recipients, i.e. with age -d.
+type ItemReader struct {
+	source  io.Reader
+	writer  io.WriteCloser
+	buffer  bytes.Buffer
+	chunk   []byte
+	size    int64
+	read    int64
+	flushed bool
+}
+
+// NewItemReader returns a reader of the age encryption of the item for the recipients. Only a chunk of the item is
+// held in memory at a time.
+func NewItemReader(r io.Reader, recipients []age.Recipient) (*ItemReader, error) {
+	if len(recipients) == 0 {
+		return nil, errors.WithStack(ErrNoRecipient)
+	}
+	reader := &ItemReader{source: r, chunk: make([]byte, itemReadSize)}
+	writer, err := age.Encrypt(&reader.buffer, recipients...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	reader.writer = writer
+	return reader, nil
+}
+
+func (r *ItemReader) Read(p []byte) (int, error) {
+	for r.buffer.Len() == 0 {
+		if r.flushed {
+			return 0, io.EOF
+		}
+		n, err := r.source.Read(r.chunk)
+		r.read += int64(n)
+		if n > 0 {
+			_, writeErr := r.writer.Write(r.chunk[:n])
+			if writeErr != nil {
+				return 0, errors.WithStack(writeErr)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			// Closing the writer seals the last chunk of the payload
+			err = r.writer.Close()
+			if err != nil {
+				return 0, errors.WithStack(err)
+			}
+			r.flushed = true
+			continue
+		}
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+	}
+	n, _ := r.buffer.Read(p)
+	r.size += int64(n)
+	return n, nil
+}
+
+// Size returns the number of encrypted bytes read so far, which is the size of the age file once the reader
+// returned io.EOF.
+func (r *ItemReader) Size() int64 {
+	return r.size
+}
+
+// PlaintextSize returns the number of bytes of the item read so far.
+func (r *ItemReader) PlaintextSize() int64 {
+	return r.read
+}
@@ -0,0 +1,72 @@
+package encryption
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecipients(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	recipient := identity.Recipient().String()
+
+	parsed, err := ParseRecipients([]string{recipient})
+	require.NoError(t, err)
+	require.Len(t, parsed, 1)
+
+	_, err = ParseRecipients(nil)
+	require.ErrorIs(t, err, ErrNoRecipient)
+	_, err = ParseRecipients([]string{"age1invalid"})
+	require.ErrorIs(t, err, ErrInvalidRecipient)
+	_, err = ParseRecipients([]string{recipient, recipient})
+	require.ErrorIs(t, err, ErrInvalidRecipient)
+}
+
+func TestItemReader(t *testing.T) {
+	alice, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	bob, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	eve, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	recipients := []age.Recipient{alice.Recipient(), bob.Recipient()}
+
+	for _, size := range []int{0, 1, itemReadSize, 3*itemReadSize + 7} {
+		plaintext := bytes.Repeat([]byte{'a'}, size)
+		reader, err := NewItemReader(iotest.HalfReader(bytes.NewReader(plaintext)), recipients)
+		require.NoError(t, err)
+		ciphertext, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.EqualValues(t, len(ciphertext), reader.Size())
+		require.EqualValues(t, size, reader.PlaintextSize())
+
+		for _, identity := range []age.Identity{alice, bob} {
+			decrypted, err := age.Decrypt(bytes.NewReader(ciphertext), identity)
+			require.NoError(t, err)
+			result, err := io.ReadAll(decrypted)
+			require.NoError(t, err)
+			require.Equal(t, plaintext, result)
+		}
+		_, err = age.Decrypt(bytes.NewReader(ciphertext), eve)
+		require.Error(t, err)
+	}
+
+	// Each item has its own file key
+	first, err := NewItemReader(bytes.NewReader([]byte("same")), recipients)
+	require.NoError(t, err)
+	second, err := NewItemReader(bytes.NewReader([]byte("same")), recipients)
+	require.NoError(t, err)
+	firstCiphertext, err := io.ReadAll(first)
+	require.NoError(t, err)
+	secondCiphertext, err := io.ReadAll(second)
+	require.NoError(t, err)
+	require.NotEqual(t, firstCiphertext, secondCiphertext)
+
+	_, err = NewItemReader(bytes.NewReader(nil), nil)
+	require.ErrorIs(t, err, ErrNoRecipient)
+}
@@ -0,0 +1,63 @@
+package encryption
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/cockroachdb/errors"
+)
+
+// awsKMS generates and unwraps data keys with a key of AWS KMS.
+type awsKMS struct {
+	client kmsiface.KMSAPI
+	keyID  string
+}
+
+// openAWSKMS opens a key of AWS KMS by its ID, ARN or alias. The credentials are loaded from the environment or the
+// shared AWS configuration, and the region defaults to the region of the ARN of the key.
+func openAWSKMS(_ context.Context, keyID string) (KMS, error) {
+	config := aws.NewConfig()
+	// ARNs are in the form of arn:aws:kms:<region>:<account>:key/<id>
+	parts := strings.Split(keyID, ":")
+	if len(parts) > 3 && parts[0] == "arn" {
+		config = config.WithRegion(parts[3])
+	}
+	return newAWSKMS(keyID, config)
+}
+
+func newAWSKMS(keyID string, config *aws.Config) (*awsKMS, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *config,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AWS session")
+	}
+	return &awsKMS{client: kms.New(sess), keyID: keyID}, nil
+}
+
+func (k *awsKMS) GenerateDataKey(ctx context.Context) ([]byte, []byte, error) {
+	output, err := k.client.GenerateDataKeyWithContext(ctx, &kms.GenerateDataKeyInput{
+		KeyId:   aws.String(k.keyID),
+		KeySpec: aws.String(kms.DataKeySpecAes256),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate data key with AWS KMS")
+	}
+	return output.Plaintext, output.CiphertextBlob, nil
+}
+
+func (k *awsKMS) Decrypt(ctx context.Context, wrapped []byte) ([]byte, error) {
+	output, err := k.client.DecryptWithContext(ctx, &kms.DecryptInput{
+		KeyId:          aws.String(k.keyID),
+		CiphertextBlob: wrapped,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt data key with AWS KMS")
+	}
+	return output.Plaintext, nil
+}
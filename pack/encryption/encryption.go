@@ -0,0 +1,134 @@
+// Package encryption encrypts whole CAR files with a key per piece. Each piece key is wrapped for the data owner's
+// public key, so the wrapped keys can be stored in the database and exported to the data owner while only the owner,
+// who holds the private key, can decrypt the pieces.
+//
+// Items, i.e. files or ranges of files, can also be encrypted one by one into age files as they are packed, for the
+// age X25519 recipients of the preparation.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// KeySize is the size of a piece key, for AES-256.
+const KeySize = 32
+
+var ErrInvalidKey = errors.New("invalid key")
+
+// ErrUnwrapFailed is returned when a wrapped key cannot be opened with the given identity.
+var ErrUnwrapFailed = errors.New("failed to unwrap key, the identity does not match the recipient")
+
+// GenerateIdentity generates a new key pair for the data owner.
+//
+// Returns:
+//   - The identity, which is the base64 encoded private key to be kept by the data owner.
+//   - The recipient, which is the base64 encoded public key that pieces are encrypted for.
+//   - An error if the key pair cannot be generated.
+func GenerateIdentity() (identity string, recipient string, err error) {
+	public, private, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", errors.WithStack(err)
+	}
+	return base64.StdEncoding.EncodeToString(private[:]), base64.StdEncoding.EncodeToString(public[:]), nil
+}
+
+// ParseKey decodes a base64 encoded identity or recipient.
+func ParseKey(s string) (*[32]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(decoded) != 32 {
+		return nil, errors.Wrap(ErrInvalidKey, "expecting a base64 encoded 32 bytes key")
+	}
+	var key [32]byte
+	copy(key[:], decoded)
+	return &key, nil
+}
+
+// NewPieceKey generates a random key for a piece and wraps it for the recipient.
+//
+// Parameters:
+//   - recipient: The base64 encoded public key of the data owner.
+//
+// Returns:
+//   - The piece key used to encrypt the piece.
+//   - The wrapped key, which can only be unwrapped with the identity of the recipient.
+//   - An error if the recipient is invalid or the key cannot be generated.
+func NewPieceKey(recipient string) (key []byte, wrapped []byte, err error) {
+	public, err := ParseKey(recipient)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	key = make([]byte, KeySize)
+	_, err = io.ReadFull(rand.Reader, key)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	wrapped, err = box.SealAnonymous(nil, key, public, rand.Reader)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	return key, wrapped, nil
+}
+
+// UnwrapKey unwraps a piece key with the identity of the recipient it was wrapped for.
+func UnwrapKey(wrapped []byte, identity string) ([]byte, error) {
+	private, err := ParseKey(identity)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	derived, err := curve25519.X25519(private[:], curve25519.Basepoint)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidKey, err.Error())
+	}
+	var public [32]byte
+	copy(public[:], derived)
+	key, ok := box.OpenAnonymous(nil, wrapped, &public, private)
+	if !ok || len(key) != KeySize {
+		return nil, errors.WithStack(ErrUnwrapFailed)
+	}
+	return key, nil
+}
+
+// NewReader returns a reader that encrypts, or decrypts, the stream with AES-256 in CTR mode. The IV is zero as each
+// piece key is only ever used for a single piece. CTR mode keeps the size of the CAR file unchanged.
+func NewReader(r io.Reader, key []byte) (io.Reader, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidKey, err.Error())
+	}
+	iv := make([]byte, aes.BlockSize)
+	return &cipher.StreamReader{S: cipher.NewCTR(block, iv), R: r}, nil
+}
+
+// Encrypt encrypts the stream with a new piece key wrapped for the recipient. The stream is returned as is if the
+// recipient is empty, i.e. the preparation does not encrypt its pieces.
+//
+// Parameters:
+//   - r: The CAR file stream to encrypt.
+//   - recipient: The base64 encoded public key of the data owner, or empty.
+//
+// Returns:
+//   - The encrypted stream.
+//   - The wrapped piece key, or nil if the stream is not encrypted.
+//   - An error if the recipient is invalid or the piece key cannot be generated.
+func Encrypt(r io.Reader, recipient string) (io.Reader, []byte, error) {
+	if recipient == "" {
+		return r, nil, nil
+	}
+	key, wrapped, err := NewPieceKey(recipient)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate piece key")
+	}
+	encrypted, err := NewReader(r, key)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	return encrypted, wrapped, nil
+}
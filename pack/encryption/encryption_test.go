@@ -0,0 +1,55 @@
+package encryption
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncrypt(t *testing.T) {
+	identity, recipient, err := GenerateIdentity()
+	require.NoError(t, err)
+	plaintext := bytes.Repeat([]byte("hello world"), 1000)
+
+	encrypted, wrapped, err := Encrypt(bytes.NewReader(plaintext), recipient)
+	require.NoError(t, err)
+	require.NotEmpty(t, wrapped)
+	ciphertext, err := io.ReadAll(encrypted)
+	require.NoError(t, err)
+	require.Len(t, ciphertext, len(plaintext))
+	require.NotEqual(t, plaintext, ciphertext)
+
+	key, err := UnwrapKey(wrapped, identity)
+	require.NoError(t, err)
+	decrypted, err := NewReader(bytes.NewReader(ciphertext), key)
+	require.NoError(t, err)
+	result, err := io.ReadAll(decrypted)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, result)
+
+	otherIdentity, _, err := GenerateIdentity()
+	require.NoError(t, err)
+	_, err = UnwrapKey(wrapped, otherIdentity)
+	require.ErrorIs(t, err, ErrUnwrapFailed)
+}
+
+func TestEncrypt_NoRecipient(t *testing.T) {
+	r := bytes.NewReader([]byte("hello"))
+	encrypted, wrapped, err := Encrypt(r, "")
+	require.NoError(t, err)
+	require.Nil(t, wrapped)
+	require.Equal(t, r, encrypted)
+}
+
+func TestParseKey(t *testing.T) {
+	_, err := ParseKey("not base64")
+	require.ErrorIs(t, err, ErrInvalidKey)
+	_, err = ParseKey("aGVsbG8=")
+	require.ErrorIs(t, err, ErrInvalidKey)
+	_, recipient, err := GenerateIdentity()
+	require.NoError(t, err)
+	_, err = ParseKey(recipient)
+	require.NoError(t, err)
+}
@@ -0,0 +1,188 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"io"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrDecryptFailed is returned when an encrypted item is truncated, reordered or modified, or is decrypted with the
+// wrong data key.
+var ErrDecryptFailed = errors.New("failed to decrypt item")
+
+// gcmSegmentSize is the size of the plaintext of each segment of an item encrypted with AES-256-GCM.
+const gcmSegmentSize = 64 * 1024
+
+// gcmOverhead is the size of the authentication tag that each segment grows by.
+const gcmOverhead = 16
+
+// newGCM returns the AES-256-GCM cipher of a data key.
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, errors.Wrapf(ErrInvalidKey, "expecting a %d bytes data key", KeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidKey, err.Error())
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return aead, nil
+}
+
+// gcmNonce returns the nonce of a segment: the counter of the segment in big endian, followed by a byte that is 1 for
+// the last segment, so segments cannot be reordered and the item cannot be truncated at a segment boundary. The
+// nonces only need to be unique for a data key, as each data key only encrypts a single item.
+func gcmNonce(counter uint64, last bool) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce[3:11], counter)
+	if last {
+		nonce[11] = 1
+	}
+	return nonce
+}
+
+// segmentReader reads a stream in segments of a fixed size, and tells whether a segment is the last one by reading
+// one byte ahead.
+type segmentReader struct {
+	source    io.Reader
+	size      int
+	lookahead []byte
+	done      bool
+}
+
+// next returns the next segment and whether it is the last one. The last segment may be empty, if the stream is.
+func (s *segmentReader) next(buffer []byte) ([]byte, bool, error) {
+	segment := append(buffer[:0], s.lookahead...)
+	s.lookahead = s.lookahead[:0]
+	n, err := io.ReadFull(s.source, segment[len(segment):s.size])
+	segment = segment[:len(segment)+n]
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		s.done = true
+		return segment, true, nil
+	}
+	if err != nil {
+		return nil, false, errors.WithStack(err)
+	}
+	var one [1]byte
+	n, err = io.ReadFull(s.source, one[:])
+	if errors.Is(err, io.EOF) {
+		s.done = true
+		return segment, true, nil
+	}
+	if err != nil {
+		return nil, false, errors.WithStack(err)
+	}
+	s.lookahead = append(s.lookahead, one[:n]...)
+	return segment, false, nil
+}
+
+// GCMItemReader encrypts an item with AES-256-GCM under its own data key as it is read. The item is split into
+// segments of 64KiB, each sealed with a nonce made of its counter and whether it is the last segment, so only a
+// segment is held in memory at a time, and the encrypted item can be decrypted with NewGCMDecryptReader and the data
+// key.
+type GCMItemReader struct {
+	aead     cipher.AEAD
+	segments segmentReader
+	plain    []byte
+	sealed   []byte
+	pending  []byte
+	counter  uint64
+	size     int64
+	read     int64
+}
+
+// NewGCMItemReader returns a reader of the AES-256-GCM encryption of the item with the data key.
+func NewGCMItemReader(r io.Reader, key []byte) (*GCMItemReader, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &GCMItemReader{
+		aead:     aead,
+		segments: segmentReader{source: r, size: gcmSegmentSize, lookahead: make([]byte, 0, 1)},
+		plain:    make([]byte, gcmSegmentSize),
+		sealed:   make([]byte, 0, gcmSegmentSize+gcmOverhead),
+	}, nil
+}
+
+func (r *GCMItemReader) Read(p []byte) (int, error) {
+	if len(r.pending) == 0 {
+		if r.segments.done {
+			return 0, io.EOF
+		}
+		segment, last, err := r.segments.next(r.plain)
+		if err != nil {
+			return 0, err
+		}
+		r.read += int64(len(segment))
+		r.pending = r.aead.Seal(r.sealed[:0], gcmNonce(r.counter, last), segment, nil)
+		r.counter++
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	r.size += int64(n)
+	return n, nil
+}
+
+// Size returns the number of encrypted bytes read so far, which is the size of the encrypted item once the reader
+// returned io.EOF.
+func (r *GCMItemReader) Size() int64 {
+	return r.size
+}
+
+// PlaintextSize returns the number of bytes of the item read so far.
+func (r *GCMItemReader) PlaintextSize() int64 {
+	return r.read
+}
+
+// gcmDecryptReader decrypts an item encrypted by GCMItemReader.
+type gcmDecryptReader struct {
+	aead     cipher.AEAD
+	segments segmentReader
+	sealed   []byte
+	plain    []byte
+	pending  []byte
+	counter  uint64
+}
+
+// NewGCMDecryptReader returns a reader of the plaintext of an item encrypted by GCMItemReader with the data key. Each
+// segment is authenticated before it is returned, and a reader of a truncated item returns ErrDecryptFailed rather
+// than io.EOF.
+func NewGCMDecryptReader(r io.Reader, key []byte) (io.Reader, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &gcmDecryptReader{
+		aead:     aead,
+		segments: segmentReader{source: r, size: gcmSegmentSize + gcmOverhead, lookahead: make([]byte, 0, 1)},
+		sealed:   make([]byte, gcmSegmentSize+gcmOverhead),
+		plain:    make([]byte, 0, gcmSegmentSize),
+	}, nil
+}
+
+func (r *gcmDecryptReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.segments.done {
+			return 0, io.EOF
+		}
+		segment, last, err := r.segments.next(r.sealed)
+		if err != nil {
+			return 0, err
+		}
+		r.pending, err = r.aead.Open(r.plain[:0], gcmNonce(r.counter, last), segment, nil)
+		if err != nil {
+			return 0, errors.Wrapf(ErrDecryptFailed, "segment %d cannot be authenticated", r.counter)
+		}
+		r.counter++
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
@@ -0,0 +1,77 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGCMItemReader(t *testing.T) {
+	key := make([]byte, KeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	otherKey := make([]byte, KeySize)
+	_, err = rand.Read(otherKey)
+	require.NoError(t, err)
+
+	for _, size := range []int{0, 1, gcmSegmentSize, 3*gcmSegmentSize + 7} {
+		plaintext := make([]byte, size)
+		_, err = rand.Read(plaintext)
+		require.NoError(t, err)
+		reader, err := NewGCMItemReader(iotest.HalfReader(bytes.NewReader(plaintext)), key)
+		require.NoError(t, err)
+		ciphertext, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		segments := (size + gcmSegmentSize - 1) / gcmSegmentSize
+		if segments == 0 {
+			segments = 1
+		}
+		require.Len(t, ciphertext, size+segments*gcmOverhead)
+		require.EqualValues(t, len(ciphertext), reader.Size())
+		require.EqualValues(t, size, reader.PlaintextSize())
+
+		decrypter, err := NewGCMDecryptReader(iotest.HalfReader(bytes.NewReader(ciphertext)), key)
+		require.NoError(t, err)
+		decrypted, err := io.ReadAll(decrypter)
+		require.NoError(t, err)
+		require.Equal(t, plaintext, decrypted)
+
+		decrypter, err = NewGCMDecryptReader(bytes.NewReader(ciphertext), otherKey)
+		require.NoError(t, err)
+		_, err = io.ReadAll(decrypter)
+		require.ErrorIs(t, err, ErrDecryptFailed)
+	}
+
+	plaintext := bytes.Repeat([]byte{'a'}, 2*gcmSegmentSize+1)
+	reader, err := NewGCMItemReader(bytes.NewReader(plaintext), key)
+	require.NoError(t, err)
+	ciphertext, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	// Modified
+	modified := bytes.Clone(ciphertext)
+	modified[10] ^= 1
+	decrypter, err := NewGCMDecryptReader(bytes.NewReader(modified), key)
+	require.NoError(t, err)
+	_, err = io.ReadAll(decrypter)
+	require.ErrorIs(t, err, ErrDecryptFailed)
+
+	// Truncated at a segment boundary
+	decrypter, err = NewGCMDecryptReader(bytes.NewReader(ciphertext[:2*(gcmSegmentSize+gcmOverhead)]), key)
+	require.NoError(t, err)
+	_, err = io.ReadAll(decrypter)
+	require.ErrorIs(t, err, ErrDecryptFailed)
+
+	// Empty
+	decrypter, err = NewGCMDecryptReader(bytes.NewReader(nil), key)
+	require.NoError(t, err)
+	_, err = io.ReadAll(decrypter)
+	require.ErrorIs(t, err, ErrDecryptFailed)
+
+	_, err = NewGCMItemReader(bytes.NewReader(nil), key[:16])
+	require.ErrorIs(t, err, ErrInvalidKey)
+}
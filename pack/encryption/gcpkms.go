@@ -0,0 +1,67 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	cloudkms "google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+)
+
+// gcpKMS wraps and unwraps data keys with a key of Google Cloud KMS. Cloud KMS does not generate data keys, so they
+// are generated locally and encrypted with the key.
+type gcpKMS struct {
+	keys *cloudkms.ProjectsLocationsKeyRingsCryptoKeysService
+	name string
+}
+
+// openGCPKMS opens a key of Google Cloud KMS by its resource name, i.e.
+// projects/<project>/locations/<location>/keyRings/<key ring>/cryptoKeys/<key>. The credentials are the application
+// default credentials.
+func openGCPKMS(ctx context.Context, name string) (KMS, error) {
+	return newGCPKMS(ctx, name)
+}
+
+func newGCPKMS(ctx context.Context, name string, opts ...option.ClientOption) (*gcpKMS, error) {
+	service, err := cloudkms.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create Cloud KMS client")
+	}
+	return &gcpKMS{keys: service.Projects.Locations.KeyRings.CryptoKeys, name: name}, nil
+}
+
+func (k *gcpKMS) GenerateDataKey(ctx context.Context) ([]byte, []byte, error) {
+	key := make([]byte, KeySize)
+	_, err := io.ReadFull(rand.Reader, key)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	response, err := k.keys.Encrypt(k.name, &cloudkms.EncryptRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(key),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to encrypt data key with Cloud KMS")
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(response.Ciphertext)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid ciphertext returned by Cloud KMS")
+	}
+	return key, wrapped, nil
+}
+
+func (k *gcpKMS) Decrypt(ctx context.Context, wrapped []byte) ([]byte, error) {
+	response, err := k.keys.Decrypt(k.name, &cloudkms.DecryptRequest{
+		Ciphertext: base64.StdEncoding.EncodeToString(wrapped),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt data key with Cloud KMS")
+	}
+	key, err := base64.StdEncoding.DecodeString(response.Plaintext)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid plaintext returned by Cloud KMS")
+	}
+	return key, nil
+}
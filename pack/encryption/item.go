@@ -0,0 +1,58 @@
+package encryption
+
+import (
+	"context"
+	"io"
+
+	"filippo.io/age"
+	"github.com/cockroachdb/errors"
+)
+
+// Item is the encryption of an item, i.e. a file or a range of a file, that is read as it is encrypted.
+type Item interface {
+	io.Reader
+	// Size returns the number of encrypted bytes read so far.
+	Size() int64
+	// PlaintextSize returns the number of bytes of the item read so far.
+	PlaintextSize() int64
+}
+
+// ItemEncrypter encrypts the items of a preparation one by one, each with its own key.
+type ItemEncrypter interface {
+	// EncryptItem returns the encryption of the item, and the key of the item wrapped by a key management service, if
+	// the key is not carried by the encrypted item itself.
+	EncryptItem(ctx context.Context, r io.Reader) (Item, []byte, error)
+}
+
+// AgeEncrypter encrypts each item into an age file for the recipients. The file key of each item is wrapped for the
+// recipients in the header of its age file, so there is no wrapped key to record.
+type AgeEncrypter struct {
+	Recipients []age.Recipient
+}
+
+func (e AgeEncrypter) EncryptItem(_ context.Context, r io.Reader) (Item, []byte, error) {
+	item, err := NewItemReader(r, e.Recipients)
+	if err != nil {
+		return nil, nil, err
+	}
+	return item, nil, nil
+}
+
+// KMSEncrypter encrypts each item with AES-256-GCM under a data key generated by a key management service, which also
+// wraps the data key with its master key. Only the wrapped data key is recorded, so the items can be decrypted by
+// whoever is allowed to decrypt with the master key, without the master key ever leaving the service.
+type KMSEncrypter struct {
+	KMS KMS
+}
+
+func (e KMSEncrypter) EncryptItem(ctx context.Context, r io.Reader) (Item, []byte, error) {
+	key, wrapped, err := e.KMS.GenerateDataKey(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate data key")
+	}
+	item, err := NewGCMItemReader(r, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return item, wrapped, nil
+}
@@ -0,0 +1,78 @@
+package encryption
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrInvalidKMSKey is returned when a KMS key URI is malformed or its scheme has no registered key management service.
+var ErrInvalidKMSKey = errors.New("invalid KMS key")
+
+// KMS is a key management service that generates data keys and wraps them with a master key that never leaves the
+// service.
+type KMS interface {
+	// GenerateDataKey returns a new AES-256 data key, and the data key wrapped by the master key.
+	GenerateDataKey(ctx context.Context) (key []byte, wrapped []byte, err error)
+	// Decrypt unwraps a data key wrapped by the master key.
+	Decrypt(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// KMSOpener opens the key management service of a master key, given the URI of the key without its scheme.
+type KMSOpener func(ctx context.Context, keyID string) (KMS, error)
+
+var (
+	kmsOpenersMu sync.RWMutex
+	kmsOpeners   = map[string]KMSOpener{
+		"awskms": openAWSKMS,
+		"gcpkms": openGCPKMS,
+		"vault":  openVault,
+	}
+)
+
+// RegisterKMS makes a key management service available for the KMS key URIs with the given scheme,
+// replacing any key management service registered with the same scheme.
+func RegisterKMS(scheme string, opener KMSOpener) {
+	kmsOpenersMu.Lock()
+	defer kmsOpenersMu.Unlock()
+	kmsOpeners[scheme] = opener
+}
+
+// ParseKMSKey splits a KMS key URI, i.e. awskms://arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab,
+// gcpkms://projects/p/locations/global/keyRings/r/cryptoKeys/k or vault://transit/k, into its scheme and the ID of
+// the key, and returns the opener of its key management service.
+func ParseKMSKey(uri string) (KMSOpener, string, error) {
+	scheme, keyID, ok := strings.Cut(uri, "://")
+	if !ok || keyID == "" {
+		return nil, "", errors.Wrapf(ErrInvalidKMSKey, "%s is not in the form of scheme://key", uri)
+	}
+	kmsOpenersMu.RLock()
+	opener, ok := kmsOpeners[scheme]
+	schemes := make([]string, 0, len(kmsOpeners))
+	for name := range kmsOpeners {
+		schemes = append(schemes, name)
+	}
+	kmsOpenersMu.RUnlock()
+	if !ok {
+		sort.Strings(schemes)
+		return nil, "", errors.Wrapf(ErrInvalidKMSKey, "scheme %s is not supported, must be one of %v", scheme, schemes)
+	}
+	return opener, keyID, nil
+}
+
+// OpenKMS opens the key management service of a KMS key URI. The credentials of the service are loaded from the
+// environment, as with the CLI of the service.
+func OpenKMS(ctx context.Context, uri string) (KMS, error) {
+	opener, keyID, err := ParseKMSKey(uri)
+	if err != nil {
+		return nil, err
+	}
+	kms, err := opener(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open KMS key %s", uri)
+	}
+	return kms, nil
+}
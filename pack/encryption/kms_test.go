@@ -0,0 +1,173 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+// testKMS wraps data keys by prefixing them, so they can be unwrapped without a master key.
+type testKMS struct{}
+
+func (testKMS) GenerateDataKey(context.Context) ([]byte, []byte, error) {
+	key := bytes.Repeat([]byte{1}, KeySize)
+	return key, append([]byte("wrapped:"), key...), nil
+}
+
+func (testKMS) Decrypt(_ context.Context, wrapped []byte) ([]byte, error) {
+	return bytes.TrimPrefix(wrapped, []byte("wrapped:")), nil
+}
+
+// requireRoundTrip generates a data key with the KMS and unwraps it again.
+func requireRoundTrip(t *testing.T, kms KMS) {
+	ctx := context.Background()
+	key, wrapped, err := kms.GenerateDataKey(ctx)
+	require.NoError(t, err)
+	require.Len(t, key, KeySize)
+	require.NotEqual(t, key, wrapped)
+	unwrapped, err := kms.Decrypt(ctx, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, key, unwrapped)
+}
+
+func TestParseKMSKey(t *testing.T) {
+	for _, uri := range []string{
+		"awskms://arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+		"gcpkms://projects/p/locations/global/keyRings/r/cryptoKeys/k",
+		"vault://transit/k",
+	} {
+		_, _, err := ParseKMSKey(uri)
+		require.NoError(t, err, uri)
+	}
+	for _, uri := range []string{"", "transit/k", "vault://", "unknown://key"} {
+		_, _, err := ParseKMSKey(uri)
+		require.ErrorIs(t, err, ErrInvalidKMSKey, uri)
+	}
+
+	RegisterKMS("test", func(context.Context, string) (KMS, error) { return testKMS{}, nil })
+	defer func() {
+		kmsOpenersMu.Lock()
+		delete(kmsOpeners, "test")
+		kmsOpenersMu.Unlock()
+	}()
+	kms, err := OpenKMS(context.Background(), "test://key")
+	require.NoError(t, err)
+	requireRoundTrip(t, kms)
+}
+
+func TestKMSEncrypter(t *testing.T) {
+	ctx := context.Background()
+	plaintext := []byte("hello world")
+	item, wrapped, err := KMSEncrypter{KMS: testKMS{}}.EncryptItem(ctx, bytes.NewReader(plaintext))
+	require.NoError(t, err)
+	ciphertext, err := io.ReadAll(item)
+	require.NoError(t, err)
+	require.EqualValues(t, len(plaintext)+gcmOverhead, item.Size())
+
+	key, err := testKMS{}.Decrypt(ctx, wrapped)
+	require.NoError(t, err)
+	decrypter, err := NewGCMDecryptReader(bytes.NewReader(ciphertext), key)
+	require.NoError(t, err)
+	decrypted, err := io.ReadAll(decrypter)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, decrypted)
+}
+
+func TestAWSKMS(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var input map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+		require.Equal(t, "alias/test", input["KeyId"])
+		key := bytes.Repeat([]byte{2}, KeySize)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.GenerateDataKey":
+			require.Equal(t, "AES_256", input["KeySpec"])
+			_ = json.NewEncoder(w).Encode(map[string]any{"KeyId": "alias/test", "Plaintext": key, "CiphertextBlob": []byte("blob")})
+		case "TrentService.Decrypt":
+			require.Equal(t, base64.StdEncoding.EncodeToString([]byte("blob")), input["CiphertextBlob"])
+			_ = json.NewEncoder(w).Encode(map[string]any{"KeyId": "alias/test", "Plaintext": key})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	kms, err := newAWSKMS("alias/test", aws.NewConfig().WithEndpoint(server.URL).WithRegion("us-east-1").
+		WithCredentials(credentials.NewStaticCredentials("access", "secret", "")))
+	require.NoError(t, err)
+	requireRoundTrip(t, kms)
+}
+
+func TestGCPKMS(t *testing.T) {
+	name := "projects/p/locations/global/keyRings/r/cryptoKeys/k"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		switch r.URL.Path {
+		case "/v1/" + name + ":encrypt":
+			plaintext, err := base64.StdEncoding.DecodeString(request["plaintext"])
+			require.NoError(t, err)
+			ciphertext := base64.StdEncoding.EncodeToString(append([]byte("gcp:"), plaintext...))
+			_ = json.NewEncoder(w).Encode(map[string]string{"name": name, "ciphertext": ciphertext})
+		case "/v1/" + name + ":decrypt":
+			ciphertext, err := base64.StdEncoding.DecodeString(request["ciphertext"])
+			require.NoError(t, err)
+			plaintext := base64.StdEncoding.EncodeToString(bytes.TrimPrefix(ciphertext, []byte("gcp:")))
+			_ = json.NewEncoder(w).Encode(map[string]string{"plaintext": plaintext})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	kms, err := newGCPKMS(context.Background(), name, option.WithEndpoint(server.URL+"/"), option.WithoutAuthentication())
+	require.NoError(t, err)
+	requireRoundTrip(t, kms)
+}
+
+func TestVaultKMS(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		var request map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{3}, KeySize))
+		switch r.URL.Path {
+		case "/v1/transit/datakey/plaintext/key":
+			require.EqualValues(t, 256, request["bits"])
+			_, _ = w.Write([]byte(`{"data":{"plaintext":"` + key + `","ciphertext":"vault:v1:abc"}}`))
+		case "/v1/transit/decrypt/key":
+			require.Equal(t, "vault:v1:abc", request["ciphertext"])
+			_, _ = w.Write([]byte(`{"data":{"plaintext":"` + key + `"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	kms, err := newVaultKMS(server.Client(), server.URL, "token", "", "transit/key")
+	require.NoError(t, err)
+	requireRoundTrip(t, kms)
+
+	kms, err = newVaultKMS(server.Client(), server.URL, "wrong", "", "transit/key")
+	require.NoError(t, err)
+	_, _, err = kms.GenerateDataKey(context.Background())
+	require.ErrorContains(t, err, "permission denied")
+
+	_, err = newVaultKMS(server.Client(), server.URL, "token", "", "key")
+	require.ErrorIs(t, err, ErrInvalidKMSKey)
+}
@@ -0,0 +1,122 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// vaultKMS generates and unwraps data keys with a key of the transit secrets engine of HashiCorp Vault.
+type vaultKMS struct {
+	client    *http.Client
+	address   string
+	token     string
+	namespace string
+	mount     string
+	key       string
+}
+
+// openVault opens a key of the transit secrets engine of Vault by its mount path and name, i.e. transit/my-key. The
+// address, the token and the namespace are read from VAULT_ADDR, VAULT_TOKEN and VAULT_NAMESPACE, as with the Vault CLI.
+func openVault(_ context.Context, keyID string) (KMS, error) {
+	address := os.Getenv("VAULT_ADDR")
+	if address == "" {
+		address = "https://127.0.0.1:8200"
+	}
+	token := os.Getenv("VAULT_TOKEN")
+	if token == "" {
+		return nil, errors.New("VAULT_TOKEN is not set")
+	}
+	return newVaultKMS(http.DefaultClient, address, token, os.Getenv("VAULT_NAMESPACE"), keyID)
+}
+
+func newVaultKMS(client *http.Client, address string, token string, namespace string, keyID string) (*vaultKMS, error) {
+	index := strings.LastIndex(keyID, "/")
+	if index <= 0 || index == len(keyID)-1 {
+		return nil, errors.Wrapf(ErrInvalidKMSKey, "vault key %s is not in the form of <mount>/<key>", keyID)
+	}
+	return &vaultKMS{
+		client:    client,
+		address:   strings.TrimSuffix(address, "/"),
+		token:     token,
+		namespace: namespace,
+		mount:     keyID[:index],
+		key:       keyID[index+1:],
+	}, nil
+}
+
+type vaultResponse struct {
+	Data struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+func (k *vaultKMS) call(ctx context.Context, operation string, body any) (*vaultResponse, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	url := fmt.Sprintf("%s/v1/%s/%s/%s", k.address, k.mount, operation, k.key)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", k.token)
+	if k.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", k.namespace)
+	}
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call vault %s", operation)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var response vaultResponse
+	err = json.Unmarshal(data, &response)
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Newf("vault %s failed with status %d: %s", operation, resp.StatusCode, strings.Join(response.Errors, "; "))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid response from vault %s", operation)
+	}
+	return &response, nil
+}
+
+func (k *vaultKMS) GenerateDataKey(ctx context.Context) ([]byte, []byte, error) {
+	response, err := k.call(ctx, "datakey/plaintext", map[string]any{"bits": KeySize * 8})
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(response.Data.Plaintext)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid plaintext returned by vault")
+	}
+	// The ciphertext is in the form of vault:v<key version>:<base64>, which is kept as is to decrypt it
+	return key, []byte(response.Data.Ciphertext), nil
+}
+
+func (k *vaultKMS) Decrypt(ctx context.Context, wrapped []byte) ([]byte, error) {
+	response, err := k.call(ctx, "decrypt", map[string]any{"ciphertext": string(wrapped)})
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(response.Data.Plaintext)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid plaintext returned by vault")
+	}
+	return key, nil
+}
@@ -0,0 +1,62 @@
+package pack
+
+import (
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// hashingSlots bounds the number of goroutines hashing data at once across all packs of the process. A nil channel
+// leaves hashing unbounded.
+var hashingSlots atomic.Pointer[chan struct{}]
+
+// SetHashingThreads bounds the number of goroutines hashing data at once across all packs of the process. Only the
+// hashing is bounded, so reading the source and writing to the output storage keep running while a pack waits for a
+// slot.
+//
+// Parameters:
+//   - threads: The number of goroutines allowed to hash at once. 0 leaves hashing unbounded.
+func SetHashingThreads(threads int) {
+	if threads <= 0 {
+		hashingSlots.Store(nil)
+		return
+	}
+	slots := make(chan struct{}, threads)
+	hashingSlots.Store(&slots)
+}
+
+// HashingThreads returns the number of goroutines allowed to hash at once, or 0 if hashing is unbounded.
+func HashingThreads() int {
+	slots := hashingSlots.Load()
+	if slots == nil {
+		return 0
+	}
+	return cap(*slots)
+}
+
+// hash runs f once a hashing slot is available, and adds the time spent waiting for the slot and hashing to elapsed
+// if it is set.
+func hash(elapsed *time.Duration, f func()) {
+	start := time.Now()
+	if slots := hashingSlots.Load(); slots != nil {
+		*slots <- struct{}{}
+		defer func() { <-*slots }()
+	}
+	f()
+	if elapsed != nil {
+		*elapsed += time.Since(start)
+	}
+}
+
+// hashingWriter writes to a hasher once a hashing slot is available, and adds the time spent to elapsed if it is set.
+type hashingWriter struct {
+	writer  io.Writer
+	elapsed *time.Duration
+}
+
+func (w *hashingWriter) Write(p []byte) (n int, err error) {
+	hash(w.elapsed, func() {
+		n, err = w.writer.Write(p)
+	})
+	return n, err
+}
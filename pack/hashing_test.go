@@ -0,0 +1,44 @@
+package pack
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetHashingThreads(t *testing.T) {
+	defer SetHashingThreads(HashingThreads())
+	SetHashingThreads(2)
+	require.Equal(t, 2, HashingThreads())
+
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hash(nil, func() {
+				n := atomic.AddInt32(&running, 1)
+				for {
+					m := atomic.LoadInt32(&maxRunning)
+					if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+			})
+		}()
+	}
+	wg.Wait()
+	require.EqualValues(t, 2, maxRunning)
+
+	SetHashingThreads(0)
+	require.Equal(t, 0, HashingThreads())
+	var elapsed time.Duration
+	hash(&elapsed, func() { time.Sleep(time.Millisecond) })
+	require.Greater(t, elapsed, time.Duration(0))
+}
@@ -2,14 +2,22 @@ package pack
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"io"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/data-preservation-programs/singularity/analytics"
 	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/pack/compression"
 	"github.com/data-preservation-programs/singularity/pack/daggen"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
 	"github.com/data-preservation-programs/singularity/pack/packutil"
 	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/data-preservation-programs/singularity/store"
 	"github.com/data-preservation-programs/singularity/util"
 	"github.com/google/uuid"
 	"github.com/rjNemo/underscore"
@@ -24,6 +32,7 @@ import (
 	"github.com/ipfs/go-cid"
 	format "github.com/ipfs/go-ipld-format"
 	"github.com/ipfs/go-log/v2"
+	"github.com/rclone/rclone/fs"
 )
 
 var logger = log.Logger("pack")
@@ -68,11 +77,160 @@ func GetCommp(calc *commp.Calc, targetPieceSize uint64) (cid.Cid, uint64, error)
 	return commCid, rawPieceSize, nil
 }
 
+// CarPath returns the path of the CAR file of a piece in the output storage, rendered from the CAR filename template
+// and the shard depth of the preparation.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: The database, to number the CAR file within the preparation if the template has {sequence}.
+//   - preparation: The preparation the piece belongs to.
+//   - pieceCid: The piece CID of the CAR file.
+//   - extension: The extension of the CAR file, i.e. .car or .car.zst.
+//
+// Returns:
+//   - The path of the CAR file in the output storage.
+//   - An error, if the CAR files of the preparation cannot be counted.
+func CarPath(ctx context.Context, db *gorm.DB, preparation model.Preparation, pieceCid cid.Cid, extension string) (string, error) {
+	values := packutil.CarNameValues{
+		PieceCID:    pieceCid,
+		Preparation: preparation.Name,
+		Time:        time.Now(),
+	}
+	if strings.Contains(preparation.CarNameTemplate, "{sequence}") {
+		var count int64
+		err := db.WithContext(ctx).Model(&model.Car{}).Where("preparation_id = ?", preparation.ID).Count(&count).Error
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		values.Sequence = count + 1
+	}
+	return packutil.CarName(preparation.CarNameTemplate, preparation.CarShardDepth, values) + extension, nil
+}
+
 var ErrNoContent = errors.New("no content to pack")
 
+var ErrCommPMismatch = errors.New("commP of the generated piece does not match")
+
+// QuarantineDir is the directory of the output storage that CAR files failing commP validation are moved to.
+const QuarantineDir = "quarantine"
+
+// CommPSource is where the generated piece was read from when its commP was validated.
+type CommPSource string
+
+const (
+	// CommPFromOutputStorage means the CAR file was read back from the output storage. A mismatch points at the
+	// output storage corrupting the CAR file, as the piece was hashed as it was written.
+	CommPFromOutputStorage CommPSource = "output storage"
+	// CommPFromSource means the piece of an inline preparation was regenerated from the source. A mismatch points at
+	// the source having changed, or at a corrupted read of it.
+	CommPFromSource CommPSource = "source"
+)
+
+// CommPMismatchError is returned by PackAndValidate when the commP calculated again from the generated piece does not
+// match the commP calculated while packing it. The piece is quarantined rather than recorded.
+type CommPMismatchError struct {
+	Expected       cid.Cid
+	Actual         cid.Cid
+	From           CommPSource
+	QuarantinePath string // Path of the quarantined CAR file in the output storage, empty for inline preparations
+}
+
+func (e CommPMismatchError) Unwrap() error {
+	return ErrCommPMismatch
+}
+
+func (e CommPMismatchError) Error() string {
+	var message string
+	switch e.From {
+	case CommPFromOutputStorage:
+		message = fmt.Sprintf("commP of the CAR file read back from the output storage is %s, expected %s", e.Actual, e.Expected)
+	case CommPFromSource:
+		message = fmt.Sprintf("commP of the piece regenerated from the source is %s, expected %s", e.Actual, e.Expected)
+	default:
+		message = fmt.Sprintf("commP of the generated piece is %s, expected %s", e.Actual, e.Expected)
+	}
+	if e.QuarantinePath != "" {
+		message += ", quarantined as " + e.QuarantinePath
+	}
+	return message
+}
+
+// checkCommP calculates the commP of a piece and checks that it matches the expected commP.
+//
+// Parameters:
+//   - reader: The reader of the piece.
+//   - expected: The commP calculated while packing the piece.
+//   - targetPieceSize: The size the commP is padded to.
+//   - from: Where the piece is read from, which the mismatch is reported with.
+//
+// Returns:
+//   - A CommPMismatchError if the commP does not match, or an error if the piece cannot be read.
+func checkCommP(reader io.Reader, expected cid.Cid, targetPieceSize uint64, from CommPSource) error {
+	calc := &commp.Calc{}
+	_, err := io.Copy(&hashingWriter{writer: calc}, reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to read the generated piece")
+	}
+	actual, _, err := GetCommp(calc, targetPieceSize)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !actual.Equals(expected) {
+		return CommPMismatchError{Expected: expected, Actual: actual, From: from}
+	}
+	return nil
+}
+
+// validateObject validates the commP of a piece by reading back its CAR file from the output storage. A compressed
+// CAR file is decompressed, as it will be when the piece is served.
+func validateObject(ctx context.Context, obj fs.Object, carCompression model.CarCompression, pieceCid cid.Cid, targetPieceSize uint64) error {
+	reader, err := obj.Open(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to open the generated CAR file")
+	}
+	defer reader.Close()
+	decompressed, err := compression.NewReader(reader, carCompression)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer decompressed.Close()
+	return checkCommP(decompressed, pieceCid, targetPieceSize, CommPFromOutputStorage)
+}
+
+// validateInline validates the commP of a piece of an inline preparation by regenerating the piece from the source,
+// as it will be when the piece is served.
+func validateInline(ctx context.Context, job model.Job, assembler *Assembler, pieceCid cid.Cid, fileSize int64) error {
+	if len(assembler.carBlocks) == 0 {
+		return nil
+	}
+	files := make([]model.File, 0, len(job.FileRanges))
+	for _, fileRange := range job.FileRanges {
+		file := *fileRange.File
+		if length, ok := assembler.fileLengthCorrection[file.ID]; ok {
+			file.Size = length
+		}
+		files = append(files, file)
+	}
+	car := model.Car{
+		RootCID:  model.CID(assembler.rootCID),
+		FileSize: fileSize,
+	}
+	reader, err := store.NewPieceReader(ctx, car, *job.Attachment.Storage, assembler.carBlocks, files)
+	if err != nil {
+		return errors.Wrap(err, "failed to regenerate the piece from the source")
+	}
+	defer reader.Close()
+	return checkCommP(reader, pieceCid, uint64(job.Attachment.Preparation.PieceSize), CommPFromSource)
+}
+
 // Pack takes in a Job and processes its attachment by reading it, possibly encrypting it,
 // splitting it into manageable chunks, and then storing those chunks into a designated storage.
-// If the job's attachment requires encryption, it will be encrypted using the specified encryption method.
+// If the preparation has a piece key recipient, the CAR file is encrypted with a new piece key,
+// and the piece key wrapped for the recipient is stored with the Car.
+// If the preparation has encryption recipients, each file range is encrypted into its own age file
+// as it is read, and the blocks of the age file are packed instead of the blocks of the file.
+// If the preparation has a KMS key instead, each file range is encrypted with AES-256-GCM under a
+// data key generated by the KMS, and the wrapped data key is stored with the file range.
 // The function returns a slice of Car objects which represent the stored chunks and an error if any occurred.
 //
 // Parameters:
@@ -87,8 +245,42 @@ func Pack(
 	ctx context.Context,
 	db *gorm.DB,
 	job model.Job,
+) (*model.Car, error) {
+	return pack(ctx, db, job, false)
+}
+
+// PackAndValidate packs a Job like Pack, and validates the commP of the generated piece before it is recorded. The
+// commP is calculated again from the CAR file read back from the output storage, or, for inline preparations, from
+// the piece regenerated from the source.
+//
+// A piece that fails validation is quarantined: its CAR file is moved to QuarantineDir of the output storage, and
+// neither the Car nor the CIDs of its files are recorded, so the Job can be packed again.
+//
+// Parameters:
+//   - ctx: The context which controls the lifetime of the operation.
+//   - db: The gorm database instance used for querying and updating database records.
+//   - job: The Job model instance which contains information about the attachment to be processed.
+//
+// Returns:
+//   - The Car that was recorded.
+//   - A CommPMismatchError if the piece failed validation, or any other error that occurred during the operation.
+func PackAndValidate(
+	ctx context.Context,
+	db *gorm.DB,
+	job model.Job,
+) (*model.Car, error) {
+	return pack(ctx, db, job, true)
+}
+
+func pack(
+	ctx context.Context,
+	db *gorm.DB,
+	job model.Job,
+	validate bool,
 ) (*model.Car, error) {
 	db = db.WithContext(ctx)
+	packStart := time.Now()
+	var timing model.PackTiming
 	pieceSize := job.Attachment.Preparation.PieceSize
 	// storageWriter can be nil for inline preparation
 	storageID, storageWriter, err := storagesystem.GetRandomOutputWriter(ctx, job.Attachment.Preparation.OutputStorages)
@@ -101,22 +293,66 @@ func Pack(
 		return nil, errors.Wrapf(err, "failed to get storage handler for %s", job.Attachment.Storage.Name)
 	}
 
+	cidOptions, err := packutil.NewCidOptions(job.Attachment.Preparation.HashFunction, job.Attachment.Preparation.LeafCodec, job.Attachment.Preparation.CidVersion, job.Attachment.Preparation.Chunker)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	cidOptions.SmallFileLimit = job.Attachment.Preparation.SmallFileLimit
+
 	var skipInaccessibleFile bool
 	if job.Attachment.Storage.ClientConfig.SkipInaccessibleFile != nil {
 		skipInaccessibleFile = *job.Attachment.Storage.ClientConfig.SkipInaccessibleFile
 	}
-	assembler := NewAssembler(ctx, storageReader, job.FileRanges, job.Attachment.Preparation.NoInline, skipInaccessibleFile)
+	var encrypter encryption.ItemEncrypter
+	var keyVersion int
+	switch {
+	case len(job.Attachment.Preparation.EncryptionRecipients) > 0:
+		recipients, err := encryption.ParseRecipients(job.Attachment.Preparation.EncryptionRecipients)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		encrypter = encryption.AgeEncrypter{Recipients: recipients}
+		keyVersion = job.Attachment.Preparation.EncryptionKeyVersion
+	case job.Attachment.Preparation.EncryptionKMSKey != "":
+		kms, err := encryption.OpenKMS(ctx, job.Attachment.Preparation.EncryptionKMSKey)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		encrypter = encryption.KMSEncrypter{KMS: kms}
+		keyVersion = job.Attachment.Preparation.EncryptionKeyVersion
+	}
+	assembler := NewAssembler(ctx, storageReader, job.FileRanges, job.Attachment.Preparation.NoInline, skipInaccessibleFile,
+		job.Attachment.Preparation.CarSource, cidOptions, encrypter)
 	defer assembler.Close()
+	payload, wrappedKey, err := encryption.Encrypt(assembler, job.Attachment.Preparation.PieceKeyRecipient)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 	var filename string
 	calc := &commp.Calc{}
 	var pieceCid cid.Cid
 	var finalPieceSize uint64
 	var fileSize int64
+	carCompression := job.Attachment.Preparation.CarCompression
 	if storageWriter != nil {
 		var carGenerated bool
-		reader := io.TeeReader(assembler, calc)
-		filename = uuid.NewString() + ".car"
+		// The piece CID is calculated over the uncompressed CAR file, while the checksum is the one of the CAR file as
+		// it is stored, so it can be checked with sha256sum.
+		compressed, err := compression.Compress(io.TeeReader(payload, &hashingWriter{writer: calc, elapsed: &assembler.hashing}), carCompression)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		defer compressed.Close()
+		checksum := sha256.New()
+		// The time spent writing to the output storage is the time of the write, less the time spent producing the
+		// CAR file to write.
+		var producing time.Duration
+		reader := &timedReader{reader: io.TeeReader(compressed, checksum), elapsed: &producing}
+		extension := ".car" + compression.Extension(carCompression)
+		filename = uuid.NewString() + extension
+		writeStart := time.Now()
 		obj, err := storageWriter.Write(ctx, filename, reader)
+		timing.Writing = time.Since(writeStart) - producing
 		defer func() {
 			if !carGenerated && obj != nil {
 				removeCtx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
@@ -130,7 +366,7 @@ func Pack(
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
-		fileSize = obj.Size()
+		fileSize = compressed.Size()
 
 		if assembler.carOffset <= 65 {
 			return nil, errors.WithStack(ErrNoContent)
@@ -139,16 +375,55 @@ func Pack(
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
-		_, err = storageWriter.Move(ctx, obj, pieceCid.String()+".car")
+		if validate {
+			validateStart := time.Now()
+			err = validateObject(ctx, obj, carCompression, pieceCid, uint64(pieceSize))
+			timing.Validating = time.Since(validateStart)
+			var mismatch CommPMismatchError
+			if errors.As(err, &mismatch) {
+				quarantinePath := path.Join(QuarantineDir, filename)
+				_, moveErr := storageWriter.Move(ctx, obj, quarantinePath)
+				if moveErr == nil {
+					// The quarantined CAR file is kept for inspection rather than removed.
+					obj = nil
+					mismatch.QuarantinePath = quarantinePath
+					err = mismatch
+				} else {
+					logger.Errorf("failed to quarantine CAR file %s: %v", filename, moveErr)
+				}
+			}
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+		carPath, err := CarPath(ctx, db, *job.Attachment.Preparation, pieceCid, extension)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		moved, err := storageWriter.Move(ctx, obj, carPath)
 		if err != nil && !errors.Is(err, storagesystem.ErrMoveNotSupported) {
-			logger.Errorf("failed to move car file from %s to %s: %s", filename, pieceCid.String()+".car", err)
+			logger.Errorf("failed to move car file from %s to %s: %s", filename, carPath, err)
 		}
 		if err == nil {
-			filename = pieceCid.String() + ".car"
+			filename = carPath
+			obj = moved
+		}
+		if job.Attachment.Preparation.ChecksumSidecar {
+			err = writeSidecars(ctx, storageWriter, filename, CommPSidecar{
+				PayloadCID:  assembler.rootCID.String(),
+				PieceCID:    pieceCid.String(),
+				PieceSize:   finalPieceSize,
+				FileSize:    fileSize,
+				SHA256:      hex.EncodeToString(checksum.Sum(nil)),
+				Compression: string(carCompression),
+			})
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
 		}
 		carGenerated = true
 	} else {
-		fileSize, err = io.Copy(calc, assembler)
+		fileSize, err = io.Copy(&hashingWriter{writer: calc, elapsed: &assembler.hashing}, payload)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
@@ -159,18 +434,32 @@ func Pack(
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
+		if validate {
+			validateStart := time.Now()
+			err = validateInline(ctx, job, assembler, pieceCid, fileSize)
+			timing.Validating = time.Since(validateStart)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
 	}
 	car := &model.Car{
-		PieceCID:      model.CID(pieceCid),
-		PieceSize:     int64(finalPieceSize),
-		RootCID:       model.CID(assembler.rootCID),
-		FileSize:      fileSize,
-		StorageID:     storageID,
-		StoragePath:   filename,
-		AttachmentID:  &job.AttachmentID,
-		PreparationID: job.Attachment.PreparationID,
-		JobID:         &job.ID,
+		PieceCID:             model.CID(pieceCid),
+		PieceSize:            int64(finalPieceSize),
+		RootCID:              model.CID(assembler.rootCID),
+		FileSize:             fileSize,
+		StorageID:            storageID,
+		StoragePath:          filename,
+		AttachmentID:         &job.AttachmentID,
+		PreparationID:        job.Attachment.PreparationID,
+		JobID:                &job.ID,
+		WrappedKey:           wrappedKey,
+		EncryptionKeyVersion: keyVersion,
 	}
+	if filename != "" {
+		car.Compression = carCompression
+	}
+	databaseStart := time.Now()
 
 	// Update all Files and FileRanges that have size == -1
 	for fileID, length := range assembler.fileLengthCorrection {
@@ -199,15 +488,23 @@ func Pack(
 	splitFileIDs := make(map[model.FileID]model.File)
 	var updatedFiles []model.File
 	splitFileBlks := make(map[model.FileID][]blocks.Block)
+	// The files of the DAG are the encryptions of their ranges if they are encrypted, so their sizes are the ones of
+	// the encryptions.
+	encryptedSizes := make(map[model.FileID]int64)
 	for _, fileRange := range job.FileRanges {
 		err = database.DoRetry(ctx, func() error {
 			return db.Model(&model.FileRange{}).Where("id = ?", fileRange.ID).
-				Update("cid", fileRange.CID).Error
+				Updates(map[string]any{
+					"cid":              fileRange.CID,
+					"encrypted_length": fileRange.EncryptedLength,
+					"wrapped_key":      fileRange.WrappedKey,
+				}).Error
 		})
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
-		if fileRange.Offset == 0 && fileRange.Length == fileRange.File.Size {
+		// The CID of a CAR file of a CAR source is its root, which every range of the file already has
+		if (fileRange.Offset == 0 && fileRange.Length == fileRange.File.Size) || job.Attachment.Preparation.CarSource {
 			err = database.DoRetry(ctx, func() error {
 				return db.Model(&model.File{}).Where("id = ?", fileRange.FileID).
 					Update("cid", fileRange.CID).Error
@@ -217,6 +514,9 @@ func Pack(
 			}
 			fileRange.File.CID = fileRange.CID
 			updatedFiles = append(updatedFiles, *fileRange.File)
+			if fileRange.EncryptedLength > 0 {
+				encryptedSizes[fileRange.FileID] = fileRange.EncryptedLength
+			}
 		} else {
 			splitFileIDs[fileRange.FileID] = *fileRange.File
 		}
@@ -234,13 +534,19 @@ func Pack(
 				if underscore.All(allParts, func(p model.FileRange) bool {
 					return p.CID != model.CID(cid.Undef)
 				}) {
+					var encryptedSize int64
 					links := underscore.Map(allParts, func(p model.FileRange) format.Link {
+						length := p.Length
+						if p.EncryptedLength > 0 {
+							length = p.EncryptedLength
+							encryptedSize += length
+						}
 						return format.Link{
-							Size: uint64(p.Length),
+							Size: uint64(length),
 							Cid:  cid.Cid(p.CID),
 						}
 					})
-					blks, node, err := packutil.AssembleFileFromLinks(links)
+					blks, node, err := cidOptions.AssembleFileFromLinks(links)
 					if err != nil {
 						return errors.Wrap(err, "failed to assemble file from links")
 					}
@@ -253,6 +559,9 @@ func Pack(
 					file.CID = model.CID(nodeCid)
 					updatedFiles = append(updatedFiles, file)
 					splitFileBlks[fileID] = blks
+					if encryptedSize > 0 {
+						encryptedSizes[fileID] = encryptedSize
+					}
 				}
 				return nil
 			})
@@ -263,6 +572,17 @@ func Pack(
 	}
 
 	car.NumOfFiles = int64(len(updatedFiles))
+	blobStorage := job.Attachment.Preparation.BlobStorage
+	if !job.Attachment.Preparation.NoInline && blobStorage != nil {
+		blobStore, err := store.NewBlobStore(ctx, *blobStorage)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		err = blobStore.Offload(ctx, assembler.carBlocks)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to offload raw blocks to blob storage")
+		}
+	}
 	err = database.DoRetry(ctx, func() error {
 		return db.Transaction(
 			func(db *gorm.DB) error {
@@ -293,18 +613,23 @@ func Pack(
 				return nil
 			}
 			return db.Transaction(func(db *gorm.DB) error {
-				var err error
-				tree := daggen.NewDirectoryTree()
+				tree := daggen.NewDirectoryTree(cidOptions)
+				ancestors, err := findAncestors(ctx, db, *job.Attachment, updatedFiles)
+				if err != nil {
+					return errors.Wrap(err, "failed to find directories")
+				}
 				var rootDirID model.DirectoryID
 				for _, file := range updatedFiles {
 					dirID := file.DirectoryID
 					for {
 						// Add the directory to tree if it is not there
 						if !tree.Has(*dirID) {
-							var dir model.Directory
-							err = db.Where("id = ?", dirID).First(&dir).Error
-							if err != nil {
-								return errors.Wrap(err, "failed to get directory")
+							dir, ok := ancestors[*dirID]
+							if !ok {
+								err = db.Where("id = ?", dirID).First(&dir).Error
+								if err != nil {
+									return errors.Wrap(err, "failed to get directory")
+								}
 							}
 							err = tree.Add(ctx, &dir)
 							if err != nil {
@@ -316,12 +641,22 @@ func Pack(
 
 						// Update the directory for first iteration
 						if dirID == file.DirectoryID {
-							err = dirDetail.Data.AddFile(ctx, file.FileName(), cid.Cid(file.CID), uint64(file.Size))
+							name, err := resolvePathConflict(ctx, db, job.Attachment.Preparation.ConflictPolicy, dirDetail.Data, file)
 							if err != nil {
-								return errors.Wrap(err, "failed to add file to directory")
+								return errors.Wrapf(err, "failed to resolve path conflict of %s", file.Path)
 							}
-							if blks, ok := splitFileBlks[file.ID]; ok {
-								dirDetail.Data.AddBlocks(ctx, blks)
+							if name != "" {
+								size := file.Size
+								if encryptedSize, ok := encryptedSizes[file.ID]; ok {
+									size = encryptedSize
+								}
+								err = dirDetail.Data.AddFile(ctx, name, cid.Cid(file.CID), uint64(size))
+								if err != nil {
+									return errors.Wrap(err, "failed to add file to directory")
+								}
+								if blks, ok := splitFileBlks[file.ID]; ok {
+									dirDetail.Data.AddBlocks(ctx, blks)
+								}
 							}
 						}
 
@@ -363,6 +698,28 @@ func Pack(
 		}
 	}
 
+	timing.Listing = assembler.listing
+	timing.Reading = assembler.reading
+	timing.Hashing = assembler.hashing
+	timing.Database = time.Since(databaseStart)
+	timing.Total = time.Since(packStart)
+	timing.NumOfFiles = int64(len(job.FileRanges))
+	timing.FileSize = car.FileSize
+	timing.WorkerID = job.WorkerID
+	timing.JobID = job.ID
+	timing.CarID = &car.ID
+	timing.PreparationID = job.Attachment.PreparationID
+	// The piece is already recorded, so failing to record its timing does not fail the pack.
+	err = database.DoRetry(ctx, func() error {
+		return db.Create(&timing).Error
+	})
+	if err != nil {
+		logger.Warnw("failed to record pack timing", "jobID", job.ID, "error", err)
+	}
+	sourceRead := assembler.sourceRead
+	sourceRead.ReadTime = assembler.reading
+	recordSourceRead(ctx, db, job, sourceRead)
+
 	logger.With("jobsID", job.ID).Info("finished packing")
 	if job.Attachment.Preparation.DeleteAfterExport && len(job.Attachment.Preparation.OutputStorages) > 0 {
 		logger.Info("Deleting original data source")
@@ -399,3 +756,30 @@ func Pack(
 	analytics.Default.QueuePushJobEvent(packJobEvent)
 	return car, nil
 }
+
+// findAncestors finds the ancestors of the files by their compressed paths, so the directories of a deep tree are
+// not loaded one level at a time. Directories without a compressed path, and the virtual directories of partitioned
+// sources, are not found and are left to be loaded by their ID.
+func findAncestors(ctx context.Context, db *gorm.DB, attachment model.SourceAttachment, files []model.File) (map[model.DirectoryID]model.Directory, error) {
+	seen := make(map[string]struct{})
+	var paths []string
+	for _, file := range files {
+		for end := strings.LastIndex(file.Path, "/"); end >= 0; end = strings.LastIndex(file.Path[:end], "/") {
+			p := file.Path[:end]
+			if _, ok := seen[p]; ok {
+				break
+			}
+			seen[p] = struct{}{}
+			paths = append(paths, p)
+		}
+	}
+	found, err := attachment.FindDirectories(ctx, db, paths)
+	if err != nil {
+		return nil, err
+	}
+	ancestors := make(map[model.DirectoryID]model.Directory, len(found))
+	for _, dir := range found {
+		ancestors[dir.ID] = dir
+	}
+	return ancestors, nil
+}
@@ -1,14 +1,29 @@
 package pack
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"filippo.io/age"
+	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
+	"github.com/data-preservation-programs/singularity/util"
 	"github.com/data-preservation-programs/singularity/util/testutil"
+	commp "github.com/filecoin-project/go-fil-commp-hashhash"
 	"github.com/gotidy/ptr"
+	"github.com/ipfs/go-cid"
+	carv1 "github.com/ipld/go-car"
+	"github.com/klauspost/compress/zstd"
+	"github.com/multiformats/go-multihash"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
@@ -224,3 +239,781 @@ func TestAssembleCar(t *testing.T) {
 		})
 	}
 }
+
+func TestPackAndValidate(t *testing.T) {
+	tmp := t.TempDir()
+	out := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmp, "test.txt"), testutil.GenerateRandomBytes(5_000_000), 0644)
+	require.NoError(t, err)
+	stat, err := os.Stat(filepath.Join(tmp, "test.txt"))
+	require.NoError(t, err)
+
+	for _, inline := range []bool{true, false} {
+		name := "output storage"
+		if inline {
+			name = "inline"
+		}
+		t.Run(name, func(t *testing.T) {
+			testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+				preparation := &model.Preparation{
+					MaxSize:   10_000_000,
+					PieceSize: 1 << 23,
+				}
+				if !inline {
+					preparation.OutputStorages = []model.Storage{{Name: "out", Type: "local", Path: out}}
+				}
+				job := model.Job{
+					Type:  model.Pack,
+					State: model.Processing,
+					Attachment: &model.SourceAttachment{
+						Preparation: preparation,
+						Storage: &model.Storage{
+							Name: "tmp",
+							Type: "local",
+							Path: tmp,
+						},
+					},
+					FileRanges: []model.FileRange{
+						{
+							Offset: 0,
+							Length: -1,
+							File: &model.File{
+								Path:             "test.txt",
+								Size:             -1,
+								LastModifiedNano: stat.ModTime().UnixNano(),
+								AttachmentID:     1,
+								Directory: &model.Directory{
+									AttachmentID: 1,
+								},
+							},
+						},
+					},
+				}
+				err := db.Create(&job).Error
+				require.NoError(t, err)
+				car, err := PackAndValidate(ctx, db, job)
+				require.NoError(t, err)
+				require.EqualValues(t, 1<<23, car.PieceSize)
+
+				var timing model.PackTiming
+				err = db.Where("car_id = ?", car.ID).First(&timing).Error
+				require.NoError(t, err)
+				require.Equal(t, job.ID, timing.JobID)
+				require.Equal(t, car.FileSize, timing.FileSize)
+				require.EqualValues(t, 1, timing.NumOfFiles)
+				require.Positive(t, timing.Reading)
+				require.Positive(t, timing.Hashing)
+				require.Positive(t, timing.Validating)
+				require.Positive(t, timing.Database)
+				require.GreaterOrEqual(t, timing.Total, timing.Reading+timing.Hashing)
+
+				var sourceRead model.SourceRead
+				err = db.Where("job_id = ?", job.ID).First(&sourceRead).Error
+				require.NoError(t, err)
+				require.Equal(t, job.Attachment.StorageID, sourceRead.StorageID)
+				require.EqualValues(t, 1, sourceRead.NumOfReads)
+				require.EqualValues(t, 1, sourceRead.FastReads+sourceRead.ModerateReads+sourceRead.SlowReads+sourceRead.VerySlowReads)
+				require.Positive(t, sourceRead.BytesRead)
+				require.Equal(t, timing.Reading, sourceRead.ReadTime)
+			})
+		})
+	}
+}
+
+func TestPack_ChecksumSidecar(t *testing.T) {
+	tmp := t.TempDir()
+	out := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmp, "test.txt"), testutil.GenerateRandomBytes(1000), 0644)
+	require.NoError(t, err)
+	stat, err := os.Stat(filepath.Join(tmp, "test.txt"))
+	require.NoError(t, err)
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		job := model.Job{
+			Type:  model.Pack,
+			State: model.Processing,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{
+					MaxSize:         2000000,
+					PieceSize:       1 << 21,
+					ChecksumSidecar: true,
+					OutputStorages:  []model.Storage{{Name: "out", Type: "local", Path: out}},
+				},
+				Storage: &model.Storage{
+					Name: "tmp",
+					Type: "local",
+					Path: tmp,
+				},
+			},
+			FileRanges: []model.FileRange{
+				{
+					Offset: 0,
+					Length: stat.Size(),
+					File: &model.File{
+						Path:             "test.txt",
+						Size:             stat.Size(),
+						LastModifiedNano: stat.ModTime().UnixNano(),
+						AttachmentID:     1,
+						Directory: &model.Directory{
+							AttachmentID: 1,
+						},
+					},
+				},
+			},
+		}
+		err := db.Create(&job).Error
+		require.NoError(t, err)
+		car, err := Pack(ctx, db, job)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(out, car.StoragePath))
+		require.NoError(t, err)
+		sum := sha256.Sum256(content)
+		checksum, err := os.ReadFile(filepath.Join(out, car.StoragePath+".sha256"))
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(sum[:])+"  "+car.StoragePath+"\n", string(checksum))
+
+		content, err = os.ReadFile(filepath.Join(out, car.PieceCID.String()+".commp.json"))
+		require.NoError(t, err)
+		var sidecar CommPSidecar
+		err = json.Unmarshal(content, &sidecar)
+		require.NoError(t, err)
+		require.Equal(t, CommPSidecar{
+			PayloadCID: car.RootCID.String(),
+			PieceCID:   car.PieceCID.String(),
+			PieceSize:  uint64(car.PieceSize),
+			FileSize:   car.FileSize,
+			SHA256:     hex.EncodeToString(sum[:]),
+		}, sidecar)
+	})
+}
+
+func TestPack_CarNameTemplate(t *testing.T) {
+	tmp := t.TempDir()
+	out := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmp, "test.txt"), testutil.GenerateRandomBytes(1000), 0644)
+	require.NoError(t, err)
+	stat, err := os.Stat(filepath.Join(tmp, "test.txt"))
+	require.NoError(t, err)
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		job := model.Job{
+			Type:  model.Pack,
+			State: model.Processing,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{
+					Name:            "prep",
+					MaxSize:         2000000,
+					PieceSize:       1 << 21,
+					ChecksumSidecar: true,
+					CarNameTemplate: "{preparation}/{sequence}-{piece_cid}",
+					CarShardDepth:   2,
+					OutputStorages:  []model.Storage{{Name: "out", Type: "local", Path: out}},
+				},
+				Storage: &model.Storage{
+					Name: "tmp",
+					Type: "local",
+					Path: tmp,
+				},
+			},
+			FileRanges: []model.FileRange{
+				{
+					Offset: 0,
+					Length: stat.Size(),
+					File: &model.File{
+						Path:             "test.txt",
+						Size:             stat.Size(),
+						LastModifiedNano: stat.ModTime().UnixNano(),
+						AttachmentID:     1,
+						Directory: &model.Directory{
+							AttachmentID: 1,
+						},
+					},
+				},
+			},
+		}
+		err := db.Create(&job).Error
+		require.NoError(t, err)
+		car, err := Pack(ctx, db, job)
+		require.NoError(t, err)
+
+		pieceCID := car.PieceCID.String()
+		dir := pieceCID[len(pieceCID)-3:len(pieceCID)-1] + "/" + pieceCID[len(pieceCID)-5:len(pieceCID)-3] + "/prep"
+		require.Equal(t, dir+"/000001-"+pieceCID+".car", car.StoragePath)
+		require.FileExists(t, filepath.Join(out, car.StoragePath))
+		require.FileExists(t, filepath.Join(out, car.StoragePath+".sha256"))
+		require.FileExists(t, filepath.Join(out, dir, "000001-"+pieceCID+".commp.json"))
+	})
+}
+
+func TestPack_CarCompression(t *testing.T) {
+	tmp := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmp, "test.txt"), bytes.Repeat([]byte("singularity"), 100_000), 0644)
+	require.NoError(t, err)
+	stat, err := os.Stat(filepath.Join(tmp, "test.txt"))
+	require.NoError(t, err)
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		cars := make(map[model.CarCompression]*model.Car)
+		outs := make(map[model.CarCompression]string)
+		for _, carCompression := range []model.CarCompression{model.CarCompressionNone, model.CarCompressionZstd} {
+			outs[carCompression] = t.TempDir()
+			job := model.Job{
+				Type:  model.Pack,
+				State: model.Processing,
+				Attachment: &model.SourceAttachment{
+					Preparation: &model.Preparation{
+						Name:            "prep-" + string(carCompression),
+						MaxSize:         2000000,
+						PieceSize:       1 << 21,
+						ChecksumSidecar: true,
+						CarCompression:  carCompression,
+						OutputStorages:  []model.Storage{{Name: "out-" + string(carCompression), Type: "local", Path: outs[carCompression]}},
+					},
+					Storage: &model.Storage{
+						Name: "tmp-" + string(carCompression),
+						Type: "local",
+						Path: tmp,
+					},
+				},
+				FileRanges: []model.FileRange{
+					{
+						Offset: 0,
+						Length: stat.Size(),
+						File: &model.File{
+							Path:             "test.txt",
+							Size:             stat.Size(),
+							LastModifiedNano: stat.ModTime().UnixNano(),
+							AttachmentID:     1,
+							Directory: &model.Directory{
+								AttachmentID: 1,
+							},
+						},
+					},
+				},
+			}
+			err := db.Create(&job).Error
+			require.NoError(t, err)
+			cars[carCompression], err = PackAndValidate(ctx, db, job)
+			require.NoError(t, err)
+		}
+
+		plain := cars[model.CarCompressionNone]
+		compressed := cars[model.CarCompressionZstd]
+		require.Equal(t, model.CarCompressionZstd, compressed.Compression)
+		require.Equal(t, plain.PieceCID, compressed.PieceCID)
+		require.Equal(t, plain.FileSize, compressed.FileSize)
+		require.Equal(t, compressed.PieceCID.String()+".car.zst", compressed.StoragePath)
+
+		content, err := os.ReadFile(filepath.Join(outs[model.CarCompressionZstd], compressed.StoragePath))
+		require.NoError(t, err)
+		require.Less(t, int64(len(content)), compressed.FileSize/10)
+		sum := sha256.Sum256(content)
+		checksum, err := os.ReadFile(filepath.Join(outs[model.CarCompressionZstd], compressed.StoragePath+".sha256"))
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(sum[:])+"  "+compressed.StoragePath+"\n", string(checksum))
+
+		decoder, err := zstd.NewReader(bytes.NewReader(content))
+		require.NoError(t, err)
+		defer decoder.Close()
+		decompressed, err := io.ReadAll(decoder)
+		require.NoError(t, err)
+		expected, err := os.ReadFile(filepath.Join(outs[model.CarCompressionNone], plain.StoragePath))
+		require.NoError(t, err)
+		require.Equal(t, expected, decompressed)
+
+		content, err = os.ReadFile(filepath.Join(outs[model.CarCompressionZstd], compressed.PieceCID.String()+".commp.json"))
+		require.NoError(t, err)
+		var sidecar CommPSidecar
+		err = json.Unmarshal(content, &sidecar)
+		require.NoError(t, err)
+		require.Equal(t, compressed.FileSize, sidecar.FileSize)
+		require.Equal(t, "zstd", sidecar.Compression)
+	})
+}
+
+func TestPack_CidOptions(t *testing.T) {
+	tmp := t.TempDir()
+	out := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmp, "test.txt"), testutil.GenerateRandomBytes(3_000_000), 0644)
+	require.NoError(t, err)
+	stat, err := os.Stat(filepath.Join(tmp, "test.txt"))
+	require.NoError(t, err)
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		job := model.Job{
+			Type:  model.Pack,
+			State: model.Processing,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{
+					MaxSize:        10_000_000,
+					PieceSize:      1 << 23,
+					NoInline:       true,
+					HashFunction:   model.HashBlake2b256,
+					LeafCodec:      model.LeafCodecDagPB,
+					OutputStorages: []model.Storage{{Name: "out", Type: "local", Path: out}},
+				},
+				Storage: &model.Storage{
+					Name: "tmp",
+					Type: "local",
+					Path: tmp,
+				},
+			},
+			FileRanges: []model.FileRange{
+				{
+					Offset: 0,
+					Length: stat.Size(),
+					File: &model.File{
+						Path:             "test.txt",
+						Size:             stat.Size(),
+						LastModifiedNano: stat.ModTime().UnixNano(),
+						AttachmentID:     1,
+						Directory: &model.Directory{
+							AttachmentID: 1,
+						},
+					},
+				},
+			},
+		}
+		err := db.Create(&job).Error
+		require.NoError(t, err)
+		car, err := Pack(ctx, db, job)
+		require.NoError(t, err)
+
+		var file model.File
+		err = db.First(&file).Error
+		require.NoError(t, err)
+		require.EqualValues(t, cid.DagProtobuf, cid.Cid(file.CID).Type())
+		require.EqualValues(t, multihash.Names["blake2b-256"], cid.Cid(file.CID).Prefix().MhType)
+
+		f, err := os.Open(filepath.Join(out, car.StoragePath))
+		require.NoError(t, err)
+		defer f.Close()
+		reader, err := carv1.NewCarReader(f)
+		require.NoError(t, err)
+		var numBlocks int
+		for {
+			blk, err := reader.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			numBlocks++
+			require.EqualValues(t, cid.DagProtobuf, blk.Cid().Type())
+			actual, err := blk.Cid().Prefix().Sum(blk.RawData())
+			require.NoError(t, err)
+			require.Equal(t, blk.Cid(), actual)
+		}
+		// 3 leaves and the root node of the file
+		require.Equal(t, 4, numBlocks)
+	})
+}
+
+func TestPack_CidV0(t *testing.T) {
+	tmp := t.TempDir()
+	out := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmp, "test.txt"), testutil.GenerateRandomBytes(3_000_000), 0644)
+	require.NoError(t, err)
+	stat, err := os.Stat(filepath.Join(tmp, "test.txt"))
+	require.NoError(t, err)
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		job := model.Job{
+			Type:  model.Pack,
+			State: model.Processing,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{
+					MaxSize:        10_000_000,
+					PieceSize:      1 << 23,
+					NoInline:       true,
+					LeafCodec:      model.LeafCodecDagPB,
+					CidVersion:     model.CidV0,
+					OutputStorages: []model.Storage{{Name: "out", Type: "local", Path: out}},
+				},
+				Storage: &model.Storage{
+					Name: "tmp",
+					Type: "local",
+					Path: tmp,
+				},
+			},
+			FileRanges: []model.FileRange{
+				{
+					Offset: 0,
+					Length: stat.Size(),
+					File: &model.File{
+						Path:             "test.txt",
+						Size:             stat.Size(),
+						LastModifiedNano: stat.ModTime().UnixNano(),
+						AttachmentID:     1,
+						Directory: &model.Directory{
+							AttachmentID: 1,
+						},
+					},
+				},
+			},
+		}
+		err := db.Create(&job).Error
+		require.NoError(t, err)
+		car, err := Pack(ctx, db, job)
+		require.NoError(t, err)
+
+		var file model.File
+		err = db.First(&file).Error
+		require.NoError(t, err)
+		require.EqualValues(t, 0, cid.Cid(file.CID).Version())
+		require.True(t, strings.HasPrefix(cid.Cid(file.CID).String(), "Qm"))
+
+		f, err := os.Open(filepath.Join(out, car.StoragePath))
+		require.NoError(t, err)
+		defer f.Close()
+		reader, err := carv1.NewCarReader(f)
+		require.NoError(t, err)
+		var numBlocks int
+		for {
+			blk, err := reader.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			numBlocks++
+			require.EqualValues(t, 0, blk.Cid().Version())
+			actual, err := blk.Cid().Prefix().Sum(blk.RawData())
+			require.NoError(t, err)
+			require.Equal(t, blk.Cid(), actual)
+		}
+		// 3 leaves and the root node of the file
+		require.Equal(t, 4, numBlocks)
+	})
+}
+
+func TestPack_CarSource(t *testing.T) {
+	tmp := t.TempDir()
+	content, blks := generateCarFile(t, 3)
+	err := os.WriteFile(filepath.Join(tmp, "snapshot.car"), content, 0644)
+	require.NoError(t, err)
+	stat, err := os.Stat(filepath.Join(tmp, "snapshot.car"))
+	require.NoError(t, err)
+	header, err := util.GenerateCarHeader(blks[0].Cid())
+	require.NoError(t, err)
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		// The CAR file is split right after the start of its first block, so the first range only has the first block
+		split := int64(len(header)) + 1
+		job := model.Job{
+			Type:  model.Pack,
+			State: model.Processing,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{
+					MaxSize:   2000000,
+					PieceSize: 1 << 21,
+					NoDag:     true,
+					CarSource: true,
+				},
+				Storage: &model.Storage{
+					Name: "tmp",
+					Type: "local",
+					Path: tmp,
+				},
+			},
+			FileRanges: []model.FileRange{
+				{
+					Offset: 0,
+					Length: split,
+					File: &model.File{
+						Path:             "snapshot.car",
+						Size:             stat.Size(),
+						LastModifiedNano: stat.ModTime().UnixNano(),
+						AttachmentID:     1,
+					},
+				},
+			},
+		}
+		err := db.Create(&job).Error
+		require.NoError(t, err)
+		job2 := model.Job{
+			Type:         model.Pack,
+			State:        model.Processing,
+			AttachmentID: job.AttachmentID,
+			FileRanges: []model.FileRange{
+				{
+					Offset: split,
+					Length: stat.Size() - split,
+					FileID: job.FileRanges[0].FileID,
+				},
+			},
+		}
+		err = db.Create(&job2).Error
+		require.NoError(t, err)
+		job2.Attachment = job.Attachment
+		job2.FileRanges[0].File = job.FileRanges[0].File
+
+		for i, job := range []model.Job{job, job2} {
+			car, err := PackAndValidate(ctx, db, job)
+			require.NoError(t, err)
+			var carBlocks []model.CarBlock
+			err = db.Where("car_id = ?", car.ID).Order("car_offset").Find(&carBlocks).Error
+			require.NoError(t, err)
+			expected := blks[:1]
+			if i == 1 {
+				expected = blks[1:]
+			}
+			require.Len(t, carBlocks, len(expected))
+			require.Equal(t, expected[0].Cid(), cid.Cid(car.RootCID))
+			for j, carBlock := range carBlocks {
+				require.Equal(t, expected[j].Cid(), cid.Cid(carBlock.CID))
+				require.Equal(t, expected[j].RawData(), content[carBlock.FileOffset:carBlock.FileOffset+int64(carBlock.BlockLength())])
+			}
+		}
+
+		var file model.File
+		err = db.First(&file).Error
+		require.NoError(t, err)
+		require.Equal(t, blks[0].Cid(), cid.Cid(file.CID))
+	})
+}
+
+func TestPack_EncryptionRecipients(t *testing.T) {
+	tmp := t.TempDir()
+	plaintext := bytes.Repeat([]byte("singularity"), 30_000)
+	err := os.WriteFile(filepath.Join(tmp, "test.txt"), plaintext, 0644)
+	require.NoError(t, err)
+	stat, err := os.Stat(filepath.Join(tmp, "test.txt"))
+	require.NoError(t, err)
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		out := t.TempDir()
+		file := &model.File{
+			Path:             "test.txt",
+			Size:             stat.Size(),
+			LastModifiedNano: stat.ModTime().UnixNano(),
+			AttachmentID:     1,
+			Directory: &model.Directory{
+				AttachmentID: 1,
+			},
+		}
+		// The file is split into two ranges, which are encrypted into an age file each
+		split := stat.Size() / 3
+		job := model.Job{
+			Type:  model.Pack,
+			State: model.Processing,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{
+					MaxSize:              2000000,
+					PieceSize:            1 << 21,
+					NoInline:             true,
+					EncryptionRecipients: model.StringSlice{identity.Recipient().String()},
+					EncryptionKeyVersion: 2,
+					OutputStorages:       []model.Storage{{Name: "out", Type: "local", Path: out}},
+				},
+				Storage: &model.Storage{
+					Name: "tmp",
+					Type: "local",
+					Path: tmp,
+				},
+			},
+			FileRanges: []model.FileRange{
+				{Offset: 0, Length: split, File: file},
+				{Offset: split, Length: stat.Size() - split, File: file},
+			},
+		}
+		err := db.Create(&job).Error
+		require.NoError(t, err)
+		car, err := PackAndValidate(ctx, db, job)
+		require.NoError(t, err)
+		require.Equal(t, 2, car.EncryptionKeyVersion)
+
+		f, err := os.Open(filepath.Join(out, car.StoragePath))
+		require.NoError(t, err)
+		defer f.Close()
+		reader, err := carv1.NewCarReader(f)
+		require.NoError(t, err)
+		blks := make(map[cid.Cid][]byte)
+		for {
+			blk, err := reader.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			blks[blk.Cid()] = blk.RawData()
+		}
+
+		var fileRanges []model.FileRange
+		err = db.Order("\"offset\"").Find(&fileRanges).Error
+		require.NoError(t, err)
+		require.Len(t, fileRanges, 2)
+		var decrypted []byte
+		for _, fileRange := range fileRanges {
+			// Each range fits in a single leaf, which is its age file
+			ciphertext, ok := blks[cid.Cid(fileRange.CID)]
+			require.True(t, ok)
+			require.EqualValues(t, len(ciphertext), fileRange.EncryptedLength)
+			require.NotContains(t, string(ciphertext), "singularity")
+			r, err := age.Decrypt(bytes.NewReader(ciphertext), identity)
+			require.NoError(t, err)
+			content, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.EqualValues(t, fileRange.Length, len(content))
+			decrypted = append(decrypted, content...)
+		}
+		require.Equal(t, plaintext, decrypted)
+
+		var packed model.File
+		err = db.First(&packed).Error
+		require.NoError(t, err)
+		// The file links the age files of its ranges
+		require.NotEqual(t, cid.Undef, cid.Cid(packed.CID))
+		require.NotEqual(t, fileRanges[0].CID, packed.CID)
+	})
+}
+
+// prefixKMS wraps random data keys by prefixing them, so they can be unwrapped without a master key.
+type prefixKMS struct{}
+
+func (prefixKMS) GenerateDataKey(context.Context) ([]byte, []byte, error) {
+	key := testutil.GenerateRandomBytes(encryption.KeySize)
+	return key, append([]byte("wrapped:"), key...), nil
+}
+
+func (prefixKMS) Decrypt(_ context.Context, wrapped []byte) ([]byte, error) {
+	return bytes.TrimPrefix(wrapped, []byte("wrapped:")), nil
+}
+
+func TestPack_EncryptionKMSKey(t *testing.T) {
+	encryption.RegisterKMS("prefix", func(context.Context, string) (encryption.KMS, error) { return prefixKMS{}, nil })
+	tmp := t.TempDir()
+	plaintext := bytes.Repeat([]byte("singularity"), 30_000)
+	err := os.WriteFile(filepath.Join(tmp, "test.txt"), plaintext, 0644)
+	require.NoError(t, err)
+	stat, err := os.Stat(filepath.Join(tmp, "test.txt"))
+	require.NoError(t, err)
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		out := t.TempDir()
+		file := &model.File{
+			Path:             "test.txt",
+			Size:             stat.Size(),
+			LastModifiedNano: stat.ModTime().UnixNano(),
+			AttachmentID:     1,
+			Directory: &model.Directory{
+				AttachmentID: 1,
+			},
+		}
+		// The file is split into two ranges, which are encrypted with a data key each
+		split := stat.Size() / 3
+		job := model.Job{
+			Type:  model.Pack,
+			State: model.Processing,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{
+					MaxSize:              2000000,
+					PieceSize:            1 << 21,
+					NoInline:             true,
+					EncryptionKMSKey:     "prefix://key",
+					EncryptionKeyVersion: 1,
+					OutputStorages:       []model.Storage{{Name: "out", Type: "local", Path: out}},
+				},
+				Storage: &model.Storage{
+					Name: "tmp",
+					Type: "local",
+					Path: tmp,
+				},
+			},
+			FileRanges: []model.FileRange{
+				{Offset: 0, Length: split, File: file},
+				{Offset: split, Length: stat.Size() - split, File: file},
+			},
+		}
+		err := db.Create(&job).Error
+		require.NoError(t, err)
+		car, err := PackAndValidate(ctx, db, job)
+		require.NoError(t, err)
+		require.Equal(t, 1, car.EncryptionKeyVersion)
+
+		f, err := os.Open(filepath.Join(out, car.StoragePath))
+		require.NoError(t, err)
+		defer f.Close()
+		reader, err := carv1.NewCarReader(f)
+		require.NoError(t, err)
+		blks := make(map[cid.Cid][]byte)
+		for {
+			blk, err := reader.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			blks[blk.Cid()] = blk.RawData()
+		}
+
+		var fileRanges []model.FileRange
+		err = db.Order("\"offset\"").Find(&fileRanges).Error
+		require.NoError(t, err)
+		require.Len(t, fileRanges, 2)
+		require.NotEqual(t, fileRanges[0].WrappedKey, fileRanges[1].WrappedKey)
+		var decrypted []byte
+		for _, fileRange := range fileRanges {
+			// Each range fits in a single leaf, which is its encryption
+			ciphertext, ok := blks[cid.Cid(fileRange.CID)]
+			require.True(t, ok)
+			require.EqualValues(t, len(ciphertext), fileRange.EncryptedLength)
+			require.NotContains(t, string(ciphertext), "singularity")
+			key, err := prefixKMS{}.Decrypt(ctx, fileRange.WrappedKey)
+			require.NoError(t, err)
+			r, err := encryption.NewGCMDecryptReader(bytes.NewReader(ciphertext), key)
+			require.NoError(t, err)
+			content, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.EqualValues(t, fileRange.Length, len(content))
+			decrypted = append(decrypted, content...)
+		}
+		require.Equal(t, plaintext, decrypted)
+	})
+}
+
+func TestCheckCommP(t *testing.T) {
+	data := testutil.GenerateRandomBytes(1000)
+	calc := &commp.Calc{}
+	_, err := calc.Write(data)
+	require.NoError(t, err)
+	expected, _, err := GetCommp(calc, 1<<20)
+	require.NoError(t, err)
+
+	err = checkCommP(bytes.NewReader(data), expected, 1<<20, CommPFromOutputStorage)
+	require.NoError(t, err)
+
+	data[500] ^= 0xff
+	err = checkCommP(bytes.NewReader(data), expected, 1<<20, CommPFromOutputStorage)
+	require.ErrorIs(t, err, ErrCommPMismatch)
+	var mismatch CommPMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	require.Equal(t, expected, mismatch.Expected)
+	require.NotEqual(t, expected, mismatch.Actual)
+	require.Equal(t, CommPFromOutputStorage, mismatch.From)
+	require.Contains(t, err.Error(), "read back from the output storage")
+
+	err = checkCommP(bytes.NewReader(data), expected, 1<<20, CommPFromSource)
+	require.ErrorAs(t, err, &mismatch)
+	require.Equal(t, CommPFromSource, mismatch.From)
+	require.Contains(t, err.Error(), "regenerated from the source")
+}
+
+func TestGetCommp_64GiB(t *testing.T) {
+	data := testutil.GenerateRandomBytes(1000)
+	getCommp := func(targetPieceSize uint64) (cid.Cid, uint64, error) {
+		calc := &commp.Calc{}
+		_, err := calc.Write(data)
+		require.NoError(t, err)
+		return GetCommp(calc, targetPieceSize)
+	}
+	commp32, size, err := getCommp(32 << 30)
+	require.NoError(t, err)
+	require.EqualValues(t, 32<<30, size)
+	commp64, size, err := getCommp(util.MaxPieceSize)
+	require.NoError(t, err)
+	require.EqualValues(t, util.MaxPieceSize, size)
+	require.NotEqual(t, commp32, commp64)
+
+	_, _, err = getCommp(util.MaxPieceSize * 2)
+	require.Error(t, err)
+}
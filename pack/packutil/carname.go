@@ -0,0 +1,108 @@
+package packutil
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/ipfs/go-cid"
+	"golang.org/x/exp/slices"
+)
+
+var ErrInvalidCarNameTemplate = errors.New("invalid CAR filename template")
+
+// DefaultCarNameTemplate names the CAR files after their piece CID, which is how they are named unless configured
+// otherwise.
+const DefaultCarNameTemplate = "{piece_cid}"
+
+// MaxCarShardDepth is the largest number of levels of subdirectories the CAR files can be sharded into. Each level
+// has up to 1024 subdirectories, so 3 levels are enough for a billion CAR files.
+const MaxCarShardDepth = 3
+
+var carNamePlaceholder = regexp.MustCompile(`\{[^{}]*}`)
+
+// CarNamePlaceholders are the placeholders of a CAR filename template:
+//   - {piece_cid}: The piece CID of the CAR file. Every template must have it, so that the names are unique.
+//   - {preparation}: The name of the preparation.
+//   - {sequence}: The number of the CAR file within the preparation, starting from 1, padded to 6 digits. CAR files
+//     packed at the same time by several workers may get the same number.
+//   - {date}: The date the CAR file is packed, in UTC, as 2006-01-02.
+var CarNamePlaceholders = []string{"{piece_cid}", "{preparation}", "{sequence}", "{date}"}
+
+// CarNameValues are the values of the placeholders of a CAR filename template.
+type CarNameValues struct {
+	PieceCID    cid.Cid
+	Preparation string
+	Sequence    int64
+	Time        time.Time
+}
+
+// ValidateCarNameTemplate checks that a CAR filename template only has known placeholders, has the piece CID, and
+// is a relative path that stays inside the output storage.
+//
+// Parameters:
+//   - template: The template of the name of the CAR files, without the .car extension. Empty means
+//     DefaultCarNameTemplate. It can have slashes to put the CAR files in subdirectories, i.e. {preparation}/{date}/{piece_cid}.
+//
+// Returns:
+//   - ErrInvalidCarNameTemplate if the template is not valid.
+func ValidateCarNameTemplate(template string) error {
+	if template == "" {
+		return nil
+	}
+	for _, placeholder := range carNamePlaceholder.FindAllString(template, -1) {
+		if !slices.Contains(CarNamePlaceholders, placeholder) {
+			return errors.Wrapf(ErrInvalidCarNameTemplate, "unknown placeholder %s, must be one of %v", placeholder, CarNamePlaceholders)
+		}
+	}
+	if !strings.Contains(template, "{piece_cid}") {
+		return errors.Wrap(ErrInvalidCarNameTemplate, "the template must have {piece_cid}, so that the names of the CAR files are unique")
+	}
+	if strings.ContainsAny(carNamePlaceholder.ReplaceAllString(template, ""), "\\{}") {
+		return errors.Wrap(ErrInvalidCarNameTemplate, "the template cannot have backslashes or unmatched braces")
+	}
+	for _, segment := range strings.Split(template, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return errors.Wrapf(ErrInvalidCarNameTemplate, "%s is not a relative path inside the output storage", template)
+		}
+	}
+	return nil
+}
+
+// CarName renders the name of a CAR file from a template, and shards it into subdirectories named after the
+// characters of the piece CID. All piece CIDs start with the same characters, so the subdirectories are named after
+// the characters next to the last, two characters per level, like the flatfs datastore of IPFS. With a depth of 2,
+// a CAR file whose piece CID ends with ...vwxyz is put in xy/vw/.
+//
+// Parameters:
+//   - template: The template of the name, as validated by ValidateCarNameTemplate. Empty means DefaultCarNameTemplate.
+//   - shardDepth: The number of levels of subdirectories, up to MaxCarShardDepth. 0 disables sharding.
+//   - values: The values of the placeholders.
+//
+// Returns:
+//   - The path of the CAR file in the output storage, without the .car extension.
+func CarName(template string, shardDepth int, values CarNameValues) string {
+	if template == "" {
+		template = DefaultCarNameTemplate
+	}
+	pieceCID := values.PieceCID.String()
+	name := strings.NewReplacer(
+		"{piece_cid}", pieceCID,
+		"{preparation}", strings.ReplaceAll(values.Preparation, "/", "_"),
+		"{sequence}", fmt.Sprintf("%06d", values.Sequence),
+		"{date}", values.Time.UTC().Format("2006-01-02"),
+	).Replace(template)
+
+	var shards []string
+	for i := 0; i < shardDepth && i < MaxCarShardDepth; i++ {
+		end := len(pieceCID) - 1 - 2*i
+		if end-2 < 0 {
+			break
+		}
+		shards = append(shards, pieceCID[end-2:end])
+	}
+	return path.Join(append(shards, name)...)
+}
@@ -0,0 +1,43 @@
+package packutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCarNameTemplate(t *testing.T) {
+	for _, template := range []string{"", DefaultCarNameTemplate, "{preparation}/{date}/{piece_cid}", "car-{sequence}-{piece_cid}"} {
+		require.NoError(t, ValidateCarNameTemplate(template), template)
+	}
+	for _, template := range []string{
+		"{preparation}",
+		"{piece_cid}-{dataset}",
+		"{piece_cid}}",
+		"{piece_cid}\\{date}",
+		"/{piece_cid}",
+		"{preparation}//{piece_cid}",
+		"../{piece_cid}",
+		"{piece_cid}/.",
+	} {
+		require.ErrorIs(t, ValidateCarNameTemplate(template), ErrInvalidCarNameTemplate, template)
+	}
+}
+
+func TestCarName(t *testing.T) {
+	pieceCID := cid.MustParse("baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq")
+	values := CarNameValues{
+		PieceCID:    pieceCID,
+		Preparation: "my/prep",
+		Sequence:    42,
+		Time:        time.Date(2024, 3, 1, 23, 0, 0, 0, time.FixedZone("", -3600)),
+	}
+
+	require.Equal(t, pieceCID.String(), CarName("", 0, values))
+	require.Equal(t, "my_prep/2024-03-02/000042-"+pieceCID.String(),
+		CarName("{preparation}/{date}/{sequence}-{piece_cid}", 0, values))
+	require.Equal(t, "mp/x2/"+pieceCID.String(), CarName("", 2, values))
+	require.Equal(t, "mp/x2/y6/"+pieceCID.String(), CarName("", 3, values))
+}
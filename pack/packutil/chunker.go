@@ -0,0 +1,73 @@
+package packutil
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dustin/go-humanize"
+	chunk "github.com/ipfs/boxo/chunker"
+)
+
+// DefaultChunker splits files into leaf blocks of ChunkSize bytes, which is how files are chunked unless configured
+// otherwise.
+const DefaultChunker = "size-1048576"
+
+var ErrInvalidChunker = errors.New("invalid chunker")
+
+// ParseChunker validates a chunking strategy and returns it in the form understood by the IPFS chunkers, so it can be
+// compared with the chunker of ipfs add. The strategies are the ones of ipfs add:
+//   - size-{size}: fixed size chunks, i.e. size-262144, which is the default of ipfs add.
+//   - rabin, rabin-{avg} or rabin-{min}-{avg}-{max}: content defined chunks with a rabin fingerprint.
+//   - buzhash: content defined chunks with a buzhash, of 128KiB to 512KiB.
+//
+// Sizes can be written with units, i.e. rabin-256KiB-512KiB-1MiB, and no chunk can be larger than 1MiB.
+//
+// Parameters:
+//   - chunker: The chunking strategy. Empty means DefaultChunker.
+//
+// Returns:
+//   - The chunking strategy with the sizes in bytes.
+//   - ErrInvalidChunker if the chunking strategy is not supported.
+func ParseChunker(chunker string) (string, error) {
+	if chunker == "" {
+		return DefaultChunker, nil
+	}
+	parts := strings.Split(chunker, "-")
+	for i := 1; i < len(parts); i++ {
+		label, value, found := strings.Cut(parts[i], ":")
+		if !found {
+			value, label = label, ""
+		}
+		size, err := humanize.ParseBytes(value)
+		if err != nil {
+			return "", errors.Wrapf(ErrInvalidChunker, "invalid size %s of chunker %s", value, chunker)
+		}
+		parts[i] = strconv.FormatUint(size, 10)
+		if found {
+			parts[i] = label + ":" + parts[i]
+		}
+	}
+	normalized := strings.Join(parts, "-")
+	_, err := chunk.FromString(bytes.NewReader(nil), normalized)
+	if err != nil {
+		return "", errors.Wrapf(ErrInvalidChunker, "chunker %s: %s", chunker, err.Error())
+	}
+	return normalized, nil
+}
+
+// NewSplitter returns the splitter that chunks the content of a file into leaf blocks, according to the chunker of
+// the CID options.
+func (o CidOptions) NewSplitter(r io.Reader) (chunk.Splitter, error) {
+	chunker := o.Chunker
+	if chunker == "" {
+		chunker = DefaultChunker
+	}
+	splitter, err := chunk.FromString(r, chunker)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidChunker, "chunker %s: %s", chunker, err.Error())
+	}
+	return splitter, nil
+}
@@ -0,0 +1,46 @@
+package packutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChunker(t *testing.T) {
+	for input, expected := range map[string]string{
+		"":                               DefaultChunker,
+		"size-262144":                    "size-262144",
+		"size-256KiB":                    "size-262144",
+		"rabin":                          "rabin",
+		"rabin-256KiB":                   "rabin-262144",
+		"rabin-256KiB-512KiB-1MiB":       "rabin-262144-524288-1048576",
+		"rabin-min:16-avg:1024-max:4096": "rabin-min:16-avg:1024-max:4096",
+		"buzhash":                        "buzhash",
+	} {
+		actual, err := ParseChunker(input)
+		require.NoError(t, err, input)
+		require.Equal(t, expected, actual, input)
+	}
+
+	for _, input := range []string{"size", "size-0", "size-2MiB", "size-abc", "rabin-1MiB-2MiB-4MiB", "rabin-8-16-32", "fastcdc"} {
+		_, err := ParseChunker(input)
+		require.ErrorIs(t, err, ErrInvalidChunker, input)
+	}
+}
+
+func TestCidOptions_NewSplitter(t *testing.T) {
+	options := DefaultCidOptions
+	options.Chunker = "size-4"
+	splitter, err := options.NewSplitter(bytes.NewReader([]byte("hello world")))
+	require.NoError(t, err)
+	var chunks []string
+	for {
+		data, err := splitter.NextBytes()
+		if err != nil {
+			break
+		}
+		chunks = append(chunks, string(data))
+	}
+	require.Equal(t, []string{"hell", "o wo", "rld"}, chunks)
+}
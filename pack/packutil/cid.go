@@ -0,0 +1,177 @@
+package packutil
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-merkledag"
+	"github.com/ipfs/go-unixfs"
+	"github.com/multiformats/go-multihash"
+)
+
+var ErrUnsupportedCidOptions = errors.New("unsupported CID options")
+
+// MaxSmallFileLimit is the size of the largest file that can be embedded in its CID, which is the largest identity
+// digest accepted by the IPFS implementations.
+const MaxSmallFileLimit = 128
+
+// CidOptions decides how the CIDs of the blocks of a preparation are built. The hash function and the CID version are
+// used for all blocks, while the leaf codec only applies to the blocks holding the content of files. The intermediate
+// nodes of files and the directories are always dag-pb. The chunker decides where the content of files is split into
+// leaf blocks.
+type CidOptions struct {
+	HashFunction uint64 // Multihash code of the hash function, i.e. multihash.SHA2_256
+	LeafCodec    uint64 // Codec of the leaf blocks, either cid.Raw or cid.DagProtobuf
+	CidVersion   uint64 // Version of the CIDs, either 0 or 1. CIDv0 is only used with sha2-256 and dag-pb leaves
+	Chunker      string // Chunking strategy of the content of files, as returned by ParseChunker
+	// SmallFileLimit is the size of the largest file that is embedded in its CID with the identity hash, rather than
+	// written as a block, so its content ends up in the directory that links to it. 0 disables it.
+	SmallFileLimit int
+}
+
+// DefaultCidOptions builds sha2-256 CIDv1 with raw leaves, which is how the CIDs of a preparation are built unless
+// configured otherwise.
+var DefaultCidOptions = CidOptions{
+	HashFunction: multihash.SHA2_256,
+	LeafCodec:    cid.Raw,
+	CidVersion:   1,
+	Chunker:      DefaultChunker,
+}
+
+// NewCidOptions returns the CID options for the hash function, the leaf codec, the CID version and the chunker of a
+// preparation. Only the hash functions and codecs that are understood by the Filecoin retrieval clients and the IPFS
+// implementations are supported.
+//
+// Parameters:
+//   - hashFunction: The hash function, i.e. sha2-256 or blake2b-256. Empty means sha2-256.
+//   - leafCodec: The codec of the leaf blocks, i.e. raw or dag-pb. Empty means raw.
+//   - cidVersion: The version of the CIDs, i.e. v1 or v0. Empty means v1.
+//   - chunker: The chunking strategy, i.e. size-262144 or rabin-262144-524288-1048576. Empty means DefaultChunker.
+//
+// Returns:
+//   - The CID options.
+//   - ErrUnsupportedCidOptions if the hash function, the codec, the CID version or the chunker is not supported, or
+//     if a CIDv0 is requested for blocks that cannot have one.
+func NewCidOptions(hashFunction model.HashFunction, leafCodec model.LeafCodec, cidVersion model.CidVersion, chunker string) (CidOptions, error) {
+	options := DefaultCidOptions
+	switch hashFunction {
+	case "", model.HashSHA256:
+	case model.HashBlake2b256:
+		options.HashFunction = multihash.BLAKE2B_MIN + 31
+	default:
+		return CidOptions{}, errors.Wrapf(ErrUnsupportedCidOptions, "hash function %s is not supported", hashFunction)
+	}
+	switch leafCodec {
+	case "", model.LeafCodecRaw:
+	case model.LeafCodecDagPB:
+		options.LeafCodec = cid.DagProtobuf
+	default:
+		return CidOptions{}, errors.Wrapf(ErrUnsupportedCidOptions, "leaf codec %s is not supported", leafCodec)
+	}
+	switch cidVersion {
+	case "", model.CidV1:
+	case model.CidV0:
+		// A CIDv0 is a bare sha2-256 multihash, which implies dag-pb
+		if options.HashFunction != multihash.SHA2_256 || options.LeafCodec != cid.DagProtobuf {
+			return CidOptions{}, errors.Wrap(ErrUnsupportedCidOptions, "CIDv0 requires the sha2-256 hash function and the dag-pb leaf codec")
+		}
+		options.CidVersion = 0
+	default:
+		return CidOptions{}, errors.Wrapf(ErrUnsupportedCidOptions, "CID version %s is not supported", cidVersion)
+	}
+	var err error
+	options.Chunker, err = ParseChunker(chunker)
+	if err != nil {
+		return CidOptions{}, errors.Wrapf(ErrUnsupportedCidOptions, "%s", err.Error())
+	}
+	return options, nil
+}
+
+// NodePrefix returns the CID prefix of the dag-pb nodes, i.e. the intermediate nodes of files and the directories.
+func (o CidOptions) NodePrefix() cid.Prefix {
+	return cid.Prefix{
+		Version:  o.CidVersion,
+		Codec:    cid.DagProtobuf,
+		MhType:   o.HashFunction,
+		MhLength: -1,
+	}
+}
+
+// NewLeaf creates the leaf block holding a chunk of a file. A raw leaf holds the chunk as is, so it shares the
+// underlying array of the chunk, while a dag-pb leaf wraps it in a UnixFS file node.
+//
+// Parameters:
+//   - data: The chunk of the file.
+//
+// Returns:
+//   - The leaf block.
+//   - An error, if the chunk cannot be hashed.
+func (o CidOptions) NewLeaf(data []byte) (blocks.Block, error) {
+	if o.LeafCodec == cid.DagProtobuf {
+		node := merkledag.NodeWithData(unixfs.FilePBData(data, uint64(len(data))))
+		err := node.SetCidBuilder(o.NodePrefix())
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return node, nil
+	}
+	c, err := cid.Prefix{
+		Version:  1,
+		Codec:    cid.Raw,
+		MhType:   o.HashFunction,
+		MhLength: -1,
+	}.Sum(data)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	blk, err := blocks.NewBlockWithCid(data, c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return blk, nil
+}
+
+// NewInlineCid returns the CID that embeds a whole file with the identity hash, if the file is no larger than the
+// small file limit and the leaves are raw.
+//
+// Parameters:
+//   - data: The content of the whole file.
+//
+// Returns:
+//   - The identity CID of the file.
+//   - Whether the file can be embedded in its CID.
+func (o CidOptions) NewInlineCid(data []byte) (cid.Cid, bool) {
+	if o.SmallFileLimit <= 0 || len(data) > o.SmallFileLimit || o.LeafCodec != cid.Raw {
+		return cid.Undef, false
+	}
+	c, err := cid.Prefix{
+		Version:  1,
+		Codec:    cid.Raw,
+		MhType:   multihash.IDENTITY,
+		MhLength: -1,
+	}.Sum(data)
+	if err != nil {
+		return cid.Undef, false
+	}
+	return c, true
+}
+
+// InlineData returns the content of a file embedded in its CID by NewInlineCid.
+//
+// Parameters:
+//   - c: The CID of the file.
+//
+// Returns:
+//   - The content of the file.
+//   - Whether the CID embeds the content of the file.
+func InlineData(c cid.Cid) ([]byte, bool) {
+	if !c.Defined() || c.Type() != cid.Raw || c.Prefix().MhType != multihash.IDENTITY {
+		return nil, false
+	}
+	decoded, err := multihash.Decode(c.Hash())
+	if err != nil {
+		return nil, false
+	}
+	return decoded.Digest, true
+}
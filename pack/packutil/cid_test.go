@@ -0,0 +1,131 @@
+package packutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/go-merkledag"
+	"github.com/ipfs/go-unixfs"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCidOptions(t *testing.T) {
+	options, err := NewCidOptions("", "", "", "")
+	require.NoError(t, err)
+	require.Equal(t, DefaultCidOptions, options)
+	require.Equal(t, merkledag.V1CidPrefix(), options.NodePrefix())
+
+	options, err = NewCidOptions(model.HashBlake2b256, model.LeafCodecDagPB, "", "")
+	require.NoError(t, err)
+	require.EqualValues(t, multihash.Names["blake2b-256"], options.HashFunction)
+	require.EqualValues(t, cid.DagProtobuf, options.LeafCodec)
+
+	_, err = NewCidOptions("md5", "", "", "")
+	require.ErrorIs(t, err, ErrUnsupportedCidOptions)
+	_, err = NewCidOptions("", "dag-cbor", "", "")
+	require.ErrorIs(t, err, ErrUnsupportedCidOptions)
+
+	options, err = NewCidOptions(model.HashSHA256, model.LeafCodecDagPB, model.CidV0, "")
+	require.NoError(t, err)
+	require.EqualValues(t, 0, options.CidVersion)
+	require.Equal(t, merkledag.V0CidPrefix(), options.NodePrefix())
+
+	_, err = NewCidOptions(model.HashSHA256, model.LeafCodecRaw, model.CidV0, "")
+	require.ErrorIs(t, err, ErrUnsupportedCidOptions)
+	_, err = NewCidOptions(model.HashBlake2b256, model.LeafCodecDagPB, model.CidV0, "")
+	require.ErrorIs(t, err, ErrUnsupportedCidOptions)
+	_, err = NewCidOptions("", "", "v2", "")
+	require.ErrorIs(t, err, ErrUnsupportedCidOptions)
+}
+
+func TestCidOptions_NewLeaf(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		blk, err := DefaultCidOptions.NewLeaf(nil)
+		require.NoError(t, err)
+		require.Equal(t, EmptyFileCid, blk.Cid())
+	})
+
+	t.Run("blake2b-256", func(t *testing.T) {
+		options, err := NewCidOptions(model.HashBlake2b256, model.LeafCodecRaw, "", "")
+		require.NoError(t, err)
+		blk, err := options.NewLeaf([]byte("hello"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("hello"), blk.RawData())
+		require.EqualValues(t, cid.Raw, blk.Cid().Type())
+		require.EqualValues(t, multihash.Names["blake2b-256"], blk.Cid().Prefix().MhType)
+		actual, err := blk.Cid().Prefix().Sum([]byte("hello"))
+		require.NoError(t, err)
+		require.Equal(t, blk.Cid(), actual)
+	})
+
+	t.Run("dag-pb", func(t *testing.T) {
+		options, err := NewCidOptions(model.HashSHA256, model.LeafCodecDagPB, "", "")
+		require.NoError(t, err)
+		blk, err := options.NewLeaf([]byte("hello"))
+		require.NoError(t, err)
+		require.EqualValues(t, cid.DagProtobuf, blk.Cid().Type())
+		node, err := merkledag.DecodeProtobuf(blk.RawData())
+		require.NoError(t, err)
+		fsNode, err := unixfs.FSNodeFromBytes(node.Data())
+		require.NoError(t, err)
+		require.Equal(t, []byte("hello"), fsNode.Data())
+		require.EqualValues(t, 5, fsNode.FileSize())
+	})
+
+	t.Run("cidv0", func(t *testing.T) {
+		options, err := NewCidOptions(model.HashSHA256, model.LeafCodecDagPB, model.CidV0, "")
+		require.NoError(t, err)
+		blk, err := options.NewLeaf([]byte("hello"))
+		require.NoError(t, err)
+		require.EqualValues(t, 0, blk.Cid().Version())
+		require.True(t, strings.HasPrefix(blk.Cid().String(), "Qm"))
+		actual, err := merkledag.V0CidPrefix().Sum(blk.RawData())
+		require.NoError(t, err)
+		require.Equal(t, blk.Cid(), actual)
+	})
+}
+
+func TestCidOptions_AssembleFileFromLinks(t *testing.T) {
+	options, err := NewCidOptions(model.HashBlake2b256, model.LeafCodecRaw, "", "")
+	require.NoError(t, err)
+	var links []format.Link
+	for _, data := range []string{"hello", "world"} {
+		blk, err := options.NewLeaf([]byte(data))
+		require.NoError(t, err)
+		links = append(links, format.Link{Cid: blk.Cid(), Size: uint64(len(data))})
+	}
+	blks, node, err := options.AssembleFileFromLinks(links)
+	require.NoError(t, err)
+	require.Len(t, blks, 1)
+	require.EqualValues(t, cid.DagProtobuf, node.Cid().Type())
+	require.EqualValues(t, options.HashFunction, node.Cid().Prefix().MhType)
+}
+
+func TestCidOptions_NewInlineCid(t *testing.T) {
+	options := DefaultCidOptions
+	_, ok := options.NewInlineCid([]byte("hello"))
+	require.False(t, ok)
+
+	options.SmallFileLimit = 5
+	c, ok := options.NewInlineCid([]byte("hello"))
+	require.True(t, ok)
+	require.EqualValues(t, cid.Raw, c.Type())
+	require.EqualValues(t, multihash.IDENTITY, c.Prefix().MhType)
+	data, ok := InlineData(c)
+	require.True(t, ok)
+	require.Equal(t, []byte("hello"), data)
+
+	_, ok = options.NewInlineCid([]byte("hello!"))
+	require.False(t, ok)
+
+	options.LeafCodec = cid.DagProtobuf
+	_, ok = options.NewInlineCid([]byte("hello"))
+	require.False(t, ok)
+
+	_, ok = InlineData(EmptyFileCid)
+	require.False(t, ok)
+}
@@ -33,6 +33,7 @@ const NumLinkPerNode = 1024
 // Parameters:
 //   - links: An array of format.Link objects. These links will be added as child
 //     links to the new ProtoNode.
+//   - prefix: The CID prefix of the new parent node.
 //
 // Returns:
 //   - *merkledag.ProtoNode: A pointer to the new parent ProtoNode that has been
@@ -41,7 +42,7 @@ const NumLinkPerNode = 1024
 //     is the sum of the sizes of all the links.
 //   - error: An error that can occur during the creation of the new parent node, or
 //     nil if the operation was successful.
-func createParentNode(links []format.Link) (*merkledag.ProtoNode, uint64, error) {
+func createParentNode(links []format.Link, prefix cid.Prefix) (*merkledag.ProtoNode, uint64, error) {
 	node := unixfs.NewFSNode(unixfs_pb.Data_File)
 	total := uint64(0)
 	for _, link := range links {
@@ -53,7 +54,7 @@ func createParentNode(links []format.Link) (*merkledag.ProtoNode, uint64, error)
 		return nil, 0, errors.WithStack(err)
 	}
 	pbNode := merkledag.NodeWithData(nodeBytes)
-	err = pbNode.SetCidBuilder(merkledag.V1CidPrefix())
+	err = pbNode.SetCidBuilder(prefix)
 	if err != nil {
 		return nil, 0, errors.WithStack(err)
 	}
@@ -92,6 +93,12 @@ var errLinkLessThanTwo = errors.New("links must be more than 1")
 //   - error: An error that can occur during the MerkleDAG creation process,
 //     or nil if the operation was successful.
 func AssembleFileFromLinks(links []format.Link) ([]blocks.Block, *merkledag.ProtoNode, error) {
+	return DefaultCidOptions.AssembleFileFromLinks(links)
+}
+
+// AssembleFileFromLinks constructs a MerkleDAG from a list of links, like the package level AssembleFileFromLinks,
+// with the intermediate nodes hashed by the hash function of the CID options.
+func (o CidOptions) AssembleFileFromLinks(links []format.Link) ([]blocks.Block, *merkledag.ProtoNode, error) {
 	if len(links) <= 1 {
 		return nil, nil, errLinkLessThanTwo
 	}
@@ -100,7 +107,7 @@ func AssembleFileFromLinks(links []format.Link) ([]blocks.Block, *merkledag.Prot
 	for len(links) > 1 {
 		newLinks := make([]format.Link, 0)
 		for start := 0; start < len(links); start += NumLinkPerNode {
-			newNode, total, err := createParentNode(links[start:Min(start+NumLinkPerNode, len(links))])
+			newNode, total, err := createParentNode(links[start:Min(start+NumLinkPerNode, len(links))], o.NodePrefix())
 			if err != nil {
 				return nil, nil, errors.WithStack(err)
 			}
@@ -31,7 +31,7 @@ func TestCreateParentNode(t *testing.T) {
 		},
 	}
 
-	node, size, err := createParentNode(links)
+	node, size, err := createParentNode(links, DefaultCidOptions.NodePrefix())
 	require.NoError(t, err)
 	require.Equal(t, uint64(10), size)
 	require.NotNil(t, node)
@@ -57,7 +57,15 @@ func (r *FileRangeSet) FileRangeIDs() []model.FileRangeID {
 	})
 }
 
+// UnknownSizeEstimate is the size assumed for file ranges of unknown length, i.e. Google Docs that are exported when
+// they are read, when deciding whether they fit in a CAR file. The Google Drive API does not export documents larger
+// than 10 MiB.
+const UnknownSizeEstimate int64 = 10 << 20
+
 func toCarSize(size int64) int64 {
+	if size < 0 {
+		size = UnknownSizeEstimate
+	}
 	if size == 0 {
 		return 37
 	}
@@ -26,6 +26,7 @@ func TestToCarSize(t *testing.T) {
 		{10485760, 10486714, 10486697},
 		{104857600, 104866744, 104866548},
 	}
+	require.Equal(t, toCarSize(UnknownSizeEstimate), toCarSize(-1))
 	for _, job := range jobs {
 		t.Run(fmt.Sprintf("%d", job.origin), func(t *testing.T) {
 			require.Equal(t, job.estimate, toCarSize(job.origin))
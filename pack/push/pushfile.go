@@ -2,6 +2,7 @@ package push
 
 import (
 	"context"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -17,6 +18,20 @@ import (
 
 var logger = logging.Logger("pushfile")
 
+var ErrDirectoryTooDeep = errors.New("file is nested deeper than the maximum directory depth")
+
+var ErrNotCarFile = errors.New("file is not a CAR file")
+
+// IsCarFile returns whether a file is a CAR file, which are the only files of the sources of a CAR source preparation.
+func IsCarFile(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".car")
+}
+
+// DirectoryDepth returns the number of directories a file is nested in, relative to the root of the source.
+func DirectoryDepth(path string) int {
+	return strings.Count(path, "/")
+}
+
 func MaxSizeToSplitSize(m int64) int64 {
 	r := util.NextPowerOfTwo(uint64(m)) / 4
 	if r > 1<<30 {
@@ -52,8 +67,28 @@ func PushFile(
 	obj fs.ObjectInfo,
 	attachment model.SourceAttachment,
 	directoryCache map[string]model.DirectoryID) (*model.File, []model.FileRange, error) {
+	return PushFileWithEventTime(ctx, db, obj, attachment, directoryCache, time.Time{})
+}
+
+// PushFileWithEventTime is PushFile for a file created by an event, i.e. an S3 event notification. If the preparation
+// is partitioned, the file is organized into the partition of the event time instead of its modification time.
+// A zero event time falls back to the modification time.
+func PushFileWithEventTime(
+	ctx context.Context,
+	db *gorm.DB,
+	obj fs.ObjectInfo,
+	attachment model.SourceAttachment,
+	directoryCache map[string]model.DirectoryID,
+	eventTime time.Time) (*model.File, []model.FileRange, error) {
 	logger.Debugw("pushing file", "file", obj.Remote(), "preparation", attachment.PreparationID, "storage", attachment.StorageID)
 	db = db.WithContext(ctx)
+	maxDepth := attachment.Preparation.MaxDirectoryDepth
+	if maxDepth > 0 && DirectoryDepth(obj.Remote()) > maxDepth {
+		return nil, nil, errors.Wrapf(ErrDirectoryTooDeep, "%s has depth %d, maximum is %d", obj.Remote(), DirectoryDepth(obj.Remote()), maxDepth)
+	}
+	if attachment.Preparation.CarSource && !IsCarFile(obj.Remote()) {
+		return nil, nil, errors.Wrapf(ErrNotCarFile, "%s is not a .car file", obj.Remote())
+	}
 	splitSize := MaxSizeToSplitSize(attachment.Preparation.MaxSize)
 	rootID, err := attachment.RootDirectoryID(ctx, db)
 	if err != nil {
@@ -96,7 +131,20 @@ func PushFile(
 	}
 
 	logger.Infow("new file", "file", file)
-	err = EnsureParentDirectories(ctx, db, &file, rootID, directoryCache)
+	if partitionBy := attachment.Preparation.PartitionBy; partitionBy != model.PartitionNone {
+		if eventTime.IsZero() {
+			eventTime = lastModified
+		}
+		// The directories are created for the virtual path of the file, while the file keeps its path in the source
+		virtual := model.File{
+			AttachmentID: file.AttachmentID,
+			Path:         partitionBy.Directory(eventTime) + "/" + file.Path,
+		}
+		err = EnsureParentDirectories(ctx, db, &virtual, rootID, directoryCache)
+		file.DirectoryID = virtual.DirectoryID
+	} else {
+		err = EnsureParentDirectories(ctx, db, &file, rootID, directoryCache)
+	}
 	if err != nil {
 		return nil, nil, errors.WithStack(err)
 	}
@@ -136,6 +184,25 @@ func PushFile(
 	return &file, fileRanges, nil
 }
 
+// EnsureParentDirectories makes sure all parent directories of a file exist in the database,
+// and sets the DirectoryID of the file to its immediate parent.
+//
+// The directoryCache maps the path of a directory to its ID. The keys are substrings of the file paths,
+// so the paths of all ancestors of a deeply nested file share the memory of a single path.
+// The lookup starts from the immediate parent and walks up until a cached ancestor is found.
+// The ancestors that are not cached are then looked up by their compressed path with a single query,
+// so only the missing directories are created, regardless of how deep the file is nested.
+// Directories found without a compressed path, i.e. created by an older version, are given one.
+//
+// Parameters:
+//   - ctx: The context for database transactions.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - file: The file whose parent directories should exist. Its DirectoryID is updated in place.
+//   - rootDirID: The ID of the root directory of the source attachment.
+//   - directoryCache: The cache of directory paths to IDs, which is updated with the created directories.
+//
+// Returns:
+//   - An error, if any occurred while creating the directories.
 func EnsureParentDirectories(
 	ctx context.Context,
 	db *gorm.DB,
@@ -144,25 +211,62 @@ func EnsureParentDirectories(
 	if file.DirectoryID != nil {
 		return nil
 	}
+	parentEnd := strings.LastIndex(file.Path, "/")
 	last := rootDirID
-	segments := strings.Split(file.Path, "/")
-	for i, segment := range segments[:len(segments)-1] {
-		p := strings.Join(segments[:i+1], "/")
-		if dirID, ok := directoryCache[p]; ok {
+	start := 0
+	for end := parentEnd; end >= 0; end = strings.LastIndex(file.Path[:end], "/") {
+		if dirID, ok := directoryCache[file.Path[:end]]; ok {
 			last = dirID
-			continue
+			start = end + 1
+			break
+		}
+	}
+
+	if start <= parentEnd {
+		var paths []string
+		for end := parentEnd; end >= start; end = strings.LastIndex(file.Path[:end], "/") {
+			paths = append(paths, file.Path[:end])
 		}
+		attachment := model.SourceAttachment{ID: file.AttachmentID}
+		found, err := attachment.FindDirectories(ctx, db, paths)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for p, dir := range found {
+			directoryCache[p] = dir.ID
+		}
+		// The paths are ordered from the deepest
+		for _, p := range paths {
+			if dir, ok := found[p]; ok {
+				last = dir.ID
+				start = len(p) + 1
+				break
+			}
+		}
+	}
+
+	for start <= parentEnd {
+		end := start + strings.Index(file.Path[start:], "/")
+		p := file.Path[:end]
+		segment := file.Path[start:end]
+		pathKey := model.DirectoryPathKey(file.AttachmentID, p)
 		newDir := model.Directory{
 			AttachmentID: file.AttachmentID,
 			Name:         segment,
 			ParentID:     &last,
+			PathKey:      pathKey,
 		}
 		logger.Debugw("creating directory", "path", p, "dir", newDir)
 		err := database.DoRetry(ctx, func() error {
 			return db.Transaction(func(db *gorm.DB) error {
-				return db.
+				err := db.
 					Where("parent_id = ? AND name = ?", last, segment).
 					FirstOrCreate(&newDir).Error
+				if err != nil || newDir.PathKey != "" {
+					return err
+				}
+				newDir.PathKey = pathKey
+				return db.Model(&newDir).Update("path_key", pathKey).Error
 			})
 		})
 		if err != nil {
@@ -170,6 +274,7 @@ func EnsureParentDirectories(
 		}
 		directoryCache[p] = newDir.ID
 		last = newDir.ID
+		start = end + 1
 	}
 
 	file.DirectoryID = &last
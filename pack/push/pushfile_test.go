@@ -4,7 +4,9 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/storagesystem"
@@ -84,6 +86,88 @@ func TestPushFile(t *testing.T) {
 		require.NoError(t, err)
 		require.Nil(t, file)
 		require.Nil(t, fileRanges)
+
+		// File deeper than the maximum directory depth
+		err = os.MkdirAll(filepath.Join(tmp, "a", "b"), 0755)
+		require.NoError(t, err)
+		err = os.WriteFile(filepath.Join(tmp, "a", "b", "deep.txt"), []byte("hello world"), 0644)
+		require.NoError(t, err)
+		obj, err = f.NewObject(ctx, "a/b/deep.txt")
+		require.NoError(t, err)
+		attachment.Preparation.MaxDirectoryDepth = 1
+		_, _, err = PushFile(ctx, db, obj, attachment, cache)
+		require.ErrorIs(t, err, ErrDirectoryTooDeep)
+		attachment.Preparation.MaxDirectoryDepth = 2
+		file, _, err = PushFile(ctx, db, obj, attachment, cache)
+		require.NoError(t, err)
+		require.Equal(t, "a/b/deep.txt", file.Path)
+
+		// Only CAR files are pushed to a CAR source
+		err = os.WriteFile(filepath.Join(tmp, "readme.txt"), []byte("hello world"), 0644)
+		require.NoError(t, err)
+		obj, err = f.NewObject(ctx, "readme.txt")
+		require.NoError(t, err)
+		attachment.Preparation.CarSource = true
+		_, _, err = PushFile(ctx, db, obj, attachment, cache)
+		require.ErrorIs(t, err, ErrNotCarFile)
+		require.True(t, IsCarFile("snapshots/a.CAR"))
+	})
+}
+
+func TestPushFileWithEventTime_Partitioned(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		cache := map[string]model.DirectoryID{}
+		attachment := model.SourceAttachment{
+			Preparation: &model.Preparation{
+				MaxSize:     1 << 20,
+				PartitionBy: model.PartitionDay,
+			},
+			Storage: &model.Storage{},
+		}
+		err := db.Create(&attachment).Error
+		require.NoError(t, err)
+		root := model.Directory{
+			AttachmentID: attachment.ID,
+		}
+		err = db.Create(&root).Error
+		require.NoError(t, err)
+		tmp := t.TempDir()
+		err = os.MkdirAll(filepath.Join(tmp, "logs"), 0755)
+		require.NoError(t, err)
+		for _, name := range []string{"a.txt", "b.txt"} {
+			err = os.WriteFile(filepath.Join(tmp, "logs", name), []byte("hello world"), 0644)
+			require.NoError(t, err)
+		}
+		mtime := time.Date(2024, 6, 15, 13, 0, 0, 0, time.UTC)
+		err = os.Chtimes(filepath.Join(tmp, "logs", "a.txt"), mtime, mtime)
+		require.NoError(t, err)
+		_ = storagesystem.Backends
+		backend, err := fs.Find("local")
+		require.NoError(t, err)
+		f, err := backend.NewFs(ctx, "local", tmp, make(configmap.Simple))
+		require.NoError(t, err)
+
+		// Partitioned by the modification time
+		obj, err := f.NewObject(ctx, "logs/a.txt")
+		require.NoError(t, err)
+		file, _, err := PushFile(ctx, db, obj, attachment, cache)
+		require.NoError(t, err)
+		require.Equal(t, "logs/a.txt", file.Path)
+		require.Equal(t, cache["2024/06/15/logs"], *file.DirectoryID)
+
+		// Partitioned by the event time
+		obj, err = f.NewObject(ctx, "logs/b.txt")
+		require.NoError(t, err)
+		file, _, err = PushFileWithEventTime(ctx, db, obj, attachment, cache, time.Date(2023, 12, 31, 23, 0, 0, 0, time.FixedZone("", -3600)))
+		require.NoError(t, err)
+		require.Equal(t, "logs/b.txt", file.Path)
+		require.Equal(t, cache["2024/01/01/logs"], *file.DirectoryID)
+
+		var dir model.Directory
+		err = db.First(&dir, cache["2024/01/01"]).Error
+		require.NoError(t, err)
+		require.Equal(t, "01", dir.Name)
+		require.Equal(t, cache["2024/01"], *dir.ParentID)
 	})
 }
 
@@ -128,6 +212,119 @@ func TestEnsureParentDirectories(t *testing.T) {
 	})
 }
 
+func TestEnsureParentDirectories_Deep(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		cache := map[string]model.DirectoryID{}
+		attachment := model.SourceAttachment{
+			Preparation: &model.Preparation{},
+			Storage:     &model.Storage{},
+		}
+		err := db.Create(&attachment).Error
+		require.NoError(t, err)
+		root := model.Directory{
+			AttachmentID: attachment.ID,
+		}
+		err = db.Create(&root).Error
+		require.NoError(t, err)
+
+		const depth = 1200
+		dirPath := strings.TrimSuffix(strings.Repeat("d/", depth), "/")
+		file := model.File{
+			Path:         dirPath + "/test.txt",
+			AttachmentID: attachment.ID,
+		}
+		err = EnsureParentDirectories(ctx, db, &file, root.ID, cache)
+		require.NoError(t, err)
+		require.Equal(t, depth, DirectoryDepth(file.Path))
+		require.Len(t, cache, depth)
+		require.Equal(t, cache[dirPath], *file.DirectoryID)
+		var count int64
+		err = db.Model(&model.Directory{}).Count(&count).Error
+		require.NoError(t, err)
+		require.EqualValues(t, depth+1, count)
+
+		// A sibling only needs the cached parent
+		sibling := model.File{
+			Path:         dirPath + "/test2.txt",
+			AttachmentID: attachment.ID,
+		}
+		err = EnsureParentDirectories(ctx, db, &sibling, root.ID, cache)
+		require.NoError(t, err)
+		require.Equal(t, *file.DirectoryID, *sibling.DirectoryID)
+
+		// A new directory under a deep cached ancestor
+		nested := model.File{
+			Path:         dirPath + "/e/test.txt",
+			AttachmentID: attachment.ID,
+		}
+		err = EnsureParentDirectories(ctx, db, &nested, root.ID, cache)
+		require.NoError(t, err)
+		var dir model.Directory
+		err = db.First(&dir, *nested.DirectoryID).Error
+		require.NoError(t, err)
+		require.Equal(t, "e", dir.Name)
+		require.Equal(t, *file.DirectoryID, *dir.ParentID)
+	})
+}
+
+func TestEnsureParentDirectories_PathCompression(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		attachment := model.SourceAttachment{
+			Preparation: &model.Preparation{},
+			Storage:     &model.Storage{},
+		}
+		err := db.Create(&attachment).Error
+		require.NoError(t, err)
+		root := model.Directory{
+			AttachmentID: attachment.ID,
+		}
+		err = db.Create(&root).Error
+		require.NoError(t, err)
+
+		const depth = 300
+		dirPath := strings.TrimSuffix(strings.Repeat("d/", depth), "/")
+		file := model.File{
+			Path:         dirPath + "/test.txt",
+			AttachmentID: attachment.ID,
+		}
+		err = EnsureParentDirectories(ctx, db, &file, root.ID, map[string]model.DirectoryID{})
+		require.NoError(t, err)
+		var dir model.Directory
+		err = db.First(&dir, *file.DirectoryID).Error
+		require.NoError(t, err)
+		require.Equal(t, model.DirectoryPathKey(attachment.ID, dirPath), dir.PathKey)
+
+		// The existing ancestors are found by their compressed paths without a cache
+		cache := map[string]model.DirectoryID{}
+		other := model.File{
+			Path:         dirPath + "/test2.txt",
+			AttachmentID: attachment.ID,
+		}
+		err = EnsureParentDirectories(ctx, db, &other, root.ID, cache)
+		require.NoError(t, err)
+		require.Equal(t, *file.DirectoryID, *other.DirectoryID)
+		require.Len(t, cache, depth)
+		var count int64
+		err = db.Model(&model.Directory{}).Count(&count).Error
+		require.NoError(t, err)
+		require.EqualValues(t, depth+1, count)
+
+		// Directories without a compressed path are walked from the root and given one
+		err = db.Model(&model.Directory{}).Where("path_key <> ''").Update("path_key", "").Error
+		require.NoError(t, err)
+		legacy := model.File{
+			Path:         dirPath + "/test3.txt",
+			AttachmentID: attachment.ID,
+		}
+		err = EnsureParentDirectories(ctx, db, &legacy, root.ID, map[string]model.DirectoryID{})
+		require.NoError(t, err)
+		require.Equal(t, *file.DirectoryID, *legacy.DirectoryID)
+		err = db.Model(&model.Directory{}).Where("path_key <> ''").Count(&count).Error
+		require.NoError(t, err)
+		require.EqualValues(t, depth, count)
+	})
+}
+
 func TestCreatePackJob(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
 		attachment := model.SourceAttachment{
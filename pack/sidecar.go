@@ -0,0 +1,58 @@
+package pack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+)
+
+// CommPSidecar is the content of the .commp.json sidecar file written next to a CAR file, so that transfer tools can
+// verify the CAR file without querying the API.
+type CommPSidecar struct {
+	PayloadCID  string `json:"payloadCid"`
+	PieceCID    string `json:"pieceCid"`
+	PieceSize   uint64 `json:"pieceSize"`
+	FileSize    int64  `json:"fileSize"`
+	SHA256      string `json:"sha256"`
+	Compression string `json:"compression,omitempty"`
+}
+
+// writeSidecars writes the checksum sidecar files of a CAR file next to it in the output storage:
+//   - {name}.car.sha256: the SHA-256 checksum of the CAR file, in the format of sha256sum, so it can be checked with
+//     sha256sum -c. The checksum of a compressed CAR file is written as {name}.car.zst.sha256, and is the checksum of
+//     the compressed file.
+//   - {name}.commp.json: the payload CID, the piece CID, the padded piece size, the size and the SHA-256 checksum of
+//     the CAR file, as a CommPSidecar. The size is always the size of the uncompressed CAR file.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - storageWriter: The writer of the output storage holding the CAR file.
+//   - filename: The path of the CAR file in the output storage.
+//   - sidecar: The content of the .commp.json sidecar file.
+//
+// Returns:
+//   - An error, if any occurred while writing the sidecar files.
+func writeSidecars(ctx context.Context, storageWriter storagesystem.Writer, filename string, sidecar CommPSidecar) error {
+	checksum := fmt.Sprintf("%s  %s\n", sidecar.SHA256, path.Base(filename))
+	_, err := storageWriter.Write(ctx, filename+".sha256", strings.NewReader(checksum))
+	if err != nil {
+		return errors.Wrapf(err, "failed to write checksum of %s", filename)
+	}
+
+	content, err := json.MarshalIndent(sidecar, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	name := filename[:strings.LastIndex(filename, ".car")]
+	_, err = storageWriter.Write(ctx, name+".commp.json", bytes.NewReader(content))
+	if err != nil {
+		return errors.Wrapf(err, "failed to write commp of %s", filename)
+	}
+	return nil
+}
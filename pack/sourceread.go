@@ -0,0 +1,69 @@
+package pack
+
+import (
+	"context"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+// chronicallySlowWindow is how far back the reads of a source are scored to tell whether it is chronically slow.
+const chronicallySlowWindow = 24 * time.Hour
+
+// recordRead counts the read of a file range in the tier of its latency, and logs the slow ones, at info level for a
+// slow read and at warn level for a very slow one.
+func (a *Assembler) recordRead(path string, latency time.Duration) {
+	a.sourceRead.Add(latency)
+	switch {
+	case latency >= model.VerySlowSourceRead:
+		logger.Warnw("very slow read from source", "path", path, "latency", latency)
+	case latency >= model.SlowSourceRead:
+		logger.Infow("slow read from source", "path", path, "latency", latency)
+	}
+}
+
+// recordSourceRead records the reads of a pack from the source storage of the job, then scores the reads of the
+// source over the last day, and warns if the source is chronically slow, so operators know to mirror it locally
+// before it holds back every worker. The piece is already recorded, so failures are only logged.
+func recordSourceRead(ctx context.Context, db *gorm.DB, job model.Job, sourceRead model.SourceRead) {
+	if sourceRead.NumOfReads == 0 {
+		return
+	}
+	sourceRead.WorkerID = job.WorkerID
+	sourceRead.StorageID = job.Attachment.StorageID
+	sourceRead.JobID = job.ID
+	sourceRead.PreparationID = job.Attachment.PreparationID
+	err := database.DoRetry(ctx, func() error {
+		return db.Create(&sourceRead).Error
+	})
+	if err != nil {
+		logger.Warnw("failed to record source reads", "jobID", job.ID, "error", err)
+		return
+	}
+
+	storageName := job.Attachment.Storage.Name
+	if sourceRead.Score() >= model.ChronicallySlowSourceScore {
+		logger.Infow("slow reads from source", "storage", storageName, "reads", sourceRead.NumOfReads,
+			"score", sourceRead.Score(), "maxLatency", sourceRead.MaxLatency)
+	} else {
+		logger.Debugw("reads from source", "storage", storageName, "reads", sourceRead.NumOfReads,
+			"score", sourceRead.Score(), "maxLatency", sourceRead.MaxLatency)
+	}
+
+	var recent model.SourceRead
+	err = db.Model(&model.SourceRead{}).
+		Where("storage_id = ? AND created_at >= ?", sourceRead.StorageID, time.Now().Add(-chronicallySlowWindow)).
+		Select("COALESCE(SUM(num_of_reads), 0) AS num_of_reads, COALESCE(SUM(moderate_reads), 0) AS moderate_reads, " +
+			"COALESCE(SUM(slow_reads), 0) AS slow_reads, COALESCE(SUM(very_slow_reads), 0) AS very_slow_reads").
+		Scan(&recent).Error
+	if err != nil {
+		logger.Warnw("failed to score source", "storage", storageName, "error", err)
+		return
+	}
+	if recent.ChronicallySlow() {
+		logger.Warnw("source is chronically slow, consider mirroring it to a local storage",
+			"storage", storageName, "reads", recent.NumOfReads, "score", recent.Score())
+	}
+}
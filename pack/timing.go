@@ -0,0 +1,25 @@
+package pack
+
+import (
+	"io"
+	"time"
+)
+
+// timedReader adds the time spent reading from the underlying reader to elapsed, and the number of bytes read to
+// bytes if it is set. The phases of a pack are interleaved as the CAR file is streamed, so the time of each phase is
+// measured where it pulls or pushes the data.
+type timedReader struct {
+	reader  io.Reader
+	elapsed *time.Duration
+	bytes   *int64
+}
+
+func (r *timedReader) Read(p []byte) (int, error) {
+	start := time.Now()
+	n, err := r.reader.Read(p)
+	*r.elapsed += time.Since(start)
+	if r.bytes != nil {
+		*r.bytes += int64(n)
+	}
+	return n, err
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/data-preservation-programs/singularity/analytics"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/service/epochutil"
+	"github.com/data-preservation-programs/singularity/signer"
 	"github.com/filecoin-project/go-address"
 	cborutil "github.com/filecoin-project/go-cbor-util"
 	"github.com/filecoin-project/go-fil-markets/storagemarket"
@@ -23,7 +24,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/ipfs/go-cid"
 	"github.com/jellydator/ttlcache/v3"
-	"github.com/jsign/go-filsigner/wallet"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/peerstore"
@@ -40,6 +40,9 @@ const (
 
 var ErrNoSupportedProtocols = errors.New("no supported protocols")
 
+// ErrDealRejected is returned when the provider rejects a deal proposal, as opposed to failing to receive it.
+var ErrDealRejected = errors.New("deal rejected")
+
 //nolint:tagliatelle
 type MinerInfo struct {
 	PeerIDEncoded           string `json:"PeerID"`
@@ -511,7 +514,7 @@ func (d DealConfig) GetPrice(pieceSize int64, duration time.Duration) big.Int {
 //
 //   - Failed to sign the deal proposal.
 //
-//   - Deal proposal rejected by the provider.
+//   - Deal proposal rejected by the provider, which is marked with ErrDealRejected.
 //
 //   - No supported protocol found between client and provider.
 func (d DealMakerImpl) MakeDeal(ctx context.Context, walletObj model.Wallet,
@@ -577,7 +580,11 @@ func (d DealMakerImpl) MakeDeal(ctx context.Context, walletObj model.Wallet,
 		return nil, errors.Wrapf(err, "failed to serialize deal proposal %s", proposal)
 	}
 
-	signature, err := wallet.WalletSign(walletObj.PrivateKey, proposalBytes)
+	walletSigner, err := signer.New(walletObj.PrivateKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load the key of wallet %s", walletObj.ID)
+	}
+	signature, err := walletSigner.Sign(proposalBytes)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to sign deal proposal")
 	}
@@ -611,7 +618,7 @@ func (d DealMakerImpl) MakeDeal(ctx context.Context, walletObj model.Wallet,
 			return dealModel, nil
 		}
 
-		return nil, errors.Errorf("deal rejected: %s", resp.Message)
+		return nil, errors.Mark(errors.Errorf("deal rejected: %s", resp.Message), ErrDealRejected)
 	} else if slices.Contains(protocols, StorageProposalV111) {
 		resp, err := d.MakeDeal111(ctx, deal, dealConfig, cid.Cid(car.RootCID), addrInfo)
 		if err != nil {
@@ -4,15 +4,10 @@ import (
 	"context"
 	"crypto/rand"
 	"math/big"
-	"strconv"
-	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/model"
-	"github.com/data-preservation-programs/singularity/util"
 	logging "github.com/ipfs/go-log/v2"
-	"github.com/jellydator/ttlcache/v3"
-	"github.com/ybbus/jsonrpc/v3"
 	"gorm.io/gorm"
 )
 
@@ -61,52 +56,20 @@ func (w RandomWalletChooser) Choose(ctx context.Context, wallets []model.Wallet)
 	return chosenWallet, nil
 }
 
+// DatacapWalletChooser chooses a wallet for a verified deal among the wallets with enough datacap left. The datacap
+// left in a wallet is the datacap tracked by the deal tracker, less the verified deals proposed from the wallet that
+// are not published yet. Wallets whose datacap has never been tracked are assumed to have enough datacap.
 type DatacapWalletChooser struct {
-	db          *gorm.DB
-	cache       *ttlcache.Cache[string, int64]
-	lotusClient jsonrpc.RPCClient
-	min         uint64
+	db  *gorm.DB
+	min int64
 }
 
-func NewDatacapWalletChooser(db *gorm.DB, cacheTTL time.Duration,
-	lotusAPI string, lotusToken string, min uint64) DatacapWalletChooser {
-	cache := ttlcache.New[string, int64](
-		ttlcache.WithTTL[string, int64](cacheTTL),
-		ttlcache.WithDisableTouchOnHit[string, int64]())
-
-	lotusClient := util.NewLotusClient(lotusAPI, lotusToken)
+// NewDatacapWalletChooser returns a DatacapWalletChooser for deals of min bytes, i.e. the piece size.
+func NewDatacapWalletChooser(db *gorm.DB, min int64) DatacapWalletChooser {
 	return DatacapWalletChooser{
-		db:          db,
-		cache:       cache,
-		lotusClient: lotusClient,
-		min:         min,
-	}
-}
-
-func (w DatacapWalletChooser) getDatacap(ctx context.Context, wallet model.Wallet) (int64, error) {
-	var result string
-	err := w.lotusClient.CallFor(ctx, &result, "Filecoin.StateMarketBalance", wallet.Address, nil)
-	if err != nil {
-		return 0, errors.WithStack(err)
-	}
-	return strconv.ParseInt(result, 10, 64)
-}
-
-func (w DatacapWalletChooser) getDatacapCached(ctx context.Context, wallet model.Wallet) (int64, error) {
-	file := w.cache.Get(wallet.Address)
-	if file != nil && !file.IsExpired() {
-		return file.Value(), nil
-	}
-	datacap, err := w.getDatacap(ctx, wallet)
-	if err != nil {
-		logger.Errorf("failed to get datacap for wallet %s: %s", wallet.Address, err)
-		if file != nil {
-			return file.Value(), nil
-		}
-		return 0, errors.WithStack(err)
+		db:  db,
+		min: min,
 	}
-	w.cache.Set(wallet.Address, datacap, ttlcache.DefaultTTL)
-	return datacap, nil
 }
 
 func (w DatacapWalletChooser) getPendingDeals(ctx context.Context, wallet model.Wallet) (int64, error) {
@@ -117,7 +80,6 @@ func (w DatacapWalletChooser) getPendingDeals(ctx context.Context, wallet model.
 		Scan(&totalPieceSize).
 		Error
 	if err != nil {
-		logger.Errorf("failed to get pending deals for wallet %s: %s", wallet.Address, err)
 		return 0, errors.WithStack(err)
 	}
 	return totalPieceSize, nil
@@ -126,12 +88,13 @@ func (w DatacapWalletChooser) getPendingDeals(ctx context.Context, wallet model.
 // Choose selects a random Wallet from the provided slice of Wallets based on certain criteria.
 //
 // The Choose function of the DatacapWalletChooser type filters the given slice of Wallets
-// based on a specific criterion, which is whether the datacap for the wallet minus
+// based on a specific criterion, which is whether the tracked datacap for the wallet minus
 // the pending deals for the wallet is greater or equal to a minimum threshold (w.min).
-// From the filtered eligible Wallets, the function then randomly selects one Wallet.
-// It uses a cryptographically secure random number generator to make the selection.
-// If the initial slice of Wallets is empty, or if no Wallets meet the criteria,
-// the function returns an error.
+// The datacap is read again from the database, since the deal tracker updates it while
+// the deals of a schedule are being made. From the filtered eligible Wallets, the function
+// then randomly selects one Wallet. It uses a cryptographically secure random number generator
+// to make the selection. If the initial slice of Wallets is empty, or if no Wallets meet the
+// criteria, the function returns an error.
 //
 // Parameters:
 //   - ctx context.Context: The context to use for cancellation and deadlines, used
@@ -151,11 +114,20 @@ func (w DatacapWalletChooser) Choose(ctx context.Context, wallets []model.Wallet
 		return model.Wallet{}, ErrNoWallet
 	}
 
-	var eligibleWallets []model.Wallet
+	ids := make([]string, 0, len(wallets))
 	for _, wallet := range wallets {
-		datacap, err := w.getDatacapCached(ctx, wallet)
-		if err != nil {
-			logger.Errorw("failed to get datacap for wallet", "wallet", wallet.Address, "error", err)
+		ids = append(ids, wallet.ID)
+	}
+	var tracked []model.Wallet
+	err := w.db.WithContext(ctx).Where("id IN ?", ids).Find(&tracked).Error
+	if err != nil {
+		return model.Wallet{}, errors.WithStack(err)
+	}
+
+	var eligibleWallets []model.Wallet
+	for _, wallet := range tracked {
+		if wallet.BalanceUpdatedAt == nil {
+			eligibleWallets = append(eligibleWallets, wallet)
 			continue
 		}
 		pendingDeals, err := w.getPendingDeals(ctx, wallet)
@@ -163,7 +135,7 @@ func (w DatacapWalletChooser) Choose(ctx context.Context, wallets []model.Wallet
 			logger.Errorw("failed to get pending deals for wallet", "wallet", wallet.Address, "error", err)
 			continue
 		}
-		if datacap-pendingDeals >= int64(w.min) {
+		if wallet.Datacap-pendingDeals >= w.min {
 			eligibleWallets = append(eligibleWallets, wallet)
 		}
 	}
@@ -5,7 +5,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/util/testutil"
 	"github.com/stretchr/testify/mock"
@@ -44,40 +43,17 @@ func (m *MockRPCClient) CallBatchRaw(ctx context.Context, requests jsonrpc.RPCRe
 
 func TestDatacapWalletChooser_Choose(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		lotusClient := new(MockRPCClient)
-
+		now := time.Now()
 		// Set up the test data
 		wallets := []model.Wallet{
-			{ID: "1", Address: "address1"},
-			{ID: "2", Address: "address2"},
-			{ID: "3", Address: "address3"},
-			{ID: "4", Address: "address4"},
+			{ID: "1", Address: "address1", Datacap: 1000000, BalanceUpdatedAt: &now},
+			{ID: "2", Address: "address2", Datacap: 100, BalanceUpdatedAt: &now},
+			{ID: "3", Address: "address3", Datacap: 1000000, BalanceUpdatedAt: &now},
+			{ID: "4", Address: "address4", Datacap: 900000, BalanceUpdatedAt: &now},
+			{ID: "5", Address: "address5"},
 		}
 
-		// Set up expectations for the lotusClient mock
-		lotusClient.On("CallFor", mock.Anything, mock.AnythingOfType("*string"), "Filecoin.StateMarketBalance", []any{"address1", nil}).
-			Return(nil).Run(func(args mock.Arguments) {
-			resultPtr := args.Get(1).(*string)
-			*resultPtr = "1000000"
-		})
-
-		lotusClient.On("CallFor", mock.Anything, mock.AnythingOfType("*string"), "Filecoin.StateMarketBalance", []any{"address2", nil}).
-			Return(errors.New("failed to get datacap"))
-
-		lotusClient.On("CallFor", mock.Anything, mock.AnythingOfType("*string"), "Filecoin.StateMarketBalance", []any{"address3", nil}).
-			Return(nil).Run(func(args mock.Arguments) {
-			resultPtr := args.Get(1).(*string)
-			*resultPtr = "1000000"
-		})
-
-		lotusClient.On("CallFor", mock.Anything, mock.AnythingOfType("*string"), "Filecoin.StateMarketBalance", []any{"address4", nil}).
-			Return(nil).Run(func(args mock.Arguments) {
-			resultPtr := args.Get(1).(*string)
-			*resultPtr = "900000"
-		})
-
-		chooser := NewDatacapWalletChooser(db, time.Minute, "lotusAPI", "lotusToken", 900001)
-		chooser.lotusClient = lotusClient
+		chooser := NewDatacapWalletChooser(db, 900001)
 
 		err := db.Create(&wallets).Error
 		require.NoError(t, err)
@@ -90,19 +66,33 @@ func TestDatacapWalletChooser_Choose(t *testing.T) {
 		require.NoError(t, err)
 
 		t.Run("Choose wallet with empty wallet", func(t *testing.T) {
-			_, err := chooser.Choose(context.Background(), []model.Wallet{})
+			_, err := chooser.Choose(ctx, []model.Wallet{})
 			require.ErrorAs(t, err, &ErrNoWallet)
 		})
 
 		t.Run("Choose wallet with sufficient datacap", func(t *testing.T) {
-			chosenWallet, err := chooser.Choose(context.Background(), []model.Wallet{wallets[0], wallets[1]})
+			chosenWallet, err := chooser.Choose(ctx, []model.Wallet{wallets[0], wallets[1]})
 			require.NoError(t, err)
 			require.Equal(t, "address1", chosenWallet.Address)
 		})
 
 		t.Run("Choose wallet with insufficient datacap", func(t *testing.T) {
-			_, err := chooser.Choose(context.Background(), []model.Wallet{wallets[2], wallets[3]})
-			require.ErrorAs(t, err, &ErrNoDatacap)
+			_, err := chooser.Choose(ctx, []model.Wallet{wallets[2], wallets[3]})
+			require.ErrorIs(t, err, ErrNoDatacap)
+		})
+
+		t.Run("Choose wallet whose datacap is not tracked", func(t *testing.T) {
+			chosenWallet, err := chooser.Choose(ctx, []model.Wallet{wallets[3], wallets[4]})
+			require.NoError(t, err)
+			require.Equal(t, "address5", chosenWallet.Address)
+		})
+
+		t.Run("Choose wallet with the datacap tracked since", func(t *testing.T) {
+			err := db.Model(&model.Wallet{}).Where("id = ?", "4").Update("datacap", 2000000).Error
+			require.NoError(t, err)
+			chosenWallet, err := chooser.Choose(ctx, []model.Wallet{wallets[3]})
+			require.NoError(t, err)
+			require.Equal(t, "address4", chosenWallet.Address)
 		})
 	})
 }
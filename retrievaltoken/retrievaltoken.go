@@ -0,0 +1,233 @@
+// Package retrievaltoken issues and verifies the signed tokens that data owners hand to third parties to retrieve
+// the pieces of a preparation from a content provider that requires retrieval tokens.
+package retrievaltoken
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// Prefix is the prefix of the retrieval tokens, so they are recognizable in logs and configs.
+	Prefix = "srt_"
+	// SecretEnvVar is the environment variable the secret of the retrieval tokens is read from. It must be set to the
+	// same value for the instances issuing the tokens and the content providers verifying them.
+	SecretEnvVar    = "SINGULARITY_RETRIEVAL_TOKEN_SECRET"
+	minSecretLength = 16
+	saltGlobal      = "retrieval_token_salt"
+	saltLength      = 32
+)
+
+var (
+	ErrInvalid    = errors.New("invalid retrieval token")
+	ErrRevoked    = errors.New("retrieval token is revoked")
+	ErrExpired    = errors.New("retrieval token is expired")
+	ErrExhausted  = errors.New("retrieval token has no bytes left")
+	ErrOutOfScope = errors.New("retrieval token does not allow this content")
+	ErrNoSecret   = errors.New("retrieval token secret is not configured")
+)
+
+// CheckSecret returns an error wrapping ErrNoSecret if the secret of the retrieval tokens is not configured, so that
+// a content provider requiring retrieval tokens fails to start rather than rejecting every request.
+func CheckSecret() error {
+	_, err := configuredSecret()
+	return err
+}
+
+func configuredSecret() ([]byte, error) {
+	secret := os.Getenv(SecretEnvVar)
+	if len(secret) < minSecretLength {
+		return nil, errors.Wrapf(ErrNoSecret, "set %s to a secret of at least %d characters", SecretEnvVar, minSecretLength)
+	}
+	return []byte(secret), nil
+}
+
+// loadSecret returns the key used to sign the retrieval tokens. It is derived from the secret configured with
+// SecretEnvVar and a random salt stored in the database on first use, so that read access to the database is not
+// enough to mint tokens, while the salt keeps the tokens of instances sharing a configured secret apart.
+func loadSecret(ctx context.Context, db *gorm.DB) ([]byte, error) {
+	secret, err := configuredSecret()
+	if err != nil {
+		return nil, err
+	}
+	where := clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Name: "key"}, Value: saltGlobal},
+	}}
+	var global model.Global
+	err = db.Clauses(where).First(&global).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		generated := make([]byte, saltLength)
+		_, err = rand.Read(generated)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		global = model.Global{Key: saltGlobal, Value: base64.StdEncoding.EncodeToString(generated)}
+		err = database.DoRetry(ctx, func() error {
+			return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&global).Error
+		})
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		// Another process may have stored its salt first
+		err = db.Clauses(where).First(&global).Error
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	salt, err := base64.StdEncoding.DecodeString(global.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode retrieval token salt")
+	}
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(salt)
+	return mac.Sum(nil), nil
+}
+
+func sign(secret []byte, id model.RetrievalTokenID) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(Prefix + strconv.FormatUint(uint64(id), 10)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Issue stores a new retrieval token and returns the signed token to hand to the third party. The signed token is not
+// stored, so it can only be obtained when the token is issued.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - token: The retrieval token to store, with its preparation and scope.
+//
+// Returns:
+//   - The signed token, i.e. srt_<id>_<signature>.
+//   - An error, if any occurred during the operation.
+func Issue(ctx context.Context, db *gorm.DB, token *model.RetrievalToken) (string, error) {
+	secret, err := loadSecret(ctx, db)
+	if err != nil {
+		return "", err
+	}
+	err = database.DoRetry(ctx, func() error { return db.Create(token).Error })
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return Prefix + strconv.FormatUint(uint64(token.ID), 10) + "_" + sign(secret, token.ID), nil
+}
+
+// Verify checks the signature of a signed token and returns the retrieval token it refers to, provided that the
+// token is not revoked, expired or exhausted.
+//
+// Parameters:
+//   - ctx: The context for database transactions and other operations.
+//   - db: A pointer to the gorm.DB instance representing the database connection.
+//   - signed: The signed token presented by the third party.
+//
+// Returns:
+//   - A pointer to the retrieval token.
+//   - An error wrapping ErrInvalid, ErrRevoked, ErrExpired or ErrExhausted if the token cannot be used.
+func Verify(ctx context.Context, db *gorm.DB, signed string) (*model.RetrievalToken, error) {
+	idString, signature, ok := strings.Cut(strings.TrimPrefix(signed, Prefix), "_")
+	if !ok || !strings.HasPrefix(signed, Prefix) {
+		return nil, ErrInvalid
+	}
+	id, err := strconv.ParseUint(idString, 10, 64)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	secret, err := loadSecret(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal([]byte(signature), []byte(sign(secret, model.RetrievalTokenID(id)))) {
+		return nil, ErrInvalid
+	}
+
+	var token model.RetrievalToken
+	err = db.First(&token, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// The token was signed by this instance, so it has been removed along with its preparation
+		return nil, ErrRevoked
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	switch {
+	case token.Revoked:
+		return nil, ErrRevoked
+	case token.ExpiresAt != nil && !time.Now().Before(*token.ExpiresAt):
+		return nil, ErrExpired
+	case token.MaxBytes > 0 && token.BytesServed >= token.MaxBytes:
+		return nil, ErrExhausted
+	}
+	return &token, nil
+}
+
+// AllowsPiece checks that the retrieval token allows the piece, which must be a piece of the preparation of the token
+// and, if the token is limited to some pieces, one of them.
+func AllowsPiece(db *gorm.DB, token model.RetrievalToken, pieceCID cid.Cid) error {
+	if len(token.PieceCIDs) > 0 && !containsPiece(token.PieceCIDs, pieceCID) {
+		return ErrOutOfScope
+	}
+	var count int64
+	err := db.Model(&model.Car{}).
+		Where("piece_cid = ? AND preparation_id = ?", model.CID(pieceCID), token.PreparationID).
+		Count(&count).Error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if count == 0 {
+		return ErrOutOfScope
+	}
+	return nil
+}
+
+// AllowsBlock checks that the retrieval token allows the block, which must be indexed in a piece that the token
+// allows. This is used to check the root of a sub-DAG retrieval.
+func AllowsBlock(db *gorm.DB, token model.RetrievalToken, blockCID cid.Cid) error {
+	var pieceCIDs []model.CID
+	err := db.Model(&model.Car{}).
+		Where("preparation_id = ? AND id IN (?)", token.PreparationID,
+			db.Model(&model.CarBlock{}).Select("car_id").Where("cid = ?", model.CID(blockCID))).
+		Pluck("piece_cid", &pieceCIDs).Error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, pieceCID := range pieceCIDs {
+		if len(token.PieceCIDs) == 0 || containsPiece(token.PieceCIDs, cid.Cid(pieceCID)) {
+			return nil
+		}
+	}
+	return ErrOutOfScope
+}
+
+func containsPiece(pieceCIDs []string, pieceCID cid.Cid) bool {
+	for _, c := range pieceCIDs {
+		if c == pieceCID.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// AddBytesServed adds the number of bytes served with the retrieval token to its usage.
+func AddBytesServed(ctx context.Context, db *gorm.DB, id model.RetrievalTokenID, n int64) error {
+	if n <= 0 {
+		return nil
+	}
+	return database.DoRetry(ctx, func() error {
+		return db.Model(&model.RetrievalToken{}).Where("id = ?", id).
+			Update("bytes_served", gorm.Expr("bytes_served + ?", n)).Error
+	})
+}
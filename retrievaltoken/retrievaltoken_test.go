@@ -0,0 +1,100 @@
+package retrievaltoken
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestIssueAndVerify(t *testing.T) {
+	t.Setenv(SecretEnvVar, "0123456789abcdef")
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		require.NoError(t, db.Create(&model.Preparation{Name: "prep"}).Error)
+
+		token := model.RetrievalToken{Name: "partner", PreparationID: 1, MaxBytes: 100}
+		signed, err := Issue(ctx, db, &token)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(signed, Prefix))
+
+		verified, err := Verify(ctx, db, signed)
+		require.NoError(t, err)
+		require.Equal(t, token.ID, verified.ID)
+		require.Equal(t, "partner", verified.Name)
+
+		// The signature does not match another token ID
+		_, err = Verify(ctx, db, strings.Replace(signed, Prefix+"1_", Prefix+"2_", 1))
+		require.ErrorIs(t, err, ErrInvalid)
+		_, err = Verify(ctx, db, signed+"x")
+		require.ErrorIs(t, err, ErrInvalid)
+		_, err = Verify(ctx, db, "invalid")
+		require.ErrorIs(t, err, ErrInvalid)
+
+		require.NoError(t, AddBytesServed(ctx, db, token.ID, 60))
+		require.NoError(t, AddBytesServed(ctx, db, token.ID, 40))
+		_, err = Verify(ctx, db, signed)
+		require.ErrorIs(t, err, ErrExhausted)
+
+		expired := model.RetrievalToken{PreparationID: 1, ExpiresAt: ptr.Of(time.Now().Add(-time.Minute))}
+		signed, err = Issue(ctx, db, &expired)
+		require.NoError(t, err)
+		_, err = Verify(ctx, db, signed)
+		require.ErrorIs(t, err, ErrExpired)
+
+		revoked := model.RetrievalToken{PreparationID: 1, Revoked: true}
+		signed, err = Issue(ctx, db, &revoked)
+		require.NoError(t, err)
+		_, err = Verify(ctx, db, signed)
+		require.ErrorIs(t, err, ErrRevoked)
+
+		// The salt stored in the database is not enough to verify or mint tokens without the configured secret
+		t.Setenv(SecretEnvVar, "fedcba9876543210")
+		_, err = Verify(ctx, db, signed)
+		require.ErrorIs(t, err, ErrInvalid)
+		t.Setenv(SecretEnvVar, "")
+		_, err = Issue(ctx, db, &model.RetrievalToken{PreparationID: 1})
+		require.ErrorIs(t, err, ErrNoSecret)
+		require.ErrorIs(t, CheckSecret(), ErrNoSecret)
+	})
+}
+
+func TestAllows(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		require.NoError(t, db.Create([]model.Preparation{{Name: "prep1"}, {Name: "prep2"}}).Error)
+		piece1 := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("piece1")))
+		piece2 := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("piece2")))
+		piece3 := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("piece3")))
+		cars := []model.Car{
+			{PieceCID: model.CID(piece1), PreparationID: 1},
+			{PieceCID: model.CID(piece2), PreparationID: 1},
+			{PieceCID: model.CID(piece3), PreparationID: 2},
+		}
+		require.NoError(t, db.Create(&cars).Error)
+		block1 := cid.NewCidV1(cid.Raw, util.Hash([]byte("block1")))
+		block3 := cid.NewCidV1(cid.Raw, util.Hash([]byte("block3")))
+		require.NoError(t, db.Create([]model.CarBlock{
+			{CarID: cars[0].ID, CID: model.CID(block1)},
+			{CarID: cars[2].ID, CID: model.CID(block3)},
+		}).Error)
+
+		dataset := model.RetrievalToken{PreparationID: 1}
+		require.NoError(t, AllowsPiece(db, dataset, piece1))
+		require.NoError(t, AllowsPiece(db, dataset, piece2))
+		require.ErrorIs(t, AllowsPiece(db, dataset, piece3), ErrOutOfScope)
+		require.NoError(t, AllowsBlock(db, dataset, block1))
+		require.ErrorIs(t, AllowsBlock(db, dataset, block3), ErrOutOfScope)
+
+		limited := model.RetrievalToken{PreparationID: 1, PieceCIDs: model.StringSlice{piece2.String()}}
+		require.ErrorIs(t, AllowsPiece(db, limited, piece1), ErrOutOfScope)
+		require.NoError(t, AllowsPiece(db, limited, piece2))
+		require.ErrorIs(t, AllowsBlock(db, limited, block1), ErrOutOfScope)
+	})
+}
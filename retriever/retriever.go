@@ -2,7 +2,9 @@
 package retriever
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/data-preservation-programs/singularity/retriever/deserializer"
@@ -121,3 +123,49 @@ func (r *Retriever) RetrieveReader(ctx context.Context, c cid.Cid, rangeStart in
 
 	return outReader, nil
 }
+
+// RetrieveBlock retrieves a single block with a trustless retrieval from a given list of SPs. The retrieval only
+// succeeds if the SPs serve a block that hashes to the cid, which is then returned.
+func (r *Retriever) RetrieveBlock(ctx context.Context, c cid.Cid, sps []string) ([]byte, error) {
+	logger.Debugw("retrieving block from filecoin", "cid", c, "sps", sps)
+	var buf bytes.Buffer
+	writable, err := storage.NewWritable(&buf, []cid.Cid{c}, car.WriteAsCarV1(true))
+	if err != nil {
+		return nil, err
+	}
+	providerAddrs, err := r.endpointFinder.FindHTTPEndpoints(ctx, sps)
+	if err != nil {
+		return nil, err
+	}
+	request, err := lassietypes.NewRequestForPath(writable, c, "", trustlessutils.DagScopeBlock, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Protocols = []multicodec.Code{multicodec.TransportIpfsGatewayHttp}
+	request.FixedPeers = providerAddrs
+	_, err = r.lassie.Fetch(ctx, request, func(lassietypes.RetrievalEvent) {})
+	if err != nil {
+		return nil, err
+	}
+	err = writable.Finalize()
+	if err != nil {
+		return nil, err
+	}
+
+	cr, err := car.NewBlockReader(&buf)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		blk, err := cr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("block %s is missing from the retrieved CAR", c)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if blk.Cid().Equals(c) {
+			return blk.RawData(), nil
+		}
+	}
+}
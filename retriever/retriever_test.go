@@ -142,3 +142,29 @@ func (fl *fakeLassie) Fetch(ctx context.Context, request lassietypes.RetrievalRe
 	}
 	return &lassietypes.RetrievalStats{}, nil
 }
+
+func TestRetrieveBlock(t *testing.T) {
+	lsys := cidlink.DefaultLinkSystem()
+	memSys := memstore.Store{
+		Bag: make(map[string][]byte),
+	}
+	lsys.SetReadStorage(&memSys)
+	lsys.SetWriteStorage(&memSys)
+	file := testutil.GenerateFile(t, &lsys, rand.Reader, 4<<20)
+	fl := &fakeLassie{lsys: &lsys}
+	ef := &fakeEndpointFinder{
+		endpoints: map[string]peer.AddrInfo{
+			"apples": {
+				ID: peer.ID("apple tree"),
+			},
+		},
+	}
+	retriever := retriever.NewRetriever(fl, ef)
+	data, err := retriever.RetrieveBlock(context.Background(), file.Root, []string{"apples"})
+	require.NoError(t, err)
+	expected, err := memSys.Get(context.Background(), file.Root.KeyString())
+	require.NoError(t, err)
+	require.Equal(t, expected, data)
+	require.Equal(t, []peer.AddrInfo{{ID: peer.ID("apple tree")}}, fl.lastRequest.FixedPeers)
+	require.Nil(t, fl.lastRequest.Bytes)
+}
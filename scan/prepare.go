@@ -3,6 +3,7 @@ package scan
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/database"
@@ -12,6 +13,10 @@ import (
 	"gorm.io/gorm"
 )
 
+// ErrPackJobSealed is returned when a pack job is no longer being filled, i.e. because it has been sealed by its age in
+// the meantime.
+var ErrPackJobSealed = errors.New("pack job is no longer being filled")
+
 func NextAvailablePackJob(
 	ctx context.Context,
 	db *gorm.DB,
@@ -37,6 +42,7 @@ func PrepareToPackFileRanges(
 		if err != nil {
 			return 0, fmt.Errorf("finding next available pack job: %w", err)
 		}
+		parts := remainingParts
 		fileRangeSet.Reset()
 		fileRangeSet.Add(nextPackJob.FileRanges...)
 		for len(remainingParts) > 0 {
@@ -51,10 +57,15 @@ func PrepareToPackFileRanges(
 		}
 		// if we still have remaining parts, we've filled up this chunk
 		packJobState := model.Created
-		if len(remainingParts) > 0 {
+		if len(remainingParts) > 0 || packJobExpired(*nextPackJob, attachment.Preparation.MaxBatchAge, time.Now()) {
 			packJobState = model.Ready
 		}
 		err = UpdatePackJob(ctx, db, nextPackJob.ID, packJobState, fileRangeSet.FileRangeIDs())
+		if errors.Is(err, ErrPackJobSealed) {
+			// The pack job has been sealed in the meantime, so the parts go to the next one
+			remainingParts = parts
+			continue
+		}
 		if err != nil {
 			return 0, fmt.Errorf("updating pack job: %w", err)
 		}
@@ -72,7 +83,15 @@ func UpdatePackJob(
 	return database.DoRetry(ctx, func() error {
 		return db.Transaction(
 			func(db *gorm.DB) error {
-				err := db.Model(&model.Job{}).Where("id = ?", packJobID).Update("state", state).Error
+				var open int64
+				err := db.Model(&model.Job{}).Where("id = ? AND state = ?", packJobID, model.Created).Count(&open).Error
+				if err != nil {
+					return fmt.Errorf("failed to find pack job: %w", err)
+				}
+				if open == 0 {
+					return ErrPackJobSealed
+				}
+				err = db.Model(&model.Job{}).Where("id = ?", packJobID).Update("state", state).Error
 				if err != nil {
 					return fmt.Errorf("failed to update pack job: %w", err)
 				}
@@ -105,10 +124,11 @@ func PrepareSource(ctx context.Context, db *gorm.DB, attachment model.SourceAtta
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	return markPackJobsReady(ctx, db, attachment.ID)
+	return MarkPackJobsReady(ctx, db, attachment.ID)
 }
 
-func markPackJobsReady(
+// MarkPackJobsReady marks the pack jobs of the source attachment that are still being filled as ready to be packed.
+func MarkPackJobsReady(
 	ctx context.Context,
 	db *gorm.DB,
 	attachmentID model.SourceAttachmentID,
@@ -117,3 +137,49 @@ func markPackJobsReady(
 		return db.Model(&model.Job{}).Where("attachment_id = ? AND state = ?", attachmentID, model.Created).Update("state", model.Ready).Error
 	})
 }
+
+// packJobExpired returns whether the pack job has been filled for longer than the maximum batch age.
+func packJobExpired(job model.Job, maxBatchAge time.Duration, now time.Time) bool {
+	return maxBatchAge > 0 && !job.CreatedAt.IsZero() && now.Sub(job.CreatedAt) >= maxBatchAge
+}
+
+// SealExpiredPackJobs marks the pack jobs that are still being filled as ready to be packed once they reach the maximum
+// batch age of their preparation, even if they are not full. This bounds the latency of continuously arriving data,
+// i.e. appended by the ingest listener, instead of waiting for a full piece to accumulate.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: The database connection.
+//   - now: The current time.
+//
+// Returns:
+//   - The number of pack jobs sealed.
+//   - An error, if any occurred during the operation.
+func SealExpiredPackJobs(ctx context.Context, db *gorm.DB, now time.Time) (int64, error) {
+	db = db.WithContext(ctx)
+	var preparations []model.Preparation
+	err := db.Where("max_batch_age > 0").Find(&preparations).Error
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	var sealed int64
+	for _, preparation := range preparations {
+		err = database.DoRetry(ctx, func() error {
+			result := db.Model(&model.Job{}).
+				Where("type = ? AND state = ? AND created_at <= ? AND attachment_id IN (?)",
+					model.Pack, model.Created, now.Add(-preparation.MaxBatchAge).UTC(),
+					db.Model(&model.SourceAttachment{}).Select("id").Where("preparation_id = ?", preparation.ID)).
+				Where("EXISTS (SELECT 1 FROM file_ranges WHERE file_ranges.job_id = jobs.id)").
+				Update("state", model.Ready)
+			if result.Error != nil {
+				return errors.WithStack(result.Error)
+			}
+			sealed += result.RowsAffected
+			return nil
+		})
+		if err != nil {
+			return sealed, errors.Wrapf(err, "failed to seal pack jobs of preparation %s", preparation.Name)
+		}
+	}
+	return sealed, nil
+}
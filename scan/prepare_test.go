@@ -0,0 +1,74 @@
+package scan
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// createFileRanges creates a source attachment with a file of the given number of ranges of 100 bytes each.
+func createFileRanges(t *testing.T, db *gorm.DB, maxBatchAge time.Duration, count int) (model.SourceAttachment, []model.FileRange) {
+	attachment := model.SourceAttachment{
+		Preparation: &model.Preparation{Name: "prep", MaxSize: 1 << 20, MaxBatchAge: maxBatchAge},
+		Storage:     &model.Storage{Name: "source", Type: "local"},
+	}
+	require.NoError(t, db.Create(&attachment).Error)
+	file := model.File{AttachmentID: attachment.ID, Path: "a.txt", Size: int64(count) * 100}
+	require.NoError(t, db.Create(&file).Error)
+	var fileRanges []model.FileRange
+	for i := 0; i < count; i++ {
+		fileRanges = append(fileRanges, model.FileRange{FileID: file.ID, Offset: int64(i) * 100, Length: 100})
+	}
+	require.NoError(t, db.Create(&fileRanges).Error)
+	return attachment, fileRanges
+}
+
+func TestPrepareToPackFileRanges_MaxBatchAge(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		attachment, fileRanges := createFileRanges(t, db, time.Hour, 2)
+
+		_, err := PrepareToPackFileRanges(ctx, db, attachment, fileRanges[:1])
+		require.NoError(t, err)
+		var job model.Job
+		require.NoError(t, db.First(&job).Error)
+		require.Equal(t, model.Created, job.State)
+
+		// The pack job is sealed when more files are appended after the max batch age
+		require.NoError(t, db.Model(&job).Update("created_at", time.Now().Add(-2*time.Hour)).Error)
+		_, err = PrepareToPackFileRanges(ctx, db, attachment, fileRanges[1:])
+		require.NoError(t, err)
+		require.NoError(t, db.First(&job, job.ID).Error)
+		require.Equal(t, model.Ready, job.State)
+		var count int64
+		require.NoError(t, db.Model(&model.FileRange{}).Where("job_id = ?", job.ID).Count(&count).Error)
+		require.EqualValues(t, 2, count)
+	})
+}
+
+func TestSealExpiredPackJobs(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		attachment, fileRanges := createFileRanges(t, db, time.Hour, 1)
+		_, err := PrepareToPackFileRanges(ctx, db, attachment, fileRanges)
+		require.NoError(t, err)
+		var job model.Job
+		require.NoError(t, db.First(&job).Error)
+
+		sealed, err := SealExpiredPackJobs(ctx, db, time.Now())
+		require.NoError(t, err)
+		require.Zero(t, sealed)
+
+		sealed, err = SealExpiredPackJobs(ctx, db, job.CreatedAt.Add(time.Hour))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, sealed)
+		require.NoError(t, db.First(&job, job.ID).Error)
+		require.Equal(t, model.Ready, job.State)
+
+		// A sealed pack job is not filled anymore
+		require.ErrorIs(t, UpdatePackJob(ctx, db, job.ID, model.Created, nil), ErrPackJobSealed)
+	})
+}
@@ -0,0 +1,190 @@
+package scan
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/daggen"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+)
+
+// scannedFile is the latest scanned version of a path.
+type scannedFile struct {
+	Size             int64
+	LastModifiedNano int64
+	Hash             string
+}
+
+// unchanged returns whether a file in the source is the same as the scanned version, the same way PushFile finds
+// existing files. The hash and the size are only compared if the source reports them.
+func (f scannedFile) unchanged(size int64, hashValue string, lastModified time.Time) bool {
+	return f.LastModifiedNano == lastModified.UnixNano() &&
+		(hashValue == "" || f.Hash == hashValue) &&
+		(size < 0 || f.Size == size)
+}
+
+// rescanStats counts the changes found by an incremental rescan.
+type rescanStats struct {
+	Added     int
+	Changed   int
+	Removed   int
+	Unchanged int
+}
+
+// loadScannedFiles returns the latest scanned version of each path of the source attachment.
+func loadScannedFiles(db *gorm.DB, attachmentID model.SourceAttachmentID) (map[string]scannedFile, error) {
+	scanned := make(map[string]scannedFile)
+	var files []model.File
+	err := db.Select("id", "path", "size", "last_modified_nano", "hash").
+		Where("attachment_id = ?", attachmentID).
+		Order("id ASC").
+		FindInBatches(&files, util.BatchSize, func(_ *gorm.DB, _ int) error {
+			// Later versions of the same path override the earlier ones
+			for _, file := range files {
+				scanned[file.Path] = scannedFile{Size: file.Size, LastModifiedNano: file.LastModifiedNano, Hash: file.Hash}
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return scanned, nil
+}
+
+// removeFiles removes the files with the given paths from the directory tree of the source attachment, so the next
+// DAG of the source no longer has them. The files themselves are kept, since the CAR files they have been packed into
+// still refer to them. An entry is only removed if it is a packed version of the path, so an entry that has been
+// replaced in the meantime is kept.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: The database connection.
+//   - attachment: The source attachment, with its preparation.
+//   - paths: The paths of the files that are no longer in the source.
+//
+// Returns:
+//   - The number of entries removed from the directory tree.
+//   - An error, if any occurred during the operation.
+func removeFiles(
+	ctx context.Context,
+	db *gorm.DB,
+	attachment model.SourceAttachment,
+	paths []string,
+) (int, error) {
+	if attachment.Preparation.NoDag || len(paths) == 0 {
+		return 0, nil
+	}
+	cidOptions, err := packutil.NewCidOptions(attachment.Preparation.HashFunction, attachment.Preparation.LeafCodec, attachment.Preparation.CidVersion, attachment.Preparation.Chunker)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	type entryKey struct {
+		dirID model.DirectoryID
+		name  string
+	}
+	versions := make(map[entryKey]map[cid.Cid]struct{})
+	var keys []entryKey
+	for _, chunk := range util.ChunkSlice(paths, util.BatchSize) {
+		var files []model.File
+		err = db.Select("id", "path", "cid", "directory_id").
+			Where("attachment_id = ? AND path IN ? AND directory_id IS NOT NULL", attachment.ID, chunk).
+			Find(&files).Error
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		for _, file := range files {
+			if !cid.Cid(file.CID).Defined() {
+				// The file has not been packed, so it is not in the directory tree
+				continue
+			}
+			key := entryKey{dirID: *file.DirectoryID, name: file.FileName()}
+			if versions[key] == nil {
+				versions[key] = make(map[cid.Cid]struct{})
+				keys = append(keys, key)
+			}
+			versions[key][cid.Cid(file.CID)] = struct{}{}
+		}
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	var removed int
+	err = database.DoRetry(ctx, func() error {
+		removed = 0
+		return db.Transaction(func(db *gorm.DB) error {
+			tree := daggen.NewDirectoryTree(cidOptions)
+			var rootDirID model.DirectoryID
+			for _, key := range keys {
+				dirID := &key.dirID
+				for {
+					if !tree.Has(*dirID) {
+						var dir model.Directory
+						err := db.Where("id = ?", *dirID).First(&dir).Error
+						if err != nil {
+							return errors.Wrap(err, "failed to get directory")
+						}
+						err = tree.Add(ctx, &dir)
+						if err != nil {
+							return errors.Wrap(err, "failed to add directory to tree")
+						}
+					}
+					dirDetail := tree.Get(*dirID)
+					if *dirID == key.dirID {
+						existing, err := dirDetail.Data.Child(ctx, key.name)
+						if err != nil {
+							return errors.WithStack(err)
+						}
+						if _, ok := versions[key][existing]; ok {
+							err = dirDetail.Data.RemoveChild(ctx, key.name)
+							if err != nil {
+								return errors.Wrap(err, "failed to remove file from directory")
+							}
+							removed++
+						}
+					}
+					dirID = dirDetail.Dir.ParentID
+					if dirID == nil {
+						rootDirID = dirDetail.Dir.ID
+						break
+					}
+				}
+			}
+			if removed == 0 {
+				return nil
+			}
+
+			_, err := tree.Resolve(ctx, rootDirID)
+			if err != nil {
+				return errors.Wrap(err, "failed to resolve directory tree")
+			}
+			for dirID, dirDetail := range tree.Cache() {
+				bytes, err := dirDetail.Data.MarshalBinary(ctx)
+				if err != nil {
+					return errors.Wrap(err, "failed to marshall directory data")
+				}
+				node, _ := dirDetail.Data.Node()
+				err = db.Model(&model.Directory{}).Where("id = ?", dirID).Updates(map[string]any{
+					"cid":      model.CID(node.Cid()),
+					"data":     bytes,
+					"exported": false,
+				}).Error
+				if err != nil {
+					return errors.Wrap(err, "failed to update directory")
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to remove files from directory tree")
+	}
+	return removed, nil
+}
@@ -0,0 +1,71 @@
+package scan
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+	"gorm.io/gorm"
+)
+
+// ErrMutableSource is returned when a preparation that enforces immutability is scanned from a source whose bucket
+// does not retain its objects with object lock.
+var ErrMutableSource = errors.New("source is not immutable")
+
+// RecordRetention verifies the object lock retention mode of the bucket of a source, and records it with the time
+// of the verification on the source attachment.
+//
+// Parameters:
+//   - ctx: Context for timeout and cancellation.
+//   - db: A pointer to a gorm.DB object, providing database access.
+//   - attachment: The source attachment to verify, with its storage loaded. Its retention mode and the time of the
+//     verification are updated in place.
+//
+// Returns:
+//   - An error if the verifier of the storage type fails, i.e. because it is not allowed to read the object lock
+//     configuration of the bucket, or if the database update fails.
+func RecordRetention(ctx context.Context, db *gorm.DB, attachment *model.SourceAttachment) error {
+	mode, err := storagesystem.VerifyObjectLock(ctx, *attachment.Storage)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	now := time.Now()
+	err = database.DoRetry(ctx, func() error {
+		return db.Model(&model.SourceAttachment{}).Where("id = ?", attachment.ID).Updates(map[string]any{
+			"retention_mode":       mode,
+			"retention_checked_at": now,
+		}).Error
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	attachment.RetentionMode = mode
+	attachment.RetentionCheckedAt = &now
+	return nil
+}
+
+// checkImmutability applies the immutability policy of the preparation to a source before it is scanned. The
+// retention mode is verified again for each scan, as object lock can be disabled on a bucket that has no default
+// retention yet, and a mutable source is logged with the warn policy and refused with the enforce policy.
+func checkImmutability(ctx context.Context, db *gorm.DB, attachment *model.SourceAttachment) error {
+	policy := attachment.Preparation.Immutability
+	if policy == model.ImmutabilityNone {
+		return nil
+	}
+	err := RecordRetention(ctx, db, attachment)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if attachment.RetentionMode.IsImmutable() {
+		return nil
+	}
+	if policy == model.ImmutabilityEnforce {
+		return errors.Wrapf(ErrMutableSource, "storage %s has retention mode %s", attachment.Storage.Name, attachment.RetentionMode)
+	}
+	logger.Warnw("scanning a source that is not immutable", "storage", attachment.Storage.Name,
+		"retentionMode", attachment.RetentionMode)
+	return nil
+}
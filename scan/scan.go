@@ -7,6 +7,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/pack/push"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
 	"github.com/data-preservation-programs/singularity/storagesystem"
 	"github.com/ipfs/go-log/v2"
 	"gorm.io/gorm"
@@ -23,6 +24,15 @@ var logger = log.Logger("scan")
 // the `last_scanned_path` field of the SourceAttachment after each file to allow
 // resuming interrupted scans.
 //
+// If the rescan mode of the SourceAttachment is incremental, the files in the storage are compared
+// in bulk against the latest scanned version of their path, by size, last modified time and hash,
+// so unchanged files do not cost a database query. Once the whole storage has been listed, the
+// files that are no longer in the storage are removed from the directory tree. They are kept if
+// any folder failed to be listed, since their absence may be due to the failure.
+//
+// If the preparation has an immutability policy, the object lock retention mode of the bucket of
+// the source is verified and recorded first, and a mutable source is refused with the enforce policy.
+//
 // Parameters:
 //   - ctx: Context for timeout and cancellation.
 //   - db: A pointer to a gorm.DB object, providing database access.
@@ -37,10 +47,15 @@ var logger = log.Logger("scan")
 //	The push package is used for processing files and managing file ranges.
 func Scan(ctx context.Context, db *gorm.DB, attachment model.SourceAttachment) error {
 	db = db.WithContext(ctx)
+	err := checkImmutability(ctx, db, &attachment)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
 	directoryCache := make(map[string]model.DirectoryID)
 	var remaining = push.NewFileRangeSet()
 	var remainingFileRanges []model.FileRange
-	err := db.Joins("File").
+	err = db.Joins("File").
 		Where("attachment_id = ? AND file_ranges.job_id is null", attachment.ID).
 		Order("file_ranges.id asc").
 		Find(&remainingFileRanges).Error
@@ -57,14 +72,39 @@ func Scan(ctx context.Context, db *gorm.DB, attachment model.SourceAttachment) e
 	if err != nil {
 		return errors.WithStack(err)
 	}
+	incremental := attachment.RescanMode == model.RescanIncremental
+	var scanned map[string]scannedFile
+	var stats rescanStats
+	var scanErrors int
+	if incremental {
+		scanned, err = loadScannedFiles(db, attachment.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed to load scanned files")
+		}
+	}
 	entryChan := sourceScanner.Scan(ctx, "")
 	for entry := range entryChan {
+		healthcheck.ReportProgress(ctx)
 		if entry.Error != nil {
+			// The rest of the listing would be rejected too
+			if storagesystem.IsAuthError(entry.Error) {
+				return errors.WithStack(entry.Error)
+			}
 			logger.Errorw("failed to scan", "error", entry.Error)
+			scanErrors++
 			continue
 		}
 
 		if entry.Dir != nil {
+			// Partitioned preparations only have the directories of their files, inside the partitions
+			if attachment.Preparation.PartitionBy != model.PartitionNone {
+				continue
+			}
+			maxDepth := attachment.Preparation.MaxDirectoryDepth
+			if maxDepth > 0 && push.DirectoryDepth(entry.Dir.Remote())+1 > maxDepth {
+				logger.Warnw("skipping directory deeper than the maximum directory depth", "path", entry.Dir.Remote(), "maxDepth", maxDepth)
+				continue
+			}
 			rootID, err := attachment.RootDirectoryID(ctx, db)
 			if err != nil {
 				return errors.Wrapf(err, "failed to get root directory for attachment %d", attachment.ID)
@@ -85,7 +125,26 @@ func Scan(ctx context.Context, db *gorm.DB, attachment model.SourceAttachment) e
 			continue
 		}
 
+		var wasScanned bool
+		if incremental {
+			path := entry.Info.Remote()
+			previous, ok := scanned[path]
+			delete(scanned, path)
+			wasScanned = ok
+			if ok {
+				size, hashValue, lastModified := push.ExtractFromFsObject(ctx, entry.Info)
+				if previous.unchanged(size, hashValue, lastModified) {
+					stats.Unchanged++
+					continue
+				}
+			}
+		}
+
 		file, fileRanges, err := push.PushFile(ctx, db, entry.Info, attachment, directoryCache)
+		if errors.Is(err, push.ErrDirectoryTooDeep) || errors.Is(err, push.ErrNotCarFile) {
+			logger.Warnw("skipping file", "error", err)
+			continue
+		}
 		if err != nil {
 			return errors.Wrapf(err, "failed to push file %s", entry.Info.Remote())
 		}
@@ -93,6 +152,11 @@ func Scan(ctx context.Context, db *gorm.DB, attachment model.SourceAttachment) e
 			logger.Infow("file already exists", "path", entry.Info.Remote())
 			continue
 		}
+		if wasScanned {
+			stats.Changed++
+		} else {
+			stats.Added++
+		}
 
 		err = addFileRangesAndCreatePackJob(ctx, db, attachment.ID, remaining, attachment.Preparation.MaxSize, fileRanges...)
 		if err != nil {
@@ -106,6 +170,27 @@ func Scan(ctx context.Context, db *gorm.DB, attachment model.SourceAttachment) e
 			return errors.WithStack(err)
 		}
 	}
+
+	if !incremental || ctx.Err() != nil {
+		return nil
+	}
+	if scanErrors > 0 {
+		logger.Warnw("keeping the files missing from the source since some folders failed to be listed",
+			"attachment", attachment.ID, "missing", len(scanned), "errors", scanErrors)
+	} else {
+		paths := make([]string, 0, len(scanned))
+		for path := range scanned {
+			paths = append(paths, path)
+		}
+		stats.Removed = len(paths)
+		removed, err := removeFiles(ctx, db, attachment, paths)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		logger.Infow("removed files from the directory tree", "attachment", attachment.ID, "removed", removed)
+	}
+	logger.Infow("incremental rescan finished", "attachment", attachment.ID, "added", stats.Added,
+		"changed", stats.Changed, "removed", stats.Removed, "unchanged", stats.Unchanged)
 	return nil
 }
 
@@ -5,9 +5,13 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/daggen"
 	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
 	"github.com/rjNemo/underscore"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -74,3 +78,178 @@ func TestScan(t *testing.T) {
 		require.Len(t, jobs, 13)
 	})
 }
+
+func TestScan_MaxDirectoryDepth(t *testing.T) {
+	tmp := t.TempDir()
+	for _, path := range []string{"1.bin", "1/2.bin", "1/2/3.bin", "1/2/3/4.bin", "1/2/3/4/5.bin"} {
+		err := os.MkdirAll(filepath.Join(tmp, filepath.Dir(path)), 0755)
+		require.NoError(t, err)
+		err = os.WriteFile(filepath.Join(tmp, path), testutil.GenerateRandomBytes(10), 0644)
+		require.NoError(t, err)
+	}
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		attachment := model.SourceAttachment{
+			Preparation: &model.Preparation{
+				MaxSize:           2_000_000,
+				MaxDirectoryDepth: 2,
+			},
+			Storage: &model.Storage{
+				Type: "local",
+				Path: tmp,
+			},
+		}
+		err := db.Create(&attachment).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Directory{AttachmentID: attachment.ID}).Error
+		require.NoError(t, err)
+		err = Scan(ctx, db, attachment)
+		require.NoError(t, err)
+
+		var dirs []model.Directory
+		err = db.Find(&dirs).Error
+		require.NoError(t, err)
+		require.Len(t, dirs, 3)
+		var files []model.File
+		err = db.Find(&files).Error
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"1.bin", "1/2.bin", "1/2/3.bin"}, underscore.Map(files, func(f model.File) string {
+			return f.Path
+		}))
+	})
+}
+
+func TestScan_Immutability(t *testing.T) {
+	tmp := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmp, "1.bin"), testutil.GenerateRandomBytes(10), 0644)
+	require.NoError(t, err)
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		attachment := model.SourceAttachment{
+			Preparation: &model.Preparation{
+				MaxSize:      2_000_000,
+				Immutability: model.ImmutabilityEnforce,
+			},
+			Storage: &model.Storage{
+				Name: "source",
+				Type: "local",
+				Path: tmp,
+			},
+		}
+		err := db.Create(&attachment).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Directory{AttachmentID: attachment.ID}).Error
+		require.NoError(t, err)
+
+		// The local storage has no object lock, so it cannot be verified to be immutable
+		err = Scan(ctx, db, attachment)
+		require.ErrorIs(t, err, ErrMutableSource)
+		var stored model.SourceAttachment
+		err = db.First(&stored, attachment.ID).Error
+		require.NoError(t, err)
+		require.Equal(t, model.RetentionUnsupported, stored.RetentionMode)
+		require.NotNil(t, stored.RetentionCheckedAt)
+		var count int64
+		err = db.Model(&model.File{}).Count(&count).Error
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		attachment.Preparation.Immutability = model.ImmutabilityWarn
+		err = Scan(ctx, db, attachment)
+		require.NoError(t, err)
+		err = db.Model(&model.File{}).Count(&count).Error
+		require.NoError(t, err)
+		require.EqualValues(t, 1, count)
+	})
+}
+
+func TestScan_Incremental(t *testing.T) {
+	tmp := t.TempDir()
+	for _, path := range []string{"a.txt", "b.txt", "sub/c.txt"} {
+		err := os.MkdirAll(filepath.Join(tmp, filepath.Dir(path)), 0755)
+		require.NoError(t, err)
+		err = os.WriteFile(filepath.Join(tmp, path), testutil.GenerateRandomBytes(10), 0644)
+		require.NoError(t, err)
+	}
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		attachment := model.SourceAttachment{
+			RescanMode: model.RescanIncremental,
+			Preparation: &model.Preparation{
+				MaxSize: 2_000_000,
+			},
+			Storage: &model.Storage{
+				Type: "local",
+				Path: tmp,
+			},
+		}
+		err := db.Create(&attachment).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Directory{AttachmentID: attachment.ID}).Error
+		require.NoError(t, err)
+		err = Scan(ctx, db, attachment)
+		require.NoError(t, err)
+
+		// Pack the files into their directories
+		var files []model.File
+		err = db.Find(&files).Error
+		require.NoError(t, err)
+		require.Len(t, files, 3)
+		for _, file := range files {
+			c := cid.NewCidV1(cid.Raw, util.Hash([]byte(file.Path)))
+			err = db.Model(&file).Update("cid", model.CID(c)).Error
+			require.NoError(t, err)
+			var dir model.Directory
+			err = db.First(&dir, *file.DirectoryID).Error
+			require.NoError(t, err)
+			data := daggen.NewDirectoryData()
+			err = data.UnmarshalBinary(ctx, dir.Data)
+			require.NoError(t, err)
+			err = data.AddFile(ctx, file.FileName(), c, uint64(file.Size))
+			require.NoError(t, err)
+			dir.Data, err = data.MarshalBinary(ctx)
+			require.NoError(t, err)
+			err = db.Model(&dir).Updates(map[string]any{"data": dir.Data, "exported": true}).Error
+			require.NoError(t, err)
+		}
+
+		// Rescan after removing, modifying and adding files
+		err = os.Remove(filepath.Join(tmp, "sub/c.txt"))
+		require.NoError(t, err)
+		err = os.WriteFile(filepath.Join(tmp, "a.txt"), testutil.GenerateRandomBytes(20), 0644)
+		require.NoError(t, err)
+		err = os.Chtimes(filepath.Join(tmp, "a.txt"), time.Now(), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		err = os.WriteFile(filepath.Join(tmp, "d.txt"), testutil.GenerateRandomBytes(30), 0644)
+		require.NoError(t, err)
+		err = Scan(ctx, db, attachment)
+		require.NoError(t, err)
+
+		err = db.Order("id").Find(&files).Error
+		require.NoError(t, err)
+		require.Equal(t, []string{"a.txt", "b.txt", "sub/c.txt", "a.txt", "d.txt"}, underscore.Map(files, func(f model.File) string {
+			return f.Path
+		}))
+
+		var sub model.Directory
+		err = db.Where("name = ?", "sub").First(&sub).Error
+		require.NoError(t, err)
+		require.False(t, sub.Exported)
+		data := daggen.NewDirectoryData()
+		err = data.UnmarshalBinary(ctx, sub.Data)
+		require.NoError(t, err)
+		child, err := data.Child(ctx, "c.txt")
+		require.NoError(t, err)
+		require.False(t, child.Defined())
+
+		var root model.Directory
+		err = db.Where("parent_id IS NULL").First(&root).Error
+		require.NoError(t, err)
+		require.False(t, root.Exported)
+		err = data.UnmarshalBinary(ctx, root.Data)
+		require.NoError(t, err)
+		child, err = data.Child(ctx, "b.txt")
+		require.NoError(t, err)
+		require.True(t, child.Defined())
+	})
+}
@@ -0,0 +1,332 @@
+package contentprovider
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ipfs/go-cid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	AccessLogFormatCombined = "combined"
+	AccessLogFormatW3C      = "w3c"
+)
+
+// AccessLogStdout is the access log path that writes to stdout instead of a file.
+const AccessLogStdout = "-"
+
+var ErrInvalidAccessLogFormat = errors.New("invalid access log format")
+
+type AccessLogConfig struct {
+	// Path is the file to write the access log to. Empty disables the access log, "-" writes to stdout.
+	Path string
+	// Format is either "combined" or "w3c".
+	Format string
+	// MaxSize is the size in bytes after which the log file is rotated. 0 disables rotation.
+	MaxSize int64
+	// MaxBackups is the number of rotated log files to keep.
+	MaxBackups int
+}
+
+type accessLogEntry struct {
+	Time      time.Time
+	Client    string
+	Method    string
+	Path      string
+	Query     string
+	Proto     string
+	Status    int
+	Bytes     int64
+	Duration  time.Duration
+	Referer   string
+	UserAgent string
+	Range     string
+	PieceCID  string
+	DealID    *uint64
+}
+
+// AccessLogger writes retrieval requests as access logs in Apache combined format or W3C extended log format,
+// so that they can be processed by existing log analytics stacks. When writing to a file, the file is rotated
+// once it reaches the configured size.
+type AccessLogger struct {
+	mu         sync.Mutex
+	format     string
+	path       string
+	maxSize    int64
+	maxBackups int
+	out        io.Writer
+	file       *os.File
+	size       int64
+}
+
+// NewAccessLogger creates a new AccessLogger from the given configuration.
+//
+// Parameters:
+//   - config: The AccessLogConfig that specifies the destination, format and rotation of the access log.
+//
+// Returns:
+//   - A pointer to the AccessLogger, or nil if the access log is disabled.
+//   - An error if the format is invalid or the log file cannot be opened.
+func NewAccessLogger(config AccessLogConfig) (*AccessLogger, error) {
+	if config.Path == "" {
+		//nolint:nilnil
+		return nil, nil
+	}
+	if config.Format == "" {
+		config.Format = AccessLogFormatCombined
+	}
+	if config.Format != AccessLogFormatCombined && config.Format != AccessLogFormatW3C {
+		return nil, errors.Wrapf(ErrInvalidAccessLogFormat, "%s", config.Format)
+	}
+
+	a := &AccessLogger{
+		format:     config.Format,
+		path:       config.Path,
+		maxSize:    config.MaxSize,
+		maxBackups: config.MaxBackups,
+	}
+	if config.Path == AccessLogStdout {
+		a.out = os.Stdout
+		if a.format == AccessLogFormatW3C {
+			_, err := io.WriteString(a.out, w3cHeader(time.Now()))
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+		return a, nil
+	}
+
+	err := a.openFile()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return a, nil
+}
+
+func (a *AccessLogger) openFile() error {
+	file, err := os.OpenFile(a.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open access log file %s", a.path)
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return errors.Wrapf(err, "failed to stat access log file %s", a.path)
+	}
+	a.file = file
+	a.out = file
+	a.size = stat.Size()
+	if a.size == 0 && a.format == AccessLogFormatW3C {
+		n, err := io.WriteString(file, w3cHeader(time.Now()))
+		a.size += int64(n)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+// rotate closes the current log file and shifts it to <path>.1, <path>.1 to <path>.2 and so on.
+// Files beyond MaxBackups are removed.
+func (a *AccessLogger) rotate() error {
+	err := a.file.Close()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if a.maxBackups <= 0 {
+		err = os.Remove(a.path)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return a.openFile()
+	}
+
+	err = os.Remove(a.path + "." + strconv.Itoa(a.maxBackups))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.WithStack(err)
+	}
+	for i := a.maxBackups - 1; i >= 1; i-- {
+		err = os.Rename(a.path+"."+strconv.Itoa(i), a.path+"."+strconv.Itoa(i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return errors.WithStack(err)
+		}
+	}
+	err = os.Rename(a.path, a.path+".1")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return a.openFile()
+}
+
+func (a *AccessLogger) log(entry accessLogEntry) error {
+	var line string
+	if a.format == AccessLogFormatW3C {
+		line = formatW3C(entry)
+	} else {
+		line = formatCombined(entry)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.file != nil && a.maxSize > 0 && a.size > 0 && a.size+int64(len(line)) > a.maxSize {
+		err := a.rotate()
+		if err != nil {
+			return errors.Wrap(err, "failed to rotate access log")
+		}
+	}
+	n, err := io.WriteString(a.out, line)
+	a.size += int64(n)
+	return errors.WithStack(err)
+}
+
+func (a *AccessLogger) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.file == nil {
+		return nil
+	}
+	return a.file.Close()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func dealIDString(dealID *uint64) string {
+	if dealID == nil {
+		return "-"
+	}
+	return strconv.FormatUint(*dealID, 10)
+}
+
+// formatCombined formats the entry in Apache combined log format, followed by the piece CID, the requested range,
+// the deal ID and the duration in milliseconds.
+func formatCombined(e accessLogEntry) string {
+	uri := e.Path
+	if e.Query != "" {
+		uri += "?" + e.Query
+	}
+	return fmt.Sprintf("%s - - [%s] %q %d %d %q %q %q %q %s %d\n",
+		orDash(e.Client),
+		e.Time.Format("02/Jan/2006:15:04:05 -0700"),
+		e.Method+" "+uri+" "+e.Proto,
+		e.Status,
+		e.Bytes,
+		orDash(e.Referer),
+		orDash(e.UserAgent),
+		orDash(e.PieceCID),
+		orDash(e.Range),
+		dealIDString(e.DealID),
+		e.Duration.Milliseconds(),
+	)
+}
+
+const w3cFields = "date time c-ip cs-method cs-uri-stem cs-uri-query sc-status sc-bytes time-taken cs(User-Agent) cs(Referer) cs(Range) x-piece-cid x-deal-id"
+
+func w3cHeader(t time.Time) string {
+	return "#Version: 1.0\n" +
+		"#Software: singularity content provider\n" +
+		"#Date: " + t.UTC().Format("2006-01-02 15:04:05") + "\n" +
+		"#Fields: " + w3cFields + "\n"
+}
+
+// w3cValue escapes a field value for W3C extended log format, where fields are separated by spaces.
+func w3cValue(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return strings.ReplaceAll(s, " ", "+")
+}
+
+// formatW3C formats the entry in W3C extended log format using the fields declared in the header.
+func formatW3C(e accessLogEntry) string {
+	t := e.Time.UTC()
+	return strings.Join([]string{
+		t.Format("2006-01-02"),
+		t.Format("15:04:05"),
+		w3cValue(e.Client),
+		w3cValue(e.Method),
+		w3cValue(e.Path),
+		w3cValue(e.Query),
+		strconv.Itoa(e.Status),
+		strconv.FormatInt(e.Bytes, 10),
+		strconv.FormatFloat(e.Duration.Seconds(), 'f', 3, 64),
+		w3cValue(e.UserAgent),
+		w3cValue(e.Referer),
+		w3cValue(e.Range),
+		w3cValue(e.PieceCID),
+		dealIDString(e.DealID),
+	}, " ") + "\n"
+}
+
+// findDealID returns the deal ID of the piece if it is unambiguous, i.e. there is exactly one
+// published or active deal for the piece. Otherwise, it returns nil.
+func (s *HTTPServer) findDealID(c echo.Context, pieceCid cid.Cid) *uint64 {
+	var dealIDs []uint64
+	err := s.dbNoContext.WithContext(c.Request().Context()).Model(&model.Deal{}).
+		Where("piece_cid = ? AND state IN ? AND deal_id IS NOT NULL", model.CID(pieceCid), []model.DealState{model.DealPublished, model.DealActive}).
+		Limit(2).
+		Pluck("deal_id", &dealIDs).Error
+	if err != nil {
+		logger.Warnw("failed to find deal for access log", "piece", pieceCid.String(), "error", err)
+		return nil
+	}
+	if len(dealIDs) != 1 {
+		return nil
+	}
+	return &dealIDs[0]
+}
+
+// accessLogMiddleware is an echo middleware that writes an access log entry for every request once it is served.
+func (s *HTTPServer) accessLogMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+		err := next(c)
+		if err != nil {
+			c.Error(err)
+		}
+
+		req := c.Request()
+		entry := accessLogEntry{
+			Time:      start,
+			Client:    c.RealIP(),
+			Method:    req.Method,
+			Path:      req.URL.Path,
+			Query:     req.URL.RawQuery,
+			Proto:     req.Proto,
+			Status:    c.Response().Status,
+			Bytes:     c.Response().Size,
+			Duration:  time.Since(start),
+			Referer:   req.Referer(),
+			UserAgent: req.UserAgent(),
+			Range:     req.Header.Get("Range"),
+		}
+		if id := c.Param("id"); id != "" && strings.HasPrefix(c.Path(), "/piece/") {
+			pieceCid, parseErr := cid.Parse(id)
+			if parseErr == nil {
+				entry.PieceCID = pieceCid.String()
+				if entry.Status < 400 {
+					entry.DealID = s.findDealID(c, pieceCid)
+				}
+			}
+		}
+
+		logErr := s.accessLogger.log(entry)
+		if logErr != nil {
+			logger.Errorw("failed to write access log", "error", logErr)
+		}
+		return err
+	}
+}
@@ -0,0 +1,119 @@
+package contentprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var testAccessLogEntry = accessLogEntry{
+	Time:      time.Date(2023, 10, 1, 12, 30, 0, 0, time.UTC),
+	Client:    "10.0.0.1",
+	Method:    http.MethodGet,
+	Path:      "/piece/baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq",
+	Proto:     "HTTP/1.1",
+	Status:    http.StatusPartialContent,
+	Bytes:     1024,
+	Duration:  1500 * time.Millisecond,
+	UserAgent: "curl/8.0 test",
+	Range:     "bytes=0-1023",
+	PieceCID:  "baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq",
+	DealID:    ptr.Of(uint64(42)),
+}
+
+func TestAccessLogFormats(t *testing.T) {
+	require.Equal(t,
+		`10.0.0.1 - - [01/Oct/2023:12:30:00 +0000] "GET /piece/baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq HTTP/1.1" 206 1024 "-" "curl/8.0 test" "baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq" "bytes=0-1023" 42 1500`+"\n",
+		formatCombined(testAccessLogEntry))
+	require.Equal(t,
+		"2023-10-01 12:30:00 10.0.0.1 GET /piece/baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq - 206 1024 1.500 curl/8.0+test - bytes=0-1023 baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq 42\n",
+		formatW3C(testAccessLogEntry))
+}
+
+func TestNewAccessLogger(t *testing.T) {
+	a, err := NewAccessLogger(AccessLogConfig{})
+	require.NoError(t, err)
+	require.Nil(t, a)
+
+	_, err = NewAccessLogger(AccessLogConfig{Path: AccessLogStdout, Format: "unknown"})
+	require.ErrorIs(t, err, ErrInvalidAccessLogFormat)
+}
+
+func TestAccessLoggerRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	line := formatW3C(testAccessLogEntry)
+	a, err := NewAccessLogger(AccessLogConfig{
+		Path:       path,
+		Format:     AccessLogFormatW3C,
+		MaxSize:    int64(len(w3cHeader(time.Now())) + len(line)),
+		MaxBackups: 2,
+	})
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, a.log(testAccessLogEntry))
+	}
+	require.NoError(t, a.Close())
+
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		content, err := os.ReadFile(name)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(content), "#Version: 1.0\n"))
+		require.True(t, strings.HasSuffix(string(content), line))
+	}
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestAccessLogMiddleware(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		pieceCID := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("test")))
+		err := db.Create(&model.Deal{
+			DealID:   ptr.Of(uint64(100)),
+			State:    model.DealActive,
+			PieceCID: model.CID(pieceCID),
+			Wallet:   &model.Wallet{},
+		}).Error
+		require.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "access.log")
+		accessLogger, err := NewAccessLogger(AccessLogConfig{Path: path})
+		require.NoError(t, err)
+		s := HTTPServer{
+			dbNoContext:  db,
+			accessLogger: accessLogger,
+		}
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/piece/"+pieceCID.String(), nil)
+		req.Header.Set("Range", "bytes=0-9")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/piece/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(pieceCID.String())
+		handler := s.accessLogMiddleware(func(c echo.Context) error {
+			return c.String(http.StatusPartialContent, "0123456789")
+		})
+		err = handler(c)
+		require.NoError(t, err)
+		require.NoError(t, accessLogger.Close())
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Contains(t, string(content), `"GET /piece/`+pieceCID.String()+` HTTP/1.1" 206 10 "-" "-" "`+pieceCID.String()+`" "bytes=0-9" 100 `)
+	})
+}
@@ -0,0 +1,191 @@
+package contentprovider
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ipfs/boxo/routing/http/client"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"gorm.io/gorm"
+)
+
+const (
+	AnnounceStrategyAll   = "all"
+	AnnounceStrategyRoots = "roots"
+
+	DefaultAnnounceInterval = 12 * time.Hour
+	// announceCheckInterval is how often the blocks added since the last announcement are announced.
+	announceCheckInterval = 10 * time.Minute
+	// announceBatchSize is the number of CIDs announced in each provide request.
+	announceBatchSize = 1000
+)
+
+var ErrInvalidAnnounceStrategy = errors.New("invalid announce strategy, must be 'all' or 'roots'")
+
+// AnnounceConfig configures the announcement of the blocks served over Bitswap to delegated routing endpoints.
+type AnnounceConfig struct {
+	URLs     []string      // Delegated routing endpoints to announce to, i.e. https://cid.contact. Announcement is disabled if empty.
+	Strategy string        // Which CIDs to announce, one of AnnounceStrategyAll or AnnounceStrategyRoots
+	Interval time.Duration // How often all CIDs are announced again, before the announcements expire
+	Addrs    []string      // Public multiaddresses of the libp2p host to announce. The public listen addresses are announced if empty.
+}
+
+// BitswapProvider announces that the content provider serves CIDs over Bitswap, i.e. a delegated routing client.
+type BitswapProvider interface {
+	ProvideBitswap(ctx context.Context, keys []cid.Cid, ttl time.Duration) (time.Duration, error)
+}
+
+// BitswapAnnouncer announces the CIDs of the blocks served over Bitswap to delegated routing endpoints, such as the
+// network indexer, so IPFS clients can find the content provider and retrieve the files directly from it. With the
+// "all" strategy, the CIDs of all blocks of the CarBlock index are announced, while with the "roots" strategy, only
+// the CIDs of the files and directories are announced, and clients retrieve the other blocks from the peer they
+// found the root with. The announcements are signed with the libp2p identity key.
+type BitswapAnnouncer struct {
+	db        *gorm.DB
+	providers []BitswapProvider
+	config    AnnounceConfig
+	// lastIDs are the IDs of the last rows announced of each table, so only the rows added since are announced until
+	// all CIDs are announced again
+	lastIDs map[string]uint64
+}
+
+// NewBitswapAnnouncer creates a BitswapAnnouncer that announces to the configured delegated routing endpoints.
+//
+// Parameters:
+//   - db: The database to read the CIDs from.
+//   - key: The libp2p identity key of the Bitswap server, used to sign the announcements.
+//   - addrs: The multiaddresses of the Bitswap server to announce.
+//   - config: The AnnounceConfig with the endpoints, the strategy and the interval.
+//
+// Returns:
+//   - A pointer to the BitswapAnnouncer, and an error if the strategy or an endpoint is invalid.
+func NewBitswapAnnouncer(db *gorm.DB, key crypto.PrivKey, addrs []multiaddr.Multiaddr, config AnnounceConfig) (*BitswapAnnouncer, error) {
+	switch config.Strategy {
+	case "":
+		config.Strategy = AnnounceStrategyAll
+	case AnnounceStrategyAll, AnnounceStrategyRoots:
+	default:
+		return nil, errors.Wrapf(ErrInvalidAnnounceStrategy, "got '%s'", config.Strategy)
+	}
+	if config.Interval <= 0 {
+		config.Interval = DefaultAnnounceInterval
+	}
+	peerID, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	announcer := &BitswapAnnouncer{
+		db:      db,
+		config:  config,
+		lastIDs: make(map[string]uint64),
+	}
+	for _, url := range config.URLs {
+		c, err := client.New(url, client.WithIdentity(key), client.WithProviderInfo(peerID, addrs))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid announce URL %s", url)
+		}
+		announcer.providers = append(announcer.providers, c)
+	}
+	return announcer, nil
+}
+
+// announceRows announces the CIDs of the rows of a table added since the last announcement, or of all rows if all is
+// set, in batches.
+func (a *BitswapAnnouncer) announceRows(ctx context.Context, table any, name string, all bool) (int, error) {
+	lastID := a.lastIDs[name]
+	if all {
+		lastID = 0
+	}
+	var count int
+	for {
+		var rows []struct {
+			ID  uint64
+			CID model.CID `gorm:"column:cid"`
+		}
+		err := a.db.WithContext(ctx).Model(table).Select("id", "cid").
+			Where("id > ? AND cid IS NOT NULL", lastID).
+			Order("id asc").Limit(announceBatchSize).Find(&rows).Error
+		if err != nil {
+			return count, errors.Wrapf(err, "failed to find %s to announce", name)
+		}
+		if len(rows) == 0 {
+			break
+		}
+		keys := make([]cid.Cid, 0, len(rows))
+		for _, row := range rows {
+			if cid.Cid(row.CID) != cid.Undef {
+				keys = append(keys, cid.Cid(row.CID))
+			}
+		}
+		if len(keys) > 0 {
+			// The announcements are valid until all CIDs are announced again, with some margin
+			ttl := 2 * a.config.Interval
+			for _, provider := range a.providers {
+				_, err = provider.ProvideBitswap(ctx, keys, ttl)
+				if err != nil {
+					return count, errors.Wrapf(err, "failed to announce %s", name)
+				}
+			}
+		}
+		count += len(keys)
+		lastID = rows[len(rows)-1].ID
+		a.lastIDs[name] = lastID
+	}
+	return count, nil
+}
+
+// Announce announces the CIDs added since the last announcement, or all CIDs if all is set.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - all: Whether to announce all CIDs again.
+//
+// Returns:
+//   - The number of CIDs announced.
+//   - An error, if the CIDs could not be read or announced.
+func (a *BitswapAnnouncer) Announce(ctx context.Context, all bool) (int, error) {
+	if a.config.Strategy == AnnounceStrategyRoots {
+		files, err := a.announceRows(ctx, &model.File{}, "files", all)
+		if err != nil {
+			return files, err
+		}
+		directories, err := a.announceRows(ctx, &model.Directory{}, "directories", all)
+		return files + directories, err
+	}
+	return a.announceRows(ctx, &model.CarBlock{}, "blocks", all)
+}
+
+// Start announces all CIDs immediately and then every interval, and the CIDs added in the meantime every 10 minutes,
+// until the context is done. Failed announcements are retried on the next round.
+func (a *BitswapAnnouncer) Start(ctx context.Context) {
+	go func() {
+		lastAll := time.Time{}
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+			all := time.Since(lastAll) >= a.config.Interval
+			count, err := a.Announce(ctx, all)
+			if err != nil && ctx.Err() == nil {
+				logger.Errorw("failed to announce bitswap blocks", "error", err)
+			} else if err == nil {
+				if all {
+					lastAll = time.Now()
+				}
+				if count > 0 {
+					logger.Infow("announced bitswap blocks", "count", count, "all", all)
+				}
+			}
+			timer.Reset(announceCheckInterval)
+		}
+	}()
+}
@@ -0,0 +1,150 @@
+package contentprovider
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/routing/http/server"
+	"github.com/ipfs/boxo/routing/http/types"
+	"github.com/ipfs/boxo/routing/http/types/iter"
+	util2 "github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type fakeContentRouter struct {
+	mu       sync.Mutex
+	requests []*server.BitswapWriteProvideRequest
+}
+
+func (*fakeContentRouter) FindProviders(context.Context, cid.Cid, int) (iter.ResultIter[types.ProviderResponse], error) {
+	return nil, nil
+}
+
+func (r *fakeContentRouter) ProvideBitswap(_ context.Context, req *server.BitswapWriteProvideRequest) (time.Duration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, req)
+	return req.AdvisoryTTL, nil
+}
+
+func (*fakeContentRouter) Provide(context.Context, *server.WriteProvideRequest) (types.ProviderResponse, error) {
+	return nil, nil
+}
+
+func (r *fakeContentRouter) keys() []cid.Cid {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var keys []cid.Cid
+	for _, req := range r.requests {
+		keys = append(keys, req.Keys...)
+	}
+	r.requests = nil
+	return keys
+}
+
+func TestBitswapAnnouncer(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		router := &fakeContentRouter{}
+		routingServer := httptest.NewServer(server.Handler(router))
+		defer routingServer.Close()
+
+		private, _, _, err := util.GenerateNewPeer()
+		require.NoError(t, err)
+		key, err := crypto.UnmarshalPrivateKey(private)
+		require.NoError(t, err)
+		peerID, err := peer.IDFromPrivateKey(key)
+		require.NoError(t, err)
+		addr := multiaddr.StringCast("/dns4/bitswap.example.com/tcp/4001")
+
+		_, err = NewBitswapAnnouncer(db, key, nil, AnnounceConfig{URLs: []string{routingServer.URL}, Strategy: "some"})
+		require.ErrorIs(t, err, ErrInvalidAnnounceStrategy)
+
+		announcer, err := NewBitswapAnnouncer(db, key, []multiaddr.Multiaddr{addr}, AnnounceConfig{
+			URLs:     []string{routingServer.URL},
+			Interval: time.Hour,
+		})
+		require.NoError(t, err)
+
+		newCID := func(s string) model.CID {
+			return model.CID(cid.NewCidV1(cid.Raw, util2.Hash([]byte(s))))
+		}
+		preparation := model.Preparation{}
+		require.NoError(t, db.Create(&preparation).Error)
+		car := model.Car{PreparationID: preparation.ID}
+		require.NoError(t, db.Create(&car).Error)
+		require.NoError(t, db.Create(&[]model.CarBlock{
+			{CarID: car.ID, CID: newCID("1")},
+			{CarID: car.ID, CID: newCID("2")},
+		}).Error)
+
+		count, err := announcer.Announce(ctx, false)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.Len(t, router.requests, 1)
+		request := router.requests[0]
+		require.Equal(t, peerID, request.ID)
+		require.Equal(t, []multiaddr.Multiaddr{addr}, request.Addrs)
+		require.Equal(t, 2*time.Hour, request.AdvisoryTTL)
+		require.Equal(t, []cid.Cid{cid.Cid(newCID("1")), cid.Cid(newCID("2"))}, router.keys())
+
+		// Only the blocks added since are announced, until all blocks are announced again
+		require.NoError(t, db.Create(&model.CarBlock{CarID: car.ID, CID: newCID("3")}).Error)
+		count, err = announcer.Announce(ctx, false)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.Equal(t, []cid.Cid{cid.Cid(newCID("3"))}, router.keys())
+		count, err = announcer.Announce(ctx, true)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+		require.Len(t, router.keys(), 3)
+
+		t.Run("roots", func(t *testing.T) {
+			announcer, err := NewBitswapAnnouncer(db, key, []multiaddr.Multiaddr{addr}, AnnounceConfig{
+				URLs:     []string{routingServer.URL},
+				Strategy: AnnounceStrategyRoots,
+			})
+			require.NoError(t, err)
+			storage := model.Storage{Name: "source"}
+			require.NoError(t, db.Create(&storage).Error)
+			attachment := model.SourceAttachment{PreparationID: preparation.ID, StorageID: storage.ID}
+			require.NoError(t, db.Create(&attachment).Error)
+			dir := model.Directory{AttachmentID: attachment.ID, CID: newCID("dir")}
+			require.NoError(t, db.Create(&dir).Error)
+			require.NoError(t, db.Create(&[]model.File{
+				{AttachmentID: attachment.ID, DirectoryID: &dir.ID, Path: "a.txt", CID: newCID("file")},
+				// Files that are not packed yet have no CID
+				{AttachmentID: attachment.ID, DirectoryID: &dir.ID, Path: "b.txt"},
+			}).Error)
+			count, err := announcer.Announce(ctx, false)
+			require.NoError(t, err)
+			require.Equal(t, 2, count)
+			require.Equal(t, []cid.Cid{cid.Cid(newCID("file")), cid.Cid(newCID("dir"))}, router.keys())
+		})
+	})
+}
+
+func TestBitswapAnnounceAddrs(t *testing.T) {
+	public := multiaddr.StringCast("/ip4/8.8.8.8/tcp/4001")
+	private := multiaddr.StringCast("/ip4/127.0.0.1/tcp/4001")
+	addrs, err := bitswapAnnounceAddrs([]multiaddr.Multiaddr{public, private}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []multiaddr.Multiaddr{public}, addrs)
+
+	addrs, err = bitswapAnnounceAddrs([]multiaddr.Multiaddr{private}, []string{"/dns4/bitswap.example.com/tcp/4001"})
+	require.NoError(t, err)
+	require.Equal(t, "/dns4/bitswap.example.com/tcp/4001", addrs[0].String())
+
+	_, err = bitswapAnnounceAddrs(nil, []string{"invalid"})
+	require.ErrorContains(t, err, "invalid announce address")
+}
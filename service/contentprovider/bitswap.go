@@ -23,11 +23,17 @@ type BitswapServer struct {
 	// dbNoContext is a GORM database instance that doesn't use context for managing database connections.
 	dbNoContext *gorm.DB
 
+	// blockCache is an optional cache of the blocks read from the data sources.
+	blockCache *store.BlockCache
+
 	// host is a libp2p host used to build and configure a new Bitswap instance.
 	host host.Host
+
+	// announcer is an optional announcer of the served blocks to delegated routing endpoints.
+	announcer *BitswapAnnouncer
 }
 
-func NewBitswapServer(dbNoContext *gorm.DB, private crypto.PrivKey, addrs ...multiaddr.Multiaddr) (*BitswapServer, error) {
+func NewBitswapServer(dbNoContext *gorm.DB, blockCache *store.BlockCache, private crypto.PrivKey, addrs ...multiaddr.Multiaddr) (*BitswapServer, error) {
 	h, err := util.InitHost([]libp2p.Option{libp2p.Identity(private)}, addrs...)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -38,6 +44,7 @@ func NewBitswapServer(dbNoContext *gorm.DB, private crypto.PrivKey, addrs ...mul
 	logger.Info("peerID: " + h.ID().String())
 	return &BitswapServer{
 		dbNoContext: dbNoContext,
+		blockCache:  blockCache,
 		host:        h,
 	}, nil
 }
@@ -48,7 +55,7 @@ func (BitswapServer) Name() string {
 
 // Start initializes the Bitswap server with the provided context.
 // It sets up the necessary routing and networking components,
-// and starts serving Bitswap requests.
+// and starts serving Bitswap requests and announcing the served blocks, if enabled.
 // It returns channels that signal when the service has stopped or encountered an error.
 func (s BitswapServer) Start(ctx context.Context, exitErr chan<- error) error {
 	nilRouter, err := nilrouting.ConstructNilRouting(ctx, nil, nil, nil)
@@ -57,9 +64,12 @@ func (s BitswapServer) Start(ctx context.Context, exitErr chan<- error) error {
 	}
 
 	net := bsnetwork.NewFromIpfsHost(s.host, nilRouter)
-	bs := &store.FileReferenceBlockStore{DBNoContext: s.dbNoContext}
+	bs := &store.FileReferenceBlockStore{DBNoContext: s.dbNoContext, BlockCache: s.blockCache}
 	bsserver := server.New(ctx, net, bs)
 	net.Start(bsserver)
+	if s.announcer != nil {
+		s.announcer.Start(ctx)
+	}
 
 	go func() {
 		<-ctx.Done()
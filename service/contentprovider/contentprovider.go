@@ -2,13 +2,18 @@ package contentprovider
 
 import (
 	"context"
-	"encoding/base64"
+	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/retrievaltoken"
 	"github.com/data-preservation-programs/singularity/service"
+	"github.com/data-preservation-programs/singularity/signer"
+	"github.com/data-preservation-programs/singularity/store"
 	"github.com/data-preservation-programs/singularity/util"
+	"github.com/ipni/go-libipni/metadata"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
 
 	logging "github.com/ipfs/go-log/v2"
 	"gorm.io/gorm"
@@ -17,24 +22,50 @@ import (
 var logger = logging.Logger("contentprovider")
 
 type Service struct {
-	servers []service.Server
+	servers    []service.Server
+	blockCache *store.BlockCache
 }
 
 type Config struct {
-	HTTP    HTTPConfig
-	Bitswap BitswapConfig
+	HTTP       HTTPConfig
+	Bitswap    BitswapConfig
+	BlockCache BlockCacheConfig
+	IPNI       IPNIConfig
+}
+
+// BlockCacheConfig configures the cache of the blocks read from the data sources, shared by all retrieval methods.
+type BlockCacheConfig struct {
+	MaxSize int64  // Maximum total size of the cached blocks in bytes. The cache is disabled if 0.
+	Dir     string // Directory to store the cached blocks in. The blocks are kept in memory if empty.
 }
 
 type HTTPConfig struct {
-	EnablePiece         bool
-	EnablePieceMetadata bool
-	Bind                string
+	EnablePiece           bool
+	EnablePieceMetadata   bool
+	EnableSubDAG          bool
+	EnableGateway         bool // Serve /ipfs/<cid> as a trustless gateway, with raw block, CAR and UnixFS file responses
+	Bind                  string
+	MetadataCacheTTL      time.Duration
+	MetadataCacheCapacity uint64        // Maximum number of pieces whose block index is cached. 0 means no limit.
+	VerifyBlocks          bool          // Re-hash the blocks read from the data sources and verify them against their CID
+	PrefetchConcurrency   int           // Number of concurrent reads of the upcoming blocks of a piece. Prefetching is disabled if 0.
+	PrefetchBufferSize    int64         // Maximum total size of the blocks of a piece that are read ahead
+	MaxWarmPrefetch       int64         // Maximum total number of bytes prefetched by one warm request across all its pieces
+	RemoteCarMode         string        // How the CAR files in a storage with signed links are served, one of RemoteCarModeOpen, RemoteCarModeProxy or RemoteCarModeRedirect
+	RemoteCarLinkExpiry   time.Duration // How long the signed links of the CAR files are valid
+	RequireToken          bool          // Require a retrieval token to retrieve pieces, piece metadata and sub-DAGs
+	PendingDealsToken     string        // Bearer token required to list the deals pending import by a storage provider. The list is not served if empty.
+	AccessLog             AccessLogConfig
+	Manifest              ManifestConfig
+	PublicStats           PublicStatsConfig
+	Transport             TransportConfig
 }
 
 type BitswapConfig struct {
 	Enable           bool
 	IdentityKey      string
 	ListenMultiAddrs []string
+	Announce         AnnounceConfig
 }
 
 // NewService creates a new Service instance with the provided database and configuration.
@@ -45,53 +76,143 @@ type BitswapConfig struct {
 //
 // The function performs the following steps:
 //
-//  1. Creates an empty Service instance.
+//  1. Creates an empty Service instance. If the Bitswap server, the piece manifest or the IPNI announcement is enabled,
+//     initializes the identity key.
+//     - If the identity key is not provided, generates a new peer identity key.
+//     - If the identity key is provided, decodes it from base64 and unmarshals the private key.
+//     - If the identity key is a PKCS#11 URI, uses the secp256k1 key in the token, which never leaves the token.
+//     If a block cache size is configured, also creates a block cache shared by the HTTP and Bitswap servers.
 //
 //  2. If the HTTP server is enabled in the configuration, creates an HTTPServer instance and adds it to the servers slice.
-//     - The HTTPServer is configured with the bind address, database without context, and a DefaultHandlerResolver.
+//     - The HTTPServer is configured with the bind address, database without context, a piece metadata cache, an optional access logger,
+//     an optional piece manifest signed with the identity key, and optional public stats of the shared preparations.
 //
-//  3. If the Bitswap server is enabled in the configuration, initializes the identity key based on the configuration.
-//     - If the identity key is not provided, generates a new peer identity key.
-//     - If the identity key is provided, decodes it from base64.
-//     - Unmarshals the private key from the identity key bytes.
+//  3. If the Bitswap server is enabled in the configuration:
 //     - If no listen multiaddresses are provided, sets a default listen multiaddress.
 //     - Converts each listen multiaddress string to a Multiaddr instance.
 //     - Initializes a libp2p host with the identity key and listen multiaddresses.
 //     - Logs the libp2p listening addresses and peer ID.
 //     - Creates a BitswapServer instance with the libp2p host and database without context, and adds it to the servers slice.
+//     - If announce URLs are provided, announces the served blocks to them with the public addresses of the host.
+//
+//  4. If the IPNI announcement is enabled, creates an IndexPublisher that advertises the pieces as retrievable over Bitswap
+//     and the HTTP gateway, whichever is enabled, and publishes the advertisements with the HTTP server.
 //
-// 4. Returns the created Service instance and nil for the error if all steps are executed successfully.
+// 5. Returns the created Service instance and nil for the error if all steps are executed successfully.
 func NewService(db *gorm.DB, config Config) (*Service, error) {
 	s := &Service{}
 
-	if config.HTTP.EnablePiece || config.HTTP.EnablePieceMetadata {
-		s.servers = append(s.servers, &HTTPServer{
-			dbNoContext:         db,
-			bind:                config.HTTP.Bind,
-			enablePiece:         config.HTTP.EnablePiece,
-			enablePieceMetadata: config.HTTP.EnablePieceMetadata,
-		})
+	needIdentity := config.Bitswap.Enable || config.HTTP.Manifest.Enable || config.IPNI.Enable
+	var identityKey crypto.PrivKey
+	if needIdentity && config.Bitswap.IdentityKey != "" {
+		var err error
+		identityKey, err = signer.NewPrivKey(config.Bitswap.IdentityKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load identity key")
+		}
+	} else if needIdentity {
+		private, _, _, err := util.GenerateNewPeer()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if config.HTTP.Manifest.Enable {
+			logger.Warn("piece manifest is signed with an auto generated identity key that changes on every restart")
+		}
+		if config.IPNI.Enable {
+			logger.Warn("IPNI advertisements are signed with an auto generated identity key that changes on every restart")
+		}
+		identityKey, err = crypto.UnmarshalPrivateKey(private)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
 	}
 
-	if config.Bitswap.Enable {
-		var private []byte
-		if config.Bitswap.IdentityKey == "" {
-			var err error
-			private, _, _, err = util.GenerateNewPeer()
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-		} else {
-			var err error
-			private, err = base64.StdEncoding.DecodeString(config.Bitswap.IdentityKey)
+	if config.HTTP.Manifest.Enable && !config.HTTP.EnablePiece {
+		return nil, ErrManifestWithoutPieceRetrieval
+	}
+
+	if config.HTTP.RequireToken && config.Bitswap.Enable {
+		return nil, ErrBitswapWithRetrievalToken
+	}
+
+	if config.HTTP.RequireToken {
+		if err := retrievaltoken.CheckSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := config.HTTP.Transport.validate(); err != nil {
+		return nil, err
+	}
+
+	switch config.HTTP.RemoteCarMode {
+	case "", RemoteCarModeOpen, RemoteCarModeProxy, RemoteCarModeRedirect:
+	default:
+		return nil, errors.Wrapf(ErrInvalidRemoteCarMode, "got '%s'", config.HTTP.RemoteCarMode)
+	}
+
+	if config.BlockCache.MaxSize > 0 {
+		var err error
+		s.blockCache, err = store.NewBlockCache(config.BlockCache.MaxSize, config.BlockCache.Dir)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	var httpServer *HTTPServer
+	if config.HTTP.EnablePiece || config.HTTP.EnablePieceMetadata || config.HTTP.EnableSubDAG || config.HTTP.EnableGateway ||
+		config.HTTP.PublicStats.Enable || config.IPNI.Enable {
+		if config.HTTP.MetadataCacheTTL == 0 {
+			config.HTTP.MetadataCacheTTL = DefaultPieceMetadataCacheTTL
+		}
+		if config.HTTP.MaxWarmPrefetch == 0 {
+			config.HTTP.MaxWarmPrefetch = DefaultMaxWarmPrefetch
+		}
+		if config.HTTP.RemoteCarLinkExpiry == 0 {
+			config.HTTP.RemoteCarLinkExpiry = DefaultRemoteCarLinkExpiry
+		}
+		accessLogger, err := NewAccessLogger(config.HTTP.AccessLog)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		var manifest *PieceManifest
+		if config.HTTP.Manifest.Enable {
+			manifest, err = NewPieceManifest(db, identityKey, config.HTTP.Manifest)
 			if err != nil {
 				return nil, errors.WithStack(err)
 			}
 		}
-		identityKey, err := crypto.UnmarshalPrivateKey(private)
-		if err != nil {
-			return nil, errors.WithStack(err)
+		var publicStats *PublicStats
+		if config.HTTP.PublicStats.Enable {
+			publicStats = NewPublicStats(db, config.HTTP.PublicStats)
 		}
+		httpServer = &HTTPServer{
+			dbNoContext:         db,
+			bind:                config.HTTP.Bind,
+			enablePiece:         config.HTTP.EnablePiece,
+			enablePieceMetadata: config.HTTP.EnablePieceMetadata,
+			enableSubDAG:        config.HTTP.EnableSubDAG,
+			enableGateway:       config.HTTP.EnableGateway,
+			metadataCache:       NewPieceMetadataCache(config.HTTP.MetadataCacheTTL, config.HTTP.MetadataCacheCapacity),
+			blockCache:          s.blockCache,
+			verifyBlocks:        config.HTTP.VerifyBlocks,
+			prefetchConcurrency: config.HTTP.PrefetchConcurrency,
+			prefetchBufferSize:  config.HTTP.PrefetchBufferSize,
+			maxWarmPrefetch:     config.HTTP.MaxWarmPrefetch,
+			remoteCarMode:       config.HTTP.RemoteCarMode,
+			remoteCarLinkExpiry: config.HTTP.RemoteCarLinkExpiry,
+			requireToken:        config.HTTP.RequireToken,
+			pendingDealsToken:   config.HTTP.PendingDealsToken,
+			accessLogger:        accessLogger,
+			manifest:            manifest,
+			publicStats:         publicStats,
+			transport:           config.HTTP.Transport,
+		}
+		s.servers = append(s.servers, httpServer)
+	}
+
+	var bitswapServer *BitswapServer
+	if config.Bitswap.Enable {
 		if len(config.Bitswap.ListenMultiAddrs) == 0 {
 			config.Bitswap.ListenMultiAddrs = []string{"/ip4/0.0.0.0/tcp/0"}
 		}
@@ -104,15 +225,94 @@ func NewService(db *gorm.DB, config Config) (*Service, error) {
 			listenAddrs = append(listenAddrs, ma)
 		}
 
-		bitswapServer, err := NewBitswapServer(db, identityKey, listenAddrs...)
+		var err error
+		bitswapServer, err = NewBitswapServer(db, s.blockCache, identityKey, listenAddrs...)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
+		if len(config.Bitswap.Announce.URLs) > 0 {
+			announceAddrs, err := bitswapAnnounceAddrs(bitswapServer.host.Addrs(), config.Bitswap.Announce.Addrs)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			bitswapServer.announcer, err = NewBitswapAnnouncer(db, identityKey, announceAddrs, config.Bitswap.Announce)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
 		s.servers = append(s.servers, bitswapServer)
 	}
+
+	if config.IPNI.Enable {
+		var protocols []metadata.Protocol
+		var providerAddrs []multiaddr.Multiaddr
+		for _, addr := range config.IPNI.ProviderAddrs {
+			ma, err := multiaddr.NewMultiaddr(addr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid provider address %s", addr)
+			}
+			providerAddrs = append(providerAddrs, ma)
+		}
+		advertiseDefaults := len(providerAddrs) == 0
+		if bitswapServer != nil {
+			protocols = append(protocols, metadata.Bitswap{})
+			if advertiseDefaults {
+				addrs, err := bitswapAnnounceAddrs(bitswapServer.host.Addrs(), config.Bitswap.Announce.Addrs)
+				if err != nil {
+					return nil, errors.WithStack(err)
+				}
+				providerAddrs = append(providerAddrs, addrs...)
+			}
+		}
+		if config.HTTP.EnableGateway {
+			protocols = append(protocols, metadata.IpfsGatewayHttp{})
+			if advertiseDefaults {
+				// The gateway is served by the same HTTP server as the advertisements
+				for _, addr := range config.IPNI.PublisherAddrs {
+					ma, err := multiaddr.NewMultiaddr(addr)
+					if err != nil {
+						return nil, errors.Wrapf(err, "invalid publisher address %s", addr)
+					}
+					providerAddrs = append(providerAddrs, ma)
+				}
+			}
+		}
+		var err error
+		httpServer.indexPublisher, err = NewIndexPublisher(db, identityKey, protocols, providerAddrs, config.IPNI)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
 	return s, nil
 }
 
+// bitswapAnnounceAddrs returns the multiaddresses of the Bitswap server to announce: the configured ones, or the public
+// addresses the host listens on.
+func bitswapAnnounceAddrs(hostAddrs []multiaddr.Multiaddr, configured []string) ([]multiaddr.Multiaddr, error) {
+	var addrs []multiaddr.Multiaddr
+	for _, addr := range configured {
+		ma, err := multiaddr.NewMultiaddr(addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid announce address %s", addr)
+		}
+		addrs = append(addrs, ma)
+	}
+	if len(addrs) > 0 {
+		return addrs, nil
+	}
+	for _, addr := range hostAddrs {
+		if manet.IsPublicAddr(addr) {
+			addrs = append(addrs, addr)
+		}
+	}
+	if len(addrs) == 0 {
+		logger.Warn("the libp2p host has no public address, set the announce addresses so IPFS clients can reach it")
+		return hostAddrs, nil
+	}
+	return addrs, nil
+}
+
 func (s *Service) Start(ctx context.Context) error {
+	defer s.blockCache.Close()
 	return service.StartServers(ctx, logger, s.servers...)
 }
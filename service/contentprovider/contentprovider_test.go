@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/data-preservation-programs/singularity/retrievaltoken"
 	"github.com/data-preservation-programs/singularity/service"
 	"github.com/data-preservation-programs/singularity/util"
 	"github.com/data-preservation-programs/singularity/util/testutil"
@@ -78,3 +79,71 @@ func TestContentProviderStart_NoneEnabled(t *testing.T) {
 		require.ErrorIs(t, err, service.ErrNoService)
 	})
 }
+
+func TestContentProviderStart_ManifestWithoutPiece(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := NewService(db, Config{
+			HTTP: HTTPConfig{
+				EnablePieceMetadata: true,
+				Manifest: ManifestConfig{
+					Enable: true,
+				},
+			},
+		})
+		require.ErrorIs(t, err, ErrManifestWithoutPieceRetrieval)
+	})
+}
+
+func TestContentProviderStart_InvalidRemoteCarMode(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := NewService(db, Config{
+			HTTP: HTTPConfig{
+				EnablePiece:   true,
+				RemoteCarMode: "copy",
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidRemoteCarMode)
+	})
+}
+
+func TestContentProviderStart_InvalidCompression(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := NewService(db, Config{
+			HTTP: HTTPConfig{
+				EnablePiece: true,
+				Transport:   TransportConfig{Compression: []string{EncodingZstd, "br"}},
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidCompression)
+	})
+}
+
+func TestContentProvider_RetrievalTokenWithBitswap(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := NewService(db, Config{
+			HTTP: HTTPConfig{
+				EnablePiece:  true,
+				Bind:         ":0",
+				RequireToken: true,
+			},
+			Bitswap: BitswapConfig{
+				Enable: true,
+			},
+		})
+		require.ErrorIs(t, err, ErrBitswapWithRetrievalToken)
+	})
+}
+
+func TestContentProvider_RetrievalTokenWithoutSecret(t *testing.T) {
+	t.Setenv(retrievaltoken.SecretEnvVar, "")
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		_, err := NewService(db, Config{
+			HTTP: HTTPConfig{
+				EnablePiece:  true,
+				Bind:         ":0",
+				RequireToken: true,
+			},
+		})
+		require.ErrorIs(t, err, retrievaltoken.ErrNoSecret)
+	})
+}
@@ -0,0 +1,270 @@
+package contentprovider
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/store"
+	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/go-unixfsnode"
+	"github.com/ipld/go-ipld-prime/datamodel"
+	"github.com/ipld/go-ipld-prime/linking"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	RawContentType = "application/vnd.ipld.raw"
+
+	GatewayFormatRaw = "raw"
+	GatewayFormatCar = "car"
+)
+
+var errPathNotFound = errors.New("path not found")
+
+// gatewayFormat returns the response format requested by the format query parameter, or else by the Accept header,
+// as specified by the trustless gateway spec. An empty format means a deserialized UnixFS response.
+func gatewayFormat(c echo.Context) (string, error) {
+	switch f := c.QueryParam("format"); f {
+	case GatewayFormatRaw, GatewayFormatCar:
+		return f, nil
+	case "":
+	default:
+		return "", errors.Newf("invalid format %s, must be raw or car", f)
+	}
+	for _, accept := range strings.Split(c.Request().Header.Get(echo.HeaderAccept), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case RawContentType:
+			return GatewayFormatRaw, nil
+		case "application/vnd.ipld.car":
+			return GatewayFormatCar, nil
+		}
+	}
+	return "", nil
+}
+
+// loadUnixFSNode loads a block and interprets it as a UnixFS node, i.e. a directory or a file. Blocks that are not
+// UnixFS are returned as is.
+func loadUnixFSNode(ctx context.Context, lsys *linking.LinkSystem, c cid.Cid) (datamodel.Node, error) {
+	link := cidlink.Link{Cid: c}
+	linkCtx := linking.LinkContext{Ctx: ctx}
+	proto, err := protoChooser(link, linkCtx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	node, err := lsys.Load(linkCtx, link, proto)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load %s", c)
+	}
+	node, err = unixfsnode.Reify(linkCtx, node, lsys)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to interpret %s as UnixFS", c)
+	}
+	return node, nil
+}
+
+// resolveUnixFSPath resolves a UnixFS path under a root, returning the CID and the UnixFS node at the path.
+// It returns errPathNotFound if a segment of the path does not exist.
+func resolveUnixFSPath(ctx context.Context, lsys *linking.LinkSystem, root cid.Cid, p string) (cid.Cid, datamodel.Node, error) {
+	c := root
+	node, err := loadUnixFSNode(ctx, lsys, c)
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+	for _, segment := range strings.Split(p, "/") {
+		if segment == "" {
+			continue
+		}
+		child, err := node.LookupByString(segment)
+		if err != nil {
+			return cid.Undef, nil, errors.Wrapf(errPathNotFound, "%s under %s", segment, c)
+		}
+		link, err := child.AsLink()
+		if err != nil {
+			return cid.Undef, nil, errors.Wrapf(errPathNotFound, "%s under %s is not a link", segment, c)
+		}
+		c = link.(cidlink.Link).Cid
+		node, err = loadUnixFSNode(ctx, lsys, c)
+		if err != nil {
+			return cid.Undef, nil, err
+		}
+	}
+	return c, node, nil
+}
+
+// serveUnixFSFile serves the content of a UnixFS file, with its content type guessed from its name or its content,
+// and with support of range requests.
+func serveUnixFSFile(c echo.Context, name string, node datamodel.Node) error {
+	var content io.ReadSeeker
+	var err error
+	if large, ok := node.(datamodel.LargeBytesNode); ok {
+		content, err = large.AsLargeBytes()
+	} else {
+		var data []byte
+		data, err = node.AsBytes()
+		content = bytes.NewReader(data)
+	}
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to read file: "+err.Error())
+	}
+	http.ServeContent(c.Response(), c.Request(), name, time.Time{}, content)
+	return nil
+}
+
+type gatewayEntry struct {
+	Name string
+	CID  string
+	Href string
+}
+
+var directoryTemplate = template.Must(template.New("directory").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Path}}</title></head>
+<body>
+<h1>{{.Path}}</h1>
+<ul>
+{{- range .Entries}}
+<li><a href="{{.Href}}">{{.Name}}</a> <small>{{.CID}}</small></li>
+{{- end}}
+</ul>
+</body>
+</html>
+`))
+
+// handleGetGateway is a method on the HTTPServer struct that handles HTTP requests of a trustless IPFS gateway.
+//
+// The root CID and an optional UnixFS path are specified in the URL, i.e. /ipfs/{cid}/{path}. The response format is
+// selected with the format query parameter, or else with the Accept header:
+//   - raw (application/vnd.ipld.raw): the single block at the path.
+//   - car (application/vnd.ipld.car): the sub-DAG at the path as a CAR file, see handleGetSubDAG.
+//   - none: the deserialized UnixFS content at the path. Files are served with their content type guessed from their
+//     name, and with support of range requests. Directories are served as their index.html, or as an HTML listing.
+//
+// The blocks are looked up from the block index, and read from the data sources or the CAR files, so prepared data
+// can be retrieved with standard gateway tooling, i.e. curl, ipfs or Helia clients.
+//
+// Parameters:
+//   - c: The Echo context for the HTTP request.
+//
+// Returns:
+//   - An error if there was a problem handling the request.
+func (s *HTTPServer) handleGetGateway(c echo.Context) error {
+	f, err := gatewayFormat(c)
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+	if f == GatewayFormatCar {
+		return s.handleGetSubDAG(c)
+	}
+
+	root, err := cid.Parse(c.Param("cid"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed to parse root CID: "+err.Error())
+	}
+	p := c.Param("*")
+	if unescaped, err := url.PathUnescape(p); err == nil {
+		p = unescaped
+	}
+	ctx := c.Request().Context()
+	bs := &store.FileReferenceBlockStore{DBNoContext: s.dbNoContext, BlockCache: s.blockCache}
+	has, err := bs.Has(ctx, root)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to look up root: "+err.Error())
+	}
+	if !has {
+		return c.String(http.StatusNotFound, "root not found")
+	}
+
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.TrustedStorage = true
+	lsys.StorageReadOpener = func(lc linking.LinkContext, l datamodel.Link) (io.Reader, error) {
+		blk, err := bs.Get(lc.Ctx, l.(cidlink.Link).Cid)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(blk.RawData()), nil
+	}
+	unixfsnode.AddUnixFSReificationToLinkSystem(&lsys)
+
+	target, node, err := resolveUnixFSPath(ctx, &lsys, root, p)
+	if errors.Is(err, errPathNotFound) || format.IsNotFound(err) {
+		return c.String(http.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return c.String(http.StatusInternalServerError, err.Error())
+	}
+
+	header := c.Response().Header()
+	header.Set("X-Ipfs-Path", c.Request().URL.EscapedPath())
+	header.Set("X-Ipfs-Roots", root.String())
+	// The content of a CID never changes
+	header.Set(echo.HeaderCacheControl, "public, max-age=29030400, immutable")
+
+	if f == GatewayFormatRaw {
+		blk, err := bs.Get(ctx, target)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, "failed to get block: "+err.Error())
+		}
+		header.Set("Etag", `"`+target.String()+`.raw"`)
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set(echo.HeaderContentDisposition, `attachment; filename="`+target.String()+`.bin"`)
+		return c.Blob(http.StatusOK, RawContentType, blk.RawData())
+	}
+
+	header.Set("Etag", `"`+target.String()+`"`)
+	switch node.Kind() {
+	case datamodel.Kind_Bytes:
+		name := path.Base(p)
+		if p == "" || name == "/" || name == "." {
+			name = target.String()
+		}
+		return serveUnixFSFile(c, name, node)
+	case datamodel.Kind_Map:
+		if _, index, err := resolveUnixFSPath(ctx, &lsys, target, "index.html"); err == nil && index.Kind() == datamodel.Kind_Bytes {
+			return serveUnixFSFile(c, "index.html", index)
+		}
+		base := c.Request().URL.Path
+		if !strings.HasSuffix(base, "/") {
+			base += "/"
+		}
+		var entries []gatewayEntry
+		it := node.MapIterator()
+		for !it.Done() {
+			k, v, err := it.Next()
+			if err != nil {
+				return c.String(http.StatusInternalServerError, "failed to list directory: "+err.Error())
+			}
+			name, err := k.AsString()
+			if err != nil {
+				continue
+			}
+			entry := gatewayEntry{Name: name, Href: base + url.PathEscape(name)}
+			if link, err := v.AsLink(); err == nil {
+				entry.CID = link.String()
+			}
+			entries = append(entries, entry)
+		}
+		var body bytes.Buffer
+		err = directoryTemplate.Execute(&body, map[string]any{"Path": "/ipfs/" + path.Join(root.String(), p), "Entries": entries})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return c.HTMLBlob(http.StatusOK, body.Bytes())
+	default:
+		return c.String(http.StatusNotImplemented, "only UnixFS files and directories can be deserialized, use format=raw or format=car")
+	}
+}
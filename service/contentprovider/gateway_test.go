@@ -0,0 +1,146 @@
+package contentprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/ipld/merkledag"
+	"github.com/ipfs/boxo/ipld/unixfs"
+	format "github.com/ipfs/go-ipld-format"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestHTTPServer_handleGetGateway(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		// root/
+		// ├── site/
+		// │   └── index.html
+		// ├── a.txt (raw block)
+		// └── big.txt (file of two chunks)
+		a := merkledag.NewRawNode([]byte("hello"))
+		chunk1 := merkledag.NewRawNode([]byte("hello "))
+		chunk2 := merkledag.NewRawNode([]byte("world"))
+		fsNode := unixfs.NewFSNode(unixfs.TFile)
+		fsNode.AddBlockSize(6)
+		fsNode.AddBlockSize(5)
+		data, err := fsNode.GetBytes()
+		require.NoError(t, err)
+		big := merkledag.NodeWithData(data)
+		require.NoError(t, big.AddNodeLink("", chunk1))
+		require.NoError(t, big.AddNodeLink("", chunk2))
+		index := merkledag.NewRawNode([]byte("<html>welcome</html>"))
+		site := merkledag.NodeWithData(unixfs.FolderPBData())
+		require.NoError(t, site.AddNodeLink("index.html", index))
+		root := merkledag.NodeWithData(unixfs.FolderPBData())
+		require.NoError(t, root.AddNodeLink("site", site))
+		require.NoError(t, root.AddNodeLink("a.txt", a))
+		require.NoError(t, root.AddNodeLink("big.txt", big))
+
+		carModel := model.Car{
+			PreparationID: 1,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{},
+				Storage:     &model.Storage{},
+			},
+		}
+		require.NoError(t, db.Create(&carModel).Error)
+		for _, node := range []format.Node{root, site, index, a, big, chunk1, chunk2} {
+			err := db.Create(&model.CarBlock{
+				CarID:          carModel.ID,
+				CID:            model.CID(node.Cid()),
+				CarBlockLength: int32(1 + node.Cid().ByteLen() + len(node.RawData())),
+				Varint:         []byte{0},
+				RawBlock:       node.RawData(),
+			}).Error
+			require.NoError(t, err)
+		}
+
+		s := HTTPServer{
+			dbNoContext:   db,
+			enableGateway: true,
+		}
+		get := func(path string, query url.Values, header http.Header) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/ipfs/"+root.Cid().String()+"/"+path+"?"+query.Encode(), nil)
+			req = req.WithContext(ctx)
+			for k, v := range header {
+				req.Header[k] = v
+			}
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetPath("/ipfs/:cid/*")
+			c.SetParamNames("cid", "*")
+			c.SetParamValues(root.Cid().String(), path)
+			err := s.handleGetGateway(c)
+			require.NoError(t, err)
+			return rec
+		}
+
+		t.Run("raw file", func(t *testing.T) {
+			rec := get("a.txt", nil, nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, "hello", rec.Body.String())
+			require.Contains(t, rec.Header().Get(echo.HeaderContentType), "text/plain")
+			require.Equal(t, `"`+a.Cid().String()+`"`, rec.Header().Get("Etag"))
+		})
+
+		t.Run("chunked file with range", func(t *testing.T) {
+			rec := get("big.txt", nil, nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, "hello world", rec.Body.String())
+			rec = get("big.txt", nil, http.Header{"Range": {"bytes=6-"}})
+			require.Equal(t, http.StatusPartialContent, rec.Code)
+			require.Equal(t, "world", rec.Body.String())
+		})
+
+		t.Run("directory index", func(t *testing.T) {
+			rec := get("site", nil, nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, "<html>welcome</html>", rec.Body.String())
+		})
+
+		t.Run("directory listing", func(t *testing.T) {
+			rec := get("", nil, nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Contains(t, rec.Header().Get(echo.HeaderContentType), echo.MIMETextHTML)
+			require.Contains(t, rec.Body.String(), `/big.txt">big.txt</a>`)
+			require.Contains(t, rec.Body.String(), a.Cid().String())
+		})
+
+		t.Run("raw block", func(t *testing.T) {
+			rec := get("big.txt", url.Values{"format": {"raw"}}, nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, RawContentType, rec.Header().Get(echo.HeaderContentType))
+			require.Equal(t, big.RawData(), rec.Body.Bytes())
+
+			rec = get("a.txt", nil, http.Header{echo.HeaderAccept: {RawContentType}})
+			require.Equal(t, RawContentType, rec.Header().Get(echo.HeaderContentType))
+			require.Equal(t, a.RawData(), rec.Body.Bytes())
+		})
+
+		t.Run("car", func(t *testing.T) {
+			rec := get("a.txt", url.Values{"format": {"car"}}, nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, CarContentType, rec.Header().Get(echo.HeaderContentType))
+
+			rec = get("a.txt", nil, http.Header{echo.HeaderAccept: {"application/vnd.ipld.car; version=1"}})
+			require.Equal(t, CarContentType, rec.Header().Get(echo.HeaderContentType))
+		})
+
+		t.Run("invalid format", func(t *testing.T) {
+			rec := get("", url.Values{"format": {"tar"}}, nil)
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+
+		t.Run("path not found", func(t *testing.T) {
+			rec := get("missing.txt", nil, nil)
+			require.Equal(t, http.StatusNotFound, rec.Code)
+		})
+	})
+}
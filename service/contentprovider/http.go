@@ -1,9 +1,14 @@
 package contentprovider
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"os"
 	"time"
@@ -11,13 +16,16 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/errors/oserror"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/compression"
 	"github.com/data-preservation-programs/singularity/storagesystem"
 	"github.com/data-preservation-programs/singularity/store"
 	"github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/version"
 	"github.com/fxamacker/cbor/v2"
 	"github.com/ipfs/go-cid"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/net/http2"
 	"gorm.io/gorm"
 )
 
@@ -26,16 +34,49 @@ type HTTPServer struct {
 	bind                string
 	enablePiece         bool
 	enablePieceMetadata bool
+	enableSubDAG        bool
+	enableGateway       bool
+	metadataCache       *PieceMetadataCache
+	blockCache          *store.BlockCache
+	verifyBlocks        bool
+	prefetchConcurrency int
+	prefetchBufferSize  int64
+	maxWarmPrefetch     int64
+	remoteCarMode       string
+	remoteCarLinkExpiry time.Duration
+	requireToken        bool
+	pendingDealsToken   string
+	accessLogger        *AccessLogger
+	manifest            *PieceManifest
+	publicStats         *PublicStats
+	indexPublisher      *IndexPublisher
+	transport           TransportConfig
 }
 
 func (*HTTPServer) Name() string {
 	return "HTTPServer"
 }
 
+// skipCompression skips the compression of piece and block content and of range requests, so only the metadata
+// responses are compressed. The content hardly compresses and is better sent at the full speed of the link, and the
+// byte ranges of a compressed response would not match the byte ranges of the piece, so a client resuming an
+// interrupted download with a Range header would get corrupted content.
+func skipCompression(c echo.Context) bool {
+	switch c.Path() {
+	case "/piece/:id", "/ipfs/:cid", "/ipfs/:cid/*":
+		return true
+	}
+	return c.Request().Header.Get("Range") != ""
+}
+
 // Start is a method on the HTTPServer struct that starts the HTTP server.
 //
-// It sets up the Echo framework with various middleware for gzip compression, request logging, and panic recovery.
-// It also sets up routes for getting piece metadata and the piece itself.
+// It sets up the Echo framework with various middleware for access logging, compression of the metadata responses,
+// request logging, and panic recovery. The server listens with the TCP settings of the transport config, and also
+// serves HTTP/2 over cleartext if enabled.
+// It also sets up routes for getting piece metadata, the piece itself, the deals pending import by a storage provider
+// if a pending deals token is configured, sub-DAGs by path or selector, the trustless gateway that also serves raw
+// blocks and UnixFS files, and the IPNI advertisements of the pieces.
 //
 // The server runs in its own goroutine until the provided context is cancelled. When the context is cancelled,
 // the server is shut down gracefully.
@@ -52,7 +93,12 @@ func (*HTTPServer) Name() string {
 //   - An error if the server fails to start.
 func (s *HTTPServer) Start(ctx context.Context, exitErr chan<- error) error {
 	e := echo.New()
-	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{}))
+	if s.accessLogger != nil {
+		e.Use(s.accessLogMiddleware)
+	}
+	if len(s.transport.Compression) > 0 {
+		e.Use(compressMiddleware(s.transport.Compression, skipCompression))
+	}
 	e.Use(
 		middleware.RequestLoggerWithConfig(
 			middleware.RequestLoggerConfig{
@@ -92,23 +138,69 @@ func (s *HTTPServer) Start(ctx context.Context, exitErr chan<- error) error {
 			return nil
 		},
 	}))
+	e.Use(version.Middleware)
+	// The content of the pieces is only served to the holders of a retrieval token if required
+	var retrieval []echo.MiddlewareFunc
+	if s.requireToken {
+		retrieval = append(retrieval, s.retrievalTokenMiddleware)
+	}
 	if s.enablePieceMetadata {
-		e.GET("/piece/metadata/:id", s.getMetadataHandler)
-		e.HEAD("/piece/metadata/:id", s.getMetadataHandler)
+		e.GET("/piece/metadata/:id", s.getMetadataHandler, retrieval...)
+		e.HEAD("/piece/metadata/:id", s.getMetadataHandler, retrieval...)
 	}
 	if s.enablePiece {
-		e.GET("/piece/:id", s.handleGetPiece)
-		e.HEAD("/piece/:id", s.handleGetPiece)
+		e.GET("/piece/:id", s.handleGetPiece, retrieval...)
+		e.HEAD("/piece/:id", s.handleGetPiece, retrieval...)
+		e.POST("/piece/warm", s.handleWarm, retrieval...)
+	}
+	if s.enablePiece && s.pendingDealsToken != "" {
+		e.GET("/deal/pending/:provider", s.handleGetPendingDeals, s.pendingDealsTokenMiddleware)
+	}
+	if s.enableGateway {
+		e.GET("/ipfs/:cid", s.handleGetGateway, retrieval...)
+		e.HEAD("/ipfs/:cid", s.handleGetGateway, retrieval...)
+		e.GET("/ipfs/:cid/*", s.handleGetGateway, retrieval...)
+		e.HEAD("/ipfs/:cid/*", s.handleGetGateway, retrieval...)
+	} else if s.enableSubDAG {
+		e.GET("/ipfs/:cid", s.handleGetSubDAG, retrieval...)
+		e.HEAD("/ipfs/:cid", s.handleGetSubDAG, retrieval...)
+		e.GET("/ipfs/:cid/*", s.handleGetSubDAG, retrieval...)
+		e.HEAD("/ipfs/:cid/*", s.handleGetSubDAG, retrieval...)
+	}
+	if s.manifest != nil {
+		e.GET(ManifestPath, s.handleGetManifest)
+		e.HEAD(ManifestPath, s.handleGetManifest)
+		s.manifest.Start(ctx)
+	}
+	if s.publicStats != nil {
+		e.GET(StatsPath, s.handleGetStats, s.publicStats.rateLimiter())
+	}
+	s.metadataCache.Start()
+	if s.indexPublisher != nil {
+		e.GET(IPNIPath+"/head", s.handleGetIPNIHead)
+		e.GET(IPNIPath+"/:cid", s.handleGetIPNIBlock)
+		s.indexPublisher.Start(ctx)
 	}
 	e.GET("/health", func(c echo.Context) error {
 		return c.String(http.StatusOK, "ok")
 	})
 
+	listener, err := s.transport.listen(ctx, s.bind)
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on %s", s.bind)
+	}
+	e.Listener = listener
+
 	forceShutdown := make(chan struct{})
 	shutdownErr := make(chan error, 1)
 
 	go func() {
-		err := e.Start(s.bind)
+		var err error
+		if s.transport.EnableHTTP2 {
+			err = e.StartH2CServer(s.bind, &http2.Server{})
+		} else {
+			err = e.Start(s.bind)
+		}
 		if errors.Is(err, http.ErrServerClosed) {
 			err = nil
 		}
@@ -128,7 +220,15 @@ func (s *HTTPServer) Start(ctx context.Context, exitErr chan<- error) error {
 		case <-forceShutdown:
 		}
 		//nolint:contextcheck
-		shutdownErr <- e.Shutdown(context.Background())
+		err := e.Shutdown(context.Background())
+		s.metadataCache.Stop()
+		if s.accessLogger != nil {
+			closeErr := s.accessLogger.Close()
+			if err == nil {
+				err = closeErr
+			}
+		}
+		shutdownErr <- err
 	}()
 
 	return nil
@@ -148,6 +248,23 @@ func getPieceMetadata(ctx context.Context, db *gorm.DB, car model.Car) (*PieceMe
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	// Raw blocks offloaded to a blob storage are only read when the piece is, but the blob storages must exist.
+	blobStorageIDs := make(map[model.StorageID]struct{})
+	for _, carBlock := range carBlocks {
+		if carBlock.BlobStorageID != nil {
+			blobStorageIDs[*carBlock.BlobStorageID] = struct{}{}
+		}
+	}
+	for storageID := range blobStorageIDs {
+		var count int64
+		err = db.Model(&model.Storage{}).Where("id = ?", storageID).Count(&count).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if count == 0 {
+			return nil, errors.Newf("blob storage %d of the raw blocks not found", storageID)
+		}
+	}
 	var files []model.File
 	err = db.Where("id IN (?)", db.Model(&model.CarBlock{}).Select("file_id").Where("car_id = ?", car.ID)).Find(&files).Error
 	if err != nil {
@@ -172,7 +289,8 @@ func getPieceMetadata(ctx context.Context, db *gorm.DB, car model.Car) (*PieceMe
 //
 // Finally, it removes any sensitive information from the metadata and returns it in the response.
 // The format of the response depends on the "Accept" header of the request: if it's "application/cbor", the metadata is encoded as CBOR;
-// otherwise, it's encoded as JSON.
+// otherwise, it's encoded as JSON. The response has an ETag and a Last-Modified header, so conditional and HEAD requests
+// are answered without the metadata.
 //
 // Parameters:
 //   - c: The Echo context for the HTTP request.
@@ -202,6 +320,11 @@ func GetMetadataHandler(c echo.Context, db *gorm.DB) error {
 	if err != nil {
 		return c.String(http.StatusInternalServerError, fmt.Sprintf("Error: %s", err.Error()))
 	}
+	// Raw blocks offloaded to a blob storage are loaded here, so that the download utility does not need access to the blob storage.
+	err = store.LoadBlobs(ctx, db, metadata.CarBlocks)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("Error: failed to load raw blocks from blob storage: %s", err.Error()))
+	}
 
 	// Remove all credentials
 	for k := range metadata.Storage.Config {
@@ -210,18 +333,51 @@ func GetMetadataHandler(c echo.Context, db *gorm.DB) error {
 		}
 	}
 
+	// The metadata also changes when the storage is updated, i.e. its config.
+	lastModified := car.CreatedAt
+	if metadata.Storage.UpdatedAt.After(lastModified) {
+		lastModified = metadata.Storage.UpdatedAt
+	}
+	c.Response().Header().Set("Vary", "Accept")
+
 	acceptHeader := c.Request().Header.Get("Accept")
 	switch acceptHeader {
 	case "application/cbor":
-		c.Response().WriteHeader(http.StatusOK)
-		c.Response().Header().Set(echo.HeaderContentType, "application/cbor")
-		encoder := cbor.NewEncoder(c.Response().Writer)
-		return encoder.Encode(metadata)
+		var buf bytes.Buffer
+		err = cbor.NewEncoder(&buf).Encode(metadata)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("Error: %s", err.Error()))
+		}
+		return serveConditional(c, "application/cbor", lastModified, buf.Bytes())
 	default:
-		return c.JSON(http.StatusOK, metadata)
+		body, err := json.Marshal(metadata)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("Error: %s", err.Error()))
+		}
+		return serveConditional(c, echo.MIMEApplicationJSON, lastModified, body)
 	}
 }
 
+// serveConditional serves a response body with an ETag derived from its content and a Last-Modified time. Requests
+// with If-None-Match or If-Modified-Since are answered with 304 Not Modified if the body has not changed, and HEAD
+// requests with the headers only, so that proxies can cache the response and monitoring can cheaply check it.
+//
+// Parameters:
+//   - c: The Echo context for the HTTP request.
+//   - contentType: The content type of the body.
+//   - lastModified: The time the body last changed, or the zero time if it is unknown.
+//   - body: The response body.
+//
+// Returns:
+//   - An error if there was a problem handling the request.
+func serveConditional(c echo.Context, contentType string, lastModified time.Time, body []byte) error {
+	sum := sha256.Sum256(body)
+	c.Response().Header().Set("Etag", "\""+hex.EncodeToString(sum[:16])+"\"")
+	c.Response().Header().Set(echo.HeaderContentType, contentType)
+	http.ServeContent(c.Response(), c.Request(), "", lastModified, bytes.NewReader(body))
+	return nil
+}
+
 func (s *HTTPServer) getMetadataHandler(c echo.Context) error {
 	return GetMetadataHandler(c, s.dbNoContext.WithContext(c.Request().Context()))
 }
@@ -243,9 +399,11 @@ type PieceMetadata struct {
 // Then, it tries to open each car's file. If it can't open a file or the file size doesn't match the car's file size,
 // it records the error and continues with the next car.
 //
-// If it successfully opens a file, it returns the file, its modification time, and nil error.
+// If it successfully opens a file, it returns the file, its modification time, and nil error. A compressed CAR file is
+// decompressed as it is read, so the piece is served as the uncompressed CAR file its piece CID is calculated over.
 //
-// If it can't open any of the files, it tries to create a piece reader for each car. If it can't create a reader,
+// If it can't open any of the files, it tries to create a piece reader for each car, using the block index from the
+// piece metadata cache when available. If it can't create a reader,
 // it records the error and continues with the next car.
 //
 // If it successfully creates a reader, it returns the reader, the car's creation time, and nil error.
@@ -293,7 +451,13 @@ func (s *HTTPServer) findPiece(ctx context.Context, pieceCid cid.Cid) (
 				errs = append(errs, errors.Wrapf(err, "failed to open storage path %s", car.StoragePath))
 				continue
 			}
-			return seeker, obj.ModTime(ctx), nil
+			reader, err := compression.NewReadSeeker(seeker, car.Compression, car.FileSize)
+			if err != nil {
+				seeker.Close()
+				errs = append(errs, errors.Wrapf(err, "failed to decompress %s", car.StoragePath))
+				continue
+			}
+			return reader, obj.ModTime(ctx), nil
 		}
 
 		file, err := os.Open(car.StoragePath)
@@ -307,36 +471,44 @@ func (s *HTTPServer) findPiece(ctx context.Context, pieceCid cid.Cid) (
 			errs = append(errs, errors.Wrapf(err, "failed to stat file %s", car.StoragePath))
 			continue
 		}
-		if fileInfo.Size() != car.FileSize {
+		// The size of a compressed CAR file is unknown until it is decompressed.
+		if car.Compression == model.CarCompressionNone && fileInfo.Size() != car.FileSize {
 			file.Close()
 			errs = append(errs, errors.Wrapf(err, "CAR file size mismatch for %s. expected %d, actual %d.", car.StoragePath, car.FileSize, fileInfo.Size()))
 			continue
 		}
-		return file, fileInfo.ModTime(), nil
-	}
-
-	for _, car := range cars {
-		if car.AttachmentID == nil {
-			continue
-		}
-		metadata, err := getPieceMetadata(ctx, s.dbNoContext.WithContext(ctx), car)
+		reader, err := compression.NewReadSeeker(file, car.Compression, car.FileSize)
 		if err != nil {
-			errs = append(errs, errors.Wrap(err, "failed to get piece metadata"))
+			file.Close()
+			errs = append(errs, errors.Wrapf(err, "failed to decompress %s", car.StoragePath))
 			continue
 		}
-		reader, err := store.NewPieceReader(ctx, metadata.Car, metadata.Storage, metadata.CarBlocks, metadata.Files)
+		return reader, fileInfo.ModTime(), nil
+	}
+
+	metadata, err := s.metadataCache.Get(ctx, s.dbNoContext, pieceCid)
+	if err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to get piece metadata"))
+	}
+	blobs := store.NewBlobLoader(s.dbNoContext)
+	for _, m := range metadata {
+		reader, err := store.NewPieceReader(ctx, m.Car, m.Storage, m.CarBlocks, m.Files)
 		if err != nil {
 			errs = append(errs, errors.Wrap(err, "failed to create piece reader"))
 			continue
 		}
-		return reader, car.CreatedAt, nil
+		reader.UseBlobLoader(blobs)
+		reader.UseBlockCache(s.blockCache)
+		reader.VerifyBlocks(s.verifyBlocks)
+		reader.Prefetch(s.prefetchConcurrency, s.prefetchBufferSize)
+		return reader, m.Car.CreatedAt, nil
 	}
 
 	return nil, time.Time{}, &util.AggregateError{Errors: errs}
 }
 
 func SetCommonHeaders(c echo.Context, pieceCid string) {
-	c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", pieceCid+".car"))
+	c.Response().Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": pieceCid + ".car"}))
 	c.Response().Header().Set("Content-Type", "application/vnd.ipld.car; version=1")
 	c.Response().Header().Set("Accept-Ranges", "bytes")
 	c.Response().Header().Set("Etag", "\""+pieceCid+"\"")
@@ -351,6 +523,11 @@ func SetCommonHeaders(c echo.Context, pieceCid string) {
 //
 // If the piece is found, it sets common headers on the response and serves the piece content using http.ServeContent.
 // The name of the served content is the string representation of the piece CID with a ".car" extension.
+// http.ServeContent honors the Range and If-Range headers of RFC 7233 by seeking the piece reader, so interrupted
+// downloads are resumed with a Range request, and multiple ranges are served as multipart/byteranges.
+// If a remote CAR mode is configured, GET requests of pieces whose CAR file lives in S3 are redirected to, or streamed
+// from, a signed link of the CAR file instead. Requests with a retrieval token limited to a number of bytes are never
+// redirected.
 //
 // Parameters:
 //   - c: The Echo context for the HTTP request.
@@ -367,6 +544,19 @@ func (s *HTTPServer) handleGetPiece(c echo.Context) error {
 		return c.String(http.StatusBadRequest, "CID is not a commp")
 	}
 
+	// A redirected request does not go through the content provider, so the bytes served with a limited retrieval
+	// token could not be counted
+	uncounted := s.remoteCarMode == RemoteCarModeRedirect && limitedRetrievalToken(c)
+	if s.remoteCarMode != "" && s.remoteCarMode != RemoteCarModeOpen && !uncounted && c.Request().Method == http.MethodGet {
+		served, err := s.serveRemoteCar(c, pieceCid)
+		if served {
+			return err
+		}
+		if err != nil {
+			logger.Warnw("failed to serve piece from remote CAR, reading it from the storage instead", "piece", pieceCid, "err", err)
+		}
+	}
+
 	reader, lastModified, err := s.findPiece(c.Request().Context(), pieceCid)
 	if oserror.IsNotExist(err) {
 		return c.String(http.StatusNotFound, "piece not found")
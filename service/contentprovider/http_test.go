@@ -1,16 +1,21 @@
 package contentprovider
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/pack/compression"
 	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
 	"github.com/ipfs/boxo/util"
 	"github.com/ipfs/go-cid"
 	"github.com/labstack/echo/v4"
@@ -163,6 +168,7 @@ func TestHTTPServerHandler(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, http.StatusOK, rec.Code)
 			require.EqualValues(t, 101, rec.Body.Len())
+			require.Equal(t, "attachment; filename="+pieceCID.String()+".car", rec.Header().Get("Content-Disposition"))
 		}
 		t.Run("car file deleted, fail back to inline", testfunc)
 
@@ -175,3 +181,271 @@ func TestHTTPServerHandler(t *testing.T) {
 		t.Run("car file exists", testfunc)
 	})
 }
+
+func TestHTTPServerConditionalRequests(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		e := echo.New()
+		s := HTTPServer{
+			dbNoContext:         db,
+			enablePiece:         true,
+			enablePieceMetadata: true,
+		}
+
+		pieceCID := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("test")))
+		err := db.Create(&model.Car{
+			PieceCID:      model.CID(pieceCID),
+			PieceSize:     128,
+			FileSize:      59 + 1 + 36 + 5,
+			PreparationID: 1,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{},
+				Storage: &model.Storage{
+					Type: "local",
+				},
+			},
+			RootCID: model.CID(testutil.TestCid),
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.CarBlock{
+			CarID:          1,
+			CID:            model.CID(testutil.TestCid),
+			CarOffset:      59,
+			CarBlockLength: 1 + 36 + 5,
+			Varint:         varint.ToUvarint(36 + 5),
+			RawBlock:       []byte("hello"),
+		}).Error
+		require.NoError(t, err)
+
+		request := func(handler echo.HandlerFunc, method string, header http.Header) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, "/", nil)
+			for k, v := range header {
+				req.Header[k] = v
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(pieceCID.String())
+			require.NoError(t, handler(c))
+			return rec
+		}
+
+		for _, handler := range []struct {
+			name    string
+			handler echo.HandlerFunc
+		}{
+			{"metadata", s.getMetadataHandler},
+			{"piece", s.handleGetPiece},
+		} {
+			t.Run(handler.name, func(t *testing.T) {
+				rec := request(handler.handler, http.MethodGet, nil)
+				require.Equal(t, http.StatusOK, rec.Code)
+				etag := rec.Header().Get("Etag")
+				require.NotEmpty(t, etag)
+				lastModified := rec.Header().Get("Last-Modified")
+				require.NotEmpty(t, lastModified)
+				length := rec.Body.Len()
+
+				rec = request(handler.handler, http.MethodHead, nil)
+				require.Equal(t, http.StatusOK, rec.Code)
+				require.Equal(t, etag, rec.Header().Get("Etag"))
+				require.Equal(t, strconv.Itoa(length), rec.Header().Get("Content-Length"))
+
+				rec = request(handler.handler, http.MethodGet, http.Header{"If-None-Match": {etag}})
+				require.Equal(t, http.StatusNotModified, rec.Code)
+				require.Zero(t, rec.Body.Len())
+
+				rec = request(handler.handler, http.MethodGet, http.Header{"If-None-Match": {`"other"`}})
+				require.Equal(t, http.StatusOK, rec.Code)
+
+				rec = request(handler.handler, http.MethodGet, http.Header{"If-Modified-Since": {lastModified}})
+				require.Equal(t, http.StatusNotModified, rec.Code)
+
+				rec = request(handler.handler, http.MethodGet, http.Header{"Range": {"bytes=1-"}, "If-Range": {etag}})
+				require.Equal(t, http.StatusPartialContent, rec.Code)
+				require.Equal(t, length-1, rec.Body.Len())
+			})
+		}
+
+		t.Run("metadata formats have different etags", func(t *testing.T) {
+			json := request(s.getMetadataHandler, http.MethodGet, nil)
+			cbor := request(s.getMetadataHandler, http.MethodGet, http.Header{"Accept": {"application/cbor"}})
+			require.Equal(t, "application/cbor", cbor.Header().Get(echo.HeaderContentType))
+			require.Equal(t, "Accept", cbor.Header().Get("Vary"))
+			require.NotEqual(t, json.Header().Get("Etag"), cbor.Header().Get("Etag"))
+		})
+	})
+}
+
+func TestHTTPServerRangeRequests(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		tmp := t.TempDir()
+		content := []byte("12345678901234567890")
+		err := os.WriteFile(filepath.Join(tmp, "1.txt"), content, 0644)
+		require.NoError(t, err)
+
+		pieceCID := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("test")))
+		err = db.Create(&model.Car{
+			PieceCID:      model.CID(pieceCID),
+			PieceSize:     128,
+			FileSize:      59 + 57,
+			PreparationID: 1,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{},
+				Storage: &model.Storage{
+					Type: "local",
+					Path: tmp,
+				},
+			},
+			RootCID: model.CID(testutil.TestCid),
+		}).Error
+		require.NoError(t, err)
+		file := model.File{
+			Path:             "1.txt",
+			Size:             20,
+			LastModifiedNano: testutil.GetFileTimestamp(t, filepath.Join(tmp, "1.txt")),
+			AttachmentID:     1,
+		}
+		err = db.Create(&file).Error
+		require.NoError(t, err)
+		err = db.Create(&model.CarBlock{
+			CarID:          1,
+			CID:            model.CID(cid.NewCidV1(cid.Raw, util.Hash(content))),
+			CarOffset:      59,
+			CarBlockLength: 57,
+			Varint:         []byte{56},
+			FileID:         &file.ID,
+		}).Error
+		require.NoError(t, err)
+
+		s := HTTPServer{
+			dbNoContext: db,
+			enablePiece: true,
+		}
+		e := echo.New()
+		e.Use(compressMiddleware([]string{EncodingGzip}, skipCompression))
+		e.GET("/piece/:id", s.handleGetPiece)
+		request := func(header http.Header) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/piece/"+pieceCID.String(), nil)
+			req.Header.Set("Accept-Encoding", "gzip")
+			for k, v := range header {
+				req.Header[k] = v
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			return rec
+		}
+
+		rec := request(nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Empty(t, rec.Header().Get("Content-Encoding"))
+		piece := rec.Body.Bytes()
+		require.Len(t, piece, 116)
+		require.Equal(t, content, piece[96:])
+
+		for _, test := range []struct {
+			rangeHeader string
+			expected    []byte
+		}{
+			{"bytes=0-9", piece[:10]},
+			{"bytes=100-", piece[100:]},
+			{"bytes=-10", piece[106:]},
+			{"bytes=58-99", piece[58:100]},
+		} {
+			rec = request(http.Header{"Range": {test.rangeHeader}})
+			require.Equal(t, http.StatusPartialContent, rec.Code, test.rangeHeader)
+			require.Empty(t, rec.Header().Get("Content-Encoding"))
+			require.Equal(t, test.expected, rec.Body.Bytes(), test.rangeHeader)
+		}
+
+		rec = request(http.Header{"Range": {"bytes=0-1,100-101"}})
+		require.Equal(t, http.StatusPartialContent, rec.Code)
+		require.Contains(t, rec.Header().Get("Content-Type"), "multipart/byteranges")
+		require.Contains(t, rec.Body.String(), string(piece[100:102]))
+
+		rec = request(http.Header{"Range": {"bytes=200-"}})
+		require.Equal(t, http.StatusRequestedRangeNotSatisfiable, rec.Code)
+
+		// A stale If-Range serves the whole piece
+		rec = request(http.Header{"Range": {"bytes=100-"}, "If-Range": {`"other"`}})
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, piece, rec.Body.Bytes())
+	})
+}
+
+func TestHTTPServerCompressedCar(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		tmp := t.TempDir()
+		content := bytes.Repeat([]byte("singularity"), 10_000)
+		reader, err := compression.Compress(bytes.NewReader(content), model.CarCompressionZstd)
+		require.NoError(t, err)
+		compressed, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		err = os.WriteFile(filepath.Join(tmp, "piece.car.zst"), compressed, 0644)
+		require.NoError(t, err)
+
+		storagePieceCID := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("storage")))
+		err = db.Create(&model.Car{
+			PieceCID:      model.CID(storagePieceCID),
+			PieceSize:     1 << 17,
+			FileSize:      int64(len(content)),
+			StoragePath:   "piece.car.zst",
+			Compression:   model.CarCompressionZstd,
+			PreparationID: 1,
+			Storage: &model.Storage{
+				Name: "out",
+				Type: "local",
+				Path: tmp,
+			},
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{},
+				Storage: &model.Storage{
+					Name: "source",
+					Type: "local",
+				},
+			},
+		}).Error
+		require.NoError(t, err)
+		localPieceCID := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("local")))
+		err = db.Create(&model.Car{
+			PieceCID:      model.CID(localPieceCID),
+			PieceSize:     1 << 17,
+			FileSize:      int64(len(content)),
+			StoragePath:   filepath.Join(tmp, "piece.car.zst"),
+			Compression:   model.CarCompressionZstd,
+			PreparationID: 1,
+			AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+		}).Error
+		require.NoError(t, err)
+
+		s := HTTPServer{
+			dbNoContext: db,
+			enablePiece: true,
+		}
+		e := echo.New()
+		e.GET("/piece/:id", s.handleGetPiece)
+		for _, pieceCID := range []cid.Cid{storagePieceCID, localPieceCID} {
+			request := func(header http.Header) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodGet, "/piece/"+pieceCID.String(), nil)
+				for k, v := range header {
+					req.Header[k] = v
+				}
+				rec := httptest.NewRecorder()
+				e.ServeHTTP(rec, req)
+				return rec
+			}
+
+			rec := request(nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, strconv.Itoa(len(content)), rec.Header().Get(echo.HeaderContentLength))
+			require.Equal(t, content, rec.Body.Bytes())
+
+			rec = request(http.Header{"Range": {"bytes=50000-50099"}})
+			require.Equal(t, http.StatusPartialContent, rec.Code)
+			require.Equal(t, content[50000:50100], rec.Body.Bytes())
+
+			rec = request(http.Header{"Range": {"bytes=-10"}})
+			require.Equal(t, http.StatusPartialContent, rec.Code)
+			require.Equal(t, content[len(content)-10:], rec.Body.Bytes())
+		}
+	})
+}
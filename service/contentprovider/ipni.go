@@ -0,0 +1,490 @@
+package contentprovider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/bindnode"
+	"github.com/ipni/go-libipni/announce"
+	"github.com/ipni/go-libipni/announce/httpsender"
+	"github.com/ipni/go-libipni/announce/message"
+	"github.com/ipni/go-libipni/dagsync/httpsync"
+	"github.com/ipni/go-libipni/ingest/schema"
+	"github.com/ipni/go-libipni/metadata"
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/multiformats/go-multihash"
+	"gorm.io/gorm"
+)
+
+const (
+	// IPNIPath is the path the advertisements are published at, as specified by the IPNI HTTP publisher spec.
+	IPNIPath                    = "/ipni/v1/ad"
+	DefaultIPNIAnnounceURL      = "https://cid.contact"
+	DefaultIPNIEntriesChunkSize = 16384
+	DefaultIPNIAnnounceInterval = time.Hour
+	// ipniCheckInterval is how often the pieces added or removed since the last check are advertised.
+	ipniCheckInterval = 10 * time.Minute
+)
+
+var (
+	ErrIPNIWithoutPublisherAddr = errors.New("IPNI announcement requires the HTTP addresses the advertisements are published at")
+	ErrIPNIWithoutRetrieval     = errors.New("IPNI announcement requires Bitswap retrieval or the HTTP gateway to be enabled")
+)
+
+// IPNIConfig configures the advertisement of the prepared pieces to the InterPlanetary Network Indexer.
+type IPNIConfig struct {
+	Enable         bool
+	AnnounceURLs   []string      // Indexers to announce new advertisements to, i.e. https://cid.contact
+	PublisherAddrs []string      // HTTP multiaddresses of the content provider the indexers fetch the advertisements from, i.e. /dns/cp.example.com/tcp/443/https
+	ProviderAddrs  []string      // Multiaddresses the content is retrieved from. The Bitswap and gateway addresses are advertised if empty.
+	ChunkSize      int           // Number of multihashes in each entry chunk
+	Interval       time.Duration // How often the latest advertisement is announced again
+}
+
+// signedHead is the head advertisement signed with the identity key, as served at IPNIPath/head.
+type signedHead struct {
+	Head   cidlink.Link
+	Sig    []byte
+	Pubkey []byte
+}
+
+// IndexPublisher publishes an advertisement to the InterPlanetary Network Indexer (IPNI) for every prepared piece,
+// with the multihashes of the blocks of the piece as entries and the piece CID as context ID, so retrieval clients can
+// find the content provider by the CID of any block. When a piece is removed, an advertisement retracting it is
+// published. The advertisements form a chain signed with the libp2p identity key, which the indexers fetch from the
+// content provider over HTTP after they are announced.
+type IndexPublisher struct {
+	db             *gorm.DB
+	key            crypto.PrivKey
+	providerID     peer.ID
+	providerAddrs  []string
+	publisherAddrs []multiaddr.Multiaddr
+	metadata       []byte
+	senders        []announce.Sender
+	config         IPNIConfig
+	// mu serializes the publication of the advertisements, as each links to the previous one
+	mu sync.Mutex
+}
+
+// NewIndexPublisher creates an IndexPublisher that advertises the pieces as retrievable with the given protocols.
+//
+// Parameters:
+//   - db: The database to read the pieces and to store the advertisements in.
+//   - key: The libp2p identity key of the content provider, used to sign the advertisements.
+//   - protocols: The retrieval protocols to advertise, i.e. Bitswap or the HTTP gateway.
+//   - providerAddrs: The multiaddresses to retrieve the content from.
+//   - config: The IPNIConfig with the announce URLs, the publisher addresses and the chunk size.
+//
+// Returns:
+//   - A pointer to the IndexPublisher, and an error if an address or an announce URL is invalid.
+func NewIndexPublisher(
+	db *gorm.DB,
+	key crypto.PrivKey,
+	protocols []metadata.Protocol,
+	providerAddrs []multiaddr.Multiaddr,
+	config IPNIConfig,
+) (*IndexPublisher, error) {
+	if len(protocols) == 0 {
+		return nil, ErrIPNIWithoutRetrieval
+	}
+	if len(config.PublisherAddrs) == 0 {
+		return nil, ErrIPNIWithoutPublisherAddr
+	}
+	if config.ChunkSize <= 0 {
+		config.ChunkSize = DefaultIPNIEntriesChunkSize
+	}
+	if config.Interval <= 0 {
+		config.Interval = DefaultIPNIAnnounceInterval
+	}
+	providerID, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := metadata.Default.New(protocols...)
+	mdBytes, err := md.MarshalBinary()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	p := &IndexPublisher{
+		db:         db,
+		key:        key,
+		providerID: providerID,
+		metadata:   mdBytes,
+		config:     config,
+	}
+	for _, addr := range providerAddrs {
+		p.providerAddrs = append(p.providerAddrs, addr.String())
+	}
+	for _, addr := range config.PublisherAddrs {
+		ma, err := multiaddr.NewMultiaddr(addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid publisher address %s", addr)
+		}
+		p.publisherAddrs = append(p.publisherAddrs, ma)
+	}
+	if len(config.AnnounceURLs) > 0 {
+		var urls []*url.URL
+		for _, u := range config.AnnounceURLs {
+			parsed, err := url.Parse(u)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid announce URL %s", u)
+			}
+			urls = append(urls, parsed)
+		}
+		sender, err := httpsender.New(urls, providerID)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		p.senders = append(p.senders, sender)
+	}
+	return p, nil
+}
+
+// encodeIPNINode encodes a node of the advertisement chain as dag-json, and returns it with its CID.
+func encodeIPNINode(node ipld.Node) ([]byte, cid.Cid, error) {
+	var buf bytes.Buffer
+	err := dagjson.Encode(node, &buf)
+	if err != nil {
+		return nil, cid.Undef, errors.WithStack(err)
+	}
+	c, err := schema.Linkproto.Prefix.Sum(buf.Bytes())
+	if err != nil {
+		return nil, cid.Undef, errors.WithStack(err)
+	}
+	return buf.Bytes(), c, nil
+}
+
+// loadEntryChunk builds an entry chunk from the multihashes of the blocks of a CAR file, and returns it encoded with
+// its CID.
+func (p *IndexPublisher) loadEntryChunk(ctx context.Context, carID model.CarID, offset int, length int, next model.CID) ([]byte, cid.Cid, error) {
+	var cids []model.CID
+	err := p.db.WithContext(ctx).Model(&model.CarBlock{}).Where("car_id = ?", carID).
+		Order("id asc").Offset(offset).Limit(length).Pluck("cid", &cids).Error
+	if err != nil {
+		return nil, cid.Undef, errors.Wrapf(err, "failed to find the blocks of car %d", carID)
+	}
+	chunk := schema.EntryChunk{Entries: make([]multihash.Multihash, 0, len(cids))}
+	for _, c := range cids {
+		chunk.Entries = append(chunk.Entries, cid.Cid(c).Hash())
+	}
+	if cid.Cid(next) != cid.Undef {
+		chunk.Next = cidlink.Link{Cid: cid.Cid(next)}
+	}
+	node, err := chunk.ToNode()
+	if err != nil {
+		return nil, cid.Undef, errors.WithStack(err)
+	}
+	return encodeIPNINode(node)
+}
+
+// publish appends an advertisement of a piece to the chain, or a retraction of the piece if isRm is set. The entry
+// chunks are built from the last one, as each links to the next one. It returns false if the CAR file of the piece
+// has no blocks to advertise.
+func (p *IndexPublisher) publish(ctx context.Context, pieceCID model.CID, carID *model.CarID, isRm bool) (bool, error) {
+	db := p.db.WithContext(ctx)
+	entries := schema.NoEntries.Cid
+	var numEntries int64
+	var chunks []model.IndexEntryChunk
+	if !isRm {
+		err := db.Model(&model.CarBlock{}).Where("car_id = ?", *carID).Count(&numEntries).Error
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to count the blocks of car %d", *carID)
+		}
+		if numEntries == 0 {
+			return false, nil
+		}
+		next := model.CID(cid.Undef)
+		last := int((numEntries - 1) / int64(p.config.ChunkSize))
+		for i := last; i >= 0; i-- {
+			offset := i * p.config.ChunkSize
+			length := p.config.ChunkSize
+			if i == last {
+				length = int(numEntries) - offset
+			}
+			_, c, err := p.loadEntryChunk(ctx, *carID, offset, length, next)
+			if err != nil {
+				return false, err
+			}
+			chunks = append(chunks, model.IndexEntryChunk{
+				CID:     model.CID(c),
+				NextCID: next,
+				Offset:  offset,
+				Length:  length,
+			})
+			next = model.CID(c)
+		}
+		entries = cid.Cid(next)
+	}
+
+	var head model.IndexAdvertisement
+	err := db.Select("id", "cid").Order("id desc").Limit(1).Find(&head).Error
+	if err != nil {
+		return false, errors.Wrap(err, "failed to find the latest advertisement")
+	}
+	ad := schema.Advertisement{
+		Provider:  p.providerID.String(),
+		Addresses: p.providerAddrs,
+		Entries:   cidlink.Link{Cid: entries},
+		ContextID: cid.Cid(pieceCID).Bytes(),
+		Metadata:  p.metadata,
+		IsRm:      isRm,
+	}
+	if head.ID != 0 {
+		ad.PreviousID = cidlink.Link{Cid: cid.Cid(head.CID)}
+	}
+	err = ad.Sign(p.key)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to sign advertisement")
+	}
+	node, err := ad.ToNode()
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	block, adCID, err := encodeIPNINode(node)
+	if err != nil {
+		return false, err
+	}
+
+	row := model.IndexAdvertisement{
+		CID:        model.CID(adCID),
+		PieceCID:   pieceCID,
+		IsRm:       isRm,
+		EntriesCID: model.CID(entries),
+		NumEntries: numEntries,
+		Block:      block,
+		CarID:      carID,
+	}
+	err = db.Transaction(func(db *gorm.DB) error {
+		err := db.Create(&row).Error
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for i := range chunks {
+			chunks[i].AdvertisementID = row.ID
+		}
+		if len(chunks) > 0 {
+			return errors.WithStack(db.CreateInBatches(chunks, 1000).Error)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to save advertisement")
+	}
+	logger.Infow("published IPNI advertisement", "cid", adCID, "piece", pieceCID, "entries", numEntries, "isRm", isRm)
+	return true, nil
+}
+
+// Publish advertises the pieces that are not advertised yet, and retracts the advertised pieces that have been
+// removed.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//
+// Returns:
+//   - The number of advertisements published.
+//   - An error, if the pieces could not be read or an advertisement could not be published.
+func (p *IndexPublisher) Publish(ctx context.Context) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	db := p.db.WithContext(ctx)
+
+	// The latest advertisement of each piece tells whether the piece is advertised or retracted
+	var ads []model.IndexAdvertisement
+	err := db.Select("piece_cid", "is_rm").Order("id asc").Find(&ads).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find advertisements")
+	}
+	advertised := make(map[string]bool)
+	var pieces []model.CID
+	for _, ad := range ads {
+		key := ad.PieceCID.String()
+		if _, ok := advertised[key]; !ok {
+			pieces = append(pieces, ad.PieceCID)
+		}
+		advertised[key] = !ad.IsRm
+	}
+
+	var cars []model.Car
+	err = db.Select("id", "piece_cid").Where("piece_cid IS NOT NULL").Order("id asc").Find(&cars).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find pieces")
+	}
+	var count int
+	present := make(map[string]struct{})
+	for _, car := range cars {
+		key := car.PieceCID.String()
+		if key == "" {
+			continue
+		}
+		present[key] = struct{}{}
+		if advertised[key] {
+			continue
+		}
+		carID := car.ID
+		published, err := p.publish(ctx, car.PieceCID, &carID, false)
+		if err != nil {
+			return count, err
+		}
+		if published {
+			advertised[key] = true
+			count++
+		}
+	}
+
+	for _, pieceCID := range pieces {
+		key := pieceCID.String()
+		if _, ok := present[key]; ok || !advertised[key] {
+			continue
+		}
+		_, err = p.publish(ctx, pieceCID, nil, true)
+		if err != nil {
+			return count, err
+		}
+		advertised[key] = false
+		count++
+	}
+	return count, nil
+}
+
+// Announce announces the latest advertisement to the indexers, so they fetch the advertisements published since
+// they last synced.
+func (p *IndexPublisher) Announce(ctx context.Context) error {
+	var head model.IndexAdvertisement
+	err := p.db.WithContext(ctx).Select("id", "cid").Order("id desc").Limit(1).Find(&head).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to find the latest advertisement")
+	}
+	if head.ID == 0 {
+		return nil
+	}
+	msg := message.Message{Cid: cid.Cid(head.CID)}
+	msg.SetAddrs(p.publisherAddrs)
+	for _, sender := range p.senders {
+		err = sender.Send(ctx, msg)
+		if err != nil {
+			return errors.Wrapf(err, "failed to announce advertisement %s", head.CID)
+		}
+	}
+	return nil
+}
+
+// Start publishes the advertisements of the pieces added or removed since the last check immediately and then every
+// 10 minutes, and announces the latest advertisement when new ones are published, or else every interval, until the
+// context is done. Failed publications and announcements are retried on the next round.
+func (p *IndexPublisher) Start(ctx context.Context) {
+	go func() {
+		lastAnnounce := time.Time{}
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				for _, sender := range p.senders {
+					_ = sender.Close()
+				}
+				return
+			case <-timer.C:
+			}
+			count, err := p.Publish(ctx)
+			if err != nil && ctx.Err() == nil {
+				logger.Errorw("failed to publish IPNI advertisements", "error", err)
+			}
+			if count > 0 || time.Since(lastAnnounce) >= p.config.Interval {
+				err = p.Announce(ctx)
+				if err != nil && ctx.Err() == nil {
+					logger.Errorw("failed to announce IPNI advertisements", "error", err)
+				} else if err == nil {
+					lastAnnounce = time.Now()
+				}
+			}
+			timer.Reset(ipniCheckInterval)
+		}
+	}()
+}
+
+// handleGetIPNIHead is a method on the HTTPServer struct that serves the CID of the latest advertisement, signed with
+// the identity key of the content provider, for the indexers to sync the advertisement chain from.
+func (s *HTTPServer) handleGetIPNIHead(c echo.Context) error {
+	var head model.IndexAdvertisement
+	err := s.dbNoContext.WithContext(c.Request().Context()).Select("id", "cid").Order("id desc").Limit(1).Find(&head).Error
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to find the latest advertisement: "+err.Error())
+	}
+	if head.ID == 0 {
+		return c.NoContent(http.StatusNoContent)
+	}
+	key := s.indexPublisher.key
+	sig, err := key.Sign(cid.Cid(head.CID).Bytes())
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to sign head: "+err.Error())
+	}
+	pubkey, err := crypto.MarshalPublicKey(key.GetPublic())
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to marshal public key: "+err.Error())
+	}
+	node := bindnode.Wrap(&signedHead{
+		Head:   cidlink.Link{Cid: cid.Cid(head.CID)},
+		Sig:    sig,
+		Pubkey: pubkey,
+	}, httpsync.SignedHeadSchema())
+	var buf bytes.Buffer
+	err = dagjson.Encode(node.Representation(), &buf)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to encode head: "+err.Error())
+	}
+	return c.JSONBlob(http.StatusOK, buf.Bytes())
+}
+
+// handleGetIPNIBlock is a method on the HTTPServer struct that serves an advertisement or an entry chunk by its CID,
+// encoded as dag-json. The entry chunks are rebuilt from the blocks of the CAR file of the advertisement.
+func (s *HTTPServer) handleGetIPNIBlock(c echo.Context) error {
+	ctx := c.Request().Context()
+	db := s.dbNoContext.WithContext(ctx)
+	id, err := cid.Parse(c.Param("cid"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed to parse CID: "+err.Error())
+	}
+
+	var ads []model.IndexAdvertisement
+	err = db.Where("cid = ?", model.CID(id)).Limit(1).Find(&ads).Error
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to find advertisement: "+err.Error())
+	}
+	if len(ads) > 0 {
+		return c.JSONBlob(http.StatusOK, ads[0].Block)
+	}
+
+	var chunks []model.IndexEntryChunk
+	err = db.Preload("Advertisement", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "car_id")
+	}).Where("cid = ?", model.CID(id)).Limit(1).Find(&chunks).Error
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to find entry chunk: "+err.Error())
+	}
+	if len(chunks) == 0 || chunks[0].Advertisement == nil || chunks[0].Advertisement.CarID == nil {
+		return c.String(http.StatusNotFound, "cid not found")
+	}
+	chunk := chunks[0]
+	block, c2, err := s.indexPublisher.loadEntryChunk(ctx, *chunk.Advertisement.CarID, chunk.Offset, chunk.Length, chunk.NextCID)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, err.Error())
+	}
+	if !c2.Equals(id) {
+		// The blocks of the CAR file have changed, i.e. the piece has been removed
+		return c.String(http.StatusNotFound, "entry chunk is no longer available")
+	}
+	return c.JSONBlob(http.StatusOK, block)
+}
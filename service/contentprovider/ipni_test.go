@@ -0,0 +1,173 @@
+package contentprovider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	util2 "github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/bindnode"
+	"github.com/ipni/go-libipni/dagsync/httpsync"
+	"github.com/ipni/go-libipni/ingest/schema"
+	"github.com/ipni/go-libipni/metadata"
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestIndexPublisher(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		var announced atomic.Int32
+		indexer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/announce" {
+				announced.Add(1)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer indexer.Close()
+
+		private, _, _, err := util.GenerateNewPeer()
+		require.NoError(t, err)
+		key, err := crypto.UnmarshalPrivateKey(private)
+		require.NoError(t, err)
+		peerID, err := peer.IDFromPrivateKey(key)
+		require.NoError(t, err)
+		providerAddr := multiaddr.StringCast("/dns4/bitswap.example.com/tcp/4001")
+		config := IPNIConfig{
+			Enable:         true,
+			AnnounceURLs:   []string{indexer.URL},
+			PublisherAddrs: []string{"/dns4/cp.example.com/tcp/443/https"},
+			ChunkSize:      2,
+		}
+
+		_, err = NewIndexPublisher(db, key, nil, nil, config)
+		require.ErrorIs(t, err, ErrIPNIWithoutRetrieval)
+		_, err = NewIndexPublisher(db, key, []metadata.Protocol{metadata.Bitswap{}}, nil, IPNIConfig{Enable: true})
+		require.ErrorIs(t, err, ErrIPNIWithoutPublisherAddr)
+
+		publisher, err := NewIndexPublisher(db, key, []metadata.Protocol{metadata.Bitswap{}},
+			[]multiaddr.Multiaddr{providerAddr}, config)
+		require.NoError(t, err)
+		s := HTTPServer{dbNoContext: db, indexPublisher: publisher}
+		get := func(path string, handler echo.HandlerFunc, param string) []byte {
+			req := httptest.NewRequest(http.MethodGet, IPNIPath+"/"+path, nil).WithContext(ctx)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("cid")
+			c.SetParamValues(param)
+			require.NoError(t, handler(c))
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			return rec.Body.Bytes()
+		}
+		// getNode fetches a node of the advertisement chain and checks that its content matches its CID
+		getNode := func(c cid.Cid) []byte {
+			body := get(c.String(), s.handleGetIPNIBlock, c.String())
+			actual, err := schema.Linkproto.Prefix.Sum(body)
+			require.NoError(t, err)
+			require.Equal(t, c, actual)
+			return body
+		}
+		getHead := func() cid.Cid {
+			body := get("head", s.handleGetIPNIHead, "")
+			builder := bindnode.Prototype((*signedHead)(nil), httpsync.SignedHeadSchema()).Representation().NewBuilder()
+			require.NoError(t, dagjson.Decode(builder, bytes.NewReader(body)))
+			head := bindnode.Unwrap(builder.Build()).(*signedHead)
+			ok, err := key.GetPublic().Verify(head.Head.Cid.Bytes(), head.Sig)
+			require.NoError(t, err)
+			require.True(t, ok)
+			return head.Head.Cid
+		}
+		getAd := func(c cid.Cid) *schema.Advertisement {
+			builder := schema.AdvertisementPrototype.Representation().NewBuilder()
+			require.NoError(t, dagjson.Decode(builder, bytes.NewReader(getNode(c))))
+			ad, err := schema.UnwrapAdvertisement(builder.Build())
+			require.NoError(t, err)
+			signer, err := ad.VerifySignature()
+			require.NoError(t, err)
+			require.Equal(t, peerID, signer)
+			return ad
+		}
+
+		newCID := func(s string) model.CID {
+			return model.CID(cid.NewCidV1(cid.Raw, util2.Hash([]byte(s))))
+		}
+		preparation := model.Preparation{}
+		require.NoError(t, db.Create(&preparation).Error)
+		pieceCID := newCID("piece")
+		car := model.Car{PreparationID: preparation.ID, PieceCID: pieceCID}
+		require.NoError(t, db.Create(&car).Error)
+		// A car without blocks is not advertised
+		require.NoError(t, db.Create(&model.Car{PreparationID: preparation.ID, PieceCID: newCID("empty")}).Error)
+		var blocks []model.CarBlock
+		for _, b := range []string{"1", "2", "3", "4", "5"} {
+			blocks = append(blocks, model.CarBlock{CarID: car.ID, CID: newCID(b)})
+		}
+		require.NoError(t, db.Create(&blocks).Error)
+
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, IPNIPath+"/head", nil).WithContext(ctx), rec)
+		require.NoError(t, s.handleGetIPNIHead(c))
+		require.Equal(t, http.StatusNoContent, rec.Code)
+
+		count, err := publisher.Publish(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.NoError(t, publisher.Announce(ctx))
+		require.EqualValues(t, 1, announced.Load())
+
+		head := getHead()
+		ad := getAd(head)
+		require.Nil(t, ad.PreviousID)
+		require.False(t, ad.IsRm)
+		require.Equal(t, cid.Cid(pieceCID).Bytes(), ad.ContextID)
+		require.Equal(t, []string{providerAddr.String()}, ad.Addresses)
+		var entries []multihash.Multihash
+		next := ad.Entries
+		for next != nil {
+			builder := schema.EntryChunkPrototype.Representation().NewBuilder()
+			require.NoError(t, dagjson.Decode(builder, bytes.NewReader(getNode(next.(cidlink.Link).Cid))))
+			chunk, err := schema.UnwrapEntryChunk(builder.Build())
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(chunk.Entries), 2)
+			entries = append(entries, chunk.Entries...)
+			next = chunk.Next
+		}
+		require.Len(t, entries, 5)
+		for i, block := range blocks {
+			require.Equal(t, cid.Cid(block.CID).Hash(), entries[i])
+		}
+
+		// Nothing changed since
+		count, err = publisher.Publish(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+
+		// The removed piece is retracted
+		require.NoError(t, db.Delete(&car).Error)
+		count, err = publisher.Publish(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		retraction := getAd(getHead())
+		require.True(t, retraction.IsRm)
+		require.Equal(t, cid.Cid(pieceCID).Bytes(), retraction.ContextID)
+		require.Equal(t, schema.NoEntries, retraction.Entries)
+		require.Equal(t, head, retraction.PreviousID.(cidlink.Link).Cid)
+
+		count, err = publisher.Publish(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+	})
+}
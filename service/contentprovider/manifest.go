@@ -0,0 +1,296 @@
+package contentprovider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"gorm.io/gorm"
+)
+
+const (
+	ManifestPath                   = "/.well-known/singularity/pieces"
+	DefaultManifestPageSize        = 1000
+	DefaultManifestRefreshInterval = 10 * time.Minute
+)
+
+var (
+	ErrInvalidManifestSignature      = errors.New("invalid manifest signature")
+	ErrManifestWithoutPieceRetrieval = errors.New("piece manifest requires HTTP piece retrieval to be enabled")
+)
+
+type ManifestConfig struct {
+	Enable          bool
+	PublicURL       string
+	PageSize        int
+	RefreshInterval time.Duration
+}
+
+// ManifestPiece is a piece that can be retrieved from the content provider.
+type ManifestPiece struct {
+	PieceCID  string   `json:"pieceCid"`
+	PieceSize int64    `json:"pieceSize"`
+	FileSize  int64    `json:"fileSize"`
+	RootCID   string   `json:"rootCid"`
+	Endpoints []string `json:"endpoints"`
+}
+
+// ManifestPage is a page of the piece manifest. All pages of the same manifest have the same GeneratedAt,
+// so crawlers can detect that the manifest has been refreshed while they are paginating.
+type ManifestPage struct {
+	GeneratedAt time.Time       `json:"generatedAt"`
+	Page        int             `json:"page"`
+	TotalPages  int             `json:"totalPages"`
+	TotalPieces int             `json:"totalPieces"`
+	Next        string          `json:"next,omitempty"`
+	Pieces      []ManifestPiece `json:"pieces"`
+}
+
+// SignedManifestPage is the envelope served at the well-known URL. The payload is the JSON encoded ManifestPage,
+// signed with the libp2p identity key of the content provider.
+type SignedManifestPage struct {
+	Payload   json.RawMessage `json:"payload"`
+	Signer    string          `json:"signer"`    // Peer ID of the identity key
+	PublicKey []byte          `json:"publicKey"` // Protobuf encoded public key of the identity key
+	Signature []byte          `json:"signature"`
+}
+
+// Verify checks the signature of the page against the public key, and that the public key matches the signer.
+//
+// Returns:
+//   - A pointer to the decoded ManifestPage if the signature is valid.
+//   - An error if the signature is invalid or the page cannot be decoded.
+func (s SignedManifestPage) Verify() (*ManifestPage, error) {
+	err := util.VerifyPeerSignature(s.Signer, s.PublicKey, s.Payload, s.Signature)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidManifestSignature, err.Error())
+	}
+	var page ManifestPage
+	err = json.Unmarshal(s.Payload, &page)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &page, nil
+}
+
+// PieceManifest maintains the signed pages of the manifest of all pieces that can be retrieved from the content provider.
+// The pages are built from the database and refreshed periodically, so that serving a page does not query the database.
+type PieceManifest struct {
+	db          *gorm.DB
+	key         crypto.PrivKey
+	publicKey   []byte
+	signer      peer.ID
+	config      ManifestConfig
+	mu          sync.RWMutex
+	pages       [][]byte
+	generatedAt time.Time
+	digest      [sha256.Size]byte // Digest of the listed pieces, to keep the pages when the pieces have not changed
+}
+
+// NewPieceManifest creates a PieceManifest that signs the pages with the given key.
+//
+// Parameters:
+//   - db: The database to query the pieces from.
+//   - key: The libp2p identity key used to sign the pages.
+//   - config: The ManifestConfig with the public URL, the page size and the refresh interval.
+//
+// Returns:
+//   - A pointer to the PieceManifest, and an error if the public key cannot be derived from the key.
+func NewPieceManifest(db *gorm.DB, key crypto.PrivKey, config ManifestConfig) (*PieceManifest, error) {
+	if config.PageSize <= 0 {
+		config.PageSize = DefaultManifestPageSize
+	}
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = DefaultManifestRefreshInterval
+	}
+	config.PublicURL = strings.TrimSuffix(config.PublicURL, "/")
+	signer, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	publicKey, err := crypto.MarshalPublicKey(key.GetPublic())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &PieceManifest{
+		db:        db,
+		key:       key,
+		publicKey: publicKey,
+		signer:    signer,
+		config:    config,
+	}, nil
+}
+
+// pageURL returns the URL of a page. It is relative to the content provider if no public URL is configured.
+func (m *PieceManifest) pageURL(page int) string {
+	return m.config.PublicURL + ManifestPath + "?page=" + strconv.Itoa(page)
+}
+
+// Refresh rebuilds all pages of the manifest from the database. A piece is listed if at least one of its CAR files
+// can be served, i.e. the CAR file has been exported to an output storage or can be assembled from the source.
+// The pages are kept, including their GeneratedAt, if the listed pieces have not changed, so that they can still be
+// cached by crawlers and proxies.
+func (m *PieceManifest) Refresh(ctx context.Context) error {
+	var cars []model.Car
+	err := m.db.WithContext(ctx).Where("storage_path <> '' OR attachment_id IS NOT NULL").Order("id asc").Find(&cars).Error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	seen := make(map[string]struct{})
+	pieces := make([]ManifestPiece, 0, len(cars))
+	for _, car := range cars {
+		pieceCID := car.PieceCID.String()
+		if _, ok := seen[pieceCID]; ok {
+			continue
+		}
+		seen[pieceCID] = struct{}{}
+		pieces = append(pieces, ManifestPiece{
+			PieceCID:  pieceCID,
+			PieceSize: car.PieceSize,
+			FileSize:  car.FileSize,
+			RootCID:   car.RootCID.String(),
+			Endpoints: []string{m.config.PublicURL + "/piece/" + pieceCID},
+		})
+	}
+
+	encoded, err := json.Marshal(pieces)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	digest := sha256.Sum256(encoded)
+	m.mu.RLock()
+	unchanged := m.pages != nil && m.digest == digest
+	m.mu.RUnlock()
+	if unchanged {
+		logger.Debugw("piece manifest is unchanged", "pieces", len(pieces))
+		return nil
+	}
+
+	generatedAt := time.Now().UTC()
+	totalPages := (len(pieces) + m.config.PageSize - 1) / m.config.PageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+	pages := make([][]byte, 0, totalPages)
+	for i := 0; i < totalPages; i++ {
+		end := (i + 1) * m.config.PageSize
+		if end > len(pieces) {
+			end = len(pieces)
+		}
+		page := ManifestPage{
+			GeneratedAt: generatedAt,
+			Page:        i + 1,
+			TotalPages:  totalPages,
+			TotalPieces: len(pieces),
+			Pieces:      pieces[i*m.config.PageSize : end],
+		}
+		if i+1 < totalPages {
+			page.Next = m.pageURL(i + 2)
+		}
+		payload, err := json.Marshal(page)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		signature, err := m.key.Sign(payload)
+		if err != nil {
+			return errors.Wrap(err, "failed to sign manifest page")
+		}
+		signed, err := json.Marshal(SignedManifestPage{
+			Payload:   payload,
+			Signer:    m.signer.String(),
+			PublicKey: m.publicKey,
+			Signature: signature,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		pages = append(pages, signed)
+	}
+
+	m.mu.Lock()
+	m.pages = pages
+	m.generatedAt = generatedAt
+	m.digest = digest
+	m.mu.Unlock()
+	logger.Infow("refreshed piece manifest", "pieces", len(pieces), "pages", totalPages)
+	return nil
+}
+
+// Start refreshes the manifest immediately and then periodically until the context is done.
+func (m *PieceManifest) Start(ctx context.Context) {
+	go func() {
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+			err := m.Refresh(ctx)
+			if err != nil && ctx.Err() == nil {
+				logger.Errorw("failed to refresh piece manifest", "error", err)
+			}
+			timer.Reset(m.config.RefreshInterval)
+		}
+	}()
+}
+
+// page returns the signed page with the given 1-based number and the time the manifest was generated, or false if
+// the page does not exist.
+func (m *PieceManifest) page(n int) ([]byte, time.Time, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if n < 1 || n > len(m.pages) {
+		return nil, time.Time{}, false
+	}
+	return m.pages[n-1], m.generatedAt, true
+}
+
+// ready reports whether the manifest has been built at least once.
+func (m *PieceManifest) ready() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.pages != nil
+}
+
+// handleGetManifest is a method on the HTTPServer struct that serves a page of the signed piece manifest.
+// The page number is specified with the page query parameter and defaults to the first page. The page has an ETag
+// and a Last-Modified header set to the time the manifest was generated, so conditional and HEAD requests are
+// answered without the page.
+//
+// Parameters:
+//   - c: The Echo context for the HTTP request.
+//
+// Returns:
+//   - An error if there was a problem handling the request.
+func (s *HTTPServer) handleGetManifest(c echo.Context) error {
+	n := 1
+	if p := c.QueryParam("page"); p != "" {
+		var err error
+		n, err = strconv.Atoi(p)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "invalid page: "+p)
+		}
+	}
+	page, generatedAt, ok := s.manifest.page(n)
+	if !ok {
+		if s.manifest.ready() {
+			return c.String(http.StatusNotFound, "page not found")
+		}
+		return c.String(http.StatusServiceUnavailable, "manifest is not ready yet")
+	}
+	return serveConditional(c, echo.MIMEApplicationJSON, generatedAt, page)
+}
@@ -0,0 +1,137 @@
+package contentprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	util2 "github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func getManifestPage(t *testing.T, s *HTTPServer, query string) (int, *SignedManifestPage) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, ManifestPath+query, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := s.handleGetManifest(c)
+	require.NoError(t, err)
+	if rec.Code != http.StatusOK {
+		return rec.Code, nil
+	}
+	var signed SignedManifestPage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &signed))
+	return rec.Code, &signed
+}
+
+func manifestRequest(t *testing.T, s *HTTPServer, method string, header http.Header) *httptest.ResponseRecorder {
+	e := echo.New()
+	req := httptest.NewRequest(method, ManifestPath, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	require.NoError(t, s.handleGetManifest(e.NewContext(req, rec)))
+	return rec
+}
+
+func TestHTTPServerManifest(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		private, _, _, err := util.GenerateNewPeer()
+		require.NoError(t, err)
+		key, err := crypto.UnmarshalPrivateKey(private)
+		require.NoError(t, err)
+		manifest, err := NewPieceManifest(db, key, ManifestConfig{
+			Enable:    true,
+			PublicURL: "https://example.com/",
+			PageSize:  1,
+		})
+		require.NoError(t, err)
+		s := &HTTPServer{
+			dbNoContext: db,
+			enablePiece: true,
+			manifest:    manifest,
+		}
+
+		code, _ := getManifestPage(t, s, "")
+		require.Equal(t, http.StatusServiceUnavailable, code)
+
+		pieceCIDs := []cid.Cid{
+			cid.NewCidV1(cid.FilCommitmentUnsealed, util2.Hash([]byte("piece1"))),
+			cid.NewCidV1(cid.FilCommitmentUnsealed, util2.Hash([]byte("piece2"))),
+			cid.NewCidV1(cid.FilCommitmentUnsealed, util2.Hash([]byte("piece3"))),
+		}
+		err = db.Create(&model.Preparation{}).Error
+		require.NoError(t, err)
+		err = db.Create([]model.Car{
+			{PieceCID: model.CID(pieceCIDs[0]), PieceSize: 128, StoragePath: "piece1.car", PreparationID: 1},
+			{PieceCID: model.CID(pieceCIDs[0]), PieceSize: 128, StoragePath: "piece1_copy.car", PreparationID: 1},
+			{PieceCID: model.CID(pieceCIDs[1]), PieceSize: 256, StoragePath: "piece2.car", PreparationID: 1},
+			// Not retrievable
+			{PieceCID: model.CID(pieceCIDs[2]), PieceSize: 256, PreparationID: 1},
+		}).Error
+		require.NoError(t, err)
+		require.NoError(t, manifest.Refresh(ctx))
+
+		code, signed := getManifestPage(t, s, "")
+		require.Equal(t, http.StatusOK, code)
+		page, err := signed.Verify()
+		require.NoError(t, err)
+		require.Equal(t, 1, page.Page)
+		require.Equal(t, 2, page.TotalPages)
+		require.Equal(t, 2, page.TotalPieces)
+		require.Equal(t, "https://example.com"+ManifestPath+"?page=2", page.Next)
+		require.Len(t, page.Pieces, 1)
+		require.Equal(t, pieceCIDs[0].String(), page.Pieces[0].PieceCID)
+		require.Equal(t, []string{"https://example.com/piece/" + pieceCIDs[0].String()}, page.Pieces[0].Endpoints)
+
+		code, signed = getManifestPage(t, s, "?page=2")
+		require.Equal(t, http.StatusOK, code)
+		page, err = signed.Verify()
+		require.NoError(t, err)
+		require.Empty(t, page.Next)
+		require.Equal(t, pieceCIDs[1].String(), page.Pieces[0].PieceCID)
+
+		code, _ = getManifestPage(t, s, "?page=3")
+		require.Equal(t, http.StatusNotFound, code)
+		code, _ = getManifestPage(t, s, "?page=invalid")
+		require.Equal(t, http.StatusBadRequest, code)
+
+		// Conditional requests
+		rec := manifestRequest(t, s, http.MethodGet, nil)
+		etag := rec.Header().Get("Etag")
+		require.NotEmpty(t, etag)
+		require.NotEmpty(t, rec.Header().Get("Last-Modified"))
+		rec = manifestRequest(t, s, http.MethodGet, http.Header{"If-None-Match": {etag}})
+		require.Equal(t, http.StatusNotModified, rec.Code)
+		rec = manifestRequest(t, s, http.MethodHead, nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, etag, rec.Header().Get("Etag"))
+		require.NotEmpty(t, rec.Header().Get("Content-Length"))
+
+		// The pages are kept if the pieces have not changed
+		require.NoError(t, manifest.Refresh(ctx))
+		rec = manifestRequest(t, s, http.MethodGet, http.Header{"If-None-Match": {etag}})
+		require.Equal(t, http.StatusNotModified, rec.Code)
+		err = db.Create(&model.Car{PieceCID: model.CID(pieceCIDs[2]), PieceSize: 256, StoragePath: "piece3.car", PreparationID: 1}).Error
+		require.NoError(t, err)
+		require.NoError(t, manifest.Refresh(ctx))
+		rec = manifestRequest(t, s, http.MethodGet, http.Header{"If-None-Match": {etag}})
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NotEqual(t, etag, rec.Header().Get("Etag"))
+
+		// Tampered payload
+		signed.Payload = json.RawMessage(`{"page":1}`)
+		_, err = signed.Verify()
+		require.ErrorIs(t, err, ErrInvalidManifestSignature)
+	})
+}
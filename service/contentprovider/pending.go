@@ -0,0 +1,43 @@
+package contentprovider
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/handler/sp"
+	"github.com/labstack/echo/v4"
+)
+
+// pendingDealsTokenMiddleware only lets the requests with the pending deals token as bearer token list the pending
+// deals, since they reveal the deals of all clients with the storage providers.
+func (s *HTTPServer) pendingDealsTokenMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.pendingDealsToken)) != 1 {
+			return c.String(http.StatusUnauthorized, "a valid pending deals token is required")
+		}
+		return next(c)
+	}
+}
+
+// handleGetPendingDeals is a method on the HTTPServer struct that lists the deals proposed to a storage provider
+// that are not active yet, ordered by start epoch, so the storage provider knows which pieces to download and import.
+//
+// Parameters:
+//   - c: The Echo context for the HTTP request. The provider is taken from the path.
+//
+// Returns:
+//   - An error if there was a problem handling the request.
+func (s *HTTPServer) handleGetPendingDeals(c echo.Context) error {
+	pending, err := sp.Default.ListPendingDealsHandler(c.Request().Context(), s.dbNoContext, c.Param("provider"))
+	if errors.Is(err, handlererror.ErrInvalidParameter) {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to find pending deals: "+err.Error())
+	}
+	return c.JSON(http.StatusOK, pending)
+}
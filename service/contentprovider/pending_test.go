@@ -0,0 +1,56 @@
+package contentprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/sp"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestHTTPServerPendingDeals(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		s := HTTPServer{dbNoContext: db, bind: ":0", enablePiece: true, pendingDealsToken: "secret"}
+		require.NoError(t, db.Create(&model.Wallet{ID: "f01000", Address: "f1wallet"}).Error)
+		require.NoError(t, db.Create(&model.Deal{
+			ProposalID: "uuid1", PieceCID: model.CID(testutil.TestCid), PieceSize: 2048, Provider: "f01234",
+			ClientID: "f01000", State: model.DealProposed, StartEpoch: 100,
+		}).Error)
+
+		e := echo.New()
+		handler := s.pendingDealsTokenMiddleware(s.handleGetPendingDeals)
+		for _, token := range []string{"", "Bearer wrong"} {
+			req := httptest.NewRequest(http.MethodGet, "/deal/pending/f01234", nil)
+			if token != "" {
+				req.Header.Set(echo.HeaderAuthorization, token)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("provider")
+			c.SetParamValues("f01234")
+			require.NoError(t, handler(c))
+			require.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/deal/pending/f01234", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer secret")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("provider")
+		c.SetParamValues("f01234")
+		require.NoError(t, handler(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var pending []sp.PendingDeal
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &pending))
+		require.Len(t, pending, 1)
+		require.Equal(t, "uuid1", pending[0].DealUUID)
+	})
+}
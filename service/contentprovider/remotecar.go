@@ -0,0 +1,132 @@
+package contentprovider
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/ipfs/go-cid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// RemoteCarModeOpen serves the CAR files in a storage by reading them through the storage, like any other file.
+	RemoteCarModeOpen = "open"
+	// RemoteCarModeProxy streams the requested range of the CAR files in a storage from a signed link of the storage.
+	RemoteCarModeProxy = "proxy"
+	// RemoteCarModeRedirect redirects the client to a signed link of the CAR files in a storage.
+	RemoteCarModeRedirect = "redirect"
+
+	DefaultRemoteCarLinkExpiry = time.Hour
+)
+
+var (
+	ErrInvalidRemoteCarMode = errors.New("remote CAR mode must be one of 'open', 'proxy' or 'redirect'")
+	ErrUnexpectedStatus     = errors.New("unexpected status from remote CAR")
+)
+
+// signedCarLink returns a signed link of a CAR file of the piece that lives in a storage that supports signed links,
+// such as S3. It returns an empty link if no uncompressed CAR file of the piece lives in such a storage.
+func (s *HTTPServer) signedCarLink(c echo.Context, pieceCid cid.Cid) (string, error) {
+	ctx := c.Request().Context()
+	var cars []model.Car
+	err := s.dbNoContext.WithContext(ctx).Preload("Storage").
+		Where("piece_cid = ? AND storage_id IS NOT NULL AND storage_path != ''", model.CID(pieceCid)).
+		Find(&cars).Error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	var errs []error
+	for _, car := range cars {
+		// A compressed CAR file must be decompressed before it is served, so it cannot be downloaded from the link.
+		if car.Storage == nil || car.Compression != model.CarCompressionNone {
+			continue
+		}
+		handler, err := storagesystem.NewRCloneHandler(ctx, *car.Storage)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to create rclone handler with storage %d", car.Storage.ID))
+			continue
+		}
+		link, err := handler.SignedLink(ctx, car.StoragePath, s.remoteCarLinkExpiry)
+		if errors.Is(err, storagesystem.ErrSignedLinkNotSupported) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return link, nil
+	}
+	return "", errors.Join(errs...)
+}
+
+// serveRemoteCar serves a GET request of a piece from a signed link of its CAR file, either by redirecting the client
+// to the link, or by streaming the requested range from the link. This skips the generic datasource path, which
+// reads the CAR file through rclone and buffers it to serve each range.
+//
+// It returns false if the piece is not served, so that the request can be served from the datasource instead, i.e.
+// because no CAR file of the piece lives in a storage that supports signed links, or because the request asks for
+// multiple ranges, which S3 does not support.
+//
+// Parameters:
+//   - c: The Echo context for the HTTP request.
+//   - pieceCid: The CID of the piece.
+//
+// Returns:
+//   - Whether the piece has been served.
+//   - An error if there was a problem serving the piece.
+func (s *HTTPServer) serveRemoteCar(c echo.Context, pieceCid cid.Cid) (bool, error) {
+	request := c.Request()
+	rangeHeader := request.Header.Get("Range")
+	if strings.Contains(rangeHeader, ",") {
+		return false, nil
+	}
+	// The content of a piece never changes, so an If-Range with the Etag of the piece always matches.
+	ifRange := request.Header.Get("If-Range")
+	if ifRange != "" && ifRange != "\""+pieceCid.String()+"\"" {
+		rangeHeader = ""
+	}
+
+	link, err := s.signedCarLink(c, pieceCid)
+	if err != nil || link == "" {
+		return false, err
+	}
+
+	if s.remoteCarMode == RemoteCarModeRedirect {
+		return true, c.Redirect(http.StatusTemporaryRedirect, link)
+	}
+
+	remoteRequest, err := http.NewRequestWithContext(request.Context(), http.MethodGet, link, nil)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	if rangeHeader != "" {
+		remoteRequest.Header.Set("Range", rangeHeader)
+	}
+	resp, err := http.DefaultClient.Do(remoteRequest)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent, http.StatusRequestedRangeNotSatisfiable:
+	default:
+		return false, errors.Wrap(ErrUnexpectedStatus, resp.Status)
+	}
+
+	SetCommonHeaders(c, pieceCid.String())
+	for _, header := range []string{"Content-Length", "Content-Range", "Last-Modified"} {
+		value := resp.Header.Get(header)
+		if value != "" {
+			c.Response().Header().Set(header, value)
+		}
+	}
+	c.Response().WriteHeader(resp.StatusCode)
+	_, err = io.Copy(c.Response(), resp.Body)
+	return true, errors.WithStack(err)
+}
@@ -0,0 +1,115 @@
+package contentprovider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestHTTPServerRemoteCar(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		content := testutil.GenerateRandomBytes(1000)
+		var remoteRequests int
+		s3 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/bucket/piece.car" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			remoteRequests++
+			http.ServeContent(w, r, "piece.car", time.Unix(1000, 0), bytes.NewReader(content))
+		}))
+		defer s3.Close()
+
+		pieceCID := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("test")))
+		err := db.Create(&model.Car{
+			PieceCID:      model.CID(pieceCID),
+			PieceSize:     2048,
+			FileSize:      int64(len(content)),
+			StoragePath:   "piece.car",
+			PreparationID: 1,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{},
+				Storage:     &model.Storage{Type: "local"},
+			},
+			Storage: &model.Storage{
+				Name: "remote",
+				Type: "s3",
+				Path: "bucket",
+				Config: map[string]string{
+					"provider":          "Other",
+					"endpoint":          s3.URL,
+					"access_key_id":     "access",
+					"secret_access_key": "secret",
+					"region":            "us-east-1",
+					"chunk_size":        "5Mi",
+					"force_path_style":  "true",
+				},
+			},
+			RootCID: model.CID(testutil.TestCid),
+		}).Error
+		require.NoError(t, err)
+
+		e := echo.New()
+		request := func(s *HTTPServer, header http.Header) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/piece/"+pieceCID.String(), nil)
+			for k, v := range header {
+				req.Header[k] = v
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/piece/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(pieceCID.String())
+			require.NoError(t, s.handleGetPiece(c))
+			return rec
+		}
+
+		t.Run("redirect", func(t *testing.T) {
+			s := &HTTPServer{dbNoContext: db, remoteCarMode: RemoteCarModeRedirect, remoteCarLinkExpiry: time.Hour}
+			rec := request(s, nil)
+			require.Equal(t, http.StatusTemporaryRedirect, rec.Code, rec.Body.String())
+			location := rec.Header().Get("Location")
+			require.True(t, strings.HasPrefix(location, s3.URL+"/bucket/piece.car?"), location)
+			require.Contains(t, location, "X-Amz-Signature=")
+		})
+
+		t.Run("proxy", func(t *testing.T) {
+			s := &HTTPServer{dbNoContext: db, remoteCarMode: RemoteCarModeProxy, remoteCarLinkExpiry: time.Hour}
+			rec := request(s, nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, content, rec.Body.Bytes())
+			require.Equal(t, "\""+pieceCID.String()+"\"", rec.Header().Get("Etag"))
+
+			rec = request(s, http.Header{"Range": {"bytes=100-199"}})
+			require.Equal(t, http.StatusPartialContent, rec.Code)
+			require.Equal(t, content[100:200], rec.Body.Bytes())
+			require.Equal(t, "bytes 100-199/1000", rec.Header().Get("Content-Range"))
+
+			// A stale If-Range serves the whole piece
+			rec = request(s, http.Header{"Range": {"bytes=100-199"}, "If-Range": {`"other"`}})
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, content, rec.Body.Bytes())
+		})
+
+		t.Run("open", func(t *testing.T) {
+			s := &HTTPServer{dbNoContext: db, remoteCarMode: RemoteCarModeOpen}
+			before := remoteRequests
+			rec := request(s, nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, content, rec.Body.Bytes())
+			require.Greater(t, remoteRequests, before)
+		})
+	})
+}
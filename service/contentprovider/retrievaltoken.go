@@ -0,0 +1,120 @@
+package contentprovider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/retrievaltoken"
+	"github.com/ipfs/go-cid"
+	"github.com/labstack/echo/v4"
+)
+
+var ErrBitswapWithRetrievalToken = errors.New("bitswap retrieval cannot be restricted with retrieval tokens")
+
+const retrievalTokenContextKey = "retrievalToken"
+
+// retrievalTokenFromRequest returns the retrieval token of the request, either from the Authorization header as a
+// bearer token, or from the token query parameter for clients that cannot set headers.
+func retrievalTokenFromRequest(c echo.Context) string {
+	if authorization := c.Request().Header.Get(echo.HeaderAuthorization); authorization != "" {
+		if token, ok := strings.CutPrefix(authorization, "Bearer "); ok {
+			return strings.TrimSpace(token)
+		}
+	}
+	return c.QueryParam("token")
+}
+
+// retrievalTokenWriter counts the bytes written to the response, and fails the writes beyond the bytes left on the
+// retrieval token.
+type retrievalTokenWriter struct {
+	http.ResponseWriter
+	limited   bool
+	remaining int64
+	written   int64
+}
+
+func (w *retrievalTokenWriter) Write(p []byte) (int, error) {
+	if w.limited && int64(len(p)) > w.remaining {
+		n, err := w.ResponseWriter.Write(p[:w.remaining])
+		w.remaining -= int64(n)
+		w.written += int64(n)
+		if err != nil {
+			return n, err
+		}
+		return n, retrievaltoken.ErrExhausted
+	}
+	n, err := w.ResponseWriter.Write(p)
+	w.remaining -= int64(n)
+	w.written += int64(n)
+	return n, err
+}
+
+func (w *retrievalTokenWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// retrievalTokenMiddleware requires a valid retrieval token that allows the requested piece, or the root block of the
+// requested sub-DAG. The bytes of the response are added to the usage of the token, and the response is cut once the
+// token has no bytes left. Since the usage is only updated once a response is done, concurrent requests may exceed
+// the max bytes of the token by the size of the responses in flight.
+func (s *HTTPServer) retrievalTokenMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		signed := retrievalTokenFromRequest(c)
+		if signed == "" {
+			return c.String(http.StatusUnauthorized, "a retrieval token is required")
+		}
+		ctx := c.Request().Context()
+		db := s.dbNoContext.WithContext(ctx)
+		token, err := retrievaltoken.Verify(ctx, db, signed)
+		switch {
+		case errors.Is(err, retrievaltoken.ErrInvalid):
+			return c.String(http.StatusUnauthorized, err.Error())
+		case errors.Is(err, retrievaltoken.ErrRevoked), errors.Is(err, retrievaltoken.ErrExpired), errors.Is(err, retrievaltoken.ErrExhausted):
+			return c.String(http.StatusForbidden, err.Error())
+		case err != nil:
+			return c.String(http.StatusInternalServerError, "failed to verify retrieval token: "+err.Error())
+		}
+
+		// An invalid CID is rejected by the handler itself
+		if strings.HasPrefix(c.Path(), "/ipfs/") {
+			if root, parseErr := cid.Parse(c.Param("cid")); parseErr == nil {
+				err = retrievaltoken.AllowsBlock(db, *token, root)
+			}
+		} else if pieceCid, parseErr := cid.Parse(c.Param("id")); parseErr == nil {
+			err = retrievaltoken.AllowsPiece(db, *token, pieceCid)
+		}
+		if errors.Is(err, retrievaltoken.ErrOutOfScope) {
+			return c.String(http.StatusForbidden, err.Error())
+		}
+		if err != nil {
+			return c.String(http.StatusInternalServerError, "failed to check the scope of the retrieval token: "+err.Error())
+		}
+
+		writer := &retrievalTokenWriter{
+			ResponseWriter: c.Response().Writer,
+			limited:        token.MaxBytes > 0,
+			remaining:      token.MaxBytes - token.BytesServed,
+		}
+		c.Response().Writer = writer
+		c.Set(retrievalTokenContextKey, token)
+		err = next(c)
+		c.Response().Writer = writer.ResponseWriter
+		// The usage is recorded even if the client went away
+		addErr := retrievaltoken.AddBytesServed(context.Background(), s.dbNoContext, token.ID, writer.written)
+		if addErr != nil {
+			logger.Errorw("failed to update the usage of the retrieval token", "id", token.ID, "err", addErr)
+		}
+		return err
+	}
+}
+
+// limitedRetrievalToken returns whether the request is made with a retrieval token limited to a number of bytes.
+func limitedRetrievalToken(c echo.Context) bool {
+	token, ok := c.Get(retrievalTokenContextKey).(*model.RetrievalToken)
+	return ok && token.MaxBytes > 0
+}
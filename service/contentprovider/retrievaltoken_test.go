@@ -0,0 +1,146 @@
+package contentprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/retrievaltoken"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/labstack/echo/v4"
+	"github.com/multiformats/go-varint"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestRetrievalTokenMiddleware(t *testing.T) {
+	t.Setenv(retrievaltoken.SecretEnvVar, "0123456789abcdef")
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		e := echo.New()
+		s := HTTPServer{
+			dbNoContext:         db,
+			enablePiece:         true,
+			enablePieceMetadata: true,
+			requireToken:        true,
+		}
+
+		pieceCID := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("test")))
+		otherPieceCID := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("other")))
+		err := db.Create(&model.Car{
+			PieceCID:      model.CID(pieceCID),
+			PieceSize:     128,
+			FileSize:      59 + 1 + 36 + 5,
+			PreparationID: 1,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{Name: "prep"},
+				Storage: &model.Storage{
+					Type: "local",
+				},
+			},
+			RootCID: model.CID(testutil.TestCid),
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.CarBlock{
+			CarID:          1,
+			CID:            model.CID(testutil.TestCid),
+			CarOffset:      59,
+			CarBlockLength: 1 + 36 + 5,
+			Varint:         varint.ToUvarint(36 + 5),
+			RawBlock:       []byte("hello"),
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Preparation{Name: "other"}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Car{PieceCID: model.CID(otherPieceCID), PreparationID: 2}).Error
+		require.NoError(t, err)
+
+		issue := func(token model.RetrievalToken) string {
+			signed, err := retrievaltoken.Issue(ctx, db, &token)
+			require.NoError(t, err)
+			return signed
+		}
+		request := func(piece cid.Cid, header string, query string) *httptest.ResponseRecorder {
+			target := "/piece/" + piece.String()
+			if query != "" {
+				target += "?token=" + query
+			}
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			if header != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+header)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/piece/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(piece.String())
+			require.NoError(t, s.retrievalTokenMiddleware(s.handleGetPiece)(c))
+			return rec
+		}
+
+		unlimited := issue(model.RetrievalToken{PreparationID: 1})
+		limited := issue(model.RetrievalToken{PreparationID: 1, MaxBytes: 150})
+
+		t.Run("no token", func(t *testing.T) {
+			require.Equal(t, http.StatusUnauthorized, request(pieceCID, "", "").Code)
+		})
+		t.Run("invalid token", func(t *testing.T) {
+			require.Equal(t, http.StatusUnauthorized, request(pieceCID, unlimited+"x", "").Code)
+		})
+		t.Run("out of scope", func(t *testing.T) {
+			require.Equal(t, http.StatusForbidden, request(otherPieceCID, unlimited, "").Code)
+		})
+		t.Run("bearer token", func(t *testing.T) {
+			rec := request(pieceCID, unlimited, "")
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.EqualValues(t, 101, rec.Body.Len())
+		})
+		t.Run("query token", func(t *testing.T) {
+			rec := request(pieceCID, "", unlimited)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.EqualValues(t, 101, rec.Body.Len())
+			var token model.RetrievalToken
+			require.NoError(t, db.First(&token, 1).Error)
+			require.EqualValues(t, 202, token.BytesServed)
+		})
+		t.Run("max bytes", func(t *testing.T) {
+			rec := request(pieceCID, limited, "")
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.EqualValues(t, 101, rec.Body.Len())
+			// The second response is cut at the max bytes of the token
+			rec = request(pieceCID, limited, "")
+			require.EqualValues(t, 49, rec.Body.Len())
+			require.Equal(t, http.StatusForbidden, request(pieceCID, limited, "").Code)
+		})
+		t.Run("warm", func(t *testing.T) {
+			warm := func(header string) *httptest.ResponseRecorder {
+				body := `{"pieces":["` + pieceCID.String() + `","` + otherPieceCID.String() + `"]}`
+				req := httptest.NewRequest(http.MethodPost, "/piece/warm", strings.NewReader(body))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				if header != "" {
+					req.Header.Set(echo.HeaderAuthorization, "Bearer "+header)
+				}
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/piece/warm")
+				require.NoError(t, s.retrievalTokenMiddleware(s.handleWarm)(c))
+				return rec
+			}
+			require.Equal(t, http.StatusUnauthorized, warm("").Code)
+			rec := warm(unlimited)
+			require.Equal(t, http.StatusOK, rec.Code)
+			var results []WarmResult
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &results))
+			require.Len(t, results, 2)
+			require.Equal(t, 1, results[0].CachedCars)
+			require.Empty(t, results[0].Error)
+			require.Zero(t, results[1].CachedCars)
+			require.Equal(t, retrievaltoken.ErrOutOfScope.Error(), results[1].Error)
+		})
+	})
+}
@@ -0,0 +1,163 @@
+package contentprovider
+
+import (
+	"html/template"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/dataprep"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/dustin/go-humanize"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+	"gorm.io/gorm"
+)
+
+const (
+	StatsPath                = "/stats/:id"
+	DefaultPublicStatsLimit  = 60
+	DefaultPublicStatsMaxAge = time.Minute
+)
+
+type PublicStatsConfig struct {
+	Enable    bool
+	RateLimit int // Number of requests allowed per minute for each client IP
+}
+
+// PublicStats serves the stats of the preparations shared by their data owners. The stats of each share ID are
+// cached for a minute, so the page can be linked or embedded publicly without each view querying the database.
+type PublicStats struct {
+	db      *gorm.DB
+	handler dataprep.Handler
+	config  PublicStatsConfig
+	mu      sync.Mutex
+	cache   map[string]cachedStats
+}
+
+type cachedStats struct {
+	stats     *dataprep.Stats
+	expiresAt time.Time
+}
+
+// NewPublicStats creates a PublicStats that computes the stats with the dataprep handler.
+func NewPublicStats(db *gorm.DB, config PublicStatsConfig) *PublicStats {
+	if config.RateLimit <= 0 {
+		config.RateLimit = DefaultPublicStatsLimit
+	}
+	return &PublicStats{
+		db:      db,
+		handler: dataprep.Default,
+		config:  config,
+		cache:   make(map[string]cachedStats),
+	}
+}
+
+// rateLimiter returns a middleware that limits the requests of each client IP to the configured number of requests
+// per minute, answering the requests over the limit with 429 Too Many Requests.
+func (p *PublicStats) rateLimiter() echo.MiddlewareFunc {
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Store: middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+			Rate:      rate.Limit(float64(p.config.RateLimit) / time.Minute.Seconds()),
+			Burst:     p.config.RateLimit,
+			ExpiresIn: 3 * time.Minute,
+		}),
+	})
+}
+
+// get returns the stats shared with a share ID, from the cache if they were computed less than a minute ago.
+func (p *PublicStats) get(c echo.Context, shareID string) (*dataprep.Stats, error) {
+	now := time.Now()
+	p.mu.Lock()
+	cached, ok := p.cache[shareID]
+	for id, entry := range p.cache {
+		if now.After(entry.expiresAt) {
+			delete(p.cache, id)
+		}
+	}
+	p.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.stats, nil
+	}
+
+	stats, err := p.handler.GetSharedStatsHandler(c.Request().Context(), p.db, shareID)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	p.cache[shareID] = cachedStats{stats: stats, expiresAt: now.Add(DefaultPublicStatsMaxAge)}
+	p.mu.Unlock()
+	return stats, nil
+}
+
+var statsTemplate = template.Must(template.New("stats").Funcs(template.FuncMap{
+	"bytes": func(n int64) string { return humanize.IBytes(uint64(n)) },
+	"time":  func(t time.Time) string { return t.Format("2006-01-02 15:04:05 MST") },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Name}}</title>
+<style>
+body { font-family: sans-serif; margin: 1em; }
+table { border-collapse: collapse; }
+th, td { text-align: left; padding: 0.2em 1em 0.2em 0; }
+</style>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+<table>
+<tr><th>Pieces prepared</th><td>{{.Pieces}}</td></tr>
+<tr><th>Bytes prepared</th><td>{{bytes .BytesPrepared}}</td></tr>
+<tr><th>Active deals</th><td>{{.ActiveDeals}} ({{bytes .ActiveBytes}})</td></tr>
+<tr><th>Pending deals</th><td>{{.PendingDeals}}</td></tr>
+<tr><th>Last retrieval check</th><td>{{if .LastRetrievalCheck}}{{time .LastRetrievalCheck}} ({{if .LastRetrievalSuccess}}passed{{else}}failed{{end}}){{else}}never{{end}}</td></tr>
+<tr><th>Retrieval checks passed in the last week</th><td>{{.RetrievalChecksPassed}} / {{.RetrievalChecks}}</td></tr>
+</table>
+{{if .Providers}}
+<h2>Storage providers</h2>
+<table>
+<tr><th>Provider</th><th>Active deals</th><th>Active bytes</th></tr>
+{{range .Providers}}<tr><td>{{.Provider}}</td><td>{{.ActiveDeals}}</td><td>{{bytes .ActiveBytes}}</td></tr>
+{{end}}</table>
+{{end}}
+<p><small>Generated at {{time .GeneratedAt}}</small></p>
+</body>
+</html>
+`))
+
+// handleGetStats is a method on the HTTPServer struct that serves the stats of the preparation shared with the share
+// ID in the path. The stats are served as JSON, or as an HTML page if the client accepts HTML or the format query
+// parameter is html, so they can be fetched by scripts, linked or embedded in an iframe. Any origin can fetch them.
+//
+// Parameters:
+//   - c: The Echo context for the HTTP request.
+//
+// Returns:
+//   - An error if there was a problem handling the request.
+func (s *HTTPServer) handleGetStats(c echo.Context) error {
+	stats, err := s.publicStats.get(c, c.Param("id"))
+	if errors.Is(err, handlererror.ErrNotFound) {
+		return c.String(http.StatusNotFound, "stats not found")
+	}
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to get stats: "+err.Error())
+	}
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderAccessControlAllowOrigin, "*")
+	header.Set(echo.HeaderCacheControl, "public, max-age=60")
+	format := c.QueryParam("format")
+	if format == "html" || (format == "" && strings.Contains(c.Request().Header.Get(echo.HeaderAccept), echo.MIMETextHTML)) {
+		var sb strings.Builder
+		err = statsTemplate.Execute(&sb, stats)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, "failed to render stats: "+err.Error())
+		}
+		return c.HTML(http.StatusOK, sb.String())
+	}
+	return c.JSON(http.StatusOK, stats)
+}
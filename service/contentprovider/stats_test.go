@@ -0,0 +1,61 @@
+package contentprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/handler/dataprep"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestHTTPServerPublicStats(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		shareID := "0123456789abcdef"
+		require.NoError(t, db.Create(&model.Preparation{Name: "prep", StatsShareID: &shareID}).Error)
+		require.NoError(t, db.Create(&model.Preparation{Name: "private"}).Error)
+		s := &HTTPServer{
+			dbNoContext: db,
+			publicStats: NewPublicStats(db, PublicStatsConfig{Enable: true, RateLimit: 3}),
+		}
+		e := echo.New()
+		e.GET(StatsPath, s.handleGetStats, s.publicStats.rateLimiter())
+		get := func(path string, accept string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			if accept != "" {
+				req.Header.Set(echo.HeaderAccept, accept)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			return rec
+		}
+
+		rec := get("/stats/"+shareID, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "*", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+		require.Equal(t, "public, max-age=60", rec.Header().Get(echo.HeaderCacheControl))
+		var stats dataprep.Stats
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+		require.Equal(t, "prep", stats.Name)
+
+		// The stats are cached, so unsharing them takes effect once the cache expires
+		require.NoError(t, db.Model(&model.Preparation{}).Where("name = ?", "prep").Update("stats_share_id", nil).Error)
+		rec = get("/stats/"+shareID, "text/html,application/xhtml+xml")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Header().Get(echo.HeaderContentType), echo.MIMETextHTML)
+		require.Contains(t, rec.Body.String(), "<h1>prep</h1>")
+
+		rec = get("/stats/unknown", "")
+		require.Equal(t, http.StatusNotFound, rec.Code)
+
+		// Each client IP is limited to 3 requests per minute
+		rec = get("/stats/"+shareID+"?format=html", "")
+		require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	})
+}
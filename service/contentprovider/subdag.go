@@ -0,0 +1,201 @@
+package contentprovider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/store"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-unixfsnode"
+	dagpb "github.com/ipld/go-codec-dagpb"
+	"github.com/ipld/go-ipld-prime/datamodel"
+	"github.com/ipld/go-ipld-prime/linking"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/ipld/go-ipld-prime/traversal"
+	"github.com/ipld/go-ipld-prime/traversal/selector"
+	selectorparse "github.com/ipld/go-ipld-prime/traversal/selector/parse"
+	trustlessutils "github.com/ipld/go-trustless-utils"
+	"github.com/labstack/echo/v4"
+)
+
+const CarContentType = "application/vnd.ipld.car; version=1; order=dfs; dups=n"
+
+var protoChooser = dagpb.AddSupportToChooser(basicnode.Chooser)
+
+// SubDAGRequest selects the part of a DAG to retrieve. Either a UnixFS path with a DAG scope, or an IPLD selector is used.
+type SubDAGRequest struct {
+	Root     cid.Cid
+	Path     string
+	Scope    trustlessutils.DagScope
+	Selector datamodel.Node
+}
+
+// selector returns the compiled selector of the request. The path selector is the same as the one used by
+// trustless IPFS gateways, so the result can be verified by any trustless gateway client.
+func (r SubDAGRequest) selector() (selector.Selector, error) {
+	node := r.Selector
+	if node == nil {
+		node = trustlessutils.Request{Root: r.Root, Path: r.Path, Scope: r.Scope}.Selector()
+	}
+	sel, err := selector.CompileSelector(node)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compile selector")
+	}
+	return sel, nil
+}
+
+// WriteSubDAG writes the blocks matching the request as a CARv1 file, in the order they are visited by the traversal.
+// Each block is only written once. Blocks are looked up from the block index, so only blocks of prepared data,
+// and the directory blocks after DAG generation, can be retrieved.
+//
+// Parameters:
+//   - ctx: The context for the traversal and the block lookups.
+//   - bs: The block store to look up the blocks from.
+//   - request: The SubDAGRequest that specifies the root and the part of the DAG to retrieve.
+//   - out: The writer to write the CAR file to.
+//
+// Returns:
+//   - An error if a block cannot be found or the traversal fails. The CAR file is incomplete in this case.
+func WriteSubDAG(ctx context.Context, bs *store.FileReferenceBlockStore, request SubDAGRequest, out io.Writer) error {
+	sel, err := request.selector()
+	if err != nil {
+		return err
+	}
+
+	_, err = packutil.WriteCarHeader(out, request.Root)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	written := make(map[cid.Cid]struct{})
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.TrustedStorage = true
+	lsys.StorageReadOpener = func(lc linking.LinkContext, l datamodel.Link) (io.Reader, error) {
+		c := l.(cidlink.Link).Cid
+		blk, err := bs.Get(lc.Ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := written[c]; !ok {
+			_, err = packutil.WriteCarBlock(out, blk)
+			if err != nil {
+				return nil, err
+			}
+			written[c] = struct{}{}
+		}
+		return bytes.NewReader(blk.RawData()), nil
+	}
+	unixfsnode.AddUnixFSReificationToLinkSystem(&lsys)
+
+	rootLink := cidlink.Link{Cid: request.Root}
+	linkCtx := linking.LinkContext{Ctx: ctx}
+	proto, err := protoChooser(rootLink, linkCtx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	rootNode, err := lsys.Load(linkCtx, rootLink, proto)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load root %s", request.Root)
+	}
+
+	progress := traversal.Progress{
+		Cfg: &traversal.Config{
+			Ctx:                            ctx,
+			LinkSystem:                     lsys,
+			LinkTargetNodePrototypeChooser: protoChooser,
+		},
+	}
+	err = progress.WalkAdv(rootNode, sel, func(traversal.Progress, datamodel.Node, traversal.VisitReason) error {
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to traverse DAG")
+	}
+	return nil
+}
+
+// parseSubDAGRequest parses the root CID and UnixFS path from the URL, and the DAG scope or the dag-json encoded
+// selector from the query parameters.
+func parseSubDAGRequest(c echo.Context) (*SubDAGRequest, error) {
+	root, err := cid.Parse(c.Param("cid"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse root CID")
+	}
+	request := &SubDAGRequest{
+		Root:  root,
+		Path:  c.Param("*"),
+		Scope: trustlessutils.DagScopeAll,
+	}
+	if unescaped, err := url.PathUnescape(request.Path); err == nil {
+		request.Path = unescaped
+	}
+
+	if scope := c.QueryParam("dag-scope"); scope != "" {
+		switch trustlessutils.DagScope(scope) {
+		case trustlessutils.DagScopeAll, trustlessutils.DagScopeEntity, trustlessutils.DagScopeBlock:
+			request.Scope = trustlessutils.DagScope(scope)
+		default:
+			return nil, errors.Newf("invalid dag-scope %s, must be all, entity or block", scope)
+		}
+	}
+
+	if s := c.QueryParam("selector"); s != "" {
+		if request.Path != "" || c.QueryParam("dag-scope") != "" {
+			return nil, errors.New("selector cannot be combined with a path or dag-scope")
+		}
+		request.Selector, err = selectorparse.ParseJSONSelector(s)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse selector")
+		}
+	}
+	return request, nil
+}
+
+// handleGetSubDAG is a method on the HTTPServer struct that handles HTTP requests to retrieve a sub-DAG as a CAR file.
+//
+// The root CID and an optional UnixFS path are specified in the URL, i.e. /ipfs/{cid}/{path}. The dag-scope query
+// parameter selects whether the whole DAG under the path (all), only the entity at the path (entity), or only the
+// block at the path (block) is returned. Alternatively, an arbitrary dag-json encoded IPLD selector can be specified
+// with the selector query parameter.
+//
+// If the root block does not exist, it returns a 404 Not Found response. Since the CAR file is streamed, an error
+// during the traversal can only be signaled by terminating the response.
+//
+// Parameters:
+//   - c: The Echo context for the HTTP request.
+//
+// Returns:
+//   - An error if there was a problem handling the request.
+func (s *HTTPServer) handleGetSubDAG(c echo.Context) error {
+	request, err := parseSubDAGRequest(c)
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+	ctx := c.Request().Context()
+	bs := &store.FileReferenceBlockStore{DBNoContext: s.dbNoContext, BlockCache: s.blockCache}
+	has, err := bs.Has(ctx, request.Root)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to look up root: "+err.Error())
+	}
+	if !has {
+		return c.String(http.StatusNotFound, "root not found")
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, CarContentType)
+	c.Response().WriteHeader(http.StatusOK)
+	if c.Request().Method == http.MethodHead {
+		return nil
+	}
+	err = WriteSubDAG(ctx, bs, *request, c.Response())
+	if err != nil {
+		logger.Errorw("failed to write sub-DAG", "root", request.Root, "path", request.Path, "error", err)
+		return errors.WithStack(err)
+	}
+	return nil
+}
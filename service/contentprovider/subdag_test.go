@@ -0,0 +1,140 @@
+package contentprovider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/ipld/merkledag"
+	"github.com/ipfs/boxo/ipld/unixfs"
+	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
+	"github.com/ipld/go-car/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestHTTPServer_handleGetSubDAG(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		// root/
+		// ├── sub/
+		// │   └── a.txt
+		// └── b.txt
+		a := merkledag.NewRawNode([]byte("hello"))
+		b := merkledag.NewRawNode([]byte("world"))
+		sub := merkledag.NodeWithData(unixfs.FolderPBData())
+		require.NoError(t, sub.AddNodeLink("a.txt", a))
+		root := merkledag.NodeWithData(unixfs.FolderPBData())
+		require.NoError(t, root.AddNodeLink("sub", sub))
+		require.NoError(t, root.AddNodeLink("b.txt", b))
+
+		carModel := model.Car{
+			PreparationID: 1,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{},
+				Storage:     &model.Storage{},
+			},
+		}
+		require.NoError(t, db.Create(&carModel).Error)
+		for _, node := range []format.Node{root, sub, a, b} {
+			err := db.Create(&model.CarBlock{
+				CarID:          carModel.ID,
+				CID:            model.CID(node.Cid()),
+				CarBlockLength: int32(1 + node.Cid().ByteLen() + len(node.RawData())),
+				Varint:         []byte{0},
+				RawBlock:       node.RawData(),
+			}).Error
+			require.NoError(t, err)
+		}
+
+		s := HTTPServer{
+			dbNoContext:  db,
+			enableSubDAG: true,
+		}
+		get := func(rootCID string, path string, query url.Values) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/ipfs/"+rootCID+"/"+path+"?"+query.Encode(), nil)
+			req = req.WithContext(ctx)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetPath("/ipfs/:cid/*")
+			c.SetParamNames("cid", "*")
+			c.SetParamValues(rootCID, path)
+			err := s.handleGetSubDAG(c)
+			require.NoError(t, err)
+			return rec
+		}
+		blocksOf := func(rec *httptest.ResponseRecorder) []cid.Cid {
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, CarContentType, rec.Header().Get(echo.HeaderContentType))
+			reader, err := car.NewBlockReader(bytes.NewReader(rec.Body.Bytes()))
+			require.NoError(t, err)
+			require.Equal(t, []cid.Cid{root.Cid()}, reader.Roots)
+			var cids []cid.Cid
+			for {
+				blk, err := reader.Next()
+				if err != nil {
+					break
+				}
+				cids = append(cids, blk.Cid())
+			}
+			return cids
+		}
+
+		t.Run("whole dag", func(t *testing.T) {
+			rec := get(root.Cid().String(), "", nil)
+			require.ElementsMatch(t, []cid.Cid{root.Cid(), sub.Cid(), a.Cid(), b.Cid()}, blocksOf(rec))
+		})
+
+		t.Run("directory", func(t *testing.T) {
+			rec := get(root.Cid().String(), "sub", nil)
+			require.Equal(t, []cid.Cid{root.Cid(), sub.Cid(), a.Cid()}, blocksOf(rec))
+		})
+
+		t.Run("single file", func(t *testing.T) {
+			rec := get(root.Cid().String(), "b.txt", nil)
+			require.Equal(t, []cid.Cid{root.Cid(), b.Cid()}, blocksOf(rec))
+		})
+
+		t.Run("block scope", func(t *testing.T) {
+			rec := get(root.Cid().String(), "sub", url.Values{"dag-scope": {"block"}})
+			require.Equal(t, []cid.Cid{root.Cid(), sub.Cid()}, blocksOf(rec))
+		})
+
+		t.Run("selector", func(t *testing.T) {
+			// Only the root block
+			rec := get(root.Cid().String(), "", url.Values{"selector": {`{".":{}}`}})
+			require.Equal(t, []cid.Cid{root.Cid()}, blocksOf(rec))
+		})
+
+		t.Run("invalid selector", func(t *testing.T) {
+			rec := get(root.Cid().String(), "", url.Values{"selector": {"invalid"}})
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+
+		t.Run("selector with path", func(t *testing.T) {
+			rec := get(root.Cid().String(), "sub", url.Values{"selector": {`{".":{}}`}})
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+
+		t.Run("invalid dag scope", func(t *testing.T) {
+			rec := get(root.Cid().String(), "", url.Values{"dag-scope": {"invalid"}})
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+
+		t.Run("invalid cid", func(t *testing.T) {
+			rec := get("invalid", "", nil)
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+
+		t.Run("root not found", func(t *testing.T) {
+			rec := get(testutil.TestCid.String(), "", nil)
+			require.Equal(t, http.StatusNotFound, rec.Code)
+		})
+	})
+}
@@ -0,0 +1,242 @@
+package contentprovider
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/klauspost/compress/zstd"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+const (
+	EncodingZstd = "zstd"
+	EncodingGzip = "gzip"
+)
+
+var ErrInvalidCompression = errors.New("compression must be one of 'zstd' or 'gzip'")
+
+// TransportConfig configures how the HTTP server talks to the clients, tuned for long-haul transfers to distant
+// storage providers.
+type TransportConfig struct {
+	EnableHTTP2 bool          // Serve HTTP/2 over cleartext (h2c) in addition to HTTP/1.1
+	Compression []string      // Encodings the metadata responses are compressed with, in order of preference. Not compressed if empty.
+	KeepAlive   time.Duration // Keep-alive period of the TCP connections. The OS default is used if 0, and keep-alives are disabled if negative.
+	ReadBuffer  int           // Size of the receive buffer of the TCP connections in bytes. The OS default is used if 0.
+	WriteBuffer int           // Size of the send buffer of the TCP connections in bytes. The OS default is used if 0.
+}
+
+// validate checks that the encodings of the config are supported.
+func (t TransportConfig) validate() error {
+	for _, encoding := range t.Compression {
+		if encoding != EncodingZstd && encoding != EncodingGzip {
+			return errors.Wrapf(ErrInvalidCompression, "got '%s'", encoding)
+		}
+	}
+	return nil
+}
+
+// listen opens the TCP listener of the HTTP server. The keep-alive period applies to the accepted connections, and
+// so do the buffer sizes, since a larger send buffer keeps more data in flight on links with a high latency.
+func (t TransportConfig) listen(ctx context.Context, bind string) (net.Listener, error) {
+	listenConfig := net.ListenConfig{KeepAlive: t.KeepAlive}
+	listener, err := listenConfig.Listen(ctx, "tcp", bind)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if t.ReadBuffer <= 0 && t.WriteBuffer <= 0 {
+		return listener, nil
+	}
+	return &tunedListener{Listener: listener, readBuffer: t.ReadBuffer, writeBuffer: t.WriteBuffer}, nil
+}
+
+type tunedListener struct {
+	net.Listener
+	readBuffer  int
+	writeBuffer int
+}
+
+func (l *tunedListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	tcpConn, ok := conn.(*net.TCPConn)
+	if !ok {
+		return conn, nil
+	}
+	// A connection with the default buffers is still usable, so failing to set them is not fatal
+	if l.readBuffer > 0 {
+		if err := tcpConn.SetReadBuffer(l.readBuffer); err != nil {
+			logger.Warnw("failed to set TCP read buffer", "size", l.readBuffer, "err", err)
+		}
+	}
+	if l.writeBuffer > 0 {
+		if err := tcpConn.SetWriteBuffer(l.writeBuffer); err != nil {
+			logger.Warnw("failed to set TCP write buffer", "size", l.writeBuffer, "err", err)
+		}
+	}
+	return conn, nil
+}
+
+// encoder is a compressor that can be flushed and reused, as implemented by gzip.Writer and zstd.Encoder.
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+var encoderPools = map[string]*sync.Pool{
+	EncodingGzip: {New: func() any { return gzip.NewWriter(io.Discard) }},
+	EncodingZstd: {New: func() any {
+		// The options are valid, so the error can be ignored
+		e, _ := zstd.NewWriter(io.Discard, zstd.WithEncoderConcurrency(1), zstd.WithEncoderLevel(zstd.SpeedDefault))
+		return e
+	}},
+}
+
+// negotiateEncoding returns the first of the encodings that the Accept-Encoding header of the client accepts, or an
+// empty string if none is.
+func negotiateEncoding(acceptEncoding string, encodings []string) string {
+	accepted := make(map[string]struct{})
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+				continue
+			}
+		}
+		accepted[strings.ToLower(strings.TrimSpace(name))] = struct{}{}
+	}
+	for _, encoding := range encodings {
+		if _, ok := accepted[encoding]; ok {
+			return encoding
+		}
+		if _, ok := accepted["*"]; ok {
+			return encoding
+		}
+	}
+	return ""
+}
+
+// compressMiddleware compresses the responses with the first of the encodings that the client accepts. The decision
+// to compress is delayed until the first write, so responses without a body, and responses that already have a
+// Content-Encoding, are sent as is.
+func compressMiddleware(encodings []string, skipper middleware.Skipper) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skipper(c) {
+				return next(c)
+			}
+			res := c.Response()
+			res.Header().Add(echo.HeaderVary, echo.HeaderAcceptEncoding)
+			encoding := negotiateEncoding(c.Request().Header.Get(echo.HeaderAcceptEncoding), encodings)
+			if encoding == "" {
+				return next(c)
+			}
+			writer := &compressResponseWriter{ResponseWriter: res.Writer, encoding: encoding}
+			res.Writer = writer
+			defer func() {
+				err := writer.close()
+				if err != nil {
+					logger.Warnw("failed to finish compressed response", "encoding", encoding, "err", err)
+				}
+				res.Writer = writer.ResponseWriter
+			}()
+			return next(c)
+		}
+	}
+}
+
+type compressResponseWriter struct {
+	http.ResponseWriter
+	encoding    string
+	encoder     encoder
+	code        int
+	wroteHeader bool
+	passthrough bool
+}
+
+func (w *compressResponseWriter) WriteHeader(code int) {
+	// Delay writing the header until it is known whether the response is compressed
+	if w.code == 0 {
+		w.code = code
+	}
+}
+
+// writeHeader writes the delayed header, with the Content-Encoding if the response is compressed.
+func (w *compressResponseWriter) writeHeader(compress bool) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	header := w.Header()
+	if !compress || header.Get(echo.HeaderContentEncoding) != "" ||
+		w.code == http.StatusNoContent || w.code == http.StatusNotModified {
+		w.passthrough = true
+	} else {
+		header.Del(echo.HeaderContentLength)
+		header.Set(echo.HeaderContentEncoding, w.encoding)
+	}
+	w.ResponseWriter.WriteHeader(w.code)
+}
+
+func (w *compressResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		// The content type is detected from the uncompressed content
+		if w.Header().Get(echo.HeaderContentType) == "" {
+			w.Header().Set(echo.HeaderContentType, http.DetectContentType(b))
+		}
+		w.writeHeader(true)
+	}
+	if w.passthrough {
+		return w.ResponseWriter.Write(b)
+	}
+	if w.encoder == nil {
+		w.encoder = encoderPools[w.encoding].Get().(encoder)
+		w.encoder.Reset(w.ResponseWriter)
+	}
+	return w.encoder.Write(b)
+}
+
+func (w *compressResponseWriter) Flush() {
+	w.writeHeader(true)
+	if w.encoder != nil {
+		_ = w.encoder.Flush()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// close finishes the compressed stream and returns the encoder to its pool. The header is written uncompressed if
+// nothing was written.
+func (w *compressResponseWriter) close() error {
+	if w.code != 0 {
+		w.writeHeader(false)
+	}
+	if w.encoder == nil && w.wroteHeader && !w.passthrough {
+		// The header was flushed as compressed, so an empty compressed stream is still sent
+		w.encoder = encoderPools[w.encoding].Get().(encoder)
+		w.encoder.Reset(w.ResponseWriter)
+	}
+	if w.encoder == nil {
+		return nil
+	}
+	err := w.encoder.Close()
+	w.encoder.Reset(io.Discard)
+	encoderPools[w.encoding].Put(w.encoder)
+	w.encoder = nil
+	return errors.WithStack(err)
+}
@@ -0,0 +1,136 @@
+package contentprovider
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+)
+
+func TestCompressMiddleware(t *testing.T) {
+	metadata := bytes.Repeat([]byte(`{"cid":"bafkqaaa"}`), 100)
+	e := echo.New()
+	e.Use(compressMiddleware([]string{EncodingZstd, EncodingGzip}, skipCompression))
+	e.GET("/piece/metadata/:id", func(c echo.Context) error {
+		return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, metadata)
+	})
+	e.GET("/piece/:id", func(c echo.Context) error {
+		return c.Blob(http.StatusOK, "application/piece", metadata)
+	})
+	e.GET("/empty", func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+	request := func(path string, acceptEncoding string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(echo.HeaderAcceptEncoding, acceptEncoding)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("zstd is preferred", func(t *testing.T) {
+		rec := request("/piece/metadata/1", "gzip, deflate, br, zstd")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, EncodingZstd, rec.Header().Get(echo.HeaderContentEncoding))
+		require.Equal(t, echo.HeaderAcceptEncoding, rec.Header().Get(echo.HeaderVary))
+		require.Equal(t, echo.MIMEApplicationJSON, rec.Header().Get(echo.HeaderContentType))
+		require.Less(t, rec.Body.Len(), len(metadata))
+		decoder, err := zstd.NewReader(rec.Body)
+		require.NoError(t, err)
+		defer decoder.Close()
+		decoded, err := io.ReadAll(decoder)
+		require.NoError(t, err)
+		require.Equal(t, metadata, decoded)
+	})
+
+	t.Run("gzip", func(t *testing.T) {
+		rec := request("/piece/metadata/1", "gzip, zstd;q=0")
+		require.Equal(t, EncodingGzip, rec.Header().Get(echo.HeaderContentEncoding))
+		reader, err := gzip.NewReader(rec.Body)
+		require.NoError(t, err)
+		decoded, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, metadata, decoded)
+	})
+
+	t.Run("identity", func(t *testing.T) {
+		rec := request("/piece/metadata/1", "")
+		require.Empty(t, rec.Header().Get(echo.HeaderContentEncoding))
+		require.Equal(t, metadata, rec.Body.Bytes())
+	})
+
+	t.Run("piece content is not compressed", func(t *testing.T) {
+		rec := request("/piece/1", "zstd")
+		require.Empty(t, rec.Header().Get(echo.HeaderContentEncoding))
+		require.Equal(t, metadata, rec.Body.Bytes())
+	})
+
+	t.Run("empty response", func(t *testing.T) {
+		rec := request("/empty", "zstd")
+		require.Equal(t, http.StatusNoContent, rec.Code)
+		require.Empty(t, rec.Header().Get(echo.HeaderContentEncoding))
+		require.Zero(t, rec.Body.Len())
+	})
+}
+
+func TestHTTPServer_HTTP2(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	bind := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	s := HTTPServer{
+		bind: bind,
+		transport: TransportConfig{
+			EnableHTTP2: true,
+			Compression: []string{EncodingZstd},
+			KeepAlive:   time.Minute,
+			ReadBuffer:  1 << 20,
+			WriteBuffer: 4 << 20,
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	exitErr := make(chan error, 1)
+	require.NoError(t, s.Start(ctx, exitErr))
+	defer func() {
+		cancel()
+		require.NoError(t, <-exitErr)
+	}()
+
+	// Talk HTTP/2 over cleartext with prior knowledge, as clients of h2c do
+	client := &http.Client{Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}}
+	var resp *http.Response
+	require.Eventually(t, func() bool {
+		resp, err = client.Get("http://" + bind + "/health")
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 2, resp.ProtoMajor)
+
+	// HTTP/1.1 is still served
+	resp11, err := http.Get("http://" + bind + "/health")
+	require.NoError(t, err)
+	defer func() { _ = resp11.Body.Close() }()
+	require.Equal(t, 1, resp11.ProtoMajor)
+	body, err := io.ReadAll(resp11.Body)
+	require.NoError(t, err)
+	require.Equal(t, "ok", string(body))
+}
@@ -0,0 +1,189 @@
+package contentprovider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/oserror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/retrievaltoken"
+	"github.com/ipfs/go-cid"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPieceMetadataCacheTTL      = time.Hour
+	DefaultPieceMetadataCacheCapacity = 1000
+	DefaultMaxWarmPrefetch            = 1 << 30
+	MaxWarmPieces                     = 1000
+)
+
+// PieceMetadataCache caches the block index of pieces, i.e. the car blocks and files that are needed to
+// assemble a piece on the fly, so that the content provider does not need to query the database for
+// every retrieval request of the same piece.
+type PieceMetadataCache struct {
+	cache *ttlcache.Cache[cid.Cid, []PieceMetadata]
+}
+
+// NewPieceMetadataCache creates a cache of the block index of up to capacity pieces, each of them cached for ttl.
+// The least recently used pieces are evicted once the capacity is reached. A capacity of 0 means no limit.
+func NewPieceMetadataCache(ttl time.Duration, capacity uint64) *PieceMetadataCache {
+	return &PieceMetadataCache{
+		cache: ttlcache.New[cid.Cid, []PieceMetadata](
+			ttlcache.WithTTL[cid.Cid, []PieceMetadata](ttl),
+			ttlcache.WithCapacity[cid.Cid, []PieceMetadata](capacity)),
+	}
+}
+
+// Start starts the removal of the expired pieces from the cache in the background, until Stop is called.
+func (p *PieceMetadataCache) Start() {
+	if p == nil {
+		return
+	}
+	go p.cache.Start()
+}
+
+// Stop stops the removal of the expired pieces started by Start.
+func (p *PieceMetadataCache) Stop() {
+	if p == nil {
+		return
+	}
+	p.cache.Stop()
+}
+
+// Get returns the metadata of all cars of the given piece that can be assembled on the fly.
+// The metadata is loaded from the database if it is not in the cache yet. A car whose metadata cannot be loaded,
+// i.e. because its storage is gone, is logged and skipped, so the other cars of the piece can still serve it.
+// The cache is optional, a nil PieceMetadataCache always loads from the database.
+func (p *PieceMetadataCache) Get(ctx context.Context, db *gorm.DB, pieceCid cid.Cid) ([]PieceMetadata, error) {
+	if p != nil {
+		item := p.cache.Get(pieceCid)
+		if item != nil {
+			return item.Value(), nil
+		}
+	}
+
+	var cars []model.Car
+	err := db.WithContext(ctx).Where("piece_cid = ? AND attachment_id IS NOT NULL", model.CID(pieceCid)).Find(&cars).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	metadata := make([]PieceMetadata, 0, len(cars))
+	for _, car := range cars {
+		m, err := getPieceMetadata(ctx, db, car)
+		if err != nil {
+			logger.Warnw("skipping car with invalid metadata", "car", car.ID, "piece", pieceCid.String(), "error", err)
+			continue
+		}
+		metadata = append(metadata, *m)
+	}
+
+	if p != nil && len(metadata) > 0 {
+		p.cache.Set(pieceCid, metadata, ttlcache.DefaultTTL)
+	}
+	return metadata, nil
+}
+
+type WarmRequest struct {
+	Pieces        []string `json:"pieces"`
+	PrefetchBytes int64    `json:"prefetchBytes"`
+}
+
+type WarmResult struct {
+	PieceCID        string `json:"pieceCid"`
+	CachedCars      int    `json:"cachedCars"`
+	PrefetchedBytes int64  `json:"prefetchedBytes"`
+	Error           string `json:"error,omitempty" table:"verbose"`
+}
+
+// warm loads the block index of a piece into the cache and, if prefetchBytes is positive,
+// reads the beginning of the piece so that slow storage backends have the hot range ready.
+func (s *HTTPServer) warm(ctx context.Context, pieceCid cid.Cid, prefetchBytes int64) (WarmResult, error) {
+	result := WarmResult{PieceCID: pieceCid.String()}
+	metadata, err := s.metadataCache.Get(ctx, s.dbNoContext, pieceCid)
+	if err != nil {
+		return result, errors.WithStack(err)
+	}
+	result.CachedCars = len(metadata)
+
+	if prefetchBytes <= 0 {
+		return result, nil
+	}
+
+	reader, _, err := s.findPiece(ctx, pieceCid)
+	if err != nil {
+		return result, errors.WithStack(err)
+	}
+	defer reader.Close()
+	result.PrefetchedBytes, err = io.Copy(io.Discard, io.LimitReader(reader, prefetchBytes))
+	if err != nil {
+		return result, errors.Wrap(err, "failed to prefetch piece")
+	}
+	return result, nil
+}
+
+// handleWarm is a method on the HTTPServer struct that handles HTTP requests to pre-warm the caches for a list of pieces.
+//
+// It is meant to be called ahead of an announced storage provider pulling window so that the first retrieval
+// requests do not suffer from cold start latency. For each piece, the block index is loaded into the piece metadata cache,
+// and optionally the first PrefetchBytes bytes of the piece are read from the storage.
+//
+// The response contains one WarmResult per requested piece. A failure to warm one piece does not fail the whole request.
+// The request is rejected if it lists more than MaxWarmPieces pieces, or if the bytes to prefetch across all pieces exceed
+// the configured maximum.
+// When the request is made with a retrieval token, the pieces that the token does not allow are not warmed.
+//
+// Parameters:
+//   - c: The Echo context for the HTTP request.
+//
+// Returns:
+//   - An error if there was a problem handling the request.
+func (s *HTTPServer) handleWarm(c echo.Context) error {
+	var request WarmRequest
+	err := c.Bind(&request)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed to parse request: "+err.Error())
+	}
+	if len(request.Pieces) > MaxWarmPieces {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("at most %d pieces can be warmed in one request", MaxWarmPieces))
+	}
+	if request.PrefetchBytes < 0 {
+		return c.String(http.StatusBadRequest, "prefetchBytes cannot be negative")
+	}
+	if len(request.Pieces) > 0 && request.PrefetchBytes > s.maxWarmPrefetch/int64(len(request.Pieces)) {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("at most %d bytes can be prefetched in one request", s.maxWarmPrefetch))
+	}
+
+	results := make([]WarmResult, 0, len(request.Pieces))
+	for _, piece := range request.Pieces {
+		pieceCid, err := cid.Parse(piece)
+		if err != nil {
+			results = append(results, WarmResult{PieceCID: piece, Error: "failed to parse piece CID: " + err.Error()})
+			continue
+		}
+		if token, ok := c.Get(retrievalTokenContextKey).(*model.RetrievalToken); ok {
+			err = retrievaltoken.AllowsPiece(s.dbNoContext.WithContext(c.Request().Context()), *token, pieceCid)
+			if err != nil {
+				results = append(results, WarmResult{PieceCID: piece, Error: err.Error()})
+				continue
+			}
+		}
+		result, err := s.warm(c.Request().Context(), pieceCid, request.PrefetchBytes)
+		if oserror.IsNotExist(err) {
+			result.Error = "piece not found"
+		} else if err != nil {
+			logger.Warnw("failed to warm piece", "piece", piece, "error", err)
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	return c.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,182 @@
+package contentprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/labstack/echo/v4"
+	"github.com/multiformats/go-varint"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestHTTPServerWarm(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		e := echo.New()
+		s := HTTPServer{
+			dbNoContext:     db,
+			bind:            ":0",
+			enablePiece:     true,
+			metadataCache:   NewPieceMetadataCache(time.Minute, DefaultPieceMetadataCacheCapacity),
+			maxWarmPrefetch: 256,
+		}
+
+		pieceCID := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("test")))
+		err := db.Create(&model.Car{
+			PieceCID:      model.CID(pieceCID),
+			PieceSize:     128,
+			FileSize:      59 + 1 + 36 + 5,
+			PreparationID: 1,
+			Attachment: &model.SourceAttachment{
+				Preparation: &model.Preparation{},
+				Storage: &model.Storage{
+					Type: "local",
+				},
+			},
+			RootCID: model.CID(testutil.TestCid),
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.CarBlock{
+			CarID:          1,
+			CID:            model.CID(testutil.TestCid),
+			CarOffset:      59,
+			CarBlockLength: 1 + 36 + 5,
+			Varint:         varint.ToUvarint(36 + 5),
+			RawBlock:       []byte("hello"),
+		}).Error
+		require.NoError(t, err)
+
+		notFound := cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("not_exist"))).String()
+		body := `{"pieces":["` + pieceCID.String() + `","` + notFound + `","invalid"],"prefetchBytes":64}`
+		req := httptest.NewRequest(http.MethodPost, "/piece/warm", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		err = s.handleWarm(c)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var results []WarmResult
+		err = json.Unmarshal(rec.Body.Bytes(), &results)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		require.Equal(t, 1, results[0].CachedCars)
+		require.EqualValues(t, 64, results[0].PrefetchedBytes)
+		require.Empty(t, results[0].Error)
+		require.Equal(t, "piece not found", results[1].Error)
+		require.Contains(t, results[2].Error, "failed to parse piece CID")
+
+		// The block index is now served from the cache
+		err = db.Where("id = ?", 1).Delete(&model.CarBlock{}).Error
+		require.NoError(t, err)
+		metadata, err := s.metadataCache.Get(ctx, db, pieceCID)
+		require.NoError(t, err)
+		require.Len(t, metadata, 1)
+		require.Len(t, metadata[0].CarBlocks, 1)
+	})
+}
+
+func TestHTTPServerWarmLimits(t *testing.T) {
+	e := echo.New()
+	s := HTTPServer{
+		enablePiece:     true,
+		metadataCache:   NewPieceMetadataCache(time.Minute, DefaultPieceMetadataCacheCapacity),
+		maxWarmPrefetch: 256,
+	}
+	warm := func(request WarmRequest) *httptest.ResponseRecorder {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/piece/warm", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, s.handleWarm(e.NewContext(req, rec)))
+		return rec
+	}
+
+	tooMany := make([]string, MaxWarmPieces+1)
+	for i := range tooMany {
+		tooMany[i] = "invalid"
+	}
+	rec := warm(WarmRequest{Pieces: tooMany})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "pieces can be warmed")
+
+	rec = warm(WarmRequest{Pieces: []string{"invalid"}, PrefetchBytes: -1})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// The limit applies to the total across all pieces
+	rec = warm(WarmRequest{Pieces: []string{"invalid", "invalid", "invalid"}, PrefetchBytes: 100})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "at most 256 bytes")
+
+	rec = warm(WarmRequest{Pieces: []string{"invalid", "invalid"}, PrefetchBytes: 128})
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestPieceMetadataCache(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			SourceStorages: []model.Storage{{Name: "source", Type: "local"}},
+		}).Error
+		require.NoError(t, err)
+
+		pieceCIDs := []cid.Cid{
+			cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("first"))),
+			cid.NewCidV1(cid.FilCommitmentUnsealed, util.Hash([]byte("second"))),
+		}
+		for _, pieceCID := range pieceCIDs {
+			err = db.Create(&model.Car{
+				PieceCID:      model.CID(pieceCID),
+				PieceSize:     128,
+				PreparationID: 1,
+				AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+				RootCID:       model.CID(testutil.TestCid),
+			}).Error
+			require.NoError(t, err)
+		}
+		// The first piece has a second car whose blob storage is missing
+		err = db.Create(&model.Car{
+			PieceCID:      model.CID(pieceCIDs[0]),
+			PieceSize:     128,
+			PreparationID: 1,
+			AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+			RootCID:       model.CID(testutil.TestCid),
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.CarBlock{
+			CarID:          3,
+			CID:            model.CID(testutil.TestCid),
+			CarOffset:      59,
+			CarBlockLength: 1 + 36 + 5,
+			Varint:         varint.ToUvarint(36 + 5),
+			BlobStorageID:  ptr.Of(model.StorageID(99)),
+		}).Error
+		require.NoError(t, err)
+
+		cache := NewPieceMetadataCache(time.Minute, 1)
+		cache.Start()
+		defer cache.Stop()
+
+		metadata, err := cache.Get(ctx, db, pieceCIDs[0])
+		require.NoError(t, err)
+		require.Len(t, metadata, 1)
+		require.EqualValues(t, 1, metadata[0].Car.ID)
+
+		// The least recently used piece is evicted once the capacity is reached
+		_, err = cache.Get(ctx, db, pieceCIDs[1])
+		require.NoError(t, err)
+		require.Equal(t, 1, cache.cache.Len())
+		require.Nil(t, cache.cache.Get(pieceCIDs[0]))
+	})
+}
@@ -0,0 +1,142 @@
+package datasetworker
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/scan"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
+	"github.com/data-preservation-programs/singularity/service/leaderelection"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const defaultCoordinatorInterval = 30 * time.Second
+
+type CoordinatorConfig struct {
+	Interval       time.Duration // How often the housekeeping runs
+	StuckThreshold time.Duration // How long a job can go without progress before it is released, or 0 to only release the jobs of dead workers
+}
+
+// Coordinator does the housekeeping of a pool of dataset workers that share the same database, so that the workers,
+// on any number of machines, only claim and process jobs. Only one coordinator is active at a time: it holds a lease,
+// and the other coordinators stand by and take over once the lease expires. Every interval, the active coordinator
+//   - removes the workers that stopped sending heartbeats, and releases their jobs and the jobs that have not made
+//     progress for the stuck threshold, so that idle workers pick them up;
+//   - seals the pack jobs that reached the max batch age of their preparation, so that they are packed.
+//
+// A dataset worker runs a coordinator by default, so a single worker does not need a separate one.
+type Coordinator struct {
+	id          uuid.UUID
+	dbNoContext *gorm.DB
+	logger      *zap.SugaredLogger
+	config      CoordinatorConfig
+}
+
+func NewCoordinator(db *gorm.DB, config CoordinatorConfig) *Coordinator {
+	if config.Interval <= 0 {
+		config.Interval = defaultCoordinatorInterval
+	}
+	id := uuid.New()
+	return &Coordinator{
+		id:          id,
+		dbNoContext: db,
+		logger:      logger.With("coordinatorID", id.String()),
+		config:      config,
+	}
+}
+
+func (c *Coordinator) Name() string {
+	return "Dataset Coordinator - " + c.id.String()
+}
+
+// Start runs the coordinator in the background until the context is done. See Run.
+func (c *Coordinator) Start(ctx context.Context, exitErr chan<- error) error {
+	go func() {
+		err := c.Run(ctx)
+		if exitErr != nil {
+			exitErr <- err
+		}
+	}()
+	return nil
+}
+
+// Run campaigns to become the active coordinator, then does the housekeeping every interval while it holds the lease.
+// If the lease is lost, i.e. because the database was unreachable for too long, it stands by again until it gets the
+// lease back, so Run only returns once the context is done.
+func (c *Coordinator) Run(ctx context.Context) error {
+	elector := leaderelection.NewElector(c.dbNoContext, string(model.DatasetCoordinator), c.id.String())
+	for {
+		err := elector.Campaign(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.WithStack(err)
+		}
+		_, err = healthcheck.Register(ctx, c.dbNoContext, c.id, model.DatasetCoordinator, true)
+		if err != nil {
+			return errors.Wrap(err, "failed to register coordinator")
+		}
+
+		leaderCtx, lost := elector.Hold(ctx)
+		healthcheckDone := make(chan struct{})
+		go func() {
+			defer close(healthcheckDone)
+			healthcheck.StartReportHealth(leaderCtx, c.dbNoContext, c.id, model.DatasetCoordinator)
+		}()
+
+		timer := time.NewTimer(0)
+		for leaderCtx.Err() == nil {
+			select {
+			case <-leaderCtx.Done():
+			case <-timer.C:
+				c.runOnce(leaderCtx)
+				timer.Reset(c.config.Interval)
+			}
+		}
+		timer.Stop()
+		<-healthcheckDone
+
+		ctxCleanup, cancelCleanup := context.WithTimeout(context.Background(), cleanupTimeout)
+		//nolint:contextcheck
+		err = database.DoRetry(ctxCleanup, func() error {
+			return c.dbNoContext.WithContext(ctxCleanup).Where("id = ?", c.id.String()).Delete(&model.Worker{}).Error
+		})
+		if err != nil {
+			c.logger.Errorw("failed to cleanup", "error", err)
+		}
+		//nolint:contextcheck
+		err = elector.Release(ctxCleanup)
+		if err != nil {
+			c.logger.Errorw("failed to release lease", "error", err)
+		}
+		cancelCleanup()
+
+		if ctx.Err() != nil {
+			c.logger.Info("coordinator stopped")
+			return nil
+		}
+		if lost() {
+			c.logger.Warnw("coordinator lease lost, standing by", "error", leaderelection.ErrLeadershipLost)
+		}
+	}
+}
+
+// runOnce releases the jobs of dead and stuck workers and seals the expired pack jobs. Failures are logged and the
+// housekeeping is retried in the next interval.
+func (c *Coordinator) runOnce(ctx context.Context) {
+	healthcheck.HealthCheckCleanup(ctx, c.dbNoContext, c.config.StuckThreshold)
+
+	sealed, err := scan.SealExpiredPackJobs(ctx, c.dbNoContext, time.Now())
+	if err != nil && !errors.Is(err, context.Canceled) {
+		c.logger.Errorw("failed to seal expired pack jobs", "error", err)
+	}
+	if sealed > 0 {
+		c.logger.Infow("sealed pack jobs that reached the maximum batch age", "count", sealed)
+	}
+}
@@ -0,0 +1,144 @@
+package datasetworker
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/google/uuid"
+	"github.com/gotidy/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestCoordinator_ReleasesAndSeals(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			MaxBatchAge:    time.Hour,
+			SourceStorages: []model.Storage{{Name: "source"}},
+		}).Error
+		require.NoError(t, err)
+		// A worker that stopped sending heartbeats on another machine, holding a job
+		deadWorker := model.Worker{ID: uuid.NewString(), LastHeartbeat: time.Now().UTC().Add(-time.Hour), Type: model.DatasetWorker}
+		require.NoError(t, db.Create(&deadWorker).Error)
+		orphaned := model.Job{AttachmentID: 1, Type: model.Pack, State: model.Processing, WorkerID: ptr.Of(deadWorker.ID)}
+		require.NoError(t, db.Create(&orphaned).Error)
+		// A pack job still being filled, older than the max batch age
+		filling := model.Job{AttachmentID: 1, Type: model.Pack, State: model.Created, CreatedAt: time.Now().Add(-2 * time.Hour)}
+		require.NoError(t, db.Create(&filling).Error)
+		require.NoError(t, db.Create(&model.FileRange{
+			JobID: &filling.ID,
+			File:  &model.File{AttachmentID: 1},
+		}).Error)
+
+		ctx, cancel := context.WithCancel(ctx)
+		coordinator := NewCoordinator(db, CoordinatorConfig{Interval: time.Hour})
+		done := make(chan error)
+		go func() { done <- coordinator.Run(ctx) }()
+		require.Eventually(t, func() bool {
+			var job model.Job
+			require.NoError(t, db.First(&job, filling.ID).Error)
+			return job.State == model.Ready
+		}, 10*time.Second, 100*time.Millisecond)
+
+		require.NoError(t, db.First(&orphaned, orphaned.ID).Error)
+		require.Equal(t, model.Ready, orphaned.State)
+		require.Nil(t, orphaned.WorkerID)
+		var workers []model.Worker
+		require.NoError(t, db.Find(&workers).Error)
+		require.Len(t, workers, 1)
+		require.Equal(t, model.DatasetCoordinator, workers[0].Type)
+
+		cancel()
+		require.NoError(t, <-done)
+		var count int64
+		require.NoError(t, db.Model(&model.Worker{}).Count(&count).Error)
+		require.Zero(t, count)
+		require.NoError(t, db.Model(&model.Lease{}).Count(&count).Error)
+		require.Zero(t, count)
+	})
+}
+
+func TestCoordinator_SingleActive(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			coordinator := NewCoordinator(db, CoordinatorConfig{Interval: time.Hour})
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, coordinator.Run(ctx))
+			}()
+		}
+		require.Eventually(t, func() bool {
+			var count int64
+			require.NoError(t, db.Model(&model.Worker{}).Count(&count).Error)
+			return count > 0
+		}, 10*time.Second, 100*time.Millisecond)
+		time.Sleep(500 * time.Millisecond)
+		var workers []model.Worker
+		require.NoError(t, db.Find(&workers).Error)
+		require.Len(t, workers, 1)
+		var lease model.Lease
+		require.NoError(t, db.First(&lease).Error)
+		require.Equal(t, workers[0].ID, lease.Holder)
+		cancel()
+		wg.Wait()
+	})
+}
+
+func TestFindJob_ConcurrentWorkers(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			SourceStorages: []model.Storage{{Name: "source"}},
+		}).Error
+		require.NoError(t, err)
+		const numJobs = 12
+		for i := 0; i < numJobs; i++ {
+			require.NoError(t, db.Create(&model.Job{AttachmentID: 1, Type: model.Scan, State: model.Ready}).Error)
+		}
+
+		// Threads of workers on different machines claim the jobs at the same time
+		var mu sync.Mutex
+		claimed := make(map[model.JobID]string)
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			thread := &Thread{
+				dbNoContext: db,
+				logger:      logger.With("test", true),
+				id:          uuid.New(),
+			}
+			_, err := healthcheck.Register(ctx, db, thread.id, model.DatasetWorker, true)
+			require.NoError(t, err)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					job, err := thread.findJob(ctx, []model.JobType{model.Scan})
+					if !assert.NoError(t, err) || job == nil {
+						return
+					}
+					mu.Lock()
+					_, ok := claimed[job.ID]
+					claimed[job.ID] = thread.id.String()
+					mu.Unlock()
+					assert.False(t, ok, "job %d claimed twice", job.ID)
+				}
+			}()
+		}
+		wg.Wait()
+		require.Len(t, claimed, numJobs)
+		for jobID, workerID := range claimed {
+			var job model.Job
+			require.NoError(t, db.First(&job, jobID).Error)
+			require.Equal(t, model.Processing, job.State)
+			require.Equal(t, workerID, *job.WorkerID)
+		}
+	})
+}
@@ -10,9 +10,13 @@ import (
 	"github.com/data-preservation-programs/singularity/database"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/pack"
+	"github.com/data-preservation-programs/singularity/pack/compression"
 	"github.com/data-preservation-programs/singularity/pack/daggen"
+	"github.com/data-preservation-programs/singularity/pack/encryption"
 	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
 	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/data-preservation-programs/singularity/store"
 	"github.com/data-preservation-programs/singularity/util"
 	commp "github.com/filecoin-project/go-fil-commp-hashhash"
 	"github.com/google/uuid"
@@ -58,6 +62,7 @@ func (d *DagGenerator) Read(p []byte) (int, error) {
 	if d.ctx.Err() != nil {
 		return 0, d.ctx.Err()
 	}
+	healthcheck.ReportProgress(d.ctx)
 	if d.buffer != nil {
 		n, err := d.buffer.Read(p)
 		if err == io.EOF {
@@ -198,20 +203,30 @@ func (w *Thread) ExportDag(ctx context.Context, job model.Job) error {
 
 	dagGenerator := NewDagGenerator(ctx, db, job.Attachment.ID, rootCID, job.Attachment.Preparation.NoInline)
 	defer dagGenerator.Close()
+	payload, wrappedKey, err := encryption.Encrypt(dagGenerator, job.Attachment.Preparation.PieceKeyRecipient)
+	if err != nil {
+		return errors.WithStack(err)
+	}
 
 	var filename string
 	calc := &commp.Calc{}
 	var pieceCid cid.Cid
 	var finalPieceSize uint64
 	var fileSize int64
+	carCompression := job.Attachment.Preparation.CarCompression
 	if storageWriter != nil {
-		reader := io.TeeReader(dagGenerator, calc)
-		filename = uuid.NewString() + ".car"
-		obj, err := storageWriter.Write(ctx, filename, reader)
+		compressed, err := compression.Compress(io.TeeReader(payload, calc), carCompression)
 		if err != nil {
 			return errors.WithStack(err)
 		}
-		fileSize = obj.Size()
+		defer compressed.Close()
+		extension := ".car" + compression.Extension(carCompression)
+		filename = uuid.NewString() + extension
+		obj, err := storageWriter.Write(ctx, filename, compressed)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		fileSize = compressed.Size()
 
 		if dagGenerator.offset <= 59 {
 			logger.Info("Nothing to export to dag. Skipping.")
@@ -222,15 +237,19 @@ func (w *Thread) ExportDag(ctx context.Context, job model.Job) error {
 		if err != nil {
 			return errors.WithStack(err)
 		}
-		_, err = storageWriter.Move(ctx, obj, pieceCid.String()+".car")
+		carPath, err := pack.CarPath(ctx, db, *job.Attachment.Preparation, pieceCid, extension)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = storageWriter.Move(ctx, obj, carPath)
 		if err != nil && !errors.Is(err, storagesystem.ErrMoveNotSupported) {
-			logger.Errorf("failed to move car file from %s to %s: %s", filename, pieceCid.String()+".car", err)
+			logger.Errorf("failed to move car file from %s to %s: %s", filename, carPath, err)
 		}
 		if err == nil {
-			filename = pieceCid.String() + ".car"
+			filename = carPath
 		}
 	} else {
-		fileSize, err = io.Copy(calc, dagGenerator)
+		fileSize, err = io.Copy(calc, payload)
 		if err != nil {
 			return errors.WithStack(err)
 		}
@@ -255,6 +274,22 @@ func (w *Thread) ExportDag(ctx context.Context, job model.Job) error {
 		StoragePath:   filename,
 		AttachmentID:  &job.AttachmentID,
 		PreparationID: job.Attachment.PreparationID,
+		WrappedKey:    wrappedKey,
+	}
+	if filename != "" {
+		car.Compression = carCompression
+	}
+
+	blobStorage := job.Attachment.Preparation.BlobStorage
+	if blobStorage != nil && len(dagGenerator.carBlocks) > 0 {
+		blobStore, err := store.NewBlobStore(ctx, *blobStorage)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		err = blobStore.Offload(ctx, dagGenerator.carBlocks)
+		if err != nil {
+			return errors.Wrap(err, "failed to offload raw blocks to blob storage")
+		}
 	}
 
 	err = database.DoRetry(ctx, func() error {
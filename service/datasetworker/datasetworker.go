@@ -11,6 +11,7 @@ import (
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/service"
 	"github.com/data-preservation-programs/singularity/service/healthcheck"
+	"github.com/data-preservation-programs/singularity/storagesystem"
 	"github.com/google/uuid"
 	"github.com/ipfs/go-log/v2"
 	"go.uber.org/zap"
@@ -38,6 +39,14 @@ type Config struct {
 	ExitOnError    bool
 	MinInterval    time.Duration
 	MaxInterval    time.Duration
+	Nice           int
+	IOPriority     string
+	HashingThreads int
+	StuckThreshold time.Duration
+	// SkipCommPValidation records generated pieces without validating their commP first
+	SkipCommPValidation bool
+	// DisableCoordinator stops the worker from running a coordinator, when the pool of workers has a dedicated one
+	DisableCoordinator bool
 }
 
 func NewWorker(db *gorm.DB, config Config) *Worker {
@@ -69,8 +78,8 @@ type Thread struct {
 // This function:
 //  1. Creates a cancellable context derived from the input context.
 //  2. Registers the worker with a health check service, providing a state function for reporting its status.
-//  3. Launches separate goroutines to report health status, clean up old health check records, execute the worker's task, and handle cleanup.
-//  4. Returns channels that are closed when the health reporting, health check cleanup, worker execution, and worker cleanup are complete.
+//  3. Launches separate goroutines to report health status, execute the worker's task, and handle cleanup.
+//  4. Returns channels that are closed when the health reporting, worker execution, and worker cleanup are complete.
 //
 // Parameters:
 //
@@ -98,13 +107,6 @@ func (w *Thread) Start(ctx context.Context, exitErr chan<- error) error {
 		w.logger.Info("health report stopped")
 	}()
 
-	healthcheckCleanupDone := make(chan struct{})
-	go func() {
-		defer close(healthcheckCleanupDone)
-		healthcheck.StartHealthCheckCleanup(ctx, w.dbNoContext)
-		w.logger.Info("healthcheck cleanup stopped")
-	}()
-
 	go func() {
 		err := w.run(ctx)
 		if exitErr != nil {
@@ -127,7 +129,6 @@ func (w *Thread) Start(ctx context.Context, exitErr chan<- error) error {
 
 		// Wait for components to end.
 		<-healthcheckDone
-		<-healthcheckCleanupDone
 
 		w.logger.Info("worker thread finished")
 	}()
@@ -147,9 +148,12 @@ func (w *Thread) cleanup(ctx context.Context) error {
 
 // Run initializes and starts a set of worker threads based on the Concurrency specified in the configuration.
 // This function:
-//  1. Creates an array of worker threads, each having a unique identifier.
-//  2. Initializes each thread with a shared set of dependencies (e.g., database, logger) and individual configuration.
-//  3. Invokes the StartServers function to run all the threads, passing the initialized threads and a logger.
+//  1. Applies the CPU and IO priority and the cap on hashing threads from the configuration.
+//  2. Creates an array of worker threads, each having a unique identifier.
+//  3. Initializes each thread with a shared set of dependencies (e.g., database, logger) and individual configuration.
+//  4. Unless DisableCoordinator is set, runs a Coordinator next to the threads, which only becomes active if no other
+//     coordinator of the pool is.
+//  5. Invokes the StartServers function to run all the threads, passing the initialized threads and a logger.
 //
 // Parameters:
 //
@@ -157,11 +161,16 @@ func (w *Thread) cleanup(ctx context.Context) error {
 //
 // Returns:
 //
-//   - error : An error is returned if the StartServers function encounters an issue while starting the threads. Otherwise, it returns nil.
+//   - error : An error is returned if the priority cannot be applied, or the StartServers function encounters an issue while starting the threads. Otherwise, it returns nil.
 func (w Worker) Run(ctx context.Context) error {
+	err := applyPriority(w.config)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
-	err := analytics.Init(ctx, w.dbNoContext)
+	err = analytics.Init(ctx, w.dbNoContext)
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -174,6 +183,8 @@ func (w Worker) Run(ctx context.Context) error {
 		analytics.Default.Flush()
 	}()
 
+	go analytics.StartTelemetry(ctx, w.dbNoContext)
+
 	threads := make([]service.Server, w.config.Concurrency)
 	for i := 0; i < w.config.Concurrency; i++ {
 		id := uuid.New()
@@ -186,10 +197,24 @@ func (w Worker) Run(ctx context.Context) error {
 		}
 		threads[i] = thread
 	}
+	coordinatorDone := make(chan struct{})
+	if w.config.DisableCoordinator {
+		close(coordinatorDone)
+	} else {
+		coordinator := NewCoordinator(w.dbNoContext, CoordinatorConfig{StuckThreshold: w.config.StuckThreshold})
+		go func() {
+			defer close(coordinatorDone)
+			err := coordinator.Run(ctx)
+			if err != nil {
+				logger.Errorw("coordinator failed", "error", err)
+			}
+		}()
+	}
 	w.stateMonitor.Start(ctx)
 	err = service.StartServers(ctx, logger, threads...)
 	cancel()
 	<-w.stateMonitor.Done()
+	<-coordinatorDone
 	<-eventsFlushed
 	return errors.WithStack(err)
 }
@@ -210,6 +235,10 @@ func (w *Thread) handleWorkComplete(ctx context.Context, jobID model.JobID) erro
 }
 
 func (w *Thread) handleWorkError(ctx context.Context, jobID model.JobID, err error) error {
+	var authErr *storagesystem.AuthError
+	if errors.As(err, &authErr) {
+		return w.handleAuthError(ctx, jobID, authErr, err)
+	}
 	updates := make(map[string]any)
 	updates["worker_id"] = nil
 	// Reset the state to ready if the context was canceled
@@ -231,6 +260,31 @@ func (w *Thread) handleWorkError(ctx context.Context, jobID model.JobID, err err
 	})
 }
 
+// handleAuthError flags the storage whose credentials are rejected as needing re-authentication, so the jobs that use
+// it are held instead of failing one after the other. The job is put back to ready with the error, and is picked up
+// again once the storage is re-authenticated with "singularity storage reauth".
+func (w *Thread) handleAuthError(ctx context.Context, jobID model.JobID, authErr *storagesystem.AuthError, workErr error) error {
+	w.logger.Warnw("storage needs to be re-authenticated, holding the jobs that use it",
+		"storage", authErr.Storage, "jobID", jobID, "error", authErr.Err)
+	return database.DoRetry(ctx, func() error {
+		return w.dbNoContext.WithContext(ctx).Transaction(func(db *gorm.DB) error {
+			err := db.Model(&model.Storage{}).Where("id = ?", authErr.StorageID).Updates(map[string]any{
+				"needs_reauth": true,
+				"auth_error":   authErr.Err.Error(),
+			}).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return db.Model(&model.Job{}).Where("id = ?", jobID).Updates(map[string]any{
+				"worker_id":         nil,
+				"error_message":     workErr.Error(),
+				"error_stack_trace": fmt.Sprintf("%+v", workErr),
+				"state":             model.Ready,
+			}).Error
+		})
+	})
+}
+
 // run is the core loop that a Thread executes when started.
 // It continually looks for work to process, handles errors, and reports updates:
 //  1. It attempts to find work to do. The types of work are defined by WorkType enumeration (e.g., Scan, Pack, Dag).
@@ -270,6 +324,8 @@ func (w *Thread) run(ctx context.Context) (retErr error) {
 	interval := w.config.MinInterval
 	for {
 		workCtx, workCancel := context.WithCancel(ctx)
+		progress := healthcheck.NewProgress()
+		workCtx = healthcheck.WithProgress(workCtx, progress)
 		job, err := w.findJob(ctx, jobTypes)
 		if err != nil {
 			workCancel()
@@ -287,7 +343,7 @@ func (w *Thread) run(ctx context.Context) (retErr error) {
 			goto loop
 		}
 
-		w.stateMonitor.AddJob(job.ID, workCancel)
+		w.stateMonitor.AddJob(job.ID, w.id.String(), progress, workCancel)
 		switch job.Type {
 		case model.Scan:
 			err = w.scan(workCtx, *job.Attachment)
@@ -3,17 +3,24 @@ package datasetworker
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/database"
 	"github.com/data-preservation-programs/singularity/model"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // findJob searches for a Job from the database based on the ordered list of job types provided.
 // It iterates through the typesOrdered list, and for each type, it attempts to find a Job of that type which is
 // either Ready or is marked as Processing but hasn't been claimed by any worker yet. Once a suitable Job is found,
-// it marks that Job as being processed by the current worker thread.
+// it marks that Job as being processed by the current worker thread. The jobs of paused preparations, and the jobs that
+// use a storage that needs to be re-authenticated, are skipped.
+//
+// Workers on any number of machines may search for jobs in the same database at the same time. With Postgres and
+// MySQL, the row of the job is locked while it is claimed, and the rows locked by other workers are skipped, so the
+// workers claim different jobs without waiting for each other. SQLite serializes the transactions instead.
 //
 // Parameters:
 //   - ctx: The context which controls the lifetime of the operation.
@@ -25,45 +32,62 @@ import (
 func (w *Thread) findJob(ctx context.Context, typesOrdered []model.JobType) (*model.Job, error) {
 	db := w.dbNoContext.WithContext(ctx)
 
-	txOpts := &sql.TxOptions{
-		Isolation: sql.LevelSerializable,
+	lockRows := db.Dialector.Name() != "sqlite"
+	var txOpts *sql.TxOptions
+	if !lockRows {
+		txOpts = &sql.TxOptions{
+			Isolation: sql.LevelSerializable,
+		}
 	}
-	var job model.Job
+	var jobID model.JobID
 	for _, jobType := range typesOrdered {
 		err := database.DoRetry(ctx, func() error {
 			return db.Transaction(func(db *gorm.DB) error {
-				err := db.Preload("Attachment.Preparation.OutputStorages").Preload("Attachment.Storage").
-					Where("type = ? AND state = ? OR (state = ? AND worker_id is null)", jobType, model.Ready, model.Processing).
-					First(&job).Error
+				query := model.ClaimableJobs(db).Select("id").
+					Where("(type = ? AND state = ? OR (state = ? AND worker_id is null))", jobType, model.Ready, model.Processing)
+				if lockRows {
+					query = query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+				}
+				var candidate model.Job
+				err := query.First(&candidate).Error
 				if err != nil {
 					if errors.Is(err, gorm.ErrRecordNotFound) {
-						job.ID = 0
+						jobID = 0
 						return nil
 					}
 					return errors.WithStack(err)
 				}
 
-				return db.Model(&job).
+				jobID = candidate.ID
+				return db.Model(&model.Job{}).Where("id = ?", candidate.ID).
 					Updates(map[string]any{
 						"state":         model.Processing,
 						"worker_id":     w.id,
 						"error_message": "",
+						"progress_at":   time.Now().UTC(),
 					}).Error
 			}, txOpts)
 		})
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
-		if job.ID != 0 {
+		if jobID != 0 {
 			break
 		}
 	}
 
-	if job.ID == 0 {
+	if jobID == 0 {
 		//nolint: nilnil
 		return nil, nil
 	}
 
+	var job model.Job
+	err := db.Preload("Attachment.Preparation.OutputStorages").Preload("Attachment.Preparation.BlobStorage").Preload("Attachment.Storage").
+		First(&job, jobID).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
 	w.logger.Debugw("found job", "jobID", job.ID, "jobType", job.Type, "workerID", w.id)
 
 	if job.Type == model.Pack {
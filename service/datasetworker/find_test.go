@@ -4,8 +4,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/service/healthcheck"
+	"github.com/data-preservation-programs/singularity/storagesystem"
 	"github.com/data-preservation-programs/singularity/util/testutil"
 	"github.com/google/uuid"
 	"github.com/gotidy/ptr"
@@ -67,3 +69,96 @@ func TestFindPackWork(t *testing.T) {
 		require.Equal(t, thread.id.String(), *existing.WorkerID)
 	})
 }
+
+func TestFindJob_PausedPreparation(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		thread := &Thread{
+			dbNoContext: db,
+			config: Config{
+				EnablePack: true,
+			},
+			logger: logger.With("test", true),
+			id:     uuid.New(),
+		}
+
+		_, err := healthcheck.Register(ctx, thread.dbNoContext, thread.id, model.DatasetWorker, true)
+		require.NoError(t, err)
+		err = db.Create(&model.Preparation{
+			Paused: true,
+			SourceStorages: []model.Storage{{
+				Name: "source",
+			}},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Job{
+			AttachmentID: 1,
+			State:        model.Ready,
+			Type:         model.Pack,
+		}).Error
+		require.NoError(t, err)
+
+		found, err := thread.findJob(ctx, []model.JobType{model.Pack})
+		require.NoError(t, err)
+		require.Nil(t, found)
+
+		err = db.Model(&model.Preparation{}).Where("id = ?", 1).Update("paused", false).Error
+		require.NoError(t, err)
+		found, err = thread.findJob(ctx, []model.JobType{model.Pack})
+		require.NoError(t, err)
+		require.NotNil(t, found)
+	})
+}
+
+func TestFindJob_StorageNeedsReauth(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		thread := &Thread{
+			dbNoContext: db,
+			config: Config{
+				EnablePack: true,
+			},
+			logger: logger.With("test", true),
+			id:     uuid.New(),
+		}
+
+		_, err := healthcheck.Register(ctx, thread.dbNoContext, thread.id, model.DatasetWorker, true)
+		require.NoError(t, err)
+		err = db.Create(&model.Preparation{
+			SourceStorages: []model.Storage{{
+				Name: "source",
+			}},
+			OutputStorages: []model.Storage{{
+				Name: "output",
+			}},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Job{
+			AttachmentID: 1,
+			State:        model.Ready,
+			Type:         model.Pack,
+		}).Error
+		require.NoError(t, err)
+
+		// The job is held while its source or output storage needs to be re-authenticated
+		authErr := &storagesystem.AuthError{StorageID: 2, Storage: "output", Err: errors.New("401 Unauthorized")}
+		err = thread.handleWorkError(ctx, 1, errors.Wrap(authErr, "failed to write CAR file"))
+		require.NoError(t, err)
+		var storage model.Storage
+		require.NoError(t, db.First(&storage, 2).Error)
+		require.True(t, storage.NeedsReauth)
+		require.Equal(t, "401 Unauthorized", storage.AuthError)
+		var job model.Job
+		require.NoError(t, db.First(&job, 1).Error)
+		require.Equal(t, model.Ready, job.State)
+		require.Contains(t, job.ErrorMessage, "storage output needs to be re-authenticated")
+
+		found, err := thread.findJob(ctx, []model.JobType{model.Pack})
+		require.NoError(t, err)
+		require.Nil(t, found)
+
+		err = db.Model(&model.Storage{}).Where("id = ?", 2).Update("needs_reauth", false).Error
+		require.NoError(t, err)
+		found, err = thread.findJob(ctx, []model.JobType{model.Pack})
+		require.NoError(t, err)
+		require.NotNil(t, found)
+	})
+}
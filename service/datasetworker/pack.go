@@ -6,15 +6,75 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/pack"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
 )
 
+// pack packs the job into a piece and, unless commP validation is skipped, validates its commP. A piece that fails
+// validation is quarantined and the job is packed once more. What a mismatch points at depends on how the piece was validated:
+// reading the CAR file back from the output storage catches the output storage corrupting it, while regenerating the
+// piece of an inline preparation from the source catches the source changing.
 func (w *Thread) pack(
 	ctx context.Context, job model.Job,
 ) error {
-	_, err := pack.Pack(ctx, w.dbNoContext, job)
-	if err != nil {
+	if w.config.SkipCommPValidation {
+		car, err := pack.Pack(ctx, w.dbNoContext, job)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		w.addBytesPacked(ctx, car)
+		return nil
+	}
+
+	car, err := pack.PackAndValidate(ctx, w.dbNoContext, job)
+	var mismatch pack.CommPMismatchError
+	if err == nil {
+		w.addBytesPacked(ctx, car)
+		return nil
+	}
+	if !errors.As(err, &mismatch) {
 		return errors.WithStack(err)
 	}
+	w.logger.Warnw("generated piece failed commP validation, packing it again",
+		"jobID", job.ID, "from", string(mismatch.From), "expected", mismatch.Expected.String(),
+		"actual", mismatch.Actual.String(), "quarantinePath", mismatch.QuarantinePath)
 
+	car, err = pack.PackAndValidate(ctx, w.dbNoContext, job)
+	if errors.As(err, &mismatch) {
+		cause := mismatchCause(mismatch.From, true)
+		w.logger.Errorw("generated piece failed commP validation again, "+cause,
+			"jobID", job.ID, "from", string(mismatch.From), "expected", mismatch.Expected.String(),
+			"actual", mismatch.Actual.String(), "quarantinePath", mismatch.QuarantinePath)
+		return errors.Wrap(err, "piece failed commP validation twice, "+cause)
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	w.logger.Infow("piece passed commP validation when packed again, "+mismatchCause(mismatch.From, false),
+		"jobID", job.ID, "pieceCID", car.PieceCID.String())
+	w.addBytesPacked(ctx, car)
 	return nil
 }
+
+// mismatchCause describes the likely cause of a commP mismatch, depending on where the piece was read from and
+// whether packing it again also failed validation.
+func mismatchCause(from pack.CommPSource, repeated bool) string {
+	switch {
+	case from == pack.CommPFromOutputStorage && repeated:
+		return "the output storage is likely corrupting the CAR files written to it"
+	case from == pack.CommPFromOutputStorage:
+		return "the first CAR file was likely corrupted while being written to or read back from the output storage"
+	case repeated:
+		return "the source has likely changed"
+	default:
+		return "the first read of the source was likely corrupted"
+	}
+}
+
+// addBytesPacked adds the size of the packed CAR file to the throughput of the worker. A failure only skews the
+// throughput, so it is logged rather than failing the job.
+func (w *Thread) addBytesPacked(ctx context.Context, car *model.Car) {
+	err := healthcheck.AddBytesPacked(ctx, w.dbNoContext, w.id, car.FileSize)
+	if err != nil {
+		w.logger.Warnw("failed to record the bytes packed by the worker", "error", err)
+	}
+}
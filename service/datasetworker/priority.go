@@ -0,0 +1,92 @@
+package datasetworker
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/pack"
+)
+
+var ErrInvalidPriority = errors.New("invalid CPU or IO priority")
+
+var ErrPriorityNotSupported = errors.New("CPU and IO priority are only supported on Linux")
+
+const (
+	ioClassBestEffort = 2
+	ioClassIdle       = 3
+
+	defaultIOLevel = 4
+	maxIOLevel     = 7
+)
+
+// ioPriority is an IO scheduling class and level, as set by ionice. The zero value keeps the current IO priority.
+type ioPriority struct {
+	class int
+	level int
+}
+
+// parseIOPriority parses an IO priority given as "idle", "best-effort" or "best-effort:<level>", where the level ranges
+// from 0 (highest priority) to 7 (lowest priority).
+func parseIOPriority(value string) (ioPriority, error) {
+	class, level, hasLevel := strings.Cut(value, ":")
+	switch class {
+	case "":
+		if hasLevel {
+			break
+		}
+		return ioPriority{}, nil
+	case "idle":
+		if hasLevel {
+			break
+		}
+		return ioPriority{class: ioClassIdle}, nil
+	case "best-effort":
+		if !hasLevel {
+			return ioPriority{class: ioClassBestEffort, level: defaultIOLevel}, nil
+		}
+		l, err := strconv.Atoi(level)
+		if err != nil || l < 0 || l > maxIOLevel {
+			return ioPriority{}, errors.Wrapf(ErrInvalidPriority, "IO priority level %q must be between 0 and %d", level, maxIOLevel)
+		}
+		return ioPriority{class: ioClassBestEffort, level: l}, nil
+	}
+	return ioPriority{}, errors.Wrapf(ErrInvalidPriority, "IO priority %q must be idle, best-effort or best-effort:<level>", value)
+}
+
+// applyPriority lowers the CPU and IO priority of the process and caps the number of goroutines hashing data, so that
+// packing can share a host with latency-sensitive services.
+//
+// Parameters:
+//   - config: The worker config. Zero values of Nice, IOPriority and HashingThreads keep the current settings.
+//
+// Returns:
+//   - An error if the config is invalid, or the priority cannot be applied, i.e. on platforms other than Linux.
+func applyPriority(config Config) error {
+	if config.Nice < -20 || config.Nice > 19 {
+		return errors.Wrapf(ErrInvalidPriority, "niceness %d must be between -20 and 19", config.Nice)
+	}
+	if config.HashingThreads < 0 {
+		return errors.Wrapf(ErrInvalidPriority, "number of hashing threads %d must not be negative", config.HashingThreads)
+	}
+	io, err := parseIOPriority(config.IOPriority)
+	if err != nil {
+		return err
+	}
+
+	if config.HashingThreads > 0 {
+		// Only the hashing is bounded, so the API, the health checks and the IO of the worker are not starved of
+		// threads while it packs.
+		pack.SetHashingThreads(config.HashingThreads)
+		logger.Infof("limited the worker to %d hashing threads", config.HashingThreads)
+	}
+	if config.Nice == 0 && io.class == 0 {
+		return nil
+	}
+	err = setPriority(config.Nice, io)
+	if err != nil {
+		return err
+	}
+	logger.Infof("set the worker niceness to %d and IO priority to %q", config.Nice, config.IOPriority)
+	return nil
+}
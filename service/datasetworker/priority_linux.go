@@ -0,0 +1,63 @@
+//go:build linux
+
+package datasetworker
+
+import (
+	"os"
+	"strconv"
+	"syscall"
+
+	"github.com/cockroachdb/errors"
+)
+
+const ioprioWhoProcess = 1
+
+// setPriority sets the niceness and the IO priority of every thread of the process. On Linux, both are attributes of a
+// thread rather than of the process, and new threads inherit them from the thread that creates them. The threads are
+// listed again until no new thread shows up, so that threads created concurrently are not missed.
+func setPriority(nice int, io ioPriority) error {
+	done := make(map[int]bool)
+	for {
+		entries, err := os.ReadDir("/proc/self/task")
+		if err != nil {
+			return errors.Wrap(err, "failed to list the threads of the process")
+		}
+		updated := false
+		for _, entry := range entries {
+			tid, err := strconv.Atoi(entry.Name())
+			if err != nil || done[tid] {
+				continue
+			}
+			done[tid] = true
+			updated = true
+			err = setThreadPriority(tid, nice, io)
+			if errors.Is(err, syscall.ESRCH) {
+				// The thread has exited in the meantime.
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if !updated {
+			return nil
+		}
+	}
+}
+
+func setThreadPriority(tid int, nice int, io ioPriority) error {
+	if nice != 0 {
+		err := syscall.Setpriority(syscall.PRIO_PROCESS, tid, nice)
+		if err != nil {
+			return errors.Wrapf(err, "failed to set the niceness of thread %d to %d", tid, nice)
+		}
+	}
+	if io.class != 0 {
+		value := io.class<<13 | io.level
+		_, _, errno := syscall.Syscall(syscall.SYS_IOPRIO_SET, ioprioWhoProcess, uintptr(tid), uintptr(value))
+		if errno != 0 {
+			return errors.Wrapf(errno, "failed to set the IO priority of thread %d", tid)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+//go:build !linux
+
+package datasetworker
+
+func setPriority(int, ioPriority) error {
+	return ErrPriorityNotSupported
+}
@@ -0,0 +1,51 @@
+package datasetworker
+
+import (
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/pack"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIOPriority(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected ioPriority
+	}{
+		{"", ioPriority{}},
+		{"idle", ioPriority{class: ioClassIdle}},
+		{"best-effort", ioPriority{class: ioClassBestEffort, level: defaultIOLevel}},
+		{"best-effort:0", ioPriority{class: ioClassBestEffort}},
+		{"best-effort:7", ioPriority{class: ioClassBestEffort, level: 7}},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			priority, err := parseIOPriority(test.value)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, priority)
+		})
+	}
+
+	for _, value := range []string{"realtime", "idle:1", "best-effort:8", "best-effort:x", ":1"} {
+		t.Run(value, func(t *testing.T) {
+			_, err := parseIOPriority(value)
+			require.ErrorIs(t, err, ErrInvalidPriority)
+		})
+	}
+}
+
+func TestApplyPriority(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		require.ErrorIs(t, applyPriority(Config{Nice: 20}), ErrInvalidPriority)
+		require.ErrorIs(t, applyPriority(Config{HashingThreads: -1}), ErrInvalidPriority)
+		require.ErrorIs(t, applyPriority(Config{IOPriority: "fast"}), ErrInvalidPriority)
+	})
+	t.Run("unchanged", func(t *testing.T) {
+		require.NoError(t, applyPriority(Config{}))
+	})
+	t.Run("hashing threads", func(t *testing.T) {
+		defer pack.SetHashingThreads(pack.HashingThreads())
+		require.NoError(t, applyPriority(Config{HashingThreads: 1}))
+		require.Equal(t, 1, pack.HashingThreads())
+	})
+}
@@ -5,31 +5,53 @@ import (
 	"sync"
 	"time"
 
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
 	"gorm.io/gorm"
 )
 
 const jobCheckInterval = 5 * time.Second
 
+// progressReportInterval is how often the progress of a job is written to the database at most
+const progressReportInterval = time.Minute
+
 func NewStateMonitor(db *gorm.DB) *StateMonitor {
 	return &StateMonitor{
 		db:   db,
-		jobs: make(map[model.JobID]context.CancelFunc),
+		jobs: make(map[model.JobID]*monitoredJob),
 		done: make(chan struct{}),
 	}
 }
 
 type StateMonitor struct {
 	db   *gorm.DB
-	jobs map[model.JobID]context.CancelFunc
+	jobs map[model.JobID]*monitoredJob
 	mu   sync.Mutex
 	done chan struct{}
 }
 
-func (s *StateMonitor) AddJob(jobID model.JobID, cancel context.CancelFunc) {
+// monitoredJob is a job being worked on by one of the worker threads.
+type monitoredJob struct {
+	workerID   string
+	cancel     context.CancelFunc
+	progress   *healthcheck.Progress
+	reportedAt time.Time
+}
+
+// AddJob starts monitoring a job that the worker thread with the given ID works on. The job is cancelled once it is
+// paused or no longer held by the worker, and its progress is written to the database periodically, so that the job
+// is not released as stuck while it makes progress.
+func (s *StateMonitor) AddJob(jobID model.JobID, workerID string, progress *healthcheck.Progress, cancel context.CancelFunc) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.jobs[jobID] = cancel
+	s.jobs[jobID] = &monitoredJob{
+		workerID:   workerID,
+		cancel:     cancel,
+		progress:   progress,
+		reportedAt: progress.Last(),
+	}
 }
 
 func (s *StateMonitor) RemoveJob(jobID model.JobID) {
@@ -39,7 +61,6 @@ func (s *StateMonitor) RemoveJob(jobID model.JobID) {
 }
 
 func (s *StateMonitor) Start(ctx context.Context) {
-	db := s.db.WithContext(ctx)
 	go func() {
 		defer close(s.done)
 		var timer *time.Timer
@@ -55,18 +76,26 @@ func (s *StateMonitor) Start(ctx context.Context) {
 
 			var jobs []model.Job
 			if len(jobIDs) > 0 {
-				err := db.Where("state = ?", model.Paused).Find(&jobs, jobIDs).Error
+				s.reportProgress(ctx)
+				scanJobs, err := s.stopPausedScans(ctx, jobIDs)
+				if err != nil {
+					logger.Errorf("failed to stop scans of paused preparations: %v", err)
+				}
+				jobs = append(jobs, scanJobs...)
+				// Paused jobs and jobs released by the healthcheck cleanup are no longer held by the worker
+				releasedJobs, err := s.releasedJobs(ctx, jobIDs)
 				if err != nil {
-					logger.Errorf("failed to fetch paused jobs: %v", err)
+					logger.Errorf("failed to fetch released jobs: %v", err)
 				}
+				jobs = append(jobs, releasedJobs...)
 			}
 
 			s.mu.Lock()
 			for _, job := range jobs {
 				jobID := job.ID
-				cancel, ok := s.jobs[jobID]
+				monitored, ok := s.jobs[jobID]
 				if ok {
-					cancel()
+					monitored.cancel()
 					delete(s.jobs, jobID)
 				}
 			}
@@ -87,6 +116,90 @@ func (s *StateMonitor) Start(ctx context.Context) {
 	}()
 }
 
+// stopPausedScans resets the running scan jobs of paused preparations to ready, so they can be cancelled and start
+// over once the preparation is resumed. The pack and daggen jobs of paused preparations are left to finish.
+func (s *StateMonitor) stopPausedScans(ctx context.Context, jobIDs []model.JobID) ([]model.Job, error) {
+	db := s.db.WithContext(ctx)
+	var jobs []model.Job
+	err := db.Where("type = ? AND state = ? AND attachment_id IN (?)", model.Scan, model.Processing, model.PausedAttachments(db)).
+		Find(&jobs, jobIDs).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	ids := make([]model.JobID, len(jobs))
+	for i, job := range jobs {
+		ids[i] = job.ID
+	}
+	err = database.DoRetry(ctx, func() error {
+		return db.Model(&model.Job{}).Where("id IN ? AND state = ?", ids, model.Processing).Updates(map[string]any{
+			"state":     model.Ready,
+			"worker_id": nil,
+		}).Error
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return jobs, nil
+}
+
+// releasedJobs returns the monitored jobs that are no longer being processed by the worker thread that works on them,
+// because they have been paused, or released by the healthcheck cleanup and possibly picked up by another worker.
+func (s *StateMonitor) releasedJobs(ctx context.Context, jobIDs []model.JobID) ([]model.Job, error) {
+	var jobs []model.Job
+	err := s.db.WithContext(ctx).Select("id", "state", "worker_id").Find(&jobs, jobIDs).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var released []model.Job
+	for _, job := range jobs {
+		monitored, ok := s.jobs[job.ID]
+		if !ok {
+			continue
+		}
+		if job.State != model.Processing || job.WorkerID == nil || *job.WorkerID != monitored.workerID {
+			released = append(released, job)
+		}
+	}
+	return released, nil
+}
+
+// reportProgress writes the last progress of the monitored jobs to the database, at most once per
+// progressReportInterval for each job.
+func (s *StateMonitor) reportProgress(ctx context.Context) {
+	type progressUpdate struct {
+		jobID    model.JobID
+		workerID string
+		last     time.Time
+	}
+	var updates []progressUpdate
+	s.mu.Lock()
+	for jobID, monitored := range s.jobs {
+		last := monitored.progress.Last()
+		if last.Sub(monitored.reportedAt) < progressReportInterval {
+			continue
+		}
+		monitored.reportedAt = last
+		updates = append(updates, progressUpdate{jobID: jobID, workerID: monitored.workerID, last: last})
+	}
+	s.mu.Unlock()
+
+	db := s.db.WithContext(ctx)
+	for _, update := range updates {
+		err := database.DoRetry(ctx, func() error {
+			return db.Model(&model.Job{}).Where("id = ? AND worker_id = ?", update.jobID, update.workerID).
+				Update("progress_at", update.last).Error
+		})
+		if err != nil {
+			logger.Errorw("failed to report job progress", "jobID", update.jobID, "error", err)
+		}
+	}
+}
+
 func (s *StateMonitor) Done() <-chan struct{} {
 	return s.done
 }
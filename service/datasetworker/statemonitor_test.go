@@ -0,0 +1,107 @@
+package datasetworker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestStateMonitor_StopPausedScans(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create([]model.Preparation{
+			{Name: "paused", Paused: true, SourceStorages: []model.Storage{{Name: "source1"}}},
+			{Name: "active", SourceStorages: []model.Storage{{Name: "source2"}}},
+		}).Error
+		require.NoError(t, err)
+		jobs := []model.Job{
+			{AttachmentID: 1, State: model.Processing, Type: model.Scan},
+			{AttachmentID: 1, State: model.Processing, Type: model.Pack},
+			{AttachmentID: 2, State: model.Processing, Type: model.Scan},
+		}
+		err = db.Create(&jobs).Error
+		require.NoError(t, err)
+
+		monitor := NewStateMonitor(db)
+		stopped, err := monitor.stopPausedScans(ctx, []model.JobID{jobs[0].ID, jobs[1].ID, jobs[2].ID})
+		require.NoError(t, err)
+		require.Len(t, stopped, 1)
+		require.Equal(t, jobs[0].ID, stopped[0].ID)
+
+		var states []model.JobState
+		err = db.Model(&model.Job{}).Order("id").Pluck("state", &states).Error
+		require.NoError(t, err)
+		require.Equal(t, []model.JobState{model.Ready, model.Processing, model.Processing}, states)
+	})
+}
+
+func TestStateMonitor_ReleasedJobs(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			SourceStorages: []model.Storage{{Name: "source"}},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create([]model.Worker{{ID: "1"}, {ID: "2"}}).Error
+		require.NoError(t, err)
+		jobs := []model.Job{
+			{AttachmentID: 1, State: model.Processing, Type: model.Pack, WorkerID: ptr.Of("1")},
+			{AttachmentID: 1, State: model.Ready, Type: model.Pack},
+			{AttachmentID: 1, State: model.Processing, Type: model.Pack, WorkerID: ptr.Of("2")},
+			{AttachmentID: 1, State: model.Paused, Type: model.Pack, WorkerID: ptr.Of("1")},
+		}
+		err = db.Create(&jobs).Error
+		require.NoError(t, err)
+
+		monitor := NewStateMonitor(db)
+		var jobIDs []model.JobID
+		for _, job := range jobs {
+			monitor.AddJob(job.ID, "1", healthcheck.NewProgress(), func() {})
+			jobIDs = append(jobIDs, job.ID)
+		}
+		released, err := monitor.releasedJobs(ctx, jobIDs)
+		require.NoError(t, err)
+		var releasedIDs []model.JobID
+		for _, job := range released {
+			releasedIDs = append(releasedIDs, job.ID)
+		}
+		require.ElementsMatch(t, []model.JobID{jobs[1].ID, jobs[2].ID, jobs[3].ID}, releasedIDs)
+	})
+}
+
+func TestStateMonitor_ReportProgress(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			SourceStorages: []model.Storage{{Name: "source"}},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Worker{ID: "1"}).Error
+		require.NoError(t, err)
+		job := model.Job{AttachmentID: 1, State: model.Processing, Type: model.Pack, WorkerID: ptr.Of("1")}
+		err = db.Create(&job).Error
+		require.NoError(t, err)
+
+		monitor := NewStateMonitor(db)
+		progress := healthcheck.NewProgress()
+		monitor.AddJob(job.ID, "1", progress, func() {})
+
+		// Progress is not written until the report interval has passed
+		monitor.reportProgress(ctx)
+		err = db.First(&job, job.ID).Error
+		require.NoError(t, err)
+		require.Nil(t, job.ProgressAt)
+
+		monitor.jobs[job.ID].reportedAt = time.Now().Add(-2 * progressReportInterval)
+		progress.Report()
+		monitor.reportProgress(ctx)
+		err = db.First(&job, job.ID).Error
+		require.NoError(t, err)
+		require.NotNil(t, job.ProgressAt)
+		require.WithinDuration(t, progress.Last(), *job.ProgressAt, time.Second)
+	})
+}
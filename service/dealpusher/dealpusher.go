@@ -8,16 +8,19 @@ import (
 
 	"github.com/avast/retry-go"
 	"github.com/data-preservation-programs/singularity/analytics"
+	"github.com/data-preservation-programs/singularity/budget"
 	"github.com/data-preservation-programs/singularity/database"
 	"github.com/ipfs/go-cid"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/rjNemo/underscore"
 	"github.com/robfig/cron/v3"
 
+	"github.com/benbjohnson/clock"
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/replication"
 	"github.com/data-preservation-programs/singularity/service/healthcheck"
+	"github.com/data-preservation-programs/singularity/service/leaderelection"
 	"github.com/data-preservation-programs/singularity/util"
 	"github.com/google/uuid"
 	"github.com/ipfs/go-log/v2"
@@ -27,9 +30,12 @@ import (
 var Logger = log.Logger("dealpusher")
 
 const (
-	cleanupTimeout              = 5 * time.Second
-	healthRegisterRetryInterval = time.Minute
-	schedCheckPeriod            = 15 * time.Second
+	cleanupTimeout   = 5 * time.Second
+	schedCheckPeriod = 15 * time.Second
+	// minRetrievalChecks is the number of retrieval checks below which the retrieval success rate of a provider is not trusted.
+	minRetrievalChecks = 100
+	// minProofChecks is the number of retrieval proofs below which the proof success rate of a provider is not trusted.
+	minProofChecks = 10
 )
 
 var waitPendingInterval = time.Minute
@@ -48,6 +54,59 @@ type DealPusher struct {
 	sendDealAttempts         uint                                    // Number of attempts for sending a deal.
 	host                     host.Host                               // Libp2p host for making deals.
 	maxReplicas              uint                                    // Maximum number of replicas for each individual PieceCID across all clients and providers.
+	activeReplicasOnly       bool                                    // Whether only the deals active on chain, as reconciled by the deal tracker, count as replicas.
+	minRetrievalSuccessRate  float64                                 // Minimum retrieval success rate of a provider to keep making deals with it.
+	budgetGuard              *budget.Guard                           // Guard that keeps deals within the datacap and FIL budgets.
+	lastPolicyCheck          time.Time                               // Last time the replicas were checked against the replication policies.
+	clock                    clock.Clock                             // Clock the pacing of the schedules is based on, replaced with a mock clock in tests.
+	proposing                map[string]map[model.CID]struct{}       // Pieces being proposed to each provider, whose deals are not saved yet.
+	proposingMutex           sync.Mutex                              // Mutex protecting the pieces being proposed.
+}
+
+// releaseBudget returns the datacap and FIL reserved for a deal that was not made to the budgets.
+func (d *DealPusher) releaseBudget(ctx context.Context, reservation *budget.Reservation) {
+	err := d.budgetGuard.Release(ctx, reservation)
+	if err != nil {
+		Logger.Errorw("failed to release budget", "error", err)
+	}
+}
+
+// claimPiece marks a piece as being proposed to a provider, so the other schedules of the provider do not propose it
+// at the same time, before the deal is saved. It returns false if the piece is already being proposed to the provider.
+func (d *DealPusher) claimPiece(provider string, pieceCID model.CID) bool {
+	d.proposingMutex.Lock()
+	defer d.proposingMutex.Unlock()
+	pieces, ok := d.proposing[provider]
+	if !ok {
+		pieces = make(map[model.CID]struct{})
+		d.proposing[provider] = pieces
+	}
+	if _, ok := pieces[pieceCID]; ok {
+		return false
+	}
+	pieces[pieceCID] = struct{}{}
+	return true
+}
+
+// releasePiece removes a piece from the pieces being proposed to a provider, once its deal is saved or failed.
+func (d *DealPusher) releasePiece(provider string, pieceCID model.CID) {
+	d.proposingMutex.Lock()
+	defer d.proposingMutex.Unlock()
+	delete(d.proposing[provider], pieceCID)
+	if len(d.proposing[provider]) == 0 {
+		delete(d.proposing, provider)
+	}
+}
+
+// proposingPieces returns the pieces being proposed to a provider.
+func (d *DealPusher) proposingPieces(provider string) []model.CID {
+	d.proposingMutex.Lock()
+	defer d.proposingMutex.Unlock()
+	pieces := make([]model.CID, 0, len(d.proposing[provider]))
+	for pieceCID := range d.proposing[provider] {
+		pieces = append(pieces, pieceCID)
+	}
+	return pieces
 }
 
 func (*DealPusher) Name() string {
@@ -212,13 +271,18 @@ func (d *DealPusher) updateScheduleUnsafe(ctx context.Context, schedule model.Sc
 // and continuously attempts to make deals based on the information and constraints specified in the Schedule.
 //
 // The steps it takes in each iteration are as follows:
-//  1. Counts the number and size of pending and total active deals for the current schedule from the database.
-//  2. Checks various conditions defined in the Schedule to decide whether to proceed with making a new deal.
-//  3. Finds a car (Content Addressed Archive) that has not been sent to the provider for a deal.
-//  4. Chooses a wallet from the preparation’s associated wallets.
+//  1. Counts the number and size of pending and total active deals for the current schedule from the database, and of
+//     the deals proposed within the last hour and day.
+//  2. Checks various conditions defined in the Schedule to decide whether to proceed with making a new deal. Once the
+//     hourly or daily limits are reached, waits until older proposals leave the window.
+//  3. Finds a car (Content Addressed Archive) that has not been sent to the provider for a deal. A piece rejected by the
+//     provider is only proposed again after the rejected retry delay, up to the max rejected retries.
+//  4. Checks that the deal does not exceed the datacap and FIL budgets, otherwise waits until they are raised or overridden.
+//     Chooses a wallet from the preparation’s associated wallets.
 //  5. Makes a deal using the details from the car and wallet, and the deal parameters defined in the Schedule.
-//  6. Saves the newly created deal to the database.
-//  7. Updates the counts of pending, total, and current deals based on the new deal.
+//  6. Saves the newly created deal to the database. A rejected proposal is saved as a rejected deal, so it can be
+//     retried later, and the schedule moves on to the next piece.
+//  7. Updates the counts of pending, total, current, hourly and daily deals based on the new deal.
 //  8. If the context is done, returns immediately, otherwise waits for a specified interval before the next iteration.
 //
 // Parameters:
@@ -234,28 +298,39 @@ func (d *DealPusher) updateScheduleUnsafe(ctx context.Context, schedule model.Sc
 //  2. An error if any step of the process encounters an issue, otherwise nil.
 func (d *DealPusher) runSchedule(ctx context.Context, schedule *model.Schedule) (model.ScheduleState, error) {
 	db := d.dbNoContext.WithContext(ctx)
+	replicaStates := []model.DealState{model.DealProposed, model.DealPublished, model.DealActive}
+	if d.activeReplicasOnly {
+		replicaStates = []model.DealState{model.DealActive}
+	}
 	overReplicatedCIDs := db.
 		Table("deals").
 		Select("piece_cid").
-		Where("state in ?", []model.DealState{model.DealProposed, model.DealPublished, model.DealActive}).
+		Where("state in ?", replicaStates).
 		Group("piece_cid").
 		Having("count(*) >= ?", d.maxReplicas)
-	var allowedPieceCIDs []model.CID
-	for _, c := range schedule.AllowedPieceCIDs {
-		c2, err := cid.Parse(c)
-		if err != nil {
-			return model.ScheduleError, errors.Wrapf(err, "failed to parse CID %s", c)
-		}
-		allowedPieceCIDs = append(allowedPieceCIDs, model.CID(c2))
-	}
 	// Find all attachment IDs for this schedule
 	var attachments []model.SourceAttachment
 	err := db.Model(&model.SourceAttachment{}).Where("preparation_id = ?", schedule.PreparationID).Find(&attachments).Error
 	if err != nil {
 		return model.ScheduleError, errors.Wrap(err, "failed to find attachments")
 	}
+	// Forced schedules do not claim the pieces they propose
+	releasePiece := func(pieceCID model.CID) {
+		if !schedule.Force {
+			d.releasePiece(schedule.Provider, pieceCID)
+		}
+	}
 	var timer *time.Timer
 	for {
+		// The allowed pieces are parsed on each run, since a replication policy adds pieces to its schedules
+		var allowedPieceCIDs []model.CID
+		for _, c := range schedule.AllowedPieceCIDs {
+			c2, err := cid.Parse(c)
+			if err != nil {
+				return model.ScheduleError, errors.Wrapf(err, "failed to parse CID %s", c)
+			}
+			allowedPieceCIDs = append(allowedPieceCIDs, model.CID(c2))
+		}
 		var pending sumResult
 		err = db.Model(&model.Deal{}).
 			Where("schedule_id = ? AND state IN (?)", schedule.ID, []model.DealState{
@@ -271,6 +346,14 @@ func (d *DealPusher) runSchedule(ctx context.Context, schedule *model.Schedule)
 		if err != nil {
 			return model.ScheduleError, errors.Wrap(err, "failed to count total active and pending deals")
 		}
+		hourly, err := proposedSince(db, schedule.ID, d.clock.Now().Add(-time.Hour))
+		if err != nil {
+			return model.ScheduleError, errors.Wrap(err, "failed to count deals proposed within the last hour")
+		}
+		daily, err := proposedSince(db, schedule.ID, d.clock.Now().Add(-24*time.Hour))
+		if err != nil {
+			return model.ScheduleError, errors.Wrap(err, "failed to count deals proposed within the last day")
+		}
 
 		var current sumResult
 
@@ -283,6 +366,8 @@ func (d *DealPusher) runSchedule(ctx context.Context, schedule *model.Schedule)
 			var car model.Car
 			var dealModel *model.Deal
 			var walletObj model.Wallet
+			var dealConfig replication.DealConfig
+			var reservation *budget.Reservation
 			if schedule.MaxPendingDealNumber > 0 && pending.DealNumber >= schedule.MaxPendingDealNumber {
 				Logger.Infow("skipping this time since the max pending deal is reached", "schedule_id", schedule.ID)
 				goto waitForPending
@@ -291,6 +376,33 @@ func (d *DealPusher) runSchedule(ctx context.Context, schedule *model.Schedule)
 				Logger.Infow("skipping this time since the max pending deal size is reached", "schedule_id", schedule.ID)
 				goto waitForPending
 			}
+			if schedule.HourlyDealNumber > 0 && hourly.DealNumber >= schedule.HourlyDealNumber ||
+				schedule.HourlyDealSize > 0 && hourly.DealSize >= schedule.HourlyDealSize {
+				Logger.Infow("skipping this time since the hourly deal limit is reached", "schedule_id", schedule.ID)
+				goto waitForPending
+			}
+			if schedule.DailyDealNumber > 0 && daily.DealNumber >= schedule.DailyDealNumber ||
+				schedule.DailyDealSize > 0 && daily.DealSize >= schedule.DailyDealSize {
+				Logger.Infow("skipping this time since the daily deal limit is reached", "schedule_id", schedule.ID)
+				goto waitForPending
+			}
+			if d.minRetrievalSuccessRate > 0 {
+				var reputation model.ProviderReputation
+				err = db.Where("provider = ?", schedule.Provider).Limit(1).Find(&reputation).Error
+				if err != nil {
+					return model.ScheduleError, errors.Wrap(err, "failed to get provider reputation")
+				}
+				if reputation.RetrievalTotal >= minRetrievalChecks && reputation.RetrievalSuccessRate < d.minRetrievalSuccessRate {
+					Logger.Infow("skipping this time since the retrieval success rate of the provider is too low",
+						"schedule_id", schedule.ID, "provider", schedule.Provider, "rate", reputation.RetrievalSuccessRate)
+					goto waitForPending
+				}
+				if reputation.ProofTotal >= minProofChecks && reputation.ProofSuccessRate < d.minRetrievalSuccessRate {
+					Logger.Infow("skipping this time since the retrieval proof success rate of the provider is too low",
+						"schedule_id", schedule.ID, "provider", schedule.Provider, "rate", reputation.ProofSuccessRate)
+					goto waitForPending
+				}
+			}
 			if schedule.TotalDealNumber > 0 && total.DealNumber >= schedule.TotalDealNumber {
 				Logger.Infow("completing since the total deal number is reached", "schedule_id", schedule.ID)
 				return model.ScheduleCompleted, nil
@@ -315,25 +427,47 @@ func (d *DealPusher) runSchedule(ctx context.Context, schedule *model.Schedule)
 						model.DealProposed, model.DealPublished, model.DealActive,
 					})
 			if schedule.Force {
-				existingPieceCIDQuery = db.Table("deals").Select("piece_cid").Where("schedule_id = ?", schedule.ID)
+				existingPieceCIDQuery = db.Table("deals").Select("piece_cid").
+					Where("schedule_id = ? AND state <> ?", schedule.ID, model.DealRejected)
+			}
+			// A piece is never proposed twice to the same provider, including by another schedule that is proposing it
+			// right now, unless the schedule is forced
+			var proposingPieceCIDs []model.CID
+			if !schedule.Force {
+				proposingPieceCIDs = d.proposingPieces(schedule.Provider)
 			}
+			// Pieces rejected by the provider wait for the retry delay, and are no longer proposed once the retries are
+			// exhausted
+			rejectedPieceCIDQuery := db.Table("deals").Select("piece_cid").
+				Where("schedule_id = ? AND state = ?", schedule.ID, model.DealRejected).
+				Group("piece_cid").
+				Having("COUNT(*) > ? OR MAX(created_at) > ?",
+					schedule.MaxRejectedRetries, d.clock.Now().UTC().Add(-schedule.RejectedRetryDelay))
+			// Aggregated pieces are dealt through their aggregate, which has no source attachment
+			attachmentIDs := underscore.Map(attachments, func(a model.SourceAttachment) model.SourceAttachmentID { return a.ID })
+			dealablePieces := db.Where("attachment_id IN ? AND aggregate_id IS NULL", attachmentIDs).
+				Or("id IN (?)", db.Model(&model.Car{}).Select("aggregate_id").Where("attachment_id IN ?", attachmentIDs))
 			if len(allowedPieceCIDs) == 0 {
-				query := db.Where("attachment_id IN ? AND piece_cid NOT IN (?)",
-					underscore.Map(attachments, func(a model.SourceAttachment) model.SourceAttachmentID { return a.ID }),
-					existingPieceCIDQuery)
+				query := db.Where(dealablePieces).Where("piece_cid NOT IN (?) AND piece_cid NOT IN (?)",
+					existingPieceCIDQuery, rejectedPieceCIDQuery)
 				if d.maxReplicas > 0 && !schedule.Force {
 					query = query.Where("piece_cid NOT IN (?)", overReplicatedCIDs)
 				}
+				if len(proposingPieceCIDs) > 0 {
+					query = query.Where("piece_cid NOT IN ?", proposingPieceCIDs)
+				}
 				err = query.First(&car).Error
 			} else {
 				pieceCIDChunks := util.ChunkSlice(allowedPieceCIDs, util.BatchSize)
 				for _, pieceCIDChunk := range pieceCIDChunks {
-					query := db.Where("attachment_id IN ? AND piece_cid NOT IN (?) AND piece_cid IN ?",
-						underscore.Map(attachments, func(a model.SourceAttachment) model.SourceAttachmentID { return a.ID }),
-						existingPieceCIDQuery, pieceCIDChunk)
+					query := db.Where(dealablePieces).Where("piece_cid NOT IN (?) AND piece_cid NOT IN (?) AND piece_cid IN ?",
+						existingPieceCIDQuery, rejectedPieceCIDQuery, pieceCIDChunk)
 					if d.maxReplicas > 0 && !schedule.Force {
 						query = query.Where("piece_cid NOT IN (?)", overReplicatedCIDs)
 					}
+					if len(proposingPieceCIDs) > 0 {
+						query = query.Where("piece_cid NOT IN ?", proposingPieceCIDs)
+					}
 					err = query.First(&car).Error
 					if err == nil {
 						break
@@ -341,6 +475,26 @@ func (d *DealPusher) runSchedule(ctx context.Context, schedule *model.Schedule)
 				}
 			}
 			if errors.Is(err, gorm.ErrRecordNotFound) {
+				// Pieces rejected by the provider that are waiting for the retry delay still need to be proposed
+				var retryPieceCIDs []model.CID
+				query := db.Table("deals").Select("piece_cid").
+					Where("schedule_id = ? AND state = ? AND piece_cid NOT IN (?)", schedule.ID, model.DealRejected, existingPieceCIDQuery)
+				if d.maxReplicas > 0 && !schedule.Force {
+					query = query.Where("piece_cid NOT IN (?)", overReplicatedCIDs)
+				}
+				err = query.Group("piece_cid").Having("COUNT(*) <= ?", schedule.MaxRejectedRetries).
+					Limit(1).Pluck("piece_cid", &retryPieceCIDs).Error
+				if err != nil {
+					return model.ScheduleError, errors.Wrap(err, "failed to find rejected pieces to retry")
+				}
+				if len(retryPieceCIDs) > 0 {
+					Logger.Infow("waiting to propose rejected pieces again", "schedule_id", schedule.ID)
+					// A cron schedule proposes them again in a later run
+					if schedule.ScheduleCron != "" {
+						return "", nil
+					}
+					goto waitForPending
+				}
 				Logger.Infow("no more pieces to send deal", "schedule_id", schedule.ID)
 				// we're out of deals to schedule, but if we're running a perpetual cron, we simply put things on hold till next cron
 				if schedule.ScheduleCron != "" && schedule.ScheduleCronPerpetual {
@@ -352,29 +506,56 @@ func (d *DealPusher) runSchedule(ctx context.Context, schedule *model.Schedule)
 				return model.ScheduleError, errors.Wrap(err, "failed to find car")
 			}
 
-			walletObj, err = d.walletChooser.Choose(ctx, schedule.Preparation.Wallets)
+			dealConfig = replication.DealConfig{
+				Provider:        schedule.Provider,
+				StartDelay:      schedule.StartDelay,
+				Duration:        schedule.Duration,
+				Verified:        schedule.Verified,
+				HTTPHeaders:     schedule.HTTPHeaders,
+				URLTemplate:     schedule.URLTemplate,
+				KeepUnsealed:    schedule.KeepUnsealed,
+				AnnounceToIPNI:  schedule.AnnounceToIPNI,
+				PricePerDeal:    schedule.PricePerDeal,
+				PricePerGB:      schedule.PricePerGB,
+				PricePerGBEpoch: schedule.PricePerGBEpoch,
+			}
+			reservation, err = d.budgetGuard.Reserve(ctx, schedule.PreparationID, schedule.Verified, car.PieceSize,
+				dealConfig.GetPrice(car.PieceSize, dealConfig.Duration).Int)
+			if errors.Is(err, budget.ErrExceeded) {
+				Logger.Warnw("skipping this time since a budget would be exceeded", "schedule_id", schedule.ID, "error", err)
+				goto waitForPending
+			}
+			if err != nil {
+				return model.ScheduleError, errors.Wrap(err, "failed to reserve budgets")
+			}
+
+			if schedule.Verified {
+				walletObj, err = replication.NewDatacapWalletChooser(db, car.PieceSize).Choose(ctx, schedule.Preparation.Wallets)
+			} else {
+				walletObj, err = d.walletChooser.Choose(ctx, schedule.Preparation.Wallets)
+			}
+			if errors.Is(err, replication.ErrNoDatacap) {
+				Logger.Warnw("skipping this time since no wallet has enough datacap left for the piece",
+					"schedule_id", schedule.ID, "pieceSize", car.PieceSize)
+				d.releaseBudget(ctx, reservation)
+				goto waitForPending
+			}
 			if err != nil {
+				d.releaseBudget(ctx, reservation)
 				return model.ScheduleError, errors.Wrap(err, "failed to choose wallet")
 			}
 
+			if !schedule.Force && !d.claimPiece(schedule.Provider, car.PieceCID) {
+				// Another schedule of the provider started proposing the piece in the meantime
+				d.releaseBudget(ctx, reservation)
+				continue
+			}
+			var rejectErr error
 			err = retry.Do(func() error {
-				dealModel, err = d.dealMaker.MakeDeal(
-					ctx,
-					walletObj,
-					car,
-					replication.DealConfig{
-						Provider:        schedule.Provider,
-						StartDelay:      schedule.StartDelay,
-						Duration:        schedule.Duration,
-						Verified:        schedule.Verified,
-						HTTPHeaders:     schedule.HTTPHeaders,
-						URLTemplate:     schedule.URLTemplate,
-						KeepUnsealed:    schedule.KeepUnsealed,
-						AnnounceToIPNI:  schedule.AnnounceToIPNI,
-						PricePerDeal:    schedule.PricePerDeal,
-						PricePerGB:      schedule.PricePerGB,
-						PricePerGBEpoch: schedule.PricePerGBEpoch,
-					})
+				dealModel, err = d.dealMaker.MakeDeal(ctx, walletObj, car, dealConfig)
+				if errors.Is(err, replication.ErrDealRejected) {
+					rejectErr = err
+				}
 				if err != nil {
 					Logger.Errorw("failed to send deal", "error", err, "provider", schedule.Provider)
 					if strings.Contains(err.Error(), "deal proposal is identical") {
@@ -384,24 +565,60 @@ func (d *DealPusher) runSchedule(ctx context.Context, schedule *model.Schedule)
 
 				return errors.WithStack(err)
 			}, retry.Attempts(d.sendDealAttempts), retry.Delay(time.Second),
-				retry.DelayType(retry.FixedDelay), retry.Context(ctx))
+				retry.DelayType(retry.FixedDelay), retry.Context(ctx),
+				// A rejected proposal is retried later, after the rejected retry delay of the schedule
+				retry.RetryIf(func(err error) bool { return !errors.Is(err, replication.ErrDealRejected) }))
+			if rejectErr != nil {
+				Logger.Warnw("deal proposal rejected by the provider", "schedule_id", schedule.ID,
+					"provider", schedule.Provider, "pieceCID", car.PieceCID.String(), "error", rejectErr)
+				d.releaseBudget(ctx, reservation)
+				errorMessage := rejectErr.Error()
+				err = database.DoRetry(ctx, func() error {
+					return db.Create(&model.Deal{
+						CreatedAt:    d.clock.Now().UTC(),
+						State:        model.DealRejected,
+						ClientID:     walletObj.ID,
+						Provider:     schedule.Provider,
+						Label:        cid.Cid(car.RootCID).String(),
+						PieceCID:     car.PieceCID,
+						PieceSize:    car.PieceSize,
+						Verified:     schedule.Verified,
+						ErrorMessage: errorMessage,
+						ScheduleID:   &schedule.ID,
+					}).Error
+				})
+				releasePiece(car.PieceCID)
+				if err != nil {
+					return model.ScheduleError, errors.Wrap(err, "failed to create rejected deal")
+				}
+				continue
+			}
 			if err != nil {
+				releasePiece(car.PieceCID)
+				d.releaseBudget(ctx, reservation)
 				return "", errors.Wrap(err, "failed to send deal")
 			}
 
 			if dealModel == nil {
+				releasePiece(car.PieceCID)
+				d.releaseBudget(ctx, reservation)
 				continue
 			}
 			dealModel.ScheduleID = &schedule.ID
 
 			Logger.Debugw("save accepted deal", "deal", dealModel)
 			err = database.DoRetry(ctx, func() error { return db.Create(dealModel).Error })
+			releasePiece(car.PieceCID)
 			if err != nil {
 				return model.ScheduleError, errors.Wrap(err, "failed to create deal")
 			}
 
 			current.DealSize += car.PieceSize
 			current.DealNumber += 1
+			hourly.DealSize += car.PieceSize
+			hourly.DealNumber += 1
+			daily.DealSize += car.PieceSize
+			daily.DealNumber += 1
 			total.DealSize += car.PieceSize
 			total.DealNumber += 1
 			pending.DealSize += car.PieceSize
@@ -423,8 +640,18 @@ func (d *DealPusher) runSchedule(ctx context.Context, schedule *model.Schedule)
 	}
 }
 
+// proposedSince returns the number and size of the deals proposed by the schedule since the given time, leaving out
+// the proposals rejected by the provider.
+func proposedSince(db *gorm.DB, scheduleID model.ScheduleID, since time.Time) (sumResult, error) {
+	var result sumResult
+	err := db.Model(&model.Deal{}).
+		Where("schedule_id = ? AND state <> ? AND created_at >= ?", scheduleID, model.DealRejected, since.UTC()).
+		Select("COUNT(*) AS deal_number, SUM(piece_size) AS deal_size").Scan(&result).Error
+	return result, errors.WithStack(err)
+}
+
 func NewDealPusher(db *gorm.DB, lotusURL string,
-	lotusToken string, numAttempts uint, maxReplicas uint) (*DealPusher, error) {
+	lotusToken string, numAttempts uint, maxReplicas uint, activeReplicasOnly bool, minRetrievalSuccessRate float64, budgetAlertWebhook string) (*DealPusher, error) {
 	if numAttempts <= 1 {
 		numAttempts = 1
 	}
@@ -447,19 +674,25 @@ func NewDealPusher(db *gorm.DB, lotusURL string,
 		workerID:                 uuid.New(),
 		cron: cron.New(cron.WithLogger(&cronLogger{}), cron.WithLocation(time.UTC),
 			cron.WithParser(cron.NewParser(cron.SecondOptional|cron.Minute|cron.Hour|cron.Dom|cron.Month|cron.Dow|cron.Descriptor))),
-		sendDealAttempts: numAttempts,
-		host:             h,
-		maxReplicas:      maxReplicas,
+		sendDealAttempts:        numAttempts,
+		host:                    h,
+		maxReplicas:             maxReplicas,
+		activeReplicasOnly:      activeReplicasOnly,
+		minRetrievalSuccessRate: minRetrievalSuccessRate,
+		budgetGuard:             budget.NewGuard(db, budgetAlertWebhook),
+		clock:                   clock.New(),
+		proposing:               make(map[string]map[model.CID]struct{}),
 	}, nil
 }
 
 // runOnce is a method of the DealPusher type that runs a single iteration of the deal pushing logic.
 //
 // In each iteration, the method performs the following actions:
-//  1. Fetches all the active schedules from the database.
-//  2. Constructs a map of these schedules for quick lookup.
-//  3. Cancels all the jobs in the DealPusher that are no longer active (based on the latest fetched schedules).
-//  4. For each schedule in the fetched active schedules:
+//  1. Every policyCheckPeriod, assigns the pieces missing replicas to the schedules of the replication policies.
+//  2. Fetches all the active schedules from the database.
+//  3. Constructs a map of these schedules for quick lookup.
+//  4. Cancels all the jobs in the DealPusher that are no longer active (based on the latest fetched schedules).
+//  5. For each schedule in the fetched active schedules:
 //     a. If the schedule is already being processed, it updates that schedule's processing logic.
 //     b. If the schedule is new, it starts processing that schedule.
 //
@@ -472,6 +705,10 @@ func NewDealPusher(db *gorm.DB, lotusURL string,
 //
 // Note: Errors encountered during this process are logged but do not stop the function's execution.
 func (d *DealPusher) runOnce(ctx context.Context) {
+	if d.clock.Since(d.lastPolicyCheck) >= policyCheckPeriod {
+		d.applyPolicies(ctx)
+		d.lastPolicyCheck = d.clock.Now()
+	}
 	var schedules []model.Schedule
 	scheduleMap := map[model.ScheduleID]model.Schedule{}
 	Logger.Debugw("getting schedules")
@@ -513,13 +750,15 @@ func (d *DealPusher) runOnce(ctx context.Context) {
 
 // Start initializes and starts the DealPusher service.
 //
-// It first attempts to register the worker with the health check system.
-// If another worker is already running, it waits and retries until it can register or the context is cancelled.
-// Once registered, it launches three main activities in separate goroutines:
+// It first campaigns to become the leader among all deal pusher replicas sharing the same database.
+// If another replica is the leader, it stands by until the leader's lease expires or the context is cancelled.
+// Once elected, it registers the worker with the health check system and launches three main activities in separate goroutines:
 //  1. Reporting its health status.
 //  2. Running the deal processing loop.
 //  3. Handling cleanup when the service is stopped.
 //
+// If the lease cannot be renewed, the service stops and exits with leaderelection.ErrLeadershipLost.
+//
 // Parameters:
 //
 //   - ctx : The context for managing the lifecycle of the Start function. If Done, the function exits cleanly.
@@ -530,31 +769,19 @@ func (d *DealPusher) runOnce(ctx context.Context) {
 //
 // This function is intended to be called once at the start of the service lifecycle.
 func (d *DealPusher) Start(ctx context.Context, exitErr chan<- error) error {
-	var regTimer *time.Timer
-	for {
-		alreadyRunning, err := healthcheck.Register(ctx, d.dbNoContext, d.workerID, model.DealPusher, false)
-		if err != nil {
-			return errors.Wrap(err, "failed to register worker")
-		}
-		if !alreadyRunning {
-			break
-		}
-		Logger.Warnw("another worker already running")
-		Logger.Warn("retrying in 1 minute")
-		if regTimer == nil {
-			regTimer = time.NewTimer(healthRegisterRetryInterval)
-			defer regTimer.Stop()
-		} else {
-			regTimer.Reset(healthRegisterRetryInterval)
-		}
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-regTimer.C:
-		}
+	elector := leaderelection.NewElector(d.dbNoContext, string(model.DealPusher), d.workerID.String())
+	err := elector.Campaign(ctx)
+	if err != nil {
+		return errors.WithStack(err)
 	}
+	// The lease guarantees that this is the only running deal pusher, so duplicates are allowed here.
+	_, err = healthcheck.Register(ctx, d.dbNoContext, d.workerID, model.DealPusher, true)
+	if err != nil {
+		return errors.Wrap(err, "failed to register worker")
+	}
+	ctx, lost := elector.Hold(ctx)
 
-	err := analytics.Init(ctx, d.dbNoContext)
+	err = analytics.Init(ctx, d.dbNoContext)
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -566,6 +793,8 @@ func (d *DealPusher) Start(ctx context.Context, exitErr chan<- error) error {
 		analytics.Default.Flush()
 	}()
 
+	go analytics.StartTelemetry(ctx, d.dbNoContext)
+
 	healthcheckDone := make(chan struct{})
 	go func() {
 		defer close(healthcheckDone)
@@ -608,6 +837,11 @@ func (d *DealPusher) Start(ctx context.Context, exitErr chan<- error) error {
 		} else {
 			Logger.Info("cleanup done")
 		}
+		//nolint:contextcheck
+		err = elector.Release(ctx2)
+		if err != nil {
+			Logger.Errorw("failed to release lease", "error", err)
+		}
 		cancel()
 
 		err = d.host.Close()
@@ -621,7 +855,11 @@ func (d *DealPusher) Start(ctx context.Context, exitErr chan<- error) error {
 		<-healthcheckDone
 
 		if exitErr != nil {
-			exitErr <- nil
+			if lost() {
+				exitErr <- leaderelection.ErrLeadershipLost
+			} else {
+				exitErr <- nil
+			}
 		}
 	}()
 
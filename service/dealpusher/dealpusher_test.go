@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/analytics"
 	"github.com/data-preservation-programs/singularity/model"
@@ -64,7 +65,7 @@ func (m *MockDealMaker) MakeDeal(ctx context.Context, walletObj model.Wallet, ca
 
 func TestDealMakerService_Start(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10)
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0, "")
 		require.NoError(t, err)
 		ctx, cancel := context.WithCancel(ctx)
 		exitErr := make(chan error, 1)
@@ -78,9 +79,9 @@ func TestDealMakerService_Start(t *testing.T) {
 
 func TestDealMakerService_MultipleInstances(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		service1, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10)
+		service1, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0, "")
 		require.NoError(t, err)
-		service2, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10)
+		service2, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0, "")
 		require.NoError(t, err)
 		ctx, cancel := context.WithTimeout(ctx, time.Second)
 		defer cancel()
@@ -99,7 +100,7 @@ func TestDealMakerService_FailtoSend(t *testing.T) {
 		waitPendingInterval = time.Minute
 	}()
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 2, 0)
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 2, 0, false, 0, "")
 		require.NoError(t, err)
 		mockDealmaker := new(MockDealMaker)
 		service.dealMaker = mockDealmaker
@@ -153,7 +154,7 @@ func TestDealMakerService_Cron(t *testing.T) {
 		waitPendingInterval = time.Minute
 	}()
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10)
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0, "")
 		require.NoError(t, err)
 		mockDealmaker := new(MockDealMaker)
 		service.dealMaker = mockDealmaker
@@ -250,7 +251,7 @@ func TestDealMakerService_ScheduleWithConstraints(t *testing.T) {
 		waitPendingInterval = time.Minute
 	}()
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10)
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0, "")
 		require.NoError(t, err)
 		mockDealmaker := new(MockDealMaker)
 		service.dealMaker = mockDealmaker
@@ -359,7 +360,7 @@ func TestDealMakerService_ScheduleWithConstraints(t *testing.T) {
 
 func TestDealmakerService_Force(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10)
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0, "")
 		require.NoError(t, err)
 		mockDealmaker := new(MockDealMaker)
 		service.dealMaker = mockDealmaker
@@ -416,9 +417,72 @@ func TestDealmakerService_Force(t *testing.T) {
 	})
 }
 
+func TestDealMakerService_NoDuplicateAcrossSchedules(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0, "")
+		require.NoError(t, err)
+		mockDealmaker := new(MockDealMaker)
+		service.dealMaker = mockDealmaker
+		pieceCID := model.CID(calculateCommp(t, generateRandomBytes(1000), 1024))
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		provider := "f0miner"
+		client := "f0client"
+		schedule := model.Schedule{
+			Preparation: &model.Preparation{
+				Wallets: []model.Wallet{
+					{
+						ID: client, Address: "f0xx",
+					},
+				},
+				SourceStorages: []model.Storage{{}},
+			},
+			State:    model.ScheduleActive,
+			Provider: provider,
+		}
+		err = db.Create(&schedule).Error
+		require.NoError(t, err)
+		// A second schedule proposes the same preparation to the same provider
+		err = db.Create(&model.Schedule{
+			PreparationID: schedule.PreparationID,
+			State:         model.ScheduleActive,
+			Provider:      provider,
+		}).Error
+		require.NoError(t, err)
+		// The deal is saved after the proposal, so both schedules would see the piece as not proposed yet
+		mockDealmaker.On("MakeDeal", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			After(500*time.Millisecond).Return(&model.Deal{
+			Provider:  provider,
+			ClientID:  client,
+			PieceCID:  pieceCID,
+			PieceSize: 1024,
+			State:     model.DealProposed,
+		}, nil)
+
+		err = db.Create([]model.Car{
+			{
+				AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+				PreparationID: 1,
+				PieceCID:      pieceCID,
+				PieceSize:     1024,
+				StoragePath:   "0",
+			},
+		}).Error
+		require.NoError(t, err)
+		service.runOnce(ctx)
+		time.Sleep(2 * time.Second)
+		var deals []model.Deal
+		err = db.Find(&deals).Error
+		require.NoError(t, err)
+		require.Len(t, deals, 1)
+		mockDealmaker.AssertNumberOfCalls(t, "MakeDeal", 1)
+		require.Empty(t, service.proposingPieces(provider))
+	})
+}
+
 func TestDealMakerService_MaxReplica(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 1)
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 1, false, 0, "")
 		require.NoError(t, err)
 		mockDealmaker := new(MockDealMaker)
 		service.dealMaker = mockDealmaker
@@ -473,9 +537,66 @@ func TestDealMakerService_MaxReplica(t *testing.T) {
 	})
 }
 
+func TestDealMakerService_MaxReplica_ActiveReplicasOnly(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 1, true, 0, "")
+		require.NoError(t, err)
+		mockDealmaker := new(MockDealMaker)
+		service.dealMaker = mockDealmaker
+		pieceCID := model.CID(calculateCommp(t, generateRandomBytes(1000), 1024))
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		provider := "f0miner"
+		client := "f0client"
+		schedule := model.Schedule{
+			Preparation: &model.Preparation{
+				Wallets: []model.Wallet{
+					{
+						ID: client, Address: "f0xx",
+					},
+				},
+				SourceStorages: []model.Storage{{}},
+			},
+			State:    model.ScheduleActive,
+			Provider: provider,
+		}
+		err = db.Create(&schedule).Error
+		require.NoError(t, err)
+		mockDealmaker.On("MakeDeal", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Deal{
+			ScheduleID: &schedule.ID,
+		}, nil)
+		err = db.Create([]model.Car{
+			{
+				AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+				PreparationID: 1,
+				PieceCID:      pieceCID,
+				PieceSize:     1024,
+				StoragePath:   "0",
+			},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create([]model.Deal{
+			{
+				ScheduleID: &schedule.ID,
+				Provider:   "another",
+				ClientID:   client,
+				PieceCID:   pieceCID,
+				PieceSize:  1024,
+				State:      model.DealProposed,
+			}}).Error
+		require.NoError(t, err)
+		service.runOnce(ctx)
+		time.Sleep(time.Second)
+		var deals []model.Deal
+		err = db.Find(&deals).Error
+		require.NoError(t, err)
+		require.Len(t, deals, 2)
+	})
+}
+
 func TestDealMakerService_NewScheduleOneOff(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10)
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0, "")
 		require.NoError(t, err)
 		mockDealmaker := new(MockDealMaker)
 		service.dealMaker = mockDealmaker
@@ -623,3 +744,340 @@ func generateRandomBytes(n int) []byte {
 	rand.Read(b)
 	return b
 }
+
+func TestDealMakerService_MinRetrievalSuccessRate(t *testing.T) {
+	waitPendingInterval = 100 * time.Millisecond
+	defer func() {
+		waitPendingInterval = time.Minute
+	}()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0.5, "")
+		require.NoError(t, err)
+		mockDealmaker := new(MockDealMaker)
+		service.dealMaker = mockDealmaker
+		provider := "f0miner"
+		schedule := model.Schedule{
+			Preparation: &model.Preparation{
+				Wallets: []model.Wallet{
+					{
+						ID: "f0client", Address: "f0xx",
+					},
+				},
+				SourceStorages: []model.Storage{{}},
+			},
+			State:    model.ScheduleActive,
+			Provider: provider,
+		}
+		require.NoError(t, db.Create(&schedule).Error)
+		require.NoError(t, db.Preload("Preparation.Wallets").First(&schedule, schedule.ID).Error)
+		mockDealmaker.On("MakeDeal", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Deal{
+			ScheduleID: &schedule.ID,
+		}, nil)
+		require.NoError(t, db.Create(&model.Car{
+			AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+			PreparationID: 1,
+			PieceCID:      model.CID(calculateCommp(t, generateRandomBytes(1000), 1024)),
+			PieceSize:     1024,
+		}).Error)
+		reputation := model.ProviderReputation{
+			Provider:             provider,
+			RetrievalTotal:       1000,
+			RetrievalSuccessful:  100,
+			RetrievalSuccessRate: 0.1,
+		}
+		require.NoError(t, db.Create(&reputation).Error)
+
+		// The provider is poorly retrievable, so the schedule is put on hold
+		holdCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		state, err := service.runSchedule(holdCtx, &schedule)
+		require.NoError(t, err)
+		require.Empty(t, state)
+		var count int64
+		require.NoError(t, db.Model(&model.Deal{}).Count(&count).Error)
+		require.Zero(t, count)
+
+		// The retrieval proofs sampled from the provider also put the schedule on hold
+		require.NoError(t, db.Model(&reputation).Updates(map[string]any{
+			"retrieval_successful":   900,
+			"retrieval_success_rate": 0.9,
+			"proof_total":            20,
+			"proof_successful":       2,
+			"proof_success_rate":     0.1,
+		}).Error)
+		holdCtx, cancel = context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		state, err = service.runSchedule(holdCtx, &schedule)
+		require.NoError(t, err)
+		require.Empty(t, state)
+
+		// Once the rates recover, deals are made again
+		require.NoError(t, db.Model(&reputation).Updates(map[string]any{
+			"proof_successful":   18,
+			"proof_success_rate": 0.9,
+		}).Error)
+		state, err = service.runSchedule(ctx, &schedule)
+		require.NoError(t, err)
+		require.Equal(t, model.ScheduleCompleted, state)
+		require.NoError(t, db.Model(&model.Deal{}).Count(&count).Error)
+		require.EqualValues(t, 1, count)
+	})
+}
+
+func TestDealMakerService_Budget(t *testing.T) {
+	waitPendingInterval = 100 * time.Millisecond
+	defer func() {
+		waitPendingInterval = time.Minute
+	}()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0, "")
+		require.NoError(t, err)
+		mockDealmaker := new(MockDealMaker)
+		service.dealMaker = mockDealmaker
+		schedule := model.Schedule{
+			Preparation: &model.Preparation{
+				Wallets: []model.Wallet{
+					{
+						ID: "f0client", Address: "f0xx",
+					},
+				},
+				SourceStorages: []model.Storage{{}},
+			},
+			State:    model.ScheduleActive,
+			Provider: "f0miner",
+			Verified: true,
+		}
+		require.NoError(t, db.Create(&schedule).Error)
+		require.NoError(t, db.Preload("Preparation.Wallets").First(&schedule, schedule.ID).Error)
+		mockDealmaker.On("MakeDeal", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Deal{
+			ScheduleID: &schedule.ID,
+		}, nil)
+		for i := 0; i < 2; i++ {
+			require.NoError(t, db.Create(&model.Car{
+				AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+				PreparationID: 1,
+				PieceCID:      model.CID(calculateCommp(t, generateRandomBytes(1000), 1024)),
+				PieceSize:     1024,
+			}).Error)
+		}
+		budget := model.Budget{PreparationID: ptr.Of(schedule.PreparationID), Datacap: 1024}
+		require.NoError(t, db.Create(&budget).Error)
+
+		// Only the first deal fits in the budget, so the schedule is put on hold
+		holdCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		state, err := service.runSchedule(holdCtx, &schedule)
+		require.NoError(t, err)
+		require.Empty(t, state)
+		var count int64
+		require.NoError(t, db.Model(&model.Deal{}).Count(&count).Error)
+		require.EqualValues(t, 1, count)
+		require.NoError(t, db.First(&budget, budget.ID).Error)
+		require.EqualValues(t, 1024, budget.DatacapSpent)
+
+		// Once the budget is overridden, the remaining deal is made
+		require.NoError(t, db.Model(&budget).Update("override_until", time.Now().Add(time.Hour)).Error)
+		state, err = service.runSchedule(ctx, &schedule)
+		require.NoError(t, err)
+		require.Equal(t, model.ScheduleCompleted, state)
+		require.NoError(t, db.Model(&model.Deal{}).Count(&count).Error)
+		require.EqualValues(t, 2, count)
+		require.NoError(t, db.First(&budget, budget.ID).Error)
+		require.EqualValues(t, 2048, budget.DatacapSpent)
+	})
+}
+
+func TestDealMakerService_Datacap(t *testing.T) {
+	waitPendingInterval = 100 * time.Millisecond
+	defer func() {
+		waitPendingInterval = time.Minute
+	}()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0, "")
+		require.NoError(t, err)
+		mockDealmaker := new(MockDealMaker)
+		service.dealMaker = mockDealmaker
+		schedule := model.Schedule{
+			Preparation: &model.Preparation{
+				Wallets: []model.Wallet{
+					{
+						ID: "f0client", Address: "f0xx", Datacap: 1024, BalanceUpdatedAt: ptr.Of(time.Now()),
+					},
+				},
+				SourceStorages: []model.Storage{{}},
+			},
+			State:    model.ScheduleActive,
+			Provider: "f0miner",
+			Verified: true,
+		}
+		require.NoError(t, db.Create(&schedule).Error)
+		require.NoError(t, db.Preload("Preparation.Wallets").First(&schedule, schedule.ID).Error)
+		for i := 0; i < 2; i++ {
+			mockDealmaker.On("MakeDeal", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Deal{
+				ClientID:  "f0client",
+				Verified:  true,
+				State:     model.DealProposed,
+				PieceSize: 1024,
+			}, nil).Once()
+			require.NoError(t, db.Create(&model.Car{
+				AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+				PreparationID: 1,
+				PieceCID:      model.CID(calculateCommp(t, generateRandomBytes(1000), 1024)),
+				PieceSize:     1024,
+			}).Error)
+		}
+
+		// The first deal uses all the datacap of the wallet, so the schedule is put on hold
+		holdCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		state, err := service.runSchedule(holdCtx, &schedule)
+		require.NoError(t, err)
+		require.Empty(t, state)
+		var count int64
+		require.NoError(t, db.Model(&model.Deal{}).Count(&count).Error)
+		require.EqualValues(t, 1, count)
+
+		// Once the deal tracker finds more datacap in the wallet, the remaining deal is made
+		require.NoError(t, db.Model(&model.Wallet{}).Where("id = ?", "f0client").Update("datacap", 2048).Error)
+		state, err = service.runSchedule(ctx, &schedule)
+		require.NoError(t, err)
+		require.Equal(t, model.ScheduleCompleted, state)
+		require.NoError(t, db.Model(&model.Deal{}).Count(&count).Error)
+		require.EqualValues(t, 2, count)
+	})
+}
+
+func TestDealMakerService_RateLimits(t *testing.T) {
+	waitPendingInterval = 100 * time.Millisecond
+	defer func() {
+		waitPendingInterval = time.Minute
+	}()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 1, 10, false, 0, "")
+		require.NoError(t, err)
+		mockDealmaker := new(MockDealMaker)
+		service.dealMaker = mockDealmaker
+		schedule := model.Schedule{
+			Preparation: &model.Preparation{
+				Wallets: []model.Wallet{
+					{
+						ID: "f0client", Address: "f0xx",
+					},
+				},
+				SourceStorages: []model.Storage{{}},
+			},
+			State:            model.ScheduleActive,
+			Provider:         "f0miner",
+			HourlyDealNumber: 2,
+			DailyDealSize:    4096,
+		}
+		require.NoError(t, db.Create(&schedule).Error)
+		require.NoError(t, db.Preload("Preparation.Wallets").First(&schedule, schedule.ID).Error)
+		mockDealmaker.On("MakeDeal", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Deal{
+			ScheduleID: &schedule.ID,
+		}, nil)
+		for i := 0; i < 5; i++ {
+			require.NoError(t, db.Create(&model.Car{
+				AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+				PreparationID: 1,
+				PieceCID:      model.CID(calculateCommp(t, generateRandomBytes(1000), 1024)),
+				PieceSize:     1024,
+			}).Error)
+		}
+
+		// The hourly deal number is reached, so the schedule waits
+		limitCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		state, err := service.runSchedule(limitCtx, &schedule)
+		require.NoError(t, err)
+		require.Empty(t, state)
+		var count int64
+		require.NoError(t, db.Model(&model.Deal{}).Count(&count).Error)
+		require.EqualValues(t, 2, count)
+
+		// An hour later, the daily deal size is reached
+		require.NoError(t, db.Model(&model.Deal{}).Where("1 = 1").
+			Update("created_at", time.Now().UTC().Add(-2*time.Hour)).Error)
+		limitCtx, cancel = context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		state, err = service.runSchedule(limitCtx, &schedule)
+		require.NoError(t, err)
+		require.Empty(t, state)
+		require.NoError(t, db.Model(&model.Deal{}).Count(&count).Error)
+		require.EqualValues(t, 4, count)
+
+		// A day later, the remaining piece is proposed
+		require.NoError(t, db.Model(&model.Deal{}).Where("1 = 1").
+			Update("created_at", time.Now().UTC().Add(-48*time.Hour)).Error)
+		state, err = service.runSchedule(ctx, &schedule)
+		require.NoError(t, err)
+		require.Equal(t, model.ScheduleCompleted, state)
+		require.NoError(t, db.Model(&model.Deal{}).Count(&count).Error)
+		require.EqualValues(t, 5, count)
+	})
+}
+
+func TestDealMakerService_RejectedRetry(t *testing.T) {
+	waitPendingInterval = 100 * time.Millisecond
+	defer func() {
+		waitPendingInterval = time.Minute
+	}()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		service, err := NewDealPusher(db, "https://api.node.glif.io", "", 3, 10, false, 0, "")
+		require.NoError(t, err)
+		mockDealmaker := new(MockDealMaker)
+		service.dealMaker = mockDealmaker
+		mockClock := clock.NewMock()
+		mockClock.Set(time.Now())
+		service.clock = mockClock
+		schedule := model.Schedule{
+			Preparation: &model.Preparation{
+				Wallets: []model.Wallet{
+					{
+						ID: "f0client", Address: "f0xx",
+					},
+				},
+				SourceStorages: []model.Storage{{}},
+			},
+			State:              model.ScheduleActive,
+			Provider:           "f0miner",
+			MaxRejectedRetries: 1,
+			RejectedRetryDelay: time.Hour,
+		}
+		require.NoError(t, db.Create(&schedule).Error)
+		require.NoError(t, db.Preload("Preparation.Wallets").First(&schedule, schedule.ID).Error)
+		mockDealmaker.On("MakeDeal", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.Mark(errors.New("deal rejected: no space"), replication.ErrDealRejected)).Once()
+		mockDealmaker.On("MakeDeal", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Deal{
+			ScheduleID: &schedule.ID,
+		}, nil)
+		require.NoError(t, db.Create(&model.Car{
+			AttachmentID:  ptr.Of(model.SourceAttachmentID(1)),
+			PreparationID: 1,
+			PieceCID:      model.CID(calculateCommp(t, generateRandomBytes(1000), 1024)),
+			PieceSize:     1024,
+		}).Error)
+
+		// The rejected proposal is recorded and not retried before the delay
+		retryCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		state, err := service.runSchedule(retryCtx, &schedule)
+		require.NoError(t, err)
+		require.Empty(t, state)
+		var deals []model.Deal
+		require.NoError(t, db.Find(&deals).Error)
+		require.Len(t, deals, 1)
+		require.Equal(t, model.DealRejected, deals[0].State)
+		require.Equal(t, "deal rejected: no space", deals[0].ErrorMessage)
+		mockDealmaker.AssertNumberOfCalls(t, "MakeDeal", 1)
+
+		// Once the delay has passed, the piece is proposed again
+		mockClock.Add(2 * time.Hour)
+		state, err = service.runSchedule(ctx, &schedule)
+		require.NoError(t, err)
+		require.Equal(t, model.ScheduleCompleted, state)
+		require.NoError(t, db.Order("id").Find(&deals).Error)
+		require.Len(t, deals, 2)
+		require.Equal(t, model.DealProposed, deals[1].State)
+	})
+}
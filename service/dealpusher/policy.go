@@ -0,0 +1,397 @@
+package dealpusher
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/rjNemo/underscore"
+	"golang.org/x/exp/slices"
+	"gorm.io/gorm"
+)
+
+// policyCheckPeriod is how often the replicas of the pieces are checked against the replication policies.
+const policyCheckPeriod = 5 * time.Minute
+
+// replicaDealStates are the states of the deals that count as replicas for the replication policies. Pending deals
+// are counted, so a piece is not proposed to more providers while its deals are being sealed.
+var replicaDealStates = []model.DealState{model.DealProposed, model.DealPublished, model.DealActive}
+
+// ChooseProviders chooses the providers to make the missing replicas of a piece with, among the providers of a
+// replication policy. A provider is not chosen if it already holds a replica of the piece, if it is excluded, or if
+// its organization already holds MaxPerOrg replicas of the piece. Until the replicas are spread across MinRegions
+// regions, the providers in a region without a replica are preferred. The providers holding the fewest pieces are then
+// preferred, so the replicas are spread evenly, and ties are broken by the order of the providers in the policy.
+//
+// Parameters:
+//   - policy: The replication policy.
+//   - holders: The providers that hold a replica of the piece, or are being proposed one.
+//   - excluded: The providers that cannot be chosen for the piece, i.e. because they rejected it.
+//   - load: The number of pieces each provider holds or is being proposed. It is updated with the chosen providers.
+//
+// Returns:
+//   - The chosen providers. There are fewer than the missing replicas if the providers cannot satisfy the policy.
+func ChooseProviders(
+	policy model.ReplicationPolicy,
+	holders []string,
+	excluded map[string]struct{},
+	load map[string]int,
+) []string {
+	byProvider := make(map[string]model.PolicyProvider)
+	for _, provider := range policy.Providers {
+		byProvider[provider.Provider] = provider
+	}
+	orgOf := func(provider string) string {
+		if org := byProvider[provider].Org; org != "" {
+			return org
+		}
+		return provider
+	}
+
+	taken := make(map[string]struct{})
+	orgs := make(map[string]int)
+	regions := make(map[string]struct{})
+	take := func(provider string) {
+		taken[provider] = struct{}{}
+		orgs[orgOf(provider)]++
+		if region := byProvider[provider].Region; region != "" {
+			regions[region] = struct{}{}
+		}
+	}
+	for _, holder := range holders {
+		take(holder)
+	}
+	newRegion := func(provider model.PolicyProvider) bool {
+		if provider.Region == "" || len(regions) >= policy.MinRegions {
+			return false
+		}
+		_, ok := regions[provider.Region]
+		return !ok
+	}
+
+	var chosen []string
+	for missing := policy.Replicas - len(taken); missing > 0; missing-- {
+		var best *model.PolicyProvider
+		for i := range policy.Providers {
+			candidate := &policy.Providers[i]
+			if _, ok := taken[candidate.Provider]; ok {
+				continue
+			}
+			if _, ok := excluded[candidate.Provider]; ok {
+				continue
+			}
+			if policy.MaxPerOrg > 0 && orgs[orgOf(candidate.Provider)] >= policy.MaxPerOrg {
+				continue
+			}
+			if best == nil ||
+				newRegion(*candidate) && !newRegion(*best) ||
+				newRegion(*candidate) == newRegion(*best) && load[candidate.Provider] < load[best.Provider] {
+				best = candidate
+			}
+		}
+		if best == nil {
+			break
+		}
+		take(best.Provider)
+		load[best.Provider]++
+		chosen = append(chosen, best.Provider)
+	}
+	return chosen
+}
+
+// SelectProviders chooses the providers of the provider reputations a replication policy with AutoSelect also makes
+// replicas with. A provider is eligible if it is listed by the provider directory and reachable, is in one of the
+// regions of the policy, accepts the piece size of the preparation, and asks at most the price per GiB per epoch of
+// the policy for the deals. Its retrieval success rate is the lower of the success rates of the Spark retrieval
+// checks and of the retrieval proofs, and must be known and at least the minimum of the policy, if any. The eligible
+// providers are ranked by retrieval success rate, the providers with an unknown rate last, then by ask price, and
+// the best AutoSelect providers not in the policy already are chosen. The region of the chosen providers is the one of the
+// provider directory, and they are their own organization.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: The database connection.
+//   - policy: The replication policy.
+//
+// Returns:
+//   - The chosen providers, best first.
+//   - An error, if any occurred during the operation.
+func SelectProviders(ctx context.Context, db *gorm.DB, policy model.ReplicationPolicy) (model.PolicyProviders, error) {
+	if policy.AutoSelect <= 0 {
+		return nil, nil
+	}
+	db = db.WithContext(ctx)
+	var preparation model.Preparation
+	err := db.First(&preparation, policy.PreparationID).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find preparation of policy")
+	}
+	priceColumn := "price"
+	if policy.Verified {
+		priceColumn = "verified_price"
+	}
+	query := db.Where("listed = ? AND reachable = ?", true, true).
+		Where("min_piece_size <= ? AND (max_piece_size = 0 OR max_piece_size >= ?)", preparation.PieceSize, preparation.PieceSize).
+		Where(priceColumn+" <= ?", policy.PricePerGBEpoch)
+	if len(policy.Regions) > 0 {
+		query = query.Where("region IN ?", []string(policy.Regions))
+	}
+	var reputations []model.ProviderReputation
+	err = query.Find(&reputations).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find provider reputations")
+	}
+
+	price := func(reputation model.ProviderReputation) float64 {
+		if policy.Verified {
+			return reputation.VerifiedPrice
+		}
+		return reputation.Price
+	}
+	// The retrieval success rate of a provider is the lower of the rates of the retrieval checks and of the retrieval
+	// proofs that are trusted, or unknown if neither is
+	rate := func(reputation model.ProviderReputation) (float64, bool) {
+		value, known := 1.0, false
+		if reputation.RetrievalTotal >= minRetrievalChecks {
+			value, known = math.Min(value, reputation.RetrievalSuccessRate), true
+		}
+		if reputation.ProofTotal >= minProofChecks {
+			value, known = math.Min(value, reputation.ProofSuccessRate), true
+		}
+		return value, known
+	}
+	if policy.MinRetrievalRate > 0 {
+		reputations = underscore.Filter(reputations, func(reputation model.ProviderReputation) bool {
+			value, known := rate(reputation)
+			return known && value >= policy.MinRetrievalRate
+		})
+	}
+	sort.SliceStable(reputations, func(i, j int) bool {
+		a, b := reputations[i], reputations[j]
+		rateA, knownA := rate(a)
+		rateB, knownB := rate(b)
+		if knownA != knownB {
+			return knownA
+		}
+		if rateA != rateB {
+			return rateA > rateB
+		}
+		if price(a) != price(b) {
+			return price(a) < price(b)
+		}
+		return a.Provider < b.Provider
+	})
+
+	inPolicy := make(map[string]struct{})
+	for _, provider := range policy.Providers {
+		inPolicy[provider.Provider] = struct{}{}
+	}
+	var chosen model.PolicyProviders
+	for _, reputation := range reputations {
+		if len(chosen) >= policy.AutoSelect {
+			break
+		}
+		if _, ok := inPolicy[reputation.Provider]; ok {
+			continue
+		}
+		chosen = append(chosen, model.PolicyProvider{Provider: reputation.Provider, Region: reputation.Region})
+	}
+	return chosen, nil
+}
+
+// ApplyPolicy checks the replicas of the pieces of a preparation against its replication policy, and assigns the
+// pieces that are missing replicas to the providers chosen by ChooseProviders. A piece is assigned to a provider by
+// adding it to the allowed pieces of the schedule of the policy for the provider, which is created if needed, and
+// made active again if it has completed, so the deal pusher proposes the deal.
+//
+// The replicas of a piece are its proposed, published and active deals with any provider, and the pieces allowed by
+// the active schedules of the policy that are yet to be proposed. Replicas that expire or are slashed are therefore
+// made again, with the same provider if it is still the best choice. Providers that rejected a piece are not chosen
+// for it again, and neither are the providers whose schedule of the policy was paused or failed. Deals that failed
+// model.UnretrievableProofFailures retrieval proofs in a row are not counted as replicas, and their provider is not
+// chosen for the piece again, so the piece is repaired with another provider. With AutoSelect, the providers chosen by
+// SelectProviders are chosen from after the providers of the policy.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: The database connection.
+//   - policy: The replication policy.
+//
+// Returns:
+//   - The number of replicas assigned to providers.
+//   - An error, if any occurred during the operation.
+func ApplyPolicy(ctx context.Context, db *gorm.DB, policy model.ReplicationPolicy) (int, error) {
+	db = db.WithContext(ctx)
+	if policy.AutoSelect > 0 {
+		selected, err := SelectProviders(ctx, db, policy)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		policy.Providers = append(slices.Clone(policy.Providers), selected...)
+	}
+
+	var pieceCIDs []model.CID
+	err := db.Model(&model.Car{}).Where("preparation_id = ? AND aggregate_id IS NULL", policy.PreparationID).
+		Pluck("piece_cid", &pieceCIDs).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find pieces of preparation")
+	}
+	preparationPieces := db.Model(&model.Car{}).Select("piece_cid").Where("preparation_id = ?", policy.PreparationID)
+
+	var deals []model.Deal
+	err = db.Select("piece_cid", "provider", "state", "failed_proofs").
+		Where("piece_cid IN (?) AND state IN ?", preparationPieces,
+			append(slices.Clone(replicaDealStates), model.DealRejected)).
+		Find(&deals).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find deals of preparation")
+	}
+
+	var schedules []model.Schedule
+	err = db.Where("policy_id = ?", policy.ID).Find(&schedules).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find schedules of policy")
+	}
+
+	holders := make(map[string]map[string]struct{})
+	excluded := make(map[string]map[string]struct{})
+	hold := func(pieceCID string, provider string) {
+		if holders[pieceCID] == nil {
+			holders[pieceCID] = make(map[string]struct{})
+		}
+		holders[pieceCID][provider] = struct{}{}
+	}
+	for _, deal := range deals {
+		pieceCID := deal.PieceCID.String()
+		if deal.State != model.DealRejected && deal.FailedProofs < model.UnretrievableProofFailures {
+			hold(pieceCID, deal.Provider)
+			continue
+		}
+		if excluded[pieceCID] == nil {
+			excluded[pieceCID] = make(map[string]struct{})
+		}
+		excluded[pieceCID][deal.Provider] = struct{}{}
+	}
+
+	schedulesByProvider := make(map[string]*model.Schedule)
+	unavailable := make(map[string]struct{})
+	for i, schedule := range schedules {
+		schedulesByProvider[schedule.Provider] = &schedules[i]
+		switch schedule.State {
+		case model.ScheduleActive, model.SchedulePendingApproval:
+			for _, pieceCID := range schedule.AllowedPieceCIDs {
+				hold(pieceCID, schedule.Provider)
+			}
+		case model.ScheduleCompleted:
+		default:
+			unavailable[schedule.Provider] = struct{}{}
+		}
+	}
+
+	load := make(map[string]int)
+	for _, providers := range holders {
+		for provider := range providers {
+			load[provider]++
+		}
+	}
+
+	assigned := make(map[string][]string)
+	var numAssigned int
+	for _, pieceCID := range underscore.Unique(underscore.Map(pieceCIDs, func(c model.CID) string { return c.String() })) {
+		pieceExcluded := make(map[string]struct{})
+		for provider := range excluded[pieceCID] {
+			pieceExcluded[provider] = struct{}{}
+		}
+		for provider := range unavailable {
+			pieceExcluded[provider] = struct{}{}
+		}
+		var pieceHolders []string
+		for provider := range holders[pieceCID] {
+			pieceHolders = append(pieceHolders, provider)
+		}
+		chosen := ChooseProviders(policy, pieceHolders, pieceExcluded, load)
+		if len(pieceHolders)+len(chosen) < policy.Replicas {
+			Logger.Warnw("not enough providers to satisfy the replication policy", "preparation", policy.PreparationID,
+				"pieceCID", pieceCID, "replicas", len(pieceHolders)+len(chosen), "wanted", policy.Replicas)
+		}
+		for _, provider := range chosen {
+			assigned[provider] = append(assigned[provider], pieceCID)
+			numAssigned++
+		}
+	}
+
+	for provider, newPieceCIDs := range assigned {
+		schedule, ok := schedulesByProvider[provider]
+		if !ok {
+			state := model.ScheduleActive
+			if policy.NeedsApproval {
+				state = model.SchedulePendingApproval
+			}
+			schedule = &model.Schedule{
+				PreparationID:      policy.PreparationID,
+				PolicyID:           &policy.ID,
+				Provider:           provider,
+				URLTemplate:        policy.URLTemplate,
+				PricePerGBEpoch:    policy.PricePerGBEpoch,
+				PricePerGB:         policy.PricePerGB,
+				PricePerDeal:       policy.PricePerDeal,
+				Verified:           policy.Verified,
+				KeepUnsealed:       policy.KeepUnsealed,
+				AnnounceToIPNI:     policy.AnnounceToIPNI,
+				StartDelay:         policy.StartDelay,
+				Duration:           policy.Duration,
+				State:              state,
+				MaxRejectedRetries: 3,
+				RejectedRetryDelay: time.Hour,
+				RequestedBy:        policy.RequestedBy,
+				Notes:              "Created by the replication policy of the preparation",
+				AllowedPieceCIDs:   newPieceCIDs,
+			}
+			err = database.DoRetry(ctx, func() error { return db.Create(schedule).Error })
+			if err != nil {
+				return 0, errors.Wrapf(err, "failed to create schedule for provider %s", provider)
+			}
+			Logger.Infow("created schedule for replication policy", "schedule_id", schedule.ID,
+				"provider", provider, "pieces", len(newPieceCIDs))
+			continue
+		}
+
+		updates := map[string]any{
+			"allowed_piece_cids": model.StringSlice(underscore.Unique(append(schedule.AllowedPieceCIDs, newPieceCIDs...))),
+		}
+		if schedule.State == model.ScheduleCompleted {
+			updates["state"] = model.ScheduleActive
+		}
+		err = database.DoRetry(ctx, func() error { return db.Model(schedule).Updates(updates).Error })
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to update schedule %d", schedule.ID)
+		}
+		Logger.Infow("assigned pieces to schedule of replication policy", "schedule_id", schedule.ID,
+			"provider", provider, "pieces", len(newPieceCIDs))
+	}
+	return numAssigned, nil
+}
+
+// applyPolicies applies all replication policies. Errors are logged, so one policy does not hold up the others.
+func (d *DealPusher) applyPolicies(ctx context.Context) {
+	var policies []model.ReplicationPolicy
+	err := d.dbNoContext.WithContext(ctx).Find(&policies).Error
+	if err != nil {
+		Logger.Errorw("failed to get replication policies", "error", err)
+		return
+	}
+	for _, policy := range policies {
+		assigned, err := ApplyPolicy(ctx, d.dbNoContext, policy)
+		if err != nil {
+			Logger.Errorw("failed to apply replication policy", "preparation", policy.PreparationID, "error", err)
+			continue
+		}
+		if assigned > 0 {
+			Logger.Infow("assigned replicas for replication policy", "preparation", policy.PreparationID, "replicas", assigned)
+		}
+	}
+}
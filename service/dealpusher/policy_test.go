@@ -0,0 +1,281 @@
+package dealpusher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/rjNemo/underscore"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestChooseProviders(t *testing.T) {
+	policy := model.ReplicationPolicy{
+		Replicas:   3,
+		MaxPerOrg:  1,
+		MinRegions: 2,
+		Providers: model.PolicyProviders{
+			{Provider: "f01", Org: "acme", Region: "eu"},
+			{Provider: "f02", Org: "acme", Region: "us"},
+			{Provider: "f03", Org: "globex", Region: "eu"},
+			{Provider: "f04", Org: "initech", Region: "us"},
+			{Provider: "f05"},
+		},
+	}
+
+	t.Run("spread across orgs and regions", func(t *testing.T) {
+		chosen := ChooseProviders(policy, nil, nil, map[string]int{})
+		// f02 is an org already used once f01 is chosen, f04 brings the second region
+		require.Equal(t, []string{"f01", "f04", "f03"}, chosen)
+	})
+
+	t.Run("holders count towards the replicas, orgs and regions", func(t *testing.T) {
+		chosen := ChooseProviders(policy, []string{"f03"}, nil, map[string]int{})
+		require.Equal(t, []string{"f02", "f04"}, chosen)
+	})
+
+	t.Run("excluded and loaded providers", func(t *testing.T) {
+		load := map[string]int{"f04": 10}
+		chosen := ChooseProviders(policy, []string{"f03"}, map[string]struct{}{"f02": {}}, load)
+		require.Equal(t, []string{"f04", "f01"}, chosen)
+		require.Equal(t, 11, load["f04"])
+		require.Equal(t, 1, load["f01"])
+	})
+
+	t.Run("not enough providers", func(t *testing.T) {
+		policy := policy
+		policy.Replicas = 10
+		chosen := ChooseProviders(policy, nil, nil, map[string]int{})
+		require.Len(t, chosen, 4)
+	})
+
+	t.Run("enough replicas", func(t *testing.T) {
+		chosen := ChooseProviders(policy, []string{"f01", "f03", "f99"}, nil, map[string]int{})
+		require.Empty(t, chosen)
+	})
+}
+
+func TestApplyPolicy(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		preparation := model.Preparation{Name: "prep"}
+		require.NoError(t, db.Create(&preparation).Error)
+		require.NoError(t, db.Create(&model.Wallet{ID: "f0client"}).Error)
+		pieceCIDs := make([]model.CID, 3)
+		for i := range pieceCIDs {
+			pieceCIDs[i] = model.CID(calculateCommp(t, generateRandomBytes(1000), 1024))
+			require.NoError(t, db.Create(&model.Car{
+				PreparationID: preparation.ID,
+				PieceCID:      pieceCIDs[i],
+				PieceSize:     1024,
+			}).Error)
+		}
+		policy := model.ReplicationPolicy{
+			PreparationID: preparation.ID,
+			Replicas:      2,
+			Providers: model.PolicyProviders{
+				{Provider: "f01", Region: "eu"},
+				{Provider: "f02", Region: "us"},
+				{Provider: "f03", Region: "eu"},
+			},
+			MinRegions: 2,
+			Verified:   true,
+		}
+		require.NoError(t, db.Create(&policy).Error)
+
+		// The first piece already has a replica with f01, the second was rejected by f02
+		require.NoError(t, db.Create(&model.Deal{
+			PieceCID: pieceCIDs[0], Provider: "f01", State: model.DealActive, ClientID: "f0client",
+		}).Error)
+		require.NoError(t, db.Create(&model.Deal{
+			PieceCID: pieceCIDs[1], Provider: "f02", State: model.DealRejected, ClientID: "f0client",
+		}).Error)
+
+		assigned, err := ApplyPolicy(ctx, db, policy)
+		require.NoError(t, err)
+		require.Equal(t, 5, assigned)
+
+		var schedules []model.Schedule
+		require.NoError(t, db.Order("provider").Find(&schedules).Error)
+		allowed := make(map[string][]string)
+		for _, schedule := range schedules {
+			require.Equal(t, policy.ID, *schedule.PolicyID)
+			require.Equal(t, model.ScheduleActive, schedule.State)
+			require.True(t, schedule.Verified)
+			allowed[schedule.Provider] = schedule.AllowedPieceCIDs
+		}
+		require.ElementsMatch(t, []string{pieceCIDs[0].String(), pieceCIDs[2].String()}, allowed["f02"])
+		require.ElementsMatch(t, []string{pieceCIDs[1].String()}, allowed["f01"])
+		require.ElementsMatch(t, []string{pieceCIDs[1].String(), pieceCIDs[2].String()}, allowed["f03"])
+
+		// Nothing is missing while the schedules are running
+		assigned, err = ApplyPolicy(ctx, db, policy)
+		require.NoError(t, err)
+		require.Zero(t, assigned)
+
+		// Once the replica of the first piece with f01 expires, it is proposed again through the completed schedule
+		require.NoError(t, db.Model(&model.Schedule{}).Where("true").Update("state", model.ScheduleCompleted).Error)
+		require.NoError(t, db.Create(&[]model.Deal{
+			{PieceCID: pieceCIDs[0], Provider: "f02", State: model.DealActive, ClientID: "f0client"},
+			{PieceCID: pieceCIDs[1], Provider: "f01", State: model.DealActive, ClientID: "f0client"},
+			{PieceCID: pieceCIDs[1], Provider: "f03", State: model.DealActive, ClientID: "f0client"},
+			{PieceCID: pieceCIDs[2], Provider: "f02", State: model.DealActive, ClientID: "f0client"},
+			{PieceCID: pieceCIDs[2], Provider: "f03", State: model.DealPublished, ClientID: "f0client"},
+		}).Error)
+		require.NoError(t, db.Model(&model.Deal{}).Where("piece_cid = ? AND provider = ?", pieceCIDs[0], "f01").
+			Update("state", model.DealExpired).Error)
+		assigned, err = ApplyPolicy(ctx, db, policy)
+		require.NoError(t, err)
+		require.Equal(t, 1, assigned)
+		findSchedule := func(provider string) model.Schedule {
+			var schedule model.Schedule
+			require.NoError(t, db.Where("provider = ?", provider).First(&schedule).Error)
+			return schedule
+		}
+		schedule := findSchedule("f01")
+		require.Equal(t, model.ScheduleActive, schedule.State)
+		require.ElementsMatch(t, []string{pieceCIDs[0].String(), pieceCIDs[1].String()}, schedule.AllowedPieceCIDs)
+
+		// Paused schedules are not given more pieces, so the missing replicas go to the other providers
+		require.NoError(t, db.Model(&schedule).Update("state", model.SchedulePaused).Error)
+		require.NoError(t, db.Model(&model.Deal{}).Where("piece_cid = ? AND provider = ?", pieceCIDs[2], "f03").
+			Update("state", model.DealSlashed).Error)
+		assigned, err = ApplyPolicy(ctx, db, policy)
+		require.NoError(t, err)
+		require.Equal(t, 2, assigned)
+		schedule = findSchedule("f01")
+		require.Equal(t, model.SchedulePaused, schedule.State)
+		schedule = findSchedule("f03")
+		require.Equal(t, model.ScheduleActive, schedule.State)
+		require.ElementsMatch(t, []string{pieceCIDs[0].String(), pieceCIDs[1].String(), pieceCIDs[2].String()}, schedule.AllowedPieceCIDs)
+
+		// A policy above the approval threshold creates its schedules pending approval
+		policy.NeedsApproval = true
+		policy.Replicas = 3
+		policy.Providers = append(policy.Providers, model.PolicyProvider{Provider: "f04", Region: "us"})
+		require.NoError(t, db.Save(&policy).Error)
+		assigned, err = ApplyPolicy(ctx, db, policy)
+		require.NoError(t, err)
+		require.Equal(t, 3, assigned)
+		schedule = findSchedule("f04")
+		require.Equal(t, model.SchedulePendingApproval, schedule.State)
+		require.Len(t, schedule.AllowedPieceCIDs, 3)
+		require.Equal(t, ptr.Of(policy.ID), schedule.PolicyID)
+	})
+}
+
+func TestApplyPolicy_UnretrievableDeal(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		preparation := model.Preparation{Name: "prep"}
+		require.NoError(t, db.Create(&preparation).Error)
+		require.NoError(t, db.Create(&model.Wallet{ID: "f0client"}).Error)
+		pieceCID := model.CID(calculateCommp(t, generateRandomBytes(1000), 1024))
+		require.NoError(t, db.Create(&model.Car{PreparationID: preparation.ID, PieceCID: pieceCID, PieceSize: 1024}).Error)
+		policy := model.ReplicationPolicy{
+			PreparationID: preparation.ID,
+			Replicas:      1,
+			Providers:     model.PolicyProviders{{Provider: "f01"}, {Provider: "f02"}},
+		}
+		require.NoError(t, db.Create(&policy).Error)
+		deal := model.Deal{PieceCID: pieceCID, Provider: "f01", State: model.DealActive, ClientID: "f0client", FailedProofs: 2}
+		require.NoError(t, db.Create(&deal).Error)
+
+		assigned, err := ApplyPolicy(ctx, db, policy)
+		require.NoError(t, err)
+		require.Zero(t, assigned)
+
+		// Once the deal failed too many retrieval proofs, the piece is repaired with another provider
+		require.NoError(t, db.Model(&deal).Update("failed_proofs", model.UnretrievableProofFailures).Error)
+		assigned, err = ApplyPolicy(ctx, db, policy)
+		require.NoError(t, err)
+		require.Equal(t, 1, assigned)
+		var schedule model.Schedule
+		require.NoError(t, db.First(&schedule).Error)
+		require.Equal(t, "f02", schedule.Provider)
+		require.Equal(t, []string{pieceCID.String()}, []string(schedule.AllowedPieceCIDs))
+	})
+}
+
+func TestSelectProviders(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		preparation := model.Preparation{Name: "prep", PieceSize: 1 << 35}
+		require.NoError(t, db.Create(&preparation).Error)
+		listed := func(provider string, region string, rate float64, total int64, verifiedPrice float64) model.ProviderReputation {
+			return model.ProviderReputation{
+				Provider: provider, Listed: true, Reachable: true, Region: region,
+				RetrievalTotal: total, RetrievalSuccessRate: rate, VerifiedPrice: verifiedPrice, Price: 1,
+				MinPieceSize: 256, MaxPieceSize: 1 << 36,
+			}
+		}
+		unreachable := listed("f10", "Europe", 1, 1000, 0)
+		unreachable.Reachable = false
+		tooSmall := listed("f11", "Europe", 1, 1000, 0)
+		tooSmall.MaxPieceSize = 1 << 34
+		unlisted := listed("f12", "Europe", 1, 1000, 0)
+		unlisted.Listed = false
+		failingProofs := listed("f13", "Europe", 1, 1000, 0)
+		// The rate of the retrieval proofs is used when it is lower
+		failingProofs.ProofTotal, failingProofs.ProofSuccessRate = 20, 0.6
+		require.NoError(t, db.Create(&[]model.ProviderReputation{
+			listed("f01", "Europe", 0.95, 1000, 0),
+			listed("f02", "Europe", 0.99, 1000, 1e-9),
+			listed("f03", "Asia", 0.99, 1000, 0),
+			listed("f04", "Europe", 0.99, 1000, 0),
+			listed("f05", "Europe", 0.5, 1000, 0),
+			// Not enough retrieval checks to know the rate
+			listed("f06", "Europe", 1, 10, 0),
+			listed("f07", "North America", 1, 1000, 0),
+			unreachable, tooSmall, unlisted, failingProofs,
+		}).Error)
+
+		policy := model.ReplicationPolicy{
+			PreparationID:   preparation.ID,
+			Verified:        true,
+			PricePerGBEpoch: 1e-9,
+			AutoSelect:      10,
+			Regions:         model.StringSlice{"Europe", "Asia"},
+		}
+		chosen, err := SelectProviders(ctx, db, policy)
+		require.NoError(t, err)
+		// Ranked by retrieval success rate, then by ask price, with unknown rates last
+		require.Equal(t, []string{"f03", "f04", "f02", "f01", "f13", "f05", "f06"},
+			underscore.Map(chosen, func(p model.PolicyProvider) string { return p.Provider }))
+		require.Equal(t, model.PolicyProvider{Provider: "f03", Region: "Asia"}, chosen[0])
+
+		t.Run("min retrieval rate, price and limit", func(t *testing.T) {
+			policy := policy
+			policy.MinRetrievalRate = 0.9
+			policy.PricePerGBEpoch = 0
+			policy.AutoSelect = 2
+			policy.Providers = model.PolicyProviders{{Provider: "f03"}}
+			chosen, err := SelectProviders(ctx, db, policy)
+			require.NoError(t, err)
+			require.Equal(t, model.PolicyProviders{{Provider: "f04", Region: "Europe"}, {Provider: "f01", Region: "Europe"}}, chosen)
+		})
+
+		t.Run("regular deals", func(t *testing.T) {
+			policy := policy
+			policy.Verified = false
+			chosen, err := SelectProviders(ctx, db, policy)
+			require.NoError(t, err)
+			require.Empty(t, chosen)
+		})
+
+		t.Run("apply policy", func(t *testing.T) {
+			require.NoError(t, db.Create(&model.Car{PreparationID: preparation.ID, PieceCID: model.CID(testutil.TestCid), PieceSize: 1 << 35}).Error)
+			policy := policy
+			policy.Replicas = 2
+			policy.MinRegions = 2
+			policy.AutoSelect = 3
+			require.NoError(t, db.Create(&policy).Error)
+			assigned, err := ApplyPolicy(ctx, db, policy)
+			require.NoError(t, err)
+			require.Equal(t, 2, assigned)
+			var providers []string
+			require.NoError(t, db.Model(&model.Schedule{}).Order("provider").Pluck("provider", &providers).Error)
+			require.Equal(t, []string{"f03", "f04"}, providers)
+		})
+	})
+}
@@ -0,0 +1,47 @@
+package dealtracker
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/handler/wallet"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ybbus/jsonrpc/v3"
+	"gorm.io/gorm"
+)
+
+// TrackWalletBalances looks up the balance and the datacap of all the wallets and saves them with the wallets, so the
+// deal pusher stops proposing verified deals from the wallets whose tracked datacap runs out. The wallet balance
+// command and API only look the balances up, so the deal tracker is the only writer of the tracked balances.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: The database connection.
+//   - lotusClient: The RPC client used to interact with a Lotus node or gateway.
+//
+// Returns:
+//   - The number of wallets whose balance was saved.
+//   - An error, if the balances cannot be looked up or saved.
+func TrackWalletBalances(ctx context.Context, db *gorm.DB, lotusClient jsonrpc.RPCClient) (int, error) {
+	balances, err := wallet.Default.BalanceHandler(ctx, db, lotusClient)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	db = db.WithContext(ctx)
+	now := time.Now()
+	for i, balance := range balances {
+		err = database.DoRetry(ctx, func() error {
+			return db.Model(&model.Wallet{}).Where("id = ?", balance.ID).Updates(map[string]any{
+				"balance":            balance.Balance,
+				"datacap":            balance.Datacap,
+				"balance_updated_at": &now,
+			}).Error
+		})
+		if err != nil {
+			return i, errors.Wrapf(err, "failed to save balance of wallet %s", balance.Address)
+		}
+	}
+	return len(balances), nil
+}
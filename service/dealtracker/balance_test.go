@@ -0,0 +1,55 @@
+package dealtracker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestTrackWalletBalances(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     int    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		var result any
+		switch request.Method {
+		case "Filecoin.WalletBalance":
+			result = "1500000000000000000"
+		case "Filecoin.StateVerifiedClientStatus":
+			if request.Params[0] == "f1verified" {
+				result = "1099511627776"
+			}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": result}))
+	}))
+	defer server.Close()
+
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create([]model.Wallet{{ID: "f01", Address: "f1verified"}, {ID: "f02", Address: "f1other"}}).Error
+		require.NoError(t, err)
+
+		tracked, err := TrackWalletBalances(ctx, db, util.NewLotusClient(server.URL, ""))
+		require.NoError(t, err)
+		require.Equal(t, 2, tracked)
+
+		var wallets []model.Wallet
+		require.NoError(t, db.Order("id").Find(&wallets).Error)
+		require.Equal(t, 1.5, wallets[0].Balance)
+		require.EqualValues(t, 1<<40, wallets[0].Datacap)
+		require.NotNil(t, wallets[0].BalanceUpdatedAt)
+		require.Equal(t, 1.5, wallets[1].Balance)
+		require.Zero(t, wallets[1].Datacap)
+		require.NotNil(t, wallets[1].BalanceUpdatedAt)
+	})
+}
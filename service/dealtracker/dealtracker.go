@@ -12,10 +12,12 @@ import (
 
 	"github.com/bcicen/jstream"
 	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/budget"
 	"github.com/data-preservation-programs/singularity/database"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/service/epochutil"
 	"github.com/data-preservation-programs/singularity/service/healthcheck"
+	"github.com/data-preservation-programs/singularity/service/leaderelection"
 	"github.com/data-preservation-programs/singularity/util"
 	"github.com/dustin/go-humanize"
 	"github.com/google/uuid"
@@ -23,13 +25,13 @@ import (
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-log/v2"
 	"github.com/klauspost/compress/zstd"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/mitchellh/mapstructure"
 	"gorm.io/gorm"
 )
 
 var ErrAlreadyRunning = errors.New("another worker already running")
 
-const healthRegisterRetryInterval = time.Minute
 const cleanupTimeout = 5 * time.Second
 const logStatsInterval = 15 * time.Second
 
@@ -90,13 +92,16 @@ func (c CloserFunc) Close() error {
 var Logger = log.Logger("dealtracker")
 
 type DealTracker struct {
-	workerID    uuid.UUID
-	dbNoContext *gorm.DB
-	interval    time.Duration
-	dealZstURL  string
-	lotusURL    string
-	lotusToken  string
-	once        bool
+	workerID          uuid.UUID
+	dbNoContext       *gorm.DB
+	interval          time.Duration
+	dealZstURL        string
+	lotusURL          string
+	lotusToken        string
+	once              bool
+	retrievalStatsURL string
+	directory         ProviderDirectory
+	receiptKey        crypto.PrivKey
 }
 
 func NewDealTracker(
@@ -105,15 +110,21 @@ func NewDealTracker(
 	dealZstURL string,
 	lotusURL string,
 	lotusToken string,
-	once bool) DealTracker {
+	once bool,
+	retrievalStatsURL string,
+	directory ProviderDirectory,
+	receiptKey crypto.PrivKey) DealTracker {
 	return DealTracker{
-		workerID:    uuid.New(),
-		dbNoContext: db,
-		interval:    interval,
-		dealZstURL:  dealZstURL,
-		lotusURL:    lotusURL,
-		lotusToken:  lotusToken,
-		once:        once,
+		workerID:          uuid.New(),
+		dbNoContext:       db,
+		interval:          interval,
+		dealZstURL:        dealZstURL,
+		lotusURL:          lotusURL,
+		lotusToken:        lotusToken,
+		once:              once,
+		retrievalStatsURL: retrievalStatsURL,
+		directory:         directory,
+		receiptKey:        receiptKey,
 	}
 }
 
@@ -247,13 +258,14 @@ func (*DealTracker) Name() string {
 //
 //  1. Defines a getState function that returns a healthcheck.State with JobType set to model.DealTracking.
 //
-//  2. Registers the worker using healthcheck.Register with the provided context, dbNoContext, workerID, getState function, and false for the force flag.
-//     - If an error occurs during registration, it returns nil for the service.Done channels, nil for the service.Fail channel, and the error wrapped with an appropriate message.
-//     - If another worker is already running, it logs a warning and checks if d.once is true. If d.once is true, it returns nil for the service.Done channels,
-//     nil for the service.Fail channel, and an error indicating that another worker is already running.
+//  2. Tries to acquire the deal tracker lease using leaderelection.Elector, so that only one deal tracker replica is running.
+//     - If an error occurs, it returns the error.
+//     - If another replica holds the lease, it logs a warning and checks if d.once is true. If d.once is true, it returns an error
+//     indicating that another worker is already running.
 //
-//  3. Logs a warning message and waits for 1 minute before retrying.
-//     - If the context is done during the wait, it returns nil for the service.Done channels, nil for the service.Fail channel, and the context error.
+//  3. Stands by until the lease of the other replica expires, then registers the worker using healthcheck.Register.
+//     - If the context is done while standing by, it returns the context error.
+//     - Once elected, the lease is renewed in the background. If it cannot be renewed, the service stops and exits with leaderelection.ErrLeadershipLost.
 //
 //  4. Starts reporting health using healthcheck.StartReportHealth with the provided context, dbNoContext, workerID, and getState function in a separate goroutine.
 //
@@ -270,34 +282,28 @@ func (*DealTracker) Name() string {
 //
 //  7. Returns a list of service.Done channels containing healthcheckDone, runDone, and cleanupDone, the service.Fail channel fail, and nil for the error.
 func (d *DealTracker) Start(ctx context.Context, exitErr chan<- error) error {
-	var regTimer *time.Timer
-	for {
-		alreadyRunning, err := healthcheck.Register(ctx, d.dbNoContext, d.workerID, model.DealTracker, false)
-		if err != nil {
-			return errors.WithStack(err)
-		}
-		if !alreadyRunning {
-			break
-		}
-
+	elector := leaderelection.NewElector(d.dbNoContext, string(model.DealTracker), d.workerID.String())
+	acquired, err := elector.TryAcquire(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !acquired {
 		Logger.Warnw("another worker already running")
 		if d.once {
 			return ErrAlreadyRunning
 		}
-		Logger.Warn("retrying in 1 minute")
-		if regTimer == nil {
-			regTimer = time.NewTimer(healthRegisterRetryInterval)
-			defer regTimer.Stop()
-		} else {
-			regTimer.Reset(healthRegisterRetryInterval)
-		}
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-regTimer.C:
+		err = elector.Campaign(ctx)
+		if err != nil {
+			return errors.WithStack(err)
 		}
 	}
+	// The lease guarantees that this is the only running deal tracker, so duplicates are allowed here.
+	_, err = healthcheck.Register(ctx, d.dbNoContext, d.workerID, model.DealTracker, true)
+	if err != nil {
+		return errors.WithStack(err)
+	}
 
+	ctx, lost := elector.Hold(ctx)
 	var cancel context.CancelFunc
 	ctx, cancel = context.WithCancel(ctx)
 
@@ -357,9 +363,17 @@ func (d *DealTracker) Start(ctx context.Context, exitErr chan<- error) error {
 		} else {
 			Logger.Info("cleanup done")
 		}
+		//nolint:contextcheck
+		err = elector.Release(ctx2)
+		if err != nil {
+			Logger.Errorw("failed to release lease", "error", err)
+		}
 
 		<-healthcheckDone
 
+		if runErr == nil && lost() {
+			runErr = leaderelection.ErrLeadershipLost
+		}
 		if exitErr != nil {
 			exitErr <- runErr
 		}
@@ -403,6 +417,7 @@ type UnknownDeal struct {
 //  9. In trackDeal's callback, insert new deals found on-chain that don't exist in the local database.
 //  10. Mark all expired active deals as 'expired' in the local database.
 //  11. Mark all expired deal proposals as 'proposal_expired' in the local database.
+//  12. Issue receipts for pieces that reached their replication target, and ingest the retrieval stats of providers.
 //
 // Parameters:
 //
@@ -576,23 +591,55 @@ func (d *DealTracker) runOnce(ctx context.Context) error {
 		return errors.WithStack(err)
 	}
 
-	// Mark all expired active deals as expired
-	result := db.Model(&model.Deal{}).
-		Where("end_epoch < ? AND state = 'active'", lastEpoch).
-		Update("state", model.DealExpired)
-	if result.Error != nil {
+	// Mark all expired active deals and deal proposals
+	expired, err := ExpireDeals(ctx, db, lastEpoch)
+	if err != nil {
 		return errors.WithStack(err)
 	}
-	Logger.Infof("marked %d deals as expired", result.RowsAffected)
+	var proposalExpired int
+	for _, deal := range expired {
+		if deal.State == model.DealProposalExpired {
+			proposalExpired++
+		}
+	}
+	Logger.Infof("marked %d deals as expired", len(expired)-proposalExpired)
+	Logger.Infof("marked %d deal as proposal_expired", proposalExpired)
 
-	// Mark all expired deal proposals
-	result = db.Model(&model.Deal{}).
-		Where("state in ('proposed', 'published') AND start_epoch < ?", lastEpoch).
-		Update("state", model.DealProposalExpired)
-	if result.Error != nil {
-		return errors.WithStack(err)
+	// Expired proposals no longer spend the datacap and FIL reserved from the budgets by the deal pusher
+	err = budget.ReleaseDeals(ctx, db, expired)
+	if err != nil {
+		Logger.Errorw("failed to release budgets of expired deal proposals", "error", err)
+	}
+
+	issued, err := IssueReceipts(ctx, db, d.receiptKey)
+	if err != nil {
+		Logger.Errorw("failed to issue piece receipts", "error", err)
+	}
+	Logger.Infof("issued %d piece receipts", issued)
+
+	// The directory is ingested first, so the retrieval stats of the providers it lists are ingested too
+	if d.directory != nil {
+		listed, err := IngestProviderDirectory(ctx, db, d.directory)
+		if err != nil {
+			Logger.Errorw("failed to ingest provider directory", "error", err)
+		}
+		Logger.Infof("updated %d providers from the provider directory", listed)
+	}
+
+	if d.retrievalStatsURL != "" {
+		ingested, err := IngestRetrievalStats(ctx, db, d.retrievalStatsURL, headTime)
+		if err != nil {
+			Logger.Errorw("failed to ingest retrieval stats", "error", err)
+		}
+		Logger.Infof("updated retrieval stats of %d providers", ingested)
+	}
+
+	// The deal pusher stops proposing verified deals from the wallets whose tracked datacap runs out
+	tracked, err := TrackWalletBalances(ctx, db, util.NewLotusClient(d.lotusURL, d.lotusToken))
+	if err != nil {
+		Logger.Errorw("failed to track wallet balances", "error", err)
 	}
-	Logger.Infof("marked %d deal as proposal_expired", result.RowsAffected)
+	Logger.Infof("tracked the balance and datacap of %d wallets", tracked)
 
 	return nil
 }
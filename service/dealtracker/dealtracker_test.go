@@ -40,13 +40,13 @@ func setupTestServerWithBody(t *testing.T, b string) (string, Closer) {
 }
 
 func TestDealTracker_Name(t *testing.T) {
-	tracker := NewDealTracker(nil, time.Minute, "", "", "", true)
+	tracker := NewDealTracker(nil, time.Minute, "", "", "", true, "", nil, nil)
 	require.Equal(t, "DealTracker", tracker.Name())
 }
 
 func TestDealTracker_Start(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		tracker := NewDealTracker(db, time.Minute, "", "", "", true)
+		tracker := NewDealTracker(db, time.Minute, "", "", "", true, "", nil, nil)
 		exitErr := make(chan error, 1)
 		ctx, cancel := context.WithCancel(ctx)
 		err := tracker.Start(ctx, exitErr)
@@ -59,8 +59,8 @@ func TestDealTracker_Start(t *testing.T) {
 
 func TestDealTracker_MultipleRunning_Once(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		tracker1 := NewDealTracker(db, time.Minute, "", "", "", false)
-		tracker2 := NewDealTracker(db, time.Minute, "", "", "", true)
+		tracker1 := NewDealTracker(db, time.Minute, "", "", "", false, "", nil, nil)
+		tracker2 := NewDealTracker(db, time.Minute, "", "", "", true, "", nil, nil)
 		exitErr := make(chan error, 1)
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
@@ -75,8 +75,8 @@ func TestDealTracker_MultipleRunning_Once(t *testing.T) {
 
 func TestDealTracker_MultipleRunning(t *testing.T) {
 	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
-		tracker1 := NewDealTracker(db, time.Minute, "", "", "", false)
-		tracker2 := NewDealTracker(db, time.Minute, "", "", "", false)
+		tracker1 := NewDealTracker(db, time.Minute, "", "", "", false, "", nil, nil)
+		tracker2 := NewDealTracker(db, time.Minute, "", "", "", false, "", nil, nil)
 		ctx, cancel := context.WithTimeout(ctx, time.Second)
 		defer cancel()
 		exitErr1 := make(chan error, 1)
@@ -138,7 +138,7 @@ func TestDealStateStreamFromHttpRequest_UnCompressed(t *testing.T) {
 func TestTrackDeal(t *testing.T) {
 	url, server := setupTestServer(t)
 	defer server.Close()
-	tracker := NewDealTracker(nil, 0, url, "", "", true)
+	tracker := NewDealTracker(nil, 0, url, "", "", true, "", nil, nil)
 	var deals []Deal
 	callback := func(dealID uint64, deal Deal) error {
 		deals = append(deals, deal)
@@ -337,7 +337,7 @@ func TestRunOnce(t *testing.T) {
 		url, server := setupTestServerWithBody(t, string(body))
 		defer server.Close()
 		require.NoError(t, err)
-		tracker := NewDealTracker(db, time.Minute, url, "https://api.node.glif.io/", "", true)
+		tracker := NewDealTracker(db, time.Minute, url, "https://api.node.glif.io/", "", true, "", nil, nil)
 		err = tracker.runOnce(context.Background())
 		require.NoError(t, err)
 		var allDeals []model.Deal
@@ -0,0 +1,220 @@
+package dealtracker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultProviderDirectoryURL is the public list of storage providers of filrep.io.
+const DefaultProviderDirectoryURL = "https://api.filrep.io/api/v1/miners"
+
+// directoryPageSize is the number of providers requested from the provider directory at a time.
+const directoryPageSize = 1000
+
+// DirectoryProvider is a storage provider listed by a provider directory.
+type DirectoryProvider struct {
+	Provider      string
+	Reachable     bool
+	Region        string
+	Country       string
+	Price         float64 // Ask price for regular deals in FIL per GiB per epoch
+	VerifiedPrice float64 // Ask price for verified deals in FIL per GiB per epoch
+	MinPieceSize  int64
+	MaxPieceSize  int64
+}
+
+// ProviderDirectory is a source of the storage providers that deals can be made with, such as filrep.io.
+type ProviderDirectory interface {
+	// URL identifies the directory in the provider reputations.
+	URL() string
+	// Providers lists the storage providers of the directory.
+	Providers(ctx context.Context) ([]DirectoryProvider, error)
+}
+
+// FilRep is a ProviderDirectory that lists the storage providers of a filrep.io compatible API.
+type FilRep struct {
+	url string
+}
+
+// NewFilRep creates a FilRep directory with the URL of the miners endpoint of the API.
+func NewFilRep(url string) FilRep {
+	return FilRep{url: url}
+}
+
+func (f FilRep) URL() string {
+	return f.url
+}
+
+// filRepMiner is a storage provider listed by filrep.io. The prices are in attoFIL per GiB per epoch and the sizes in
+// bytes, and are encoded as strings.
+type filRepMiner struct {
+	Address       string      `json:"address"`
+	Reachability  string      `json:"reachability"`
+	Region        string      `json:"region"`
+	IsoCode       string      `json:"isoCode"`
+	Price         json.Number `json:"price"`
+	VerifiedPrice json.Number `json:"verifiedPrice"`
+	MinPieceSize  json.Number `json:"minPieceSize"`
+	MaxPieceSize  json.Number `json:"maxPieceSize"`
+}
+
+type filRepResponse struct {
+	Miners []filRepMiner `json:"miners"`
+}
+
+// Providers lists the storage providers of the API, requesting them a page at a time with the limit and offset query
+// parameters.
+func (f FilRep) Providers(ctx context.Context) ([]DirectoryProvider, error) {
+	u, err := url.Parse(f.url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid provider directory URL %s", f.url)
+	}
+	var providers []DirectoryProvider
+	for offset := 0; ; offset += directoryPageSize {
+		query := u.Query()
+		query.Set("limit", strconv.Itoa(directoryPageSize))
+		query.Set("offset", strconv.Itoa(offset))
+		u.RawQuery = query.Encode()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		req.Header.Set("Accept", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get providers from %s", f.url)
+		}
+		var page filRepResponse
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, errors.Newf("failed to get providers from %s: %s", f.url, resp.Status)
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode providers from %s", f.url)
+		}
+		for _, miner := range page.Miners {
+			provider := DirectoryProvider{
+				Provider:  miner.Address,
+				Reachable: miner.Reachability == "reachable",
+				Region:    miner.Region,
+				Country:   miner.IsoCode,
+			}
+			provider.Price, err = parseAttoFIL(miner.Price)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid price of %s", miner.Address)
+			}
+			provider.VerifiedPrice, err = parseAttoFIL(miner.VerifiedPrice)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid verified price of %s", miner.Address)
+			}
+			provider.MinPieceSize, err = parseSize(miner.MinPieceSize)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid min piece size of %s", miner.Address)
+			}
+			provider.MaxPieceSize, err = parseSize(miner.MaxPieceSize)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid max piece size of %s", miner.Address)
+			}
+			providers = append(providers, provider)
+		}
+		if len(page.Miners) < directoryPageSize {
+			return providers, nil
+		}
+	}
+}
+
+func parseAttoFIL(n json.Number) (float64, error) {
+	if n == "" {
+		return 0, nil
+	}
+	v, err := n.Float64()
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return v / 1e18, nil
+}
+
+func parseSize(n json.Number) (int64, error) {
+	if n == "" {
+		return 0, nil
+	}
+	v, err := n.Int64()
+	return v, errors.WithStack(err)
+}
+
+// IngestProviderDirectory lists the storage providers of a provider directory and stores their region, ask price and
+// piece sizes in their reputation, so the replication policies can choose providers from them. The providers that are
+// no longer listed by the directory are unlisted, and keep their retrieval metrics.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: The database connection.
+//   - directory: The provider directory.
+//
+// Returns:
+//   - The number of providers listed by the directory.
+//   - An error, if any occurred during the operation.
+func IngestProviderDirectory(ctx context.Context, db *gorm.DB, directory ProviderDirectory) (int, error) {
+	db = db.WithContext(ctx)
+	providers, err := directory.Providers(ctx)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	listed := make([]string, 0, len(providers))
+	reputations := make([]model.ProviderReputation, 0, len(providers))
+	for _, provider := range providers {
+		if provider.Provider == "" {
+			continue
+		}
+		listed = append(listed, provider.Provider)
+		reputations = append(reputations, model.ProviderReputation{
+			Provider:      provider.Provider,
+			Listed:        true,
+			Reachable:     provider.Reachable,
+			Region:        provider.Region,
+			Country:       provider.Country,
+			Price:         provider.Price,
+			VerifiedPrice: provider.VerifiedPrice,
+			MinPieceSize:  provider.MinPieceSize,
+			MaxPieceSize:  provider.MaxPieceSize,
+			Directory:     directory.URL(),
+		})
+	}
+	if len(reputations) == 0 {
+		return 0, nil
+	}
+
+	err = database.DoRetry(ctx, func() error {
+		return db.Transaction(func(db *gorm.DB) error {
+			err := db.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "provider"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"updated_at", "listed", "reachable", "region", "country", "price", "verified_price",
+					"min_piece_size", "max_piece_size", "directory",
+				}),
+			}).CreateInBatches(reputations, 50).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return db.Model(&model.ProviderReputation{}).
+				Where("listed = ? AND provider NOT IN ?", true, listed).
+				Update("listed", false).Error
+		})
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to save provider directory")
+	}
+	return len(reputations), nil
+}
@@ -0,0 +1,81 @@
+package dealtracker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestIngestProviderDirectory(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		miners := `{"miners":[
+			{"address":"f01","reachability":"reachable","region":"Europe","isoCode":"DE","price":"20000000000","verifiedPrice":"0","minPieceSize":"256","maxPieceSize":"34359738368"},
+			{"address":"f02","reachability":"unreachable","region":"Asia","isoCode":"CN","price":"0","verifiedPrice":"0","minPieceSize":"1073741824","maxPieceSize":"68719476736"}
+		]}`
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "1000", r.URL.Query().Get("limit"))
+			require.Equal(t, "0", r.URL.Query().Get("offset"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(miners))
+		}))
+		defer server.Close()
+
+		// Providers keep their retrieval metrics
+		require.NoError(t, db.Create(&model.ProviderReputation{Provider: "f01", RetrievalTotal: 100}).Error)
+		count, err := IngestProviderDirectory(ctx, db, NewFilRep(server.URL))
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		var reputations []model.ProviderReputation
+		require.NoError(t, db.Order("provider asc").Find(&reputations).Error)
+		require.Len(t, reputations, 2)
+		require.True(t, reputations[0].Listed)
+		require.True(t, reputations[0].Reachable)
+		require.Equal(t, "Europe", reputations[0].Region)
+		require.Equal(t, "DE", reputations[0].Country)
+		require.InDelta(t, 2e-8, reputations[0].Price, 1e-20)
+		require.Zero(t, reputations[0].VerifiedPrice)
+		require.EqualValues(t, 256, reputations[0].MinPieceSize)
+		require.EqualValues(t, 1<<35, reputations[0].MaxPieceSize)
+		require.EqualValues(t, 100, reputations[0].RetrievalTotal)
+		require.Equal(t, server.URL, reputations[0].Directory)
+		require.False(t, reputations[1].Reachable)
+
+		// The retrieval stats of the listed providers are ingested too
+		stats := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[{"miner_id":"f02","total":"200","successful":"150"}]`))
+		}))
+		defer stats.Close()
+		count, err = IngestRetrievalStats(ctx, db, stats.URL, time.Now())
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		// Providers no longer listed are unlisted
+		miners = `{"miners":[{"address":"f02","reachability":"reachable","region":"Asia"}]}`
+		count, err = IngestProviderDirectory(ctx, db, NewFilRep(server.URL))
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.NoError(t, db.Order("provider asc").Find(&reputations).Error)
+		require.False(t, reputations[0].Listed)
+		require.True(t, reputations[1].Listed)
+		require.True(t, reputations[1].Reachable)
+		require.EqualValues(t, 200, reputations[1].RetrievalTotal)
+	})
+}
+
+func TestIngestProviderDirectory_Error(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+		_, err := IngestProviderDirectory(ctx, db, NewFilRep(server.URL))
+		require.ErrorContains(t, err, "502")
+	})
+}
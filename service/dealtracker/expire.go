@@ -0,0 +1,51 @@
+package dealtracker
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+// ExpireDeals marks the active deals that ended before an epoch as expired, and the deal proposals that were not
+// activated before their start epoch as proposal_expired. The epoch is the latest epoch seen on chain when tracking
+// deals, or the epoch of a simulated clock when simulating how the deals age.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: The database connection.
+//   - epoch: The current epoch.
+//
+// Returns:
+//   - The deals that were marked, with their new state.
+//   - An error, if any occurred during the operation.
+func ExpireDeals(ctx context.Context, db *gorm.DB, epoch int32) ([]model.Deal, error) {
+	db = db.WithContext(ctx)
+	var expired []model.Deal
+	for _, step := range []struct {
+		where    string
+		newState model.DealState
+	}{
+		{"end_epoch < ? AND state = 'active'", model.DealExpired},
+		{"state in ('proposed', 'published') AND start_epoch < ?", model.DealProposalExpired},
+	} {
+		var deals []model.Deal
+		err := db.Where(step.where, epoch).Find(&deals).Error
+		if err != nil {
+			return expired, errors.WithStack(err)
+		}
+		if len(deals) == 0 {
+			continue
+		}
+		err = db.Model(&model.Deal{}).Where(step.where, epoch).Update("state", step.newState).Error
+		if err != nil {
+			return expired, errors.WithStack(err)
+		}
+		for i := range deals {
+			deals[i].State = step.newState
+		}
+		expired = append(expired, deals...)
+	}
+	return expired, nil
+}
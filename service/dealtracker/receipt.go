@@ -0,0 +1,256 @@
+package dealtracker
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	ReceiptVersion   = 1
+	receiptKeyGlobal = "receipt_key"
+)
+
+var ErrInvalidReceiptSignature = errors.New("invalid receipt signature")
+
+// ReceiptDeal is an active deal of a piece, as listed in a receipt.
+type ReceiptDeal struct {
+	DealID           uint64 `json:"dealId"`
+	Provider         string `json:"provider"`
+	Client           string `json:"client"`
+	StartEpoch       int32  `json:"startEpoch"`
+	EndEpoch         int32  `json:"endEpoch"`
+	SectorStartEpoch int32  `json:"sectorStartEpoch"`
+	Verified         bool   `json:"verified"`
+}
+
+// Receipt is the signed payload of a model.PieceReceipt. It only contains on-chain identifiers, so it can be verified
+// against the chain without access to the database of the Singularity instance that issued it.
+type Receipt struct {
+	Version           int           `json:"version"`
+	Instance          string        `json:"instance"` // Instance ID of the Singularity instance that issued the receipt
+	IssuedAt          time.Time     `json:"issuedAt"`
+	Preparation       string        `json:"preparation"`
+	PieceCID          string        `json:"pieceCid"`
+	PieceSize         int64         `json:"pieceSize"`
+	RootCID           string        `json:"rootCid"`
+	ReplicationTarget int           `json:"replicationTarget"`
+	Deals             []ReceiptDeal `json:"deals"`
+}
+
+// VerifyReceipt checks the signature of the receipt against its public key, and that the public key matches the signer.
+//
+// Parameters:
+//   - receipt: The model.PieceReceipt to verify.
+//
+// Returns:
+//   - A pointer to the decoded Receipt if the signature is valid.
+//   - An error if the signature is invalid or the payload cannot be decoded.
+func VerifyReceipt(receipt model.PieceReceipt) (*Receipt, error) {
+	err := util.VerifyPeerSignature(receipt.Signer, receipt.PublicKey, receipt.Payload, receipt.Signature)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidReceiptSignature, err.Error())
+	}
+	var decoded Receipt
+	err = json.Unmarshal(receipt.Payload, &decoded)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &decoded, nil
+}
+
+// getGlobal returns the global value with the given key. The key column is quoted since it is a reserved word in MySQL.
+func getGlobal(db *gorm.DB, key string) (model.Global, error) {
+	var global model.Global
+	where := clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Name: "key"}, Value: key},
+	}}
+	err := db.Clauses(where).First(&global).Error
+	return global, errors.WithStack(err)
+}
+
+// loadReceiptKey returns the key used to sign receipts, generating and storing it on first use.
+func loadReceiptKey(ctx context.Context, db *gorm.DB) (crypto.PrivKey, error) {
+	global, err := getGlobal(db, receiptKeyGlobal)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		var generated []byte
+		generated, _, _, err = util.GenerateNewPeer()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		global = model.Global{Key: receiptKeyGlobal, Value: base64.StdEncoding.EncodeToString(generated)}
+		err = database.DoRetry(ctx, func() error {
+			return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&global).Error
+		})
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		// Another process may have stored its key first
+		global, err = getGlobal(db, receiptKeyGlobal)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	private, err := base64.StdEncoding.DecodeString(global.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode receipt key")
+	}
+	key, err := crypto.UnmarshalPrivateKey(private)
+	return key, errors.Wrap(err, "failed to unmarshal receipt key")
+}
+
+// IssueReceipts issues a signed receipt for every piece that has reached the replication target of its preparation
+// and does not have a receipt yet. A piece reaches the target when it has active deals with as many distinct
+// providers as the preparation is scheduled with. The pieces are processed in batches, and only the active deals of
+// the pieces in a batch are loaded.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: The database connection.
+//   - key: The key to sign the receipts with, i.e. a key kept in a PKCS#11 token. If nil, the receipt key of the
+//     instance is used, which is generated and stored in the database on first use.
+//
+// Returns:
+//   - The number of receipts issued.
+//   - An error, if any occurred during the operation.
+func IssueReceipts(ctx context.Context, db *gorm.DB, key crypto.PrivKey) (int, error) {
+	db = db.WithContext(ctx)
+	var signer peer.ID
+	var publicKey []byte
+	var instance model.Global
+	targets := make(map[model.PreparationID]int)
+	issued := make(map[string]struct{})
+	var count int
+	var lastID model.CarID
+	for {
+		var cars []model.Car
+		err := db.Preload("Preparation").
+			Where("id > ? AND NOT EXISTS (SELECT 1 FROM piece_receipts WHERE piece_receipts.preparation_id = cars.preparation_id "+
+				"AND piece_receipts.piece_cid = cars.piece_cid)", lastID).
+			Order("id asc").Limit(util.BatchSize).Find(&cars).Error
+		if err != nil {
+			return count, errors.Wrap(err, "failed to find pieces without receipt")
+		}
+		if len(cars) == 0 {
+			return count, nil
+		}
+		lastID = cars[len(cars)-1].ID
+
+		pieceCIDs := make([]model.CID, 0, len(cars))
+		for _, car := range cars {
+			pieceCIDs = append(pieceCIDs, car.PieceCID)
+		}
+		var deals []model.Deal
+		err = db.Where("state = ? AND deal_id IS NOT NULL AND piece_cid IN ?", model.DealActive, pieceCIDs).
+			Order("deal_id asc").Find(&deals).Error
+		if err != nil {
+			return count, errors.Wrap(err, "failed to find active deals")
+		}
+		activeDeals := make(map[string][]model.Deal)
+		for _, deal := range deals {
+			pieceCID := deal.PieceCID.String()
+			activeDeals[pieceCID] = append(activeDeals[pieceCID], deal)
+		}
+
+		for _, car := range cars {
+			pieceCID := car.PieceCID.String()
+			issuedKey := fmt.Sprintf("%d/%s", car.PreparationID, pieceCID)
+			if _, ok := issued[issuedKey]; ok {
+				continue
+			}
+			target, ok := targets[car.PreparationID]
+			if !ok {
+				target, err = car.Preparation.ReplicationTarget(db)
+				if err != nil {
+					return count, errors.WithStack(err)
+				}
+				targets[car.PreparationID] = target
+			}
+			providers := make(map[string]struct{})
+			for _, deal := range activeDeals[pieceCID] {
+				providers[deal.Provider] = struct{}{}
+			}
+			if len(providers) < target {
+				continue
+			}
+
+			if publicKey == nil {
+				if key == nil {
+					key, err = loadReceiptKey(ctx, db)
+					if err != nil {
+						return count, err
+					}
+				}
+				signer, err = peer.IDFromPrivateKey(key)
+				if err != nil {
+					return count, errors.WithStack(err)
+				}
+				publicKey, err = crypto.MarshalPublicKey(key.GetPublic())
+				if err != nil {
+					return count, errors.WithStack(err)
+				}
+				instance, err = getGlobal(db, "instance_id")
+				if err != nil {
+					return count, errors.Wrap(err, "failed to get instance id")
+				}
+			}
+
+			receipt := Receipt{
+				Version:           ReceiptVersion,
+				Instance:          instance.Value,
+				IssuedAt:          time.Now().UTC(),
+				Preparation:       car.Preparation.Name,
+				PieceCID:          pieceCID,
+				PieceSize:         car.PieceSize,
+				RootCID:           car.RootCID.String(),
+				ReplicationTarget: target,
+			}
+			for _, deal := range activeDeals[pieceCID] {
+				receipt.Deals = append(receipt.Deals, ReceiptDeal{
+					DealID:           *deal.DealID,
+					Provider:         deal.Provider,
+					Client:           deal.ClientID,
+					StartEpoch:       deal.StartEpoch,
+					EndEpoch:         deal.EndEpoch,
+					SectorStartEpoch: deal.SectorStartEpoch,
+					Verified:         deal.Verified,
+				})
+			}
+			payload, err := json.Marshal(receipt)
+			if err != nil {
+				return count, errors.WithStack(err)
+			}
+			signature, err := key.Sign(payload)
+			if err != nil {
+				return count, errors.Wrap(err, "failed to sign receipt")
+			}
+			err = database.DoRetry(ctx, func() error {
+				return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.PieceReceipt{
+					PieceCID:      car.PieceCID,
+					PreparationID: car.PreparationID,
+					Payload:       payload,
+					Signer:        signer.String(),
+					PublicKey:     publicKey,
+					Signature:     signature,
+				}).Error
+			})
+			if err != nil {
+				return count, errors.Wrapf(err, "failed to save receipt of piece %s", pieceCID)
+			}
+			issued[issuedKey] = struct{}{}
+			count++
+			Logger.Infow("issued piece receipt", "pieceCID", pieceCID, "preparation", car.Preparation.Name, "deals", len(receipt.Deals))
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package dealtracker
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/signer"
+	singularityutil "github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestIssueReceipts(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		preparation := model.Preparation{Name: "prep", PieceSize: 1 << 20}
+		require.NoError(t, db.Create(&preparation).Error)
+		piece1 := model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("piece1"))))
+		piece2 := model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("piece2"))))
+		require.NoError(t, db.Create([]model.Car{
+			{PieceCID: piece1, PieceSize: 1 << 20, RootCID: model.CID(testutil.TestCid), PreparationID: preparation.ID},
+			{PieceCID: piece2, PieceSize: 1 << 20, RootCID: model.CID(testutil.TestCid), PreparationID: preparation.ID},
+		}).Error)
+		require.NoError(t, db.Create(&model.Wallet{ID: "f01000", Address: "f1wallet"}).Error)
+		require.NoError(t, db.Create([]model.Schedule{
+			{PreparationID: preparation.ID, Provider: "f01"},
+			{PreparationID: preparation.ID, Provider: "f02"},
+		}).Error)
+		require.NoError(t, db.Create([]model.Deal{
+			{DealID: ptr.Of(uint64(1)), PieceCID: piece1, PieceSize: 1 << 20, Provider: "f01", ClientID: "f01000", State: model.DealActive, StartEpoch: 100, EndEpoch: 200},
+			{DealID: ptr.Of(uint64(2)), PieceCID: piece1, PieceSize: 1 << 20, Provider: "f02", ClientID: "f01000", State: model.DealActive, StartEpoch: 100, EndEpoch: 200},
+			{DealID: ptr.Of(uint64(3)), PieceCID: piece2, PieceSize: 1 << 20, Provider: "f01", ClientID: "f01000", State: model.DealActive},
+			{PieceCID: piece2, PieceSize: 1 << 20, Provider: "f02", ClientID: "f01000", State: model.DealProposed},
+		}).Error)
+
+		// Only piece1 has reached the replication target of two providers
+		issued, err := IssueReceipts(ctx, db, nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, issued)
+		var receipts []model.PieceReceipt
+		require.NoError(t, db.Find(&receipts).Error)
+		require.Len(t, receipts, 1)
+		receipt, err := VerifyReceipt(receipts[0])
+		require.NoError(t, err)
+		require.Equal(t, piece1.String(), receipt.PieceCID)
+		require.Equal(t, "prep", receipt.Preparation)
+		require.Equal(t, 2, receipt.ReplicationTarget)
+		require.NotEmpty(t, receipt.Instance)
+		require.Len(t, receipt.Deals, 2)
+		require.EqualValues(t, 1, receipt.Deals[0].DealID)
+		require.Equal(t, "f02", receipt.Deals[1].Provider)
+
+		// Receipts are only issued once
+		issued, err = IssueReceipts(ctx, db, nil)
+		require.NoError(t, err)
+		require.Zero(t, issued)
+
+		require.NoError(t, db.Model(&model.Deal{}).Where("provider = ? AND piece_cid = ?", "f02", piece2).
+			Updates(map[string]any{"state": model.DealActive, "deal_id": 4}).Error)
+		issued, err = IssueReceipts(ctx, db, nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, issued)
+		receipts = nil
+		require.NoError(t, db.Order("id asc").Find(&receipts).Error)
+		require.Len(t, receipts, 2)
+		// Both receipts are signed with the same key
+		require.Equal(t, receipts[0].Signer, receipts[1].Signer)
+
+		receipts[1].Payload = []byte(`{"pieceCid":"tampered"}`)
+		_, err = VerifyReceipt(receipts[1])
+		require.ErrorIs(t, err, ErrInvalidReceiptSignature)
+	})
+}
+
+func TestIssueReceipts_BatchesWithKey(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		preparation := model.Preparation{Name: "prep", PieceSize: 1 << 20}
+		require.NoError(t, db.Create(&preparation).Error)
+		require.NoError(t, db.Create(&model.Wallet{ID: "f01000", Address: "f1wallet"}).Error)
+		require.NoError(t, db.Create(&model.Schedule{PreparationID: preparation.ID, Provider: "f01"}).Error)
+		pieces := singularityutil.BatchSize + 5
+		var cars []model.Car
+		var deals []model.Deal
+		for i := 0; i < pieces; i++ {
+			pieceCID := model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte(fmt.Sprintf("piece%d", i)))))
+			cars = append(cars, model.Car{PieceCID: pieceCID, PieceSize: 1 << 20, RootCID: model.CID(testutil.TestCid), PreparationID: preparation.ID})
+			deals = append(deals, model.Deal{DealID: ptr.Of(uint64(i + 1)), PieceCID: pieceCID, PieceSize: 1 << 20, Provider: "f01", ClientID: "f01000", State: model.DealActive})
+		}
+		require.NoError(t, db.CreateInBatches(cars, 50).Error)
+		require.NoError(t, db.CreateInBatches(deals, 50).Error)
+
+		private, _, peerID, err := singularityutil.GenerateNewPeer()
+		require.NoError(t, err)
+		key, err := signer.NewPrivKey(base64.StdEncoding.EncodeToString(private))
+		require.NoError(t, err)
+
+		issued, err := IssueReceipts(ctx, db, key)
+		require.NoError(t, err)
+		require.Equal(t, pieces, issued)
+		var receipts []model.PieceReceipt
+		require.NoError(t, db.Find(&receipts).Error)
+		require.Len(t, receipts, pieces)
+		for _, receipt := range receipts {
+			require.Equal(t, peerID.String(), receipt.Signer)
+			decoded, err := VerifyReceipt(receipt)
+			require.NoError(t, err)
+			require.Len(t, decoded.Deals, 1)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package dealtracker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SparkRetrievalStatsURL is the public retrieval success rate summary of the Spark retrieval checker run by Filecoin Station.
+// The retrieval stats are only ingested if the deal tracker is configured with a URL, i.e. this one.
+const SparkRetrievalStatsURL = "https://stats.filspark.com/miners/retrieval-success-rate/summary"
+
+// retrievalStatsWindow is the period over which the retrieval success rate is measured.
+const retrievalStatsWindow = 7 * 24 * time.Hour
+
+// minerRetrievalStats is an entry of the retrieval success rate summary. The counts are encoded as strings by Spark.
+type minerRetrievalStats struct {
+	MinerID    string      `json:"miner_id"`
+	Total      json.Number `json:"total"`
+	Successful json.Number `json:"successful"`
+}
+
+// IngestRetrievalStats fetches the retrieval success rate of storage providers over the last week from a Spark
+// compatible stats endpoint, and stores it in the reputation of the providers that hold our deals, that deals are
+// scheduled with, or that are listed by the provider directory, so the replication policies can rank them. The endpoint is queried with the from and to dates of the window, and returns an array of
+// entries with the miner_id, total and successful fields. Providers without retrieval checks keep their reputation.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - db: The database connection.
+//   - statsURL: The URL of the retrieval success rate summary.
+//   - now: The end of the measurement window.
+//
+// Returns:
+//   - The number of provider reputations updated.
+//   - An error, if any occurred during the operation.
+func IngestRetrievalStats(ctx context.Context, db *gorm.DB, statsURL string, now time.Time) (int, error) {
+	db = db.WithContext(ctx)
+	var dealProviders []string
+	err := db.Model(&model.Deal{}).Distinct("provider").
+		Where("state IN ?", []model.DealState{model.DealProposed, model.DealPublished, model.DealActive}).
+		Pluck("provider", &dealProviders).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find providers of deals")
+	}
+	var scheduleProviders []string
+	err = db.Model(&model.Schedule{}).Distinct("provider").Pluck("provider", &scheduleProviders).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find providers of schedules")
+	}
+	var listedProviders []string
+	err = db.Model(&model.ProviderReputation{}).Where("listed = ?", true).Pluck("provider", &listedProviders).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find providers of provider directory")
+	}
+	providers := make(map[string]struct{})
+	for _, provider := range append(append(dealProviders, scheduleProviders...), listedProviders...) {
+		providers[provider] = struct{}{}
+	}
+	if len(providers) == 0 {
+		return 0, nil
+	}
+
+	u, err := url.Parse(statsURL)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid retrieval stats URL %s", statsURL)
+	}
+	query := u.Query()
+	query.Set("from", now.Add(-retrievalStatsWindow).UTC().Format(time.DateOnly))
+	query.Set("to", now.UTC().Format(time.DateOnly))
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get retrieval stats from %s", statsURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.Newf("failed to get retrieval stats from %s: %s", statsURL, resp.Status)
+	}
+	var stats []minerRetrievalStats
+	err = json.NewDecoder(resp.Body).Decode(&stats)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to decode retrieval stats from %s", statsURL)
+	}
+
+	var count int
+	for _, stat := range stats {
+		if _, ok := providers[stat.MinerID]; !ok {
+			continue
+		}
+		total, err := stat.Total.Int64()
+		if err != nil {
+			return count, errors.Wrapf(err, "invalid total retrieval checks of %s", stat.MinerID)
+		}
+		successful, err := stat.Successful.Int64()
+		if err != nil {
+			return count, errors.Wrapf(err, "invalid successful retrieval checks of %s", stat.MinerID)
+		}
+		if total <= 0 {
+			continue
+		}
+		reputation := model.ProviderReputation{
+			Provider:             stat.MinerID,
+			RetrievalTotal:       total,
+			RetrievalSuccessful:  successful,
+			RetrievalSuccessRate: float64(successful) / float64(total),
+			Source:               statsURL,
+		}
+		err = database.DoRetry(ctx, func() error {
+			return db.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "provider"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"updated_at", "retrieval_total", "retrieval_successful", "retrieval_success_rate", "source",
+				}),
+			}).Create(&reputation).Error
+		})
+		if err != nil {
+			return count, errors.Wrapf(err, "failed to save reputation of %s", stat.MinerID)
+		}
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,73 @@
+package dealtracker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestIngestRetrievalStats(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		now := time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "2024-01-01", r.URL.Query().Get("from"))
+			require.Equal(t, "2024-01-08", r.URL.Query().Get("to"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[
+				{"miner_id":"f01","total":"200","successful":"150","success_rate":0.75},
+				{"miner_id":"f02","total":100,"successful":10,"success_rate":0.1},
+				{"miner_id":"f09","total":"100","successful":"100","success_rate":1}
+			]`))
+		}))
+		defer server.Close()
+
+		// Nothing to ingest without deals or schedules
+		count, err := IngestRetrievalStats(ctx, db, server.URL, now)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		require.NoError(t, db.Create(&model.Wallet{ID: "f01000", Address: "f1wallet"}).Error)
+		require.NoError(t, db.Create(&model.Deal{PieceCID: model.CID(testutil.TestCid), Provider: "f01", ClientID: "f01000", State: model.DealActive}).Error)
+		require.NoError(t, db.Create(&model.Schedule{Preparation: &model.Preparation{Name: "prep"}, Provider: "f02"}).Error)
+
+		count, err = IngestRetrievalStats(ctx, db, server.URL, now)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		var reputations []model.ProviderReputation
+		require.NoError(t, db.Order("provider asc").Find(&reputations).Error)
+		require.Len(t, reputations, 2)
+		require.Equal(t, "f01", reputations[0].Provider)
+		require.EqualValues(t, 200, reputations[0].RetrievalTotal)
+		require.EqualValues(t, 150, reputations[0].RetrievalSuccessful)
+		require.InDelta(t, 0.75, reputations[0].RetrievalSuccessRate, 1e-9)
+		require.Equal(t, server.URL, reputations[0].Source)
+		require.InDelta(t, 0.1, reputations[1].RetrievalSuccessRate, 1e-9)
+
+		// Ingesting again updates the existing reputations
+		count, err = IngestRetrievalStats(ctx, db, server.URL, now)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		var total int64
+		require.NoError(t, db.Model(&model.ProviderReputation{}).Count(&total).Error)
+		require.EqualValues(t, 2, total)
+	})
+}
+
+func TestIngestRetrievalStats_Error(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		require.NoError(t, db.Create(&model.Schedule{Preparation: &model.Preparation{Name: "prep"}, Provider: "f01"}).Error)
+		_, err := IngestRetrievalStats(ctx, db, server.URL, time.Now())
+		require.ErrorContains(t, err, "500")
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/data-preservation-programs/singularity/service/contentprovider"
 	"github.com/data-preservation-programs/singularity/storagesystem"
 	"github.com/data-preservation-programs/singularity/store"
+	"github.com/data-preservation-programs/singularity/version"
 	"github.com/fxamacker/cbor/v2"
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-log/v2"
@@ -165,11 +166,16 @@ func GetMetadata(
 	}
 
 	req.Header.Add("Accept", "application/cbor")
+	version.SetRequestHeader(req)
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return nil, 0, errors.WithStack(err)
 	}
 	defer resp.Body.Close()
+	err = version.CheckResponse(resp)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, resp.StatusCode, errors.Errorf("failed to get metadata: %s", resp.Status)
 	}
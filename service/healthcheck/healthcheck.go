@@ -8,6 +8,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/database"
 	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/version"
 	"github.com/google/uuid"
 	"github.com/ipfs/go-log/v2"
 	"gorm.io/gorm"
@@ -40,11 +41,13 @@ var logger = log.Logger("healthcheck")
 //     stopping its background cleaning task.
 //   - db *gorm.DB: The database connection object used by HealthCheckCleanup to interact with
 //     the database.
-func StartHealthCheckCleanup(ctx context.Context, db *gorm.DB) {
+//   - stuckThreshold time.Duration: How long a job can go without progress before it is released.
+//     0 only releases the jobs of dead workers.
+func StartHealthCheckCleanup(ctx context.Context, db *gorm.DB, stuckThreshold time.Duration) {
 	timer := time.NewTimer(cleanupInterval)
 	defer timer.Stop()
 	for {
-		HealthCheckCleanup(ctx, db)
+		HealthCheckCleanup(ctx, db, stuckThreshold)
 		select {
 		case <-ctx.Done():
 			return
@@ -60,14 +63,17 @@ func StartHealthCheckCleanup(ctx context.Context, db *gorm.DB) {
 // It first removes all workers that haven't sent a heartbeat for a certain threshold (staleThreshold).
 // If there's an error removing the workers, it logs the error and continues.
 //
-// Then, it resets the state of any jobs that are marked as being processed by a worker that no longer exists.
-// If there's an error updating the sources, it logs the error and continues.
+// Then, it releases any jobs that are marked as being processed by a worker that no longer exists, or that have not
+// made progress for stuckThreshold, so that other workers pick them up. See ReleaseStuckJobs.
+// If there's an error releasing the jobs, it logs the error and continues.
 //
 // All database operations are retried on failure using the DoRetry function.
 //
 // Parameters:
 //   - db: The Gorm DBNoContext connection to use for database queries.
-func HealthCheckCleanup(ctx context.Context, db *gorm.DB) {
+//   - stuckThreshold: How long a job can go without progress before it is released. 0 only releases the jobs of dead
+//     workers.
+func HealthCheckCleanup(ctx context.Context, db *gorm.DB, stuckThreshold time.Duration) {
 	db = db.WithContext(ctx)
 	logger.Debugw("running healthcheck cleanup")
 	// Remove all workers that haven't sent heartbeat for 5 minutes.
@@ -78,17 +84,11 @@ func HealthCheckCleanup(ctx context.Context, db *gorm.DB) {
 		log.Logger("healthcheck").Errorw("failed to remove dead workers", "error", err)
 	}
 
-	// In case there are some works that have stale foreign key referenced to dead workers, we need to remove them
-	err = database.DoRetry(ctx, func() error {
-		return db.Model(&model.Job{}).Where("(worker_id NOT IN (?) OR worker_id IS NULL) AND state = ?",
-			db.Table("workers").Select("id"), model.Processing).
-			Updates(map[string]any{
-				"worker_id": nil,
-				"state":     model.Ready,
-			}).Error
-	})
+	// In case there are some works that have stale foreign key referenced to dead workers, or that are stuck, we need
+	// to release them
+	_, err = ReleaseStuckJobs(ctx, db, stuckThreshold)
 	if err != nil && !errors.Is(err, context.Canceled) {
-		log.Logger("healthcheck").Errorw("failed to remove stale workers", "error", err)
+		log.Logger("healthcheck").Errorw("failed to release stuck jobs", "error", err)
 	}
 }
 
@@ -113,11 +113,14 @@ func Register(ctx context.Context, db *gorm.DB, workerID uuid.UUID, workerType m
 	if err != nil {
 		return false, errors.WithStack(err)
 	}
+	now := time.Now().UTC()
 	worker := model.Worker{
 		ID:            workerID.String(),
-		LastHeartbeat: time.Now().UTC(),
+		LastHeartbeat: now,
 		Hostname:      hostname,
 		Type:          workerType,
+		Version:       version.Version,
+		StartedAt:     now,
 	}
 	logger.Debugw("registering worker", "worker", worker)
 	err = database.DoRetry(ctx, func() error {
@@ -148,7 +151,8 @@ func Register(ctx context.Context, db *gorm.DB, workerID uuid.UUID, workerType m
 // It then creates a new worker model with the provided workerID, the current time as the last heartbeat, the hostname, and the work type and working on values from the state.
 //
 // The function then tries to create the worker in the database or update the existing worker if one with the same ID already exists.
-// The update will set the last heartbeat, work type, working on, and hostname fields to the values from the worker model.
+// The update will set the last heartbeat, work type, hostname and version fields to the values from the worker model, while
+// the start time and the bytes packed of an existing worker are kept.
 // If the database operation fails, it logs an error.
 func ReportHealth(ctx context.Context, db *gorm.DB, workerID uuid.UUID, workerType model.WorkerType) {
 	hostname, err := os.Hostname()
@@ -156,17 +160,20 @@ func ReportHealth(ctx context.Context, db *gorm.DB, workerID uuid.UUID, workerTy
 		logger.Errorw("failed to get hostname", "error", err)
 		return
 	}
+	now := time.Now().UTC()
 	worker := model.Worker{
 		ID:            workerID.String(),
-		LastHeartbeat: time.Now().UTC(),
+		LastHeartbeat: now,
 		Hostname:      hostname,
 		Type:          workerType,
+		Version:       version.Version,
+		StartedAt:     now,
 	}
 	logger.Debugw("sending heartbeat", "worker", worker)
 	err = database.DoRetry(ctx, func() error {
 		return db.WithContext(ctx).Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "id"}},
-			DoUpdates: clause.AssignmentColumns([]string{"last_heartbeat", "type", "hostname"}),
+			DoUpdates: clause.AssignmentColumns([]string{"last_heartbeat", "type", "hostname", "version"}),
 		}).Create(&worker).Error
 	})
 
@@ -205,3 +212,21 @@ func StartReportHealth(ctx context.Context, db *gorm.DB, workerID uuid.UUID, wor
 		}
 	}
 }
+
+// IsStale returns whether a worker has missed more than one heartbeat, so it is likely hung or cut off from the
+// database, although it has not been removed by the healthcheck cleanup yet.
+func IsStale(lastHeartbeat time.Time) bool {
+	return time.Since(lastHeartbeat) > 2*reportInterval
+}
+
+// AddBytesPacked adds the size of a packed CAR file to the bytes packed by a worker, from which the throughput of the
+// worker is derived.
+func AddBytesPacked(ctx context.Context, db *gorm.DB, workerID uuid.UUID, n int64) error {
+	if n <= 0 {
+		return nil
+	}
+	return database.DoRetry(ctx, func() error {
+		return db.WithContext(ctx).Model(&model.Worker{}).Where("id = ?", workerID.String()).
+			Update("bytes_packed", gorm.Expr("bytes_packed + ?", n)).Error
+	})
+}
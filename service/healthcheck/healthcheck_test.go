@@ -7,6 +7,7 @@ import (
 
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/data-preservation-programs/singularity/version"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -17,7 +18,7 @@ func TestHealthCheckCleanup(t *testing.T) {
 		ctx, cancel := context.WithCancel(ctx)
 		done := make(chan struct{})
 		go func() {
-			StartHealthCheckCleanup(ctx, db)
+			StartHealthCheckCleanup(ctx, db, DefaultStuckThreshold)
 			close(done)
 		}()
 		time.Sleep(time.Second)
@@ -65,7 +66,12 @@ func TestHealthCheck(t *testing.T) {
 		req.Nil(err)
 		req.Equal(model.DatasetWorker, worker.Type)
 		req.NotEmpty(worker.Hostname)
+		req.Equal(version.Version, worker.Version)
 		lastHeatbeat := worker.LastHeartbeat
+		startedAt := worker.StartedAt
+
+		req.NoError(AddBytesPacked(ctx, db, id, 1000))
+		req.NoError(AddBytesPacked(ctx, db, id, 24))
 
 		time.Sleep(time.Second)
 		ReportHealth(context.Background(), db, id, model.DatasetWorker)
@@ -75,8 +81,12 @@ func TestHealthCheck(t *testing.T) {
 		req.Equal(model.DatasetWorker, worker.Type)
 		req.NotEmpty(worker.Hostname)
 		req.NotEqual(lastHeatbeat, worker.LastHeartbeat)
+		req.True(startedAt.Equal(worker.StartedAt))
+		req.EqualValues(1024, worker.BytesPacked)
+		req.False(IsStale(worker.LastHeartbeat))
+		req.True(IsStale(worker.LastHeartbeat.Add(-3 * reportInterval)))
 
-		HealthCheckCleanup(ctx, db)
+		HealthCheckCleanup(ctx, db, DefaultStuckThreshold)
 		err = db.Where("id = ?", id.String()).First(&worker).Error
 		req.Nil(err)
 
@@ -87,7 +97,7 @@ func TestHealthCheck(t *testing.T) {
 		}()
 
 		time.Sleep(time.Second)
-		HealthCheckCleanup(ctx, db)
+		HealthCheckCleanup(ctx, db, DefaultStuckThreshold)
 		err = db.Where("id = ?", id.String()).First(&worker).Error
 		req.ErrorIs(err, gorm.ErrRecordNotFound)
 	})
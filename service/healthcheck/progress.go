@@ -0,0 +1,126 @@
+package healthcheck
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"gorm.io/gorm"
+)
+
+// DefaultStuckThreshold is how long a job can go without progress before it is considered stuck by default.
+const DefaultStuckThreshold = time.Minute * 30
+
+type progressKey struct{}
+
+// Progress records when the job run by a worker last made progress, i.e. scanned a file or produced CAR data, so
+// a job that is stuck can be told apart from a job that is slow.
+type Progress struct {
+	last atomic.Int64
+}
+
+// NewProgress returns a Progress that last made progress now.
+func NewProgress() *Progress {
+	p := &Progress{}
+	p.Report()
+	return p
+}
+
+// Report records that the job made progress now.
+func (p *Progress) Report() {
+	p.last.Store(time.Now().UnixNano())
+}
+
+// Last returns when the job last made progress.
+func (p *Progress) Last() time.Time {
+	return time.Unix(0, p.last.Load()).UTC()
+}
+
+// WithProgress returns a copy of the context that carries the progress of a job, so the code running the job can
+// report progress with ReportProgress.
+func WithProgress(ctx context.Context, p *Progress) context.Context {
+	return context.WithValue(ctx, progressKey{}, p)
+}
+
+// ReportProgress records that the job carried by the context made progress now. It does nothing if the context does
+// not carry the progress of a job.
+func ReportProgress(ctx context.Context) {
+	p, ok := ctx.Value(progressKey{}).(*Progress)
+	if ok {
+		p.Report()
+	}
+}
+
+// ReleaseStuckJobs releases the jobs that are held by a worker that no longer exists, or that have not made progress
+// for longer than the stuck threshold while their worker is still sending heartbeats. The released jobs are set back
+// to ready so that another worker picks them up, and each release is logged as a warning with the reason, so no
+// manual cleanup of the database is needed. The worker that held a job stops working on it once it notices that the
+// job has been released.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - db: The database connection.
+//   - stuckThreshold: How long a job can go without progress before it is released. 0 only releases the jobs of
+//     workers that no longer exist.
+//
+// Returns:
+//   - The jobs that have been released, as they were before their release.
+//   - An error, if any occurred during the operation.
+func ReleaseStuckJobs(ctx context.Context, db *gorm.DB, stuckThreshold time.Duration) ([]model.Job, error) {
+	db = db.WithContext(ctx)
+	condition := db.Where("worker_id IS NULL OR worker_id NOT IN (?)", db.Table("workers").Select("id"))
+	var cutoff time.Time
+	if stuckThreshold > 0 {
+		cutoff = time.Now().UTC().Add(-stuckThreshold)
+		condition = condition.Or("progress_at < ?", cutoff)
+	}
+	var jobs []model.Job
+	err := db.Where("state = ?", model.Processing).Where(condition).Order("id").Find(&jobs).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var released []model.Job
+	for _, job := range jobs {
+		var rowsAffected int64
+		err = database.DoRetry(ctx, func() error {
+			// Only release the job if it is still held by the same worker, since it may have completed or been released
+			// in the meantime
+			query := db.Model(&model.Job{}).Where("id = ? AND state = ?", job.ID, model.Processing)
+			if job.WorkerID == nil {
+				query = query.Where("worker_id IS NULL")
+			} else {
+				query = query.Where("worker_id = ?", *job.WorkerID)
+			}
+			result := query.Updates(map[string]any{
+				"worker_id":   nil,
+				"state":       model.Ready,
+				"progress_at": nil,
+			})
+			rowsAffected = result.RowsAffected
+			return result.Error
+		})
+		if err != nil {
+			return released, errors.Wrapf(err, "failed to release job %d", job.ID)
+		}
+		if rowsAffected == 0 {
+			continue
+		}
+		released = append(released, job)
+
+		var workerID string
+		if job.WorkerID != nil {
+			workerID = *job.WorkerID
+		}
+		if stuckThreshold > 0 && job.ProgressAt != nil && job.ProgressAt.Before(cutoff) {
+			logger.Warnw("released stuck job that has not made progress", "jobID", job.ID, "type", job.Type,
+				"workerID", workerID, "lastProgress", *job.ProgressAt)
+		} else {
+			logger.Warnw("released job of a worker that is gone", "jobID", job.ID, "type", job.Type, "workerID", workerID)
+		}
+	}
+	return released, nil
+}
@@ -0,0 +1,71 @@
+package healthcheck
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/gotidy/ptr"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestReportProgress(t *testing.T) {
+	// Reporting progress without a job in the context does nothing
+	ReportProgress(context.Background())
+
+	progress := NewProgress()
+	last := progress.Last()
+	time.Sleep(time.Millisecond)
+	ReportProgress(WithProgress(context.Background(), progress))
+	require.True(t, progress.Last().After(last))
+}
+
+func TestReleaseStuckJobs(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		err := db.Create(&model.Preparation{
+			SourceStorages: []model.Storage{{Name: "source"}},
+		}).Error
+		require.NoError(t, err)
+		err = db.Create(&model.Worker{ID: "alive", LastHeartbeat: time.Now().UTC(), Type: model.DatasetWorker}).Error
+		require.NoError(t, err)
+
+		now := time.Now().UTC()
+		old := now.Add(-time.Hour)
+		jobs := []model.Job{
+			{AttachmentID: 1, Type: model.Pack, State: model.Processing, WorkerID: ptr.Of("alive"), ProgressAt: &now},
+			{AttachmentID: 1, Type: model.Pack, State: model.Processing, WorkerID: ptr.Of("alive"), ProgressAt: &old},
+			{AttachmentID: 1, Type: model.Scan, State: model.Processing},
+			{AttachmentID: 1, Type: model.Pack, State: model.Ready, ProgressAt: &old},
+		}
+		err = db.Create(&jobs).Error
+		require.NoError(t, err)
+
+		// Without a stuck threshold, only the jobs without a worker are released
+		released, err := ReleaseStuckJobs(ctx, db, 0)
+		require.NoError(t, err)
+		require.Len(t, released, 1)
+		require.Equal(t, jobs[2].ID, released[0].ID)
+
+		err = db.Model(&model.Job{}).Where("id = ?", jobs[2].ID).Update("state", model.Processing).Error
+		require.NoError(t, err)
+		released, err = ReleaseStuckJobs(ctx, db, DefaultStuckThreshold)
+		require.NoError(t, err)
+		require.Len(t, released, 2)
+		require.Equal(t, jobs[1].ID, released[0].ID)
+		require.Equal(t, jobs[2].ID, released[1].ID)
+
+		var updated []model.Job
+		err = db.Order("id").Find(&updated).Error
+		require.NoError(t, err)
+		require.Equal(t, model.Processing, updated[0].State)
+		require.Equal(t, "alive", *updated[0].WorkerID)
+		for _, job := range updated[1:] {
+			require.Equal(t, model.Ready, job.State)
+			require.Nil(t, job.WorkerID)
+		}
+		require.Nil(t, updated[1].ProgressAt)
+	})
+}
@@ -0,0 +1,124 @@
+package ingestlistener
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+var ErrUnrecognizedEvent = errors.New("unrecognized event")
+
+// Message is a message received from a queue or a topic.
+type Message struct {
+	ID         string            // Identifier used to acknowledge the message, i.e. the SQS receipt handle
+	Body       []byte            // Payload of the message
+	Attributes map[string]string // Attributes of the message, i.e. the Pub/Sub message attributes
+}
+
+// Object is a created object referenced by an event. An object with an empty bucket is a path relative to the source.
+type Object struct {
+	Bucket    string
+	Key       string
+	EventTime time.Time // Time of the event, or zero if the event does not have one
+}
+
+type s3Record struct {
+	EventName string    `json:"eventName"`
+	EventTime time.Time `json:"eventTime"`
+	S3        struct {
+		Bucket struct {
+			Name string `json:"name"`
+		} `json:"bucket"`
+		Object struct {
+			Key string `json:"key"`
+		} `json:"object"`
+	} `json:"s3"`
+}
+
+type eventBody struct {
+	Type    string     `json:"Type"`    // SNS notification wrapping an S3 event
+	Message string     `json:"Message"` // SNS notification wrapping an S3 event
+	Records []s3Record `json:"Records"` // S3 or MinIO event notification
+	Event   string     `json:"Event"`   // S3 test event
+	Path    string     `json:"path"`    // Custom event with a path relative to the source
+	Time    time.Time  `json:"time"`    // Custom event with an optional time of the event
+}
+
+// ParseMessage returns the objects created according to the event in the message. The following events are supported:
+//   - S3 event notifications, also when delivered through SNS, and MinIO bucket notifications. Only ObjectCreated events
+//     are considered;
+//   - Google Cloud Storage Pub/Sub notifications. Only OBJECT_FINALIZE events are considered;
+//   - custom events with a path relative to the source and an optional time, i.e.
+//     {"path": "dir/file.txt", "time": "2024-06-15T13:00:00Z"}.
+//
+// The time of the events is kept, so the objects can be organized into the partition of the event time.
+//
+// Parameters:
+//   - message: The message to parse.
+//
+// Returns:
+//   - The objects created. Events that are not about created objects result in no object.
+//   - An error if the message is not a supported event.
+func ParseMessage(message Message) ([]Object, error) {
+	if eventType, ok := message.Attributes["eventType"]; ok {
+		if eventType != "OBJECT_FINALIZE" {
+			return nil, nil
+		}
+		// The event time is optional, objects without one are partitioned by their modification time
+		eventTime, _ := time.Parse(time.RFC3339Nano, message.Attributes["eventTime"])
+		return []Object{{Bucket: message.Attributes["bucketId"], Key: message.Attributes["objectId"], EventTime: eventTime}}, nil
+	}
+
+	var body eventBody
+	err := json.Unmarshal(message.Body, &body)
+	if err != nil {
+		return nil, errors.Wrap(ErrUnrecognizedEvent, err.Error())
+	}
+	switch {
+	case body.Type == "Notification" && body.Message != "":
+		return ParseMessage(Message{ID: message.ID, Body: []byte(body.Message)})
+	case body.Records != nil:
+		var objects []Object
+		for _, record := range body.Records {
+			if !strings.HasPrefix(record.EventName, "ObjectCreated:") && !strings.HasPrefix(record.EventName, "s3:ObjectCreated:") {
+				continue
+			}
+			// Object keys are URL encoded in S3 event notifications
+			key, err := url.QueryUnescape(record.S3.Object.Key)
+			if err != nil {
+				return nil, errors.Wrapf(ErrUnrecognizedEvent, "invalid object key %s", record.S3.Object.Key)
+			}
+			objects = append(objects, Object{Bucket: record.S3.Bucket.Name, Key: key, EventTime: record.EventTime})
+		}
+		return objects, nil
+	case body.Event == "s3:TestEvent":
+		return nil, nil
+	case body.Path != "":
+		return []Object{{Key: body.Path, EventTime: body.Time}}, nil
+	default:
+		return nil, ErrUnrecognizedEvent
+	}
+}
+
+// relativePath returns the path of the object relative to the root of the source, or false if the object is not
+// in the source. The root of object storage sources is the bucket followed by an optional prefix, i.e. bucket/prefix.
+func relativePath(object Object, root string) (string, bool) {
+	if object.Key == "" || strings.HasSuffix(object.Key, "/") {
+		return "", false
+	}
+	if object.Bucket == "" {
+		return strings.TrimPrefix(object.Key, "/"), true
+	}
+	full := object.Bucket + "/" + object.Key
+	root = strings.Trim(root, "/")
+	if root == "" {
+		return full, true
+	}
+	if !strings.HasPrefix(full, root+"/") {
+		return "", false
+	}
+	return full[len(root)+1:], true
+}
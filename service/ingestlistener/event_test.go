@@ -0,0 +1,101 @@
+package ingestlistener
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMessage(t *testing.T) {
+	eventTime := time.Date(2024, 6, 15, 13, 0, 0, 0, time.UTC)
+	s3Event := `{"Records":[` +
+		`{"eventName":"ObjectCreated:Put","eventTime":"2024-06-15T13:00:00.000Z","s3":{"bucket":{"name":"bucket"},"object":{"key":"dir/my+file%21.txt"}}},` +
+		`{"eventName":"ObjectRemoved:Delete","s3":{"bucket":{"name":"bucket"},"object":{"key":"removed.txt"}}}]}`
+	tests := []struct {
+		name     string
+		message  Message
+		expected []Object
+		err      error
+	}{
+		{
+			name:     "s3",
+			message:  Message{Body: []byte(s3Event)},
+			expected: []Object{{Bucket: "bucket", Key: "dir/my file!.txt", EventTime: eventTime}},
+		},
+		{
+			name:     "sns",
+			message:  Message{Body: []byte(`{"Type":"Notification","Message":` + `"{\"Records\":[{\"eventName\":\"ObjectCreated:Copy\",\"s3\":{\"bucket\":{\"name\":\"bucket\"},\"object\":{\"key\":\"a.txt\"}}}]}"}`)},
+			expected: []Object{{Bucket: "bucket", Key: "a.txt"}},
+		},
+		{
+			name:     "minio",
+			message:  Message{Body: []byte(`{"EventName":"s3:ObjectCreated:Put","Records":[{"eventName":"s3:ObjectCreated:Put","s3":{"bucket":{"name":"bucket"},"object":{"key":"a.txt"}}}]}`)},
+			expected: []Object{{Bucket: "bucket", Key: "a.txt"}},
+		},
+		{
+			name:    "s3 test event",
+			message: Message{Body: []byte(`{"Service":"Amazon S3","Event":"s3:TestEvent"}`)},
+		},
+		{
+			name:     "gcs",
+			message:  Message{Attributes: map[string]string{"eventType": "OBJECT_FINALIZE", "bucketId": "bucket", "objectId": "a.txt", "eventTime": "2024-06-15T13:00:00.000000Z"}},
+			expected: []Object{{Bucket: "bucket", Key: "a.txt", EventTime: eventTime}},
+		},
+		{
+			name:    "gcs delete",
+			message: Message{Attributes: map[string]string{"eventType": "OBJECT_DELETE", "bucketId": "bucket", "objectId": "a.txt"}},
+		},
+		{
+			name:     "custom",
+			message:  Message{Body: []byte(`{"path":"dir/a.txt"}`)},
+			expected: []Object{{Key: "dir/a.txt"}},
+		},
+		{
+			name:     "custom with time",
+			message:  Message{Body: []byte(`{"path":"dir/a.txt","time":"2024-06-15T13:00:00Z"}`)},
+			expected: []Object{{Key: "dir/a.txt", EventTime: eventTime}},
+		},
+		{
+			name:    "unrecognized",
+			message: Message{Body: []byte(`{"foo":"bar"}`)},
+			err:     ErrUnrecognizedEvent,
+		},
+		{
+			name:    "invalid",
+			message: Message{Body: []byte(`not json`)},
+			err:     ErrUnrecognizedEvent,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objects, err := ParseMessage(test.message)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, objects)
+		})
+	}
+}
+
+func TestRelativePath(t *testing.T) {
+	path, ok := relativePath(Object{Bucket: "bucket", Key: "prefix/dir/a.txt"}, "bucket/prefix")
+	require.True(t, ok)
+	require.Equal(t, "dir/a.txt", path)
+
+	path, ok = relativePath(Object{Bucket: "bucket", Key: "a.txt"}, "")
+	require.True(t, ok)
+	require.Equal(t, "bucket/a.txt", path)
+
+	path, ok = relativePath(Object{Key: "/dir/a.txt"}, "/mnt/data")
+	require.True(t, ok)
+	require.Equal(t, "dir/a.txt", path)
+
+	_, ok = relativePath(Object{Bucket: "bucket", Key: "prefix2/a.txt"}, "bucket/prefix")
+	require.False(t, ok)
+
+	_, ok = relativePath(Object{Bucket: "bucket", Key: "prefix/dir/"}, "bucket/prefix")
+	require.False(t, ok)
+}
@@ -0,0 +1,175 @@
+package ingestlistener
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/handler/file"
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ipfs/go-log/v2"
+	"gorm.io/gorm"
+)
+
+const retryDelay = 5 * time.Second
+
+var Logger = log.Logger("ingestlistener")
+
+// Consumer receives messages from a queue or a topic.
+type Consumer interface {
+	// Receive waits for the next batch of messages. It may return no message when nothing arrived for a while.
+	Receive(ctx context.Context) ([]Message, error)
+	// Ack acknowledges the messages, so they are not delivered again.
+	Ack(ctx context.Context, messages []Message) error
+	// Close releases the resources of the consumer.
+	Close() error
+}
+
+// IngestListener consumes object created events and appends the created objects to a source of a preparation, so
+// continuously produced data is queued for packing without rescanning the source.
+//
+// Messages are only acknowledged once the objects are appended. If appending fails, the listener exits, so the
+// messages are delivered again when it is restarted. Objects that are already in the source are skipped, so
+// redelivered messages are harmless.
+type IngestListener struct {
+	dbNoContext *gorm.DB
+	consumer    Consumer
+	preparation string
+	source      string
+	finalize    bool
+}
+
+func NewIngestListener(
+	db *gorm.DB,
+	consumer Consumer,
+	preparation string,
+	source string,
+	finalize bool) *IngestListener {
+	return &IngestListener{
+		dbNoContext: db,
+		consumer:    consumer,
+		preparation: preparation,
+		source:      source,
+		finalize:    finalize,
+	}
+}
+
+func (l *IngestListener) Name() string {
+	return "IngestListener"
+}
+
+// Start starts the ingest listener. It returns an error immediately if the source is not attached to the preparation.
+func (l *IngestListener) Start(ctx context.Context, exitErr chan<- error) error {
+	var attachment model.SourceAttachment
+	err := attachment.FindByPreparationAndSource(l.dbNoContext.WithContext(ctx), l.preparation, l.source)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.Wrapf(handlererror.ErrNotFound, "source '%s' is not attached to preparation %s", l.source, l.preparation)
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	go func() {
+		err := l.run(ctx, attachment.Storage.Path)
+		closeErr := l.consumer.Close()
+		if closeErr != nil {
+			Logger.Warnw("failed to close consumer", "error", closeErr)
+		}
+		Logger.Info("ingest listener stopped")
+		if exitErr != nil {
+			exitErr <- err
+		}
+	}()
+	return nil
+}
+
+func (l *IngestListener) run(ctx context.Context, root string) error {
+	for {
+		messages, err := l.consumer.Receive(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			Logger.Errorw("failed to receive messages", "error", err)
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(retryDelay):
+			}
+			continue
+		}
+		if len(messages) == 0 {
+			continue
+		}
+		err = l.handleMessages(ctx, messages, root)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// handleMessages appends the objects created according to the messages to the source and acknowledges the messages.
+func (l *IngestListener) handleMessages(ctx context.Context, messages []Message, root string) error {
+	var files []file.Info
+	seen := make(map[string]struct{})
+	for _, message := range messages {
+		objects, err := ParseMessage(message)
+		if err != nil {
+			Logger.Warnw("ignoring message", "id", message.ID, "error", err)
+			continue
+		}
+		for _, object := range objects {
+			path, ok := relativePath(object, root)
+			if !ok {
+				Logger.Debugw("ignoring object outside of the source", "bucket", object.Bucket, "key", object.Key)
+				continue
+			}
+			if _, ok := seen[path]; ok {
+				continue
+			}
+			seen[path] = struct{}{}
+			info := file.Info{Path: path}
+			if eventTime := object.EventTime; !eventTime.IsZero() {
+				info.EventTime = &eventTime
+			}
+			files = append(files, info)
+		}
+	}
+
+	if len(files) > 0 {
+		err := l.appendFiles(ctx, files)
+		if err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(l.consumer.Ack(ctx, messages), "failed to acknowledge messages")
+}
+
+// appendFiles appends the files to the source. If any of the files cannot be appended, i.e. because it was deleted
+// after the event was produced, the files are appended one by one and the invalid ones are skipped.
+func (l *IngestListener) appendFiles(ctx context.Context, files []file.Info) error {
+	db := l.dbNoContext.WithContext(ctx)
+	result, err := file.Default.AppendFilesHandler(ctx, db, l.preparation, l.source, file.AppendRequest{Files: files, Finalize: l.finalize})
+	if err == nil {
+		Logger.Infow("appended files", "appended", len(result.Files), "skipped", len(result.Skipped))
+		return nil
+	}
+	if !errors.Is(err, handlererror.ErrInvalidParameter) {
+		return errors.Wrap(err, "failed to append files")
+	}
+	if len(files) == 1 {
+		Logger.Warnw("skipping file", "path", files[0].Path, "error", err)
+		return nil
+	}
+	for _, info := range files {
+		err = l.appendFiles(ctx, []file.Info{info})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package ingestlistener
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/handler/handlererror"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type fakeConsumer struct {
+	batches [][]Message
+	acked   chan []Message
+	closed  bool
+}
+
+func (c *fakeConsumer) Receive(ctx context.Context) ([]Message, error) {
+	if len(c.batches) == 0 {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	batch := c.batches[0]
+	c.batches = c.batches[1:]
+	return batch, nil
+}
+
+func (c *fakeConsumer) Ack(ctx context.Context, messages []Message) error {
+	c.acked <- messages
+	return nil
+}
+
+func (c *fakeConsumer) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestIngestListener(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		tmpdir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(tmpdir, "dir"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(tmpdir, "a.txt"), []byte("test"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(tmpdir, "dir", "b.txt"), []byte("test"), 0o644))
+		require.NoError(t, db.Create(&model.Preparation{
+			Name:      "prep",
+			MaxSize:   1 << 20,
+			PieceSize: 1 << 21,
+			SourceStorages: []model.Storage{{
+				Name: "source",
+				Type: "local",
+				Path: tmpdir,
+			}},
+		}).Error)
+		require.NoError(t, db.Create(&model.Directory{AttachmentID: 1}).Error)
+
+		consumer := &fakeConsumer{
+			batches: [][]Message{
+				{
+					{ID: "1", Body: []byte(`{"path":"a.txt"}`)},
+					{ID: "2", Body: []byte(`{"path":"notexist.txt"}`)},
+					{ID: "3", Body: []byte(`unrecognized`)},
+				},
+				{
+					{ID: "4", Body: []byte(`{"path":"a.txt"}`)},
+					{ID: "5", Body: []byte(`{"path":"dir/b.txt"}`)},
+				},
+			},
+			acked: make(chan []Message, 2),
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		listener := NewIngestListener(db, consumer, "prep", "source", true)
+		exitErr := make(chan error, 1)
+		require.NoError(t, listener.Start(ctx, exitErr))
+
+		for _, expected := range []int{3, 2} {
+			select {
+			case acked := <-consumer.acked:
+				require.Len(t, acked, expected)
+			case <-time.After(10 * time.Second):
+				t.Fatal("messages not acknowledged")
+			}
+		}
+		cancel()
+		require.NoError(t, <-exitErr)
+		require.True(t, consumer.closed)
+
+		var files []model.File
+		require.NoError(t, db.Order("id asc").Find(&files).Error)
+		require.Len(t, files, 2)
+		require.Equal(t, "a.txt", files[0].Path)
+		require.Equal(t, "dir/b.txt", files[1].Path)
+		var count int64
+		require.NoError(t, db.Model(&model.Job{}).Where("type = ? AND state = ?", model.Pack, model.Ready).Count(&count).Error)
+		require.EqualValues(t, 2, count)
+	})
+}
+
+func TestIngestListener_NotFound(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		listener := NewIngestListener(db, &fakeConsumer{}, "prep", "source", false)
+		err := listener.Start(ctx, nil)
+		require.ErrorIs(t, err, handlererror.ErrNotFound)
+	})
+}
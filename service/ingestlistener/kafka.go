@@ -0,0 +1,167 @@
+package ingestlistener
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	kafkaContentType   = "application/vnd.kafka.v2+json"
+	kafkaRecordsType   = "application/vnd.kafka.binary.v2+json"
+	kafkaIdleWait      = time.Second
+	kafkaClientTimeout = time.Minute
+)
+
+type kafkaRecord struct {
+	Topic     string `json:"topic"`
+	Value     string `json:"value"`
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+}
+
+type kafkaOffset struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+}
+
+// KafkaConsumer consumes a Kafka topic through a Kafka REST proxy, i.e. the Confluent REST proxy, with the v2 consumer
+// API. Offsets are only committed once the messages are acknowledged.
+type KafkaConsumer struct {
+	client  *http.Client
+	baseURI string
+	topic   string
+	offsets map[string]kafkaOffset
+}
+
+// NewKafkaConsumer creates a consumer instance in the consumer group with the REST proxy and subscribes it to the topic.
+func NewKafkaConsumer(ctx context.Context, proxyURL string, group string, topic string) (*KafkaConsumer, error) {
+	c := &KafkaConsumer{
+		client:  &http.Client{Timeout: kafkaClientTimeout},
+		topic:   topic,
+		offsets: make(map[string]kafkaOffset),
+	}
+	var instance struct {
+		BaseURI string `json:"base_uri"`
+	}
+	err := c.call(ctx, http.MethodPost, strings.TrimSuffix(proxyURL, "/")+"/consumers/"+group, map[string]string{
+		"name":               "singularity-" + uuid.NewString(),
+		"format":             "binary",
+		"auto.offset.reset":  "earliest",
+		"auto.commit.enable": "false",
+	}, "", &instance)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create Kafka consumer")
+	}
+	c.baseURI = instance.BaseURI
+	err = c.call(ctx, http.MethodPost, c.baseURI+"/subscription", map[string][]string{"topics": {topic}}, "", nil)
+	if err != nil {
+		_ = c.Close()
+		return nil, errors.Wrapf(err, "failed to subscribe to Kafka topic %s", topic)
+	}
+	return c, nil
+}
+
+// call sends a request to the REST proxy and decodes the JSON response into out, if not nil.
+func (c *KafkaConsumer) call(ctx context.Context, method string, url string, body any, accept string, out any) error {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", kafkaContentType)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Newf("%s %s: %s %s", method, url, resp.Status, strings.TrimSpace(string(message)))
+	}
+	if out == nil {
+		return nil
+	}
+	return errors.WithStack(json.NewDecoder(resp.Body).Decode(out))
+}
+
+func (c *KafkaConsumer) Receive(ctx context.Context) ([]Message, error) {
+	var records []kafkaRecord
+	err := c.call(ctx, http.MethodGet, c.baseURI+"/records", nil, kafkaRecordsType, &records)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch records from Kafka")
+	}
+	if len(records) == 0 {
+		select {
+		case <-ctx.Done():
+		case <-time.After(kafkaIdleWait):
+		}
+		return nil, nil
+	}
+	messages := make([]Message, 0, len(records))
+	for _, record := range records {
+		id := fmt.Sprintf("%s/%d/%d", record.Topic, record.Partition, record.Offset)
+		body, err := base64.StdEncoding.DecodeString(record.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode record %s", id)
+		}
+		c.offsets[id] = kafkaOffset{Topic: record.Topic, Partition: record.Partition, Offset: record.Offset}
+		messages = append(messages, Message{ID: id, Body: body})
+	}
+	return messages, nil
+}
+
+// Ack commits the highest offset of the messages for each partition. The REST proxy commits the offset following the
+// given one, so the acknowledged records are not consumed again.
+func (c *KafkaConsumer) Ack(ctx context.Context, messages []Message) error {
+	latest := make(map[int32]kafkaOffset)
+	for _, message := range messages {
+		offset, ok := c.offsets[message.ID]
+		if !ok {
+			continue
+		}
+		delete(c.offsets, message.ID)
+		if current, ok := latest[offset.Partition]; !ok || offset.Offset > current.Offset {
+			latest[offset.Partition] = offset
+		}
+	}
+	if len(latest) == 0 {
+		return nil
+	}
+	offsets := make([]kafkaOffset, 0, len(latest))
+	for _, offset := range latest {
+		offsets = append(offsets, offset)
+	}
+	err := c.call(ctx, http.MethodPost, c.baseURI+"/offsets", map[string][]kafkaOffset{"offsets": offsets}, "", nil)
+	return errors.Wrap(err, "failed to commit Kafka offsets")
+}
+
+// Close deletes the consumer instance from the REST proxy.
+func (c *KafkaConsumer) Close() error {
+	if c.baseURI == "" {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), kafkaClientTimeout)
+	defer cancel()
+	return c.call(ctx, http.MethodDelete, c.baseURI, nil, "", nil)
+}
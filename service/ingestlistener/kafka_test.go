@@ -0,0 +1,72 @@
+package ingestlistener
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaConsumer(t *testing.T) {
+	ctx := context.Background()
+	var server *httptest.Server
+	var committed []kafkaOffset
+	var deleted bool
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, kafkaContentType, r.Header.Get("Content-Type"))
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/consumers/group":
+			var request map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			require.Equal(t, "binary", request["format"])
+			require.Equal(t, "false", request["auto.commit.enable"])
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"instance_id": request["name"],
+				"base_uri":    server.URL + "/consumers/group/instances/" + request["name"],
+			})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/subscription"):
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/records"):
+			require.Equal(t, kafkaRecordsType, r.Header.Get("Accept"))
+			value := base64.StdEncoding.EncodeToString([]byte(`{"path":"a.txt"}`))
+			_ = json.NewEncoder(w).Encode([]kafkaRecord{
+				{Topic: "topic", Value: value, Partition: 0, Offset: 1},
+				{Topic: "topic", Value: value, Partition: 0, Offset: 2},
+				{Topic: "topic", Value: value, Partition: 1, Offset: 5},
+			})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/offsets"):
+			var request map[string][]kafkaOffset
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			committed = append(committed, request["offsets"]...)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	consumer, err := NewKafkaConsumer(ctx, server.URL, "group", "topic")
+	require.NoError(t, err)
+	messages, err := consumer.Receive(ctx)
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+	require.Equal(t, "topic/0/1", messages[0].ID)
+	require.Equal(t, `{"path":"a.txt"}`, string(messages[0].Body))
+
+	require.NoError(t, consumer.Ack(ctx, messages))
+	require.ElementsMatch(t, []kafkaOffset{
+		{Topic: "topic", Partition: 0, Offset: 2},
+		{Topic: "topic", Partition: 1, Offset: 5},
+	}, committed)
+
+	require.NoError(t, consumer.Close())
+	require.True(t, deleted)
+}
@@ -0,0 +1,66 @@
+package ingestlistener
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/api/option"
+	"google.golang.org/api/pubsub/v1"
+)
+
+const pubsubMaxMessages = 100
+
+// PubSubConsumer pulls messages from a Google Cloud Pub/Sub subscription.
+type PubSubConsumer struct {
+	service      *pubsub.Service
+	subscription string
+}
+
+// NewPubSubConsumer creates a consumer of the Pub/Sub subscription, in the form of
+// projects/<project>/subscriptions/<subscription>. The credentials are the application default credentials unless
+// other options are given.
+func NewPubSubConsumer(ctx context.Context, subscription string, opts ...option.ClientOption) (*PubSubConsumer, error) {
+	service, err := pubsub.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create Pub/Sub client")
+	}
+	return &PubSubConsumer{service: service, subscription: subscription}, nil
+}
+
+func (c *PubSubConsumer) Receive(ctx context.Context) ([]Message, error) {
+	response, err := c.service.Projects.Subscriptions.Pull(c.subscription, &pubsub.PullRequest{
+		MaxMessages: pubsubMaxMessages,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pull messages from Pub/Sub")
+	}
+	messages := make([]Message, 0, len(response.ReceivedMessages))
+	for _, received := range response.ReceivedMessages {
+		message := Message{ID: received.AckId}
+		if received.Message != nil {
+			message.Attributes = received.Message.Attributes
+			message.Body, err = base64.StdEncoding.DecodeString(received.Message.Data)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode message %s", received.Message.MessageId)
+			}
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+func (c *PubSubConsumer) Ack(ctx context.Context, messages []Message) error {
+	ackIDs := make([]string, 0, len(messages))
+	for _, message := range messages {
+		ackIDs = append(ackIDs, message.ID)
+	}
+	_, err := c.service.Projects.Subscriptions.Acknowledge(c.subscription, &pubsub.AcknowledgeRequest{
+		AckIds: ackIDs,
+	}).Context(ctx).Do()
+	return errors.Wrap(err, "failed to acknowledge messages on Pub/Sub")
+}
+
+func (c *PubSubConsumer) Close() error {
+	return nil
+}
@@ -0,0 +1,65 @@
+package ingestlistener
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	"google.golang.org/api/pubsub/v1"
+)
+
+func TestPubSubConsumer(t *testing.T) {
+	ctx := context.Background()
+	subscription := "projects/project/subscriptions/subscription"
+	var acked []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		switch r.URL.Path {
+		case "/v1/" + subscription + ":pull":
+			var request pubsub.PullRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			require.EqualValues(t, pubsubMaxMessages, request.MaxMessages)
+			_ = json.NewEncoder(w).Encode(pubsub.PullResponse{
+				ReceivedMessages: []*pubsub.ReceivedMessage{{
+					AckId: "ack",
+					Message: &pubsub.PubsubMessage{
+						MessageId:  "1",
+						Data:       base64.StdEncoding.EncodeToString([]byte(`{"path":"a.txt"}`)),
+						Attributes: map[string]string{"bucketId": "bucket"},
+					},
+				}},
+			})
+		case "/v1/" + subscription + ":acknowledge":
+			var request pubsub.AcknowledgeRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			acked = append(acked, request.AckIds...)
+			_ = json.NewEncoder(w).Encode(pubsub.Empty{})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	consumer, err := NewPubSubConsumer(ctx, subscription, option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+	messages, err := consumer.Receive(ctx)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Equal(t, "ack", messages[0].ID)
+	require.Equal(t, `{"path":"a.txt"}`, string(messages[0].Body))
+	require.Equal(t, map[string]string{"bucketId": "bucket"}, messages[0].Attributes)
+
+	require.NoError(t, consumer.Ack(ctx, messages))
+	require.Equal(t, []string{"ack"}, acked)
+	require.NoError(t, consumer.Close())
+
+	consumer, err = NewPubSubConsumer(ctx, "projects/project/subscriptions/missing", option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+	_, err = consumer.Receive(ctx)
+	require.ErrorContains(t, err, "failed to pull messages from Pub/Sub")
+}
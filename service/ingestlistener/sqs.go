@@ -0,0 +1,104 @@
+package ingestlistener
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	sqsMaxMessages = 10
+	sqsWaitSeconds = 20
+)
+
+// SQSConsumer receives messages from an Amazon SQS queue with long polling.
+type SQSConsumer struct {
+	client   sqsiface.SQSAPI
+	queueURL string
+}
+
+// NewSQSConsumer creates a consumer of the SQS queue. The credentials are loaded from the environment or the shared
+// AWS configuration, and the region defaults to the region of the queue URL.
+func NewSQSConsumer(queueURL string) (*SQSConsumer, error) {
+	config := aws.NewConfig()
+	parsed, err := url.Parse(queueURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid SQS queue URL %s", queueURL)
+	}
+	// Queue URLs are in the form of https://sqs.<region>.amazonaws.com/<account>/<queue>
+	parts := strings.Split(parsed.Hostname(), ".")
+	if len(parts) > 2 && parts[0] == "sqs" {
+		config = config.WithRegion(parts[1])
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *config,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AWS session")
+	}
+	return &SQSConsumer{client: sqs.New(sess), queueURL: queueURL}, nil
+}
+
+func (c *SQSConsumer) Receive(ctx context.Context) ([]Message, error) {
+	output, err := c.client.ReceiveMessageWithContext(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:              aws.String(c.queueURL),
+		MaxNumberOfMessages:   aws.Int64(sqsMaxMessages),
+		WaitTimeSeconds:       aws.Int64(sqsWaitSeconds),
+		MessageAttributeNames: []*string{aws.String("All")},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to receive messages from SQS")
+	}
+	messages := make([]Message, 0, len(output.Messages))
+	for _, received := range output.Messages {
+		message := Message{
+			ID:         aws.StringValue(received.ReceiptHandle),
+			Body:       []byte(aws.StringValue(received.Body)),
+			Attributes: make(map[string]string),
+		}
+		for name, value := range received.MessageAttributes {
+			message.Attributes[name] = aws.StringValue(value.StringValue)
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+func (c *SQSConsumer) Ack(ctx context.Context, messages []Message) error {
+	for start := 0; start < len(messages); start += sqsMaxMessages {
+		end := start + sqsMaxMessages
+		if end > len(messages) {
+			end = len(messages)
+		}
+		var entries []*sqs.DeleteMessageBatchRequestEntry
+		for i, message := range messages[start:end] {
+			entries = append(entries, &sqs.DeleteMessageBatchRequestEntry{
+				Id:            aws.String(strconv.Itoa(i)),
+				ReceiptHandle: aws.String(message.ID),
+			})
+		}
+		output, err := c.client.DeleteMessageBatchWithContext(ctx, &sqs.DeleteMessageBatchInput{
+			QueueUrl: aws.String(c.queueURL),
+			Entries:  entries,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to delete messages from SQS")
+		}
+		if len(output.Failed) > 0 {
+			return errors.Newf("failed to delete %d messages from SQS: %s", len(output.Failed), aws.StringValue(output.Failed[0].Message))
+		}
+	}
+	return nil
+}
+
+func (c *SQSConsumer) Close() error {
+	return nil
+}
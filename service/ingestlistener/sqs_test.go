@@ -0,0 +1,91 @@
+package ingestlistener
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockSQS struct {
+	sqsiface.SQSAPI
+	mock.Mock
+}
+
+//nolint:forcetypeassert
+func (m *MockSQS) ReceiveMessageWithContext(ctx aws.Context, input *sqs.ReceiveMessageInput, _ ...request.Option) (*sqs.ReceiveMessageOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*sqs.ReceiveMessageOutput), args.Error(1)
+}
+
+//nolint:forcetypeassert
+func (m *MockSQS) DeleteMessageBatchWithContext(ctx aws.Context, input *sqs.DeleteMessageBatchInput, _ ...request.Option) (*sqs.DeleteMessageBatchOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*sqs.DeleteMessageBatchOutput), args.Error(1)
+}
+
+func TestNewSQSConsumer(t *testing.T) {
+	consumer, err := NewSQSConsumer("https://sqs.eu-west-1.amazonaws.com/123456789012/queue")
+	require.NoError(t, err)
+	require.Equal(t, "eu-west-1", aws.StringValue(consumer.client.(*sqs.SQS).Config.Region))
+
+	_, err = NewSQSConsumer("://invalid")
+	require.Error(t, err)
+}
+
+func TestSQSConsumer(t *testing.T) {
+	ctx := context.Background()
+	client := new(MockSQS)
+	consumer := &SQSConsumer{client: client, queueURL: "queue"}
+
+	client.On("ReceiveMessageWithContext", mock.Anything, mock.MatchedBy(func(input *sqs.ReceiveMessageInput) bool {
+		return aws.StringValue(input.QueueUrl) == "queue" && aws.Int64Value(input.MaxNumberOfMessages) == sqsMaxMessages &&
+			aws.Int64Value(input.WaitTimeSeconds) == sqsWaitSeconds
+	})).Return(&sqs.ReceiveMessageOutput{
+		Messages: []*sqs.Message{{
+			ReceiptHandle: aws.String("handle"),
+			Body:          aws.String(`{"path":"a.txt"}`),
+			MessageAttributes: map[string]*sqs.MessageAttributeValue{
+				"source": {DataType: aws.String("String"), StringValue: aws.String("bucket")},
+			},
+		}},
+	}, nil).Once()
+	messages, err := consumer.Receive(ctx)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Equal(t, "handle", messages[0].ID)
+	require.Equal(t, `{"path":"a.txt"}`, string(messages[0].Body))
+	require.Equal(t, map[string]string{"source": "bucket"}, messages[0].Attributes)
+
+	// Messages are deleted in batches of at most 10
+	var acked []Message
+	for i := 0; i < 12; i++ {
+		acked = append(acked, Message{ID: "handle"})
+	}
+	client.On("DeleteMessageBatchWithContext", mock.Anything, mock.MatchedBy(func(input *sqs.DeleteMessageBatchInput) bool {
+		return len(input.Entries) == sqsMaxMessages
+	})).Return(&sqs.DeleteMessageBatchOutput{}, nil).Once()
+	client.On("DeleteMessageBatchWithContext", mock.Anything, mock.MatchedBy(func(input *sqs.DeleteMessageBatchInput) bool {
+		return len(input.Entries) == 2
+	})).Return(&sqs.DeleteMessageBatchOutput{}, nil).Once()
+	require.NoError(t, consumer.Ack(ctx, acked))
+
+	client.On("DeleteMessageBatchWithContext", mock.Anything, mock.Anything).Return(&sqs.DeleteMessageBatchOutput{
+		Failed: []*sqs.BatchResultErrorEntry{{Id: aws.String("0"), Message: aws.String("receipt handle is invalid")}},
+	}, nil).Once()
+	err = consumer.Ack(ctx, messages)
+	require.ErrorContains(t, err, "receipt handle is invalid")
+
+	client.On("ReceiveMessageWithContext", mock.Anything, mock.Anything).
+		Return((*sqs.ReceiveMessageOutput)(nil), context.DeadlineExceeded).Once()
+	_, err = consumer.Receive(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	client.AssertExpectations(t)
+	require.NoError(t, consumer.Close())
+}
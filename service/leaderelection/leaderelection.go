@@ -0,0 +1,161 @@
+package leaderelection
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/ipfs/go-log/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var logger = log.Logger("leaderelection")
+
+// LeaseTTL is how long a lease is valid without being renewed. If the leader stops renewing the lease,
+// e.g. because the node fails, another replica takes over after at most LeaseTTL.
+var LeaseTTL = 30 * time.Second
+
+var ErrLeadershipLost = errors.New("leadership lost")
+
+// Elector elects a single leader among all replicas of a service that share the same database.
+// The leader holds a named lease in the database and renews it periodically. Other replicas stand by
+// and take over the lease once it expires.
+type Elector struct {
+	db     *gorm.DB
+	name   string
+	holder string
+	ttl    time.Duration
+}
+
+// NewElector creates an Elector for the lease with the given name.
+//
+// Parameters:
+//   - db: The database connection used to store the lease.
+//   - name: The name of the lease, i.e. the role that only one replica may hold at a time.
+//   - holder: The unique identifier of this replica, typically the worker ID.
+//
+// Returns:
+//   - A pointer to the Elector.
+func NewElector(db *gorm.DB, name string, holder string) *Elector {
+	return &Elector{
+		db:     db,
+		name:   name,
+		holder: holder,
+		ttl:    LeaseTTL,
+	}
+}
+
+// RetryInterval is how often the lease is renewed by the leader, and how often the other replicas try to acquire it.
+func (e *Elector) RetryInterval() time.Duration {
+	return e.ttl / 3
+}
+
+// TryAcquire acquires or renews the lease. It returns true if this replica is the leader.
+// The lease is acquired if it does not exist yet, is already held by this replica, or has expired.
+func (e *Elector) TryAcquire(ctx context.Context) (bool, error) {
+	var acquired bool
+	err := database.DoRetry(ctx, func() error {
+		db := e.db.WithContext(ctx)
+		now := time.Now().UTC()
+		err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.Lease{
+			Name:      e.name,
+			Holder:    e.holder,
+			ExpiresAt: now.Add(e.ttl),
+		}).Error
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		result := db.Model(&model.Lease{}).
+			Where("name = ? AND (holder = ? OR expires_at < ?)", e.name, e.holder, now).
+			Updates(map[string]any{
+				"holder":     e.holder,
+				"expires_at": now.Add(e.ttl),
+			})
+		if result.Error != nil {
+			return errors.WithStack(result.Error)
+		}
+		acquired = result.RowsAffected > 0
+		return nil
+	})
+	return acquired, errors.WithStack(err)
+}
+
+// Campaign blocks until this replica becomes the leader, or the context is done.
+func (e *Elector) Campaign(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	var logged bool
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+		acquired, err := e.TryAcquire(ctx)
+		if err != nil {
+			return err
+		}
+		if acquired {
+			logger.Infow("elected as leader", "lease", e.name, "holder", e.holder)
+			return nil
+		}
+		if !logged {
+			logger.Infow("another replica is the leader, standing by", "lease", e.name)
+			logged = true
+		}
+		timer.Reset(e.RetryInterval())
+	}
+}
+
+// Hold keeps renewing the lease until the context is done. The returned context is cancelled when the lease
+// cannot be renewed before it expires, so that the leader stops working before another replica takes over.
+// Lost reports whether the returned context was cancelled because the leadership has been lost.
+func (e *Elector) Hold(ctx context.Context) (leaderCtx context.Context, lost func() bool) {
+	leaderCtx, cancel := context.WithCancelCause(ctx)
+	go func() {
+		timer := time.NewTimer(e.RetryInterval())
+		defer timer.Stop()
+		lastRenewed := time.Now()
+		for {
+			select {
+			case <-leaderCtx.Done():
+				return
+			case <-timer.C:
+			}
+			acquired, err := e.TryAcquire(leaderCtx)
+			switch {
+			case err != nil && leaderCtx.Err() != nil:
+				return
+			case err != nil:
+				logger.Warnw("failed to renew lease", "lease", e.name, "error", err)
+			case !acquired:
+				logger.Errorw("lease has been taken over by another replica", "lease", e.name)
+				cancel(ErrLeadershipLost)
+				return
+			default:
+				lastRenewed = time.Now()
+			}
+			// Step down before the lease expires, since another replica may take it over afterwards.
+			if time.Since(lastRenewed) > e.ttl-e.RetryInterval() {
+				logger.Errorw("failed to renew lease before it expires", "lease", e.name)
+				cancel(ErrLeadershipLost)
+				return
+			}
+			timer.Reset(e.RetryInterval())
+		}
+	}()
+	return leaderCtx, func() bool {
+		return errors.Is(context.Cause(leaderCtx), ErrLeadershipLost)
+	}
+}
+
+// Release gives up the lease if it is held by this replica, so that another replica can take over immediately.
+func (e *Elector) Release(ctx context.Context) error {
+	return database.DoRetry(ctx, func() error {
+		return e.db.WithContext(ctx).Where("name = ? AND holder = ?", e.name, e.holder).Delete(&model.Lease{}).Error
+	})
+}
@@ -0,0 +1,97 @@
+package leaderelection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestTryAcquire(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		leader := NewElector(db, "test", "leader")
+		standby := NewElector(db, "test", "standby")
+
+		acquired, err := leader.TryAcquire(ctx)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		acquired, err = standby.TryAcquire(ctx)
+		require.NoError(t, err)
+		require.False(t, acquired)
+
+		// Renew
+		acquired, err = leader.TryAcquire(ctx)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		require.NoError(t, leader.Release(ctx))
+		acquired, err = standby.TryAcquire(ctx)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		var lease model.Lease
+		require.NoError(t, db.First(&lease, "name = ?", "test").Error)
+		require.Equal(t, "standby", lease.Holder)
+	})
+}
+
+func TestTakeOverExpiredLease(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		leader := NewElector(db, "test", "leader")
+		standby := NewElector(db, "test", "standby")
+		acquired, err := leader.TryAcquire(ctx)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		require.NoError(t, db.Model(&model.Lease{}).Where("name = ?", "test").
+			Update("expires_at", time.Now().UTC().Add(-time.Second)).Error)
+
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		require.NoError(t, standby.Campaign(ctx))
+	})
+}
+
+func TestHold_Lost(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		leader := NewElector(db, "test", "leader")
+		leader.ttl = 300 * time.Millisecond
+		acquired, err := leader.TryAcquire(ctx)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		leaderCtx, lost := leader.Hold(ctx)
+		require.NoError(t, db.Model(&model.Lease{}).Where("name = ?", "test").
+			Update("holder", "other").Error)
+
+		select {
+		case <-leaderCtx.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("leadership should have been lost")
+		}
+		require.True(t, lost())
+	})
+}
+
+func TestHold_Stopped(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		leader := NewElector(db, "test", "leader")
+		leader.ttl = 300 * time.Millisecond
+		acquired, err := leader.TryAcquire(ctx)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		ctx, cancel := context.WithCancel(ctx)
+		leaderCtx, lost := leader.Hold(ctx)
+		time.Sleep(time.Second)
+		require.NoError(t, leaderCtx.Err())
+		cancel()
+		<-leaderCtx.Done()
+		require.False(t, lost())
+	})
+}
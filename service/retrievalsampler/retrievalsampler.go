@@ -0,0 +1,342 @@
+package retrievalsampler
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/database"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/service/healthcheck"
+	"github.com/data-preservation-programs/singularity/service/leaderelection"
+	"github.com/google/uuid"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-log/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrAlreadyRunning = errors.New("another worker already running")
+
+var Logger = log.Logger("retrievalsampler")
+
+const cleanupTimeout = 5 * time.Second
+
+// proofWindow is the period over which the proof success rate of a provider is measured.
+const proofWindow = 7 * 24 * time.Hour
+
+// BlockRetriever retrieves a block from a list of storage providers with a trustless retrieval.
+type BlockRetriever interface {
+	RetrieveBlock(ctx context.Context, c cid.Cid, sps []string) ([]byte, error)
+}
+
+// RetrievalSampler periodically retrieves random blocks of the active deals from their providers, verifies them
+// against the CIDs stored when the pieces were packed, and records the outcome as a retrieval proof of the deal.
+type RetrievalSampler struct {
+	workerID    uuid.UUID
+	dbNoContext *gorm.DB
+	retriever   BlockRetriever
+	interval    time.Duration
+	sampleSize  int
+	timeout     time.Duration
+	once        bool
+}
+
+func NewRetrievalSampler(
+	db *gorm.DB,
+	retriever BlockRetriever,
+	interval time.Duration,
+	sampleSize int,
+	timeout time.Duration,
+	once bool) RetrievalSampler {
+	return RetrievalSampler{
+		workerID:    uuid.New(),
+		dbNoContext: db,
+		retriever:   retriever,
+		interval:    interval,
+		sampleSize:  sampleSize,
+		timeout:     timeout,
+		once:        once,
+	}
+}
+
+func (*RetrievalSampler) Name() string {
+	return "RetrievalSampler"
+}
+
+// Start starts the RetrievalSampler. Like the deal tracker, it holds a lease so that only one replica samples the
+// deals, registers itself as a worker and reports its health, then samples the deals every interval until the
+// context is done or the lease is lost. The outcome of the run is sent to exitErr once the sampler has stopped.
+func (r *RetrievalSampler) Start(ctx context.Context, exitErr chan<- error) error {
+	elector := leaderelection.NewElector(r.dbNoContext, string(model.RetrievalSampler), r.workerID.String())
+	acquired, err := elector.TryAcquire(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !acquired {
+		Logger.Warnw("another worker already running")
+		if r.once {
+			return ErrAlreadyRunning
+		}
+		err = elector.Campaign(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	_, err = healthcheck.Register(ctx, r.dbNoContext, r.workerID, model.RetrievalSampler, true)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	ctx, lost := elector.Hold(ctx)
+	var cancel context.CancelFunc
+	ctx, cancel = context.WithCancel(ctx)
+
+	healthcheckDone := make(chan struct{})
+	go func() {
+		defer close(healthcheckDone)
+		healthcheck.StartReportHealth(ctx, r.dbNoContext, r.workerID, model.RetrievalSampler)
+		Logger.Info("health report stopped")
+	}()
+
+	go func() {
+		var timer *time.Timer
+		var runErr error
+		for {
+			runErr = r.runOnce(ctx)
+			if runErr != nil {
+				if ctx.Err() != nil {
+					if errors.Is(runErr, context.Canceled) {
+						runErr = nil
+					}
+					Logger.Info("run stopped")
+					break
+				}
+				Logger.Errorw("failed to run once", "error", runErr)
+			}
+			if r.once {
+				Logger.Info("run once done")
+				break
+			}
+			if timer == nil {
+				timer = time.NewTimer(r.interval)
+				defer timer.Stop()
+			} else {
+				timer.Reset(r.interval)
+			}
+
+			var stopped bool
+			select {
+			case <-ctx.Done():
+				stopped = true
+			case <-timer.C:
+			}
+			if stopped {
+				Logger.Info("run stopped")
+				break
+			}
+		}
+
+		cancel()
+
+		ctx2, cancel2 := context.WithTimeout(context.Background(), cleanupTimeout)
+		defer cancel2()
+		//nolint:contextcheck
+		err := r.cleanup(ctx2)
+		if err != nil {
+			Logger.Errorw("failed to cleanup", "error", err)
+		} else {
+			Logger.Info("cleanup done")
+		}
+		//nolint:contextcheck
+		err = elector.Release(ctx2)
+		if err != nil {
+			Logger.Errorw("failed to release lease", "error", err)
+		}
+
+		<-healthcheckDone
+
+		if runErr == nil && lost() {
+			runErr = leaderelection.ErrLeadershipLost
+		}
+		if exitErr != nil {
+			exitErr <- runErr
+		}
+	}()
+
+	return nil
+}
+
+func (r *RetrievalSampler) cleanup(ctx context.Context) error {
+	return database.DoRetry(ctx, func() error {
+		return r.dbNoContext.WithContext(ctx).Where("id = ?", r.workerID).Delete(&model.Worker{}).Error
+	})
+}
+
+// runOnce samples the active deals of the pieces packed by Singularity that were sampled the longest time ago, so
+// all deals are sampled in turn. A random block of each deal is retrieved from its provider and recorded as a
+// retrieval proof. The number of consecutive failed proofs of the deal is kept, so the replication policies repair
+// the pieces of unretrievable deals, and the proof success rate over the last week is stored in the reputation of
+// the sampled providers, so the deal pusher stops making deals with providers that do not serve their data.
+func (r *RetrievalSampler) runOnce(ctx context.Context) error {
+	db := r.dbNoContext.WithContext(ctx)
+	var deals []model.Deal
+	err := db.Where("state = ? AND piece_cid IN (?)", model.DealActive, db.Model(&model.Car{}).Select("piece_cid")).
+		Order("last_sampled_at IS NOT NULL, last_sampled_at, id").
+		Limit(r.sampleSize).
+		Find(&deals).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to find deals to sample")
+	}
+
+	providers := make(map[string]struct{})
+	for _, deal := range deals {
+		proof, err := r.sample(ctx, deal)
+		if err != nil {
+			return errors.Wrapf(err, "failed to sample deal %d", deal.ID)
+		}
+		if proof == nil {
+			continue
+		}
+		providers[deal.Provider] = struct{}{}
+		if proof.Success {
+			Logger.Infow("retrieval proof succeeded", "deal_id", deal.ID, "provider", deal.Provider,
+				"block_cid", proof.BlockCID.String(), "latency", proof.Latency)
+		} else {
+			Logger.Warnw("retrieval proof failed", "deal_id", deal.ID, "provider", deal.Provider,
+				"block_cid", proof.BlockCID.String(), "error", proof.Error)
+		}
+	}
+
+	now := time.Now()
+	for provider := range providers {
+		err = updateReputation(ctx, db, provider, now)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update reputation of %s", provider)
+		}
+	}
+	return nil
+}
+
+// sample retrieves a random block of the pieces of a deal from its provider and verifies it against its CID. The
+// blocks of a piece are those of its CAR files, or of the CAR files aggregated into it. The outcome is recorded as a
+// retrieval proof, and the deal is marked as sampled. No proof is recorded if the blocks of the piece are unknown.
+func (r *RetrievalSampler) sample(ctx context.Context, deal model.Deal) (*model.RetrievalProof, error) {
+	db := r.dbNoContext.WithContext(ctx)
+	now := time.Now()
+	var carIDs []model.CarID
+	err := db.Model(&model.Car{}).Where("piece_cid = ?", deal.PieceCID).Pluck("id", &carIDs).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find cars of piece")
+	}
+	blocks := func() *gorm.DB {
+		return db.Model(&model.CarBlock{}).Where("car_id IN ? OR car_id IN (?)",
+			carIDs, db.Model(&model.Car{}).Select("id").Where("aggregate_id IN ?", carIDs))
+	}
+	var count int64
+	err = blocks().Count(&count).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count blocks of piece")
+	}
+	if count == 0 {
+		Logger.Debugw("skipping deal since the blocks of its piece are unknown", "deal_id", deal.ID)
+		err = database.DoRetry(ctx, func() error {
+			return db.Model(&model.Deal{}).Where("id = ?", deal.ID).Update("last_sampled_at", now).Error
+		})
+		return nil, errors.Wrap(err, "failed to update deal")
+	}
+	var block model.CarBlock
+	//nolint:gosec
+	err = blocks().Select("cid").Order("id").Offset(int(rand.Int63n(count))).Limit(1).Find(&block).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find block of piece")
+	}
+
+	proof := model.RetrievalProof{
+		Provider: deal.Provider,
+		PieceCID: deal.PieceCID,
+		BlockCID: block.CID,
+		DealID:   deal.ID,
+	}
+	retrieveCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	start := time.Now()
+	data, err := r.retriever.RetrieveBlock(retrieveCtx, cid.Cid(block.CID), []string{deal.Provider})
+	proof.Latency = time.Since(start)
+	if err == nil {
+		err = verifyBlock(cid.Cid(block.CID), data)
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		proof.Error = err.Error()
+	} else {
+		proof.Success = true
+	}
+
+	updates := map[string]any{"last_sampled_at": now, "failed_proofs": 0}
+	if !proof.Success {
+		updates["failed_proofs"] = gorm.Expr("failed_proofs + 1")
+	}
+	err = database.DoRetry(ctx, func() error {
+		return db.Transaction(func(db *gorm.DB) error {
+			err := db.Create(&proof).Error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return db.Model(&model.Deal{}).Where("id = ?", deal.ID).Updates(updates).Error
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save retrieval proof")
+	}
+	return &proof, nil
+}
+
+// verifyBlock checks that the data of a block hashes to its CID.
+func verifyBlock(c cid.Cid, data []byte) error {
+	actual, err := c.Prefix().Sum(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to hash block %s", c)
+	}
+	if !actual.Equals(c) {
+		return errors.Newf("block %s does not match its CID, got %s", c, actual)
+	}
+	return nil
+}
+
+// updateReputation stores the success rate of the retrieval proofs of a provider over the last week in its
+// reputation, next to the retrieval metrics ingested by the deal tracker.
+func updateReputation(ctx context.Context, db *gorm.DB, provider string, now time.Time) error {
+	var total, successful int64
+	proofs := func() *gorm.DB {
+		return db.Model(&model.RetrievalProof{}).Where("provider = ? AND created_at > ?", provider, now.Add(-proofWindow))
+	}
+	err := proofs().Count(&total).Error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = proofs().Where("success = ?", true).Count(&successful).Error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if total == 0 {
+		return nil
+	}
+	reputation := model.ProviderReputation{
+		Provider:         provider,
+		ProofTotal:       total,
+		ProofSuccessful:  successful,
+		ProofSuccessRate: float64(successful) / float64(total),
+	}
+	return database.DoRetry(ctx, func() error {
+		return db.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "provider"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"updated_at", "proof_total", "proof_successful", "proof_success_rate",
+			}),
+		}).Create(&reputation).Error
+	})
+}
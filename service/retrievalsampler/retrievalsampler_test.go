@@ -0,0 +1,148 @@
+package retrievalsampler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type fakeRetriever struct {
+	blocks    map[cid.Cid][]byte
+	corrupted map[string]bool
+	requests  int
+}
+
+func (f *fakeRetriever) RetrieveBlock(_ context.Context, c cid.Cid, sps []string) ([]byte, error) {
+	f.requests++
+	if f.corrupted[sps[0]] {
+		return []byte("corrupted"), nil
+	}
+	data, ok := f.blocks[c]
+	if !ok {
+		return nil, errors.New("block not found")
+	}
+	return data, nil
+}
+
+func createCar(t *testing.T, db *gorm.DB, retriever *fakeRetriever, car model.Car, numBlocks int) model.Car {
+	t.Helper()
+	require.NoError(t, db.Create(&car).Error)
+	for i := 0; i < numBlocks; i++ {
+		data := testutil.GenerateRandomBytes(100)
+		c := cid.NewCidV1(cid.Raw, util.Hash(data))
+		retriever.blocks[c] = data
+		require.NoError(t, db.Create(&model.CarBlock{CarID: car.ID, CID: model.CID(c)}).Error)
+	}
+	return car
+}
+
+func TestRetrievalSampler_RunOnce(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		retriever := &fakeRetriever{blocks: make(map[cid.Cid][]byte), corrupted: map[string]bool{"f02": true}}
+		sampler := NewRetrievalSampler(db, retriever, time.Hour, 10, time.Minute, true)
+		preparation := model.Preparation{Name: "prep"}
+		require.NoError(t, db.Create(&preparation).Error)
+		require.NoError(t, db.Create(&model.Wallet{ID: "f0client"}).Error)
+
+		piece := model.CID(testutil.TestCid)
+		createCar(t, db, retriever, model.Car{PreparationID: preparation.ID, PieceCID: piece}, 3)
+		// The blocks of an aggregate piece are those of the pieces aggregated into it
+		aggregatePiece := model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("aggregate"))))
+		aggregate := createCar(t, db, retriever, model.Car{PreparationID: preparation.ID, PieceCID: aggregatePiece}, 0)
+		createCar(t, db, retriever, model.Car{PreparationID: preparation.ID, PieceCID: model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("small")))), AggregateID: &aggregate.ID}, 2)
+		// The provider of this piece does not serve its block
+		unknownPiece := model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("unknown"))))
+		unknown := createCar(t, db, retriever, model.Car{PreparationID: preparation.ID, PieceCID: unknownPiece}, 0)
+		require.NoError(t, db.Create(&model.CarBlock{CarID: unknown.ID, CID: model.CID(testutil.TestCid)}).Error)
+
+		deals := []model.Deal{
+			{PieceCID: piece, Provider: "f01", State: model.DealActive, ClientID: "f0client"},
+			{PieceCID: piece, Provider: "f02", State: model.DealActive, ClientID: "f0client"},
+			{PieceCID: aggregatePiece, Provider: "f03", State: model.DealActive, ClientID: "f0client"},
+			{PieceCID: unknownPiece, Provider: "f04", State: model.DealActive, ClientID: "f0client"},
+			// Deals that are not active, or whose pieces were not packed by us, are not sampled
+			{PieceCID: piece, Provider: "f05", State: model.DealExpired, ClientID: "f0client"},
+			{PieceCID: model.CID(cid.NewCidV1(cid.Raw, util.Hash([]byte("other")))), Provider: "f06", State: model.DealActive, ClientID: "f0client"},
+		}
+		require.NoError(t, db.Create(&deals).Error)
+
+		require.NoError(t, sampler.runOnce(ctx))
+		require.Equal(t, 4, retriever.requests)
+		var proofs []model.RetrievalProof
+		require.NoError(t, db.Order("deal_id").Find(&proofs).Error)
+		require.Len(t, proofs, 4)
+		require.True(t, proofs[0].Success)
+		require.Equal(t, "f01", proofs[0].Provider)
+		require.Equal(t, piece, proofs[0].PieceCID)
+		require.Contains(t, retriever.blocks, cid.Cid(proofs[0].BlockCID))
+		require.False(t, proofs[1].Success)
+		require.Contains(t, proofs[1].Error, "does not match its CID")
+		require.True(t, proofs[2].Success)
+		require.False(t, proofs[3].Success)
+		require.Contains(t, proofs[3].Error, "block not found")
+
+		var sampled []model.Deal
+		require.NoError(t, db.Order("id").Find(&sampled).Error)
+		require.Equal(t, []int{0, 1, 0, 1, 0, 0}, []int{
+			sampled[0].FailedProofs, sampled[1].FailedProofs, sampled[2].FailedProofs,
+			sampled[3].FailedProofs, sampled[4].FailedProofs, sampled[5].FailedProofs,
+		})
+		require.NotNil(t, sampled[0].LastSampledAt)
+		require.Nil(t, sampled[4].LastSampledAt)
+
+		var reputations []model.ProviderReputation
+		require.NoError(t, db.Order("provider").Find(&reputations).Error)
+		require.Len(t, reputations, 4)
+		require.EqualValues(t, 1, reputations[0].ProofTotal)
+		require.InDelta(t, 1.0, reputations[0].ProofSuccessRate, 1e-9)
+		require.EqualValues(t, 1, reputations[1].ProofTotal)
+		require.Zero(t, reputations[1].ProofSuccessRate)
+
+		// Consecutive failures are counted until a proof succeeds
+		require.NoError(t, sampler.runOnce(ctx))
+		require.NoError(t, db.First(&sampled[1], sampled[1].ID).Error)
+		require.Equal(t, 2, sampled[1].FailedProofs)
+		retriever.corrupted = nil
+		require.NoError(t, sampler.runOnce(ctx))
+		require.NoError(t, db.First(&sampled[1], sampled[1].ID).Error)
+		require.Zero(t, sampled[1].FailedProofs)
+		require.NoError(t, db.Where("provider = ?", "f02").First(&reputations[1]).Error)
+		require.EqualValues(t, 3, reputations[1].ProofTotal)
+		require.EqualValues(t, 1, reputations[1].ProofSuccessful)
+	})
+}
+
+func TestRetrievalSampler_SampleSize(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		retriever := &fakeRetriever{blocks: make(map[cid.Cid][]byte)}
+		sampler := NewRetrievalSampler(db, retriever, time.Hour, 2, time.Minute, true)
+		preparation := model.Preparation{Name: "prep"}
+		require.NoError(t, db.Create(&preparation).Error)
+		require.NoError(t, db.Create(&model.Wallet{ID: "f0client"}).Error)
+		createCar(t, db, retriever, model.Car{PreparationID: preparation.ID, PieceCID: model.CID(testutil.TestCid)}, 1)
+		for _, provider := range []string{"f01", "f02", "f03"} {
+			require.NoError(t, db.Create(&model.Deal{
+				PieceCID: model.CID(testutil.TestCid), Provider: provider, State: model.DealActive, ClientID: "f0client",
+			}).Error)
+		}
+
+		// The deals sampled the longest time ago are sampled first, so all deals are sampled in turn
+		require.NoError(t, sampler.runOnce(ctx))
+		require.NoError(t, sampler.runOnce(ctx))
+		var counts []int64
+		for _, provider := range []string{"f01", "f02", "f03"} {
+			var count int64
+			require.NoError(t, db.Model(&model.RetrievalProof{}).Where("provider = ?", provider).Count(&count).Error)
+			counts = append(counts, count)
+		}
+		require.Equal(t, []int64{2, 1, 1}, counts)
+	})
+}
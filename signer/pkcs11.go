@@ -0,0 +1,373 @@
+//go:build cgo
+
+package signer
+
+import (
+	"crypto/sha256"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/crypto"
+	p2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/crypto/pb"
+	"github.com/miekg/pkcs11"
+	"golang.org/x/crypto/blake2b"
+)
+
+var ErrKeyNotExportable = errors.New("the key is kept in a PKCS#11 token and cannot be exported")
+
+// A PKCS#11 module can only be initialized once per process, and logging in is per token rather than per session.
+// So the modules and the sessions are cached for the lifetime of the process, which also avoids logging in to the
+// token for every signature.
+var (
+	cacheMu  sync.Mutex
+	modules  = make(map[string]*pkcs11.Ctx)
+	sessions = make(map[string]*session)
+	keys     = make(map[string]*pkcs11Key)
+)
+
+// session is a logged in session with a token. A session cannot be used concurrently, so all operations hold mu.
+type session struct {
+	mu     sync.Mutex
+	ctx    *pkcs11.Ctx
+	handle pkcs11.SessionHandle
+	opened bool
+}
+
+// pkcs11Key is a secp256k1 key in a token. Its public key is read from the token on first use.
+type pkcs11Key struct {
+	uri       PKCS11URI
+	session   *session
+	mu        sync.Mutex
+	publicKey *secp256k1.PublicKey
+}
+
+// getKey returns the cached key for the URI, so the public key and the session are shared by all its users.
+func getKey(uri PKCS11URI) *pkcs11Key {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	cacheKey := uri.String()
+	key, ok := keys[cacheKey]
+	if !ok {
+		sessionKey := uri.ModulePath + "|" + uri.Token + "|" + uri.Serial
+		if uri.SlotID != nil {
+			sessionKey += "|" + strconv.FormatUint(uint64(*uri.SlotID), 10)
+		}
+		s, ok := sessions[sessionKey]
+		if !ok {
+			s = &session{}
+			sessions[sessionKey] = s
+		}
+		key = &pkcs11Key{uri: uri, session: s}
+		keys[cacheKey] = key
+	}
+	return key
+}
+
+// loadModule loads and initializes the PKCS#11 module at the path, or returns the one already loaded.
+func loadModule(path string) (*pkcs11.Ctx, error) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if ctx, ok := modules[path]; ok {
+		return ctx, nil
+	}
+	ctx := pkcs11.New(path)
+	if ctx == nil {
+		return nil, errors.Newf("failed to load PKCS#11 module %s", path)
+	}
+	err := ctx.Initialize()
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		ctx.Destroy()
+		return nil, errors.Wrapf(err, "failed to initialize PKCS#11 module %s", path)
+	}
+	modules[path] = ctx
+	return ctx, nil
+}
+
+// findToken returns the slot of the token of the URI in the module, and whether it has been found.
+func findToken(ctx *pkcs11.Ctx, uri PKCS11URI) (uint, pkcs11.TokenInfo, bool, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, pkcs11.TokenInfo{}, false, errors.Wrap(err, "failed to list PKCS#11 slots")
+	}
+	for _, id := range slots {
+		if uri.SlotID != nil && *uri.SlotID != id {
+			continue
+		}
+		info, err := ctx.GetTokenInfo(id)
+		if err != nil {
+			return 0, pkcs11.TokenInfo{}, false, errors.Wrapf(err, "failed to get the token info of slot %d", id)
+		}
+		if (uri.Token != "" && uri.Token != info.Label) || (uri.Serial != "" && uri.Serial != info.SerialNumber) {
+			continue
+		}
+		return id, info, true, nil
+	}
+	return 0, pkcs11.TokenInfo{}, false, nil
+}
+
+// open opens a session with the token of the URI and logs in with its PIN. The token is looked for in the allowed
+// modules, see PKCS11URI.Modules.
+func (s *session) open(uri PKCS11URI) error {
+	paths, err := uri.Modules()
+	if err != nil {
+		return err
+	}
+	var ctx *pkcs11.Ctx
+	var slot uint
+	var tokenInfo pkcs11.TokenInfo
+	found := false
+	for _, path := range paths {
+		ctx, err = loadModule(path)
+		if err != nil {
+			return err
+		}
+		slot, tokenInfo, found, err = findToken(ctx, uri)
+		if err != nil {
+			return err
+		}
+		if found {
+			break
+		}
+	}
+	if !found {
+		return errors.Newf("no token found for %s", uri)
+	}
+
+	handle, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open a session with token %s", tokenInfo.Label)
+	}
+	pin, err := uri.PIN()
+	if err != nil {
+		_ = ctx.CloseSession(handle)
+		return err
+	}
+	protectedPath := tokenInfo.Flags&pkcs11.CKF_PROTECTED_AUTHENTICATION_PATH != 0
+	if pin == "" && !protectedPath && tokenInfo.Flags&pkcs11.CKF_LOGIN_REQUIRED != 0 {
+		_ = ctx.CloseSession(handle)
+		return errors.Newf("token %s requires a PIN, set it with the pin-source attribute or the %s environment variable",
+			tokenInfo.Label, PINEnvVar)
+	}
+	if pin != "" || protectedPath {
+		err = ctx.Login(handle, pkcs11.CKU_USER, pin)
+		if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+			_ = ctx.CloseSession(handle)
+			return errors.Wrapf(err, "failed to log in to token %s", tokenInfo.Label)
+		}
+	}
+	s.ctx, s.handle, s.opened = ctx, handle, true
+	return nil
+}
+
+// isSessionError returns whether the error means the session is no longer usable, i.e. the token has been removed
+// and inserted again, so a new session has to be opened.
+func isSessionError(err error) bool {
+	for _, code := range []uint{
+		pkcs11.CKR_SESSION_HANDLE_INVALID,
+		pkcs11.CKR_SESSION_CLOSED,
+		pkcs11.CKR_USER_NOT_LOGGED_IN,
+		pkcs11.CKR_DEVICE_REMOVED,
+		pkcs11.CKR_TOKEN_NOT_PRESENT,
+	} {
+		if errors.Is(err, pkcs11.Error(code)) {
+			return true
+		}
+	}
+	return false
+}
+
+// do runs fn with the cached session of the key, opening it first if needed. If fn fails because the session is no
+// longer usable, the session is opened again and fn is retried once.
+func (k *pkcs11Key) do(fn func(ctx *pkcs11.Ctx, handle pkcs11.SessionHandle) error) error {
+	s := k.session
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for attempt := 0; ; attempt++ {
+		if !s.opened {
+			err := s.open(k.uri)
+			if err != nil {
+				return err
+			}
+		}
+		err := fn(s.ctx, s.handle)
+		if err == nil || attempt > 0 || !isSessionError(err) {
+			return err
+		}
+		_ = s.ctx.CloseSession(s.handle)
+		s.opened = false
+	}
+}
+
+// findObject returns the object of the given class that matches the object label and the id of the URI.
+func findObject(ctx *pkcs11.Ctx, handle pkcs11.SessionHandle, class uint, uri PKCS11URI) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+	}
+	if uri.Object != "" {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, uri.Object))
+	}
+	if len(uri.ID) > 0 {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_ID, uri.ID))
+	}
+	err := ctx.FindObjectsInit(handle, template)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find key")
+	}
+	objects, _, err := ctx.FindObjects(handle, 2)
+	finalErr := ctx.FindObjectsFinal(handle)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find key")
+	}
+	if finalErr != nil {
+		return 0, errors.Wrap(finalErr, "failed to find key")
+	}
+	switch len(objects) {
+	case 0:
+		return 0, errors.Newf("no EC key found for %s", uri)
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, errors.Newf("more than one EC key found for %s", uri)
+	}
+}
+
+// getPublicKey returns the public key of the key, reading it from the token on first use.
+func (k *pkcs11Key) getPublicKey() (*secp256k1.PublicKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.publicKey != nil {
+		return k.publicKey, nil
+	}
+	err := k.do(func(ctx *pkcs11.Ctx, handle pkcs11.SessionHandle) error {
+		object, err := findObject(ctx, handle, pkcs11.CKO_PUBLIC_KEY, k.uri)
+		if err != nil {
+			return err
+		}
+		attributes, err := ctx.GetAttributeValue(handle, object, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to read public key")
+		}
+		err = parseECParams(attributes[0].Value)
+		if err != nil {
+			return err
+		}
+		k.publicKey, err = parseECPoint(attributes[1].Value)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return k.publicKey, nil
+}
+
+// sign signs the digest with the CKM_ECDSA mechanism, which returns r and s concatenated.
+func (k *pkcs11Key) sign(digest []byte) ([]byte, error) {
+	var signature []byte
+	err := k.do(func(ctx *pkcs11.Ctx, handle pkcs11.SessionHandle) error {
+		object, err := findObject(ctx, handle, pkcs11.CKO_PRIVATE_KEY, k.uri)
+		if err != nil {
+			return err
+		}
+		err = ctx.SignInit(handle, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}, object)
+		if err != nil {
+			return errors.Wrap(err, "failed to sign")
+		}
+		signature, err = ctx.Sign(handle, digest)
+		return errors.Wrap(err, "failed to sign")
+	})
+	return signature, err
+}
+
+// PKCS11Signer signs with a secp256k1 key kept in a PKCS#11 token, such as a YubiHSM.
+type PKCS11Signer struct {
+	key *pkcs11Key
+}
+
+// NewPKCS11Signer returns a Signer for the key in the token identified by the URI. The token is only accessed when
+// the address is requested or a message is signed.
+func NewPKCS11Signer(uri PKCS11URI) (Signer, error) {
+	return &PKCS11Signer{key: getKey(uri)}, nil
+}
+
+func (p *PKCS11Signer) Address() (address.Address, error) {
+	publicKey, err := p.key.getPublicKey()
+	if err != nil {
+		return address.Undef, err
+	}
+	addr, err := address.NewSecp256k1Address(publicKey.SerializeUncompressed())
+	return addr, errors.WithStack(err)
+}
+
+func (p *PKCS11Signer) Sign(msg []byte) (*crypto.Signature, error) {
+	publicKey, err := p.key.getPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	hash := blake2b.Sum256(msg)
+	signature, err := p.key.sign(hash[:])
+	if err != nil {
+		return nil, err
+	}
+	data, err := recoverableSignature(signature, hash[:], publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &crypto.Signature{Type: crypto.SigTypeSecp256k1, Data: data}, nil
+}
+
+// pkcs11PrivKey is a libp2p private key backed by a secp256k1 key kept in a PKCS#11 token.
+type pkcs11PrivKey struct {
+	key    *pkcs11Key
+	public p2pcrypto.PubKey
+}
+
+// NewPKCS11PrivKey returns a libp2p private key for the key in the token identified by the URI, i.e. to sign the
+// piece manifest of the content provider. The public key is read from the token immediately, so a missing token or
+// a wrong PIN is reported on startup.
+func NewPKCS11PrivKey(uri PKCS11URI) (p2pcrypto.PrivKey, error) {
+	key := getKey(uri)
+	publicKey, err := key.getPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	public, err := p2pcrypto.UnmarshalSecp256k1PublicKey(publicKey.SerializeCompressed())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &pkcs11PrivKey{key: key, public: public}, nil
+}
+
+func (p *pkcs11PrivKey) Equals(o p2pcrypto.Key) bool {
+	other, ok := o.(p2pcrypto.PrivKey)
+	return ok && p.public.Equals(other.GetPublic())
+}
+
+func (p *pkcs11PrivKey) Raw() ([]byte, error) {
+	return nil, ErrKeyNotExportable
+}
+
+func (p *pkcs11PrivKey) Type() pb.KeyType {
+	return pb.KeyType_Secp256k1
+}
+
+// Sign signs the SHA-256 hash of the data with a DER encoded signature, the same as a libp2p secp256k1 key.
+func (p *pkcs11PrivKey) Sign(data []byte) ([]byte, error) {
+	hash := sha256.Sum256(data)
+	signature, err := p.key.sign(hash[:])
+	if err != nil {
+		return nil, err
+	}
+	return derSignature(signature)
+}
+
+func (p *pkcs11PrivKey) GetPublic() p2pcrypto.PubKey {
+	return p.public
+}
@@ -0,0 +1,18 @@
+//go:build !cgo
+
+package signer
+
+import (
+	"github.com/cockroachdb/errors"
+	p2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+)
+
+// NewPKCS11Signer is not supported without CGO, as PKCS#11 modules are native libraries.
+func NewPKCS11Signer(uri PKCS11URI) (Signer, error) {
+	return nil, errors.WithStack(ErrPKCS11NotSupported)
+}
+
+// NewPKCS11PrivKey is not supported without CGO, as PKCS#11 modules are native libraries.
+func NewPKCS11PrivKey(uri PKCS11URI) (p2pcrypto.PrivKey, error) {
+	return nil, errors.WithStack(ErrPKCS11NotSupported)
+}
@@ -0,0 +1,130 @@
+//go:build cgo
+
+package signer
+
+import (
+	"encoding/asn1"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jsign/go-filsigner/wallet"
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/require"
+)
+
+var softHSMModules = []string{
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+	"/opt/homebrew/lib/softhsm/libsofthsm2.so",
+}
+
+// setupSoftHSM initializes a SoftHSM token labelled wallet with a secp256k1 key pair labelled client.
+func setupSoftHSM(t *testing.T) string {
+	t.Helper()
+	var module string
+	for _, path := range softHSMModules {
+		if _, err := os.Stat(path); err == nil {
+			module = path
+			break
+		}
+	}
+	if module == "" {
+		t.Skip("SoftHSM is not installed")
+	}
+	SetAllowedModules([]string{module})
+	t.Cleanup(func() { SetAllowedModules(nil) })
+
+	tmp := t.TempDir()
+	conf := filepath.Join(tmp, "softhsm2.conf")
+	err := os.WriteFile(conf, []byte("directories.tokendir = "+tmp+"\nobjectstore.backend = file\n"), 0600)
+	require.NoError(t, err)
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	ctx, err := loadModule(module)
+	require.NoError(t, err)
+	slots, err := ctx.GetSlotList(false)
+	require.NoError(t, err)
+	require.NoError(t, ctx.InitToken(slots[0], "1234", "wallet"))
+	slots, err = ctx.GetSlotList(true)
+	require.NoError(t, err)
+	var slot uint
+	for _, id := range slots {
+		info, err := ctx.GetTokenInfo(id)
+		require.NoError(t, err)
+		if info.Label == "wallet" {
+			slot = id
+		}
+	}
+
+	handle, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	require.NoError(t, err)
+	defer ctx.CloseSession(handle)
+	require.NoError(t, ctx.Login(handle, pkcs11.CKU_SO, "1234"))
+	require.NoError(t, ctx.InitPIN(handle, "123456"))
+	require.NoError(t, ctx.Logout(handle))
+	require.NoError(t, ctx.Login(handle, pkcs11.CKU_USER, "123456"))
+	defer ctx.Logout(handle)
+	params, err := asn1.Marshal(oidSecp256k1)
+	require.NoError(t, err)
+	_, _, err = ctx.GenerateKeyPair(handle,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_EC_KEY_PAIR_GEN, nil)},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, "client"),
+		},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, "client"),
+		})
+	require.NoError(t, err)
+	return "pkcs11:token=wallet;object=client?module-path=" + module
+}
+
+func TestPKCS11Signer(t *testing.T) {
+	uri := setupSoftHSM(t)
+	t.Setenv(PINEnvVar, "123456")
+
+	s, err := New(uri)
+	require.NoError(t, err)
+	addr, err := s.Address()
+	require.NoError(t, err)
+	for _, msg := range [][]byte{[]byte("deal proposal 1"), []byte("deal proposal 2")} {
+		signature, err := s.Sign(msg)
+		require.NoError(t, err)
+		data, err := signature.MarshalBinary()
+		require.NoError(t, err)
+		ok, err := wallet.WalletVerify(addr, msg, data)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	parsed, err := ParsePKCS11URI(uri)
+	require.NoError(t, err)
+	key, err := NewPKCS11PrivKey(parsed)
+	require.NoError(t, err)
+	data := []byte("manifest page")
+	signature, err := key.Sign(data)
+	require.NoError(t, err)
+	ok, err := key.GetPublic().Verify(data, signature)
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, err = key.Raw()
+	require.ErrorIs(t, err, ErrKeyNotExportable)
+
+	// The token is looked for in the allowed modules if the URI has no module-path
+	parsed.ModulePath = ""
+	resolved, err := NewPKCS11PrivKey(parsed)
+	require.NoError(t, err)
+	require.True(t, key.GetPublic().Equals(resolved.GetPublic()))
+
+	parsed.Object = "missing"
+	_, err = NewPKCS11PrivKey(parsed)
+	require.ErrorContains(t, err, "no EC key found")
+}
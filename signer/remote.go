@@ -0,0 +1,152 @@
+package signer
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/ybbus/jsonrpc/v3"
+)
+
+const remoteScheme = "lotus-wallet:"
+
+// RemoteTokenEnvVar is the environment variable the API token of the remote wallet is read from if the remote wallet
+// URI has no token-source attribute.
+const RemoteTokenEnvVar = "SINGULARITY_REMOTE_WALLET_TOKEN"
+
+// remoteSignTimeout is how long a remote wallet has to sign a message. It is long enough for the signature to be
+// confirmed on the device if the remote wallet keeps its keys in a Ledger.
+const remoteSignTimeout = 5 * time.Minute
+
+// msgTypeDealProposal is the type of the messages signed by Singularity, as expected by the wallet API of Lotus.
+const msgTypeDealProposal = "dealproposal"
+
+var ErrInvalidRemoteURI = errors.New("invalid remote wallet URI")
+
+// RemoteURI identifies a key held by a remote wallet, i.e. a lotus-wallet daemon, as
+// lotus-wallet:f1...?api=http://127.0.0.1:1777/rpc/v0&token-source=/etc/singularity/wallet-token
+type RemoteURI struct {
+	Address     address.Address // Address of the wallet
+	API         string          // URL of the JSON-RPC API of the remote wallet
+	TokenSource string          // File to read the API token of the remote wallet from
+}
+
+// IsRemote returns whether the private key of a wallet is a remote wallet URI rather than an exported private key.
+func IsRemote(privateKey string) bool {
+	return strings.HasPrefix(privateKey, remoteScheme)
+}
+
+// ParseRemoteURI parses a remote wallet URI such as lotus-wallet:f1...?api=http://127.0.0.1:1777/rpc/v0
+// The address and the api attribute are required. The API token is never part of the URI, since the URI is stored in
+// the database.
+func ParseRemoteURI(s string) (RemoteURI, error) {
+	var uri RemoteURI
+	if !strings.HasPrefix(s, remoteScheme) {
+		return uri, errors.Wrapf(ErrInvalidRemoteURI, "missing %s scheme", remoteScheme)
+	}
+	addr, query, _ := strings.Cut(strings.TrimPrefix(s, remoteScheme), "?")
+	var err error
+	uri.Address, err = address.NewFromString(addr)
+	if err != nil {
+		return uri, errors.Wrapf(ErrInvalidRemoteURI, "invalid address %s", addr)
+	}
+	if uri.Address.Protocol() != address.SECP256K1 && uri.Address.Protocol() != address.BLS {
+		return uri, errors.Wrapf(ErrInvalidRemoteURI, "%s is not the address of a secp256k1 or BLS key", addr)
+	}
+
+	err = parseAttributes(query, "&", func(name string, value string) error {
+		switch name {
+		case "api":
+			uri.API = value
+		case "token-source":
+			uri.TokenSource = value
+		case "token", "token-value":
+			return errors.Wrapf(ErrInvalidRemoteURI,
+				"the API token would be stored in the database, use token-source or %s instead", RemoteTokenEnvVar)
+		}
+		return nil
+	})
+	if err != nil {
+		return uri, errors.Mark(err, ErrInvalidRemoteURI)
+	}
+
+	if uri.API == "" {
+		return uri, errors.Wrap(ErrInvalidRemoteURI, "api is required")
+	}
+	parsed, err := url.Parse(uri.API)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return uri, errors.Wrapf(ErrInvalidRemoteURI, "api %s is not an HTTP URL", uri.API)
+	}
+	return uri, nil
+}
+
+// Token returns the API token of the remote wallet. It is read from the file in the token-source attribute, or the
+// SINGULARITY_REMOTE_WALLET_TOKEN environment variable. An empty token means the API is used without authentication.
+func (u RemoteURI) Token() (string, error) {
+	if u.TokenSource != "" {
+		content, err := os.ReadFile(strings.TrimPrefix(u.TokenSource, "file://"))
+		if err != nil {
+			return "", errors.Wrap(err, "failed to read API token from token-source")
+		}
+		return strings.TrimSpace(string(content)), nil
+	}
+	return os.Getenv(RemoteTokenEnvVar), nil
+}
+
+// RemoteSigner delegates signing to a remote wallet that implements the wallet API of Lotus, such as a lotus-wallet
+// daemon, so the private key is never stored in the database. The remote wallet may itself keep the key in a Ledger.
+type RemoteSigner struct {
+	uri RemoteURI
+}
+
+// NewRemoteSigner returns the Signer for a key held by a remote wallet.
+func NewRemoteSigner(uri RemoteURI) RemoteSigner {
+	return RemoteSigner{uri: uri}
+}
+
+func (r RemoteSigner) client() (jsonrpc.RPCClient, error) {
+	token, err := r.uri.Token()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return util.NewLotusClient(r.uri.API, token), nil
+}
+
+func (r RemoteSigner) Address() (address.Address, error) {
+	return r.uri.Address, nil
+}
+
+// Has returns whether the remote wallet holds the key of the address.
+func (r RemoteSigner) Has(ctx context.Context) (bool, error) {
+	client, err := r.client()
+	if err != nil {
+		return false, err
+	}
+	var has bool
+	err = client.CallFor(ctx, &has, "Filecoin.WalletHas", r.uri.Address.String())
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to reach the remote wallet at %s", r.uri.API)
+	}
+	return has, nil
+}
+
+func (r RemoteSigner) Sign(msg []byte) (*crypto.Signature, error) {
+	client, err := r.client()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignTimeout)
+	defer cancel()
+	var signature crypto.Signature
+	err = client.CallFor(ctx, &signature, "Filecoin.WalletSign", r.uri.Address.String(), msg, map[string]string{"Type": msgTypeDealProposal})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sign with the remote wallet at %s", r.uri.API)
+	}
+	return &signature, nil
+}
@@ -0,0 +1,102 @@
+package signer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/jsign/go-filsigner/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+// newRemoteWallet serves the wallet API of a lotus-wallet daemon holding the test key.
+func newRemoteWallet(t *testing.T, token string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var request struct {
+			ID     int               `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		var addr string
+		require.NoError(t, json.Unmarshal(request.Params[0], &addr))
+		var result any
+		switch request.Method {
+		case "Filecoin.WalletHas":
+			result = addr[1:] == testutil.TestWalletAddr[1:]
+		case "Filecoin.WalletSign":
+			var msg string
+			require.NoError(t, json.Unmarshal(request.Params[1], &msg))
+			var meta map[string]string
+			require.NoError(t, json.Unmarshal(request.Params[2], &meta))
+			require.Equal(t, msgTypeDealProposal, meta["Type"])
+			decoded, err := base64.StdEncoding.DecodeString(msg)
+			require.NoError(t, err)
+			result, err = wallet.WalletSign(testutil.TestPrivateKeyHex, decoded)
+			require.NoError(t, err)
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": result}))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestParseRemoteURI(t *testing.T) {
+	uri, err := ParseRemoteURI("lotus-wallet:" + testutil.TestWalletAddr + "?api=http%3A%2F%2F127.0.0.1%3A1777%2Frpc%2Fv0&token-source=/etc/token")
+	require.NoError(t, err)
+	require.Equal(t, testutil.TestWalletAddr[1:], uri.Address.String()[1:])
+	require.Equal(t, "http://127.0.0.1:1777/rpc/v0", uri.API)
+	require.Equal(t, "/etc/token", uri.TokenSource)
+
+	for _, s := range []string{
+		"lotus-wallet:" + testutil.TestWalletAddr,
+		"lotus-wallet:f01234?api=http://127.0.0.1:1777/rpc/v0",
+		"lotus-wallet:xxxx?api=http://127.0.0.1:1777/rpc/v0",
+		"lotus-wallet:" + testutil.TestWalletAddr + "?api=/rpc/v0",
+		"lotus-wallet:" + testutil.TestWalletAddr + "?api=http://127.0.0.1:1777/rpc/v0&token=secret",
+	} {
+		_, err = ParseRemoteURI(s)
+		require.ErrorIs(t, err, ErrInvalidRemoteURI, s)
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	server := newRemoteWallet(t, "secret")
+	tokenSource := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenSource, []byte("secret\n"), 0600))
+
+	s, err := New("lotus-wallet:" + testutil.TestWalletAddr + "?api=" + server.URL + "&token-source=" + tokenSource)
+	require.NoError(t, err)
+	require.IsType(t, RemoteSigner{}, s)
+	addr, err := s.Address()
+	require.NoError(t, err)
+	require.Equal(t, testutil.TestWalletAddr[1:], addr.String()[1:])
+	has, err := s.(RemoteSigner).Has(context.Background())
+	require.NoError(t, err)
+	require.True(t, has)
+
+	msg := []byte("deal proposal")
+	signature, err := s.Sign(msg)
+	require.NoError(t, err)
+	data, err := signature.MarshalBinary()
+	require.NoError(t, err)
+	ok, err := wallet.WalletVerify(addr, msg, data)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	t.Setenv(RemoteTokenEnvVar, "wrong")
+	s, err = New("lotus-wallet:" + testutil.TestWalletAddr + "?api=" + server.URL)
+	require.NoError(t, err)
+	_, err = s.Sign(msg)
+	require.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package signer
+
+import (
+	"bytes"
+	"encoding/asn1"
+
+	"github.com/cockroachdb/errors"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// oidSecp256k1 is the object identifier of the secp256k1 curve, as found in the CKA_EC_PARAMS of a key.
+var oidSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+
+var ErrUnsupportedKey = errors.New("only secp256k1 keys are supported")
+
+// parseECParams checks that the DER encoded EC parameters of a key name the secp256k1 curve.
+func parseECParams(params []byte) error {
+	var oid asn1.ObjectIdentifier
+	_, err := asn1.Unmarshal(params, &oid)
+	if err != nil || !oid.Equal(oidSecp256k1) {
+		return errors.WithStack(ErrUnsupportedKey)
+	}
+	return nil
+}
+
+// parseECPoint parses the CKA_EC_POINT of a public key. The point is DER encoded as an octet string by the
+// specification, but some modules return the raw point.
+func parseECPoint(point []byte) (*secp256k1.PublicKey, error) {
+	var raw []byte
+	rest, err := asn1.Unmarshal(point, &raw)
+	if err != nil || len(rest) > 0 {
+		raw = point
+	}
+	publicKey, err := secp256k1.ParsePubKey(raw)
+	if err != nil {
+		return nil, errors.Wrap(ErrUnsupportedKey, err.Error())
+	}
+	return publicKey, nil
+}
+
+// parseRawSignature parses the signature returned by the CKM_ECDSA mechanism, which is r and s concatenated,
+// and normalizes s to the lower half of the curve order as most verifiers reject the other half.
+func parseRawSignature(signature []byte) (*secp256k1.ModNScalar, *secp256k1.ModNScalar, error) {
+	if len(signature) != 64 {
+		return nil, nil, errors.Newf("unexpected ECDSA signature length %d", len(signature))
+	}
+	var r, s secp256k1.ModNScalar
+	if r.SetByteSlice(signature[:32]) || s.SetByteSlice(signature[32:]) || r.IsZero() || s.IsZero() {
+		return nil, nil, errors.New("invalid ECDSA signature")
+	}
+	if s.IsOverHalfOrder() {
+		s.Negate()
+	}
+	return &r, &s, nil
+}
+
+// recoverableSignature converts the signature returned by the CKM_ECDSA mechanism to the 65 bytes R|S|V signature
+// used by Filecoin, where V is the recovery ID that allows recovering the public key from the signature.
+// The module does not return the recovery ID, so it is found by recovering the public key with each candidate.
+func recoverableSignature(signature []byte, hash []byte, publicKey *secp256k1.PublicKey) ([]byte, error) {
+	r, s, err := parseRawSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+	compact := make([]byte, 65)
+	r.PutBytesUnchecked(compact[1:33])
+	s.PutBytesUnchecked(compact[33:65])
+	expected := publicKey.SerializeUncompressed()
+	for recoveryID := byte(0); recoveryID < 4; recoveryID++ {
+		// 27 is the magic offset of the recovery code of compact signatures
+		compact[0] = 27 + recoveryID
+		recovered, _, err := ecdsa.RecoverCompact(compact, hash)
+		if err != nil || !bytes.Equal(recovered.SerializeUncompressed(), expected) {
+			continue
+		}
+		result := make([]byte, 65)
+		copy(result, compact[1:])
+		result[64] = recoveryID
+		return result, nil
+	}
+	return nil, errors.New("the signature does not match the public key")
+}
+
+// derSignature converts the signature returned by the CKM_ECDSA mechanism to the DER encoding used by libp2p.
+func derSignature(signature []byte) ([]byte, error) {
+	r, s, err := parseRawSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+	return ecdsa.NewSignature(r, s).Serialize(), nil
+}
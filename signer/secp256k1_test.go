@@ -0,0 +1,91 @@
+package signer
+
+import (
+	"crypto/sha256"
+	"encoding/asn1"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/filecoin-project/go-address"
+	filsecp256k1 "github.com/jsign/go-filsigner/secp256k1"
+	p2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+// rawSign signs the hash the way the CKM_ECDSA mechanism does, returning r and s concatenated.
+func rawSign(t *testing.T, key *secp256k1.PrivateKey, hash []byte, highS bool) []byte {
+	t.Helper()
+	compact := ecdsa.SignCompact(key, hash, false)
+	signature := make([]byte, 64)
+	copy(signature, compact[1:])
+	if highS {
+		var s secp256k1.ModNScalar
+		s.SetByteSlice(signature[32:])
+		s.Negate()
+		s.PutBytesUnchecked(signature[32:])
+	}
+	return signature
+}
+
+func TestRecoverableSignature(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	addr, err := address.NewSecp256k1Address(key.PubKey().SerializeUncompressed())
+	require.NoError(t, err)
+	msg := []byte("deal proposal")
+	hash := blake2b.Sum256(msg)
+
+	for _, highS := range []bool{false, true} {
+		signature, err := recoverableSignature(rawSign(t, key, hash[:], highS), hash[:], key.PubKey())
+		require.NoError(t, err)
+		require.Len(t, signature, 65)
+		require.True(t, filsecp256k1.Verify(addr.Payload(), msg, signature))
+	}
+
+	other, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	_, err = recoverableSignature(rawSign(t, key, hash[:], false), hash[:], other.PubKey())
+	require.Error(t, err)
+	_, err = recoverableSignature([]byte{1, 2, 3}, hash[:], key.PubKey())
+	require.Error(t, err)
+}
+
+func TestDERSignature(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	public, err := p2pcrypto.UnmarshalSecp256k1PublicKey(key.PubKey().SerializeCompressed())
+	require.NoError(t, err)
+	data := []byte("manifest page")
+	hash := sha256.Sum256(data)
+
+	signature, err := derSignature(rawSign(t, key, hash[:], true))
+	require.NoError(t, err)
+	ok, err := public.Verify(data, signature)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestParseECPoint(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	raw := key.PubKey().SerializeUncompressed()
+	encoded, err := asn1.Marshal(raw)
+	require.NoError(t, err)
+	for _, point := range [][]byte{raw, encoded} {
+		publicKey, err := parseECPoint(point)
+		require.NoError(t, err)
+		require.True(t, publicKey.IsEqual(key.PubKey()))
+	}
+	_, err = parseECPoint([]byte{1, 2, 3})
+	require.ErrorIs(t, err, ErrUnsupportedKey)
+
+	params, err := asn1.Marshal(oidSecp256k1)
+	require.NoError(t, err)
+	require.NoError(t, parseECParams(params))
+	// prime256v1
+	params, err = asn1.Marshal(asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7})
+	require.NoError(t, err)
+	require.ErrorIs(t, parseECParams(params), ErrUnsupportedKey)
+}
@@ -0,0 +1,102 @@
+// Package signer signs deal proposals with the key of a wallet. The key is either the private key exported from a
+// Filecoin client, a PKCS#11 URI referring to a secp256k1 key kept in a hardware security module, i.e. a YubiHSM,
+// so the private key never leaves the device, or a URI referring to a key held by a remote wallet, i.e. a lotus-wallet
+// daemon, which signs on behalf of Singularity.
+package signer
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/jsign/go-filsigner/wallet"
+	p2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+)
+
+var ErrPKCS11NotSupported = errors.New("PKCS#11 is not supported by this build, it requires CGO")
+
+// Signer signs messages on behalf of a wallet.
+type Signer interface {
+	// Address returns the public key address of the wallet.
+	Address() (address.Address, error)
+	// Sign signs the message with the key of the wallet, in the signature format used by Filecoin.
+	Sign(msg []byte) (*crypto.Signature, error)
+}
+
+// IsPKCS11 returns whether the private key of a wallet is a PKCS#11 URI rather than an exported private key.
+func IsPKCS11(privateKey string) bool {
+	return strings.HasPrefix(privateKey, pkcs11Scheme)
+}
+
+// New returns the Signer for the private key of a wallet.
+//
+// Parameters:
+//   - privateKey: The private key exported from a Filecoin client, i.e. with 'lotus wallet export', a PKCS#11 URI
+//     such as pkcs11:token=wallet;object=client from one of the allowed modules, or a remote wallet URI
+//     such as lotus-wallet:f1...?api=http://127.0.0.1:1777/rpc/v0
+//
+// Returns:
+//   - The Signer, and an error if the PKCS#11 URI or the remote wallet URI is invalid or PKCS#11 is not supported by
+//     this build.
+//     Exported private keys are only decoded when they are used.
+func New(privateKey string) (Signer, error) {
+	privateKey = strings.TrimSpace(privateKey)
+	if IsPKCS11(privateKey) {
+		uri, err := ParsePKCS11URI(privateKey)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return NewPKCS11Signer(uri)
+	}
+	if IsRemote(privateKey) {
+		uri, err := ParseRemoteURI(privateKey)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return NewRemoteSigner(uri), nil
+	}
+	return LocalSigner{privateKey: privateKey}, nil
+}
+
+// NewPrivKey returns the libp2p private key to sign with, i.e. the identity key of the content provider or the
+// receipt key of the deal tracker.
+//
+// Parameters:
+//   - key: A base64 encoded libp2p private key, or a PKCS#11 URI referring to a secp256k1 key kept in a token.
+//
+// Returns:
+//   - The private key, and an error if the key cannot be decoded or the key in the token cannot be read.
+func NewPrivKey(key string) (p2pcrypto.PrivKey, error) {
+	key = strings.TrimSpace(key)
+	if IsPKCS11(key) {
+		uri, err := ParsePKCS11URI(key)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		private, err := NewPKCS11PrivKey(uri)
+		return private, errors.Wrapf(err, "failed to load key from %s", uri)
+	}
+	private, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode key")
+	}
+	unmarshalled, err := p2pcrypto.UnmarshalPrivateKey(private)
+	return unmarshalled, errors.Wrap(err, "failed to unmarshal key")
+}
+
+// LocalSigner signs with a private key exported from a Filecoin client.
+type LocalSigner struct {
+	privateKey string
+}
+
+func (l LocalSigner) Address() (address.Address, error) {
+	addr, err := wallet.PublicKey(l.privateKey)
+	return addr, errors.WithStack(err)
+}
+
+func (l LocalSigner) Sign(msg []byte) (*crypto.Signature, error) {
+	signature, err := wallet.WalletSign(l.privateKey, msg)
+	return signature, errors.WithStack(err)
+}
@@ -0,0 +1,37 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/jsign/go-filsigner/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_LocalSigner(t *testing.T) {
+	s, err := New(testutil.TestPrivateKeyHex + "\n")
+	require.NoError(t, err)
+	require.IsType(t, LocalSigner{}, s)
+	addr, err := s.Address()
+	require.NoError(t, err)
+	require.Equal(t, testutil.TestWalletAddr[1:], addr.String()[1:])
+
+	msg := []byte("deal proposal")
+	signature, err := s.Sign(msg)
+	require.NoError(t, err)
+	data, err := signature.MarshalBinary()
+	require.NoError(t, err)
+	ok, err := wallet.WalletVerify(addr, msg, data)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, err = LocalSigner{privateKey: "xxxx"}.Address()
+	require.Error(t, err)
+}
+
+func TestNew_PKCS11(t *testing.T) {
+	require.True(t, IsPKCS11("pkcs11:object=client?module-path=/usr/lib/libykcs11.so"))
+	require.False(t, IsPKCS11(testutil.TestPrivateKeyHex))
+	_, err := New("pkcs11:token=wallet")
+	require.ErrorIs(t, err, ErrInvalidPKCS11URI)
+}
@@ -0,0 +1,208 @@
+package signer
+
+import (
+	"encoding/hex"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const pkcs11Scheme = "pkcs11:"
+
+// PINEnvVar is the environment variable the PIN of the token is read from if the PKCS#11 URI has no pin-value or
+// pin-source attribute.
+const PINEnvVar = "SINGULARITY_PKCS11_PIN"
+
+// ModulesEnvVar is the environment variable the paths of the allowed PKCS#11 modules are read from, separated by commas.
+const ModulesEnvVar = "SINGULARITY_PKCS11_MODULES"
+
+var (
+	ErrInvalidPKCS11URI = errors.New("invalid PKCS#11 URI")
+	ErrModuleNotAllowed = errors.New("PKCS#11 module is not allowed")
+)
+
+// allowedModules are the paths of the PKCS#11 modules that can be loaded. A module is a native library that is loaded
+// into the process, so it is never taken from a URI unless the operator of the server has allowed it.
+var allowedModules []string
+
+// SetAllowedModules sets the paths of the PKCS#11 modules that can be loaded, i.e. from the --pkcs11-module flag.
+func SetAllowedModules(paths []string) {
+	allowedModules = nil
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path != "" {
+			allowedModules = append(allowedModules, path)
+		}
+	}
+}
+
+// PKCS11URI is the subset of a PKCS#11 URI (RFC 7512) that identifies a key in a token.
+type PKCS11URI struct {
+	ModulePath string // Path to the PKCS#11 module of the token, i.e. /usr/lib/libykcs11.so
+	Token      string // Label of the token
+	Serial     string // Serial number of the token
+	SlotID     *uint  // ID of the slot the token is in
+	Object     string // Label of the key
+	ID         []byte // ID of the key
+	PINValue   string // PIN of the token
+	PINSource  string // File to read the PIN of the token from
+}
+
+// ParsePKCS11URI parses a PKCS#11 URI such as pkcs11:token=wallet;object=client?module-path=/usr/lib/libykcs11.so
+// The module-path attribute is optional, see Modules, and the key is identified by its object label, its id, or both.
+// Attributes that are not used to find the key, such as type or manufacturer, are ignored.
+func ParsePKCS11URI(s string) (PKCS11URI, error) {
+	var uri PKCS11URI
+	if !strings.HasPrefix(s, pkcs11Scheme) {
+		return uri, errors.Wrapf(ErrInvalidPKCS11URI, "missing %s scheme", pkcs11Scheme)
+	}
+	path, query, _ := strings.Cut(strings.TrimPrefix(s, pkcs11Scheme), "?")
+
+	err := parseAttributes(path, ";", func(name string, value string) error {
+		switch name {
+		case "token":
+			uri.Token = value
+		case "serial":
+			uri.Serial = value
+		case "slot-id":
+			slotID, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return errors.Wrapf(ErrInvalidPKCS11URI, "invalid slot-id %s", value)
+			}
+			id := uint(slotID)
+			uri.SlotID = &id
+		case "object":
+			uri.Object = value
+		case "id":
+			uri.ID = []byte(value)
+		}
+		return nil
+	})
+	if err != nil {
+		return uri, err
+	}
+
+	err = parseAttributes(query, "&", func(name string, value string) error {
+		switch name {
+		case "module-path":
+			uri.ModulePath = value
+		case "pin-value":
+			uri.PINValue = value
+		case "pin-source":
+			uri.PINSource = value
+		}
+		return nil
+	})
+	if err != nil {
+		return uri, err
+	}
+
+	if uri.Object == "" && len(uri.ID) == 0 {
+		return uri, errors.Wrap(ErrInvalidPKCS11URI, "object or id is required to identify the key")
+	}
+	return uri, nil
+}
+
+// Modules returns the paths of the PKCS#11 modules to look for the token in. If the URI has a module-path attribute,
+// the module has to be one of the allowed modules. Otherwise, the token is looked for in all the allowed modules.
+func (u PKCS11URI) Modules() ([]string, error) {
+	if len(allowedModules) == 0 {
+		return nil, errors.Wrapf(ErrModuleNotAllowed, "no PKCS#11 module is allowed, set the allowed modules with --pkcs11-module or %s",
+			ModulesEnvVar)
+	}
+	if u.ModulePath == "" {
+		return allowedModules, nil
+	}
+	for _, path := range allowedModules {
+		if path == u.ModulePath {
+			return []string{path}, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrModuleNotAllowed, "%s is not one of the allowed modules", u.ModulePath)
+}
+
+// parseAttributes calls fn with the name and the percent decoded value of each attribute separated by sep.
+func parseAttributes(s string, sep string, fn func(name string, value string) error) error {
+	if s == "" {
+		return nil
+	}
+	for _, attribute := range strings.Split(s, sep) {
+		name, value, ok := strings.Cut(attribute, "=")
+		if !ok {
+			return errors.Wrapf(ErrInvalidPKCS11URI, "invalid attribute %s", attribute)
+		}
+		decoded, err := url.PathUnescape(value)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidPKCS11URI, "invalid value of attribute %s", name)
+		}
+		err = fn(name, decoded)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PIN returns the PIN to log in to the token with. It is read from the pin-value attribute, the file in the
+// pin-source attribute, or the SINGULARITY_PKCS11_PIN environment variable, in that order. An empty PIN means the
+// token is used without logging in, or logs in through a protected authentication path such as a PIN pad.
+func (u PKCS11URI) PIN() (string, error) {
+	if u.PINValue != "" {
+		return u.PINValue, nil
+	}
+	if u.PINSource != "" {
+		content, err := os.ReadFile(strings.TrimPrefix(u.PINSource, "file://"))
+		if err != nil {
+			return "", errors.Wrap(err, "failed to read PIN from pin-source")
+		}
+		return strings.TrimRight(string(content), "\r\n"), nil
+	}
+	return os.Getenv(PINEnvVar), nil
+}
+
+// String returns the URI with the pin-value attribute redacted, so it can be logged.
+func (u PKCS11URI) String() string {
+	var path []string
+	if u.Token != "" {
+		path = append(path, "token="+url.PathEscape(u.Token))
+	}
+	if u.Serial != "" {
+		path = append(path, "serial="+url.PathEscape(u.Serial))
+	}
+	if u.SlotID != nil {
+		path = append(path, "slot-id="+strconv.FormatUint(uint64(*u.SlotID), 10))
+	}
+	if u.Object != "" {
+		path = append(path, "object="+url.PathEscape(u.Object))
+	}
+	if len(u.ID) > 0 {
+		encoded := hex.EncodeToString(u.ID)
+		var sb strings.Builder
+		for i := 0; i < len(encoded); i += 2 {
+			sb.WriteString("%" + encoded[i:i+2])
+		}
+		path = append(path, "id="+sb.String())
+	}
+	var query []string
+	if u.ModulePath != "" {
+		query = append(query, "module-path="+escapePath(u.ModulePath))
+	}
+	if u.PINSource != "" {
+		query = append(query, "pin-source="+escapePath(u.PINSource))
+	}
+	if u.PINValue != "" {
+		query = append(query, "pin-value=REDACTED")
+	}
+	if len(query) == 0 {
+		return pkcs11Scheme + strings.Join(path, ";")
+	}
+	return pkcs11Scheme + strings.Join(path, ";") + "?" + strings.Join(query, "&")
+}
+
+// escapePath percent encodes a file path, keeping the path separators readable.
+func escapePath(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "%2F", "/")
+}
@@ -0,0 +1,79 @@
+package signer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePKCS11URI(t *testing.T) {
+	uri, err := ParsePKCS11URI("pkcs11:token=my%20wallet;serial=0123;slot-id=2;object=client;id=%01%02;type=private" +
+		"?module-path=/usr/lib/libykcs11.so&pin-value=123456")
+	require.NoError(t, err)
+	require.Equal(t, "/usr/lib/libykcs11.so", uri.ModulePath)
+	require.Equal(t, "my wallet", uri.Token)
+	require.Equal(t, "0123", uri.Serial)
+	require.Equal(t, uint(2), *uri.SlotID)
+	require.Equal(t, "client", uri.Object)
+	require.Equal(t, []byte{1, 2}, uri.ID)
+	require.Equal(t, "123456", uri.PINValue)
+	require.Equal(t, "pkcs11:token=my%20wallet;serial=0123;slot-id=2;object=client;id=%01%02"+
+		"?module-path=/usr/lib/libykcs11.so&pin-value=REDACTED", uri.String())
+
+	for _, s := range []string{
+		"object=client?module-path=/usr/lib/libykcs11.so",
+		"pkcs11:token=wallet?module-path=/usr/lib/libykcs11.so",
+		"pkcs11:object=client;slot-id=x?module-path=/usr/lib/libykcs11.so",
+		"pkcs11:object?module-path=/usr/lib/libykcs11.so",
+	} {
+		_, err = ParsePKCS11URI(s)
+		require.ErrorIs(t, err, ErrInvalidPKCS11URI, s)
+	}
+}
+
+func TestPKCS11URI_PIN(t *testing.T) {
+	pinFile := filepath.Join(t.TempDir(), "pin")
+	err := os.WriteFile(pinFile, []byte("654321\n"), 0600)
+	require.NoError(t, err)
+	t.Setenv(PINEnvVar, "111111")
+
+	pin, err := PKCS11URI{PINValue: "123456", PINSource: pinFile}.PIN()
+	require.NoError(t, err)
+	require.Equal(t, "123456", pin)
+
+	pin, err = PKCS11URI{PINSource: "file://" + pinFile}.PIN()
+	require.NoError(t, err)
+	require.Equal(t, "654321", pin)
+
+	pin, err = PKCS11URI{}.PIN()
+	require.NoError(t, err)
+	require.Equal(t, "111111", pin)
+
+	_, err = PKCS11URI{PINSource: filepath.Join(t.TempDir(), "missing")}.PIN()
+	require.Error(t, err)
+}
+
+func TestPKCS11URI_Modules(t *testing.T) {
+	defer SetAllowedModules(nil)
+	uri, err := ParsePKCS11URI("pkcs11:token=wallet;object=client")
+	require.NoError(t, err)
+	require.Equal(t, "pkcs11:token=wallet;object=client", uri.String())
+	_, err = uri.Modules()
+	require.ErrorIs(t, err, ErrModuleNotAllowed)
+
+	SetAllowedModules([]string{"/usr/lib/libykcs11.so", " /usr/lib/pkcs11/yubihsm_pkcs11.so", ""})
+	modules, err := uri.Modules()
+	require.NoError(t, err)
+	require.Equal(t, []string{"/usr/lib/libykcs11.so", "/usr/lib/pkcs11/yubihsm_pkcs11.so"}, modules)
+
+	uri.ModulePath = "/usr/lib/pkcs11/yubihsm_pkcs11.so"
+	modules, err = uri.Modules()
+	require.NoError(t, err)
+	require.Equal(t, []string{"/usr/lib/pkcs11/yubihsm_pkcs11.so"}, modules)
+
+	uri.ModulePath = "/tmp/evil.so"
+	_, err = uri.Modules()
+	require.ErrorIs(t, err, ErrModuleNotAllowed)
+}
@@ -0,0 +1,83 @@
+package storagesystem
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+)
+
+// AuthError is an error of a storage whose credentials are rejected, i.e. because an OAuth token or a session token
+// expired, or an access key was rotated. Retrying does not help until the storage is re-authenticated.
+type AuthError struct {
+	StorageID model.StorageID
+	Storage   string
+	Err       error
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("storage %s needs to be re-authenticated: %s", e.Storage, e.Err)
+}
+
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// authErrorMarkers are the messages, or error codes, of the errors of the storage backends that mean the credentials
+// are rejected.
+var authErrorMarkers = []string{
+	"401 Unauthorized",
+	"oauth2: cannot fetch token",
+	"invalid_grant",
+	"Token has been expired or revoked",
+	"couldn't fetch token",
+	"empty token found",
+	"ExpiredToken",
+	"InvalidAccessKeyId",
+	"SignatureDoesNotMatch",
+	"InvalidAuthenticationInfo",
+	"AuthenticationFailed",
+	"ssh: unable to authenticate",
+}
+
+// IsAuthError returns whether an error of a storage backend means that its credentials are rejected.
+func IsAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var authErr *AuthError
+	if errors.As(err, &authErr) {
+		return true
+	}
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusUnauthorized {
+		return true
+	}
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		return true
+	}
+	msg := err.Error()
+	for _, marker := range authErrorMarkers {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// wrapAuthError wraps an error of the storage in an AuthError if it means that its credentials are rejected.
+func wrapAuthError(id model.StorageID, name string, err error) error {
+	if err == nil || !IsAuthError(err) {
+		return err
+	}
+	var authErr *AuthError
+	if errors.As(err, &authErr) {
+		return err
+	}
+	return &AuthError{StorageID: id, Storage: name, Err: err}
+}
@@ -0,0 +1,38 @@
+package storagesystem
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+)
+
+func TestIsAuthError(t *testing.T) {
+	require.False(t, IsAuthError(nil))
+	require.False(t, IsAuthError(errors.New("connection reset by peer")))
+	require.False(t, IsAuthError(context.Canceled))
+	require.False(t, IsAuthError(&googleapi.Error{Code: http.StatusTooManyRequests}))
+	require.True(t, IsAuthError(errors.Wrap(&googleapi.Error{Code: http.StatusUnauthorized}, "list")))
+	require.True(t, IsAuthError(errors.Wrap(&oauth2.RetrieveError{}, "failed to refresh token")))
+	require.True(t, IsAuthError(errors.New("InvalidAccessKeyId: The AWS Access Key Id you provided does not exist in our records.")))
+	require.True(t, IsAuthError(errors.New("couldn't connect SSH: ssh: handshake failed: ssh: unable to authenticate")))
+}
+
+func TestWrapAuthError(t *testing.T) {
+	require.NoError(t, wrapAuthError(1, "source", nil))
+	err := errors.New("connection reset by peer")
+	require.Equal(t, err, wrapAuthError(1, "source", err))
+
+	err = wrapAuthError(1, "source", errors.New("ExpiredToken: The provided token has expired."))
+	var authErr *AuthError
+	require.ErrorAs(t, err, &authErr)
+	require.EqualValues(t, 1, authErr.StorageID)
+	require.Equal(t, "storage source needs to be re-authenticated: ExpiredToken: The provided token has expired.", err.Error())
+	// An AuthError is not wrapped again
+	wrapped := errors.Wrap(err, "list")
+	require.Equal(t, wrapped, wrapAuthError(2, "other", wrapped))
+}
@@ -0,0 +1,65 @@
+package storagesystem
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+)
+
+// CheckAzureBlobConfig checks the authentication config of an Azure Blob Storage storage before it is created or
+// updated, so that a misconfigured storage is rejected with a clear error instead of failing on the first listing.
+// Other storage types are left untouched.
+//
+//   - Only one of an account key, with the key option, and a SAS URL, with the sas_url option, may be set.
+//   - A SAS URL must be signed, and a SAS URL of a container only grants access to that container, so the path of
+//     the storage must be in the container.
+//   - A service principal, with the tenant and client_id options, requires a client secret, a client certificate,
+//     or a username and password.
+//
+// Parameters:
+//   - s: The storage to check.
+//
+// Returns:
+//   - An error wrapping ErrInvalidConfig if the config is inconsistent.
+func CheckAzureBlobConfig(s model.Storage) error {
+	if s.Type != "azureblob" {
+		return nil
+	}
+	config := s.Config
+
+	if sasURL := config["sas_url"]; sasURL != "" {
+		if config["key"] != "" {
+			return errors.Wrap(ErrInvalidConfig, "only one of key and sas_url can be set")
+		}
+		u, err := url.Parse(sasURL)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidConfig, "invalid sas_url: %s", err)
+		}
+		if u.Query().Get("sig") == "" {
+			return errors.Wrap(ErrInvalidConfig, "sas_url has no signature, it must include the SAS token")
+		}
+		container, _, _ := strings.Cut(strings.Trim(u.Path, "/"), "/")
+		if container != "" {
+			pathContainer, _, _ := strings.Cut(strings.Trim(s.Path, "/"), "/")
+			if pathContainer != container {
+				return errors.Wrapf(ErrInvalidConfig, "sas_url only grants access to container '%s', but the path is in container '%s'",
+					container, pathContainer)
+			}
+		}
+	}
+
+	if config["tenant"] != "" || config["client_id"] != "" || config["client_secret"] != "" ||
+		config["client_certificate_path"] != "" {
+		if config["tenant"] == "" || config["client_id"] == "" {
+			return errors.Wrap(ErrInvalidConfig, "a service principal requires both tenant and client_id")
+		}
+		if config["client_secret"] == "" && config["client_certificate_path"] == "" &&
+			(config["username"] == "" || config["password"] == "") {
+			return errors.Wrap(ErrInvalidConfig,
+				"a service principal requires client_secret, client_certificate_path, or username and password")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package storagesystem
+
+import (
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAzureBlobConfig(t *testing.T) {
+	sasURL := "https://account.blob.core.windows.net/data?sv=2021-08-06&sr=c&sp=rl&sig=abc"
+	tests := []struct {
+		name   string
+		path   string
+		config map[string]string
+		valid  bool
+	}{
+		{"account key", "data/dir", map[string]string{"account": "account", "key": "a2V5"}, true},
+		{"sas url", "data/dir", map[string]string{"sas_url": sasURL}, true},
+		{"sas url and key", "data/dir", map[string]string{"sas_url": sasURL, "key": "a2V5"}, false},
+		{"unsigned sas url", "data", map[string]string{"sas_url": "https://account.blob.core.windows.net/data"}, false},
+		{"sas url of another container", "other/dir", map[string]string{"sas_url": sasURL}, false},
+		{"service principal with secret", "data", map[string]string{"tenant": "t", "client_id": "c", "client_secret": "s"}, true},
+		{"service principal with certificate", "data", map[string]string{"tenant": "t", "client_id": "c", "client_certificate_path": "/cert.pem"}, true},
+		{"service principal with password", "data", map[string]string{"tenant": "t", "client_id": "c", "username": "u", "password": "p"}, true},
+		{"service principal without tenant", "data", map[string]string{"client_id": "c", "client_secret": "s"}, false},
+		{"service principal without credentials", "data", map[string]string{"tenant": "t", "client_id": "c"}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckAzureBlobConfig(model.Storage{Type: "azureblob", Path: test.path, Config: test.config})
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidConfig)
+			}
+		})
+	}
+
+	require.NoError(t, CheckAzureBlobConfig(model.Storage{Type: "s3", Config: map[string]string{"key": "a", "sas_url": "b"}}))
+}
@@ -0,0 +1,104 @@
+package storagesystem
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/rjNemo/underscore"
+	drive "google.golang.org/api/drive/v3"
+)
+
+var ErrSharedDriveNotFound = errors.New("shared drive not found")
+
+// ResolveDriveConfig checks and completes the config of a Google Drive storage before it is created or updated. Other
+// storage types are left untouched.
+//
+//   - Impersonating a user, with the impersonate option, requires the credentials of a service account with
+//     domain-wide delegation.
+//   - The shared drive, with the team_drive option, may be given by its name instead of its ID. The name is resolved
+//     to the ID of the shared drive, among the shared drives that the account can access.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - s: The storage to resolve. Its config is modified in place.
+//
+// Returns:
+//   - An error wrapping ErrInvalidConfig if the config is inconsistent, or ErrSharedDriveNotFound if the shared drive
+//     cannot be resolved.
+func ResolveDriveConfig(ctx context.Context, s *model.Storage) error {
+	if s.Type != "drive" {
+		return nil
+	}
+	if s.Config["impersonate"] != "" && s.Config["service_account_file"] == "" && s.Config["service_account_credentials"] == "" {
+		return errors.Wrap(ErrInvalidConfig, "impersonate requires service_account_file or service_account_credentials")
+	}
+
+	teamDrive := s.Config["team_drive"]
+	if teamDrive == "" {
+		return nil
+	}
+
+	// The shared drives are listed with an account that is not restricted to the shared drive being resolved.
+	lookup := *s
+	lookup.Config = make(map[string]string, len(s.Config))
+	for k, v := range s.Config {
+		if k != "team_drive" && k != "root_folder_id" {
+			lookup.Config[k] = v
+		}
+	}
+	handler, err := NewRCloneHandler(ctx, lookup)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	command := handler.fs.Features().Command
+	if command == nil {
+		return nil
+	}
+	out, err := command(ctx, "drives", nil, nil)
+	if err != nil {
+		// The account may not be allowed to list the shared drives, in which case the value is used as the ID.
+		logger.Warnw("failed to list the shared drives, using team_drive as the ID", "teamDrive", teamDrive, "error", err)
+		return nil
+	}
+	drives, ok := out.([]*drive.Drive)
+	if !ok {
+		return nil
+	}
+	id, err := matchSharedDrive(drives, teamDrive)
+	if err != nil {
+		return err
+	}
+	if id != teamDrive {
+		logger.Infow("resolved shared drive", "name", teamDrive, "id", id)
+		s.Config["team_drive"] = id
+	}
+	return nil
+}
+
+// matchSharedDrive returns the ID of the shared drive with the given ID or name. The ID takes precedence, and names
+// are matched case-insensitively if there is no exact match.
+func matchSharedDrive(drives []*drive.Drive, value string) (string, error) {
+	for _, d := range drives {
+		if d.Id == value {
+			return d.Id, nil
+		}
+	}
+	for _, equal := range []func(a, b string) bool{
+		func(a, b string) bool { return a == b },
+		strings.EqualFold,
+	} {
+		matches := underscore.Filter(drives, func(d *drive.Drive) bool { return equal(d.Name, value) })
+		if len(matches) == 1 {
+			return matches[0].Id, nil
+		}
+		if len(matches) > 1 {
+			return "", errors.Wrapf(ErrInvalidConfig, "more than one shared drive is named '%s', use its ID instead: %s",
+				value, strings.Join(underscore.Map(matches, func(d *drive.Drive) string { return d.Id }), ", "))
+		}
+	}
+	names := underscore.Map(drives, func(d *drive.Drive) string { return d.Name })
+	return "", errors.Wrapf(ErrSharedDriveNotFound, "no shared drive has the ID or name '%s', available shared drives are: %s",
+		value, strings.Join(names, ", "))
+}
@@ -0,0 +1,57 @@
+package storagesystem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/stretchr/testify/require"
+	drive "google.golang.org/api/drive/v3"
+)
+
+func TestMatchSharedDrive(t *testing.T) {
+	drives := []*drive.Drive{
+		{Id: "0AAAAAAAAAAAAAAAAAA", Name: "Research"},
+		{Id: "0BBBBBBBBBBBBBBBBBB", Name: "Archive"},
+		{Id: "0CCCCCCCCCCCCCCCCCC", Name: "archive"},
+		{Id: "0DDDDDDDDDDDDDDDDDD", Name: "Shared"},
+		{Id: "0EEEEEEEEEEEEEEEEEE", Name: "Shared"},
+	}
+
+	id, err := matchSharedDrive(drives, "0AAAAAAAAAAAAAAAAAA")
+	require.NoError(t, err)
+	require.Equal(t, "0AAAAAAAAAAAAAAAAAA", id)
+
+	id, err = matchSharedDrive(drives, "Research")
+	require.NoError(t, err)
+	require.Equal(t, "0AAAAAAAAAAAAAAAAAA", id)
+
+	id, err = matchSharedDrive(drives, "research")
+	require.NoError(t, err)
+	require.Equal(t, "0AAAAAAAAAAAAAAAAAA", id)
+
+	id, err = matchSharedDrive(drives, "archive")
+	require.NoError(t, err)
+	require.Equal(t, "0CCCCCCCCCCCCCCCCCC", id)
+
+	_, err = matchSharedDrive(drives, "Shared")
+	require.ErrorIs(t, err, ErrInvalidConfig)
+	require.ErrorContains(t, err, "0DDDDDDDDDDDDDDDDDD, 0EEEEEEEEEEEEEEEEEE")
+
+	_, err = matchSharedDrive(drives, "Missing")
+	require.ErrorIs(t, err, ErrSharedDriveNotFound)
+	require.ErrorContains(t, err, "Research, Archive")
+}
+
+func TestResolveDriveConfig(t *testing.T) {
+	ctx := context.Background()
+	storage := model.Storage{Type: "local", Config: map[string]string{"team_drive": "Research"}}
+	require.NoError(t, ResolveDriveConfig(ctx, &storage))
+	require.Equal(t, "Research", storage.Config["team_drive"])
+
+	storage = model.Storage{Type: "drive", Config: map[string]string{"impersonate": "user@example.com"}}
+	require.ErrorIs(t, ResolveDriveConfig(ctx, &storage), ErrInvalidConfig)
+
+	storage = model.Storage{Type: "drive", Config: map[string]string{}}
+	require.NoError(t, ResolveDriveConfig(ctx, &storage))
+}
@@ -0,0 +1,142 @@
+package ipfs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/util"
+	lru "github.com/hashicorp/golang-lru/v2"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+)
+
+// maxBlockSize is the maximum size of a block that is read from an IPFS endpoint. Blocks larger than 2 MiB are not
+// exchanged by IPFS nodes either.
+const maxBlockSize = 2 << 20
+
+// httpBlockstore is a read-only blockstore that fetches blocks from a trustless IPFS gateway or a Kubo RPC API, and
+// verifies each block against its CID. The DAG-PB blocks, i.e. directories and the intermediate nodes of files, are
+// cached, as they are fetched again whenever a path is resolved or a file is opened.
+type httpBlockstore struct {
+	client     *http.Client
+	gatewayURL string
+	apiURL     string
+	cache      *lru.Cache[cid.Cid, blocks.Block]
+}
+
+func newHTTPBlockstore(client *http.Client, gatewayURL string, apiURL string, cacheSize int) (*httpBlockstore, error) {
+	bs := &httpBlockstore{
+		client:     client,
+		gatewayURL: gatewayURL,
+		apiURL:     apiURL,
+	}
+	if cacheSize > 0 {
+		cache, err := lru.New[cid.Cid, blocks.Block](cacheSize)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		bs.cache = cache
+	}
+	return bs, nil
+}
+
+func (b *httpBlockstore) newRequest(ctx context.Context, c cid.Cid) (*http.Request, error) {
+	if b.apiURL != "" {
+		return http.NewRequestWithContext(ctx, http.MethodPost,
+			b.apiURL+"/api/v0/block/get?arg="+url.QueryEscape(c.String()), nil)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.gatewayURL+"/ipfs/"+c.String()+"?format=raw", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.ipld.raw")
+	return req, nil
+}
+
+func (b *httpBlockstore) Get(ctx context.Context, c cid.Cid) (blocks.Block, error) {
+	if b.cache != nil {
+		if blk, ok := b.cache.Get(c); ok {
+			return blk, nil
+		}
+	}
+	req, err := b.newRequest(ctx, c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch block %s", c)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ipld.ErrNotFound{Cid: c}
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, errors.Newf("failed to fetch block %s: %s %s", c, resp.Status, body)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBlockSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read block %s", c)
+	}
+	if len(data) > maxBlockSize {
+		return nil, errors.Newf("block %s is larger than %d bytes", c, maxBlockSize)
+	}
+	actual, err := c.Prefix().Sum(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to hash block %s", c)
+	}
+	if !actual.Equals(c) {
+		return nil, errors.Wrapf(util.ErrBlockMismatch, "block returned by the IPFS endpoint, expected %s, got %s", c, actual)
+	}
+	blk, err := blocks.NewBlockWithCid(data, c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if b.cache != nil && c.Type() == cid.DagProtobuf {
+		b.cache.Add(c, blk)
+	}
+	return blk, nil
+}
+
+func (b *httpBlockstore) Has(ctx context.Context, c cid.Cid) (bool, error) {
+	_, err := b.Get(ctx, c)
+	if ipld.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (b *httpBlockstore) GetSize(ctx context.Context, c cid.Cid) (int, error) {
+	blk, err := b.Get(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	return len(blk.RawData()), nil
+}
+
+func (b *httpBlockstore) DeleteBlock(ctx context.Context, c cid.Cid) error {
+	return util.ErrNotImplemented
+}
+
+func (b *httpBlockstore) Put(ctx context.Context, block blocks.Block) error {
+	return util.ErrNotImplemented
+}
+
+func (b *httpBlockstore) PutMany(ctx context.Context, blocks []blocks.Block) error {
+	return util.ErrNotImplemented
+}
+
+func (b *httpBlockstore) AllKeysChan(ctx context.Context) (<-chan cid.Cid, error) {
+	return nil, util.ErrNotImplemented
+}
+
+func (b *httpBlockstore) HashOnRead(enabled bool) {
+}
@@ -0,0 +1,398 @@
+// Package ipfs provides a read-only rclone backend for data that already lives on IPFS. The backend enumerates a
+// UnixFS DAG from a root CID as directories and files, and reads the blocks from a trustless gateway or a Kubo RPC
+// API, so that existing IPFS pins can be prepared like any other data source.
+package ipfs
+
+import (
+	"context"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/oserror"
+	"github.com/ipfs/go-blockservice"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/go-merkledag"
+	"github.com/ipfs/go-unixfs"
+	uio "github.com/ipfs/go-unixfs/io"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/rclone/rclone/fs/config/configstruct"
+	"github.com/rclone/rclone/fs/fshttp"
+	"github.com/rclone/rclone/fs/hash"
+	"github.com/rclone/rclone/lib/readers"
+)
+
+var (
+	errorReadOnly   = errors.New("ipfs remotes are read only")
+	ErrInvalidRoot  = errors.New("invalid IPFS root")
+	ErrNotDirectory = errors.New("IPFS root is not a directory")
+)
+
+// modTime is the last modified time of all files and directories. UnixFS nodes are immutable and have no modification
+// time, so a constant time keeps the files unchanged between scanning and packing.
+var modTime = time.Unix(0, 0)
+
+func init() {
+	fs.Register(&fs.RegInfo{
+		Name:        "ipfs",
+		Description: "IPFS UnixFS DAG",
+		NewFs:       NewFs,
+		Options: []fs.Option{{
+			Name:    "gateway_url",
+			Help:    "URL of the trustless IPFS gateway to read the blocks from.\n\nThe gateway must support raw block responses, i.e. /ipfs/<cid>?format=raw.",
+			Default: "http://127.0.0.1:8080",
+		}, {
+			Name: "api_url",
+			Help: "URL of the Kubo RPC API to read the blocks from instead of the gateway, i.e. http://127.0.0.1:5001.",
+		}, {
+			Name:     "block_cache_size",
+			Help:     "Number of directory and intermediate file blocks kept in memory.\n\nThese blocks are read again whenever a path is resolved or a file is opened.",
+			Default:  1024,
+			Advanced: true,
+		}},
+	})
+}
+
+// Options defines the configuration for this backend
+type Options struct {
+	GatewayURL     string `config:"gateway_url"`
+	APIURL         string `config:"api_url"`
+	BlockCacheSize int    `config:"block_cache_size"`
+}
+
+// Fs is a UnixFS DAG on IPFS. The root is the root CID, optionally followed by the path of a directory within the DAG.
+type Fs struct {
+	name     string
+	root     string
+	opt      Options
+	features *fs.Features
+	rootCID  cid.Cid // CID of the directory at the root
+	dagServ  ipld.DAGService
+}
+
+// Object is a file within the DAG.
+type Object struct {
+	fs     *Fs
+	remote string
+	cid    cid.Cid
+	size   int64
+}
+
+// parseRoot splits a root, i.e. bafy.../photos, /ipfs/bafy.../photos or ipfs://bafy.../photos, into the root CID and
+// the path within the DAG.
+func parseRoot(root string) (cid.Cid, string, error) {
+	root = strings.TrimPrefix(root, "ipfs://")
+	root = strings.TrimPrefix(strings.Trim(root, "/"), "ipfs/")
+	rootCID, dir, _ := strings.Cut(root, "/")
+	c, err := cid.Decode(rootCID)
+	if err != nil {
+		return cid.Undef, "", errors.Wrapf(ErrInvalidRoot, "'%s' does not start with a CID: %s", root, err)
+	}
+	return c, dir, nil
+}
+
+// NewFs creates a new Fs from the name and the root, and resolves the root to a directory of the DAG.
+func NewFs(ctx context.Context, name, root string, m configmap.Mapper) (fs.Fs, error) {
+	opt := new(Options)
+	err := configstruct.Set(m, opt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	opt.GatewayURL = strings.TrimSuffix(opt.GatewayURL, "/")
+	opt.APIURL = strings.TrimSuffix(opt.APIURL, "/")
+	if opt.GatewayURL == "" && opt.APIURL == "" {
+		return nil, errors.New("either gateway_url or api_url must be set")
+	}
+
+	rootCID, dir, err := parseRoot(root)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := newHTTPBlockstore(fshttp.NewClient(ctx), opt.GatewayURL, opt.APIURL, opt.BlockCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	f := &Fs{
+		name:    name,
+		root:    root,
+		opt:     *opt,
+		rootCID: rootCID,
+		dagServ: merkledag.NewDAGService(blockservice.New(bs, nil)),
+	}
+	f.features = (&fs.Features{
+		CanHaveEmptyDirectories: true,
+	}).Fill(ctx, f)
+
+	node, err := f.resolve(ctx, dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve IPFS root %s", root)
+	}
+	if !isDirectory(node) {
+		return nil, errors.Wrapf(ErrNotDirectory, "root %s", root)
+	}
+	f.rootCID = node.Cid()
+	return f, nil
+}
+
+// resolve returns the node at a path relative to the root.
+func (f *Fs) resolve(ctx context.Context, remote string) (ipld.Node, error) {
+	node, err := f.dagServ.Get(ctx, f.rootCID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, name := range strings.Split(remote, "/") {
+		if name == "" {
+			continue
+		}
+		if !isDirectory(node) {
+			return nil, fs.ErrorObjectNotFound
+		}
+		dir, err := uio.NewDirectoryFromNode(f.dagServ, node)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		node, err = dir.Find(ctx, name)
+		if oserror.IsNotExist(err) {
+			return nil, fs.ErrorObjectNotFound
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	return node, nil
+}
+
+// isDirectory returns whether a node is a UnixFS directory, either a basic or a sharded one.
+func isDirectory(node ipld.Node) bool {
+	if node.Cid().Type() != cid.DagProtobuf {
+		return false
+	}
+	fsNode, err := unixfs.ExtractFSNode(node)
+	if err != nil {
+		return false
+	}
+	return fsNode.Type() == unixfs.TDirectory || fsNode.Type() == unixfs.THAMTShard
+}
+
+// newObject returns the Object for a node, or false if the node is not a file, i.e. a symlink.
+func (f *Fs) newObject(remote string, node ipld.Node) (*Object, bool) {
+	if node.Cid().Type() == cid.Raw {
+		return &Object{fs: f, remote: remote, cid: node.Cid(), size: int64(len(node.RawData()))}, true
+	}
+	fsNode, err := unixfs.ExtractFSNode(node)
+	if err != nil {
+		return nil, false
+	}
+	switch fsNode.Type() {
+	case unixfs.TFile, unixfs.TRaw:
+		return &Object{fs: f, remote: remote, cid: node.Cid(), size: int64(fsNode.FileSize())}, true
+	default:
+		return nil, false
+	}
+}
+
+// Name of the remote (as passed into NewFs)
+func (f *Fs) Name() string {
+	return f.name
+}
+
+// Root of the remote (as passed into NewFs)
+func (f *Fs) Root() string {
+	return f.root
+}
+
+// String converts this Fs to a string
+func (f *Fs) String() string {
+	return "IPFS root " + f.root
+}
+
+// Features returns the optional features of this Fs
+func (f *Fs) Features() *fs.Features {
+	return f.features
+}
+
+// Precision of the remote. UnixFS has no modification times.
+func (f *Fs) Precision() time.Duration {
+	return fs.ModTimeNotSupported
+}
+
+// Hashes returns the supported hash types of the filesystem
+func (f *Fs) Hashes() hash.Set {
+	return hash.Set(hash.None)
+}
+
+// List the objects and directories in dir into entries. Entries that are neither files nor directories, i.e.
+// symlinks, are skipped.
+func (f *Fs) List(ctx context.Context, dir string) (fs.DirEntries, error) {
+	node, err := f.resolve(ctx, dir)
+	if errors.Is(err, fs.ErrorObjectNotFound) {
+		return nil, fs.ErrorDirNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !isDirectory(node) {
+		return nil, fs.ErrorIsFile
+	}
+	directory, err := uio.NewDirectoryFromNode(f.dagServ, node)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var entries fs.DirEntries
+	err = directory.ForEachLink(ctx, func(link *ipld.Link) error {
+		child, err := f.dagServ.Get(ctx, link.Cid)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get %s", link.Name)
+		}
+		remote := path.Join(dir, link.Name)
+		if isDirectory(child) {
+			entries = append(entries, fs.NewDir(remote, modTime).SetID(link.Cid.String()))
+			return nil
+		}
+		object, ok := f.newObject(remote, child)
+		if !ok {
+			fs.Debugf(f, "skipping %s as it is neither a file nor a directory", remote)
+			return nil
+		}
+		entries = append(entries, object)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return entries, nil
+}
+
+// NewObject finds the Object at remote. If it can't be found it returns the error fs.ErrorObjectNotFound.
+func (f *Fs) NewObject(ctx context.Context, remote string) (fs.Object, error) {
+	node, err := f.resolve(ctx, remote)
+	if err != nil {
+		return nil, err
+	}
+	if isDirectory(node) {
+		return nil, fs.ErrorIsDir
+	}
+	object, ok := f.newObject(remote, node)
+	if !ok {
+		return nil, fs.ErrorNotAFile
+	}
+	return object, nil
+}
+
+// Put is not supported as the remote is read only
+func (f *Fs) Put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+	return nil, errorReadOnly
+}
+
+// Mkdir is not supported as the remote is read only
+func (f *Fs) Mkdir(ctx context.Context, dir string) error {
+	return errorReadOnly
+}
+
+// Rmdir is not supported as the remote is read only
+func (f *Fs) Rmdir(ctx context.Context, dir string) error {
+	return errorReadOnly
+}
+
+// Fs is the filesystem this object is located within
+func (o *Object) Fs() fs.Info {
+	return o.fs
+}
+
+// String returns the remote path
+func (o *Object) String() string {
+	if o == nil {
+		return "<nil>"
+	}
+	return o.remote
+}
+
+// Remote returns the remote path
+func (o *Object) Remote() string {
+	return o.remote
+}
+
+// ID returns the CID of the file
+func (o *Object) ID() string {
+	return o.cid.String()
+}
+
+// Hash is not supported
+func (o *Object) Hash(ctx context.Context, r hash.Type) (string, error) {
+	return "", hash.ErrUnsupported
+}
+
+// Size returns the size of the file in bytes
+func (o *Object) Size() int64 {
+	return o.size
+}
+
+// ModTime returns the constant modification time of all files
+func (o *Object) ModTime(ctx context.Context) time.Time {
+	return modTime
+}
+
+// SetModTime is not supported
+func (o *Object) SetModTime(ctx context.Context, modTime time.Time) error {
+	return fs.ErrorCantSetModTime
+}
+
+// Storable returns whether the object can be stored
+func (o *Object) Storable() bool {
+	return true
+}
+
+// Open the file for reading, starting at the offset of a SeekOption or reading the range of a RangeOption.
+func (o *Object) Open(ctx context.Context, options ...fs.OpenOption) (io.ReadCloser, error) {
+	var offset, limit int64 = 0, -1
+	for _, option := range options {
+		switch x := option.(type) {
+		case *fs.SeekOption:
+			offset = x.Offset
+		case *fs.RangeOption:
+			offset, limit = x.Decode(o.size)
+		default:
+			if option.Mandatory() {
+				fs.Logf(o, "Unsupported mandatory option: %v", option)
+			}
+		}
+	}
+	node, err := o.fs.dagServ.Get(ctx, o.cid)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	reader, err := uio.NewDagReader(ctx, node, o.fs.dagServ)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if offset > 0 {
+		_, err = reader.Seek(offset, io.SeekStart)
+		if err != nil {
+			_ = reader.Close()
+			return nil, errors.WithStack(err)
+		}
+	}
+	return readers.NewLimitedReadCloser(reader, limit), nil
+}
+
+// Update is not supported as the remote is read only
+func (o *Object) Update(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) error {
+	return errorReadOnly
+}
+
+// Remove is not supported as the remote is read only
+func (o *Object) Remove(ctx context.Context) error {
+	return errorReadOnly
+}
+
+// Check the interfaces are satisfied
+var (
+	_ fs.Fs     = (*Fs)(nil)
+	_ fs.Object = (*Object)(nil)
+	_ fs.IDer   = (*Object)(nil)
+)
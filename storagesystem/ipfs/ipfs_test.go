@@ -0,0 +1,177 @@
+package ipfs
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/pack/packutil"
+	"github.com/data-preservation-programs/singularity/util"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/go-merkledag"
+	"github.com/ipfs/go-unixfs"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDAG creates the following DAG and returns its blocks and root CID.
+//
+//	root/
+//	├── a.txt
+//	└── sub/
+//	    └── big.bin (chunked)
+func newTestDAG(t *testing.T) (map[cid.Cid][]byte, cid.Cid, []byte) {
+	stored := make(map[cid.Cid][]byte)
+	add := func(blk blocks.Block) {
+		stored[blk.Cid()] = blk.RawData()
+	}
+
+	a := merkledag.NewRawNode([]byte("hello"))
+	add(a)
+
+	big := testutil.GenerateRandomBytes(3000)
+	var links []format.Link
+	for i := 0; i < len(big); i += 1000 {
+		chunk := merkledag.NewRawNode(big[i : i+1000])
+		add(chunk)
+		links = append(links, format.Link{Cid: chunk.Cid(), Size: 1000})
+	}
+	fileBlocks, file, err := packutil.AssembleFileFromLinks(links)
+	require.NoError(t, err)
+	for _, blk := range fileBlocks {
+		add(blk)
+	}
+
+	sub := merkledag.NodeWithData(unixfs.FolderPBData())
+	require.NoError(t, sub.AddNodeLink("big.bin", file))
+	add(sub)
+	root := merkledag.NodeWithData(unixfs.FolderPBData())
+	require.NoError(t, root.AddNodeLink("a.txt", a))
+	require.NoError(t, root.AddNodeLink("sub", sub))
+	add(root)
+	return stored, root.Cid(), big
+}
+
+func newTestServer(t *testing.T, stored map[cid.Cid][]byte) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var c cid.Cid
+		var err error
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/ipfs/"):
+			require.Equal(t, "raw", r.URL.Query().Get("format"))
+			c, err = cid.Decode(strings.TrimPrefix(r.URL.Path, "/ipfs/"))
+		case r.URL.Path == "/api/v0/block/get":
+			require.Equal(t, http.MethodPost, r.Method)
+			c, err = cid.Decode(r.URL.Query().Get("arg"))
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		require.NoError(t, err)
+		data, ok := stored[c]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestIPFS(t *testing.T) {
+	ctx := context.Background()
+	stored, root, big := newTestDAG(t)
+	server := newTestServer(t, stored)
+
+	for name, config := range map[string]configmap.Simple{
+		"gateway": {"gateway_url": server.URL + "/"},
+		"api":     {"api_url": server.URL, "block_cache_size": "0"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			f, err := NewFs(ctx, "ipfs", "/ipfs/"+root.String(), config)
+			require.NoError(t, err)
+
+			entries, err := f.List(ctx, "")
+			require.NoError(t, err)
+			require.Len(t, entries, 2)
+			require.Equal(t, "a.txt", entries[0].Remote())
+			require.EqualValues(t, 5, entries[0].Size())
+			require.IsType(t, &fs.Dir{}, entries[1])
+			require.Equal(t, "sub", entries[1].Remote())
+
+			entries, err = f.List(ctx, "sub")
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			require.Equal(t, "sub/big.bin", entries[0].Remote())
+			require.EqualValues(t, len(big), entries[0].Size())
+
+			_, err = f.List(ctx, "missing")
+			require.ErrorIs(t, err, fs.ErrorDirNotFound)
+
+			obj, err := f.NewObject(ctx, "sub/big.bin")
+			require.NoError(t, err)
+			reader, err := obj.Open(ctx)
+			require.NoError(t, err)
+			data, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(big, data))
+			require.NoError(t, reader.Close())
+
+			reader, err = obj.Open(ctx, &fs.SeekOption{Offset: 1500})
+			require.NoError(t, err)
+			data, err = io.ReadAll(reader)
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(big[1500:], data))
+
+			reader, err = obj.Open(ctx, &fs.RangeOption{Start: 999, End: 1000})
+			require.NoError(t, err)
+			data, err = io.ReadAll(reader)
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(big[999:1001], data))
+
+			_, err = f.NewObject(ctx, "sub")
+			require.ErrorIs(t, err, fs.ErrorIsDir)
+			_, err = f.NewObject(ctx, "missing.txt")
+			require.ErrorIs(t, err, fs.ErrorObjectNotFound)
+		})
+	}
+
+	t.Run("sub directory root", func(t *testing.T) {
+		f, err := NewFs(ctx, "ipfs", root.String()+"/sub", configmap.Simple{"gateway_url": server.URL})
+		require.NoError(t, err)
+		entries, err := f.List(ctx, "")
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "big.bin", entries[0].Remote())
+	})
+
+	t.Run("file root", func(t *testing.T) {
+		_, err := NewFs(ctx, "ipfs", root.String()+"/a.txt", configmap.Simple{"gateway_url": server.URL})
+		require.ErrorIs(t, err, ErrNotDirectory)
+	})
+
+	t.Run("invalid root", func(t *testing.T) {
+		_, err := NewFs(ctx, "ipfs", "not-a-cid", configmap.Simple{"gateway_url": server.URL})
+		require.ErrorIs(t, err, ErrInvalidRoot)
+	})
+
+	t.Run("corrupted block", func(t *testing.T) {
+		corrupted := make(map[cid.Cid][]byte)
+		for c, data := range stored {
+			corrupted[c] = data
+		}
+		corrupted[root] = []byte("corrupted")
+		corruptedServer := newTestServer(t, corrupted)
+		_, err := NewFs(ctx, "ipfs", root.String(), configmap.Simple{"gateway_url": corruptedServer.URL})
+		require.ErrorIs(t, err, util.ErrBlockMismatch)
+	})
+}
@@ -0,0 +1,116 @@
+package storagesystem
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+)
+
+// ObjectLockVerifier returns the object lock retention mode of the bucket of a storage, i.e. whether its objects are
+// write-once-read-many by default.
+type ObjectLockVerifier func(ctx context.Context, storage model.Storage) (model.RetentionMode, error)
+
+var (
+	objectLockVerifiersMu sync.RWMutex
+	objectLockVerifiers   = map[string]ObjectLockVerifier{"s3": VerifyS3ObjectLock}
+)
+
+// RegisterObjectLockVerifier makes a verifier available for the storages of the given type,
+// replacing any verifier registered for the same type.
+func RegisterObjectLockVerifier(storageType string, verifier ObjectLockVerifier) {
+	objectLockVerifiersMu.Lock()
+	defer objectLockVerifiersMu.Unlock()
+	objectLockVerifiers[storageType] = verifier
+}
+
+// VerifyObjectLock returns the object lock retention mode of the bucket of a storage with the verifier registered for
+// its type. Storages of types without a verifier are model.RetentionUnsupported.
+func VerifyObjectLock(ctx context.Context, storage model.Storage) (model.RetentionMode, error) {
+	objectLockVerifiersMu.RLock()
+	verifier, ok := objectLockVerifiers[storage.Type]
+	objectLockVerifiersMu.RUnlock()
+	if !ok {
+		return model.RetentionUnsupported, nil
+	}
+	mode, err := verifier(ctx, storage)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to verify the object lock of storage %s", storage.Name)
+	}
+	return mode, nil
+}
+
+// VerifyS3ObjectLock returns the default retention mode of the object lock configuration of the bucket of an S3
+// storage. The bucket is the first segment of the path of the storage, and the client is configured from the
+// credentials, region and endpoint in the config of the storage, so it also works with S3 compatible providers that
+// support object lock. A bucket without object lock, or with object lock but no default retention, is
+// model.RetentionNone, as its objects can still be overwritten or deleted.
+func VerifyS3ObjectLock(ctx context.Context, storage model.Storage) (model.RetentionMode, error) {
+	bucket, _, _ := strings.Cut(strings.Trim(storage.Path, "/"), "/")
+	if bucket == "" {
+		return "", errors.Wrap(ErrInvalidConfig, "the path of the storage has no bucket")
+	}
+
+	config := aws.NewConfig().WithRegion("us-east-1")
+	if region := storage.Config["region"]; region != "" {
+		config = config.WithRegion(region)
+	}
+	if endpoint := storage.Config["endpoint"]; endpoint != "" {
+		config = config.WithEndpoint(endpoint)
+	}
+	// rclone uses path style addressing unless told otherwise
+	forcePathStyle := true
+	if value := storage.Config["force_path_style"]; value != "" {
+		forcePathStyle, _ = strconv.ParseBool(value)
+	}
+	config = config.WithS3ForcePathStyle(forcePathStyle)
+	accessKeyID := storage.Config["access_key_id"]
+	envAuth, _ := strconv.ParseBool(storage.Config["env_auth"])
+	switch {
+	case accessKeyID != "":
+		config = config.WithCredentials(credentials.NewStaticCredentials(
+			accessKeyID, storage.Config["secret_access_key"], storage.Config["session_token"]))
+	case !envAuth:
+		config = config.WithCredentials(credentials.AnonymousCredentials)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *config,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create AWS session")
+	}
+
+	output, err := s3.New(sess).GetObjectLockConfigurationWithContext(ctx, &s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) && awsErr.Code() == "ObjectLockConfigurationNotFoundError" {
+		return model.RetentionNone, nil
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get the object lock configuration of bucket %s", bucket)
+	}
+
+	lock := output.ObjectLockConfiguration
+	if lock == nil || aws.StringValue(lock.ObjectLockEnabled) != s3.ObjectLockEnabledEnabled ||
+		lock.Rule == nil || lock.Rule.DefaultRetention == nil {
+		return model.RetentionNone, nil
+	}
+	switch aws.StringValue(lock.Rule.DefaultRetention.Mode) {
+	case s3.ObjectLockRetentionModeCompliance:
+		return model.RetentionCompliance, nil
+	case s3.ObjectLockRetentionModeGovernance:
+		return model.RetentionGovernance, nil
+	default:
+		return model.RetentionNone, nil
+	}
+}
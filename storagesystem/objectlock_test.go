@@ -0,0 +1,79 @@
+package storagesystem
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyS3ObjectLock(t *testing.T) {
+	responses := map[string]struct {
+		status int
+		body   string
+	}{
+		"compliance": {http.StatusOK, `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>` +
+			`<Rule><DefaultRetention><Mode>COMPLIANCE</Mode><Years>7</Years></DefaultRetention></Rule></ObjectLockConfiguration>`},
+		"governance": {http.StatusOK, `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>` +
+			`<Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>`},
+		"noretention": {http.StatusOK, `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`},
+		"unlocked": {http.StatusNotFound, `<Error><Code>ObjectLockConfigurationNotFoundError</Code>` +
+			`<Message>Object Lock configuration does not exist for this bucket</Message></Error>`},
+		"denied": {http.StatusForbidden, `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, ok := r.URL.Query()["object-lock"]
+		response, found := responses[r.URL.Path[1:]]
+		if !ok || !found {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(response.status)
+		_, _ = w.Write([]byte(response.body))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	storage := func(path string) model.Storage {
+		return model.Storage{Name: "source", Type: "s3", Path: path, Config: map[string]string{
+			"endpoint":          server.URL,
+			"access_key_id":     "access",
+			"secret_access_key": "secret",
+		}}
+	}
+	for path, expected := range map[string]model.RetentionMode{
+		"compliance/prefix": model.RetentionCompliance,
+		"governance":        model.RetentionGovernance,
+		"noretention":       model.RetentionNone,
+		"unlocked":          model.RetentionNone,
+	} {
+		mode, err := VerifyObjectLock(ctx, storage(path))
+		require.NoError(t, err, path)
+		require.Equal(t, expected, mode, path)
+	}
+
+	_, err := VerifyObjectLock(ctx, storage("denied"))
+	require.ErrorContains(t, err, "AccessDenied")
+	_, err = VerifyObjectLock(ctx, storage(""))
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	mode, err := VerifyObjectLock(ctx, model.Storage{Type: "local", Path: "/tmp"})
+	require.NoError(t, err)
+	require.Equal(t, model.RetentionUnsupported, mode)
+
+	RegisterObjectLockVerifier("local", func(context.Context, model.Storage) (model.RetentionMode, error) {
+		return model.RetentionCompliance, nil
+	})
+	defer func() {
+		objectLockVerifiersMu.Lock()
+		delete(objectLockVerifiers, "local")
+		objectLockVerifiersMu.Unlock()
+	}()
+	mode, err = VerifyObjectLock(ctx, model.Storage{Type: "local", Path: "/tmp"})
+	require.NoError(t, err)
+	require.Equal(t, model.RetentionCompliance, mode)
+}
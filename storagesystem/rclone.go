@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -14,8 +15,10 @@ import (
 	"github.com/ipfs/go-log/v2"
 	"github.com/rclone/rclone/fs"
 	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/rclone/rclone/fs/fserrors"
 	"github.com/rclone/rclone/fs/object"
 	"golang.org/x/exp/slices"
+	"google.golang.org/api/googleapi"
 )
 
 var logger = log.Logger("storage")
@@ -25,8 +28,14 @@ var _ Handler = &RCloneHandler{}
 var ErrGetUsageNotSupported = errors.New("The backend does not support getting usage quota")
 var ErrBackendNotSupported = errors.New("This backend is not supported")
 var ErrMoveNotSupported = errors.New("The backend does not support moving files")
+var ErrSignedLinkNotSupported = errors.New("The backend does not support signed links")
+
+// signedLinkTypes are the storage types whose public links are URLs signed with the credentials of the storage.
+// These links expire, and creating them has no side effect on the storage, unlike the shared links of other backends.
+var signedLinkTypes = map[string]bool{"s3": true}
 
 type RCloneHandler struct {
+	id                      model.StorageID
 	name                    string
 	fs                      fs.Fs
 	fsNoHead                fs.Fs
@@ -46,7 +55,8 @@ func (h RCloneHandler) Write(ctx context.Context, path string, in io.Reader) (fs
 	if strings.HasSuffix(path, ".car") {
 		objInfo = objInfo.WithMimeType("application/vnd.ipfs.car")
 	}
-	return h.fs.Put(ctx, in, objInfo)
+	obj, err := h.fs.Put(ctx, in, objInfo)
+	return obj, wrapAuthError(h.id, h.name, err)
 }
 
 func (h RCloneHandler) Move(ctx context.Context, from fs.Object, to string) (fs.Object, error) {
@@ -71,7 +81,52 @@ func (h RCloneHandler) About(ctx context.Context) (*fs.Usage, error) {
 
 func (h RCloneHandler) List(ctx context.Context, path string) ([]fs.DirEntry, error) {
 	logger.Debugw("List: listing path", "type", h.fs.Name(), "root", h.fs.Root(), "path", path)
-	return h.fs.List(ctx, path)
+	entries, err := h.listWithRetry(ctx, path)
+	return entries, wrapAuthError(h.id, h.name, err)
+}
+
+// isRetryableListError returns whether listing a path should be retried after the error, i.e. when Google Drive still
+// reports that the rate limit is exceeded after the low-level retries of rclone, or when the error is transient.
+// Errors of rejected credentials are never retried.
+func isRetryableListError(err error) bool {
+	if IsAuthError(err) {
+		return false
+	}
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		if apiErr.Code == http.StatusTooManyRequests || apiErr.Code >= 500 {
+			return true
+		}
+		for _, item := range apiErr.Errors {
+			if item.Reason == "rateLimitExceeded" || item.Reason == "userRateLimitExceeded" {
+				return true
+			}
+		}
+	}
+	return fserrors.IsRetryAfterError(err) || fserrors.ShouldRetry(err)
+}
+
+// listWithRetry lists a path with the retry strategy of the storage, so that a rate limited listing does not skip
+// the entries of the path. The delay before the next attempt is the one requested by the backend, if any.
+func (h RCloneHandler) listWithRetry(ctx context.Context, path string) ([]fs.DirEntry, error) {
+	delay := h.retryDelay
+	for retryCount := 0; ; retryCount++ {
+		entries, err := h.fs.List(ctx, path)
+		if err == nil || retryCount >= h.retryMaxCount || !isRetryableListError(err) {
+			return entries, err
+		}
+		wait := delay
+		if retryAfter := fserrors.RetryAfterErrorTime(err); !retryAfter.IsZero() {
+			wait = time.Until(retryAfter)
+		}
+		logger.Warnw("List: listing is rate limited or failed temporarily, retrying", "path", path, "error", err, "delay", wait)
+		select {
+		case <-ctx.Done():
+			return nil, errors.Join(err, ctx.Err())
+		case <-time.After(wait):
+		}
+		delay = time.Duration(float64(delay)*h.retryBackoffExponential) + h.retryBackoff
+	}
 }
 
 func (h RCloneHandler) scan(ctx context.Context, path string, ch chan<- Entry, wp *workerpool.WorkerPool, wg *sync.WaitGroup) {
@@ -79,9 +134,9 @@ func (h RCloneHandler) scan(ctx context.Context, path string, ch chan<- Entry, w
 		return
 	}
 	logger.Infow("Scan: listing path", "type", h.fs.String(), "path", path)
-	entries, err := h.fs.List(ctx, path)
+	entries, err := h.listWithRetry(ctx, path)
 	if err != nil {
-		err = errors.Wrapf(err, "list path: %s", path)
+		err = errors.Wrapf(wrapAuthError(h.id, h.name, err), "list path: %s", path)
 		select {
 		case <-ctx.Done():
 			return
@@ -142,7 +197,8 @@ func (h RCloneHandler) Scan(ctx context.Context, path string) <-chan Entry {
 
 func (h RCloneHandler) Check(ctx context.Context, path string) (fs.DirEntry, error) {
 	logger.Debugw("Check: checking path", "type", h.fs.Name(), "root", h.fs.Root(), "path", path)
-	return h.fs.NewObject(ctx, path)
+	entry, err := h.fs.NewObject(ctx, path)
+	return entry, wrapAuthError(h.id, h.name, err)
 }
 
 type readCloser struct {
@@ -152,6 +208,8 @@ type readCloser struct {
 
 type readerWithRetry struct {
 	ctx                     context.Context
+	storageID               model.StorageID
+	storage                 string
 	object                  fs.Object
 	reader                  io.ReadCloser
 	offset                  int64
@@ -180,6 +238,9 @@ func (r *readerWithRetry) Read(p []byte) (int, error) {
 		return n, err
 	}
 
+	if IsAuthError(err) {
+		return n, wrapAuthError(r.storageID, r.storage, err)
+	}
 	if r.retryCount >= r.retryCountMax {
 		return n, err
 	}
@@ -208,18 +269,21 @@ func (h RCloneHandler) Read(ctx context.Context, path string, offset int64, leng
 	if length == 0 {
 		object, err := h.fs.NewObject(ctx, path)
 		if err != nil {
-			return nil, nil, errors.Wrapf(err, "failed to open object %s", path)
+			return nil, nil, errors.Wrapf(wrapAuthError(h.id, h.name, err), "failed to open object %s", path)
 		}
 		return io.NopCloser(bytes.NewReader(nil)), object, nil
 	}
 	object, err := h.fsNoHead.NewObject(ctx, path)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "failed to open object %s", path)
+		return nil, nil, errors.Wrapf(wrapAuthError(h.id, h.name, err), "failed to open object %s", path)
 	}
 	option := &fs.SeekOption{Offset: offset}
 	reader, err := object.Open(ctx, option)
+	err = wrapAuthError(h.id, h.name, err)
 	readerWithRetry := &readerWithRetry{
 		ctx:                     ctx,
+		storageID:               h.id,
+		storage:                 h.name,
 		object:                  object,
 		reader:                  reader,
 		offset:                  offset,
@@ -237,6 +301,21 @@ func (h RCloneHandler) Read(ctx context.Context, path string, offset int64, leng
 	}, object, errors.WithStack(err)
 }
 
+// SignedLink returns a URL of the file at the given path that is signed with the credentials of the storage, so that
+// the file can be downloaded directly from the storage, including with range requests, until the link expires.
+// It returns ErrSignedLinkNotSupported if the storage type does not support signed links.
+func (h RCloneHandler) SignedLink(ctx context.Context, path string, expire time.Duration) (string, error) {
+	publicLink := h.fs.Features().PublicLink
+	if !signedLinkTypes[h.fs.Name()] || publicLink == nil {
+		return "", errors.Wrapf(ErrSignedLinkNotSupported, "type: %s", h.fs.Name())
+	}
+	link, err := publicLink(ctx, path, fs.Duration(expire), false)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to sign link of %s", path)
+	}
+	return link, nil
+}
+
 func NewRCloneHandler(ctx context.Context, s model.Storage) (*RCloneHandler, error) {
 	_, ok := BackendMap[s.Type]
 	registry, err := fs.Find(s.Type)
@@ -257,12 +336,12 @@ func NewRCloneHandler(ctx context.Context, s model.Storage) (*RCloneHandler, err
 	noHeadObjectConfig["no_head_object"] = "true"
 	headObjectConfig["no_head_object"] = "false"
 
-	noHeadFS, err := registry.NewFs(ctx, s.Type, s.Path, configmap.Simple(noHeadObjectConfig))
+	noHeadFS, err := newFs(ctx, registry, s, configmap.Simple(noHeadObjectConfig))
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create RClone backend %s: %s", s.Type, s.Path)
 	}
 
-	headFS, err := registry.NewFs(ctx, s.Type, s.Path, configmap.Simple(headObjectConfig))
+	headFS, err := newFs(ctx, registry, s, configmap.Simple(headObjectConfig))
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create RClone backend %s: %s", s.Type, s.Path)
 	}
@@ -273,6 +352,7 @@ func NewRCloneHandler(ctx context.Context, s model.Storage) (*RCloneHandler, err
 	}
 
 	handler := &RCloneHandler{
+		id:                      s.ID,
 		name:                    s.Name,
 		fs:                      headFS,
 		fsNoHead:                noHeadFS,
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -13,8 +14,10 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/gotidy/ptr"
+	"github.com/rclone/rclone/fs"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/api/googleapi"
 )
 
 type faultyReader struct {
@@ -83,6 +86,56 @@ func TestReaderWithRetry(t *testing.T) {
 	require.EqualValues(t, "a", out)
 }
 
+// rateLimitedFs fails to list the first failures times with a rate limit error.
+type rateLimitedFs struct {
+	fs.Fs
+	failures int
+	calls    *int
+}
+
+func (f rateLimitedFs) List(ctx context.Context, dir string) (fs.DirEntries, error) {
+	*f.calls++
+	if *f.calls <= f.failures {
+		return nil, errors.Wrap(&googleapi.Error{
+			Code:   http.StatusForbidden,
+			Errors: []googleapi.ErrorItem{{Reason: "userRateLimitExceeded"}},
+		}, "couldn't list directory")
+	}
+	return f.Fs.List(ctx, dir)
+}
+
+func TestRCloneHandler_ListWithRetry(t *testing.T) {
+	ctx := context.Background()
+	tmp := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmp, "test.txt"), []byte("test"), 0644)
+	require.NoError(t, err)
+	handler, err := NewRCloneHandler(ctx, model.Storage{Type: "local", Path: tmp, ClientConfig: model.ClientConfig{
+		RetryMaxCount: ptr.Of(2),
+		RetryDelay:    ptr.Of(time.Millisecond),
+		RetryBackoff:  ptr.Of(time.Millisecond),
+	}})
+	require.NoError(t, err)
+
+	var calls int
+	handler.fs = rateLimitedFs{Fs: handler.fs, failures: 2, calls: &calls}
+	entries, err := handler.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, 3, calls)
+
+	calls = 0
+	handler.fs = rateLimitedFs{Fs: handler.fs.(rateLimitedFs).Fs, failures: 3, calls: &calls}
+	var scanned []Entry
+	for entry := range handler.Scan(ctx, "") {
+		scanned = append(scanned, entry)
+	}
+	require.Len(t, scanned, 1)
+	require.Error(t, scanned[0].Error)
+	require.Equal(t, 3, calls)
+
+	require.False(t, isRetryableListError(fs.ErrorDirNotFound))
+}
+
 func TestRCloneHandler_OverrideConfig(t *testing.T) {
 	tmp := t.TempDir()
 
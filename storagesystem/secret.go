@@ -0,0 +1,56 @@
+package storagesystem
+
+import (
+	"github.com/data-preservation-programs/singularity/model"
+)
+
+// secretOptionNames are the options that hold credentials but are not marked as passwords by rclone and do not look
+// like secrets by their name.
+var secretOptionNames = map[string]bool{
+	"2fa":                         true,
+	"access_grant":                true,
+	"sas_url":                     true,
+	"service_account_credentials": true,
+}
+
+// nonSecretOptionNames are the options that look like secrets by their name, but only hold a URL, a path, a flag or
+// an identifier.
+var nonSecretOptionNames = map[string]bool{
+	"ask_password":          true,
+	"key_exchange":          true,
+	"key_file":              true,
+	"key_use_agent":         true,
+	"pubkey_file":           true,
+	"sse_customer_key_file": true,
+	"sse_kms_key_id":        true,
+	"token_expiry":          true,
+	"token_url":             true,
+}
+
+// IsSecret returns whether the option holds a secret, i.e. a key, a token or a password, whose value is masked in API
+// responses, CLI output and logs.
+func (option Option) IsSecret() bool {
+	if secretOptionNames[option.Name] {
+		return true
+	}
+	if nonSecretOptionNames[option.Name] {
+		return false
+	}
+	return option.IsPassword || model.IsSecretConfigName(option.Name)
+}
+
+// registerSecretOptions marks the options of the backends as secrets or not, so that model.IsSecretConfigName
+// follows the individual options rather than their names.
+func registerSecretOptions(backends ...Backend) {
+	secrets := make(map[string]bool)
+	for _, backend := range backends {
+		for _, providerOptions := range backend.ProviderOptions {
+			for _, option := range providerOptions.Options {
+				secrets[option.Name] = secrets[option.Name] || option.IsSecret()
+			}
+		}
+	}
+	for name, secret := range secrets {
+		model.RegisterConfigOption(name, secret)
+	}
+}
@@ -0,0 +1,37 @@
+package storagesystem
+
+import (
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOption_IsSecret(t *testing.T) {
+	s3, err := BackendMap["s3"].FindProviderOptions("AWS")
+	require.NoError(t, err)
+	secrets := make(map[string]bool)
+	for _, option := range s3.Options {
+		secrets[option.Name] = option.IsSecret()
+	}
+	require.True(t, secrets["access_key_id"])
+	require.True(t, secrets["secret_access_key"])
+	require.True(t, secrets["session_token"])
+	require.False(t, secrets["region"])
+	require.False(t, secrets["sse_kms_key_id"])
+
+	require.True(t, model.IsSecretConfigName("sas_url"))
+	require.True(t, model.IsSecretConfigName("access_grant"))
+	require.False(t, model.IsSecretConfigName("token_url"))
+	require.False(t, model.IsSecretConfigName("key_file"))
+
+	for _, backend := range Backends {
+		for _, providerOptions := range backend.ProviderOptions {
+			for _, option := range providerOptions.Options {
+				if option.IsPassword {
+					require.True(t, option.IsSecret(), "%s %s", backend.Prefix, option.Name)
+				}
+			}
+		}
+	}
+}
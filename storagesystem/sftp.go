@@ -0,0 +1,86 @@
+package storagesystem
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/rclone/rclone/lib/env"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// CheckSFTPConfig checks the authentication config of an SFTP storage before it is created or updated, so that a
+// misconfigured storage is rejected with a clear error instead of failing on the first connection.
+// Other storage types are left untouched.
+//
+//   - The known_hosts_file option, which enables the verification of the host key of the server, must be a readable
+//     known_hosts file. The host key is not verified if it is not set.
+//   - The key_file option must be a readable private key file.
+//
+// Parameters:
+//   - s: The storage to check.
+//
+// Returns:
+//   - An error wrapping ErrInvalidConfig if the config is inconsistent.
+func CheckSFTPConfig(s model.Storage) error {
+	if s.Type != "sftp" {
+		return nil
+	}
+	config := s.Config
+
+	if knownHostsFile := config["known_hosts_file"]; knownHostsFile != "" {
+		_, err := knownhosts.New(env.ShellExpand(knownHostsFile))
+		if err != nil {
+			return errors.Wrapf(ErrInvalidConfig, "invalid known_hosts_file: %s", err)
+		}
+	}
+
+	if keyFile := config["key_file"]; keyFile != "" && config["key_pem"] == "" {
+		_, err := os.Stat(env.ShellExpand(keyFile))
+		if err != nil {
+			return errors.Wrapf(ErrInvalidConfig, "invalid key_file: %s", err)
+		}
+	}
+	return nil
+}
+
+// sharedFsTypes are the storage types whose backends are shared by all handlers of the same storage in the process.
+// The SFTP backend opens an SSH session when it is created and keeps a pool of idle sessions, so creating a backend
+// for each scan, pack job or retrieval would open a new session each time. Sharing the backend reuses the sessions of
+// its pool instead.
+var sharedFsTypes = map[string]bool{"sftp": true}
+
+var sharedFs = struct {
+	sync.Mutex
+	fs map[string]fs.Fs
+}{fs: make(map[string]fs.Fs)}
+
+// newFs creates the rclone backend of a storage, or returns the shared backend of the storage if its type is in
+// sharedFsTypes. The shared backends are keyed by the type, path, config and client config of the storage, so a
+// storage that is updated gets a new backend.
+func newFs(ctx context.Context, registry *fs.RegInfo, s model.Storage, config configmap.Simple) (fs.Fs, error) {
+	if !sharedFsTypes[s.Type] {
+		return registry.NewFs(ctx, s.Type, s.Path, config)
+	}
+
+	key, err := json.Marshal([]any{s.Type, s.Path, config, s.ClientConfig})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	sharedFs.Lock()
+	defer sharedFs.Unlock()
+	if f, ok := sharedFs.fs[string(key)]; ok {
+		return f, nil
+	}
+	f, err := registry.NewFs(ctx, s.Type, s.Path, config)
+	if err != nil {
+		return nil, err
+	}
+	sharedFs.fs[string(key)] = f
+	return f, nil
+}
@@ -0,0 +1,73 @@
+package storagesystem
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func TestCheckSFTPConfig(t *testing.T) {
+	tmp := t.TempDir()
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	require.NoError(t, err)
+	knownHosts := filepath.Join(tmp, "known_hosts")
+	require.NoError(t, os.WriteFile(knownHosts, []byte(knownhosts.Line([]string{"example.com"}, sshPublicKey)+"\n"), 0644))
+	invalidKnownHosts := filepath.Join(tmp, "invalid_known_hosts")
+	require.NoError(t, os.WriteFile(invalidKnownHosts, []byte("example.com ssh-ed25519 invalid\n"), 0644))
+	keyFile := filepath.Join(tmp, "id_ed25519")
+	require.NoError(t, os.WriteFile(keyFile, []byte("key"), 0600))
+
+	tests := []struct {
+		name   string
+		config map[string]string
+		valid  bool
+	}{
+		{"password", map[string]string{"host": "example.com", "pass": "secret"}, true},
+		{"known hosts", map[string]string{"host": "example.com", "known_hosts_file": knownHosts}, true},
+		{"invalid known hosts", map[string]string{"host": "example.com", "known_hosts_file": invalidKnownHosts}, false},
+		{"missing known hosts", map[string]string{"host": "example.com", "known_hosts_file": filepath.Join(tmp, "missing")}, false},
+		{"key file", map[string]string{"host": "example.com", "key_file": keyFile}, true},
+		{"missing key file", map[string]string{"host": "example.com", "key_file": filepath.Join(tmp, "missing")}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckSFTPConfig(model.Storage{Type: "sftp", Config: test.config})
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidConfig)
+			}
+		})
+	}
+
+	require.NoError(t, CheckSFTPConfig(model.Storage{Type: "s3", Config: map[string]string{"key_file": "/missing"}}))
+}
+
+func TestNewRCloneHandler_SharedFs(t *testing.T) {
+	sharedFsTypes["local"] = true
+	defer delete(sharedFsTypes, "local")
+
+	ctx := context.Background()
+	tmp := t.TempDir()
+	handler1, err := NewRCloneHandler(ctx, model.Storage{Type: "local", Path: tmp})
+	require.NoError(t, err)
+	handler2, err := NewRCloneHandler(ctx, model.Storage{Name: "other", Type: "local", Path: tmp})
+	require.NoError(t, err)
+	require.Same(t, handler1.fs, handler2.fs)
+	require.Same(t, handler1.fsNoHead, handler2.fsNoHead)
+	require.NotSame(t, handler1.fs, handler1.fsNoHead)
+
+	handler3, err := NewRCloneHandler(ctx, model.Storage{Type: "local", Path: t.TempDir()})
+	require.NoError(t, err)
+	require.NotSame(t, handler1.fs, handler3.fs)
+}
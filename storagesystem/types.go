@@ -8,6 +8,8 @@ import (
 	"strconv"
 	"strings"
 
+	_ "github.com/data-preservation-programs/singularity/storagesystem/ipfs"
+	_ "github.com/data-preservation-programs/singularity/storagesystem/urllist"
 	_ "github.com/rclone/rclone/backend/amazonclouddrive"
 	_ "github.com/rclone/rclone/backend/azureblob"
 	_ "github.com/rclone/rclone/backend/b2"
@@ -250,68 +252,100 @@ func init() {
 		if slices.Contains([]string{"crypt", "tardigrade"}, regInfo.Prefix) {
 			continue
 		}
-		backend := Backend{}
-		backend.Prefix = regInfo.Prefix
-		backend.Name = regInfo.Name
-		backend.Description = regInfo.Description
+		addBackend(newBackend(regInfo))
+	}
+	registerSecretOptions(Backends...)
+}
 
-		providerMap := make(map[string]*ProviderOptions)
-		var allProviders []string
-		for _, option := range regInfo.Options {
-			if option.Name == "provider" {
-				for _, example := range option.Examples {
-					providerMap[example.Value] = &ProviderOptions{
-						Provider:            example.Value,
-						ProviderDescription: example.Help,
-					}
-					allProviders = append(allProviders, example.Value)
-				}
-				continue
-			}
+// RegisterBackend registers an rclone backend as a storage type, so that custom backends, i.e. of proprietary object
+// stores, can be compiled in without changing this package. It is meant to be called from the init function of the
+// package of the backend, like fs.Register. The storage type is then available to the API, and to the storage create
+// and update commands.
+//
+// It panics if a storage type with the same prefix is already registered.
+//
+// Parameters:
+//   - regInfo: The rclone registration of the backend, with its name, description, options and NewFs function.
+func RegisterBackend(regInfo *fs.RegInfo) {
+	if regInfo.Prefix == "" {
+		regInfo.Prefix = regInfo.Name
+	}
+	if _, ok := BackendMap[regInfo.Prefix]; ok {
+		panic("storage type " + regInfo.Prefix + " is already registered")
+	}
+	fs.Register(regInfo)
+	backend := newBackend(regInfo)
+	addBackend(backend)
+	registerSecretOptions(backend)
+}
 
-			if len(allProviders) == 0 {
-				allProviders = []string{""}
-				providerMap[""] = &ProviderOptions{}
-			}
-			var providers []string
-			switch {
-			case option.Provider == "":
-				providers = allProviders
-			case strings.HasPrefix(option.Provider, "!"):
-				excludes := strings.Split(option.Provider[1:], ",")
-				providers = underscore.Difference(allProviders, excludes)
-			default:
-				providers = strings.Split(option.Provider, ",")
-			}
+// addBackend adds a backend to Backends, sorted by prefix, and to BackendMap.
+func addBackend(backend Backend) {
+	Backends = append(Backends, backend)
+	BackendMap[backend.Prefix] = backend
+	slices.SortFunc(Backends, func(i, j Backend) int {
+		return strings.Compare(i.Prefix, j.Prefix)
+	})
+}
 
-			for _, provider := range providers {
-				option := option.Copy()
-				if backend.Name == "local" && option.Name == "encoding" {
-					option.Default = encoder.Base
-				}
-				option.Examples = underscore.Filter(option.Examples, func(example fs.OptionExample) bool {
-					return example.Provider == "" || example.Provider == provider
-				})
-				_, ok := providerMap[provider]
-				if !ok {
-					panic("provider not found")
+// newBackend converts an rclone registration to a Backend, with the options of each provider.
+func newBackend(regInfo *fs.RegInfo) Backend {
+	backend := Backend{}
+	backend.Prefix = regInfo.Prefix
+	backend.Name = regInfo.Name
+	backend.Description = regInfo.Description
+
+	providerMap := make(map[string]*ProviderOptions)
+	var allProviders []string
+	for _, option := range regInfo.Options {
+		if option.Name == "provider" {
+			for _, example := range option.Examples {
+				providerMap[example.Value] = &ProviderOptions{
+					Provider:            example.Value,
+					ProviderDescription: example.Help,
 				}
-				providerMap[provider].Options = append(providerMap[provider].Options, Option(*option))
+				allProviders = append(allProviders, example.Value)
 			}
+			continue
 		}
 
-		for _, provider := range providerMap {
-			backend.ProviderOptions = append(backend.ProviderOptions, *provider)
+		if len(allProviders) == 0 {
+			allProviders = []string{""}
+			providerMap[""] = &ProviderOptions{}
+		}
+		var providers []string
+		switch {
+		case option.Provider == "":
+			providers = allProviders
+		case strings.HasPrefix(option.Provider, "!"):
+			excludes := strings.Split(option.Provider[1:], ",")
+			providers = underscore.Difference(allProviders, excludes)
+		default:
+			providers = strings.Split(option.Provider, ",")
 		}
 
-		sort.Slice(backend.ProviderOptions, func(i, j int) bool {
-			return backend.ProviderOptions[i].Provider < backend.ProviderOptions[j].Provider
-		})
+		for _, provider := range providers {
+			option := option.Copy()
+			if backend.Name == "local" && option.Name == "encoding" {
+				option.Default = encoder.Base
+			}
+			option.Examples = underscore.Filter(option.Examples, func(example fs.OptionExample) bool {
+				return example.Provider == "" || example.Provider == provider
+			})
+			_, ok := providerMap[provider]
+			if !ok {
+				panic("provider not found")
+			}
+			providerMap[provider].Options = append(providerMap[provider].Options, Option(*option))
+		}
+	}
 
-		Backends = append(Backends, backend)
-		BackendMap[backend.Prefix] = backend
-		slices.SortFunc(Backends, func(i, j Backend) int {
-			return strings.Compare(i.Prefix, j.Prefix)
-		})
+	for _, provider := range providerMap {
+		backend.ProviderOptions = append(backend.ProviderOptions, *provider)
 	}
+
+	sort.Slice(backend.ProviderOptions, func(i, j int) bool {
+		return backend.ProviderOptions[i].Provider < backend.ProviderOptions[j].Provider
+	})
+	return backend
 }
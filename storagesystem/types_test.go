@@ -1,13 +1,67 @@
 package storagesystem
 
 import (
+	"context"
+	"strings"
 	"testing"
 
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
 )
 
 func TestBackends(t *testing.T) {
-	require.EqualValues(t, 41, len(Backends))
+	require.EqualValues(t, 43, len(Backends))
 	local := BackendMap["local"]
 	require.Equal(t, "local", local.Name)
 }
+
+func TestRegisterBackend(t *testing.T) {
+	localRegInfo, err := fs.Find("local")
+	require.NoError(t, err)
+	count := len(Backends)
+	regInfo := &fs.RegInfo{
+		Name:        "custom",
+		Description: "Custom object store",
+		NewFs: func(ctx context.Context, name string, root string, m configmap.Mapper) (fs.Fs, error) {
+			return localRegInfo.NewFs(ctx, name, root, configmap.Simple{})
+		},
+		Options: []fs.Option{{
+			Name: "endpoint",
+			Help: "Endpoint of the object store.",
+		}, {
+			Name:       "api_token",
+			Help:       "Token of the object store.",
+			IsPassword: true,
+		}},
+	}
+	RegisterBackend(regInfo)
+	defer func() {
+		delete(BackendMap, "custom")
+		Backends = slices.DeleteFunc(Backends, func(backend Backend) bool { return backend.Prefix == "custom" })
+		fs.Registry = slices.DeleteFunc(fs.Registry, func(r *fs.RegInfo) bool { return r == regInfo })
+	}()
+
+	require.Len(t, Backends, count+1)
+	require.True(t, slices.IsSortedFunc(Backends, func(i, j Backend) int {
+		return strings.Compare(i.Prefix, j.Prefix)
+	}))
+	backend, ok := BackendMap["custom"]
+	require.True(t, ok)
+	require.Equal(t, "Custom object store", backend.Description)
+	require.Len(t, backend.ProviderOptions, 1)
+	require.Len(t, backend.ProviderOptions[0].Options, 2)
+	require.True(t, model.IsSecretConfigName("api_token"))
+
+	handler, err := NewRCloneHandler(context.Background(), model.Storage{Type: "custom", Path: t.TempDir()})
+	require.NoError(t, err)
+	entries, err := handler.List(context.Background(), "")
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	require.Panics(t, func() {
+		RegisterBackend(&fs.RegInfo{Name: "local"})
+	})
+}
@@ -0,0 +1,464 @@
+// Package urllist provides a read-only rclone backend for data that is published as a list of plain HTTP or HTTPS
+// URLs. The backend reads a manifest with one URL per line, and exposes each URL as a file at <host>/<path>, so that
+// open-data archives without a directory listing can be prepared like any other data source. Archives that do have
+// an Apache or Nginx style directory listing can be prepared with the http backend instead.
+package urllist
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/rclone/rclone/fs/config/configstruct"
+	"github.com/rclone/rclone/fs/fshttp"
+	"github.com/rclone/rclone/fs/hash"
+	"github.com/rclone/rclone/lib/readers"
+)
+
+var (
+	errorReadOnly      = errors.New("urllist remotes are read only")
+	ErrInvalidManifest = errors.New("invalid URL manifest")
+	ErrUnexpectedReply = errors.New("unexpected HTTP reply")
+)
+
+// defaultModTime is the last modified time of the files whose server does not send a Last-Modified header, so that
+// the files are unchanged between scanning and packing.
+var defaultModTime = time.Unix(0, 0)
+
+func init() {
+	fs.Register(&fs.RegInfo{
+		Name:        "urllist",
+		Description: "List of HTTP/HTTPS URLs",
+		NewFs:       NewFs,
+		Options: []fs.Option{{
+			Name:     "head_concurrency",
+			Help:     "Number of HEAD requests sent at the same time to get the size of the files of a directory.",
+			Default:  16,
+			Advanced: true,
+		}},
+	})
+}
+
+// Options defines the configuration for this backend
+type Options struct {
+	HeadConcurrency int `config:"head_concurrency"`
+}
+
+// Fs is the list of URLs of a manifest. The root is the path or the URL of the manifest.
+type Fs struct {
+	name     string
+	root     string
+	opt      Options
+	features *fs.Features
+	client   *http.Client
+	urls     map[string]string          // URL of each file by remote path
+	files    map[string][]string        // Remote paths of the files by directory
+	dirs     map[string]map[string]bool // Remote paths of the subdirectories by directory
+}
+
+// Object is a URL of the manifest.
+type Object struct {
+	fs      *Fs
+	remote  string
+	url     string
+	size    int64
+	modTime time.Time
+}
+
+// NewFs creates a new Fs from the name and the root, which is the path or the URL of the manifest, and reads the
+// manifest. Empty lines and lines starting with # are ignored.
+func NewFs(ctx context.Context, name, root string, m configmap.Mapper) (fs.Fs, error) {
+	opt := new(Options)
+	err := configstruct.Set(m, opt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if opt.HeadConcurrency <= 0 {
+		opt.HeadConcurrency = 1
+	}
+
+	f := &Fs{
+		name:   name,
+		root:   root,
+		opt:    *opt,
+		client: fshttp.NewClient(ctx),
+		urls:   make(map[string]string),
+		files:  make(map[string][]string),
+		dirs:   make(map[string]map[string]bool),
+	}
+	f.features = (&fs.Features{}).Fill(ctx, f)
+
+	manifest, err := f.openManifest(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open manifest %s", root)
+	}
+	defer manifest.Close()
+	scanner := bufio.NewScanner(manifest)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		err = f.add(line)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = scanner.Err()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read manifest %s", root)
+	}
+	for _, remotes := range f.files {
+		sort.Strings(remotes)
+	}
+	return f, nil
+}
+
+// openManifest opens the manifest at the root, either from a URL or from a local file.
+func (f *Fs) openManifest(ctx context.Context) (io.ReadCloser, error) {
+	if !strings.HasPrefix(f.root, "http://") && !strings.HasPrefix(f.root, "https://") {
+		file, err := os.Open(f.root)
+		return file, errors.WithStack(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.root, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, errors.Wrapf(ErrUnexpectedReply, "status %s", resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// add adds a URL of the manifest as the file <host>/<path>, and adds its parent directories.
+func (f *Fs) add(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Wrapf(ErrInvalidManifest, "'%s' is not an HTTP or HTTPS URL", rawURL)
+	}
+	remote := path.Join(u.Host, path.Clean("/"+u.Path))
+	if strings.HasSuffix(u.Path, "/") || u.Path == "" {
+		remote = path.Join(remote, "index.html")
+	}
+	if existing, ok := f.urls[remote]; ok {
+		fs.Logf(f, "skipping %s as %s is already at %s", rawURL, existing, remote)
+		return nil
+	}
+	f.urls[remote] = rawURL
+
+	dir := path.Dir(remote)
+	f.files[dir] = append(f.files[dir], remote)
+	for dir != "." {
+		parent := path.Dir(dir)
+		if f.dirs[parent] == nil {
+			f.dirs[parent] = make(map[string]bool)
+		}
+		f.dirs[parent][dir] = true
+		dir = parent
+	}
+	return nil
+}
+
+// head returns the Object of a URL, with its size and last modified time from a HEAD request. If the server does not
+// answer HEAD requests, the size is taken from the Content-Range of a GET request of the first byte.
+func (f *Fs) head(ctx context.Context, remote string) (*Object, error) {
+	rawURL := f.urls[remote]
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, rawURL, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s", rawURL)
+	}
+	_ = resp.Body.Close()
+	size := resp.ContentLength
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return nil, fs.ErrorObjectNotFound
+	}
+	if resp.StatusCode != http.StatusOK || size < 0 {
+		req.Method = http.MethodGet
+		req.Header.Set("Range", "bytes=0-0")
+		resp, err = f.client.Do(req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get %s", rawURL)
+		}
+		_ = resp.Body.Close()
+		switch resp.StatusCode {
+		case http.StatusOK:
+			size = resp.ContentLength
+		case http.StatusPartialContent:
+			_, total, _ := strings.Cut(resp.Header.Get("Content-Range"), "/")
+			size, err = strconv.ParseInt(total, 10, 64)
+			if err != nil {
+				size = -1
+			}
+		case http.StatusNotFound, http.StatusGone:
+			return nil, fs.ErrorObjectNotFound
+		default:
+			return nil, errors.Wrapf(ErrUnexpectedReply, "%s: status %s", rawURL, resp.Status)
+		}
+		if size < 0 {
+			return nil, errors.Wrapf(ErrUnexpectedReply, "%s: unknown size", rawURL)
+		}
+	}
+
+	modTime := defaultModTime
+	lastModified, err := http.ParseTime(resp.Header.Get("Last-Modified"))
+	if err == nil {
+		modTime = lastModified
+	}
+	return &Object{
+		fs:      f,
+		remote:  remote,
+		url:     rawURL,
+		size:    size,
+		modTime: modTime,
+	}, nil
+}
+
+// Name of the remote (as passed into NewFs)
+func (f *Fs) Name() string {
+	return f.name
+}
+
+// Root of the remote (as passed into NewFs)
+func (f *Fs) Root() string {
+	return f.root
+}
+
+// String converts this Fs to a string
+func (f *Fs) String() string {
+	return "URL list " + f.root
+}
+
+// Features returns the optional features of this Fs
+func (f *Fs) Features() *fs.Features {
+	return f.features
+}
+
+// Precision of the remote. Last-Modified headers have a precision of one second.
+func (f *Fs) Precision() time.Duration {
+	return time.Second
+}
+
+// Hashes returns the supported hash types of the filesystem
+func (f *Fs) Hashes() hash.Set {
+	return hash.Set(hash.None)
+}
+
+// List the objects and directories in dir into entries. The size of the files is requested concurrently with up to
+// head_concurrency requests.
+func (f *Fs) List(ctx context.Context, dir string) (fs.DirEntries, error) {
+	if dir == "" {
+		dir = "."
+	}
+	remotes, hasFiles := f.files[dir]
+	subdirs, hasDirs := f.dirs[dir]
+	if !hasFiles && !hasDirs {
+		if _, ok := f.urls[dir]; ok {
+			return nil, fs.ErrorIsFile
+		}
+		return nil, fs.ErrorDirNotFound
+	}
+
+	var entries fs.DirEntries
+	names := make([]string, 0, len(subdirs))
+	for subdir := range subdirs {
+		names = append(names, subdir)
+	}
+	sort.Strings(names)
+	for _, subdir := range names {
+		entries = append(entries, fs.NewDir(subdir, defaultModTime))
+	}
+
+	objects := make([]*Object, len(remotes))
+	errs := make([]error, len(remotes))
+	sem := make(chan struct{}, f.opt.HeadConcurrency)
+	var wg sync.WaitGroup
+	for i, remote := range remotes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, remote string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			objects[i], errs[i] = f.head(ctx, remote)
+		}(i, remote)
+	}
+	wg.Wait()
+	for i, object := range objects {
+		if errors.Is(errs[i], fs.ErrorObjectNotFound) {
+			fs.Logf(f, "skipping %s as %s was not found", remotes[i], f.urls[remotes[i]])
+			continue
+		}
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		entries = append(entries, object)
+	}
+	return entries, nil
+}
+
+// NewObject finds the Object at remote. If it can't be found it returns the error fs.ErrorObjectNotFound.
+func (f *Fs) NewObject(ctx context.Context, remote string) (fs.Object, error) {
+	if _, ok := f.urls[remote]; !ok {
+		if _, ok := f.dirs[remote]; ok {
+			return nil, fs.ErrorIsDir
+		}
+		if _, ok := f.files[remote]; ok {
+			return nil, fs.ErrorIsDir
+		}
+		return nil, fs.ErrorObjectNotFound
+	}
+	return f.head(ctx, remote)
+}
+
+// Put is not supported as the remote is read only
+func (f *Fs) Put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+	return nil, errorReadOnly
+}
+
+// Mkdir is not supported as the remote is read only
+func (f *Fs) Mkdir(ctx context.Context, dir string) error {
+	return errorReadOnly
+}
+
+// Rmdir is not supported as the remote is read only
+func (f *Fs) Rmdir(ctx context.Context, dir string) error {
+	return errorReadOnly
+}
+
+// Fs is the filesystem this object is located within
+func (o *Object) Fs() fs.Info {
+	return o.fs
+}
+
+// String returns the remote path
+func (o *Object) String() string {
+	if o == nil {
+		return "<nil>"
+	}
+	return o.remote
+}
+
+// Remote returns the remote path
+func (o *Object) Remote() string {
+	return o.remote
+}
+
+// ID returns the URL of the file
+func (o *Object) ID() string {
+	return o.url
+}
+
+// Hash is not supported
+func (o *Object) Hash(ctx context.Context, r hash.Type) (string, error) {
+	return "", hash.ErrUnsupported
+}
+
+// Size returns the size of the file in bytes
+func (o *Object) Size() int64 {
+	return o.size
+}
+
+// ModTime returns the Last-Modified time of the file, or a constant time if the server does not send one
+func (o *Object) ModTime(ctx context.Context) time.Time {
+	return o.modTime
+}
+
+// SetModTime is not supported
+func (o *Object) SetModTime(ctx context.Context, modTime time.Time) error {
+	return fs.ErrorCantSetModTime
+}
+
+// Storable returns whether the object can be stored
+func (o *Object) Storable() bool {
+	return true
+}
+
+// Open the file for reading, starting at the offset of a SeekOption or reading the range of a RangeOption. The range
+// is requested with a Range header. If the server ignores the Range header, the bytes before the range are skipped.
+func (o *Object) Open(ctx context.Context, options ...fs.OpenOption) (io.ReadCloser, error) {
+	var offset, limit int64 = 0, -1
+	for _, option := range options {
+		switch x := option.(type) {
+		case *fs.SeekOption:
+			offset = x.Offset
+		case *fs.RangeOption:
+			offset, limit = x.Decode(o.size)
+		default:
+			if option.Mandatory() {
+				fs.Logf(o, "Unsupported mandatory option: %v", option)
+			}
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.url, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if offset > 0 || limit >= 0 {
+		end := ""
+		if limit >= 0 {
+			end = strconv.FormatInt(offset+limit-1, 10)
+		}
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%s", offset, end))
+	}
+	resp, err := o.fs.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s", o.url)
+	}
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		if offset > 0 {
+			_, err = io.CopyN(io.Discard, resp.Body, offset)
+			if err != nil {
+				_ = resp.Body.Close()
+				return nil, errors.Wrapf(err, "failed to skip to offset %d of %s", offset, o.url)
+			}
+		}
+	case http.StatusNotFound, http.StatusGone:
+		_ = resp.Body.Close()
+		return nil, fs.ErrorObjectNotFound
+	default:
+		_ = resp.Body.Close()
+		return nil, errors.Wrapf(ErrUnexpectedReply, "%s: status %s", o.url, resp.Status)
+	}
+	return readers.NewLimitedReadCloser(resp.Body, limit), nil
+}
+
+// Update is not supported as the remote is read only
+func (o *Object) Update(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) error {
+	return errorReadOnly
+}
+
+// Remove is not supported as the remote is read only
+func (o *Object) Remove(ctx context.Context) error {
+	return errorReadOnly
+}
+
+// Check the interfaces are satisfied
+var (
+	_ fs.Fs     = (*Fs)(nil)
+	_ fs.Object = (*Object)(nil)
+	_ fs.IDer   = (*Object)(nil)
+)
@@ -0,0 +1,97 @@
+package urllist
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURLList(t *testing.T) {
+	ctx := context.Background()
+	data := testutil.GenerateRandomBytes(3000)
+	modTime := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	mux := http.NewServeMux()
+	// Serves HEAD and range requests
+	mux.HandleFunc("/data/big.bin", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "big.bin", modTime, bytes.NewReader(data))
+	})
+	// Ignores HEAD and range requests
+	mux.HandleFunc("/data/sub/plain.txt", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		_, _ = w.Write([]byte("hello world"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	manifest := "# test manifest\n\n" + server.URL + "/data/big.bin\n" + server.URL + "/data/sub/plain.txt\n" +
+		server.URL + "/data/missing.bin\n"
+	manifestPath := filepath.Join(t.TempDir(), "urls.txt")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(manifest), 0644))
+
+	f, err := NewFs(ctx, "urllist", manifestPath, configmap.Simple{})
+	require.NoError(t, err)
+
+	entries, err := f.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, host, entries[0].Remote())
+
+	entries, err = f.List(ctx, host+"/data")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, host+"/data/sub", entries[0].Remote())
+	require.IsType(t, &fs.Dir{}, entries[0])
+	big := entries[1].(fs.Object)
+	require.Equal(t, host+"/data/big.bin", big.Remote())
+	require.EqualValues(t, len(data), big.Size())
+	require.True(t, modTime.Equal(big.ModTime(ctx)))
+
+	reader, err := big.Open(ctx, &fs.RangeOption{Start: 100, End: 199})
+	require.NoError(t, err)
+	read, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, data[100:200], read)
+
+	plain, err := f.NewObject(ctx, host+"/data/sub/plain.txt")
+	require.NoError(t, err)
+	require.EqualValues(t, 11, plain.Size())
+	require.Equal(t, defaultModTime, plain.ModTime(ctx))
+	reader, err = plain.Open(ctx, &fs.SeekOption{Offset: 6})
+	require.NoError(t, err)
+	read, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, "world", string(read))
+
+	_, err = f.NewObject(ctx, host+"/data/missing.bin")
+	require.ErrorIs(t, err, fs.ErrorObjectNotFound)
+	_, err = f.NewObject(ctx, host+"/data")
+	require.ErrorIs(t, err, fs.ErrorIsDir)
+	_, err = f.List(ctx, "other")
+	require.ErrorIs(t, err, fs.ErrorDirNotFound)
+}
+
+func TestURLListInvalidManifest(t *testing.T) {
+	manifestPath := filepath.Join(t.TempDir(), "urls.txt")
+	require.NoError(t, os.WriteFile(manifestPath, []byte("ftp://example.com/file\n"), 0644))
+	_, err := NewFs(context.Background(), "urllist", manifestPath, configmap.Simple{})
+	require.ErrorIs(t, err, ErrInvalidManifest)
+}
@@ -0,0 +1,161 @@
+package storagesystem
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/rjNemo/underscore"
+)
+
+var ErrInvalidConfig = errors.New("invalid storage config")
+
+// canonicalKey returns the form of a config key that is compared against the option names, so that the snake_case
+// option names can also be given in kebab-case, as used by the CLI flags, or in camelCase.
+func canonicalKey(key string) string {
+	key = strings.ReplaceAll(key, "_", "")
+	key = strings.ReplaceAll(key, "-", "")
+	return strings.ToLower(key)
+}
+
+// FindProviderOptions returns the options of the provider of a backend. The provider is matched case-insensitively.
+//
+// Parameters:
+//   - provider: The name of the provider, or empty for the backends without providers.
+//
+// Returns:
+//   - The options of the provider, and an error listing the supported providers if the backend has no such provider.
+func (b Backend) FindProviderOptions(provider string) (ProviderOptions, error) {
+	for _, providerOptions := range b.ProviderOptions {
+		if strings.EqualFold(providerOptions.Provider, provider) {
+			return providerOptions, nil
+		}
+	}
+	providers := underscore.Map(b.ProviderOptions, func(p ProviderOptions) string { return p.Provider })
+	return ProviderOptions{}, errors.Wrapf(ErrInvalidConfig, "provider '%s' is not supported by storage type %s, valid providers are: %s",
+		provider, b.Prefix, strings.Join(providers, ", "))
+}
+
+// ValidateConfig validates the keys and values of a storage config against the options of the provider, and returns
+// the config with the keys converted to the option names expected by rclone.
+//
+// A key may be the option name, i.e. access_key_id, or its kebab-case or camelCase form, i.e. access-key-id or
+// accessKeyId. A value must be parsable as the type of the option, and be one of the examples if the option is
+// exclusive. Empty values are accepted for all options, as they leave the option unset.
+//
+// Parameters:
+//   - config: The config to validate. The key "provider" is accepted if it matches the provider, and is converted to
+//     the case of the provider.
+//
+// Returns:
+//   - The config with normalized keys.
+//   - An error wrapping ErrInvalidConfig that lists the valid options or values, if a key or a value is invalid.
+func (p ProviderOptions) ValidateConfig(config map[string]string) (map[string]string, error) {
+	options := make(map[string]Option, len(p.Options))
+	for _, option := range p.Options {
+		options[canonicalKey(option.Name)] = option
+	}
+
+	normalized := make(map[string]string, len(config))
+	for key, value := range config {
+		if key == "provider" {
+			if !strings.EqualFold(value, p.Provider) {
+				return nil, errors.Wrapf(ErrInvalidConfig, "provider '%s' does not match provider '%s'", value, p.Provider)
+			}
+			normalized[key] = p.Provider
+			continue
+		}
+		option, ok := options[canonicalKey(key)]
+		if !ok {
+			return nil, errors.Wrap(ErrInvalidConfig, p.unknownKeyMessage(key))
+		}
+		if _, ok := normalized[option.Name]; ok {
+			return nil, errors.Wrapf(ErrInvalidConfig, "config key %s is given more than once", option.Name)
+		}
+		err := option.validateValue(value)
+		if err != nil {
+			return nil, err
+		}
+		normalized[option.Name] = value
+	}
+	return normalized, nil
+}
+
+func (p ProviderOptions) unknownKeyMessage(key string) string {
+	names := underscore.Map(p.Options, func(option Option) string { return option.Name })
+	sort.Strings(names)
+
+	message := fmt.Sprintf("unknown config key %s", key)
+	if p.Provider != "" {
+		message += fmt.Sprintf(" for provider %s", p.Provider)
+	}
+	suggestion, distance := "", -1
+	for _, name := range names {
+		d := editDistance(canonicalKey(key), canonicalKey(name))
+		if distance < 0 || d < distance {
+			suggestion, distance = name, d
+		}
+	}
+	if suggestion != "" && distance <= 3 {
+		message += fmt.Sprintf(", did you mean %s?", suggestion)
+	} else {
+		message += "."
+	}
+	return message + " Valid keys are: " + strings.Join(names, ", ")
+}
+
+func (option Option) validateValue(value string) error {
+	if value == "" {
+		return nil
+	}
+	o := fs.Option(option)
+	err := o.Set(value)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidConfig, "invalid value %q for config key %s of type %s: %s",
+			value, option.Name, o.Type(), err)
+	}
+	if !option.Exclusive || len(option.Examples) == 0 {
+		return nil
+	}
+	for _, example := range option.Examples {
+		if example.Value == value {
+			return nil
+		}
+	}
+	values := underscore.Map(option.Examples, func(example fs.OptionExample) string { return example.Value })
+	return errors.Wrapf(ErrInvalidConfig, "invalid value %q for config key %s, valid values are: %s",
+		value, option.Name, strings.Join(values, ", "))
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+	for _, v := range values[1:] {
+		if v < result {
+			result = v
+		}
+	}
+	return result
+}
@@ -0,0 +1,91 @@
+package storagesystem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfig(t *testing.T) {
+	s3, err := BackendMap["s3"].FindProviderOptions("aws")
+	require.NoError(t, err)
+	require.Equal(t, "AWS", s3.Provider)
+
+	config, err := s3.ValidateConfig(map[string]string{
+		"provider":          "aws",
+		"accessKeyId":       "key",
+		"secret-access-key": "secret",
+		"chunk_size":        "10Mi",
+		"region":            "",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"provider":          "AWS",
+		"access_key_id":     "key",
+		"secret_access_key": "secret",
+		"chunk_size":        "10Mi",
+		"region":            "",
+	}, config)
+
+	_, err = s3.ValidateConfig(map[string]string{"acess_key_id": "key"})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+	require.ErrorContains(t, err, "did you mean access_key_id?")
+	require.ErrorContains(t, err, "secret_access_key")
+
+	_, err = s3.ValidateConfig(map[string]string{"access_key_id": "key", "accessKeyId": "key"})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	_, err = s3.ValidateConfig(map[string]string{"chunk_size": "large"})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+	require.ErrorContains(t, err, "SizeSuffix")
+
+	_, err = s3.ValidateConfig(map[string]string{"provider": "Minio"})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	local, err := BackendMap["local"].FindProviderOptions("")
+	require.NoError(t, err)
+	_, err = local.ValidateConfig(map[string]string{"copy_links": "invalid"})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	_, err = BackendMap["s3"].FindProviderOptions("invalid")
+	require.ErrorIs(t, err, ErrInvalidConfig)
+	require.ErrorContains(t, err, "Minio")
+}
+
+func TestValidateConfig_Exclusive(t *testing.T) {
+	for _, backend := range Backends {
+		for _, providerOptions := range backend.ProviderOptions {
+			for _, option := range providerOptions.Options {
+				if !option.Exclusive || len(option.Examples) == 0 {
+					continue
+				}
+				_, err := providerOptions.ValidateConfig(map[string]string{option.Name: option.Examples[0].Value})
+				require.NoError(t, err)
+				_, err = providerOptions.ValidateConfig(map[string]string{option.Name: "not-an-example"})
+				require.ErrorIs(t, err, ErrInvalidConfig)
+				return
+			}
+		}
+	}
+}
+
+func TestValidateConfig_UniqueKeys(t *testing.T) {
+	for _, backend := range Backends {
+		for _, providerOptions := range backend.ProviderOptions {
+			keys := make(map[string]string)
+			for _, option := range providerOptions.Options {
+				key := canonicalKey(option.Name)
+				require.NotContains(t, keys, key, "%s %s: %s conflicts with %s",
+					backend.Prefix, providerOptions.Provider, option.Name, keys[key])
+				keys[key] = option.Name
+			}
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	require.Equal(t, 0, editDistance("abc", "abc"))
+	require.Equal(t, 1, editDistance("acesskey", "accesskey"))
+	require.Equal(t, 3, editDistance("", "abc"))
+	require.Equal(t, 3, editDistance("kitten", "sitting"))
+}
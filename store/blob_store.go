@@ -0,0 +1,190 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+	"github.com/ipfs/go-cid"
+	"gorm.io/gorm"
+)
+
+var ErrBlobCorrupted = errors.New("blob does not match the CID")
+var ErrBlobNotLoaded = errors.New("raw block is stored in a blob storage and has not been loaded")
+
+// BlobStore keeps the raw blocks, i.e. the dag nodes, in a storage instead of the database.
+// Any storage type can be used, i.e. a local directory or an S3 bucket. Blobs are content addressed,
+// so the same block is only stored once, and the database only keeps the ID of the storage as the reference.
+//
+// Fields:
+//   - storageID: The ID of the storage that holds the blobs.
+//   - handler: The handler used to read and write the blobs.
+type BlobStore struct {
+	storageID model.StorageID
+	handler   storagesystem.Handler
+}
+
+// NewBlobStore creates a BlobStore backed by the given storage.
+//
+// Parameters:
+//   - ctx: The context used to initialize the storage handler.
+//   - storage: The storage that holds the blobs.
+//
+// Returns:
+//   - A pointer to the BlobStore, and an error if the storage handler cannot be created.
+func NewBlobStore(ctx context.Context, storage model.Storage) (*BlobStore, error) {
+	handler, err := storagesystem.NewRCloneHandler(ctx, storage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &BlobStore{
+		storageID: storage.ID,
+		handler:   handler,
+	}, nil
+}
+
+// BlobPath returns the path of the blob of a block relative to the root of the blob storage.
+// Blobs are sharded by the last characters of the CID to avoid huge directories.
+func BlobPath(c cid.Cid) string {
+	s := c.String()
+	return path.Join("blocks", s[len(s)-3:], s)
+}
+
+// Put writes the raw block to the blob storage.
+func (b *BlobStore) Put(ctx context.Context, c cid.Cid, data []byte) error {
+	obj, err := b.handler.Write(ctx, BlobPath(c), bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrapf(err, "failed to write blob %s", c)
+	}
+	if obj.Size() != int64(len(data)) {
+		return errors.Wrapf(ErrBlobCorrupted, "blob %s has size %d, expected %d", c, obj.Size(), len(data))
+	}
+	return nil
+}
+
+// Get reads the raw block from the blob storage and verifies it against the CID.
+func (b *BlobStore) Get(ctx context.Context, c cid.Cid, length int64) ([]byte, error) {
+	reader, _, err := b.handler.Read(ctx, BlobPath(c), 0, length)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read blob %s", c)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read blob %s", c)
+	}
+	sum, err := c.Prefix().Sum(data)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !sum.Equals(c) {
+		return nil, errors.Wrapf(ErrBlobCorrupted, "blob %s", c)
+	}
+	return data, nil
+}
+
+// Offload moves the raw blocks of the CarBlocks to the blob storage. The raw blocks are removed
+// from the CarBlocks and replaced with a reference to the blob storage, so they are not saved to the database.
+// CarBlocks without a raw block, i.e. those referencing a file, and empty blocks are left as is.
+func (b *BlobStore) Offload(ctx context.Context, carBlocks []model.CarBlock) error {
+	for i := range carBlocks {
+		if len(carBlocks[i].RawBlock) == 0 {
+			continue
+		}
+		err := b.Put(ctx, cid.Cid(carBlocks[i].CID), carBlocks[i].RawBlock)
+		if err != nil {
+			return err
+		}
+		carBlocks[i].RawBlock = nil
+		carBlocks[i].BlobStorageID = &b.storageID
+	}
+	return nil
+}
+
+// BlobLoader reads the raw blocks that have been offloaded to blob storages one at a time, so that the raw blocks
+// of a piece do not need to be held in memory together. The blob storages are looked up in the database the first
+// time they are used. It is safe for concurrent use.
+type BlobLoader struct {
+	db     *gorm.DB
+	mu     sync.Mutex
+	stores map[model.StorageID]*BlobStore
+}
+
+// NewBlobLoader creates a BlobLoader that looks up the blob storages in the database.
+func NewBlobLoader(db *gorm.DB) *BlobLoader {
+	return &BlobLoader{
+		db:     db,
+		stores: make(map[model.StorageID]*BlobStore),
+	}
+}
+
+func (l *BlobLoader) blobStore(ctx context.Context, storageID model.StorageID) (*BlobStore, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	blobStore, ok := l.stores[storageID]
+	if ok {
+		return blobStore, nil
+	}
+	var storage model.Storage
+	err := l.db.WithContext(ctx).First(&storage, storageID).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find blob storage %d", storageID)
+	}
+	blobStore, err = NewBlobStore(ctx, storage)
+	if err != nil {
+		return nil, err
+	}
+	l.stores[storageID] = blobStore
+	return blobStore, nil
+}
+
+// Load reads the raw block of a CarBlock that has been offloaded to a blob storage.
+//
+// Parameters:
+//   - ctx: The context for database queries and reading the blob.
+//   - carBlock: The CarBlock whose raw block is read. It must reference a blob storage.
+//
+// Returns:
+//   - The raw block, and an error if the blob storage cannot be found or the blob cannot be read.
+func (l *BlobLoader) Load(ctx context.Context, carBlock model.CarBlock) ([]byte, error) {
+	if carBlock.BlobStorageID == nil {
+		return nil, errors.Wrapf(ErrBlobNotLoaded, "block %s does not reference a blob storage", cid.Cid(carBlock.CID))
+	}
+	blobStore, err := l.blobStore(ctx, *carBlock.BlobStorageID)
+	if err != nil {
+		return nil, err
+	}
+	return blobStore.Get(ctx, cid.Cid(carBlock.CID), int64(carBlock.BlockLength()))
+}
+
+// LoadBlobs populates the raw blocks of the CarBlocks that have been offloaded to a blob storage,
+// so that the CarBlocks can be used as if the raw blocks were stored in the database.
+// All the raw blocks are held in memory, so it should only be used for a bounded number of CarBlocks, i.e. when they
+// are sent to a client. Use PieceReader.UseBlobLoader to stream the raw blocks of a whole piece instead.
+//
+// Parameters:
+//   - ctx: The context for database queries and reading blobs.
+//   - db: The database used to look up the blob storages.
+//   - carBlocks: The CarBlocks to populate, which are modified in place.
+//
+// Returns:
+//   - An error if a blob storage cannot be found or a blob cannot be read.
+func LoadBlobs(ctx context.Context, db *gorm.DB, carBlocks []model.CarBlock) error {
+	loader := NewBlobLoader(db)
+	for i := range carBlocks {
+		if carBlocks[i].RawBlock != nil || carBlocks[i].BlobStorageID == nil {
+			continue
+		}
+		data, err := loader.Load(ctx, carBlocks[i])
+		if err != nil {
+			return err
+		}
+		carBlocks[i].RawBlock = data
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package store
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/util/testutil"
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestBlobStore_OffloadAndLoad(t *testing.T) {
+	tmp := t.TempDir()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		storage := model.Storage{Name: "blob", Type: "local", Path: tmp}
+		require.NoError(t, db.Create(&storage).Error)
+		blobStore, err := NewBlobStore(ctx, storage)
+		require.NoError(t, err)
+
+		data := []byte("dag node")
+		cidValue := cid.NewCidV1(cid.DagProtobuf, util.Hash(data))
+		fileID := model.FileID(1)
+		carBlocks := []model.CarBlock{
+			{CID: model.CID(cidValue), CarBlockLength: int32(1 + cidValue.ByteLen() + len(data)), Varint: []byte{0}, RawBlock: data},
+			{CID: model.CID(testutil.TestCid), FileID: &fileID},
+		}
+		err = blobStore.Offload(ctx, carBlocks)
+		require.NoError(t, err)
+		require.Nil(t, carBlocks[0].RawBlock)
+		require.Equal(t, storage.ID, *carBlocks[0].BlobStorageID)
+		require.Nil(t, carBlocks[1].BlobStorageID)
+		stored, err := os.ReadFile(filepath.Join(tmp, BlobPath(cidValue)))
+		require.NoError(t, err)
+		require.Equal(t, data, stored)
+
+		err = LoadBlobs(ctx, db, carBlocks)
+		require.NoError(t, err)
+		require.Equal(t, data, carBlocks[0].RawBlock)
+		require.Nil(t, carBlocks[1].RawBlock)
+
+		// Corrupted blob
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, BlobPath(cidValue)), []byte("dag nod!"), 0644))
+		carBlocks[0].RawBlock = nil
+		err = LoadBlobs(ctx, db, carBlocks)
+		require.ErrorIs(t, err, ErrBlobCorrupted)
+	})
+}
+
+func TestFileReferenceBlockStore_Get_BlobStorage(t *testing.T) {
+	tmp := t.TempDir()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		storage := model.Storage{Name: "blob", Type: "local", Path: tmp}
+		require.NoError(t, db.Create(&storage).Error)
+		data := []byte("dag node")
+		cidValue := cid.NewCidV1(cid.DagProtobuf, util.Hash(data))
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(tmp, BlobPath(cidValue))), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, BlobPath(cidValue)), data, 0644))
+
+		err := db.Create(&model.CarBlock{
+			Car: &model.Car{
+				Attachment: &model.SourceAttachment{
+					Preparation: &model.Preparation{},
+					Storage:     &model.Storage{},
+				},
+				PreparationID: 1,
+			},
+			CID:            model.CID(cidValue),
+			CarBlockLength: int32(1 + cidValue.ByteLen() + len(data)),
+			Varint:         []byte{0},
+			BlobStorageID:  &storage.ID,
+		}).Error
+		require.NoError(t, err)
+
+		store := FileReferenceBlockStore{
+			DBNoContext: db,
+		}
+		blk, err := store.Get(ctx, cidValue)
+		require.NoError(t, err)
+		require.Equal(t, data, blk.RawData())
+	})
+}
+
+func TestPieceReader_BlobLoader(t *testing.T) {
+	tmp := t.TempDir()
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		storage := model.Storage{Name: "blob", Type: "local", Path: tmp}
+		require.NoError(t, db.Create(&storage).Error)
+		blobStore, err := NewBlobStore(ctx, storage)
+		require.NoError(t, err)
+
+		content := []byte("1234567890123456789009876543210987654321")
+		car := model.Car{
+			RootCID:  model.CID(testutil.TestCid),
+			FileSize: 173,
+		}
+		carBlocks := []model.CarBlock{
+			{
+				CarOffset:      59,
+				CarBlockLength: 57,
+				Varint:         []byte{56},
+				RawBlock:       content[:20],
+				CID:            model.CID(cid.NewCidV1(cid.Raw, util.Hash(content[:20]))),
+			},
+			{
+				CarOffset:      116,
+				CarBlockLength: 57,
+				Varint:         []byte{56},
+				RawBlock:       content[20:],
+				CID:            model.CID(cid.NewCidV1(cid.Raw, util.Hash(content[20:]))),
+			},
+		}
+		reader, err := NewPieceReader(ctx, car, storage, carBlocks, nil)
+		require.NoError(t, err)
+		expected, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		require.NoError(t, blobStore.Offload(ctx, carBlocks))
+
+		// The offloaded blocks cannot be read without a blob loader
+		reader, err = NewPieceReader(ctx, car, storage, carBlocks, nil)
+		require.NoError(t, err)
+		_, err = io.ReadAll(reader)
+		require.ErrorIs(t, err, ErrBlobNotLoaded)
+		require.NoError(t, reader.Close())
+
+		reader, err = NewPieceReader(ctx, car, storage, carBlocks, nil)
+		require.NoError(t, err)
+		reader.UseBlobLoader(NewBlobLoader(db))
+		defer func() { require.NoError(t, reader.Close()) }()
+		for _, pos := range []int64{0, 60, 100, 150, 173} {
+			_, err = reader.Seek(pos, io.SeekStart)
+			require.NoError(t, err)
+			read, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			require.Equal(t, expected[pos:], read)
+		}
+		require.Nil(t, carBlocks[0].RawBlock)
+		require.Nil(t, carBlocks[1].RawBlock)
+	})
+}
@@ -0,0 +1,192 @@
+package store
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"github.com/ipfs/go-cid"
+)
+
+// BlockCache is an LRU cache of the blocks read from the data sources, keyed by CID. It lets blocks of pieces that are
+// retrieved repeatedly, e.g. by multiple storage providers downloading the same CAR, be served without reading them
+// from the data source again.
+//
+// The cache is bounded by the total size of the cached blocks rather than their number. Blocks are kept in memory, or
+// in files under a directory if one is provided. A nil BlockCache caches nothing.
+type BlockCache struct {
+	mu      sync.Mutex
+	maxSize int64
+	size    int64
+	dir     string
+	entries *simplelru.LRU[cid.Cid, blockCacheEntry]
+}
+
+type blockCacheEntry struct {
+	data []byte
+	size int64
+}
+
+// NewBlockCache creates a new BlockCache.
+//
+// Parameters:
+//   - maxSize: The maximum total size of the cached blocks in bytes. Blocks larger than this are never cached.
+//   - dir: The directory under which the cached blocks are stored. The blocks are kept in memory if it is empty.
+//     The cache uses its own temporary subdirectory, which is removed by Close.
+//
+// Returns:
+//   - A new BlockCache, and an error if the cache directory cannot be created.
+func NewBlockCache(maxSize int64, dir string) (*BlockCache, error) {
+	c := &BlockCache{maxSize: maxSize}
+	if dir != "" {
+		err := os.MkdirAll(dir, 0755)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create block cache directory %s", dir)
+		}
+		c.dir, err = os.MkdirTemp(dir, "blockcache-")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create block cache directory under %s", dir)
+		}
+	}
+	// The cache is bounded by size, so the number of entries is effectively unlimited.
+	entries, err := simplelru.NewLRU[cid.Cid, blockCacheEntry](math.MaxInt32, c.onEvict)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	c.entries = entries
+	return c, nil
+}
+
+func (c *BlockCache) path(key cid.Cid) string {
+	return filepath.Join(c.dir, key.String())
+}
+
+func (c *BlockCache) onEvict(key cid.Cid, entry blockCacheEntry) {
+	c.size -= entry.size
+	if c.dir != "" {
+		err := os.Remove(c.path(key))
+		if err != nil && !os.IsNotExist(err) {
+			logger.Warnw("failed to remove cached block", "cid", key, "error", err)
+		}
+	}
+}
+
+// Get returns the data of the block with the specified CID, if it is cached.
+//
+// Parameters:
+//   - key: The CID of the block.
+//
+// Returns:
+//   - The data of the block, and whether it was found in the cache.
+func (c *BlockCache) Get(key cid.Cid) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	entry, ok := c.entries.Get(key)
+	c.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+	if c.dir == "" {
+		return entry.data, true
+	}
+
+	// The block may be evicted concurrently, in which case it is a miss.
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	if int64(len(data)) != entry.size {
+		return nil, false
+	}
+	return data, true
+}
+
+// Add caches the data of the block with the specified CID, evicting the least recently used blocks if the cache is
+// full. The data must not be modified afterwards.
+//
+// Parameters:
+//   - key: The CID of the block.
+//   - data: The data of the block.
+func (c *BlockCache) Add(key cid.Cid, data []byte) {
+	if c == nil || int64(len(data)) > c.maxSize {
+		return
+	}
+	c.mu.Lock()
+	found := c.entries.Contains(key)
+	c.mu.Unlock()
+	if found {
+		return
+	}
+
+	entry := blockCacheEntry{size: int64(len(data))}
+	if c.dir == "" {
+		entry.data = data
+	} else {
+		// Write to a temporary file first so concurrent reads never see a partially written block.
+		err := c.writeFile(key, data)
+		if err != nil {
+			logger.Warnw("failed to cache block", "cid", key, "error", err)
+			return
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries.Contains(key) {
+		return
+	}
+	c.entries.Add(key, entry)
+	c.size += entry.size
+	for c.size > c.maxSize {
+		c.entries.RemoveOldest()
+	}
+}
+
+func (c *BlockCache) writeFile(key cid.Cid, data []byte) error {
+	file, err := os.CreateTemp(c.dir, "tmp-")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = file.Write(data)
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), c.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// Size returns the total size of the cached blocks in bytes.
+func (c *BlockCache) Size() int64 {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// Close empties the cache and removes its directory, if any.
+func (c *BlockCache) Close() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries.Purge()
+	if c.dir == "" {
+		return nil
+	}
+	return errors.WithStack(os.RemoveAll(c.dir))
+}
@@ -0,0 +1,88 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ipfs/boxo/util"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+)
+
+func testBlock(data string) (cid.Cid, []byte) {
+	return cid.NewCidV1(cid.Raw, util.Hash([]byte(data))), []byte(data)
+}
+
+func TestBlockCache_Memory(t *testing.T) {
+	cache, err := NewBlockCache(10, "")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, cache.Close()) }()
+
+	c1, d1 := testBlock("1111")
+	c2, d2 := testBlock("2222")
+	c3, d3 := testBlock("3333")
+	cache.Add(c1, d1)
+	cache.Add(c2, d2)
+	require.EqualValues(t, 8, cache.Size())
+
+	// Using the first block makes the second one the least recently used.
+	data, ok := cache.Get(c1)
+	require.True(t, ok)
+	require.Equal(t, d1, data)
+	cache.Add(c3, d3)
+	require.EqualValues(t, 8, cache.Size())
+	_, ok = cache.Get(c2)
+	require.False(t, ok)
+	_, ok = cache.Get(c1)
+	require.True(t, ok)
+	_, ok = cache.Get(c3)
+	require.True(t, ok)
+
+	large, largeData := testBlock("01234567890")
+	cache.Add(large, largeData)
+	_, ok = cache.Get(large)
+	require.False(t, ok)
+	require.EqualValues(t, 8, cache.Size())
+}
+
+func TestBlockCache_Disk(t *testing.T) {
+	tmp := t.TempDir()
+	cache, err := NewBlockCache(10, tmp)
+	require.NoError(t, err)
+
+	c1, d1 := testBlock("1111")
+	c2, d2 := testBlock("2222")
+	c3, d3 := testBlock("3333")
+	cache.Add(c1, d1)
+	cache.Add(c2, d2)
+	cache.Add(c3, d3)
+	require.EqualValues(t, 8, cache.Size())
+	_, ok := cache.Get(c1)
+	require.False(t, ok)
+	data, ok := cache.Get(c3)
+	require.True(t, ok)
+	require.Equal(t, d3, data)
+
+	entries, err := os.ReadDir(tmp)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	files, err := os.ReadDir(filepath.Join(tmp, entries[0].Name()))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	require.NoError(t, cache.Close())
+	entries, err = os.ReadDir(tmp)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestBlockCache_Nil(t *testing.T) {
+	var cache *BlockCache
+	c1, d1 := testBlock("1111")
+	cache.Add(c1, d1)
+	_, ok := cache.Get(c1)
+	require.False(t, ok)
+	require.Zero(t, cache.Size())
+	require.NoError(t, cache.Close())
+}
@@ -20,8 +20,10 @@ import (
 //
 // Fields:
 //   - DBNoContext: The GORM database used for storage. This should be initialized and connected to a database before use.
+//   - BlockCache: An optional BlockCache that blocks read from the data sources are served from and added to.
 type FileReferenceBlockStore struct {
 	DBNoContext *gorm.DB
+	BlockCache  *BlockCache
 }
 
 // Has is a method on the FileReferenceBlockStore struct that checks if a block with the specified CID exists in the store.
@@ -40,6 +42,9 @@ func (i *FileReferenceBlockStore) Has(ctx context.Context, cid cid.Cid) (bool, e
 }
 
 func (i *FileReferenceBlockStore) Get(ctx context.Context, cid cid.Cid) (blocks.Block, error) {
+	if data, ok := i.BlockCache.Get(cid); ok {
+		return blocks.NewBlockWithCid(data, cid)
+	}
 	var carBlock model.CarBlock
 	err := i.DBNoContext.WithContext(ctx).Joins("File.Attachment.Storage").Where("car_blocks.cid = ?", model.CID(cid)).First(&carBlock).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -48,6 +53,14 @@ func (i *FileReferenceBlockStore) Get(ctx context.Context, cid cid.Cid) (blocks.
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	if carBlock.RawBlock == nil && carBlock.BlobStorageID != nil {
+		carBlocks := []model.CarBlock{carBlock}
+		err = LoadBlobs(ctx, i.DBNoContext, carBlocks)
+		if err != nil {
+			return nil, err
+		}
+		carBlock = carBlocks[0]
+	}
 	if carBlock.RawBlock != nil {
 		return blocks.NewBlockWithCid(carBlock.RawBlock, cid)
 	}
@@ -74,6 +87,7 @@ func (i *FileReferenceBlockStore) Get(ctx context.Context, cid cid.Cid) (blocks.
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	i.BlockCache.Add(cid, readBytes)
 	return blocks.NewBlockWithCid(readBytes, cid)
 }
 
@@ -166,3 +166,50 @@ func TestFileReferenceBlockStore_Get_FileBlock(t *testing.T) {
 		require.ErrorIs(t, err, fs.ErrorObjectNotFound)
 	})
 }
+
+func TestFileReferenceBlockStore_Get_BlockCache(t *testing.T) {
+	testutil.All(t, func(ctx context.Context, t *testing.T, db *gorm.DB) {
+		cache, err := NewBlockCache(1024, "")
+		require.NoError(t, err)
+		store := FileReferenceBlockStore{
+			DBNoContext: db,
+			BlockCache:  cache,
+		}
+
+		tmp := t.TempDir()
+		err = os.WriteFile(filepath.Join(tmp, "1.txt"), []byte("test"), 0644)
+		require.NoError(t, err)
+		cidValue := cid.NewCidV1(cid.Raw, util.Hash([]byte("test")))
+		err = db.Create(&model.CarBlock{
+			Car: &model.Car{
+				Attachment: &model.SourceAttachment{
+					Preparation: &model.Preparation{},
+					Storage: &model.Storage{
+						Type: "local",
+						Path: tmp,
+					},
+				},
+				PreparationID: 1,
+			},
+			CID: model.CID(cidValue),
+			File: &model.File{
+				AttachmentID:     1,
+				Path:             "1.txt",
+				Size:             4,
+				LastModifiedNano: testutil.GetFileTimestamp(t, filepath.Join(tmp, "1.txt")),
+			},
+			CarBlockLength: 36 + 1 + 4,
+		}).Error
+		require.NoError(t, err)
+		blk, err := store.Get(ctx, cidValue)
+		require.NoError(t, err)
+		require.Equal(t, []byte("test"), blk.RawData())
+
+		// The cached block is served without reading the file
+		err = os.Remove(filepath.Join(tmp, "1.txt"))
+		require.NoError(t, err)
+		blk, err = store.Get(ctx, cidValue)
+		require.NoError(t, err)
+		require.Equal(t, []byte("test"), blk.RawData())
+	})
+}
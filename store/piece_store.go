@@ -2,7 +2,9 @@ package store
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"os"
 	"sort"
 
 	"github.com/data-preservation-programs/singularity/storagesystem"
@@ -29,6 +31,26 @@ var ErrNegativeOffset = errors.New("negative offset")
 var ErrOffsetOutOfRange = errors.New("position past end of file")
 var ErrTruncated = errors.New("original file has been truncated")
 var ErrFileHasChanged = errors.New("file has changed")
+var ErrBlockMismatch = util.ErrBlockMismatch
+
+// BlockMismatchError is returned by a PieceReader that verifies blocks when the data read from a file no longer
+// hashes to the CID of the block, i.e. because the file was modified without changing its size or last modified time.
+type BlockMismatchError struct {
+	FileID model.FileID
+	Path   string
+	Offset int64   // Offset of the block in the file
+	CID    cid.Cid // CID of the block
+	Actual cid.Cid // CID of the data that was read
+}
+
+func (e BlockMismatchError) Unwrap() error {
+	return ErrBlockMismatch
+}
+
+func (e BlockMismatchError) Error() string {
+	return fmt.Sprintf("block %s of file %d (%s) at offset %d does not match its CID, got %s",
+		e.CID, e.FileID, e.Path, e.Offset, e.Actual)
+}
 
 // PieceReader is a struct that represents a reader for pieces of data.
 //
@@ -43,17 +65,31 @@ var ErrFileHasChanged = errors.New("file has changed")
 //   - readerFor: A uint64 file ID that represents the current file being read.
 //   - pos: An int64 that represents the current position in the data being read.
 //   - blockIndex: An integer that represents the index of the current block being read.
+//   - blockCache: An optional BlockCache that file-backed blocks are served from and added to.
+//   - readerOffset: The offset in the current file of the reader.
+//   - block: The data of the current block, only loaded when a block cache is used.
+//   - blockFor: The index of the block whose data is loaded.
+//   - verifyBlocks: Whether file-backed blocks are re-hashed and verified against their CID.
+//   - prefetch: An optional prefetcher that reads the upcoming file-backed blocks concurrently.
+//   - blobs: An optional BlobLoader that the blocks offloaded to a blob storage are read from, one at a time.
 type PieceReader struct {
-	ctx        context.Context
-	fileSize   int64
-	header     []byte
-	handler    storagesystem.Handler
-	carBlocks  []model.CarBlock
-	files      map[model.FileID]model.File
-	reader     io.ReadCloser
-	readerFor  model.FileID
-	pos        int64
-	blockIndex int
+	ctx          context.Context
+	fileSize     int64
+	header       []byte
+	handler      storagesystem.Handler
+	carBlocks    []model.CarBlock
+	files        map[model.FileID]model.File
+	reader       io.ReadCloser
+	readerFor    model.FileID
+	pos          int64
+	blockIndex   int
+	blockCache   *BlockCache
+	readerOffset int64
+	block        []byte
+	blockFor     int
+	verifyBlocks bool
+	prefetch     *prefetcher
+	blobs        *BlobLoader
 }
 
 // Seek is a method on the PieceReader struct that changes the position of the reader.
@@ -112,16 +148,23 @@ func (pr *PieceReader) Seek(offset int64, whence int) (int64, error) {
 //   - A new PieceReader that has the same state as the original, but starting at position 0.
 func (pr *PieceReader) Clone() *PieceReader {
 	reader := &PieceReader{
-		ctx:        pr.ctx,
-		fileSize:   pr.fileSize,
-		header:     pr.header,
-		handler:    pr.handler,
-		carBlocks:  pr.carBlocks,
-		files:      pr.files,
-		reader:     pr.reader,
-		readerFor:  pr.readerFor,
-		pos:        pr.pos,
-		blockIndex: pr.blockIndex,
+		ctx:          pr.ctx,
+		fileSize:     pr.fileSize,
+		header:       pr.header,
+		handler:      pr.handler,
+		carBlocks:    pr.carBlocks,
+		files:        pr.files,
+		reader:       pr.reader,
+		readerFor:    pr.readerFor,
+		pos:          pr.pos,
+		blockIndex:   pr.blockIndex,
+		blockCache:   pr.blockCache,
+		blockFor:     -1,
+		verifyBlocks: pr.verifyBlocks,
+		blobs:        pr.blobs,
+	}
+	if pr.prefetch != nil {
+		reader.Prefetch(pr.prefetch.concurrency, pr.prefetch.bufferSize)
 	}
 	//nolint:errcheck
 	reader.Seek(0, io.SeekStart)
@@ -193,7 +236,10 @@ func NewPieceReader(
 		if uint64(carBlocks[i].BlockLength()) != vint-uint64(cid.Cid(carBlocks[i].CID).ByteLen()) {
 			return nil, errors.Wrapf(ErrVarintDoesNotMatchBlockLength, "expected %d, got %d", carBlocks[i].BlockLength(), vint-uint64(cid.Cid(carBlocks[i].CID).ByteLen()))
 		}
-		if carBlocks[i].RawBlock == nil {
+		if carBlocks[i].RawBlock == nil && carBlocks[i].FileID == nil && carBlocks[i].BlobStorageID == nil {
+			return nil, errors.Wrapf(ErrBlobNotLoaded, "block %s", cid.Cid(carBlocks[i].CID))
+		}
+		if carBlocks[i].RawBlock == nil && carBlocks[i].FileID != nil {
 			_, ok := filesMap[*carBlocks[i].FileID]
 			if !ok {
 				return nil, ErrFileNotProvided
@@ -214,9 +260,183 @@ func NewPieceReader(
 		carBlocks:  carBlocks,
 		files:      filesMap,
 		blockIndex: -1,
+		blockFor:   -1,
 	}, nil
 }
 
+// UseBlobLoader makes the PieceReader read the blocks that have been offloaded to a blob storage from the provided
+// BlobLoader as they are reached, instead of requiring their raw blocks to be loaded beforehand. Without a BlobLoader,
+// reading such a block fails with ErrBlobNotLoaded.
+//
+// Parameters:
+//   - loader: The BlobLoader to read the offloaded blocks from.
+func (pr *PieceReader) UseBlobLoader(loader *BlobLoader) {
+	pr.blobs = loader
+}
+
+// UseBlockCache makes the PieceReader serve the file-backed blocks from the provided BlockCache when they are cached,
+// and add them to it when they are read from the data source. Blocks are then read from the data source as a whole,
+// even if only part of a block is requested.
+//
+// Parameters:
+//   - cache: The BlockCache to use. A nil cache disables caching.
+func (pr *PieceReader) UseBlockCache(cache *BlockCache) {
+	pr.blockCache = cache
+}
+
+// VerifyBlocks makes the PieceReader re-hash each file-backed block as it is streamed and verify it against the CID
+// of the block, so that a source file that was modified in place is never served as part of a corrupted piece.
+// Blocks are then read from the data source as a whole, even if only part of a block is requested, and a
+// BlockMismatchError is returned by Read for a block that does not match.
+//
+// Parameters:
+//   - verify: Whether to verify the blocks.
+func (pr *PieceReader) VerifyBlocks(verify bool) {
+	pr.verifyBlocks = verify
+}
+
+// Prefetch makes the PieceReader read the upcoming file-backed blocks ahead of the current position with concurrent
+// requests to the data source, so that streaming a piece from a high-latency data source, i.e. S3 or HTTP, is not
+// stalled by opening each file. Contiguous blocks of the same file are read with a single request. Blocks are then
+// read from the data source as a whole, even if only part of a block is requested. The blocks are prefetched even
+// if they are in the block cache.
+//
+// Parameters:
+//   - concurrency: The maximum number of requests that are read or buffered at the same time. Prefetching is disabled if 0.
+//   - bufferSize: The maximum total size of the blocks that are read or buffered ahead.
+func (pr *PieceReader) Prefetch(concurrency int, bufferSize int64) {
+	if pr.prefetch != nil {
+		pr.prefetch.close()
+		pr.prefetch = nil
+	}
+	if concurrency <= 0 {
+		return
+	}
+	pr.prefetch = &prefetcher{
+		ctx:         pr.ctx,
+		handler:     pr.handler,
+		carBlocks:   pr.carBlocks,
+		files:       pr.files,
+		concurrency: concurrency,
+		bufferSize:  bufferSize,
+	}
+}
+
+// verifyBlock checks that the data of a file-backed block hashes to the CID of the block.
+func (pr *PieceReader) verifyBlock(carBlock model.CarBlock, data []byte) error {
+	blockCID := cid.Cid(carBlock.CID)
+	actual, err := blockCID.Prefix().Sum(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to hash block %s", blockCID)
+	}
+	if actual.Equals(blockCID) {
+		return nil
+	}
+	file := pr.files[*carBlock.FileID]
+	mismatch := BlockMismatchError{
+		FileID: file.ID,
+		Path:   file.Path,
+		Offset: carBlock.FileOffset,
+		CID:    blockCID,
+		Actual: actual,
+	}
+	// The error may not reach the client once the response has started, i.e. with http.ServeContent.
+	logger.Errorw("block does not match its CID", "file", file.Path, "offset", carBlock.FileOffset,
+		"cid", blockCID, "actual", actual)
+	return mismatch
+}
+
+// loadBlock returns the data of a file-backed block, either from the block cache or read from the data source. The
+// reader of the data source is kept open, so contiguous blocks of the same file are read with a single request.
+// If blocks are prefetched, the data is taken from the prefetcher instead. If blocks are verified, the data is
+// verified before it is cached or returned.
+func (pr *PieceReader) loadBlock(carBlock model.CarBlock) ([]byte, error) {
+	if pr.blockFor == pr.blockIndex {
+		return pr.block, nil
+	}
+	blockCID := cid.Cid(carBlock.CID)
+	data, ok := pr.blockCache.Get(blockCID)
+	if !ok && pr.prefetch != nil {
+		var err error
+		data, err = pr.prefetch.get(pr.blockIndex)
+		if err != nil {
+			return nil, err
+		}
+		if pr.verifyBlocks {
+			err = pr.verifyBlock(carBlock, data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		pr.blockCache.Add(blockCID, data)
+	} else if !ok {
+		if pr.reader != nil && (pr.readerFor != *carBlock.FileID || pr.readerOffset != carBlock.FileOffset) {
+			pr.reader.Close()
+			pr.reader = nil
+		}
+
+		if pr.reader == nil {
+			file := pr.files[*carBlock.FileID]
+			logger.Infow("reading file", "path", file.Path, "offset", carBlock.FileOffset)
+			var obj fs.Object
+			var err error
+			pr.reader, obj, err = pr.handler.Read(pr.ctx, file.Path, carBlock.FileOffset, file.Size-carBlock.FileOffset)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to read file")
+			}
+			isSameEntry, explanation := storagesystem.IsSameEntry(pr.ctx, file, obj)
+			if !isSameEntry {
+				return nil, errors.Wrap(ErrFileHasChanged, explanation)
+			}
+			pr.readerFor = file.ID
+			pr.readerOffset = carBlock.FileOffset
+		}
+
+		data = make([]byte, carBlock.BlockLength())
+		n, err := io.ReadFull(pr.reader, data)
+		pr.readerOffset += int64(n)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, ErrTruncated
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if pr.verifyBlocks {
+			err = pr.verifyBlock(carBlock, data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		pr.blockCache.Add(blockCID, data)
+	} else if pr.verifyBlocks {
+		err := pr.verifyBlock(carBlock, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pr.block = data
+	pr.blockFor = pr.blockIndex
+	return data, nil
+}
+
+// loadBlob returns the raw block of a block that has been offloaded to a blob storage. Only the current block is
+// kept, so the raw blocks of the piece are never held in memory together.
+func (pr *PieceReader) loadBlob(carBlock model.CarBlock) ([]byte, error) {
+	if pr.blockFor == pr.blockIndex {
+		return pr.block, nil
+	}
+	if pr.blobs == nil {
+		return nil, errors.Wrapf(ErrBlobNotLoaded, "block %s", cid.Cid(carBlock.CID))
+	}
+	data, err := pr.blobs.Load(pr.ctx, carBlock)
+	if err != nil {
+		return nil, err
+	}
+	pr.block = data
+	pr.blockFor = pr.blockIndex
+	return data, nil
+}
+
 // Read is a method on the PieceReader struct that reads data into the provided byte slice.
 //   - It reads data from the current position of the PieceReader and advances the position accordingly.
 //   - If the context of the PieceReader has been cancelled, it returns an error immediately.
@@ -224,7 +444,9 @@ func NewPieceReader(
 //   - If the PieceReader is currently at a block boundary, it advances to the next block before reading data.
 //   - If the PieceReader is currently at a varint or CID boundary within a block, it reads the varint or CID data.
 //   - If the PieceReader is currently at a raw block boundary within a block, it reads the raw block data.
+//   - If the block has been offloaded to a blob storage, it reads the raw block from the BlobLoader.
 //   - If the PieceReader is currently at an file boundary within a block, it reads the file data.
+//   - If blocks are verified, it returns a BlockMismatchError if the file data does not match the CID of the block.
 //   - If the PieceReader encounters an error while reading data, it returns the error.
 //
 // Parameters:
@@ -276,15 +498,38 @@ func (pr *PieceReader) Read(p []byte) (n int, err error) {
 		return
 	}
 
-	if pr.reader != nil && pr.readerFor != *carBlock.FileID {
+	if carBlock.FileID == nil {
+		var data []byte
+		data, err = pr.loadBlob(carBlock)
+		if err != nil {
+			return 0, err
+		}
+		n = copy(p, data[pr.pos-carBlock.CarOffset-int64(len(carBlock.Varint))-int64(cid.Cid(carBlock.CID).ByteLen()):])
+		pr.pos += int64(n)
+		return
+	}
+
+	if pr.blockCache != nil || pr.verifyBlocks || pr.prefetch != nil {
+		var data []byte
+		data, err = pr.loadBlock(carBlock)
+		if err != nil {
+			return 0, err
+		}
+		n = copy(p, data[pr.pos-carBlock.CarOffset-int64(len(carBlock.Varint))-int64(cid.Cid(carBlock.CID).ByteLen()):])
+		pr.pos += int64(n)
+		return
+	}
+
+	fileOffset := pr.pos - carBlock.CarOffset - int64(len(carBlock.Varint)) - int64(cid.Cid(carBlock.CID).ByteLen())
+	fileOffset += carBlock.FileOffset
+	// The blocks of a file are not contiguous in the file if it is a CAR file of a CAR source
+	if pr.reader != nil && (pr.readerFor != *carBlock.FileID || pr.readerOffset != fileOffset) {
 		pr.reader.Close()
 		pr.reader = nil
 	}
 
 	if pr.reader == nil {
 		file := pr.files[*carBlock.FileID]
-		fileOffset := pr.pos - carBlock.CarOffset - int64(len(carBlock.Varint)) - int64(cid.Cid(carBlock.CID).ByteLen())
-		fileOffset += carBlock.FileOffset
 		logger.Infow("reading file", "path", file.Path, "offset", fileOffset)
 		var obj fs.Object
 		pr.reader, obj, err = pr.handler.Read(pr.ctx, file.Path, fileOffset, file.Size-fileOffset)
@@ -297,6 +542,7 @@ func (pr *PieceReader) Read(p []byte) (n int, err error) {
 		}
 
 		pr.readerFor = file.ID
+		pr.readerOffset = fileOffset
 	}
 
 	maxToRead := carBlock.CarOffset + int64(carBlock.CarBlockLength) - pr.pos
@@ -306,6 +552,7 @@ func (pr *PieceReader) Read(p []byte) (n int, err error) {
 	limitReader := io.LimitReader(pr.reader, maxToRead)
 	n, err = limitReader.Read(p)
 	pr.pos += int64(n)
+	pr.readerOffset += int64(n)
 	if errors.Is(err, io.EOF) {
 		err = nil
 		pr.reader.Close()
@@ -322,8 +569,66 @@ func (pr *PieceReader) Read(p []byte) (n int, err error) {
 }
 
 func (pr *PieceReader) Close() error {
+	if pr.prefetch != nil {
+		pr.prefetch.close()
+	}
 	if pr.reader == nil {
 		return nil
 	}
 	return pr.reader.Close()
 }
+
+// WritePiece regenerates the CAR file of a piece from its metadata and writes it to outputPath, i.e. to re-create a
+// CAR file that was lost without running the content provider. The blocks read from the data source are verified
+// against their CID, so a modified source file never ends up in the CAR file. The CAR file is written to a temporary
+// file next to outputPath first, so that an interrupted write does not leave a partial CAR file behind.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - car: The Car model of the CAR file to regenerate.
+//   - storage: The storage that the files of the CAR file are read from.
+//   - carBlocks: The blocks of the CAR file.
+//   - files: The files that the blocks are read from.
+//   - outputPath: The path of the CAR file to write.
+//
+// Returns:
+//   - The number of bytes written, and an error if the CAR file could not be regenerated.
+func WritePiece(
+	ctx context.Context,
+	car model.Car,
+	storage model.Storage,
+	carBlocks []model.CarBlock,
+	files []model.File,
+	outputPath string,
+) (int64, error) {
+	reader, err := NewPieceReader(ctx, car, storage, carBlocks, files)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create piece reader")
+	}
+	defer reader.Close()
+	reader.VerifyBlocks(true)
+
+	tmpPath := outputPath + ".tmp"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer os.Remove(tmpPath)
+	n, err := io.Copy(file, reader)
+	if err != nil {
+		_ = file.Close()
+		return n, errors.Wrapf(err, "failed to write %s", outputPath)
+	}
+	err = file.Close()
+	if err != nil {
+		return n, errors.WithStack(err)
+	}
+	if n != car.FileSize {
+		return n, errors.Wrapf(ErrTruncated, "expected %d bytes, wrote %d", car.FileSize, n)
+	}
+	err = os.Rename(tmpPath, outputPath)
+	if err != nil {
+		return n, errors.WithStack(err)
+	}
+	return n, nil
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/data-preservation-programs/singularity/model"
 	"github.com/data-preservation-programs/singularity/util/testutil"
@@ -411,3 +412,357 @@ func TestNewPieceReader_InvalidConstruction(t *testing.T) {
 		})
 	}
 }
+
+func TestPieceReader_BlockCache(t *testing.T) {
+	tmp := t.TempDir()
+	testFileContent := []byte("1234567890123456789009876543210987654321")
+	err := os.WriteFile(filepath.Join(tmp, "1.txt"), testFileContent, 0644)
+	require.NoError(t, err)
+	ctx := context.Background()
+	size := int64(173)
+
+	car := model.Car{
+		RootCID:  model.CID(testutil.TestCid),
+		FileSize: size,
+	}
+	storage := model.Storage{
+		ID:   1,
+		Type: "local",
+		Path: tmp,
+	}
+	carBlocks := []model.CarBlock{
+		{
+			CarOffset:      59,
+			CarBlockLength: 57,
+			Varint:         []byte{56},
+			FileID:         ptr.Of(model.FileID(1)),
+			CID:            model.CID(cid.NewCidV1(cid.Raw, util.Hash(testFileContent[:20]))),
+		},
+		{
+			CarOffset:      116,
+			CarBlockLength: 57,
+			Varint:         []byte{56},
+			FileID:         ptr.Of(model.FileID(1)),
+			FileOffset:     20,
+			CID:            model.CID(cid.NewCidV1(cid.Raw, util.Hash(testFileContent[20:]))),
+		},
+	}
+	files := []model.File{{
+		ID: 1,
+		Attachment: &model.SourceAttachment{
+			StorageID: 1,
+		},
+		Path:             "1.txt",
+		LastModifiedNano: testutil.GetFileTimestamp(t, filepath.Join(tmp, "1.txt")),
+		Size:             40,
+	}}
+
+	reader, err := NewPieceReader(ctx, car, storage, carBlocks, files)
+	require.NoError(t, err)
+	expected, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+
+	cache, err := NewBlockCache(1024, "")
+	require.NoError(t, err)
+	reader, err = NewPieceReader(ctx, car, storage, carBlocks, files)
+	require.NoError(t, err)
+	reader.UseBlockCache(cache)
+	read, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, expected, read)
+	require.EqualValues(t, 40, cache.Size())
+	require.NoError(t, reader.Close())
+
+	// Blocks are read as a whole even if the reader starts in the middle of one
+	partialCache, err := NewBlockCache(1024, "")
+	require.NoError(t, err)
+	reader, err = NewPieceReader(ctx, car, storage, carBlocks, files)
+	require.NoError(t, err)
+	reader.UseBlockCache(partialCache)
+	_, err = reader.Seek(100, io.SeekStart)
+	require.NoError(t, err)
+	read, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, expected[100:], read)
+	require.EqualValues(t, 40, partialCache.Size())
+	require.NoError(t, reader.Close())
+
+	// The cached blocks are served without reading the file
+	err = os.Remove(filepath.Join(tmp, "1.txt"))
+	require.NoError(t, err)
+	reader, err = NewPieceReader(ctx, car, storage, carBlocks, files)
+	require.NoError(t, err)
+	reader.UseBlockCache(cache)
+	defer func() { require.NoError(t, reader.Close()) }()
+	for _, pos := range []int64{0, 60, 100, 150, size} {
+		_, err = reader.Seek(pos, io.SeekStart)
+		require.NoError(t, err)
+		read, err = io.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, expected[pos:], read)
+	}
+}
+
+func TestPieceReader_VerifyBlocks(t *testing.T) {
+	tmp := t.TempDir()
+	path := filepath.Join(tmp, "1.txt")
+	testFileContent := []byte("1234567890123456789009876543210987654321")
+	err := os.WriteFile(path, testFileContent, 0644)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	car := model.Car{
+		RootCID:  model.CID(testutil.TestCid),
+		FileSize: 173,
+	}
+	storage := model.Storage{
+		ID:   1,
+		Type: "local",
+		Path: tmp,
+	}
+	secondCID := cid.NewCidV1(cid.Raw, util.Hash(testFileContent[20:]))
+	carBlocks := []model.CarBlock{
+		{
+			CarOffset:      59,
+			CarBlockLength: 57,
+			Varint:         []byte{56},
+			FileID:         ptr.Of(model.FileID(1)),
+			CID:            model.CID(cid.NewCidV1(cid.Raw, util.Hash(testFileContent[:20]))),
+		},
+		{
+			CarOffset:      116,
+			CarBlockLength: 57,
+			Varint:         []byte{56},
+			FileID:         ptr.Of(model.FileID(1)),
+			FileOffset:     20,
+			CID:            model.CID(secondCID),
+		},
+	}
+	lastModified := testutil.GetFileTimestamp(t, path)
+	files := []model.File{{
+		ID: 1,
+		Attachment: &model.SourceAttachment{
+			StorageID: 1,
+		},
+		Path:             "1.txt",
+		LastModifiedNano: lastModified,
+		Size:             40,
+	}}
+
+	reader, err := NewPieceReader(ctx, car, storage, carBlocks, files)
+	require.NoError(t, err)
+	reader.VerifyBlocks(true)
+	read, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Len(t, read, 173)
+	require.NoError(t, reader.Close())
+
+	// Modify the second block in place without changing the size or the last modified time
+	modified := append([]byte{}, testFileContent...)
+	modified[30] = 'x'
+	err = os.WriteFile(path, modified, 0644)
+	require.NoError(t, err)
+	mtime := time.Unix(0, lastModified)
+	err = os.Chtimes(path, mtime, mtime)
+	require.NoError(t, err)
+
+	reader, err = NewPieceReader(ctx, car, storage, carBlocks, files)
+	require.NoError(t, err)
+	read, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Len(t, read, 173)
+	require.NoError(t, reader.Close())
+
+	reader, err = NewPieceReader(ctx, car, storage, carBlocks, files)
+	require.NoError(t, err)
+	reader.VerifyBlocks(true)
+	defer func() { require.NoError(t, reader.Close()) }()
+	_, err = io.ReadAll(reader)
+	require.ErrorIs(t, err, ErrBlockMismatch)
+	var mismatch BlockMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	require.Equal(t, model.FileID(1), mismatch.FileID)
+	require.Equal(t, "1.txt", mismatch.Path)
+	require.EqualValues(t, 20, mismatch.Offset)
+	require.Equal(t, secondCID, mismatch.CID)
+	require.Equal(t, cid.NewCidV1(cid.Raw, util.Hash(modified[20:])), mismatch.Actual)
+
+	// Blocks before the modified one are still served
+	_, err = reader.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	read = make([]byte, 116)
+	_, err = io.ReadFull(reader, read)
+	require.NoError(t, err)
+}
+
+func TestPieceReader_Prefetch(t *testing.T) {
+	tmp := t.TempDir()
+	testFileContent := []byte("1234567890123456789009876543210987654321")
+	err := os.WriteFile(filepath.Join(tmp, "1.txt"), testFileContent, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tmp, "2.txt"), testFileContent[:20], 0644)
+	require.NoError(t, err)
+	ctx := context.Background()
+	size := int64(287)
+
+	car := model.Car{
+		RootCID:  model.CID(testutil.TestCid),
+		FileSize: size,
+	}
+	storage := model.Storage{
+		ID:   1,
+		Type: "local",
+		Path: tmp,
+	}
+	carBlocks := []model.CarBlock{
+		{
+			CarOffset:      59,
+			CarBlockLength: 57,
+			Varint:         []byte{56},
+			FileID:         ptr.Of(model.FileID(1)),
+			CID:            model.CID(cid.NewCidV1(cid.Raw, util.Hash(testFileContent[:20]))),
+		},
+		{
+			CarOffset:      116,
+			CarBlockLength: 57,
+			Varint:         []byte{56},
+			FileID:         ptr.Of(model.FileID(1)),
+			FileOffset:     20,
+			CID:            model.CID(cid.NewCidV1(cid.Raw, util.Hash(testFileContent[20:]))),
+		},
+		{
+			CarOffset:      173,
+			CarBlockLength: 57,
+			Varint:         []byte{56},
+			CID:            model.CID(cid.NewCidV1(cid.Raw, util.Hash(testFileContent[:20]))),
+			RawBlock:       testFileContent[:20],
+		},
+		{
+			CarOffset:      230,
+			CarBlockLength: 57,
+			Varint:         []byte{56},
+			FileID:         ptr.Of(model.FileID(2)),
+			CID:            model.CID(cid.NewCidV1(cid.Raw, util.Hash(testFileContent[:20]))),
+		},
+	}
+	files := []model.File{{
+		ID: 1,
+		Attachment: &model.SourceAttachment{
+			StorageID: 1,
+		},
+		Path:             "1.txt",
+		LastModifiedNano: testutil.GetFileTimestamp(t, filepath.Join(tmp, "1.txt")),
+		Size:             40,
+	}, {
+		ID: 2,
+		Attachment: &model.SourceAttachment{
+			StorageID: 1,
+		},
+		Path:             "2.txt",
+		LastModifiedNano: testutil.GetFileTimestamp(t, filepath.Join(tmp, "2.txt")),
+		Size:             20,
+	}}
+
+	reader, err := NewPieceReader(ctx, car, storage, carBlocks, files)
+	require.NoError(t, err)
+	expected, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+
+	// The buffer size of 20 bytes makes each block a separate segment
+	for _, bufferSize := range []int64{20, DefaultPrefetchBufferSize} {
+		reader, err = NewPieceReader(ctx, car, storage, carBlocks, files)
+		require.NoError(t, err)
+		reader.Prefetch(2, bufferSize)
+		for _, pos := range []int64{0, 100, 60, 230, 150, size, 0} {
+			_, err = reader.Seek(pos, io.SeekStart)
+			require.NoError(t, err)
+			read, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			require.Equal(t, expected[pos:], read)
+		}
+		cloned := reader.Clone()
+		read, err := io.ReadAll(cloned)
+		require.NoError(t, err)
+		require.Equal(t, expected, read)
+		require.NoError(t, cloned.Close())
+		require.NoError(t, reader.Close())
+	}
+
+	// Changed files are detected by the prefetcher
+	err = os.WriteFile(filepath.Join(tmp, "2.txt"), []byte("changed"), 0644)
+	require.NoError(t, err)
+	reader, err = NewPieceReader(ctx, car, storage, carBlocks, files)
+	require.NoError(t, err)
+	reader.Prefetch(DefaultPrefetchConcurrency, DefaultPrefetchBufferSize)
+	defer func() { require.NoError(t, reader.Close()) }()
+	_, err = io.ReadAll(reader)
+	require.ErrorIs(t, err, ErrFileHasChanged)
+}
+
+func TestWritePiece(t *testing.T) {
+	tmp := t.TempDir()
+	path := filepath.Join(tmp, "1.txt")
+	testFileContent := []byte("12345678901234567890")
+	err := os.WriteFile(path, testFileContent, 0644)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	car := model.Car{
+		RootCID:  model.CID(testutil.TestCid),
+		FileSize: 116,
+	}
+	storage := model.Storage{
+		ID:   1,
+		Type: "local",
+		Path: tmp,
+	}
+	carBlocks := []model.CarBlock{
+		{
+			CarOffset:      59,
+			CarBlockLength: 57,
+			Varint:         []byte{56},
+			FileID:         ptr.Of(model.FileID(1)),
+			CID:            model.CID(cid.NewCidV1(cid.Raw, util.Hash(testFileContent))),
+		},
+	}
+	lastModified := testutil.GetFileTimestamp(t, path)
+	files := []model.File{{
+		ID: 1,
+		Attachment: &model.SourceAttachment{
+			StorageID: 1,
+		},
+		Path:             "1.txt",
+		LastModifiedNano: lastModified,
+		Size:             20,
+	}}
+
+	reader, err := NewPieceReader(ctx, car, storage, carBlocks, files)
+	require.NoError(t, err)
+	expected, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+
+	outPath := filepath.Join(t.TempDir(), "piece.car")
+	n, err := WritePiece(ctx, car, storage, carBlocks, files, outPath)
+	require.NoError(t, err)
+	require.EqualValues(t, 116, n)
+	written, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	require.Equal(t, expected, written)
+
+	// A modified source file does not end up in the CAR file
+	err = os.WriteFile(path, []byte("x2345678901234567890"), 0644)
+	require.NoError(t, err)
+	mtime := time.Unix(0, lastModified)
+	err = os.Chtimes(path, mtime, mtime)
+	require.NoError(t, err)
+	outPath = filepath.Join(t.TempDir(), "piece.car")
+	_, err = WritePiece(ctx, car, storage, carBlocks, files, outPath)
+	require.ErrorIs(t, err, ErrBlockMismatch)
+	_, err = os.Stat(outPath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	_, err = os.Stat(outPath + ".tmp")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
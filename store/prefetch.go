@@ -0,0 +1,164 @@
+package store
+
+import (
+	"context"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"github.com/data-preservation-programs/singularity/model"
+	"github.com/data-preservation-programs/singularity/storagesystem"
+)
+
+const (
+	DefaultPrefetchConcurrency = 4
+	DefaultPrefetchBufferSize  = 64 << 20
+)
+
+// prefetchSegment is a run of file-backed blocks that are contiguous in the same file, read with a single request.
+type prefetchSegment struct {
+	first  int   // Index of the first block of the segment
+	last   int   // Index of the last block of the segment
+	offset int64 // Offset of the segment in the file
+	size   int64
+	data   []byte
+	err    error
+	done   chan struct{}
+	cancel context.CancelFunc
+}
+
+// prefetcher reads the upcoming file-backed blocks of a PieceReader concurrently and buffers them until they are
+// read, so that the latency of opening each file of a remote data source does not stall the stream.
+//
+// Fields:
+//   - ctx: The context of the PieceReader.
+//   - handler: The handler of the data source.
+//   - carBlocks: The blocks of the piece.
+//   - files: The files of the piece by ID.
+//   - concurrency: The maximum number of segments that are read or buffered at the same time.
+//   - bufferSize: The maximum total size of the segments that are read or buffered.
+//   - segments: The segments that are read or buffered, in the order of the blocks.
+//   - next: The index of the first block that is not part of a segment yet.
+type prefetcher struct {
+	ctx         context.Context
+	handler     storagesystem.Handler
+	carBlocks   []model.CarBlock
+	files       map[model.FileID]model.File
+	concurrency int
+	bufferSize  int64
+	segments    []*prefetchSegment
+	next        int
+}
+
+// get returns the data of a file-backed block, waiting for the segment that contains it to be read. The segments of
+// the blocks before it are released, and more segments are started for the blocks after it. If the block is not
+// part of the segments that are read, i.e. because the reader was moved, all segments are released and prefetching
+// restarts from the block.
+func (p *prefetcher) get(index int) ([]byte, error) {
+	for len(p.segments) > 0 && p.segments[0].last < index {
+		p.segments[0].cancel()
+		p.segments = p.segments[1:]
+	}
+	if len(p.segments) == 0 || p.segments[0].first > index {
+		p.close()
+		p.next = index
+	}
+	p.fill()
+
+	segment := p.segments[0]
+	select {
+	case <-segment.done:
+	case <-p.ctx.Done():
+		return nil, p.ctx.Err()
+	}
+	if segment.err != nil {
+		return nil, segment.err
+	}
+	carBlock := p.carBlocks[index]
+	start := carBlock.FileOffset - segment.offset
+	return segment.data[start : start+int64(carBlock.BlockLength())], nil
+}
+
+// fill starts reading the segments of the blocks after the last segment until the concurrency or the buffer size
+// is exhausted. A segment is always started if there is none, even if its size exceeds the buffer size.
+func (p *prefetcher) fill() {
+	var buffered int64
+	for _, segment := range p.segments {
+		buffered += segment.size
+	}
+	maxSegmentSize := p.bufferSize / int64(p.concurrency)
+	for len(p.segments) < p.concurrency {
+		for p.next < len(p.carBlocks) && p.carBlocks[p.next].FileID == nil {
+			p.next++
+		}
+		if p.next >= len(p.carBlocks) {
+			return
+		}
+
+		first := p.carBlocks[p.next]
+		segment := &prefetchSegment{
+			first:  p.next,
+			last:   p.next,
+			offset: first.FileOffset,
+			size:   int64(first.BlockLength()),
+			done:   make(chan struct{}),
+		}
+		for i := p.next + 1; i < len(p.carBlocks); i++ {
+			carBlock := p.carBlocks[i]
+			if carBlock.FileID == nil || *carBlock.FileID != *first.FileID ||
+				carBlock.FileOffset != segment.offset+segment.size ||
+				segment.size+int64(carBlock.BlockLength()) > maxSegmentSize {
+				break
+			}
+			segment.last = i
+			segment.size += int64(carBlock.BlockLength())
+		}
+		if len(p.segments) > 0 && buffered+segment.size > p.bufferSize {
+			return
+		}
+
+		var ctx context.Context
+		ctx, segment.cancel = context.WithCancel(p.ctx)
+		go p.read(ctx, segment)
+		p.segments = append(p.segments, segment)
+		buffered += segment.size
+		p.next = segment.last + 1
+	}
+}
+
+// read reads the data of a segment from the data source and closes its done channel.
+func (p *prefetcher) read(ctx context.Context, segment *prefetchSegment) {
+	defer close(segment.done)
+	file := p.files[*p.carBlocks[segment.first].FileID]
+	logger.Infow("prefetching file", "path", file.Path, "offset", segment.offset, "length", segment.size)
+	reader, obj, err := p.handler.Read(ctx, file.Path, segment.offset, segment.size)
+	if err != nil {
+		segment.err = errors.Wrap(err, "failed to read file")
+		return
+	}
+	defer reader.Close()
+	isSameEntry, explanation := storagesystem.IsSameEntry(ctx, file, obj)
+	if !isSameEntry {
+		segment.err = errors.Wrap(ErrFileHasChanged, explanation)
+		return
+	}
+
+	data := make([]byte, segment.size)
+	_, err = io.ReadFull(reader, data)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		segment.err = ErrTruncated
+		return
+	}
+	if err != nil {
+		segment.err = errors.WithStack(err)
+		return
+	}
+	segment.data = data
+}
+
+// close cancels the reads of all segments and releases them.
+func (p *prefetcher) close() {
+	for _, segment := range p.segments {
+		segment.cancel()
+	}
+	p.segments = nil
+}
@@ -8,6 +8,10 @@ import (
 	"gorm.io/gorm"
 )
 
+// ErrBlockMismatch is returned when the data of a block does not hash to its CID. It is defined here, rather than in
+// the store package that exports it, so the storage backends, which the store package depends on, can wrap it too.
+var ErrBlockMismatch = errors.New("block does not match its CID")
+
 type AggregateError struct {
 	Errors []error
 }
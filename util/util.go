@@ -14,6 +14,13 @@ import (
 	"github.com/ybbus/jsonrpc/v3"
 )
 
+const (
+	// MinPieceSize is the smallest piece size, which is the size of a single padded leaf pair of the commP tree.
+	MinPieceSize = 128
+	// MaxPieceSize is the largest piece size, which is the size of a 64GiB sector.
+	MaxPieceSize = 64 << 30
+)
+
 // NextPowerOfTwo calculates the smallest power of two that is greater than or equal to x.
 // If x is already a power of two, it returns x. For x equal to 0, the result is 1.
 //
@@ -159,6 +166,39 @@ func GenerateNewPeer() ([]byte, []byte, peer.ID, error) {
 	return privateBytes, publicBytes, peerID, nil
 }
 
+// VerifyPeerSignature checks a signature made with the libp2p key of a peer, such as the signed manifest of a content
+// provider or a piece receipt.
+//
+// Parameters:
+//   - signer: The peer ID of the key that signed the payload.
+//   - publicKey: The protobuf encoded public key of the signer.
+//   - payload: The signed payload.
+//   - signature: The signature of the payload.
+//
+// Returns:
+//   - An error if the public key cannot be decoded, does not match the signer, or the signature is invalid.
+func VerifyPeerSignature(signer string, publicKey []byte, payload []byte, signature []byte) error {
+	key, err := crypto.UnmarshalPublicKey(publicKey)
+	if err != nil {
+		return errors.Wrap(err, "cannot unmarshal public key")
+	}
+	signerID, err := peer.Decode(signer)
+	if err != nil {
+		return errors.Wrap(err, "cannot decode signer")
+	}
+	if !signerID.MatchesPublicKey(key) {
+		return errors.New("public key does not match the signer")
+	}
+	ok, err := key.Verify(payload, signature)
+	if err != nil {
+		return errors.Wrap(err, "cannot verify signature")
+	}
+	if !ok {
+		return errors.New("signature does not match the payload")
+	}
+	return nil
+}
+
 var ErrNotImplemented = errors.New("not implemented")
 
 func RandomName() string {
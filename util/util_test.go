@@ -153,3 +153,24 @@ func TestGenerateNewPeer(t *testing.T) {
 	err = peerID.Validate()
 	require.NoError(t, err)
 }
+
+func TestVerifyPeerSignature(t *testing.T) {
+	privateBytes, publicBytes, peerID, err := GenerateNewPeer()
+	require.NoError(t, err)
+	privateKey, err := crypto.UnmarshalPrivateKey(privateBytes)
+	require.NoError(t, err)
+	payload := []byte("payload")
+	signature, err := privateKey.Sign(payload)
+	require.NoError(t, err)
+
+	err = VerifyPeerSignature(peerID.String(), publicBytes, payload, signature)
+	require.NoError(t, err)
+
+	err = VerifyPeerSignature(peerID.String(), publicBytes, []byte("tampered"), signature)
+	require.ErrorContains(t, err, "signature does not match")
+
+	_, otherPublic, _, err := GenerateNewPeer()
+	require.NoError(t, err)
+	err = VerifyPeerSignature(peerID.String(), otherPublic, payload, signature)
+	require.ErrorContains(t, err, "does not match the signer")
+}
@@ -0,0 +1,338 @@
+package version
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/mod/semver"
+)
+
+// ReleaseAPI is the GitHub API endpoint that returns the latest release of singularity.
+var ReleaseAPI = "https://api.github.com/repos/data-preservation-programs/singularity/releases/latest"
+
+// ReleasePublicKey is the base64 encoded ed25519 public key that signs the checksums of the release artifacts.
+// It is set at build time with -ldflags "-X github.com/data-preservation-programs/singularity/version.ReleasePublicKey=...".
+var ReleasePublicKey string
+
+const maxArtifactSize = 1 << 30
+
+var (
+	ErrNoPublicKey        = errors.New("no release public key is configured, cannot verify the release signature")
+	ErrInvalidSignature   = errors.New("invalid release signature")
+	ErrChecksumMismatch   = errors.New("checksum mismatch")
+	ErrAssetNotFound      = errors.New("release asset not found")
+	ErrBinaryNotInArchive = errors.New("singularity binary not found in release archive")
+)
+
+type ReleaseAsset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+type Release struct {
+	TagName string         `json:"tag_name"`
+	HTMLURL string         `json:"html_url"`
+	Assets  []ReleaseAsset `json:"assets"`
+}
+
+type CheckResult struct {
+	Current         string `json:"current"`
+	Latest          string `json:"latest"`
+	UpdateAvailable bool   `json:"updateAvailable"`
+	ReleaseURL      string `json:"releaseUrl"`
+}
+
+// LatestRelease returns the latest published release of singularity.
+func LatestRelease(ctx context.Context) (*Release, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ReleaseAPI, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query latest release")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Newf("failed to query latest release: %s", resp.Status)
+	}
+	var release Release
+	err = json.NewDecoder(resp.Body).Decode(&release)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode latest release")
+	}
+	return &release, nil
+}
+
+// Check compares the running version with the latest release.
+func Check(ctx context.Context) (*CheckResult, error) {
+	release, err := LatestRelease(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CheckResult{
+		Current:         Version,
+		Latest:          release.TagName,
+		UpdateAvailable: release.IsNewer(),
+		ReleaseURL:      release.HTMLURL,
+	}, nil
+}
+
+// IsNewer returns whether the release is newer than the running version.
+// Development builds without a valid version are always considered outdated.
+func (r Release) IsNewer() bool {
+	if !semver.IsValid(Version) {
+		return true
+	}
+	return semver.Compare(r.TagName, Version) > 0
+}
+
+// archiveName returns the name of the release archive for the given platform, as produced by goreleaser.
+func archiveName(tag string, goos string, goarch string) string {
+	osName := goos
+	if goos == "darwin" {
+		osName = "mac_os"
+	}
+	ext := ".tar.gz"
+	if goos == "windows" || goos == "darwin" {
+		ext = ".zip"
+	}
+	return "singularity_" + strings.TrimPrefix(tag, "v") + "_" + osName + "_" + goarch + ext
+}
+
+func checksumsName(tag string) string {
+	return "singularity_" + strings.TrimPrefix(tag, "v") + "_checksums.txt"
+}
+
+func (r Release) asset(name string) (ReleaseAsset, error) {
+	for _, asset := range r.Assets {
+		if asset.Name == name {
+			return asset, nil
+		}
+	}
+	return ReleaseAsset{}, errors.Wrapf(ErrAssetNotFound, "%s in release %s", name, r.TagName)
+}
+
+func download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download %s", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Newf("failed to download %s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxArtifactSize))
+	return data, errors.Wrapf(err, "failed to download %s", url)
+}
+
+// verifySignature verifies the ed25519 signature of the checksums file. The signature may be raw or base64 encoded.
+func verifySignature(publicKey string, checksums []byte, signature []byte) error {
+	if publicKey == "" {
+		return ErrNoPublicKey
+	}
+	key, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return errors.Wrap(ErrNoPublicKey, "the release public key is not a base64 encoded ed25519 public key")
+	}
+	if len(signature) != ed25519.SignatureSize {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+		if err == nil {
+			signature = decoded
+		}
+	}
+	if !ed25519.Verify(key, checksums, signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// verifyChecksum verifies the sha256 checksum of the artifact against the goreleaser checksums file.
+func verifyChecksum(checksums []byte, name string, artifact []byte) error {
+	sum := sha256.Sum256(artifact)
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || fields[1] != name {
+			continue
+		}
+		if fields[0] != hex.EncodeToString(sum[:]) {
+			return errors.Wrapf(ErrChecksumMismatch, "%s", name)
+		}
+		return nil
+	}
+	return errors.Wrapf(ErrChecksumMismatch, "%s is not listed in the checksums file", name)
+}
+
+// extractBinary returns the singularity executable from the release archive.
+func extractBinary(archive []byte, name string) ([]byte, error) {
+	binary := "singularity"
+	if strings.HasSuffix(name, ".zip") && strings.Contains(name, "_windows_") {
+		binary += ".exe"
+	}
+
+	if strings.HasSuffix(name, ".zip") {
+		reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open release archive")
+		}
+		for _, file := range reader.File {
+			if path.Base(file.Name) != binary || file.FileInfo().IsDir() {
+				continue
+			}
+			f, err := file.Open()
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			defer f.Close()
+			data, err := io.ReadAll(io.LimitReader(f, maxArtifactSize))
+			return data, errors.WithStack(err)
+		}
+		return nil, ErrBinaryNotInArchive
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open release archive")
+	}
+	defer gz.Close()
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, ErrBinaryNotInArchive
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read release archive")
+		}
+		if header.Typeflag != tar.TypeReg || path.Base(header.Name) != binary {
+			continue
+		}
+		data, err := io.ReadAll(io.LimitReader(reader, maxArtifactSize))
+		return data, errors.WithStack(err)
+	}
+}
+
+// replaceExecutable atomically replaces the executable at target with the new binary.
+// The running executable is moved aside first, since Windows does not allow overwriting a running executable.
+func replaceExecutable(target string, binary []byte) error {
+	stat, err := os.Stat(target)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	dir := filepath.Dir(target)
+	newFile, err := os.CreateTemp(dir, ".singularity-update-*")
+	if err != nil {
+		return errors.Wrapf(err, "failed to create file in %s", dir)
+	}
+	newPath := newFile.Name()
+	defer os.Remove(newPath)
+	_, err = newFile.Write(binary)
+	if err != nil {
+		newFile.Close()
+		return errors.WithStack(err)
+	}
+	err = newFile.Close()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = os.Chmod(newPath, stat.Mode().Perm())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	oldPath := target + ".old"
+	_ = os.Remove(oldPath)
+	err = os.Rename(target, oldPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to move %s", target)
+	}
+	err = os.Rename(newPath, target)
+	if err != nil {
+		_ = os.Rename(oldPath, target)
+		return errors.Wrapf(err, "failed to replace %s", target)
+	}
+	// On Windows, the running executable cannot be removed. It is removed by the next update instead.
+	_ = os.Remove(oldPath)
+	return nil
+}
+
+// Update downloads the given release for the current platform, verifies the signature of the checksums file and
+// the checksum of the archive, and replaces the executable at target with the binary in the archive.
+//
+// Parameters:
+//   - ctx: The context for managing timeouts and cancellation.
+//   - release: The release to install.
+//   - publicKey: The base64 encoded ed25519 public key that signs the checksums file.
+//   - target: The path of the executable to replace.
+//
+// Returns:
+//   - An error if the release cannot be downloaded, fails verification or cannot be installed.
+func Update(ctx context.Context, release Release, publicKey string, target string) error {
+	if publicKey == "" {
+		return ErrNoPublicKey
+	}
+	checksumsAsset, err := release.asset(checksumsName(release.TagName))
+	if err != nil {
+		return err
+	}
+	signatureAsset, err := release.asset(checksumsAsset.Name + ".sig")
+	if err != nil {
+		return err
+	}
+	name := archiveName(release.TagName, runtime.GOOS, runtime.GOARCH)
+	archiveAsset, err := release.asset(name)
+	if err != nil {
+		return err
+	}
+
+	checksums, err := download(ctx, checksumsAsset.BrowserDownloadURL)
+	if err != nil {
+		return err
+	}
+	signature, err := download(ctx, signatureAsset.BrowserDownloadURL)
+	if err != nil {
+		return err
+	}
+	err = verifySignature(publicKey, checksums, signature)
+	if err != nil {
+		return err
+	}
+
+	archive, err := download(ctx, archiveAsset.BrowserDownloadURL)
+	if err != nil {
+		return err
+	}
+	err = verifyChecksum(checksums, name, archive)
+	if err != nil {
+		return err
+	}
+
+	binary, err := extractBinary(archive, name)
+	if err != nil {
+		return err
+	}
+	return replaceExecutable(target, binary)
+}
@@ -0,0 +1,162 @@
+package version
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func buildArchive(t *testing.T, name string, binary []byte) []byte {
+	t.Helper()
+	buf := bytes.NewBuffer(nil)
+	if filepath.Ext(name) == ".zip" {
+		writer := zip.NewWriter(buf)
+		binaryName := "singularity"
+		if runtime.GOOS == "windows" {
+			binaryName += ".exe"
+		}
+		f, err := writer.Create(binaryName)
+		require.NoError(t, err)
+		_, err = f.Write(binary)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		return buf.Bytes()
+	}
+	gz := gzip.NewWriter(buf)
+	writer := tar.NewWriter(gz)
+	require.NoError(t, writer.WriteHeader(&tar.Header{Name: "README.md", Mode: 0644, Size: 2, Typeflag: tar.TypeReg}))
+	_, err := writer.Write([]byte("hi"))
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteHeader(&tar.Header{Name: "singularity", Mode: 0755, Size: int64(len(binary)), Typeflag: tar.TypeReg}))
+	_, err = writer.Write(binary)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+type testRelease struct {
+	server    *httptest.Server
+	publicKey string
+	files     map[string][]byte
+}
+
+func newTestRelease(t *testing.T, tag string, binary []byte) *testRelease {
+	t.Helper()
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	name := archiveName(tag, runtime.GOOS, runtime.GOARCH)
+	archive := buildArchive(t, name, binary)
+	sum := sha256.Sum256(archive)
+	checksums := []byte(hex.EncodeToString(sum[:]) + "  " + name + "\n")
+	r := &testRelease{
+		publicKey: base64.StdEncoding.EncodeToString(publicKey),
+		files: map[string][]byte{
+			name:                        archive,
+			checksumsName(tag):          checksums,
+			checksumsName(tag) + ".sig": ed25519.Sign(privateKey, checksums),
+		},
+	}
+
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/latest" {
+			release := Release{TagName: tag, HTMLURL: "https://example.com/" + tag}
+			for fileName := range r.files {
+				release.Assets = append(release.Assets, ReleaseAsset{
+					Name:               fileName,
+					BrowserDownloadURL: r.server.URL + "/download/" + fileName,
+				})
+			}
+			_ = json.NewEncoder(w).Encode(release)
+			return
+		}
+		data, ok := r.files[filepath.Base(req.URL.Path)]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(r.server.Close)
+
+	oldAPI := ReleaseAPI
+	ReleaseAPI = r.server.URL + "/latest"
+	t.Cleanup(func() { ReleaseAPI = oldAPI })
+	return r
+}
+
+func TestCheck(t *testing.T) {
+	old := Version
+	Version = "v0.5.13"
+	defer func() { Version = old }()
+
+	newTestRelease(t, "v0.6.0", []byte("new"))
+	result, err := Check(context.Background())
+	require.NoError(t, err)
+	require.True(t, result.UpdateAvailable)
+	require.Equal(t, "v0.6.0", result.Latest)
+
+	Version = "v0.6.0"
+	result, err = Check(context.Background())
+	require.NoError(t, err)
+	require.False(t, result.UpdateAvailable)
+}
+
+func TestUpdate(t *testing.T) {
+	r := newTestRelease(t, "v0.6.0", []byte("new binary"))
+	release, err := LatestRelease(context.Background())
+	require.NoError(t, err)
+
+	target := filepath.Join(t.TempDir(), "singularity")
+	require.NoError(t, os.WriteFile(target, []byte("old binary"), 0755))
+
+	t.Run("no public key", func(t *testing.T) {
+		err := Update(context.Background(), *release, "", target)
+		require.ErrorIs(t, err, ErrNoPublicKey)
+	})
+
+	t.Run("wrong public key", func(t *testing.T) {
+		otherKey, _, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+		err = Update(context.Background(), *release, base64.StdEncoding.EncodeToString(otherKey), target)
+		require.ErrorIs(t, err, ErrInvalidSignature)
+		content, err := os.ReadFile(target)
+		require.NoError(t, err)
+		require.Equal(t, "old binary", string(content))
+	})
+
+	t.Run("tampered archive", func(t *testing.T) {
+		name := archiveName("v0.6.0", runtime.GOOS, runtime.GOARCH)
+		original := r.files[name]
+		r.files[name] = buildArchive(t, name, []byte("malicious binary"))
+		defer func() { r.files[name] = original }()
+		err := Update(context.Background(), *release, r.publicKey, target)
+		require.ErrorIs(t, err, ErrChecksumMismatch)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		err := Update(context.Background(), *release, r.publicKey, target)
+		require.NoError(t, err)
+		content, err := os.ReadFile(target)
+		require.NoError(t, err)
+		require.Equal(t, "new binary", string(content))
+		_, err = os.Stat(target + ".old")
+		require.True(t, os.IsNotExist(err))
+	})
+}
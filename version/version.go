@@ -0,0 +1,61 @@
+package version
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/mod/semver"
+)
+
+// Header is the HTTP header used by singularity servers and clients to advertise their version to each other.
+const Header = "X-Singularity-Version"
+
+// Version is the version of the running singularity binary.
+var Version string
+
+var ErrIncompatibleVersion = errors.New("incompatible singularity version")
+
+// Compatible returns whether two singularity versions can talk to each other.
+// Within a major version, minor version upgrades may change the database schema and the API,
+// so two versions are compatible only if they share the same major and minor version.
+// Versions that are empty or not valid semantic versions, i.e. development builds, are always considered compatible.
+func Compatible(a string, b string) bool {
+	if !semver.IsValid(a) || !semver.IsValid(b) {
+		return true
+	}
+	return semver.MajorMinor(a) == semver.MajorMinor(b)
+}
+
+// Middleware is an echo middleware that advertises the server version in every response, and rejects
+// requests from clients that advertise an incompatible version.
+// Requests without the version header, i.e. from third party clients, are always accepted.
+func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Response().Header().Set(Header, Version)
+		clientVersion := c.Request().Header.Get(Header)
+		if clientVersion != "" && !Compatible(clientVersion, Version) {
+			return c.String(http.StatusPreconditionFailed,
+				"client version "+clientVersion+" is not compatible with server version "+Version+
+					". Please upgrade the client or the server so that they share the same minor version.")
+		}
+		return next(c)
+	}
+}
+
+// SetRequestHeader advertises the client version in an outgoing request to a singularity server.
+func SetRequestHeader(req *http.Request) {
+	if Version != "" {
+		req.Header.Set(Header, Version)
+	}
+}
+
+// CheckResponse returns ErrIncompatibleVersion if the singularity server that sent the response
+// advertises a version that is not compatible with the client.
+func CheckResponse(resp *http.Response) error {
+	serverVersion := resp.Header.Get(Header)
+	if serverVersion == "" || Compatible(serverVersion, Version) {
+		return nil
+	}
+	return errors.Wrapf(ErrIncompatibleVersion, "server version %s, client version %s", serverVersion, Version)
+}
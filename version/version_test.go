@@ -0,0 +1,63 @@
+package version
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompatible(t *testing.T) {
+	require.True(t, Compatible("v0.5.13", "v0.5.1"))
+	require.False(t, Compatible("v0.5.13", "v0.6.0"))
+	require.False(t, Compatible("v1.5.0", "v0.5.0"))
+	require.True(t, Compatible("", "v0.5.0"))
+	require.True(t, Compatible("v0.5.0", "devel"))
+}
+
+func TestMiddleware(t *testing.T) {
+	old := Version
+	Version = "v0.5.13"
+	defer func() { Version = old }()
+
+	e := echo.New()
+	handler := Middleware(func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	for _, tc := range []struct {
+		clientVersion string
+		status        int
+	}{
+		{"", http.StatusOK},
+		{"v0.5.0", http.StatusOK},
+		{"v0.6.0", http.StatusPreconditionFailed},
+	} {
+		t.Run(tc.clientVersion, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.clientVersion != "" {
+				req.Header.Set(Header, tc.clientVersion)
+			}
+			rec := httptest.NewRecorder()
+			err := handler(e.NewContext(req, rec))
+			require.NoError(t, err)
+			require.Equal(t, tc.status, rec.Code)
+			require.Equal(t, "v0.5.13", rec.Header().Get(Header))
+		})
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	old := Version
+	Version = "v0.5.13"
+	defer func() { Version = old }()
+
+	resp := &http.Response{Header: http.Header{}}
+	require.NoError(t, CheckResponse(resp))
+	resp.Header.Set(Header, "v0.5.2")
+	require.NoError(t, CheckResponse(resp))
+	resp.Header.Set(Header, "v0.4.2")
+	require.ErrorIs(t, CheckResponse(resp), ErrIncompatibleVersion)
+}